@@ -0,0 +1,56 @@
+package apiconfig
+
+import "strings"
+
+// ChainEndpoints elects the chain node RPC endpoint the API talks to
+type ChainEndpoints interface {
+	// Current returns the elected endpoint URL
+	Current() string
+	// ReportFailure tells the election that a request to url failed at the transport level
+	ReportFailure(url string)
+}
+
+// Endpoints returns Url followed by the failover URLs, without blanks or duplicates
+func (c ChainNodeConfig) Endpoints() []string {
+	seen := make(map[string]bool)
+	var endpoints []string
+	add := func(url string) {
+		url = strings.TrimSpace(url)
+		if url == "" || seen[url] {
+			return
+		}
+		seen[url] = true
+		endpoints = append(endpoints, url)
+	}
+
+	add(c.Url)
+	for _, entry := range c.FailoverUrls {
+		for _, url := range strings.Split(entry, ",") {
+			add(url)
+		}
+	}
+	return endpoints
+}
+
+// SetChainEndpoints installs the endpoint election used by GetChainEndpoints
+func (cm *ConfigManager) SetChainEndpoints(endpoints ChainEndpoints) {
+	cm.mutex.Lock()
+	defer cm.mutex.Unlock()
+	cm.chainEndpoints = endpoints
+}
+
+// GetChainEndpoints returns the endpoint election, or the configured Url alone if none was installed
+func (cm *ConfigManager) GetChainEndpoints() ChainEndpoints {
+	cm.mutex.RLock()
+	defer cm.mutex.RUnlock()
+	if cm.chainEndpoints != nil {
+		return cm.chainEndpoints
+	}
+	return staticChainEndpoint(cm.currentConfig.ChainNode.Url)
+}
+
+type staticChainEndpoint string
+
+func (s staticChainEndpoint) Current() string { return string(s) }
+
+func (s staticChainEndpoint) ReportFailure(string) {}
@@ -1,7 +1,10 @@
 package apiconfig
 
 import (
+	"decentralized-api/internal/nodeaddr"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 )
 
@@ -16,9 +19,32 @@ type Config struct {
 	CurrentHeight       int64                 `koanf:"current_height" json:"current_height"`
 	LastProcessedHeight int64                 `koanf:"last_processed_height" json:"last_processed_height"`
 	UpgradePlan         UpgradePlan           `koanf:"upgrade_plan" json:"upgrade_plan"`
+	Upgrade             UpgradeConfig         `koanf:"upgrade" json:"upgrade"`
 	MLNodeKeyConfig     MLNodeKeyConfig       `koanf:"ml_node_key_config" json:"ml_node_key_config"`
 	Nats                NatsServerConfig      `koanf:"nats" json:"nats"`
 	TxBatching          TxBatchingConfig      `koanf:"tx_batching" json:"tx_batching"`
+	ValidationQueue          ValidationQueueConfig    `koanf:"validation_queue" json:"validation_queue"`
+	PeerHealth               PeerHealthConfig         `koanf:"peer_health" json:"peer_health"`
+	Preflight                PreflightConfig          `koanf:"preflight" json:"preflight"`
+	Routing                  RoutingConfig            `koanf:"routing" json:"routing"`
+	ModelDownload            ModelDownloadConfig      `koanf:"model_download" json:"model_download"`
+	PocBatchSizing           PocBatchSizingConfig     `koanf:"poc_batch_sizing" json:"poc_batch_sizing"`
+	ApiKeys                  ApiKeysConfig            `koanf:"api_keys" json:"api_keys"`
+	AuditLog                 AuditLogConfig           `koanf:"audit_log" json:"audit_log"`
+	Metrics                  MetricsConfig            `koanf:"metrics" json:"metrics"`
+	Tracing                  TracingConfig            `koanf:"tracing" json:"tracing"`
+	BlockCache               BlockCacheConfig         `koanf:"block_cache" json:"block_cache"`
+	Idempotency              IdempotencyConfig        `koanf:"idempotency" json:"idempotency"`
+	ContentFilter            ContentFilterConfig      `koanf:"content_filter" json:"content_filter"`
+	Backup                   BackupConfig             `koanf:"backup" json:"backup"`
+	Onboarding               OnboardingConfig         `koanf:"onboarding" json:"onboarding"`
+	RequesterBandwidth       RequesterBandwidthConfig `koanf:"requester_bandwidth" json:"requester_bandwidth"`
+	HedgedRequests           HedgedRequestsConfig     `koanf:"hedged_requests" json:"hedged_requests"`
+	PriorityScheduling       PrioritySchedulingConfig `koanf:"priority_scheduling" json:"priority_scheduling"`
+	Batches                  BatchesConfig            `koanf:"batches" json:"batches"`
+	EventStream              EventStreamConfig        `koanf:"event_stream" json:"event_stream"`
+	EvmRelay                 EvmRelayConfig           `koanf:"evm_relay" json:"evm_relay"`
+	Replay                   ReplayConfig             `koanf:"replay" json:"replay"`
 	CurrentNodeVersion       string                   `koanf:"current_node_version" json:"current_node_version"`
 	LastUsedVersion          string                   `koanf:"last_used_version" json:"last_used_version"`
 	ValidationParams         ValidationParamsCache    `koanf:"validation_params" json:"validation_params"`
@@ -38,9 +64,290 @@ type TxBatchingConfig struct {
 	FlushTimeoutSeconds             int  `koanf:"flush_timeout_seconds" json:"flush_timeout_seconds"`
 	ValidationV2FlushSize           int  `koanf:"validation_v2_flush_size" json:"validation_v2_flush_size"`
 	ValidationV2FlushTimeoutSeconds int  `koanf:"validation_v2_flush_timeout_seconds" json:"validation_v2_flush_timeout_seconds"`
+	ValidationFlushSize             int  `koanf:"validation_flush_size" json:"validation_flush_size"`
+	ValidationFlushTimeoutSeconds   int  `koanf:"validation_flush_timeout_seconds" json:"validation_flush_timeout_seconds"`
 	PocCommitIntervalSeconds        int  `koanf:"poc_commit_interval_seconds" json:"poc_commit_interval_seconds"`
 }
 
+// ValidationQueueConfig controls the pool of workers that re-run inferences for validation.
+// Zero values fall back to defaults, see ConfigManager.GetValidationQueueConfig.
+type ValidationQueueConfig struct {
+	Workers               int `koanf:"workers" json:"workers"`
+	MaxPerNode            int `koanf:"max_per_node" json:"max_per_node"`
+	MaxAttempts           int `koanf:"max_attempts" json:"max_attempts"`
+	InitialBackoffSeconds int `koanf:"initial_backoff_seconds" json:"initial_backoff_seconds"`
+	MaxBackoffSeconds     int `koanf:"max_backoff_seconds" json:"max_backoff_seconds"`
+}
+
+// PeerHealthConfig controls background probing of other participants' inference endpoints.
+// Zero values fall back to defaults, see ConfigManager.GetPeerHealthConfig.
+type PeerHealthConfig struct {
+	Disabled               bool `koanf:"disabled" json:"disabled"`
+	IntervalSeconds        int  `koanf:"interval_seconds" json:"interval_seconds"`
+	TimeoutSeconds         int  `koanf:"timeout_seconds" json:"timeout_seconds"`
+	UnhealthyAfterFailures int  `koanf:"unhealthy_after_failures" json:"unhealthy_after_failures"`
+	MaxConcurrency         int  `koanf:"max_concurrency" json:"max_concurrency"`
+}
+
+// PreflightConfig controls the dependency checks run at startup before event processing begins.
+// Zero values fall back to defaults, see ConfigManager.GetPreflightConfig.
+type PreflightConfig struct {
+	Disabled bool `koanf:"disabled" json:"disabled"`
+	// TimeoutSeconds bounds each individual check
+	TimeoutSeconds int `koanf:"timeout_seconds" json:"timeout_seconds"`
+	// ContinueOnFailure starts the API even when a critical check fails
+	ContinueOnFailure bool `koanf:"continue_on_failure" json:"continue_on_failure"`
+}
+
+// RoutingConfig controls how inference requests are spread across the ML nodes serving a model.
+// Zero values fall back to defaults, see ConfigManager.GetRoutingConfig.
+type RoutingConfig struct {
+	// Strategy is one of "least-loaded", "round-robin" or "latency-weighted"
+	Strategy string `koanf:"strategy" json:"strategy"`
+	// LatencyWindow is the number of recent requests per node the p95 latency is computed over
+	LatencyWindow  int                  `koanf:"latency_window" json:"latency_window"`
+	CircuitBreaker CircuitBreakerConfig `koanf:"circuit_breaker" json:"circuit_breaker"`
+}
+
+// CircuitBreakerConfig controls when a failing ML node is ejected from routing. A node is ejected when
+// ErrorRate of its last WindowSize requests failed (once it served MinRequests) or after
+// ConsecutiveTimeouts timeouts in a row. It is health-checked every EjectionSeconds while ejected and
+// re-admitted once healthy. Zero values fall back to defaults, see ConfigManager.GetRoutingConfig.
+type CircuitBreakerConfig struct {
+	Disabled            bool    `koanf:"disabled" json:"disabled"`
+	WindowSize          int     `koanf:"window_size" json:"window_size"`
+	MinRequests         int     `koanf:"min_requests" json:"min_requests"`
+	ErrorRate           float64 `koanf:"error_rate" json:"error_rate"`
+	ConsecutiveTimeouts int     `koanf:"consecutive_timeouts" json:"consecutive_timeouts"`
+	EjectionSeconds     int     `koanf:"ejection_seconds" json:"ejection_seconds"`
+}
+
+// ModelDownloadConfig controls how model pre-downloads are scheduled on the ML nodes.
+// Zero values fall back to defaults, see ConfigManager.GetModelDownloadConfig.
+type ModelDownloadConfig struct {
+	// MaxConcurrentPerNode is the number of models an ML node downloads at the same time
+	MaxConcurrentPerNode int `koanf:"max_concurrent_per_node" json:"max_concurrent_per_node"`
+	// MaxBandwidthMbps caps the total download bandwidth of each ML node, 0 means unlimited
+	MaxBandwidthMbps int `koanf:"max_bandwidth_mbps" json:"max_bandwidth_mbps"`
+}
+
+// RequesterBandwidthConfig controls how much of this Transfer Agent's bandwidth allotment
+// (BandwidthParamsCache, split across participants) a single requester may consume per block.
+// Zero values fall back to defaults, see ConfigManager.GetRequesterBandwidthConfig.
+type RequesterBandwidthConfig struct {
+	// Disabled accepts requests from any requester until the Transfer Agent's allotment is used up
+	Disabled bool `koanf:"disabled" json:"disabled"`
+	// SharePercent is the part of the allotment one requester may use, 1-100
+	SharePercent int `koanf:"share_percent" json:"share_percent"`
+}
+
+// HedgedRequestsConfig controls speculative retries of transfer requests. When the executor has not
+// responded within ThresholdMs, the request is also sent to a second executor and the first response
+// is served. Zero values fall back to defaults, see ConfigManager.GetHedgedRequestsConfig.
+type HedgedRequestsConfig struct {
+	Enabled     bool `koanf:"enabled" json:"enabled"`
+	ThresholdMs int  `koanf:"threshold_ms" json:"threshold_ms"`
+}
+
+// PrioritySchedulingConfig controls how the ML node slots are shared between priority classes. Batch
+// requests may hold at most BatchSharePercent of the MaxConcurrent slots of a node, and a realtime request
+// finding every node full preempts a batch request still waiting on a node unless PreemptionDisabled.
+// Zero values fall back to defaults, see ConfigManager.GetPrioritySchedulingConfig.
+type PrioritySchedulingConfig struct {
+	BatchSharePercent  int  `koanf:"batch_share_percent" json:"batch_share_percent"`
+	PreemptionDisabled bool `koanf:"preemption_disabled" json:"preemption_disabled"`
+}
+
+// BatchesConfig controls the batch inference API (/v1/files and /v1/batches). The items of a batch are
+// requested by the account of this API, which pays for them, in the batch priority class and only during
+// the inference phase. Zero values fall back to defaults, see ConfigManager.GetBatchesConfig.
+type BatchesConfig struct {
+	Enabled bool `koanf:"enabled" json:"enabled"`
+	// Dir holds the uploaded input files and the output files, "batches" next to the SQLite database by default
+	Dir string `koanf:"dir" json:"dir"`
+	// Concurrency is the number of batch items in flight at once, across all batches
+	Concurrency   int `koanf:"concurrency" json:"concurrency"`
+	MaxItems      int `koanf:"max_items" json:"max_items"`
+	MaxFileSizeMB int `koanf:"max_file_size_mb" json:"max_file_size_mb"`
+	// MaxAttempts bounds how many times an item is tried when the network has no capacity for it
+	MaxAttempts int `koanf:"max_attempts" json:"max_attempts"`
+}
+
+// EventStreamConfig controls the chain event firehose served at /ws/events to API key holders.
+// Zero values fall back to defaults, see ConfigManager.GetEventStreamConfig.
+type EventStreamConfig struct {
+	// BufferSize is how many recent events are kept for clients resuming from a cursor
+	BufferSize int `koanf:"buffer_size" json:"buffer_size"`
+}
+
+// EvmRelayConfig enables relaying the BLS group keys and threshold signatures to the Ethereum bridge
+// contract, paying gas from the relay account. The account key is read from the EVM_RELAY_PRIVATE_KEY
+// env var only. Zero values fall back to defaults, see ConfigManager.GetEvmRelayConfig.
+type EvmRelayConfig struct {
+	Enabled         bool   `koanf:"enabled" json:"enabled"`
+	RpcUrl          string `koanf:"rpc_url" json:"rpc_url"`
+	ChainId         int64  `koanf:"chain_id" json:"chain_id"`
+	ContractAddress string `koanf:"contract_address" json:"contract_address"`
+	// MaxFeePerGasGwei caps the fee per gas of the relay transactions, replacements included
+	MaxFeePerGasGwei int64 `koanf:"max_fee_per_gas_gwei" json:"max_fee_per_gas_gwei"`
+	// ReplaceAfterSeconds is how long a transaction may stay pending before it is resent with higher fees
+	ReplaceAfterSeconds int    `koanf:"replace_after_seconds" json:"replace_after_seconds"`
+	MaxReplacements     int    `koanf:"max_replacements" json:"max_replacements"`
+	PollIntervalSeconds int    `koanf:"poll_interval_seconds" json:"poll_interval_seconds"`
+	PrivateKey          string `koanf:"-" json:"-"`
+}
+
+// ReplayConfig runs the API against recorded chain node and ML node responses, for local development and
+// integration tests without a chain node or GPUs. Each server serves the fixtures of FixturesDir/<name>;
+// chain_node.url and the nodes are pointed at the servers. With Record set, requests without fixture
+// are proxied to the upstream of the server, when it has one, and recorded. Zero values fall back to
+// defaults, see ConfigManager.GetReplayConfig.
+type ReplayConfig struct {
+	Enabled     bool                 `koanf:"enabled" json:"enabled"`
+	FixturesDir string               `koanf:"fixtures_dir" json:"fixtures_dir"`
+	Record      bool                 `koanf:"record" json:"record"`
+	Servers     []ReplayServerConfig `koanf:"servers" json:"servers"`
+	// WebsocketIntervalSeconds spaces the recorded chain events pushed to the event listener
+	WebsocketIntervalSeconds int `koanf:"websocket_interval_seconds" json:"websocket_interval_seconds"`
+}
+
+type ReplayServerConfig struct {
+	Name          string `koanf:"name" json:"name"`
+	ListenAddress string `koanf:"listen_address" json:"listen_address"`
+	Upstream      string `koanf:"upstream" json:"upstream"`
+}
+
+// PocBatchSizingConfig controls the size of the nonce batches the ML nodes generate during PoC.
+// Batches are sized from the generation throughput measured per node to complete in about
+// TargetLatencySeconds. Zero values fall back to defaults, see ConfigManager.GetPocBatchSizingConfig.
+type PocBatchSizingConfig struct {
+	// Disabled keeps the fixed batch sizes of the ML node requests
+	Disabled             bool `koanf:"disabled" json:"disabled"`
+	TargetLatencySeconds int  `koanf:"target_latency_seconds" json:"target_latency_seconds"`
+	MinBatchSize         int  `koanf:"min_batch_size" json:"min_batch_size"`
+	MaxBatchSize         int  `koanf:"max_batch_size" json:"max_batch_size"`
+}
+
+// ApiKeysConfig controls API key checks on the public inference endpoints.
+// Keys and their limits are managed through the admin API and stored in the SQLite DB.
+type ApiKeysConfig struct {
+	// Required rejects inference requests without a valid API key; otherwise only requests with a key are limited
+	Required bool `koanf:"required" json:"required"`
+}
+
+// AuditLogConfig controls the opt-in local history of inference requests kept for debugging disputes.
+// Zero values fall back to defaults, see ConfigManager.GetAuditLogConfig.
+type AuditLogConfig struct {
+	Enabled bool `koanf:"enabled" json:"enabled"`
+	// RetentionDays is how long entries are kept before they are pruned
+	RetentionDays int `koanf:"retention_days" json:"retention_days"`
+}
+
+// BlockCacheConfig controls the local cache of historical Block and BlockResults RPC responses,
+// stored in the SQLite DB and keyed by height
+type BlockCacheConfig struct {
+	Enabled bool `koanf:"enabled" json:"enabled"`
+	// MaxSizeMB caps the cached responses; the least recently used heights are evicted beyond it
+	MaxSizeMB int `koanf:"max_size_mb" json:"max_size_mb"`
+}
+
+// IdempotencyConfig controls the in-memory cache of completed inference responses replayed to requests
+// retried with the same Idempotency-Key header. Zero values fall back to defaults, see ConfigManager.GetIdempotencyConfig.
+type IdempotencyConfig struct {
+	Disabled bool `koanf:"disabled" json:"disabled"`
+	// TtlSeconds is how long a completed response is replayed for
+	TtlSeconds int `koanf:"ttl_seconds" json:"ttl_seconds"`
+	// MaxEntries caps the cached responses; the oldest are evicted beyond it
+	MaxEntries int `koanf:"max_entries" json:"max_entries"`
+	// MaxResponseKB is the largest response that is cached, larger ones are executed again on retry
+	MaxResponseKB int `koanf:"max_response_kb" json:"max_response_kb"`
+}
+
+// ContentFilterConfig is the pipeline of filters inference requests pass through before they are
+// routed to an executor or run on an ML node. Filters run in order; the first rejecting one stops the request.
+type ContentFilterConfig struct {
+	Filters []ContentFilterSpec `koanf:"filters" json:"filters"`
+	// ExecutorRequests also filters requests routed here by other transfer agents. Their inferences are
+	// already started on chain, so a rejected one expires unfinished and counts against this participant.
+	ExecutorRequests bool `koanf:"executor_requests" json:"executor_requests"`
+}
+
+// ContentFilterSpec configures one filter of the pipeline
+type ContentFilterSpec struct {
+	// Type is a built-in filter (max_size, pattern, jailbreak) or one registered with contentfilter.Register
+	Type string `koanf:"type" json:"type"`
+	// Action on a match: reject (default) the request, or annotate it and let it through
+	Action string `koanf:"action" json:"action"`
+	// MaxBytes and MaxMessages limit the request body size and chat message count (max_size)
+	MaxBytes    int `koanf:"max_bytes" json:"max_bytes"`
+	MaxMessages int `koanf:"max_messages" json:"max_messages"`
+	// Patterns are the regular expressions matched against the prompt (pattern), or phrases added to the built-in ones (jailbreak)
+	Patterns []string `koanf:"patterns" json:"patterns"`
+	// Threshold is the number of distinct jailbreak phrases a prompt must contain to match (jailbreak)
+	Threshold int `koanf:"threshold" json:"threshold"`
+	// Options are passed as is to registered filters
+	Options map[string]string `koanf:"options" json:"options"`
+}
+
+// MetricsConfig controls the Prometheus metrics served by the admin server at /metrics.
+type MetricsConfig struct {
+	Disabled bool `koanf:"disabled" json:"disabled"`
+}
+
+// TracingConfig controls the export of inference request traces.
+// Without an endpoint trace IDs are still returned to clients but spans are not exported.
+type TracingConfig struct {
+	// Endpoint is the OTLP/HTTP collector URL, e.g. http://localhost:4318
+	Endpoint string `koanf:"endpoint" json:"endpoint"`
+	Insecure bool   `koanf:"insecure" json:"insecure"`
+}
+
+// UpgradeConfig controls how scheduled upgrades are prepared and the ML node version switch is verified.
+// Zero values fall back to defaults, see ConfigManager.GetUpgradeConfig.
+type UpgradeConfig struct {
+	// DownloadDir is where API binaries are pre-downloaded when not running under cosmovisor
+	DownloadDir string `koanf:"download_dir" json:"download_dir"`
+	// MinFreeDiskMB is the free disk space required next to the downloaded binary
+	MinFreeDiskMB int64 `koanf:"min_free_disk_mb" json:"min_free_disk_mb"`
+	// HealthCheckBlocks is how long after the ML node version switch the nodes are checked on the new version
+	HealthCheckBlocks int64 `koanf:"health_check_blocks" json:"health_check_blocks"`
+	// DisableRollback keeps the new ML node version even when no node is healthy on it
+	DisableRollback bool `koanf:"disable_rollback" json:"disable_rollback"`
+}
+
+// BackupConfig controls the periodic backup of the SQLite database holding the dynamic state (seeds,
+// nodes, heights) to S3-compatible storage or a directory, and its restore when the database is lost.
+// Zero values fall back to defaults, see ConfigManager.GetBackupConfig.
+type BackupConfig struct {
+	// Backend is "s3" or "dir", backups are disabled when empty
+	Backend         string `koanf:"backend" json:"backend"`
+	IntervalMinutes int    `koanf:"interval_minutes" json:"interval_minutes"`
+	// Retain is how many of the most recent backups are kept, older ones are deleted
+	Retain int `koanf:"retain" json:"retain"`
+	// RestoreOnStartup restores the latest backup when the SQLite database file is missing
+	RestoreOnStartup bool           `koanf:"restore_on_startup" json:"restore_on_startup"`
+	Dir              string         `koanf:"dir" json:"dir"`
+	S3               BackupS3Config `koanf:"s3" json:"s3"`
+}
+
+// BackupS3Config locates the bucket. Credentials come from the standard AWS environment.
+type BackupS3Config struct {
+	Bucket   string `koanf:"bucket" json:"bucket"`
+	Prefix   string `koanf:"prefix" json:"prefix"`
+	Region   string `koanf:"region" json:"region"`
+	Endpoint string `koanf:"endpoint" json:"endpoint"`
+}
+
+// OnboardingConfig controls the public flow that registers grantee accounts: the granter signs the authz
+// grants of the operational permissions and the grantee receives its credentials.
+// Zero values fall back to defaults, see ConfigManager.GetOnboardingConfig.
+type OnboardingConfig struct {
+	Enabled bool `koanf:"enabled" json:"enabled"`
+	// AutoApprove skips the admin approval of new registrations
+	AutoApprove         bool `koanf:"auto_approve" json:"auto_approve"`
+	GrantExpirationDays int  `koanf:"grant_expiration_days" json:"grant_expiration_days"`
+}
+
 type UpgradePlan struct {
 	Name        string            `koanf:"name" json:"name"`
 	Height      int64             `koanf:"height" json:"height"`
@@ -65,17 +372,38 @@ type ApiConfig struct {
 	AdminServerPort       int    `koanf:"admin_server_port" json:"admin_server_port"`
 	MlGrpcServerPort      int    `koanf:"ml_grpc_server_port" json:"ml_grpc_server_port"`
 	TestMode              bool   `koanf:"test_mode" json:"test_mode"`
+	// CertIssuerUrl enables automatic TLS certificates for the PublicUrl domain via proxy-ssl
+	CertIssuerUrl string `koanf:"cert_issuer_url" json:"cert_issuer_url"`
+	CertDir       string `koanf:"cert_dir" json:"cert_dir"`
+	// AdminGrpcServerPort serves the admin API over gRPC with reflection, 0 disables it
+	AdminGrpcServerPort int `koanf:"admin_grpc_server_port" json:"admin_grpc_server_port"`
 }
 
 type ChainNodeConfig struct {
-	Url              string `koanf:"url" json:"url"`
-	IsGenesis        bool   `koanf:"is_genesis" json:"is_genesis"`
-	SeedApiUrl       string `koanf:"seed_api_url" json:"seed_api_url"`
-	AccountPublicKey string `koanf:"account_public_key" json:"account_public_key"`
-	SignerKeyName    string `koanf:"signer_key_name" json:"signer_key_name"`
-	KeyringBackend   string `koanf:"keyring_backend" json:"keyring_backend"`
-	KeyringDir       string `koanf:"keyring_dir" json:"keyring_dir"`
-	KeyringPassword  string `json:"-"`
+	Url string `koanf:"url" json:"url"`
+	// FailoverUrls are further chain node RPC endpoints used while Url is unhealthy.
+	// A comma-separated list is accepted so the env var can hold several.
+	FailoverUrls     []string `koanf:"failover_urls" json:"failover_urls"`
+	IsGenesis        bool     `koanf:"is_genesis" json:"is_genesis"`
+	SeedApiUrl       string   `koanf:"seed_api_url" json:"seed_api_url"`
+	AccountPublicKey string   `koanf:"account_public_key" json:"account_public_key"`
+	SignerKeyName    string   `koanf:"signer_key_name" json:"signer_key_name"`
+	KeyringBackend   string   `koanf:"keyring_backend" json:"keyring_backend"`
+	KeyringDir       string   `koanf:"keyring_dir" json:"keyring_dir"`
+	KeyringPassword  string   `json:"-"`
+	// RemoteSigner, when its address is set, signs with the SignerKeyName key instead of the local keyring
+	RemoteSigner RemoteSignerConfig `koanf:"remote_signer" json:"remote_signer"`
+}
+
+// RemoteSignerConfig locates the gRPC remote signer holding the signer key, see cosmosclient.RemoteSigner.
+// TLS is used unless Insecure is set; CertFile and KeyFile enable mutual TLS.
+type RemoteSignerConfig struct {
+	Address        string `koanf:"address" json:"address"`
+	CaFile         string `koanf:"ca_file" json:"ca_file"`
+	CertFile       string `koanf:"cert_file" json:"cert_file"`
+	KeyFile        string `koanf:"key_file" json:"key_file"`
+	Insecure       bool   `koanf:"insecure" json:"insecure"`
+	TimeoutSeconds int    `koanf:"timeout_seconds" json:"timeout_seconds"` // per signature, 10 when zero
 }
 
 type MLNodeKeyConfig struct {
@@ -94,6 +422,20 @@ type InferenceNodeConfig struct {
 	Id               string                 `koanf:"id" json:"id"`
 	MaxConcurrent    int                    `koanf:"max_concurrent" json:"max_concurrent"`
 	Hardware         []Hardware             `koanf:"hardware" json:"hardware"`
+	// Transport of the ML node control API, NodeTransportHTTP (default) or NodeTransportGRPC.
+	// Inference requests always use HTTP.
+	Transport string `koanf:"transport" json:"transport,omitempty"`
+	GrpcPort  int    `koanf:"grpc_port" json:"grpc_port,omitempty"`
+}
+
+const (
+	NodeTransportHTTP = "http"
+	NodeTransportGRPC = "grpc"
+)
+
+// UsesGrpc reports whether the ML node control API of the node is reached over gRPC
+func (n InferenceNodeConfig) UsesGrpc() bool {
+	return n.Transport == NodeTransportGRPC
 }
 
 // ValidateInferenceNodeBasic validates basic fields of an InferenceNodeConfig without checking for duplicates.
@@ -111,14 +453,27 @@ func ValidateInferenceNodeBasic(node InferenceNodeConfig) []string {
 		errors = append(errors, "host is required and cannot be empty")
 	}
 
-	if node.InferencePort <= 0 || node.InferencePort > 65535 {
+	// SRV hosts take their ports from DNS; configured ports are only a fallback and may be omitted
+	portOptional := nodeaddr.IsSrv(node.Host)
+
+	if !(portOptional && node.InferencePort == 0) && (node.InferencePort <= 0 || node.InferencePort > 65535) {
 		errors = append(errors, fmt.Sprintf("inference_port must be between 1 and 65535, got %d", node.InferencePort))
 	}
 
-	if node.PoCPort <= 0 || node.PoCPort > 65535 {
+	if !(portOptional && node.PoCPort == 0) && (node.PoCPort <= 0 || node.PoCPort > 65535) {
 		errors = append(errors, fmt.Sprintf("poc_port must be between 1 and 65535, got %d", node.PoCPort))
 	}
 
+	switch node.Transport {
+	case "", NodeTransportHTTP:
+	case NodeTransportGRPC:
+		if !(portOptional && node.GrpcPort == 0) && (node.GrpcPort <= 0 || node.GrpcPort > 65535) {
+			errors = append(errors, fmt.Sprintf("grpc_port must be between 1 and 65535, got %d", node.GrpcPort))
+		}
+	default:
+		errors = append(errors, fmt.Sprintf("transport must be %q or %q, got %q", NodeTransportHTTP, NodeTransportGRPC, node.Transport))
+	}
+
 	if node.MaxConcurrent <= 0 {
 		errors = append(errors, fmt.Sprintf("max_concurrent must be greater than 0, got %d", node.MaxConcurrent))
 	}
@@ -127,6 +482,21 @@ func ValidateInferenceNodeBasic(node InferenceNodeConfig) []string {
 		errors = append(errors, "at least one model must be specified")
 	}
 
+	for _, modelId := range slices.Sorted(maps.Keys(node.Models)) {
+		for i, arg := range node.Models[modelId].ArgOverrides {
+			if !strings.HasPrefix(arg, "--") {
+				if i == 0 {
+					errors = append(errors, fmt.Sprintf("arg_overrides of model %s must start with an arg, got %q", modelId, arg))
+				}
+				continue
+			}
+			if !slices.Contains(HardwareTunableModelArgs, arg) {
+				errors = append(errors, fmt.Sprintf("arg_overrides of model %s: %s is not tunable per node, allowed: %s",
+					modelId, arg, strings.Join(HardwareTunableModelArgs, ", ")))
+			}
+		}
+	}
+
 	return errors
 }
 
@@ -141,6 +511,10 @@ func (n InferenceNodeConfig) DeepCopy() InferenceNodeConfig {
 				modelCopy.Args = make([]string, len(v.Args))
 				copy(modelCopy.Args, v.Args)
 			}
+			if v.ArgOverrides != nil {
+				modelCopy.ArgOverrides = make([]string, len(v.ArgOverrides))
+				copy(modelCopy.ArgOverrides, v.ArgOverrides)
+			}
 			result.Models[k] = modelCopy
 		}
 	}
@@ -155,6 +529,23 @@ func (n InferenceNodeConfig) DeepCopy() InferenceNodeConfig {
 
 type ModelConfig struct {
 	Args []string `json:"args"`
+	// Embedding marks the model as served for /v1/embeddings (e.g. vLLM started with --task embed)
+	Embedding bool `json:"embedding"`
+	// Multimodal marks the model as served with image inputs (image_url message content parts)
+	Multimodal bool `json:"multimodal,omitempty"`
+	// ArgOverrides replace the launch args templated from the node's hardware profile. Only the
+	// HardwareTunableModelArgs are accepted, and only when governance does not pin them for the model.
+	ArgOverrides []string `json:"arg_overrides,omitempty"`
+}
+
+// HardwareTunableModelArgs are the launch args the API templates per node hardware profile and that
+// node config may override. Everything else comes from governance or the node's own Args.
+var HardwareTunableModelArgs = []string{
+	"--tensor-parallel-size",
+	"--pipeline-parallel-size",
+	"--kv-cache-dtype",
+	"--gpu-memory-utilization",
+	"--max-num-seqs",
 }
 
 type Hardware struct {
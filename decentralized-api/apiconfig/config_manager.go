@@ -33,6 +33,7 @@ type ConfigManager struct {
 	mutex          sync.RWMutex
 	configDumpPath string
 	sqlitePath     string
+	chainEndpoints ChainEndpoints
 }
 
 type WriteCloserProvider interface {
@@ -43,6 +44,16 @@ func LoadDefaultConfigManager() (*ConfigManager, error) {
 	return LoadConfigManagerWithPaths(getConfigPath(), getSqlitePath(), os.Getenv("NODE_CONFIG_PATH"))
 }
 
+// LoadStaticConfigManager reads only the config file, without opening the SQLite database. It serves
+// startup steps that must run before the database is opened, such as restoring it from a backup.
+func LoadStaticConfigManager() (*ConfigManager, error) {
+	manager := &ConfigManager{KoanProvider: file.Provider(getConfigPath()), sqlitePath: getSqlitePath()}
+	if err := manager.Load(); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
+
 // LoadConfigManagerWithPaths allows tests to supply explicit paths.
 func LoadConfigManagerWithPaths(configPath, sqlitePath, nodeConfigPath string) (*ConfigManager, error) {
 	defaultDbCfg := SqliteConfig{
@@ -156,12 +167,283 @@ func (cm *ConfigManager) GetTxBatchingConfig() TxBatchingConfig {
 	if cfg.ValidationV2FlushTimeoutSeconds == 0 {
 		cfg.ValidationV2FlushTimeoutSeconds = 30
 	}
+	if cfg.ValidationFlushSize == 0 {
+		cfg.ValidationFlushSize = 20
+	}
+	if cfg.ValidationFlushTimeoutSeconds == 0 {
+		// Roughly one block, so validations still land in the next block under low load
+		cfg.ValidationFlushTimeoutSeconds = 5
+	}
 	if cfg.PocCommitIntervalSeconds == 0 {
 		cfg.PocCommitIntervalSeconds = 5
 	}
 	return cfg
 }
 
+func (cm *ConfigManager) GetValidationQueueConfig() ValidationQueueConfig {
+	cfg := cm.currentConfig.ValidationQueue
+	if cfg.Workers == 0 {
+		cfg.Workers = 8
+	}
+	if cfg.MaxPerNode == 0 {
+		cfg.MaxPerNode = 2
+	}
+	if cfg.MaxAttempts == 0 {
+		cfg.MaxAttempts = 5
+	}
+	if cfg.InitialBackoffSeconds == 0 {
+		cfg.InitialBackoffSeconds = 60
+	}
+	if cfg.MaxBackoffSeconds == 0 {
+		cfg.MaxBackoffSeconds = 600
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetPeerHealthConfig() PeerHealthConfig {
+	cfg := cm.currentConfig.PeerHealth
+	if cfg.IntervalSeconds == 0 {
+		cfg.IntervalSeconds = 60
+	}
+	if cfg.TimeoutSeconds == 0 {
+		cfg.TimeoutSeconds = 5
+	}
+	if cfg.UnhealthyAfterFailures == 0 {
+		cfg.UnhealthyAfterFailures = 3
+	}
+	if cfg.MaxConcurrency == 0 {
+		cfg.MaxConcurrency = 16
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetPreflightConfig() PreflightConfig {
+	cfg := cm.currentConfig.Preflight
+	if cfg.TimeoutSeconds == 0 {
+		cfg.TimeoutSeconds = 10
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetUpgradeConfig() UpgradeConfig {
+	cfg := cm.currentConfig.Upgrade
+	if cfg.DownloadDir == "" {
+		cfg.DownloadDir = "../data/upgrades"
+	}
+	if cfg.MinFreeDiskMB == 0 {
+		cfg.MinFreeDiskMB = 1024
+	}
+	if cfg.HealthCheckBlocks == 0 {
+		cfg.HealthCheckBlocks = 10
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetBackupConfig() BackupConfig {
+	cfg := cm.currentConfig.Backup
+	if cfg.IntervalMinutes == 0 {
+		cfg.IntervalMinutes = 60
+	}
+	if cfg.Retain == 0 {
+		cfg.Retain = 24
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = "../data/backups"
+	}
+	if cfg.S3.Prefix == "" {
+		cfg.S3.Prefix = "dapi-backups"
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetOnboardingConfig() OnboardingConfig {
+	cfg := cm.currentConfig.Onboarding
+	if cfg.GrantExpirationDays == 0 {
+		cfg.GrantExpirationDays = 365
+	}
+	return cfg
+}
+
+// GetSqlitePath returns the path of the SQLite database holding the dynamic state
+func (cm *ConfigManager) GetSqlitePath() string {
+	return cm.sqlitePath
+}
+
+func (cm *ConfigManager) GetRoutingConfig() RoutingConfig {
+	cfg := cm.currentConfig.Routing
+	if cfg.Strategy == "" {
+		cfg.Strategy = "least-loaded"
+	}
+	// window sizes must be positive, the windows index their samples modulo the size
+	if cfg.LatencyWindow <= 0 {
+		cfg.LatencyWindow = 100
+	}
+	if cfg.CircuitBreaker.WindowSize <= 0 {
+		cfg.CircuitBreaker.WindowSize = 20
+	}
+	if cfg.CircuitBreaker.MinRequests == 0 {
+		cfg.CircuitBreaker.MinRequests = 10
+	}
+	if cfg.CircuitBreaker.ErrorRate == 0 {
+		cfg.CircuitBreaker.ErrorRate = 0.5
+	}
+	if cfg.CircuitBreaker.ConsecutiveTimeouts == 0 {
+		cfg.CircuitBreaker.ConsecutiveTimeouts = 3
+	}
+	if cfg.CircuitBreaker.EjectionSeconds == 0 {
+		cfg.CircuitBreaker.EjectionSeconds = 30
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetModelDownloadConfig() ModelDownloadConfig {
+	cfg := cm.currentConfig.ModelDownload
+	if cfg.MaxConcurrentPerNode == 0 {
+		cfg.MaxConcurrentPerNode = 1
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetPocBatchSizingConfig() PocBatchSizingConfig {
+	cfg := cm.currentConfig.PocBatchSizing
+	if cfg.TargetLatencySeconds == 0 {
+		cfg.TargetLatencySeconds = 10
+	}
+	if cfg.MinBatchSize == 0 {
+		cfg.MinBatchSize = 10
+	}
+	if cfg.MaxBatchSize == 0 {
+		cfg.MaxBatchSize = 5000
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetRequesterBandwidthConfig() RequesterBandwidthConfig {
+	cfg := cm.currentConfig.RequesterBandwidth
+	if cfg.SharePercent <= 0 || cfg.SharePercent > 100 {
+		cfg.SharePercent = 25
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetHedgedRequestsConfig() HedgedRequestsConfig {
+	cfg := cm.currentConfig.HedgedRequests
+	if cfg.ThresholdMs <= 0 {
+		cfg.ThresholdMs = 3000
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetPrioritySchedulingConfig() PrioritySchedulingConfig {
+	cfg := cm.currentConfig.PriorityScheduling
+	if cfg.BatchSharePercent <= 0 || cfg.BatchSharePercent > 100 {
+		cfg.BatchSharePercent = 50
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetBatchesConfig() BatchesConfig {
+	cfg := cm.currentConfig.Batches
+	if cfg.Dir == "" {
+		cfg.Dir = filepath.Join(filepath.Dir(cm.sqlitePath), "batches")
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.MaxItems <= 0 {
+		cfg.MaxItems = 10000
+	}
+	if cfg.MaxFileSizeMB <= 0 {
+		cfg.MaxFileSizeMB = 100
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = 5
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetEventStreamConfig() EventStreamConfig {
+	cfg := cm.currentConfig.EventStream
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = 10000
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetEvmRelayConfig() EvmRelayConfig {
+	cfg := cm.currentConfig.EvmRelay
+	if cfg.MaxFeePerGasGwei <= 0 {
+		cfg.MaxFeePerGasGwei = 200
+	}
+	if cfg.ReplaceAfterSeconds <= 0 {
+		cfg.ReplaceAfterSeconds = 180
+	}
+	if cfg.MaxReplacements <= 0 {
+		cfg.MaxReplacements = 5
+	}
+	if cfg.PollIntervalSeconds <= 0 {
+		cfg.PollIntervalSeconds = 6
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetReplayConfig() ReplayConfig {
+	cfg := cm.currentConfig.Replay
+	if cfg.FixturesDir == "" {
+		cfg.FixturesDir = "replay-fixtures"
+	}
+	if cfg.WebsocketIntervalSeconds <= 0 {
+		cfg.WebsocketIntervalSeconds = 5
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetBlockCacheConfig() BlockCacheConfig {
+	cfg := cm.currentConfig.BlockCache
+	if cfg.MaxSizeMB == 0 {
+		cfg.MaxSizeMB = 256
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetApiKeysConfig() ApiKeysConfig {
+	return cm.currentConfig.ApiKeys
+}
+
+func (cm *ConfigManager) GetAuditLogConfig() AuditLogConfig {
+	cfg := cm.currentConfig.AuditLog
+	if cfg.RetentionDays == 0 {
+		cfg.RetentionDays = 7
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetIdempotencyConfig() IdempotencyConfig {
+	cfg := cm.currentConfig.Idempotency
+	if cfg.TtlSeconds == 0 {
+		cfg.TtlSeconds = 600
+	}
+	if cfg.MaxEntries == 0 {
+		cfg.MaxEntries = 10000
+	}
+	if cfg.MaxResponseKB == 0 {
+		cfg.MaxResponseKB = 1024
+	}
+	return cfg
+}
+
+func (cm *ConfigManager) GetContentFilterConfig() ContentFilterConfig {
+	return cm.currentConfig.ContentFilter
+}
+
+func (cm *ConfigManager) GetMetricsConfig() MetricsConfig {
+	return cm.currentConfig.Metrics
+}
+
+func (cm *ConfigManager) GetTracingConfig() TracingConfig {
+	return cm.currentConfig.Tracing
+}
+
 func (cm *ConfigManager) GetNodes() []InferenceNodeConfig {
 	nodes := make([]InferenceNodeConfig, len(cm.currentConfig.Nodes))
 	copy(nodes, cm.currentConfig.Nodes)
@@ -399,6 +681,10 @@ func (cm *ConfigManager) SetNodes(nodes []InferenceNodeConfig) error {
 	return nil
 }
 
+func (cm *ConfigManager) GetMLNodeKeyConfig() MLNodeKeyConfig {
+	return cm.currentConfig.MLNodeKeyConfig
+}
+
 func (cm *ConfigManager) CreateWorkerKey() (string, error) {
 	workerKey := ed25519.GenPrivKey()
 	workerPublicKey := workerKey.PubKey()
@@ -489,6 +775,11 @@ func readConfig(provider koanf.Provider) (Config, error) {
 		log.Printf("Warning: KEYRING_PASSWORD environment variable not set - keyring operations may fail")
 	}
 
+	if evmRelayKey, found := os.LookupEnv("EVM_RELAY_PRIVATE_KEY"); found {
+		config.EvmRelay.PrivateKey = evmRelayKey
+		log.Printf("Loaded EVM_RELAY_PRIVATE_KEY")
+	}
+
 	return config, nil
 }
 
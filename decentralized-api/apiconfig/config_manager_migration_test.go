@@ -273,3 +273,47 @@ chain_node:
 	require.NoError(t, err)
 	require.Equal(t, stat1.ModTime(), stat2.ModTime())
 }
+
+// Databases created before the transport columns existed get them added by EnsureSchema
+func TestEnsureSchema_AddsTransportColumnsToExistingNodesTable(t *testing.T) {
+	ctx := context.Background()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ExecContext(ctx, `
+CREATE TABLE inference_nodes (
+  id TEXT PRIMARY KEY,
+  host TEXT NOT NULL,
+  inference_segment TEXT NOT NULL,
+  inference_port INTEGER NOT NULL,
+  poc_segment TEXT NOT NULL,
+  poc_port INTEGER NOT NULL,
+  max_concurrent INTEGER NOT NULL,
+  models_json TEXT NOT NULL,
+  hardware_json TEXT NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+INSERT INTO inference_nodes (id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json)
+VALUES ('old', 'localhost', '', 5000, '', 8080, 1, '{}', '[]');`)
+	require.NoError(t, err)
+
+	require.NoError(t, apiconfig.EnsureSchema(ctx, db))
+	require.NoError(t, apiconfig.EnsureSchema(ctx, db))
+
+	grpcNode := apiconfig.InferenceNodeConfig{
+		Id: "grpc", Host: "localhost", InferencePort: 5000, PoCPort: 8080, MaxConcurrent: 1,
+		Transport: apiconfig.NodeTransportGRPC, GrpcPort: 9100,
+	}
+	require.NoError(t, apiconfig.WriteNodes(ctx, db, []apiconfig.InferenceNodeConfig{grpcNode}))
+
+	nodes, err := apiconfig.ReadNodes(ctx, db)
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+	require.Equal(t, "grpc", nodes[0].Id)
+	require.True(t, nodes[0].UsesGrpc())
+	require.Equal(t, 9100, nodes[0].GrpcPort)
+	require.Equal(t, "old", nodes[1].Id)
+	require.False(t, nodes[1].UsesGrpc())
+}
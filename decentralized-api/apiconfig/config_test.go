@@ -29,6 +29,35 @@ func TestConfigLoad(t *testing.T) {
 	require.Equal(t, "/root/.inference", testManager.GetChainNodeConfig().KeyringDir)
 }
 
+func TestRoutingConfigDefaults(t *testing.T) {
+	testManager := &apiconfig.ConfigManager{
+		KoanProvider: rawbytes.Provider([]byte(testYaml + `
+routing:
+  latency_window: -5
+  circuit_breaker:
+    window_size: -1
+`)),
+	}
+	require.NoError(t, testManager.Load())
+	cfg := testManager.GetRoutingConfig()
+	require.Equal(t, 100, cfg.LatencyWindow)
+	require.Equal(t, 20, cfg.CircuitBreaker.WindowSize)
+}
+
+func TestChainNodeEndpoints(t *testing.T) {
+	config := apiconfig.ChainNodeConfig{
+		Url:          "http://node1:26657",
+		FailoverUrls: []string{"http://node2:26657, http://node3:26657", "http://node1:26657", ""},
+	}
+	require.Equal(t, []string{"http://node1:26657", "http://node2:26657", "http://node3:26657"}, config.Endpoints())
+
+	testManager := &apiconfig.ConfigManager{
+		KoanProvider: rawbytes.Provider([]byte(testYaml)),
+	}
+	require.NoError(t, testManager.Load())
+	require.Equal(t, "http://join1-node:26657", testManager.GetChainEndpoints().Current())
+}
+
 func TestNodeVersion(t *testing.T) {
 	testManager := &apiconfig.ConfigManager{
 		KoanProvider:   rawbytes.Provider([]byte(testYaml)),
@@ -83,6 +83,8 @@ CREATE TABLE IF NOT EXISTS inference_nodes (
   max_concurrent INTEGER NOT NULL,
   models_json TEXT NOT NULL,
   hardware_json TEXT NOT NULL,
+  transport TEXT NOT NULL DEFAULT '',
+  grpc_port INTEGER NOT NULL DEFAULT 0,
   updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now')),
   created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
 );
@@ -103,8 +105,168 @@ CREATE TABLE IF NOT EXISTS seed_info (
   claimed BOOLEAN NOT NULL DEFAULT 0,
   is_active BOOLEAN NOT NULL DEFAULT 1,
   created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
-);`
-	_, err := db.ExecContext(ctx, stmt)
+);
+
+CREATE TABLE IF NOT EXISTS validation_queue (
+  inference_id TEXT PRIMARY KEY,
+  revalidation BOOLEAN NOT NULL DEFAULT 0,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at INTEGER NOT NULL, -- unix seconds
+  last_error TEXT NOT NULL DEFAULT '',
+  dead_letter BOOLEAN NOT NULL DEFAULT 0,
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS node_runtime_state (
+  node_id TEXT PRIMARY KEY,
+  state_json TEXT NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS node_maintenance_windows (
+  id TEXT PRIMARY KEY,
+  node_id TEXT NOT NULL,
+  schedule TEXT NOT NULL, -- 5-field cron expression, UTC
+  duration_seconds INTEGER NOT NULL,
+  drain_lead_seconds INTEGER NOT NULL,
+  created_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS rpc_block_cache (
+  kind TEXT NOT NULL, -- 'block' or 'block_results'
+  height INTEGER NOT NULL,
+  data BLOB NOT NULL, -- amino JSON of the RPC response
+  accessed_at INTEGER NOT NULL, -- unix milliseconds, for LRU eviction
+  PRIMARY KEY (kind, height)
+);
+CREATE INDEX IF NOT EXISTS rpc_block_cache_accessed_at ON rpc_block_cache (accessed_at);
+
+CREATE TABLE IF NOT EXISTS bls_dkg_state (
+  epoch_id INTEGER PRIMARY KEY,
+  state_blob BLOB NOT NULL,
+  updated_at DATETIME NOT NULL DEFAULT (STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+);
+
+CREATE TABLE IF NOT EXISTS api_keys (
+  id TEXT PRIMARY KEY,
+  name TEXT NOT NULL,
+  key_hash TEXT NOT NULL UNIQUE, -- sha256 of the key, the key itself is never stored
+  max_qps INTEGER NOT NULL DEFAULT 0, -- 0 = unlimited
+  max_tokens_per_minute INTEGER NOT NULL DEFAULT 0, -- 0 = unlimited
+  disabled BOOLEAN NOT NULL DEFAULT 0,
+  created_at INTEGER NOT NULL -- unix seconds
+);
+
+CREATE TABLE IF NOT EXISTS onboarding_registrations (
+  id TEXT PRIMARY KEY,
+  token_hash TEXT NOT NULL, -- sha256 of the client's token, the token itself is never stored
+  granter_address TEXT NOT NULL,
+  grantee_address TEXT NOT NULL,
+  grantee_pub_key TEXT NOT NULL, -- base64 secp256k1
+  key_generated BOOLEAN NOT NULL DEFAULT 0,
+  status TEXT NOT NULL,
+  tx_hash TEXT NOT NULL DEFAULT '',
+  error TEXT NOT NULL DEFAULT '',
+  api_key_id TEXT NOT NULL DEFAULT '',
+  created_at INTEGER NOT NULL, -- unix seconds
+  updated_at INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS inference_audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  inference_id TEXT NOT NULL,
+  role TEXT NOT NULL, -- 'transfer' or 'executor'
+  endpoint TEXT NOT NULL,
+  model TEXT NOT NULL,
+  requester_address TEXT NOT NULL,
+  transfer_address TEXT NOT NULL,
+  executor_address TEXT NOT NULL,
+  executor_node TEXT NOT NULL,
+  prompt_hash TEXT NOT NULL,
+  response_hash TEXT NOT NULL,
+  prompt_tokens INTEGER NOT NULL,
+  completion_tokens INTEGER NOT NULL,
+  status_code INTEGER NOT NULL,
+  error TEXT NOT NULL,
+  started_at INTEGER NOT NULL, -- unix milliseconds
+  duration_ms INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS inference_audit_log_inference_id ON inference_audit_log (inference_id);
+CREATE INDEX IF NOT EXISTS inference_audit_log_started_at ON inference_audit_log (started_at);
+
+CREATE TABLE IF NOT EXISTS batch_files (
+  id TEXT PRIMARY KEY,
+  api_key_id TEXT NOT NULL, -- owner, the content is stored in the batches directory
+  purpose TEXT NOT NULL, -- 'batch' (input) or 'batch_output'
+  filename TEXT NOT NULL,
+  created_at INTEGER NOT NULL -- unix seconds
+);
+
+CREATE TABLE IF NOT EXISTS batches (
+  id TEXT PRIMARY KEY,
+  api_key_id TEXT NOT NULL,
+  endpoint TEXT NOT NULL,
+  input_file_id TEXT NOT NULL,
+  output_file_id TEXT NOT NULL,
+  completion_window TEXT NOT NULL,
+  status TEXT NOT NULL,
+  metadata TEXT NOT NULL DEFAULT '{}', -- JSON object
+  created_at INTEGER NOT NULL, -- unix seconds
+  expires_at INTEGER NOT NULL,
+  finished_at INTEGER NOT NULL DEFAULT 0 -- completed, expired or cancelled
+);
+
+CREATE TABLE IF NOT EXISTS batch_items (
+  batch_id TEXT NOT NULL,
+  idx INTEGER NOT NULL, -- line of the input file
+  custom_id TEXT NOT NULL,
+  body BLOB NOT NULL,
+  status TEXT NOT NULL, -- 'pending', 'running', 'completed' or 'failed'
+  inference_id TEXT NOT NULL DEFAULT '',
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_at INTEGER NOT NULL DEFAULT 0, -- unix seconds
+  PRIMARY KEY (batch_id, idx)
+);
+CREATE INDEX IF NOT EXISTS batch_items_status ON batch_items (status, next_attempt_at);`
+	if _, err := db.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+
+	// Columns added after the initial schema; CREATE TABLE IF NOT EXISTS leaves existing tables as they are
+	if err := ensureColumn(ctx, db, "inference_nodes", "transport", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	return ensureColumn(ctx, db, "inference_nodes", "grpc_port", "INTEGER NOT NULL DEFAULT 0")
+}
+
+// ensureColumn adds the column to the table unless it is already there
+func ensureColumn(ctx context.Context, db *sql.DB, table, column, definition string) error {
+	rows, err := db.QueryContext(ctx, fmt.Sprintf("PRAGMA table_info(%s)", table))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			cid        int
+			name       string
+			colType    string
+			notNull    bool
+			defaultVal sql.NullString
+			primaryKey int
+		)
+		if err := rows.Scan(&cid, &name, &colType, &notNull, &defaultVal, &primaryKey); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	_, err = db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
 	return err
 }
 
@@ -121,8 +283,8 @@ func UpsertInferenceNodes(ctx context.Context, db *sql.DB, nodes []InferenceNode
 
 	q := `
 INSERT INTO inference_nodes (
-  id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+  id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json, transport, grpc_port
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT(id) DO UPDATE SET
   host = excluded.host,
   inference_segment = excluded.inference_segment,
@@ -132,6 +294,8 @@ ON CONFLICT(id) DO UPDATE SET
   max_concurrent = excluded.max_concurrent,
   models_json = excluded.models_json,
   hardware_json = excluded.hardware_json,
+  transport = excluded.transport,
+  grpc_port = excluded.grpc_port,
   updated_at = (STRFTIME('%Y-%m-%d %H:%M:%f','now'))`
 
 	stmt, err := tx.PrepareContext(ctx, q)
@@ -160,6 +324,8 @@ ON CONFLICT(id) DO UPDATE SET
 			n.MaxConcurrent,
 			string(modelsJSON),
 			string(hardwareJSON),
+			n.Transport,
+			n.GrpcPort,
 		); err != nil {
 			return err
 		}
@@ -175,7 +341,7 @@ func WriteNodes(ctx context.Context, db *sql.DB, nodes []InferenceNodeConfig) er
 // ReadNodes reads all nodes from the database and reconstructs InferenceNodeConfig entries.
 func ReadNodes(ctx context.Context, db *sql.DB) ([]InferenceNodeConfig, error) {
 	rows, err := db.QueryContext(ctx, `
-SELECT id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json
+SELECT id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json, transport, grpc_port
 FROM inference_nodes ORDER BY id`)
 	if err != nil {
 		return nil, err
@@ -194,8 +360,10 @@ FROM inference_nodes ORDER BY id`)
 			maxConc     int
 			modelsRaw   []byte
 			hardwareRaw []byte
+			transport   string
+			grpcPort    int
 		)
-		if err := rows.Scan(&id, &host, &infSeg, &infPort, &pocSeg, &pocPort, &maxConc, &modelsRaw, &hardwareRaw); err != nil {
+		if err := rows.Scan(&id, &host, &infSeg, &infPort, &pocSeg, &pocPort, &maxConc, &modelsRaw, &hardwareRaw, &transport, &grpcPort); err != nil {
 			return nil, err
 		}
 		var models map[string]ModelConfig
@@ -220,6 +388,8 @@ FROM inference_nodes ORDER BY id`)
 			Id:               id,
 			MaxConcurrent:    maxConc,
 			Hardware:         hardware,
+			Transport:        transport,
+			GrpcPort:         grpcPort,
 		})
 	}
 	if err := rows.Err(); err != nil {
@@ -246,8 +416,8 @@ func ReplaceInferenceNodes(ctx context.Context, db *sql.DB, nodes []InferenceNod
 
 	q := `
 INSERT INTO inference_nodes (
-  id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`
+  id, host, inference_segment, inference_port, poc_segment, poc_port, max_concurrent, models_json, hardware_json, transport, grpc_port
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	stmt, err := tx.PrepareContext(ctx, q)
 	if err != nil {
@@ -275,6 +445,8 @@ INSERT INTO inference_nodes (
 			n.MaxConcurrent,
 			string(modelsJSON),
 			string(hardwareJSON),
+			n.Transport,
+			n.GrpcPort,
 		); err != nil {
 			return err
 		}
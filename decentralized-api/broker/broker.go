@@ -5,6 +5,7 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/nodeaddr"
 	"decentralized-api/logging"
 	"decentralized-api/mlnodeclient"
 	"decentralized-api/participant"
@@ -45,12 +46,12 @@ type BrokerChainBridge interface {
 }
 
 type BrokerChainBridgeImpl struct {
-	client       cosmosclient.CosmosMessageClient
-	chainNodeUrl string
+	client         cosmosclient.CosmosMessageClient
+	chainEndpoints apiconfig.ChainEndpoints
 }
 
-func NewBrokerChainBridgeImpl(client cosmosclient.CosmosMessageClient, chainNodeUrl string) BrokerChainBridge {
-	return &BrokerChainBridgeImpl{client: client, chainNodeUrl: chainNodeUrl}
+func NewBrokerChainBridgeImpl(client cosmosclient.CosmosMessageClient, chainEndpoints apiconfig.ChainEndpoints) BrokerChainBridge {
+	return &BrokerChainBridgeImpl{client: client, chainEndpoints: chainEndpoints}
 }
 
 func (b *BrokerChainBridgeImpl) GetHardwareNodes() (*types.QueryHardwareNodesResponse, error) {
@@ -67,7 +68,7 @@ func (b *BrokerChainBridgeImpl) SubmitHardwareDiff(diff *types.MsgSubmitHardware
 }
 
 func (b *BrokerChainBridgeImpl) GetBlockHash(height int64) (string, error) {
-	client, err := cosmosclient.NewRpcClient(b.chainNodeUrl)
+	client, err := cosmosclient.NewRpcClient(b.chainEndpoints.Current())
 	if err != nil {
 		return "", err
 	}
@@ -123,6 +124,14 @@ type Broker struct {
 	lastEpochPhase       types.EpochPhase
 	statusQueryTrigger   chan statusQuerySignal
 	configManager        *apiconfig.ConfigManager
+	router               *nodeRouter
+	stateStore           nodeStateStore
+	restoredStates       map[string]persistedNodeState // saved by the previous run, until the first synced reconcile
+	persistTrigger       chan struct{}
+	maintenanceWindows   map[string]MaintenanceWindow // by window id
+	maintenanceStore     *sqlMaintenanceWindowStore
+	pocThroughput        *pocThroughputTracker
+	batchLocks           map[string][]*batchLock // by node id, batch-class requests holding a slot, oldest first
 }
 
 // GetParticipantAddress returns the current participant's address if available.
@@ -186,7 +195,12 @@ func GetPoCCallbackBaseURLV1(callbackUrl string) string {
 }
 
 type ModelArgs struct {
-	Args []string `json:"args"`
+	Args      []string `json:"args"`
+	Embedding bool     `json:"embedding"`
+	// Multimodal marks the model as accepting image inputs in chat requests
+	Multimodal bool `json:"multimodal,omitempty"`
+	// ArgOverrides replace the args templated from the node's hardware profile, see hardwareModelArgs
+	ArgOverrides []string `json:"arg_overrides,omitempty"`
 }
 
 type Node struct {
@@ -200,28 +214,37 @@ type Node struct {
 	MaxConcurrent    int                  `json:"max_concurrent"`
 	NodeNum          uint64               `json:"node_num"`
 	Hardware         []apiconfig.Hardware `json:"hardware"`
+	Transport        string               `json:"transport,omitempty"`
+	GrpcPort         int                  `json:"grpc_port,omitempty"`
 }
 
 func (n *Node) InferenceUrl() string {
-	return fmt.Sprintf("http://%s:%d%s", n.Host, n.InferencePort, n.InferenceSegment)
+	return n.InferenceUrlWithVersion("")
 }
 
 func (n *Node) InferenceUrlWithVersion(version string) string {
-	if version == "" {
-		return n.InferenceUrl()
-	}
-	return fmt.Sprintf("http://%s:%d/%s%s", n.Host, n.InferencePort, version, n.InferenceSegment)
+	return nodeaddr.Default.URL(n.Host, nodeaddr.ServiceInference, n.InferencePort, version, n.InferenceSegment)
 }
 
 func (n *Node) PoCUrl() string {
-	return fmt.Sprintf("http://%s:%d%s", n.Host, n.PoCPort, n.PoCSegment)
+	return n.PoCUrlWithVersion("")
 }
 
 func (n *Node) PoCUrlWithVersion(version string) string {
-	if version == "" {
-		return n.PoCUrl()
+	return nodeaddr.Default.URL(n.Host, nodeaddr.ServicePoC, n.PoCPort, version, n.PoCSegment)
+}
+
+// GrpcAddress is the host:port of the ML node gRPC server, used when Transport is apiconfig.NodeTransportGRPC
+func (n *Node) GrpcAddress() string {
+	return nodeaddr.Default.Address(n.Host, nodeaddr.ServiceGrpc, n.GrpcPort)
+}
+
+// NewClient creates the ML node client for the node's configured transport
+func (n *Node) NewClient(factory mlnodeclient.ClientFactory, version string) mlnodeclient.MLNodeClient {
+	if n.Transport == apiconfig.NodeTransportGRPC {
+		return factory.CreateGrpcClient(n.GrpcAddress(), version, n.InferenceUrlWithVersion(version))
 	}
-	return fmt.Sprintf("http://%s:%d/%s%s", n.Host, n.PoCPort, version, n.PoCSegment)
+	return factory.CreateClient(n.PoCUrlWithVersion(version), n.InferenceUrlWithVersion(version))
 }
 
 type NodeWithState struct {
@@ -246,11 +269,29 @@ type NodeState struct {
 
 	TrainingTask *TrainingTaskPayload `json:"training_task,omitempty"`
 
-	LockCount       int        `json:"lock_count"`
+	LockCount       int        `json:"lock_count"` // in-flight requests
+	P95LatencyMs    int64      `json:"p95_latency_ms"`
 	FailureReason   string     `json:"failure_reason"`
 	StatusTimestamp time.Time  `json:"status_timestamp"`
 	AdminState      AdminState `json:"admin_state"`
 
+	DrainStatus    DrainStatus `json:"drain_status,omitempty"`
+	drained        chan struct{}
+	drainCancelled chan struct{}
+
+	// RedeployPending is set when the node configuration changed while it serves inference;
+	// the next reconciliation restarts inference on the node with the new configuration
+	RedeployPending bool `json:"redeploy_pending,omitempty"`
+
+	// Maintenance is set while the node is in one of its maintenance windows
+	Maintenance *MaintenanceStatus `json:"maintenance,omitempty"`
+
+	// Circuit is set while the node's circuit breaker keeps it out of routing
+	Circuit *CircuitBreakerStatus `json:"circuit_breaker,omitempty"`
+
+	// PocAbortedStage is the start height of the PoC stage an operator aborted on the node
+	PocAbortedStage int64 `json:"poc_aborted_stage,omitempty"`
+
 	// Epoch-specific data, populated from the chain
 	EpochModels  map[string]types.Model      `json:"epoch_models"`
 	EpochMLNodes map[string]types.MLNodeInfo `json:"epoch_ml_nodes"`
@@ -269,11 +310,17 @@ func (s NodeState) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// needsRedeploy reports whether inference has to be restarted on the node for a configuration change
+func (s *NodeState) needsRedeploy() bool {
+	return s.RedeployPending && s.IntendedStatus == types.HardwareNodeStatus_INFERENCE
+}
+
 type TrainingTaskPayload struct {
 	Id             uint64         `json:"id"`
 	MasterNodeAddr string         `json:"master_node_addr"`
 	NodeRanks      map[string]int `json:"node_ranks"`
 	WorldSize      int            `json:"world_size"`
+	ResumeFrom     string         `json:"resume_from,omitempty"`
 }
 
 type ReconcileInfo struct {
@@ -362,6 +409,11 @@ func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhas
 		reconcileTrigger:     make(chan struct{}, 1),
 		statusQueryTrigger:   make(chan statusQuerySignal, 1),
 		configManager:        configManager,
+		router:               newNodeRouter(),
+		persistTrigger:       make(chan struct{}, 1),
+		maintenanceWindows:   make(map[string]MaintenanceWindow),
+		pocThroughput:        newPocThroughputTracker(),
+		batchLocks:           make(map[string][]*batchLock),
 	}
 
 	// Initialize NodeWorkGroup
@@ -373,6 +425,8 @@ func NewBroker(chainBridge BrokerChainBridge, phaseTracker *chainphase.ChainPhas
 	// go nodeReconciliationWorker(broker)
 	go nodeStatusQueryWorker(broker)
 	go broker.reconcilerLoop()
+	go broker.maintenanceLoop()
+	go broker.circuitBreakerLoop()
 	return broker
 }
 
@@ -462,6 +516,12 @@ func (b *Broker) executeCommand(command Command) {
 		command.Execute(b)
 	case SetNodeAdminStateCommand:
 		command.Execute(b)
+	case DrainNodeCommand:
+		command.Execute(b)
+	case UndrainNodeCommand:
+		command.Execute(b)
+	case AbortPoCCommand:
+		command.Execute(b)
 	case UpdateNodeHardwareCommand:
 		command.Execute(b)
 	case InferenceUpAllCommand:
@@ -475,6 +535,7 @@ func (b *Broker) executeCommand(command Command) {
 	default:
 		logging.Error("Unregistered command type", types.Nodes, "type", reflect.TypeOf(command).String())
 	}
+	b.triggerStatePersistence()
 }
 
 type InvalidCommandError struct {
@@ -490,7 +551,7 @@ func (b *Broker) QueueMessage(command Command) error {
 	}
 
 	switch command.(type) {
-	case StartPocCommand, InitValidateCommand, InferenceUpAllCommand, UpdateNodeResultCommand, SetNodesActualStatusCommand, SetNodeAdminStateCommand, RegisterNode, RemoveNode, StartTrainingCommand, LockNodesForTrainingCommand, SyncNodesCommand:
+	case StartPocCommand, InitValidateCommand, InferenceUpAllCommand, UpdateNodeResultCommand, SetNodesActualStatusCommand, SetNodeAdminStateCommand, DrainNodeCommand, UndrainNodeCommand, AbortPoCCommand, RegisterNode, RemoveNode, StartTrainingCommand, LockNodesForTrainingCommand, SyncNodesCommand:
 		b.highPriorityCommands <- command
 	default:
 		b.lowPriorityCommands <- command
@@ -500,30 +561,44 @@ func (b *Broker) QueueMessage(command Command) error {
 
 func (b *Broker) NewNodeClient(node *Node) mlnodeclient.MLNodeClient {
 	version := b.configManager.GetCurrentNodeVersion()
-	return b.mlNodeClientFactory.CreateClient(node.PoCUrlWithVersion(version), node.InferenceUrlWithVersion(version))
+	return node.NewClient(b.mlNodeClientFactory, version)
 }
 
 func (b *Broker) lockAvailableNode(command LockAvailableNode) {
-	leastBusyNode := b.getLeastBusyNode(command)
+	selectedNode, preempted := b.selectAvailableNode(command)
 
-	if leastBusyNode != nil {
-		b.mu.RLock()
-		leastBusyNode.State.LockCount++
-		b.mu.RUnlock()
+	if selectedNode != nil {
+		b.mu.Lock()
+		// A preempted batch request keeps its slot until it is released, the node is briefly over MaxConcurrent
+		selectedNode.State.LockCount++
+		if preempted != nil {
+			b.removeBatchLock(selectedNode.Node.Id, preempted)
+		}
+		if command.BatchLock != nil {
+			b.addBatchLock(selectedNode.Node.Id, command.BatchLock)
+		}
+		b.mu.Unlock()
 	}
-	logging.Debug("Locked node", types.Nodes, "node", leastBusyNode)
-	if leastBusyNode == nil {
+	if preempted != nil {
+		logging.Info("Preempting batch request for a realtime request", types.Nodes,
+			"node_id", selectedNode.Node.Id, "preempted", preempted.preempt())
+	}
+	logging.Debug("Locked node", types.Nodes, "node", selectedNode)
+	if selectedNode == nil {
 		command.Response <- nil
 	} else {
-		command.Response <- &leastBusyNode.Node
+		command.Response <- &selectedNode.Node
 	}
 }
 
-func (b *Broker) getLeastBusyNode(command LockAvailableNode) *NodeWithState {
+// selectAvailableNode picks one of the available nodes serving the model with the configured routing strategy.
+// Batch requests only get a node below its batch share. When every node is full, a realtime request gets a
+// node serving batch requests and the most recent of them to preempt.
+func (b *Broker) selectAvailableNode(command LockAvailableNode) (*NodeWithState, *batchLock) {
 	epochState := b.phaseTracker.GetCurrentEpochState()
 	if epochState.IsNilOrNotSynced() {
-		logging.Error("getLeastBusyNode. Cannot get least busy node, epoch state is empty", types.Nodes)
-		return nil
+		logging.Error("selectAvailableNode. Cannot select node, epoch state is empty", types.Nodes)
+		return nil, nil
 	}
 	b.mu.RLock()
 	defer b.mu.RUnlock()
@@ -536,23 +611,62 @@ func (b *Broker) getLeastBusyNode(command LockAvailableNode) *NodeWithState {
 		}
 	}
 
-	var leastBusyNode *NodeWithState = nil
+	var candidates, preemptible []*NodeWithState
 	for _, node := range b.nodes {
 		if _, shouldSkip := skip[node.Node.Id]; shouldSkip {
 			logging.Info("Node skipped by LockAvailableNode skip list", types.Nodes, "node_id", node.Node.Id)
 			continue
 		}
 		// TODO: log some kind of a reason as to why the node is not available
-		if available, reason := b.nodeAvailable(node, command.Model, epochState.LatestEpoch.EpochIndex, epochState.CurrentPhase); available {
-			if leastBusyNode == nil || node.State.LockCount < leastBusyNode.State.LockCount {
-				leastBusyNode = node
+		available, reason := b.nodeAvailable(node, command.Model, epochState.LatestEpoch.EpochIndex, epochState.CurrentPhase)
+		if available {
+			available, reason = b.nodeHasCapacity(node, command.BatchLock != nil)
+			if !available && command.BatchLock == nil && len(b.batchLocks[node.Node.Id]) > 0 {
+				preemptible = append(preemptible, node)
 			}
+		}
+		if available {
+			candidates = append(candidates, node)
 		} else {
 			logging.Info("Node not available", types.Nodes, "node_id", node.Node.Id, "reason", reason)
 		}
 	}
 
-	return leastBusyNode
+	if len(candidates) == 0 && len(preemptible) > 0 && !b.prioritySchedulingConfig().PreemptionDisabled {
+		if node := b.router.pick(b.routingStrategy(), command.Model, preemptible); node != nil {
+			locks := b.batchLocks[node.Node.Id]
+			return node, locks[len(locks)-1]
+		}
+	}
+	return b.router.pick(b.routingStrategy(), command.Model, candidates), nil
+}
+
+// nodeHasCapacity checks the node has a free slot, within the batch share of its slots for batch requests
+func (b *Broker) nodeHasCapacity(node *NodeWithState, batch bool) (bool, NodeNotAvailableReason) {
+	if node.State.LockCount >= node.Node.MaxConcurrent {
+		return false, fmt.Sprintf("Node is locked too many times: lockCount=%d, maxConcurrent=%d", node.State.LockCount, node.Node.MaxConcurrent)
+	}
+	if batch {
+		if held, slots := len(b.batchLocks[node.Node.Id]), b.batchSlots(node); held >= slots {
+			return false, fmt.Sprintf("Node serves too many batch requests: batchLocks=%d, batchSlots=%d", held, slots)
+		}
+	}
+	logging.Info("nodeAvailable. Node is not locked too many times", types.Nodes, "nodeId", node.Node.Id, "lockCount", node.State.LockCount, "maxConcurrent", node.Node.MaxConcurrent)
+	return true, ""
+}
+
+func (b *Broker) routingStrategy() RoutingStrategy {
+	if b.configManager == nil {
+		return RoutingLeastLoaded
+	}
+	return parseRoutingStrategy(b.configManager.GetRoutingConfig().Strategy)
+}
+
+func (b *Broker) latencyWindowSize() int {
+	if b.configManager == nil {
+		return 100
+	}
+	return b.configManager.GetRoutingConfig().LatencyWindow
 }
 
 type NodeNotAvailableReason = string
@@ -573,10 +687,13 @@ func (b *Broker) nodeAvailable(node *NodeWithState, neededModel string, currentE
 	}
 	logging.Info("nodeAvailable. Node is not being reconciled, ReconcileInfo == nil", types.Nodes, "nodeId", node.Node.Id)
 
-	if node.State.LockCount >= node.Node.MaxConcurrent {
-		return false, fmt.Sprintf("Node is locked too many times: lockCount=%d, maxConcurrent=%d", node.State.LockCount, node.Node.MaxConcurrent)
+	if node.State.DrainStatus != DrainStatusNone {
+		return false, fmt.Sprintf("Node is drained for maintenance: %s", node.State.DrainStatus)
+	}
+
+	if node.State.Circuit != nil {
+		return false, fmt.Sprintf("Node is ejected by its circuit breaker: %s", node.State.Circuit.Reason)
 	}
-	logging.Info("nodeAvailable. Node is not locked too many times", types.Nodes, "nodeId", node.Node.Id, "lockCount", node.State.LockCount, "maxConcurrent", node.Node.MaxConcurrent)
 
 	// Check admin state using provided epoch and phase
 	if !node.State.ShouldBeOperational(currentEpoch, currentPhase) {
@@ -603,10 +720,23 @@ func (b *Broker) releaseNode(command ReleaseNode) {
 		command.Response <- false
 		return
 	} else {
-		b.mu.RLock()
+		b.mu.Lock()
 		node.State.LockCount--
-		b.mu.RUnlock()
-		if !command.Outcome.IsSuccess() {
+		if command.BatchLock != nil {
+			b.removeBatchLock(command.NodeId, command.BatchLock)
+		}
+		node.State.finishDrainIfIdle(command.NodeId)
+		b.mu.Unlock()
+		if command.Outcome.IsSuccess() && command.Latency > 0 {
+			p95 := b.router.recordLatency(command.NodeId, command.Latency, b.latencyWindowSize())
+			b.mu.Lock()
+			node.State.P95LatencyMs = p95.Milliseconds()
+			b.mu.Unlock()
+		}
+		b.updateCircuitBreaker(command.NodeId, node, command.Outcome)
+		if _, cancelled := command.Outcome.(InferenceCancelled); cancelled {
+			logging.Info("Inference cancelled by client", types.Nodes, "node_id", command.NodeId)
+		} else if !command.Outcome.IsSuccess() {
 			logging.Error("Node failed", types.Nodes, "node_id", command.NodeId, "reason", command.Outcome.GetMessage())
 			// FIXME: need a write lock here?
 			//  not sure if we should update the state, we have health checks for that
@@ -619,6 +749,8 @@ func (b *Broker) releaseNode(command ReleaseNode) {
 
 var ErrNoNodesAvailable = errors.New("no nodes available for inference")
 
+var ErrNodeNotFound = errors.New("node not found")
+
 func LockNode[T any](
 	b *Broker,
 	model string,
@@ -639,10 +771,12 @@ func LockNode[T any](
 		return zero, ErrNoNodesAvailable
 	}
 
+	lockedAt := time.Now()
 	defer func() {
 		queueError := b.QueueMessage(ReleaseNode{
 			NodeId:   node.Id,
 			Outcome:  InferenceSuccess{},
+			Latency:  time.Since(lockedAt),
 			Response: make(chan bool, 2),
 		})
 
@@ -996,6 +1130,7 @@ func (b *Broker) reconcileIfSynced(triggerMsg string) {
 	}
 
 	b.enforceSingleModelOnAllNodes()
+	b.applyRestoredStates(epochPhaseInfo.LatestEpoch.EpochIndex)
 
 	logging.Info(triggerMsg, types.Nodes, "blockHeight", epochPhaseInfo.CurrentBlock.Height)
 	b.reconcile(*epochPhaseInfo)
@@ -1106,8 +1241,9 @@ func (b *Broker) reconcile(epochState chainphase.EpochState) {
 			continue
 		}
 
-		// Condition: The primary or PoC intended state does not match the current state.
-		if node.State.IntendedStatus != node.State.CurrentStatus || node.State.PocIntendedStatus != node.State.PocCurrentStatus {
+		// Condition: The primary or PoC intended state does not match the current state,
+		// or inference has to be restarted with a changed configuration.
+		if node.State.IntendedStatus != node.State.CurrentStatus || node.State.PocIntendedStatus != node.State.PocCurrentStatus || node.State.needsRedeploy() {
 			nodeCopy := *node
 			nodesToDispatch[id] = &nodeCopy
 		}
@@ -1121,7 +1257,7 @@ func (b *Broker) reconcile(epochState chainphase.EpochState) {
 		b.mu.Lock()
 		currentNode, ok := b.nodes[id]
 		if !ok ||
-			(currentNode.State.IntendedStatus == currentNode.State.CurrentStatus && (currentNode.State.CurrentStatus != types.HardwareNodeStatus_POC || currentNode.State.PocIntendedStatus == currentNode.State.PocCurrentStatus)) ||
+			(currentNode.State.IntendedStatus == currentNode.State.CurrentStatus && (currentNode.State.CurrentStatus != types.HardwareNodeStatus_POC || currentNode.State.PocIntendedStatus == currentNode.State.PocCurrentStatus) && !currentNode.State.needsRedeploy()) ||
 			currentNode.State.ReconcileInfo != nil {
 			b.mu.Unlock()
 			continue
@@ -1135,6 +1271,7 @@ func (b *Broker) reconcile(epochState chainphase.EpochState) {
 			PocStatus: pocIntendedStatusCopy,
 		}
 		currentNode.State.cancelInFlightTask = cancel
+		currentNode.State.RedeployPending = false
 
 		worker, exists := b.nodeWorkGroup.GetWorker(id)
 		b.mu.Unlock()
@@ -1154,7 +1291,7 @@ func (b *Broker) reconcile(epochState chainphase.EpochState) {
 		// TODO: we should make reindexing as some indexes might be skipped
 		totalNumNodes := b.curMaxNodesNum.Load() + 1
 		// Create and dispatch the command
-		cmd := b.getCommandForState(&node.State, currentPoCParams, pocParamsErr, int(totalNumNodes), epochState.ActiveConfirmationPoCEvent)
+		cmd := b.getCommandForState(node.Node.Id, &node.State, currentPoCParams, pocParamsErr, int(totalNumNodes), epochState.ActiveConfirmationPoCEvent)
 		if cmd != nil {
 			logging.Info("Dispatching reconciliation command", types.Nodes,
 				"node_id", id, "target_status", node.State.IntendedStatus, "target_poc_status", node.State.PocIntendedStatus, "blockHeight", blockHeight)
@@ -1230,10 +1367,10 @@ func (b *Broker) enrichWithPocParams(params *pocParams) {
 	}
 }
 
-func (b *Broker) getCommandForState(nodeState *NodeState, pocGenParams *pocParams, pocGenErr error, totalNodes int, confirmationEvent *types.ConfirmationPoCEvent) NodeWorkerCommand {
+func (b *Broker) getCommandForState(nodeId string, nodeState *NodeState, pocGenParams *pocParams, pocGenErr error, totalNodes int, confirmationEvent *types.ConfirmationPoCEvent) NodeWorkerCommand {
 	switch nodeState.IntendedStatus {
 	case types.HardwareNodeStatus_INFERENCE:
-		return InferenceUpNodeCommand{}
+		return InferenceUpNodeCommand{Redeploy: nodeState.needsRedeploy()}
 	case types.HardwareNodeStatus_POC:
 		switch nodeState.PocIntendedStatus {
 		case PocStatusGenerating:
@@ -1248,6 +1385,7 @@ func (b *Broker) getCommandForState(nodeState *NodeState, pocGenParams *pocParam
 						TotalNodes:  totalNodes,
 						Model:       pocGenParams.modelId,
 						SeqLen:      pocGenParams.seqLen,
+						BatchSize:   b.pocBatchSize(nodeId),
 					}
 				}
 				return StartPoCNodeCommandV1{
@@ -1257,6 +1395,7 @@ func (b *Broker) getCommandForState(nodeState *NodeState, pocGenParams *pocParam
 					CallbackUrl: GetPoCCallbackBaseURLV1(b.callbackUrl),
 					TotalNodes:  totalNodes,
 					ModelParams: nil, // V1 uses chain-stored model params
+					BatchSize:   b.pocBatchSize(nodeId),
 				}
 			}
 			logging.Error("Cannot create StartPoCNodeCommand: missing PoC parameters", types.Nodes, "error", pocGenErr)
@@ -1294,6 +1433,7 @@ func (b *Broker) getCommandForState(nodeState *NodeState, pocGenParams *pocParam
 			MasterNodeAddr: nodeState.TrainingTask.MasterNodeAddr,
 			NodeRanks:      nodeState.TrainingTask.NodeRanks,
 			WorldSize:      nodeState.TrainingTask.WorldSize,
+			ResumeFrom:     nodeState.TrainingTask.ResumeFrom,
 		}
 	default:
 		logging.Info("Reconciliation for state not yet implemented", types.Nodes,
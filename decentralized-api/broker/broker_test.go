@@ -1,12 +1,15 @@
 package broker
 
 import (
+	"context"
 	"decentralized-api/apiconfig"
 	"decentralized-api/chainphase"
 	"decentralized-api/mlnodeclient"
 	"decentralized-api/participant"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
@@ -386,7 +389,7 @@ func TestReleaseNode(t *testing.T) {
 	require.NotNil(t, runningNode)
 	require.Equal(t, node.Id, runningNode.Id)
 	release := make(chan bool, 2)
-	queueMessage(t, broker, ReleaseNode{node.Id, InferenceSuccess{}, release})
+	queueMessage(t, broker, ReleaseNode{NodeId: node.Id, Outcome: InferenceSuccess{}, Response: release})
 
 	b := <-release
 	require.True(t, b, "expected release response to be true")
@@ -394,6 +397,145 @@ func TestReleaseNode(t *testing.T) {
 	require.NotNil(t, <-availableNode, "expected node1, got nil")
 }
 
+func TestDoWithLockedNodeHTTPRetry_Cancelled(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 1,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	attempts := 0
+	_, err := DoWithLockedNodeHTTPRetry(broker, "model1", nil, 3, func(node *Node) (*http.Response, *ActionError) {
+		attempts++
+		return nil, NewCancelledActionError(context.Canceled)
+	})
+	var actionErr *ActionError
+	require.ErrorAs(t, err, &actionErr)
+	require.Equal(t, ActionErrorCancelled, actionErr.Kind)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, attempts, "cancelled actions must not be retried")
+
+	// The node is released and can serve the next request
+	availableNode := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+}
+
+func TestDoWithPriorityLockedNodeHTTPRetry_RealtimePreemptsBatch(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	locked := make(chan struct{})
+	batchErr := make(chan error, 1)
+	go func() {
+		_, err := DoWithPriorityLockedNodeHTTPRetry(context.Background(), broker, "model1", types.PriorityClassBatch, nil, 3,
+			func(ctx context.Context, node *Node) (*http.Response, *ActionError) {
+				close(locked)
+				<-ctx.Done()
+				return nil, NewTransportActionError(ctx.Err())
+			})
+		batchErr <- err
+	}()
+	<-locked
+
+	// Batch requests only get half of the slots of the node
+	availableNode := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode, BatchLock: newBatchLock(func(error) {})})
+	require.Nil(t, <-availableNode)
+
+	// Realtime requests take the free slot, then the one of the batch request
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+
+	select {
+	case err := <-batchErr:
+		// The preempted batch request is retried, but the node is full of realtime requests
+		require.ErrorIs(t, err, ErrNoNodesAvailable)
+		require.ErrorIs(t, err, errPreempted)
+	case <-time.After(5 * time.Second):
+		t.Fatal("batch request was not preempted")
+	}
+}
+
+func TestDrainNode(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	availableNode := make(chan *Node, 2)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+
+	// the in-flight request keeps the node draining
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	status, err := broker.DrainNode(ctx, node.Id)
+	require.NoError(t, err)
+	require.Equal(t, DrainStatusDraining, status)
+
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.Nil(t, <-availableNode, "draining node must not get new work")
+
+	release := make(chan bool, 2)
+	queueMessage(t, broker, ReleaseNode{NodeId: node.Id, Outcome: InferenceSuccess{}, Response: release})
+	require.True(t, <-release)
+
+	status, err = broker.DrainNode(context.Background(), node.Id)
+	require.NoError(t, err)
+	require.Equal(t, DrainStatusDrained, status)
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Equal(t, DrainStatusDrained, nodes[0].State.DrainStatus)
+
+	require.NoError(t, broker.UndrainNode(node.Id))
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+
+	// undraining while a drain waits for the in-flight request cancels it instead of reporting it drained
+	drainStatus := make(chan DrainStatus, 1)
+	go func() {
+		status, _ := broker.DrainNode(context.Background(), node.Id)
+		drainStatus <- status
+	}()
+	require.Eventually(t, func() bool {
+		nodes, err := broker.GetNodes()
+		return err == nil && nodes[0].State.DrainStatus == DrainStatusDraining
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, broker.UndrainNode(node.Id))
+	select {
+	case status := <-drainStatus:
+		require.Equal(t, DrainStatusCancelled, status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("drain was not cancelled")
+	}
+
+	_, err = broker.DrainNode(context.Background(), "unknown")
+	require.Error(t, err)
+}
+
 func TestRoundTripSegment(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping flaky test in short mode")
@@ -626,6 +768,53 @@ func TestUpdateNodeConfiguration(t *testing.T) {
 	assert.Equal(t, []string{"--foo", "bar"}, after.Node.Models["model1"].Args)
 }
 
+func TestUpdateNodeRedeploysInference(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 1,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	mockFactory := broker.mlNodeClientFactory.(*mlnodeclient.MockClientFactory)
+	mockClient := mockFactory.GetClientForNode("http://localhost:5000")
+	require.NotNil(t, mockClient)
+	inferenceUpCalls := mockClient.GetInferenceUpCalled()
+
+	updateNode := func(update apiconfig.InferenceNodeConfig) {
+		command := NewUpdateNodeCommand(update)
+		require.NoError(t, broker.QueueMessage(command))
+		out := <-command.Response
+		require.NoError(t, out.Error)
+	}
+
+	// MaxConcurrent takes effect without touching the ML node
+	node.MaxConcurrent = 4
+	updateNode(node)
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	assert.Equal(t, 4, nodes[0].Node.MaxConcurrent)
+	assert.False(t, nodes[0].State.RedeployPending)
+
+	// New model args restart inference right away, even though the model is already loaded
+	node.Models = map[string]apiconfig.ModelConfig{"model1": {Args: []string{"--max-model-len", "4096"}}}
+	updateNode(node)
+	require.Eventually(t, func() bool {
+		mockClient.Mu.Lock()
+		defer mockClient.Mu.Unlock()
+		return mockClient.InferenceUpCalled > inferenceUpCalls &&
+			slices.Equal([]string{"--max-model-len", "4096"}, mockClient.LastInferenceArgs)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	nodes, err = broker.GetNodes()
+	require.NoError(t, err)
+	assert.False(t, nodes[0].State.RedeployPending)
+}
+
 func TestValidateInferenceNode_FieldCorrectness(t *testing.T) {
 	broker := NewTestBroker()
 
@@ -0,0 +1,194 @@
+package broker
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"fmt"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// circuitProbeInterval is how often the broker looks for ejected nodes that are due for a health probe
+const circuitProbeInterval = 5 * time.Second
+
+type CircuitState string
+
+const (
+	// CircuitOpen ejects the node from routing until its next probe
+	CircuitOpen CircuitState = "open"
+	// CircuitHalfOpen is set while the node is being probed
+	CircuitHalfOpen CircuitState = "half_open"
+)
+
+// CircuitBreakerStatus is set on a node while its circuit breaker has ejected it from routing.
+// Nodes without a status are routed normally.
+type CircuitBreakerStatus struct {
+	State    CircuitState `json:"state"`
+	Reason   string       `json:"reason"`
+	OpenedAt time.Time    `json:"opened_at"`
+	// NextProbeAt is when the node is health-checked next, it is re-admitted once a probe succeeds
+	NextProbeAt  time.Time `json:"next_probe_at"`
+	FailedProbes int       `json:"failed_probes"`
+}
+
+// outcomeWindow keeps the outcomes of the most recent requests of a node for its circuit breaker
+type outcomeWindow struct {
+	failures            []bool
+	next                int
+	consecutiveTimeouts int
+}
+
+func (w *outcomeWindow) add(failed bool, size int) {
+	if len(w.failures) > size {
+		w.failures = nil
+		w.next = 0
+	}
+	if len(w.failures) < size {
+		w.failures = append(w.failures, failed)
+	} else {
+		w.failures[w.next] = failed
+		w.next = (w.next + 1) % size
+	}
+}
+
+func (w *outcomeWindow) errorRate() float64 {
+	if len(w.failures) == 0 {
+		return 0
+	}
+	failed := 0
+	for _, f := range w.failures {
+		if f {
+			failed++
+		}
+	}
+	return float64(failed) / float64(len(w.failures))
+}
+
+// isNodeFault reports whether an inference outcome counts against the node: client cancellations and
+// 4xx responses are the caller's doing
+func isNodeFault(outcome InferenceResult) bool {
+	failure, ok := outcome.(InferenceError)
+	if !ok {
+		return false
+	}
+	return failure.HttpStatus < 400 || failure.HttpStatus >= 500
+}
+
+// recordOutcome adds a released request of the node and returns why its circuit should open, "" to keep it closed
+func (r *nodeRouter) recordOutcome(nodeId string, outcome InferenceResult, cfg apiconfig.CircuitBreakerConfig) string {
+	if _, cancelled := outcome.(InferenceCancelled); cancelled {
+		return ""
+	}
+	window, ok := r.outcomes[nodeId]
+	if !ok {
+		window = &outcomeWindow{}
+		r.outcomes[nodeId] = window
+	}
+	failed := isNodeFault(outcome)
+	window.add(failed, cfg.WindowSize)
+	if failure, ok := outcome.(InferenceError); ok && failure.Timeout {
+		window.consecutiveTimeouts++
+	} else {
+		window.consecutiveTimeouts = 0
+	}
+
+	switch {
+	case window.consecutiveTimeouts >= cfg.ConsecutiveTimeouts:
+		return fmt.Sprintf("%d consecutive timeouts", window.consecutiveTimeouts)
+	case len(window.failures) >= cfg.MinRequests && window.errorRate() >= cfg.ErrorRate:
+		return fmt.Sprintf("error rate %.2f over the last %d requests", window.errorRate(), len(window.failures))
+	default:
+		return ""
+	}
+}
+
+func (r *nodeRouter) resetOutcomes(nodeId string) {
+	delete(r.outcomes, nodeId)
+}
+
+func (b *Broker) circuitBreakerConfig() apiconfig.CircuitBreakerConfig {
+	if b.configManager == nil {
+		return apiconfig.CircuitBreakerConfig{Disabled: true}
+	}
+	return b.configManager.GetRoutingConfig().CircuitBreaker
+}
+
+// updateCircuitBreaker records a released request of the node and ejects the node when its circuit trips.
+// Must be called from the command loop.
+func (b *Broker) updateCircuitBreaker(nodeId string, node *NodeWithState, outcome InferenceResult) {
+	cfg := b.circuitBreakerConfig()
+	if cfg.Disabled {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if node.State.Circuit != nil {
+		// requests finishing after the node was ejected say nothing new
+		return
+	}
+	reason := b.router.recordOutcome(nodeId, outcome, cfg)
+	if reason == "" {
+		return
+	}
+	b.router.resetOutcomes(nodeId)
+	now := time.Now()
+	node.State.Circuit = &CircuitBreakerStatus{
+		State:       CircuitOpen,
+		Reason:      reason,
+		OpenedAt:    now,
+		NextProbeAt: now.Add(time.Duration(cfg.EjectionSeconds) * time.Second),
+	}
+	logging.Warn("Circuit breaker opened, node ejected from routing", types.Nodes, "node_id", nodeId, "reason", reason)
+}
+
+func (b *Broker) circuitBreakerLoop() {
+	ticker := time.NewTicker(circuitProbeInterval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		b.probeEjectedNodes(now)
+	}
+}
+
+// probeEjectedNodes health-checks the ejected nodes due for a probe, re-admitting the healthy ones
+func (b *Broker) probeEjectedNodes(now time.Time) {
+	var due []*NodeWithState
+	b.mu.Lock()
+	for _, node := range b.nodes {
+		if node.State.Circuit != nil && node.State.Circuit.State == CircuitOpen && !now.Before(node.State.Circuit.NextProbeAt) {
+			node.State.Circuit.State = CircuitHalfOpen
+			due = append(due, node)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, node := range due {
+		b.mu.RLock()
+		nodeCopy := node.Node
+		b.mu.RUnlock()
+		ctx, cancel := context.WithTimeout(context.Background(), inferenceHealthRequestTimeout)
+		healthy, err := b.NewNodeClient(&nodeCopy).InferenceHealth(ctx)
+		cancel()
+		b.finishProbe(node, healthy && err == nil, err)
+	}
+}
+
+func (b *Broker) finishProbe(node *NodeWithState, healthy bool, probeErr error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if node.State.Circuit == nil {
+		return
+	}
+	if healthy {
+		logging.Info("Circuit breaker closed, node re-admitted to routing", types.Nodes, "node_id", node.Node.Id,
+			"ejected_for", time.Since(node.State.Circuit.OpenedAt).String())
+		node.State.Circuit = nil
+		return
+	}
+	node.State.Circuit.State = CircuitOpen
+	node.State.Circuit.FailedProbes++
+	node.State.Circuit.NextProbeAt = time.Now().Add(time.Duration(b.circuitBreakerConfig().EjectionSeconds) * time.Second)
+	logging.Info("Circuit breaker probe failed, node stays ejected", types.Nodes, "node_id", node.Node.Id,
+		"failed_probes", node.State.Circuit.FailedProbes, "error", probeErr)
+}
@@ -0,0 +1,93 @@
+package broker
+
+import (
+	"decentralized-api/apiconfig"
+	"decentralized-api/mlnodeclient"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testCircuitBreakerConfig() apiconfig.CircuitBreakerConfig {
+	return apiconfig.CircuitBreakerConfig{WindowSize: 4, MinRequests: 4, ErrorRate: 0.5, ConsecutiveTimeouts: 2, EjectionSeconds: 30}
+}
+
+func TestNodeRouter_RecordOutcome(t *testing.T) {
+	cfg := testCircuitBreakerConfig()
+	router := newNodeRouter()
+
+	// client errors and cancellations are not the node's fault
+	for i := 0; i < 4; i++ {
+		require.Empty(t, router.recordOutcome("a", InferenceError{Message: "bad request", HttpStatus: 400}, cfg))
+		require.Empty(t, router.recordOutcome("a", InferenceCancelled{}, cfg))
+	}
+
+	// 2 of the last 4 requests failed
+	require.Empty(t, router.recordOutcome("b", InferenceSuccess{}, cfg))
+	require.Empty(t, router.recordOutcome("b", InferenceError{Message: "http status 500", HttpStatus: 500}, cfg))
+	require.Empty(t, router.recordOutcome("b", InferenceSuccess{}, cfg))
+	require.Equal(t, "error rate 0.50 over the last 4 requests", router.recordOutcome("b", InferenceError{Message: "connection refused"}, cfg))
+
+	// timeouts in a row trip before the window is full
+	require.Empty(t, router.recordOutcome("c", InferenceError{Message: "timeout", Timeout: true}, cfg))
+	require.Equal(t, "2 consecutive timeouts", router.recordOutcome("c", InferenceError{Message: "timeout", Timeout: true}, cfg))
+}
+
+func TestCircuitBreakerEjectsAndReadmitsNode(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+	nodeState := func() *NodeState {
+		nodes, err := broker.GetNodes()
+		require.NoError(t, err)
+		return &nodes[0].State
+	}
+
+	availableNode := make(chan *Node, 2)
+	cfg := broker.circuitBreakerConfig()
+	for i := 0; i < cfg.ConsecutiveTimeouts; i++ {
+		queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+		require.NotNil(t, <-availableNode)
+		release := make(chan bool, 2)
+		queueMessage(t, broker, ReleaseNode{NodeId: node.Id, Outcome: InferenceError{Message: "timeout", Timeout: true}, Response: release})
+		require.True(t, <-release)
+	}
+	circuit := nodeState().Circuit
+	require.NotNil(t, circuit)
+	require.Equal(t, CircuitOpen, circuit.State)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.Nil(t, <-availableNode, "ejected node must not get new work")
+
+	mockFactory := broker.mlNodeClientFactory.(*mlnodeclient.MockClientFactory)
+	mockClient := mockFactory.GetClientForNode(fmt.Sprintf("http://%s:%d", node.Host, node.PoCPort))
+	require.NotNil(t, mockClient)
+
+	// not due for a probe yet
+	broker.probeEjectedNodes(time.Now())
+	require.Equal(t, 0, nodeState().Circuit.FailedProbes)
+
+	mockClient.Mu.Lock()
+	mockClient.InferenceIsHealthy = false
+	mockClient.Mu.Unlock()
+	broker.probeEjectedNodes(circuit.NextProbeAt)
+	circuit = nodeState().Circuit
+	require.Equal(t, CircuitOpen, circuit.State)
+	require.Equal(t, 1, circuit.FailedProbes)
+
+	mockClient.Mu.Lock()
+	mockClient.InferenceIsHealthy = true
+	mockClient.Mu.Unlock()
+	broker.probeEjectedNodes(circuit.NextProbeAt)
+	require.Nil(t, nodeState().Circuit)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+}
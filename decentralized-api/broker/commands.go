@@ -3,6 +3,8 @@ package broker
 import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/logging"
+	"slices"
+	"time"
 
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -15,6 +17,8 @@ type LockAvailableNode struct {
 	Model       string
 	Response    chan *Node
 	SkipNodeIDs []string
+	// BatchLock is set for batch-class requests, which realtime requests may preempt
+	BatchLock *batchLock
 }
 
 func (g LockAvailableNode) GetResponseChannelCapacity() int {
@@ -24,7 +28,10 @@ func (g LockAvailableNode) GetResponseChannelCapacity() int {
 type ReleaseNode struct {
 	NodeId   string
 	Outcome  InferenceResult
+	Latency  time.Duration // time the node was locked, feeds the latency-aware routing
 	Response chan bool
+	// BatchLock is the lock of a batch-class request, as passed to LockAvailableNode
+	BatchLock *batchLock
 }
 
 func (r ReleaseNode) GetResponseChannelCapacity() int {
@@ -61,7 +68,7 @@ func (c GetNodesCommand) Execute(b *Broker) {
 			for model, modelArgs := range nodeWithState.Node.Models {
 				newArgs := make([]string, len(modelArgs.Args))
 				copy(newArgs, modelArgs.Args)
-				nodeCopy.Models[model] = ModelArgs{Args: newArgs}
+				nodeCopy.Models[model] = ModelArgs{Args: newArgs, Embedding: modelArgs.Embedding, Multimodal: modelArgs.Multimodal, ArgOverrides: slices.Clone(modelArgs.ArgOverrides)}
 			}
 		}
 
@@ -76,6 +83,8 @@ func (c GetNodesCommand) Execute(b *Broker) {
 
 		// Nil out internal-only fields
 		stateCopy.cancelInFlightTask = nil
+		stateCopy.drained = nil
+		stateCopy.drainCancelled = nil
 
 		// Deep copy pointer fields
 		if nodeWithState.State.ReconcileInfo != nil {
@@ -83,6 +92,11 @@ func (c GetNodesCommand) Execute(b *Broker) {
 			stateCopy.ReconcileInfo = &reconcileInfoCopy
 		}
 
+		if nodeWithState.State.Circuit != nil {
+			circuitCopy := *nodeWithState.State.Circuit
+			stateCopy.Circuit = &circuitCopy
+		}
+
 		if nodeWithState.State.TrainingTask != nil {
 			trainingTaskCopy := *nodeWithState.State.TrainingTask // shallow copy of struct
 
@@ -114,6 +128,13 @@ type InferenceSuccess struct {
 
 type InferenceError struct {
 	Message string
+	// HttpStatus is the status the node answered with, 0 if there was no response
+	HttpStatus int
+	Timeout    bool
+}
+
+// InferenceCancelled is the outcome of an inference the client cancelled; it says nothing about the node
+type InferenceCancelled struct {
 }
 
 func (i InferenceSuccess) IsSuccess() bool {
@@ -132,6 +153,14 @@ func (i InferenceError) GetMessage() string {
 	return i.Message
 }
 
+func (i InferenceCancelled) IsSuccess() bool {
+	return false
+}
+
+func (i InferenceCancelled) GetMessage() string {
+	return "Cancelled"
+}
+
 type SyncNodesCommand struct {
 	Response chan bool
 }
@@ -1,11 +1,13 @@
 package broker
 
 import (
+	"context"
 	"decentralized-api/logging"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -18,6 +20,8 @@ const (
 	ActionErrorTransport ActionErrorKind = iota
 	// ActionErrorApplication indicates an application-level failure that should not be retried by default
 	ActionErrorApplication
+	// ActionErrorCancelled indicates the caller went away (e.g. the client disconnected); the node is not at fault
+	ActionErrorCancelled
 )
 
 func (k ActionErrorKind) String() string {
@@ -26,6 +30,8 @@ func (k ActionErrorKind) String() string {
 		return "transport"
 	case ActionErrorApplication:
 		return "application"
+	case ActionErrorCancelled:
+		return "cancelled"
 	default:
 		return "unknown"
 	}
@@ -60,6 +66,13 @@ func NewApplicationActionError(err error) *ActionError {
 	return &ActionError{Kind: ActionErrorApplication, Err: err}
 }
 
+func NewCancelledActionError(err error) *ActionError {
+	if err == nil {
+		err = errors.New("request cancelled")
+	}
+	return &ActionError{Kind: ActionErrorCancelled, Err: err}
+}
+
 func isTimeoutError(err error) bool {
 	var urlErr *url.Error
 	return errors.As(err, &urlErr) && urlErr.Timeout()
@@ -71,12 +84,32 @@ func isTimeoutError(err error) bool {
 // - HTTP 5xx responses trigger status re-check, node skip and retry.
 // - HTTP 4xx responses are returned as-is without retry.
 // - 2xx responses are returned.
+// - Cancelled actions are returned as-is: no retry, no re-check, and the node is not reported as failed.
 func DoWithLockedNodeHTTPRetry(
 	b *Broker,
 	model string,
 	skipNodeIDs []string,
 	maxAttempts int,
 	doPost func(node *Node) (*http.Response, *ActionError),
+) (*http.Response, error) {
+	return DoWithPriorityLockedNodeHTTPRetry(context.Background(), b, model, types.PriorityClassRealtime, skipNodeIDs, maxAttempts,
+		func(_ context.Context, node *Node) (*http.Response, *ActionError) {
+			return doPost(node)
+		})
+}
+
+// DoWithPriorityLockedNodeHTTPRetry is DoWithLockedNodeHTTPRetry for a request of the priority class.
+// doPost must send the request with the given context: a batch request is cancelled through it when a
+// realtime request preempts its node, and it is then retried on another lock (at most maxAttempts times)
+// without counting as a failed attempt.
+func DoWithPriorityLockedNodeHTTPRetry(
+	ctx context.Context,
+	b *Broker,
+	model string,
+	priorityClass string,
+	skipNodeIDs []string,
+	maxAttempts int,
+	doPost func(ctx context.Context, node *Node) (*http.Response, *ActionError),
 ) (*http.Response, error) {
 	var zero *http.Response
 	if maxAttempts <= 0 {
@@ -96,17 +129,27 @@ func DoWithLockedNodeHTTPRetry(
 
 	var lastErr error
 	attempts := 0
+	preemptions := 0
 
 	logging.Info("HTTP retry helper: starting inference request", types.Inferences,
 		"model", model,
+		"priority_class", priorityClass,
 		"max_attempts", maxAttempts,
 		"initial_skip_count", len(orderedSkip))
 
 	for attempts < maxAttempts {
 		attempts++
 
+		// Cancelled once the response body is closed, or on a failed attempt
+		attemptCtx, cancelAttempt := context.WithCancelCause(ctx)
+		var lock *batchLock
+		if priorityClass == types.PriorityClassBatch {
+			lock = newBatchLock(cancelAttempt)
+		}
+
 		nodeChan := make(chan *Node, 2)
-		if err := b.QueueMessage(LockAvailableNode{Model: model, Response: nodeChan, SkipNodeIDs: orderedSkip}); err != nil {
+		if err := b.QueueMessage(LockAvailableNode{Model: model, Response: nodeChan, SkipNodeIDs: orderedSkip, BatchLock: lock}); err != nil {
+			cancelAttempt(nil)
 			logging.Info("HTTP retry helper: failed to queue LockAvailableNode", types.Inferences,
 				"attempt", attempts,
 				"error", err)
@@ -114,6 +157,7 @@ func DoWithLockedNodeHTTPRetry(
 		}
 		node := <-nodeChan
 		if node == nil {
+			cancelAttempt(nil)
 			if lastErr != nil {
 				logging.Info("HTTP retry helper: no node available, returning last error", types.Inferences,
 					"attempt", attempts,
@@ -129,7 +173,35 @@ func DoWithLockedNodeHTTPRetry(
 			"attempt", attempts,
 			"node_id", node.Id)
 
-		resp, aerr := doPost(node)
+		requestStart := time.Now()
+		resp, aerr := doPost(attemptCtx, node)
+		latency := time.Since(requestStart)
+		if lock != nil {
+			lock.release()
+		}
+
+		if errors.Is(context.Cause(attemptCtx), errPreempted) && ctx.Err() == nil {
+			if resp != nil && resp.Body != nil {
+				_ = resp.Body.Close()
+			}
+			cancelAttempt(nil)
+			_ = b.QueueMessage(ReleaseNode{NodeId: node.Id, Outcome: InferenceCancelled{}, Latency: latency, Response: make(chan bool, 2), BatchLock: lock})
+			preemptions++
+			// Reported as missing capacity if no node is left for the batch request
+			lastErr = fmt.Errorf("node %s: batch request %w: %w", node.Id, errPreempted, ErrNoNodesAvailable)
+			if preemptions >= maxAttempts {
+				logging.Info("HTTP retry helper: batch request preempted too many times", types.Inferences,
+					"node_id", node.Id,
+					"preemptions", preemptions)
+				return zero, lastErr
+			}
+			logging.Info("HTTP retry helper: batch request preempted, retrying", types.Inferences,
+				"attempt", attempts,
+				"node_id", node.Id,
+				"preemptions", preemptions)
+			attempts--
+			continue
+		}
 
 		// Decide outcome and retry policy
 		retry := false
@@ -155,6 +227,14 @@ func DoWithLockedNodeHTTPRetry(
 						"recheck", triggerRecheck,
 						"error", aerr.Err)
 				}
+			} else if aerr.Kind == ActionErrorCancelled {
+				retry = false
+				triggerRecheck = false
+				lastErr = aerr
+				logging.Info("HTTP retry helper: request cancelled by caller (no retry)", types.Inferences,
+					"attempt", attempts,
+					"node_id", node.Id,
+					"error", aerr.Err)
 			} else {
 				// Application error: do not retry
 				retry = false
@@ -209,6 +289,8 @@ func DoWithLockedNodeHTTPRetry(
 		var outcome InferenceResult
 		if aerr == nil && resp != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
 			outcome = InferenceSuccess{}
+		} else if aerr != nil && aerr.Kind == ActionErrorCancelled {
+			outcome = InferenceCancelled{}
 		} else {
 			// Compose a concise message
 			msg := ""
@@ -219,9 +301,21 @@ func DoWithLockedNodeHTTPRetry(
 			} else {
 				msg = "unknown error"
 			}
-			outcome = InferenceError{Message: msg}
+			failure := InferenceError{Message: msg}
+			if aerr != nil {
+				failure.Timeout = aerr.Kind == ActionErrorTransport && isTimeoutError(aerr.Err)
+			} else if resp != nil {
+				failure.HttpStatus = resp.StatusCode
+			}
+			outcome = failure
+		}
+		_ = b.QueueMessage(ReleaseNode{NodeId: node.Id, Outcome: outcome, Latency: latency, Response: make(chan bool, 2), BatchLock: lock})
+
+		if resp != nil && resp.Body != nil && !retry {
+			resp.Body = cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancelAttempt}
+		} else {
+			cancelAttempt(nil)
 		}
-		_ = b.QueueMessage(ReleaseNode{NodeId: node.Id, Outcome: outcome, Response: make(chan bool, 2)})
 
 		if retry {
 			if triggerRecheck {
@@ -272,3 +366,37 @@ func DoWithLockedNodeHTTPRetry(
 		"max_attempts", maxAttempts)
 	return zero, ErrNoNodesAvailable
 }
+
+// EmbeddingSkipList returns the nodes that serve the model without declaring embedding support, to be passed
+// as skipNodeIDs for embeddings requests. ok is false when no node serves embeddings for the model.
+func EmbeddingSkipList(b *Broker, model string) (skip []string, ok bool, err error) {
+	nodes, err := b.GetNodes()
+	if err != nil {
+		return nil, false, err
+	}
+	for _, node := range nodes {
+		if args, found := node.Node.Models[model]; found && args.Embedding {
+			ok = true
+			continue
+		}
+		skip = append(skip, node.Node.Id)
+	}
+	return skip, ok, nil
+}
+
+// MultimodalSkipList returns the nodes that serve the model without declaring multimodal (image input) support,
+// to be passed as skipNodeIDs for requests with images. ok is false when no node serves the model with images.
+func MultimodalSkipList(b *Broker, model string) (skip []string, ok bool, err error) {
+	nodes, err := b.GetNodes()
+	if err != nil {
+		return nil, false, err
+	}
+	for _, node := range nodes {
+		if args, found := node.Node.Models[model]; found && args.Multimodal {
+			ok = true
+			continue
+		}
+		skip = append(skip, node.Node.Id)
+	}
+	return skip, ok, nil
+}
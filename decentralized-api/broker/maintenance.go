@@ -0,0 +1,303 @@
+package broker
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// DefaultMaintenanceDrainLead is how long before a maintenance window the node stops taking new work
+// when the window does not set its own lead time
+const DefaultMaintenanceDrainLead = 5 * time.Minute
+
+var ErrMaintenanceWindowNotFound = errors.New("maintenance window not found")
+
+// maintenanceCheckInterval is how often the broker checks which nodes enter or leave maintenance
+const maintenanceCheckInterval = 30 * time.Second
+
+// MaintenanceWindow is a recurring maintenance period of a node. Schedule is a 5-field cron expression
+// in UTC giving the start of each window. The node is drained DrainLeadSeconds before the start so
+// in-flight requests can finish, skips PoC while in the window and is put back into rotation at its end.
+type MaintenanceWindow struct {
+	Id               string `json:"id"`
+	NodeId           string `json:"node_id"`
+	Schedule         string `json:"schedule"`
+	DurationSeconds  int64  `json:"duration_seconds"`
+	DrainLeadSeconds int64  `json:"drain_lead_seconds"`
+
+	schedule *cronSchedule
+}
+
+// NewMaintenanceWindow validates the schedule and returns a window with a new id
+func NewMaintenanceWindow(nodeId string, schedule string, duration time.Duration, drainLead time.Duration) (MaintenanceWindow, error) {
+	window := MaintenanceWindow{
+		Id:               uuid.NewString(),
+		NodeId:           nodeId,
+		Schedule:         strings.TrimSpace(schedule),
+		DurationSeconds:  int64(duration / time.Second),
+		DrainLeadSeconds: int64(drainLead / time.Second),
+	}
+	if err := window.init(); err != nil {
+		return MaintenanceWindow{}, err
+	}
+	return window, nil
+}
+
+func (w *MaintenanceWindow) init() error {
+	if w.DurationSeconds <= 0 {
+		return fmt.Errorf("maintenance window duration must be positive")
+	}
+	if w.DrainLeadSeconds < 0 {
+		return fmt.Errorf("maintenance window drain lead must not be negative")
+	}
+	schedule, err := parseCronSchedule(w.Schedule)
+	if err != nil {
+		return err
+	}
+	w.schedule = schedule
+	return nil
+}
+
+func (w MaintenanceWindow) duration() time.Duration {
+	return time.Duration(w.DurationSeconds) * time.Second
+}
+
+func (w MaintenanceWindow) drainLead() time.Duration {
+	return time.Duration(w.DrainLeadSeconds) * time.Second
+}
+
+// activeAt returns the start of the window occurrence that covers now, drain lead included
+func (w MaintenanceWindow) activeAt(now time.Time) (time.Time, bool) {
+	// the only occurrence that can cover now is the first one that has not ended yet
+	start, ok := w.schedule.next(now.Add(-w.duration()))
+	if !ok || start.Add(-w.drainLead()).After(now) {
+		return time.Time{}, false
+	}
+	return start, true
+}
+
+// NextStart returns the start of the next window occurrence after now
+func (w MaintenanceWindow) NextStart(now time.Time) (time.Time, bool) {
+	return w.schedule.next(now)
+}
+
+// MaintenanceStatus is the maintenance window a node is currently in
+type MaintenanceStatus struct {
+	WindowId string    `json:"window_id,omitempty"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	// OwnsDrain is set when the node was drained by the maintenance window, as opposed to an
+	// operator drain that must outlast the window
+	OwnsDrain bool `json:"owns_drain"`
+}
+
+// InMaintenance reports whether the node is in a maintenance window, drain lead included
+func (s *NodeState) InMaintenance() bool {
+	return s.Maintenance != nil
+}
+
+// AddMaintenanceWindow registers a maintenance window for a registered node and persists it when
+// state persistence is enabled
+func (b *Broker) AddMaintenanceWindow(ctx context.Context, window MaintenanceWindow) error {
+	if err := window.init(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	if _, ok := b.nodes[window.NodeId]; !ok {
+		b.mu.Unlock()
+		return fmt.Errorf("%w: %s", ErrNodeNotFound, window.NodeId)
+	}
+	store := b.maintenanceStore
+	b.mu.Unlock()
+
+	if store != nil {
+		if err := store.Save(ctx, window); err != nil {
+			return err
+		}
+	}
+
+	b.mu.Lock()
+	b.maintenanceWindows[window.Id] = window
+	b.mu.Unlock()
+	logging.Info("Added maintenance window", types.Nodes, "node_id", window.NodeId, "window_id", window.Id,
+		"schedule", window.Schedule, "duration_seconds", window.DurationSeconds, "drain_lead_seconds", window.DrainLeadSeconds)
+
+	b.updateMaintenance(time.Now())
+	return nil
+}
+
+// RemoveMaintenanceWindow deletes a maintenance window of a node. A node in that window is put back
+// into rotation right away.
+func (b *Broker) RemoveMaintenanceWindow(ctx context.Context, nodeId string, windowId string) error {
+	b.mu.RLock()
+	window, ok := b.maintenanceWindows[windowId]
+	store := b.maintenanceStore
+	b.mu.RUnlock()
+	if !ok || window.NodeId != nodeId {
+		return fmt.Errorf("%w: %s", ErrMaintenanceWindowNotFound, windowId)
+	}
+
+	if store != nil {
+		if err := store.Delete(ctx, windowId); err != nil {
+			return err
+		}
+	}
+
+	b.mu.Lock()
+	delete(b.maintenanceWindows, windowId)
+	b.mu.Unlock()
+	logging.Info("Removed maintenance window", types.Nodes, "node_id", nodeId, "window_id", windowId)
+
+	b.updateMaintenance(time.Now())
+	return nil
+}
+
+// MaintenanceWindows returns the maintenance windows of a node ordered by id
+func (b *Broker) MaintenanceWindows(nodeId string) []MaintenanceWindow {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	windows := make([]MaintenanceWindow, 0)
+	for _, window := range b.maintenanceWindows {
+		if window.NodeId == nodeId {
+			windows = append(windows, window)
+		}
+	}
+	slices.SortFunc(windows, func(a, b MaintenanceWindow) int { return strings.Compare(a.Id, b.Id) })
+	return windows
+}
+
+func (b *Broker) maintenanceLoop() {
+	ticker := time.NewTicker(maintenanceCheckInterval)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		b.updateMaintenance(now)
+	}
+}
+
+// updateMaintenance drains the nodes entering a maintenance window and puts the nodes leaving one back
+// into rotation
+func (b *Broker) updateMaintenance(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	changed := false
+	for nodeId, node := range b.nodes {
+		active := b.activeMaintenance(nodeId, now)
+		current := node.State.Maintenance
+		switch {
+		case active != nil && current == nil:
+			// an operator drain in place before the window is left to the operator
+			active.OwnsDrain = node.State.DrainStatus == DrainStatusNone
+			if active.OwnsDrain {
+				node.State.startDrain(nodeId)
+			}
+			node.State.Maintenance = active
+			logging.Info("Node entering maintenance window", types.Nodes, "node_id", nodeId,
+				"window_id", active.WindowId, "start", active.Start, "end", active.End)
+		case active != nil:
+			active.OwnsDrain = current.OwnsDrain
+			node.State.Maintenance = active
+			continue
+		case current != nil:
+			if current.OwnsDrain {
+				node.State.undrain(nodeId)
+			}
+			node.State.Maintenance = nil
+			logging.Info("Node leaving maintenance window", types.Nodes, "node_id", nodeId, "window_id", current.WindowId)
+		default:
+			continue
+		}
+		changed = true
+	}
+
+	if changed {
+		b.triggerStatePersistence()
+	}
+}
+
+// activeMaintenance returns the maintenance window the node is in, the one ending last if windows overlap.
+// Must be called with b.mu held.
+func (b *Broker) activeMaintenance(nodeId string, now time.Time) *MaintenanceStatus {
+	var active *MaintenanceStatus
+	for _, window := range b.maintenanceWindows {
+		if window.NodeId != nodeId {
+			continue
+		}
+		start, ok := window.activeAt(now)
+		if !ok {
+			continue
+		}
+		end := start.Add(window.duration())
+		if active == nil || end.After(active.End) {
+			active = &MaintenanceStatus{WindowId: window.Id, Start: start, End: end}
+		}
+	}
+	return active
+}
+
+// removeNodeMaintenanceWindows drops the windows of a removed node. Must be called with b.mu held.
+func (b *Broker) removeNodeMaintenanceWindows(ctx context.Context, nodeId string) {
+	for id, window := range b.maintenanceWindows {
+		if window.NodeId != nodeId {
+			continue
+		}
+		delete(b.maintenanceWindows, id)
+		if b.maintenanceStore == nil {
+			continue
+		}
+		if err := b.maintenanceStore.Delete(ctx, id); err != nil {
+			logging.Error("Failed to delete maintenance window", types.Nodes, "node_id", nodeId, "window_id", id, "error", err)
+		}
+	}
+}
+
+// sqlMaintenanceWindowStore stores maintenance windows in the node_maintenance_windows table created
+// by apiconfig.EnsureSchema
+type sqlMaintenanceWindowStore struct {
+	db *sql.DB
+}
+
+func (s *sqlMaintenanceWindowStore) Save(ctx context.Context, window MaintenanceWindow) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO node_maintenance_windows (id, node_id, schedule, duration_seconds, drain_lead_seconds)
+VALUES (?, ?, ?, ?, ?)`,
+		window.Id, window.NodeId, window.Schedule, window.DurationSeconds, window.DrainLeadSeconds)
+	return err
+}
+
+func (s *sqlMaintenanceWindowStore) Delete(ctx context.Context, windowId string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM node_maintenance_windows WHERE id = ?`, windowId)
+	return err
+}
+
+func (s *sqlMaintenanceWindowStore) LoadAll(ctx context.Context) (map[string]MaintenanceWindow, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT id, node_id, schedule, duration_seconds, drain_lead_seconds FROM node_maintenance_windows`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	windows := make(map[string]MaintenanceWindow)
+	for rows.Next() {
+		var window MaintenanceWindow
+		if err := rows.Scan(&window.Id, &window.NodeId, &window.Schedule, &window.DurationSeconds, &window.DrainLeadSeconds); err != nil {
+			return nil, err
+		}
+		if err := window.init(); err != nil {
+			logging.Error("Skipping invalid maintenance window", types.Nodes, "window_id", window.Id, "error", err)
+			continue
+		}
+		windows[window.Id] = window
+	}
+	return windows, rows.Err()
+}
@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSchedule is a parsed 5-field cron expression: minute hour day-of-month month day-of-week.
+// Fields accept *, values, ranges (a-b), lists (a,b) and steps (*/n, a-b/n). Times are in UTC.
+type cronSchedule struct {
+	minutes     [60]bool
+	hours       [24]bool
+	daysOfMonth [32]bool
+	months      [13]bool
+	daysOfWeek  [7]bool
+	// As in cron, when both day fields are restricted a day matches if either matches
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// cronSearchDays bounds the search for the next start; covers schedules like "0 0 29 2 *"
+const cronSearchDays = 4*366 + 1
+
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron schedule must have 5 fields (minute hour day-of-month month day-of-week), got %d", len(fields))
+	}
+	s := &cronSchedule{
+		anyDayOfMonth: fields[2] == "*",
+		anyDayOfWeek:  fields[4] == "*",
+	}
+	specs := []struct {
+		name     string
+		min, max int
+		set      []bool
+	}{
+		{"minute", 0, 59, s.minutes[:]},
+		{"hour", 0, 23, s.hours[:]},
+		{"day-of-month", 1, 31, s.daysOfMonth[:]},
+		{"month", 1, 12, s.months[:]},
+		{"day-of-week", 0, 7, nil},
+	}
+	var daysOfWeek [8]bool
+	specs[4].set = daysOfWeek[:]
+	for i, spec := range specs {
+		if err := parseCronField(fields[i], spec.min, spec.max, spec.set); err != nil {
+			return nil, fmt.Errorf("invalid %s field %q: %w", spec.name, fields[i], err)
+		}
+	}
+	// 7 is Sunday too
+	copy(s.daysOfWeek[:], daysOfWeek[:7])
+	s.daysOfWeek[0] = s.daysOfWeek[0] || daysOfWeek[7]
+	return s, nil
+}
+
+func parseCronField(field string, min int, max int, set []bool) error {
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			var err error
+			rangePart = part[:i]
+			step, err = strconv.Atoi(part[i+1:])
+			if err != nil || step <= 0 {
+				return fmt.Errorf("invalid step in %q", part)
+			}
+		}
+
+		from, to := min, max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			from, err = strconv.Atoi(bounds[0])
+			if err != nil {
+				return fmt.Errorf("invalid value %q", bounds[0])
+			}
+			to = from
+			if len(bounds) == 2 {
+				to, err = strconv.Atoi(bounds[1])
+				if err != nil {
+					return fmt.Errorf("invalid value %q", bounds[1])
+				}
+			} else if step > 1 {
+				// "a/n" means from a to the end of the range
+				to = max
+			}
+		}
+		if from < min || to > max || from > to {
+			return fmt.Errorf("%q is out of range %d-%d", rangePart, min, max)
+		}
+		for v := from; v <= to; v += step {
+			set[v] = true
+		}
+	}
+	return nil
+}
+
+func (s *cronSchedule) matchesDay(day time.Time) bool {
+	if !s.months[day.Month()] {
+		return false
+	}
+	domMatch := s.daysOfMonth[day.Day()]
+	dowMatch := s.daysOfWeek[day.Weekday()]
+	switch {
+	case s.anyDayOfMonth && s.anyDayOfWeek:
+		return true
+	case s.anyDayOfMonth:
+		return dowMatch
+	case s.anyDayOfWeek:
+		return domMatch
+	default:
+		return domMatch || dowMatch
+	}
+}
+
+// next returns the first scheduled time strictly after t, or false if the schedule never fires
+func (s *cronSchedule) next(t time.Time) (time.Time, bool) {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	for i := 0; i < cronSearchDays; i++ {
+		if s.matchesDay(day) {
+			for h := 0; h < 24; h++ {
+				if !s.hours[h] {
+					continue
+				}
+				for m := 0; m < 60; m++ {
+					if !s.minutes[m] {
+						continue
+					}
+					candidate := day.Add(time.Duration(h)*time.Hour + time.Duration(m)*time.Minute)
+					if !candidate.Before(t) {
+						return candidate, true
+					}
+				}
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
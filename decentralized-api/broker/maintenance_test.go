@@ -0,0 +1,122 @@
+package broker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"decentralized-api/apiconfig"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	tests := []struct {
+		schedule string
+		after    string
+		want     string
+	}{
+		{"0 3 * * *", "2025-01-01T02:59:00Z", "2025-01-01T03:00:00Z"},
+		{"0 3 * * *", "2025-01-01T03:00:00Z", "2025-01-02T03:00:00Z"},
+		{"*/15 * * * *", "2025-01-01T10:07:30Z", "2025-01-01T10:15:00Z"},
+		{"30 1 * * 0", "2025-01-01T00:00:00Z", "2025-01-05T01:30:00Z"},   // first Sunday
+		{"30 1 * * 7", "2025-01-01T00:00:00Z", "2025-01-05T01:30:00Z"},   // 7 is Sunday too
+		{"0 0 1,15 * *", "2025-01-02T00:00:00Z", "2025-01-15T00:00:00Z"}, // list
+		{"0 9-17/4 * * 1-5", "2025-01-03T14:00:00Z", "2025-01-03T17:00:00Z"},
+		{"0 9-17/4 * * 1-5", "2025-01-03T17:00:00Z", "2025-01-06T09:00:00Z"}, // over the weekend
+		{"0 0 29 2 *", "2025-03-01T00:00:00Z", "2028-02-29T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.schedule+" after "+tt.after, func(t *testing.T) {
+			schedule, err := parseCronSchedule(tt.schedule)
+			require.NoError(t, err)
+			after, _ := time.Parse(time.RFC3339, tt.after)
+			next, ok := schedule.next(after)
+			require.True(t, ok)
+			require.Equal(t, tt.want, next.Format(time.RFC3339))
+		})
+	}
+
+	for _, invalid := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := parseCronSchedule(invalid)
+		require.Error(t, err, invalid)
+	}
+
+	never, err := parseCronSchedule("0 0 31 2 *")
+	require.NoError(t, err)
+	_, ok := never.next(time.Now())
+	require.False(t, ok)
+}
+
+func TestMaintenanceWindowDrainsAndRestoresNode(t *testing.T) {
+	broker := NewTestBroker()
+	node := apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	}
+	registerNodeAndSetInferenceStatus(t, broker, node)
+
+	// daily at 03:00 for an hour, drained 10 minutes ahead
+	window, err := NewMaintenanceWindow(node.Id, "0 3 * * *", time.Hour, 10*time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, broker.AddMaintenanceWindow(context.Background(), window))
+	require.ErrorIs(t, broker.AddMaintenanceWindow(context.Background(), MaintenanceWindow{NodeId: "unknown", Schedule: "0 3 * * *", DurationSeconds: 60}), ErrNodeNotFound)
+	require.Len(t, broker.MaintenanceWindows(node.Id), 1)
+
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nodeState := func() *NodeState {
+		nodes, err := broker.GetNodes()
+		require.NoError(t, err)
+		return &nodes[0].State
+	}
+	availableNode := make(chan *Node, 2)
+
+	broker.updateMaintenance(day.Add(2*time.Hour + 45*time.Minute))
+	require.False(t, nodeState().InMaintenance())
+
+	broker.updateMaintenance(day.Add(2*time.Hour + 55*time.Minute))
+	state := nodeState()
+	require.True(t, state.InMaintenance())
+	require.Equal(t, day.Add(3*time.Hour), state.Maintenance.Start)
+	require.Equal(t, day.Add(4*time.Hour), state.Maintenance.End)
+	require.Equal(t, DrainStatusDrained, state.DrainStatus)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.Nil(t, <-availableNode, "node in maintenance must not get new work")
+
+	broker.updateMaintenance(day.Add(3*time.Hour + 59*time.Minute))
+	require.True(t, nodeState().InMaintenance())
+
+	broker.updateMaintenance(day.Add(4 * time.Hour))
+	state = nodeState()
+	require.False(t, state.InMaintenance())
+	require.Equal(t, DrainStatusNone, state.DrainStatus)
+	queueMessage(t, broker, LockAvailableNode{Model: "model1", Response: availableNode})
+	require.NotNil(t, <-availableNode)
+	release := make(chan bool, 2)
+	queueMessage(t, broker, ReleaseNode{NodeId: node.Id, Outcome: InferenceSuccess{}, Response: release})
+	require.True(t, <-release)
+
+	// an operator drain from before the window outlasts it
+	_, err = broker.DrainNode(context.Background(), node.Id)
+	require.NoError(t, err)
+	broker.updateMaintenance(day.Add(27 * time.Hour))
+	broker.updateMaintenance(day.Add(28 * time.Hour))
+	state = nodeState()
+	require.False(t, state.InMaintenance())
+	require.Equal(t, DrainStatusDrained, state.DrainStatus)
+	require.NoError(t, broker.UndrainNode(node.Id))
+
+	// removing the window ends the maintenance
+	broker.updateMaintenance(day.Add(51 * time.Hour))
+	require.True(t, nodeState().InMaintenance())
+	require.ErrorIs(t, broker.RemoveMaintenanceWindow(context.Background(), "other", window.Id), ErrMaintenanceWindowNotFound)
+	require.NoError(t, broker.RemoveMaintenanceWindow(context.Background(), node.Id, window.Id))
+	state = nodeState()
+	require.False(t, state.InMaintenance())
+	require.Equal(t, DrainStatusNone, state.DrainStatus)
+	require.Empty(t, broker.MaintenanceWindows(node.Id))
+}
@@ -0,0 +1,114 @@
+package broker
+
+import (
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// tightVRamHeadroom is the ratio of node VRAM to the model's governance VRAM requirement below which
+// the KV cache is squeezed into fp8
+const tightVRamHeadroom = 1.25
+
+// HardwareProfile summarizes the GPUs declared for a node
+type HardwareProfile struct {
+	GPUCount uint32
+	// VRamGB is the total VRAM of the GPUs, 0 when the hardware types carry no VRAM figure
+	VRamGB uint64
+}
+
+func hardwareProfileOf(hardware []apiconfig.Hardware) HardwareProfile {
+	node := &types.HardwareNode{Hardware: make([]*types.Hardware, 0, len(hardware))}
+	var profile HardwareProfile
+	for _, hw := range hardware {
+		profile.GPUCount += hw.Count
+		node.Hardware = append(node.Hardware, &types.Hardware{Type: hw.Type, Count: hw.Count})
+	}
+	profile.VRamGB, _ = types.DeclaredVRamGB(node)
+	return profile
+}
+
+// tensorParallelSize returns the largest power of two not above the GPU count. Models split their
+// attention heads evenly across tensor parallel ranks, which powers of two always do.
+func tensorParallelSize(gpuCount uint32) int {
+	size := 1
+	for uint32(size*2) <= gpuCount {
+		size *= 2
+	}
+	return size
+}
+
+// templateModelArgs returns the launch args adapted to the node's hardware profile
+func templateModelArgs(model types.Model, profile HardwareProfile) []string {
+	var args []string
+	if profile.GPUCount > 1 {
+		args = append(args, "--tensor-parallel-size", strconv.Itoa(tensorParallelSize(profile.GPUCount)))
+	}
+	if model.VRam > 0 && profile.VRamGB > 0 && float64(profile.VRamGB) < float64(model.VRam)*tightVRamHeadroom {
+		// Little room for the KV cache next to the weights: store it in fp8 and give vLLM more of the memory
+		args = append(args, "--kv-cache-dtype", "fp8", "--gpu-memory-utilization", "0.95")
+	}
+	return args
+}
+
+// argKeys returns the keys of args laid out as MergeModelArgs expects: each key followed by its value, if any
+func argKeys(args []string) []string {
+	keys := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--") {
+			keys = append(keys, arg)
+		}
+	}
+	return keys
+}
+
+// validateArgOverrides checks the overrides of a model against the governance-permitted args: only
+// hardware-tunable args that governance does not pin for the model may be overridden
+func validateArgOverrides(model types.Model, overrides []string) error {
+	pinned := argKeys(model.ModelArgs)
+	for _, key := range argKeys(overrides) {
+		if !slices.Contains(apiconfig.HardwareTunableModelArgs, key) {
+			return fmt.Errorf("arg override %s of model %s is not tunable per node", key, model.Id)
+		}
+		if slices.Contains(pinned, key) {
+			return fmt.Errorf("arg override %s of model %s is pinned by governance", key, model.Id)
+		}
+	}
+	return nil
+}
+
+// validateNodeArgOverrides validates the arg overrides of every model of a node config
+func validateNodeArgOverrides(models map[string]apiconfig.ModelConfig, govModels []types.Model) error {
+	for _, model := range govModels {
+		config, ok := models[model.Id]
+		if !ok {
+			continue
+		}
+		if err := validateArgOverrides(model, config.ArgOverrides); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hardwareModelArgs returns the launch args of the model on the node. Governance args take precedence
+// over the node's arg overrides, which take precedence over its local args and then over the args
+// templated from its hardware profile.
+func (b *Broker) hardwareModelArgs(node Node, model types.Model) []string {
+	local := node.Models[model.Id]
+	overrides := local.ArgOverrides
+	// Governance may have pinned an arg since the node was registered
+	if err := validateArgOverrides(model, overrides); err != nil {
+		logging.Warn("Ignoring model arg overrides", types.Nodes, "node_id", node.Id, "error", err)
+		overrides = nil
+	}
+
+	template := templateModelArgs(model, hardwareProfileOf(node.Hardware))
+	nodeArgs := b.MergeModelArgs(overrides, b.MergeModelArgs(local.Args, template))
+	return b.MergeModelArgs(model.ModelArgs, nodeArgs)
+}
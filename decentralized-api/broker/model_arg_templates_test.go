@@ -0,0 +1,77 @@
+package broker
+
+import (
+	"testing"
+
+	"decentralized-api/apiconfig"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareProfileOf(t *testing.T) {
+	profile := hardwareProfileOf([]apiconfig.Hardware{
+		{Type: "NVIDIA H100 80GB HBM3 | 79GB", Count: 2},
+		{Type: "NVIDIA RTX A6000 | 48GB", Count: 1},
+	})
+	require.Equal(t, HardwareProfile{GPUCount: 3, VRamGB: 206}, profile)
+
+	profile = hardwareProfileOf([]apiconfig.Hardware{{Type: "H100", Count: 4}})
+	require.Equal(t, HardwareProfile{GPUCount: 4}, profile, "no VRAM figure in the type")
+}
+
+func TestTemplateModelArgs(t *testing.T) {
+	model := types.Model{Id: "model1", VRam: 150}
+
+	require.Empty(t, templateModelArgs(model, HardwareProfile{GPUCount: 1, VRamGB: 200}))
+	require.Equal(t, []string{"--tensor-parallel-size", "2"}, templateModelArgs(model, HardwareProfile{GPUCount: 3, VRamGB: 240}))
+	require.Equal(t, []string{"--tensor-parallel-size", "8", "--kv-cache-dtype", "fp8", "--gpu-memory-utilization", "0.95"},
+		templateModelArgs(model, HardwareProfile{GPUCount: 8, VRamGB: 160}))
+	require.Equal(t, []string{"--tensor-parallel-size", "4"}, templateModelArgs(model, HardwareProfile{GPUCount: 4}),
+		"unknown VRAM leaves the KV cache alone")
+}
+
+func TestHardwareModelArgs(t *testing.T) {
+	b := &Broker{}
+	model := types.Model{Id: "model1", VRam: 150, ModelArgs: []string{"--quantization", "fp8"}}
+	node := Node{
+		Id:       "node1",
+		Hardware: []apiconfig.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 2}},
+		Models: map[string]ModelArgs{
+			"model1": {Args: []string{"--max-model-len", "8192"}, ArgOverrides: []string{"--gpu-memory-utilization", "0.9"}},
+		},
+	}
+
+	require.Equal(t, []string{
+		"--quantization", "fp8",
+		"--gpu-memory-utilization", "0.9",
+		"--max-model-len", "8192",
+		"--tensor-parallel-size", "2",
+		"--kv-cache-dtype", "fp8",
+	}, b.hardwareModelArgs(node, model))
+
+	// overrides of args governance pins are dropped
+	model.ModelArgs = append(model.ModelArgs, "--gpu-memory-utilization", "0.8")
+	require.Error(t, validateArgOverrides(model, node.Models["model1"].ArgOverrides))
+	require.Equal(t, []string{
+		"--quantization", "fp8",
+		"--gpu-memory-utilization", "0.8",
+		"--max-model-len", "8192",
+		"--tensor-parallel-size", "2",
+		"--kv-cache-dtype", "fp8",
+	}, b.hardwareModelArgs(node, model))
+}
+
+func TestValidateNodeArgOverrides(t *testing.T) {
+	govModels := []types.Model{{Id: "model1", ModelArgs: []string{"--kv-cache-dtype", "auto"}}}
+
+	require.NoError(t, validateNodeArgOverrides(map[string]apiconfig.ModelConfig{
+		"model1": {ArgOverrides: []string{"--tensor-parallel-size", "4"}},
+	}, govModels))
+	require.Error(t, validateNodeArgOverrides(map[string]apiconfig.ModelConfig{
+		"model1": {ArgOverrides: []string{"--kv-cache-dtype", "fp8"}},
+	}, govModels), "pinned by governance")
+	require.Error(t, validateNodeArgOverrides(map[string]apiconfig.ModelConfig{
+		"model1": {ArgOverrides: []string{"--quantization", "awq"}},
+	}, govModels), "not tunable")
+}
@@ -1,9 +1,11 @@
 package broker
 
 import (
+	"context"
 	"decentralized-api/apiconfig"
 	"decentralized-api/logging"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -98,12 +100,18 @@ func (c RegisterNode) Execute(b *Broker) {
 		}
 	}
 
+	if err := validateNodeArgOverrides(c.Node.Models, govModels.Model); err != nil {
+		logging.Error("RegisterNode. Invalid model arg overrides", types.Nodes, "node_id", c.Node.Id, "error", err)
+		c.Response <- NodeCommandResponse{Node: nil, Error: err}
+		return
+	}
+
 	b.curMaxNodesNum.Add(1)
 	curNum := b.curMaxNodesNum.Load()
 
 	models := make(map[string]ModelArgs)
 	for model, config := range c.Node.Models {
-		models[model] = ModelArgs{Args: config.Args}
+		models[model] = ModelArgs{Args: config.Args, Embedding: config.Embedding, Multimodal: config.Multimodal, ArgOverrides: config.ArgOverrides}
 	}
 
 	node := Node{
@@ -117,6 +125,8 @@ func (c RegisterNode) Execute(b *Broker) {
 		MaxConcurrent:    c.Node.MaxConcurrent,
 		NodeNum:          curNum,
 		Hardware:         c.Node.Hardware,
+		Transport:        c.Node.Transport,
+		GrpcPort:         c.Node.GrpcPort,
 	}
 
 	var currentEpoch uint64
@@ -152,6 +162,7 @@ func (c RegisterNode) Execute(b *Broker) {
 	func() {
 		b.mu.Lock()
 		defer b.mu.Unlock()
+		b.restoreEpochIndependentState(c.Node.Id, &nodeWithState.State)
 		b.nodes[c.Node.Id] = nodeWithState
 
 		// Create and register a worker for this node
@@ -235,6 +246,12 @@ func (c UpdateNode) Execute(b *Broker) {
 		}
 	}
 
+	if err := validateNodeArgOverrides(c.Node.Models, govModels.Model); err != nil {
+		logging.Error("UpdateNode. Invalid model arg overrides", types.Nodes, "node_id", c.Node.Id, "error", err)
+		c.Response <- NodeCommandResponse{Node: nil, Error: err}
+		return
+	}
+
 	// Apply update
 	b.mu.Lock()
 	defer b.mu.Unlock()
@@ -242,7 +259,7 @@ func (c UpdateNode) Execute(b *Broker) {
 	// Build updated Node struct, preserving node number
 	models := make(map[string]ModelArgs)
 	for model, config := range c.Node.Models {
-		models[model] = ModelArgs{Args: config.Args}
+		models[model] = ModelArgs{Args: config.Args, Embedding: config.Embedding, Multimodal: config.Multimodal, ArgOverrides: config.ArgOverrides}
 	}
 
 	updated := Node{
@@ -256,10 +273,18 @@ func (c UpdateNode) Execute(b *Broker) {
 		MaxConcurrent:    c.Node.MaxConcurrent,
 		NodeNum:          existing.Node.NodeNum,
 		Hardware:         c.Node.Hardware,
+		Transport:        c.Node.Transport,
+		GrpcPort:         c.Node.GrpcPort,
 	}
 
 	// Apply update
+	redeploy := requiresInferenceRedeploy(existing.Node, updated)
 	existing.Node = updated
+	if redeploy && existing.State.IntendedStatus == types.HardwareNodeStatus_INFERENCE {
+		existing.State.RedeployPending = true
+		logging.Info("UpdateNode. Inference will be redeployed with the new configuration", types.Nodes, "node_id", c.Node.Id)
+		b.TriggerReconciliation()
+	}
 
 	// Optionally trigger a status re-check
 	b.TriggerStatusQuery(true)
@@ -268,6 +293,33 @@ func (c UpdateNode) Execute(b *Broker) {
 	c.Response <- NodeCommandResponse{Node: &c.Node, Error: nil}
 }
 
+// requiresInferenceRedeploy reports whether a node update changes how inference runs on the ML node.
+// MaxConcurrent and hardware only matter to the broker and take effect without a restart.
+func requiresInferenceRedeploy(old Node, updated Node) bool {
+	return old.Host != updated.Host ||
+		old.InferenceSegment != updated.InferenceSegment ||
+		old.InferencePort != updated.InferencePort ||
+		old.PoCSegment != updated.PoCSegment ||
+		old.PoCPort != updated.PoCPort ||
+		old.Transport != updated.Transport ||
+		old.GrpcPort != updated.GrpcPort ||
+		!sameModels(old.Models, updated.Models)
+}
+
+func sameModels(a map[string]ModelArgs, b map[string]ModelArgs) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for modelId, argsA := range a {
+		argsB, ok := b[modelId]
+		if !ok || argsA.Embedding != argsB.Embedding || argsA.Multimodal != argsB.Multimodal || !slices.Equal(argsA.Args, argsB.Args) ||
+			!slices.Equal(argsA.ArgOverrides, argsB.ArgOverrides) {
+			return false
+		}
+	}
+	return true
+}
+
 type RemoveNode struct {
 	NodeId   string
 	Response chan bool
@@ -289,6 +341,9 @@ func (command RemoveNode) Execute(b *Broker) {
 		return
 	}
 	delete(b.nodes, command.NodeId)
+	b.router.removeNode(command.NodeId)
+	b.removeNodeMaintenanceWindows(context.Background(), command.NodeId)
+	b.pocThroughput.removeNode(command.NodeId)
 	logging.Debug("Removed node", types.Nodes, "node_id", command.NodeId)
 	command.Response <- true
 }
@@ -370,3 +425,150 @@ func (c UpdateNodeHardwareCommand) Execute(b *Broker) {
 	logging.Info("Updated node hardware", types.Nodes, "node_id", c.NodeId, "hardware_count", len(c.Hardware))
 	c.Response <- nil
 }
+
+// DrainStatus takes a node out of inference rotation for maintenance without removing it
+type DrainStatus string
+
+const (
+	DrainStatusNone     DrainStatus = ""
+	DrainStatusDraining DrainStatus = "DRAINING" // no new work, in-flight requests still running
+	DrainStatusDrained  DrainStatus = "DRAINED"
+	// DrainStatusCancelled is only returned by DrainNode, when the node was undrained before it drained
+	DrainStatusCancelled DrainStatus = "CANCELLED"
+)
+
+// DrainNodeCommand stops assigning new inference work to a node. Drained is closed once the
+// in-flight requests of the node have finished, Cancelled when UndrainNodeCommand cancels the drain first.
+type DrainNodeCommand struct {
+	NodeId   string
+	Response chan DrainNodeResult
+}
+
+type DrainNodeResult struct {
+	Drained   <-chan struct{}
+	Cancelled <-chan struct{}
+	Err       error
+}
+
+func NewDrainNodeCommand(nodeId string) DrainNodeCommand {
+	return DrainNodeCommand{
+		NodeId:   nodeId,
+		Response: make(chan DrainNodeResult, 2),
+	}
+}
+
+func (c DrainNodeCommand) GetResponseChannelCapacity() int {
+	return cap(c.Response)
+}
+
+func (c DrainNodeCommand) Execute(b *Broker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	node, exists := b.nodes[c.NodeId]
+	if !exists {
+		c.Response <- DrainNodeResult{Err: fmt.Errorf("node not found: %s", c.NodeId)}
+		return
+	}
+
+	node.State.startDrain(c.NodeId)
+	c.Response <- DrainNodeResult{Drained: node.State.drained, Cancelled: node.State.drainCancelled}
+}
+
+// UndrainNodeCommand puts a drained or draining node back into inference rotation
+type UndrainNodeCommand struct {
+	NodeId   string
+	Response chan error
+}
+
+func NewUndrainNodeCommand(nodeId string) UndrainNodeCommand {
+	return UndrainNodeCommand{
+		NodeId:   nodeId,
+		Response: make(chan error, 2),
+	}
+}
+
+func (c UndrainNodeCommand) GetResponseChannelCapacity() int {
+	return cap(c.Response)
+}
+
+func (c UndrainNodeCommand) Execute(b *Broker) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	node, exists := b.nodes[c.NodeId]
+	if !exists {
+		c.Response <- fmt.Errorf("node not found: %s", c.NodeId)
+		return
+	}
+
+	node.State.undrain(c.NodeId)
+	c.Response <- nil
+}
+
+// startDrain stops assigning new work to the node; it is drained right away when idle.
+// Must be called with the broker lock held.
+func (s *NodeState) startDrain(nodeId string) {
+	if s.DrainStatus == DrainStatusNone {
+		s.DrainStatus = DrainStatusDraining
+		s.drained = make(chan struct{})
+		s.drainCancelled = make(chan struct{})
+		logging.Info("Draining node", types.Nodes, "node_id", nodeId, "in_flight", s.LockCount)
+	}
+	s.finishDrainIfIdle(nodeId)
+}
+
+// undrain puts the node back into rotation. Must be called with the broker lock held.
+func (s *NodeState) undrain(nodeId string) {
+	if s.DrainStatus == DrainStatusDraining {
+		// release anyone waiting for the drain to finish, without reporting the node as drained
+		close(s.drainCancelled)
+	}
+	s.DrainStatus = DrainStatusNone
+	s.drained = nil
+	s.drainCancelled = nil
+	logging.Info("Node back in rotation", types.Nodes, "node_id", nodeId)
+}
+
+// finishDrainIfIdle marks a draining node as drained once it has no in-flight requests.
+// Must be called with the broker lock held.
+func (s *NodeState) finishDrainIfIdle(nodeId string) {
+	if s.DrainStatus != DrainStatusDraining || s.LockCount > 0 {
+		return
+	}
+	s.DrainStatus = DrainStatusDrained
+	close(s.drained)
+	logging.Info("Node drained", types.Nodes, "node_id", nodeId)
+}
+
+// DrainNode takes the node out of inference rotation and waits until its in-flight requests finish or
+// ctx is done. The node stays DRAINING when ctx ends first, and CANCELLED is returned when the node is
+// undrained while waiting.
+func (b *Broker) DrainNode(ctx context.Context, nodeId string) (DrainStatus, error) {
+	command := NewDrainNodeCommand(nodeId)
+	if err := b.QueueMessage(command); err != nil {
+		return DrainStatusNone, err
+	}
+	result := <-command.Response
+	if result.Err != nil {
+		return DrainStatusNone, result.Err
+	}
+
+	select {
+	case <-result.Drained:
+		return DrainStatusDrained, nil
+	case <-result.Cancelled:
+		return DrainStatusCancelled, nil
+	case <-ctx.Done():
+		return DrainStatusDraining, nil
+	}
+}
+
+// UndrainNode puts the node back into inference rotation
+func (b *Broker) UndrainNode(nodeId string) error {
+	command := NewUndrainNodeCommand(nodeId)
+	if err := b.QueueMessage(command); err != nil {
+		return err
+	}
+	return <-command.Response
+}
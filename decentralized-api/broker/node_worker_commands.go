@@ -45,7 +45,11 @@ func (c StopNodeCommand) Execute(ctx context.Context, worker *NodeWorker) NodeRe
 }
 
 // InferenceUpNodeCommand brings up inference on a single node
-type InferenceUpNodeCommand struct{}
+type InferenceUpNodeCommand struct {
+	// Redeploy restarts inference even when the node already serves the expected model,
+	// so a changed node configuration (model args, endpoints) takes effect
+	Redeploy bool
+}
 
 func (c InferenceUpNodeCommand) Execute(ctx context.Context, worker *NodeWorker) NodeResult {
 	result := NodeResult{
@@ -61,7 +65,9 @@ func (c InferenceUpNodeCommand) Execute(ctx context.Context, worker *NodeWorker)
 	}
 
 	// Idempotency check - skip redeploy if already running correct model
-	if state, err := worker.GetClient().NodeState(ctx); err == nil && state.State == mlnodeclient.MlNodeState_INFERENCE {
+	if c.Redeploy {
+		logging.Info("Node configuration changed, redeploying inference", types.Nodes, "node_id", worker.nodeId)
+	} else if state, err := worker.GetClient().NodeState(ctx); err == nil && state.State == mlnodeclient.MlNodeState_INFERENCE {
 		if healthy, _ := worker.GetClient().InferenceHealth(ctx); healthy {
 			// Check if loaded model matches expected
 			modelMatches := true
@@ -159,12 +165,9 @@ func (c InferenceUpNodeCommand) Execute(ctx context.Context, worker *NodeWorker)
 
 	logging.Info("Selected model for inference", types.Nodes, "node_id", worker.nodeId, "selectedModel", selectedModel)
 
-	// Merge epoch model args with local ones
-	var localArgs []string
-	if localModelConfig, ok := worker.node.Node.Models[selectedModel.Id]; ok {
-		localArgs = localModelConfig.Args
-	}
-	mergedArgs := worker.broker.MergeModelArgs(selectedModel.ModelArgs, localArgs)
+	// Merge epoch model args with the local and hardware-templated ones
+	mergedArgs := worker.broker.hardwareModelArgs(worker.node.Node, *selectedModel)
+	logging.Info("Launch args for inference", types.Nodes, "node_id", worker.nodeId, "model_id", selectedModel.Id, "args", mergedArgs)
 
 	if err := worker.GetClient().InferenceUp(ctx, selectedModel.Id, mergedArgs); err != nil {
 		logging.Error("Failed to bring up inference", types.Nodes, "node_id", worker.nodeId, "error", err)
@@ -187,6 +190,7 @@ type StartTrainingNodeCommand struct {
 	MasterNodeAddr string
 	NodeRanks      map[string]int
 	WorldSize      int
+	ResumeFrom     string
 }
 
 func (c StartTrainingNodeCommand) Execute(ctx context.Context, worker *NodeWorker) NodeResult {
@@ -224,7 +228,7 @@ func (c StartTrainingNodeCommand) Execute(ctx context.Context, worker *NodeWorke
 	// Start training
 	trainingErr := worker.GetClient().StartTraining(
 		ctx, c.TaskId, c.Participant, worker.nodeId,
-		c.MasterNodeAddr, rank, c.WorldSize,
+		c.MasterNodeAddr, rank, c.WorldSize, c.ResumeFrom,
 	)
 	if trainingErr != nil {
 		logging.Error("Failed to start training", types.Training, "node_id", worker.nodeId, "error", trainingErr)
@@ -264,6 +268,8 @@ type StartPoCNodeCommandV2 struct {
 	TotalNodes  int
 	Model       string
 	SeqLen      int64
+	// BatchSize is the adaptive nonce batch size, 0 keeps the ML node default
+	BatchSize int
 }
 
 func (c StartPoCNodeCommandV2) Execute(ctx context.Context, worker *NodeWorker) NodeResult {
@@ -306,7 +312,8 @@ func (c StartPoCNodeCommandV2) Execute(ctx context.Context, worker *NodeWorker)
 			Model:  c.Model,
 			SeqLen: c.SeqLen,
 		},
-		URL: c.CallbackUrl,
+		URL:       c.CallbackUrl,
+		BatchSize: c.BatchSize,
 	}
 
 	if _, err := worker.GetClient().InitGenerateV2(ctx, req); err != nil {
@@ -318,7 +325,7 @@ func (c StartPoCNodeCommandV2) Execute(ctx context.Context, worker *NodeWorker)
 		result.Succeeded = true
 		result.FinalStatus = types.HardwareNodeStatus_POC
 		result.FinalPocStatus = PocStatusGenerating
-		logging.Info("[StartPoCNodeCommandV2] Successfully started PoC v2 on node", types.PoC, "node_id", worker.nodeId)
+		logging.Info("[StartPoCNodeCommandV2] Successfully started PoC v2 on node", types.PoC, "node_id", worker.nodeId, "batch_size", c.BatchSize)
 	}
 	return result
 }
@@ -17,6 +17,8 @@ type StartPoCNodeCommandV1 struct {
 	CallbackUrl string
 	TotalNodes  int
 	ModelParams *types.PoCModelParams
+	// BatchSize is the adaptive nonce batch size, 0 keeps mlnodeclient.DefaultBatchSize
+	BatchSize int
 }
 
 func (c StartPoCNodeCommandV1) Execute(ctx context.Context, worker *NodeWorker) NodeResult {
@@ -62,6 +64,9 @@ func (c StartPoCNodeCommandV1) Execute(ctx context.Context, worker *NodeWorker)
 		c.BlockHeight, c.PubKey, int64(c.TotalNodes),
 		worker.node.Node.NodeNum, c.BlockHash, c.CallbackUrl, c.ModelParams,
 	)
+	if c.BatchSize > 0 {
+		dto.BatchSize = c.BatchSize
+	}
 	if err := worker.GetClient().InitGenerateV1(ctx, dto); err != nil {
 		logging.Error("[StartPoCNodeCommandV1] Failed to start PoC", types.PoC, "node_id", worker.nodeId, "error", err)
 		result.Succeeded = false
@@ -71,7 +76,7 @@ func (c StartPoCNodeCommandV1) Execute(ctx context.Context, worker *NodeWorker)
 		result.Succeeded = true
 		result.FinalStatus = types.HardwareNodeStatus_POC
 		result.FinalPocStatus = PocStatusGenerating
-		logging.Info("[StartPoCNodeCommandV1] Successfully started PoC on node", types.PoC, "node_id", worker.nodeId)
+		logging.Info("[StartPoCNodeCommandV1] Successfully started PoC on node", types.PoC, "node_id", worker.nodeId, "batch_size", dto.BatchSize)
 	}
 	return result
 }
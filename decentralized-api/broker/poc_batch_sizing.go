@@ -0,0 +1,149 @@
+package broker
+
+import (
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// pocThroughputSmoothing is the weight of the latest PoC stage in the throughput carried over to later stages
+const pocThroughputSmoothing = 0.5
+
+// pocStageSample measures the nonce generation throughput and progress of a node during one PoC stage.
+// The nonces of the first batch are not counted in the throughput: when generation of that batch
+// started is unknown.
+type pocStageSample struct {
+	blockHeight int64
+	first       time.Time
+	last        time.Time
+	nonces      int
+
+	batches   int
+	generated int64
+	submitted int64
+	failed    int64
+}
+
+func (s *pocStageSample) rate() (float64, bool) {
+	elapsed := s.last.Sub(s.first).Seconds()
+	if s.nonces == 0 || elapsed <= 0 {
+		return 0, false
+	}
+	return float64(s.nonces) / elapsed, true
+}
+
+// pocThroughputTracker keeps the PoC generation throughput of each node, in nonces per second,
+// from the batches the nodes report while generating
+type pocThroughputTracker struct {
+	mu      sync.Mutex
+	samples map[string]*pocStageSample // by node id, the latest stage
+	rates   map[string]float64         // by node id, smoothed over finished stages
+}
+
+func newPocThroughputTracker() *pocThroughputTracker {
+	return &pocThroughputTracker{
+		samples: make(map[string]*pocStageSample),
+		rates:   make(map[string]float64),
+	}
+}
+
+func (t *pocThroughputTracker) record(nodeId string, blockHeight int64, nonces int, submitted bool, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sample := t.samples[nodeId]
+	if sample == nil || sample.blockHeight != blockHeight {
+		if sample != nil {
+			t.finishStage(nodeId, sample)
+		}
+		sample = &pocStageSample{blockHeight: blockHeight, first: at, last: at}
+		t.samples[nodeId] = sample
+	} else {
+		sample.nonces += nonces
+		if at.After(sample.last) {
+			sample.last = at
+		}
+	}
+
+	sample.batches++
+	sample.generated += int64(nonces)
+	if submitted {
+		sample.submitted += int64(nonces)
+	} else {
+		sample.failed += int64(nonces)
+	}
+}
+
+// finishStage folds the throughput measured over a stage into the node's smoothed rate.
+// Must be called with t.mu held.
+func (t *pocThroughputTracker) finishStage(nodeId string, sample *pocStageSample) {
+	rate, ok := sample.rate()
+	if !ok {
+		return
+	}
+	if previous, found := t.rates[nodeId]; found {
+		rate = pocThroughputSmoothing*rate + (1-pocThroughputSmoothing)*previous
+	}
+	t.rates[nodeId] = rate
+}
+
+// rate returns the node's throughput, measured in the current stage once it has two batches and
+// carried over from earlier stages until then
+func (t *pocThroughputTracker) rate(nodeId string) (float64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if sample := t.samples[nodeId]; sample != nil {
+		if rate, ok := sample.rate(); ok {
+			if previous, found := t.rates[nodeId]; found {
+				return pocThroughputSmoothing*rate + (1-pocThroughputSmoothing)*previous, true
+			}
+			return rate, true
+		}
+	}
+	rate, found := t.rates[nodeId]
+	return rate, found
+}
+
+func (t *pocThroughputTracker) removeNode(nodeId string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.samples, nodeId)
+	delete(t.rates, nodeId)
+}
+
+// pocBatchSizeFor returns the batch size that takes about the target latency at the given rate
+func pocBatchSizeFor(rate float64, cfg apiconfig.PocBatchSizingConfig) int {
+	size := int(math.Round(rate * float64(cfg.TargetLatencySeconds)))
+	return min(max(size, cfg.MinBatchSize), cfg.MaxBatchSize)
+}
+
+// RecordPoCGeneratedBatch feeds a batch of nonces a node reported during PoC generation into its
+// throughput measurement and progress. submitErr is the error of handing the batch on for submission.
+func (b *Broker) RecordPoCGeneratedBatch(nodeId string, blockHeight int64, nonces int, submitErr error) {
+	b.pocThroughput.record(nodeId, blockHeight, nonces, submitErr == nil, time.Now())
+}
+
+// pocBatchSize returns the PoC batch size for the node, or 0 to keep the ML node default when
+// adaptive sizing is disabled or the node has not been measured yet
+func (b *Broker) pocBatchSize(nodeId string) int {
+	if b.configManager == nil {
+		return 0
+	}
+	cfg := b.configManager.GetPocBatchSizingConfig()
+	if cfg.Disabled {
+		return 0
+	}
+	rate, ok := b.pocThroughput.rate(nodeId)
+	if !ok {
+		return 0
+	}
+	size := pocBatchSizeFor(rate, cfg)
+	logging.Debug("Sized PoC batches from node throughput", types.PoC, "node_id", nodeId,
+		"nonces_per_second", rate, "batch_size", size)
+	return size
+}
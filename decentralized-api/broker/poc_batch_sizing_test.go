@@ -0,0 +1,48 @@
+package broker
+
+import (
+	"testing"
+	"time"
+
+	"decentralized-api/apiconfig"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPocThroughputTracker(t *testing.T) {
+	tracker := newPocThroughputTracker()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, ok := tracker.rate("node1")
+	require.False(t, ok, "unmeasured node")
+
+	// the first batch of a stage only marks its start
+	tracker.record("node1", 100, 50, true, start)
+	_, ok = tracker.rate("node1")
+	require.False(t, ok)
+
+	tracker.record("node1", 100, 100, true, start.Add(5*time.Second))
+	tracker.record("node1", 100, 100, true, start.Add(10*time.Second))
+	rate, ok := tracker.rate("node1")
+	require.True(t, ok)
+	require.InDelta(t, 20, rate, 1e-9)
+
+	// the next stage starts from the previous rate and blends in its own measurement
+	tracker.record("node1", 200, 100, true, start.Add(time.Hour))
+	rate, _ = tracker.rate("node1")
+	require.InDelta(t, 20, rate, 1e-9)
+	tracker.record("node1", 200, 400, true, start.Add(time.Hour+10*time.Second))
+	rate, _ = tracker.rate("node1")
+	require.InDelta(t, 30, rate, 1e-9)
+
+	tracker.removeNode("node1")
+	_, ok = tracker.rate("node1")
+	require.False(t, ok)
+}
+
+func TestPocBatchSizeFor(t *testing.T) {
+	cfg := apiconfig.PocBatchSizingConfig{TargetLatencySeconds: 10, MinBatchSize: 10, MaxBatchSize: 5000}
+	require.Equal(t, 200, pocBatchSizeFor(20, cfg))
+	require.Equal(t, 10, pocBatchSizeFor(0.2, cfg), "slow nodes get the minimum")
+	require.Equal(t, 5000, pocBatchSizeFor(10000, cfg), "fast nodes are capped")
+}
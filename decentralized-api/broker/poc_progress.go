@@ -0,0 +1,180 @@
+package broker
+
+import (
+	"decentralized-api/chainphase"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// PoC progress statuses of a node
+const (
+	PocProgressGenerating = "generating"
+	PocProgressValidating = "validating"
+	PocProgressAborted    = "aborted"
+	PocProgressIdle       = "idle" // not taking part in the current PoC stage
+)
+
+var ErrNoPocStage = errors.New("no PoC stage in progress")
+
+// PocNodeProgress is the progress of a node in the current PoC stage. Submitted nonces were handed on for
+// submission: sent on chain with MsgSubmitPocBatch for PoC v1, stored for the store commits for PoC v2.
+type PocNodeProgress struct {
+	NodeId          string     `json:"node_id"`
+	Status          string     `json:"status"`
+	Batches         int        `json:"batches"`
+	NoncesGenerated int64      `json:"nonces_generated"`
+	NoncesSubmitted int64      `json:"nonces_submitted"`
+	NoncesFailed    int64      `json:"nonces_failed"`
+	NoncesPerSecond float64    `json:"nonces_per_second"`
+	LastBatchAt     *time.Time `json:"last_batch_at,omitempty"`
+}
+
+// PocProgress reports the progress of all nodes in the PoC stage starting at StageHeight
+type PocProgress struct {
+	StageHeight int64             `json:"poc_stage_height"`
+	Nodes       []PocNodeProgress `json:"nodes"`
+}
+
+// pocStageHeight returns the start height of the regular or confirmation PoC stage in progress, 0 when
+// the epoch state is unknown. Mirrors poc.GetCurrentPocStageHeight, which the broker cannot import.
+func pocStageHeight(epochState *chainphase.EpochState) int64 {
+	if epochState.IsNilOrNotSynced() {
+		return 0
+	}
+	if epochState.ActiveConfirmationPoCEvent != nil && epochState.CurrentPhase == types.InferencePhase {
+		return epochState.ActiveConfirmationPoCEvent.TriggerHeight
+	}
+	return epochState.LatestEpoch.PocStartBlockHeight
+}
+
+func (b *Broker) currentPocStageHeight() int64 {
+	if b.phaseTracker == nil {
+		return 0
+	}
+	return pocStageHeight(b.phaseTracker.GetCurrentEpochState())
+}
+
+// PocAbortedFor reports whether PoC was aborted on the node for the stage starting at stageHeight
+func (s *NodeState) PocAbortedFor(stageHeight int64) bool {
+	return stageHeight > 0 && s.PocAbortedStage == stageHeight
+}
+
+// pocExclusionReason returns why the node sits out the PoC stage, empty when it takes part
+func (s *NodeState) pocExclusionReason(stageHeight int64) string {
+	switch {
+	case s.InMaintenance():
+		return "maintenance window"
+	case s.PocAbortedFor(stageHeight):
+		return "PoC aborted by operator"
+	}
+	return ""
+}
+
+func (t *pocThroughputTracker) progress(nodeId string, stageHeight int64) (PocNodeProgress, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	sample := t.samples[nodeId]
+	if sample == nil || sample.blockHeight != stageHeight {
+		return PocNodeProgress{NodeId: nodeId}, false
+	}
+	lastBatchAt := sample.last
+	progress := PocNodeProgress{
+		NodeId:          nodeId,
+		Batches:         sample.batches,
+		NoncesGenerated: sample.generated,
+		NoncesSubmitted: sample.submitted,
+		NoncesFailed:    sample.failed,
+		LastBatchAt:     &lastBatchAt,
+	}
+	progress.NoncesPerSecond, _ = sample.rate()
+	return progress, true
+}
+
+// PocProgress returns the progress of the registered nodes in the current PoC stage, ordered by node id
+func (b *Broker) PocProgress() PocProgress {
+	stageHeight := b.currentPocStageHeight()
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	progress := PocProgress{StageHeight: stageHeight, Nodes: make([]PocNodeProgress, 0, len(b.nodes))}
+	for nodeId, node := range b.nodes {
+		nodeProgress, _ := b.pocThroughput.progress(nodeId, stageHeight)
+		switch {
+		case node.State.PocAbortedFor(stageHeight):
+			nodeProgress.Status = PocProgressAborted
+		case node.State.IntendedStatus == types.HardwareNodeStatus_POC && node.State.PocIntendedStatus == PocStatusGenerating:
+			nodeProgress.Status = PocProgressGenerating
+		case node.State.IntendedStatus == types.HardwareNodeStatus_POC && node.State.PocIntendedStatus == PocStatusValidating:
+			nodeProgress.Status = PocProgressValidating
+		default:
+			nodeProgress.Status = PocProgressIdle
+		}
+		progress.Nodes = append(progress.Nodes, nodeProgress)
+	}
+	slices.SortFunc(progress.Nodes, func(a, b PocNodeProgress) int { return strings.Compare(a.NodeId, b.NodeId) })
+	return progress
+}
+
+// AbortPoCCommand stops the PoC work of a node for the rest of the current PoC stage. The node goes
+// back to inference; the batches it already generated stay submitted.
+type AbortPoCCommand struct {
+	NodeId   string
+	Response chan error
+}
+
+func NewAbortPoCCommand(nodeId string) AbortPoCCommand {
+	return AbortPoCCommand{
+		NodeId:   nodeId,
+		Response: make(chan error, 2),
+	}
+}
+
+func (c AbortPoCCommand) GetResponseChannelCapacity() int {
+	return cap(c.Response)
+}
+
+func (c AbortPoCCommand) Execute(b *Broker) {
+	stageHeight := b.currentPocStageHeight()
+	if stageHeight == 0 {
+		c.Response <- ErrNoPocStage
+		return
+	}
+
+	b.mu.Lock()
+	node, exists := b.nodes[c.NodeId]
+	if !exists {
+		b.mu.Unlock()
+		c.Response <- fmt.Errorf("%w: %s", ErrNodeNotFound, c.NodeId)
+		return
+	}
+	if node.State.IntendedStatus != types.HardwareNodeStatus_POC {
+		b.mu.Unlock()
+		c.Response <- fmt.Errorf("%w: node %s is %s", ErrNoPocStage, c.NodeId, node.State.IntendedStatus)
+		return
+	}
+	node.State.PocAbortedStage = stageHeight
+	node.State.IntendedStatus = types.HardwareNodeStatus_INFERENCE
+	node.State.PocIntendedStatus = PocStatusIdle
+	b.mu.Unlock()
+
+	logging.Info("Aborted PoC on node", types.PoC, "node_id", c.NodeId, "poc_stage_height", stageHeight)
+	b.TriggerReconciliation()
+	c.Response <- nil
+}
+
+// AbortPoC stops the PoC work of the node for the rest of the current PoC stage
+func (b *Broker) AbortPoC(nodeId string) error {
+	command := NewAbortPoCCommand(nodeId)
+	if err := b.QueueMessage(command); err != nil {
+		return err
+	}
+	return <-command.Response
+}
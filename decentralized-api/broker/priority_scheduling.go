@@ -0,0 +1,90 @@
+package broker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"decentralized-api/apiconfig"
+)
+
+// errPreempted is the cancellation cause of a batch request preempted by a realtime one
+var errPreempted = errors.New("preempted by a realtime request")
+
+// batchLock is the node slot held by a batch-class request. A realtime request finding every node full
+// preempts it by cancelling the request, as long as the node has not started responding.
+type batchLock struct {
+	mu       sync.Mutex
+	released bool
+	cancel   context.CancelCauseFunc
+}
+
+func newBatchLock(cancel context.CancelCauseFunc) *batchLock {
+	return &batchLock{cancel: cancel}
+}
+
+// preempt cancels the batch request, false when the node already responded to it
+func (l *batchLock) preempt() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.released {
+		return false
+	}
+	l.cancel(errPreempted)
+	return true
+}
+
+// release marks the node as having responded, the request can no longer be preempted
+func (l *batchLock) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.released = true
+}
+
+func (b *Broker) prioritySchedulingConfig() apiconfig.PrioritySchedulingConfig {
+	if b.configManager == nil {
+		return apiconfig.PrioritySchedulingConfig{BatchSharePercent: 50}
+	}
+	return b.configManager.GetPrioritySchedulingConfig()
+}
+
+// batchSlots returns how many batch requests the node may serve at once, at least one
+func (b *Broker) batchSlots(node *NodeWithState) int {
+	return max(1, node.Node.MaxConcurrent*b.prioritySchedulingConfig().BatchSharePercent/100)
+}
+
+// addBatchLock must be called with b.mu held for writing
+func (b *Broker) addBatchLock(nodeId string, lock *batchLock) {
+	if b.batchLocks == nil {
+		b.batchLocks = make(map[string][]*batchLock)
+	}
+	b.batchLocks[nodeId] = append(b.batchLocks[nodeId], lock)
+}
+
+// removeBatchLock must be called with b.mu held for writing
+func (b *Broker) removeBatchLock(nodeId string, lock *batchLock) {
+	locks := b.batchLocks[nodeId]
+	for i, held := range locks {
+		if held == lock {
+			locks = append(locks[:i], locks[i+1:]...)
+			break
+		}
+	}
+	if len(locks) == 0 {
+		delete(b.batchLocks, nodeId)
+	} else {
+		b.batchLocks[nodeId] = locks
+	}
+}
+
+// cancelOnCloseBody ends the context of a request once its response body is closed
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelCauseFunc
+}
+
+func (c cancelOnCloseBody) Close() error {
+	defer c.cancel(nil)
+	return c.ReadCloser.Close()
+}
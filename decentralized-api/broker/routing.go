@@ -0,0 +1,143 @@
+package broker
+
+import (
+	"decentralized-api/logging"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RoutingStrategy decides which of the available nodes serving a model receives an inference request
+type RoutingStrategy string
+
+const (
+	// RoutingLeastLoaded picks the node with the fewest in-flight requests, lower p95 latency on ties
+	RoutingLeastLoaded RoutingStrategy = "least-loaded"
+	// RoutingRoundRobin cycles through the nodes serving the model
+	RoutingRoundRobin RoutingStrategy = "round-robin"
+	// RoutingLatencyWeighted picks the node with the lowest expected wait, (in-flight + 1) * p95 latency.
+	// Nodes without latency samples yet are preferred so they get measured.
+	RoutingLatencyWeighted RoutingStrategy = "latency-weighted"
+)
+
+func parseRoutingStrategy(value string) RoutingStrategy {
+	switch strategy := RoutingStrategy(strings.ToLower(value)); strategy {
+	case RoutingLeastLoaded, RoutingRoundRobin, RoutingLatencyWeighted:
+		return strategy
+	default:
+		logging.Warn("Unknown routing strategy, using least-loaded", types.Nodes, "strategy", value)
+		return RoutingLeastLoaded
+	}
+}
+
+// latencyWindow keeps the durations of the most recent successful requests of a node
+type latencyWindow struct {
+	samples    []time.Duration
+	next       int
+	percentile time.Duration // p95 of samples, recomputed on add
+}
+
+func (w *latencyWindow) add(d time.Duration, size int) {
+	if len(w.samples) > size {
+		// window was shrunk by a config change, start over
+		w.samples = nil
+		w.next = 0
+	}
+	if len(w.samples) < size {
+		w.samples = append(w.samples, d)
+	} else {
+		w.samples[w.next] = d
+		w.next = (w.next + 1) % size
+	}
+
+	sorted := slices.Clone(w.samples)
+	slices.Sort(sorted)
+	w.percentile = sorted[(len(sorted)*95+99)/100-1]
+}
+
+func (w *latencyWindow) p95() time.Duration {
+	return w.percentile
+}
+
+// nodeRouter holds the routing state of the broker. It is only used from the broker's command loop,
+// so it needs no locking.
+type nodeRouter struct {
+	latencies  map[string]*latencyWindow
+	roundRobin map[string]uint64 // next position per model
+	outcomes   map[string]*outcomeWindow
+}
+
+func newNodeRouter() *nodeRouter {
+	return &nodeRouter{
+		latencies:  make(map[string]*latencyWindow),
+		roundRobin: make(map[string]uint64),
+		outcomes:   make(map[string]*outcomeWindow),
+	}
+}
+
+// recordLatency adds a sample for the node and returns its updated p95 latency
+func (r *nodeRouter) recordLatency(nodeId string, d time.Duration, windowSize int) time.Duration {
+	window, ok := r.latencies[nodeId]
+	if !ok {
+		window = &latencyWindow{}
+		r.latencies[nodeId] = window
+	}
+	window.add(d, windowSize)
+	return window.p95()
+}
+
+func (r *nodeRouter) p95(nodeId string) time.Duration {
+	if window, ok := r.latencies[nodeId]; ok {
+		return window.p95()
+	}
+	return 0
+}
+
+func (r *nodeRouter) removeNode(nodeId string) {
+	delete(r.latencies, nodeId)
+	delete(r.outcomes, nodeId)
+}
+
+// pick selects one of the available candidates for the model
+func (r *nodeRouter) pick(strategy RoutingStrategy, model string, candidates []*NodeWithState) *NodeWithState {
+	if len(candidates) == 0 {
+		return nil
+	}
+	// map iteration order is random, sort for stable round-robin positions and tie breaks
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Node.Id < candidates[j].Node.Id
+	})
+
+	switch strategy {
+	case RoutingRoundRobin:
+		position := r.roundRobin[model]
+		r.roundRobin[model] = position + 1
+		return candidates[position%uint64(len(candidates))]
+	case RoutingLatencyWeighted:
+		best := candidates[0]
+		bestScore := r.expectedWait(best)
+		for _, node := range candidates[1:] {
+			score := r.expectedWait(node)
+			if score < bestScore || (score == bestScore && node.State.LockCount < best.State.LockCount) {
+				best, bestScore = node, score
+			}
+		}
+		return best
+	default:
+		best := candidates[0]
+		for _, node := range candidates[1:] {
+			if node.State.LockCount < best.State.LockCount ||
+				(node.State.LockCount == best.State.LockCount && r.p95(node.Node.Id) < r.p95(best.Node.Id)) {
+				best = node
+			}
+		}
+		return best
+	}
+}
+
+func (r *nodeRouter) expectedWait(node *NodeWithState) time.Duration {
+	return time.Duration(node.State.LockCount+1) * r.p95(node.Node.Id)
+}
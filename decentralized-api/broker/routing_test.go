@@ -0,0 +1,80 @@
+package broker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func routingCandidates(lockCounts map[string]int) []*NodeWithState {
+	var nodes []*NodeWithState
+	for id, count := range lockCounts {
+		nodes = append(nodes, &NodeWithState{Node: Node{Id: id}, State: NodeState{LockCount: count}})
+	}
+	return nodes
+}
+
+func TestLatencyWindow_P95(t *testing.T) {
+	window := &latencyWindow{}
+	for i := 1; i <= 100; i++ {
+		window.add(time.Duration(i)*time.Millisecond, 100)
+	}
+	require.Equal(t, 95*time.Millisecond, window.p95())
+
+	// oldest samples are overwritten once the window is full
+	for i := 0; i < 100; i++ {
+		window.add(time.Millisecond, 100)
+	}
+	require.Equal(t, time.Millisecond, window.p95())
+	require.Len(t, window.samples, 100)
+}
+
+func TestNodeRouter_LeastLoaded(t *testing.T) {
+	router := newNodeRouter()
+	picked := router.pick(RoutingLeastLoaded, "model1", routingCandidates(map[string]int{"a": 2, "b": 0, "c": 1}))
+	require.Equal(t, "b", picked.Node.Id)
+
+	// ties are broken by latency
+	router.recordLatency("a", 500*time.Millisecond, 10)
+	router.recordLatency("b", 100*time.Millisecond, 10)
+	picked = router.pick(RoutingLeastLoaded, "model1", routingCandidates(map[string]int{"a": 1, "b": 1}))
+	require.Equal(t, "b", picked.Node.Id)
+}
+
+func TestNodeRouter_RoundRobin(t *testing.T) {
+	router := newNodeRouter()
+	var picked []string
+	for i := 0; i < 4; i++ {
+		node := router.pick(RoutingRoundRobin, "model1", routingCandidates(map[string]int{"a": 0, "b": 5, "c": 0}))
+		picked = append(picked, node.Node.Id)
+	}
+	require.Equal(t, []string{"a", "b", "c", "a"}, picked)
+
+	// positions are tracked per model
+	node := router.pick(RoutingRoundRobin, "model2", routingCandidates(map[string]int{"a": 0, "b": 0}))
+	require.Equal(t, "a", node.Node.Id)
+}
+
+func TestNodeRouter_LatencyWeighted(t *testing.T) {
+	router := newNodeRouter()
+	router.recordLatency("fast", 100*time.Millisecond, 10)
+	router.recordLatency("slow", time.Second, 10)
+
+	// (2+1) * 100ms < (0+1) * 1s
+	picked := router.pick(RoutingLatencyWeighted, "model1", routingCandidates(map[string]int{"fast": 2, "slow": 0}))
+	require.Equal(t, "fast", picked.Node.Id)
+
+	// (10+1) * 100ms > (0+1) * 1s
+	picked = router.pick(RoutingLatencyWeighted, "model1", routingCandidates(map[string]int{"fast": 10, "slow": 0}))
+	require.Equal(t, "slow", picked.Node.Id)
+
+	// unmeasured nodes are tried first
+	picked = router.pick(RoutingLatencyWeighted, "model1", routingCandidates(map[string]int{"fast": 0, "new": 3}))
+	require.Equal(t, "new", picked.Node.Id)
+}
+
+func TestParseRoutingStrategy(t *testing.T) {
+	require.Equal(t, RoutingRoundRobin, parseRoutingStrategy("Round-Robin"))
+	require.Equal(t, RoutingLeastLoaded, parseRoutingStrategy("random"))
+}
@@ -65,6 +65,7 @@ func (c StartPocCommand) Execute(b *Broker) {
 		return
 	}
 
+	stageHeight := pocStageHeight(epochState)
 	b.mu.Lock()
 	for _, node := range b.nodes {
 		// Check if node should be operational based on admin state
@@ -76,6 +77,12 @@ func (c StartPocCommand) Execute(b *Broker) {
 				"current_epoch", epochState,
 				"current_phase", epochState.CurrentPhase)
 			node.State.IntendedStatus = types.HardwareNodeStatus_INFERENCE
+		} else if reason := node.State.pocExclusionReason(stageHeight); reason != "" {
+			logging.Info("Skipping PoC for node. Defaulting to INFERENCE state", types.PoC,
+				"node_id", node.Node.Id,
+				"reason", reason,
+				"current_phase", epochState.CurrentPhase)
+			node.State.IntendedStatus = types.HardwareNodeStatus_INFERENCE
 		} else if node.State.ShouldContinueInference() {
 			// Node should continue inference service based on POC_SLOT allocation
 			// TODO: change logs to debug
@@ -95,6 +102,7 @@ func (c StartPocCommand) Execute(b *Broker) {
 }
 
 func (c StartPocCommand) shouldMutateState(b *Broker, epochState *chainphase.EpochState) bool {
+	stageHeight := pocStageHeight(epochState)
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
@@ -105,6 +113,14 @@ func (c StartPocCommand) shouldMutateState(b *Broker, epochState *chainphase.Epo
 			return true
 		}
 
+		// Nodes in a maintenance window or with PoC aborted stay out of PoC
+		if node.State.pocExclusionReason(stageHeight) != "" {
+			if node.State.IntendedStatus != types.HardwareNodeStatus_INFERENCE {
+				return true
+			}
+			continue
+		}
+
 		// Check if node should continue inference based on POC_SLOT
 		if node.State.ShouldContinueInference() {
 			logging.Info("[StartPocCommand] Node should continue inference", types.PoC, "node_id", node.Node.Id)
@@ -172,6 +188,7 @@ func (c InitValidateCommand) Execute(b *Broker) {
 		return
 	}
 
+	stageHeight := pocStageHeight(epochState)
 	b.mu.Lock()
 	for _, node := range b.nodes {
 		// Check if node should be operational based on admin state
@@ -183,6 +200,12 @@ func (c InitValidateCommand) Execute(b *Broker) {
 				"current_epoch", epochState,
 				"current_phase", epochState.CurrentPhase)
 			node.State.IntendedStatus = types.HardwareNodeStatus_INFERENCE
+		} else if reason := node.State.pocExclusionReason(stageHeight); reason != "" {
+			logging.Info("Skipping PoC for node. Defaulting to INFERENCE state", types.PoC,
+				"node_id", node.Node.Id,
+				"reason", reason,
+				"current_phase", epochState.CurrentPhase)
+			node.State.IntendedStatus = types.HardwareNodeStatus_INFERENCE
 		} else if node.State.ShouldContinueInference() {
 			// Node should continue inference service based on POC_SLOT allocation
 			logging.Info("Keeping node in inference service mode due to POC_SLOT allocation", types.PoC,
@@ -201,6 +224,7 @@ func (c InitValidateCommand) Execute(b *Broker) {
 }
 
 func (c InitValidateCommand) shouldMutateState(b *Broker, epochState *chainphase.EpochState) bool {
+	stageHeight := pocStageHeight(epochState)
 	b.mu.RLock()
 	defer b.mu.RUnlock()
 
@@ -210,6 +234,14 @@ func (c InitValidateCommand) shouldMutateState(b *Broker, epochState *chainphase
 			return true
 		}
 
+		// Nodes in a maintenance window or with PoC aborted stay out of PoC
+		if node.State.pocExclusionReason(stageHeight) != "" {
+			if node.State.IntendedStatus != types.HardwareNodeStatus_INFERENCE {
+				return true
+			}
+			continue
+		}
+
 		// Check if node should continue inference based on POC_SLOT
 		if node.State.ShouldContinueInference() {
 			logging.Info("[InitValidateCommand] Node should continue inference", types.PoC, "node_id", node.Node.Id)
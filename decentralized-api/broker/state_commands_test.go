@@ -2,6 +2,7 @@ package broker
 
 import (
 	"decentralized-api/chainphase"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -324,3 +325,54 @@ func TestInitValidateCommand_InferencePhase_NoConfirmationEvent(t *testing.T) {
 	// Node status should remain unchanged (UNKNOWN = 0)
 	assert.Equal(t, types.HardwareNodeStatus_UNKNOWN, node1.State.IntendedStatus)
 }
+
+func TestAbortPoCCommand(t *testing.T) {
+	node1 := createTestNode("node-1")
+	node2 := createTestNode("node-2")
+
+	tracker := newPhaseTrackerWithPhase(t, types.PoCGeneratePhase)
+	broker := &Broker{
+		nodes: map[string]*NodeWithState{
+			"node-1": node1,
+			"node-2": node2,
+		},
+		phaseTracker:  tracker,
+		pocThroughput: newPocThroughputTracker(),
+	}
+
+	startPoc := StartPocCommand{Response: make(chan bool, 1)}
+	startPoc.Execute(broker)
+	require.True(t, <-startPoc.Response)
+
+	abort := NewAbortPoCCommand("node-1")
+	abort.Execute(broker)
+	require.NoError(t, <-abort.Response)
+	require.Equal(t, types.HardwareNodeStatus_INFERENCE, node1.State.IntendedStatus)
+	require.True(t, node1.State.PocAbortedFor(100))
+
+	// the aborted node sits out the rest of the stage
+	startPoc = StartPocCommand{Response: make(chan bool, 1)}
+	startPoc.Execute(broker)
+	require.Equal(t, types.HardwareNodeStatus_INFERENCE, node1.State.IntendedStatus)
+	require.Equal(t, types.HardwareNodeStatus_POC, node2.State.IntendedStatus)
+
+	abort = NewAbortPoCCommand("node-1")
+	abort.Execute(broker)
+	require.ErrorIs(t, <-abort.Response, ErrNoPocStage)
+	abort = NewAbortPoCCommand("unknown")
+	abort.Execute(broker)
+	require.ErrorIs(t, <-abort.Response, ErrNodeNotFound)
+
+	broker.RecordPoCGeneratedBatch("node-2", 100, 10, nil)
+	broker.RecordPoCGeneratedBatch("node-2", 100, 20, errors.New("submit failed"))
+	progress := broker.PocProgress()
+	require.Equal(t, int64(100), progress.StageHeight)
+	require.Len(t, progress.Nodes, 2)
+	require.Equal(t, PocProgressAborted, progress.Nodes[0].Status)
+	require.Zero(t, progress.Nodes[0].NoncesGenerated)
+	require.Equal(t, PocProgressGenerating, progress.Nodes[1].Status)
+	require.Equal(t, 2, progress.Nodes[1].Batches)
+	require.Equal(t, int64(30), progress.Nodes[1].NoncesGenerated)
+	require.Equal(t, int64(10), progress.Nodes[1].NoncesSubmitted)
+	require.Equal(t, int64(20), progress.Nodes[1].NoncesFailed)
+}
@@ -0,0 +1,261 @@
+package broker
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"encoding/json"
+	"maps"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// persistedNodeState is the part of NodeState that survives API restarts
+type persistedNodeState struct {
+	IntendedStatus    types.HardwareNodeStatus `json:"intended_status"`
+	CurrentStatus     types.HardwareNodeStatus `json:"current_status"`
+	PocIntendedStatus PocStatus                `json:"poc_intended_status"`
+	PocCurrentStatus  PocStatus                `json:"poc_current_status"`
+	FailureReason     string                   `json:"failure_reason"`
+	AdminState        AdminState               `json:"admin_state"`
+	DrainStatus       DrainStatus              `json:"drain_status,omitempty"`
+	// MaintenanceDrain is set when the drain was started by a maintenance window, which undrains the node when it ends
+	MaintenanceDrain bool `json:"maintenance_drain,omitempty"`
+	// EpochIndex is the epoch the state was saved in; intended statuses are only restored within it
+	EpochIndex uint64 `json:"epoch_index"`
+}
+
+func newPersistedNodeState(state NodeState, epochIndex uint64) persistedNodeState {
+	return persistedNodeState{
+		IntendedStatus:    state.IntendedStatus,
+		CurrentStatus:     state.CurrentStatus,
+		PocIntendedStatus: state.PocIntendedStatus,
+		PocCurrentStatus:  state.PocCurrentStatus,
+		FailureReason:     state.FailureReason,
+		AdminState:        state.AdminState,
+		DrainStatus:       state.DrainStatus,
+		MaintenanceDrain:  state.Maintenance != nil && state.Maintenance.OwnsDrain,
+		EpochIndex:        epochIndex,
+	}
+}
+
+// nodeStateStore persists broker node runtime state so an API restart mid-epoch keeps node assignments
+type nodeStateStore interface {
+	Save(ctx context.Context, nodeId string, state persistedNodeState) error
+	Delete(ctx context.Context, nodeId string) error
+	LoadAll(ctx context.Context) (map[string]persistedNodeState, error)
+}
+
+// sqlNodeStateStore stores node state in the node_runtime_state table created by apiconfig.EnsureSchema
+type sqlNodeStateStore struct {
+	db *sql.DB
+}
+
+func newSqlNodeStateStore(db *sql.DB) *sqlNodeStateStore {
+	return &sqlNodeStateStore{db: db}
+}
+
+func (s *sqlNodeStateStore) Save(ctx context.Context, nodeId string, state persistedNodeState) error {
+	stateJson, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, `
+INSERT INTO node_runtime_state (node_id, state_json, updated_at)
+VALUES (?, ?, STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+ON CONFLICT(node_id) DO UPDATE SET
+  state_json = excluded.state_json,
+  updated_at = excluded.updated_at`,
+		nodeId, string(stateJson))
+	return err
+}
+
+func (s *sqlNodeStateStore) Delete(ctx context.Context, nodeId string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM node_runtime_state WHERE node_id = ?`, nodeId)
+	return err
+}
+
+func (s *sqlNodeStateStore) LoadAll(ctx context.Context) (map[string]persistedNodeState, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT node_id, state_json FROM node_runtime_state`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states := make(map[string]persistedNodeState)
+	for rows.Next() {
+		var (
+			nodeId    string
+			stateJson string
+			state     persistedNodeState
+		)
+		if err := rows.Scan(&nodeId, &stateJson); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal([]byte(stateJson), &state); err != nil {
+			return nil, err
+		}
+		states[nodeId] = state
+	}
+	return states, rows.Err()
+}
+
+// persistDebounce limits how often node state is written; commands arrive in bursts during phase changes
+const persistDebounce = time.Second
+
+// EnableStatePersistence loads node state saved by a previous run and keeps saving it on changes.
+// Must be called before nodes are registered so the restored state can be applied to them.
+func (b *Broker) EnableStatePersistence(ctx context.Context, db *sql.DB) error {
+	store := newSqlNodeStateStore(db)
+	restored, err := store.LoadAll(ctx)
+	if err != nil {
+		return err
+	}
+	logging.Info("Loaded persisted node state", types.Nodes, "nodes", len(restored))
+
+	maintenanceStore := &sqlMaintenanceWindowStore{db: db}
+	windows, err := maintenanceStore.LoadAll(ctx)
+	if err != nil {
+		return err
+	}
+	logging.Info("Loaded maintenance windows", types.Nodes, "windows", len(windows))
+
+	b.mu.Lock()
+	b.stateStore = store
+	b.restoredStates = restored
+	b.maintenanceStore = maintenanceStore
+	b.maintenanceWindows = windows
+	b.mu.Unlock()
+
+	go b.statePersistenceWorker(ctx, maps.Clone(restored))
+	return nil
+}
+
+func (b *Broker) triggerStatePersistence() {
+	select {
+	case b.persistTrigger <- struct{}{}:
+	default: // Non-blocking send
+	}
+}
+
+func (b *Broker) statePersistenceWorker(ctx context.Context, saved map[string]persistedNodeState) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.persistTrigger:
+		}
+		b.persistNodeStates(ctx, saved)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(persistDebounce):
+		}
+	}
+}
+
+// persistNodeStates writes the nodes whose state differs from saved and removes rows of deleted nodes.
+// saved is updated in place to what is now in the store.
+func (b *Broker) persistNodeStates(ctx context.Context, saved map[string]persistedNodeState) {
+	var epochIndex uint64
+	if b.phaseTracker != nil {
+		if epochState := b.phaseTracker.GetCurrentEpochState(); epochState != nil {
+			epochIndex = epochState.LatestEpoch.EpochIndex
+		}
+	}
+
+	b.mu.RLock()
+	store := b.stateStore
+	current := make(map[string]persistedNodeState, len(b.nodes))
+	for id, node := range b.nodes {
+		current[id] = newPersistedNodeState(node.State, epochIndex)
+	}
+	// nodes from the previous run that are not registered yet must keep their rows
+	for id := range b.restoredStates {
+		if _, ok := current[id]; !ok {
+			current[id] = saved[id]
+		}
+	}
+	b.mu.RUnlock()
+
+	if store == nil {
+		return
+	}
+
+	for id, state := range current {
+		if prev, ok := saved[id]; ok && prev == state {
+			continue
+		}
+		if err := store.Save(ctx, id, state); err != nil {
+			logging.Error("Failed to persist node state", types.Nodes, "node_id", id, "error", err)
+			continue
+		}
+		saved[id] = state
+	}
+	for id := range saved {
+		if _, ok := current[id]; ok {
+			continue
+		}
+		if err := store.Delete(ctx, id); err != nil {
+			logging.Error("Failed to delete persisted node state", types.Nodes, "node_id", id, "error", err)
+			continue
+		}
+		delete(saved, id)
+	}
+}
+
+// restoreEpochIndependentState applies the saved admin, failure and drain state to a node being registered.
+// Must be called with b.mu held.
+func (b *Broker) restoreEpochIndependentState(nodeId string, state *NodeState) {
+	restored, ok := b.restoredStates[nodeId]
+	if !ok {
+		return
+	}
+	state.AdminState = restored.AdminState
+	state.FailureReason = restored.FailureReason
+	if restored.DrainStatus != DrainStatusNone {
+		// in-flight requests of the previous run are gone
+		state.DrainStatus = DrainStatusDrained
+		state.drained = make(chan struct{})
+		close(state.drained)
+		if restored.MaintenanceDrain {
+			// the maintenance check picks up the window again, or undrains the node if it is over
+			state.Maintenance = &MaintenanceStatus{OwnsDrain: true}
+		}
+	}
+	logging.Info("Restored persisted node state", types.Nodes, "node_id", nodeId,
+		"admin_enabled", restored.AdminState.Enabled, "drain_status", state.DrainStatus)
+}
+
+// applyRestoredStates restores the intended statuses saved in the current epoch, once the epoch is known.
+// Current statuses are not restored: the status query started at registration reads them from the ML nodes
+// and the reconciler converges them to the intended ones.
+func (b *Broker) applyRestoredStates(epochIndex uint64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.restoredStates == nil {
+		return
+	}
+
+	for id, restored := range b.restoredStates {
+		node, ok := b.nodes[id]
+		if !ok {
+			continue
+		}
+		if restored.EpochIndex != epochIndex {
+			logging.Info("Persisted node state is from another epoch, not restoring intended status", types.Nodes,
+				"node_id", id, "saved_epoch", restored.EpochIndex, "current_epoch", epochIndex)
+			continue
+		}
+		if node.State.IntendedStatus != types.HardwareNodeStatus_UNKNOWN {
+			continue
+		}
+		node.State.IntendedStatus = restored.IntendedStatus
+		node.State.PocIntendedStatus = restored.PocIntendedStatus
+		logging.Info("Restored persisted intended status", types.Nodes, "node_id", id,
+			"intended_status", restored.IntendedStatus, "poc_intended_status", restored.PocIntendedStatus)
+	}
+	b.restoredStates = nil
+}
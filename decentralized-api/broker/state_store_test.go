@@ -0,0 +1,118 @@
+package broker
+
+import (
+	"decentralized-api/apiconfig"
+	"path/filepath"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestNodeStateDb(t *testing.T) *sqlNodeStateStore {
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(t.Context(), db))
+	return newSqlNodeStateStore(db)
+}
+
+func TestSqlNodeStateStore(t *testing.T) {
+	store := newTestNodeStateDb(t)
+	state := persistedNodeState{
+		IntendedStatus:    types.HardwareNodeStatus_INFERENCE,
+		CurrentStatus:     types.HardwareNodeStatus_INFERENCE,
+		PocIntendedStatus: PocStatusIdle,
+		PocCurrentStatus:  PocStatusIdle,
+		AdminState:        AdminState{Enabled: false, Epoch: 7},
+		DrainStatus:       DrainStatusDraining,
+		EpochIndex:        7,
+	}
+	require.NoError(t, store.Save(t.Context(), "node1", state))
+	state.FailureReason = "oom"
+	require.NoError(t, store.Save(t.Context(), "node1", state))
+	require.NoError(t, store.Save(t.Context(), "node2", persistedNodeState{}))
+	require.NoError(t, store.Delete(t.Context(), "node2"))
+
+	loaded, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, map[string]persistedNodeState{"node1": state}, loaded)
+}
+
+func TestRestorePersistedNodeState(t *testing.T) {
+	store := newTestNodeStateDb(t)
+	require.NoError(t, store.Save(t.Context(), "node1", persistedNodeState{
+		IntendedStatus:    types.HardwareNodeStatus_INFERENCE,
+		CurrentStatus:     types.HardwareNodeStatus_INFERENCE,
+		PocIntendedStatus: PocStatusIdle,
+		PocCurrentStatus:  PocStatusIdle,
+		AdminState:        AdminState{Enabled: false, Epoch: 7},
+		DrainStatus:       DrainStatusDraining,
+		EpochIndex:        7,
+	}))
+	require.NoError(t, store.Save(t.Context(), "removed-node", persistedNodeState{EpochIndex: 7}))
+
+	broker := NewTestBroker()
+	require.NoError(t, broker.EnableStatePersistence(t.Context(), store.db))
+	register := NewRegisterNodeCommand(apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	})
+	queueMessage(t, broker, register)
+	require.NoError(t, (<-register.Response).Error)
+
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Len(t, nodes, 1)
+	require.Equal(t, AdminState{Enabled: false, Epoch: 7}, nodes[0].State.AdminState)
+	require.Equal(t, DrainStatusDrained, nodes[0].State.DrainStatus, "in-flight requests did not survive the restart")
+	require.Equal(t, types.HardwareNodeStatus_UNKNOWN, nodes[0].State.IntendedStatus, "intended status waits for the epoch")
+
+	broker.applyRestoredStates(7)
+	nodes, err = broker.GetNodes()
+	require.NoError(t, err)
+	require.Equal(t, types.HardwareNodeStatus_INFERENCE, nodes[0].State.IntendedStatus)
+	require.NotEqual(t, types.HardwareNodeStatus_INFERENCE, nodes[0].State.CurrentStatus, "current status comes from the ML node")
+
+	// once restored, rows of nodes that were not registered again are dropped
+	saved, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	broker.persistNodeStates(t.Context(), saved)
+	saved, err = store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.Len(t, saved, 1)
+	require.Equal(t, types.HardwareNodeStatus_INFERENCE, saved["node1"].IntendedStatus)
+	require.Equal(t, DrainStatusDrained, saved["node1"].DrainStatus)
+}
+
+func TestApplyRestoredStatesSkipsOtherEpoch(t *testing.T) {
+	store := newTestNodeStateDb(t)
+	require.NoError(t, store.Save(t.Context(), "node1", persistedNodeState{
+		IntendedStatus: types.HardwareNodeStatus_INFERENCE,
+		AdminState:     AdminState{Enabled: true, Epoch: 6},
+		EpochIndex:     6,
+	}))
+
+	broker := NewTestBroker()
+	require.NoError(t, broker.EnableStatePersistence(t.Context(), store.db))
+	register := NewRegisterNodeCommand(apiconfig.InferenceNodeConfig{
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       5000,
+		Models:        map[string]apiconfig.ModelConfig{"model1": {Args: make([]string, 0)}},
+		Id:            "node1",
+		MaxConcurrent: 2,
+	})
+	queueMessage(t, broker, register)
+	require.NoError(t, (<-register.Response).Error)
+
+	broker.applyRestoredStates(7)
+	nodes, err := broker.GetNodes()
+	require.NoError(t, err)
+	require.Equal(t, types.HardwareNodeStatus_UNKNOWN, nodes[0].State.IntendedStatus)
+	require.Equal(t, AdminState{Enabled: true, Epoch: 6}, nodes[0].State.AdminState)
+}
@@ -11,15 +11,17 @@ type StartTrainingCommand struct {
 	masterNodeAddress string
 	worldSize         int
 	nodeRanks         map[string]int // Key is nodeId
+	resumeFrom        string         // Checkpoint artifact to resume from, empty for a fresh start
 	Response          chan bool
 }
 
-func NewStartTrainingCommand(taskId uint64, masterNodeAddress string, worldSize int, nodeRanks map[string]int) StartTrainingCommand {
+func NewStartTrainingCommand(taskId uint64, masterNodeAddress string, worldSize int, nodeRanks map[string]int, resumeFrom string) StartTrainingCommand {
 	return StartTrainingCommand{
 		taskId:            taskId,
 		masterNodeAddress: masterNodeAddress,
 		worldSize:         worldSize,
 		nodeRanks:         nodeRanks,
+		resumeFrom:        resumeFrom,
 		Response:          make(chan bool, 2),
 	}
 }
@@ -70,6 +72,7 @@ func (c StartTrainingCommand) Execute(broker *Broker) {
 			MasterNodeAddr: c.masterNodeAddress,
 			NodeRanks:      c.nodeRanks,
 			WorldSize:      c.worldSize,
+			ResumeFrom:     c.resumeFrom,
 		}
 	}
 
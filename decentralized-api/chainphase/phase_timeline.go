@@ -0,0 +1,117 @@
+package chainphase
+
+import (
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// blockTimeWindow is how many recent blocks the average block time is taken over
+const blockTimeWindow = 100
+
+type blockTime struct {
+	height int64
+	at     time.Time
+}
+
+// PhaseBoundary is a predicted epoch phase change or stage at an absolute block height.
+// Phase is the phase entered at Height, empty for stages that do not change the phase.
+type PhaseBoundary struct {
+	EpochIndex      uint64           `json:"epoch_index"`
+	Stage           string           `json:"stage"`
+	Phase           types.EpochPhase `json:"phase,omitempty"`
+	Height          int64            `json:"height"`
+	BlocksRemaining int64            `json:"blocks_remaining"`
+	Eta             *time.Time       `json:"eta,omitempty"`
+}
+
+// PhaseTimeline predicts the upcoming phase boundaries of the current and next epoch.
+// ETAs are extrapolated from the average block time and are missing until enough synced blocks were seen.
+type PhaseTimeline struct {
+	CurrentBlock       int64            `json:"current_block"`
+	CurrentPhase       types.EpochPhase `json:"current_phase"`
+	AverageBlockTimeMs int64            `json:"average_block_time_ms"`
+	Boundaries         []PhaseBoundary  `json:"boundaries"`
+}
+
+// recordBlockTime keeps the arrival time of a new block. Blocks seen while catching up arrive in bursts,
+// so they reset the window instead of skewing the average.
+func (t *ChainPhaseTracker) recordBlockTime(height int64, at time.Time, isSynced bool) {
+	if !isSynced {
+		t.blockTimes = nil
+		return
+	}
+	if n := len(t.blockTimes); n > 0 && height <= t.blockTimes[n-1].height {
+		return
+	}
+	t.blockTimes = append(t.blockTimes, blockTime{height: height, at: at})
+	if len(t.blockTimes) > blockTimeWindow {
+		t.blockTimes = t.blockTimes[len(t.blockTimes)-blockTimeWindow:]
+	}
+}
+
+// averageBlockTime returns 0 until at least two blocks were recorded
+func (t *ChainPhaseTracker) averageBlockTime() time.Duration {
+	if len(t.blockTimes) < 2 {
+		return 0
+	}
+	first, last := t.blockTimes[0], t.blockTimes[len(t.blockTimes)-1]
+	return last.at.Sub(first.at) / time.Duration(last.height-first.height)
+}
+
+// GetPhaseTimeline predicts the block heights and wall-clock times of all upcoming phase boundaries
+// of the current and next epoch. Returns nil until the first epoch was cached.
+func (t *ChainPhaseTracker) GetPhaseTimeline() *PhaseTimeline {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if t.latestEpoch == nil || t.currentEpochParams == nil {
+		return nil
+	}
+
+	ec := types.NewEpochContext(*t.latestEpoch, *t.currentEpochParams)
+	lastBlockAt := time.Now()
+	if n := len(t.blockTimes); n > 0 && t.blockTimes[n-1].height == t.currentBlock.Height {
+		lastBlockAt = t.blockTimes[n-1].at
+	}
+	return predictPhaseTimeline(ec, t.currentBlock.Height, t.averageBlockTime(), lastBlockAt)
+}
+
+func predictPhaseTimeline(ec types.EpochContext, currentHeight int64, avgBlockTime time.Duration, lastBlockAt time.Time) *PhaseTimeline {
+	timeline := &PhaseTimeline{
+		CurrentBlock:       currentHeight,
+		CurrentPhase:       ec.GetCurrentPhase(currentHeight),
+		AverageBlockTimeMs: avgBlockTime.Milliseconds(),
+		Boundaries:         []PhaseBoundary{},
+	}
+
+	nextEc := ec.NextEpochContext()
+	for _, epochContext := range []*types.EpochContext{&ec, &nextEc} {
+		for _, boundary := range epochBoundaries(epochContext) {
+			// Epoch 0 runs no PoC and reports all its boundaries at height 0
+			if boundary.Height <= currentHeight {
+				continue
+			}
+			boundary.BlocksRemaining = boundary.Height - currentHeight
+			if avgBlockTime > 0 {
+				eta := lastBlockAt.Add(time.Duration(boundary.BlocksRemaining) * avgBlockTime)
+				boundary.Eta = &eta
+			}
+			timeline.Boundaries = append(timeline.Boundaries, boundary)
+		}
+	}
+	return timeline
+}
+
+// epochBoundaries lists the boundaries of an epoch in block order, named after the EpochStages fields
+func epochBoundaries(ec *types.EpochContext) []PhaseBoundary {
+	return []PhaseBoundary{
+		{EpochIndex: ec.EpochIndex, Stage: "poc_start", Phase: types.PoCGeneratePhase, Height: ec.StartOfPoC()},
+		{EpochIndex: ec.EpochIndex, Stage: "poc_generation_wind_down", Phase: types.PoCGenerateWindDownPhase, Height: ec.PoCGenerationWindDown()},
+		{EpochIndex: ec.EpochIndex, Stage: "poc_validation_start", Phase: types.PoCValidatePhase, Height: ec.StartOfPoCValidation()},
+		{EpochIndex: ec.EpochIndex, Stage: "poc_validation_wind_down", Phase: types.PoCValidateWindDownPhase, Height: ec.PoCValidationWindDown()},
+		{EpochIndex: ec.EpochIndex, Stage: "poc_validation_end", Phase: types.InferencePhase, Height: ec.EndOfPoCValidation()},
+		{EpochIndex: ec.EpochIndex, Stage: "set_new_validators", Height: ec.SetNewValidators()},
+		{EpochIndex: ec.EpochIndex, Stage: "claim_money", Height: ec.ClaimMoney()},
+	}
+}
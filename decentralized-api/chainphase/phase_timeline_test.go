@@ -0,0 +1,70 @@
+package chainphase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+var timelineEpochParams = types.EpochParams{
+	EpochLength:           100,
+	PocStageDuration:      20,
+	PocExchangeDuration:   1,
+	PocValidationDelay:    2,
+	PocValidationDuration: 10,
+	SetNewValidatorsDelay: 1,
+}
+
+func TestPredictPhaseTimeline(t *testing.T) {
+	ec := types.NewEpochContext(types.Epoch{Index: 5, PocStartBlockHeight: 500}, timelineEpochParams)
+	lastBlockAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	timeline := predictPhaseTimeline(ec, 525, 6*time.Second, lastBlockAt)
+	require.Equal(t, types.PoCValidatePhase, timeline.CurrentPhase)
+	require.Equal(t, int64(6000), timeline.AverageBlockTimeMs)
+	require.Len(t, timeline.Boundaries, 11)
+
+	first := timeline.Boundaries[0]
+	require.Equal(t, uint64(5), first.EpochIndex)
+	require.Equal(t, "poc_validation_wind_down", first.Stage)
+	require.Equal(t, types.PoCValidateWindDownPhase, first.Phase)
+	require.Equal(t, int64(530), first.Height)
+	require.Equal(t, int64(5), first.BlocksRemaining)
+	require.Equal(t, lastBlockAt.Add(30*time.Second), *first.Eta)
+
+	nextPoc := timeline.Boundaries[4]
+	require.Equal(t, uint64(6), nextPoc.EpochIndex)
+	require.Equal(t, types.PoCGeneratePhase, nextPoc.Phase)
+	require.Equal(t, int64(600), nextPoc.Height)
+
+	for i := 1; i < len(timeline.Boundaries); i++ {
+		require.Greater(t, timeline.Boundaries[i].Height, timeline.Boundaries[i-1].Height)
+	}
+
+	timeline = predictPhaseTimeline(ec, 525, 0, lastBlockAt)
+	require.Nil(t, timeline.Boundaries[0].Eta)
+}
+
+func TestAverageBlockTime(t *testing.T) {
+	tracker := NewChainPhaseTracker()
+	start := time.Now()
+
+	tracker.recordBlockTime(10, start, true)
+	require.Zero(t, tracker.averageBlockTime())
+
+	tracker.recordBlockTime(11, start.Add(5*time.Second), true)
+	tracker.recordBlockTime(11, start.Add(6*time.Second), true)
+	tracker.recordBlockTime(13, start.Add(15*time.Second), true)
+	require.Equal(t, 5*time.Second, tracker.averageBlockTime())
+
+	tracker.recordBlockTime(14, start.Add(16*time.Second), false)
+	require.Zero(t, tracker.averageBlockTime())
+
+	for i := int64(0); i < 2*blockTimeWindow; i++ {
+		tracker.recordBlockTime(100+i, start.Add(time.Duration(i)*time.Second), true)
+	}
+	require.Len(t, tracker.blockTimes, blockTimeWindow)
+	require.Equal(t, time.Second, tracker.averageBlockTime())
+}
@@ -2,6 +2,7 @@ package chainphase
 
 import (
 	"sync"
+	"time"
 
 	"github.com/productscience/inference/x/inference/types"
 )
@@ -21,6 +22,8 @@ type ChainPhaseTracker struct {
 	activeConfirmationPoCEvent *types.ConfirmationPoCEvent
 	pocV2Enabled               bool // cached from PocParams.PocV2Enabled, default false (set from chain)
 	confirmationPocV2Enabled   bool // cached from PocParams.ConfirmationPocV2Enabled, default true
+	// blockTimes holds the arrival times of the latest synced blocks, oldest first, to estimate the block time
+	blockTimes []blockTime
 }
 
 type BlockInfo struct {
@@ -47,6 +50,7 @@ func (t *ChainPhaseTracker) Update(block BlockInfo, epoch *types.Epoch, params *
 	t.currentEpochParams = params
 	t.currentIsSynced = isSynced
 	t.activeConfirmationPoCEvent = confirmationPoCEvent
+	t.recordBlockTime(block.Height, time.Now(), isSynced)
 }
 
 type EpochState struct {
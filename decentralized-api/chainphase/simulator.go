@@ -0,0 +1,128 @@
+package chainphase
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// maxSimulatedBlocks bounds the block range of a simulation
+const maxSimulatedBlocks = 10_000_000
+
+// ParamChange is a change of the epoch params, e.g. a governance proposal, in force from Height.
+// As on chain, an epoch keeps the params it started with: the change applies from the first
+// epoch whose PoC starts at or after Height.
+type ParamChange struct {
+	Height      int64             `json:"height"`
+	EpochParams types.EpochParams `json:"epoch_params"`
+}
+
+// AppliedChange is when a ParamChange took effect
+type AppliedChange struct {
+	Height          int64  `json:"height"`
+	EpochIndex      uint64 `json:"epoch_index"`
+	EffectiveHeight int64  `json:"effective_height"`
+}
+
+// PhaseSegment is a run of blocks of an epoch in the same phase, both heights inclusive
+type PhaseSegment struct {
+	EpochIndex  uint64           `json:"epoch_index"`
+	Phase       types.EpochPhase `json:"phase"`
+	StartHeight int64            `json:"start_height"`
+	EndHeight   int64            `json:"end_height"`
+}
+
+// Simulation is the phase sequence the chain goes through over a block range. Boundaries count
+// BlocksRemaining from FromHeight. Warnings report epochs whose params break the phase math.
+type Simulation struct {
+	FromHeight     int64           `json:"from_height"`
+	ToHeight       int64           `json:"to_height"`
+	Segments       []PhaseSegment  `json:"segments"`
+	Boundaries     []PhaseBoundary `json:"boundaries"`
+	AppliedChanges []AppliedChange `json:"applied_changes,omitempty"`
+	Warnings       []string        `json:"warnings,omitempty"`
+}
+
+// Simulate replays the epoch math of the chain from genesis with the given epoch params and changes,
+// and returns the phases and boundaries of the blocks from fromHeight to toHeight
+func Simulate(params types.EpochParams, changes []ParamChange, fromHeight, toHeight int64) (*Simulation, error) {
+	if fromHeight < 0 || toHeight < fromHeight {
+		return nil, fmt.Errorf("invalid block range %d-%d", fromHeight, toHeight)
+	}
+	if toHeight-fromHeight >= maxSimulatedBlocks {
+		return nil, fmt.Errorf("block range %d-%d exceeds %d blocks", fromHeight, toHeight, maxSimulatedBlocks)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid epoch params: %w", err)
+	}
+	changes = append([]ParamChange{}, changes...)
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Height < changes[j].Height })
+	for _, change := range changes {
+		if err := change.EpochParams.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid epoch params of change at height %d: %w", change.Height, err)
+		}
+	}
+
+	sim := &Simulation{FromHeight: fromHeight, ToHeight: toHeight, Segments: []PhaseSegment{}, Boundaries: []PhaseBoundary{}}
+	ec := types.NewEpochContext(types.Epoch{Index: 0, PocStartBlockHeight: 0}, params)
+	start := int64(0)
+	nextChange := 0
+	for start <= toHeight {
+		end := ec.NextPoCStart() - 1
+		if end < start {
+			return nil, fmt.Errorf("epoch %d starting at %d ends at %d: the epoch length is too short", ec.EpochIndex, start, end+1)
+		}
+		if end >= fromHeight {
+			sim.simulateEpoch(&ec, start, end)
+		}
+
+		// The next epoch records the params in force when its PoC starts
+		start = end + 1
+		for nextChange < len(changes) && changes[nextChange].Height <= start {
+			params = changes[nextChange].EpochParams
+			sim.AppliedChanges = append(sim.AppliedChanges, AppliedChange{
+				Height:          changes[nextChange].Height,
+				EpochIndex:      ec.EpochIndex + 1,
+				EffectiveHeight: start,
+			})
+			nextChange++
+		}
+		ec = types.NewEpochContext(types.Epoch{Index: ec.EpochIndex + 1, PocStartBlockHeight: start}, params)
+	}
+	return sim, nil
+}
+
+// simulateEpoch adds the phases and boundaries of the epoch's blocks start to end within the range
+func (s *Simulation) simulateEpoch(ec *types.EpochContext, start, end int64) {
+	if ec.EpochIndex > 0 {
+		if ec.SetNewValidators() > end {
+			s.Warnings = append(s.Warnings, fmt.Sprintf(
+				"epoch %d: set_new_validators at %d is not before the next PoC start at %d", ec.EpochIndex, ec.SetNewValidators(), end+1))
+		}
+		for _, boundary := range epochBoundaries(ec) {
+			if boundary.Height >= s.FromHeight && boundary.Height <= s.ToHeight {
+				boundary.BlocksRemaining = boundary.Height - s.FromHeight
+				s.Boundaries = append(s.Boundaries, boundary)
+			}
+		}
+	}
+
+	for height := max(start, s.FromHeight); height <= min(end, s.ToHeight); height++ {
+		phase := ec.GetCurrentPhase(height)
+		if n := len(s.Segments); n > 0 && s.Segments[n-1].EpochIndex == ec.EpochIndex && s.Segments[n-1].Phase == phase {
+			s.Segments[n-1].EndHeight = height
+			continue
+		}
+		s.Segments = append(s.Segments, PhaseSegment{EpochIndex: ec.EpochIndex, Phase: phase, StartHeight: height, EndHeight: height})
+	}
+}
+
+// PhaseAt returns the simulated phase at a height of the simulated range
+func (s *Simulation) PhaseAt(height int64) (types.EpochPhase, bool) {
+	i := sort.Search(len(s.Segments), func(i int) bool { return s.Segments[i].EndHeight >= height })
+	if i == len(s.Segments) || s.Segments[i].StartHeight > height {
+		return "", false
+	}
+	return s.Segments[i].Phase, true
+}
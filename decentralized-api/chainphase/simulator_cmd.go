@@ -0,0 +1,144 @@
+package chainphase
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RunSimulateCommand is the simulate-phases subcommand: it prints the phase sequence the chain goes through
+// over a block range with the given epoch params, e.g. to check a governance proposal before submitting it.
+// Params files hold epoch params, or the output of `inferenced query inference params -o json`.
+// Returns the process exit code.
+func RunSimulateCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("simulate-phases", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	paramsFile := flags.String("params", "", "JSON file with the current epoch params (required)")
+	changesFile := flags.String("changes", "", `JSON file with a list of epoch param changes: [{"height": 1000, "epoch_params": {...}}]`)
+	from := flags.Int64("from", 0, "first block height to report")
+	to := flags.Int64("to", 0, "last block height to report (required)")
+	asJson := flags.Bool("json", false, "print the simulation as JSON")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if *paramsFile == "" || *to == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	params, err := readEpochParamsFile(*paramsFile)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading params: %v\n", err)
+		return 1
+	}
+	var changes []ParamChange
+	if *changesFile != "" {
+		if changes, err = readParamChangesFile(*changesFile); err != nil {
+			fmt.Fprintf(stderr, "Error reading changes: %v\n", err)
+			return 1
+		}
+	}
+
+	sim, err := Simulate(params, changes, *from, *to)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *asJson {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(sim); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return 1
+		}
+	} else {
+		printSimulation(stdout, sim)
+	}
+	if len(sim.Warnings) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func printSimulation(w io.Writer, sim *Simulation) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "EPOCH\tPHASE\tSTART\tEND\tBLOCKS")
+	for _, segment := range sim.Segments {
+		fmt.Fprintf(tw, "%d\t%s\t%d\t%d\t%d\n", segment.EpochIndex, segment.Phase,
+			segment.StartHeight, segment.EndHeight, segment.EndHeight-segment.StartHeight+1)
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "EPOCH\tSTAGE\tHEIGHT")
+	for _, boundary := range sim.Boundaries {
+		fmt.Fprintf(tw, "%d\t%s\t%d\n", boundary.EpochIndex, boundary.Stage, boundary.Height)
+	}
+	_ = tw.Flush()
+
+	for _, change := range sim.AppliedChanges {
+		fmt.Fprintf(w, "\nChange at height %d applies from epoch %d at height %d\n", change.Height, change.EpochIndex, change.EffectiveHeight)
+	}
+	for _, warning := range sim.Warnings {
+		fmt.Fprintf(w, "\nWARNING: %s\n", warning)
+	}
+}
+
+func readEpochParamsFile(path string) (types.EpochParams, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return types.EpochParams{}, err
+	}
+	return parseEpochParams(bz)
+}
+
+// parseEpochParams accepts epoch params, or params or a params query response holding them.
+// Integers may be given as numbers or, as the chain prints them, as strings.
+func parseEpochParams(bz []byte) (types.EpochParams, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return types.EpochParams{}, err
+	}
+	for _, key := range []string{"params", "epoch_params"} {
+		if nested, ok := fields[key]; ok {
+			return parseEpochParams(nested)
+		}
+	}
+	var params types.EpochParams
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(bz), &params); err != nil {
+		return types.EpochParams{}, err
+	}
+	return params, nil
+}
+
+func readParamChangesFile(path string) ([]ParamChange, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []struct {
+		Height      int64           `json:"height"`
+		EpochParams json.RawMessage `json:"epoch_params"`
+	}
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, err
+	}
+	changes := make([]ParamChange, 0, len(entries))
+	for _, entry := range entries {
+		params, err := parseEpochParams(entry.EpochParams)
+		if err != nil {
+			return nil, fmt.Errorf("change at height %d: %w", entry.Height, err)
+		}
+		changes = append(changes, ParamChange{Height: entry.Height, EpochParams: params})
+	}
+	return changes, nil
+}
@@ -0,0 +1,123 @@
+package chainphase
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+var simulatorEpochParams = types.EpochParams{
+	EpochLength:                    100,
+	EpochMultiplier:                1,
+	EpochShift:                     30,
+	PocStageDuration:               20,
+	PocExchangeDuration:            1,
+	PocValidationDelay:             2,
+	PocValidationDuration:          10,
+	SetNewValidatorsDelay:          1,
+	InferencePruningEpochThreshold: 2,
+}
+
+func TestSimulateMatchesChain(t *testing.T) {
+	sim, err := Simulate(simulatorEpochParams, nil, 0, 1000)
+	require.NoError(t, err)
+	require.Empty(t, sim.Warnings)
+
+	// Replay the chain: EndBlock starts an epoch at its PoC start and switches the effective epoch at set_new_validators
+	effective := types.Epoch{Index: 0, PocStartBlockHeight: 0}
+	latest := effective
+	for height := int64(1); height <= 1000; height++ {
+		ec, err := types.NewEpochContextFromEffectiveEpoch(effective, simulatorEpochParams, height)
+		require.NoError(t, err)
+		if ec.IsStartOfPocStage(height) {
+			latest = types.Epoch{Index: ec.EpochIndex, PocStartBlockHeight: height}
+		}
+		latestEc := types.NewEpochContext(latest, simulatorEpochParams)
+		phase, ok := sim.PhaseAt(height)
+		require.True(t, ok)
+		require.Equal(t, latestEc.GetCurrentPhase(height), phase, "height %d", height)
+		if ec.IsSetNewValidatorsStage(height) {
+			effective = latest
+		}
+	}
+
+	require.Equal(t, PhaseSegment{EpochIndex: 0, Phase: types.InferencePhase, StartHeight: 0, EndHeight: 69}, sim.Segments[0])
+	require.Equal(t, PhaseSegment{EpochIndex: 1, Phase: types.PoCGeneratePhase, StartHeight: 70, EndHeight: 85}, sim.Segments[1])
+	_, ok := sim.PhaseAt(1001)
+	require.False(t, ok)
+}
+
+func TestSimulateParamChange(t *testing.T) {
+	changed := simulatorEpochParams
+	changed.EpochLength = 200
+
+	// The change lands mid-epoch 1 and applies from epoch 2
+	sim, err := Simulate(simulatorEpochParams, []ParamChange{{Height: 100, EpochParams: changed}}, 150, 600)
+	require.NoError(t, err)
+	require.Equal(t, []AppliedChange{{Height: 100, EpochIndex: 2, EffectiveHeight: 170}}, sim.AppliedChanges)
+
+	var pocStarts []int64
+	for _, boundary := range sim.Boundaries {
+		if boundary.Stage == "poc_start" {
+			pocStarts = append(pocStarts, boundary.Height)
+		}
+	}
+	require.Equal(t, []int64{170, 370, 570}, pocStarts)
+	require.Equal(t, int64(20), sim.Boundaries[0].BlocksRemaining)
+}
+
+func TestSimulateWarnings(t *testing.T) {
+	broken := simulatorEpochParams
+	broken.SetNewValidatorsDelay = 100
+
+	sim, err := Simulate(broken, nil, 0, 300)
+	require.NoError(t, err)
+	require.NotEmpty(t, sim.Warnings)
+
+	_, err = Simulate(simulatorEpochParams, nil, 10, 5)
+	require.Error(t, err)
+	invalid := simulatorEpochParams
+	invalid.EpochLength = 0
+	_, err = Simulate(invalid, nil, 0, 10)
+	require.Error(t, err)
+}
+
+func TestRunSimulateCommand(t *testing.T) {
+	dir := t.TempDir()
+	// As printed by `inferenced query inference params -o json`
+	paramsFile := filepath.Join(dir, "params.json")
+	require.NoError(t, os.WriteFile(paramsFile, []byte(`{"params":{"epoch_params":{
+		"epoch_length":"100","epoch_multiplier":"1","epoch_shift":"30","poc_stage_duration":"20",
+		"poc_exchange_duration":"1","poc_validation_delay":"2","poc_validation_duration":"10",
+		"set_new_validators_delay":"1","inference_pruning_epoch_threshold":"2"}}}`), 0o644))
+	changesFile := filepath.Join(dir, "changes.json")
+	require.NoError(t, os.WriteFile(changesFile, []byte(`[{"height":100,"epoch_params":{
+		"epoch_length":200,"epoch_multiplier":1,"epoch_shift":30,"poc_stage_duration":20,
+		"poc_exchange_duration":1,"poc_validation_delay":2,"poc_validation_duration":10,
+		"set_new_validators_delay":1,"inference_pruning_epoch_threshold":2}}]`), 0o644))
+
+	var stdout, stderr bytes.Buffer
+	code := RunSimulateCommand([]string{"-params", paramsFile, "-changes", changesFile, "-from", "150", "-to", "600", "-json"}, &stdout, &stderr)
+	require.Equal(t, 0, code, stderr.String())
+
+	var sim Simulation
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &sim))
+	expected, err := Simulate(simulatorEpochParams, []ParamChange{{Height: 100, EpochParams: func() types.EpochParams {
+		p := simulatorEpochParams
+		p.EpochLength = 200
+		return p
+	}()}}, 150, 600)
+	require.NoError(t, err)
+	require.Equal(t, expected.Segments, sim.Segments)
+
+	stdout.Reset()
+	require.Equal(t, 0, RunSimulateCommand([]string{"-params", paramsFile, "-to", "200"}, &stdout, &stderr))
+	require.Contains(t, stdout.String(), "poc_validation_start")
+
+	require.Equal(t, 2, RunSimulateCommand([]string{"-to", "200"}, &stdout, &stderr))
+}
@@ -21,6 +21,8 @@ type CompletionResponse interface {
 	GetEnforcedStr() (string, error)
 	GetEnforcedTokens() (EnforcedTokens, error)
 	ExtractLogits() []Logprob
+	// GetToolCalls returns the tool calls of the first choice, empty for plain text completions
+	GetToolCalls() []ToolCall
 }
 
 type JsonCompletionResponse struct {
@@ -64,7 +66,14 @@ func (r *JsonCompletionResponse) GetEnforcedStr() (string, error) {
 		logging.Warn("More than one choice in a non-steamed inference response, defaulting to first one", types.Validation, "choices", r.Resp.Choices)
 	}
 
-	content := r.Resp.Choices[0].Message.Content
+	message := r.Resp.Choices[0].Message
+	var content string
+	if message != nil {
+		content = message.Content
+	}
+	if content == "" {
+		content = toolCallsStr(r.GetToolCalls())
+	}
 	if content == "" {
 		logging.Error("Model return empty response", types.Validation, "inference_id", r.Resp.ID)
 		return "", errors.New("JsonResponse has no content")
@@ -149,18 +158,25 @@ func (r *StreamedCompletionResponse) GetEnforcedTokens() (EnforcedTokens, error)
 			}
 
 			if len(choice.Logprobs.Content) == 0 {
+				if choice.Delta != nil && (len(choice.Delta.ToolCalls) > 0 || choice.Delta.FunctionCall != nil) {
+					// tool call parsers may emit the parsed call in a chunk of its own, without tokens
+					continue
+				}
 				logging.Error("Choice has no logprobs content for enforced tokens", types.Validation, "inference_id", c.ID)
 				return EnforcedTokens{}, errors.New("StreamedCompletionResponse: choice has no logprobs content")
 			}
 
-			var topTokens []string
-			for _, topToken := range choice.Logprobs.Content[0].TopLogprobs {
-				topTokens = append(topTokens, topToken.Token)
+			// A chunk usually holds one token, but tool call parsers buffer several tokens into one chunk
+			for _, content := range choice.Logprobs.Content {
+				var topTokens []string
+				for _, topToken := range content.TopLogprobs {
+					topTokens = append(topTokens, topToken.Token)
+				}
+				enforcedTokens.Tokens = append(enforcedTokens.Tokens, EnforcedToken{
+					Token:     content.Token,
+					TopTokens: topTokens,
+				})
 			}
-			enforcedTokens.Tokens = append(enforcedTokens.Tokens, EnforcedToken{
-				Token:     choice.Logprobs.Content[0].Token,
-				TopTokens: topTokens,
-			})
 		}
 	}
 
@@ -249,13 +265,16 @@ func (r *StreamedCompletionResponse) GetEnforcedStr() (string, error) {
 			logging.Warn("More than one choice in a streamed inference response, defaulting to first one", types.Validation, "inferenceId", event.ID, "choices", event.Choices)
 		}
 
-		content := event.Choices[0].Delta.Content
-		if content != nil {
-			stringBuilder.WriteString(*content)
+		delta := event.Choices[0].Delta
+		if delta != nil && delta.Content != nil {
+			stringBuilder.WriteString(*delta.Content)
 		}
 	}
 
 	responseString := stringBuilder.String()
+	if responseString == "" {
+		responseString = toolCallsStr(r.GetToolCalls())
+	}
 	if responseString == "" {
 		logging.Error("Model return empty response", types.Validation, "inference_id", id)
 		return "", errors.New("StreamedResponse has no content")
@@ -283,6 +302,81 @@ func (r *StreamedCompletionResponse) ExtractLogits() []Logprob {
 	return logits
 }
 
+func (r *JsonCompletionResponse) GetToolCalls() []ToolCall {
+	if len(r.Resp.Choices) == 0 || r.Resp.Choices[0].Message == nil {
+		return nil
+	}
+	message := r.Resp.Choices[0].Message
+	if len(message.ToolCalls) == 0 && message.FunctionCall != nil {
+		return []ToolCall{{Type: "function", Function: *message.FunctionCall}}
+	}
+	return message.ToolCalls
+}
+
+// GetToolCalls reassembles the tool calls of the first choice from their streamed fragments
+func (r *StreamedCompletionResponse) GetToolCalls() []ToolCall {
+	var calls []ToolCall
+	byIndex := make(map[int]int)
+	var legacy *FunctionCall
+	for _, event := range r.Resp.Data {
+		if len(event.Choices) == 0 || event.Choices[0].Delta == nil {
+			continue
+		}
+		delta := event.Choices[0].Delta
+		for _, fragment := range delta.ToolCalls {
+			index := len(calls)
+			if fragment.Index != nil {
+				index = *fragment.Index
+			}
+			pos, ok := byIndex[index]
+			if !ok {
+				pos = len(calls)
+				byIndex[index] = pos
+				calls = append(calls, ToolCall{})
+			}
+			call := &calls[pos]
+			if call.ID == "" {
+				call.ID = fragment.ID
+			}
+			if call.Type == "" {
+				call.Type = fragment.Type
+			}
+			if call.Function.Name == "" {
+				call.Function.Name = fragment.Function.Name
+			}
+			call.Function.Arguments += fragment.Function.Arguments
+		}
+		if delta.FunctionCall != nil {
+			if legacy == nil {
+				legacy = &FunctionCall{}
+			}
+			if legacy.Name == "" {
+				legacy.Name = delta.FunctionCall.Name
+			}
+			legacy.Arguments += delta.FunctionCall.Arguments
+		}
+	}
+	if len(calls) == 0 && legacy != nil {
+		return []ToolCall{{Type: "function", Function: *legacy}}
+	}
+	return calls
+}
+
+// toolCallsStr renders tool calls as the text a tool calling completion produced, "" if there are none
+func toolCallsStr(calls []ToolCall) string {
+	var builder strings.Builder
+	for _, call := range calls {
+		if builder.Len() > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(call.Function.Name)
+		builder.WriteString("(")
+		builder.WriteString(call.Function.Arguments)
+		builder.WriteString(")")
+	}
+	return builder.String()
+}
+
 func NewCompletionResponseFromBytes(bytes []byte) (CompletionResponse, error) {
 	var response Response
 	if err := json.Unmarshal(bytes, &response); err != nil {
@@ -0,0 +1,102 @@
+package completionapi
+
+import (
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sort"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EmbeddingsResponse is the OpenAI /v1/embeddings response
+type EmbeddingsResponse struct {
+	ID     string          `json:"id"`
+	Object string          `json:"object"`
+	Model  string          `json:"model"`
+	Data   []EmbeddingData `json:"data"`
+	Usage  Usage           `json:"usage"`
+}
+
+type EmbeddingData struct {
+	Index     int       `json:"index"`
+	Object    string    `json:"object"`
+	Embedding []float64 `json:"embedding"`
+}
+
+// EmbeddingResponse is a CompletionResponse without generated tokens: it is priced by input tokens only
+// and validated by comparing vectors instead of logits
+type EmbeddingResponse struct {
+	Bytes []byte
+	Resp  EmbeddingsResponse
+}
+
+func (r *EmbeddingResponse) GetModel() (string, error) {
+	return r.Resp.Model, nil
+}
+
+func (r *EmbeddingResponse) GetInferenceId() (string, error) {
+	return r.Resp.ID, nil
+}
+
+func (r *EmbeddingResponse) GetUsage() (*Usage, error) {
+	if r.Resp.Usage.IsEmpty() {
+		return nil, errors.New("EmbeddingResponse: no usage found")
+	}
+	return &r.Resp.Usage, nil
+}
+
+func (r *EmbeddingResponse) GetBodyBytes() ([]byte, error) {
+	return r.Bytes, nil
+}
+
+func (r *EmbeddingResponse) GetHash() (string, error) {
+	if len(r.Bytes) == 0 {
+		return "", errors.New("EmbeddingResponse: can't compute hash, empty bytes")
+	}
+	return utils.GenerateSHA256HashBytes(r.Bytes), nil
+}
+
+func (r *EmbeddingResponse) GetEnforcedStr() (string, error) {
+	return "", nil
+}
+
+func (r *EmbeddingResponse) GetEnforcedTokens() (EnforcedTokens, error) {
+	return EnforcedTokens{}, nil
+}
+
+func (r *EmbeddingResponse) GetToolCalls() []ToolCall {
+	return nil
+}
+
+func (r *EmbeddingResponse) ExtractLogits() []Logprob {
+	return nil
+}
+
+// Vectors returns the embeddings ordered by their input index
+func (r *EmbeddingResponse) Vectors() [][]float64 {
+	data := slices.Clone(r.Resp.Data)
+	sort.SliceStable(data, func(i, j int) bool {
+		return data[i].Index < data[j].Index
+	})
+	vectors := make([][]float64, len(data))
+	for i := range data {
+		vectors[i] = data[i].Embedding
+	}
+	return vectors
+}
+
+func NewEmbeddingResponseFromBytes(bytes []byte) (*EmbeddingResponse, error) {
+	var response EmbeddingsResponse
+	if err := json.Unmarshal(bytes, &response); err != nil {
+		logging.Error("Failed to unmarshal json response into completionapi.EmbeddingsResponse", types.Inferences, "responseString", string(bytes), "err", err)
+		return nil, err
+	}
+
+	return &EmbeddingResponse{
+		Bytes: bytes,
+		Resp:  response,
+	}, nil
+}
@@ -0,0 +1,30 @@
+package completionapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const embeddingsResponse = `{"id":"embd-1","object":"list","model":"intfloat/e5-mistral-7b-instruct","data":[{"index":1,"object":"embedding","embedding":[0.3,0.4]},{"index":0,"object":"embedding","embedding":[0.1,0.2]}],"usage":{"prompt_tokens":7,"total_tokens":7}}`
+
+func TestEmbeddingResponse(t *testing.T) {
+	resp, err := NewEmbeddingResponseFromBytes([]byte(embeddingsResponse))
+	require.NoError(t, err)
+
+	model, err := resp.GetModel()
+	require.NoError(t, err)
+	require.Equal(t, "intfloat/e5-mistral-7b-instruct", model)
+
+	usage, err := resp.GetUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), usage.PromptTokens)
+	require.Equal(t, uint64(0), usage.CompletionTokens)
+
+	require.Nil(t, resp.ExtractLogits())
+	require.Equal(t, [][]float64{{0.1, 0.2}, {0.3, 0.4}}, resp.Vectors())
+
+	hash, err := resp.GetHash()
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+}
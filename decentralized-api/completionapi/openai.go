@@ -22,13 +22,32 @@ type Choice struct {
 }
 
 type Message struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role      string     `json:"role"`
+	Content   string     `json:"content"`
+	ToolCalls []ToolCall `json:"tool_calls,omitempty"`
+	// FunctionCall is the legacy (pre tool_calls) OpenAI function calling format
+	FunctionCall *FunctionCall `json:"function_call,omitempty"`
 }
 
 type Delta struct {
-	Role    *string `json:"role"`
-	Content *string `json:"content"`
+	Role         *string       `json:"role"`
+	Content      *string       `json:"content"`
+	ToolCalls    []ToolCall    `json:"tool_calls,omitempty"`
+	FunctionCall *FunctionCall `json:"function_call,omitempty"`
+}
+
+// ToolCall is a call of a tool requested by the model. In streamed responses a call is split across
+// deltas sharing its Index: the first one carries the ID and function name, the rest argument fragments.
+type ToolCall struct {
+	Index    *int         `json:"index,omitempty"`
+	ID       string       `json:"id,omitempty"`
+	Type     string       `json:"type,omitempty"`
+	Function FunctionCall `json:"function"`
+}
+
+type FunctionCall struct {
+	Name      string `json:"name,omitempty"`
+	Arguments string `json:"arguments"`
 }
 
 type TopLogprobs struct {
@@ -70,6 +70,15 @@ func ModifyRequestBody(requestBytes []byte, defaultSeed int32) (*ModifiedRequest
 	}, nil
 }
 
+// MaxTokens returns the completion token limit ModifyRequestBody gives the ML node for the request
+func MaxTokens(requestBytes []byte) (int, error) {
+	var requestMap map[string]interface{}
+	if err := json.Unmarshal(requestBytes, &requestMap); err != nil {
+		return 0, err
+	}
+	return getMaxTokens(requestMap), nil
+}
+
 func getMaxTokens(requestMap map[string]interface{}) int {
 	if maxTokensValue, ok := requestMap["max_tokens"]; ok {
 		if maxTokensFloat, ok := maxTokensValue.(float64); ok {
@@ -14,10 +14,15 @@ type ResponseProcessor interface {
 	GetResponseBytes() ([]byte, error)
 }
 
+// ErrMaxTokensExceeded is returned for the streamed line that takes the completion past its max tokens
+var ErrMaxTokensExceeded = errors.New("completion exceeds max tokens")
+
 type ExecutorResponseProcessor struct {
 	inferenceId       string
 	jsonResponseBytes []byte
 	streamedResponse  []string
+	maxTokens         int
+	streamedTokens    int
 }
 
 func NewExecutorResponseProcessor(inferenceId string) *ExecutorResponseProcessor {
@@ -39,7 +44,18 @@ func (rt *ExecutorResponseProcessor) ProcessJsonResponse(responseBytes []byte) (
 	return updatedBodyBytes, nil
 }
 
+// SetMaxTokens cuts streamed completions off at maxTokens tokens, in case the ML node does not stop on its own
+func (rt *ExecutorResponseProcessor) SetMaxTokens(maxTokens int) {
+	rt.maxTokens = maxTokens
+}
+
 func (rt *ExecutorResponseProcessor) ProcessStreamedResponse(line string) (string, error) {
+	if rt.maxTokens > 0 {
+		rt.streamedTokens += streamedTokenCount(line)
+		if rt.streamedTokens > rt.maxTokens {
+			return "", ErrMaxTokensExceeded
+		}
+	}
 	updatedLine, err := getUpdatedLine(line, rt.inferenceId)
 	rt.streamedResponse = append(rt.streamedResponse, updatedLine)
 	return updatedLine, err
@@ -63,6 +79,22 @@ func getUpdatedLine(line string, id string) (string, error) {
 	return DataPrefix + string(updatedBodyBytes), nil
 }
 
+// streamedTokenCount is the number of completion tokens in a streamed line, one logprob per token
+func streamedTokenCount(line string) int {
+	if !strings.HasPrefix(line, DataPrefix) {
+		return 0
+	}
+	var response Response
+	if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, DataPrefix))), &response); err != nil {
+		return 0
+	}
+	count := 0
+	for _, choice := range response.Choices {
+		count += len(choice.Logprobs.Content)
+	}
+	return count
+}
+
 func addOrReplaceIdValue(bytes []byte, id string) ([]byte, error) {
 	var bodyMap map[string]interface{}
 	err := json.Unmarshal(bytes, &bodyMap)
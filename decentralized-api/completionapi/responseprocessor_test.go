@@ -188,3 +188,31 @@ func loadJson(path string) ([]byte, error) {
 
 	return data, nil
 }
+
+func TestStreamedResponseMaxTokens(t *testing.T) {
+	token := `{"token":"a","logprob":-0.1,"bytes":[],"top_logprobs":[]}`
+	chunk := func(tokens int) string {
+		content := make([]string, tokens)
+		for i := range content {
+			content[i] = token
+		}
+		return `data: {"id":"1","choices":[{"index":0,"delta":{"content":"a"},"logprobs":{"content":[` + strings.Join(content, ",") + `]}}]}`
+	}
+
+	processor := NewExecutorResponseProcessor("inference-1")
+	processor.SetMaxTokens(3)
+
+	_, err := processor.ProcessStreamedResponse(chunk(1))
+	require.NoError(t, err)
+	_, err = processor.ProcessStreamedResponse("")
+	require.NoError(t, err)
+	_, err = processor.ProcessStreamedResponse(chunk(2))
+	require.NoError(t, err)
+	_, err = processor.ProcessStreamedResponse(chunk(1))
+	require.ErrorIs(t, err, ErrMaxTokensExceeded)
+
+	// The line past the limit is not part of the recorded completion
+	resp, err := processor.GetResponse()
+	require.NoError(t, err)
+	require.Len(t, resp.ExtractLogits(), 3)
+}
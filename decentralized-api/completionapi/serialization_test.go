@@ -663,3 +663,80 @@ func TestStreamedResponseSerialization(t *testing.T) {
 	require.Equal(t, len(resp.(*StreamedCompletionResponse).Lines), len(resp2.(*StreamedCompletionResponse).Lines))
 	require.Equal(t, len(resp.(*StreamedCompletionResponse).Resp.Data), len(resp2.(*StreamedCompletionResponse).Resp.Data))
 }
+
+const TOOL_CALL_RESPONSE = `
+{
+  "id": "chatcmpl-tool",
+  "object": "chat.completion",
+  "model": "Qwen/QwQ-32B",
+  "choices": [
+    {
+      "index": 0,
+      "message": {
+        "role": "assistant",
+        "content": null,
+        "tool_calls": [
+          {"id": "call_1", "type": "function", "function": {"name": "get_weather", "arguments": "{\"city\": \"Paris\"}"}}
+        ]
+      },
+      "logprobs": {
+        "content": [
+          {"token": "<tool_call>", "logprob": -0.1, "bytes": [], "top_logprobs": [{"token": "<tool_call>", "logprob": -0.1, "bytes": []}]}
+        ]
+      },
+      "finish_reason": "tool_calls"
+    }
+  ],
+  "usage": {"prompt_tokens": 10, "completion_tokens": 1}
+}
+`
+
+func TestToolCallResponse(t *testing.T) {
+	resp, err := NewCompletionResponseFromBytes([]byte(TOOL_CALL_RESPONSE))
+	require.NoError(t, err)
+
+	calls := resp.GetToolCalls()
+	require.Len(t, calls, 1)
+	require.Equal(t, "call_1", calls[0].ID)
+	require.Equal(t, "get_weather", calls[0].Function.Name)
+	require.Equal(t, `{"city": "Paris"}`, calls[0].Function.Arguments)
+
+	enforcedStr, err := resp.GetEnforcedStr()
+	require.NoError(t, err)
+	require.Equal(t, `get_weather({"city": "Paris"})`, enforcedStr)
+
+	enforcedTokens, err := resp.GetEnforcedTokens()
+	require.NoError(t, err)
+	require.Len(t, enforcedTokens.Tokens, 1)
+	require.Len(t, resp.ExtractLogits(), 1)
+}
+
+func TestStreamedToolCallResponse(t *testing.T) {
+	lines := []string{
+		`data: {"id":"1","model":"m","choices":[{"index":0,"delta":{"role":"assistant","content":""},"logprobs":{"content":[{"token":"<tool_call>","logprob":-0.1,"bytes":[],"top_logprobs":[{"token":"<tool_call>","logprob":-0.1,"bytes":[]}]}]}}]}`,
+		`data: {"id":"1","model":"m","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"id":"call_1","type":"function","function":{"name":"get_weather"}}]},"logprobs":{"content":[{"token":"get","logprob":-0.1,"bytes":[],"top_logprobs":[{"token":"get","logprob":-0.1,"bytes":[]}]},{"token":"_weather","logprob":-0.2,"bytes":[],"top_logprobs":[{"token":"_weather","logprob":-0.2,"bytes":[]}]}]}}]}`,
+		`data: {"id":"1","model":"m","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"{\"city\": "}}]},"logprobs":{"content":[{"token":"city","logprob":-0.1,"bytes":[],"top_logprobs":[{"token":"city","logprob":-0.1,"bytes":[]}]}]}}]}`,
+		`data: {"id":"1","model":"m","choices":[{"index":0,"delta":{"tool_calls":[{"index":0,"function":{"arguments":"\"Paris\"}"}}]},"logprobs":{"content":[]},"finish_reason":"tool_calls"}]}`,
+		`data: [DONE]`,
+	}
+	resp, err := NewCompletionResponseFromLines(lines)
+	require.NoError(t, err)
+
+	calls := resp.GetToolCalls()
+	require.Len(t, calls, 1)
+	require.Equal(t, "call_1", calls[0].ID)
+	require.Equal(t, "function", calls[0].Type)
+	require.Equal(t, "get_weather", calls[0].Function.Name)
+	require.Equal(t, `{"city": "Paris"}`, calls[0].Function.Arguments)
+
+	enforcedStr, err := resp.GetEnforcedStr()
+	require.NoError(t, err)
+	require.Equal(t, `get_weather({"city": "Paris"})`, enforcedStr)
+
+	// Every token of a multi-token chunk is enforced, matching the extracted logits
+	enforcedTokens, err := resp.GetEnforcedTokens()
+	require.NoError(t, err)
+	require.Len(t, enforcedTokens.Tokens, 4)
+	require.Equal(t, "_weather", enforcedTokens.Tokens[2].Token)
+	require.Len(t, resp.ExtractLogits(), 4)
+}
@@ -0,0 +1,210 @@
+package cosmosclient
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"errors"
+	"sync"
+	"time"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	blockCacheKindBlock        = "block"
+	blockCacheKindBlockResults = "block_results"
+
+	// blockCacheEvictBatch is the number of least recently used entries dropped per eviction query
+	blockCacheEvictBatch = 64
+)
+
+// BlockCache memoizes Block and BlockResults responses by height in the SQLite DB (table rpc_block_cache).
+// Committed blocks never change, so entries stay valid until they are evicted to keep the cache under maxBytes.
+type BlockCache struct {
+	db       *sql.DB
+	maxBytes int64
+
+	mu        sync.Mutex
+	sizeBytes int64
+}
+
+func NewBlockCache(ctx context.Context, db *sql.DB, maxBytes int64) (*BlockCache, error) {
+	var size sql.NullInt64
+	if err := db.QueryRowContext(ctx, `SELECT SUM(LENGTH(data)) FROM rpc_block_cache`).Scan(&size); err != nil {
+		return nil, err
+	}
+	return &BlockCache{db: db, maxBytes: maxBytes, sizeBytes: size.Int64}, nil
+}
+
+// newBlockCacheFromConfig opens the block cache when it is enabled and the SQLite DB is available, nil otherwise
+func newBlockCacheFromConfig(ctx context.Context, config *apiconfig.ConfigManager) *BlockCache {
+	cfg := config.GetBlockCacheConfig()
+	if !cfg.Enabled {
+		return nil
+	}
+	db := config.SqlDb().GetDb()
+	if db == nil {
+		logging.Warn("Block cache is enabled but the SQLite DB is not available", types.System)
+		return nil
+	}
+	cache, err := NewBlockCache(ctx, db, int64(cfg.MaxSizeMB)<<20)
+	if err != nil {
+		logging.Error("Failed to open block cache", types.System, "error", err)
+		return nil
+	}
+	return cache
+}
+
+// SizeBytes returns the total size of the cached responses
+func (c *BlockCache) SizeBytes() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sizeBytes
+}
+
+func (c *BlockCache) get(ctx context.Context, kind string, height int64, result interface{}) bool {
+	var data []byte
+	err := c.db.QueryRowContext(ctx, `SELECT data FROM rpc_block_cache WHERE kind = ? AND height = ?`, kind, height).Scan(&data)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			logging.Warn("Failed to read block cache", types.System, "kind", kind, "height", height, "error", err)
+		}
+		return false
+	}
+	if err := cmtjson.Unmarshal(data, result); err != nil {
+		logging.Warn("Failed to decode cached block response", types.System, "kind", kind, "height", height, "error", err)
+		return false
+	}
+	if _, err := c.db.ExecContext(ctx, `UPDATE rpc_block_cache SET accessed_at = ? WHERE kind = ? AND height = ?`,
+		time.Now().UnixMilli(), kind, height); err != nil {
+		logging.Warn("Failed to touch block cache entry", types.System, "kind", kind, "height", height, "error", err)
+	}
+	return true
+}
+
+func (c *BlockCache) put(ctx context.Context, kind string, height int64, result interface{}) {
+	data, err := cmtjson.Marshal(result)
+	if err != nil {
+		logging.Warn("Failed to encode block response for the cache", types.System, "kind", kind, "height", height, "error", err)
+		return
+	}
+	if int64(len(data)) > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var previous sql.NullInt64
+	if err := c.db.QueryRowContext(ctx, `SELECT LENGTH(data) FROM rpc_block_cache WHERE kind = ? AND height = ?`, kind, height).Scan(&previous); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logging.Warn("Failed to read block cache", types.System, "kind", kind, "height", height, "error", err)
+		return
+	}
+	_, err = c.db.ExecContext(ctx, `
+INSERT INTO rpc_block_cache (kind, height, data, accessed_at) VALUES (?, ?, ?, ?)
+ON CONFLICT(kind, height) DO UPDATE SET data = excluded.data, accessed_at = excluded.accessed_at`,
+		kind, height, data, time.Now().UnixMilli())
+	if err != nil {
+		logging.Warn("Failed to write block cache", types.System, "kind", kind, "height", height, "error", err)
+		return
+	}
+	c.sizeBytes += int64(len(data)) - previous.Int64
+
+	if err := c.evict(ctx); err != nil {
+		logging.Warn("Failed to evict block cache entries", types.System, "error", err)
+	}
+}
+
+// evict drops the least recently used entries until the cache fits in maxBytes. Must be called with c.mu held.
+func (c *BlockCache) evict(ctx context.Context) error {
+	for c.sizeBytes > c.maxBytes {
+		rows, err := c.db.QueryContext(ctx,
+			`SELECT kind, height, LENGTH(data) FROM rpc_block_cache ORDER BY accessed_at LIMIT ?`, blockCacheEvictBatch)
+		if err != nil {
+			return err
+		}
+		type entry struct {
+			kind   string
+			height int64
+			size   int64
+		}
+		var entries []entry
+		for rows.Next() {
+			var e entry
+			if err := rows.Scan(&e.kind, &e.height, &e.size); err != nil {
+				rows.Close()
+				return err
+			}
+			entries = append(entries, e)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			c.sizeBytes = 0
+			return nil
+		}
+
+		for _, e := range entries {
+			if c.sizeBytes <= c.maxBytes {
+				break
+			}
+			if _, err := c.db.ExecContext(ctx, `DELETE FROM rpc_block_cache WHERE kind = ? AND height = ?`, e.kind, e.height); err != nil {
+				return err
+			}
+			c.sizeBytes -= e.size
+		}
+	}
+	return nil
+}
+
+// CachingRpcClient serves Block and BlockResults for explicit heights from a BlockCache and passes
+// everything else, including requests for the latest block, to the wrapped client
+type CachingRpcClient struct {
+	rpcclient.Client
+	cache *BlockCache
+}
+
+// WithBlockCache wraps the client with the cache, or returns it as is when cache is nil
+func WithBlockCache(client rpcclient.Client, cache *BlockCache) rpcclient.Client {
+	if cache == nil {
+		return client
+	}
+	return &CachingRpcClient{Client: client, cache: cache}
+}
+
+func (c *CachingRpcClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	if height == nil {
+		return c.Client.Block(ctx, height)
+	}
+	cached := new(coretypes.ResultBlock)
+	if c.cache.get(ctx, blockCacheKindBlock, *height, cached) {
+		return cached, nil
+	}
+	result, err := c.Client.Block(ctx, height)
+	if err == nil && result != nil && result.Block != nil {
+		c.cache.put(ctx, blockCacheKindBlock, *height, result)
+	}
+	return result, err
+}
+
+func (c *CachingRpcClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	if height == nil {
+		return c.Client.BlockResults(ctx, height)
+	}
+	cached := new(coretypes.ResultBlockResults)
+	if c.cache.get(ctx, blockCacheKindBlockResults, *height, cached) {
+		return cached, nil
+	}
+	result, err := c.Client.BlockResults(ctx, height)
+	if err == nil && result != nil {
+		c.cache.put(ctx, blockCacheKindBlockResults, *height, result)
+	}
+	return result, err
+}
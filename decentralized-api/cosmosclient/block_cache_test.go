@@ -0,0 +1,108 @@
+package cosmosclient
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"decentralized-api/apiconfig"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/require"
+)
+
+type countingBlockClient struct {
+	rpcclient.Client
+	blockCalls map[int64]int
+}
+
+func (c *countingBlockClient) Block(_ context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	h := int64(0)
+	if height != nil {
+		h = *height
+	}
+	c.blockCalls[h]++
+	block := &cmttypes.Block{Header: cmttypes.Header{ChainID: "gonka-test", Height: h}}
+	return &coretypes.ResultBlock{Block: block}, nil
+}
+
+func TestCachingRpcClient_Block(t *testing.T) {
+	ctx := context.Background()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, apiconfig.EnsureSchema(ctx, db))
+
+	cache, err := NewBlockCache(ctx, db, 1<<20)
+	require.NoError(t, err)
+	upstream := &countingBlockClient{blockCalls: make(map[int64]int)}
+	client := WithBlockCache(upstream, cache)
+
+	height := int64(42)
+	for range 3 {
+		block, err := client.Block(ctx, &height)
+		require.NoError(t, err)
+		require.Equal(t, int64(42), block.Block.Height)
+		require.Equal(t, "gonka-test", block.Block.ChainID)
+	}
+	require.Equal(t, 1, upstream.blockCalls[42], "served from the cache after the first fetch")
+
+	// the latest block is never cached
+	_, err = client.Block(ctx, nil)
+	require.NoError(t, err)
+	_, err = client.Block(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, upstream.blockCalls[0])
+
+	// the cache survives a restart
+	reopened, err := NewBlockCache(ctx, db, 1<<20)
+	require.NoError(t, err)
+	require.Equal(t, cache.SizeBytes(), reopened.SizeBytes())
+	_, err = WithBlockCache(upstream, reopened).Block(ctx, &height)
+	require.NoError(t, err)
+	require.Equal(t, 1, upstream.blockCalls[42])
+}
+
+func TestBlockCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, apiconfig.EnsureSchema(ctx, db))
+
+	upstream := &countingBlockClient{blockCalls: make(map[int64]int)}
+	probe, err := upstream.Block(ctx, new(int64))
+	require.NoError(t, err)
+	entrySize := int64(len(mustMarshalBlock(t, probe)))
+
+	// room for two blocks
+	cache, err := NewBlockCache(ctx, db, 2*entrySize+entrySize/2)
+	require.NoError(t, err)
+	client := WithBlockCache(upstream, cache)
+
+	fetch := func(h int64) {
+		_, err := client.Block(ctx, &h)
+		require.NoError(t, err)
+		time.Sleep(2 * time.Millisecond) // distinct access times
+	}
+	fetch(1)
+	fetch(2)
+	fetch(1) // 2 is now the least recently used
+	fetch(3)
+	require.LessOrEqual(t, cache.SizeBytes(), 2*entrySize+entrySize/2)
+
+	fetch(1)
+	require.Equal(t, 1, upstream.blockCalls[1], "recently used block kept")
+	fetch(2)
+	require.Equal(t, 2, upstream.blockCalls[2], "least recently used block evicted")
+}
+
+func mustMarshalBlock(t *testing.T, block *coretypes.ResultBlock) []byte {
+	data, err := cmtjson.Marshal(block)
+	require.NoError(t, err)
+	return data
+}
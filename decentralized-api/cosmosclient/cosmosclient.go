@@ -44,6 +44,7 @@ type InferenceCosmosClient struct {
 	manager         tx_manager.TxManager
 	batchConsumer   *tx_manager.BatchConsumer
 	batchingEnabled bool
+	blockCache      *BlockCache
 }
 
 func NewInferenceCosmosClientWithRetry(
@@ -103,6 +104,29 @@ func updateKeyringIfNeeded(client *cosmosclient.Client, keyringDir string, confi
 	return nil
 }
 
+// useRemoteSignerIfConfigured moves the signer key to the remote signer, so it never has to be on the API host
+func useRemoteSignerIfConfigured(ctx context.Context, client *cosmosclient.Client, nodeConfig apiconfig.ChainNodeConfig) error {
+	remote := nodeConfig.RemoteSigner
+	if remote.Address == "" {
+		return nil
+	}
+	timeout := time.Duration(remote.TimeoutSeconds) * time.Second
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+	signer, err := NewGrpcRemoteSigner(remote)
+	if err != nil {
+		return err
+	}
+	kr, err := NewRemoteSignerKeyring(ctx, client.AccountRegistry.Keyring, nodeConfig.SignerKeyName, signer, timeout)
+	if err != nil {
+		return err
+	}
+	client.AccountRegistry.Keyring = kr
+	log.Printf("Signing with remote signer at %s", remote.Address)
+	return nil
+}
+
 func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config *apiconfig.ConfigManager) (*InferenceCosmosClient, error) {
 	nodeConfig := config.GetChainNodeConfig()
 	keyringDir, err := expandPath(nodeConfig.KeyringDir)
@@ -110,13 +134,16 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		return nil, err
 	}
 
+	blockCache := newBlockCacheFromConfig(ctx, config)
+
 	log.Printf("Initializing cosmos Client."+
-		"NodeUrl = %s. KeyringBackend = %s. KeyringDir = %s", nodeConfig.Url, nodeConfig.KeyringBackend, keyringDir)
+		"NodeUrls = %v. KeyringBackend = %s. KeyringDir = %s", nodeConfig.Endpoints(), nodeConfig.KeyringBackend, keyringDir)
 	cosmoclient, err := cosmosclient.New(
 		ctx,
 		cosmosclient.WithAddressPrefix(addressPrefix),
 		cosmosclient.WithKeyringServiceName("inferenced"),
 		cosmosclient.WithNodeAddress(nodeConfig.Url),
+		cosmosclient.WithRPCClient(WithBlockCache(NewFailoverRpcClient(config.GetChainEndpoints()), blockCache)),
 		cosmosclient.WithKeyringDir(keyringDir),
 		cosmosclient.WithGasPrices("0ngonka"),
 		cosmosclient.WithFees("0ngonka"),
@@ -132,6 +159,10 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		log.Printf("Error updating keyring: %s", err)
 		return nil, err
 	}
+	if err = useRemoteSignerIfConfigured(ctx, &cosmoclient, nodeConfig); err != nil {
+		log.Printf("Error setting up remote signer: %s", err)
+		return nil, err
+	}
 
 	apiAccount, err := apiconfig.NewApiAccount(addressPrefix, nodeConfig, &cosmoclient)
 	if err != nil {
@@ -169,6 +200,7 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		Address:    accAddress,
 		apiAccount: apiAccount,
 		manager:    mn,
+		blockCache: blockCache,
 	}
 
 	batchingCfg := config.GetTxBatchingConfig()
@@ -178,6 +210,8 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 			FlushTimeout:             time.Duration(batchingCfg.FlushTimeoutSeconds) * time.Second,
 			ValidationV2FlushSize:    batchingCfg.ValidationV2FlushSize,
 			ValidationV2FlushTimeout: time.Duration(batchingCfg.ValidationV2FlushTimeoutSeconds) * time.Second,
+			ValidationFlushSize:      batchingCfg.ValidationFlushSize,
+			ValidationFlushTimeout:   time.Duration(batchingCfg.ValidationFlushTimeoutSeconds) * time.Second,
 		}
 		batchConsumer := tx_manager.NewBatchConsumer(
 			mn.GetJetStream(),
@@ -193,7 +227,9 @@ func NewInferenceCosmosClient(ctx context.Context, addressPrefix string, config
 		logging.Info("Transaction batching enabled", types.Messages,
 			"flushSize", batchingCfg.FlushSize,
 			"flushTimeoutSeconds", batchingCfg.FlushTimeoutSeconds,
-			"validationV2FlushTimeoutSeconds", batchingCfg.ValidationV2FlushTimeoutSeconds)
+			"validationV2FlushTimeoutSeconds", batchingCfg.ValidationV2FlushTimeoutSeconds,
+			"validationFlushSize", batchingCfg.ValidationFlushSize,
+			"validationFlushTimeoutSeconds", batchingCfg.ValidationFlushTimeoutSeconds)
 	}
 
 	success = true
@@ -266,6 +302,11 @@ func (icc *InferenceCosmosClient) GetAddress() string {
 	return icc.Address
 }
 
+// GetBlockCache returns the cache of historical blocks, nil when it is disabled
+func (icc *InferenceCosmosClient) GetBlockCache() *BlockCache {
+	return icc.blockCache
+}
+
 func (icc *InferenceCosmosClient) GetKeyring() *keyring.Keyring {
 	return icc.manager.GetKeyring()
 }
@@ -346,6 +387,10 @@ func (icc *InferenceCosmosClient) FinishInference(transaction *inference.MsgFini
 func (icc *InferenceCosmosClient) ReportValidation(transaction *inference.MsgValidation) error {
 	transaction.Creator = icc.Address
 	logging.Info("Reporting validation", types.Validation, "value", transaction.Value, "type", fmt.Sprintf("%T", transaction), "creator", transaction.Creator)
+	if icc.batchingEnabled {
+		// Wait for the batch so that a rejected validation is retried by the validation queue
+		return icc.batchConsumer.PublishValidationAndWait(transaction)
+	}
 	_, err := icc.manager.SendTransactionAsyncWithRetry(transaction)
 	return err
 }
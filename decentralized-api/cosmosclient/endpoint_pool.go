@@ -0,0 +1,174 @@
+package cosmosclient
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"errors"
+	"sync"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	endpointCheckInterval = 10 * time.Second
+	endpointCheckTimeout  = 5 * time.Second
+)
+
+// EndpointStatus is the last health check result of a chain node endpoint
+type EndpointStatus struct {
+	Url       string        `json:"url"`
+	Healthy   bool          `json:"healthy"`
+	Latency   time.Duration `json:"latency"`
+	Error     string        `json:"error,omitempty"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// EndpointPool health-checks the chain node endpoints and elects the one to use.
+// The election is sticky: the current endpoint is kept while it's healthy, and only
+// when it fails is the fastest healthy endpoint elected instead.
+// An endpoint is healthy if it answers /status and isn't catching up.
+type EndpointPool struct {
+	mu       sync.RWMutex
+	statuses []EndpointStatus
+	current  string
+
+	checkStatus func(ctx context.Context, url string) (*coretypes.ResultStatus, error)
+}
+
+func NewEndpointPool(urls []string) *EndpointPool {
+	statuses := make([]EndpointStatus, len(urls))
+	for i, url := range urls {
+		statuses[i] = EndpointStatus{Url: url, Healthy: true}
+	}
+	pool := &EndpointPool{
+		statuses:    statuses,
+		checkStatus: rpcStatus,
+	}
+	if len(urls) > 0 {
+		pool.current = urls[0]
+	}
+	return pool
+}
+
+// Start runs a first health check, then keeps checking in the background until ctx is done
+func (p *EndpointPool) Start(ctx context.Context) {
+	p.checkAll(ctx)
+	if len(p.statuses) < 2 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(endpointCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				p.checkAll(ctx)
+			}
+		}
+	}()
+}
+
+func (p *EndpointPool) Current() string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.current
+}
+
+// ReportFailure marks url unhealthy until its next successful check, electing another endpoint if it was the current one
+func (p *EndpointPool) ReportFailure(url string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i := range p.statuses {
+		if p.statuses[i].Url == url {
+			p.statuses[i].Healthy = false
+			p.statuses[i].Error = "request failed"
+		}
+	}
+	p.elect("request failed")
+}
+
+// Statuses returns the last health check result of every endpoint
+func (p *EndpointPool) Statuses() []EndpointStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return append([]EndpointStatus(nil), p.statuses...)
+}
+
+func (p *EndpointPool) checkAll(ctx context.Context) {
+	results := make([]EndpointStatus, len(p.statuses))
+	var wg sync.WaitGroup
+	for i, status := range p.statuses {
+		wg.Add(1)
+		go func(i int, url string) {
+			defer wg.Done()
+			results[i] = p.check(ctx, url)
+		}(i, status.Url)
+	}
+	wg.Wait()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.statuses = results
+	p.elect("health check")
+}
+
+func (p *EndpointPool) check(ctx context.Context, url string) EndpointStatus {
+	ctx, cancel := context.WithTimeout(ctx, endpointCheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	status, err := p.checkStatus(ctx, url)
+	result := EndpointStatus{Url: url, Latency: time.Since(start), CheckedAt: time.Now()}
+	switch {
+	case err != nil:
+		result.Error = err.Error()
+	case status.SyncInfo.CatchingUp:
+		result.Error = "catching up"
+	default:
+		result.Healthy = true
+	}
+	return result
+}
+
+// elect keeps the current endpoint if it's healthy, otherwise switches to the fastest healthy one.
+// If no endpoint is healthy the current one is kept. Must be called with p.mu held.
+func (p *EndpointPool) elect(reason string) {
+	var best *EndpointStatus
+	for i := range p.statuses {
+		status := &p.statuses[i]
+		if !status.Healthy {
+			continue
+		}
+		if status.Url == p.current {
+			return
+		}
+		if best == nil || status.Latency < best.Latency {
+			best = status
+		}
+	}
+	if best == nil {
+		logging.Warn("No healthy chain node endpoint", types.System, "current", p.current, "reason", reason)
+		return
+	}
+
+	logging.Warn("Switching chain node endpoint", types.System,
+		"from", p.current, "to", best.Url, "latency", best.Latency, "reason", reason)
+	p.current = best.Url
+}
+
+func rpcStatus(ctx context.Context, url string) (*coretypes.ResultStatus, error) {
+	client, err := NewRpcClient(url)
+	if err != nil {
+		return nil, err
+	}
+	status, err := client.Status(ctx)
+	if err == nil && status == nil {
+		return nil, errors.New("empty status response")
+	}
+	return status, err
+}
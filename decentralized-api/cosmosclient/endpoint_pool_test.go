@@ -0,0 +1,92 @@
+package cosmosclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeEndpoint struct {
+	latency    time.Duration
+	err        error
+	catchingUp bool
+}
+
+func newTestPool(endpoints map[string]*fakeEndpoint, urls ...string) *EndpointPool {
+	pool := NewEndpointPool(urls)
+	pool.checkStatus = func(ctx context.Context, url string) (*coretypes.ResultStatus, error) {
+		endpoint := endpoints[url]
+		time.Sleep(endpoint.latency)
+		if endpoint.err != nil {
+			return nil, endpoint.err
+		}
+		status := &coretypes.ResultStatus{}
+		status.SyncInfo.CatchingUp = endpoint.catchingUp
+		return status, nil
+	}
+	return pool
+}
+
+func TestEndpointPool_Election(t *testing.T) {
+	endpoints := map[string]*fakeEndpoint{
+		"http://a": {latency: 30 * time.Millisecond},
+		"http://b": {latency: 20 * time.Millisecond},
+		"http://c": {latency: 1 * time.Millisecond},
+	}
+	pool := newTestPool(endpoints, "http://a", "http://b", "http://c")
+	ctx := context.Background()
+
+	// The current endpoint is kept while healthy, even if another one is faster
+	pool.checkAll(ctx)
+	require.Equal(t, "http://a", pool.Current())
+
+	// Once it fails, the fastest healthy endpoint is elected
+	endpoints["http://a"].err = errors.New("connection refused")
+	endpoints["http://c"].catchingUp = true
+	pool.checkAll(ctx)
+	require.Equal(t, "http://b", pool.Current())
+
+	// Recovered endpoints don't take over from a healthy current one
+	endpoints["http://a"].err = nil
+	endpoints["http://c"].catchingUp = false
+	pool.checkAll(ctx)
+	require.Equal(t, "http://b", pool.Current())
+
+	pool.ReportFailure("http://b")
+	require.Equal(t, "http://c", pool.Current())
+
+	// With nothing healthy the current endpoint stays
+	pool.ReportFailure("http://a")
+	pool.ReportFailure("http://c")
+	require.Equal(t, "http://c", pool.Current())
+}
+
+func TestFailoverRpcClient_RetriesOnAnotherEndpoint(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	downUrl := down.URL
+	down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ID json.RawMessage `json:"id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"jsonrpc": "2.0", "id": request.ID, "result": map[string]any{}})
+	}))
+	defer up.Close()
+
+	pool := NewEndpointPool([]string{downUrl, up.URL})
+	client := NewFailoverRpcClient(pool)
+
+	_, err := client.Health(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, up.URL, pool.Current())
+}
@@ -0,0 +1,333 @@
+package cosmosclient
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/service"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/client/http"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var _ rpcclient.Client = (*FailoverRpcClient)(nil)
+
+// FailoverRpcClient is an RPC client that sends every call to the elected chain node endpoint.
+// A call failing at the transport level is reported to the election and retried once on the
+// newly elected endpoint; errors returned by the node itself are passed through.
+type FailoverRpcClient struct {
+	*service.BaseService
+
+	endpoints apiconfig.ChainEndpoints
+	mu        sync.Mutex
+	clients   map[string]*http.HTTP
+}
+
+func NewFailoverRpcClient(endpoints apiconfig.ChainEndpoints) *FailoverRpcClient {
+	c := &FailoverRpcClient{
+		endpoints: endpoints,
+		clients:   make(map[string]*http.HTTP),
+	}
+	c.BaseService = service.NewBaseService(nil, "FailoverRpcClient", c)
+	return c
+}
+
+func (c *FailoverRpcClient) client(url string) (*http.HTTP, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if client, ok := c.clients[url]; ok {
+		return client, nil
+	}
+	client, err := NewRpcClient(url)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[url] = client
+	return client, nil
+}
+
+func withFailover[T any](ctx context.Context, c *FailoverRpcClient, call func(rpcclient.Client) (T, error)) (T, error) {
+	var zero T
+	current := c.endpoints.Current()
+	client, err := c.client(current)
+	if err != nil {
+		return zero, err
+	}
+
+	result, err := call(client)
+	if err == nil || !isTransportError(err) || ctx.Err() != nil {
+		return result, err
+	}
+
+	c.endpoints.ReportFailure(current)
+	next := c.endpoints.Current()
+	if next == current {
+		return result, err
+	}
+	logging.Warn("Chain node request failed, retrying on another endpoint", types.System,
+		"failed", current, "retry", next, "error", err)
+
+	client, cerr := c.client(next)
+	if cerr != nil {
+		return zero, cerr
+	}
+	result, err = call(client)
+	if err != nil && isTransportError(err) && ctx.Err() == nil {
+		c.endpoints.ReportFailure(next)
+	}
+	return result, err
+}
+
+// withoutRetry runs calls that must not be repeated, such as broadcasts: the transaction may have
+// reached the node before the connection failed. A transport error still triggers a re-election.
+func withoutRetry[T any](ctx context.Context, c *FailoverRpcClient, call func(rpcclient.Client) (T, error)) (T, error) {
+	var zero T
+	current := c.endpoints.Current()
+	client, err := c.client(current)
+	if err != nil {
+		return zero, err
+	}
+
+	result, err := call(client)
+	if err != nil && isTransportError(err) && ctx.Err() == nil {
+		c.endpoints.ReportFailure(current)
+	}
+	return result, err
+}
+
+// isTransportError reports whether err means the endpoint couldn't be reached, as opposed to an error answer
+func isTransportError(err error) bool {
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func (c *FailoverRpcClient) ABCIInfo(ctx context.Context) (*coretypes.ResultABCIInfo, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultABCIInfo, error) {
+		return rc.ABCIInfo(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) ABCIQuery(ctx context.Context, path string, data bytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return rc.ABCIQuery(ctx, path, data)
+	})
+}
+
+func (c *FailoverRpcClient) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*coretypes.ResultABCIQuery, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultABCIQuery, error) {
+		return rc.ABCIQueryWithOptions(ctx, path, data, opts)
+	})
+}
+
+func (c *FailoverRpcClient) BroadcastTxCommit(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTxCommit, error) {
+	return withoutRetry(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBroadcastTxCommit, error) {
+		return rc.BroadcastTxCommit(ctx, tx)
+	})
+}
+
+func (c *FailoverRpcClient) BroadcastTxAsync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return withoutRetry(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return rc.BroadcastTxAsync(ctx, tx)
+	})
+}
+
+func (c *FailoverRpcClient) BroadcastTxSync(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultBroadcastTx, error) {
+	return withoutRetry(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBroadcastTx, error) {
+		return rc.BroadcastTxSync(ctx, tx)
+	})
+}
+
+func (c *FailoverRpcClient) Block(ctx context.Context, height *int64) (*coretypes.ResultBlock, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return rc.Block(ctx, height)
+	})
+}
+
+func (c *FailoverRpcClient) BlockByHash(ctx context.Context, hash []byte) (*coretypes.ResultBlock, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBlock, error) {
+		return rc.BlockByHash(ctx, hash)
+	})
+}
+
+func (c *FailoverRpcClient) BlockResults(ctx context.Context, height *int64) (*coretypes.ResultBlockResults, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBlockResults, error) {
+		return rc.BlockResults(ctx, height)
+	})
+}
+
+func (c *FailoverRpcClient) Header(ctx context.Context, height *int64) (*coretypes.ResultHeader, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultHeader, error) {
+		return rc.Header(ctx, height)
+	})
+}
+
+func (c *FailoverRpcClient) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*coretypes.ResultHeader, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultHeader, error) {
+		return rc.HeaderByHash(ctx, hash)
+	})
+}
+
+func (c *FailoverRpcClient) Commit(ctx context.Context, height *int64) (*coretypes.ResultCommit, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultCommit, error) {
+		return rc.Commit(ctx, height)
+	})
+}
+
+func (c *FailoverRpcClient) Validators(ctx context.Context, height *int64, page, perPage *int) (*coretypes.ResultValidators, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultValidators, error) {
+		return rc.Validators(ctx, height, page, perPage)
+	})
+}
+
+func (c *FailoverRpcClient) Tx(ctx context.Context, hash []byte, prove bool) (*coretypes.ResultTx, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultTx, error) {
+		return rc.Tx(ctx, hash, prove)
+	})
+}
+
+func (c *FailoverRpcClient) TxSearch(ctx context.Context, query string, prove bool, page, perPage *int, orderBy string) (*coretypes.ResultTxSearch, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultTxSearch, error) {
+		return rc.TxSearch(ctx, query, prove, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverRpcClient) BlockSearch(ctx context.Context, query string, page, perPage *int, orderBy string) (*coretypes.ResultBlockSearch, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBlockSearch, error) {
+		return rc.BlockSearch(ctx, query, page, perPage, orderBy)
+	})
+}
+
+func (c *FailoverRpcClient) Genesis(ctx context.Context) (*coretypes.ResultGenesis, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultGenesis, error) {
+		return rc.Genesis(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) GenesisChunked(ctx context.Context, id uint) (*coretypes.ResultGenesisChunk, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultGenesisChunk, error) {
+		return rc.GenesisChunked(ctx, id)
+	})
+}
+
+func (c *FailoverRpcClient) BlockchainInfo(ctx context.Context, minHeight, maxHeight int64) (*coretypes.ResultBlockchainInfo, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBlockchainInfo, error) {
+		return rc.BlockchainInfo(ctx, minHeight, maxHeight)
+	})
+}
+
+func (c *FailoverRpcClient) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultStatus, error) {
+		return rc.Status(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) NetInfo(ctx context.Context) (*coretypes.ResultNetInfo, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultNetInfo, error) {
+		return rc.NetInfo(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) DumpConsensusState(ctx context.Context) (*coretypes.ResultDumpConsensusState, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultDumpConsensusState, error) {
+		return rc.DumpConsensusState(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) ConsensusState(ctx context.Context) (*coretypes.ResultConsensusState, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultConsensusState, error) {
+		return rc.ConsensusState(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) ConsensusParams(ctx context.Context, height *int64) (*coretypes.ResultConsensusParams, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultConsensusParams, error) {
+		return rc.ConsensusParams(ctx, height)
+	})
+}
+
+func (c *FailoverRpcClient) Health(ctx context.Context) (*coretypes.ResultHealth, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultHealth, error) {
+		return rc.Health(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) BroadcastEvidence(ctx context.Context, ev cmttypes.Evidence) (*coretypes.ResultBroadcastEvidence, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultBroadcastEvidence, error) {
+		return rc.BroadcastEvidence(ctx, ev)
+	})
+}
+
+func (c *FailoverRpcClient) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultUnconfirmedTxs, error) {
+		return rc.UnconfirmedTxs(ctx, limit)
+	})
+}
+
+func (c *FailoverRpcClient) NumUnconfirmedTxs(ctx context.Context) (*coretypes.ResultUnconfirmedTxs, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultUnconfirmedTxs, error) {
+		return rc.NumUnconfirmedTxs(ctx)
+	})
+}
+
+func (c *FailoverRpcClient) CheckTx(ctx context.Context, tx cmttypes.Tx) (*coretypes.ResultCheckTx, error) {
+	return withFailover(ctx, c, func(rc rpcclient.Client) (*coretypes.ResultCheckTx, error) {
+		return rc.CheckTx(ctx, tx)
+	})
+}
+
+// Subscribe subscribes on the current endpoint. The event listener follows the election with its
+// own websocket connection; this is only here to satisfy rpcclient.Client.
+func (c *FailoverRpcClient) Subscribe(ctx context.Context, subscriber, query string, outCapacity ...int) (<-chan coretypes.ResultEvent, error) {
+	client, err := c.client(c.endpoints.Current())
+	if err != nil {
+		return nil, err
+	}
+	if !client.IsRunning() {
+		if err := client.Start(); err != nil {
+			return nil, err
+		}
+	}
+	return client.Subscribe(ctx, subscriber, query, outCapacity...)
+}
+
+func (c *FailoverRpcClient) Unsubscribe(ctx context.Context, subscriber, query string) error {
+	return c.forEachRunning(func(client *http.HTTP) error {
+		return client.Unsubscribe(ctx, subscriber, query)
+	})
+}
+
+func (c *FailoverRpcClient) UnsubscribeAll(ctx context.Context, subscriber string) error {
+	return c.forEachRunning(func(client *http.HTTP) error {
+		return client.UnsubscribeAll(ctx, subscriber)
+	})
+}
+
+func (c *FailoverRpcClient) forEachRunning(fn func(*http.HTTP) error) error {
+	c.mu.Lock()
+	clients := make([]*http.HTTP, 0, len(c.clients))
+	for _, client := range c.clients {
+		if client.IsRunning() {
+			clients = append(clients, client)
+		}
+	}
+	c.mu.Unlock()
+
+	var errs []error
+	for _, client := range clients {
+		if err := fn(client); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,222 @@
+package cosmosclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"decentralized-api/apiconfig"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// The remote signer protocol has two unary gRPC methods on well-known types, so a signer can be
+// written in any language without shared proto files:
+//
+//	GetPubKey(google.protobuf.Empty) returns (google.protobuf.BytesValue) // 33-byte compressed secp256k1 key
+//	Sign(google.protobuf.BytesValue) returns (google.protobuf.BytesValue) // 64-byte r||s signature of sha256(msg), low-s
+//
+// This is the signature format of the cosmos-sdk secp256k1 keys.
+const (
+	remoteSignerService      = "gonka.signer.v1.RemoteSigner"
+	remoteSignerPubKeyMethod = "/" + remoteSignerService + "/GetPubKey"
+	remoteSignerSignMethod   = "/" + remoteSignerService + "/Sign"
+)
+
+// RemoteSigner signs with a secp256k1 key kept off the API host, e.g. in an HSM or on a hardened signing host
+type RemoteSigner interface {
+	PubKey(ctx context.Context) (cryptotypes.PubKey, error)
+	Sign(ctx context.Context, msg []byte) ([]byte, error)
+}
+
+type grpcRemoteSigner struct {
+	conn *grpc.ClientConn
+}
+
+func NewGrpcRemoteSigner(cfg apiconfig.RemoteSignerConfig) (RemoteSigner, error) {
+	creds, err := remoteSignerCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(cfg.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("connect to remote signer %s: %w", cfg.Address, err)
+	}
+	return &grpcRemoteSigner{conn: conn}, nil
+}
+
+func remoteSignerCredentials(cfg apiconfig.RemoteSignerConfig) (credentials.TransportCredentials, error) {
+	if cfg.Insecure {
+		return insecure.NewCredentials(), nil
+	}
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.CaFile != "" {
+		pem, err := os.ReadFile(cfg.CaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read remote signer CA: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in remote signer CA %s", cfg.CaFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load remote signer client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return credentials.NewTLS(tlsConfig), nil
+}
+
+func (s *grpcRemoteSigner) PubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	out := new(wrapperspb.BytesValue)
+	if err := s.conn.Invoke(ctx, remoteSignerPubKeyMethod, &emptypb.Empty{}, out); err != nil {
+		return nil, err
+	}
+	if len(out.Value) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("remote signer returned a %d-byte public key, expected %d", len(out.Value), secp256k1.PubKeySize)
+	}
+	return &secp256k1.PubKey{Key: out.Value}, nil
+}
+
+func (s *grpcRemoteSigner) Sign(ctx context.Context, msg []byte) ([]byte, error) {
+	out := new(wrapperspb.BytesValue)
+	if err := s.conn.Invoke(ctx, remoteSignerSignMethod, wrapperspb.Bytes(msg), out); err != nil {
+		return nil, err
+	}
+	return out.Value, nil
+}
+
+// RegisterRemoteSignerServer serves signer over the remote signer protocol, for signing hosts written in Go
+func RegisterRemoteSignerServer(server *grpc.Server, signer RemoteSigner) {
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: remoteSignerService,
+		HandlerType: (*RemoteSigner)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "GetPubKey",
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					if err := dec(new(emptypb.Empty)); err != nil {
+						return nil, err
+					}
+					pubKey, err := srv.(RemoteSigner).PubKey(ctx)
+					if err != nil {
+						return nil, err
+					}
+					return wrapperspb.Bytes(pubKey.Bytes()), nil
+				},
+			},
+			{
+				MethodName: "Sign",
+				Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+					in := new(wrapperspb.BytesValue)
+					if err := dec(in); err != nil {
+						return nil, err
+					}
+					signature, err := srv.(RemoteSigner).Sign(ctx, in.Value)
+					if err != nil {
+						return nil, err
+					}
+					return wrapperspb.Bytes(signature), nil
+				},
+			},
+		},
+	}, signer)
+}
+
+// remoteKeyring serves the key named uid from a RemoteSigner and every other key from the local keyring.
+// The API only ever sees the public key: exporting, encrypting or decrypting with the remote key fails.
+type remoteKeyring struct {
+	keyring.Keyring
+	uid     string
+	record  *keyring.Record
+	pubKey  cryptotypes.PubKey
+	signer  RemoteSigner
+	timeout time.Duration
+}
+
+// NewRemoteSignerKeyring wraps local so that the key named uid signs through signer. It fetches the
+// public key once, so a misconfigured signer fails at startup rather than at the first transaction.
+func NewRemoteSignerKeyring(ctx context.Context, local keyring.Keyring, uid string, signer RemoteSigner, timeout time.Duration) (keyring.Keyring, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	pubKey, err := signer.PubKey(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get public key from remote signer: %w", err)
+	}
+	record, err := keyring.NewOfflineRecord(uid, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &remoteKeyring{Keyring: local, uid: uid, record: record, pubKey: pubKey, signer: signer, timeout: timeout}, nil
+}
+
+func (k *remoteKeyring) isRemote(address sdk.Address) bool {
+	return bytes.Equal(address.Bytes(), k.pubKey.Address())
+}
+
+func (k *remoteKeyring) List() ([]*keyring.Record, error) {
+	records, err := k.Keyring.List()
+	if err != nil {
+		return nil, err
+	}
+	return append(records, k.record), nil
+}
+
+func (k *remoteKeyring) Key(uid string) (*keyring.Record, error) {
+	if uid == k.uid {
+		return k.record, nil
+	}
+	return k.Keyring.Key(uid)
+}
+
+func (k *remoteKeyring) KeyByAddress(address sdk.Address) (*keyring.Record, error) {
+	if k.isRemote(address) {
+		return k.record, nil
+	}
+	return k.Keyring.KeyByAddress(address)
+}
+
+func (k *remoteKeyring) Sign(uid string, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	if uid != k.uid {
+		return k.Keyring.Sign(uid, msg, signMode)
+	}
+	return k.signRemote(msg)
+}
+
+func (k *remoteKeyring) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	if !k.isRemote(address) {
+		return k.Keyring.SignByAddress(address, msg, signMode)
+	}
+	return k.signRemote(msg)
+}
+
+func (k *remoteKeyring) signRemote(msg []byte) ([]byte, cryptotypes.PubKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), k.timeout)
+	defer cancel()
+	signature, err := k.signer.Sign(ctx, msg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("remote signer: %w", err)
+	}
+	// A signature the chain would reject is caught here, with a clear error, instead of in CheckTx
+	if !k.pubKey.VerifySignature(msg, signature) {
+		return nil, nil, errors.New("remote signer returned an invalid signature")
+	}
+	return signature, k.pubKey, nil
+}
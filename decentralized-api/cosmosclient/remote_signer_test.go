@@ -0,0 +1,102 @@
+package cosmosclient
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type localSigner struct {
+	key     *secp256k1.PrivKey
+	corrupt bool
+}
+
+func (s *localSigner) PubKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	return s.key.PubKey(), nil
+}
+
+func (s *localSigner) Sign(ctx context.Context, msg []byte) ([]byte, error) {
+	if s.corrupt {
+		msg = append(msg, 'x')
+	}
+	return s.key.Sign(msg)
+}
+
+func startRemoteSigner(t *testing.T, signer RemoteSigner) RemoteSigner {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, signer)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///remote-signer",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return &grpcRemoteSigner{conn: conn}
+}
+
+func newLocalKeyring(t *testing.T) keyring.Keyring {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry))
+	_, _, err := kr.NewMnemonic("local", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	return kr
+}
+
+func TestRemoteSignerKeyring(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+	signer := startRemoteSigner(t, &localSigner{key: key})
+	kr, err := NewRemoteSignerKeyring(context.Background(), newLocalKeyring(t), "warm", signer, time.Second)
+	require.NoError(t, err)
+
+	record, err := kr.Key("warm")
+	require.NoError(t, err)
+	pubKey, err := record.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, key.PubKey().Equals(pubKey))
+	byAddress, err := kr.KeyByAddress(sdk.AccAddress(key.PubKey().Address()))
+	require.NoError(t, err)
+	require.Equal(t, "warm", byAddress.Name)
+
+	msg := []byte("sign doc")
+	signature, signedWith, err := kr.Sign("warm", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, key.PubKey().VerifySignature(msg, signature))
+	require.True(t, key.PubKey().Equals(signedWith))
+	_, _, err = kr.SignByAddress(sdk.AccAddress(key.PubKey().Address()), msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+
+	// Other keys still sign locally
+	_, _, err = kr.Sign("local", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	records, err := kr.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+}
+
+func TestRemoteSignerKeyringRejectsInvalidSignatures(t *testing.T) {
+	signer := startRemoteSigner(t, &localSigner{key: secp256k1.GenPrivKey(), corrupt: true})
+	kr, err := NewRemoteSignerKeyring(context.Background(), newLocalKeyring(t), "warm", signer, time.Second)
+	require.NoError(t, err)
+	_, _, err = kr.Sign("warm", []byte("sign doc"), signing.SignMode_SIGN_MODE_DIRECT)
+	require.ErrorContains(t, err, "invalid signature")
+}
@@ -2,12 +2,14 @@ package cosmosclient
 
 import (
 	"context"
+	"decentralized-api/apiconfig"
+
 	"github.com/cometbft/cometbft/rpc/client/http"
 	coretypes "github.com/cometbft/cometbft/rpc/core/types"
 )
 
 type TendermintClient struct {
-	ChainNodeUrl string
+	Endpoints apiconfig.ChainEndpoints
 }
 
 // NewRpcClient Can be used to query Block, Validators, and other data from the Cosmos SDK node.
@@ -16,7 +18,7 @@ func NewRpcClient(address string) (*http.HTTP, error) {
 }
 
 func (c *TendermintClient) Status() (*coretypes.ResultStatus, error) {
-	client, err := NewRpcClient(c.ChainNodeUrl)
+	client, err := NewRpcClient(c.Endpoints.Current())
 	if err != nil {
 		return nil, err
 	}
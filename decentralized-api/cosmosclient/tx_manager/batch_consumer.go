@@ -3,6 +3,8 @@ package tx_manager
 import (
 	"decentralized-api/internal/nats/server"
 	"decentralized-api/logging"
+	"errors"
+	"slices"
 	"sync"
 	"time"
 
@@ -16,7 +18,11 @@ const (
 	batchStartConsumer        = "batch-start-consumer"
 	batchFinishConsumer       = "batch-finish-consumer"
 	batchValidationV2Consumer = "batch-validation-v2-consumer"
+	batchValidationConsumer   = "batch-validation-consumer"
 	batchAckWait              = time.Minute // must exceed FlushTimeout to prevent redelivery
+	// validationResultGrace is how long past ValidationFlushTimeout a caller waits for its validation's
+	// broadcast result, covering the broadcast itself and the one-by-one fallback
+	validationResultGrace = 30 * time.Second
 
 	// V1 PoC batch consumers
 	batchPocBatchConsumer      = "batch-poc-batch-consumer"
@@ -28,6 +34,14 @@ type BatchConfig struct {
 	FlushTimeout             time.Duration
 	ValidationV2FlushSize    int
 	ValidationV2FlushTimeout time.Duration
+	ValidationFlushSize      int
+	ValidationFlushTimeout   time.Duration
+}
+
+// inferenceMsg is implemented by messages about a single inference, such as MsgValidation
+type inferenceMsg interface {
+	sdk.Msg
+	GetInferenceId() string
 }
 
 type pendingMsg struct {
@@ -44,6 +58,7 @@ type BatchConsumer struct {
 	startBatch        []pendingMsg
 	finishBatch       []pendingMsg
 	validationV2Batch []pendingMsg
+	validationBatch   []pendingMsg
 
 	// V1 PoC batches
 	pocBatchBatch      []pendingMsg
@@ -52,6 +67,7 @@ type BatchConsumer struct {
 	startMu        sync.Mutex
 	finishMu       sync.Mutex
 	validationV2Mu sync.Mutex
+	validationMu   sync.Mutex
 
 	// V1 PoC mutexes
 	pocBatchMu      sync.Mutex
@@ -60,10 +76,15 @@ type BatchConsumer struct {
 	startCreatedAt        time.Time
 	finishCreatedAt       time.Time
 	validationV2CreatedAt time.Time
+	validationCreatedAt   time.Time
 
 	// V1 PoC timestamps
 	pocBatchCreatedAt      time.Time
 	pocValidationCreatedAt time.Time
+
+	// Callers waiting for the broadcast result of their validation, by inference id
+	validationWaiters   map[string][]chan error
+	validationWaitersMu sync.Mutex
 }
 
 func NewBatchConsumer(
@@ -80,8 +101,10 @@ func NewBatchConsumer(
 		startBatch:         make([]pendingMsg, 0, config.FlushSize),
 		finishBatch:        make([]pendingMsg, 0, config.FlushSize),
 		validationV2Batch:  make([]pendingMsg, 0, config.ValidationV2FlushSize),
+		validationBatch:    make([]pendingMsg, 0, config.ValidationFlushSize),
 		pocBatchBatch:      make([]pendingMsg, 0, config.FlushSize),
 		pocValidationBatch: make([]pendingMsg, 0, config.FlushSize),
+		validationWaiters:  make(map[string][]chan error),
 	}
 }
 
@@ -95,6 +118,9 @@ func (c *BatchConsumer) Start() error {
 	if err := c.subscribeStream(server.TxsBatchValidationV2Stream, batchValidationV2Consumer, c.handleValidationV2Msg); err != nil {
 		return err
 	}
+	if err := c.subscribeStream(server.TxsBatchValidationStream, batchValidationConsumer, c.handleValidationMsg); err != nil {
+		return err
+	}
 	// V1 PoC streams
 	if err := c.subscribeStream(server.TxsBatchPocBatchStream, batchPocBatchConsumer, c.handlePocBatchMsg); err != nil {
 		return err
@@ -194,6 +220,31 @@ func (c *BatchConsumer) handleValidationV2Msg(msg *nats.Msg) {
 	}
 }
 
+func (c *BatchConsumer) handleValidationMsg(msg *nats.Msg) {
+	if err := msg.InProgress(); err != nil {
+		logging.Error("Failed to mark validation msg in progress", types.Messages, "error", err)
+	}
+	sdkMsg, err := c.unmarshalMsg(msg.Data)
+	if err != nil {
+		logging.Error("Failed to unmarshal validation msg", types.Messages, "error", err)
+		msg.Term()
+		return
+	}
+
+	var shouldFlush bool
+	c.validationMu.Lock()
+	if len(c.validationBatch) == 0 {
+		c.validationCreatedAt = time.Now()
+	}
+	c.validationBatch = append(c.validationBatch, pendingMsg{msg: sdkMsg, natsMsg: msg})
+	shouldFlush = len(c.validationBatch) >= c.config.ValidationFlushSize
+	c.validationMu.Unlock()
+
+	if shouldFlush {
+		c.flushValidation()
+	}
+}
+
 func (c *BatchConsumer) handlePocBatchMsg(msg *nats.Msg) {
 	if err := msg.InProgress(); err != nil {
 		logging.Error("Failed to mark poc batch msg in progress", types.Messages, "error", err)
@@ -253,6 +304,7 @@ func (c *BatchConsumer) flushLoop() {
 		c.checkAndFlushStart()
 		c.checkAndFlushFinish()
 		c.checkAndFlushValidationV2()
+		c.checkAndFlushValidation()
 		c.checkAndFlushPocBatch()
 		c.checkAndFlushPocValidation()
 	}
@@ -277,6 +329,12 @@ func (c *BatchConsumer) extendAckDeadlines() {
 	}
 	c.validationV2Mu.Unlock()
 
+	c.validationMu.Lock()
+	for _, p := range c.validationBatch {
+		_ = p.natsMsg.InProgress()
+	}
+	c.validationMu.Unlock()
+
 	c.pocBatchMu.Lock()
 	for _, p := range c.pocBatchBatch {
 		_ = p.natsMsg.InProgress()
@@ -320,6 +378,16 @@ func (c *BatchConsumer) checkAndFlushValidationV2() {
 	}
 }
 
+func (c *BatchConsumer) checkAndFlushValidation() {
+	c.validationMu.Lock()
+	shouldFlush := len(c.validationBatch) > 0 && time.Since(c.validationCreatedAt) >= c.config.ValidationFlushTimeout
+	c.validationMu.Unlock()
+
+	if shouldFlush {
+		c.flushValidation()
+	}
+}
+
 func (c *BatchConsumer) checkAndFlushPocBatch() {
 	c.pocBatchMu.Lock()
 	shouldFlush := len(c.pocBatchBatch) > 0 && time.Since(c.pocBatchCreatedAt) >= c.config.FlushTimeout
@@ -385,6 +453,105 @@ func (c *BatchConsumer) flushValidationV2() {
 	c.broadcastAggregatedValidationV2(aggregated, batch)
 }
 
+// flushValidation sends the accumulated MsgValidation messages as a single multi-message transaction,
+// falling back to one transaction per validation when the batch is rejected, and reports the result of
+// every validation to the callers waiting for it
+func (c *BatchConsumer) flushValidation() {
+	c.validationMu.Lock()
+	batch := c.validationBatch
+	if len(batch) == 0 {
+		c.validationMu.Unlock()
+		return
+	}
+	c.validationBatch = make([]pendingMsg, 0, c.config.ValidationFlushSize)
+	c.validationCreatedAt = time.Time{} // reset timer
+	c.validationMu.Unlock()
+
+	msgs := make([]sdk.Msg, len(batch))
+	for i, p := range batch {
+		msgs[i] = p.msg
+	}
+	logging.Info("Broadcasting batch", types.Messages, "type", "validation", "count", len(msgs))
+
+	results := make([]error, len(batch))
+	err := c.txManager.SendBatchAsyncWithRetry(msgs)
+	var txErr *TransactionError
+	if len(batch) > 1 && errors.As(err, &txErr) {
+		// The ante handler rejects the whole transaction when a single validation is stale (duplicate,
+		// inference pruned, validator left the epoch group), so retry the validations one by one
+		logging.Warn("Validation batch rejected, broadcasting validations individually", types.Messages,
+			"count", len(batch), "code", txErr.Code, "rawLog", txErr.RawLog)
+		for i, p := range batch {
+			_, results[i] = c.txManager.SendTransactionAsyncWithRetry(p.msg)
+		}
+	} else {
+		if err != nil {
+			logging.Error("Failed to hand off batch to TxManager", types.Messages, "type", "validation", "error", err)
+		}
+		for i := range results {
+			results[i] = err
+		}
+	}
+
+	for i, p := range batch {
+		p.natsMsg.Ack()
+		if v, ok := p.msg.(inferenceMsg); ok {
+			c.notifyValidationWaiters(v.GetInferenceId(), results[i])
+		}
+	}
+}
+
+// PublishValidationAndWait publishes the validation and waits for the broadcast result of its batch, so
+// that the validation queue can retry rejected validations. When no result arrives in time, e.g. because
+// the batch is replayed after a restart, the validation stays in the stream and nil is returned.
+func (c *BatchConsumer) PublishValidationAndWait(msg inferenceMsg) error {
+	inferenceId := msg.GetInferenceId()
+	result := make(chan error, 1)
+	c.validationWaitersMu.Lock()
+	c.validationWaiters[inferenceId] = append(c.validationWaiters[inferenceId], result)
+	c.validationWaitersMu.Unlock()
+
+	if err := c.PublishValidation(msg); err != nil {
+		c.removeValidationWaiter(inferenceId, result)
+		return err
+	}
+
+	timeout := c.config.ValidationFlushTimeout + validationResultGrace
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-result:
+		return err
+	case <-timer.C:
+		c.removeValidationWaiter(inferenceId, result)
+		logging.Warn("No broadcast result for validation yet, leaving it to the batch stream", types.Messages,
+			"inferenceId", inferenceId, "timeout", timeout)
+		return nil
+	}
+}
+
+func (c *BatchConsumer) notifyValidationWaiters(inferenceId string, err error) {
+	c.validationWaitersMu.Lock()
+	waiters := c.validationWaiters[inferenceId]
+	delete(c.validationWaiters, inferenceId)
+	c.validationWaitersMu.Unlock()
+
+	for _, waiter := range waiters {
+		waiter <- err
+	}
+}
+
+func (c *BatchConsumer) removeValidationWaiter(inferenceId string, result chan error) {
+	c.validationWaitersMu.Lock()
+	defer c.validationWaitersMu.Unlock()
+	waiters := slices.DeleteFunc(c.validationWaiters[inferenceId], func(w chan error) bool { return w == result })
+	if len(waiters) == 0 {
+		delete(c.validationWaiters, inferenceId)
+		return
+	}
+	c.validationWaiters[inferenceId] = waiters
+}
+
 func (c *BatchConsumer) flushPocBatch() {
 	c.pocBatchMu.Lock()
 	batch := c.pocBatchBatch
@@ -512,6 +679,10 @@ func (c *BatchConsumer) PublishPocValidationV2(msg sdk.Msg) error {
 	return c.publishMsg(server.TxsBatchValidationV2Stream, msg)
 }
 
+func (c *BatchConsumer) PublishValidation(msg sdk.Msg) error {
+	return c.publishMsg(server.TxsBatchValidationStream, msg)
+}
+
 // V1 PoC publish methods
 func (c *BatchConsumer) PublishPocBatch(msg sdk.Msg) error {
 	return c.publishMsg(server.TxsBatchPocBatchStream, msg)
@@ -26,6 +26,9 @@ import (
 
 type mockTxManager struct {
 	sendBatchCalls [][]sdk.Msg
+	sendCalls      []sdk.Msg
+	batchErr       error
+	rejected       map[string]bool // inference ids rejected when sent on their own
 	mu             sync.Mutex
 }
 
@@ -33,7 +36,7 @@ func (m *mockTxManager) SendBatchAsyncWithRetry(msgs []sdk.Msg, deadlineBlock ..
 	m.mu.Lock()
 	m.sendBatchCalls = append(m.sendBatchCalls, msgs)
 	m.mu.Unlock()
-	return nil
+	return m.batchErr
 }
 
 func (m *mockTxManager) getBatchCalls() [][]sdk.Msg {
@@ -42,7 +45,13 @@ func (m *mockTxManager) getBatchCalls() [][]sdk.Msg {
 	return m.sendBatchCalls
 }
 
-func (m *mockTxManager) SendTransactionAsyncWithRetry(sdk.Msg, ...int64) (*sdk.TxResponse, error) {
+func (m *mockTxManager) SendTransactionAsyncWithRetry(msg sdk.Msg, _ ...int64) (*sdk.TxResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sendCalls = append(m.sendCalls, msg)
+	if v, ok := msg.(inferenceMsg); ok && m.rejected[v.GetInferenceId()] {
+		return nil, &TransactionError{Code: 1106, Codespace: "inference", RawLog: "duplicate validation"}
+	}
 	return &sdk.TxResponse{}, nil
 }
 func (m *mockTxManager) SendTransactionAsyncNoRetry(sdk.Msg) (*sdk.TxResponse, error) {
@@ -114,6 +123,13 @@ func startTestNatsServer(t *testing.T) (*server.Server, nats.JetStreamContext) {
 	})
 	require.NoError(t, err)
 
+	_, err = js.AddStream(&nats.StreamConfig{
+		Name:     "txs_batch_validation",
+		Subjects: []string{"txs_batch_validation"},
+		Storage:  nats.MemoryStorage,
+	})
+	require.NoError(t, err)
+
 	// V1 PoC streams
 	_, err = js.AddStream(&nats.StreamConfig{
 		Name:     "txs_batch_poc_batch",
@@ -335,6 +351,96 @@ func TestBatchConsumer_ValidationV2Batching(t *testing.T) {
 	assert.Len(t, calls[0], 10)
 }
 
+func TestBatchConsumer_ValidationBatching(t *testing.T) {
+	_, js := startTestNatsServer(t)
+	cdc := getTestCodec(t)
+
+	mockMgr := &mockTxManager{}
+
+	config := BatchConfig{
+		FlushSize:              3,
+		FlushTimeout:           10 * time.Second,
+		ValidationFlushSize:    4,
+		ValidationFlushTimeout: 2 * time.Second,
+	}
+
+	consumer := NewBatchConsumer(js, cdc, mockMgr, config)
+	err := consumer.Start()
+	require.NoError(t, err)
+
+	// 4 validations fill a batch, the remaining 2 are flushed on timeout
+	for i := 0; i < 6; i++ {
+		msg := &inference.MsgValidation{
+			Creator:     "creator",
+			Id:          uuid.New().String(),
+			InferenceId: uuid.New().String(),
+		}
+		err := consumer.PublishValidation(msg)
+		require.NoError(t, err)
+	}
+
+	time.Sleep(500 * time.Millisecond)
+	calls := mockMgr.getBatchCalls()
+	require.Len(t, calls, 1)
+	assert.Len(t, calls[0], 4)
+
+	time.Sleep(3 * time.Second)
+	calls = mockMgr.getBatchCalls()
+	require.Len(t, calls, 2)
+	assert.Len(t, calls[1], 2)
+}
+
+func TestBatchConsumer_ValidationBatchRejected(t *testing.T) {
+	_, js := startTestNatsServer(t)
+	cdc := getTestCodec(t)
+
+	// The batch is rejected because of one stale validation
+	mockMgr := &mockTxManager{
+		batchErr: &TransactionError{Code: 1106, Codespace: "inference", RawLog: "duplicate validation"},
+		rejected: map[string]bool{"stale": true},
+	}
+
+	config := BatchConfig{
+		FlushSize:              3,
+		FlushTimeout:           10 * time.Second,
+		ValidationFlushSize:    3,
+		ValidationFlushTimeout: 10 * time.Second,
+	}
+
+	consumer := NewBatchConsumer(js, cdc, mockMgr, config)
+	err := consumer.Start()
+	require.NoError(t, err)
+
+	results := make(map[string]error)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, inferenceId := range []string{"fresh-1", "stale", "fresh-2"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := consumer.PublishValidationAndWait(&inference.MsgValidation{
+				Creator:     "creator",
+				Id:          uuid.New().String(),
+				InferenceId: inferenceId,
+			})
+			mu.Lock()
+			results[inferenceId] = err
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	// The validations are retried one by one and only the stale one reports an error
+	require.Len(t, mockMgr.getBatchCalls(), 1)
+	mockMgr.mu.Lock()
+	assert.Len(t, mockMgr.sendCalls, 3)
+	mockMgr.mu.Unlock()
+	assert.NoError(t, results["fresh-1"])
+	assert.NoError(t, results["fresh-2"])
+	var txErr *TransactionError
+	assert.ErrorAs(t, results["stale"], &txErr)
+}
+
 func TestBatchConsumer_AllQueuesIndependent(t *testing.T) {
 	_, js := startTestNatsServer(t)
 	cdc := getTestCodec(t)
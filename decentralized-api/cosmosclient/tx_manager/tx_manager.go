@@ -3,6 +3,7 @@ package tx_manager
 import (
 	"context"
 	"decentralized-api/apiconfig"
+	"decentralized-api/internal/metrics"
 	"decentralized-api/internal/nats/server"
 	"decentralized-api/logging"
 	"encoding/hex"
@@ -818,9 +819,11 @@ func (m *manager) BroadcastMessages(id string, msgs ...sdk.Msg) (*sdk.TxResponse
 
 	resp, err := m.client.Context().BroadcastTxSync(txBytes)
 	if err != nil {
+		metrics.TxSubmissionFailures.WithLabelValues("batch").Inc()
 		return nil, time.Time{}, err
 	}
 	if resp.Code != 0 {
+		metrics.TxSubmissionFailures.WithLabelValues("batch").Inc()
 		logging.Error("Batch broadcast failed", types.Messages, "code", resp.Code, "rawLog", resp.RawLog, "tx_id", id, "msgCount", len(msgs))
 	} else {
 		logging.Debug("Batch broadcast successful", types.Messages, "tx_id", id, "msgCount", len(msgs))
@@ -858,9 +861,11 @@ func (m *manager) broadcastMessage(id string, rawTx sdk.Msg) (*sdk.TxResponse, t
 
 	resp, err := m.client.Context().BroadcastTxSync(txBytes)
 	if err != nil {
+		metrics.TxSubmissionFailures.WithLabelValues(originalMsgType).Inc()
 		return nil, time.Time{}, err
 	}
 	if resp.Code != 0 {
+		metrics.TxSubmissionFailures.WithLabelValues(originalMsgType).Inc()
 		logging.Error("Broadcast failed immediately", types.Messages, "code", resp.Code, "rawLog", resp.RawLog, "tx_id", id, "originalMsgType", originalMsgType)
 	} else {
 		logging.Debug("Broadcast successful", types.Messages, "tx_id", id, "originalMsgType", originalMsgType, "resp", resp)
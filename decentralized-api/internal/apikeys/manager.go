@@ -0,0 +1,260 @@
+package apikeys
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	keyPrefix   = "gonka-"
+	qpsWindow   = time.Second
+	tokenWindow = time.Minute
+)
+
+var (
+	ErrKeyNotFound   = errors.New("api key not found")
+	ErrInvalidLimits = errors.New("limits must not be negative")
+)
+
+// ApiKey is a client key for the public inference endpoints and its limits.
+// Zero limits mean unlimited.
+type ApiKey struct {
+	Id                 string    `json:"id"`
+	Name               string    `json:"name"`
+	MaxQps             int64     `json:"max_qps"`
+	MaxTokensPerMinute int64     `json:"max_tokens_per_minute"`
+	Disabled           bool      `json:"disabled"`
+	CreatedAt          time.Time `json:"created_at"`
+
+	keyHash string
+}
+
+// Limits are the updatable settings of a key
+type Limits struct {
+	MaxQps             int64 `json:"max_qps"`
+	MaxTokensPerMinute int64 `json:"max_tokens_per_minute"`
+	Disabled           bool  `json:"disabled"`
+}
+
+func (l Limits) validate() error {
+	if l.MaxQps < 0 || l.MaxTokensPerMinute < 0 {
+		return ErrInvalidLimits
+	}
+	return nil
+}
+
+// Usage is the current sliding-window usage of a key
+type Usage struct {
+	Qps             float64 `json:"qps"`
+	TokensPerMinute float64 `json:"tokens_per_minute"`
+}
+
+type keyState struct {
+	key    ApiKey
+	qps    *slidingWindow
+	tokens *slidingWindow
+}
+
+// Manager holds the API keys in memory, persists changes and enforces the per-key limits.
+// Usage windows are kept in memory only and start empty after a restart.
+type Manager struct {
+	mu     sync.Mutex
+	store  keyStore
+	keys   map[string]*keyState // by id
+	byHash map[string]string    // key hash -> id
+	now    func() time.Time
+}
+
+// NewManager loads the keys stored in the database
+func NewManager(ctx context.Context, db *sql.DB) (*Manager, error) {
+	return newManager(ctx, newSqlKeyStore(db))
+}
+
+func newManager(ctx context.Context, store keyStore) (*Manager, error) {
+	m := &Manager{
+		store:  store,
+		keys:   make(map[string]*keyState),
+		byHash: make(map[string]string),
+		now:    time.Now,
+	}
+	keys, err := store.LoadAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		m.add(key)
+	}
+	return m, nil
+}
+
+func (m *Manager) add(key ApiKey) {
+	m.keys[key.Id] = &keyState{
+		key:    key,
+		qps:    newSlidingWindow(qpsWindow),
+		tokens: newSlidingWindow(tokenWindow),
+	}
+	m.byHash[key.keyHash] = key.Id
+}
+
+// Create generates a new key. The returned secret is shown once: only its hash is stored.
+func (m *Manager) Create(ctx context.Context, name string, limits Limits) (ApiKey, string, error) {
+	if err := limits.validate(); err != nil {
+		return ApiKey{}, "", err
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return ApiKey{}, "", err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return ApiKey{}, "", err
+	}
+	secret = keyPrefix + id + "-" + secret
+
+	key := ApiKey{
+		Id:                 id,
+		Name:               name,
+		MaxQps:             limits.MaxQps,
+		MaxTokensPerMinute: limits.MaxTokensPerMinute,
+		Disabled:           limits.Disabled,
+		CreatedAt:          m.now().UTC().Truncate(time.Second),
+		keyHash:            hashKey(secret),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.store.Save(ctx, key); err != nil {
+		return ApiKey{}, "", err
+	}
+	m.add(key)
+	return key, secret, nil
+}
+
+// Update changes the limits of a key, keeping its current usage
+func (m *Manager) Update(ctx context.Context, id string, limits Limits) (ApiKey, error) {
+	if err := limits.validate(); err != nil {
+		return ApiKey{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.keys[id]
+	if !ok {
+		return ApiKey{}, ErrKeyNotFound
+	}
+	key := state.key
+	key.MaxQps = limits.MaxQps
+	key.MaxTokensPerMinute = limits.MaxTokensPerMinute
+	key.Disabled = limits.Disabled
+	if err := m.store.Save(ctx, key); err != nil {
+		return ApiKey{}, err
+	}
+	state.key = key
+	return key, nil
+}
+
+func (m *Manager) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.keys[id]
+	if !ok {
+		return ErrKeyNotFound
+	}
+	if err := m.store.Delete(ctx, id); err != nil {
+		return err
+	}
+	delete(m.byHash, state.key.keyHash)
+	delete(m.keys, id)
+	return nil
+}
+
+// List returns every key with its current usage, oldest first
+func (m *Manager) List() ([]ApiKey, map[string]Usage) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	keys := make([]ApiKey, 0, len(m.keys))
+	usage := make(map[string]Usage, len(m.keys))
+	for id, state := range m.keys {
+		keys = append(keys, state.key)
+		usage[id] = Usage{Qps: state.qps.usage(now), TokensPerMinute: state.tokens.usage(now)}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].CreatedAt.Equal(keys[j].CreatedAt) {
+			return keys[i].CreatedAt.Before(keys[j].CreatedAt)
+		}
+		return keys[i].Id < keys[j].Id
+	})
+	return keys, usage
+}
+
+// Authenticate returns the id of the enabled key matching secret
+func (m *Manager) Authenticate(secret string) (string, bool) {
+	if !strings.HasPrefix(secret, keyPrefix) {
+		return "", false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, ok := m.byHash[hashKey(secret)]
+	if !ok || m.keys[id].key.Disabled {
+		return "", false
+	}
+	return id, true
+}
+
+// AllowRequest counts a request against the QPS limit of the key.
+// When over the limit it returns how long the client should wait before retrying.
+func (m *Manager) AllowRequest(id string) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.keys[id]
+	if !ok {
+		return 0, false
+	}
+	if state.key.MaxQps == 0 {
+		return 0, true
+	}
+	return state.qps.take(m.now(), 1, state.key.MaxQps)
+}
+
+// AllowTokens counts the tokens a request may consume against the per-minute token limit of the key
+func (m *Manager) AllowTokens(id string, tokens int64) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.keys[id]
+	if !ok {
+		return 0, false
+	}
+	if state.key.MaxTokensPerMinute == 0 {
+		return 0, true
+	}
+	return state.tokens.take(m.now(), tokens, state.key.MaxTokensPerMinute)
+}
+
+// RetryAfterSeconds rounds a wait up to the whole seconds of a Retry-After header
+func RetryAfterSeconds(wait time.Duration) int {
+	return int(math.Max(1, math.Ceil(wait.Seconds())))
+}
+
+func hashKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,128 @@
+package apikeys
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(context.Background(), db))
+
+	m, err := NewManager(context.Background(), db)
+	require.NoError(t, err)
+	return m
+}
+
+func TestManager_KeyLifecycle(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t)
+
+	key, secret, err := m.Create(ctx, "client-a", Limits{MaxQps: 5})
+	require.NoError(t, err)
+
+	id, ok := m.Authenticate(secret)
+	require.True(t, ok)
+	require.Equal(t, key.Id, id)
+
+	_, ok = m.Authenticate(secret + "x")
+	require.False(t, ok)
+
+	// Keys survive a reload from the database
+	reloaded, err := newManager(ctx, m.store)
+	require.NoError(t, err)
+	id, ok = reloaded.Authenticate(secret)
+	require.True(t, ok)
+	require.Equal(t, key.Id, id)
+	keys, _ := reloaded.List()
+	require.Len(t, keys, 1)
+	require.Equal(t, "client-a", keys[0].Name)
+	require.Equal(t, int64(5), keys[0].MaxQps)
+
+	_, err = m.Update(ctx, key.Id, Limits{MaxQps: 5, Disabled: true})
+	require.NoError(t, err)
+	_, ok = m.Authenticate(secret)
+	require.False(t, ok)
+
+	_, err = m.Update(ctx, key.Id, Limits{MaxQps: -1})
+	require.ErrorIs(t, err, ErrInvalidLimits)
+
+	require.NoError(t, m.Delete(ctx, key.Id))
+	require.ErrorIs(t, m.Delete(ctx, key.Id), ErrKeyNotFound)
+	keys, _ = m.List()
+	require.Empty(t, keys)
+}
+
+func TestManager_Limits(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	m.now = func() time.Time { return now }
+
+	key, _, err := m.Create(ctx, "client-a", Limits{MaxQps: 2, MaxTokensPerMinute: 1000})
+	require.NoError(t, err)
+
+	_, ok := m.AllowRequest(key.Id)
+	require.True(t, ok)
+	_, ok = m.AllowRequest(key.Id)
+	require.True(t, ok)
+	wait, ok := m.AllowRequest(key.Id)
+	require.False(t, ok)
+	require.Greater(t, wait, time.Duration(0))
+	require.LessOrEqual(t, wait, 2*time.Second)
+
+	now = now.Add(wait)
+	_, ok = m.AllowRequest(key.Id)
+	require.True(t, ok)
+
+	_, ok = m.AllowTokens(key.Id, 800)
+	require.True(t, ok)
+	wait, ok = m.AllowTokens(key.Id, 300)
+	require.False(t, ok)
+	require.Greater(t, wait, time.Minute)
+
+	// Unlimited keys are never limited
+	unlimited, _, err := m.Create(ctx, "client-b", Limits{})
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		_, ok = m.AllowRequest(unlimited.Id)
+		require.True(t, ok)
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := newSlidingWindow(time.Minute)
+
+	_, ok := w.take(start, 60, 100)
+	require.True(t, ok)
+
+	// Half way through the next window half of the previous one still counts
+	now := start.Add(90 * time.Second)
+	require.InDelta(t, 30, w.usage(now), 0.001)
+	_, ok = w.take(now, 70, 100)
+	require.True(t, ok)
+
+	// 30 from the previous window plus 70 leaves no room; the previous share has to decay by 10
+	wait, ok := w.take(now, 10, 100)
+	require.False(t, ok)
+	require.Equal(t, 10*time.Second, wait)
+
+	_, ok = w.take(now.Add(wait), 10, 100)
+	require.True(t, ok)
+
+	// An amount over the limit never fits
+	_, ok = w.take(now, 101, 100)
+	require.False(t, ok)
+
+	// Idle for more than two windows starts from scratch
+	require.Zero(t, w.usage(now.Add(3*time.Minute)))
+}
@@ -0,0 +1,65 @@
+package apikeys
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// keyStore persists API keys so they survive API restarts
+type keyStore interface {
+	Save(ctx context.Context, key ApiKey) error
+	Delete(ctx context.Context, id string) error
+	LoadAll(ctx context.Context) ([]ApiKey, error)
+}
+
+// sqlKeyStore stores keys in the api_keys table created by apiconfig.EnsureSchema
+type sqlKeyStore struct {
+	db *sql.DB
+}
+
+func newSqlKeyStore(db *sql.DB) *sqlKeyStore {
+	return &sqlKeyStore{db: db}
+}
+
+func (s *sqlKeyStore) Save(ctx context.Context, key ApiKey) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO api_keys (id, name, key_hash, max_qps, max_tokens_per_minute, disabled, created_at)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+  name = excluded.name,
+  max_qps = excluded.max_qps,
+  max_tokens_per_minute = excluded.max_tokens_per_minute,
+  disabled = excluded.disabled`,
+		key.Id, key.Name, key.keyHash, key.MaxQps, key.MaxTokensPerMinute, key.Disabled, key.CreatedAt.Unix())
+	return err
+}
+
+func (s *sqlKeyStore) Delete(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM api_keys WHERE id = ?`, id)
+	return err
+}
+
+func (s *sqlKeyStore) LoadAll(ctx context.Context) ([]ApiKey, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT id, name, key_hash, max_qps, max_tokens_per_minute, disabled, created_at
+FROM api_keys ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []ApiKey
+	for rows.Next() {
+		var (
+			key       ApiKey
+			createdAt int64
+		)
+		if err := rows.Scan(&key.Id, &key.Name, &key.keyHash, &key.MaxQps, &key.MaxTokensPerMinute, &key.Disabled, &createdAt); err != nil {
+			return nil, err
+		}
+		key.CreatedAt = time.Unix(createdAt, 0).UTC()
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
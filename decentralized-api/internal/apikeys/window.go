@@ -0,0 +1,64 @@
+package apikeys
+
+import "time"
+
+// slidingWindow approximates the usage over the last window by weighting the previous fixed
+// window by how much of it still overlaps the sliding one, which needs no per-request history
+type slidingWindow struct {
+	size     time.Duration
+	start    time.Time
+	current  int64
+	previous int64
+}
+
+func newSlidingWindow(size time.Duration) *slidingWindow {
+	return &slidingWindow{size: size}
+}
+
+func (w *slidingWindow) advance(now time.Time) {
+	if w.start.IsZero() {
+		w.start = now.Truncate(w.size)
+		return
+	}
+	elapsed := now.Sub(w.start)
+	switch {
+	case elapsed >= 2*w.size:
+		w.previous, w.current = 0, 0
+		w.start = now.Truncate(w.size)
+	case elapsed >= w.size:
+		w.previous, w.current = w.current, 0
+		w.start = w.start.Add(w.size)
+	}
+}
+
+// usage returns the estimated amount used over the window ending at now
+func (w *slidingWindow) usage(now time.Time) float64 {
+	w.advance(now)
+	overlap := 1 - float64(now.Sub(w.start))/float64(w.size)
+	return float64(w.previous)*overlap + float64(w.current)
+}
+
+// take records amount if it keeps the usage within limit, otherwise it returns how long to wait until it would
+func (w *slidingWindow) take(now time.Time, amount, limit int64) (time.Duration, bool) {
+	usage := w.usage(now)
+	if usage+float64(amount) <= float64(limit) {
+		w.current += amount
+		return 0, true
+	}
+	return w.waitFor(now, usage, float64(limit-amount)), false
+}
+
+// waitFor returns how long until the usage drops to target
+func (w *slidingWindow) waitFor(now time.Time, usage, target float64) time.Duration {
+	untilNext := w.start.Add(w.size).Sub(now)
+	if target < 0 {
+		// The amount alone is over the limit and never fits; come back once the window is empty
+		return untilNext + w.size
+	}
+	if float64(w.current) <= target {
+		// Only the share of the previous window has to decay, which it does linearly over the window
+		return time.Duration((usage - target) / float64(w.previous) * float64(w.size))
+	}
+	// The current window has to roll over and then decay as the previous one
+	return untilNext + time.Duration((1-target/float64(w.current))*float64(w.size))
+}
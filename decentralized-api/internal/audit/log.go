@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	queueSize      = 1024
+	writeBatchSize = 100
+	writeInterval  = time.Second
+	pruneInterval  = time.Hour
+
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+type Role string
+
+const (
+	RoleTransfer Role = "transfer"
+	RoleExecutor Role = "executor"
+)
+
+// Entry is the metadata of one inference request as handled by this API, either as
+// transfer agent or as executor. Payloads are not kept, only their hashes.
+type Entry struct {
+	Id               int64     `json:"id"`
+	InferenceId      string    `json:"inference_id"`
+	Role             Role      `json:"role"`
+	Endpoint         string    `json:"endpoint"`
+	Model            string    `json:"model"`
+	RequesterAddress string    `json:"requester_address"`
+	TransferAddress  string    `json:"transfer_address"`
+	ExecutorAddress  string    `json:"executor_address"`
+	ExecutorNode     string    `json:"executor_node,omitempty"`
+	PromptHash       string    `json:"prompt_hash"`
+	ResponseHash     string    `json:"response_hash,omitempty"`
+	PromptTokens     uint64    `json:"prompt_tokens"`
+	CompletionTokens uint64    `json:"completion_tokens"`
+	StatusCode       int       `json:"status_code"`
+	Error            string    `json:"error,omitempty"`
+	StartedAt        time.Time `json:"started_at"`
+	DurationMs       int64     `json:"duration_ms"`
+}
+
+// Filter selects entries, newest first. BeforeId pages through results: pass the id of the last entry of the previous page.
+type Filter struct {
+	InferenceId      string
+	Role             Role
+	Model            string
+	RequesterAddress string
+	ExecutorAddress  string
+	Since            time.Time
+	Until            time.Time
+	BeforeId         int64
+	Limit            int
+}
+
+// Log records audit entries in the background so request handling never waits on the database,
+// and prunes entries older than the retention period
+type Log struct {
+	store     entryStore
+	retention time.Duration
+	queue     chan Entry
+}
+
+func NewLog(db *sql.DB, retention time.Duration) *Log {
+	return newLog(newSqlEntryStore(db), retention)
+}
+
+func newLog(store entryStore, retention time.Duration) *Log {
+	return &Log{
+		store:     store,
+		retention: retention,
+		queue:     make(chan Entry, queueSize),
+	}
+}
+
+// Record queues an entry; it is dropped if the writer fell too far behind
+func (l *Log) Record(entry Entry) {
+	select {
+	case l.queue <- entry:
+	default:
+		logging.Warn("Audit log queue is full, dropping entry", types.Inferences, "inferenceId", entry.InferenceId)
+	}
+}
+
+func (l *Log) Query(ctx context.Context, filter Filter) ([]Entry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = DefaultQueryLimit
+	}
+	if filter.Limit > MaxQueryLimit {
+		filter.Limit = MaxQueryLimit
+	}
+	return l.store.Query(ctx, filter)
+}
+
+// Start writes queued entries in batches and prunes expired ones until ctx is done
+func (l *Log) Start(ctx context.Context) {
+	l.prune(ctx)
+
+	writeTicker := time.NewTicker(writeInterval)
+	defer writeTicker.Stop()
+	pruneTicker := time.NewTicker(pruneInterval)
+	defer pruneTicker.Stop()
+
+	batch := make([]Entry, 0, writeBatchSize)
+	for {
+		select {
+		case <-ctx.Done():
+			l.write(context.Background(), l.drain(batch))
+			return
+		case entry := <-l.queue:
+			batch = append(batch, entry)
+			if len(batch) >= writeBatchSize {
+				l.write(ctx, batch)
+				batch = batch[:0]
+			}
+		case <-writeTicker.C:
+			l.write(ctx, batch)
+			batch = batch[:0]
+		case <-pruneTicker.C:
+			l.prune(ctx)
+		}
+	}
+}
+
+func (l *Log) drain(batch []Entry) []Entry {
+	for {
+		select {
+		case entry := <-l.queue:
+			batch = append(batch, entry)
+		default:
+			return batch
+		}
+	}
+}
+
+func (l *Log) write(ctx context.Context, batch []Entry) {
+	if len(batch) == 0 {
+		return
+	}
+	if err := l.store.Insert(ctx, batch); err != nil {
+		logging.Error("Failed to write audit log entries", types.Inferences, "count", len(batch), "error", err)
+	}
+}
+
+func (l *Log) prune(ctx context.Context) {
+	deleted, err := l.store.DeleteBefore(ctx, time.Now().Add(-l.retention))
+	if err != nil {
+		logging.Error("Failed to prune audit log", types.Inferences, "error", err)
+		return
+	}
+	if deleted > 0 {
+		logging.Info("Pruned audit log", types.Inferences, "deleted", deleted, "retention", l.retention)
+	}
+}
@@ -0,0 +1,92 @@
+package audit
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLog(t *testing.T, retention time.Duration) *Log {
+	t.Helper()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(context.Background(), db))
+	return NewLog(db, retention)
+}
+
+func TestLog_RecordAndQuery(t *testing.T) {
+	l := newTestLog(t, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		l.Start(ctx)
+		close(done)
+	}()
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		l.Record(Entry{
+			InferenceId:      fmt.Sprintf("inference-%d", i),
+			Role:             RoleExecutor,
+			Model:            "model-a",
+			RequesterAddress: "requester",
+			PromptHash:       "prompt-hash",
+			PromptTokens:     10,
+			StatusCode:       200,
+			StartedAt:        now.Add(time.Duration(i) * time.Second),
+		})
+	}
+	l.Record(Entry{InferenceId: "inference-t", Role: RoleTransfer, Model: "model-b", StartedAt: now})
+
+	// Entries are flushed on shutdown
+	cancel()
+	<-done
+
+	entries, err := l.Query(context.Background(), Filter{InferenceId: "inference-3"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "model-a", entries[0].Model)
+	require.Equal(t, uint64(10), entries[0].PromptTokens)
+	require.Equal(t, now.Add(3*time.Second).UnixMilli(), entries[0].StartedAt.UnixMilli())
+
+	entries, err = l.Query(context.Background(), Filter{Role: RoleTransfer})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "inference-t", entries[0].InferenceId)
+
+	// Pages go newest first
+	page, err := l.Query(context.Background(), Filter{Role: RoleExecutor, Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	require.Equal(t, "inference-4", page[0].InferenceId)
+	page, err = l.Query(context.Background(), Filter{Role: RoleExecutor, Limit: 3, BeforeId: page[2].Id})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Equal(t, "inference-0", page[1].InferenceId)
+
+	entries, err = l.Query(context.Background(), Filter{Since: now.Add(2 * time.Second), Until: now.Add(4 * time.Second)})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
+
+func TestLog_Prune(t *testing.T) {
+	l := newTestLog(t, time.Hour)
+	ctx := context.Background()
+
+	require.NoError(t, l.store.Insert(ctx, []Entry{
+		{InferenceId: "old", Role: RoleExecutor, StartedAt: time.Now().Add(-2 * time.Hour)},
+		{InferenceId: "new", Role: RoleExecutor, StartedAt: time.Now()},
+	}))
+	l.prune(ctx)
+
+	entries, err := l.Query(ctx, Filter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, "new", entries[0].InferenceId)
+}
@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+)
+
+// entryStore persists audit entries
+type entryStore interface {
+	Insert(ctx context.Context, entries []Entry) error
+	Query(ctx context.Context, filter Filter) ([]Entry, error)
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error)
+}
+
+// sqlEntryStore stores entries in the inference_audit_log table created by apiconfig.EnsureSchema
+type sqlEntryStore struct {
+	db *sql.DB
+}
+
+func newSqlEntryStore(db *sql.DB) *sqlEntryStore {
+	return &sqlEntryStore{db: db}
+}
+
+const entryColumns = `id, inference_id, role, endpoint, model, requester_address, transfer_address, executor_address,
+  executor_node, prompt_hash, response_hash, prompt_tokens, completion_tokens, status_code, error, started_at, duration_ms`
+
+func (s *sqlEntryStore) Insert(ctx context.Context, entries []Entry) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.PrepareContext(ctx, `
+INSERT INTO inference_audit_log (inference_id, role, endpoint, model, requester_address, transfer_address, executor_address,
+  executor_node, prompt_hash, response_hash, prompt_tokens, completion_tokens, status_code, error, started_at, duration_ms)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, e := range entries {
+		if _, err := stmt.ExecContext(ctx, e.InferenceId, e.Role, e.Endpoint, e.Model, e.RequesterAddress, e.TransferAddress,
+			e.ExecutorAddress, e.ExecutorNode, e.PromptHash, e.ResponseHash, e.PromptTokens, e.CompletionTokens,
+			e.StatusCode, e.Error, e.StartedAt.UnixMilli(), e.DurationMs); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *sqlEntryStore) Query(ctx context.Context, filter Filter) ([]Entry, error) {
+	var (
+		conditions []string
+		args       []any
+	)
+	addEqual := func(column, value string) {
+		if value != "" {
+			conditions = append(conditions, column+" = ?")
+			args = append(args, value)
+		}
+	}
+	addEqual("inference_id", filter.InferenceId)
+	addEqual("role", string(filter.Role))
+	addEqual("model", filter.Model)
+	addEqual("requester_address", filter.RequesterAddress)
+	addEqual("executor_address", filter.ExecutorAddress)
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "started_at >= ?")
+		args = append(args, filter.Since.UnixMilli())
+	}
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "started_at < ?")
+		args = append(args, filter.Until.UnixMilli())
+	}
+	if filter.BeforeId > 0 {
+		conditions = append(conditions, "id < ?")
+		args = append(args, filter.BeforeId)
+	}
+
+	query := "SELECT " + entryColumns + " FROM inference_audit_log"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY id DESC LIMIT ?"
+	args = append(args, filter.Limit)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := make([]Entry, 0)
+	for rows.Next() {
+		var (
+			e         Entry
+			startedAt int64
+		)
+		if err := rows.Scan(&e.Id, &e.InferenceId, &e.Role, &e.Endpoint, &e.Model, &e.RequesterAddress, &e.TransferAddress,
+			&e.ExecutorAddress, &e.ExecutorNode, &e.PromptHash, &e.ResponseHash, &e.PromptTokens, &e.CompletionTokens,
+			&e.StatusCode, &e.Error, &startedAt, &e.DurationMs); err != nil {
+			return nil, err
+		}
+		e.StartedAt = time.UnixMilli(startedAt).UTC()
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *sqlEntryStore) DeleteBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `DELETE FROM inference_audit_log WHERE started_at < ?`, cutoff.UnixMilli())
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
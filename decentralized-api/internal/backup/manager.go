@@ -0,0 +1,242 @@
+package backup
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	keyPrefix       = "gonka-"
+	keySuffix       = ".db.gz"
+	timestampLayout = "20060102T150405Z"
+)
+
+var ErrNoBackup = errors.New("no backup found")
+
+type Config struct {
+	Interval time.Duration
+	Retain   int // most recent backups kept in the store, older ones are deleted
+}
+
+// Status reports the outcome of the last backup, for operators
+type Status struct {
+	LastKey     string    `json:"last_key,omitempty"`
+	LastSuccess time.Time `json:"last_success,omitempty"`
+	LastError   string    `json:"last_error,omitempty"`
+}
+
+// Manager periodically snapshots the SQLite database holding the dynamic state (seeds, nodes,
+// heights, upgrade plans) and uploads it, gzipped, to a Store
+type Manager struct {
+	db    *sql.DB
+	store Store
+	cfg   Config
+
+	mu     sync.Mutex
+	status Status
+}
+
+func NewManager(db *sql.DB, store Store, cfg Config) *Manager {
+	return &Manager{db: db, store: store, cfg: cfg}
+}
+
+// Start takes a backup every Interval until ctx is done. The first backup is taken after one
+// interval, so a database restored at startup is not immediately uploaded again.
+func (m *Manager) Start(ctx context.Context) {
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := m.Backup(ctx); err != nil {
+			logging.Error("Database backup failed", types.Config, "error", err)
+		}
+	}
+}
+
+func (m *Manager) Status() Status {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// Backup snapshots the database, uploads it and deletes the backups beyond the retention.
+// It returns the key of the new backup.
+func (m *Manager) Backup(ctx context.Context) (string, error) {
+	key, err := m.backup(ctx, time.Now().UTC())
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err != nil {
+		m.status.LastError = err.Error()
+		return "", err
+	}
+	m.status = Status{LastKey: key, LastSuccess: time.Now().UTC()}
+	return key, nil
+}
+
+func (m *Manager) backup(ctx context.Context, now time.Time) (string, error) {
+	tmpDir, err := os.MkdirTemp("", "dapi-backup-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// VACUUM INTO writes a consistent copy without blocking writers for the duration of the upload
+	snapshot := filepath.Join(tmpDir, "snapshot.db")
+	if _, err := m.db.ExecContext(ctx, "VACUUM INTO ?", snapshot); err != nil {
+		return "", fmt.Errorf("snapshot database: %w", err)
+	}
+	archive := filepath.Join(tmpDir, "snapshot.db.gz")
+	if err := gzipFile(snapshot, archive); err != nil {
+		return "", fmt.Errorf("compress snapshot: %w", err)
+	}
+
+	f, err := os.Open(archive)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	key := keyPrefix + now.Format(timestampLayout) + keySuffix
+	if err := m.store.Put(ctx, key, f); err != nil {
+		return "", fmt.Errorf("upload %s: %w", key, err)
+	}
+	logging.Info("Database backup uploaded", types.Config, "key", key)
+
+	if err := m.rotate(ctx); err != nil {
+		logging.Warn("Failed to delete old database backups", types.Config, "error", err)
+	}
+	return key, nil
+}
+
+func (m *Manager) rotate(ctx context.Context) error {
+	if m.cfg.Retain <= 0 {
+		return nil
+	}
+	keys, err := listBackups(ctx, m.store)
+	if err != nil {
+		return err
+	}
+	if len(keys) <= m.cfg.Retain {
+		return nil
+	}
+	for _, key := range keys[:len(keys)-m.cfg.Retain] {
+		if err := m.store.Delete(ctx, key); err != nil {
+			return fmt.Errorf("delete %s: %w", key, err)
+		}
+		logging.Info("Deleted old database backup", types.Config, "key", key)
+	}
+	return nil
+}
+
+// listBackups returns the backup keys in the store, oldest first. The timestamp in the key
+// sorts chronologically, other objects in the store are ignored.
+func listBackups(ctx context.Context, store Store) ([]string, error) {
+	keys, err := store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backups := keys[:0]
+	for _, key := range keys {
+		if strings.HasPrefix(key, keyPrefix) && strings.HasSuffix(key, keySuffix) {
+			backups = append(backups, key)
+		}
+	}
+	return backups, nil
+}
+
+// RestoreLatestIfMissing restores the latest backup to sqlitePath when no database exists there,
+// so an operator who lost the disk gets the seeds and node state back. It returns the restored
+// key, empty when the database already exists.
+func RestoreLatestIfMissing(ctx context.Context, store Store, sqlitePath string) (string, error) {
+	if _, err := os.Stat(sqlitePath); err == nil {
+		return "", nil
+	} else if !os.IsNotExist(err) {
+		return "", err
+	}
+	keys, err := listBackups(ctx, store)
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		return "", ErrNoBackup
+	}
+	key := keys[len(keys)-1]
+
+	body, err := store.Get(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("download %s: %w", key, err)
+	}
+	defer body.Close()
+	if err := os.MkdirAll(filepath.Dir(sqlitePath), 0o755); err != nil {
+		return "", err
+	}
+	tmp := sqlitePath + ".restore"
+	if err := gunzipTo(body, tmp); err != nil {
+		os.Remove(tmp)
+		return "", fmt.Errorf("decompress %s: %w", key, err)
+	}
+	// A leftover journal of the lost database must not be replayed onto the restored one
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if err := os.Remove(sqlitePath + suffix); err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	if err := os.Rename(tmp, sqlitePath); err != nil {
+		return "", err
+	}
+	logging.Info("Restored database from backup", types.Config, "key", key, "path", sqlitePath)
+	return key, nil
+}
+
+func gzipFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+func gunzipTo(r io.Reader, dst string) error {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	defer zr.Close()
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+	if _, err := io.Copy(out, zr); err != nil {
+		return err
+	}
+	return out.Close()
+}
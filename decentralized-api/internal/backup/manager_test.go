@@ -0,0 +1,68 @@
+package backup
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func openTestDb(t *testing.T, path string) *sql.DB {
+	db, err := sql.Open("sqlite", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestBackupRotateAndRestore(t *testing.T) {
+	ctx := context.Background()
+	db := openTestDb(t, filepath.Join(t.TempDir(), "gonka.db"))
+	_, err := db.Exec("CREATE TABLE seeds (epoch INTEGER PRIMARY KEY, seed INTEGER)")
+	require.NoError(t, err)
+
+	store, err := NewDirStore(t.TempDir())
+	require.NoError(t, err)
+	m := NewManager(db, store, Config{Interval: time.Hour, Retain: 2})
+
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	var lastKey string
+	for i := 0; i < 3; i++ {
+		_, err := db.Exec("INSERT INTO seeds (epoch, seed) VALUES (?, ?)", i, 100+i)
+		require.NoError(t, err)
+		lastKey, err = m.backup(ctx, start.Add(time.Duration(i)*time.Minute))
+		require.NoError(t, err)
+	}
+	require.Equal(t, "gonka-20261018T120200Z.db.gz", lastKey)
+
+	// An unrelated object in the store is neither rotated nor restored
+	require.NoError(t, os.WriteFile(filepath.Join(store.dir, "notes.txt"), []byte("x"), 0o644))
+	keys, err := store.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"gonka-20261018T120100Z.db.gz", "gonka-20261018T120200Z.db.gz", "notes.txt"}, keys)
+
+	restorePath := filepath.Join(t.TempDir(), "dapi", "gonka.db")
+	restored, err := RestoreLatestIfMissing(ctx, store, restorePath)
+	require.NoError(t, err)
+	require.Equal(t, lastKey, restored)
+
+	var count int
+	require.NoError(t, openTestDb(t, restorePath).QueryRow("SELECT COUNT(*) FROM seeds").Scan(&count))
+	require.Equal(t, 3, count)
+
+	// An existing database is never overwritten
+	restored, err = RestoreLatestIfMissing(ctx, store, restorePath)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+}
+
+func TestRestoreWithoutBackups(t *testing.T) {
+	store, err := NewDirStore(t.TempDir())
+	require.NoError(t, err)
+	_, err = RestoreLatestIfMissing(context.Background(), store, filepath.Join(t.TempDir(), "gonka.db"))
+	require.ErrorIs(t, err, ErrNoBackup)
+}
@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// Store holds the backups, keyed by file name
+type Store interface {
+	Put(ctx context.Context, key string, body io.ReadSeeker) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// List returns the keys of all objects in the store, sorted
+	List(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// S3Config configures an S3-compatible bucket. Credentials are taken from the standard
+// AWS environment (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, shared config or instance role).
+type S3Config struct {
+	Bucket   string
+	Prefix   string
+	Region   string
+	Endpoint string // optional, for S3-compatible stores such as MinIO
+}
+
+// S3Store keeps backups as objects in an S3 bucket under an optional key prefix
+type S3Store struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+	awsCfg := aws.NewConfig()
+	if cfg.Region != "" {
+		awsCfg = awsCfg.WithRegion(cfg.Region)
+	}
+	if cfg.Endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create aws session: %w", err)
+	}
+	return &S3Store{client: s3.New(sess), bucket: cfg.Bucket, prefix: cfg.Prefix}, nil
+}
+
+func (s *S3Store) key(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
+
+func (s *S3Store) Put(ctx context.Context, key string, body io.ReadSeeker) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   body,
+	})
+	return err
+}
+
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Body, nil
+}
+
+func (s *S3Store) List(ctx context.Context) ([]string, error) {
+	listPrefix := ""
+	if s.prefix != "" {
+		listPrefix = strings.TrimSuffix(s.prefix, "/") + "/"
+	}
+	var keys []string
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(listPrefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			key := strings.TrimPrefix(aws.StringValue(obj.Key), listPrefix)
+			if key != "" && !strings.Contains(key, "/") {
+				keys = append(keys, key)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	_, err := s.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	return err
+}
+
+// DirStore keeps backups as files in a directory, e.g. a mounted network volume
+type DirStore struct {
+	dir string
+}
+
+func NewDirStore(dir string) (*DirStore, error) {
+	if dir == "" {
+		return nil, errors.New("backup dir is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DirStore{dir: dir}, nil
+}
+
+func (s *DirStore) Put(ctx context.Context, key string, body io.ReadSeeker) error {
+	tmp, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(s.dir, key))
+}
+
+func (s *DirStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.dir, key))
+}
+
+func (s *DirStore) List(ctx context.Context) ([]string, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && !strings.HasPrefix(entry.Name(), ".") {
+			keys = append(keys, entry.Name())
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *DirStore) Delete(ctx context.Context, key string) error {
+	return os.Remove(filepath.Join(s.dir, key))
+}
+
+var (
+	_ Store = (*S3Store)(nil)
+	_ Store = (*DirStore)(nil)
+)
@@ -36,6 +36,23 @@ type BandwidthLimiter struct {
 	configManager         ConfigManager
 	cachedLimitEpochIndex uint64
 	cachedWeightLimit     uint64
+
+	// Per-requester accounting by requester address and completion block.
+	// A requester may use requesterSharePercent of limitsPerBlockKB, 0 disables the check.
+	usagePerRequester     map[string]map[int64]float64
+	requesterSharePercent uint64
+}
+
+// RequesterBandwidth is the bandwidth a requester has in flight and has left in the block window
+// of a request started at the given height. Values are averaged per block like the overall limit.
+type RequesterBandwidth struct {
+	Requester           string  `json:"requester"`
+	WindowStartBlock    int64   `json:"window_start_block"`
+	WindowEndBlock      int64   `json:"window_end_block"`
+	Enforced            bool    `json:"enforced"`
+	LimitKbPerBlock     float64 `json:"limit_kb_per_block"`
+	UsedKbPerBlock      float64 `json:"used_kb_per_block"`
+	RemainingKbPerBlock float64 `json:"remaining_kb_per_block"`
 }
 
 // CanAcceptRequest checks both bandwidth and inference count limits.
@@ -81,6 +98,55 @@ func (bl *BandwidthLimiter) CanAcceptRequest(blockHeight int64, promptTokens, ma
 	return true, estimatedKB
 }
 
+// CanAcceptRequesterRequest checks that a request of estimatedKB keeps the requester within its
+// share of the bandwidth limit. It is checked after CanAcceptRequest, which returns the estimate.
+func (bl *BandwidthLimiter) CanAcceptRequesterRequest(requester string, blockHeight int64, estimatedKB float64) bool {
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+
+	bandwidth := bl.requesterBandwidthLocked(requester, blockHeight)
+	if !bandwidth.Enforced {
+		return true
+	}
+	estimatedKBPerBlock := estimatedKB / float64(bl.requestLifespanBlocks+1)
+	if estimatedKBPerBlock > bandwidth.RemainingKbPerBlock {
+		logging.Info("Requester bandwidth limit exceeded", types.Config,
+			"requester", requester, "usedKbPerBlock", bandwidth.UsedKbPerBlock, "estimatedKB", estimatedKBPerBlock, "limit", bandwidth.LimitKbPerBlock)
+		return false
+	}
+	return true
+}
+
+// GetRequesterBandwidth returns the bandwidth of a requester for a request started at blockHeight
+func (bl *BandwidthLimiter) GetRequesterBandwidth(requester string, blockHeight int64) RequesterBandwidth {
+	bl.maybeUpdateLimits()
+
+	bl.mu.RLock()
+	defer bl.mu.RUnlock()
+	return bl.requesterBandwidthLocked(requester, blockHeight)
+}
+
+func (bl *BandwidthLimiter) requesterBandwidthLocked(requester string, blockHeight int64) RequesterBandwidth {
+	windowEnd := blockHeight + bl.requestLifespanBlocks
+	totalUsage := 0.0
+	for i := blockHeight; i <= windowEnd; i++ {
+		totalUsage += bl.usagePerRequester[requester][i]
+	}
+	bandwidth := RequesterBandwidth{
+		Requester:        requester,
+		WindowStartBlock: blockHeight,
+		WindowEndBlock:   windowEnd,
+		Enforced:         bl.requesterSharePercent > 0 && requester != "",
+		UsedKbPerBlock:   totalUsage / float64(bl.requestLifespanBlocks+1),
+	}
+	if !bandwidth.Enforced {
+		return bandwidth
+	}
+	bandwidth.LimitKbPerBlock = float64(bl.limitsPerBlockKB*bl.requesterSharePercent) / 100
+	bandwidth.RemainingKbPerBlock = max(bandwidth.LimitKbPerBlock-bandwidth.UsedKbPerBlock, 0)
+	return bandwidth
+}
+
 func (bl *BandwidthLimiter) maybeUpdateLimits() {
 	if bl.phaseTracker == nil {
 		return
@@ -116,10 +182,14 @@ func (bl *BandwidthLimiter) maybeUpdateLimits() {
 func (bl *BandwidthLimiter) updateParametersFromConfig() bool {
 	validationParams := bl.configManager.GetValidationParams()
 	bandwidthParams := bl.configManager.GetBandwidthParams()
+	requesterSharePercent := requesterSharePercent(bl.configManager.GetRequesterBandwidthConfig())
 
 	bl.mu.Lock()
 	defer bl.mu.Unlock()
 
+	// The requester share only applies to new requests, no limit recalculation needed
+	bl.requesterSharePercent = requesterSharePercent
+
 	updated := false
 
 	if validationParams.ExpirationBlocks > 0 && bl.requestLifespanBlocks != validationParams.ExpirationBlocks {
@@ -220,15 +290,32 @@ func (bl *BandwidthLimiter) calculateUniformLimit(currentEpochIndex uint64) uint
 }
 
 func (bl *BandwidthLimiter) RecordRequest(startBlockHeight int64, estimatedKB float64) {
+	bl.RecordRequestFor("", startBlockHeight, estimatedKB)
+}
+
+func (bl *BandwidthLimiter) ReleaseRequest(startBlockHeight int64, estimatedKB float64) {
+	bl.ReleaseRequestFor("", startBlockHeight, estimatedKB)
+}
+
+// RecordRequestFor records a request and attributes its bandwidth to the requester, if any
+func (bl *BandwidthLimiter) RecordRequestFor(requester string, startBlockHeight int64, estimatedKB float64) {
 	bl.mu.Lock()
 	defer bl.mu.Unlock()
 
 	completionBlock := startBlockHeight + bl.requestLifespanBlocks
 	bl.usagePerBlock[completionBlock] += estimatedKB
 	bl.inferencesPerBlock[completionBlock]++
+
+	if requester != "" {
+		if bl.usagePerRequester[requester] == nil {
+			bl.usagePerRequester[requester] = make(map[int64]float64)
+		}
+		bl.usagePerRequester[requester][completionBlock] += estimatedKB
+	}
 }
 
-func (bl *BandwidthLimiter) ReleaseRequest(startBlockHeight int64, estimatedKB float64) {
+// ReleaseRequestFor releases a request recorded with RecordRequestFor
+func (bl *BandwidthLimiter) ReleaseRequestFor(requester string, startBlockHeight int64, estimatedKB float64) {
 	bl.mu.Lock()
 	defer bl.mu.Unlock()
 
@@ -236,6 +323,16 @@ func (bl *BandwidthLimiter) ReleaseRequest(startBlockHeight int64, estimatedKB f
 	bl.usagePerBlock[completionBlock] -= estimatedKB
 	bl.inferencesPerBlock[completionBlock]--
 
+	if usage, ok := bl.usagePerRequester[requester]; ok {
+		usage[completionBlock] -= estimatedKB
+		if usage[completionBlock] <= 0 {
+			delete(usage, completionBlock)
+		}
+		if len(usage) == 0 {
+			delete(bl.usagePerRequester, requester)
+		}
+	}
+
 	if bl.usagePerBlock[completionBlock] <= 0 {
 		delete(bl.usagePerBlock, completionBlock)
 	}
@@ -289,6 +386,18 @@ func (bl *BandwidthLimiter) cleanupOldEntries() {
 			delete(bl.inferencesPerBlock, block)
 		}
 	}
+
+	// Cleanup per-requester usage
+	for requester, usage := range bl.usagePerRequester {
+		for block := range usage {
+			if block < cutoffBlock {
+				delete(usage, block)
+			}
+		}
+		if len(usage) == 0 {
+			delete(bl.usagePerRequester, requester)
+		}
+	}
 }
 
 func NewBandwidthLimiterFromConfig(configManager ConfigManager, recorder cosmosclient.CosmosMessageClient, phaseTracker ChainPhaseTracker) *BandwidthLimiter {
@@ -331,6 +440,8 @@ func NewBandwidthLimiterFromConfig(configManager ConfigManager, recorder cosmosc
 		defaultLimit:          limitsPerBlockKB,
 		phaseTracker:          phaseTracker,
 		configManager:         configManager,
+		usagePerRequester:     make(map[string]map[int64]float64),
+		requesterSharePercent: requesterSharePercent(configManager.GetRequesterBandwidthConfig()),
 	}
 
 	if recorder != nil && phaseTracker != nil {
@@ -346,9 +457,17 @@ func NewBandwidthLimiterFromConfig(configManager ConfigManager, recorder cosmosc
 	return bl
 }
 
+func requesterSharePercent(cfg apiconfig.RequesterBandwidthConfig) uint64 {
+	if cfg.Disabled || cfg.SharePercent <= 0 {
+		return 0
+	}
+	return uint64(min(cfg.SharePercent, 100))
+}
+
 type ConfigManager interface {
 	GetValidationParams() apiconfig.ValidationParamsCache
 	GetBandwidthParams() apiconfig.BandwidthParamsCache
+	GetRequesterBandwidthConfig() apiconfig.RequesterBandwidthConfig
 }
 
 type ChainPhaseTracker interface {
@@ -15,6 +15,7 @@ import (
 type mockConfigManager struct {
 	validationParams apiconfig.ValidationParamsCache
 	bandwidthParams  apiconfig.BandwidthParamsCache
+	requesterConfig  apiconfig.RequesterBandwidthConfig
 }
 
 func (m *mockConfigManager) GetValidationParams() apiconfig.ValidationParamsCache {
@@ -25,6 +26,10 @@ func (m *mockConfigManager) GetBandwidthParams() apiconfig.BandwidthParamsCache
 	return m.bandwidthParams
 }
 
+func (m *mockConfigManager) GetRequesterBandwidthConfig() apiconfig.RequesterBandwidthConfig {
+	return m.requesterConfig
+}
+
 // newTestBandwidthLimiter creates a bandwidth limiter for testing without weight-based allocation
 func newTestBandwidthLimiter(limitsPerBlockKB uint64, requestLifespanBlocks int64, kbPerInputToken, kbPerOutputToken float64) *BandwidthLimiter {
 	configManager := &mockConfigManager{
@@ -378,3 +383,43 @@ func TestBandwidthParameterLoadingWithInferenceLimit(t *testing.T) {
 	require.NotNil(t, limiter, "BandwidthLimiter should be created successfully")
 	require.Equal(t, uint64(500), limiter.maxInferencesPerBlock, "Limiter should use configured inference limit")
 }
+
+func TestBandwidthLimiter_RequesterShare(t *testing.T) {
+	configManager := &mockConfigManager{
+		validationParams: apiconfig.ValidationParamsCache{ExpirationBlocks: 9},
+		bandwidthParams: apiconfig.BandwidthParamsCache{
+			EstimatedLimitsPerBlockKb: 100,
+			KbPerInputToken:           0.0023,
+			KbPerOutputToken:          0.64,
+		},
+		requesterConfig: apiconfig.RequesterBandwidthConfig{SharePercent: 25},
+	}
+	limiter := NewBandwidthLimiterFromConfig(configManager, nil, nil)
+
+	// 25% of 100 KB per block over a window of 10 blocks
+	limiter.RecordRequestFor("alice", 1, 200)
+	bandwidth := limiter.GetRequesterBandwidth("alice", 1)
+	require.True(t, bandwidth.Enforced)
+	require.Equal(t, int64(1), bandwidth.WindowStartBlock)
+	require.Equal(t, int64(10), bandwidth.WindowEndBlock)
+	require.InDelta(t, 25.0, bandwidth.LimitKbPerBlock, 1e-9)
+	require.InDelta(t, 20.0, bandwidth.UsedKbPerBlock, 1e-9)
+	require.InDelta(t, 5.0, bandwidth.RemainingKbPerBlock, 1e-9)
+
+	require.False(t, limiter.CanAcceptRequesterRequest("alice", 1, 60))
+	require.True(t, limiter.CanAcceptRequesterRequest("alice", 1, 40))
+	require.True(t, limiter.CanAcceptRequesterRequest("bob", 1, 200))
+	// The window of a later request no longer covers alice's request
+	require.True(t, limiter.CanAcceptRequesterRequest("alice", 11, 200))
+
+	limiter.ReleaseRequestFor("alice", 1, 200)
+	require.Zero(t, limiter.GetRequesterBandwidth("alice", 1).UsedKbPerBlock)
+	require.Empty(t, limiter.usagePerRequester)
+	require.Empty(t, limiter.usagePerBlock)
+
+	configManager.requesterConfig.Disabled = true
+	limiter = NewBandwidthLimiterFromConfig(configManager, nil, nil)
+	limiter.RecordRequestFor("alice", 1, 1000)
+	require.False(t, limiter.GetRequesterBandwidth("alice", 1).Enforced)
+	require.True(t, limiter.CanAcceptRequesterRequest("alice", 1, 1000))
+}
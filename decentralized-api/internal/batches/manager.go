@@ -0,0 +1,629 @@
+package batches
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"decentralized-api/logging"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Status of a batch, as in the OpenAI batch API. A batch is in_progress until all its items finished, then
+// completed. When its completion window ends first it expires and its pending items fail. A cancelled batch
+// fails its pending items and is cancelling until the items already running finished.
+type Status string
+
+const (
+	StatusInProgress Status = "in_progress"
+	StatusCompleted  Status = "completed"
+	StatusExpired    Status = "expired"
+	StatusCancelling Status = "cancelling"
+	StatusCancelled  Status = "cancelled"
+)
+
+// ItemStatus is the status of one request of a batch
+type ItemStatus string
+
+const (
+	ItemPending   ItemStatus = "pending"
+	ItemRunning   ItemStatus = "running"
+	ItemCompleted ItemStatus = "completed"
+	ItemFailed    ItemStatus = "failed"
+)
+
+const (
+	PurposeBatch       = "batch"
+	PurposeBatchOutput = "batch_output"
+
+	DefaultCompletionWindow = "24h"
+
+	pollInterval   = 2 * time.Second
+	maxRetryDelay  = 5 * time.Minute
+	baseRetryDelay = 5 * time.Second
+)
+
+// Endpoints are the inference endpoints batch items may call
+var Endpoints = []string{"/v1/chat/completions", "/v1/embeddings"}
+
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrInvalidBatch  = errors.New("invalid batch")
+	ErrInvalidStatus = errors.New("batch is not in a status that allows this")
+)
+
+// File is an uploaded batch input file or the output file of a batch
+type File struct {
+	Id        string `json:"id"`
+	Object    string `json:"object"`
+	Bytes     int64  `json:"bytes"`
+	CreatedAt int64  `json:"created_at"`
+	Filename  string `json:"filename"`
+	Purpose   string `json:"purpose"`
+
+	apiKeyId string
+}
+
+type RequestCounts struct {
+	Total     int `json:"total"`
+	Completed int `json:"completed"`
+	Failed    int `json:"failed"`
+}
+
+// Batch runs the requests of an input file. Its results are appended to the output file as they complete.
+type Batch struct {
+	Id               string            `json:"id"`
+	Object           string            `json:"object"`
+	Endpoint         string            `json:"endpoint"`
+	InputFileId      string            `json:"input_file_id"`
+	OutputFileId     string            `json:"output_file_id"`
+	CompletionWindow string            `json:"completion_window"`
+	Status           Status            `json:"status"`
+	RequestCounts    RequestCounts     `json:"request_counts"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+	CreatedAt        int64             `json:"created_at"`
+	ExpiresAt        int64             `json:"expires_at"`
+	CompletedAt      int64             `json:"completed_at,omitempty"`
+	ExpiredAt        int64             `json:"expired_at,omitempty"`
+	CancelledAt      int64             `json:"cancelled_at,omitempty"`
+
+	apiKeyId   string
+	finishedAt int64
+}
+
+type CreateBatchRequest struct {
+	InputFileId      string            `json:"input_file_id"`
+	Endpoint         string            `json:"endpoint"`
+	CompletionWindow string            `json:"completion_window"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+// Item is one request of a batch, as handed to the Runner
+type Item struct {
+	BatchId  string
+	Index    int
+	CustomId string
+	Endpoint string
+	Body     []byte
+	ApiKeyId string
+	Attempts int
+}
+
+// Result is the response to a batch item. Retriable results (no capacity, rate limits) are tried again later.
+type Result struct {
+	InferenceId string
+	StatusCode  int
+	Body        []byte
+	Retriable   bool
+}
+
+// Runner executes batch items as inference requests
+type Runner interface {
+	// BatchCapacityAvailable tells whether items may be dispatched now
+	BatchCapacityAvailable() bool
+	RunBatchItem(ctx context.Context, item Item) Result
+}
+
+// inputLine is a line of an input file, in the OpenAI batch format
+type inputLine struct {
+	CustomId string          `json:"custom_id"`
+	Method   string          `json:"method"`
+	Url      string          `json:"url"`
+	Body     json.RawMessage `json:"body"`
+}
+
+// outputLine is a line of an output file, in the OpenAI batch format
+type outputLine struct {
+	Id       string          `json:"id"`
+	CustomId string          `json:"custom_id"`
+	Response *outputResponse `json:"response"`
+	Error    *outputError    `json:"error"`
+}
+
+type outputResponse struct {
+	StatusCode int             `json:"status_code"`
+	RequestId  string          `json:"request_id"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type outputError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type Config struct {
+	Dir          string
+	Concurrency  int
+	MaxItems     int
+	MaxFileBytes int64
+	MaxAttempts  int
+}
+
+// Manager stores batches and dispatches their items to a Runner
+type Manager struct {
+	store batchStore
+	cfg   Config
+	// mu serializes the status changes of batches with the dispatch of their items
+	mu       sync.Mutex
+	outputMu sync.Mutex
+	wake     chan struct{}
+	now      func() time.Time
+}
+
+func NewManager(db *sql.DB, cfg Config) (*Manager, error) {
+	return newManager(newSqlBatchStore(db), cfg)
+}
+
+func newManager(store batchStore, cfg Config) (*Manager, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &Manager{store: store, cfg: cfg, wake: make(chan struct{}, 1), now: time.Now}, nil
+}
+
+func newId(prefix string) string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return prefix + hex.EncodeToString(b)
+}
+
+func (m *Manager) filePath(id string) string {
+	return filepath.Join(m.cfg.Dir, id+".jsonl")
+}
+
+// CreateFile stores an uploaded input file of the API key
+func (m *Manager) CreateFile(ctx context.Context, apiKeyId, filename, purpose string, content io.Reader) (File, error) {
+	if purpose != PurposeBatch {
+		return File{}, fmt.Errorf("%w: purpose must be %q", ErrInvalidBatch, PurposeBatch)
+	}
+	file := File{Id: newId("file-"), Object: "file", CreatedAt: m.now().Unix(), Filename: filename, Purpose: purpose, apiKeyId: apiKeyId}
+	f, err := os.Create(m.filePath(file.Id))
+	if err != nil {
+		return File{}, err
+	}
+	n, err := io.Copy(f, io.LimitReader(content, m.cfg.MaxFileBytes+1))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && n > m.cfg.MaxFileBytes {
+		err = fmt.Errorf("%w: file is larger than %d bytes", ErrInvalidBatch, m.cfg.MaxFileBytes)
+	}
+	if err == nil {
+		err = m.store.SaveFile(ctx, file)
+	}
+	if err != nil {
+		_ = os.Remove(m.filePath(file.Id))
+		return File{}, err
+	}
+	file.Bytes = n
+	return file, nil
+}
+
+// GetFile returns a file of the API key
+func (m *Manager) GetFile(ctx context.Context, apiKeyId, id string) (File, error) {
+	file, err := m.store.GetFile(ctx, id)
+	if err != nil {
+		return File{}, err
+	}
+	if file.apiKeyId != apiKeyId {
+		return File{}, ErrNotFound
+	}
+	if info, err := os.Stat(m.filePath(id)); err == nil {
+		file.Bytes = info.Size()
+	}
+	return file, nil
+}
+
+// OpenFile opens the content of a file of the API key. Output files grow while their batch runs.
+func (m *Manager) OpenFile(ctx context.Context, apiKeyId, id string) (io.ReadCloser, error) {
+	if _, err := m.GetFile(ctx, apiKeyId, id); err != nil {
+		return nil, err
+	}
+	return os.Open(m.filePath(id))
+}
+
+// CreateBatch validates the input file and queues its requests
+func (m *Manager) CreateBatch(ctx context.Context, apiKeyId string, request CreateBatchRequest) (Batch, error) {
+	if !isEndpoint(request.Endpoint) {
+		return Batch{}, fmt.Errorf("%w: endpoint must be one of %v", ErrInvalidBatch, Endpoints)
+	}
+	if request.CompletionWindow == "" {
+		request.CompletionWindow = DefaultCompletionWindow
+	}
+	window, err := time.ParseDuration(request.CompletionWindow)
+	if err != nil || window <= 0 {
+		return Batch{}, fmt.Errorf("%w: completion_window must be a duration such as %q", ErrInvalidBatch, DefaultCompletionWindow)
+	}
+	input, err := m.GetFile(ctx, apiKeyId, request.InputFileId)
+	if err != nil {
+		return Batch{}, fmt.Errorf("input file %s: %w", request.InputFileId, err)
+	}
+	if input.Purpose != PurposeBatch {
+		return Batch{}, fmt.Errorf("%w: input file must have purpose %q", ErrInvalidBatch, PurposeBatch)
+	}
+	items, err := m.readInput(input.Id, request.Endpoint)
+	if err != nil {
+		return Batch{}, err
+	}
+
+	now := m.now()
+	output := File{Id: newId("file-"), Object: "file", CreatedAt: now.Unix(), Filename: "batch_output.jsonl", Purpose: PurposeBatchOutput, apiKeyId: apiKeyId}
+	if err := os.WriteFile(m.filePath(output.Id), nil, 0o644); err != nil {
+		return Batch{}, err
+	}
+	if err := m.store.SaveFile(ctx, output); err != nil {
+		return Batch{}, err
+	}
+	batch := Batch{
+		Id:               newId("batch_"),
+		Object:           "batch",
+		Endpoint:         request.Endpoint,
+		InputFileId:      input.Id,
+		OutputFileId:     output.Id,
+		CompletionWindow: request.CompletionWindow,
+		Status:           StatusInProgress,
+		RequestCounts:    RequestCounts{Total: len(items)},
+		Metadata:         request.Metadata,
+		CreatedAt:        now.Unix(),
+		ExpiresAt:        now.Add(window).Unix(),
+		apiKeyId:         apiKeyId,
+	}
+	for i := range items {
+		items[i].BatchId = batch.Id
+	}
+	if err := m.store.CreateBatch(ctx, batch, items); err != nil {
+		return Batch{}, err
+	}
+	logging.Info("Batch created", types.Inferences, "batchId", batch.Id, "items", len(items), "endpoint", batch.Endpoint)
+	m.notify()
+	return batch, nil
+}
+
+// readInput parses the requests of an input file
+func (m *Manager) readInput(fileId, endpoint string) ([]Item, error) {
+	f, err := os.Open(m.filePath(fileId))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var items []Item
+	customIds := make(map[string]struct{})
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), int(m.cfg.MaxFileBytes))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+		var line inputLine
+		if err := json.Unmarshal(raw, &line); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", ErrInvalidBatch, lineNumber, err)
+		}
+		switch {
+		case line.CustomId == "":
+			return nil, fmt.Errorf("%w: line %d: custom_id is required", ErrInvalidBatch, lineNumber)
+		case line.Method != "POST":
+			return nil, fmt.Errorf("%w: line %d: method must be POST", ErrInvalidBatch, lineNumber)
+		case line.Url != endpoint:
+			return nil, fmt.Errorf("%w: line %d: url must be the batch endpoint %s", ErrInvalidBatch, lineNumber, endpoint)
+		case len(line.Body) == 0 || line.Body[0] != '{':
+			return nil, fmt.Errorf("%w: line %d: body must be a JSON object", ErrInvalidBatch, lineNumber)
+		}
+		if _, dup := customIds[line.CustomId]; dup {
+			return nil, fmt.Errorf("%w: line %d: duplicate custom_id %q", ErrInvalidBatch, lineNumber, line.CustomId)
+		}
+		customIds[line.CustomId] = struct{}{}
+		if len(items) == m.cfg.MaxItems {
+			return nil, fmt.Errorf("%w: more than %d requests", ErrInvalidBatch, m.cfg.MaxItems)
+		}
+		items = append(items, Item{Index: len(items), CustomId: line.CustomId, Endpoint: endpoint, Body: line.Body})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBatch, err)
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: input file has no requests", ErrInvalidBatch)
+	}
+	return items, nil
+}
+
+// GetBatch returns a batch of the API key
+func (m *Manager) GetBatch(ctx context.Context, apiKeyId, id string) (Batch, error) {
+	batch, err := m.store.GetBatch(ctx, id)
+	if err != nil {
+		return Batch{}, err
+	}
+	if batch.apiKeyId != apiKeyId {
+		return Batch{}, ErrNotFound
+	}
+	return batch, nil
+}
+
+// ListBatches returns the batches of the API key, newest first
+func (m *Manager) ListBatches(ctx context.Context, apiKeyId string) ([]Batch, error) {
+	return m.store.ListBatches(ctx, apiKeyId)
+}
+
+// CancelBatch stops dispatching the items of a batch. Items already running still complete.
+func (m *Manager) CancelBatch(ctx context.Context, apiKeyId, id string) (Batch, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batch, err := m.GetBatch(ctx, apiKeyId, id)
+	if err != nil {
+		return Batch{}, err
+	}
+	if batch.Status != StatusInProgress {
+		return Batch{}, ErrInvalidStatus
+	}
+	if err := m.failPending(ctx, batch, "batch_cancelled", "the batch was cancelled"); err != nil {
+		return Batch{}, err
+	}
+	if err := m.store.SetBatchStatus(ctx, batch.Id, StatusCancelling, 0); err != nil {
+		return Batch{}, err
+	}
+	logging.Info("Batch cancelled", types.Inferences, "batchId", batch.Id)
+	m.notify()
+	return m.store.GetBatch(ctx, batch.Id)
+}
+
+func (m *Manager) notify() {
+	select {
+	case m.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start dispatches pending items to the runner, at most Concurrency at once, until ctx is done
+func (m *Manager) Start(ctx context.Context, runner Runner) {
+	m.recoverRunning(ctx)
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	slots := make(chan struct{}, m.cfg.Concurrency)
+	for {
+		m.finishBatches(ctx)
+		if runner.BatchCapacityAvailable() {
+			m.dispatch(ctx, runner, slots, &wg)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-m.wake:
+		}
+	}
+}
+
+func (m *Manager) dispatch(ctx context.Context, runner Runner, slots chan struct{}, wg *sync.WaitGroup) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	free := cap(slots) - len(slots)
+	if free == 0 {
+		return
+	}
+	items, err := m.store.NextItems(ctx, m.now().Unix(), free)
+	if err != nil {
+		logging.Error("Failed to get pending batch items", types.Inferences, "error", err)
+		return
+	}
+	for _, item := range items {
+		if err := m.store.StartItem(ctx, item.BatchId, item.Index); err != nil {
+			logging.Error("Failed to start batch item", types.Inferences, "batchId", item.BatchId, "index", item.Index, "error", err)
+			continue
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(item Item) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			m.run(ctx, runner, item)
+			m.notify()
+		}(item)
+	}
+}
+
+func (m *Manager) run(ctx context.Context, runner Runner, item Item) {
+	item.Attempts++
+	result := runner.RunBatchItem(ctx, item)
+	if ctx.Err() != nil {
+		// Shutting down, the item is failed as interrupted on the next start
+		return
+	}
+	batch, err := m.store.GetBatch(ctx, item.BatchId)
+	if err != nil {
+		logging.Error("Failed to get batch of item", types.Inferences, "batchId", item.BatchId, "error", err)
+		return
+	}
+
+	now := m.now()
+	if result.Retriable && item.Attempts < m.cfg.MaxAttempts && batch.Status == StatusInProgress && now.Unix() < batch.ExpiresAt {
+		delay := min(baseRetryDelay<<(item.Attempts-1), maxRetryDelay)
+		logging.Info("Batch item not served, retrying later", types.Inferences,
+			"batchId", item.BatchId, "index", item.Index, "statusCode", result.StatusCode, "attempts", item.Attempts, "delay", delay)
+		if err := m.store.RetryItem(ctx, item.BatchId, item.Index, item.Attempts, now.Add(delay).Unix()); err != nil {
+			logging.Error("Failed to requeue batch item", types.Inferences, "batchId", item.BatchId, "index", item.Index, "error", err)
+		}
+		return
+	}
+
+	status := ItemFailed
+	if result.StatusCode >= 200 && result.StatusCode < 300 {
+		status = ItemCompleted
+	}
+	body := json.RawMessage(result.Body)
+	if !json.Valid(body) {
+		body, _ = json.Marshal(string(result.Body))
+	}
+	line := outputLine{
+		Id:       requestId(item.BatchId, item.Index),
+		CustomId: item.CustomId,
+		Response: &outputResponse{StatusCode: result.StatusCode, RequestId: result.InferenceId, Body: body},
+	}
+	if err := m.appendOutput(batch, line); err != nil {
+		logging.Error("Failed to write batch output", types.Inferences, "batchId", item.BatchId, "index", item.Index, "error", err)
+	}
+	if err := m.store.FinishItem(ctx, item.BatchId, item.Index, status, result.InferenceId, item.Attempts); err != nil {
+		logging.Error("Failed to finish batch item", types.Inferences, "batchId", item.BatchId, "index", item.Index, "error", err)
+	}
+}
+
+func (m *Manager) appendOutput(batch Batch, lines ...outputLine) error {
+	m.outputMu.Lock()
+	defer m.outputMu.Unlock()
+
+	f, err := os.OpenFile(m.filePath(batch.OutputFileId), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	for _, line := range lines {
+		bz, err := json.Marshal(line)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(append(bz, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// failPending fails the pending items of a batch, with an error line each in the output file
+func (m *Manager) failPending(ctx context.Context, batch Batch, code, message string) error {
+	items, err := m.store.PendingItems(ctx, batch.Id)
+	if err != nil {
+		return err
+	}
+	lines := make([]outputLine, 0, len(items))
+	for _, item := range items {
+		lines = append(lines, outputLine{
+			Id:       requestId(batch.Id, item.Index),
+			CustomId: item.CustomId,
+			Error:    &outputError{Code: code, Message: message},
+		})
+	}
+	if err := m.appendOutput(batch, lines...); err != nil {
+		return err
+	}
+	return m.store.FailPending(ctx, batch.Id)
+}
+
+// recoverRunning fails the items that were running when the API stopped: they may have started on chain,
+// so they are not requested again
+func (m *Manager) recoverRunning(ctx context.Context) {
+	items, err := m.store.RunningItems(ctx)
+	if err != nil {
+		logging.Error("Failed to get interrupted batch items", types.Inferences, "error", err)
+		return
+	}
+	for _, item := range items {
+		batch, err := m.store.GetBatch(ctx, item.BatchId)
+		if err != nil {
+			logging.Error("Failed to get batch of interrupted item", types.Inferences, "batchId", item.BatchId, "error", err)
+			continue
+		}
+		line := outputLine{
+			Id:       requestId(batch.Id, item.Index),
+			CustomId: item.CustomId,
+			Error:    &outputError{Code: "interrupted", Message: "the API restarted while the request was running"},
+		}
+		if err := m.appendOutput(batch, line); err != nil {
+			logging.Error("Failed to write batch output", types.Inferences, "batchId", item.BatchId, "error", err)
+		}
+		if err := m.store.FinishItem(ctx, item.BatchId, item.Index, ItemFailed, "", item.Attempts); err != nil {
+			logging.Error("Failed to fail interrupted batch item", types.Inferences, "batchId", item.BatchId, "error", err)
+		}
+	}
+}
+
+// finishBatches expires the batches past their completion window and completes the ones without
+// pending or running items
+func (m *Manager) finishBatches(ctx context.Context) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	batches, err := m.store.ActiveBatches(ctx)
+	if err != nil {
+		logging.Error("Failed to get active batches", types.Inferences, "error", err)
+		return
+	}
+	now := m.now().Unix()
+	for _, batch := range batches {
+		open, err := m.store.OpenItems(ctx, batch.Id)
+		if err != nil {
+			logging.Error("Failed to count open batch items", types.Inferences, "batchId", batch.Id, "error", err)
+			continue
+		}
+		switch {
+		case batch.Status == StatusInProgress && now >= batch.ExpiresAt:
+			if err := m.failPending(ctx, batch, "batch_expired", "the batch could not be completed within its completion window"); err != nil {
+				logging.Error("Failed to expire batch", types.Inferences, "batchId", batch.Id, "error", err)
+				continue
+			}
+			err = m.store.SetBatchStatus(ctx, batch.Id, StatusExpired, now)
+			logging.Info("Batch expired", types.Inferences, "batchId", batch.Id)
+		case open > 0:
+			continue
+		case batch.Status == StatusCancelling:
+			err = m.store.SetBatchStatus(ctx, batch.Id, StatusCancelled, now)
+		default:
+			err = m.store.SetBatchStatus(ctx, batch.Id, StatusCompleted, now)
+			logging.Info("Batch completed", types.Inferences, "batchId", batch.Id)
+		}
+		if err != nil {
+			logging.Error("Failed to update batch status", types.Inferences, "batchId", batch.Id, "error", err)
+		}
+	}
+}
+
+// requestId identifies an item in the output file
+func requestId(batchId string, index int) string {
+	return fmt.Sprintf("batch_req_%s_%d", strings.TrimPrefix(batchId, "batch_"), index)
+}
+
+func isEndpoint(endpoint string) bool {
+	for _, known := range Endpoints {
+		if endpoint == known {
+			return true
+		}
+	}
+	return false
+}
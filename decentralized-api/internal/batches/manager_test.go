@@ -0,0 +1,172 @@
+package batches
+
+import (
+	"bufio"
+	"context"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRunner struct {
+	mu       sync.Mutex
+	busy     int // items answered with no capacity before one is served
+	runs     map[string]int
+	disabled bool
+}
+
+func (f *fakeRunner) BatchCapacityAvailable() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return !f.disabled
+}
+
+func (f *fakeRunner) RunBatchItem(ctx context.Context, item Item) Result {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.runs[item.CustomId]++
+	inferenceId := fmt.Sprintf("inf-%s-%d", item.CustomId, f.runs[item.CustomId])
+	if f.busy > 0 {
+		f.busy--
+		return Result{InferenceId: inferenceId, StatusCode: http.StatusServiceUnavailable, Body: []byte(`{"error":"no capacity"}`), Retriable: true}
+	}
+	return Result{InferenceId: inferenceId, StatusCode: http.StatusOK, Body: []byte(`{"echo":` + string(item.Body) + `}`)}
+}
+
+func newTestManager(t *testing.T) *Manager {
+	t.Helper()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(context.Background(), db))
+	m, err := NewManager(db, Config{Dir: t.TempDir(), Concurrency: 2, MaxItems: 10, MaxFileBytes: 1 << 20, MaxAttempts: 3})
+	require.NoError(t, err)
+	return m
+}
+
+func inputFile(customIds ...string) string {
+	var sb strings.Builder
+	for _, id := range customIds {
+		sb.WriteString(`{"custom_id":"` + id + `","method":"POST","url":"/v1/chat/completions","body":{"model":"m","messages":[]}}` + "\n")
+	}
+	return sb.String()
+}
+
+func readOutput(t *testing.T, m *Manager, batch Batch) map[string]outputLine {
+	content, err := m.OpenFile(context.Background(), "key", batch.OutputFileId)
+	require.NoError(t, err)
+	defer content.Close()
+	lines := make(map[string]outputLine)
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		var line outputLine
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines[line.CustomId] = line
+	}
+	return lines
+}
+
+func waitForStatus(t *testing.T, m *Manager, id string, status Status) Batch {
+	var batch Batch
+	require.Eventually(t, func() bool {
+		var err error
+		batch, err = m.GetBatch(context.Background(), "key", id)
+		require.NoError(t, err)
+		return batch.Status == status
+	}, 5*time.Second, 10*time.Millisecond)
+	return batch
+}
+
+func TestBatchLifecycle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := newTestManager(t)
+
+	input, err := m.CreateFile(ctx, "key", "input.jsonl", PurposeBatch, strings.NewReader(inputFile("a", "b", "c")))
+	require.NoError(t, err)
+	_, err = m.GetFile(ctx, "other-key", input.Id)
+	require.ErrorIs(t, err, ErrNotFound)
+
+	batch, err := m.CreateBatch(ctx, "key", CreateBatchRequest{InputFileId: input.Id, Endpoint: "/v1/chat/completions"})
+	require.NoError(t, err)
+	require.Equal(t, StatusInProgress, batch.Status)
+	require.Equal(t, 3, batch.RequestCounts.Total)
+
+	runner := &fakeRunner{busy: 1, runs: make(map[string]int)}
+	// Every reading of the clock is 10s later, so retries are due right away
+	var ticks atomic.Int64
+	m.now = func() time.Time { return time.Now().Add(time.Duration(ticks.Add(1)) * 10 * time.Second) }
+	go m.Start(ctx, runner)
+
+	batch = waitForStatus(t, m, batch.Id, StatusCompleted)
+	require.Equal(t, RequestCounts{Total: 3, Completed: 3}, batch.RequestCounts)
+	require.NotZero(t, batch.CompletedAt)
+
+	lines := readOutput(t, m, batch)
+	require.Len(t, lines, 3)
+	for _, id := range []string{"a", "b", "c"} {
+		require.Equal(t, http.StatusOK, lines[id].Response.StatusCode)
+		require.Nil(t, lines[id].Error)
+	}
+	// The item answered without capacity was served on its second attempt
+	served := 0
+	for _, line := range lines {
+		if strings.HasSuffix(line.Response.RequestId, "-2") {
+			served++
+		}
+	}
+	require.Equal(t, 1, served)
+}
+
+func TestCreateBatch_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+	m := newTestManager(t)
+
+	for name, content := range map[string]string{
+		"empty":        "\n",
+		"duplicate id": inputFile("a", "a"),
+		"wrong url":    `{"custom_id":"a","method":"POST","url":"/v1/embeddings","body":{}}`,
+		"too many":     inputFile("1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11"),
+	} {
+		input, err := m.CreateFile(ctx, "key", "input.jsonl", PurposeBatch, strings.NewReader(content))
+		require.NoError(t, err)
+		_, err = m.CreateBatch(ctx, "key", CreateBatchRequest{InputFileId: input.Id, Endpoint: "/v1/chat/completions"})
+		require.ErrorIs(t, err, ErrInvalidBatch, name)
+	}
+}
+
+func TestCancelBatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	m := newTestManager(t)
+
+	input, err := m.CreateFile(ctx, "key", "input.jsonl", PurposeBatch, strings.NewReader(inputFile("a", "b")))
+	require.NoError(t, err)
+	batch, err := m.CreateBatch(ctx, "key", CreateBatchRequest{InputFileId: input.Id, Endpoint: "/v1/chat/completions"})
+	require.NoError(t, err)
+
+	// Nothing is dispatched while there is no capacity
+	runner := &fakeRunner{disabled: true, runs: make(map[string]int)}
+	go m.Start(ctx, runner)
+
+	batch, err = m.CancelBatch(ctx, "key", batch.Id)
+	require.NoError(t, err)
+	require.Equal(t, RequestCounts{Total: 2, Failed: 2}, batch.RequestCounts)
+	_, err = m.CancelBatch(ctx, "key", batch.Id)
+	require.ErrorIs(t, err, ErrInvalidStatus)
+
+	batch = waitForStatus(t, m, batch.Id, StatusCancelled)
+	lines := readOutput(t, m, batch)
+	require.Len(t, lines, 2)
+	require.Equal(t, "batch_cancelled", lines["a"].Error.Code)
+	require.Empty(t, runner.runs)
+}
@@ -0,0 +1,243 @@
+package batches
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+)
+
+// batchStore persists files, batches and their items so batches survive API restarts
+type batchStore interface {
+	SaveFile(ctx context.Context, file File) error
+	GetFile(ctx context.Context, id string) (File, error)
+
+	CreateBatch(ctx context.Context, batch Batch, items []Item) error
+	GetBatch(ctx context.Context, id string) (Batch, error)
+	ListBatches(ctx context.Context, apiKeyId string) ([]Batch, error)
+	ActiveBatches(ctx context.Context) ([]Batch, error)
+	SetBatchStatus(ctx context.Context, id string, status Status, finishedAt int64) error
+
+	NextItems(ctx context.Context, now int64, limit int) ([]Item, error)
+	PendingItems(ctx context.Context, batchId string) ([]Item, error)
+	RunningItems(ctx context.Context) ([]Item, error)
+	OpenItems(ctx context.Context, batchId string) (int, error)
+	StartItem(ctx context.Context, batchId string, index int) error
+	RetryItem(ctx context.Context, batchId string, index int, attempts int, nextAttemptAt int64) error
+	FinishItem(ctx context.Context, batchId string, index int, status ItemStatus, inferenceId string, attempts int) error
+	FailPending(ctx context.Context, batchId string) error
+}
+
+// sqlBatchStore stores batches in the batch_files, batches and batch_items tables created by apiconfig.EnsureSchema
+type sqlBatchStore struct {
+	db *sql.DB
+}
+
+func newSqlBatchStore(db *sql.DB) *sqlBatchStore {
+	return &sqlBatchStore{db: db}
+}
+
+func (s *sqlBatchStore) SaveFile(ctx context.Context, file File) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO batch_files (id, api_key_id, purpose, filename, created_at) VALUES (?, ?, ?, ?, ?)`,
+		file.Id, file.apiKeyId, file.Purpose, file.Filename, file.CreatedAt)
+	return err
+}
+
+func (s *sqlBatchStore) GetFile(ctx context.Context, id string) (File, error) {
+	file := File{Object: "file"}
+	err := s.db.QueryRowContext(ctx, `SELECT id, api_key_id, purpose, filename, created_at FROM batch_files WHERE id = ?`, id).
+		Scan(&file.Id, &file.apiKeyId, &file.Purpose, &file.Filename, &file.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return File{}, ErrNotFound
+	}
+	return file, err
+}
+
+func (s *sqlBatchStore) CreateBatch(ctx context.Context, batch Batch, items []Item) error {
+	metadata, err := json.Marshal(batch.Metadata)
+	if err != nil {
+		return err
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, `
+INSERT INTO batches (id, api_key_id, endpoint, input_file_id, output_file_id, completion_window, status, metadata, created_at, expires_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		batch.Id, batch.apiKeyId, batch.Endpoint, batch.InputFileId, batch.OutputFileId, batch.CompletionWindow,
+		batch.Status, string(metadata), batch.CreatedAt, batch.ExpiresAt); err != nil {
+		return err
+	}
+	stmt, err := tx.PrepareContext(ctx, `INSERT INTO batch_items (batch_id, idx, custom_id, body, status) VALUES (?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+	for _, item := range items {
+		if _, err := stmt.ExecContext(ctx, batch.Id, item.Index, item.CustomId, item.Body, ItemPending); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+const batchColumns = `id, api_key_id, endpoint, input_file_id, output_file_id, completion_window, status, metadata,
+created_at, expires_at, finished_at`
+
+func (s *sqlBatchStore) GetBatch(ctx context.Context, id string) (Batch, error) {
+	batch, err := scanBatch(s.db.QueryRowContext(ctx, `SELECT `+batchColumns+` FROM batches WHERE id = ?`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return Batch{}, ErrNotFound
+	}
+	if err != nil {
+		return Batch{}, err
+	}
+	batch.RequestCounts, err = s.requestCounts(ctx, id)
+	return batch, err
+}
+
+func (s *sqlBatchStore) ListBatches(ctx context.Context, apiKeyId string) ([]Batch, error) {
+	batches, err := s.queryBatches(ctx, `SELECT `+batchColumns+` FROM batches WHERE api_key_id = ? ORDER BY created_at DESC, id`, apiKeyId)
+	if err != nil {
+		return nil, err
+	}
+	for i := range batches {
+		if batches[i].RequestCounts, err = s.requestCounts(ctx, batches[i].Id); err != nil {
+			return nil, err
+		}
+	}
+	return batches, nil
+}
+
+// ActiveBatches returns the batches that are not finished yet
+func (s *sqlBatchStore) ActiveBatches(ctx context.Context) ([]Batch, error) {
+	return s.queryBatches(ctx, `SELECT `+batchColumns+` FROM batches WHERE status IN (?, ?) ORDER BY created_at, id`,
+		StatusInProgress, StatusCancelling)
+}
+
+func (s *sqlBatchStore) queryBatches(ctx context.Context, query string, args ...any) ([]Batch, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var batches []Batch
+	for rows.Next() {
+		batch, err := scanBatch(rows)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, batch)
+	}
+	return batches, rows.Err()
+}
+
+func (s *sqlBatchStore) requestCounts(ctx context.Context, batchId string) (RequestCounts, error) {
+	var counts RequestCounts
+	err := s.db.QueryRowContext(ctx, `
+SELECT COUNT(*), COALESCE(SUM(status = ?), 0), COALESCE(SUM(status = ?), 0) FROM batch_items WHERE batch_id = ?`,
+		ItemCompleted, ItemFailed, batchId).Scan(&counts.Total, &counts.Completed, &counts.Failed)
+	return counts, err
+}
+
+func (s *sqlBatchStore) SetBatchStatus(ctx context.Context, id string, status Status, finishedAt int64) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE batches SET status = ?, finished_at = ? WHERE id = ?`, status, finishedAt, id)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanBatch(row scanner) (Batch, error) {
+	batch := Batch{Object: "batch"}
+	var metadata string
+	if err := row.Scan(&batch.Id, &batch.apiKeyId, &batch.Endpoint, &batch.InputFileId, &batch.OutputFileId, &batch.CompletionWindow,
+		&batch.Status, &metadata, &batch.CreatedAt, &batch.ExpiresAt, &batch.finishedAt); err != nil {
+		return Batch{}, err
+	}
+	if err := json.Unmarshal([]byte(metadata), &batch.Metadata); err != nil {
+		return Batch{}, err
+	}
+	switch batch.Status {
+	case StatusCompleted:
+		batch.CompletedAt = batch.finishedAt
+	case StatusExpired:
+		batch.ExpiredAt = batch.finishedAt
+	case StatusCancelled:
+		batch.CancelledAt = batch.finishedAt
+	}
+	return batch, nil
+}
+
+const itemColumns = `i.batch_id, i.idx, i.custom_id, b.endpoint, i.body, b.api_key_id, i.attempts`
+
+// NextItems returns the pending items due for an attempt, oldest batches first
+func (s *sqlBatchStore) NextItems(ctx context.Context, now int64, limit int) ([]Item, error) {
+	return s.queryItems(ctx, `SELECT `+itemColumns+` FROM batch_items i JOIN batches b ON b.id = i.batch_id
+WHERE i.status = ? AND i.next_attempt_at <= ? AND b.status = ? ORDER BY b.created_at, b.id, i.idx LIMIT ?`,
+		ItemPending, now, StatusInProgress, limit)
+}
+
+func (s *sqlBatchStore) PendingItems(ctx context.Context, batchId string) ([]Item, error) {
+	return s.queryItems(ctx, `SELECT `+itemColumns+` FROM batch_items i JOIN batches b ON b.id = i.batch_id
+WHERE i.batch_id = ? AND i.status = ? ORDER BY i.idx`, batchId, ItemPending)
+}
+
+func (s *sqlBatchStore) RunningItems(ctx context.Context) ([]Item, error) {
+	return s.queryItems(ctx, `SELECT `+itemColumns+` FROM batch_items i JOIN batches b ON b.id = i.batch_id
+WHERE i.status = ? ORDER BY i.batch_id, i.idx`, ItemRunning)
+}
+
+func (s *sqlBatchStore) queryItems(ctx context.Context, query string, args ...any) ([]Item, error) {
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []Item
+	for rows.Next() {
+		var item Item
+		if err := rows.Scan(&item.BatchId, &item.Index, &item.CustomId, &item.Endpoint, &item.Body, &item.ApiKeyId, &item.Attempts); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// OpenItems counts the pending and running items of a batch
+func (s *sqlBatchStore) OpenItems(ctx context.Context, batchId string) (int, error) {
+	var open int
+	err := s.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM batch_items WHERE batch_id = ? AND status IN (?, ?)`,
+		batchId, ItemPending, ItemRunning).Scan(&open)
+	return open, err
+}
+
+func (s *sqlBatchStore) StartItem(ctx context.Context, batchId string, index int) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE batch_items SET status = ? WHERE batch_id = ? AND idx = ?`, ItemRunning, batchId, index)
+	return err
+}
+
+func (s *sqlBatchStore) RetryItem(ctx context.Context, batchId string, index int, attempts int, nextAttemptAt int64) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE batch_items SET status = ?, attempts = ?, next_attempt_at = ? WHERE batch_id = ? AND idx = ?`,
+		ItemPending, attempts, nextAttemptAt, batchId, index)
+	return err
+}
+
+func (s *sqlBatchStore) FinishItem(ctx context.Context, batchId string, index int, status ItemStatus, inferenceId string, attempts int) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE batch_items SET status = ?, inference_id = ?, attempts = ? WHERE batch_id = ? AND idx = ?`,
+		status, inferenceId, attempts, batchId, index)
+	return err
+}
+
+func (s *sqlBatchStore) FailPending(ctx context.Context, batchId string) error {
+	_, err := s.db.ExecContext(ctx, `UPDATE batch_items SET status = ? WHERE batch_id = ? AND status = ?`, ItemFailed, batchId, ItemPending)
+	return err
+}
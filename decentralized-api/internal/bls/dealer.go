@@ -55,6 +55,14 @@ func (bm *BlsManager) ProcessKeyGenerationInitiated(event *chainevents.JSONRPCRe
 		return fmt.Errorf("failed to parse epoch_id: %w", err)
 	}
 
+	// The chain restarts a stalled DKG round for the same epoch without its non-responders,
+	// so a result verified in an earlier round of this epoch no longer applies
+	if bm.cache.Get(epochID) != nil {
+		logging.Info("Dropping verification result of a restarted DKG round", inferenceTypes.BLS, "epochID", epochID)
+		bm.cache.Delete(epochID)
+		bm.deletePersistedVerificationResult(epochID)
+	}
+
 	totalSlotsStrs, ok := event.Result.Events["inference.bls.EventKeyGenerationInitiated.i_total_slots"]
 	if !ok || len(totalSlotsStrs) == 0 {
 		return fmt.Errorf("i_total_slots not found in event")
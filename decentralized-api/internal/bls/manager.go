@@ -26,6 +26,10 @@ type BlsManager struct {
 	cache        *VerificationCache
 	recoverySF   singleflight.Group
 	maxCacheSize uint64
+
+	stateMu    sync.Mutex
+	stateStore dkgStateStore
+	sealer     stateSealer
 }
 
 // VerificationResult holds the results of DKG verification for an epoch
@@ -84,6 +88,13 @@ func (vc *VerificationCache) Get(epochID uint64) *VerificationResult {
 	return vc.results[epochID]
 }
 
+// Delete drops the result of an epoch, e.g. when the chain restarted its DKG round
+func (vc *VerificationCache) Delete(epochID uint64) {
+	vc.Lock()
+	defer vc.Unlock()
+	delete(vc.results, epochID)
+}
+
 func (vc *VerificationCache) GetCurrent() *VerificationResult {
 	vc.RLock()
 	defer vc.RUnlock()
@@ -190,7 +201,7 @@ func (bm *BlsManager) GetOrRecoverVerificationResult(epochID uint64) (*Verificat
 	return bm.cache.Get(epochID), nil
 }
 
-// storeVerificationResult stores a verification result in the cache
+// storeVerificationResult stores a verification result in the cache and persists it when persistence is enabled
 // This method can be extended in the future for additional validation or processing
 func (bm *BlsManager) storeVerificationResult(result *VerificationResult) {
 	if result == nil {
@@ -199,6 +210,7 @@ func (bm *BlsManager) storeVerificationResult(result *VerificationResult) {
 	}
 
 	bm.cache.Store(result)
+	bm.persistVerificationResult(result)
 
 	logging.Debug(verifierLogTag+"Stored verification result", inferenceTypes.BLS,
 		"epochID", result.EpochID,
@@ -0,0 +1,290 @@
+package bls
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/productscience/inference/x/bls/types"
+	inferenceTypes "github.com/productscience/inference/x/inference/types"
+)
+
+// persistedVerificationResult is the JSON form of a VerificationResult; field elements are stored as 32-byte big-endian values
+type persistedVerificationResult struct {
+	EpochID          uint64         `json:"epoch_id"`
+	DkgPhase         types.DKGPhase `json:"dkg_phase"`
+	IsParticipant    bool           `json:"is_participant"`
+	SlotRange        [2]uint32      `json:"slot_range"`
+	DealerShares     [][][]byte     `json:"dealer_shares"`
+	DealerValidity   []bool         `json:"dealer_validity"`
+	AggregatedShares [][]byte       `json:"aggregated_shares"`
+	ValidDealers     []bool         `json:"valid_dealers"`
+	GroupPublicKey   []byte         `json:"group_public_key"`
+}
+
+func newPersistedVerificationResult(result *VerificationResult) persistedVerificationResult {
+	persisted := persistedVerificationResult{
+		EpochID:          result.EpochID,
+		DkgPhase:         result.DkgPhase,
+		IsParticipant:    result.IsParticipant,
+		SlotRange:        result.SlotRange,
+		DealerShares:     make([][][]byte, len(result.DealerShares)),
+		DealerValidity:   result.DealerValidity,
+		AggregatedShares: elementsToBytes(result.AggregatedShares),
+		ValidDealers:     result.ValidDealers,
+		GroupPublicKey:   result.GroupPublicKey,
+	}
+	for i, shares := range result.DealerShares {
+		persisted.DealerShares[i] = elementsToBytes(shares)
+	}
+	return persisted
+}
+
+func (p persistedVerificationResult) toVerificationResult() *VerificationResult {
+	result := &VerificationResult{
+		EpochID:          p.EpochID,
+		DkgPhase:         p.DkgPhase,
+		IsParticipant:    p.IsParticipant,
+		SlotRange:        p.SlotRange,
+		DealerShares:     make([][]fr.Element, len(p.DealerShares)),
+		DealerValidity:   p.DealerValidity,
+		AggregatedShares: bytesToElements(p.AggregatedShares),
+		ValidDealers:     p.ValidDealers,
+		GroupPublicKey:   p.GroupPublicKey,
+	}
+	for i, shares := range p.DealerShares {
+		result.DealerShares[i] = bytesToElements(shares)
+	}
+	return result
+}
+
+func elementsToBytes(elements []fr.Element) [][]byte {
+	out := make([][]byte, len(elements))
+	for i := range elements {
+		b := elements[i].Bytes()
+		out[i] = b[:]
+	}
+	return out
+}
+
+func bytesToElements(values [][]byte) []fr.Element {
+	out := make([]fr.Element, len(values))
+	for i, b := range values {
+		out[i].SetBytes(b)
+	}
+	return out
+}
+
+// stateSealer encrypts DKG state at rest; the cosmos client implements it with the node's keyring key
+type stateSealer interface {
+	EncryptBytes(plaintext []byte) ([]byte, error)
+	DecryptBytes(ciphertext []byte) ([]byte, error)
+}
+
+// dkgStateStore persists sealed verification results so an API restart during key generation keeps the secret shares
+type dkgStateStore interface {
+	Save(ctx context.Context, epochID uint64, sealed []byte) error
+	Delete(ctx context.Context, epochID uint64) error
+	DeleteBefore(ctx context.Context, epochID uint64) error
+	LoadAll(ctx context.Context) (map[uint64][]byte, error)
+}
+
+// sqlDkgStateStore stores DKG state in the bls_dkg_state table created by apiconfig.EnsureSchema
+type sqlDkgStateStore struct {
+	db *sql.DB
+}
+
+func newSqlDkgStateStore(db *sql.DB) *sqlDkgStateStore {
+	return &sqlDkgStateStore{db: db}
+}
+
+func (s *sqlDkgStateStore) Save(ctx context.Context, epochID uint64, sealed []byte) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO bls_dkg_state (epoch_id, state_blob, updated_at)
+VALUES (?, ?, STRFTIME('%Y-%m-%d %H:%M:%f','now'))
+ON CONFLICT(epoch_id) DO UPDATE SET
+  state_blob = excluded.state_blob,
+  updated_at = excluded.updated_at`,
+		epochID, sealed)
+	return err
+}
+
+func (s *sqlDkgStateStore) Delete(ctx context.Context, epochID uint64) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM bls_dkg_state WHERE epoch_id = ?`, epochID)
+	return err
+}
+
+func (s *sqlDkgStateStore) DeleteBefore(ctx context.Context, epochID uint64) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM bls_dkg_state WHERE epoch_id < ?`, epochID)
+	return err
+}
+
+func (s *sqlDkgStateStore) LoadAll(ctx context.Context) (map[uint64][]byte, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT epoch_id, state_blob FROM bls_dkg_state`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	states := make(map[uint64][]byte)
+	for rows.Next() {
+		var (
+			epochID uint64
+			sealed  []byte
+		)
+		if err := rows.Scan(&epochID, &sealed); err != nil {
+			return nil, err
+		}
+		states[epochID] = sealed
+	}
+	return states, rows.Err()
+}
+
+// EnableStatePersistence restores the verification results saved by a previous run and keeps saving them.
+// Rounds still in the verifying phase are then resumed, so the verification vector is submitted if it was not yet.
+func (bm *BlsManager) EnableStatePersistence(ctx context.Context, db *sql.DB) error {
+	restored, err := bm.enableStatePersistence(ctx, newSqlDkgStateStore(db), &bm.cosmosClient)
+	if err != nil {
+		return err
+	}
+	for _, result := range restored {
+		if result.DkgPhase == types.DKGPhase_DKG_PHASE_VERIFYING {
+			go bm.resumeVerifyingRound(result.EpochID)
+		}
+	}
+	return nil
+}
+
+func (bm *BlsManager) enableStatePersistence(ctx context.Context, store dkgStateStore, sealer stateSealer) ([]*VerificationResult, error) {
+	states, err := store.LoadAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var restored []*VerificationResult
+	for epochID, sealed := range states {
+		result, err := unsealVerificationResult(sealer, sealed)
+		if err != nil {
+			logging.Warn(blsLogTag+"Dropping unreadable persisted DKG state", inferenceTypes.BLS, "epochID", epochID, "error", err)
+			_ = store.Delete(ctx, epochID)
+			continue
+		}
+		bm.cache.Store(result)
+		restored = append(restored, result)
+	}
+
+	bm.stateMu.Lock()
+	bm.stateStore = store
+	bm.sealer = sealer
+	bm.stateMu.Unlock()
+	logging.Info(blsLogTag+"Loaded persisted DKG state", inferenceTypes.BLS, "epochs", len(restored))
+	return restored, nil
+}
+
+// persistVerificationResult saves a verification result and drops the ones the cache no longer keeps
+func (bm *BlsManager) persistVerificationResult(result *VerificationResult) {
+	bm.stateMu.Lock()
+	store, sealer := bm.stateStore, bm.sealer
+	bm.stateMu.Unlock()
+	if store == nil {
+		return
+	}
+
+	sealed, err := sealVerificationResult(sealer, result)
+	if err != nil {
+		logging.Warn(blsLogTag+"Failed to seal DKG state", inferenceTypes.BLS, "epochID", result.EpochID, "error", err)
+		return
+	}
+	if err := store.Save(bm.ctx, result.EpochID, sealed); err != nil {
+		logging.Warn(blsLogTag+"Failed to persist DKG state", inferenceTypes.BLS, "epochID", result.EpochID, "error", err)
+	}
+	if result.EpochID >= 2 {
+		if err := store.DeleteBefore(bm.ctx, result.EpochID-1); err != nil {
+			logging.Warn(blsLogTag+"Failed to prune persisted DKG state", inferenceTypes.BLS, "epochID", result.EpochID, "error", err)
+		}
+	}
+}
+
+func (bm *BlsManager) deletePersistedVerificationResult(epochID uint64) {
+	bm.stateMu.Lock()
+	store := bm.stateStore
+	bm.stateMu.Unlock()
+	if store == nil {
+		return
+	}
+	if err := store.Delete(bm.ctx, epochID); err != nil {
+		logging.Warn(blsLogTag+"Failed to delete persisted DKG state", inferenceTypes.BLS, "epochID", epochID, "error", err)
+	}
+}
+
+// resumeVerifyingRound continues a round restored in the verifying phase: it submits the verification vector
+// if the chain has none from us yet, or catches the result up if the round has moved on meanwhile.
+func (bm *BlsManager) resumeVerifyingRound(epochID uint64) {
+	res, err := bm.cosmosClient.NewBLSQueryClient().EpochBLSData(bm.ctx, &types.QueryEpochBLSDataRequest{EpochId: epochID})
+	if err != nil {
+		logging.Warn(blsLogTag+"Failed to query epoch data to resume DKG", inferenceTypes.BLS, "epochID", epochID, "error", err)
+		return
+	}
+	if err := bm.resumeRound(epochID, &res.EpochData); err != nil {
+		logging.Warn(blsLogTag+"Failed to resume DKG", inferenceTypes.BLS, "epochID", epochID, "error", err)
+	}
+}
+
+func (bm *BlsManager) resumeRound(epochID uint64, epochData *types.EpochBLSData) error {
+	result := bm.cache.Get(epochID)
+	if result == nil {
+		return nil
+	}
+
+	switch epochData.DkgPhase {
+	case types.DKGPhase_DKG_PHASE_VERIFYING:
+		myAddress := bm.cosmosClient.GetAccountAddress()
+		for i, participant := range epochData.Participants {
+			if participant.Address != myAddress {
+				continue
+			}
+			if i < len(epochData.VerificationSubmissions) &&
+				epochData.VerificationSubmissions[i] != nil &&
+				len(epochData.VerificationSubmissions[i].DealerValidity) > 0 {
+				return nil
+			}
+			logging.Info(blsLogTag+"Resuming DKG verification after restart", inferenceTypes.BLS, "epochID", epochID)
+			return bm.submitVerificationVectorSimplified(epochID)
+		}
+		return nil
+	case types.DKGPhase_DKG_PHASE_COMPLETED, types.DKGPhase_DKG_PHASE_SIGNED:
+		completed := *result
+		completed.DkgPhase = epochData.DkgPhase
+		completed.ValidDealers = epochData.ValidDealers
+		completed.GroupPublicKey = epochData.GroupPublicKey
+		bm.storeVerificationResult(&completed)
+		return nil
+	default:
+		// The round failed or was restarted; the shares of this round are of no use anymore
+		bm.cache.Delete(epochID)
+		bm.deletePersistedVerificationResult(epochID)
+		return nil
+	}
+}
+
+func sealVerificationResult(sealer stateSealer, result *VerificationResult) ([]byte, error) {
+	plaintext, err := json.Marshal(newPersistedVerificationResult(result))
+	if err != nil {
+		return nil, err
+	}
+	return sealer.EncryptBytes(plaintext)
+}
+
+func unsealVerificationResult(sealer stateSealer, sealed []byte) (*VerificationResult, error) {
+	plaintext, err := sealer.DecryptBytes(sealed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	var persisted persistedVerificationResult
+	if err := json.Unmarshal(plaintext, &persisted); err != nil {
+		return nil, err
+	}
+	return persisted.toVerificationResult(), nil
+}
@@ -0,0 +1,142 @@
+package bls
+
+import (
+	"decentralized-api/apiconfig"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/productscience/inference/x/bls/types"
+	"github.com/stretchr/testify/require"
+)
+
+// xorSealer stands in for the keyring encryption
+type xorSealer struct{}
+
+func (xorSealer) EncryptBytes(plaintext []byte) ([]byte, error) {
+	out := make([]byte, len(plaintext))
+	for i, b := range plaintext {
+		out[i] = b ^ 0x5a
+	}
+	return out, nil
+}
+
+func (s xorSealer) DecryptBytes(ciphertext []byte) ([]byte, error) {
+	return s.EncryptBytes(ciphertext)
+}
+
+type failingSealer struct{ xorSealer }
+
+func (failingSealer) DecryptBytes([]byte) ([]byte, error) {
+	return nil, errors.New("wrong key")
+}
+
+func newTestDkgStateStore(t *testing.T) *sqlDkgStateStore {
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(t.Context(), db))
+	return newSqlDkgStateStore(db)
+}
+
+func testVerificationResult(epochID uint64) *VerificationResult {
+	var a, b, c fr.Element
+	a.SetUint64(11)
+	b.SetUint64(22)
+	c.SetUint64(33)
+	return &VerificationResult{
+		EpochID:          epochID,
+		DkgPhase:         types.DKGPhase_DKG_PHASE_VERIFYING,
+		IsParticipant:    true,
+		SlotRange:        [2]uint32{4, 5},
+		DealerShares:     [][]fr.Element{{a, b}, {c, a}},
+		DealerValidity:   []bool{true, false},
+		AggregatedShares: []fr.Element{b, c},
+	}
+}
+
+func TestPersistedVerificationResultRoundTrip(t *testing.T) {
+	store := newTestDkgStateStore(t)
+	bm := NewBlsManager(createMockCosmosClient())
+	_, err := bm.enableStatePersistence(t.Context(), store, xorSealer{})
+	require.NoError(t, err)
+
+	result := testVerificationResult(3)
+	bm.storeVerificationResult(result)
+
+	states, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.Len(t, states, 1)
+	require.NotContains(t, string(states[3]), "dealer_shares", "state must be stored sealed")
+
+	// A restarted manager gets the shares back
+	restarted := NewBlsManager(createMockCosmosClient())
+	restored, err := restarted.enableStatePersistence(t.Context(), store, xorSealer{})
+	require.NoError(t, err)
+	require.Equal(t, []*VerificationResult{result}, restored)
+	require.Equal(t, result, restarted.GetVerificationResult(3))
+}
+
+func TestPersistedVerificationResultPruning(t *testing.T) {
+	store := newTestDkgStateStore(t)
+	bm := NewBlsManager(createMockCosmosClient())
+	_, err := bm.enableStatePersistence(t.Context(), store, xorSealer{})
+	require.NoError(t, err)
+
+	for epochID := uint64(1); epochID <= 4; epochID++ {
+		completed := testVerificationResult(epochID)
+		completed.DkgPhase = types.DKGPhase_DKG_PHASE_COMPLETED
+		bm.storeVerificationResult(completed)
+	}
+
+	states, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	require.Contains(t, states, uint64(3))
+	require.Contains(t, states, uint64(4))
+}
+
+func TestEnableStatePersistenceDropsUnreadableState(t *testing.T) {
+	store := newTestDkgStateStore(t)
+	sealed, err := sealVerificationResult(xorSealer{}, testVerificationResult(3))
+	require.NoError(t, err)
+	require.NoError(t, store.Save(t.Context(), 3, sealed))
+
+	bm := NewBlsManager(createMockCosmosClient())
+	_, err = bm.enableStatePersistence(t.Context(), store, failingSealer{})
+	require.NoError(t, err)
+	require.Nil(t, bm.GetVerificationResult(3))
+
+	states, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, states)
+}
+
+func TestResumeRound(t *testing.T) {
+	store := newTestDkgStateStore(t)
+	bm := NewBlsManager(createMockCosmosClient())
+	_, err := bm.enableStatePersistence(t.Context(), store, xorSealer{})
+	require.NoError(t, err)
+
+	// The round completed while the API was down
+	bm.storeVerificationResult(testVerificationResult(3))
+	require.NoError(t, bm.resumeRound(3, &types.EpochBLSData{
+		EpochId:        3,
+		DkgPhase:       types.DKGPhase_DKG_PHASE_COMPLETED,
+		ValidDealers:   []bool{true, false},
+		GroupPublicKey: []byte("group-key"),
+	}))
+	resumed := bm.GetVerificationResult(3)
+	require.Equal(t, types.DKGPhase_DKG_PHASE_COMPLETED, resumed.DkgPhase)
+	require.Equal(t, []byte("group-key"), resumed.GroupPublicKey)
+	require.Equal(t, testVerificationResult(3).AggregatedShares, resumed.AggregatedShares)
+
+	// The round failed while the API was down
+	bm.storeVerificationResult(testVerificationResult(4))
+	require.NoError(t, bm.resumeRound(4, &types.EpochBLSData{EpochId: 4, DkgPhase: types.DKGPhase_DKG_PHASE_FAILED}))
+	require.Nil(t, bm.GetVerificationResult(4))
+	states, err := store.LoadAll(t.Context())
+	require.NoError(t, err)
+	require.NotContains(t, states, uint64(4))
+}
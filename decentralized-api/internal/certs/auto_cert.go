@@ -0,0 +1,204 @@
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"decentralized-api/logging"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	DefaultCertDir = "/root/.dapi/certs"
+	CertFileName   = "cert.pem"
+	KeyFileName    = "key.pem"
+
+	// renewBefore matches the proxy-ssl renewal window, so a request inside it yields a new certificate
+	renewBefore   = 30 * 24 * time.Hour
+	checkInterval = 12 * time.Hour
+	retryInterval = 10 * time.Minute
+)
+
+// Signer is the part of the cosmos client needed to authenticate against proxy-ssl
+type Signer interface {
+	GetAccountAddress() string
+	SignBytes(seed []byte) ([]byte, error)
+}
+
+type certificateRequest struct {
+	Address   string `json:"address"`
+	FQDN      string `json:"fqdn"`
+	Timestamp int64  `json:"timestamp"`
+	Signature string `json:"signature"`
+}
+
+type certificateResponse struct {
+	OrderID     string `json:"order_id"`
+	PrivateKey  string `json:"private_key"`
+	Certificate string `json:"certificate"`
+	ExpiresAt   string `json:"expires_at"`
+}
+
+// AutoCertManager keeps a TLS certificate for the participant's public domain up to date
+// by requesting it from proxy-ssl, authenticated with the participant key.
+type AutoCertManager struct {
+	issuerUrl  string
+	publicUrl  string
+	certDir    string
+	signer     Signer
+	httpClient *http.Client
+}
+
+func NewAutoCertManager(issuerUrl, publicUrl, certDir string, signer Signer) *AutoCertManager {
+	if certDir == "" {
+		certDir = DefaultCertDir
+	}
+	return &AutoCertManager{
+		issuerUrl: strings.TrimRight(issuerUrl, "/"),
+		publicUrl: publicUrl,
+		certDir:   certDir,
+		signer:    signer,
+		// Issuance waits for the DNS-01 challenge, which can take several minutes
+		httpClient: &http.Client{Timeout: 10 * time.Minute},
+	}
+}
+
+// Start ensures the certificate exists and re-checks it periodically until ctx is done
+func (m *AutoCertManager) Start(ctx context.Context) {
+	for {
+		wait := checkInterval
+		if err := m.EnsureCertificate(ctx); err != nil {
+			logging.Error("Failed to ensure TLS certificate", types.Server, "error", err)
+			wait = retryInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// EnsureCertificate requests a certificate when none is stored or the stored one is close to expiry
+func (m *AutoCertManager) EnsureCertificate(ctx context.Context) error {
+	if notAfter, err := m.storedCertificateExpiry(); err == nil && time.Until(notAfter) > renewBefore {
+		return nil
+	}
+
+	fqdn, err := hostFromUrl(m.publicUrl)
+	if err != nil {
+		return fmt.Errorf("invalid public url: %w", err)
+	}
+
+	resp, err := m.requestCertificate(ctx, fqdn)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.certDir, 0o700); err != nil {
+		return fmt.Errorf("failed to create cert dir: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(m.certDir, KeyFileName), []byte(resp.PrivateKey), 0o600); err != nil {
+		return fmt.Errorf("failed to write private key: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(m.certDir, CertFileName), []byte(resp.Certificate), 0o644); err != nil {
+		return fmt.Errorf("failed to write certificate: %w", err)
+	}
+
+	logging.Info("TLS certificate updated", types.Server, "fqdn", fqdn, "orderId", resp.OrderID, "expiresAt", resp.ExpiresAt)
+	return nil
+}
+
+func (m *AutoCertManager) requestCertificate(ctx context.Context, fqdn string) (*certificateResponse, error) {
+	address := m.signer.GetAccountAddress()
+	timestamp := time.Now().UnixNano()
+
+	// Same layout as calculations.SignatureComponents{Payload: fqdn, Timestamp, TransferAddress: address}
+	message := fqdn + strconv.FormatInt(timestamp, 10) + address
+	signature, err := m.signer.SignBytes([]byte(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign certificate request: %w", err)
+	}
+
+	body, err := json.Marshal(certificateRequest{
+		Address:   address,
+		FQDN:      fqdn,
+		Timestamp: timestamp,
+		Signature: base64.StdEncoding.EncodeToString(signature),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, m.issuerUrl+"/v1/participant/certs", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("certificate request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusCreated {
+		msg, _ := io.ReadAll(io.LimitReader(httpResp.Body, 1024))
+		return nil, fmt.Errorf("cert issuer returned status %d: %s", httpResp.StatusCode, string(msg))
+	}
+
+	var resp certificateResponse
+	if err := json.NewDecoder(httpResp.Body).Decode(&resp); err != nil {
+		return nil, fmt.Errorf("failed to decode certificate response: %w", err)
+	}
+	if resp.Certificate == "" || resp.PrivateKey == "" {
+		return nil, fmt.Errorf("cert issuer returned an empty certificate")
+	}
+	return &resp, nil
+}
+
+func (m *AutoCertManager) storedCertificateExpiry() (time.Time, error) {
+	data, err := os.ReadFile(filepath.Join(m.certDir, CertFileName))
+	if err != nil {
+		return time.Time{}, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return time.Time{}, fmt.Errorf("no PEM block in stored certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.NotAfter, nil
+}
+
+func hostFromUrl(rawUrl string) (string, error) {
+	parsed, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", err
+	}
+	host := parsed.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "" {
+		return "", fmt.Errorf("url %q has no host", rawUrl)
+	}
+	return host, nil
+}
@@ -0,0 +1,115 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSigner struct {
+	signed []byte
+}
+
+func (s *fakeSigner) GetAccountAddress() string { return "gonka1participant" }
+
+func (s *fakeSigner) SignBytes(seed []byte) ([]byte, error) {
+	s.signed = seed
+	return []byte("signature"), nil
+}
+
+func selfSignedPEM(t *testing.T, notAfter time.Time) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "node.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestEnsureCertificate_RequestsAndStores(t *testing.T) {
+	var calls atomic.Int32
+	cert := selfSignedPEM(t, time.Now().Add(90*24*time.Hour))
+	signer := &fakeSigner{}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		require.Equal(t, "/v1/participant/certs", r.URL.Path)
+
+		var req certificateRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "node.example.com", req.FQDN)
+		require.Equal(t, "gonka1participant", req.Address)
+		require.Equal(t, "node.example.com"+strconv.FormatInt(req.Timestamp, 10)+"gonka1participant", string(signer.signed))
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(certificateResponse{
+			OrderID:     "order_1",
+			PrivateKey:  "key",
+			Certificate: cert,
+		})
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	manager := NewAutoCertManager(srv.URL, "https://node.example.com:8443", dir, signer)
+
+	require.NoError(t, manager.EnsureCertificate(t.Context()))
+	stored, err := os.ReadFile(filepath.Join(dir, CertFileName))
+	require.NoError(t, err)
+	require.Equal(t, cert, string(stored))
+
+	// A valid stored certificate is not requested again
+	require.NoError(t, manager.EnsureCertificate(t.Context()))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestEnsureCertificate_RenewsExpiringCertificate(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(certificateResponse{
+			PrivateKey:  "key",
+			Certificate: selfSignedPEM(t, time.Now().Add(90*24*time.Hour)),
+		})
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, CertFileName), []byte(selfSignedPEM(t, time.Now().Add(24*time.Hour))), 0o644))
+
+	manager := NewAutoCertManager(srv.URL, "https://node.example.com", dir, &fakeSigner{})
+	require.NoError(t, manager.EnsureCertificate(t.Context()))
+	require.Equal(t, int32(1), calls.Load())
+}
+
+func TestEnsureCertificate_IssuerError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"invalid signature"}`, http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	manager := NewAutoCertManager(srv.URL, "https://node.example.com", t.TempDir(), &fakeSigner{})
+	err := manager.EnsureCertificate(t.Context())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "401")
+}
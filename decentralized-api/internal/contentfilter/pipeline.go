@@ -0,0 +1,152 @@
+package contentfilter
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+)
+
+const (
+	ActionReject   = "reject"
+	ActionAnnotate = "annotate"
+)
+
+// Request is what filters see of an inference request
+type Request struct {
+	Endpoint         string
+	Model            string
+	RequesterAddress string
+	Body             []byte
+	// Prompt is the text of the messages of a chat request, or the inputs of an embeddings request
+	Prompt       string
+	MessageCount int
+}
+
+// Match is a filter hit. Status is the HTTP status a rejected request gets, 400 if unset.
+type Match struct {
+	Filter string `json:"filter"`
+	Reason string `json:"reason"`
+	Status int    `json:"-"`
+}
+
+// Filter inspects a request, returning a match or nil. Errors reject the request.
+type Filter interface {
+	Name() string
+	Check(ctx context.Context, request *Request) (*Match, error)
+}
+
+// Factory builds a filter from its configuration
+type Factory func(spec apiconfig.ContentFilterSpec) (Filter, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]Factory{}
+)
+
+// Register makes a filter type available to the pipeline configuration. Custom filters are
+// registered from an init function of a package linked into the binary.
+func Register(filterType string, factory Factory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	if _, exists := factories[filterType]; exists {
+		panic("contentfilter: filter type registered twice: " + filterType)
+	}
+	factories[filterType] = factory
+}
+
+// Types lists the registered filter types
+func Types() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	types := make([]string, 0, len(factories))
+	for filterType := range factories {
+		types = append(types, filterType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+type stage struct {
+	filter Filter
+	action string
+}
+
+// Pipeline runs the configured filters in order
+type Pipeline struct {
+	stages           []stage
+	executorRequests bool
+}
+
+// Result is the outcome of a pipeline run: the match that rejects the request, if any,
+// and the matches of the annotating filters that ran before it
+type Result struct {
+	Rejection   *Match
+	Annotations []Match
+}
+
+// NewPipeline builds the filters of the configuration. It returns nil when no filter is configured.
+func NewPipeline(cfg apiconfig.ContentFilterConfig) (*Pipeline, error) {
+	if len(cfg.Filters) == 0 {
+		return nil, nil
+	}
+	pipeline := &Pipeline{executorRequests: cfg.ExecutorRequests}
+	for i, spec := range cfg.Filters {
+		action := spec.Action
+		if action == "" {
+			action = ActionReject
+		}
+		if action != ActionReject && action != ActionAnnotate {
+			return nil, fmt.Errorf("content filter %d (%s): unknown action %q", i, spec.Type, spec.Action)
+		}
+		factoriesMu.RLock()
+		factory, ok := factories[spec.Type]
+		factoriesMu.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("content filter %d: unknown type %q, registered types are %v", i, spec.Type, Types())
+		}
+		filter, err := factory(spec)
+		if err != nil {
+			return nil, fmt.Errorf("content filter %d (%s): %w", i, spec.Type, err)
+		}
+		pipeline.stages = append(pipeline.stages, stage{filter: filter, action: action})
+	}
+	return pipeline, nil
+}
+
+// FiltersExecutorRequests reports whether requests routed by other transfer agents are filtered too
+func (p *Pipeline) FiltersExecutorRequests() bool {
+	return p != nil && p.executorRequests
+}
+
+// Run passes the request through the filters, stopping at the first rejection
+func (p *Pipeline) Run(ctx context.Context, request *Request) (Result, error) {
+	var result Result
+	if p == nil {
+		return result, nil
+	}
+	for _, stage := range p.stages {
+		match, err := stage.filter.Check(ctx, request)
+		if err != nil {
+			return result, fmt.Errorf("content filter %s: %w", stage.filter.Name(), err)
+		}
+		if match == nil {
+			continue
+		}
+		if match.Filter == "" {
+			match.Filter = stage.filter.Name()
+		}
+		if stage.action == ActionAnnotate {
+			result.Annotations = append(result.Annotations, *match)
+			continue
+		}
+		if match.Status == 0 {
+			match.Status = http.StatusBadRequest
+		}
+		result.Rejection = match
+		return result, nil
+	}
+	return result, nil
+}
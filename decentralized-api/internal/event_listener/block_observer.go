@@ -3,6 +3,7 @@ package event_listener
 import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/internal/event_listener/chainevents"
+	"decentralized-api/internal/eventstream"
 	"decentralized-api/logging"
 	"strconv"
 
@@ -24,6 +25,8 @@ type BlockObserver struct {
 	caughtUp                 atomic.Bool
 	tmClient                 TmHTTPClient
 	notify                   chan struct{}
+	// eventStream, when set, re-broadcasts the events of each processed block
+	eventStream *eventstream.Stream
 }
 
 // TmHTTPClient abstracts the subset of RPC methods we need
@@ -34,11 +37,8 @@ type TmHTTPClient interface {
 
 func NewBlockObserver(manager *apiconfig.ConfigManager) *BlockObserver {
 	queue := NewUnboundedQueue[*chainevents.JSONRPCResponse]()
-	// Initialize Tendermint RPC client
-	httpClient, err := cosmosclient.NewRpcClient(manager.GetChainNodeConfig().Url)
-	if err != nil {
-		logging.Error("Failed to create Tendermint RPC client for BlockObserver", types.EventProcessing, "error", err)
-	}
+	// Tendermint RPC client following the elected chain node endpoint
+	httpClient := cosmosclient.NewFailoverRpcClient(manager.GetChainEndpoints())
 
 	bo := &BlockObserver{
 		ConfigManager: manager,
@@ -193,7 +193,29 @@ func (bo *BlockObserver) processBlock(ctx context.Context, height int64) bool {
 		return false
 	}
 
-	// For each tx in the block, flatten events and enqueue as synthetic Tx events
+	if bo.eventStream != nil {
+		bo.eventStream.PublishBlock(height, res)
+	}
+	for _, msg := range txEventsFromBlockResults(height, res) {
+		bo.Queue.In <- msg
+	}
+	// Enqueue a barrier event to signal block completion when consumed
+	barrier := &chainevents.JSONRPCResponse{
+		JSONRPC: "2.0",
+		ID:      "block-" + strconv.FormatInt(height, 10) + "-barrier",
+		Result: chainevents.Result{
+			Query:  "block_monitor/Barrier",
+			Data:   chainevents.Data{Type: systemBarrierEventType, Value: map[string]interface{}{}},
+			Events: map[string][]string{"barrier.height": {strconv.FormatInt(height, 10)}},
+		},
+	}
+	bo.Queue.In <- barrier
+	return true
+}
+
+// txEventsFromBlockResults flattens the events of each tx in the block into synthetic Tx events
+func txEventsFromBlockResults(height int64, res *coretypes.ResultBlockResults) []*chainevents.JSONRPCResponse {
+	msgs := make([]*chainevents.JSONRPCResponse, 0, len(res.TxsResults))
 	for txIdx, txRes := range res.TxsResults {
 		events := make(map[string][]string)
 		// Include tx.height to satisfy waitForEventHeight
@@ -208,7 +230,7 @@ func (bo *BlockObserver) processBlock(ctx context.Context, height int64) bool {
 			}
 		}
 
-		msg := &chainevents.JSONRPCResponse{
+		msgs = append(msgs, &chainevents.JSONRPCResponse{
 			JSONRPC: "2.0",
 			ID:      "block-" + strconv.FormatInt(height, 10) + "-tx-" + strconv.Itoa(txIdx),
 			Result: chainevents.Result{
@@ -216,22 +238,9 @@ func (bo *BlockObserver) processBlock(ctx context.Context, height int64) bool {
 				Data:   chainevents.Data{Type: "tendermint/event/Tx", Value: map[string]interface{}{}},
 				Events: events,
 			},
-		}
-		// Enqueue for processing
-		bo.Queue.In <- msg
-	}
-	// Enqueue a barrier event to signal block completion when consumed
-	barrier := &chainevents.JSONRPCResponse{
-		JSONRPC: "2.0",
-		ID:      "block-" + strconv.FormatInt(height, 10) + "-barrier",
-		Result: chainevents.Result{
-			Query:  "block_monitor/Barrier",
-			Data:   chainevents.Data{Type: systemBarrierEventType, Value: map[string]interface{}{}},
-			Events: map[string][]string{"barrier.height": {strconv.FormatInt(height, 10)}},
-		},
+		})
 	}
-	bo.Queue.In <- barrier
-	return true
+	return msgs
 }
 
 // signalAllEventsRead is called once the barrier event for a block
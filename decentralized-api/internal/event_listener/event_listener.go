@@ -8,6 +8,10 @@ import (
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal/bls"
 	"decentralized-api/internal/event_listener/chainevents"
+	"decentralized-api/internal/eventstream"
+	"decentralized-api/internal/evmrelay"
+	"decentralized-api/internal/inflight"
+	"decentralized-api/internal/metrics"
 	"decentralized-api/internal/startup"
 	"decentralized-api/internal/validation"
 	"decentralized-api/logging"
@@ -32,6 +36,7 @@ const (
 	blsVerifyingPhaseStartedEvent     = "inference.bls.EventVerifyingPhaseStarted"
 	blsGroupPublicKeyGeneratedEvent   = "inference.bls.EventGroupPublicKeyGenerated"
 	blsThresholdSigningRequestedEvent = "inference.bls.EventThresholdSigningRequested"
+	blsGroupKeyValidatedEvent         = "inference.bls.EventGroupKeyValidated"
 
 	newBlockEventType      = "tendermint/event/NewBlock"
 	txEventType            = "tendermint/event/Tx"
@@ -51,11 +56,19 @@ type EventListener struct {
 	dispatcher            *OnNewBlockDispatcher
 	cancelFunc            context.CancelFunc
 	rewardRecoveryChecker *startup.RewardRecoveryChecker
+	upgradeOrchestrator   *upgrade.Orchestrator
+	inflightInferences    *inflight.Tracker
+	eventStream           *eventstream.Stream
+	evmRelayer            *evmrelay.Relayer
 
 	eventHandlers []EventHandler
 
 	ws            *websocket.Conn
 	blockObserver *BlockObserver
+	// Unix nanoseconds of the last message read from the websocket, 0 until the first one
+	lastWsMessageAt atomic.Int64
+
+	replay replayState
 }
 
 func NewEventListener(
@@ -82,13 +95,17 @@ func NewEventListener(
 
 	eventHandlers := []EventHandler{
 		&BlsTransactionEventHandler{},
+		&EvmRelayEventHandler{},
 		&InferenceFinishedEventHandler{},
 		&InferenceValidationEventHandler{},
 		&SubmitProposalEventHandler{},
 		&TrainingTaskAssignedEventHandler{},
 	}
 
+	eventStream := eventstream.NewStream(configManager.GetEventStreamConfig().BufferSize)
+	dispatcher.eventStream = eventStream
 	bo := NewBlockObserver(configManager)
+	bo.eventStream = eventStream
 
 	return &EventListener{
 		nodeBroker:            nodeBroker,
@@ -103,16 +120,54 @@ func NewEventListener(
 		eventHandlers:         eventHandlers,
 		blockObserver:         bo,
 		rewardRecoveryChecker: startup.NewRewardRecoveryChecker(phaseTracker, &transactionRecorder, validator, configManager),
+		upgradeOrchestrator:   upgrade.NewOrchestrator(configManager, nodeBroker),
+		inflightInferences:    inflight.NewTracker(),
+		eventStream:           eventStream,
 	}
 }
 
-func (el *EventListener) openWsConnAndSubscribe() {
-	websocketUrl := getWebsocketUrl(el.configManager.GetChainNodeConfig().Url)
-	logging.Info("Connecting to websocket at", types.EventProcessing, "url", websocketUrl)
+// InflightInferences returns the tracker that cancels the requests of inferences the chain expired
+func (el *EventListener) InflightInferences() *inflight.Tracker {
+	return el.inflightInferences
+}
 
-	ws, _, err := websocket.DefaultDialer.Dial(websocketUrl, nil)
+// EventStream returns the stream re-broadcasting the processed chain events to external subscribers
+func (el *EventListener) EventStream() *eventstream.Stream {
+	return el.eventStream
+}
+
+// SetEvmRelayer enables relaying the validated group keys and the signing requests to the Ethereum bridge.
+// It must be called before Start.
+func (el *EventListener) SetEvmRelayer(relayer *evmrelay.Relayer) {
+	el.evmRelayer = relayer
+}
+
+// UpgradeOrchestrator returns the orchestrator of the scheduled upgrades
+func (el *EventListener) UpgradeOrchestrator() *upgrade.Orchestrator {
+	return el.upgradeOrchestrator
+}
+
+// openWsConnAndSubscribe connects to the elected chain node endpoint. An endpoint that can't be
+// dialed is reported so the next one is elected; it only gives up once every endpoint failed.
+func (el *EventListener) openWsConnAndSubscribe() {
+	endpoints := el.configManager.GetChainEndpoints()
+	attempts := len(el.configManager.GetChainNodeConfig().Endpoints())
+
+	var ws *websocket.Conn
+	var err error
+	for attempt := 0; attempt < max(attempts, 1); attempt++ {
+		chainNodeUrl := endpoints.Current()
+		websocketUrl := getWebsocketUrl(chainNodeUrl)
+		logging.Info("Connecting to websocket at", types.EventProcessing, "url", websocketUrl)
+
+		ws, _, err = websocket.DefaultDialer.Dial(websocketUrl, nil)
+		if err == nil {
+			break
+		}
+		logging.Error("Failed to connect to websocket", types.EventProcessing, "url", websocketUrl, "error", err)
+		endpoints.ReportFailure(chainNodeUrl)
+	}
 	if err != nil {
-		logging.Error("Failed to connect to websocket", types.EventProcessing, "error", err)
 		log.Fatal("dial:", err)
 	}
 	el.ws = ws
@@ -145,8 +200,10 @@ func (el *EventListener) Start(ctx context.Context) {
 func worker(
 	ctx context.Context,
 	eventQueue *UnboundedQueue[*chainevents.JSONRPCResponse],
+	queueName string,
 	processEvent func(event *chainevents.JSONRPCResponse, workerName string),
 	workerName string) {
+	queueDepth := metrics.EventQueueDepth.WithLabelValues(queueName)
 	go func() {
 		for {
 			select {
@@ -157,6 +214,7 @@ func worker(
 					logging.Warn(workerName+": event channel is closed", types.System)
 					return
 				}
+				queueDepth.Set(float64(eventQueue.Size()))
 				if event == nil {
 					logging.Error(workerName+": received nil chain event", types.System)
 				} else {
@@ -170,14 +228,14 @@ func worker(
 func (el *EventListener) processEvents(ctx context.Context, mainQueue *UnboundedQueue[*chainevents.JSONRPCResponse]) {
 	const numWorkers = 10
 	for i := 0; i < numWorkers; i++ {
-		worker(ctx, mainQueue, el.processEvent, "process_events_"+strconv.Itoa(i))
+		worker(ctx, mainQueue, "tx_events", el.processEvent, "process_events_"+strconv.Itoa(i))
 	}
 }
 
 func (el *EventListener) processBlockEvents(ctx context.Context, blockQueue *UnboundedQueue[*chainevents.JSONRPCResponse]) {
 	const numWorkers = 2
 	for i := 0; i < numWorkers; i++ {
-		worker(ctx, blockQueue, el.processEvent, "process_block_events")
+		worker(ctx, blockQueue, "block_events", el.processEvent, "process_block_events")
 	}
 }
 
@@ -205,6 +263,8 @@ func (el *EventListener) listen(ctx context.Context, blockQueue, mainQueue *Unbo
 
 				logging.Warn("Close websocket connection", types.EventProcessing)
 				el.ws.Close()
+				endpoints := el.configManager.GetChainEndpoints()
+				endpoints.ReportFailure(endpoints.Current())
 
 				logging.Warn("Reopen websocket", types.EventProcessing)
 				time.Sleep(10 * time.Second)
@@ -212,6 +272,7 @@ func (el *EventListener) listen(ctx context.Context, blockQueue, mainQueue *Unbo
 				el.openWsConnAndSubscribe()
 				continue
 			}
+			el.lastWsMessageAt.Store(time.Now().UnixNano())
 
 			// logging.Debug("Raw websocket message received", types.EventProcessing, "raw_message_bytes", string(message))
 
@@ -243,16 +304,18 @@ func (el *EventListener) listen(ctx context.Context, blockQueue, mainQueue *Unbo
 }
 
 func (el *EventListener) startSyncStatusChecker() {
-	chainNodeUrl := el.configManager.GetChainNodeConfig().Url
 	hasTriedVersionSync := false
 
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 
 	for range ticker.C {
+		endpoints := el.configManager.GetChainEndpoints()
+		chainNodeUrl := endpoints.Current()
 		status, err := getStatus(chainNodeUrl)
 		if err != nil {
-			logging.Error("Error getting node status", types.EventProcessing, "error", err)
+			logging.Error("Error getting node status", types.EventProcessing, "url", chainNodeUrl, "error", err)
+			endpoints.ReportFailure(chainNodeUrl)
 			continue
 		}
 		// The node is "synced" if it's NOT catching up.
@@ -277,6 +340,16 @@ func (el *EventListener) startSyncStatusChecker() {
 	}
 }
 
+// LastWebsocketMessage returns when the chain websocket subscription last delivered a message,
+// or the zero time if it has not delivered any yet
+func (el *EventListener) LastWebsocketMessage() time.Time {
+	nanos := el.lastWsMessageAt.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
 func (el *EventListener) isNodeSynced() bool {
 	return el.nodeCaughtUp.Load()
 }
@@ -295,6 +368,10 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 			// Check for BLS events in NewBlock events (emitted from EndBlocker)
 			el.handleBLSEvents(event, workerName)
 		}
+		el.handleInferenceExpiredEvents(event)
+		if el.isNodeSynced() {
+			el.handleParticipantExitEvents(event)
+		}
 
 		// Parse the event into NewBlockInfo
 		blockInfo, err := parseNewBlockInfo(event)
@@ -314,7 +391,7 @@ func (el *EventListener) processEvent(event *chainevents.JSONRPCResponse, worker
 		}
 
 		// Still handle upgrade processing separately
-		upgrade.ProcessNewBlockEvent(event, el.transactionRecorder, el.configManager)
+		upgrade.ProcessNewBlockEvent(event, el.transactionRecorder, el.configManager, el.upgradeOrchestrator)
 		if el.isNodeSynced() {
 			el.rewardRecoveryChecker.RecoverIfNeeded(blockInfo.Height)
 		}
@@ -347,6 +424,43 @@ func (el *EventListener) hasHandler(event *chainevents.JSONRPCResponse) bool {
 	return false
 }
 
+// handleInferenceExpiredEvents cancels the requests still running for inferences expired in the EndBlocker
+func (el *EventListener) handleInferenceExpiredEvents(event *chainevents.JSONRPCResponse) {
+	for _, inferenceId := range event.Result.Events[types.EventTypeInferenceExpired+"."+types.AttributeKeyInferenceId] {
+		if cancelled := el.inflightInferences.Expire(inferenceId); cancelled > 0 {
+			logging.Info("Cancelled requests of expired inference", types.Inferences, "inferenceId", inferenceId, "requests", cancelled)
+		}
+	}
+}
+
+// handleParticipantExitEvents drains the ML nodes once the chain left this participant out of the epoch
+// groups on its exit, so they take no new inference work
+func (el *EventListener) handleParticipantExitEvents(event *chainevents.JSONRPCResponse) {
+	address := el.transactionRecorder.GetAccountAddress()
+	for _, participant := range event.Result.Events[types.EventTypeParticipantExited+"."+types.AttributeKeyParticipant] {
+		if participant != address {
+			continue
+		}
+		nodes, err := el.nodeBroker.GetNodes()
+		if err != nil {
+			logging.Error("Participant exited, failed to list the nodes to drain", types.Nodes, "error", err)
+			return
+		}
+		logging.Info("Participant exited the network, draining all nodes", types.Nodes, "nodes", len(nodes))
+		for _, node := range nodes {
+			command := broker.NewDrainNodeCommand(node.Node.Id)
+			if err := el.nodeBroker.QueueMessage(command); err != nil {
+				logging.Error("Failed to drain node of exited participant", types.Nodes, "node_id", node.Node.Id, "error", err)
+				continue
+			}
+			if result := <-command.Response; result.Err != nil {
+				logging.Error("Failed to drain node of exited participant", types.Nodes, "node_id", node.Node.Id, "error", result.Err)
+			}
+		}
+		return
+	}
+}
+
 func (el *EventListener) handleBLSEvents(event *chainevents.JSONRPCResponse, workerName string) {
 	// Check for BLS events in NewBlock events (emitted from EndBlocker)
 	// Note: Threshold signing events are handled separately in handleBLSTransactionEvents
@@ -415,6 +529,25 @@ func (e *BlsTransactionEventHandler) Handle(event *chainevents.JSONRPCResponse,
 	return nil
 }
 
+// EvmRelayEventHandler queues the validated group keys and the signing requests for the EVM relay, when enabled
+type EvmRelayEventHandler struct{}
+
+func (e *EvmRelayEventHandler) GetName() string {
+	return "evm_relay"
+}
+
+func (e *EvmRelayEventHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
+	return len(event.Result.Events[blsGroupKeyValidatedEvent+".new_epoch_id"]) > 0 ||
+		len(event.Result.Events[blsThresholdSigningRequestedEvent+".request_id"]) > 0
+}
+
+func (e *EvmRelayEventHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
+	if el.evmRelayer != nil && el.isNodeSynced() {
+		el.evmRelayer.ProcessEvent(event)
+	}
+	return nil
+}
+
 type InferenceFinishedEventHandler struct {
 }
 
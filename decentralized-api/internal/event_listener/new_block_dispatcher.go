@@ -16,6 +16,8 @@ import (
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal"
 	"decentralized-api/internal/event_listener/chainevents"
+	"decentralized-api/internal/eventstream"
+	"decentralized-api/internal/metrics"
 	"decentralized-api/internal/seed"
 	"decentralized-api/internal/validation"
 	"decentralized-api/logging"
@@ -69,6 +71,8 @@ type OnNewBlockDispatcher struct {
 	configManager        *apiconfig.ConfigManager
 	validator            *validation.InferenceValidator
 	epochGroupDataCache  *internal.EpochGroupDataCache
+	// eventStream, when set, is told about the epoch phase changes
+	eventStream *eventstream.Stream
 }
 
 // StatusResponse matches the structure expected by getStatus function
@@ -137,7 +141,7 @@ func NewOnNewBlockDispatcherFromCosmosClient(
 		return configManager.SetHeight(blockHeight)
 	}
 	getStatusFunc := func() (*coretypes.ResultStatus, error) {
-		url := configManager.GetChainNodeConfig().Url
+		url := configManager.GetChainEndpoints().Current()
 		return getStatus(url)
 	}
 
@@ -253,6 +257,7 @@ func (d *OnNewBlockDispatcher) ProcessNewBlock(ctx context.Context, blockInfo ch
 	// 	comes from a totally different source?
 	// TODO: log block that came from event vs block returned by query
 	// TODO: can we add the state to the block event? As a future optimization?
+	previousState := d.phaseTracker.GetCurrentEpochState()
 	d.phaseTracker.Update(blockInfo, &networkInfo.LatestEpoch, &networkInfo.EpochParams, networkInfo.IsSynced, networkInfo.ActiveConfirmationPoCEvent)
 	epochState := d.phaseTracker.GetCurrentEpochState()
 	if epochState == nil {
@@ -261,6 +266,17 @@ func (d *OnNewBlockDispatcher) ProcessNewBlock(ctx context.Context, blockInfo ch
 			"blockHeight", blockInfo.Height, "isSynced", networkInfo.IsSynced)
 		return nil
 	}
+	metrics.BlocksProcessed.Inc()
+	if previousState != nil && previousState.CurrentPhase != epochState.CurrentPhase {
+		metrics.PhaseTransitions.WithLabelValues(string(epochState.CurrentPhase)).Inc()
+		if d.eventStream != nil {
+			d.eventStream.Defer(eventstream.TypeEpochPhaseChanged, blockInfo.Height, map[string]string{
+				"epoch_index":    strconv.FormatUint(epochState.LatestEpoch.EpochIndex, 10),
+				"phase":          string(epochState.CurrentPhase),
+				"previous_phase": string(previousState.CurrentPhase),
+			})
+		}
+	}
 
 	logging.Info("[new-block-dispatcher] Current epoch state.", types.Stages,
 		"blockHeight", epochState.CurrentBlock.Height,
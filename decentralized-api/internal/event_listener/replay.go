@@ -0,0 +1,201 @@
+package event_listener
+
+import (
+	"context"
+	"decentralized-api/internal/event_listener/chainevents"
+	"decentralized-api/logging"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// maxReplayBlocks bounds the block range of a single replay
+const maxReplayBlocks = 10_000
+
+const (
+	ReplayStatusHandled   = "handled"
+	ReplayStatusDryRun    = "dry_run"
+	ReplayStatusDuplicate = "skipped_duplicate"
+	ReplayStatusFailed    = "failed"
+)
+
+var (
+	ErrReplayInProgress     = errors.New("a replay is already in progress")
+	ErrInvalidReplayRequest = errors.New("invalid replay request")
+)
+
+// ReplayRequest selects the blocks and handlers of a replay, both heights inclusive.
+// No Handlers means every handler. Force re-runs handlers already replayed for an event.
+type ReplayRequest struct {
+	FromHeight int64    `json:"from_height"`
+	ToHeight   int64    `json:"to_height"`
+	Handlers   []string `json:"handlers,omitempty"`
+	DryRun     bool     `json:"dry_run"`
+	Force      bool     `json:"force"`
+}
+
+// ReplayedEvent is the outcome of one handler for one Tx event
+type ReplayedEvent struct {
+	Height  int64  `json:"height"`
+	TxIndex int    `json:"tx_index"`
+	Handler string `json:"handler"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+type ReplayResult struct {
+	FromHeight int64           `json:"from_height"`
+	ToHeight   int64           `json:"to_height"`
+	DryRun     bool            `json:"dry_run"`
+	Events     []ReplayedEvent `json:"events"`
+	Handled    int             `json:"handled"`
+	Skipped    int             `json:"skipped"`
+	Failed     int             `json:"failed"`
+}
+
+type replayKey struct {
+	height  int64
+	txIndex int
+	handler string
+}
+
+// replayState serializes replays and remembers which events they already handled
+type replayState struct {
+	running sync.Mutex
+	mu      sync.Mutex
+	handled map[replayKey]struct{}
+}
+
+func (s *replayState) seen(key replayKey) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.handled[key]
+	return ok
+}
+
+func (s *replayState) markHandled(key replayKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.handled == nil {
+		s.handled = make(map[replayKey]struct{})
+	}
+	s.handled[key] = struct{}{}
+}
+
+// Replay re-fetches the Tx events of already processed blocks and re-runs the selected handlers on them,
+// in block and tx order. Handlers that already replayed an event are skipped unless forced, so a replay
+// can be retried after a partial failure. Only one replay runs at a time.
+func (el *EventListener) Replay(ctx context.Context, req ReplayRequest) (*ReplayResult, error) {
+	if el.blockObserver == nil || el.blockObserver.tmClient == nil {
+		return nil, errors.New("no chain RPC client to fetch blocks from")
+	}
+	lastProcessed := el.blockObserver.lastProcessedBlockHeight.Load()
+	if err := validateReplayRequest(req, lastProcessed); err != nil {
+		return nil, err
+	}
+	handlers, err := el.replayHandlers(req.Handlers)
+	if err != nil {
+		return nil, err
+	}
+
+	if !el.replay.running.TryLock() {
+		return nil, ErrReplayInProgress
+	}
+	defer el.replay.running.Unlock()
+
+	logging.Info("Replaying events", types.EventProcessing,
+		"fromHeight", req.FromHeight, "toHeight", req.ToHeight, "handlers", req.Handlers, "dryRun", req.DryRun, "force", req.Force)
+	result := &ReplayResult{FromHeight: req.FromHeight, ToHeight: req.ToHeight, DryRun: req.DryRun, Events: []ReplayedEvent{}}
+	for height := req.FromHeight; height <= req.ToHeight; height++ {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+		h := height
+		res, err := el.blockObserver.tmClient.BlockResults(ctx, &h)
+		if err != nil || res == nil {
+			return result, fmt.Errorf("failed to fetch block results at height %d: %v", height, err)
+		}
+		for txIndex, event := range txEventsFromBlockResults(height, res) {
+			for _, handler := range handlers {
+				if !handler.CanHandle(event) {
+					continue
+				}
+				result.add(el.replayEvent(event, handler, replayKey{height: height, txIndex: txIndex, handler: handler.GetName()}, req))
+			}
+		}
+	}
+	logging.Info("Replay finished", types.EventProcessing,
+		"fromHeight", req.FromHeight, "toHeight", req.ToHeight, "handled", result.Handled, "skipped", result.Skipped, "failed", result.Failed)
+	return result, nil
+}
+
+func (el *EventListener) replayEvent(event *chainevents.JSONRPCResponse, handler EventHandler, key replayKey, req ReplayRequest) ReplayedEvent {
+	replayed := ReplayedEvent{Height: key.height, TxIndex: key.txIndex, Handler: key.handler}
+	switch {
+	case !req.Force && el.replay.seen(key):
+		replayed.Status = ReplayStatusDuplicate
+	case req.DryRun:
+		replayed.Status = ReplayStatusDryRun
+	default:
+		if err := handler.Handle(event, el); err != nil {
+			logging.Warn("Replayed event handler failed", types.EventProcessing,
+				"height", key.height, "txIndex", key.txIndex, "handler", key.handler, "error", err)
+			replayed.Status = ReplayStatusFailed
+			replayed.Error = err.Error()
+		} else {
+			el.replay.markHandled(key)
+			replayed.Status = ReplayStatusHandled
+		}
+	}
+	return replayed
+}
+
+func (r *ReplayResult) add(event ReplayedEvent) {
+	r.Events = append(r.Events, event)
+	switch event.Status {
+	case ReplayStatusHandled, ReplayStatusDryRun:
+		r.Handled++
+	case ReplayStatusDuplicate:
+		r.Skipped++
+	case ReplayStatusFailed:
+		r.Failed++
+	}
+}
+
+// replayHandlers returns the named handlers in their registration order
+func (el *EventListener) replayHandlers(names []string) ([]EventHandler, error) {
+	if len(names) == 0 {
+		return el.eventHandlers, nil
+	}
+	selected := make(map[string]bool, len(names))
+	for _, name := range names {
+		selected[name] = true
+	}
+	var handlers []EventHandler
+	for _, handler := range el.eventHandlers {
+		if selected[handler.GetName()] {
+			handlers = append(handlers, handler)
+			delete(selected, handler.GetName())
+		}
+	}
+	for name := range selected {
+		return nil, fmt.Errorf("%w: unknown event handler %q", ErrInvalidReplayRequest, name)
+	}
+	return handlers, nil
+}
+
+// validateReplayRequest only allows blocks the live observer already processed, so a replay can't race it
+func validateReplayRequest(req ReplayRequest, lastProcessed int64) error {
+	if req.FromHeight <= 0 || req.ToHeight < req.FromHeight {
+		return fmt.Errorf("%w: invalid block range %d-%d", ErrInvalidReplayRequest, req.FromHeight, req.ToHeight)
+	}
+	if req.ToHeight-req.FromHeight >= maxReplayBlocks {
+		return fmt.Errorf("%w: block range %d-%d exceeds %d blocks", ErrInvalidReplayRequest, req.FromHeight, req.ToHeight, maxReplayBlocks)
+	}
+	if req.ToHeight > lastProcessed {
+		return fmt.Errorf("%w: block %d has not been processed yet, last processed block is %d", ErrInvalidReplayRequest, req.ToHeight, lastProcessed)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package event_listener
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"decentralized-api/apiconfig"
+	"decentralized-api/internal/event_listener/chainevents"
+
+	"github.com/stretchr/testify/require"
+)
+
+type recordingHandler struct {
+	name    string
+	handled []string
+	fail    bool
+}
+
+func (h *recordingHandler) GetName() string { return h.name }
+
+func (h *recordingHandler) CanHandle(event *chainevents.JSONRPCResponse) bool {
+	return len(event.Result.Events["inference_finished.inference_id"]) > 0
+}
+
+func (h *recordingHandler) Handle(event *chainevents.JSONRPCResponse, el *EventListener) error {
+	if h.fail {
+		return errors.New("handler failed")
+	}
+	h.handled = append(h.handled, event.ID)
+	return nil
+}
+
+func newReplayListener(handlers ...EventHandler) *EventListener {
+	bo := NewBlockObserverWithClient(&apiconfig.ConfigManager{}, newMockTmHTTPClient(2))
+	bo.lastProcessedBlockHeight.Store(20)
+	return &EventListener{blockObserver: bo, eventHandlers: handlers}
+}
+
+func TestReplay(t *testing.T) {
+	first := &recordingHandler{name: "first"}
+	second := &recordingHandler{name: "second"}
+	el := newReplayListener(first, second)
+	ctx := context.Background()
+
+	result, err := el.Replay(ctx, ReplayRequest{FromHeight: 5, ToHeight: 6, Handlers: []string{"second"}, DryRun: true})
+	require.NoError(t, err)
+	require.Equal(t, 4, result.Handled)
+	require.Empty(t, second.handled)
+
+	result, err = el.Replay(ctx, ReplayRequest{FromHeight: 5, ToHeight: 6, Handlers: []string{"second"}})
+	require.NoError(t, err)
+	require.Equal(t, 4, result.Handled)
+	require.Equal(t, []string{"block-5-tx-0", "block-5-tx-1", "block-6-tx-0", "block-6-tx-1"}, second.handled)
+	require.Empty(t, first.handled)
+
+	// Events already replayed are skipped unless forced
+	result, err = el.Replay(ctx, ReplayRequest{FromHeight: 6, ToHeight: 7, Handlers: []string{"second"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Skipped)
+	require.Equal(t, 2, result.Handled)
+	require.Equal(t, ReplayStatusDuplicate, result.Events[0].Status)
+
+	result, err = el.Replay(ctx, ReplayRequest{FromHeight: 6, ToHeight: 6, Handlers: []string{"second"}, Force: true})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Handled)
+	require.Len(t, second.handled, 8)
+}
+
+func TestReplayFailuresAreRetried(t *testing.T) {
+	handler := &recordingHandler{name: "flaky", fail: true}
+	el := newReplayListener(handler)
+
+	result, err := el.Replay(context.Background(), ReplayRequest{FromHeight: 1, ToHeight: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Failed)
+	require.Equal(t, "handler failed", result.Events[0].Error)
+
+	handler.fail = false
+	result, err = el.Replay(context.Background(), ReplayRequest{FromHeight: 1, ToHeight: 1})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Handled)
+}
+
+func TestReplayRejectsInvalidRequests(t *testing.T) {
+	el := newReplayListener(&recordingHandler{name: "first"})
+	ctx := context.Background()
+
+	_, err := el.Replay(ctx, ReplayRequest{FromHeight: 10, ToHeight: 5})
+	require.Error(t, err)
+	_, err = el.Replay(ctx, ReplayRequest{FromHeight: 10, ToHeight: 21})
+	require.ErrorContains(t, err, "not been processed")
+	_, err = el.Replay(ctx, ReplayRequest{FromHeight: 1, ToHeight: 2, Handlers: []string{"unknown"}})
+	require.ErrorContains(t, err, "unknown event handler")
+
+	el.replay.running.Lock()
+	_, err = el.Replay(ctx, ReplayRequest{FromHeight: 1, ToHeight: 2})
+	require.ErrorIs(t, err, ErrReplayInProgress)
+	el.replay.running.Unlock()
+}
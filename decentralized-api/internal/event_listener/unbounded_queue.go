@@ -2,6 +2,7 @@ package event_listener
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 // UnboundedQueue[T] represents an unbounded thread-safe FIFO queue
@@ -16,7 +17,8 @@ type UnboundedQueue[T any] struct {
 	output    chan T
 	done      chan struct{}
 	wg        sync.WaitGroup
-	closeOnce sync.Once // Ensures Close is only executed once
+	closeOnce sync.Once    // Ensures Close is only executed once
+	stored    atomic.Int64 // Items held by the manager, not yet moved to the output channel
 }
 
 // NewUnboundedQueue creates a new unbounded queue that exposes channels
@@ -62,10 +64,12 @@ func (q *UnboundedQueue[T]) manage() {
 		case item := <-q.input:
 			// Store new item from producer
 			items = append(items, item)
+			q.stored.Store(int64(len(items)))
 
 		case out <- first:
 			// First item was consumed, remove it
 			items = items[1:]
+			q.stored.Store(int64(len(items)))
 
 		case <-q.done:
 			// Shutdown signal received, exit manager
@@ -78,8 +82,7 @@ func (q *UnboundedQueue[T]) manage() {
 // Note: This is approximate since the queue state might change
 // immediately after the count is returned
 func (q *UnboundedQueue[T]) Size() int {
-	// This is just an approximation based on channel buffer lengths
-	return len(q.input) + len(q.output)
+	return len(q.input) + int(q.stored.Load()) + len(q.output)
 }
 
 // Close shuts down the queue and waits for the manager to exit
@@ -0,0 +1,224 @@
+// Package eventstream re-broadcasts the chain events the event listener processes to external
+// subscribers, such as indexers that would otherwise run their own chain node.
+package eventstream
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Types of the events in the stream. Chain events keep their chain type; epoch phase changes are
+// derived by the API from the epoch state, the chain emits no event for them.
+const (
+	TypeInferenceFinished   = "inference_finished"
+	TypeInferenceValidation = "inference_validation"
+	TypeInferenceExpired    = types.EventTypeInferenceExpired
+	TypeEpochPhaseChanged   = "epoch_phase_changed"
+)
+
+// Types lists the event types the stream carries
+var Types = []string{TypeInferenceFinished, TypeInferenceValidation, TypeInferenceExpired, TypeEpochPhaseChanged}
+
+// chainTypes are the streamed types read from the block results
+var chainTypes = map[string]bool{TypeInferenceFinished: true, TypeInferenceValidation: true, TypeInferenceExpired: true}
+
+// subscriberBuffer is how many events a subscriber may lag behind before it is dropped
+const subscriberBuffer = 1024
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor is the position of an event in the stream: the block height and the index of the event among
+// the streamed events of the block. The events of transactions come first in transaction order, then
+// the block events, so cursors of chain events are the same on every API.
+type Cursor struct {
+	Height int64
+	Index  int
+}
+
+func (c Cursor) String() string {
+	return strconv.FormatInt(c.Height, 10) + "-" + strconv.Itoa(c.Index)
+}
+
+func (c Cursor) Before(other Cursor) bool {
+	return c.Height < other.Height || (c.Height == other.Height && c.Index < other.Index)
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	height, index, ok := strings.Cut(s, "-")
+	if !ok {
+		return Cursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	h, err := strconv.ParseInt(height, 10, 64)
+	if err != nil || h < 0 {
+		return Cursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	i, err := strconv.Atoi(index)
+	if err != nil || i < 0 {
+		return Cursor{}, fmt.Errorf("%w: %q", ErrInvalidCursor, s)
+	}
+	return Cursor{Height: h, Index: i}, nil
+}
+
+// Event is a decoded chain event
+type Event struct {
+	Cursor string `json:"cursor"`
+	Height int64  `json:"height"`
+	Type   string `json:"type"`
+	// TxIndex is the index in the block of the transaction that emitted the event, nil for block events
+	TxIndex    *int              `json:"tx_index,omitempty"`
+	Attributes map[string]string `json:"attributes"`
+
+	cursor Cursor
+}
+
+// Stream keeps the recent events for clients resuming from a cursor and fans new events out to the subscribers
+type Stream struct {
+	mu sync.Mutex
+	// events is a ring buffer of the recent events, the oldest at start
+	events      []Event
+	start       int
+	count       int
+	evicted     *Cursor
+	lastHeight  int64
+	deferred    []Event
+	subscribers map[*Subscription]struct{}
+}
+
+func NewStream(size int) *Stream {
+	return &Stream{
+		events:      make([]Event, size),
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// Defer queues an event derived by the API, such as an epoch phase change, to be published after the
+// chain events of the next block
+func (s *Stream) Defer(eventType string, height int64, attributes map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deferred = append(s.deferred, Event{Height: height, Type: eventType, Attributes: attributes})
+}
+
+// PublishBlock publishes the streamed events of a block, then the deferred ones. Blocks are expected in
+// height order, a block at or below the last published height is ignored.
+func (s *Stream) PublishBlock(height int64, res *coretypes.ResultBlockResults) {
+	var events []Event
+	for txIndex, txResult := range res.TxsResults {
+		events = appendEvents(events, height, &txIndex, txResult.Events)
+	}
+	events = appendEvents(events, height, nil, res.FinalizeBlockEvents)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if height <= s.lastHeight {
+		return
+	}
+	s.lastHeight = height
+	events = append(events, s.deferred...)
+	s.deferred = nil
+
+	for i := range events {
+		events[i].cursor = Cursor{Height: height, Index: i}
+		events[i].Cursor = events[i].cursor.String()
+		s.publish(events[i])
+	}
+}
+
+func appendEvents(events []Event, height int64, txIndex *int, chainEvents []abci.Event) []Event {
+	for _, chainEvent := range chainEvents {
+		if !chainTypes[chainEvent.Type] {
+			continue
+		}
+		event := Event{Height: height, Type: chainEvent.Type, Attributes: make(map[string]string, len(chainEvent.Attributes))}
+		if txIndex != nil {
+			index := *txIndex
+			event.TxIndex = &index
+		}
+		for _, attribute := range chainEvent.Attributes {
+			event.Attributes[attribute.Key] = attribute.Value
+		}
+		events = append(events, event)
+	}
+	return events
+}
+
+func (s *Stream) publish(event Event) {
+	if s.count < len(s.events) {
+		s.events[(s.start+s.count)%len(s.events)] = event
+		s.count++
+	} else {
+		evicted := s.events[s.start].cursor
+		s.evicted = &evicted
+		s.events[s.start] = event
+		s.start = (s.start + 1) % len(s.events)
+	}
+	for sub := range s.subscribers {
+		if !sub.wants(event.Type) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// Too slow: the client resumes from the last event it received
+			delete(s.subscribers, sub)
+			sub.Dropped = true
+			close(sub.events)
+		}
+	}
+}
+
+// Subscription receives the events of the subscribed types. Events is closed when the subscription is
+// dropped for lagging behind, or cancelled.
+type Subscription struct {
+	events chan Event
+	types  map[string]bool
+	// Dropped is set when the subscriber lagged too far behind; read it once Events is closed
+	Dropped bool
+}
+
+func (sub *Subscription) Events() <-chan Event {
+	return sub.events
+}
+
+func (sub *Subscription) wants(eventType string) bool {
+	return len(sub.types) == 0 || sub.types[eventType]
+}
+
+// Subscribe returns the retained events after the cursor, if any, and subscribes to the next ones.
+// Gap is set when events after the cursor were already evicted from the buffer.
+func (s *Stream) Subscribe(after *Cursor, eventTypes []string) (sub *Subscription, backlog []Event, gap bool) {
+	sub = &Subscription{events: make(chan Event, subscriberBuffer), types: make(map[string]bool, len(eventTypes))}
+	for _, t := range eventTypes {
+		sub.types[t] = true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if after != nil {
+		gap = s.evicted != nil && after.Before(*s.evicted)
+		for i := 0; i < s.count; i++ {
+			event := s.events[(s.start+i)%len(s.events)]
+			if after.Before(event.cursor) && sub.wants(event.Type) {
+				backlog = append(backlog, event)
+			}
+		}
+	}
+	s.subscribers[sub] = struct{}{}
+	return sub, backlog, gap
+}
+
+func (s *Stream) Unsubscribe(sub *Subscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subscribers[sub]; ok {
+		delete(s.subscribers, sub)
+		close(sub.events)
+	}
+}
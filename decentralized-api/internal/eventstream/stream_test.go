@@ -0,0 +1,85 @@
+package eventstream
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func blockResults(inferenceIds ...string) *coretypes.ResultBlockResults {
+	res := &coretypes.ResultBlockResults{}
+	for _, id := range inferenceIds {
+		res.TxsResults = append(res.TxsResults, &abci.ExecTxResult{Events: []abci.Event{
+			{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "finish_inference"}}},
+			{Type: TypeInferenceFinished, Attributes: []abci.EventAttribute{{Key: "inference_id", Value: id}}},
+		}})
+	}
+	res.FinalizeBlockEvents = []abci.Event{
+		{Type: TypeInferenceExpired, Attributes: []abci.EventAttribute{{Key: "inference_id", Value: "expired"}}},
+	}
+	return res
+}
+
+func TestStream_PublishAndResume(t *testing.T) {
+	s := NewStream(4)
+	s.PublishBlock(10, blockResults("a", "b"))
+	s.Defer(TypeEpochPhaseChanged, 10, map[string]string{"phase": "Inference"})
+	s.PublishBlock(11, blockResults("c"))
+	// Blocks seen again after a restart of the observer are not published twice
+	s.PublishBlock(11, blockResults("c"))
+
+	after := Cursor{Height: 10, Index: 1}
+	sub, backlog, gap := s.Subscribe(&after, nil)
+	defer s.Unsubscribe(sub)
+	require.False(t, gap)
+	require.Len(t, backlog, 4)
+	require.Equal(t, "10-2", backlog[0].Cursor)
+	require.Nil(t, backlog[0].TxIndex)
+	require.Equal(t, TypeInferenceExpired, backlog[0].Type)
+	require.Equal(t, "11-0", backlog[1].Cursor)
+	require.Equal(t, 0, *backlog[1].TxIndex)
+	require.Equal(t, "c", backlog[1].Attributes["inference_id"])
+	require.Equal(t, "11-1", backlog[2].Cursor)
+	require.Equal(t, TypeEpochPhaseChanged, backlog[3].Type)
+	require.Equal(t, int64(10), backlog[3].Height)
+	require.Equal(t, "11-2", backlog[3].Cursor)
+
+	s.PublishBlock(12, blockResults())
+	event := <-sub.Events()
+	require.Equal(t, "12-0", event.Cursor)
+
+	// The first events of block 10 were evicted, resuming from before them reports a gap
+	first := Cursor{Height: 10, Index: 0}
+	resumed, backlog, gap := s.Subscribe(&first, []string{TypeInferenceFinished})
+	defer s.Unsubscribe(resumed)
+	require.True(t, gap)
+	require.Len(t, backlog, 1)
+	require.Equal(t, "11-0", backlog[0].Cursor)
+}
+
+func TestStream_DropsSlowSubscriber(t *testing.T) {
+	s := NewStream(10)
+	sub, _, _ := s.Subscribe(nil, nil)
+	for height := int64(1); height <= subscriberBuffer+1; height++ {
+		s.PublishBlock(height, &coretypes.ResultBlockResults{FinalizeBlockEvents: blockResults().FinalizeBlockEvents})
+	}
+	received := 0
+	for range sub.Events() {
+		received++
+	}
+	require.Equal(t, subscriberBuffer, received)
+	require.True(t, sub.Dropped)
+	s.Unsubscribe(sub)
+}
+
+func TestParseCursor(t *testing.T) {
+	cursor, err := ParseCursor("123-4")
+	require.NoError(t, err)
+	require.Equal(t, Cursor{Height: 123, Index: 4}, cursor)
+	for _, invalid := range []string{"", "123", "a-1", "1-b", "-1-2"} {
+		_, err := ParseCursor(invalid)
+		require.ErrorIs(t, err, ErrInvalidCursor, invalid)
+	}
+}
@@ -0,0 +1,69 @@
+package evmrelay
+
+import (
+	"math/big"
+
+	"golang.org/x/crypto/sha3"
+)
+
+// Methods of the bridge contract the relay calls. The BLS points are in the uncompressed encodings the
+// chain signs: G2 as 256 bytes and G1 as 128 bytes, each coordinate a 64-byte big-endian limb.
+const (
+	// submitGroupKey rotates the group key to the one of epochId, validationSignature being the signature
+	// of the previous epoch's group over the new key
+	submitGroupKeyMethod = "submitGroupKey(uint64,bytes,bytes)"
+	// submitSignedMessage executes a threshold signed message: encodedData is the abi.encodePacked data
+	// the group signed, starting with the epoch whose key verifies the signature
+	submitSignedMessageMethod = "submitSignedMessage(bytes,bytes)"
+)
+
+func keccak256(data ...[]byte) []byte {
+	hash := sha3.NewLegacyKeccak256()
+	for _, d := range data {
+		hash.Write(d)
+	}
+	return hash.Sum(nil)
+}
+
+// selector is the 4-byte function selector of a method signature
+func selector(method string) []byte {
+	return keccak256([]byte(method))[:4]
+}
+
+// GroupKeyCalldata builds the call rotating the bridge contract to the group key of an epoch
+func GroupKeyCalldata(epochId uint64, groupKey, validationSignature []byte) []byte {
+	return encodeCall(submitGroupKeyMethod, epochId, groupKey, validationSignature)
+}
+
+// SignedMessageCalldata builds the call executing a threshold signed message
+func SignedMessageCalldata(encodedData, signature []byte) []byte {
+	return encodeCall(submitSignedMessageMethod, encodedData, signature)
+}
+
+// encodeCall ABI encodes a call with uint64 and bytes arguments: static words (the value, or the offset
+// of the dynamic part) then the dynamic parts, each its length then the data padded to 32 bytes
+func encodeCall(method string, args ...any) []byte {
+	head := make([]byte, 0, 32*len(args))
+	var tail []byte
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case uint64:
+			head = append(head, word(new(big.Int).SetUint64(v))...)
+		case []byte:
+			head = append(head, word(big.NewInt(int64(32*len(args)+len(tail))))...)
+			tail = append(tail, word(big.NewInt(int64(len(v))))...)
+			tail = append(tail, v...)
+			if pad := len(v) % 32; pad != 0 {
+				tail = append(tail, make([]byte, 32-pad)...)
+			}
+		default:
+			panic("evmrelay: unsupported ABI argument")
+		}
+	}
+	call := append(selector(method), head...)
+	return append(call, tail...)
+}
+
+func word(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, 32))
+}
@@ -0,0 +1,410 @@
+// Package evmrelay relays the BLS group keys and threshold signatures of the chain to the Ethereum bridge
+// contract: it watches the validated group keys and the signing requests, builds the contract calls from
+// the uncompressed encodings of the points, and submits them from a relay account with gas management.
+package evmrelay
+
+import (
+	"context"
+	"decentralized-api/internal/bls"
+	"decentralized-api/internal/event_listener/chainevents"
+	"decentralized-api/internal/utils"
+	"decentralized-api/logging"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	blstypes "github.com/productscience/inference/x/bls/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	groupKeyValidatedEpochKey   = "inference.bls.EventGroupKeyValidated.new_epoch_id"
+	signingRequestedRequestKey  = "inference.bls.EventThresholdSigningRequested.request_id"
+	signingRequestedEpochKey    = "inference.bls.EventThresholdSigningRequested.current_epoch_id"
+	gasLimitHeadroomPercent     = 20
+	feeBumpPercent              = 125 // replacements must raise both fees by at least 10%
+	receiptPollInterval         = 3 * time.Second
+	maxTransientFailuresPerCall = 20
+)
+
+var (
+	// ErrReverted is a call the contract rejects, e.g. a group key or a message already relayed. It isn't retried.
+	ErrReverted = errors.New("bridge contract call reverted")
+	// ErrFeeAboveCap postpones a call while the network fees are above the configured cap
+	ErrFeeAboveCap = errors.New("network fee above the configured cap")
+	// errPermanent marks chain side failures no retry fixes, like a failed or expired signing request
+	errPermanent = errors.New("cannot be relayed")
+)
+
+type Config struct {
+	RpcUrl          string
+	ChainId         int64
+	ContractAddress string
+	PrivateKey      string
+	// MaxFeePerGas caps the fee per gas of the relay transactions, in wei
+	MaxFeePerGas *big.Int
+	// ReplaceAfter is how long a transaction may stay pending before it is replaced with higher fees
+	ReplaceAfter    time.Duration
+	MaxReplacements int
+	PollInterval    time.Duration
+}
+
+type jobKind int
+
+const (
+	jobGroupKey jobKind = iota
+	jobSignature
+)
+
+// job is a call to relay: the group key of an epoch, or the signature of a signing request once complete
+type job struct {
+	kind      jobKind
+	epochId   uint64
+	requestId []byte
+	failures  int
+}
+
+func (j job) String() string {
+	if j.kind == jobGroupKey {
+		return fmt.Sprintf("group key of epoch %d", j.epochId)
+	}
+	return fmt.Sprintf("signature of request %x of epoch %d", j.requestId, j.epochId)
+}
+
+type Relayer struct {
+	cfg      Config
+	rpc      *rpcClient
+	signer   *Signer
+	contract []byte
+	bls      blstypes.QueryClient
+
+	mu   sync.Mutex
+	jobs []job
+	wake chan struct{}
+}
+
+func NewRelayer(cfg Config, blsQueryClient blstypes.QueryClient) (*Relayer, error) {
+	signer, err := NewSigner(cfg.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := hex.DecodeString(strings.TrimPrefix(cfg.ContractAddress, "0x"))
+	if err != nil || len(contract) != 20 {
+		return nil, fmt.Errorf("invalid bridge contract address %q", cfg.ContractAddress)
+	}
+	if cfg.RpcUrl == "" {
+		return nil, errors.New("an Ethereum RPC URL is required")
+	}
+	return &Relayer{
+		cfg:      cfg,
+		rpc:      newRPCClient(cfg.RpcUrl),
+		signer:   signer,
+		contract: contract,
+		bls:      blsQueryClient,
+		wake:     make(chan struct{}, 1),
+	}, nil
+}
+
+// Start checks the RPC serves the configured chain, then relays the queued calls until ctx is done
+func (r *Relayer) Start(ctx context.Context) {
+	chainId, err := r.rpc.chainId(ctx)
+	if err != nil {
+		logging.Error("EVM relay: failed to query the chain id, not starting", types.BLS, "error", err)
+		return
+	}
+	if chainId.Cmp(big.NewInt(r.cfg.ChainId)) != 0 {
+		logging.Error("EVM relay: the RPC serves another chain, not starting", types.BLS, "expected", r.cfg.ChainId, "actual", chainId)
+		return
+	}
+	logging.Info("EVM relay started", types.BLS, "account", r.signer.Address(), "contract", hexBytes(r.contract), "chainId", chainId)
+
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.wake:
+		}
+		r.processJobs(ctx)
+	}
+}
+
+// ProcessEvent queues the group keys validated and the signing requested in a transaction
+func (r *Relayer) ProcessEvent(event *chainevents.JSONRPCResponse) {
+	var jobs []job
+	for _, value := range event.Result.Events[groupKeyValidatedEpochKey] {
+		epochId, err := eventUint(value)
+		if err != nil {
+			logging.Warn("EVM relay: invalid epoch in group key event", types.BLS, "value", value, "error", err)
+			continue
+		}
+		jobs = append(jobs, job{kind: jobGroupKey, epochId: epochId})
+	}
+	epochs := event.Result.Events[signingRequestedEpochKey]
+	for i, value := range event.Result.Events[signingRequestedRequestKey] {
+		requestId, err := eventBytes(value)
+		if err != nil {
+			logging.Warn("EVM relay: invalid request id in signing event", types.BLS, "value", value, "error", err)
+			continue
+		}
+		signing := job{kind: jobSignature, requestId: requestId}
+		if i < len(epochs) {
+			signing.epochId, _ = eventUint(epochs[i])
+		}
+		jobs = append(jobs, signing)
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	r.jobs = append(r.jobs, jobs...)
+	r.mu.Unlock()
+	select {
+	case r.wake <- struct{}{}:
+	default:
+	}
+}
+
+// processJobs relays the queued calls in order. Signatures still being collected stay queued, as do calls
+// failing for transient reasons, up to maxTransientFailuresPerCall.
+func (r *Relayer) processJobs(ctx context.Context) {
+	r.mu.Lock()
+	jobs := r.jobs
+	r.jobs = nil
+	r.mu.Unlock()
+
+	var remaining []job
+	for _, j := range jobs {
+		if ctx.Err() != nil {
+			remaining = append(remaining, j)
+			continue
+		}
+		done, err := r.relay(ctx, j)
+		switch {
+		case err == nil && done:
+		case err == nil:
+			remaining = append(remaining, j)
+		case errors.Is(err, ErrReverted) || errors.Is(err, errPermanent):
+			logging.Warn("EVM relay: dropping call", types.BLS, "job", j.String(), "error", err)
+		case errors.Is(err, ErrFeeAboveCap):
+			logging.Info("EVM relay: postponing call while fees are high", types.BLS, "job", j.String(), "error", err)
+			remaining = append(remaining, j)
+		default:
+			j.failures++
+			if j.failures >= maxTransientFailuresPerCall {
+				logging.Error("EVM relay: giving up on call", types.BLS, "job", j.String(), "error", err)
+				continue
+			}
+			logging.Warn("EVM relay: call failed, will retry", types.BLS, "job", j.String(), "error", err, "failures", j.failures)
+			remaining = append(remaining, j)
+		}
+	}
+
+	r.mu.Lock()
+	r.jobs = append(remaining, r.jobs...)
+	r.mu.Unlock()
+}
+
+// relay submits the call of a job. done is false while the job has to wait, e.g. for signatures.
+func (r *Relayer) relay(ctx context.Context, j job) (done bool, err error) {
+	var calldata []byte
+	switch j.kind {
+	case jobGroupKey:
+		res, err := r.bls.EpochBLSData(ctx, &blstypes.QueryEpochBLSDataRequest{EpochId: j.epochId})
+		if err != nil {
+			return false, err
+		}
+		groupKey, err := bls.DecompressG2To256Blst(res.EpochData.GroupPublicKey)
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		signature, err := bls.DecompressG1To128Blst(res.EpochData.ValidationSignature)
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		calldata = GroupKeyCalldata(j.epochId, groupKey, signature)
+	case jobSignature:
+		res, err := r.bls.SigningStatus(ctx, &blstypes.QuerySigningStatusRequest{RequestId: j.requestId})
+		if err != nil {
+			return false, err
+		}
+		request := res.SigningRequest
+		switch request.Status {
+		case blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COMPLETED:
+		case blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_FAILED, blstypes.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_EXPIRED:
+			return false, fmt.Errorf("%w: signing request %s", errPermanent, request.Status)
+		default:
+			return false, nil
+		}
+		signature, err := bls.DecompressG1To128Blst(request.FinalSignature)
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		calldata = SignedMessageCalldata(request.EncodedData, signature)
+	}
+
+	hash, err := r.submit(ctx, calldata)
+	if err != nil {
+		return false, err
+	}
+	logging.Info("EVM relay: call mined", types.BLS, "job", j.String(), "tx", hexBytes(hash))
+	return true, nil
+}
+
+// submit sends a contract call and waits for it to be mined. A transaction pending longer than ReplaceAfter
+// is replaced, same nonce, with both fees raised by a quarter, up to MaxReplacements times and never above
+// MaxFeePerGas.
+func (r *Relayer) submit(ctx context.Context, calldata []byte) ([]byte, error) {
+	from, to := r.signer.Address(), hexBytes(r.contract)
+	gas, err := r.rpc.estimateGas(ctx, callMsg{From: from, To: to, Data: hexBytes(calldata)})
+	if err != nil {
+		if isRevert(err) {
+			return nil, fmt.Errorf("%w: %v", ErrReverted, err)
+		}
+		return nil, err
+	}
+	tip, feeCap, err := r.fees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := r.rpc.pendingNonce(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := DynamicFeeTx{
+		ChainId:   big.NewInt(r.cfg.ChainId),
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas + gas*gasLimitHeadroomPercent/100,
+		To:        r.contract,
+		Data:      calldata,
+	}
+	var sent [][]byte
+	for replacement := 0; ; replacement++ {
+		raw, hash := r.signer.Sign(tx)
+		if err := r.rpc.sendRawTransaction(ctx, raw); err != nil {
+			// A previous transaction with this nonce may have been mined meanwhile
+			if len(sent) == 0 {
+				return nil, err
+			}
+			logging.Warn("EVM relay: failed to send replacement", types.BLS, "nonce", nonce, "error", err)
+		} else {
+			sent = append(sent, hash)
+			logging.Info("EVM relay: transaction sent", types.BLS, "tx", hexBytes(hash), "nonce", nonce,
+				"gas", tx.Gas, "maxFeePerGas", tx.GasFeeCap, "maxPriorityFeePerGas", tx.GasTipCap)
+		}
+
+		mined, err := r.waitMined(ctx, sent)
+		if err != nil || mined != nil {
+			return mined, err
+		}
+		if replacement >= r.cfg.MaxReplacements {
+			return nil, fmt.Errorf("transaction with nonce %d not mined after %d replacements", nonce, replacement)
+		}
+		tx.GasTipCap = bump(tx.GasTipCap)
+		tx.GasFeeCap = bump(tx.GasFeeCap)
+		if tx.GasFeeCap.Cmp(r.cfg.MaxFeePerGas) > 0 {
+			// A replacement below the +10% rule would be rejected: wait for the pending one instead
+			if tx.GasFeeCap = r.cfg.MaxFeePerGas; tx.GasTipCap.Cmp(tx.GasFeeCap) > 0 {
+				tx.GasTipCap = tx.GasFeeCap
+			}
+		}
+	}
+}
+
+// waitMined polls the receipts of the transactions sent with one nonce for up to ReplaceAfter. It returns
+// the hash of the mined one, nil when none is mined yet.
+func (r *Relayer) waitMined(ctx context.Context, hashes [][]byte) ([]byte, error) {
+	deadline := time.Now().Add(r.cfg.ReplaceAfter)
+	ticker := time.NewTicker(receiptPollInterval)
+	defer ticker.Stop()
+	for {
+		for _, hash := range hashes {
+			rec, err := r.rpc.receipt(ctx, hash)
+			if err != nil {
+				logging.Warn("EVM relay: failed to query receipt", types.BLS, "tx", hexBytes(hash), "error", err)
+				continue
+			}
+			if rec == nil {
+				continue
+			}
+			if rec.Status.Sign() == 0 {
+				return nil, fmt.Errorf("%w: transaction %s failed in block %s", ErrReverted, hexBytes(hash), rec.BlockNumber.String())
+			}
+			return hash, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// fees returns the priority fee the node suggests and a fee cap of twice the base fee above it, so the
+// transaction stays includable while the base fee rises for a few blocks
+func (r *Relayer) fees(ctx context.Context) (tip, feeCap *big.Int, err error) {
+	tip, err = r.rpc.maxPriorityFeePerGas(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	baseFee, err := r.rpc.baseFee(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if new(big.Int).Add(baseFee, tip).Cmp(r.cfg.MaxFeePerGas) > 0 {
+		return nil, nil, fmt.Errorf("%w: base fee %s, priority fee %s, cap %s", ErrFeeAboveCap, baseFee, tip, r.cfg.MaxFeePerGas)
+	}
+	feeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), tip)
+	if feeCap.Cmp(r.cfg.MaxFeePerGas) > 0 {
+		feeCap = new(big.Int).Set(r.cfg.MaxFeePerGas)
+	}
+	return tip, feeCap, nil
+}
+
+// isRevert tells a call the contract rejects from other RPC errors, like rate limits. Nodes answer reverts
+// with code 3 (geth) or an "execution reverted" message.
+func isRevert(err error) bool {
+	var rpcErr *RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+	return rpcErr.Code == 3 || strings.Contains(strings.ToLower(rpcErr.Message), "revert")
+}
+
+func bump(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(feeBumpPercent))
+	bumped.Div(bumped, big.NewInt(100))
+	// Tiny fees don't grow by rounding down
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
+
+func eventUint(value string) (uint64, error) {
+	if unquoted, err := utils.UnquoteEventValue(value); err == nil {
+		value = unquoted
+	}
+	return strconv.ParseUint(value, 10, 64)
+}
+
+func eventBytes(value string) ([]byte, error) {
+	if unquoted, err := utils.UnquoteEventValue(value); err == nil {
+		value = unquoted
+	}
+	return utils.DecodeBase64IfPossible(value)
+}
@@ -0,0 +1,183 @@
+package evmrelay
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/internal/bls"
+	"decentralized-api/internal/event_listener/chainevents"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/bls/types"
+	"github.com/stretchr/testify/require"
+	blst "github.com/supranational/blst/bindings/go"
+	"google.golang.org/grpc"
+)
+
+// fakeEthereum answers the JSON-RPC calls of the relay and records the transactions sent
+type fakeEthereum struct {
+	mu        sync.Mutex
+	revert    bool
+	sent      [][]byte
+	estimates int
+}
+
+func (f *fakeEthereum) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Id     int64             `json:"id"`
+		Method string            `json:"method"`
+		Params []json.RawMessage `json:"params"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	response := map[string]any{"jsonrpc": "2.0", "id": request.Id}
+	switch request.Method {
+	case "eth_chainId":
+		response["result"] = "0x1"
+	case "eth_estimateGas":
+		f.estimates++
+		if f.revert {
+			response["error"] = map[string]any{"code": 3, "message": "execution reverted: epoch already set"}
+		} else {
+			response["result"] = "0x186a0"
+		}
+	case "eth_maxPriorityFeePerGas":
+		response["result"] = "0x3b9aca00"
+	case "eth_getBlockByNumber":
+		response["result"] = map[string]any{"baseFeePerGas": "0x2540be400"}
+	case "eth_getTransactionCount":
+		response["result"] = "0x7"
+	case "eth_sendRawTransaction":
+		var raw string
+		_ = json.Unmarshal(request.Params[0], &raw)
+		decoded, _ := hex.DecodeString(strings.TrimPrefix(raw, "0x"))
+		f.sent = append(f.sent, decoded)
+		response["result"] = hexBytes(keccak256(decoded))
+	case "eth_getTransactionReceipt":
+		response["result"] = map[string]any{"status": "0x1", "blockNumber": "0x10", "gasUsed": "0x186a0"}
+	default:
+		response["error"] = map[string]any{"code": -32601, "message": "method not found"}
+	}
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+type fakeBLSQueryClient struct {
+	types.QueryClient
+	epochData types.EpochBLSData
+	signing   types.ThresholdSigningRequest
+}
+
+func (c *fakeBLSQueryClient) EpochBLSData(_ context.Context, _ *types.QueryEpochBLSDataRequest, _ ...grpc.CallOption) (*types.QueryEpochBLSDataResponse, error) {
+	return &types.QueryEpochBLSDataResponse{EpochData: c.epochData}, nil
+}
+
+func (c *fakeBLSQueryClient) SigningStatus(_ context.Context, _ *types.QuerySigningStatusRequest, _ ...grpc.CallOption) (*types.QuerySigningStatusResponse, error) {
+	return &types.QuerySigningStatusResponse{SigningRequest: c.signing}, nil
+}
+
+func newTestRelayer(t *testing.T, eth *fakeEthereum, queryClient types.QueryClient) *Relayer {
+	server := httptest.NewServer(eth)
+	t.Cleanup(server.Close)
+	relayer, err := NewRelayer(Config{
+		RpcUrl:          server.URL,
+		ChainId:         1,
+		ContractAddress: "0x" + strings.Repeat("11", 20),
+		PrivateKey:      "4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318",
+		MaxFeePerGas:    big.NewInt(200_000_000_000),
+		ReplaceAfter:    time.Minute,
+		MaxReplacements: 1,
+		PollInterval:    time.Second,
+	}, queryClient)
+	require.NoError(t, err)
+	return relayer
+}
+
+func testPoints(t *testing.T) (groupKey, signature []byte) {
+	sk := blst.KeyGen(bytes.Repeat([]byte{7}, 32))
+	require.NotNil(t, sk)
+	return new(blst.P2Affine).From(sk).Compress(), new(blst.P1Affine).From(sk).Compress()
+}
+
+func eventOf(events map[string][]string) *chainevents.JSONRPCResponse {
+	return &chainevents.JSONRPCResponse{Result: chainevents.Result{Events: events}}
+}
+
+func TestRelayGroupKey(t *testing.T) {
+	groupKey, signature := testPoints(t)
+	eth := &fakeEthereum{}
+	relayer := newTestRelayer(t, eth, &fakeBLSQueryClient{
+		epochData: types.EpochBLSData{GroupPublicKey: groupKey, ValidationSignature: signature},
+	})
+
+	relayer.ProcessEvent(eventOf(map[string][]string{groupKeyValidatedEpochKey: {"\"5\""}}))
+	relayer.processJobs(context.Background())
+
+	uncompressedKey, err := bls.DecompressG2To256Blst(groupKey)
+	require.NoError(t, err)
+	uncompressedSignature, err := bls.DecompressG1To128Blst(signature)
+	require.NoError(t, err)
+	require.Len(t, eth.sent, 1)
+	require.True(t, bytes.Contains(eth.sent[0], GroupKeyCalldata(5, uncompressedKey, uncompressedSignature)))
+	require.Empty(t, relayer.jobs)
+}
+
+func TestRelayDropsRevertedCall(t *testing.T) {
+	groupKey, signature := testPoints(t)
+	eth := &fakeEthereum{revert: true}
+	relayer := newTestRelayer(t, eth, &fakeBLSQueryClient{
+		epochData: types.EpochBLSData{GroupPublicKey: groupKey, ValidationSignature: signature},
+	})
+
+	relayer.ProcessEvent(eventOf(map[string][]string{groupKeyValidatedEpochKey: {"5"}}))
+	relayer.processJobs(context.Background())
+
+	require.Equal(t, 1, eth.estimates)
+	require.Empty(t, eth.sent)
+	require.Empty(t, relayer.jobs)
+}
+
+func TestRelaySignatureWaitsForCompletion(t *testing.T) {
+	_, signature := testPoints(t)
+	requestId := bytes.Repeat([]byte{0xab}, 32)
+	eth := &fakeEthereum{}
+	queryClient := &fakeBLSQueryClient{signing: types.ThresholdSigningRequest{
+		RequestId: requestId,
+		Status:    types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COLLECTING_SIGNATURES,
+	}}
+	relayer := newTestRelayer(t, eth, queryClient)
+
+	relayer.ProcessEvent(eventOf(map[string][]string{
+		signingRequestedRequestKey: {"\"" + base64.StdEncoding.EncodeToString(requestId) + "\""},
+		signingRequestedEpochKey:   {"\"3\""},
+	}))
+	relayer.processJobs(context.Background())
+	require.Empty(t, eth.sent)
+	require.Len(t, relayer.jobs, 1)
+	require.Equal(t, requestId, relayer.jobs[0].requestId)
+	require.Equal(t, uint64(3), relayer.jobs[0].epochId)
+
+	encodedData := []byte("encoded message")
+	queryClient.signing.Status = types.ThresholdSigningStatus_THRESHOLD_SIGNING_STATUS_COMPLETED
+	queryClient.signing.EncodedData = encodedData
+	queryClient.signing.FinalSignature = signature
+	relayer.processJobs(context.Background())
+
+	uncompressedSignature, err := bls.DecompressG1To128Blst(signature)
+	require.NoError(t, err)
+	require.Len(t, eth.sent, 1)
+	require.True(t, bytes.Contains(eth.sent[0], SignedMessageCalldata(encodedData, uncompressedSignature)))
+	require.Empty(t, relayer.jobs)
+}
@@ -0,0 +1,170 @@
+package evmrelay
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// RPCError is an error answered by the Ethereum node, as opposed to a transport error. A call the
+// contract reverts fails with one.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("rpc error %d: %s", e.Code, e.Message)
+}
+
+// rpcClient is a minimal Ethereum JSON-RPC client for the calls of the relay
+type rpcClient struct {
+	url    string
+	http   *http.Client
+	nextId atomic.Int64
+}
+
+func newRPCClient(url string) *rpcClient {
+	return &rpcClient{url: url, http: &http.Client{Timeout: 30 * time.Second}}
+}
+
+func (c *rpcClient) call(ctx context.Context, result any, method string, params ...any) error {
+	if params == nil {
+		params = []any{}
+	}
+	body, err := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": c.nextId.Add(1), "method": method, "params": params})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: unexpected status %d", method, resp.StatusCode)
+	}
+
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *RPCError       `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+	if response.Error != nil {
+		return response.Error
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Result, result)
+}
+
+// quantity is a hex encoded JSON-RPC integer
+type quantity struct {
+	*big.Int
+}
+
+func (q *quantity) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, ok := new(big.Int).SetString(strings.TrimPrefix(s, "0x"), 16)
+	if !ok {
+		return fmt.Errorf("invalid quantity %q", s)
+	}
+	q.Int = v
+	return nil
+}
+
+func hexQuantity(v *big.Int) string {
+	return "0x" + v.Text(16)
+}
+
+func hexBytes(b []byte) string {
+	return "0x" + hex.EncodeToString(b)
+}
+
+type callMsg struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Data string `json:"data"`
+}
+
+type receipt struct {
+	Status      quantity `json:"status"`
+	BlockNumber quantity `json:"blockNumber"`
+	GasUsed     quantity `json:"gasUsed"`
+}
+
+func (c *rpcClient) chainId(ctx context.Context) (*big.Int, error) {
+	var id quantity
+	if err := c.call(ctx, &id, "eth_chainId"); err != nil {
+		return nil, err
+	}
+	return id.Int, nil
+}
+
+func (c *rpcClient) pendingNonce(ctx context.Context, address string) (uint64, error) {
+	var nonce quantity
+	if err := c.call(ctx, &nonce, "eth_getTransactionCount", address, "pending"); err != nil {
+		return 0, err
+	}
+	return nonce.Uint64(), nil
+}
+
+func (c *rpcClient) estimateGas(ctx context.Context, msg callMsg) (uint64, error) {
+	var gas quantity
+	if err := c.call(ctx, &gas, "eth_estimateGas", msg); err != nil {
+		return 0, err
+	}
+	return gas.Uint64(), nil
+}
+
+func (c *rpcClient) maxPriorityFeePerGas(ctx context.Context) (*big.Int, error) {
+	var tip quantity
+	if err := c.call(ctx, &tip, "eth_maxPriorityFeePerGas"); err != nil {
+		return nil, err
+	}
+	return tip.Int, nil
+}
+
+func (c *rpcClient) baseFee(ctx context.Context) (*big.Int, error) {
+	var block struct {
+		BaseFeePerGas *quantity `json:"baseFeePerGas"`
+	}
+	if err := c.call(ctx, &block, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, err
+	}
+	if block.BaseFeePerGas == nil {
+		return nil, fmt.Errorf("latest block has no base fee, EIP-1559 is required")
+	}
+	return block.BaseFeePerGas.Int, nil
+}
+
+func (c *rpcClient) sendRawTransaction(ctx context.Context, raw []byte) error {
+	return c.call(ctx, nil, "eth_sendRawTransaction", hexBytes(raw))
+}
+
+// receipt returns the receipt of a mined transaction, nil while it is pending
+func (c *rpcClient) receipt(ctx context.Context, hash []byte) (*receipt, error) {
+	var r *receipt
+	if err := c.call(ctx, &r, "eth_getTransactionReceipt", hexBytes(hash)); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
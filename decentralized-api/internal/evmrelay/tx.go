@@ -0,0 +1,99 @@
+package evmrelay
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// dynamicFeeTxType is the EIP-1559 transaction type
+const dynamicFeeTxType = 0x02
+
+var ErrInvalidKey = errors.New("invalid EVM private key")
+
+// Signer signs the relay transactions with the relay account key
+type Signer struct {
+	key     *secp256k1.PrivateKey
+	address []byte
+}
+
+// NewSigner parses a hex secp256k1 private key, with or without 0x prefix
+func NewSigner(hexKey string) (*Signer, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hexKey), "0x"))
+	if err != nil || len(raw) != 32 {
+		return nil, ErrInvalidKey
+	}
+	key := secp256k1.PrivKeyFromBytes(raw)
+	if key.Key.IsZero() {
+		return nil, ErrInvalidKey
+	}
+	// The address is the last 20 bytes of the hash of the uncompressed public key, without its 0x04 prefix
+	address := keccak256(key.PubKey().SerializeUncompressed()[1:])[12:]
+	return &Signer{key: key, address: address}, nil
+}
+
+// Address is the 0x prefixed hex address of the relay account
+func (s *Signer) Address() string {
+	return "0x" + hex.EncodeToString(s.address)
+}
+
+// DynamicFeeTx is an EIP-1559 contract call without value or access list
+type DynamicFeeTx struct {
+	ChainId   *big.Int
+	Nonce     uint64
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	Gas       uint64
+	To        []byte
+	Data      []byte
+}
+
+// Sign returns the raw signed transaction for eth_sendRawTransaction and its hash
+func (s *Signer) Sign(tx DynamicFeeTx) (raw []byte, hash []byte) {
+	fields := []any{tx.ChainId, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, new(big.Int), tx.Data, []any{}}
+	sigHash := keccak256([]byte{dynamicFeeTxType}, rlpEncode(fields))
+
+	// SignCompact returns [27 + recovery id] || r || s
+	sig := ecdsa.SignCompact(s.key, sigHash, false)
+	v := big.NewInt(int64(sig[0] - 27))
+	r := new(big.Int).SetBytes(sig[1:33])
+	sv := new(big.Int).SetBytes(sig[33:65])
+
+	raw = append([]byte{dynamicFeeTxType}, rlpEncode(append(fields, v, r, sv))...)
+	return raw, keccak256(raw)
+}
+
+// rlpEncode encodes byte strings, unsigned integers and lists of them
+func rlpEncode(item any) []byte {
+	switch v := item.(type) {
+	case []byte:
+		if len(v) == 1 && v[0] < 0x80 {
+			return v
+		}
+		return append(rlpLength(len(v), 0x80), v...)
+	case uint64:
+		return rlpEncode(new(big.Int).SetUint64(v))
+	case *big.Int:
+		// Integers are their big-endian bytes without leading zeros, zero being the empty string
+		return rlpEncode(v.Bytes())
+	case []any:
+		var payload []byte
+		for _, element := range v {
+			payload = append(payload, rlpEncode(element)...)
+		}
+		return append(rlpLength(len(payload), 0xc0), payload...)
+	}
+	panic("evmrelay: unsupported RLP item")
+}
+
+func rlpLength(length int, offset byte) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	lengthBytes := big.NewInt(int64(length)).Bytes()
+	return append([]byte{offset + 55 + byte(len(lengthBytes))}, lengthBytes...)
+}
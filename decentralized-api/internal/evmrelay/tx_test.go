@@ -0,0 +1,78 @@
+package evmrelay
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector(t *testing.T) {
+	require.Equal(t, "a9059cbb", hex.EncodeToString(selector("transfer(address,uint256)")))
+}
+
+func TestEncodeCall(t *testing.T) {
+	call := encodeCall("f(uint64,bytes)", uint64(5), []byte{1, 2, 3})
+	require.Len(t, call, 4+4*32)
+	require.Equal(t, selector("f(uint64,bytes)"), call[:4])
+	require.Equal(t, word(big.NewInt(5)), call[4:36])
+	// The offset of the bytes counts from the start of the arguments
+	require.Equal(t, word(big.NewInt(64)), call[36:68])
+	require.Equal(t, word(big.NewInt(3)), call[68:100])
+	require.Equal(t, append([]byte{1, 2, 3}, make([]byte, 29)...), call[100:])
+}
+
+func TestRlpEncode(t *testing.T) {
+	for _, tc := range []struct {
+		item     any
+		expected string
+	}{
+		{[]byte("dog"), "83646f67"},
+		{[]any{[]byte("cat"), []byte("dog")}, "c88363617483646f67"},
+		{[]byte{}, "80"},
+		{[]any{}, "c0"},
+		{uint64(0), "80"},
+		{uint64(15), "0f"},
+		{uint64(1024), "820400"},
+		{bytes.Repeat([]byte{'a'}, 56), "b838" + hex.EncodeToString(bytes.Repeat([]byte{'a'}, 56))},
+	} {
+		require.Equal(t, tc.expected, hex.EncodeToString(rlpEncode(tc.item)))
+	}
+}
+
+func TestSigner(t *testing.T) {
+	signer, err := NewSigner("0x4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	require.Equal(t, "0x2c7536e3605d9c16a7a3d7b1898e529396a65c23", signer.Address())
+
+	_, err = NewSigner("not a key")
+	require.ErrorIs(t, err, ErrInvalidKey)
+
+	tx := DynamicFeeTx{
+		ChainId:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(2_000_000_000),
+		GasFeeCap: big.NewInt(50_000_000_000),
+		Gas:       100_000,
+		To:        bytes.Repeat([]byte{0x11}, 20),
+		Data:      []byte{0xde, 0xad},
+	}
+	raw, hash := signer.Sign(tx)
+	require.Equal(t, byte(dynamicFeeTxType), raw[0])
+	require.Equal(t, keccak256(raw), hash)
+
+	// Signatures are deterministic: the raw transaction ends with the recovery id, r and s of the signature
+	// of the unsigned fields, and the signature recovers the signer's address
+	fields := []any{tx.ChainId, tx.Nonce, tx.GasTipCap, tx.GasFeeCap, tx.Gas, tx.To, new(big.Int), tx.Data, []any{}}
+	sigHash := keccak256([]byte{dynamicFeeTxType}, rlpEncode(fields))
+	sig := ecdsa.SignCompact(signer.key, sigHash, false)
+	v, r, s := big.NewInt(int64(sig[0]-27)), new(big.Int).SetBytes(sig[1:33]), new(big.Int).SetBytes(sig[33:])
+	require.Equal(t, append([]byte{dynamicFeeTxType}, rlpEncode(append(fields, v, r, s))...), raw)
+
+	pub, _, err := ecdsa.RecoverCompact(sig, sigHash)
+	require.NoError(t, err)
+	require.Equal(t, signer.address, keccak256(pub.SerializeUncompressed()[1:])[12:])
+}
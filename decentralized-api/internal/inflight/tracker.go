@@ -0,0 +1,83 @@
+package inflight
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrInferenceExpired is the cancellation cause of requests whose inference the chain expired unfinished
+var ErrInferenceExpired = errors.New("inference expired on chain before it finished")
+
+// Tracker binds the in-flight requests of this API to the chain expiry of their inferences. When the chain
+// emits inference_expired, the requests still running for the inference are cancelled, which stops the call
+// to the executor or the inference node and releases what the request holds (bandwidth, node lock).
+// A nil Tracker tracks nothing.
+type Tracker struct {
+	mu      sync.Mutex
+	nextId  uint64
+	cancels map[string]map[uint64]context.CancelCauseFunc
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{cancels: make(map[string]map[uint64]context.CancelCauseFunc)}
+}
+
+// Track returns a context that is cancelled with ErrInferenceExpired when the inference expires.
+// stop must be called once the request is done.
+func (t *Tracker) Track(parent context.Context, inferenceId string) (ctx context.Context, stop func()) {
+	if t == nil || inferenceId == "" {
+		return parent, func() {}
+	}
+	ctx, cancel := context.WithCancelCause(parent)
+
+	t.mu.Lock()
+	id := t.nextId
+	t.nextId++
+	if t.cancels[inferenceId] == nil {
+		t.cancels[inferenceId] = make(map[uint64]context.CancelCauseFunc)
+	}
+	t.cancels[inferenceId][id] = cancel
+	t.mu.Unlock()
+
+	return ctx, func() {
+		t.mu.Lock()
+		delete(t.cancels[inferenceId], id)
+		if len(t.cancels[inferenceId]) == 0 {
+			delete(t.cancels, inferenceId)
+		}
+		t.mu.Unlock()
+		cancel(nil)
+	}
+}
+
+// Expire cancels the requests of an expired inference and returns how many were still running
+func (t *Tracker) Expire(inferenceId string) int {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	cancels := t.cancels[inferenceId]
+	delete(t.cancels, inferenceId)
+	t.mu.Unlock()
+
+	for _, cancel := range cancels {
+		cancel(ErrInferenceExpired)
+	}
+	return len(cancels)
+}
+
+// InFlight returns the number of inferences with running requests
+func (t *Tracker) InFlight() int {
+	if t == nil {
+		return 0
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.cancels)
+}
+
+// Expired reports whether ctx was cancelled because its inference expired
+func Expired(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), ErrInferenceExpired)
+}
@@ -0,0 +1,46 @@
+package inflight
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker_Expire(t *testing.T) {
+	tracker := NewTracker()
+
+	// The transfer agent and the executor on the same node track the same inference
+	transferCtx, stopTransfer := tracker.Track(context.Background(), "inf-1")
+	executorCtx, stopExecutor := tracker.Track(transferCtx, "inf-1")
+	otherCtx, stopOther := tracker.Track(context.Background(), "inf-2")
+	defer stopTransfer()
+	defer stopExecutor()
+	defer stopOther()
+	require.Equal(t, 2, tracker.InFlight())
+
+	require.Equal(t, 2, tracker.Expire("inf-1"))
+	require.True(t, Expired(transferCtx))
+	require.True(t, Expired(executorCtx))
+	require.NoError(t, otherCtx.Err())
+	require.Equal(t, 1, tracker.InFlight())
+
+	require.Zero(t, tracker.Expire("inf-1"))
+}
+
+func TestTracker_Stop(t *testing.T) {
+	tracker := NewTracker()
+
+	ctx, stop := tracker.Track(context.Background(), "inf-1")
+	stop()
+	require.Error(t, ctx.Err())
+	require.False(t, Expired(ctx))
+	require.Zero(t, tracker.InFlight())
+	require.Zero(t, tracker.Expire("inf-1"))
+
+	var none *Tracker
+	ctx, stop = none.Track(context.Background(), "inf-1")
+	stop()
+	require.NoError(t, ctx.Err())
+	require.Zero(t, none.Expire("inf-1"))
+}
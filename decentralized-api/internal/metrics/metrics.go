@@ -0,0 +1,89 @@
+// Package metrics holds the Prometheus metrics of the API node. The metrics are always collected;
+// the metrics config only controls whether the admin server serves them at /metrics.
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "dapi"
+
+const (
+	ValidationPassed       = "passed"
+	ValidationFailed       = "failed"
+	ValidationError        = "error"
+	ValidationUnavailable  = "payload_unavailable"
+	ValidationHashMismatch = "hash_mismatch"
+)
+
+var registry = prometheus.NewRegistry()
+
+var (
+	BlocksProcessed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "blocks_processed_total",
+		Help:      "New blocks processed by the block dispatcher.",
+	})
+	PhaseTransitions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "phase_transitions_total",
+		Help:      "Epoch phase transitions observed, by the phase entered.",
+	}, []string{"phase"})
+	EventQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "event_queue_depth",
+		Help:      "Chain events waiting in the event listener queues.",
+	}, []string{"queue"})
+	Validations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "validations_total",
+		Help:      "Inference validations, by outcome.",
+	}, []string{"outcome"})
+	ValidationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "validation_duration_seconds",
+		Help:      "Time to re-run an inference on an ML node for validation, by outcome.",
+		Buckets:   prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"outcome"})
+	InferenceProxyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "inference_proxy_duration_seconds",
+		Help:      "Time until an ML node answers a proxied inference request, by node.",
+		Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+	}, []string{"node"})
+	TxSubmissionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "tx_submission_failures_total",
+		Help:      "Chain transactions that failed to broadcast or were rejected on submission, by message type.",
+	}, []string{"msg_type"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		BlocksProcessed,
+		PhaseTransitions,
+		EventQueueDepth,
+		Validations,
+		ValidationDuration,
+		InferenceProxyDuration,
+		TxSubmissionFailures,
+	)
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// ObserveValidation records the outcome of a validation that re-ran the inference since start
+func ObserveValidation(outcome string, start time.Time) {
+	Validations.WithLabelValues(outcome).Inc()
+	ValidationDuration.WithLabelValues(outcome).Observe(time.Since(start).Seconds())
+}
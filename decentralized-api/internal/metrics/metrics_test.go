@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandlerExposesMetrics(t *testing.T) {
+	BlocksProcessed.Inc()
+	PhaseTransitions.WithLabelValues("PoCGenerate").Inc()
+	EventQueueDepth.WithLabelValues("block_events").Set(3)
+	ObserveValidation(ValidationPassed, time.Now().Add(-time.Second))
+	InferenceProxyDuration.WithLabelValues("node-1").Observe(0.2)
+	TxSubmissionFailures.WithLabelValues("batch").Inc()
+
+	rec := httptest.NewRecorder()
+	Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	for _, expected := range []string{
+		"dapi_blocks_processed_total",
+		`dapi_phase_transitions_total{phase="PoCGenerate"} 1`,
+		`dapi_event_queue_depth{queue="block_events"} 3`,
+		`dapi_validations_total{outcome="passed"} 1`,
+		`dapi_validation_duration_seconds_count{outcome="passed"} 1`,
+		`dapi_inference_proxy_duration_seconds_count{node="node-1"} 1`,
+		`dapi_tx_submission_failures_total{msg_type="batch"} 1`,
+		"go_goroutines",
+	} {
+		require.Contains(t, body, expected)
+	}
+}
@@ -0,0 +1,170 @@
+package modelmanager
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/broker"
+	"decentralized-api/logging"
+	"decentralized-api/mlnodeclient"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// DownloadState is the scheduler's view of a model on an ML node
+type DownloadState string
+
+const (
+	DownloadStateQueued      DownloadState = "QUEUED"      // missing, waiting for a download slot on the node
+	DownloadStateDownloading DownloadState = "DOWNLOADING" // download running on the ML node
+	DownloadStateDownloaded  DownloadState = "DOWNLOADED"
+	DownloadStateFailed      DownloadState = "FAILED" // status check or download start failed, retried on the next check
+)
+
+// Download priorities, lower is downloaded first
+const (
+	// PriorityCurrentEpoch is for models the node serves in the current epoch
+	PriorityCurrentEpoch = 0
+	// PriorityConfigured is for the other models configured on the node
+	PriorityConfigured = 1
+)
+
+// ModelDownload reports the download progress of a model on a node through the admin API
+type ModelDownload struct {
+	NodeId         string        `json:"node_id"`
+	Model          string        `json:"model"`
+	Priority       int           `json:"priority"`
+	State          DownloadState `json:"state"`
+	ElapsedSeconds float64       `json:"elapsed_seconds,omitempty"`
+	Verified       bool          `json:"verified,omitempty"`
+	Error          string        `json:"error,omitempty"`
+	UpdatedAt      time.Time     `json:"updated_at"`
+}
+
+// downloadTracker keeps the latest ModelDownload per node and model
+type downloadTracker struct {
+	mu     sync.RWMutex
+	byNode map[string]map[string]ModelDownload
+}
+
+// setNode replaces the downloads of a node, dropping models that are no longer configured
+func (d *downloadTracker) setNode(nodeId string, downloads []ModelDownload) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.byNode == nil {
+		d.byNode = make(map[string]map[string]ModelDownload)
+	}
+	byModel := make(map[string]ModelDownload, len(downloads))
+	for _, download := range downloads {
+		byModel[download.Model] = download
+	}
+	d.byNode[nodeId] = byModel
+}
+
+// retainNodes drops the downloads of nodes that were removed
+func (d *downloadTracker) retainNodes(nodes []apiconfig.InferenceNodeConfig) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	keep := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		keep[node.Id] = struct{}{}
+	}
+	for nodeId := range d.byNode {
+		if _, ok := keep[nodeId]; !ok {
+			delete(d.byNode, nodeId)
+		}
+	}
+}
+
+func (d *downloadTracker) list() []ModelDownload {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	result := make([]ModelDownload, 0)
+	for _, byModel := range d.byNode {
+		for _, download := range byModel {
+			result = append(result, download)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].NodeId != result[j].NodeId {
+			return result[i].NodeId < result[j].NodeId
+		}
+		if result[i].Priority != result[j].Priority {
+			return result[i].Priority < result[j].Priority
+		}
+		return result[i].Model < result[j].Model
+	})
+	return result
+}
+
+type prioritizedModel struct {
+	id       string
+	priority int
+	// commit is the governance-declared HfCommit, empty when the model isn't in the node's epoch models
+	commit string
+}
+
+// ref returns the ML node model reference, pinned to the declared commit when there is one
+func (p prioritizedModel) ref() mlnodeclient.Model {
+	model := mlnodeclient.Model{HfRepo: p.id}
+	if p.commit != "" {
+		commit := p.commit
+		model.HfCommit = &commit
+	}
+	return model
+}
+
+// prioritizeModels orders the node's models for download: models of the current epoch first, then by id
+func prioritizeModels(node apiconfig.InferenceNodeConfig, epochModels map[string]types.Model) []prioritizedModel {
+	models := make([]prioritizedModel, 0, len(node.Models))
+	for modelId := range node.Models {
+		model := prioritizedModel{id: modelId, priority: PriorityConfigured}
+		if epochModel, ok := epochModels[modelId]; ok {
+			model.priority = PriorityCurrentEpoch
+			model.commit = epochModel.HfCommit
+		}
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].priority != models[j].priority {
+			return models[i].priority < models[j].priority
+		}
+		return models[i].id < models[j].id
+	})
+	return models
+}
+
+// currentEpochModels returns the governance snapshots of the models each node is assigned in the current epoch, per node id.
+// Returns nil when the broker can't be queried; all models then get the same priority and no pinned commit.
+func (m *MLNodeBackgroundManager) currentEpochModels(ctx context.Context) map[string]map[string]types.Model {
+	cmd := broker.NewGetNodesCommand()
+	if err := m.broker.QueueMessage(cmd); err != nil {
+		logging.Warn("Failed to query nodes for download priorities", types.System, "error", err)
+		return nil
+	}
+
+	var nodes []broker.NodeResponse
+	select {
+	case nodes = <-cmd.Response:
+	case <-ctx.Done():
+		return nil
+	}
+
+	result := make(map[string]map[string]types.Model, len(nodes))
+	for _, node := range nodes {
+		result[node.Node.Id] = node.State.EpochModels
+	}
+	return result
+}
+
+// SetDownloadConfig sets the per-node download concurrency and bandwidth cap
+func (m *MLNodeBackgroundManager) SetDownloadConfig(cfg apiconfig.ModelDownloadConfig) {
+	m.downloadConfig = cfg
+}
+
+// ModelDownloads returns the download progress of the configured models on every node
+func (m *MLNodeBackgroundManager) ModelDownloads() []ModelDownload {
+	return m.downloads.list()
+}
@@ -0,0 +1,129 @@
+package modelmanager
+
+import (
+	"bytes"
+	"decentralized-api/apiconfig"
+	"decentralized-api/mlnodeclient"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// AttestationSigner signs ML node capability reports with the participant's worker key
+type AttestationSigner struct {
+	participant string
+	workerKey   ed25519.PrivKey
+}
+
+// NewAttestationSigner returns an error when the worker key was never created, e.g. for participants
+// registered outside this API
+func NewAttestationSigner(participant string, keyConfig apiconfig.MLNodeKeyConfig) (*AttestationSigner, error) {
+	if keyConfig.WorkerPrivateKey == "" {
+		return nil, errors.New("worker key is not configured")
+	}
+	keyBytes, err := base64.StdEncoding.DecodeString(keyConfig.WorkerPrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid worker private key: %w", err)
+	}
+	if len(keyBytes) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("worker private key must be %d bytes, got %d", ed25519.PrivateKeySize, len(keyBytes))
+	}
+	return &AttestationSigner{participant: participant, workerKey: keyBytes}, nil
+}
+
+func (s *AttestationSigner) Sign(attestation *types.HardwareAttestation) error {
+	attestation.Participant = s.participant
+	signature, err := s.workerKey.Sign(attestation.SignBytes())
+	if err != nil {
+		return err
+	}
+	attestation.Signature = base64.StdEncoding.EncodeToString(signature)
+	return nil
+}
+
+// hardwareAttestations keeps the latest signed capability report per node
+type hardwareAttestations struct {
+	mu     sync.RWMutex
+	byNode map[string]types.HardwareAttestation
+}
+
+func (h *hardwareAttestations) set(attestation types.HardwareAttestation) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.byNode == nil {
+		h.byNode = make(map[string]types.HardwareAttestation)
+	}
+	h.byNode[attestation.LocalId] = attestation
+}
+
+func (h *hardwareAttestations) get(localId string) (types.HardwareAttestation, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	attestation, found := h.byNode[localId]
+	return attestation, found
+}
+
+func (h *hardwareAttestations) list() []types.HardwareAttestation {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	result := make([]types.HardwareAttestation, 0, len(h.byNode))
+	for _, attestation := range h.byNode {
+		result = append(result, attestation)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LocalId < result[j].LocalId
+	})
+	return result
+}
+
+// sameHardwareReport reports whether two attestations only differ in their timestamp and signature
+func sameHardwareReport(a, b types.HardwareAttestation) bool {
+	a.Timestamp, b.Timestamp = 0, 0
+	return bytes.Equal(a.SignBytes(), b.SignBytes())
+}
+
+// SetAttestationSigner enables signed capability reports for nodes whose GPUs are detected
+func (m *MLNodeBackgroundManager) SetAttestationSigner(signer *AttestationSigner) {
+	m.attestationSigner = signer
+}
+
+// HardwareAttestations returns the latest signed capability report of every node
+func (m *MLNodeBackgroundManager) HardwareAttestations() []types.HardwareAttestation {
+	return m.attestations.list()
+}
+
+// newHardwareAttestation summarizes the available GPUs of a node. BenchmarkScore stays empty: ML nodes
+// do not expose a benchmark yet.
+func newHardwareAttestation(node *apiconfig.InferenceNodeConfig, devices []mlnodeclient.GPUDevice, now time.Time) types.HardwareAttestation {
+	attestation := types.HardwareAttestation{
+		LocalId:   node.Id,
+		Models:    make([]string, 0, len(node.Models)),
+		Timestamp: now.Unix(),
+	}
+	var gpuModels []string
+	for _, device := range devices {
+		if !device.IsAvailable || device.ErrorMessage != nil || device.TotalMemoryMB == nil {
+			continue
+		}
+		attestation.GpuCount++
+		attestation.VRamGB += uint64(*device.TotalMemoryMB / 1024)
+		if !slices.Contains(gpuModels, device.Name) {
+			gpuModels = append(gpuModels, device.Name)
+		}
+	}
+	sort.Strings(gpuModels)
+	attestation.GpuModel = strings.Join(gpuModels, ", ")
+	for modelId := range node.Models {
+		attestation.Models = append(attestation.Models, modelId)
+	}
+	sort.Strings(attestation.Models)
+	return attestation
+}
@@ -5,6 +5,7 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
+	"decentralized-api/internal/nodeaddr"
 	"decentralized-api/logging"
 	"decentralized-api/mlnodeclient"
 	"errors"
@@ -33,7 +34,8 @@ type BrokerInterface interface {
 }
 
 // MLNodeBackgroundManager handles background operations for MLNodes:
-// - Model pre-downloading for upcoming epochs
+// - Model pre-downloading for upcoming epochs, scheduled per node by priority and bandwidth
+// - Integrity verification of downloaded models against the governance-declared commit
 // - GPU hardware detection and updates
 type MLNodeBackgroundManager struct {
 	configManager       NodesConfigManagerInterface
@@ -41,6 +43,11 @@ type MLNodeBackgroundManager struct {
 	broker              BrokerInterface
 	mlNodeClientFactory mlnodeclient.ClientFactory
 	checkInterval       time.Duration
+	attestationSigner   *AttestationSigner
+	attestations        hardwareAttestations
+	downloadConfig      apiconfig.ModelDownloadConfig
+	downloads           downloadTracker
+	verifications       modelVerifications
 }
 
 // NewMLNodeBackgroundManager creates a new MLNode background manager
@@ -92,8 +99,10 @@ func (m *MLNodeBackgroundManager) checkAndDownloadModels(ctx context.Context) {
 		"phase", epochState.CurrentPhase)
 
 	nodes := m.configManager.GetNodes()
+	m.downloads.retainNodes(nodes)
+	epochModels := m.currentEpochModels(ctx)
 	for _, node := range nodes {
-		m.checkNodeModels(node)
+		m.checkNodeModels(node, epochModels[node.Id])
 	}
 }
 
@@ -122,74 +131,138 @@ func (m *MLNodeBackgroundManager) isInDownloadWindow(epochState *chainphase.Epoc
 	return true
 }
 
-// checkNodeModels checks and downloads models for a specific node
-func (m *MLNodeBackgroundManager) checkNodeModels(node apiconfig.InferenceNodeConfig) {
-	version := m.configManager.GetCurrentNodeVersion()
-	pocUrl := getPoCUrlWithVersion(node, version)
-	inferenceUrl := getInferenceUrlWithVersion(node, version)
-	client := m.mlNodeClientFactory.CreateClient(pocUrl, inferenceUrl)
+// checkNodeModels checks the models of a node and starts downloads for the missing ones in priority order,
+// keeping at most MaxConcurrentPerNode downloads running on the node. The rest stay queued for the next check.
+// Downloaded models with a declared commit are verified once; corrupted copies are deleted and queued again.
+func (m *MLNodeBackgroundManager) checkNodeModels(node apiconfig.InferenceNodeConfig, epochModels map[string]types.Model) {
+	client := m.newNodeClient(node)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	endpointAvailable := true
+	maxConcurrent := max(m.downloadConfig.MaxConcurrentPerNode, 1)
+	downloadOptions := mlnodeclient.DownloadOptions{}
+	if m.downloadConfig.MaxBandwidthMbps > 0 {
+		// the node's cap is shared by its concurrent downloads
+		downloadOptions.MaxBandwidthMbps = max(m.downloadConfig.MaxBandwidthMbps/maxConcurrent, 1)
+	}
 
-	for modelId := range node.Models {
-		model := mlnodeclient.Model{
-			HfRepo:   modelId,
-			HfCommit: nil, // nil = latest
+	models := prioritizeModels(node, epochModels)
+	downloads := make([]ModelDownload, 0, len(models))
+	var missing []int
+	active := 0
+	for _, model := range models {
+		download := ModelDownload{
+			NodeId:    node.Id,
+			Model:     model.id,
+			Priority:  model.priority,
+			UpdatedAt: time.Now(),
 		}
 
-		statusResp, err := client.CheckModelStatus(ctx, model)
+		statusResp, err := client.CheckModelStatus(ctx, model.ref())
 		if err != nil {
 			var apiNotImplemented *mlnodeclient.ErrAPINotImplemented
 			if errors.As(err, &apiNotImplemented) {
-				if endpointAvailable {
-					logging.Info("Model pre-download endpoint not available",
-						types.System,
-						"node_id", node.Id)
-					endpointAvailable = false
-				}
-				break
+				logging.Info("Model pre-download endpoint not available",
+					types.System,
+					"node_id", node.Id)
+				return
 			}
 
 			logging.Warn("Failed to check model status",
 				types.System,
 				"node_id", node.Id,
-				"model", modelId,
+				"model", model.id,
 				"error", err.Error())
+			download.State = DownloadStateFailed
+			download.Error = err.Error()
+			downloads = append(downloads, download)
 			continue
 		}
 
 		switch statusResp.Status {
 		case mlnodeclient.ModelStatusNotFound, mlnodeclient.ModelStatusPartial:
-			logging.Info("Pre-downloading model",
+			download.State = DownloadStateQueued
+			missing = append(missing, len(downloads))
+		case mlnodeclient.ModelStatusDownloading:
+			logging.Debug("Model already downloading",
 				types.System,
-				"model", modelId,
+				"model", model.id,
 				"node_id", node.Id)
-
-			_, err := client.DownloadModel(ctx, model)
+			download.State = DownloadStateDownloading
+			if statusResp.Progress != nil {
+				download.ElapsedSeconds = statusResp.Progress.ElapsedSeconds
+			}
+			active++
+		case mlnodeclient.ModelStatusDownloaded:
+			logging.Debug("Model already downloaded",
+				types.System,
+				"model", model.id,
+				"node_id", node.Id)
+			download.State = DownloadStateDownloaded
+			problem, err := m.verifyDownloadedModel(client, node, model)
 			if err != nil {
-				logging.Warn("Failed to start model download",
+				logging.Warn("Failed to verify model",
 					types.System,
 					"node_id", node.Id,
-					"model", modelId,
+					"model", model.id,
 					"error", err.Error())
+			} else if problem != "" {
+				download.State = DownloadStateQueued
+				download.Error = "integrity check failed: " + problem
+				missing = append(missing, len(downloads))
+			} else {
+				download.Verified = m.verifications.isVerified(node.Id, model.id, model.commit)
 			}
+		}
+		downloads = append(downloads, download)
+	}
 
-		case mlnodeclient.ModelStatusDownloading:
-			logging.Debug("Model already downloading",
+	for _, i := range missing {
+		model := models[i]
+		modelId := model.id
+		if active >= maxConcurrent {
+			logging.Debug("Model download queued, node has no free download slot",
 				types.System,
 				"model", modelId,
-				"node_id", node.Id)
+				"node_id", node.Id,
+				"active_downloads", active)
+			continue
+		}
 
-		case mlnodeclient.ModelStatusDownloaded:
-			logging.Debug("Model already downloaded",
+		logging.Info("Pre-downloading model",
+			types.System,
+			"model", modelId,
+			"node_id", node.Id,
+			"priority", downloads[i].Priority,
+			"max_bandwidth_mbps", downloadOptions.MaxBandwidthMbps)
+
+		if _, err := client.DownloadModel(ctx, model.ref(), downloadOptions); err != nil {
+			logging.Warn("Failed to start model download",
 				types.System,
+				"node_id", node.Id,
 				"model", modelId,
-				"node_id", node.Id)
+				"error", err.Error())
+			downloads[i].State = DownloadStateFailed
+			downloads[i].Error = err.Error()
+			continue
 		}
+		downloads[i].State = DownloadStateDownloading
+		active++
 	}
+
+	m.downloads.setNode(node.Id, downloads)
+}
+
+// newNodeClient creates the ML node client for the node's configured transport and the current node version
+func (m *MLNodeBackgroundManager) newNodeClient(node apiconfig.InferenceNodeConfig) mlnodeclient.MLNodeClient {
+	version := m.configManager.GetCurrentNodeVersion()
+	inferenceUrl := getInferenceUrlWithVersion(node, version)
+	if node.UsesGrpc() {
+		grpcAddress := nodeaddr.Default.Address(node.Host, nodeaddr.ServiceGrpc, node.GrpcPort)
+		return m.mlNodeClientFactory.CreateGrpcClient(grpcAddress, version, inferenceUrl)
+	}
+	return m.mlNodeClientFactory.CreateClient(getPoCUrlWithVersion(node, version), inferenceUrl)
 }
 
 func getPoCUrlWithVersion(node apiconfig.InferenceNodeConfig, version string) string {
@@ -207,27 +280,31 @@ func getInferenceUrlWithVersion(node apiconfig.InferenceNodeConfig, version stri
 }
 
 func getPoCUrl(node apiconfig.InferenceNodeConfig) string {
-	return formatURL(node.Host, node.PoCPort, node.PoCSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServicePoC, node.PoCPort)
+	return formatURL(host, port, node.PoCSegment)
 }
 
 func getPoCUrlVersioned(node apiconfig.InferenceNodeConfig, version string) string {
-	return formatURLWithVersion(node.Host, node.PoCPort, version, node.PoCSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServicePoC, node.PoCPort)
+	return formatURLWithVersion(host, port, version, node.PoCSegment)
 }
 
 func getInferenceUrl(node apiconfig.InferenceNodeConfig) string {
-	return formatURL(node.Host, node.InferencePort, node.InferenceSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServiceInference, node.InferencePort)
+	return formatURL(host, port, node.InferenceSegment)
 }
 
 func getInferenceUrlVersioned(node apiconfig.InferenceNodeConfig, version string) string {
-	return formatURLWithVersion(node.Host, node.InferencePort, version, node.InferenceSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServiceInference, node.InferencePort)
+	return formatURLWithVersion(host, port, version, node.InferenceSegment)
 }
 
 func formatURL(host string, port int, segment string) string {
-	return fmt.Sprintf("http://%s:%d%s", host, port, segment)
+	return nodeaddr.FormatURL(host, port, "", segment)
 }
 
 func formatURLWithVersion(host string, port int, version string, segment string) string {
-	return fmt.Sprintf("http://%s:%d/%s%s", host, port, version, segment)
+	return nodeaddr.FormatURL(host, port, version, segment)
 }
 
 // checkAndUpdateGPUs fetches GPU info from all nodes and updates hardware
@@ -242,7 +319,7 @@ func (m *MLNodeBackgroundManager) checkAndUpdateGPUs(ctx context.Context) {
 	for i := range updatedNodes {
 		node := &updatedNodes[i]
 
-		hardware, err := m.fetchNodeGPUHardware(ctx, node)
+		devices, err := m.fetchNodeGPUDevices(ctx, node)
 		if err != nil {
 			var apiNotImplemented *mlnodeclient.ErrAPINotImplemented
 			if errors.As(err, &apiNotImplemented) {
@@ -253,9 +330,14 @@ func (m *MLNodeBackgroundManager) checkAndUpdateGPUs(ctx context.Context) {
 			continue
 		}
 
+		hardware := transformGPUDevicesToHardware(devices)
 		if len(hardware) == 0 {
 			continue
 		}
+		if attestation, ok := m.attestHardware(node, devices); ok {
+			// Relayed to the chain with the node's hardware, where it is verified against the worker key
+			hardware = append(hardware, attestation)
+		}
 
 		// Update config
 		node.Hardware = hardware
@@ -286,12 +368,34 @@ func (m *MLNodeBackgroundManager) checkAndUpdateGPUs(ctx context.Context) {
 	}
 }
 
-// fetchNodeGPUHardware fetches GPU devices and transforms to Hardware entries
-func (m *MLNodeBackgroundManager) fetchNodeGPUHardware(ctx context.Context, node *apiconfig.InferenceNodeConfig) ([]apiconfig.Hardware, error) {
-	version := m.configManager.GetCurrentNodeVersion()
-	pocUrl := getPoCUrlWithVersion(*node, version)
-	inferenceUrl := getInferenceUrlWithVersion(*node, version)
-	client := m.mlNodeClientFactory.CreateClient(pocUrl, inferenceUrl)
+// attestHardware records a signed capability report for the node, if a signer is configured, and returns
+// the Hardware entry relaying it. An unchanged report keeps its previous signature, so that the node's
+// hardware does not differ from the chain on every check.
+func (m *MLNodeBackgroundManager) attestHardware(node *apiconfig.InferenceNodeConfig, devices []mlnodeclient.GPUDevice) (apiconfig.Hardware, bool) {
+	if m.attestationSigner == nil {
+		return apiconfig.Hardware{}, false
+	}
+	attestation := newHardwareAttestation(node, devices, time.Now())
+	if err := m.attestationSigner.Sign(&attestation); err != nil {
+		logging.Warn("Failed to sign hardware attestation", types.Nodes, "node_id", node.Id, "error", err.Error())
+		return apiconfig.Hardware{}, false
+	}
+	if previous, found := m.attestations.get(node.Id); found && sameHardwareReport(previous, attestation) {
+		attestation = previous
+	}
+	m.attestations.set(attestation)
+
+	hw, err := types.AttestationHardware(attestation)
+	if err != nil {
+		logging.Warn("Failed to encode hardware attestation", types.Nodes, "node_id", node.Id, "error", err.Error())
+		return apiconfig.Hardware{}, false
+	}
+	return apiconfig.Hardware{Type: hw.Type, Count: hw.Count}, true
+}
+
+// fetchNodeGPUDevices fetches the GPU devices of a node
+func (m *MLNodeBackgroundManager) fetchNodeGPUDevices(ctx context.Context, node *apiconfig.InferenceNodeConfig) ([]mlnodeclient.GPUDevice, error) {
+	client := m.newNodeClient(*node)
 
 	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
@@ -301,7 +405,7 @@ func (m *MLNodeBackgroundManager) fetchNodeGPUHardware(ctx context.Context, node
 		return nil, err
 	}
 
-	return transformGPUDevicesToHardware(resp.Devices), nil
+	return resp.Devices, nil
 }
 
 // transformGPUDevicesToHardware groups GPUs by type and memory, returns Hardware list
@@ -6,10 +6,13 @@ import (
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/mlnodeclient"
+	"encoding/base64"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/productscience/inference/x/inference/types"
 )
 
@@ -38,6 +41,7 @@ func (m *mockConfigManager) SetNodes(nodes []apiconfig.InferenceNodeConfig) erro
 
 // Mock Broker
 type mockBroker struct {
+	nodes          []broker.NodeResponse
 	queuedCommands []broker.Command
 	queueError     error
 	executeError   error
@@ -50,6 +54,9 @@ func (m *mockBroker) QueueMessage(cmd broker.Command) error {
 	m.queuedCommands = append(m.queuedCommands, cmd)
 
 	// Execute command immediately for testing
+	if getNodesCmd, ok := cmd.(broker.GetNodesCommand); ok {
+		getNodesCmd.Response <- m.nodes
+	}
 	if updateCmd, ok := cmd.(broker.UpdateNodeHardwareCommand); ok {
 		if m.executeError != nil {
 			updateCmd.Response <- m.executeError
@@ -78,6 +85,10 @@ func (m *mockClientFactory) CreateClient(pocUrl, inferenceUrl string) mlnodeclie
 	return m.client
 }
 
+func (m *mockClientFactory) CreateGrpcClient(grpcAddress, version, inferenceUrl string) mlnodeclient.MLNodeClient {
+	return m.client
+}
+
 // Custom mock client for testing error handling
 type customMockClient struct {
 	*mlnodeclient.MockClient
@@ -257,7 +268,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		if mockClient.CheckModelStatusCalled != 1 {
 			t.Errorf("expected CheckModelStatus to be called once, got %d", mockClient.CheckModelStatusCalled)
@@ -305,7 +316,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		if mockClient.DownloadModelCalled != 1 {
 			t.Errorf("expected DownloadModel to be called once, got %d", mockClient.DownloadModelCalled)
@@ -347,7 +358,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		if mockClient.CheckModelStatusCalled != 1 {
 			t.Errorf("expected CheckModelStatus to be called once, got %d", mockClient.CheckModelStatusCalled)
@@ -395,7 +406,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		if mockClient.CheckModelStatusCalled != 1 {
 			t.Errorf("expected CheckModelStatus to be called once, got %d", mockClient.CheckModelStatusCalled)
@@ -441,7 +452,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		// Should only check once and then stop
 		if mockClient.CheckModelStatusCalled != 1 {
@@ -488,7 +499,7 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		// Should try checking both models despite first error
 		if mockClient.callCount != 2 {
@@ -538,15 +549,94 @@ func TestCheckNodeModels(t *testing.T) {
 			30*time.Minute,
 		)
 
-		manager.checkNodeModels(configMgr.nodes[0])
+		manager.checkNodeModels(configMgr.nodes[0], nil)
 
 		if mockClient.CheckModelStatusCalled != 3 {
 			t.Errorf("expected CheckModelStatus to be called 3 times, got %d", mockClient.CheckModelStatusCalled)
 		}
 
-		// Should download 2 models (model1 and model3 are NOT_FOUND)
-		if mockClient.DownloadModelCalled != 2 {
-			t.Errorf("expected DownloadModel to be called twice, got %d", mockClient.DownloadModelCalled)
+		// model1 and model3 are NOT_FOUND, only one download runs at a time per node by default
+		if mockClient.DownloadModelCalled != 1 {
+			t.Errorf("expected DownloadModel to be called once, got %d", mockClient.DownloadModelCalled)
+		}
+		if mockClient.LastModelDownload.HfRepo != "model1" {
+			t.Errorf("expected model1 to be downloaded first, got %s", mockClient.LastModelDownload.HfRepo)
+		}
+
+		states := make(map[string]DownloadState)
+		for _, download := range manager.ModelDownloads() {
+			states[download.Model] = download.State
+		}
+		expected := map[string]DownloadState{
+			"model1": DownloadStateDownloading,
+			"model2": DownloadStateDownloaded,
+			"model3": DownloadStateQueued,
+		}
+		if !reflect.DeepEqual(states, expected) {
+			t.Errorf("expected download states %v, got %v", expected, states)
+		}
+	})
+}
+
+func TestCheckNodeModels_Scheduling(t *testing.T) {
+	node := apiconfig.InferenceNodeConfig{
+		Id:               "node1",
+		Host:             "localhost",
+		PoCPort:          8080,
+		PoCSegment:       "/api",
+		InferencePort:    8081,
+		InferenceSegment: "/inference",
+		Models: map[string]apiconfig.ModelConfig{
+			"a-model": {Args: []string{}},
+			"b-model": {Args: []string{}},
+			"z-model": {Args: []string{}},
+		},
+	}
+
+	t.Run("current epoch models are downloaded first", func(t *testing.T) {
+		mockClient := mlnodeclient.NewMockClient()
+		manager := NewMLNodeBackgroundManager(&mockConfigManager{}, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+
+		manager.checkNodeModels(node, map[string]types.Model{"z-model": {Id: "z-model"}})
+
+		if mockClient.DownloadModelCalled != 1 {
+			t.Fatalf("expected DownloadModel to be called once, got %d", mockClient.DownloadModelCalled)
+		}
+		if mockClient.LastModelDownload.HfRepo != "z-model" {
+			t.Errorf("expected z-model to be downloaded first, got %s", mockClient.LastModelDownload.HfRepo)
+		}
+
+		downloads := manager.ModelDownloads()
+		if len(downloads) != 3 || downloads[0].Model != "z-model" || downloads[0].Priority != PriorityCurrentEpoch {
+			t.Errorf("expected z-model first with current epoch priority, got %+v", downloads)
+		}
+	})
+
+	t.Run("running downloads count against the node's slots", func(t *testing.T) {
+		mockClient := mlnodeclient.NewMockClient()
+		mockClient.DownloadingModels["b-model:latest"] = &mlnodeclient.DownloadProgress{ElapsedSeconds: 42}
+		manager := NewMLNodeBackgroundManager(&mockConfigManager{}, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+		manager.SetDownloadConfig(apiconfig.ModelDownloadConfig{MaxConcurrentPerNode: 2, MaxBandwidthMbps: 500})
+
+		manager.checkNodeModels(node, nil)
+
+		if mockClient.DownloadModelCalled != 1 {
+			t.Fatalf("expected DownloadModel to be called once, got %d", mockClient.DownloadModelCalled)
+		}
+		if mockClient.LastModelDownload.HfRepo != "a-model" {
+			t.Errorf("expected a-model to be downloaded, got %s", mockClient.LastModelDownload.HfRepo)
+		}
+		if mockClient.LastDownloadOptions.MaxBandwidthMbps != 250 {
+			t.Errorf("expected the bandwidth cap to be split across 2 slots, got %d", mockClient.LastDownloadOptions.MaxBandwidthMbps)
+		}
+
+		for _, download := range manager.ModelDownloads() {
+			if download.Model == "b-model" && download.ElapsedSeconds != 42 {
+				t.Errorf("expected b-model progress to be reported, got %+v", download)
+			}
+			if download.Model == "z-model" && download.State != DownloadStateQueued {
+				t.Errorf("expected z-model to be queued, got %s", download.State)
+			}
 		}
 	})
 }
@@ -795,6 +885,59 @@ func TestCheckAndUpdateGPUs(t *testing.T) {
 		}
 	})
 
+	t.Run("signed hardware attestation", func(t *testing.T) {
+		mem := int(81920)
+		mockClient := &mlnodeclient.MockClient{
+			GPUDevices: []mlnodeclient.GPUDevice{
+				{Name: "NVIDIA H100 80GB HBM3", TotalMemoryMB: &mem, IsAvailable: true},
+				{Name: "NVIDIA H100 80GB HBM3", TotalMemoryMB: &mem, IsAvailable: true},
+			},
+		}
+		configMgr := &mockConfigManager{
+			nodes: []apiconfig.InferenceNodeConfig{
+				{Id: "node1", Host: "localhost", PoCPort: 8080, Models: map[string]apiconfig.ModelConfig{"Qwen/QwQ-32B": {}}},
+			},
+		}
+
+		workerKey := ed25519.GenPrivKey()
+		signer, err := NewAttestationSigner("gonka1participant", apiconfig.MLNodeKeyConfig{
+			WorkerPrivateKey: base64.StdEncoding.EncodeToString(workerKey.Bytes()),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		manager := NewMLNodeBackgroundManager(configMgr, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+		manager.SetAttestationSigner(signer)
+		manager.checkAndUpdateGPUs(context.Background())
+
+		attestations := manager.HardwareAttestations()
+		if len(attestations) != 1 {
+			t.Fatalf("expected 1 attestation, got %d", len(attestations))
+		}
+		attestation := attestations[0]
+		if attestation.GpuCount != 2 || attestation.VRamGB != 160 || attestation.Participant != "gonka1participant" {
+			t.Errorf("unexpected attestation: %+v", attestation)
+		}
+		if err := attestation.Verify(base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes())); err != nil {
+			t.Errorf("attestation does not verify: %v", err)
+		}
+
+		// The attestation is relayed with the node's hardware and keeps its signature while unchanged
+		relayed := configMgr.nodes[0].Hardware
+		node := &types.HardwareNode{LocalId: "node1"}
+		for _, hw := range relayed {
+			node.Hardware = append(node.Hardware, &types.Hardware{Type: hw.Type, Count: hw.Count})
+		}
+		if _, found, err := types.VerifyNodeAttestation(node, "gonka1participant", base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes())); !found || err != nil {
+			t.Errorf("relayed attestation does not verify: found=%v, err=%v", found, err)
+		}
+		manager.checkAndUpdateGPUs(context.Background())
+		if !reflect.DeepEqual(relayed, configMgr.nodes[0].Hardware) {
+			t.Errorf("unchanged hardware was attested again: %v", configMgr.nodes[0].Hardware)
+		}
+	})
+
 	t.Run("ErrAPINotImplemented handling", func(t *testing.T) {
 		mockClient := &mlnodeclient.MockClient{
 			GetGPUDevicesError: &mlnodeclient.ErrAPINotImplemented{Endpoint: "/api/v1/gpu/devices"},
@@ -962,3 +1105,81 @@ func TestCheckAndUpdateGPUs(t *testing.T) {
 		}
 	})
 }
+
+func TestCheckNodeModels_Integrity(t *testing.T) {
+	commit := "abc123"
+	node := apiconfig.InferenceNodeConfig{
+		Id:               "node1",
+		Host:             "localhost",
+		PoCPort:          8080,
+		PoCSegment:       "/api",
+		InferencePort:    8081,
+		InferenceSegment: "/inference",
+		Models: map[string]apiconfig.ModelConfig{
+			"model1": {Args: []string{}},
+		},
+	}
+	epochModels := map[string]types.Model{"model1": {Id: "model1", HfRepo: "model1", HfCommit: commit}}
+	cached := mlnodeclient.ModelListItem{
+		Model:  mlnodeclient.Model{HfRepo: "model1", HfCommit: &commit},
+		Status: mlnodeclient.ModelStatusDownloaded,
+	}
+
+	t.Run("verified model is checked only once", func(t *testing.T) {
+		mockClient := mlnodeclient.NewMockClient()
+		mockClient.CachedModels["model1:"+commit] = cached
+		manager := NewMLNodeBackgroundManager(&mockConfigManager{}, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+
+		manager.checkNodeModels(node, epochModels)
+		manager.checkNodeModels(node, epochModels)
+
+		if mockClient.VerifyModelCalled != 1 {
+			t.Errorf("expected VerifyModel to be called once, got %d", mockClient.VerifyModelCalled)
+		}
+		if *mockClient.LastModelVerify.HfCommit != commit {
+			t.Errorf("expected verification against commit %s, got %s", commit, *mockClient.LastModelVerify.HfCommit)
+		}
+		downloads := manager.ModelDownloads()
+		if len(downloads) != 1 || !downloads[0].Verified || downloads[0].State != DownloadStateDownloaded {
+			t.Errorf("expected model1 to be downloaded and verified, got %+v", downloads)
+		}
+	})
+
+	t.Run("corrupted model is deleted and downloaded again", func(t *testing.T) {
+		mockClient := mlnodeclient.NewMockClient()
+		mockClient.CachedModels["model1:"+commit] = cached
+		mockClient.CorruptedModels["model1:"+commit] = []string{"model-00001-of-00002.safetensors"}
+		manager := NewMLNodeBackgroundManager(&mockConfigManager{}, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+
+		manager.checkNodeModels(node, epochModels)
+
+		if mockClient.DeleteModelCalled != 1 {
+			t.Errorf("expected DeleteModel to be called once, got %d", mockClient.DeleteModelCalled)
+		}
+		if mockClient.DownloadModelCalled != 1 {
+			t.Fatalf("expected DownloadModel to be called once, got %d", mockClient.DownloadModelCalled)
+		}
+		if *mockClient.LastModelDownload.HfCommit != commit {
+			t.Errorf("expected the declared commit to be downloaded, got %s", *mockClient.LastModelDownload.HfCommit)
+		}
+		downloads := manager.ModelDownloads()
+		if len(downloads) != 1 || downloads[0].State != DownloadStateDownloading || downloads[0].Error == "" {
+			t.Errorf("expected model1 to be re-downloading with the integrity error, got %+v", downloads)
+		}
+	})
+
+	t.Run("model without declared commit is not verified", func(t *testing.T) {
+		mockClient := mlnodeclient.NewMockClient()
+		mockClient.CachedModels["model1:latest"] = mlnodeclient.ModelListItem{
+			Model:  mlnodeclient.Model{HfRepo: "model1"},
+			Status: mlnodeclient.ModelStatusDownloaded,
+		}
+		manager := NewMLNodeBackgroundManager(&mockConfigManager{}, nil, &mockBroker{}, &mockClientFactory{client: mockClient}, 30*time.Minute)
+
+		manager.checkNodeModels(node, nil)
+
+		if mockClient.VerifyModelCalled != 0 {
+			t.Errorf("expected VerifyModel not to be called, got %d", mockClient.VerifyModelCalled)
+		}
+	})
+}
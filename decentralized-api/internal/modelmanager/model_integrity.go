@@ -0,0 +1,104 @@
+package modelmanager
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"decentralized-api/mlnodeclient"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// modelVerifyTimeout bounds a verification on the ML node, which hashes every file of the model
+const modelVerifyTimeout = 5 * time.Minute
+
+// modelVerifications remembers the commit each model was verified at per node, so a downloaded
+// revision is only hashed once. Only used from the background loop.
+type modelVerifications struct {
+	verified map[string]string
+}
+
+func verificationKey(nodeId, modelId string) string {
+	return nodeId + "/" + modelId
+}
+
+func (v *modelVerifications) isVerified(nodeId, modelId, commit string) bool {
+	verifiedCommit, ok := v.verified[verificationKey(nodeId, modelId)]
+	return ok && verifiedCommit == commit
+}
+
+func (v *modelVerifications) markVerified(nodeId, modelId, commit string) {
+	if v.verified == nil {
+		v.verified = make(map[string]string)
+	}
+	v.verified[verificationKey(nodeId, modelId)] = commit
+}
+
+func (v *modelVerifications) forget(nodeId, modelId string) {
+	delete(v.verified, verificationKey(nodeId, modelId))
+}
+
+// verifyDownloadedModel checks a downloaded model against its governance-declared HfCommit.
+// Returns a description of the problem when the node has a corrupted or mismatched copy; that copy
+// is deleted from the node's cache so the model is downloaded again. Returns "" when the model is
+// fine, has no declared commit or the node can't verify models.
+func (m *MLNodeBackgroundManager) verifyDownloadedModel(client mlnodeclient.MLNodeClient, node apiconfig.InferenceNodeConfig, model prioritizedModel) (string, error) {
+	if model.commit == "" || m.verifications.isVerified(node.Id, model.id, model.commit) {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), modelVerifyTimeout)
+	defer cancel()
+
+	resp, err := client.VerifyModel(ctx, model.ref())
+	if err != nil {
+		var apiNotImplemented *mlnodeclient.ErrAPINotImplemented
+		if errors.As(err, &apiNotImplemented) {
+			logging.Debug("Model verify endpoint not available", types.Nodes, "node_id", node.Id)
+			return "", nil
+		}
+		return "", err
+	}
+
+	problem := integrityProblem(resp, model.commit)
+	if problem == "" {
+		m.verifications.markVerified(node.Id, model.id, model.commit)
+		logging.Info("Model integrity verified", types.Nodes, "node_id", node.Id, "model", model.id, "commit", model.commit)
+		return "", nil
+	}
+
+	logging.Error("Model integrity check failed, re-downloading", types.Nodes,
+		"node_id", node.Id,
+		"model", model.id,
+		"expected_commit", model.commit,
+		"cached_commit", resp.Commit,
+		"mismatched_files", resp.MismatchedFiles,
+		"problem", problem)
+
+	m.verifications.forget(node.Id, model.id)
+	if _, err := client.DeleteModel(ctx, model.ref()); err != nil {
+		logging.Warn("Failed to delete corrupted model", types.Nodes, "node_id", node.Id, "model", model.id, "error", err.Error())
+	}
+	return problem, nil
+}
+
+// integrityProblem describes why a verification result doesn't match the expected commit, "" if it does
+func integrityProblem(resp *mlnodeclient.ModelVerifyResponse, expectedCommit string) string {
+	if resp.Commit != "" && resp.Commit != expectedCommit {
+		return fmt.Sprintf("cached commit %s does not match declared commit %s", resp.Commit, expectedCommit)
+	}
+	if resp.Valid {
+		return ""
+	}
+	if len(resp.MismatchedFiles) > 0 {
+		return "checksum mismatch in " + strings.Join(resp.MismatchedFiles, ", ")
+	}
+	if resp.ErrorMessage != nil {
+		return *resp.ErrorMessage
+	}
+	return "model files failed verification"
+}
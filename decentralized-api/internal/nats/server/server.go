@@ -17,6 +17,7 @@ const (
 	TxsBatchStartStream        = "txs_batch_start"
 	TxsBatchFinishStream       = "txs_batch_finish"
 	TxsBatchValidationV2Stream = "txs_batch_validation_v2"
+	TxsBatchValidationStream   = "txs_batch_validation"
 
 	// V1 PoC batching streams
 	TxsBatchPocBatchStream      = "txs_batch_poc_batch"
@@ -90,6 +91,7 @@ func (s *server) Start() error {
 		TxsBatchStartStream,
 		TxsBatchFinishStream,
 		TxsBatchValidationV2Stream,
+		TxsBatchValidationStream,
 		TxsBatchPocBatchStream,
 		TxsBatchPocValidationStream,
 	})
@@ -0,0 +1,180 @@
+// Package nodeaddr turns the host configured for an ML node into a dialable address.
+//
+// Supported host forms:
+//   - hostname or IPv4 literal: "mlnode-1", "10.0.0.5"
+//   - IPv6 literal, with or without brackets: "fd00::5", "[fd00::5]"
+//   - DNS SRV: "srv+mlnode.example.com" resolves _inference._tcp.mlnode.example.com,
+//     _poc._tcp.mlnode.example.com and _grpc._tcp.mlnode.example.com; the configured ports are
+//     used when a record is missing
+//
+// SRV answers are cached per node and re-resolved when the TTL expires or a connection fails.
+package nodeaddr
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SrvPrefix marks a host that is resolved through DNS SRV records
+const SrvPrefix = "srv+"
+
+const (
+	defaultTTL    = time.Minute
+	lookupTimeout = 5 * time.Second
+)
+
+type Service string
+
+const (
+	ServiceInference Service = "inference"
+	ServicePoC       Service = "poc"
+	ServiceGrpc      Service = "grpc"
+)
+
+type lookupSRVFunc func(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+
+type srvEntry struct {
+	host      string
+	port      int
+	expiresAt time.Time
+}
+
+// Resolver resolves node hosts and caches SRV answers
+type Resolver struct {
+	lookupSRV lookupSRVFunc
+	ttl       time.Duration
+
+	mu      sync.Mutex
+	entries map[string]srvEntry
+}
+
+// Default is the resolver shared by the broker and ML node clients
+var Default = NewResolver(net.DefaultResolver.LookupSRV, defaultTTL)
+
+func NewResolver(lookupSRV lookupSRVFunc, ttl time.Duration) *Resolver {
+	return &Resolver{
+		lookupSRV: lookupSRV,
+		ttl:       ttl,
+		entries:   make(map[string]srvEntry),
+	}
+}
+
+// IsSrv reports whether the host is resolved through DNS SRV records
+func IsSrv(host string) bool {
+	return strings.HasPrefix(strings.TrimSpace(host), SrvPrefix)
+}
+
+// NormalizeHost trims whitespace and the brackets around IPv6 literals
+func NormalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		return host[1 : len(host)-1]
+	}
+	return host
+}
+
+// FormatURL builds an http URL, bracketing IPv6 literals
+func FormatURL(host string, port int, version string, segment string) string {
+	hostPort := net.JoinHostPort(NormalizeHost(host), strconv.Itoa(port))
+	if version == "" {
+		return fmt.Sprintf("http://%s%s", hostPort, segment)
+	}
+	return fmt.Sprintf("http://%s/%s%s", hostPort, version, segment)
+}
+
+// URL resolves the host for the given service and builds its URL
+func (r *Resolver) URL(host string, service Service, port int, version string, segment string) string {
+	resolvedHost, resolvedPort := r.Resolve(host, service, port)
+	return FormatURL(resolvedHost, resolvedPort, version, segment)
+}
+
+// Address resolves the host for the given service and returns it as host:port, for non-HTTP clients
+func (r *Resolver) Address(host string, service Service, port int) string {
+	resolvedHost, resolvedPort := r.Resolve(host, service, port)
+	return net.JoinHostPort(NormalizeHost(resolvedHost), strconv.Itoa(resolvedPort))
+}
+
+// Resolve returns the host and port to dial for a node service.
+// Non-SRV hosts are returned as is (minus IPv6 brackets) and left to the system resolver.
+func (r *Resolver) Resolve(host string, service Service, port int) (string, int) {
+	host = NormalizeHost(host)
+	if !IsSrv(host) {
+		return host, port
+	}
+
+	name := strings.TrimPrefix(host, SrvPrefix)
+	if entry, ok := r.lookup(name, service); ok {
+		return entry.host, entry.port
+	}
+	// No SRV record available: fall back to the domain itself with the configured port
+	return name, port
+}
+
+// InvalidateAddress drops cached SRV answers that resolved to hostPort, so the next
+// Resolve call performs a fresh lookup. Used when a connection to a node fails.
+func (r *Resolver) InvalidateAddress(hostPort string) {
+	host, portStr, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, entry := range r.entries {
+		if entry.host == host && entry.port == port {
+			logging.Info("Invalidating cached SRV answer after connection failure", types.Nodes, "record", key, "address", hostPort)
+			delete(r.entries, key)
+		}
+	}
+}
+
+func (r *Resolver) lookup(name string, service Service) (srvEntry, bool) {
+	key := fmt.Sprintf("_%s._tcp.%s", service, name)
+
+	r.mu.Lock()
+	cached, ok := r.entries[key]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached, true
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+	defer cancel()
+	_, addrs, err := r.lookupSRV(ctx, string(service), "tcp", name)
+	if err != nil || len(addrs) == 0 {
+		if ok {
+			// Keep serving the stale answer rather than losing the node on a transient DNS error
+			logging.Warn("SRV lookup failed, using stale answer", types.Nodes, "record", key, "error", err)
+			return cached, true
+		}
+		logging.Warn("SRV lookup failed", types.Nodes, "record", key, "error", err)
+		return srvEntry{}, false
+	}
+
+	// Records are sorted by priority and shuffled by weight by the resolver
+	entry := srvEntry{
+		host:      strings.TrimSuffix(addrs[0].Target, "."),
+		port:      int(addrs[0].Port),
+		expiresAt: time.Now().Add(r.ttl),
+	}
+	r.mu.Lock()
+	r.entries[key] = entry
+	r.mu.Unlock()
+
+	if !ok || cached.host != entry.host || cached.port != entry.port {
+		logging.Info("Resolved SRV record", types.Nodes, "record", key, "host", entry.host, "port", entry.port)
+	}
+	return entry, true
+}
@@ -0,0 +1,112 @@
+package nodeaddr
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDNS struct {
+	records map[string][]*net.SRV
+	err     error
+	calls   int
+}
+
+func (f *fakeDNS) lookup(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	f.calls++
+	if f.err != nil {
+		return "", nil, f.err
+	}
+	key := "_" + service + "._" + proto + "." + name
+	return key, f.records[key], nil
+}
+
+func TestFormatURL(t *testing.T) {
+	require.Equal(t, "http://localhost:8080/api/v1", FormatURL("localhost", 8080, "", "/api/v1"))
+	require.Equal(t, "http://localhost:8080/v2/api/v1", FormatURL("localhost", 8080, "v2", "/api/v1"))
+	require.Equal(t, "http://[fd00::5]:8080/api/v1", FormatURL("fd00::5", 8080, "", "/api/v1"))
+	require.Equal(t, "http://[fd00::5]:8080/v2/api/v1", FormatURL("[fd00::5]", 8080, "v2", "/api/v1"))
+}
+
+func TestResolve_PlainHost(t *testing.T) {
+	dns := &fakeDNS{}
+	r := NewResolver(dns.lookup, time.Minute)
+
+	host, port := r.Resolve(" [::1] ", ServicePoC, 8080)
+	require.Equal(t, "::1", host)
+	require.Equal(t, 8080, port)
+	require.Zero(t, dns.calls)
+}
+
+func TestResolve_Srv(t *testing.T) {
+	dns := &fakeDNS{records: map[string][]*net.SRV{
+		"_inference._tcp.mlnode.example.com": {{Target: "node-a.example.com.", Port: 5000}},
+		"_poc._tcp.mlnode.example.com":       {{Target: "node-a.example.com.", Port: 8080}},
+	}}
+	r := NewResolver(dns.lookup, time.Minute)
+
+	require.Equal(t, "http://node-a.example.com:5000/v1", r.URL("srv+mlnode.example.com", ServiceInference, 0, "", "/v1"))
+	require.Equal(t, "http://node-a.example.com:8080/v3/api", r.URL("srv+mlnode.example.com", ServicePoC, 0, "v3", "/api"))
+
+	// Cached answers are reused within the TTL
+	r.URL("srv+mlnode.example.com", ServicePoC, 0, "", "")
+	require.Equal(t, 2, dns.calls)
+}
+
+func TestAddress(t *testing.T) {
+	dns := &fakeDNS{records: map[string][]*net.SRV{
+		"_grpc._tcp.mlnode.example.com": {{Target: "node-a.example.com.", Port: 9100}},
+	}}
+	r := NewResolver(dns.lookup, time.Minute)
+
+	require.Equal(t, "node-a.example.com:9100", r.Address("srv+mlnode.example.com", ServiceGrpc, 0))
+	require.Equal(t, "[fd00::5]:9100", r.Address("[fd00::5]", ServiceGrpc, 9100))
+}
+
+func TestResolve_SrvMissingRecordFallsBackToConfiguredPort(t *testing.T) {
+	dns := &fakeDNS{records: map[string][]*net.SRV{}}
+	r := NewResolver(dns.lookup, time.Minute)
+
+	host, port := r.Resolve("srv+mlnode.example.com", ServicePoC, 8081)
+	require.Equal(t, "mlnode.example.com", host)
+	require.Equal(t, 8081, port)
+}
+
+func TestResolve_SrvStaleAnswerOnLookupFailure(t *testing.T) {
+	dns := &fakeDNS{records: map[string][]*net.SRV{
+		"_poc._tcp.mlnode.example.com": {{Target: "node-a.example.com.", Port: 8080}},
+	}}
+	r := NewResolver(dns.lookup, 0)
+
+	host, _ := r.Resolve("srv+mlnode.example.com", ServicePoC, 0)
+	require.Equal(t, "node-a.example.com", host)
+
+	dns.err = errors.New("dns unavailable")
+	host, port := r.Resolve("srv+mlnode.example.com", ServicePoC, 0)
+	require.Equal(t, "node-a.example.com", host)
+	require.Equal(t, 8080, port)
+}
+
+func TestInvalidateAddress(t *testing.T) {
+	dns := &fakeDNS{records: map[string][]*net.SRV{
+		"_poc._tcp.mlnode.example.com": {{Target: "node-a.example.com.", Port: 8080}},
+	}}
+	r := NewResolver(dns.lookup, time.Hour)
+
+	r.Resolve("srv+mlnode.example.com", ServicePoC, 0)
+	dns.records["_poc._tcp.mlnode.example.com"] = []*net.SRV{{Target: "node-b.example.com.", Port: 9090}}
+
+	// Unrelated addresses keep the cache intact
+	r.InvalidateAddress("node-c.example.com:8080")
+	host, _ := r.Resolve("srv+mlnode.example.com", ServicePoC, 0)
+	require.Equal(t, "node-a.example.com", host)
+
+	r.InvalidateAddress("node-a.example.com:8080")
+	host, port := r.Resolve("srv+mlnode.example.com", ServicePoC, 0)
+	require.Equal(t, "node-b.example.com", host)
+	require.Equal(t, 9090, port)
+}
@@ -0,0 +1,135 @@
+package onboarding
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/x/inference"
+)
+
+// gasPerGrant is the gas limit set per MsgGrant in the unsigned transaction
+const gasPerGrant = 30000
+
+// Chain builds the authz grant transaction for the granter to sign and broadcasts it once signed
+type Chain interface {
+	// UnsignedGrantTx returns the unsigned transaction, as JSON, that grants the grantee the operational permissions
+	UnsignedGrantTx(granter, grantee string, expiration time.Time) ([]byte, error)
+	// DecodeGrantTx decodes a signed transaction and checks that it only grants permissions from granter to grantee
+	DecodeGrantTx(signedTx []byte, granter, grantee string) error
+	// Broadcast submits a signed transaction and returns its hash
+	Broadcast(ctx context.Context, signedTx []byte) (string, error)
+	// TxResult reports whether the transaction is in a block and, if so, its result code and log
+	TxResult(ctx context.Context, txHash string) (included bool, code uint32, log string, err error)
+}
+
+// Permissions lists the message types the grantee is granted, the same as an ML operational key
+func Permissions() []string {
+	urls := make([]string, 0, len(inference.InferenceOperationKeyPerms))
+	for _, msg := range inference.InferenceOperationKeyPerms {
+		urls = append(urls, sdk.MsgTypeURL(msg))
+	}
+	return urls
+}
+
+type cosmosChain struct {
+	clientCtx client.Context
+}
+
+func NewChain(clientCtx client.Context) Chain {
+	authz.RegisterInterfaces(clientCtx.InterfaceRegistry)
+	return &cosmosChain{clientCtx: clientCtx}
+}
+
+func grantMsgs(granter, grantee string, expiration time.Time) ([]sdk.Msg, error) {
+	permissions := Permissions()
+	msgs := make([]sdk.Msg, 0, len(permissions))
+	for _, msgType := range permissions {
+		grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization(msgType), &expiration)
+		if err != nil {
+			return nil, fmt.Errorf("grant %s: %w", msgType, err)
+		}
+		msgs = append(msgs, &authz.MsgGrant{Granter: granter, Grantee: grantee, Grant: grant})
+	}
+	return msgs, nil
+}
+
+func (c *cosmosChain) UnsignedGrantTx(granter, grantee string, expiration time.Time) ([]byte, error) {
+	msgs, err := grantMsgs(granter, grantee, expiration)
+	if err != nil {
+		return nil, err
+	}
+	builder := c.clientCtx.TxConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	builder.SetGasLimit(uint64(gasPerGrant * len(msgs)))
+	return c.clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+}
+
+func (c *cosmosChain) DecodeGrantTx(signedTx []byte, granter, grantee string) error {
+	tx, err := c.clientCtx.TxConfig.TxDecoder()(signedTx)
+	if err != nil {
+		return fmt.Errorf("decode transaction: %w", err)
+	}
+	return checkGrantMsgs(tx.GetMsgs(), granter, grantee)
+}
+
+// checkGrantMsgs accepts only generic grants of the operational permissions from granter to grantee,
+// so the API never broadcasts anything else on behalf of a client
+func checkGrantMsgs(msgs []sdk.Msg, granter, grantee string) error {
+	if len(msgs) == 0 {
+		return fmt.Errorf("transaction has no messages")
+	}
+	allowed := make(map[string]bool)
+	for _, msgType := range Permissions() {
+		allowed[msgType] = true
+	}
+	for _, msg := range msgs {
+		grant, ok := msg.(*authz.MsgGrant)
+		if !ok {
+			return fmt.Errorf("unexpected message %s, only %s is accepted", sdk.MsgTypeURL(msg), sdk.MsgTypeURL(&authz.MsgGrant{}))
+		}
+		if grant.Granter != granter || grant.Grantee != grantee {
+			return fmt.Errorf("grant from %s to %s does not match the registration", grant.Granter, grant.Grantee)
+		}
+		authorization, err := grant.Grant.GetAuthorization()
+		if err != nil {
+			return err
+		}
+		generic, ok := authorization.(*authz.GenericAuthorization)
+		if !ok || !allowed[generic.Msg] {
+			return fmt.Errorf("authorization %s is not an operational permission", authorization.MsgTypeURL())
+		}
+	}
+	return nil
+}
+
+func (c *cosmosChain) Broadcast(ctx context.Context, signedTx []byte) (string, error) {
+	res, err := c.clientCtx.WithBroadcastMode(flags.BroadcastSync).BroadcastTx(signedTx)
+	if err != nil {
+		return "", err
+	}
+	if res.Code != 0 {
+		return "", fmt.Errorf("transaction rejected with code %d: %s", res.Code, res.RawLog)
+	}
+	return res.TxHash, nil
+}
+
+func (c *cosmosChain) TxResult(ctx context.Context, txHash string) (bool, uint32, string, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return false, 0, "", err
+	}
+	res, err := c.clientCtx.Client.Tx(ctx, hash, false)
+	if err != nil {
+		// Not found until the transaction is in a block
+		return false, 0, "", nil
+	}
+	return true, res.TxResult.Code, res.TxResult.Log, nil
+}
@@ -0,0 +1,322 @@
+package onboarding
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/logging"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Status of a registration. A registration moves from pending_approval to approved when an admin approves
+// it, to submitted once the granter's signed grant transaction is broadcast, and to confirmed when that
+// transaction succeeds on chain. A failed transaction can be resubmitted.
+type Status string
+
+const (
+	StatusPendingApproval Status = "pending_approval"
+	StatusApproved        Status = "approved"
+	StatusRejected        Status = "rejected"
+	StatusSubmitted       Status = "submitted"
+	StatusConfirmed       Status = "confirmed"
+	StatusFailed          Status = "failed"
+)
+
+var (
+	ErrRegistrationNotFound = errors.New("registration not found")
+	ErrInvalidRegistration  = errors.New("invalid registration")
+	ErrInvalidStatus        = errors.New("registration is not in a status that allows this")
+	ErrInvalidGrantTx       = errors.New("invalid grant transaction")
+)
+
+// Registration onboards a grantee account: once confirmed, GranterAddress has granted GranteeAddress
+// the operational permissions (see Permissions)
+type Registration struct {
+	Id             string    `json:"id"`
+	GranterAddress string    `json:"granter_address"`
+	GranteeAddress string    `json:"grantee_address"`
+	GranteePubKey  string    `json:"grantee_pub_key"`
+	KeyGenerated   bool      `json:"key_generated"` // the grantee key was generated by this API
+	Status         Status    `json:"status"`
+	TxHash         string    `json:"tx_hash,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	ApiKeyId       string    `json:"api_key_id,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+
+	tokenHash string
+}
+
+// Secrets are returned once, when a registration is created. Only the hash of the token is stored,
+// and a generated private key is not stored at all.
+type Secrets struct {
+	Token             string `json:"token"`
+	GranteePrivateKey string `json:"grantee_private_key,omitempty"` // hex secp256k1, only when the key was generated
+}
+
+// Credentials are what the grantee needs to use its account against this API. The API key is only
+// set in the response that observes the confirmation.
+type Credentials struct {
+	GranterAddress string   `json:"granter_address"`
+	GranteeAddress string   `json:"grantee_address"`
+	GranteePubKey  string   `json:"grantee_pub_key"`
+	ChainId        string   `json:"chain_id"`
+	Permissions    []string `json:"permissions"`
+	ApiKey         string   `json:"api_key,omitempty"`
+}
+
+type Config struct {
+	AddressPrefix   string
+	ChainId         string
+	AutoApprove     bool
+	GrantExpiration time.Duration
+}
+
+// Manager runs the onboarding flow of grantee accounts
+type Manager struct {
+	mu      sync.Mutex
+	store   registrationStore
+	chain   Chain
+	apiKeys *apikeys.Manager // optional, issues an API key on confirmation
+	cfg     Config
+	now     func() time.Time
+}
+
+func NewManager(db *sql.DB, chain Chain, apiKeys *apikeys.Manager, cfg Config) *Manager {
+	return newManager(newSqlRegistrationStore(db), chain, apiKeys, cfg)
+}
+
+func newManager(store registrationStore, chain Chain, apiKeys *apikeys.Manager, cfg Config) *Manager {
+	return &Manager{store: store, chain: chain, apiKeys: apiKeys, cfg: cfg, now: time.Now}
+}
+
+// Register creates a registration for the granter. An empty granteePubKey (base64 secp256k1) makes the
+// API generate the grantee key and return its private key in the secrets.
+func (m *Manager) Register(ctx context.Context, granterAddress, granteePubKey string) (Registration, Secrets, error) {
+	if _, err := sdk.GetFromBech32(granterAddress, m.cfg.AddressPrefix); err != nil {
+		return Registration{}, Secrets{}, fmt.Errorf("%w: granter address: %v", ErrInvalidRegistration, err)
+	}
+	var secrets Secrets
+	var pubKey secp256k1.PubKey
+	if granteePubKey == "" {
+		privKey := secp256k1.GenPrivKey()
+		pubKey = secp256k1.PubKey{Key: privKey.PubKey().Bytes()}
+		secrets.GranteePrivateKey = hex.EncodeToString(privKey.Bytes())
+	} else {
+		bz, err := base64.StdEncoding.DecodeString(granteePubKey)
+		if err != nil || len(bz) != secp256k1.PubKeySize {
+			return Registration{}, Secrets{}, fmt.Errorf("%w: grantee pub key must be a base64 compressed secp256k1 key", ErrInvalidRegistration)
+		}
+		pubKey = secp256k1.PubKey{Key: bz}
+	}
+	granteeAddress, err := sdk.Bech32ifyAddressBytes(m.cfg.AddressPrefix, pubKey.Address())
+	if err != nil {
+		return Registration{}, Secrets{}, err
+	}
+	if granteeAddress == granterAddress {
+		return Registration{}, Secrets{}, fmt.Errorf("%w: granter and grantee are the same account", ErrInvalidRegistration)
+	}
+
+	id, err := randomHex(8)
+	if err != nil {
+		return Registration{}, Secrets{}, err
+	}
+	if secrets.Token, err = randomHex(24); err != nil {
+		return Registration{}, Secrets{}, err
+	}
+	now := m.now().UTC().Truncate(time.Second)
+	reg := Registration{
+		Id:             id,
+		GranterAddress: granterAddress,
+		GranteeAddress: granteeAddress,
+		GranteePubKey:  base64.StdEncoding.EncodeToString(pubKey.Key),
+		KeyGenerated:   secrets.GranteePrivateKey != "",
+		Status:         StatusPendingApproval,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+		tokenHash:      hashToken(secrets.Token),
+	}
+	if m.cfg.AutoApprove {
+		reg.Status = StatusApproved
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.store.Save(ctx, reg); err != nil {
+		return Registration{}, Secrets{}, err
+	}
+	logging.Info("Onboarding registration created", types.Participants, "id", reg.Id, "granter", granterAddress, "grantee", granteeAddress)
+	return reg, secrets, nil
+}
+
+// List returns all registrations, oldest first
+func (m *Manager) List(ctx context.Context) ([]Registration, error) {
+	return m.store.List(ctx)
+}
+
+// Approve lets the client of a pending registration submit its grant transaction
+func (m *Manager) Approve(ctx context.Context, id string) (Registration, error) {
+	return m.transition(ctx, id, StatusPendingApproval, func(reg *Registration) { reg.Status = StatusApproved })
+}
+
+func (m *Manager) Reject(ctx context.Context, id, reason string) (Registration, error) {
+	return m.transition(ctx, id, StatusPendingApproval, func(reg *Registration) {
+		reg.Status = StatusRejected
+		reg.Error = reason
+	})
+}
+
+func (m *Manager) transition(ctx context.Context, id string, from Status, update func(*Registration)) (Registration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reg, err := m.store.Get(ctx, id)
+	if err != nil {
+		return Registration{}, err
+	}
+	if reg.Status != from {
+		return Registration{}, fmt.Errorf("%w: %s", ErrInvalidStatus, reg.Status)
+	}
+	update(&reg)
+	reg.UpdatedAt = m.now().UTC().Truncate(time.Second)
+	if err := m.store.Save(ctx, reg); err != nil {
+		return Registration{}, err
+	}
+	logging.Info("Onboarding registration updated", types.Participants, "id", id, "status", reg.Status)
+	return reg, nil
+}
+
+// authenticate returns the registration when token is the one issued with it. A wrong token is reported
+// as not found, so registrations of other clients can't be probed.
+func (m *Manager) authenticate(ctx context.Context, id, token string) (Registration, error) {
+	reg, err := m.store.Get(ctx, id)
+	if err != nil {
+		return Registration{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(reg.tokenHash), []byte(hashToken(token))) != 1 {
+		return Registration{}, ErrRegistrationNotFound
+	}
+	return reg, nil
+}
+
+// UnsignedGrantTx returns the transaction the granter signs, e.g. with `inferenced tx sign`, once the
+// registration is approved
+func (m *Manager) UnsignedGrantTx(ctx context.Context, id, token string) ([]byte, error) {
+	reg, err := m.authenticate(ctx, id, token)
+	if err != nil {
+		return nil, err
+	}
+	if reg.Status != StatusApproved && reg.Status != StatusFailed {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidStatus, reg.Status)
+	}
+	return m.chain.UnsignedGrantTx(reg.GranterAddress, reg.GranteeAddress, m.now().Add(m.cfg.GrantExpiration))
+}
+
+// SubmitGrantTx broadcasts the granter's signed grant transaction
+func (m *Manager) SubmitGrantTx(ctx context.Context, id, token string, signedTx []byte) (Registration, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reg, err := m.authenticate(ctx, id, token)
+	if err != nil {
+		return Registration{}, err
+	}
+	if reg.Status != StatusApproved && reg.Status != StatusFailed {
+		return Registration{}, fmt.Errorf("%w: %s", ErrInvalidStatus, reg.Status)
+	}
+	if err := m.chain.DecodeGrantTx(signedTx, reg.GranterAddress, reg.GranteeAddress); err != nil {
+		return Registration{}, fmt.Errorf("%w: %v", ErrInvalidGrantTx, err)
+	}
+	txHash, err := m.chain.Broadcast(ctx, signedTx)
+	if err != nil {
+		return Registration{}, fmt.Errorf("%w: %v", ErrInvalidGrantTx, err)
+	}
+	reg.Status = StatusSubmitted
+	reg.TxHash = txHash
+	reg.Error = ""
+	reg.UpdatedAt = m.now().UTC().Truncate(time.Second)
+	if err := m.store.Save(ctx, reg); err != nil {
+		return Registration{}, err
+	}
+	logging.Info("Onboarding grant transaction submitted", types.Participants, "id", id, "txHash", txHash)
+	return reg, nil
+}
+
+// Poll returns the registration, checking a submitted grant transaction on chain. The credentials are
+// set once the registration is confirmed; the API key only in the response that confirms it.
+func (m *Manager) Poll(ctx context.Context, id, token string) (Registration, *Credentials, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	reg, err := m.authenticate(ctx, id, token)
+	if err != nil {
+		return Registration{}, nil, err
+	}
+	var apiKey string
+	if reg.Status == StatusSubmitted {
+		included, code, log, err := m.chain.TxResult(ctx, reg.TxHash)
+		if err != nil {
+			return Registration{}, nil, err
+		}
+		if included {
+			if apiKey, err = m.confirm(ctx, &reg, code, log); err != nil {
+				return Registration{}, nil, err
+			}
+		}
+	}
+	if reg.Status != StatusConfirmed {
+		return reg, nil, nil
+	}
+	return reg, &Credentials{
+		GranterAddress: reg.GranterAddress,
+		GranteeAddress: reg.GranteeAddress,
+		GranteePubKey:  reg.GranteePubKey,
+		ChainId:        m.cfg.ChainId,
+		Permissions:    Permissions(),
+		ApiKey:         apiKey,
+	}, nil
+}
+
+func (m *Manager) confirm(ctx context.Context, reg *Registration, code uint32, log string) (string, error) {
+	var apiKey string
+	if code != 0 {
+		reg.Status = StatusFailed
+		reg.Error = fmt.Sprintf("transaction failed with code %d: %s", code, log)
+		logging.Warn("Onboarding grant transaction failed", types.Participants, "id", reg.Id, "txHash", reg.TxHash, "code", code)
+	} else {
+		reg.Status = StatusConfirmed
+		if m.apiKeys != nil {
+			key, secret, err := m.apiKeys.Create(ctx, "onboarding-"+reg.GranteeAddress, apikeys.Limits{})
+			if err != nil {
+				return "", err
+			}
+			reg.ApiKeyId = key.Id
+			apiKey = secret
+		}
+		logging.Info("Onboarding registration confirmed", types.Participants, "id", reg.Id, "grantee", reg.GranteeAddress)
+	}
+	reg.UpdatedAt = m.now().UTC().Truncate(time.Second)
+	return apiKey, m.store.Save(ctx, *reg)
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,178 @@
+package onboarding
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/internal/apikeys"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testGranter = testAddress(1)
+	testGrantee = testAddress(2)
+)
+
+func testAddress(b byte) string {
+	addr, err := sdk.Bech32ifyAddressBytes("gonka", bytes.Repeat([]byte{b}, 20))
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+type fakeChain struct {
+	broadcast []byte
+	included  bool
+	code      uint32
+}
+
+func (f *fakeChain) UnsignedGrantTx(granter, grantee string, expiration time.Time) ([]byte, error) {
+	return []byte(`{"granter":"` + granter + `","grantee":"` + grantee + `"}`), nil
+}
+
+func (f *fakeChain) DecodeGrantTx(signedTx []byte, granter, grantee string) error {
+	if string(signedTx) != "signed" {
+		return errors.New("not signed")
+	}
+	return nil
+}
+
+func (f *fakeChain) Broadcast(ctx context.Context, signedTx []byte) (string, error) {
+	f.broadcast = signedTx
+	return "ABCD", nil
+}
+
+func (f *fakeChain) TxResult(ctx context.Context, txHash string) (bool, uint32, string, error) {
+	return f.included, f.code, "log", nil
+}
+
+func newTestManager(t *testing.T, chain Chain, cfg Config) (*Manager, *apikeys.Manager) {
+	t.Helper()
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	require.NoError(t, apiconfig.EnsureSchema(context.Background(), db))
+	keys, err := apikeys.NewManager(context.Background(), db)
+	require.NoError(t, err)
+	cfg.AddressPrefix = "gonka"
+	cfg.ChainId = "gonka-test"
+	cfg.GrantExpiration = time.Hour
+	return NewManager(db, chain, keys, cfg), keys
+}
+
+func TestOnboardingFlow(t *testing.T) {
+	ctx := context.Background()
+	chain := &fakeChain{}
+	m, keys := newTestManager(t, chain, Config{})
+
+	_, _, err := m.Register(ctx, "not-an-address", "")
+	require.ErrorIs(t, err, ErrInvalidRegistration)
+
+	reg, secrets, err := m.Register(ctx, testGranter, "")
+	require.NoError(t, err)
+	require.Equal(t, StatusPendingApproval, reg.Status)
+	require.True(t, reg.KeyGenerated)
+	require.NotEmpty(t, secrets.GranteePrivateKey)
+	require.Contains(t, reg.GranteeAddress, "gonka1")
+
+	// Nothing can be signed before an admin approves
+	_, err = m.UnsignedGrantTx(ctx, reg.Id, secrets.Token)
+	require.ErrorIs(t, err, ErrInvalidStatus)
+	_, err = m.Approve(ctx, reg.Id)
+	require.NoError(t, err)
+	_, err = m.Approve(ctx, reg.Id)
+	require.ErrorIs(t, err, ErrInvalidStatus)
+
+	_, err = m.UnsignedGrantTx(ctx, reg.Id, "wrong")
+	require.ErrorIs(t, err, ErrRegistrationNotFound)
+	unsigned, err := m.UnsignedGrantTx(ctx, reg.Id, secrets.Token)
+	require.NoError(t, err)
+	require.Contains(t, string(unsigned), reg.GranteeAddress)
+
+	_, err = m.SubmitGrantTx(ctx, reg.Id, secrets.Token, []byte("tampered"))
+	require.ErrorIs(t, err, ErrInvalidGrantTx)
+	reg, err = m.SubmitGrantTx(ctx, reg.Id, secrets.Token, []byte("signed"))
+	require.NoError(t, err)
+	require.Equal(t, StatusSubmitted, reg.Status)
+	require.Equal(t, "ABCD", reg.TxHash)
+
+	reg, creds, err := m.Poll(ctx, reg.Id, secrets.Token)
+	require.NoError(t, err)
+	require.Equal(t, StatusSubmitted, reg.Status)
+	require.Nil(t, creds)
+
+	chain.included = true
+	reg, creds, err = m.Poll(ctx, reg.Id, secrets.Token)
+	require.NoError(t, err)
+	require.Equal(t, StatusConfirmed, reg.Status)
+	require.Equal(t, "gonka-test", creds.ChainId)
+	require.Equal(t, reg.GranteeAddress, creds.GranteeAddress)
+	require.NotEmpty(t, creds.Permissions)
+	id, ok := keys.Authenticate(creds.ApiKey)
+	require.True(t, ok)
+	require.Equal(t, reg.ApiKeyId, id)
+
+	// The API key is only returned once
+	_, creds, err = m.Poll(ctx, reg.Id, secrets.Token)
+	require.NoError(t, err)
+	require.Empty(t, creds.ApiKey)
+}
+
+func TestOnboardingFailedTxCanBeResubmitted(t *testing.T) {
+	ctx := context.Background()
+	chain := &fakeChain{included: true, code: 5}
+	m, _ := newTestManager(t, chain, Config{AutoApprove: true})
+
+	reg, secrets, err := m.Register(ctx, testGranter, "A8eOIsrBzk0IS+ZP3ISFx15kx35RmZ27Qbx9XUtK2Ym7")
+	require.NoError(t, err)
+	require.Equal(t, StatusApproved, reg.Status)
+	require.False(t, reg.KeyGenerated)
+	require.Empty(t, secrets.GranteePrivateKey)
+
+	_, err = m.SubmitGrantTx(ctx, reg.Id, secrets.Token, []byte("signed"))
+	require.NoError(t, err)
+	reg, creds, err := m.Poll(ctx, reg.Id, secrets.Token)
+	require.NoError(t, err)
+	require.Equal(t, StatusFailed, reg.Status)
+	require.Contains(t, reg.Error, "code 5")
+	require.Nil(t, creds)
+
+	reg, err = m.SubmitGrantTx(ctx, reg.Id, secrets.Token, []byte("signed"))
+	require.NoError(t, err)
+	require.Equal(t, StatusSubmitted, reg.Status)
+	require.Empty(t, reg.Error)
+
+	regs, err := m.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, regs, 1)
+
+	_, err = m.Reject(ctx, reg.Id, "spam")
+	require.ErrorIs(t, err, ErrInvalidStatus)
+}
+
+func TestCheckGrantMsgs(t *testing.T) {
+	msgs, err := grantMsgs(testGranter, testGrantee, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Len(t, msgs, len(Permissions()))
+	require.NoError(t, checkGrantMsgs(msgs, testGranter, testGrantee))
+
+	require.Error(t, checkGrantMsgs(nil, testGranter, testGrantee))
+	require.ErrorContains(t, checkGrantMsgs(msgs, testGranter, testGranter), "does not match")
+
+	send := &banktypes.MsgSend{FromAddress: testGranter, ToAddress: testGrantee}
+	require.ErrorContains(t, checkGrantMsgs(append(msgs, send), testGranter, testGrantee), "unexpected message")
+
+	grant, err := authz.NewGrant(time.Now(), authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), nil)
+	require.NoError(t, err)
+	wide := &authz.MsgGrant{Granter: testGranter, Grantee: testGrantee, Grant: grant}
+	require.ErrorContains(t, checkGrantMsgs([]sdk.Msg{wide}, testGranter, testGrantee), "not an operational permission")
+}
@@ -0,0 +1,87 @@
+package onboarding
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// registrationStore persists registrations so the flow survives API restarts
+type registrationStore interface {
+	Save(ctx context.Context, reg Registration) error
+	Get(ctx context.Context, id string) (Registration, error)
+	List(ctx context.Context) ([]Registration, error)
+}
+
+// sqlRegistrationStore stores registrations in the onboarding_registrations table created by apiconfig.EnsureSchema
+type sqlRegistrationStore struct {
+	db *sql.DB
+}
+
+func newSqlRegistrationStore(db *sql.DB) *sqlRegistrationStore {
+	return &sqlRegistrationStore{db: db}
+}
+
+const registrationColumns = `id, token_hash, granter_address, grantee_address, grantee_pub_key, key_generated,
+status, tx_hash, error, api_key_id, created_at, updated_at`
+
+func (s *sqlRegistrationStore) Save(ctx context.Context, reg Registration) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO onboarding_registrations (`+registrationColumns+`)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+ON CONFLICT(id) DO UPDATE SET
+  status = excluded.status,
+  tx_hash = excluded.tx_hash,
+  error = excluded.error,
+  api_key_id = excluded.api_key_id,
+  updated_at = excluded.updated_at`,
+		reg.Id, reg.tokenHash, reg.GranterAddress, reg.GranteeAddress, reg.GranteePubKey, reg.KeyGenerated,
+		reg.Status, reg.TxHash, reg.Error, reg.ApiKeyId, reg.CreatedAt.Unix(), reg.UpdatedAt.Unix())
+	return err
+}
+
+func (s *sqlRegistrationStore) Get(ctx context.Context, id string) (Registration, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+registrationColumns+` FROM onboarding_registrations WHERE id = ?`, id)
+	reg, err := scanRegistration(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Registration{}, ErrRegistrationNotFound
+	}
+	return reg, err
+}
+
+func (s *sqlRegistrationStore) List(ctx context.Context) ([]Registration, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+registrationColumns+` FROM onboarding_registrations ORDER BY created_at, id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var regs []Registration
+	for rows.Next() {
+		reg, err := scanRegistration(rows)
+		if err != nil {
+			return nil, err
+		}
+		regs = append(regs, reg)
+	}
+	return regs, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRegistration(row scanner) (Registration, error) {
+	var (
+		reg                  Registration
+		createdAt, updatedAt int64
+	)
+	if err := row.Scan(&reg.Id, &reg.tokenHash, &reg.GranterAddress, &reg.GranteeAddress, &reg.GranteePubKey, &reg.KeyGenerated,
+		&reg.Status, &reg.TxHash, &reg.Error, &reg.ApiKeyId, &createdAt, &updatedAt); err != nil {
+		return Registration{}, err
+	}
+	reg.CreatedAt = time.Unix(createdAt, 0).UTC()
+	reg.UpdatedAt = time.Unix(updatedAt, 0).UTC()
+	return reg, nil
+}
@@ -0,0 +1,76 @@
+package peerhealth
+
+import (
+	"context"
+	"decentralized-api/cosmosclient"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ChainPeerSource lists the participants of the current epoch with their inference URLs
+// and the models they serve, excluding this node's own participant
+func ChainPeerSource(recorder cosmosclient.CosmosMessageClient) PeerSource {
+	return func(ctx context.Context) ([]Peer, error) {
+		queryClient := recorder.NewInferenceQueryClient()
+
+		current, err := queryClient.CurrentEpochGroupData(ctx, &types.QueryCurrentEpochGroupDataRequest{})
+		if err != nil {
+			return nil, err
+		}
+		epochData := current.EpochGroupData
+
+		models := make(map[string][]string)
+		for _, modelId := range epochData.SubGroupModels {
+			modelData, err := queryClient.EpochGroupData(ctx, &types.QueryGetEpochGroupDataRequest{
+				EpochIndex: epochData.EpochIndex,
+				ModelId:    modelId,
+			})
+			if err != nil {
+				continue
+			}
+			for _, vw := range modelData.EpochGroupData.ValidationWeights {
+				models[vw.MemberAddress] = append(models[vw.MemberAddress], modelId)
+			}
+		}
+
+		urls, err := participantUrls(ctx, queryClient)
+		if err != nil {
+			return nil, err
+		}
+
+		self := recorder.GetAccountAddress()
+		peers := make([]Peer, 0, len(epochData.ValidationWeights))
+		for _, vw := range epochData.ValidationWeights {
+			if vw.MemberAddress == self {
+				continue
+			}
+			peers = append(peers, Peer{
+				Address: vw.MemberAddress,
+				Url:     urls[vw.MemberAddress],
+				Models:  models[vw.MemberAddress],
+			})
+		}
+		return peers, nil
+	}
+}
+
+func participantUrls(ctx context.Context, queryClient types.QueryClient) (map[string]string, error) {
+	urls := make(map[string]string)
+	var nextKey []byte
+	for {
+		resp, err := queryClient.ParticipantAll(ctx, &types.QueryAllParticipantRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 1000},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, participant := range resp.Participant {
+			urls[participant.Address] = participant.InferenceUrl
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			return urls, nil
+		}
+		nextKey = resp.Pagination.NextKey
+	}
+}
@@ -0,0 +1,64 @@
+package peerhealth
+
+import (
+	"decentralized-api/logging"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// maxNonDeliveryEvidence bounds the evidence kept per peer
+const maxNonDeliveryEvidence = 20
+
+// NonDeliveryReport is evidence that an executor accepted a routed inference and never delivered it.
+// TransferSignature and PromptHash are the transfer agent's signed request, the same values sent in
+// MsgStartInference, so the report can be matched against the inference recorded on chain.
+type NonDeliveryReport struct {
+	InferenceId       string    `json:"inference_id"`
+	Executor          string    `json:"executor"`
+	Url               string    `json:"url"`
+	Model             string    `json:"model"`
+	RequestTimestamp  int64     `json:"request_timestamp"`
+	TransferSignature string    `json:"transfer_signature"`
+	PromptHash        string    `json:"prompt_hash"`
+	SentAt            time.Time `json:"sent_at"`
+	FailedAt          time.Time `json:"failed_at"`
+	StatusCode        int       `json:"status_code,omitempty"` // 0 when no response was received
+	Error             string    `json:"error"`
+}
+
+// RecordNonDelivery stores the evidence for the executor. After UnhealthyAfter consecutive
+// non-deliveries the executor is treated as unhealthy for routing until it delivers again.
+func (p *Prober) RecordNonDelivery(report NonDeliveryReport) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status, ok := p.statuses[report.Executor]
+	if !ok {
+		status = &PeerStatus{Address: report.Executor, Url: report.Url}
+		p.statuses[report.Executor] = status
+	}
+	status.ConsecutiveNonDeliveries++
+	status.NonDeliveries = append(status.NonDeliveries, report)
+	if len(status.NonDeliveries) > maxNonDeliveryEvidence {
+		status.NonDeliveries = status.NonDeliveries[len(status.NonDeliveries)-maxNonDeliveryEvidence:]
+	}
+	if status.ConsecutiveNonDeliveries == p.cfg.UnhealthyAfter {
+		logging.Warn("Peer became unhealthy after repeated non-delivery", types.Participants, "address", report.Executor, "url", report.Url, "inferenceId", report.InferenceId)
+	}
+}
+
+// RecordDelivery resets the non-delivery streak of the executor
+func (p *Prober) RecordDelivery(address string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if status, ok := p.statuses[address]; ok {
+		status.ConsecutiveNonDeliveries = 0
+	}
+}
@@ -0,0 +1,212 @@
+// Package peerhealth actively probes the inference endpoints of other participants in the
+// current epoch. Results are cached and used to avoid routing requests to executors that are
+// known to be unreachable, and are surfaced in the admin API.
+package peerhealth
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// Peer is a participant endpoint to probe
+type Peer struct {
+	Address string
+	Url     string
+	Models  []string // models the participant serves in the current epoch
+}
+
+// PeerSource lists the peers to probe, typically the current epoch's participants
+type PeerSource func(ctx context.Context) ([]Peer, error)
+
+type PeerStatus struct {
+	Address             string    `json:"address"`
+	Url                 string    `json:"url"`
+	Models              []string  `json:"models"`
+	Healthy             bool      `json:"healthy"`
+	LatencyMs           int64     `json:"latency_ms"`
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastError           string    `json:"last_error,omitempty"`
+	LastProbed          time.Time `json:"last_probed"`
+	LastHealthy         time.Time `json:"last_healthy,omitempty"`
+	// Inferences routed to the peer that were never delivered, see RecordNonDelivery
+	ConsecutiveNonDeliveries int                 `json:"consecutive_non_deliveries"`
+	NonDeliveries            []NonDeliveryReport `json:"non_deliveries,omitempty"`
+}
+
+type Config struct {
+	Interval       time.Duration
+	Timeout        time.Duration
+	UnhealthyAfter int // consecutive failed probes before a peer counts as unhealthy
+	MaxConcurrency int
+}
+
+type Prober struct {
+	source PeerSource
+	client *http.Client
+	cfg    Config
+
+	mu       sync.RWMutex
+	statuses map[string]*PeerStatus
+}
+
+func NewProber(source PeerSource, cfg Config) *Prober {
+	return &Prober{
+		source:   source,
+		client:   &http.Client{Timeout: cfg.Timeout},
+		cfg:      cfg,
+		statuses: make(map[string]*PeerStatus),
+	}
+}
+
+// Start probes all peers every Interval until ctx is cancelled
+func (p *Prober) Start(ctx context.Context) {
+	ticker := time.NewTicker(p.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		p.ProbeOnce(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProbeOnce refreshes the peer list and probes every peer once
+func (p *Prober) ProbeOnce(ctx context.Context) {
+	peers, err := p.source(ctx)
+	if err != nil {
+		logging.Warn("Failed to list peers for health probing", types.Participants, "error", err)
+		return
+	}
+
+	p.retainPeers(peers)
+
+	sem := make(chan struct{}, max(1, p.cfg.MaxConcurrency))
+	var wg sync.WaitGroup
+	for _, peer := range peers {
+		if peer.Url == "" {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(peer Peer) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			latency, err := p.probe(ctx, peer.Url)
+			p.record(peer, latency, err)
+		}(peer)
+	}
+	wg.Wait()
+}
+
+// probe checks the peer's public status endpoint and returns the round trip latency
+func (p *Prober) probe(ctx context.Context, peerUrl string) (time.Duration, error) {
+	statusUrl, err := url.JoinPath(peerUrl, "/v1/status")
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, statusUrl, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	resp, err := p.client.Do(req)
+	latency := time.Since(start)
+	if err != nil {
+		return latency, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return latency, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return latency, nil
+}
+
+func (p *Prober) record(peer Peer, latency time.Duration, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status, ok := p.statuses[peer.Address]
+	if !ok {
+		status = &PeerStatus{Address: peer.Address}
+		p.statuses[peer.Address] = status
+	}
+	status.Url = peer.Url
+	status.Models = peer.Models
+	status.LatencyMs = latency.Milliseconds()
+	status.LastProbed = time.Now()
+
+	if err != nil {
+		status.ConsecutiveFailures++
+		status.LastError = err.Error()
+		wasHealthy := status.Healthy
+		status.Healthy = status.ConsecutiveFailures < p.cfg.UnhealthyAfter
+		if wasHealthy && !status.Healthy {
+			logging.Warn("Peer became unhealthy", types.Participants, "address", peer.Address, "url", peer.Url, "error", err)
+		}
+		return
+	}
+
+	if !status.Healthy && status.ConsecutiveFailures >= p.cfg.UnhealthyAfter {
+		logging.Info("Peer recovered", types.Participants, "address", peer.Address, "url", peer.Url)
+	}
+	status.ConsecutiveFailures = 0
+	status.LastError = ""
+	status.Healthy = true
+	status.LastHealthy = status.LastProbed
+}
+
+// retainPeers drops statuses of participants that left the epoch
+func (p *Prober) retainPeers(peers []Peer) {
+	current := make(map[string]bool, len(peers))
+	for _, peer := range peers {
+		current[peer.Address] = true
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for address := range p.statuses {
+		if !current[address] {
+			delete(p.statuses, address)
+		}
+	}
+}
+
+// IsUnhealthy reports whether the peer failed its recent probes or repeatedly did not deliver
+// routed inferences. Peers that were never probed are not considered unhealthy by probing.
+func (p *Prober) IsUnhealthy(address string) bool {
+	if p == nil {
+		return false
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	status, ok := p.statuses[address]
+	if !ok {
+		return false
+	}
+	probedUnhealthy := !status.LastProbed.IsZero() && !status.Healthy
+	return probedUnhealthy || status.ConsecutiveNonDeliveries >= p.cfg.UnhealthyAfter
+}
+
+// Report returns the latest status of every probed peer, ordered by address
+func (p *Prober) Report() []PeerStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	report := make([]PeerStatus, 0, len(p.statuses))
+	for _, status := range p.statuses {
+		peerStatus := *status
+		peerStatus.NonDeliveries = append([]NonDeliveryReport(nil), status.NonDeliveries...)
+		report = append(report, peerStatus)
+	}
+	sort.Slice(report, func(i, j int) bool { return report[i].Address < report[j].Address })
+	return report
+}
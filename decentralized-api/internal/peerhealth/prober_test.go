@@ -0,0 +1,103 @@
+package peerhealth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig() Config {
+	return Config{Interval: time.Minute, Timeout: time.Second, UnhealthyAfter: 2, MaxConcurrency: 4}
+}
+
+func TestProber_HealthyAndUnhealthyPeers(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/status", r.URL.Path)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer failing.Close()
+
+	peers := []Peer{
+		{Address: "peer-a", Url: healthy.URL, Models: []string{"model-1"}},
+		{Address: "peer-b", Url: failing.URL},
+	}
+	prober := NewProber(func(ctx context.Context) ([]Peer, error) { return peers, nil }, testConfig())
+
+	prober.ProbeOnce(context.Background())
+	require.False(t, prober.IsUnhealthy("peer-a"))
+	// A single failure is tolerated
+	require.False(t, prober.IsUnhealthy("peer-b"))
+
+	prober.ProbeOnce(context.Background())
+	require.False(t, prober.IsUnhealthy("peer-a"))
+	require.True(t, prober.IsUnhealthy("peer-b"))
+	require.False(t, prober.IsUnhealthy("unknown"))
+
+	report := prober.Report()
+	require.Len(t, report, 2)
+	require.Equal(t, "peer-a", report[0].Address)
+	require.True(t, report[0].Healthy)
+	require.Equal(t, []string{"model-1"}, report[0].Models)
+	require.False(t, report[1].Healthy)
+	require.Equal(t, 2, report[1].ConsecutiveFailures)
+	require.Contains(t, report[1].LastError, "502")
+}
+
+func TestProber_RecoversAndDropsDepartedPeers(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	peers := []Peer{{Address: "peer-a", Url: server.URL}}
+	prober := NewProber(func(ctx context.Context) ([]Peer, error) { return peers, nil }, testConfig())
+
+	prober.ProbeOnce(context.Background())
+	prober.ProbeOnce(context.Background())
+	require.True(t, prober.IsUnhealthy("peer-a"))
+
+	status = http.StatusOK
+	prober.ProbeOnce(context.Background())
+	require.False(t, prober.IsUnhealthy("peer-a"))
+	require.Zero(t, prober.Report()[0].ConsecutiveFailures)
+
+	peers = nil
+	prober.ProbeOnce(context.Background())
+	require.Empty(t, prober.Report())
+}
+
+func TestProber_NonDeliveryMarksPeerUnhealthy(t *testing.T) {
+	prober := NewProber(func(ctx context.Context) ([]Peer, error) { return nil, nil }, testConfig())
+
+	report := NonDeliveryReport{InferenceId: "inf-1", Executor: "peer-a", Url: "http://peer-a", Error: "timeout"}
+	prober.RecordNonDelivery(report)
+	require.False(t, prober.IsUnhealthy("peer-a"))
+
+	report.InferenceId = "inf-2"
+	prober.RecordNonDelivery(report)
+	require.True(t, prober.IsUnhealthy("peer-a"))
+
+	status := prober.Report()[0]
+	require.Equal(t, 2, status.ConsecutiveNonDeliveries)
+	require.Len(t, status.NonDeliveries, 2)
+	require.Equal(t, "inf-2", status.NonDeliveries[1].InferenceId)
+
+	prober.RecordDelivery("peer-a")
+	require.False(t, prober.IsUnhealthy("peer-a"))
+	// Evidence is kept after the peer recovers
+	require.Len(t, prober.Report()[0].NonDeliveries, 2)
+
+	for i := 0; i < maxNonDeliveryEvidence+5; i++ {
+		prober.RecordNonDelivery(report)
+	}
+	require.Len(t, prober.Report()[0].NonDeliveries, maxNonDeliveryEvidence)
+}
@@ -0,0 +1,179 @@
+// Package replay serves recorded chain node and ML node responses, so the API runs locally without a
+// chain node or GPUs. Each server answers the requests matching its fixtures and, in record mode,
+// proxies the others to the real upstream and saves the responses as new fixtures.
+package replay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// websocketFixtureFile holds the messages pushed to the websocket subscribers, e.g. NewBlock events
+const websocketFixtureFile = "websocket.json"
+
+// Fixture is a recorded response and the requests it answers. A fixture file holds one fixture or an
+// array of them.
+type Fixture struct {
+	Request  Matcher  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Matcher selects requests. Empty fields match anything.
+type Matcher struct {
+	Method string `json:"method,omitempty"`
+	Path   string `json:"path,omitempty"`
+	// RpcMethod and RpcParams match JSON-RPC requests, like the abci_query calls of the chain client.
+	// Params are compared as JSON values.
+	RpcMethod    string          `json:"rpc_method,omitempty"`
+	RpcParams    json.RawMessage `json:"rpc_params,omitempty"`
+	BodyContains []string        `json:"body_contains,omitempty"`
+}
+
+// Response is a recorded response. JSON bodies are kept as JSON to be readable and editable, other
+// bodies, like the event streams of inferences, as text.
+type Response struct {
+	Status   int               `json:"status,omitempty"`
+	Headers  map[string]string `json:"headers,omitempty"`
+	Body     json.RawMessage   `json:"body,omitempty"`
+	BodyText string            `json:"body_text,omitempty"`
+}
+
+// rpcRequest is the part of a JSON-RPC request the fixtures match
+type rpcRequest struct {
+	Id     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+func parseRpcRequest(body []byte) *rpcRequest {
+	var req rpcRequest
+	if json.Unmarshal(body, &req) != nil || req.Method == "" {
+		return nil
+	}
+	return &req
+}
+
+func (m Matcher) matches(r *http.Request, body []byte, rpc *rpcRequest) bool {
+	if m.Method != "" && !strings.EqualFold(m.Method, r.Method) {
+		return false
+	}
+	if m.Path != "" && m.Path != r.URL.Path {
+		return false
+	}
+	if m.RpcMethod != "" && (rpc == nil || rpc.Method != m.RpcMethod) {
+		return false
+	}
+	if len(m.RpcParams) > 0 && (rpc == nil || !jsonEqual(m.RpcParams, rpc.Params)) {
+		return false
+	}
+	for _, s := range m.BodyContains {
+		if !bytes.Contains(body, []byte(s)) {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonEqual(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// LoadFixtures reads the fixture files of a directory in name order, the order they are matched in.
+// A missing directory has no fixtures.
+func LoadFixtures(dir string) ([]Fixture, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".json") && entry.Name() != websocketFixtureFile {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	var fixtures []Fixture
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		data = bytes.TrimSpace(data)
+		if bytes.HasPrefix(data, []byte("[")) {
+			var list []Fixture
+			if err := json.Unmarshal(data, &list); err != nil {
+				return nil, fmt.Errorf("fixture %s: %w", name, err)
+			}
+			fixtures = append(fixtures, list...)
+			continue
+		}
+		var fixture Fixture
+		if err := json.Unmarshal(data, &fixture); err != nil {
+			return nil, fmt.Errorf("fixture %s: %w", name, err)
+		}
+		fixtures = append(fixtures, fixture)
+	}
+	return fixtures, nil
+}
+
+// loadWebsocketMessages reads the messages pushed to websocket subscribers, none if the file is missing
+func loadWebsocketMessages(dir string) ([]json.RawMessage, error) {
+	data, err := os.ReadFile(filepath.Join(dir, websocketFixtureFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var messages []json.RawMessage
+	if err := json.Unmarshal(data, &messages); err != nil {
+		return nil, fmt.Errorf("fixture %s: %w", websocketFixtureFile, err)
+	}
+	return messages, nil
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// fixtureFileName names a recorded fixture after its position and request, e.g.
+// 0007-abci_query-inference.inference.Query-Params.json
+func fixtureFileName(index int, fixture Fixture) string {
+	name := fixture.Request.RpcMethod
+	if name == "" {
+		name = fixture.Request.Method + fixture.Request.Path
+	} else if path := rpcQueryPath(fixture.Request.RpcParams); path != "" {
+		name += path
+	}
+	name = strings.Trim(unsafeFileChars.ReplaceAllString(strings.ReplaceAll(name, "/", "-"), "_"), "-_")
+	if len(name) > 80 {
+		name = name[:80]
+	}
+	return fmt.Sprintf("%04d-%s.json", index, name)
+}
+
+// rpcQueryPath is the gRPC method of an abci_query, which says more than the RPC method
+func rpcQueryPath(params json.RawMessage) string {
+	var query struct {
+		Path string `json:"path"`
+	}
+	if json.Unmarshal(params, &query) != nil {
+		return ""
+	}
+	return query.Path
+}
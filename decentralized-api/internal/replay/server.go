@@ -0,0 +1,317 @@
+package replay
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/logging"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// websocketPath is where the chain node serves its event subscriptions
+const websocketPath = "/websocket"
+
+type Config struct {
+	// Name of the server, also the fixtures subdirectory
+	Name          string
+	ListenAddress string
+	Dir           string
+	// Upstream is the real node requests without fixture are proxied to and recorded from, in record mode
+	Upstream string
+	Record   bool
+	// WebsocketInterval spaces the messages pushed to websocket subscribers
+	WebsocketInterval time.Duration
+}
+
+// Server answers requests from fixtures. Without a matching fixture it answers 404, unless recording.
+type Server struct {
+	cfg      Config
+	upstream *url.URL
+	client   *http.Client
+	upgrader websocket.Upgrader
+	listener net.Listener
+	http     *http.Server
+
+	mu         sync.Mutex
+	fixtures   []Fixture
+	wsMessages []json.RawMessage
+	nextIndex  int
+}
+
+func NewServer(cfg Config) (*Server, error) {
+	fixtures, err := LoadFixtures(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	wsMessages, err := loadWebsocketMessages(cfg.Dir)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		cfg:        cfg,
+		client:     &http.Client{Timeout: 5 * time.Minute},
+		fixtures:   fixtures,
+		wsMessages: wsMessages,
+	}
+	if cfg.Record {
+		if cfg.Upstream == "" {
+			return nil, fmt.Errorf("replay server %s: recording requires an upstream", cfg.Name)
+		}
+		if s.upstream, err = url.Parse(cfg.Upstream); err != nil {
+			return nil, fmt.Errorf("replay server %s: invalid upstream: %w", cfg.Name, err)
+		}
+		if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start listens on the configured address and serves in the background
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.cfg.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("replay server %s: %w", s.cfg.Name, err)
+	}
+	s.listener = listener
+	s.http = &http.Server{Handler: s, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		if err := s.http.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logging.Error("Replay server stopped", types.Testing, "server", s.cfg.Name, "error", err)
+		}
+	}()
+	logging.Info("Replay server started", types.Testing, "server", s.cfg.Name, "address", listener.Addr().String(),
+		"fixtures", len(s.fixtures), "record", s.cfg.Record)
+	return nil
+}
+
+// Addr is the address the server listens on, once started
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *Server) Close() error {
+	return s.http.Shutdown(context.Background())
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == websocketPath && websocket.IsWebSocketUpgrade(r) {
+		s.serveWebsocket(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rpc := parseRpcRequest(body)
+
+	if fixture, ok := s.match(r, body, rpc); ok {
+		writeResponse(w, fixture.Response, rpc)
+		return
+	}
+	if s.cfg.Record {
+		s.record(w, r, body, rpc)
+		return
+	}
+
+	rpcMethod := ""
+	if rpc != nil {
+		rpcMethod = rpc.Method
+	}
+	logging.Warn("Replay: no fixture for request", types.Testing, "server", s.cfg.Name, "method", r.Method,
+		"path", r.URL.Path, "rpcMethod", rpcMethod, "body", string(body))
+	http.Error(w, "no replay fixture matches the request", http.StatusNotFound)
+}
+
+func (s *Server) match(r *http.Request, body []byte, rpc *rpcRequest) (Fixture, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, fixture := range s.fixtures {
+		if fixture.Request.matches(r, body, rpc) {
+			return fixture, true
+		}
+	}
+	return Fixture{}, false
+}
+
+// record proxies a request to the upstream, answers with its response and saves it as a fixture.
+// Responses are buffered, so streamed responses reach the client at once.
+func (s *Server) record(w http.ResponseWriter, r *http.Request, body []byte, rpc *rpcRequest) {
+	target := *s.upstream
+	target.Path = strings.TrimSuffix(s.upstream.Path, "/") + r.URL.Path
+	target.RawQuery = r.URL.RawQuery
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	req.Header = r.Header.Clone()
+	// Fixtures keep the plain body
+	req.Header.Del("Accept-Encoding")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		logging.Warn("Replay: upstream request failed", types.Testing, "server", s.cfg.Name, "path", r.URL.Path, "error", err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	fixture := Fixture{Response: Response{Status: resp.StatusCode}}
+	if rpc != nil {
+		fixture.Request = Matcher{RpcMethod: rpc.Method, RpcParams: rpc.Params}
+	} else {
+		fixture.Request = Matcher{Method: r.Method, Path: r.URL.Path}
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		fixture.Response.Headers = map[string]string{"Content-Type": contentType}
+	}
+	if json.Valid(respBody) {
+		fixture.Response.Body = respBody
+	} else {
+		fixture.Response.BodyText = string(respBody)
+	}
+	if err := s.save(fixture); err != nil {
+		logging.Error("Replay: failed to save fixture", types.Testing, "server", s.cfg.Name, "path", r.URL.Path, "error", err)
+	}
+
+	for key, values := range resp.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.WriteHeader(resp.StatusCode)
+	_, _ = w.Write(respBody)
+}
+
+// save writes a recorded fixture to a new file and serves it from now on
+func (s *Server) save(fixture Fixture) error {
+	data, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for {
+		path := filepath.Join(s.cfg.Dir, fixtureFileName(s.nextIndex, fixture))
+		s.nextIndex++
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			if err := os.WriteFile(path, data, 0o644); err != nil {
+				return err
+			}
+			break
+		}
+	}
+	s.fixtures = append(s.fixtures, fixture)
+	return nil
+}
+
+// writeResponse answers with a fixture. JSON-RPC answers carry the id of the request, not the recorded one.
+func writeResponse(w http.ResponseWriter, response Response, rpc *rpcRequest) {
+	for key, value := range response.Headers {
+		w.Header().Set(key, value)
+	}
+	body := []byte(response.BodyText)
+	if len(response.Body) > 0 {
+		body = response.Body
+		if rpc != nil {
+			body = withRpcId(body, rpc.Id)
+		}
+		if w.Header().Get("Content-Type") == "" {
+			w.Header().Set("Content-Type", "application/json")
+		}
+	}
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+}
+
+func withRpcId(body []byte, id json.RawMessage) []byte {
+	var message map[string]json.RawMessage
+	if json.Unmarshal(body, &message) != nil {
+		return body
+	}
+	if _, ok := message["id"]; !ok || len(id) == 0 {
+		return body
+	}
+	message["id"] = id
+	rewritten, err := json.Marshal(message)
+	if err != nil {
+		return body
+	}
+	return rewritten
+}
+
+// serveWebsocket acknowledges the subscriptions of the event listener and, after the first one, pushes
+// the recorded websocket messages one per WebsocketInterval
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	var writeMu sync.Mutex
+	write := func(message []byte) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return conn.WriteMessage(websocket.TextMessage, message)
+	}
+	done := make(chan struct{})
+	defer close(done)
+
+	pushing := false
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		rpc := parseRpcRequest(message)
+		if rpc == nil {
+			continue
+		}
+		if err := write(withRpcId([]byte(`{"jsonrpc":"2.0","id":null,"result":{}}`), rpc.Id)); err != nil {
+			return
+		}
+		if rpc.Method == "subscribe" && !pushing {
+			pushing = true
+			go s.pushMessages(write, done)
+		}
+	}
+}
+
+func (s *Server) pushMessages(write func([]byte) error, done <-chan struct{}) {
+	ticker := time.NewTicker(s.cfg.WebsocketInterval)
+	defer ticker.Stop()
+	for _, message := range s.wsMessages {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if err := write(message); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package replay
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+}
+
+func post(t *testing.T, url, body string) (int, string) {
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(respBody)
+}
+
+func TestServeFixtures(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, "01-params.json", `{
+		"request": {"rpc_method": "abci_query", "rpc_params": {"path": "/inference.inference.Query/Params", "data": "", "prove": false}},
+		"response": {"body": {"jsonrpc": "2.0", "id": 7, "result": {"response": {"code": 0, "value": "cGFyYW1z"}}}}
+	}`)
+	writeFile(t, dir, "02-mlnode.json", `[
+		{"request": {"method": "GET", "path": "/api/v1/state"}, "response": {"body": {"state": "STOPPED"}}},
+		{"request": {"method": "POST", "path": "/v1/chat/completions", "body_contains": ["\"stream\":true"]},
+		 "response": {"headers": {"Content-Type": "text/event-stream"}, "body_text": "data: [DONE]\n\n"}}
+	]`)
+	server, err := NewServer(Config{Name: "chain", Dir: dir})
+	require.NoError(t, err)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	// The JSON-RPC answer carries the id of the request
+	status, body := post(t, ts.URL, `{"jsonrpc":"2.0","id":42,"method":"abci_query","params":{"prove":false,"path":"/inference.inference.Query/Params","data":""}}`)
+	require.Equal(t, http.StatusOK, status)
+	var answer struct {
+		Id     int `json:"id"`
+		Result struct {
+			Response struct {
+				Value string `json:"value"`
+			} `json:"response"`
+		} `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &answer))
+	require.Equal(t, 42, answer.Id)
+	require.Equal(t, "cGFyYW1z", answer.Result.Response.Value)
+
+	resp, err := http.Get(ts.URL + "/api/v1/state")
+	require.NoError(t, err)
+	stateBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.JSONEq(t, `{"state": "STOPPED"}`, string(stateBody))
+
+	status, body = post(t, ts.URL+"/v1/chat/completions", `{"model":"m","stream":true}`)
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "data: [DONE]\n\n", body)
+
+	// Other params, other body or no fixture at all
+	status, _ = post(t, ts.URL, `{"jsonrpc":"2.0","id":1,"method":"abci_query","params":{"path":"/inference.inference.Query/Other"}}`)
+	require.Equal(t, http.StatusNotFound, status)
+	status, _ = post(t, ts.URL+"/v1/chat/completions", `{"model":"m"}`)
+	require.Equal(t, http.StatusNotFound, status)
+}
+
+func TestRecordFixtures(t *testing.T) {
+	calls := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		require.Equal(t, "/node/api/v1/state", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"state":"INFERENCE"}`))
+	}))
+	defer upstream.Close()
+
+	dir := filepath.Join(t.TempDir(), "mlnode")
+	server, err := NewServer(Config{Name: "mlnode", Dir: dir, Upstream: upstream.URL + "/node/", Record: true})
+	require.NoError(t, err)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	for i := 0; i < 2; i++ {
+		resp, err := http.Get(ts.URL + "/api/v1/state")
+		require.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		require.JSONEq(t, `{"state":"INFERENCE"}`, string(body))
+	}
+	// The second request is answered by the recorded fixture
+	require.Equal(t, 1, calls)
+
+	fixtures, err := LoadFixtures(dir)
+	require.NoError(t, err)
+	require.Len(t, fixtures, 1)
+	require.Equal(t, Matcher{Method: "GET", Path: "/api/v1/state"}, fixtures[0].Request)
+	require.FileExists(t, filepath.Join(dir, "0000-GET-api-v1-state.json"))
+
+	_, err = NewServer(Config{Name: "mlnode", Dir: dir, Record: true})
+	require.Error(t, err)
+}
+
+func TestWebsocketReplay(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, dir, websocketFixtureFile, `[{"jsonrpc":"2.0","id":1,"result":{"query":"tm.event='NewBlock'","data":{"type":"tendermint/event/NewBlock"}}}]`)
+	server, err := NewServer(Config{Name: "chain", Dir: dir, WebsocketInterval: 10 * time.Millisecond})
+	require.NoError(t, err)
+	ts := httptest.NewServer(server)
+	defer ts.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http")+"/websocket", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"subscribe","params":{"query":"tm.event='NewBlock'"}}`)))
+
+	_, ack, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":{}}`, string(ack))
+	_, event, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Contains(t, string(event), "tendermint/event/NewBlock")
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var ErrApiKeysUnavailable = echo.NewHTTPError(http.StatusServiceUnavailable, "API keys are not available: no SQL database")
+
+type ApiKeyResponse struct {
+	apikeys.ApiKey
+	Usage apikeys.Usage `json:"usage"`
+}
+
+type ApiKeysResponse struct {
+	Required bool             `json:"required"`
+	Keys     []ApiKeyResponse `json:"keys"`
+}
+
+type CreateApiKeyRequest struct {
+	Name string `json:"name"`
+	apikeys.Limits
+}
+
+// CreateApiKeyResponse carries the key itself, which is only returned on creation
+type CreateApiKeyResponse struct {
+	apikeys.ApiKey
+	Key string `json:"key"`
+}
+
+func (s *Server) getApiKeys(ctx echo.Context) error {
+	if s.apiKeys == nil {
+		return ErrApiKeysUnavailable
+	}
+	keys, usage := s.apiKeys.List()
+	response := ApiKeysResponse{
+		Required: s.configManager.GetApiKeysConfig().Required,
+		Keys:     make([]ApiKeyResponse, 0, len(keys)),
+	}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, ApiKeyResponse{ApiKey: key, Usage: usage[key.Id]})
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func (s *Server) createApiKey(ctx echo.Context) error {
+	if s.apiKeys == nil {
+		return ErrApiKeysUnavailable
+	}
+	var request CreateApiKeyRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if request.Name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name is required")
+	}
+
+	key, secret, err := s.apiKeys.Create(ctx.Request().Context(), request.Name, request.Limits)
+	if err != nil {
+		logging.Error("Failed to create API key", types.Server, "name", request.Name, "error", err)
+		return apiKeyError(err)
+	}
+	logging.Info("API key created", types.Server, "id", key.Id, "name", key.Name)
+	return ctx.JSON(http.StatusCreated, CreateApiKeyResponse{ApiKey: key, Key: secret})
+}
+
+func (s *Server) updateApiKey(ctx echo.Context) error {
+	if s.apiKeys == nil {
+		return ErrApiKeysUnavailable
+	}
+	var limits apikeys.Limits
+	if err := ctx.Bind(&limits); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	key, err := s.apiKeys.Update(ctx.Request().Context(), ctx.Param("id"), limits)
+	if err != nil {
+		return apiKeyError(err)
+	}
+	logging.Info("API key updated", types.Server, "id", key.Id, "limits", limits)
+	return ctx.JSON(http.StatusOK, key)
+}
+
+func (s *Server) deleteApiKey(ctx echo.Context) error {
+	if s.apiKeys == nil {
+		return ErrApiKeysUnavailable
+	}
+	id := ctx.Param("id")
+	if err := s.apiKeys.Delete(ctx.Request().Context(), id); err != nil {
+		return apiKeyError(err)
+	}
+	logging.Info("API key deleted", types.Server, "id", id)
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func apiKeyError(err error) error {
+	switch {
+	case errors.Is(err, apikeys.ErrKeyNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, apikeys.ErrInvalidLimits):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return err
+	}
+}
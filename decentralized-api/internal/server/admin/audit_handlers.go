@@ -0,0 +1,86 @@
+package admin
+
+import (
+	"decentralized-api/internal/audit"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AuditLogResponse struct {
+	Enabled bool          `json:"enabled"`
+	Entries []audit.Entry `json:"entries"`
+	// NextBeforeId is passed as before_id to get the next page, 0 when there are no more entries
+	NextBeforeId int64 `json:"next_before_id,omitempty"`
+}
+
+// getAuditLog returns inference audit entries, newest first. Filters: inference_id, role, model,
+// requester, executor, since and until (RFC3339), before_id and limit for pagination.
+func (s *Server) getAuditLog(ctx echo.Context) error {
+	if s.auditLog == nil {
+		return ctx.JSON(http.StatusOK, AuditLogResponse{Enabled: false, Entries: []audit.Entry{}})
+	}
+
+	filter := audit.Filter{
+		InferenceId:      ctx.QueryParam("inference_id"),
+		Role:             audit.Role(ctx.QueryParam("role")),
+		Model:            ctx.QueryParam("model"),
+		RequesterAddress: ctx.QueryParam("requester"),
+		ExecutorAddress:  ctx.QueryParam("executor"),
+	}
+	var err error
+	if filter.Since, err = parseTimeParam(ctx, "since"); err != nil {
+		return err
+	}
+	if filter.Until, err = parseTimeParam(ctx, "until"); err != nil {
+		return err
+	}
+	if filter.BeforeId, err = parseIntParam(ctx, "before_id"); err != nil {
+		return err
+	}
+	limit, err := parseIntParam(ctx, "limit")
+	if err != nil {
+		return err
+	}
+	filter.Limit = int(limit)
+
+	entries, err := s.auditLog.Query(ctx.Request().Context(), filter)
+	if err != nil {
+		return err
+	}
+	response := AuditLogResponse{Enabled: true, Entries: entries}
+	requested := filter.Limit
+	if requested <= 0 {
+		requested = audit.DefaultQueryLimit
+	}
+	if len(entries) > 0 && len(entries) >= min(requested, audit.MaxQueryLimit) {
+		response.NextBeforeId = entries[len(entries)-1].Id
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func parseTimeParam(ctx echo.Context, name string) (time.Time, error) {
+	value := ctx.QueryParam(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, echo.NewHTTPError(http.StatusBadRequest, name+" must be an RFC3339 time")
+	}
+	return t, nil
+}
+
+func parseIntParam(ctx echo.Context, name string) (int64, error) {
+	value := ctx.QueryParam(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, name+" must be a non-negative integer")
+	}
+	return n, nil
+}
@@ -0,0 +1,32 @@
+package admin
+
+import (
+	"decentralized-api/internal/event_listener"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// postEventReplay re-runs the selected event handlers on the Tx events of a range of processed blocks.
+// With dry_run it only reports which handlers would run.
+func (s *Server) postEventReplay(ctx echo.Context) error {
+	if s.eventReplay == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Event replay is not available")
+	}
+	var req event_listener.ReplayRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+
+	result, err := s.eventReplay.Replay(ctx.Request().Context(), req)
+	switch {
+	case errors.Is(err, event_listener.ErrInvalidReplayRequest):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, event_listener.ErrReplayInProgress):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case err != nil:
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
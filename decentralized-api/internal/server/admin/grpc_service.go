@@ -0,0 +1,274 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// The admin gRPC service mirrors a subset of the admin REST API. Each method is mapped to its REST route
+// with a google.api.http rule, as grpc-gateway would, and is served by the REST handler itself, so both
+// APIs always behave the same. Requests are google.protobuf.Struct: fields named in the path fill the
+// path, the others become query params (GET, DELETE) or the JSON body (POST, PUT, PATCH). Responses
+// are the JSON body as a google.protobuf.Value. The descriptor is registered for server reflection, so
+// tools like grpcurl and client generators can discover the service without .proto files.
+const (
+	adminGrpcPackage = "decentralized_api.admin.v1"
+	adminGrpcService = adminGrpcPackage + ".AdminService"
+	adminGrpcFile    = "decentralized_api/admin/v1/admin.proto"
+)
+
+type adminGrpcRoute struct {
+	name   string
+	method string
+	// path in the google.api.http template syntax, e.g. /admin/v1/nodes/{id}
+	path string
+}
+
+var adminGrpcRoutes = []adminGrpcRoute{
+	// Node management
+	{name: "ListNodes", method: http.MethodGet, path: "/admin/v1/nodes"},
+	{name: "CreateNode", method: http.MethodPost, path: "/admin/v1/nodes"},
+	{name: "UpdateNode", method: http.MethodPut, path: "/admin/v1/nodes/{id}"},
+	{name: "PatchNode", method: http.MethodPatch, path: "/admin/v1/nodes/{id}"},
+	{name: "DeleteNode", method: http.MethodDelete, path: "/admin/v1/nodes/{id}"},
+	{name: "EnableNode", method: http.MethodPost, path: "/admin/v1/nodes/{id}/enable"},
+	{name: "DisableNode", method: http.MethodPost, path: "/admin/v1/nodes/{id}/disable"},
+	{name: "DrainNode", method: http.MethodPost, path: "/admin/v1/nodes/{id}/drain"},
+	{name: "UndrainNode", method: http.MethodPost, path: "/admin/v1/nodes/{id}/undrain"},
+	{name: "GetUpgradeStatus", method: http.MethodGet, path: "/admin/v1/nodes/upgrade-status"},
+	{name: "GetPocProgress", method: http.MethodGet, path: "/admin/v1/poc/progress"},
+	// State export
+	{name: "ExportDb", method: http.MethodGet, path: "/admin/v1/export/db"},
+	{name: "GetConfig", method: http.MethodGet, path: "/admin/v1/config"},
+	// Validation queue inspection
+	{name: "GetValidationQueue", method: http.MethodGet, path: "/admin/v1/validation/queue"},
+	{name: "RetryDeadLetters", method: http.MethodPost, path: "/admin/v1/validation/dead-letters/retry"},
+}
+
+var registerAdminGrpcFile = sync.OnceValue(func() error {
+	fd, err := protodesc.NewFile(adminGrpcFileDescriptor(), protoregistry.GlobalFiles)
+	if err != nil {
+		return err
+	}
+	return protoregistry.GlobalFiles.RegisterFile(fd)
+})
+
+func adminGrpcFileDescriptor() *descriptorpb.FileDescriptorProto {
+	methods := make([]*descriptorpb.MethodDescriptorProto, 0, len(adminGrpcRoutes))
+	for _, route := range adminGrpcRoutes {
+		rule := &annotations.HttpRule{}
+		switch route.method {
+		case http.MethodGet:
+			rule.Pattern = &annotations.HttpRule_Get{Get: route.path}
+		case http.MethodPost:
+			rule.Pattern = &annotations.HttpRule_Post{Post: route.path}
+			rule.Body = "*"
+		case http.MethodPut:
+			rule.Pattern = &annotations.HttpRule_Put{Put: route.path}
+			rule.Body = "*"
+		case http.MethodPatch:
+			rule.Pattern = &annotations.HttpRule_Patch{Patch: route.path}
+			rule.Body = "*"
+		case http.MethodDelete:
+			rule.Pattern = &annotations.HttpRule_Delete{Delete: route.path}
+		}
+		options := &descriptorpb.MethodOptions{}
+		proto.SetExtension(options, annotations.E_Http, rule)
+		methods = append(methods, &descriptorpb.MethodDescriptorProto{
+			Name:       proto.String(route.name),
+			InputType:  proto.String(".google.protobuf.Struct"),
+			OutputType: proto.String(".google.protobuf.Value"),
+			Options:    options,
+		})
+	}
+	return &descriptorpb.FileDescriptorProto{
+		Name:       proto.String(adminGrpcFile),
+		Package:    proto.String(adminGrpcPackage),
+		Dependency: []string{"google/api/annotations.proto", "google/protobuf/struct.proto"},
+		Service:    []*descriptorpb.ServiceDescriptorProto{{Name: proto.String("AdminService"), Method: methods}},
+		Syntax:     proto.String("proto3"),
+	}
+}
+
+func (s *Server) adminGrpcServiceDesc() *grpc.ServiceDesc {
+	desc := &grpc.ServiceDesc{
+		ServiceName: adminGrpcService,
+		HandlerType: (*any)(nil),
+		Metadata:    adminGrpcFile,
+	}
+	for _, route := range adminGrpcRoutes {
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: route.name,
+			Handler: func(_ any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+				req := &structpb.Struct{}
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				handler := func(ctx context.Context, req any) (any, error) {
+					return s.serveGrpcRoute(ctx, route, req.(*structpb.Struct))
+				}
+				if interceptor == nil {
+					return handler(ctx, req)
+				}
+				info := &grpc.UnaryServerInfo{FullMethod: "/" + adminGrpcService + "/" + route.name}
+				return interceptor(ctx, req, info, handler)
+			},
+		})
+	}
+	return desc
+}
+
+// StartGrpc serves the admin gRPC service with reflection on addr
+func (s *Server) StartGrpc(addr string) error {
+	if err := registerAdminGrpcFile(); err != nil {
+		return fmt.Errorf("register admin gRPC descriptor: %w", err)
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(s.adminGrpcServiceDesc(), s)
+	reflection.Register(grpcServer)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			logging.Error("Admin gRPC server stopped", types.Server, "error", err)
+		}
+	}()
+	return nil
+}
+
+// serveGrpcRoute runs the REST handler of a route for a gRPC request
+func (s *Server) serveGrpcRoute(ctx context.Context, route adminGrpcRoute, req *structpb.Struct) (*structpb.Value, error) {
+	httpReq, err := newGrpcRouteRequest(ctx, route, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rec := httptest.NewRecorder()
+	s.e.ServeHTTP(rec, httpReq)
+
+	if rec.Code < 200 || rec.Code >= 300 {
+		return nil, status.Error(grpcCodeFromHTTP(rec.Code), grpcErrorMessage(rec.Code, rec.Body.Bytes()))
+	}
+	if rec.Body.Len() == 0 {
+		return structpb.NewNullValue(), nil
+	}
+	resp := &structpb.Value{}
+	if err := resp.UnmarshalJSON(rec.Body.Bytes()); err != nil {
+		return structpb.NewStringValue(rec.Body.String()), nil
+	}
+	return resp, nil
+}
+
+func newGrpcRouteRequest(ctx context.Context, route adminGrpcRoute, req *structpb.Struct) (*http.Request, error) {
+	fields := req.AsMap()
+	path := route.path
+	for name, value := range fields {
+		placeholder := "{" + name + "}"
+		if !strings.Contains(path, placeholder) {
+			continue
+		}
+		path = strings.ReplaceAll(path, placeholder, url.PathEscape(fmt.Sprint(value)))
+		// PUT replaces the whole node, whose id is part of the body
+		if route.method != http.MethodPut {
+			delete(fields, name)
+		}
+	}
+	if strings.Contains(path, "{") {
+		return nil, fmt.Errorf("missing path field in %s", route.path)
+	}
+
+	var body []byte
+	switch route.method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		var err error
+		if body, err = json.Marshal(fields); err != nil {
+			return nil, err
+		}
+	default:
+		query := url.Values{}
+		for name, value := range fields {
+			query.Set(name, fmt.Sprint(value))
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, route.method, path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get("authorization") {
+			httpReq.Header.Add("Authorization", value)
+		}
+	}
+	return httpReq, nil
+}
+
+func grpcCodeFromHTTP(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.Aborted
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
+// grpcErrorMessage extracts the message of an echo or {"error": ...} body, falling back to the raw body
+func grpcErrorMessage(code int, body []byte) string {
+	var parsed struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		if parsed.Message != "" {
+			return parsed.Message
+		}
+		if parsed.Error != "" {
+			return parsed.Error
+		}
+	}
+	if msg := strings.TrimSpace(string(body)); msg != "" {
+		return msg
+	}
+	return http.StatusText(code)
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestAdminGrpcService(t *testing.T) {
+	s, configManager, _ := setupTestServer(t)
+	require.NoError(t, registerAdminGrpcFile())
+
+	nodeConfig := apiconfig.InferenceNodeConfig{
+		Id:            "node-1",
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       8081,
+		MaxConcurrent: 3,
+		Models: map[string]apiconfig.ModelConfig{
+			"test-model": {Args: []string{}},
+		},
+	}
+	require.NoError(t, configManager.SetNodes([]apiconfig.InferenceNodeConfig{nodeConfig}))
+	select {
+	case response := <-s.nodeBroker.LoadNodeToBroker(&nodeConfig):
+		require.NoError(t, response.Error)
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out waiting for node to register")
+	}
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	grpcServer.RegisterService(s.adminGrpcServiceDesc(), s)
+	go func() { _ = grpcServer.Serve(lis) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	invoke := func(method string, fields map[string]any) (*structpb.Value, error) {
+		req, err := structpb.NewStruct(fields)
+		require.NoError(t, err)
+		resp := &structpb.Value{}
+		err = conn.Invoke(context.Background(), "/"+adminGrpcService+"/"+method, req, resp)
+		return resp, err
+	}
+
+	t.Run("descriptor maps methods to REST routes", func(t *testing.T) {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(adminGrpcService)
+		require.NoError(t, err)
+		method := desc.(protoreflect.ServiceDescriptor).Methods().ByName("EnableNode")
+		require.NotNil(t, method)
+		rule := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		assert.Equal(t, "/admin/v1/nodes/{id}/enable", rule.GetPost())
+		assert.Equal(t, "*", rule.GetBody())
+	})
+
+	t.Run("list nodes", func(t *testing.T) {
+		resp, err := invoke("ListNodes", nil)
+		require.NoError(t, err)
+		nodes := resp.GetListValue().GetValues()
+		require.Len(t, nodes, 1)
+		assert.Equal(t, "node-1", nodes[0].GetStructValue().GetFields()["node"].GetStructValue().GetFields()["id"].GetStringValue())
+	})
+
+	t.Run("patch node", func(t *testing.T) {
+		_, err := invoke("PatchNode", map[string]any{"id": "node-1", "max_concurrent": 8})
+		require.NoError(t, err)
+		assert.Equal(t, 8, configManager.GetNodes()[0].MaxConcurrent)
+	})
+
+	t.Run("REST errors become gRPC status codes", func(t *testing.T) {
+		_, err := invoke("PatchNode", map[string]any{"id": "node-2", "max_concurrent": 8})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = invoke("EnableNode", nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"decentralized-api/broker"
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type CreateMaintenanceWindowRequest struct {
+	// Schedule is a 5-field cron expression in UTC for the start of each window, e.g. "0 3 * * 0"
+	Schedule        string `json:"schedule"`
+	DurationSeconds int64  `json:"duration_seconds"`
+	// DrainLeadSeconds defaults to broker.DefaultMaintenanceDrainLead when omitted
+	DrainLeadSeconds *int64 `json:"drain_lead_seconds,omitempty"`
+}
+
+type MaintenanceWindowResponse struct {
+	broker.MaintenanceWindow
+	NextStart *time.Time `json:"next_start,omitempty"`
+}
+
+type MaintenanceWindowsResponse struct {
+	NodeId  string                      `json:"node_id"`
+	Windows []MaintenanceWindowResponse `json:"windows"`
+	// Maintenance is the window the node is in right now, if any
+	Maintenance *broker.MaintenanceStatus `json:"maintenance,omitempty"`
+}
+
+func newMaintenanceWindowResponse(window broker.MaintenanceWindow, now time.Time) MaintenanceWindowResponse {
+	response := MaintenanceWindowResponse{MaintenanceWindow: window}
+	if next, ok := window.NextStart(now); ok {
+		response.NextStart = &next
+	}
+	return response
+}
+
+// getMaintenanceWindows handles GET /admin/v1/nodes/:id/maintenance-windows
+func (s *Server) getMaintenanceWindows(ctx echo.Context) error {
+	nodeId := ctx.Param("id")
+	nodes, err := s.nodeBroker.GetNodes()
+	if err != nil {
+		logging.Error("Error reading nodes", types.Nodes, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var node *broker.NodeResponse
+	for i := range nodes {
+		if nodes[i].Node.Id == nodeId {
+			node = &nodes[i]
+			break
+		}
+	}
+	if node == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "node not found: "+nodeId)
+	}
+
+	now := time.Now()
+	response := MaintenanceWindowsResponse{
+		NodeId:      nodeId,
+		Windows:     make([]MaintenanceWindowResponse, 0),
+		Maintenance: node.State.Maintenance,
+	}
+	for _, window := range s.nodeBroker.MaintenanceWindows(nodeId) {
+		response.Windows = append(response.Windows, newMaintenanceWindowResponse(window, now))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// createMaintenanceWindow handles POST /admin/v1/nodes/:id/maintenance-windows
+func (s *Server) createMaintenanceWindow(ctx echo.Context) error {
+	nodeId := ctx.Param("id")
+	var request CreateMaintenanceWindowRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	drainLead := broker.DefaultMaintenanceDrainLead
+	if request.DrainLeadSeconds != nil {
+		drainLead = time.Duration(*request.DrainLeadSeconds) * time.Second
+	}
+	window, err := broker.NewMaintenanceWindow(nodeId, request.Schedule, time.Duration(request.DurationSeconds)*time.Second, drainLead)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := s.nodeBroker.AddMaintenanceWindow(ctx.Request().Context(), window); err != nil {
+		if errors.Is(err, broker.ErrNodeNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		logging.Error("Failed to add maintenance window", types.Nodes, "node_id", nodeId, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusCreated, newMaintenanceWindowResponse(window, time.Now()))
+}
+
+// deleteMaintenanceWindow handles DELETE /admin/v1/nodes/:id/maintenance-windows/:window_id
+func (s *Server) deleteMaintenanceWindow(ctx echo.Context) error {
+	nodeId := ctx.Param("id")
+	windowId := ctx.Param("window_id")
+	if err := s.nodeBroker.RemoveMaintenanceWindow(ctx.Request().Context(), nodeId, windowId); err != nil {
+		if errors.Is(err, broker.ErrMaintenanceWindowNotFound) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		logging.Error("Failed to remove maintenance window", types.Nodes, "node_id", nodeId, "window_id", windowId, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
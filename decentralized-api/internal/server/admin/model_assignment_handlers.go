@@ -0,0 +1,87 @@
+package admin
+
+import (
+	"decentralized-api/logging"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type SimulatedNodeAssignment struct {
+	NodeId             string `json:"node_id"`
+	PocWeight          int64  `json:"poc_weight"`
+	Throughput         int64  `json:"throughput"`
+	TimeslotAllocation []bool `json:"timeslot_allocation"`
+}
+
+type SimulatedModelAssignment struct {
+	ModelId string                    `json:"model_id"`
+	Nodes   []SimulatedNodeAssignment `json:"nodes"`
+}
+
+type SimulatedParticipantAssignment struct {
+	Participant string                     `json:"participant"`
+	Weight      int64                      `json:"weight"`
+	Models      []SimulatedModelAssignment `json:"models"`
+}
+
+type SimulateModelAssignmentResponse struct {
+	UpcomingEpochIndex uint64                           `json:"upcoming_epoch_index"`
+	Strategy           string                           `json:"strategy"`
+	Participants       []SimulatedParticipantAssignment `json:"participants"`
+}
+
+// getSimulateModelAssignment returns the chain's SimulateModelAssignment query, which runs model assignment
+// and PoC slot allocation read-only against current hardware nodes and governance models.
+// Query param "participant" limits the output to one participant ("all" returns everyone);
+// it defaults to this node's participant.
+func (s *Server) getSimulateModelAssignment(ctx echo.Context) error {
+	filter := ctx.QueryParam("participant")
+	if filter == "" {
+		filter = s.recorder.GetAccountAddress()
+	}
+	if filter == "all" {
+		filter = ""
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	simulated, err := queryClient.SimulateModelAssignment(ctx.Request().Context(), &types.QuerySimulateModelAssignmentRequest{Participant: filter})
+	if err != nil {
+		logging.Error("Failed to simulate model assignment", types.Allocation, "error", err)
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "failed to simulate model assignment")
+	}
+
+	response := SimulateModelAssignmentResponse{
+		UpcomingEpochIndex: simulated.UpcomingEpochIndex,
+		Strategy:           simulated.Strategy,
+		Participants:       make([]SimulatedParticipantAssignment, 0, len(simulated.Participants)),
+	}
+	for _, p := range simulated.Participants {
+		response.Participants = append(response.Participants, toSimulatedParticipantAssignment(p))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func toSimulatedParticipantAssignment(p *types.ActiveParticipant) SimulatedParticipantAssignment {
+	result := SimulatedParticipantAssignment{
+		Participant: p.Index,
+		Weight:      p.Weight,
+		Models:      make([]SimulatedModelAssignment, 0, len(p.Models)),
+	}
+	for i, modelId := range p.Models {
+		model := SimulatedModelAssignment{ModelId: modelId, Nodes: make([]SimulatedNodeAssignment, 0)}
+		if i < len(p.MlNodes) && p.MlNodes[i] != nil {
+			for _, node := range p.MlNodes[i].MlNodes {
+				model.Nodes = append(model.Nodes, SimulatedNodeAssignment{
+					NodeId:             node.NodeId,
+					PocWeight:          node.PocWeight,
+					Throughput:         node.Throughput,
+					TimeslotAllocation: node.TimeslotAllocation,
+				})
+			}
+		}
+		result.Models = append(result.Models, model)
+	}
+	return result
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"decentralized-api/internal/modelmanager"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ModelDownloadsResponse struct {
+	Enabled   bool                         `json:"enabled"`
+	Downloads []modelmanager.ModelDownload `json:"downloads"`
+}
+
+func (s *Server) getModelDownloads(ctx echo.Context) error {
+	if s.modelDownloads == nil {
+		return ctx.JSON(http.StatusOK, ModelDownloadsResponse{Enabled: false, Downloads: []modelmanager.ModelDownload{}})
+	}
+	return ctx.JSON(http.StatusOK, ModelDownloadsResponse{Enabled: true, Downloads: s.modelDownloads.ModelDownloads()})
+}
@@ -1,11 +1,14 @@
 package admin
 
 import (
+	"context"
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/logging"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/productscience/inference/x/inference/types"
@@ -43,24 +46,7 @@ func syncNodesWithConfig(nodeBroker *broker.Broker, config *apiconfig.ConfigMana
 	nodes, err := nodeBroker.GetNodes()
 	iNodes := make([]apiconfig.InferenceNodeConfig, len(nodes))
 	for i, n := range nodes {
-		node := n.Node
-
-		models := make(map[string]apiconfig.ModelConfig)
-		for model, cfg := range node.Models {
-			models[model] = apiconfig.ModelConfig{Args: cfg.Args}
-		}
-
-		iNodes[i] = apiconfig.InferenceNodeConfig{
-			Host:             node.Host,
-			InferenceSegment: node.InferenceSegment,
-			InferencePort:    node.InferencePort,
-			PoCSegment:       node.PoCSegment,
-			PoCPort:          node.PoCPort,
-			Models:           models,
-			Id:               node.Id,
-			MaxConcurrent:    node.MaxConcurrent,
-			Hardware:         node.Hardware,
-		}
+		iNodes[i] = nodeConfigOf(n.Node)
 	}
 	err = config.SetNodes(iNodes)
 	if err != nil {
@@ -68,6 +54,132 @@ func syncNodesWithConfig(nodeBroker *broker.Broker, config *apiconfig.ConfigMana
 	}
 }
 
+func nodeConfigOf(node broker.Node) apiconfig.InferenceNodeConfig {
+	models := make(map[string]apiconfig.ModelConfig)
+	for model, cfg := range node.Models {
+		models[model] = apiconfig.ModelConfig{Args: cfg.Args, Embedding: cfg.Embedding, Multimodal: cfg.Multimodal, ArgOverrides: cfg.ArgOverrides}
+	}
+
+	return apiconfig.InferenceNodeConfig{
+		Host:             node.Host,
+		InferenceSegment: node.InferenceSegment,
+		InferencePort:    node.InferencePort,
+		PoCSegment:       node.PoCSegment,
+		PoCPort:          node.PoCPort,
+		Models:           models,
+		Id:               node.Id,
+		MaxConcurrent:    node.MaxConcurrent,
+		Hardware:         node.Hardware,
+		Transport:        node.Transport,
+		GrpcPort:         node.GrpcPort,
+	}
+}
+
+// NodePatch holds the node fields updated by PATCH /admin/v1/nodes/:id. Omitted fields keep their
+// value; models and hardware are replaced as a whole when given.
+type NodePatch struct {
+	Host             *string                          `json:"host"`
+	InferenceSegment *string                          `json:"inference_segment"`
+	InferencePort    *int                             `json:"inference_port"`
+	PoCSegment       *string                          `json:"poc_segment"`
+	PoCPort          *int                             `json:"poc_port"`
+	Models           map[string]apiconfig.ModelConfig `json:"models"`
+	MaxConcurrent    *int                             `json:"max_concurrent"`
+	Hardware         []apiconfig.Hardware             `json:"hardware"`
+	Transport        *string                          `json:"transport"`
+	GrpcPort         *int                             `json:"grpc_port"`
+}
+
+func (p NodePatch) apply(node *apiconfig.InferenceNodeConfig) {
+	if p.Host != nil {
+		node.Host = *p.Host
+	}
+	if p.InferenceSegment != nil {
+		node.InferenceSegment = *p.InferenceSegment
+	}
+	if p.InferencePort != nil {
+		node.InferencePort = *p.InferencePort
+	}
+	if p.PoCSegment != nil {
+		node.PoCSegment = *p.PoCSegment
+	}
+	if p.PoCPort != nil {
+		node.PoCPort = *p.PoCPort
+	}
+	if p.Models != nil {
+		node.Models = p.Models
+	}
+	if p.MaxConcurrent != nil {
+		node.MaxConcurrent = *p.MaxConcurrent
+	}
+	if p.Hardware != nil {
+		node.Hardware = p.Hardware
+	}
+	if p.Transport != nil {
+		node.Transport = *p.Transport
+	}
+	if p.GrpcPort != nil {
+		node.GrpcPort = *p.GrpcPort
+	}
+}
+
+// patchNode handles PATCH /admin/v1/nodes/:id
+// It updates the given fields of a running node without a restart: the change is persisted, and when
+// it affects how inference runs (models, endpoints) the broker redeploys inference on the ML node right away.
+func (s *Server) patchNode(ctx echo.Context) error {
+	nodeId := ctx.Param("id")
+	var patch NodePatch
+	if err := ctx.Bind(&patch); err != nil {
+		logging.Error("Error decoding request", types.Nodes, "error", err)
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+	}
+
+	nodes, err := s.nodeBroker.GetNodes()
+	if err != nil {
+		logging.Error("Error reading nodes", types.Nodes, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to read nodes: %v", err))
+	}
+	var nodeConfig *apiconfig.InferenceNodeConfig
+	for _, n := range nodes {
+		if n.Node.Id == nodeId {
+			config := nodeConfigOf(n.Node)
+			nodeConfig = &config
+			break
+		}
+	}
+	if nodeConfig == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("node not found: %s", nodeId))
+	}
+	patch.apply(nodeConfig)
+
+	command := broker.NewUpdateNodeCommand(*nodeConfig)
+	if err := s.nodeBroker.QueueMessage(command); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to queue update command: %v", err))
+	}
+	response := <-command.Response
+	if response.Error != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("failed to update node: %v", response.Error))
+	}
+	logging.Info("Node reconfigured", types.Nodes, "node_id", nodeId)
+
+	syncNodesWithConfig(s.nodeBroker, s.configManager)
+	if err := s.persistNode(ctx.Request().Context(), *response.Node); err != nil {
+		logging.Error("Error persisting node", types.Nodes, "node_id", nodeId, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("node updated but not persisted: %v", err))
+	}
+	return ctx.JSON(http.StatusOK, response.Node)
+}
+
+// persistNode writes the node to the database right away instead of waiting for the next config flush
+func (s *Server) persistNode(ctx context.Context, node apiconfig.InferenceNodeConfig) error {
+	db := s.configManager.SqlDb()
+	if db == nil || db.GetDb() == nil {
+		logging.Warn("DB not initialized, node change is kept in memory only", types.Nodes, "node_id", node.Id)
+		return nil
+	}
+	return apiconfig.UpsertInferenceNodes(ctx, db.GetDb(), []apiconfig.InferenceNodeConfig{node})
+}
+
 func (s *Server) createNewNodes(ctx echo.Context) error {
 	var newNodes []apiconfig.InferenceNodeConfig
 	if err := ctx.Bind(&newNodes); err != nil {
@@ -253,6 +365,82 @@ func (s *Server) disableNode(c echo.Context) error {
 	})
 }
 
+// defaultDrainTimeout bounds how long drainNode waits for in-flight requests before answering
+const defaultDrainTimeout = 60 * time.Second
+
+// drainNode handles POST /admin/v1/nodes/:id/drain?timeout_seconds=N
+// The node stops receiving new inference work. Responds 200 once in-flight requests are done and
+// 202 when they are still running at the timeout; the node becomes DRAINED by itself afterwards.
+func (s *Server) drainNode(c echo.Context) error {
+	nodeId := c.Param("id")
+	if nodeId == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "node id is required",
+		})
+	}
+
+	timeout := defaultDrainTimeout
+	if value := c.QueryParam("timeout_seconds"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds < 0 {
+			return c.JSON(http.StatusBadRequest, map[string]string{
+				"error": "timeout_seconds must be a non-negative integer",
+			})
+		}
+		timeout = time.Duration(seconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+	defer cancel()
+	status, err := s.nodeBroker.DrainNode(ctx, nodeId)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	if status == broker.DrainStatusCancelled {
+		return c.JSON(http.StatusConflict, map[string]interface{}{
+			"message":      "drain was cancelled, node is back in rotation",
+			"node_id":      nodeId,
+			"drain_status": status,
+		})
+	}
+	if status != broker.DrainStatusDrained {
+		return c.JSON(http.StatusAccepted, map[string]interface{}{
+			"message":      "node is draining, in-flight requests are still running",
+			"node_id":      nodeId,
+			"drain_status": status,
+		})
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message":      "node drained successfully",
+		"node_id":      nodeId,
+		"drain_status": status,
+	})
+}
+
+// undrainNode handles POST /admin/v1/nodes/:id/undrain
+func (s *Server) undrainNode(c echo.Context) error {
+	nodeId := c.Param("id")
+	if nodeId == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "node id is required",
+		})
+	}
+
+	if err := s.nodeBroker.UndrainNode(nodeId); err != nil {
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"message": "node back in rotation",
+		"node_id": nodeId,
+	})
+}
+
 // exportDb returns a human-readable JSON snapshot of DB-backed dynamic config
 func (s *Server) exportDb(c echo.Context) error {
 	ctx := c.Request().Context()
@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"decentralized-api/internal/onboarding"
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var ErrOnboardingUnavailable = echo.NewHTTPError(http.StatusServiceUnavailable, "account onboarding is not enabled")
+
+type RejectRegistrationRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (s *Server) getOnboardingRegistrations(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	regs, err := s.onboarding.List(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+	if regs == nil {
+		regs = []onboarding.Registration{}
+	}
+	return ctx.JSON(http.StatusOK, regs)
+}
+
+func (s *Server) approveOnboardingRegistration(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	reg, err := s.onboarding.Approve(ctx.Request().Context(), ctx.Param("id"))
+	if err != nil {
+		return onboardingError(err)
+	}
+	logging.Info("Onboarding registration approved", types.Server, "id", reg.Id, "grantee", reg.GranteeAddress)
+	return ctx.JSON(http.StatusOK, reg)
+}
+
+func (s *Server) rejectOnboardingRegistration(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	var request RejectRegistrationRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	reg, err := s.onboarding.Reject(ctx.Request().Context(), ctx.Param("id"), request.Reason)
+	if err != nil {
+		return onboardingError(err)
+	}
+	logging.Info("Onboarding registration rejected", types.Server, "id", reg.Id, "reason", request.Reason)
+	return ctx.JSON(http.StatusOK, reg)
+}
+
+func onboardingError(err error) error {
+	switch {
+	case errors.Is(err, onboarding.ErrRegistrationNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, onboarding.ErrInvalidStatus):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return err
+	}
+}
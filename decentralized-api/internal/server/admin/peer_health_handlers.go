@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"decentralized-api/internal/peerhealth"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PeerHealthResponse struct {
+	Enabled bool                    `json:"enabled"`
+	Peers   []peerhealth.PeerStatus `json:"peers"`
+}
+
+func (s *Server) getPeerHealth(ctx echo.Context) error {
+	if s.peerHealth == nil {
+		return ctx.JSON(http.StatusOK, PeerHealthResponse{Enabled: false, Peers: []peerhealth.PeerStatus{}})
+	}
+	return ctx.JSON(http.StatusOK, PeerHealthResponse{Enabled: true, Peers: s.peerHealth.Report()})
+}
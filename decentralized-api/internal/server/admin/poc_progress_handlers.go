@@ -0,0 +1,93 @@
+package admin
+
+import (
+	"decentralized-api/broker"
+	"decentralized-api/chainphase"
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type PocNodeProgressResponse struct {
+	broker.PocNodeProgress
+	// EstimatedNonces projects the nonces the node will have generated when generation ends at its current rate
+	EstimatedNonces int64 `json:"estimated_nonces,omitempty"`
+}
+
+type PocProgressResponse struct {
+	StageHeight  int64            `json:"poc_stage_height"`
+	CurrentPhase types.EpochPhase `json:"current_phase"`
+	CurrentBlock int64            `json:"current_block"`
+	// GenerationEndHeight is the last block of nonce generation in the stage, GenerationEta its predicted time
+	GenerationEndHeight int64                     `json:"generation_end_height"`
+	GenerationEta       *time.Time                `json:"generation_eta,omitempty"`
+	Nodes               []PocNodeProgressResponse `json:"nodes"`
+}
+
+// generationEndHeight returns the last block of nonce generation of the regular or confirmation PoC stage
+func generationEndHeight(epochState *chainphase.EpochState) int64 {
+	if epochState.ActiveConfirmationPoCEvent != nil && epochState.CurrentPhase == types.InferencePhase {
+		return epochState.ActiveConfirmationPoCEvent.GetGenerationEnd(&epochState.LatestEpoch.EpochParams)
+	}
+	return epochState.LatestEpoch.EndOfPoCGeneration()
+}
+
+// getPocProgress handles GET /admin/v1/poc/progress
+func (s *Server) getPocProgress(ctx echo.Context) error {
+	phaseTracker := s.nodeBroker.GetPhaseTracker()
+	epochState := phaseTracker.GetCurrentEpochState()
+	if epochState.IsNilOrNotSynced() {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "epoch state is not synced yet")
+	}
+
+	progress := s.nodeBroker.PocProgress()
+	response := PocProgressResponse{
+		StageHeight:         progress.StageHeight,
+		CurrentPhase:        epochState.CurrentPhase,
+		CurrentBlock:        epochState.CurrentBlock.Height,
+		GenerationEndHeight: generationEndHeight(epochState),
+		Nodes:               make([]PocNodeProgressResponse, 0, len(progress.Nodes)),
+	}
+
+	var remaining time.Duration
+	if blocksLeft := response.GenerationEndHeight - response.CurrentBlock; blocksLeft > 0 {
+		if timeline := phaseTracker.GetPhaseTimeline(); timeline != nil && timeline.AverageBlockTimeMs > 0 {
+			remaining = time.Duration(blocksLeft*timeline.AverageBlockTimeMs) * time.Millisecond
+			eta := time.Now().Add(remaining)
+			response.GenerationEta = &eta
+		}
+	}
+
+	for _, node := range progress.Nodes {
+		nodeResponse := PocNodeProgressResponse{PocNodeProgress: node}
+		if node.Status == broker.PocProgressGenerating {
+			nodeResponse.EstimatedNonces = node.NoncesGenerated + int64(node.NoncesPerSecond*remaining.Seconds())
+		}
+		response.Nodes = append(response.Nodes, nodeResponse)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// abortNodePoC handles POST /admin/v1/nodes/:id/poc/abort
+// The node stops PoC work for the rest of the current stage and goes back to inference.
+func (s *Server) abortNodePoC(ctx echo.Context) error {
+	nodeId := ctx.Param("id")
+	if err := s.nodeBroker.AbortPoC(nodeId); err != nil {
+		switch {
+		case errors.Is(err, broker.ErrNodeNotFound):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, broker.ErrNoPocStage):
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		logging.Error("Failed to abort PoC", types.PoC, "node_id", nodeId, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"message": "PoC aborted, node is going back to inference",
+		"node_id": nodeId,
+	})
+}
@@ -4,10 +4,18 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/internal/audit"
+	"decentralized-api/internal/event_listener"
+	"decentralized-api/internal/metrics"
+	"decentralized-api/internal/modelmanager"
+	"decentralized-api/internal/onboarding"
+	"decentralized-api/internal/peerhealth"
 	"decentralized-api/internal/server/middleware"
 	pserver "decentralized-api/internal/server/public"
 	"decentralized-api/internal/validation"
 	"decentralized-api/payloadstorage"
+	"decentralized-api/upgrade"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 	blstypes "github.com/productscience/inference/x/bls/types"
@@ -33,6 +41,65 @@ type Server struct {
 	cdc            *codec.ProtoCodec
 	blockQueue     *pserver.BridgeQueue
 	payloadStorage payloadstorage.PayloadStorage
+	peerHealth     *peerhealth.Prober
+	modelDownloads *modelmanager.MLNodeBackgroundManager
+	apiKeys        *apikeys.Manager
+	auditLog       *audit.Log
+	eventReplay    *event_listener.EventListener
+	upgrades       *upgrade.Orchestrator
+	onboarding     *onboarding.Manager
+}
+
+// ServerOption configures optional Server dependencies.
+type ServerOption func(*Server)
+
+// WithPeerHealth exposes the peer health probing report.
+func WithPeerHealth(prober *peerhealth.Prober) ServerOption {
+	return func(s *Server) {
+		s.peerHealth = prober
+	}
+}
+
+// WithModelDownloads exposes the model download progress of the ML nodes.
+func WithModelDownloads(manager *modelmanager.MLNodeBackgroundManager) ServerOption {
+	return func(s *Server) {
+		s.modelDownloads = manager
+	}
+}
+
+// WithApiKeys enables management of the API keys of the public inference endpoints.
+func WithApiKeys(manager *apikeys.Manager) ServerOption {
+	return func(s *Server) {
+		s.apiKeys = manager
+	}
+}
+
+// WithAuditLog exposes the inference audit log.
+func WithAuditLog(log *audit.Log) ServerOption {
+	return func(s *Server) {
+		s.auditLog = log
+	}
+}
+
+// WithEventReplay enables replaying the Tx events of processed blocks.
+func WithEventReplay(listener *event_listener.EventListener) ServerOption {
+	return func(s *Server) {
+		s.eventReplay = listener
+	}
+}
+
+// WithUpgradeOrchestrator exposes the progress of scheduled upgrades and the ML node version rollback.
+func WithUpgradeOrchestrator(orchestrator *upgrade.Orchestrator) ServerOption {
+	return func(s *Server) {
+		s.upgrades = orchestrator
+	}
+}
+
+// WithOnboarding enables the admin approval of account onboarding registrations.
+func WithOnboarding(manager *onboarding.Manager) ServerOption {
+	return func(s *Server) {
+		s.onboarding = manager
+	}
 }
 
 func NewServer(
@@ -41,7 +108,8 @@ func NewServer(
 	configManager *apiconfig.ConfigManager,
 	validator *validation.InferenceValidator,
 	blockQueue *pserver.BridgeQueue,
-	payloadStorage payloadstorage.PayloadStorage) *Server {
+	payloadStorage payloadstorage.PayloadStorage,
+	opts ...ServerOption) *Server {
 	cdc := getCodec()
 
 	e := echo.New()
@@ -57,19 +125,37 @@ func NewServer(
 		payloadStorage: payloadStorage,
 	}
 
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	e.Use(middleware.LoggingMiddleware)
+	if !configManager.GetMetricsConfig().Disabled {
+		e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+	}
+
 	g := e.Group("/admin/v1/")
 
 	g.POST("nodes", s.createNewNode)
 	g.POST("nodes/batch", s.createNewNodes)
 	// For explicit updates, also allow PUT on a single node
 	g.PUT("nodes/:id", s.createNewNode)
+	g.PATCH("nodes/:id", s.patchNode)
 	g.GET("nodes/upgrade-status", s.getUpgradeStatus)
 	g.POST("nodes/version-status", s.postVersionStatus)
+	g.GET("upgrade/status", s.getUpgradeOrchestration)
+	g.POST("upgrade/rollback", s.postUpgradeRollback)
 	g.GET("nodes", s.getNodes)
 	g.DELETE("nodes/:id", s.deleteNode)
 	g.POST("nodes/:id/enable", s.enableNode)
 	g.POST("nodes/:id/disable", s.disableNode)
+	g.POST("nodes/:id/drain", s.drainNode)
+	g.POST("nodes/:id/undrain", s.undrainNode)
+	g.GET("nodes/:id/maintenance-windows", s.getMaintenanceWindows)
+	g.POST("nodes/:id/maintenance-windows", s.createMaintenanceWindow)
+	g.DELETE("nodes/:id/maintenance-windows/:window_id", s.deleteMaintenanceWindow)
+	g.POST("nodes/:id/poc/abort", s.abortNodePoC)
+	g.GET("poc/progress", s.getPocProgress)
 
 	g.POST("unit-of-compute-price-proposal", s.postUnitOfComputePriceProposal)
 	g.GET("unit-of-compute-price-proposal", s.getUnitOfComputePriceProposal)
@@ -90,6 +176,36 @@ func NewServer(
 	// Manual validation recovery and claim endpoint
 	g.POST("claim-reward/recover", s.postClaimRewardRecover)
 
+	// Validation queue inspection and dead-letter management
+	g.GET("validation/queue", s.getValidationQueue)
+	g.POST("validation/dead-letters/retry", s.retryDeadLetter)
+
+	// Dry run of model assignment and PoC slot allocation for the upcoming epoch
+	g.GET("model-assignment/simulate", s.getSimulateModelAssignment)
+
+	// Latest results of background health probes of other participants
+	g.GET("peers/health", s.getPeerHealth)
+
+	// Download queue and progress of configured models on the ML nodes
+	g.GET("models/downloads", s.getModelDownloads)
+
+	// API keys and per-key limits of the public inference endpoints
+	g.GET("api-keys", s.getApiKeys)
+	g.POST("api-keys", s.createApiKey)
+	g.PUT("api-keys/:id", s.updateApiKey)
+	g.DELETE("api-keys/:id", s.deleteApiKey)
+
+	// Local history of inference requests handled by this API
+	g.GET("audit/inferences", s.getAuditLog)
+
+	// Re-run event handlers on the Tx events of already processed blocks
+	g.POST("events/replay", s.postEventReplay)
+
+	// Approval of grantee account registrations
+	g.GET("onboarding/registrations", s.getOnboardingRegistrations)
+	g.POST("onboarding/registrations/:id/approve", s.approveOnboardingRegistration)
+	g.POST("onboarding/registrations/:id/reject", s.rejectOnboardingRegistration)
+
 	// EXPERIMENTAL: Setup and health report endpoint for participant onboarding
 	g.GET("setup/report", s.getSetupReport)
 
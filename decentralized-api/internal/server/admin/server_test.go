@@ -89,7 +89,7 @@ func setupTestServer(t *testing.T) (*Server, *apiconfig.ConfigManager, *mlnodecl
 		},
 	}, nil)
 	mockCosmos.On("NewInferenceQueryClient").Return(mockQueryClient)
-	bridge := broker.NewBrokerChainBridgeImpl(mockCosmos, "")
+	bridge := broker.NewBrokerChainBridgeImpl(mockCosmos, nil)
 	mockParticipant := &mockParticipantInfo{}
 	mockClientFactory := mlnodeclient.NewMockClientFactory()
 
@@ -226,3 +226,58 @@ func TestPostVersionStatus(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 }
+
+func TestPatchNode(t *testing.T) {
+	s, configManager, _ := setupTestServer(t)
+
+	nodeConfig := apiconfig.InferenceNodeConfig{
+		Id:            "node-1",
+		Host:          "localhost",
+		InferencePort: 8080,
+		PoCPort:       8081,
+		MaxConcurrent: 3,
+		Models: map[string]apiconfig.ModelConfig{
+			"test-model": {Args: []string{}},
+		},
+	}
+	assert.NoError(t, configManager.SetNodes([]apiconfig.InferenceNodeConfig{nodeConfig}))
+	select {
+	case response := <-s.nodeBroker.LoadNodeToBroker(&nodeConfig):
+		if response.Error != nil || response.Node == nil {
+			t.Fatal("failed to register node - node validation failed")
+		}
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out waiting for node to register")
+	}
+
+	patch := func(nodeId string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPatch, "/admin/v1/nodes/"+nodeId, bytes.NewReader([]byte(body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		s.e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("updates only the given fields", func(t *testing.T) {
+		rec := patch("node-1", `{"max_concurrent": 8, "models": {"test-model": {"args": ["--max-model-len", "4096"]}}}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		nodes := configManager.GetNodes()
+		assert.Len(t, nodes, 1)
+		assert.Equal(t, 8, nodes[0].MaxConcurrent)
+		assert.Equal(t, []string{"--max-model-len", "4096"}, nodes[0].Models["test-model"].Args)
+		assert.Equal(t, "localhost", nodes[0].Host)
+		assert.Equal(t, 8080, nodes[0].InferencePort)
+	})
+
+	t.Run("unknown model is rejected", func(t *testing.T) {
+		rec := patch("node-1", `{"models": {"other-model": {"args": []}}}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, configManager.GetNodes()[0].Models, "test-model")
+	})
+
+	t.Run("unknown node", func(t *testing.T) {
+		rec := patch("node-2", `{"max_concurrent": 8}`)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
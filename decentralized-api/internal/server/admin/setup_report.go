@@ -4,6 +4,7 @@ import (
 	"context"
 	"decentralized-api/apiconfig"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/nodeaddr"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -319,7 +320,7 @@ func (s *Server) checkConsensusKey(ctx context.Context) []Check {
 	checks := []Check{}
 
 	// Get consensus key from local node
-	chainNodeUrl := s.configManager.GetChainNodeConfig().Url
+	chainNodeUrl := s.configManager.GetChainEndpoints().Current()
 	rpcClient, err := cosmosclient.NewRpcClient(chainNodeUrl)
 	if err != nil {
 		checks = append(checks, Check{
@@ -629,7 +630,7 @@ func (s *Server) checkMLNodes(ctx context.Context) []Check {
 }
 
 func (s *Server) checkBlockSync(ctx context.Context) Check {
-	chainNodeUrl := s.configManager.GetChainNodeConfig().Url
+	chainNodeUrl := s.configManager.GetChainEndpoints().Current()
 	rpcClient, err := cosmosclient.NewRpcClient(chainNodeUrl)
 	if err != nil {
 		return Check{
@@ -784,17 +785,19 @@ func getPoCUrlWithVersion(node apiconfig.InferenceNodeConfig, version string) st
 }
 
 func getPoCUrl(node apiconfig.InferenceNodeConfig) string {
-	return formatURL(node.Host, node.PoCPort, node.PoCSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServicePoC, node.PoCPort)
+	return formatURL(host, port, node.PoCSegment)
 }
 
 func getPoCUrlVersioned(node apiconfig.InferenceNodeConfig, version string) string {
-	return formatURLWithVersion(node.Host, node.PoCPort, version, node.PoCSegment)
+	host, port := nodeaddr.Default.Resolve(node.Host, nodeaddr.ServicePoC, node.PoCPort)
+	return formatURLWithVersion(host, port, version, node.PoCSegment)
 }
 
 func formatURL(host string, port int, segment string) string {
-	return fmt.Sprintf("http://%s:%d%s", host, port, segment)
+	return nodeaddr.FormatURL(host, port, "", segment)
 }
 
 func formatURLWithVersion(host string, port int, version string, segment string) string {
-	return fmt.Sprintf("http://%s:%d/%s%s", host, port, version, segment)
+	return nodeaddr.FormatURL(host, port, version, segment)
 }
@@ -1,6 +1,8 @@
 package admin
 
 import (
+	"decentralized-api/upgrade"
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -33,3 +35,25 @@ func (s *Server) postVersionStatus(c echo.Context) error {
 	reports := s.nodeBroker.CheckVersionHealth(req.Version)
 	return c.JSON(http.StatusOK, reports)
 }
+
+// getUpgradeOrchestration returns the pre-flight checks and the ML node version switch of the latest upgrade plan
+func (s *Server) getUpgradeOrchestration(c echo.Context) error {
+	if s.upgrades == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Upgrade orchestration is not available")
+	}
+	return c.JSON(http.StatusOK, s.upgrades.Status())
+}
+
+// postUpgradeRollback switches the ML nodes back to the version they ran before the latest upgrade
+func (s *Server) postUpgradeRollback(c echo.Context) error {
+	if s.upgrades == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Upgrade orchestration is not available")
+	}
+	if err := s.upgrades.Rollback(); err != nil {
+		if errors.Is(err, upgrade.ErrNothingToRollBack) {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+	return c.JSON(http.StatusOK, s.upgrades.Status())
+}
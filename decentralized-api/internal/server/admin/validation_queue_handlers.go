@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"decentralized-api/internal/validation"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ValidationQueueResponse struct {
+	Pending     int                         `json:"pending"`
+	DeadLetters []validation.ValidationTask `json:"dead_letters"`
+}
+
+func (s *Server) getValidationQueue(ctx echo.Context) error {
+	queue := s.validator.Queue()
+	return ctx.JSON(http.StatusOK, ValidationQueueResponse{
+		Pending:     queue.PendingCount(),
+		DeadLetters: queue.DeadLetters(),
+	})
+}
+
+type RetryDeadLetterRequest struct {
+	InferenceId string `json:"inference_id"`
+}
+
+func (s *Server) retryDeadLetter(ctx echo.Context) error {
+	var req RetryDeadLetterRequest
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
+	}
+	if req.InferenceId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "inference_id is required")
+	}
+
+	if !s.validator.Queue().RetryDeadLetter(req.InferenceId) {
+		return echo.NewHTTPError(http.StatusNotFound, "inference is not in the dead-letter list")
+	}
+	return ctx.NoContent(http.StatusAccepted)
+}
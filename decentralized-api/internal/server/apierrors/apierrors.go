@@ -0,0 +1,191 @@
+// Package apierrors is the error model of the public API. Handlers may return any error: the error
+// handler maps it to an Error with a stable code, tells clients whether retrying can help and, when a
+// chain transaction failed, which one.
+package apierrors
+
+import (
+	"context"
+	"decentralized-api/broker"
+	"decentralized-api/cosmosclient/tx_manager"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Code identifies the kind of failure. Codes are part of the API: add new ones, never rename.
+type Code string
+
+const (
+	CodeInvalidRequest      Code = "invalid_request"
+	CodeUnauthorized        Code = "unauthorized"
+	CodeInsufficientBalance Code = "insufficient_balance"
+	CodeForbidden           Code = "forbidden"
+	CodeNotFound            Code = "not_found"
+	CodeConflict            Code = "conflict"
+	CodeRateLimited         Code = "rate_limited"
+	// CodeNoCapacity means no ML node of this participant can serve the request right now
+	CodeNoCapacity Code = "no_capacity"
+	// CodeUpstreamError means an ML node failed the request
+	CodeUpstreamError Code = "upstream_error"
+	// CodeUpstreamUnavailable means an ML node could not be reached
+	CodeUpstreamUnavailable Code = "upstream_unavailable"
+	CodeChainUnavailable    Code = "chain_unavailable"
+	CodeChainTxFailed       Code = "chain_tx_failed"
+	CodeTimeout             Code = "timeout"
+	CodeCancelled           Code = "cancelled"
+	CodeUnavailable         Code = "unavailable"
+	CodeInternal            Code = "internal"
+)
+
+// StatusClientClosedRequest is returned when the client went away before the response
+const StatusClientClosedRequest = 499
+
+type Error struct {
+	Status      int
+	Code        Code
+	Message     string
+	Retriable   bool
+	ChainTxHash string
+	cause       error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message, Retriable: retriableStatus(status)}
+}
+
+// Upstream maps the error status an ML node answered with: a rejected payload is the client's error
+// and passed through, an overloaded node means no capacity, anything else is a retriable 502
+func Upstream(nodeStatus int, message string) *Error {
+	switch nodeStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return New(nodeStatus, CodeInvalidRequest, message)
+	case http.StatusTooManyRequests:
+		return New(http.StatusServiceUnavailable, CodeNoCapacity, message)
+	default:
+		return New(http.StatusBadGateway, CodeUpstreamError, message)
+	}
+}
+
+// From maps any error returned by a handler to an Error
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var txErr *tx_manager.TransactionError
+	if errors.As(err, &txErr) {
+		e := wrap(err, http.StatusBadGateway, CodeChainTxFailed, false)
+		e.ChainTxHash = txErr.TxHash
+		return e
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		e := New(he.Code, codeForStatus(he.Code), httpErrorMessage(he))
+		e.cause = err
+		return e
+	}
+
+	if errors.Is(err, broker.ErrNoNodesAvailable) {
+		return wrap(err, http.StatusServiceUnavailable, CodeNoCapacity, true)
+	}
+	var actionErr *broker.ActionError
+	if errors.As(err, &actionErr) {
+		switch actionErr.Kind {
+		case broker.ActionErrorTransport:
+			return wrap(err, http.StatusBadGateway, CodeUpstreamUnavailable, true)
+		case broker.ActionErrorCancelled:
+			return wrap(err, StatusClientClosedRequest, CodeCancelled, false)
+		}
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return wrap(err, http.StatusGatewayTimeout, CodeTimeout, true)
+	case errors.Is(err, context.Canceled):
+		return wrap(err, StatusClientClosedRequest, CodeCancelled, false)
+	}
+
+	// Chain queries fail with gRPC statuses
+	if st, ok := status.FromError(err); ok && st.Code() != codes.Unknown {
+		switch st.Code() {
+		case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+			return wrap(err, http.StatusServiceUnavailable, CodeChainUnavailable, true)
+		case codes.DeadlineExceeded:
+			return wrap(err, http.StatusGatewayTimeout, CodeTimeout, true)
+		case codes.NotFound:
+			return wrap(err, http.StatusNotFound, CodeNotFound, false)
+		case codes.InvalidArgument:
+			return wrap(err, http.StatusBadRequest, CodeInvalidRequest, false)
+		}
+	}
+
+	return wrap(err, http.StatusInternalServerError, CodeInternal, false)
+}
+
+func wrap(err error, status int, code Code, retriable bool) *Error {
+	return &Error{Status: status, Code: code, Message: err.Error(), Retriable: retriable, cause: err}
+}
+
+func httpErrorMessage(he *echo.HTTPError) string {
+	switch msg := he.Message.(type) {
+	case nil:
+		return http.StatusText(he.Code)
+	case string:
+		return msg
+	case error:
+		return msg.Error()
+	default:
+		return fmt.Sprint(msg)
+	}
+}
+
+func codeForStatus(status int) Code {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType:
+		return CodeInvalidRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusPaymentRequired:
+		return CodeInsufficientBalance
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusBadGateway:
+		return CodeUpstreamError
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeTimeout
+	}
+	if status < 500 {
+		return CodeInvalidRequest
+	}
+	return CodeInternal
+}
+
+func retriableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
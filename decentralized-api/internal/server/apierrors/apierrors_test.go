@@ -0,0 +1,78 @@
+package apierrors
+
+import (
+	"context"
+	"decentralized-api/broker"
+	"decentralized-api/cosmosclient/tx_manager"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFrom(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		status    int
+		code      Code
+		retriable bool
+	}{
+		{"rate limited", echo.NewHTTPError(http.StatusTooManyRequests, "slow down"), http.StatusTooManyRequests, CodeRateLimited, true},
+		{"bad request", echo.NewHTTPError(http.StatusBadRequest, "bad"), http.StatusBadRequest, CodeInvalidRequest, false},
+		{"no nodes", fmt.Errorf("acquire: %w", broker.ErrNoNodesAvailable), http.StatusServiceUnavailable, CodeNoCapacity, true},
+		{"transport", &broker.ActionError{Kind: broker.ActionErrorTransport, Err: errors.New("refused")}, http.StatusBadGateway, CodeUpstreamUnavailable, true},
+		{"deadline", context.DeadlineExceeded, http.StatusGatewayTimeout, CodeTimeout, true},
+		{"chain unavailable", status.Error(codes.Unavailable, "down"), http.StatusServiceUnavailable, CodeChainUnavailable, true},
+		{"upstream", Upstream(http.StatusInternalServerError, "oom"), http.StatusBadGateway, CodeUpstreamError, true},
+		{"upstream rejected", Upstream(http.StatusBadRequest, "bad prompt"), http.StatusBadRequest, CodeInvalidRequest, false},
+		{"plain", errors.New("boom"), http.StatusInternalServerError, CodeInternal, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := From(tt.err)
+			require.Equal(t, tt.status, e.Status)
+			require.Equal(t, tt.code, e.Code)
+			require.Equal(t, tt.retriable, e.Retriable)
+		})
+	}
+
+	e := From(fmt.Errorf("start inference: %w", &tx_manager.TransactionError{TxHash: "ABCD", Code: 5}))
+	require.Equal(t, CodeChainTxFailed, e.Code)
+	require.Equal(t, "ABCD", e.ChainTxHash)
+}
+
+func serve(t *testing.T, path string, err error) (int, map[string]any) {
+	t.Helper()
+	e := echo.New()
+	e.HTTPErrorHandler = Handler(func(c echo.Context) bool { return c.Path() == "/v1/chat/completions" })
+	e.POST(path, func(c echo.Context) error { return err })
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, nil))
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return rec.Code, body
+}
+
+func TestHandler(t *testing.T) {
+	status, body := serve(t, "/v1/chat/completions", broker.ErrNoNodesAvailable)
+	require.Equal(t, http.StatusServiceUnavailable, status)
+	openAiErr := body["error"].(map[string]any)
+	require.Equal(t, "server_error", openAiErr["type"])
+	require.Equal(t, string(CodeNoCapacity), openAiErr["code"])
+	require.Equal(t, true, openAiErr["retriable"])
+	require.Contains(t, openAiErr, "param")
+
+	status, body = serve(t, "/v1/epochs/current", &tx_manager.TransactionError{TxHash: "ABCD"})
+	require.Equal(t, http.StatusBadGateway, status)
+	require.Equal(t, string(CodeChainTxFailed), body["code"])
+	require.Equal(t, "ABCD", body["chain_tx_hash"])
+	require.Contains(t, body["error"], "transaction ABCD failed")
+}
@@ -0,0 +1,74 @@
+package apierrors
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Body is the error response of the public endpoints. Error keeps the message string that clients
+// parsed before the typed fields were added.
+type Body struct {
+	Error       string `json:"error"`
+	Code        Code   `json:"code"`
+	Retriable   bool   `json:"retriable"`
+	ChainTxHash string `json:"chain_tx_hash,omitempty"`
+}
+
+// OpenAiBody is the error response of the OpenAI-compatible endpoints, see
+// https://platform.openai.com/docs/guides/error-codes. Retriable and ChainTxHash are extensions.
+type OpenAiBody struct {
+	Error OpenAiError `json:"error"`
+}
+
+type OpenAiError struct {
+	Message     string  `json:"message"`
+	Type        string  `json:"type"`
+	Param       *string `json:"param"`
+	Code        Code    `json:"code"`
+	Retriable   bool    `json:"retriable"`
+	ChainTxHash string  `json:"chain_tx_hash,omitempty"`
+}
+
+// Handler returns an echo error handler writing Body, or OpenAiBody for the requests isOpenAi matches
+func Handler(isOpenAi func(c echo.Context) bool) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		// Avoid double responses, e.g. when a stream already started
+		if c.Response().Committed {
+			return
+		}
+		e := From(err)
+		if c.Request().Method == http.MethodHead {
+			_ = c.NoContent(e.Status)
+			return
+		}
+		if isOpenAi != nil && isOpenAi(c) {
+			_ = c.JSON(e.Status, OpenAiBody{Error: OpenAiError{
+				Message:     e.Message,
+				Type:        openAiType(e.Status),
+				Code:        e.Code,
+				Retriable:   e.Retriable,
+				ChainTxHash: e.ChainTxHash,
+			}})
+			return
+		}
+		_ = c.JSON(e.Status, Body{Error: e.Message, Code: e.Code, Retriable: e.Retriable, ChainTxHash: e.ChainTxHash})
+	}
+}
+
+func openAiType(status int) string {
+	switch {
+	case status == http.StatusUnauthorized:
+		return "authentication_error"
+	case status == http.StatusForbidden:
+		return "permission_error"
+	case status == http.StatusNotFound:
+		return "not_found_error"
+	case status == http.StatusTooManyRequests:
+		return "rate_limit_error"
+	case status < 500:
+		return "invalid_request_error"
+	default:
+		return "server_error"
+	}
+}
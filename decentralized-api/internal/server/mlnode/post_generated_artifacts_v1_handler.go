@@ -54,7 +54,11 @@ func (s *Server) postGeneratedBatchesV1(ctx echo.Context) error {
 		NodeId:                   nodeId,
 	}
 
-	if err := s.recorder.SubmitPocBatch(msg); err != nil {
+	err := s.recorder.SubmitPocBatch(msg)
+	if found {
+		s.broker.RecordPoCGeneratedBatch(nodeId, body.BlockHeight, len(body.Nonces), err)
+	}
+	if err != nil {
 		logging.Error("ProofBatchV1-callback. Failed to submit MsgSubmitPocBatch", types.PoC, "error", err)
 		return err
 	}
@@ -84,6 +84,7 @@ func (s *Server) postGeneratedArtifactsV2(ctx echo.Context) error {
 	// Store commits (MsgPoCV2StoreCommit) are submitted by CommitWorker
 	// Weight distributions (MsgMLNodeWeightDistribution) are submitted at end of generation
 	totalCount, nodeDistribution := s.addToLocalStorage(body.BlockHeight, nodeId, protoArtifacts)
+	s.broker.RecordPoCGeneratedBatch(nodeId, body.BlockHeight, len(protoArtifacts), nil)
 
 	logging.Debug("ArtifactBatchV2-callback. Stored locally", types.PoC,
 		"blockHeight", body.BlockHeight,
@@ -0,0 +1,66 @@
+package public
+
+import (
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// batchApiKeyContextKey carries the API key id of the batch an item runs for, see RunBatchItem
+type batchApiKeyContextKey struct{}
+
+// checkApiKey authenticates the X-Api-Key header of a transfer request and counts the request
+// against the QPS limit of the key. It returns the key id, or "" for a request without a key
+// when keys aren't required. Batch items run for the key that created the batch, they are paced
+// by the batch concurrency instead of the QPS limit.
+func (s *Server) checkApiKey(ctx echo.Context) (string, error) {
+	if id, ok := ctx.Request().Context().Value(batchApiKeyContextKey{}).(string); ok {
+		return id, nil
+	}
+	secret := ctx.Request().Header.Get(utils.XApiKeyHeader)
+	if secret == "" {
+		if s.configManager.GetApiKeysConfig().Required {
+			return "", ErrApiKeyRequired
+		}
+		return "", nil
+	}
+	if s.apiKeys == nil {
+		logging.Warn("API key sent but API keys are not available", types.Server)
+		return "", ErrInvalidApiKey
+	}
+
+	id, ok := s.apiKeys.Authenticate(secret)
+	if !ok {
+		return "", ErrInvalidApiKey
+	}
+	if wait, ok := s.apiKeys.AllowRequest(id); !ok {
+		return "", tooManyRequests(ctx, wait, fmt.Sprintf("API key %s is over its QPS limit", id))
+	}
+	return id, nil
+}
+
+// checkApiKeyTokens counts the tokens the request may consume, prompt and max tokens, against the
+// per-minute token limit of the key
+func (s *Server) checkApiKeyTokens(ctx echo.Context, apiKeyId string, tokens int64) error {
+	if apiKeyId == "" {
+		return nil
+	}
+	if wait, ok := s.apiKeys.AllowTokens(apiKeyId, tokens); !ok {
+		return tooManyRequests(ctx, wait, fmt.Sprintf("API key %s is over its token limit", apiKeyId))
+	}
+	return nil
+}
+
+func tooManyRequests(ctx echo.Context, wait time.Duration, message string) error {
+	retryAfter := apikeys.RetryAfterSeconds(wait)
+	logging.Info("Rate limited request", types.Server, "reason", message, "retryAfterSeconds", retryAfter)
+	ctx.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(retryAfter))
+	return echo.NewHTTPError(http.StatusTooManyRequests, message)
+}
@@ -0,0 +1,38 @@
+package public
+
+import (
+	"decentralized-api/internal/audit"
+	"decentralized-api/internal/server/middleware"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) newAuditEntry(role audit.Role, request *ChatRequest) *audit.Entry {
+	return &audit.Entry{
+		InferenceId:      request.InferenceId,
+		Role:             role,
+		Endpoint:         string(request.Endpoint),
+		Model:            request.OpenAiRequest.Model,
+		RequesterAddress: request.RequesterAddress,
+		TransferAddress:  request.TransferAddress,
+		StartedAt:        time.Now(),
+	}
+}
+
+// recordAudit completes the entry with the outcome of the request and queues it, if the audit log is enabled
+func (s *Server) recordAudit(ctx echo.Context, entry *audit.Entry, err error) {
+	if s.auditLog == nil {
+		return
+	}
+	entry.DurationMs = time.Since(entry.StartedAt).Milliseconds()
+	if err != nil {
+		status, message := middleware.ExtractError(err)
+		entry.StatusCode = status
+		entry.Error = fmt.Sprint(message)
+	} else {
+		entry.StatusCode = ctx.Response().Status
+	}
+	s.auditLog.Record(*entry)
+}
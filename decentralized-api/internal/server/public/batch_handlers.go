@@ -0,0 +1,259 @@
+package public
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/internal/batches"
+	"decentralized-api/internal/server/apierrors"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+var ErrBatchesUnavailable = echo.NewHTTPError(http.StatusNotFound, "batch inference is not enabled")
+
+type BatchList struct {
+	Object  string          `json:"object"`
+	Data    []batches.Batch `json:"data"`
+	HasMore bool            `json:"has_more"`
+}
+
+// batchApiKey authenticates the requests of the files and batches endpoints. Batches belong to the
+// API key that created them, so a key is required even when inference requests may go without one.
+func (s *Server) batchApiKey(ctx echo.Context) (string, error) {
+	if s.batches == nil {
+		return "", ErrBatchesUnavailable
+	}
+	id, err := s.checkApiKey(ctx)
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", ErrApiKeyRequired
+	}
+	return id, nil
+}
+
+func (s *Server) postFile(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "file is required")
+	}
+	content, err := header.Open()
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	file, err := s.batches.CreateFile(ctx.Request().Context(), apiKeyId, header.Filename, ctx.FormValue("purpose"), content)
+	if err != nil {
+		return batchError(err)
+	}
+	return ctx.JSON(http.StatusOK, file)
+}
+
+func (s *Server) getFile(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	file, err := s.batches.GetFile(ctx.Request().Context(), apiKeyId, ctx.Param("id"))
+	if err != nil {
+		return batchError(err)
+	}
+	return ctx.JSON(http.StatusOK, file)
+}
+
+func (s *Server) getFileContent(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	content, err := s.batches.OpenFile(ctx.Request().Context(), apiKeyId, ctx.Param("id"))
+	if err != nil {
+		return batchError(err)
+	}
+	defer content.Close()
+	return ctx.Stream(http.StatusOK, "application/jsonl", content)
+}
+
+func (s *Server) postBatch(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	var request batches.CreateBatchRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	batch, err := s.batches.CreateBatch(ctx.Request().Context(), apiKeyId, request)
+	if err != nil {
+		return batchError(err)
+	}
+	logging.Info("Batch created", types.Inferences, "batchId", batch.Id, "apiKeyId", apiKeyId, "requests", batch.RequestCounts.Total)
+	return ctx.JSON(http.StatusOK, batch)
+}
+
+func (s *Server) getBatches(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	list, err := s.batches.ListBatches(ctx.Request().Context(), apiKeyId)
+	if err != nil {
+		return batchError(err)
+	}
+	if list == nil {
+		list = []batches.Batch{}
+	}
+	return ctx.JSON(http.StatusOK, BatchList{Object: "list", Data: list})
+}
+
+func (s *Server) getBatch(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	batch, err := s.batches.GetBatch(ctx.Request().Context(), apiKeyId, ctx.Param("id"))
+	if err != nil {
+		return batchError(err)
+	}
+	return ctx.JSON(http.StatusOK, batch)
+}
+
+func (s *Server) postBatchCancel(ctx echo.Context) error {
+	apiKeyId, err := s.batchApiKey(ctx)
+	if err != nil {
+		return err
+	}
+	batch, err := s.batches.CancelBatch(ctx.Request().Context(), apiKeyId, ctx.Param("id"))
+	if err != nil {
+		return batchError(err)
+	}
+	logging.Info("Batch cancelled", types.Inferences, "batchId", batch.Id, "apiKeyId", apiKeyId)
+	return ctx.JSON(http.StatusOK, batch)
+}
+
+func batchError(err error) error {
+	switch {
+	case errors.Is(err, batches.ErrNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, batches.ErrInvalidBatch):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, batches.ErrInvalidStatus):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	}
+	return err
+}
+
+// BatchCapacityAvailable dispatches batch items only in the inference phase, the ML nodes are busy with
+// PoC otherwise
+func (s *Server) BatchCapacityAvailable() bool {
+	state := s.phaseTracker.GetCurrentEpochState()
+	return !state.IsNilOrNotSynced() && state.CurrentPhase == types.InferencePhase
+}
+
+// RunBatchItem runs a batch item as a transfer request of this node's account, in the batch priority class.
+// Developer signatures expire within minutes, so items can't be signed by the developer when the batch is
+// created: this node requests and pays for them and its API key limits stand for the developer's.
+func (s *Server) RunBatchItem(ctx context.Context, item batches.Item) batches.Result {
+	ctx = context.WithValue(ctx, batchApiKeyContextKey{}, item.ApiKeyId)
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, item.Endpoint, http.NoBody)
+	if err != nil {
+		return batches.Result{StatusCode: http.StatusInternalServerError, Body: []byte(err.Error())}
+	}
+	writer := &batchResponseWriter{header: make(http.Header)}
+	c := s.e.NewContext(request, writer)
+	c.SetPath(item.Endpoint)
+	err = s.runBatchItem(c, item)
+	if err != nil {
+		// Answer the error like the endpoint would, in the OpenAI format
+		s.e.HTTPErrorHandler(err, c)
+	}
+	result := batches.Result{
+		// The inference id of a transfer request is the requester's signature
+		InferenceId: request.Header.Get(utils.AuthorizationHeader),
+		StatusCode:  writer.status,
+		Body:        writer.body.Bytes(),
+		Retriable:   err != nil && apierrors.From(err).Retriable,
+	}
+	if result.StatusCode == 0 {
+		result.StatusCode = http.StatusOK
+	}
+	return result
+}
+
+func (s *Server) runBatchItem(c echo.Context, item batches.Item) error {
+	body, err := batchItemBody(item.Body)
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().UnixNano()
+	signature, err := s.calculateSignature(utils.GenerateSHA256Hash(string(body)), timestamp, s.recorder.GetAccountAddress(), "", calculations.Developer)
+	if err != nil {
+		return err
+	}
+
+	request := c.Request()
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.ContentLength = int64(len(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	request.Header.Set(utils.AuthorizationHeader, signature)
+	request.Header.Set(utils.XRequesterAddressHeader, s.recorder.GetSignerAddress())
+	request.Header.Set(utils.XTimestampHeader, strconv.FormatInt(timestamp, 10))
+
+	if item.Endpoint == string(EmbeddingsEndpoint) {
+		return s.postInference(c, EmbeddingsEndpoint)
+	}
+	return s.postInference(c, ChatCompletionsEndpoint)
+}
+
+// batchItemBody runs the item in the batch priority class, without streaming: the output file holds
+// whole responses
+func batchItemBody(raw []byte) ([]byte, error) {
+	var body map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &body); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	body["priority_class"] = json.RawMessage(strconv.Quote(types.PriorityClassBatch))
+	delete(body, "stream")
+	delete(body, "stream_options")
+	return json.Marshal(body)
+}
+
+// batchResponseWriter keeps the response to a batch item in memory for the output file
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *batchResponseWriter) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(p)
+}
@@ -0,0 +1,21 @@
+package public
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchItemBody(t *testing.T) {
+	body, err := batchItemBody([]byte(`{"model": "test", "messages": [], "stream": true, "stream_options": {"include_usage": true}, "priority_class": "realtime"}`))
+	require.NoError(t, err)
+
+	request, err := readRequest(createTestRequest(body), nil, "transfer", ChatCompletionsEndpoint)
+	require.NoError(t, err)
+	require.Equal(t, types.PriorityClassBatch, request.OpenAiRequest.priorityClass())
+	require.NotContains(t, string(body), "stream")
+
+	_, err = batchItemBody([]byte(`[]`))
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package public
 import (
 	"context"
 	"encoding/hex"
+	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -11,8 +12,13 @@ import (
 
 	"github.com/labstack/echo/v4"
 	blsTypes "github.com/productscience/inference/x/bls/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxGroupKeyHistoryLimit bounds how many epochs one group key history request walks
+const maxGroupKeyHistoryLimit = 100
+
 // getBLSEpochByID handles requests for BLS epoch data
 func (s *Server) getBLSEpochByID(c echo.Context) error {
 	idStr := c.Param("id")
@@ -82,3 +88,64 @@ func (s *Server) getBLSSignatureByRequestID(c echo.Context) error {
 		"uncompressed_signature_128": uncompressedSig, // base64-encoded in JSON
 	})
 }
+
+// getBLSGroupKeyHistory returns the group public keys of completed DKGs from the `from` epoch (default 1) onwards,
+// each with the validation signature made by the previous epoch's group key, so clients can verify the chain
+// of custody without replaying blocks. `limit` caps the number of epochs walked; `next_from` continues the walk.
+func (s *Server) getBLSGroupKeyHistory(c echo.Context) error {
+	from := uint64(1)
+	if fromStr := c.QueryParam("from"); fromStr != "" {
+		parsed, err := strconv.ParseUint(fromStr, 10, 64)
+		if err != nil || parsed == 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid from epoch")
+		}
+		from = parsed
+	}
+	limit := uint64(maxGroupKeyHistoryLimit)
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsed, err := strconv.ParseUint(limitStr, 10, 64)
+		if err != nil || parsed == 0 || parsed > maxGroupKeyHistoryLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid limit, must be between 1 and %d", maxGroupKeyHistoryLimit))
+		}
+		limit = parsed
+	}
+
+	records, nextFrom, err := collectGroupKeyHistory(c.Request().Context(), s.recorder.NewBLSQueryClient(), from, limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to query BLS group key history: "+err.Error())
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"group_keys": records,
+		"next_from":  nextFrom,
+	})
+}
+
+// collectGroupKeyHistory walks up to limit epochs starting at from and returns the records of completed DKGs.
+// nextFrom is the epoch to continue from, or 0 once the walk reached an epoch without DKG data.
+func collectGroupKeyHistory(ctx context.Context, client blsTypes.QueryClient, from, limit uint64) ([]blsTypes.GroupKeyRecord, uint64, error) {
+	records := []blsTypes.GroupKeyRecord{}
+	for epochID := from; epochID < from+limit; epochID++ {
+		res, err := client.EpochBLSData(ctx, &blsTypes.QueryEpochBLSDataRequest{EpochId: epochID})
+		if err != nil {
+			if grpcStatus, ok := status.FromError(err); ok && grpcStatus.Code() == codes.NotFound {
+				return records, 0, nil
+			}
+			return nil, 0, err
+		}
+		data := res.EpochData
+		if data.DkgPhase != blsTypes.DKGPhase_DKG_PHASE_COMPLETED && data.DkgPhase != blsTypes.DKGPhase_DKG_PHASE_SIGNED {
+			continue
+		}
+		record := blsTypes.GroupKeyRecord{
+			EpochId:             data.EpochId,
+			DkgPhase:            data.DkgPhase,
+			GroupPublicKey:      data.GroupPublicKey,
+			ValidationSignature: data.ValidationSignature,
+		}
+		if len(data.ValidationSignature) > 0 {
+			record.ValidatedByEpochId = data.EpochId - 1
+		}
+		records = append(records, record)
+	}
+	return records, from + limit, nil
+}
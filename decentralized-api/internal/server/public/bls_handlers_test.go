@@ -0,0 +1,48 @@
+package public
+
+import (
+	"context"
+	"testing"
+
+	blsTypes "github.com/productscience/inference/x/bls/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type fakeBLSQueryClient struct {
+	blsTypes.QueryClient
+	epochs map[uint64]blsTypes.EpochBLSData
+}
+
+func (f *fakeBLSQueryClient) EpochBLSData(_ context.Context, req *blsTypes.QueryEpochBLSDataRequest, _ ...grpc.CallOption) (*blsTypes.QueryEpochBLSDataResponse, error) {
+	data, ok := f.epochs[req.EpochId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "no DKG data found")
+	}
+	return &blsTypes.QueryEpochBLSDataResponse{EpochData: data}, nil
+}
+
+func TestCollectGroupKeyHistory(t *testing.T) {
+	client := &fakeBLSQueryClient{epochs: map[uint64]blsTypes.EpochBLSData{
+		1: {EpochId: 1, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key1")},
+		2: {EpochId: 2, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: []byte("key2"), ValidationSignature: []byte("sig2")},
+		3: {EpochId: 3, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_FAILED},
+		4: {EpochId: 4, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_DEALING},
+	}}
+
+	records, nextFrom, err := collectGroupKeyHistory(context.Background(), client, 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), nextFrom)
+	require.Equal(t, []blsTypes.GroupKeyRecord{
+		{EpochId: 1, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key1")},
+		{EpochId: 2, DkgPhase: blsTypes.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: []byte("key2"), ValidationSignature: []byte("sig2"), ValidatedByEpochId: 1},
+	}, records)
+
+	// Failed and unfinished DKGs are skipped, the walk ends at the first epoch without data
+	records, nextFrom, err = collectGroupKeyHistory(context.Background(), client, 3, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), nextFrom)
+	require.Empty(t, records)
+}
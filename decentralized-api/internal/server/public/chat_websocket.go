@@ -0,0 +1,271 @@
+package public
+
+import (
+	"bytes"
+	"context"
+	"decentralized-api/internal/server/middleware"
+	"decentralized-api/logging"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// WebSocket protocol of /v1/chat/completions/ws:
+//
+//	client -> {"type": "request", "headers": {"Authorization": "...", ...}, "body": {...}}
+//	server -> {"type": "chunk", "data": {...}}      one per streamed completion delta
+//	server -> {"type": "response", "data": {...}}   the completion, for non-streaming requests
+//	server -> {"type": "error", "status": 400, "error": ...}
+//	server -> {"type": "done"}
+//	client -> {"type": "cancel"}                    stops the completion mid-stream
+//
+// The request is handled exactly like a POST to /v1/chat/completions. Its headers default to the
+// headers of the upgrade request, since browsers cannot set custom headers on WebSockets. The body
+// is forwarded byte for byte, so it must be the exact JSON the request signature covers.
+const (
+	wsMessageRequest  = "request"
+	wsMessageCancel   = "cancel"
+	wsMessageChunk    = "chunk"
+	wsMessageResponse = "response"
+	wsMessageError    = "error"
+	wsMessageDone     = "done"
+
+	wsRequestTimeout = 30 * time.Second
+	wsWriteTimeout   = 10 * time.Second
+)
+
+type wsClientMessage struct {
+	Type    string            `json:"type"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+type wsServerMessage struct {
+	Type   string          `json:"type"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Status int             `json:"status,omitempty"`
+	Error  interface{}     `json:"error,omitempty"`
+}
+
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  4096,
+	WriteBufferSize: 4096,
+	// Requests are authenticated by their signature, not by cookies, so any origin may connect
+	CheckOrigin: func(r *http.Request) bool { return true },
+}
+
+func (s *Server) chatCompletionsWebSocket(c echo.Context) error {
+	conn, err := wsUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		logging.Warn("Failed to upgrade chat completions WebSocket", types.Server, "error", err)
+		return nil
+	}
+	defer conn.Close()
+
+	send := func(msg wsServerMessage) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		return conn.WriteJSON(msg)
+	}
+
+	_ = conn.SetReadDeadline(time.Now().Add(wsRequestTimeout))
+	var first wsClientMessage
+	if err := conn.ReadJSON(&first); err != nil || first.Type != wsMessageRequest || len(first.Body) == 0 {
+		_ = send(wsServerMessage{Type: wsMessageError, Status: http.StatusBadRequest, Error: "first message must be a request with a body"})
+		return nil
+	}
+	_ = conn.SetReadDeadline(time.Time{})
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	// The only message expected after the request is a cancel; a closed connection cancels as well
+	go func() {
+		defer cancel()
+		for {
+			var msg wsClientMessage
+			if err := conn.ReadJSON(&msg); err != nil {
+				return
+			}
+			if msg.Type == wsMessageCancel {
+				logging.Info("Chat completion cancelled by WebSocket client", types.Inferences)
+				return
+			}
+		}
+	}()
+
+	req, err := newWebSocketChatRequest(ctx, c.Request(), first)
+	if err != nil {
+		_ = send(wsServerMessage{Type: wsMessageError, Status: http.StatusBadRequest, Error: err.Error()})
+		return nil
+	}
+
+	w := newWsResponseWriter(ctx, send)
+	if err := s.postChat(s.e.NewContext(req, w)); err != nil {
+		status, message := middleware.ExtractError(err)
+		w.sendError(status, message)
+	}
+	w.finish()
+
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(wsWriteTimeout))
+	return nil
+}
+
+// newWebSocketChatRequest builds the POST /v1/chat/completions request a WebSocket request message stands for
+func newWebSocketChatRequest(ctx context.Context, upgrade *http.Request, msg wsClientMessage) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, string(ChatCompletionsEndpoint), bytes.NewReader(msg.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range upgrade.Header {
+		if isWebSocketHandshakeHeader(key) {
+			continue
+		}
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	for key, value := range msg.Headers {
+		req.Header.Set(key, value)
+	}
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.RemoteAddr = upgrade.RemoteAddr
+	return req, nil
+}
+
+func isWebSocketHandshakeHeader(key string) bool {
+	key = http.CanonicalHeaderKey(key)
+	return key == "Connection" || key == "Upgrade" || strings.HasPrefix(key, "Sec-Websocket-")
+}
+
+// wsResponseWriter turns what the chat handler writes into WebSocket messages: each SSE "data:" line
+// becomes a chunk, any other body becomes a response or an error once the handler is done.
+// Writes fail once the client cancelled, which makes the proxy close the upstream stream.
+type wsResponseWriter struct {
+	ctx    context.Context
+	send   func(wsServerMessage) error
+	header http.Header
+	status int
+
+	mu      sync.Mutex
+	pending []byte
+	body    bytes.Buffer
+	failed  bool
+	errSent bool
+}
+
+func newWsResponseWriter(ctx context.Context, send func(wsServerMessage) error) *wsResponseWriter {
+	return &wsResponseWriter{ctx: ctx, send: send, header: http.Header{}}
+}
+
+func (w *wsResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *wsResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *wsResponseWriter) Flush() {}
+
+func (w *wsResponseWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if w.failed || w.ctx.Err() != nil {
+		return 0, &net.OpError{Op: "write", Net: "websocket", Err: errors.New("client cancelled the completion")}
+	}
+	if !w.streaming() {
+		return w.body.Write(p)
+	}
+
+	w.pending = append(w.pending, p...)
+	for {
+		i := bytes.IndexByte(w.pending, '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		line := strings.TrimRight(string(w.pending[:i]), "\r")
+		w.pending = w.pending[i+1:]
+		if err := w.sendLine(line); err != nil {
+			w.failed = true
+			return 0, &net.OpError{Op: "write", Net: "websocket", Err: err}
+		}
+	}
+}
+
+func (w *wsResponseWriter) streaming() bool {
+	return strings.HasPrefix(w.header.Get(echo.HeaderContentType), "text/event-stream")
+}
+
+func (w *wsResponseWriter) sendLine(line string) error {
+	switch {
+	case line == "" || strings.HasPrefix(line, ":") || strings.HasPrefix(line, "event:") ||
+		strings.HasPrefix(line, "id:") || strings.HasPrefix(line, "retry:"):
+		return nil
+	case strings.HasPrefix(line, "data:"):
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			return nil
+		}
+		if !json.Valid([]byte(data)) {
+			quoted, _ := json.Marshal(data)
+			data = string(quoted)
+		}
+		return w.send(wsServerMessage{Type: wsMessageChunk, Data: json.RawMessage(data)})
+	default:
+		// http.Error output written mid-stream
+		w.errSent = true
+		return w.send(wsServerMessage{Type: wsMessageError, Status: http.StatusInternalServerError, Error: line})
+	}
+}
+
+func (w *wsResponseWriter) sendError(status int, message interface{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.errSent = true
+	_ = w.send(wsServerMessage{Type: wsMessageError, Status: status, Error: message})
+}
+
+// finish sends what is left of the response followed by done
+func (w *wsResponseWriter) finish() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.failed || w.ctx.Err() != nil {
+		return
+	}
+	if len(w.pending) > 0 {
+		_ = w.sendLine(string(w.pending))
+		w.pending = nil
+	}
+	if w.body.Len() > 0 {
+		body := bytes.TrimSpace(w.body.Bytes())
+		if w.status >= http.StatusBadRequest {
+			var errBody interface{} = string(body)
+			if json.Valid(body) {
+				errBody = json.RawMessage(body)
+			}
+			_ = w.send(wsServerMessage{Type: wsMessageError, Status: w.status, Error: errBody})
+			return
+		}
+		if !json.Valid(body) {
+			_ = w.send(wsServerMessage{Type: wsMessageError, Status: http.StatusBadGateway, Error: "invalid response from executor"})
+			return
+		}
+		_ = w.send(wsServerMessage{Type: wsMessageResponse, Data: json.RawMessage(body)})
+	}
+	if !w.errSent {
+		_ = w.send(wsServerMessage{Type: wsMessageDone})
+	}
+}
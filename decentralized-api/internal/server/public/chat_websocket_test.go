@@ -0,0 +1,110 @@
+package public
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWsResponseWriter_Stream(t *testing.T) {
+	var sent []wsServerMessage
+	w := newWsResponseWriter(context.Background(), func(msg wsServerMessage) error {
+		sent = append(sent, msg)
+		return nil
+	})
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+
+	_, err := w.Write([]byte("data: {\"id\":\"1\"}\n\ndata: {\"id\""))
+	require.NoError(t, err)
+	_, err = w.Write([]byte(":\"2\"}\n\ndata: [DONE]\n\n"))
+	require.NoError(t, err)
+	w.finish()
+
+	require.Len(t, sent, 3)
+	require.Equal(t, wsMessageChunk, sent[0].Type)
+	require.JSONEq(t, `{"id":"1"}`, string(sent[0].Data))
+	require.Equal(t, wsMessageChunk, sent[1].Type)
+	require.JSONEq(t, `{"id":"2"}`, string(sent[1].Data))
+	require.Equal(t, wsMessageDone, sent[2].Type)
+}
+
+func TestWsResponseWriter_Response(t *testing.T) {
+	var sent []wsServerMessage
+	w := newWsResponseWriter(context.Background(), func(msg wsServerMessage) error {
+		sent = append(sent, msg)
+		return nil
+	})
+	w.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	_, err := w.Write([]byte(`{"id":"1","choices":[]}`))
+	require.NoError(t, err)
+	w.finish()
+
+	require.Len(t, sent, 2)
+	require.Equal(t, wsMessageResponse, sent[0].Type)
+	require.JSONEq(t, `{"id":"1","choices":[]}`, string(sent[0].Data))
+	require.Equal(t, wsMessageDone, sent[1].Type)
+
+	// Error bodies are reported as errors, without done
+	sent = nil
+	w = newWsResponseWriter(context.Background(), func(msg wsServerMessage) error {
+		sent = append(sent, msg)
+		return nil
+	})
+	w.WriteHeader(http.StatusBadRequest)
+	_, err = w.Write([]byte(`{"error":"bad request"}`))
+	require.NoError(t, err)
+	w.finish()
+
+	require.Len(t, sent, 1)
+	require.Equal(t, wsMessageError, sent[0].Type)
+	require.Equal(t, http.StatusBadRequest, sent[0].Status)
+}
+
+func TestWsResponseWriter_Cancel(t *testing.T) {
+	var sent []wsServerMessage
+	ctx, cancel := context.WithCancel(context.Background())
+	w := newWsResponseWriter(ctx, func(msg wsServerMessage) error {
+		sent = append(sent, msg)
+		return nil
+	})
+	w.Header().Set(echo.HeaderContentType, "text/event-stream")
+
+	_, err := w.Write([]byte("data: {\"id\":\"1\"}\n\n"))
+	require.NoError(t, err)
+
+	cancel()
+	_, err = w.Write([]byte("data: {\"id\":\"2\"}\n\n"))
+	var opErr *net.OpError
+	require.True(t, errors.As(err, &opErr), "cancelled writes must look like a broken connection to the proxy")
+	w.finish()
+
+	require.Len(t, sent, 1)
+}
+
+func TestNewWebSocketChatRequest(t *testing.T) {
+	upgrade, _ := http.NewRequest(http.MethodGet, "/v1/chat/completions/ws", nil)
+	upgrade.Header.Set("Authorization", "from-upgrade")
+	upgrade.Header.Set("X-Requester-Address", "requester")
+	upgrade.Header.Set("Upgrade", "websocket")
+	upgrade.Header.Set("Sec-WebSocket-Key", "key")
+
+	req, err := newWebSocketChatRequest(context.Background(), upgrade, wsClientMessage{
+		Type:    wsMessageRequest,
+		Headers: map[string]string{"Authorization": "from-message"},
+		Body:    []byte(`{"model":"m"}`),
+	})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPost, req.Method)
+	require.Equal(t, string(ChatCompletionsEndpoint), req.URL.Path)
+	require.Equal(t, "from-message", req.Header.Get("Authorization"))
+	require.Equal(t, "requester", req.Header.Get("X-Requester-Address"))
+	require.Equal(t, echo.MIMEApplicationJSON, req.Header.Get(echo.HeaderContentType))
+	require.Empty(t, req.Header.Get("Upgrade"))
+	require.Empty(t, req.Header.Get("Sec-WebSocket-Key"))
+}
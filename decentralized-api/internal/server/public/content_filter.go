@@ -0,0 +1,49 @@
+package public
+
+import (
+	"decentralized-api/internal/contentfilter"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// applyContentFilter passes the request through the configured content filters. Rejected requests get an
+// HTTP error; the matches of annotating filters are logged and returned in the X-Content-Filter header.
+func (s *Server) applyContentFilter(ctx echo.Context, request *ChatRequest, executorRequest bool) error {
+	if s.contentFilter == nil || (executorRequest && !s.contentFilter.FiltersExecutorRequests()) {
+		return nil
+	}
+
+	result, err := s.contentFilter.Run(ctx.Request().Context(), &contentfilter.Request{
+		Endpoint:         string(request.Endpoint),
+		Model:            request.OpenAiRequest.Model,
+		RequesterAddress: request.RequesterAddress,
+		Body:             request.Body,
+		Prompt:           request.promptText(),
+		MessageCount:     len(request.OpenAiRequest.Messages),
+	})
+	if err != nil {
+		logging.Error("Content filter failed", types.Inferences, "requester", request.RequesterAddress, "error", err)
+		return echo.NewHTTPError(http.StatusInternalServerError, "content filter failed")
+	}
+
+	if len(result.Annotations) > 0 {
+		logging.Info("Request annotated by content filters", types.Inferences,
+			"requester", request.RequesterAddress, "inferenceId", request.InferenceId, "annotations", result.Annotations)
+		if annotations, err := json.Marshal(result.Annotations); err == nil {
+			ctx.Response().Header().Set(utils.XContentFilterHeader, string(annotations))
+		}
+	}
+
+	if result.Rejection != nil {
+		logging.Warn("Request rejected by content filter", types.Inferences,
+			"requester", request.RequesterAddress, "inferenceId", request.InferenceId,
+			"filter", result.Rejection.Filter, "reason", result.Rejection.Reason)
+		return echo.NewHTTPError(result.Rejection.Status, "request rejected by content filter "+result.Rejection.Filter+": "+result.Rejection.Reason)
+	}
+	return nil
+}
@@ -1,16 +1,29 @@
 package public
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	cryptotypes "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	comettypes "github.com/cometbft/cometbft/types"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// InferenceEndpoint is the OpenAI-compatible path a request is proxied to on the executor and its ML node
+type InferenceEndpoint string
+
+const (
+	ChatCompletionsEndpoint InferenceEndpoint = "/v1/chat/completions"
+	EmbeddingsEndpoint      InferenceEndpoint = "/v1/embeddings"
+)
+
 type ChatRequest struct {
 	Body              []byte
 	Request           *http.Request
+	Endpoint          InferenceEndpoint
 	OpenAiRequest     OpenAiRequest
 	AuthKey           string // signature signing inference request
 	Seed              string
@@ -20,6 +33,8 @@ type ChatRequest struct {
 	Timestamp         int64  // timestamp of the request
 	TransferSignature string // signature of the transfer address
 	PromptHash        string
+	EmbeddingInput    []string // inputs of an embeddings request
+	Hedged            bool     // also sent to another executor, only the one the chain assigns records it
 }
 
 type OpenAiRequest struct {
@@ -28,10 +43,87 @@ type OpenAiRequest struct {
 	MaxTokens           int32     `json:"max_tokens"`
 	MaxCompletionTokens int32     `json:"max_completion_tokens"`
 	Messages            []Message `json:"messages"`
+	// MaxCost is the most the requester agrees to be billed for the inference, in the base coin.
+	// Requests whose escrow would exceed it are rejected.
+	MaxCost uint64 `json:"max_cost,omitempty"`
+	// Input is the string or list of strings of an embeddings request
+	Input json.RawMessage `json:"input,omitempty"`
+	// PriorityClass is realtime (default) or batch, which is priced and scheduled differently
+	PriorityClass string `json:"priority_class,omitempty"`
 }
 
 type Message struct {
-	Content string `json:"content"` // The content of the message
+	Content MessageContent `json:"content"` // The content of the message
+}
+
+// MessageContent is the content of a chat message, given either as a string or as a list of
+// text and image_url parts (multimodal requests)
+type MessageContent struct {
+	Text   string
+	Images []ImageURL
+}
+
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+type ImageURL struct {
+	URL string `json:"url"`
+	// Detail is the OpenAI image detail level: low, high or auto
+	Detail string `json:"detail,omitempty"`
+}
+
+func (c *MessageContent) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*c = MessageContent{}
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*c = MessageContent{Text: text}
+		return nil
+	}
+	var parts []ContentPart
+	if err := json.Unmarshal(data, &parts); err != nil {
+		return errors.New("message content must be a string or an array of content parts")
+	}
+	content := MessageContent{}
+	var texts []string
+	for _, part := range parts {
+		switch part.Type {
+		case "text":
+			texts = append(texts, part.Text)
+		case "image_url":
+			if part.ImageURL == nil || part.ImageURL.URL == "" {
+				return errors.New("image_url content part has no url")
+			}
+			content.Images = append(content.Images, *part.ImageURL)
+		default:
+			return fmt.Errorf("unsupported message content part type %q", part.Type)
+		}
+	}
+	content.Text = strings.Join(texts, "\n")
+	*c = content
+	return nil
+}
+
+// images returns the image inputs of all messages of the request
+func (r *OpenAiRequest) images() []ImageURL {
+	var images []ImageURL
+	for _, message := range r.Messages {
+		images = append(images, message.Content.Images...)
+	}
+	return images
+}
+
+// priorityClass returns the priority class of the request, realtime when none is given
+func (r *OpenAiRequest) priorityClass() string {
+	if r.PriorityClass == "" {
+		return types.PriorityClassRealtime
+	}
+	return r.PriorityClass
 }
 
 type ExecutorDestination struct {
@@ -43,6 +135,26 @@ type ModelsResponse struct {
 	Models []types.Model `json:"models"`
 }
 
+// ActiveModelsResponse follows the OpenAI models listing (object "list" with model entries in data).
+// Models keeps the previous response format for existing clients.
+type ActiveModelsResponse struct {
+	Object string           `json:"object"`
+	Data   []ActiveModelDto `json:"data"`
+	Models []types.Model    `json:"models"`
+}
+
+type ActiveModelDto struct {
+	Id      string `json:"id"`
+	Object  string `json:"object"`
+	Created int64  `json:"created"`
+	OwnedBy string `json:"owned_by"`
+	// Live data of the epoch serving the model
+	EpochIndex       uint64 `json:"epoch_index"`
+	Throughput       int64  `json:"throughput"`
+	PricePerToken    uint64 `json:"price_per_token"`
+	ParticipantCount int    `json:"participant_count"`
+}
+
 type ActiveParticipantWithProof struct {
 	ActiveParticipants      types.ActiveParticipants `json:"active_participants"`
 	Addresses               []string                 `json:"addresses"`
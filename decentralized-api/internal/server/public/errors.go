@@ -10,6 +10,9 @@ var (
 	ErrRequestAuth                  = echo.NewHTTPError(http.StatusUnauthorized, "Authorization is required")
 	ErrInferenceParticipantNotFound = echo.NewHTTPError(http.StatusNotFound, "Inference participant not found")
 	ErrInsufficientBalance          = echo.NewHTTPError(http.StatusPaymentRequired, "Insufficient balance")
+	ErrApiKeyRequired               = echo.NewHTTPError(http.StatusUnauthorized, "API key is required")
+	ErrInvalidApiKey                = echo.NewHTTPError(http.StatusUnauthorized, "Invalid API key")
+	ErrInferenceExpired             = echo.NewHTTPError(http.StatusGatewayTimeout, "Inference expired before it finished")
 
 	ErrIdRequired           = echo.NewHTTPError(http.StatusBadRequest, "Id is required")
 	ErrAddressRequired      = echo.NewHTTPError(http.StatusBadRequest, "Address is required")
@@ -19,3 +22,20 @@ var (
 	ErrInferenceNotFound    = echo.NewHTTPError(http.StatusNotFound, "Inference not found")
 	ErrNoModelSpecified     = echo.NewHTTPError(http.StatusBadRequest, "No model specified")
 )
+
+// openAiCompatiblePaths answer errors in the OpenAI error format, see apierrors.OpenAiBody
+var openAiCompatiblePaths = map[string]bool{
+	string(ChatCompletionsEndpoint): true,
+	string(EmbeddingsEndpoint):      true,
+	"/v1/models":                    true,
+	"/v1/files":                     true,
+	"/v1/files/:id":                 true,
+	"/v1/files/:id/content":         true,
+	"/v1/batches":                   true,
+	"/v1/batches/:id":               true,
+	"/v1/batches/:id/cancel":        true,
+}
+
+func isOpenAiCompatible(c echo.Context) bool {
+	return openAiCompatiblePaths[c.Path()]
+}
@@ -0,0 +1,138 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/internal/eventstream"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// WebSocket protocol of /ws/events, the chain event firehose:
+//
+//	server -> {"type": "event", "data": {"cursor": "1234-0", "height": 1234, "type": "inference_finished", ...}}
+//	server -> {"type": "gap"}                      events after the requested cursor are no longer retained
+//	server -> {"type": "error", "status": 503, "error": ...}
+//
+// The upgrade request needs an API key. Query param "cursor" resumes after the event with that cursor,
+// "types" selects event types (comma separated, all of eventstream.Types by default). A client lagging
+// too far behind gets an error and is disconnected; it reconnects with the cursor of its last event.
+const (
+	wsMessageEvent = "event"
+	wsMessageGap   = "gap"
+
+	wsEventsPingInterval = 30 * time.Second
+)
+
+var ErrEventStreamUnavailable = echo.NewHTTPError(http.StatusNotFound, "event stream is not available")
+
+func (s *Server) eventsWebSocket(c echo.Context) error {
+	if s.eventStream == nil {
+		return ErrEventStreamUnavailable
+	}
+	apiKeyId, err := s.checkApiKey(c)
+	if err != nil {
+		return err
+	}
+	if apiKeyId == "" {
+		return ErrApiKeyRequired
+	}
+
+	var after *eventstream.Cursor
+	if param := c.QueryParam("cursor"); param != "" {
+		cursor, err := eventstream.ParseCursor(param)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		after = &cursor
+	}
+	var eventTypes []string
+	if param := c.QueryParam("types"); param != "" {
+		eventTypes = strings.Split(param, ",")
+		for _, t := range eventTypes {
+			if !slices.Contains(eventstream.Types, t) {
+				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown event type %q, expected one of %s",
+					t, strings.Join(eventstream.Types, ", ")))
+			}
+		}
+	}
+
+	conn, err := wsUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		logging.Warn("Failed to upgrade events WebSocket", types.Server, "error", err)
+		return nil
+	}
+	defer conn.Close()
+
+	sub, backlog, gap := s.eventStream.Subscribe(after, eventTypes)
+	defer s.eventStream.Unsubscribe(sub)
+	logging.Info("Event stream subscriber connected", types.Server, "apiKeyId", apiKeyId, "cursor", c.QueryParam("cursor"), "backlog", len(backlog))
+
+	send := func(msg wsServerMessage) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+		return conn.WriteJSON(msg)
+	}
+	sendEvent := func(event eventstream.Event) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return send(wsServerMessage{Type: wsMessageEvent, Data: data})
+	}
+
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+	// Clients don't send anything, reading only notices the connection closing
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	if gap {
+		if err := send(wsServerMessage{Type: wsMessageGap}); err != nil {
+			return nil
+		}
+	}
+	for _, event := range backlog {
+		if err := sendEvent(event); err != nil {
+			return nil
+		}
+	}
+
+	ping := time.NewTicker(wsEventsPingInterval)
+	defer ping.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ping.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+				return nil
+			}
+		case event, ok := <-sub.Events():
+			if !ok {
+				if sub.Dropped {
+					logging.Info("Event stream subscriber dropped for lagging behind", types.Server, "apiKeyId", apiKeyId)
+					_ = send(wsServerMessage{Type: wsMessageError, Status: http.StatusServiceUnavailable,
+						Error: "subscriber lagged too far behind, reconnect with the cursor of the last event"})
+				}
+				return nil
+			}
+			if err := sendEvent(event); err != nil {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package public
+
+import (
+	"decentralized-api/logging"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/epochgroup"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getEpochGroupDiff returns the members that joined or left, and the weight and ML node changes,
+// between the epoch group of :epoch and the one of :other. Both accept "current".
+// The model is passed as query param "model" since model ids usually contain slashes;
+// without it the parent epoch group is compared.
+func (s *Server) getEpochGroupDiff(c echo.Context) error {
+	fromEpoch, err := s.resolveEpochFromContext(c)
+	if err != nil {
+		return err
+	}
+	toEpoch, err := s.resolveEpochParam(c.Param("other"))
+	if err != nil {
+		return err
+	}
+	modelId := c.QueryParam("model")
+
+	from, err := s.getEpochGroupData(c, fromEpoch, modelId)
+	if err != nil {
+		return err
+	}
+	to, err := s.getEpochGroupData(c, toEpoch, modelId)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, epochgroup.Diff(from, to))
+}
+
+func (s *Server) getEpochGroupData(c echo.Context, epochIndex uint64, modelId string) (*types.EpochGroupData, error) {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	resp, err := queryClient.EpochGroupData(c.Request().Context(), &types.QueryGetEpochGroupDataRequest{
+		EpochIndex: epochIndex,
+		ModelId:    modelId,
+	})
+	if status.Code(err) == codes.NotFound {
+		msg := fmt.Sprintf("Epoch group not found. epoch = %d, model = %s", epochIndex, modelId)
+		return nil, echo.NewHTTPError(http.StatusNotFound, msg)
+	}
+	if err != nil {
+		logging.Error("Failed to get epoch group data", types.EpochGroup, "epoch", epochIndex, "model", modelId, "error", err)
+		return nil, err
+	}
+	return &resp.EpochGroupData, nil
+}
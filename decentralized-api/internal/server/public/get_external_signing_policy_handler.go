@@ -0,0 +1,67 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	blsTypes "github.com/productscience/inference/x/bls/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type ExternalSigningPolicyResponse struct {
+	Policy blsTypes.ExternalSigningPolicy `json:"policy"`
+	// Usage is the current rate limit window of the requester given as query param "requester"
+	Usage *blsTypes.ExternalSigningUsage `json:"usage,omitempty"`
+}
+
+// getExternalSigningPolicy returns the policy gating MsgRequestThresholdSignature, read from the BLS module
+// store since the policy has no query endpoint, and the usage of the requester given as query param "requester"
+func (s *Server) getExternalSigningPolicy(c echo.Context) error {
+	requester := c.QueryParam("requester")
+	if requester != "" {
+		if _, err := sdk.AccAddressFromBech32(requester); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Invalid requester address")
+		}
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.BLS, "error", err)
+		return err
+	}
+
+	result, err := cosmos_client.QueryByKey(rpcClient, blsTypes.StoreKey, blsTypes.ExternalSigningPolicyKey)
+	if err != nil {
+		logging.Error("Failed to query external signing policy", types.BLS, "error", err)
+		return err
+	}
+	response := ExternalSigningPolicyResponse{Policy: blsTypes.DefaultExternalSigningPolicy()}
+	if len(result.Response.Value) > 0 {
+		if err := json.Unmarshal(result.Response.Value, &response.Policy); err != nil {
+			logging.Error("Failed to decode external signing policy", types.BLS, "error", err)
+			return err
+		}
+	}
+
+	if requester != "" {
+		result, err := cosmos_client.QueryByKey(rpcClient, blsTypes.StoreKey, blsTypes.ExternalSigningUsageKey(requester))
+		if err != nil {
+			logging.Error("Failed to query external signing usage", types.BLS, "requester", requester, "error", err)
+			return err
+		}
+		usage := blsTypes.ExternalSigningUsage{}
+		if len(result.Response.Value) > 0 {
+			if err := json.Unmarshal(result.Response.Value, &usage); err != nil {
+				logging.Error("Failed to decode external signing usage", types.BLS, "requester", requester, "error", err)
+				return err
+			}
+		}
+		response.Usage = &usage
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,25 @@
+package public
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type HardwareAttestationSource interface {
+	HardwareAttestations() []types.HardwareAttestation
+}
+
+// getHardwareAttestations returns the latest signed capability report of each ML node.
+// Reports are signed with the worker key, so they can be checked against the participant's
+// on-chain WorkerPublicKey with types.HardwareAttestation.Verify.
+func (s *Server) getHardwareAttestations(c echo.Context) error {
+	attestations := []types.HardwareAttestation{}
+	if s.attestations != nil {
+		attestations = s.attestations.HardwareAttestations()
+	}
+	return c.JSON(http.StatusOK, map[string]interface{}{
+		"attestations": attestations,
+	})
+}
@@ -0,0 +1,51 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getInferenceEscrow returns the escrow status of an inference stored on chain.
+// The inference is passed as query param "inference_id" since inference ids are base64 and may contain slashes.
+func (s *Server) getInferenceEscrow(c echo.Context) error {
+	inferenceId := c.QueryParam("inference_id")
+	if inferenceId == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "inference_id required")
+	}
+
+	dataKey, err := types.InferenceEscrowFullKey(inferenceId)
+	if err != nil {
+		logging.Error("Failed to encode inference escrow key", types.Payments, "inferenceId", inferenceId, "error", err)
+		return err
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Payments, "error", err)
+		return err
+	}
+
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+	if err != nil {
+		logging.Error("Failed to query inference escrow", types.Payments, "inferenceId", inferenceId, "error", err)
+		return err
+	}
+
+	if len(result.Response.Value) == 0 {
+		msg := fmt.Sprintf("Inference escrow not found. inference_id = %s", inferenceId)
+		return echo.NewHTTPError(http.StatusNotFound, msg)
+	}
+
+	var escrow types.InferenceEscrow
+	if err := escrow.Unmarshal(result.Response.Value); err != nil {
+		logging.Error("Failed to decode inference escrow", types.Payments, "inferenceId", inferenceId, "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, escrow)
+}
@@ -1,12 +1,55 @@
 package public
 
 import (
+	"decentralized-api/logging"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// activeModel is the per-epoch data of a model served in the current epoch
+type activeModel struct {
+	model            types.Model
+	throughput       int64
+	participantCount int
+}
+
+// activeModelsCache keeps the active models of one epoch; epoch group data does not change within an epoch
+type activeModelsCache struct {
+	mu         sync.RWMutex
+	epochIndex uint64
+	loadedAt   time.Time
+	models     []activeModel
+}
+
+func newActiveModelsCache() *activeModelsCache {
+	return &activeModelsCache{}
+}
+
+func (c *activeModelsCache) get(epochIndex uint64) ([]activeModel, time.Time, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.models == nil || c.epochIndex != epochIndex {
+		return nil, time.Time{}, false
+	}
+	return c.models, c.loadedAt, true
+}
+
+func (c *activeModelsCache) set(epochIndex uint64, models []activeModel) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.epochIndex = epochIndex
+	c.loadedAt = time.Now()
+	c.models = models
+	return c.loadedAt
+}
+
+// getModels lists the models served in the current epoch, in the OpenAI models format
 func (s *Server) getModels(ctx echo.Context) error {
 	queryClient := s.recorder.NewInferenceQueryClient()
 	context := s.recorder.GetContext()
@@ -16,30 +59,74 @@ func (s *Server) getModels(ctx echo.Context) error {
 	if err != nil {
 		return err
 	}
-
-	var activeModels []types.Model
 	parentEpochData := currentEpoch.GetEpochGroupData()
 
-	// Iterate over the subgroup models to get the snapshot for each one.
-	for _, modelId := range parentEpochData.SubGroupModels {
-		req := &types.QueryGetEpochGroupDataRequest{
-			EpochIndex: parentEpochData.EpochIndex,
-			ModelId:    modelId,
-		}
-		modelEpochData, err := queryClient.EpochGroupData(context, req)
-		if err != nil {
-			// If a model subgroup is listed but not found, we can log it, but we shouldn't fail the entire request.
-			continue
-		}
+	models, loadedAt, found := s.activeModels.get(parentEpochData.EpochIndex)
+	if !found {
+		models = make([]activeModel, 0, len(parentEpochData.SubGroupModels))
+		// Iterate over the subgroup models to get the snapshot for each one.
+		for _, modelId := range parentEpochData.SubGroupModels {
+			req := &types.QueryGetEpochGroupDataRequest{
+				EpochIndex: parentEpochData.EpochIndex,
+				ModelId:    modelId,
+			}
+			modelEpochData, err := queryClient.EpochGroupData(context, req)
+			if err != nil {
+				// If a model subgroup is listed but not found, we can log it, but we shouldn't fail the entire request.
+				logging.Warn("Model subgroup not found", types.Inferences, "epochIndex", parentEpochData.EpochIndex, "modelId", modelId, "error", err)
+				continue
+			}
 
-		if modelEpochData.EpochGroupData.ModelSnapshot != nil {
-			activeModels = append(activeModels, *modelEpochData.EpochGroupData.ModelSnapshot)
+			if modelEpochData.EpochGroupData.ModelSnapshot != nil {
+				models = append(models, activeModel{
+					model:            *modelEpochData.EpochGroupData.ModelSnapshot,
+					throughput:       modelEpochData.EpochGroupData.TotalThroughput,
+					participantCount: len(modelEpochData.EpochGroupData.ValidationWeights),
+				})
+			}
 		}
+		loadedAt = s.activeModels.set(parentEpochData.EpochIndex, models)
 	}
 
-	return ctx.JSON(http.StatusOK, &ModelsResponse{
-		Models: activeModels,
-	})
+	// Prices can change within an epoch when dynamic pricing is enabled, so they are not cached
+	dynamicPricingEnabled, dynamicPrices, err := s.getDynamicPricingData()
+	if err != nil {
+		logging.Warn("Failed to get dynamic pricing data, falling back to legacy pricing", types.Pricing, "error", err)
+		dynamicPricingEnabled = false
+	}
+	if !dynamicPricingEnabled {
+		dynamicPrices = nil
+	}
+
+	return ctx.JSON(http.StatusOK, newActiveModelsResponse(parentEpochData.EpochIndex, loadedAt, models, parentEpochData.UnitOfComputePrice, dynamicPrices))
+}
+
+// newActiveModelsResponse prices each model with its dynamic price when available, otherwise with the
+// epoch's unit of compute price. Created is when this API first saw the model in the epoch.
+func newActiveModelsResponse(epochIndex uint64, loadedAt time.Time, models []activeModel, unitOfComputePrice int64, dynamicPrices map[string]uint64) *ActiveModelsResponse {
+	response := &ActiveModelsResponse{
+		Object: "list",
+		Data:   make([]ActiveModelDto, 0, len(models)),
+		Models: make([]types.Model, 0, len(models)),
+	}
+	for _, m := range models {
+		pricePerToken := m.model.UnitsOfComputePerToken * uint64(unitOfComputePrice)
+		if dynamicPrice, exists := dynamicPrices[m.model.Id]; exists {
+			pricePerToken = dynamicPrice
+		}
+		response.Data = append(response.Data, ActiveModelDto{
+			Id:               m.model.Id,
+			Object:           "model",
+			Created:          loadedAt.Unix(),
+			OwnedBy:          m.model.ProposedBy,
+			EpochIndex:       epochIndex,
+			Throughput:       m.throughput,
+			PricePerToken:    pricePerToken,
+			ParticipantCount: m.participantCount,
+		})
+		response.Models = append(response.Models, m.model)
+	}
+	return response
 }
 
 func (s *Server) getGovernanceModels(ctx echo.Context) error {
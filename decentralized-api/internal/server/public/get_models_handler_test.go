@@ -0,0 +1,53 @@
+package public
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewActiveModelsResponse(t *testing.T) {
+	loadedAt := time.Unix(1700000000, 0)
+	models := []activeModel{
+		{model: types.Model{Id: "Qwen/QwQ-32B", ProposedBy: "genesis", UnitsOfComputePerToken: 100}, throughput: 5000, participantCount: 3},
+		{model: types.Model{Id: "Qwen/Qwen2.5-7B-Instruct", ProposedBy: "genesis", UnitsOfComputePerToken: 10}, throughput: 900, participantCount: 7},
+	}
+
+	response := newActiveModelsResponse(12, loadedAt, models, 2, map[string]uint64{"Qwen/QwQ-32B": 150})
+
+	require.Equal(t, "list", response.Object)
+	require.Len(t, response.Data, 2)
+	require.Len(t, response.Models, 2)
+	require.Equal(t, ActiveModelDto{
+		Id: "Qwen/QwQ-32B", Object: "model", Created: 1700000000, OwnedBy: "genesis",
+		EpochIndex: 12, Throughput: 5000, PricePerToken: 150, ParticipantCount: 3,
+	}, response.Data[0])
+	// no dynamic price: legacy price from the unit of compute price
+	require.Equal(t, uint64(20), response.Data[1].PricePerToken)
+
+	bz, err := json.Marshal(response)
+	require.NoError(t, err)
+	var decoded map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(bz, &decoded))
+	require.Contains(t, decoded, "object")
+	require.Contains(t, decoded, "data")
+	require.Contains(t, decoded, "models")
+}
+
+func TestActiveModelsCache(t *testing.T) {
+	cache := newActiveModelsCache()
+	_, _, found := cache.get(1)
+	require.False(t, found)
+
+	loadedAt := cache.set(1, []activeModel{})
+	models, cachedAt, found := cache.get(1)
+	require.True(t, found)
+	require.Empty(t, models)
+	require.Equal(t, loadedAt, cachedAt)
+
+	_, _, found = cache.get(2)
+	require.False(t, found)
+}
@@ -0,0 +1,74 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getNetworkCapacity returns the throughput, ML nodes and utilization of every model served in the current epoch.
+// Usage is read from the per-model counters the chain keeps for the epoch, utilization is relative to the latest block time.
+func (s *Server) getNetworkCapacity(c echo.Context) error {
+	queryClient := s.recorder.NewInferenceQueryClient()
+	ctx := c.Request().Context()
+
+	currentEpoch, err := queryClient.CurrentEpochGroupData(ctx, &types.QueryCurrentEpochGroupDataRequest{})
+	if err != nil {
+		logging.Error("Failed to get current epoch group data", types.Inferences, "error", err)
+		return err
+	}
+	parentEpochData := currentEpoch.GetEpochGroupData()
+
+	status, err := s.recorder.Status(ctx)
+	if err != nil {
+		logging.Error("Failed to get status", types.Inferences, "error", err)
+		return err
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Inferences, "error", err)
+		return err
+	}
+
+	subgroups := make([]types.EpochGroupData, 0, len(parentEpochData.SubGroupModels))
+	usage := make(map[string]types.ModelEpochUsage, len(parentEpochData.SubGroupModels))
+	for _, modelId := range parentEpochData.SubGroupModels {
+		modelEpochData, err := queryClient.EpochGroupData(ctx, &types.QueryGetEpochGroupDataRequest{
+			EpochIndex: parentEpochData.EpochIndex,
+			ModelId:    modelId,
+		})
+		if err != nil {
+			logging.Warn("Model subgroup not found", types.Inferences, "epochIndex", parentEpochData.EpochIndex, "modelId", modelId, "error", err)
+			continue
+		}
+		subgroups = append(subgroups, modelEpochData.EpochGroupData)
+
+		dataKey, err := types.ModelEpochUsageFullKey(parentEpochData.EpochIndex, modelId)
+		if err != nil {
+			logging.Error("Failed to encode model epoch usage key", types.Inferences, "modelId", modelId, "error", err)
+			return err
+		}
+		result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+		if err != nil {
+			logging.Error("Failed to query model epoch usage", types.Inferences, "modelId", modelId, "error", err)
+			return err
+		}
+		if len(result.Response.Value) == 0 {
+			continue
+		}
+		var u types.ModelEpochUsage
+		if err := json.Unmarshal(result.Response.Value, &u); err != nil {
+			logging.Error("Failed to decode model epoch usage", types.Inferences, "modelId", modelId, "error", err)
+			return err
+		}
+		usage[modelId] = u
+	}
+
+	now := status.SyncInfo.LatestBlockTime.Unix()
+	return c.JSON(http.StatusOK, types.NewNetworkCapacity(parentEpochData.EpochIndex, subgroups, usage, now))
+}
@@ -0,0 +1,38 @@
+package public
+
+import (
+	"decentralized-api/logging"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type ParticipantClaimableResponse struct {
+	Participant string `json:"participant"`
+	// SettleAmounts are the current and carried over settle amounts, ordered by epoch
+	SettleAmounts []types.SettleAmount `json:"settle_amounts"`
+}
+
+// getParticipantClaimable returns the settle amounts a participant can still claim, including those
+// carried over from epochs whose claim stage the participant missed.
+func (s *Server) getParticipantClaimable(c echo.Context) error {
+	address := c.Param("address")
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid participant address")
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	claimable, err := queryClient.ListClaimableSettleAmounts(c.Request().Context(), &types.QueryClaimableSettleAmountsRequest{Participant: address})
+	if err != nil {
+		logging.Error("Failed to query claimable settle amounts", types.Settle, "participant", address, "error", err)
+		return err
+	}
+
+	response := ParticipantClaimableResponse{Participant: address, SettleAmounts: []types.SettleAmount{}}
+	if len(claimable.SettleAmounts) > 0 {
+		response.SettleAmounts = claimable.SettleAmounts
+	}
+	return c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,59 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type ParticipantDelegationsResponse struct {
+	Policy types.DelegationPolicy `json:"policy"`
+	// Delegations is the stake delegated to the participant, ordered by delegator address
+	Delegations []*types.Delegation `json:"delegations"`
+	Total       uint64              `json:"total"`
+}
+
+// getParticipantDelegations returns the stake delegated to a participant and the delegation policy weighting it.
+// The policy has no query endpoint and is read from the inference module store.
+func (s *Server) getParticipantDelegations(c echo.Context) error {
+	address := c.Param("address")
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid participant address")
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Tokenomics, "error", err)
+		return err
+	}
+
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", types.DelegationPolicyFullKey())
+	if err != nil {
+		logging.Error("Failed to query delegation policy", types.Tokenomics, "error", err)
+		return err
+	}
+	response := ParticipantDelegationsResponse{Policy: types.DefaultDelegationPolicy(), Delegations: []*types.Delegation{}}
+	if len(result.Response.Value) > 0 {
+		if err := json.Unmarshal(result.Response.Value, &response.Policy); err != nil {
+			logging.Error("Failed to decode delegation policy", types.Tokenomics, "error", err)
+			return err
+		}
+	}
+
+	queryClient := s.recorder.NewInferenceQueryClient()
+	delegations, err := queryClient.ListParticipantDelegations(c.Request().Context(), &types.QueryParticipantDelegationsRequest{Participant: address})
+	if err != nil {
+		logging.Error("Failed to query participant delegations", types.Tokenomics, "participant", address, "error", err)
+		return err
+	}
+	if len(delegations.Delegations.Delegations) > 0 {
+		response.Delegations = delegations.Delegations.Delegations
+	}
+	response.Total = delegations.Total
+	return c.JSON(http.StatusOK, response)
+}
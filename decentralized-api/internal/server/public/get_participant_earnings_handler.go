@@ -0,0 +1,88 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	defaultParticipantEarningsLimit = 20
+	// maxParticipantEarningsLimit bounds how many epochs one earnings history request walks
+	maxParticipantEarningsLimit = 100
+)
+
+type ParticipantEarningsResponse struct {
+	Participant string                           `json:"participant"`
+	Earnings    []types.ParticipantEpochEarnings `json:"earnings"`
+	// NextToEpoch continues the walk to older epochs, 0 once epoch 1 was reached
+	NextToEpoch uint64 `json:"next_to_epoch,omitempty"`
+}
+
+// getParticipantEarnings returns the per-epoch earnings of a participant stored on chain at settlement,
+// newest epoch first. It walks `limit` epochs back from `to_epoch` (default the current epoch);
+// epochs in which the participant earned nothing and was not slashed are skipped.
+func (s *Server) getParticipantEarnings(c echo.Context) error {
+	address := c.Param("address")
+	participant, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid participant address")
+	}
+
+	toEpochParam := c.QueryParam("to_epoch")
+	if toEpochParam == "" {
+		toEpochParam = "current"
+	}
+	toEpoch, err := s.resolveEpochParam(toEpochParam)
+	if err != nil {
+		return err
+	}
+	limit := uint64(defaultParticipantEarningsLimit)
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		parsed, err := strconv.ParseUint(limitStr, 10, 64)
+		if err != nil || parsed == 0 || parsed > maxParticipantEarningsLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid limit, must be between 1 and %d", maxParticipantEarningsLimit))
+		}
+		limit = parsed
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Settle, "error", err)
+		return err
+	}
+
+	response := ParticipantEarningsResponse{Participant: address, Earnings: []types.ParticipantEpochEarnings{}}
+	epoch := toEpoch
+	for ; epoch > 0 && epoch+limit > toEpoch; epoch-- {
+		dataKey, err := types.ParticipantEarningsFullKey(participant, epoch)
+		if err != nil {
+			logging.Error("Failed to encode participant earnings key", types.Settle, "participant", address, "epoch", epoch, "error", err)
+			return err
+		}
+		result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+		if err != nil {
+			logging.Error("Failed to query participant earnings", types.Settle, "participant", address, "epoch", epoch, "error", err)
+			return err
+		}
+		if len(result.Response.Value) == 0 {
+			continue
+		}
+		var earnings types.ParticipantEpochEarnings
+		if err := json.Unmarshal(result.Response.Value, &earnings); err != nil {
+			logging.Error("Failed to decode participant earnings", types.Settle, "participant", address, "epoch", epoch, "error", err)
+			return err
+		}
+		response.Earnings = append(response.Earnings, earnings)
+	}
+	response.NextToEpoch = epoch
+
+	return c.JSON(http.StatusOK, response)
+}
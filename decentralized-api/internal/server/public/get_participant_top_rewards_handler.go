@@ -0,0 +1,50 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getParticipantTopRewards returns a participant's progress through the top-reward program: payouts
+// received and remaining, when the next payout is due and the disbursement history.
+func (s *Server) getParticipantTopRewards(c echo.Context) error {
+	address := c.Param("address")
+	participant, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid participant address")
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Tokenomics, "error", err)
+		return err
+	}
+	dataKey, err := types.TopRewardProgressFullKey(participant)
+	if err != nil {
+		logging.Error("Failed to encode top reward progress key", types.Tokenomics, "participant", address, "error", err)
+		return err
+	}
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+	if err != nil {
+		logging.Error("Failed to query top reward progress", types.Tokenomics, "participant", address, "error", err)
+		return err
+	}
+	if len(result.Response.Value) == 0 {
+		return echo.NewHTTPError(http.StatusNotFound, "Participant is not a top miner")
+	}
+	var progress types.TopRewardProgress
+	if err := json.Unmarshal(result.Response.Value, &progress); err != nil {
+		logging.Error("Failed to decode top reward progress", types.Tokenomics, "participant", address, "error", err)
+		return err
+	}
+	if progress.Disbursements == nil {
+		progress.Disbursements = []types.TopRewardDisbursement{}
+	}
+	return c.JSON(http.StatusOK, progress)
+}
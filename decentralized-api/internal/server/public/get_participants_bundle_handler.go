@@ -0,0 +1,101 @@
+package public
+
+import (
+	"context"
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"decentralized-api/merkleproof"
+	"net/http"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client/http"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const validatorsPageSize = 100
+
+// getParticipantsBundle returns the active participants of an epoch as a self-contained verification
+// bundle (see merkleproof.ParticipantsBundle), so they can be verified offline without an archive node.
+func (s *Server) getParticipantsBundle(c echo.Context) error {
+	epoch, err := s.resolveEpochFromContext(c)
+	if err != nil {
+		logging.Error("Failed to resolve epoch from context", types.Server, "error", err)
+		return err
+	}
+	if epoch == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "Epoch enumeration starts with 1")
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.System, "error", err)
+		return err
+	}
+	bundle, err := buildParticipantsBundle(c.Request().Context(), rpcClient, epoch)
+	if err != nil {
+		return err
+	}
+	data, err := merkleproof.MarshalParticipantsBundle(bundle)
+	if err != nil {
+		logging.Error("Failed to serialize participants bundle", types.Participants, "epoch", epoch, "error", err)
+		return err
+	}
+	return c.JSONBlob(http.StatusOK, data)
+}
+
+func buildParticipantsBundle(ctx context.Context, rpcClient *rpcclient.HTTP, epoch uint64) (*merkleproof.ParticipantsBundle, error) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(interfaceRegistry)
+	result, err := queryActiveParticipants(rpcClient, codec.NewProtoCodec(interfaceRegistry), epoch)
+	if err != nil {
+		return nil, err
+	}
+	if result.Response.ProofOps == nil {
+		logging.Error("Active participants query returned no proof", types.Participants, "epoch", epoch)
+		return nil, echo.NewHTTPError(http.StatusServiceUnavailable, "Chain node did not return a proof for the active participants")
+	}
+
+	// The app hash committing to the value read at height N is recorded in the header of N+1
+	headerHeight := result.Response.Height + 1
+	commit, err := rpcClient.Commit(ctx, &headerHeight)
+	if err != nil {
+		logging.Error("Failed to get commit", types.Participants, "height", headerHeight, "error", err)
+		return nil, err
+	}
+	validators, err := queryAllValidators(ctx, rpcClient, headerHeight)
+	if err != nil {
+		logging.Error("Failed to get validators", types.Participants, "height", headerHeight, "error", err)
+		return nil, err
+	}
+
+	return &merkleproof.ParticipantsBundle{
+		Version:      merkleproof.ParticipantsBundleVersion,
+		ChainID:      commit.Header.ChainID,
+		Epoch:        epoch,
+		StoreKey:     "inference",
+		Key:          types.ActiveParticipantsFullKey(epoch),
+		Value:        result.Response.Value,
+		ProofOps:     result.Response.ProofOps,
+		SignedHeader: &commit.SignedHeader,
+		Validators:   validators,
+	}, nil
+}
+
+// queryAllValidators returns the complete validator set at a height, walking all result pages
+func queryAllValidators(ctx context.Context, rpcClient *rpcclient.HTTP, height int64) ([]*comettypes.Validator, error) {
+	var validators []*comettypes.Validator
+	perPage := validatorsPageSize
+	for page := 1; ; page++ {
+		res, err := rpcClient.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			return validators, nil
+		}
+	}
+}
@@ -66,7 +66,11 @@ func (s *Server) getParticipantsByEpoch(c echo.Context) error {
 // resolveEpochFromContext extracts the epoch from the context parameters.
 // If the epoch is "current", it returns nil
 func (s *Server) resolveEpochFromContext(c echo.Context) (uint64, error) {
-	epochParam := c.Param("epoch")
+	return s.resolveEpochParam(c.Param("epoch"))
+}
+
+// resolveEpochParam parses an epoch index, or resolves "current" to the current epoch
+func (s *Server) resolveEpochParam(epochParam string) (uint64, error) {
 	if epochParam == "" {
 		return 0, ErrInvalidEpochId
 	}
@@ -100,7 +104,7 @@ func (s *Server) getParticipants(ctx context.Context, epoch uint64) (*ActivePart
 
 	cdc := codec.NewProtoCodec(interfaceRegistry)
 
-	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainNodeConfig().Url)
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
 	if err != nil {
 		logging.Error("Failed to create rpc client", types.System, "error", err)
 		return nil, err
@@ -121,14 +125,15 @@ func (s *Server) getParticipants(ctx context.Context, epoch uint64) (*ActivePart
 		"epoch", epoch,
 		"activeParticipants", activeParticipants)
 
-	block, err := rpcClient.Block(context.Background(), &activeParticipants.CreatedAtBlockHeight)
+	blockClient := cosmos_client.WithBlockCache(rpcClient, s.blockCache)
+	block, err := blockClient.Block(context.Background(), &activeParticipants.CreatedAtBlockHeight)
 	if err != nil || block == nil {
 		logging.Error("Failed to get block", types.Participants, "error", err)
 		return nil, err
 	}
 
 	heightP1 := activeParticipants.CreatedAtBlockHeight + 1
-	blockP1, err := rpcClient.Block(context.Background(), &heightP1)
+	blockP1, err := blockClient.Block(context.Background(), &heightP1)
 	if err != nil || blockP1 == nil {
 		logging.Error("Failed to get block + 1", types.Participants, "error", err)
 	}
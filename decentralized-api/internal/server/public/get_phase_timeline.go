@@ -0,0 +1,17 @@
+package public
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// getPhaseTimeline returns the predicted heights and ETAs of the upcoming phase boundaries,
+// for operators to schedule maintenance outside of PoC
+func (s *Server) getPhaseTimeline(ctx echo.Context) error {
+	timeline := s.phaseTracker.GetPhaseTimeline()
+	if timeline == nil {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "epoch state is not known yet")
+	}
+	return ctx.JSON(http.StatusOK, timeline)
+}
@@ -0,0 +1,58 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getPocAllocationAudit returns the PoC slot allocation audit stored on chain for an epoch and model.
+// The model is passed as query param "model" since model ids usually contain slashes.
+func (s *Server) getPocAllocationAudit(c echo.Context) error {
+	epoch, err := s.resolveEpochFromContext(c)
+	if err != nil {
+		logging.Error("Failed to resolve epoch from context", types.Allocation, "error", err)
+		return err
+	}
+
+	modelId := c.QueryParam("model")
+	if modelId == "" {
+		return ErrNoModelSpecified
+	}
+
+	dataKey, err := types.PocAllocationAuditFullKey(epoch, modelId)
+	if err != nil {
+		logging.Error("Failed to encode PoC allocation audit key", types.Allocation, "epoch", epoch, "model", modelId, "error", err)
+		return err
+	}
+
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Allocation, "error", err)
+		return err
+	}
+
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+	if err != nil {
+		logging.Error("Failed to query PoC allocation audit", types.Allocation, "epoch", epoch, "model", modelId, "error", err)
+		return err
+	}
+
+	if len(result.Response.Value) == 0 {
+		msg := fmt.Sprintf("PoC allocation audit not found. epoch = %d, model = %s", epoch, modelId)
+		return echo.NewHTTPError(http.StatusNotFound, msg)
+	}
+
+	var audit types.PocAllocationAudit
+	if err := json.Unmarshal(result.Response.Value, &audit); err != nil {
+		logging.Error("Failed to decode PoC allocation audit", types.Allocation, "epoch", epoch, "model", modelId, "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, audit)
+}
@@ -0,0 +1,27 @@
+package public
+
+import (
+	"decentralized-api/logging"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getRequesterBandwidth returns how much of its bandwidth allotment on this Transfer Agent a requester
+// has in flight and has left for a request started at the current block.
+func (s *Server) getRequesterBandwidth(c echo.Context) error {
+	address := c.Param("address")
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid requester address")
+	}
+
+	status, err := s.recorder.Status(c.Request().Context())
+	if err != nil {
+		logging.Error("Failed to get status", types.Inferences, "error", err)
+		return err
+	}
+
+	return c.JSON(http.StatusOK, s.bandwidthLimiter.GetRequesterBandwidth(address, status.SyncInfo.LatestBlockHeight))
+}
@@ -0,0 +1,82 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// getTrainingDataset returns a dataset of the on-chain training dataset registry.
+// The dataset is passed as query param "name" since dataset names may contain slashes.
+func (s *Server) getTrainingDataset(c echo.Context) error {
+	name := c.QueryParam("name")
+	if name == "" {
+		return echo.NewHTTPError(http.StatusBadRequest, "name required")
+	}
+	dataKey, err := types.TrainingDatasetFullKey(name)
+	if err != nil {
+		logging.Error("Failed to encode training dataset key", types.Training, "name", name, "error", err)
+		return err
+	}
+
+	var dataset types.TrainingDataset
+	found, err := s.queryTrainingRecord(dataKey, &dataset)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Training dataset not found. name = %s", name))
+	}
+	return c.JSON(http.StatusOK, dataset)
+}
+
+// getTrainingTaskDatasets returns the datasets, with their hashes, a training task was created with
+func (s *Server) getTrainingTaskDatasets(c echo.Context) error {
+	taskId, err := strconv.ParseUint(c.Param("id"), 10, 64)
+	if err != nil {
+		return ErrInvalidTrainingJobId
+	}
+	dataKey, err := types.TrainingTaskDatasetsFullKey(taskId)
+	if err != nil {
+		logging.Error("Failed to encode training task datasets key", types.Training, "taskId", taskId, "error", err)
+		return err
+	}
+
+	var datasets types.TrainingTaskDatasets
+	found, err := s.queryTrainingRecord(dataKey, &datasets)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Training task datasets not found. task_id = %d", taskId))
+	}
+	return c.JSON(http.StatusOK, datasets)
+}
+
+// queryTrainingRecord reads a JSON-encoded training record of the inference store by key
+func (s *Server) queryTrainingRecord(dataKey []byte, record any) (bool, error) {
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Training, "error", err)
+		return false, err
+	}
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", dataKey)
+	if err != nil {
+		logging.Error("Failed to query training record", types.Training, "error", err)
+		return false, err
+	}
+	if len(result.Response.Value) == 0 {
+		return false, nil
+	}
+	if err := json.Unmarshal(result.Response.Value, record); err != nil {
+		logging.Error("Failed to decode training record", types.Training, "error", err)
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,226 @@
+package public
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/cosmosclient"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	healthCheckTimeout = 5 * time.Second
+	// websocketStaleAfter is how long the chain websocket may stay silent; a new block arrives every few seconds
+	websocketStaleAfter = 60 * time.Second
+
+	DependencyStatusOK      = "ok"
+	DependencyStatusFail    = "fail"
+	DependencyStatusSkipped = "skipped"
+)
+
+// WebsocketLiveness reports when the chain websocket subscription last delivered a message
+type WebsocketLiveness interface {
+	LastWebsocketMessage() time.Time
+}
+
+type DependencyStatus struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+	LatencyMs int64  `json:"latency_ms"`
+}
+
+type HealthReport struct {
+	Status       string             `json:"status"`
+	Dependencies []DependencyStatus `json:"dependencies"`
+}
+
+// dependencyCheck returns a short description of the dependency state, or an error if it is unhealthy.
+// A nil check marks a dependency this API runs without.
+type dependencyCheck struct {
+	name  string
+	check func(ctx context.Context) (string, error)
+}
+
+// getHealthz is the liveness probe: it fails only on local dependencies that a restart may fix,
+// the SQLite database and a websocket subscription that stopped delivering blocks
+func (s *Server) getHealthz(ctx echo.Context) error {
+	return s.writeHealthReport(ctx, []dependencyCheck{
+		s.sqliteCheck(),
+		s.websocketCheck(false),
+	})
+}
+
+// getReadyz is the readiness probe: the API serves traffic only with a synced chain node,
+// a live websocket subscription, an accessible database and at least one healthy ML node
+func (s *Server) getReadyz(ctx echo.Context) error {
+	return s.writeHealthReport(ctx, []dependencyCheck{
+		s.chainRpcCheck(),
+		s.websocketCheck(true),
+		s.sqliteCheck(),
+		s.mlNodesCheck(),
+	})
+}
+
+func (s *Server) writeHealthReport(ctx echo.Context, checks []dependencyCheck) error {
+	report := runDependencyChecks(ctx.Request().Context(), checks)
+	code := http.StatusOK
+	if report.Status != DependencyStatusOK {
+		code = http.StatusServiceUnavailable
+	}
+	return ctx.JSON(code, report)
+}
+
+func runDependencyChecks(ctx context.Context, checks []dependencyCheck) HealthReport {
+	report := HealthReport{
+		Status:       DependencyStatusOK,
+		Dependencies: make([]DependencyStatus, len(checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		if c.check == nil {
+			report.Dependencies[i] = DependencyStatus{Name: c.name, Status: DependencyStatusSkipped, Message: "not configured"}
+			continue
+		}
+		wg.Add(1)
+		go func(i int, c dependencyCheck) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+			defer cancel()
+
+			start := time.Now()
+			message, err := c.check(checkCtx)
+			status := DependencyStatus{
+				Name:      c.name,
+				Status:    DependencyStatusOK,
+				Message:   message,
+				LatencyMs: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				status.Status = DependencyStatusFail
+				status.Message = err.Error()
+			}
+			report.Dependencies[i] = status
+		}(i, c)
+	}
+	wg.Wait()
+
+	for _, dependency := range report.Dependencies {
+		if dependency.Status == DependencyStatusFail {
+			report.Status = DependencyStatusFail
+		}
+	}
+	return report
+}
+
+func (s *Server) chainRpcCheck() dependencyCheck {
+	return dependencyCheck{name: "chain_rpc", check: func(ctx context.Context) (string, error) {
+		rpcClient, err := cosmosclient.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+		if err != nil {
+			return "", fmt.Errorf("failed to connect to chain node: %w", err)
+		}
+		status, err := rpcClient.Status(ctx)
+		if err != nil {
+			return "", fmt.Errorf("failed to get node status: %w", err)
+		}
+		if status.SyncInfo.CatchingUp {
+			return "", fmt.Errorf("chain node is catching up at height %d", status.SyncInfo.LatestBlockHeight)
+		}
+		return fmt.Sprintf("latest height %d", status.SyncInfo.LatestBlockHeight), nil
+	}}
+}
+
+// websocketCheck fails when the websocket subscription went silent. Unless requireMessage is set,
+// a subscription that has not delivered its first message yet is considered still starting.
+func (s *Server) websocketCheck(requireMessage bool) dependencyCheck {
+	if s.wsLiveness == nil {
+		return dependencyCheck{name: "chain_websocket"}
+	}
+	return dependencyCheck{name: "chain_websocket", check: func(ctx context.Context) (string, error) {
+		return checkWebsocketLiveness(s.wsLiveness.LastWebsocketMessage(), time.Now(), requireMessage)
+	}}
+}
+
+func checkWebsocketLiveness(lastMessage time.Time, now time.Time, requireMessage bool) (string, error) {
+	if lastMessage.IsZero() {
+		if requireMessage {
+			return "", fmt.Errorf("no message received yet")
+		}
+		return "waiting for the first message", nil
+	}
+	silence := now.Sub(lastMessage)
+	if silence > websocketStaleAfter {
+		return "", fmt.Errorf("no message for %s", silence.Round(time.Second))
+	}
+	return fmt.Sprintf("last message %s ago", silence.Round(time.Second)), nil
+}
+
+func (s *Server) sqliteCheck() dependencyCheck {
+	if s.db == nil {
+		return dependencyCheck{name: "sqlite"}
+	}
+	return dependencyCheck{name: "sqlite", check: func(ctx context.Context) (string, error) {
+		return checkSqlite(ctx, s.db)
+	}}
+}
+
+func checkSqlite(ctx context.Context, db *sql.DB) (string, error) {
+	var one int
+	if err := db.QueryRowContext(ctx, `SELECT 1`).Scan(&one); err != nil {
+		return "", fmt.Errorf("database is not accessible: %w", err)
+	}
+	return "", nil
+}
+
+func (s *Server) mlNodesCheck() dependencyCheck {
+	if s.nodeBroker == nil {
+		return dependencyCheck{name: "ml_nodes"}
+	}
+	return dependencyCheck{name: "ml_nodes", check: func(ctx context.Context) (string, error) {
+		nodes, err := s.nodeBroker.GetNodes()
+		if err != nil {
+			return "", fmt.Errorf("failed to list ML nodes: %w", err)
+		}
+		if len(nodes) == 0 {
+			return "", fmt.Errorf("no ML nodes registered")
+		}
+
+		version := s.configManager.GetCurrentNodeVersion()
+		healthy := 0
+		var unreachable []string
+		for _, node := range nodes {
+			healthUrl, err := url.JoinPath(node.Node.PoCUrlWithVersion(version), "/health")
+			if err == nil && probeHealthUrl(ctx, s.httpClient, healthUrl) {
+				healthy++
+			} else {
+				unreachable = append(unreachable, node.Node.Id)
+			}
+		}
+		if healthy == 0 {
+			return "", fmt.Errorf("none of %d ML nodes is reachable", len(nodes))
+		}
+		if len(unreachable) > 0 {
+			return fmt.Sprintf("%d of %d ML nodes healthy, unreachable: %v", healthy, len(nodes), unreachable), nil
+		}
+		return fmt.Sprintf("%d of %d ML nodes healthy", healthy, len(nodes)), nil
+	}}
+}
+
+func probeHealthUrl(ctx context.Context, client *http.Client, healthUrl string) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthUrl, nil)
+	if err != nil {
+		return false
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
@@ -0,0 +1,90 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedWebsocketLiveness time.Time
+
+func (f fixedWebsocketLiveness) LastWebsocketMessage() time.Time {
+	return time.Time(f)
+}
+
+func TestRunDependencyChecks(t *testing.T) {
+	ok := dependencyCheck{name: "ok", check: func(context.Context) (string, error) { return "fine", nil }}
+	failing := dependencyCheck{name: "failing", check: func(context.Context) (string, error) { return "", errors.New("down") }}
+	skipped := dependencyCheck{name: "skipped"}
+
+	report := runDependencyChecks(t.Context(), []dependencyCheck{ok, skipped})
+	require.Equal(t, DependencyStatusOK, report.Status)
+	require.Equal(t, DependencyStatus{Name: "ok", Status: DependencyStatusOK, Message: "fine"}, withoutLatency(report.Dependencies[0]))
+	require.Equal(t, DependencyStatusSkipped, report.Dependencies[1].Status)
+
+	report = runDependencyChecks(t.Context(), []dependencyCheck{ok, failing, skipped})
+	require.Equal(t, DependencyStatusFail, report.Status)
+	require.Equal(t, DependencyStatus{Name: "failing", Status: DependencyStatusFail, Message: "down"}, withoutLatency(report.Dependencies[1]))
+}
+
+func withoutLatency(status DependencyStatus) DependencyStatus {
+	status.LatencyMs = 0
+	return status
+}
+
+func TestCheckWebsocketLiveness(t *testing.T) {
+	now := time.Now()
+
+	_, err := checkWebsocketLiveness(time.Time{}, now, false)
+	require.NoError(t, err)
+	_, err = checkWebsocketLiveness(time.Time{}, now, true)
+	require.Error(t, err)
+
+	_, err = checkWebsocketLiveness(now.Add(-5*time.Second), now, true)
+	require.NoError(t, err)
+	_, err = checkWebsocketLiveness(now.Add(-2*websocketStaleAfter), now, false)
+	require.Error(t, err)
+}
+
+func TestGetHealthz(t *testing.T) {
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+
+	s := &Server{db: db, wsLiveness: fixedWebsocketLiveness(time.Now())}
+	report, code := serveHealthz(t, s)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, DependencyStatusOK, report.Status)
+	require.Len(t, report.Dependencies, 2)
+
+	s.wsLiveness = fixedWebsocketLiveness(time.Now().Add(-2 * websocketStaleAfter))
+	report, code = serveHealthz(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, DependencyStatusOK, report.Dependencies[0].Status)
+	require.Equal(t, DependencyStatusFail, report.Dependencies[1].Status)
+
+	require.NoError(t, db.Close())
+	s.wsLiveness = fixedWebsocketLiveness(time.Now())
+	report, code = serveHealthz(t, s)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, DependencyStatusFail, report.Dependencies[0].Status)
+}
+
+func serveHealthz(t *testing.T, s *Server) (HealthReport, int) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/healthz", nil), rec)
+	require.NoError(t, s.getHealthz(c))
+
+	var report HealthReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	return report, rec.Code
+}
@@ -0,0 +1,163 @@
+package public
+
+import (
+	"context"
+	"decentralized-api/internal/audit"
+	"decentralized-api/internal/inflight"
+	"decentralized-api/logging"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/api/inference/inference"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const maxHedgedExecutorDraws = 3
+
+var errNoHedgeExecutor = errors.New("no other executor drawn for the model")
+
+// hedgedAttempt is one executor a hedged transfer request is sent to
+type hedgedAttempt struct {
+	executor         *ExecutorDestination
+	inferenceRequest *inference.MsgStartInference
+	seed             int32
+	sentAt           time.Time
+	cancel           context.CancelFunc
+	resp             *http.Response
+	err              error
+}
+
+// delivered reports whether the executor responded, with anything but a server error
+func (a *hedgedAttempt) delivered() bool {
+	return a != nil && a.err == nil && a.resp.StatusCode < http.StatusInternalServerError
+}
+
+// routeHedgedRequest sends the request to a second executor when the first has not responded within
+// threshold, and serves whichever responds first. Both executors run the same inference id, so the
+// MsgStartInference of the served attempt only is submitted: the chain assigns the inference to that
+// executor, and the other request is cancelled. An executor does not record a cancelled hedged request
+// the chain did not assign to it. When every attempt fails, the last failure is served and started,
+// as an unhedged request would be.
+func (s *Server) routeHedgedRequest(ctx echo.Context, request *ChatRequest, entry *audit.Entry, primary *hedgedAttempt, promptTokenCount int, threshold time.Duration) error {
+	inferenceId := primary.inferenceRequest.InferenceId
+	results := make(chan *hedgedAttempt, 2)
+	dispatch := func(attempt *hedgedAttempt) {
+		attemptCtx, cancel := context.WithCancel(ctx.Request().Context())
+		attempt.cancel = cancel
+		attempt.sentAt = time.Now()
+		logging.Debug("Sending hedged request to executor", types.Inferences, "url", attempt.executor.Url, "seed", attempt.seed, "inferenceId", inferenceId)
+		go func() {
+			attempt.resp, attempt.err = s.sendToExecutor(attemptCtx, request, attempt.executor, attempt.inferenceRequest, attempt.seed, true)
+			results <- attempt
+		}()
+	}
+
+	attempts := []*hedgedAttempt{primary}
+	dispatch(primary)
+	running := 1
+	hedgeTimer := time.NewTimer(threshold)
+	defer hedgeTimer.Stop()
+	hedgeC := hedgeTimer.C
+
+	var served *hedgedAttempt
+	for running > 0 && !served.delivered() {
+		select {
+		case <-hedgeC:
+			hedgeC = nil
+			secondary, err := s.newHedgedAttempt(ctx.Request().Context(), request, primary, promptTokenCount)
+			if err != nil {
+				logging.Warn("Unable to hedge slow inference", types.Inferences, "inferenceId", inferenceId, "error", err)
+				continue
+			}
+			logging.Info("Executor slow, hedging inference on a second executor", types.Inferences,
+				"inferenceId", inferenceId, "executor", primary.executor.Address, "secondary", secondary.executor.Address, "threshold", threshold)
+			attempts = append(attempts, secondary)
+			dispatch(secondary)
+			running++
+		case attempt := <-results:
+			running--
+			if served != nil && served.resp != nil {
+				served.resp.Body.Close()
+			}
+			served = attempt
+			switch {
+			case attempt.delivered():
+				s.peerHealth.RecordDelivery(attempt.executor.Address)
+			case attempt.err != nil:
+				logging.Error("Failed to make http request to executor", types.Inferences, "error", attempt.err, "url", attempt.executor.Url)
+				s.reportNonDelivery(request, attempt.inferenceRequest, attempt.executor, attempt.sentAt, 0, attempt.err.Error())
+			default:
+				s.reportNonDelivery(request, attempt.inferenceRequest, attempt.executor, attempt.sentAt, attempt.resp.StatusCode, http.StatusText(attempt.resp.StatusCode))
+			}
+		}
+	}
+
+	// The attempts still running lost: they are cancelled and their responses, if any, discarded
+	for _, attempt := range attempts {
+		if attempt != served {
+			attempt.cancel()
+		}
+	}
+	if running > 0 {
+		go func(losers int) {
+			for i := 0; i < losers; i++ {
+				if attempt := <-results; attempt.resp != nil {
+					attempt.resp.Body.Close()
+				}
+			}
+		}(running)
+	}
+	defer served.cancel()
+
+	entry.ExecutorAddress = served.executor.Address
+	s.submitStartInference(ctx.Request().Context(), request, served.inferenceRequest)
+
+	if served.err != nil {
+		if inflight.Expired(ctx.Request().Context()) {
+			return ErrInferenceExpired
+		}
+		return served.err
+	}
+	defer served.resp.Body.Close()
+
+	logging.Info("Proxying response from executor", types.Inferences,
+		"inferenceId", inferenceId,
+		"executor", served.executor.Address,
+		"hedged", len(attempts) > 1)
+	proxyResponse(served.resp, ctx.Response().Writer, false, nil, inferenceId)
+	return nil
+}
+
+// newHedgedAttempt draws a second executor for the inference, other than the first one and this node
+func (s *Server) newHedgedAttempt(ctx context.Context, request *ChatRequest, primary *hedgedAttempt, promptTokenCount int) (*hedgedAttempt, error) {
+	for draw := 0; draw < maxHedgedExecutorDraws; draw++ {
+		executor, err := s.getExecutorForRequest(ctx, request.OpenAiRequest.Model)
+		if err != nil {
+			return nil, err
+		}
+		if executor.Address == primary.executor.Address || executor.Url == s.configManager.GetApiConfig().PublicUrl {
+			continue
+		}
+		inferenceRequest, err := createInferenceStartRequest(s, request, primary.seed, primary.inferenceRequest.InferenceId, executor, s.configManager.GetCurrentNodeVersion(), promptTokenCount)
+		if err != nil {
+			return nil, err
+		}
+		return &hedgedAttempt{executor: executor, inferenceRequest: inferenceRequest, seed: primary.seed}, nil
+	}
+	return nil, errNoHedgeExecutor
+}
+
+// hedgedInferenceAssigned reports whether the chain assigned a hedged inference to this executor, i.e. the
+// transfer agent served this executor's response and started the inference for it
+func (s *Server) hedgedInferenceAssigned(inferenceId string) bool {
+	queryCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := s.recorder.NewInferenceQueryClient().Inference(queryCtx, &types.QueryGetInferenceRequest{Index: inferenceId})
+	if err != nil {
+		logging.Warn("Failed to get hedged inference", types.Inferences, "inferenceId", inferenceId, "error", err)
+		return false
+	}
+	return response.Inference.AssignedTo == s.recorder.GetAccountAddress()
+}
@@ -0,0 +1,152 @@
+package public
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"decentralized-api/apiconfig"
+	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/audit"
+	"decentralized-api/utils"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/api/inference/inference"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeExecutorQueryServer struct {
+	types.UnimplementedQueryServer
+	executor types.Participant
+}
+
+func (f *fakeExecutorQueryServer) GetRandomExecutor(ctx context.Context, req *types.QueryGetRandomExecutorRequest) (*types.QueryGetRandomExecutorResponse, error) {
+	return &types.QueryGetRandomExecutorResponse{Executor: f.executor}, nil
+}
+
+// newHedgingTestServer returns a server drawing secondary as executor, and the executors it starts inferences for
+func newHedgingTestServer(t *testing.T, secondary types.Participant) (*Server, <-chan string) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry))
+	record, _, err := kr.NewMnemonic("ta", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	address, err := record.GetAddress()
+	require.NoError(t, err)
+
+	conn, cleanup := startBufGRPCServer(t, &fakeExecutorQueryServer{executor: secondary})
+	t.Cleanup(cleanup)
+
+	mc := &cosmosclient.MockCosmosMessageClient{}
+	mc.On("NewInferenceQueryClient").Return(types.NewQueryClient(conn))
+	mc.On("GetSignerAddress").Return(address.String())
+	mc.On("GetKeyring").Return(&kr)
+	started := make(chan string, 2)
+	mc.On("StartInference", mock.Anything).Run(func(args mock.Arguments) {
+		started <- args.Get(0).(*inference.MsgStartInference).AssignedTo
+	}).Return(nil)
+
+	return &Server{
+		configManager: &apiconfig.ConfigManager{},
+		recorder:      mc,
+		httpClient:    &http.Client{},
+	}, started
+}
+
+func newHedgingTestRequest(t *testing.T) (echo.Context, *httptest.ResponseRecorder, *ChatRequest) {
+	body := `{"model":"test-model","max_tokens":16,"messages":[{"role":"user","content":"hello"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec, &ChatRequest{
+		Body:             []byte(body),
+		Request:          req,
+		Endpoint:         ChatCompletionsEndpoint,
+		OpenAiRequest:    OpenAiRequest{Model: "test-model", MaxTokens: 16},
+		AuthKey:          "inf-1",
+		RequesterAddress: "requester",
+		Timestamp:        time.Now().UnixNano(),
+	}
+}
+
+func requireStartedOnlyFor(t *testing.T, started <-chan string, executor string) {
+	select {
+	case assignedTo := <-started:
+		require.Equal(t, executor, assignedTo)
+	case <-time.After(5 * time.Second):
+		t.Fatal("inference was not started")
+	}
+	select {
+	case assignedTo := <-started:
+		t.Fatalf("inference also started for %s", assignedTo)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRouteHedgedRequest_ServesSecondaryWhenPrimaryIsSlow(t *testing.T) {
+	primaryCancelled := make(chan string, 1)
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server notices the client going away once the body is read
+		_, _ = io.ReadAll(r.Body)
+		<-r.Context().Done()
+		primaryCancelled <- r.Header.Get(utils.XHedgedRequestHeader)
+	}))
+	defer slow.Close()
+	fast := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "from secondary")
+	}))
+	defer fast.Close()
+
+	s, started := newHedgingTestServer(t, types.Participant{Address: "secondary", InferenceUrl: fast.URL})
+	c, rec, request := newHedgingTestRequest(t)
+	entry := &audit.Entry{}
+	primary := &hedgedAttempt{
+		executor:         &ExecutorDestination{Url: slow.URL, Address: "primary"},
+		inferenceRequest: &inference.MsgStartInference{InferenceId: "inf-1", AssignedTo: "primary"},
+		seed:             7,
+	}
+
+	require.NoError(t, s.routeHedgedRequest(c, request, entry, primary, 4, 50*time.Millisecond))
+	require.Equal(t, "from secondary", rec.Body.String())
+	require.Equal(t, "secondary", entry.ExecutorAddress)
+
+	select {
+	case hedged := <-primaryCancelled:
+		require.Equal(t, "true", hedged)
+	case <-time.After(5 * time.Second):
+		t.Fatal("slow executor request was not cancelled")
+	}
+	requireStartedOnlyFor(t, started, "secondary")
+}
+
+func TestRouteHedgedRequest_PrimaryWithinThreshold(t *testing.T) {
+	executor := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "from primary")
+	}))
+	defer executor.Close()
+
+	s, started := newHedgingTestServer(t, types.Participant{Address: "secondary", InferenceUrl: "http://unused"})
+	c, rec, request := newHedgingTestRequest(t)
+	entry := &audit.Entry{}
+	primary := &hedgedAttempt{
+		executor:         &ExecutorDestination{Url: executor.URL, Address: "primary"},
+		inferenceRequest: &inference.MsgStartInference{InferenceId: "inf-1", AssignedTo: "primary"},
+		seed:             7,
+	}
+
+	require.NoError(t, s.routeHedgedRequest(c, request, entry, primary, 4, time.Minute))
+	require.Equal(t, "from primary", rec.Body.String())
+	requireStartedOnlyFor(t, started, "primary")
+}
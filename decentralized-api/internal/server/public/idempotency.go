@@ -0,0 +1,199 @@
+package public
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const maxIdempotencyKeyLength = 255
+
+// idempotentResponse is a request seen with an Idempotency-Key: in flight until completed, then replayable until it expires
+type idempotentResponse struct {
+	bodyHash    [sha256.Size]byte
+	completed   bool
+	status      int
+	contentType string
+	body        []byte
+	createdAt   time.Time
+	expiresAt   time.Time
+}
+
+// idempotencyCache keeps the completed inference responses of requests sent with an Idempotency-Key,
+// so a client retrying a request (e.g. after a dropped connection) gets the original response instead of
+// paying for the inference again
+type idempotencyCache struct {
+	mu      sync.Mutex
+	entries map[string]*idempotentResponse
+}
+
+func newIdempotencyCache() *idempotencyCache {
+	return &idempotencyCache{entries: make(map[string]*idempotentResponse)}
+}
+
+// begin returns the completed response to replay for the key, or reserves the key for a new request
+// and returns the reservation to complete or abandon once the request is done
+func (c *idempotencyCache) begin(key string, bodyHash [sha256.Size]byte, now time.Time, maxEntries int) (replay *idempotentResponse, reserved *idempotentResponse, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if existing, ok := c.entries[key]; ok && (!existing.completed || now.Before(existing.expiresAt)) {
+		switch {
+		case existing.bodyHash != bodyHash:
+			return nil, nil, echo.NewHTTPError(http.StatusUnprocessableEntity, "Idempotency-Key was already used for a different request body")
+		case !existing.completed:
+			return nil, nil, echo.NewHTTPError(http.StatusConflict, "A request with this Idempotency-Key is still in progress")
+		default:
+			return existing, nil, nil
+		}
+	}
+
+	c.evict(now, maxEntries-1)
+	reserved = &idempotentResponse{bodyHash: bodyHash, createdAt: now}
+	c.entries[key] = reserved
+	return nil, reserved, nil
+}
+
+// evict drops the expired responses and then the oldest entries until at most limit remain
+func (c *idempotencyCache) evict(now time.Time, limit int) {
+	for key, entry := range c.entries {
+		if entry.completed && !now.Before(entry.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+	for len(c.entries) > limit && len(c.entries) > 0 {
+		var oldestKey string
+		var oldest *idempotentResponse
+		for key, entry := range c.entries {
+			if oldest == nil || entry.createdAt.Before(oldest.createdAt) {
+				oldestKey, oldest = key, entry
+			}
+		}
+		delete(c.entries, oldestKey)
+	}
+}
+
+func (c *idempotencyCache) complete(key string, reserved *idempotentResponse, status int, contentType string, body []byte, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] != reserved {
+		// evicted while in flight
+		return
+	}
+	reserved.completed = true
+	reserved.status = status
+	reserved.contentType = contentType
+	reserved.body = body
+	reserved.expiresAt = expiresAt
+}
+
+// abandon releases the key of a request that did not complete, so a retry executes it again
+func (c *idempotencyCache) abandon(key string, reserved *idempotentResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[key] == reserved {
+		delete(c.entries, key)
+	}
+}
+
+// capturingWriter copies the response written to the client, up to limit bytes
+type capturingWriter struct {
+	http.ResponseWriter
+	status   int
+	body     bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (w *capturingWriter) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *capturingWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	if !w.overflow {
+		if w.body.Len()+len(b) > w.limit {
+			w.overflow = true
+			w.body = bytes.Buffer{}
+		} else {
+			w.body.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *capturingWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// completed reports whether the captured response is a whole successful inference worth replaying
+func (w *capturingWriter) completed() bool {
+	if w.overflow || w.status < 200 || w.status >= 300 {
+		return false
+	}
+	if strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		// a stream cut short by the executor or the client has no terminating event
+		return bytes.Contains(w.body.Bytes(), []byte("data: [DONE]"))
+	}
+	return true
+}
+
+func noopFinish(error) {}
+
+// beginIdempotentRequest handles the Idempotency-Key header of a transfer request whose requester has been
+// verified. It either replays the completed response of an earlier identical request (replayed is true),
+// or captures the response of this one; finish must be called with the outcome of the request.
+func (s *Server) beginIdempotentRequest(ctx echo.Context, request *ChatRequest) (replayed bool, finish func(error), err error) {
+	idempotencyKey := ctx.Request().Header.Get(utils.IdempotencyKeyHeader)
+	cfg := s.configManager.GetIdempotencyConfig()
+	if idempotencyKey == "" || cfg.Disabled || s.idempotency == nil {
+		return false, noopFinish, nil
+	}
+	if len(idempotencyKey) > maxIdempotencyKeyLength {
+		return false, nil, echo.NewHTTPError(http.StatusBadRequest, "Idempotency-Key is too long")
+	}
+
+	// Keys are scoped to the requester, who has signed the request
+	cacheKey := request.RequesterAddress + "\x00" + idempotencyKey
+	now := time.Now()
+	replay, reserved, err := s.idempotency.begin(cacheKey, sha256.Sum256(request.Body), now, cfg.MaxEntries)
+	if err != nil {
+		logging.Warn("Rejected request with Idempotency-Key", types.Inferences,
+			"requester", request.RequesterAddress, "idempotencyKey", idempotencyKey, "error", err)
+		return false, nil, err
+	}
+	if replay != nil {
+		logging.Info("Replaying cached response for Idempotency-Key", types.Inferences,
+			"requester", request.RequesterAddress, "idempotencyKey", idempotencyKey)
+		ctx.Response().Header().Set(utils.IdempotentReplayHeader, "true")
+		return true, nil, ctx.Blob(replay.status, replay.contentType, replay.body)
+	}
+
+	original := ctx.Response().Writer
+	writer := &capturingWriter{ResponseWriter: original, limit: cfg.MaxResponseKB * 1024}
+	ctx.Response().Writer = writer
+	return false, func(requestErr error) {
+		ctx.Response().Writer = original
+		if requestErr != nil || ctx.Request().Context().Err() != nil || !writer.completed() {
+			s.idempotency.abandon(cacheKey, reserved)
+			return
+		}
+		s.idempotency.complete(cacheKey, reserved, writer.status, writer.Header().Get("Content-Type"),
+			writer.body.Bytes(), time.Now().Add(time.Duration(cfg.TtlSeconds)*time.Second))
+	}, nil
+}
@@ -0,0 +1,125 @@
+package public
+
+import (
+	"crypto/sha256"
+	"decentralized-api/apiconfig"
+	"decentralized-api/utils"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func newIdempotentContext(e *echo.Echo, key string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/v1/chat/completions", strings.NewReader("{}"))
+	req.Header.Set(utils.IdempotencyKeyHeader, key)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestBeginIdempotentRequest(t *testing.T) {
+	e := echo.New()
+	s := &Server{configManager: &apiconfig.ConfigManager{}, idempotency: newIdempotencyCache()}
+	request := &ChatRequest{RequesterAddress: "gonka1requester", Body: []byte(`{"model":"m"}`)}
+
+	ctx, rec := newIdempotentContext(e, "retry-1")
+	replayed, finish, err := s.beginIdempotentRequest(ctx, request)
+	require.NoError(t, err)
+	require.False(t, replayed)
+
+	// A duplicate arriving while the first request is in flight is rejected
+	dupCtx, _ := newIdempotentContext(e, "retry-1")
+	_, _, err = s.beginIdempotentRequest(dupCtx, request)
+	var httpErr *echo.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusConflict, httpErr.Code)
+
+	require.NoError(t, ctx.JSONBlob(http.StatusOK, []byte(`{"id":"inference-1"}`)))
+	finish(nil)
+	require.Equal(t, `{"id":"inference-1"}`, rec.Body.String())
+
+	// A retry gets the completed response back
+	retryCtx, retryRec := newIdempotentContext(e, "retry-1")
+	replayed, _, err = s.beginIdempotentRequest(retryCtx, request)
+	require.NoError(t, err)
+	require.True(t, replayed)
+	require.Equal(t, http.StatusOK, retryRec.Code)
+	require.Equal(t, `{"id":"inference-1"}`, retryRec.Body.String())
+	require.Equal(t, "true", retryRec.Header().Get(utils.IdempotentReplayHeader))
+
+	// The same key with another body is an error, and keys are scoped to the requester
+	otherBody := &ChatRequest{RequesterAddress: "gonka1requester", Body: []byte(`{"model":"other"}`)}
+	otherCtx, _ := newIdempotentContext(e, "retry-1")
+	_, _, err = s.beginIdempotentRequest(otherCtx, otherBody)
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusUnprocessableEntity, httpErr.Code)
+
+	otherRequester := &ChatRequest{RequesterAddress: "gonka1other", Body: request.Body}
+	otherCtx, _ = newIdempotentContext(e, "retry-1")
+	replayed, _, err = s.beginIdempotentRequest(otherCtx, otherRequester)
+	require.NoError(t, err)
+	require.False(t, replayed)
+}
+
+func TestBeginIdempotentRequest_FailuresAreNotCached(t *testing.T) {
+	e := echo.New()
+	s := &Server{configManager: &apiconfig.ConfigManager{}, idempotency: newIdempotencyCache()}
+	request := &ChatRequest{RequesterAddress: "gonka1requester", Body: []byte(`{"model":"m"}`)}
+
+	// Errors, non-2xx responses and streams without their terminating event are executed again on retry
+	outcomes := []func(ctx echo.Context) error{
+		func(ctx echo.Context) error { return errors.New("executor unavailable") },
+		func(ctx echo.Context) error { return ctx.JSONBlob(http.StatusBadGateway, []byte(`{}`)) },
+		func(ctx echo.Context) error {
+			ctx.Response().Header().Set("Content-Type", "text/event-stream")
+			return ctx.String(http.StatusOK, "data: {\"id\":\"1\"}\n")
+		},
+	}
+	for _, outcome := range outcomes {
+		ctx, _ := newIdempotentContext(e, "retry-1")
+		replayed, finish, err := s.beginIdempotentRequest(ctx, request)
+		require.NoError(t, err)
+		require.False(t, replayed)
+		finish(outcome(ctx))
+	}
+
+	ctx, _ := newIdempotentContext(e, "retry-1")
+	_, finish, err := s.beginIdempotentRequest(ctx, request)
+	require.NoError(t, err)
+	ctx.Response().Header().Set("Content-Type", "text/event-stream")
+	require.NoError(t, ctx.String(http.StatusOK, "data: {\"id\":\"1\"}\n\ndata: [DONE]\n"))
+	finish(nil)
+
+	retryCtx, retryRec := newIdempotentContext(e, "retry-1")
+	replayed, _, err := s.beginIdempotentRequest(retryCtx, request)
+	require.NoError(t, err)
+	require.True(t, replayed)
+	require.Contains(t, retryRec.Body.String(), "data: [DONE]")
+	require.True(t, strings.HasPrefix(retryRec.Header().Get("Content-Type"), "text/event-stream"))
+}
+
+func TestIdempotencyCacheEviction(t *testing.T) {
+	cache := newIdempotencyCache()
+	now := time.Now()
+	hash := sha256.Sum256([]byte("body"))
+
+	for i, key := range []string{"a", "b", "c"} {
+		_, reserved, err := cache.begin(key, hash, now.Add(time.Duration(i)*time.Second), 2)
+		require.NoError(t, err)
+		cache.complete(key, reserved, http.StatusOK, "application/json", []byte(key), now.Add(time.Minute))
+	}
+	require.Len(t, cache.entries, 2)
+	require.NotContains(t, cache.entries, "a")
+
+	// Expired responses are no longer replayed
+	replay, reserved, err := cache.begin("b", hash, now.Add(2*time.Minute), 2)
+	require.NoError(t, err)
+	require.Nil(t, replay)
+	require.NotNil(t, reserved)
+	require.NotContains(t, cache.entries, "c")
+}
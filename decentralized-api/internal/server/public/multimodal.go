@@ -0,0 +1,99 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const imageInputPolicyCacheTTL = time.Minute
+
+// imageInputPolicyCache keeps the governance-set image input policy, which rarely changes
+type imageInputPolicyCache struct {
+	mu        sync.RWMutex
+	policy    *types.ImageInputPolicy
+	expiresAt time.Time
+}
+
+func newImageInputPolicyCache() *imageInputPolicyCache {
+	return &imageInputPolicyCache{}
+}
+
+func (c *imageInputPolicyCache) get() (types.ImageInputPolicy, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.policy == nil || time.Now().After(c.expiresAt) {
+		return types.ImageInputPolicy{}, false
+	}
+	return *c.policy, true
+}
+
+func (c *imageInputPolicyCache) set(policy types.ImageInputPolicy) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.policy = &policy
+	c.expiresAt = time.Now().Add(imageInputPolicyCacheTTL)
+}
+
+func (s *Server) getImageInputPolicy() (types.ImageInputPolicy, error) {
+	if policy, ok := s.imageInputPolicy.get(); ok {
+		return policy, nil
+	}
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Inferences, "error", err)
+		return types.ImageInputPolicy{}, err
+	}
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", types.ImageInputPolicyFullKey())
+	if err != nil {
+		logging.Error("Failed to query image input policy", types.Inferences, "error", err)
+		return types.ImageInputPolicy{}, err
+	}
+	policy := types.DefaultImageInputPolicy()
+	if len(result.Response.Value) > 0 {
+		if err := json.Unmarshal(result.Response.Value, &policy); err != nil {
+			logging.Error("Failed to decode image input policy", types.Inferences, "error", err)
+			return types.ImageInputPolicy{}, err
+		}
+	}
+	s.imageInputPolicy.set(policy)
+	return policy, nil
+}
+
+// imageTokenEstimation returns the prompt tokens the image inputs of a chat request are charged as
+func imageTokenEstimation(images []ImageURL, policy types.ImageInputPolicy) (int, error) {
+	if len(images) > int(policy.MaxImagesPerRequest) {
+		if policy.MaxImagesPerRequest == 0 {
+			return 0, echo.NewHTTPError(http.StatusBadRequest, "image inputs are not accepted")
+		}
+		return 0, echo.NewHTTPError(http.StatusBadRequest,
+			fmt.Sprintf("too many images in request: %d, at most %d are accepted", len(images), policy.MaxImagesPerRequest))
+	}
+	tokens := 0
+	for _, image := range images {
+		tokens += int(policy.ImageTokens(image.Detail))
+	}
+	return tokens, nil
+}
+
+// getImageTokenEstimation accounts for the image inputs of a request per the governance-set rates
+func (s *Server) getImageTokenEstimation(request *ChatRequest) (int, error) {
+	images := request.OpenAiRequest.images()
+	if len(images) == 0 {
+		return 0, nil
+	}
+	policy, err := s.getImageInputPolicy()
+	if err != nil {
+		return 0, err
+	}
+	return imageTokenEstimation(images, policy)
+}
@@ -0,0 +1,59 @@
+package public
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageContentUnmarshal(t *testing.T) {
+	body := `{"model":"Qwen/Qwen2.5-VL-7B-Instruct","messages":[
+		{"role":"system","content":"You describe images."},
+		{"role":"user","content":[
+			{"type":"text","text":"What is in"},
+			{"type":"image_url","image_url":{"url":"https://example.com/cat.png","detail":"low"}},
+			{"type":"text","text":"this picture?"},
+			{"type":"image_url","image_url":{"url":"data:image/png;base64,iVBORw0KGgo="}}
+		]},
+		{"role":"assistant","content":null}
+	]}`
+	var request OpenAiRequest
+	require.NoError(t, json.Unmarshal([]byte(body), &request))
+
+	require.Equal(t, "You describe images.", request.Messages[0].Content.Text)
+	require.Equal(t, "What is in\nthis picture?", request.Messages[1].Content.Text)
+	require.Empty(t, request.Messages[2].Content.Text)
+	require.Equal(t, []ImageURL{
+		{URL: "https://example.com/cat.png", Detail: "low"},
+		{URL: "data:image/png;base64,iVBORw0KGgo="},
+	}, request.images())
+
+	require.Error(t, json.Unmarshal([]byte(`{"messages":[{"content":[{"type":"input_audio"}]}]}`), &request))
+	require.Error(t, json.Unmarshal([]byte(`{"messages":[{"content":[{"type":"image_url"}]}]}`), &request))
+	require.Error(t, json.Unmarshal([]byte(`{"messages":[{"content":42}]}`), &request))
+}
+
+func TestImageTokenEstimation(t *testing.T) {
+	policy := types.ImageInputPolicy{MaxImagesPerRequest: 2, TokensPerImage: 1000, LowDetailTokensPerImage: 80}
+	images := []ImageURL{{URL: "a", Detail: "low"}, {URL: "b", Detail: "high"}}
+
+	tokens, err := imageTokenEstimation(images, policy)
+	require.NoError(t, err)
+	require.Equal(t, 1080, tokens)
+
+	var httpErr *echo.HTTPError
+	_, err = imageTokenEstimation(append(images, ImageURL{URL: "c"}), policy)
+	require.ErrorAs(t, err, &httpErr)
+	require.Equal(t, http.StatusBadRequest, httpErr.Code)
+
+	_, err = imageTokenEstimation(images, types.ImageInputPolicy{})
+	require.ErrorContains(t, err, "image inputs are not accepted")
+
+	tokens, err = imageTokenEstimation(nil, types.ImageInputPolicy{})
+	require.NoError(t, err)
+	require.Zero(t, tokens)
+}
@@ -0,0 +1,109 @@
+package public
+
+import (
+	"decentralized-api/internal/onboarding"
+	"decentralized-api/logging"
+	"encoding/base64"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// OnboardingTokenHeader carries the token returned when a registration is created
+const OnboardingTokenHeader = "X-Onboarding-Token"
+
+var ErrOnboardingUnavailable = echo.NewHTTPError(http.StatusNotFound, "account onboarding is not enabled")
+
+type CreateRegistrationRequest struct {
+	GranterAddress string `json:"granter_address"`
+	// GranteePubKey is the base64 secp256k1 key of the grantee, the API generates one when empty
+	GranteePubKey string `json:"grantee_pub_key"`
+}
+
+type CreateRegistrationResponse struct {
+	onboarding.Registration
+	onboarding.Secrets
+}
+
+type RegistrationResponse struct {
+	onboarding.Registration
+	Credentials *onboarding.Credentials `json:"credentials,omitempty"`
+}
+
+type SubmitGrantTxRequest struct {
+	// SignedTx is the base64 encoded signed transaction, e.g. the output of `inferenced tx encode`
+	SignedTx string `json:"signed_tx"`
+}
+
+func (s *Server) postOnboardingRegistration(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	var request CreateRegistrationRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	reg, secrets, err := s.onboarding.Register(ctx.Request().Context(), request.GranterAddress, request.GranteePubKey)
+	if err != nil {
+		return onboardingError(err)
+	}
+	return ctx.JSON(http.StatusCreated, CreateRegistrationResponse{Registration: reg, Secrets: secrets})
+}
+
+func (s *Server) getOnboardingRegistration(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	reg, credentials, err := s.onboarding.Poll(ctx.Request().Context(), ctx.Param("id"), ctx.Request().Header.Get(OnboardingTokenHeader))
+	if err != nil {
+		logging.Warn("Failed to poll onboarding registration", types.Participants, "id", ctx.Param("id"), "error", err)
+		return onboardingError(err)
+	}
+	return ctx.JSON(http.StatusOK, RegistrationResponse{Registration: reg, Credentials: credentials})
+}
+
+// getOnboardingGrantTx returns the unsigned grant transaction for the granter to sign
+func (s *Server) getOnboardingGrantTx(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	unsignedTx, err := s.onboarding.UnsignedGrantTx(ctx.Request().Context(), ctx.Param("id"), ctx.Request().Header.Get(OnboardingTokenHeader))
+	if err != nil {
+		return onboardingError(err)
+	}
+	return ctx.JSONBlob(http.StatusOK, unsignedTx)
+}
+
+func (s *Server) postOnboardingGrantTx(ctx echo.Context) error {
+	if s.onboarding == nil {
+		return ErrOnboardingUnavailable
+	}
+	var request SubmitGrantTxRequest
+	if err := ctx.Bind(&request); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	signedTx, err := base64.StdEncoding.DecodeString(request.SignedTx)
+	if err != nil || len(signedTx) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, "signed_tx must be a base64 encoded transaction")
+	}
+	reg, err := s.onboarding.SubmitGrantTx(ctx.Request().Context(), ctx.Param("id"), ctx.Request().Header.Get(OnboardingTokenHeader), signedTx)
+	if err != nil {
+		return onboardingError(err)
+	}
+	return ctx.JSON(http.StatusAccepted, RegistrationResponse{Registration: reg})
+}
+
+func onboardingError(err error) error {
+	switch {
+	case errors.Is(err, onboarding.ErrRegistrationNotFound):
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case errors.Is(err, onboarding.ErrInvalidRegistration), errors.Is(err, onboarding.ErrInvalidGrantTx):
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	case errors.Is(err, onboarding.ErrInvalidStatus):
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	default:
+		return err
+	}
+}
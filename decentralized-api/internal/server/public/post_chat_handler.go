@@ -6,6 +6,12 @@ import (
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/completionapi"
+	"decentralized-api/internal/audit"
+	"decentralized-api/internal/inflight"
+	"decentralized-api/internal/metrics"
+	"decentralized-api/internal/peerhealth"
+	"decentralized-api/internal/server/apierrors"
+	"decentralized-api/internal/tracing"
 	"decentralized-api/logging"
 	"decentralized-api/utils"
 	"encoding/json"
@@ -15,6 +21,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -25,6 +32,7 @@ import (
 	"github.com/productscience/inference/cmd/inferenced/cmd"
 	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/productscience/inference/x/inference/types"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // AuthKeyContext represents the context in which an AuthKey was used
@@ -210,9 +218,13 @@ func cleanupExpiredAuthKeys(currentBlockHeight int64) {
 }
 
 func (s *Server) postChat(ctx echo.Context) error {
-	logging.Debug("PostChat. Received request", types.Inferences, "path", ctx.Request().URL.Path)
+	return s.postInference(ctx, ChatCompletionsEndpoint)
+}
+
+func (s *Server) postInference(ctx echo.Context, endpoint InferenceEndpoint) error {
+	logging.Debug("PostInference. Received request", types.Inferences, "path", ctx.Request().URL.Path)
 
-	chatRequest, err := readRequest(ctx.Request(), ctx.Response().Writer, s.recorder.GetAccountAddress())
+	chatRequest, err := readRequest(ctx.Request(), ctx.Response().Writer, s.recorder.GetAccountAddress(), endpoint)
 	if err != nil {
 		return err
 	}
@@ -240,7 +252,12 @@ func (s *Server) postChat(ctx echo.Context) error {
 		return err
 	}
 
-	if chatRequest.InferenceId != "" && chatRequest.Seed != "" {
+	isExecutorRequest := chatRequest.InferenceId != "" && chatRequest.Seed != ""
+	if err := s.applyContentFilter(ctx, chatRequest, isExecutorRequest); err != nil {
+		return err
+	}
+
+	if isExecutorRequest {
 		logging.Info("Executor request", types.Inferences, "inferenceId", chatRequest.InferenceId, "seed", chatRequest.Seed)
 		return s.handleExecutorRequest(ctx, chatRequest, ctx.Response().Writer)
 	} else {
@@ -293,8 +310,20 @@ func (s *Server) enforceTransferAgentAccess(taAddress string) error {
 }
 
 func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) error {
+	entry := s.newAuditEntry(audit.RoleTransfer, request)
+	err := s.routeTransferRequest(ctx, request, entry)
+	s.recordAudit(ctx, entry, err)
+	return err
+}
+
+func (s *Server) routeTransferRequest(ctx echo.Context, request *ChatRequest, entry *audit.Entry) (err error) {
 	logging.Debug("GET inference requester for transfer", types.Inferences, "address", request.RequesterAddress)
 
+	apiKeyId, err := s.checkApiKey(ctx)
+	if err != nil {
+		return err
+	}
+
 	queryClient := s.recorder.NewInferenceQueryClient()
 	requester, err := queryClient.InferenceParticipant(ctx.Request().Context(), &types.QueryInferenceParticipantRequest{Address: request.RequesterAddress})
 	if err != nil {
@@ -302,24 +331,32 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return err
 	}
 
-	promptText := ""
-	for _, message := range request.OpenAiRequest.Messages {
-		promptText += message.Content + "\n"
-	}
-
-	promptTokenCount, err := s.getPromptTokenEstimation(promptText, request.OpenAiRequest.Model)
+	promptTokenCount, err := s.getPromptTokenEstimation(request.promptText(), request.OpenAiRequest.Model)
 
 	if err != nil {
 		logging.Error("Failed to get prompt token estimation", types.Inferences, "error", err)
 		return err
 	}
 
+	imageTokenCount, err := s.getImageTokenEstimation(request)
+	if err != nil {
+		return err
+	}
+	promptTokenCount += imageTokenCount
+
 	logging.Info("Prompt token estimation", types.Inferences, "count", promptTokenCount, "model", request.OpenAiRequest.Model)
 
 	if err := s.validateRequester(ctx.Request().Context(), request, requester, promptTokenCount); err != nil {
 		return err
 	}
 
+	// Retries of a completed request are answered before the AuthKey reuse check and without paying again
+	replayed, finishIdempotent, err := s.beginIdempotentRequest(ctx, request)
+	if err != nil || replayed {
+		return err
+	}
+	defer func() { finishIdempotent(err) }()
+
 	status, err := s.recorder.Status(context.Background())
 	if err != nil {
 		logging.Error("Failed to get status", types.Inferences, "error", err)
@@ -330,6 +367,10 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return err
 	}
 
+	if err := s.checkApiKeyTokens(ctx, apiKeyId, int64(promptTokenCount)+int64(request.OpenAiRequest.MaxTokens)); err != nil {
+		return err
+	}
+
 	requestBlockHeight := status.SyncInfo.LatestBlockHeight
 	can, estimatedKB := s.bandwidthLimiter.CanAcceptRequest(requestBlockHeight, int(promptTokenCount), int(request.OpenAiRequest.MaxTokens))
 	if !can {
@@ -338,8 +379,13 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return echo.NewHTTPError(http.StatusTooManyRequests, "Transfer Agent capacity reached. Try another TA from "+url+"/v1/epochs/current/participants")
 	}
 
-	s.bandwidthLimiter.RecordRequest(requestBlockHeight, estimatedKB)
-	defer s.bandwidthLimiter.ReleaseRequest(requestBlockHeight, estimatedKB)
+	if !s.bandwidthLimiter.CanAcceptRequesterRequest(request.RequesterAddress, requestBlockHeight, estimatedKB) {
+		logging.Warn("Requester bandwidth limit exceeded", types.Inferences, "address", request.RequesterAddress)
+		return echo.NewHTTPError(http.StatusTooManyRequests, "Requester bandwidth allotment reached for the current block window, see /v1/bandwidth/"+request.RequesterAddress)
+	}
+
+	s.bandwidthLimiter.RecordRequestFor(request.RequesterAddress, requestBlockHeight, estimatedKB)
+	defer s.bandwidthLimiter.ReleaseRequestFor(request.RequesterAddress, requestBlockHeight, estimatedKB)
 
 	executor, err := s.getExecutorForRequest(ctx.Request().Context(), request.OpenAiRequest.Model)
 	if err != nil {
@@ -354,25 +400,30 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		logging.Error("Failed to create inference start request", types.Inferences, "error", err)
 		return err
 	}
+	entry.InferenceId = inferenceUUID
+	entry.ExecutorAddress = executor.Address
+	expiryCtx, stopTracking := s.inflightInferences.Track(ctx.Request().Context(), inferenceUUID)
+	defer stopTracking()
+	ctx.SetRequest(ctx.Request().WithContext(expiryCtx))
+	tracing.Annotate(ctx.Request().Context(),
+		attribute.String(tracing.AttrInferenceId, inferenceUUID),
+		attribute.String(tracing.AttrModel, request.OpenAiRequest.Model))
+	entry.PromptHash = inferenceRequest.PromptHash
+	entry.PromptTokens = uint64(promptTokenCount)
 
-	go func() {
-		logging.Debug("Starting inference", types.Inferences, "id", inferenceRequest.InferenceId)
-		if s.configManager.GetApiConfig().TestMode && request.OpenAiRequest.Seed == 8675309 {
-			time.Sleep(10 * time.Second)
-		}
-		err := s.recorder.StartInference(inferenceRequest)
-		if err != nil {
-			logging.Error("Failed to submit MsgStartInference", types.Inferences, "id", inferenceRequest.InferenceId, "error", err)
-		} else {
-			logging.Debug("Submitted MsgStartInference", types.Inferences, "id", inferenceRequest.InferenceId)
-		}
-	}()
+	isSelf := s.configManager.GetApiConfig().PublicUrl == executor.Url
+	if hedging := s.configManager.GetHedgedRequestsConfig(); hedging.Enabled && !isSelf {
+		primary := &hedgedAttempt{executor: executor, inferenceRequest: inferenceRequest, seed: seed}
+		return s.routeHedgedRequest(ctx, request, entry, primary, promptTokenCount, time.Duration(hedging.ThresholdMs)*time.Millisecond)
+	}
+
+	s.submitStartInference(ctx.Request().Context(), request, inferenceRequest)
 
 	// It's important here to send the ORIGINAL body, not the finalRequest body. The executor will AGAIN go through
 	// the same process to create the same final request body
 	logging.Debug("Sending request to executor", types.Inferences, "url", executor.Url, "seed", seed, "inferenceId", inferenceUUID)
 
-	if s.configManager.GetApiConfig().PublicUrl == executor.Url {
+	if isSelf {
 		// node found itself as executor
 
 		request.InferenceId = inferenceUUID
@@ -385,14 +436,61 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 		return s.handleExecutorRequest(ctx, request, ctx.Response().Writer)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, executor.Url+"/v1/chat/completions", bytes.NewReader(request.Body))
+	// Bound to the client request, so a client going away stops the executor as well
+	sentAt := time.Now()
+	resp, err := s.sendToExecutor(ctx.Request().Context(), request, executor, inferenceRequest, seed, false)
 	if err != nil {
-		logging.Error("handleTransferRequest. Failed to create request to the executor node", types.Inferences, "error", err)
+		logging.Error("Failed to make http request to executor", types.Inferences, "error", err, "url", executor.Url)
+		s.reportNonDelivery(request, inferenceRequest, executor, sentAt, 0, err.Error())
+		if inflight.Expired(ctx.Request().Context()) {
+			return ErrInferenceExpired
+		}
 		return err
 	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusInternalServerError {
+		s.reportNonDelivery(request, inferenceRequest, executor, sentAt, resp.StatusCode, http.StatusText(resp.StatusCode))
+	} else {
+		s.peerHealth.RecordDelivery(executor.Address)
+	}
+
+	logging.Info("Proxying response from executor", types.Inferences,
+		"inferenceId", inferenceUUID,
+		"executor", executor.Address)
+	proxyResponse(resp, ctx.Response().Writer, false, nil, inferenceUUID)
+	return nil
+}
+
+// submitStartInference submits MsgStartInference in the background, the response is not waited for
+func (s *Server) submitStartInference(ctx context.Context, request *ChatRequest, inferenceRequest *inference.MsgStartInference) {
+	_, submitSpan := tracing.Start(ctx, "chain.submit",
+		attribute.String(tracing.AttrMsgType, sdk.MsgTypeURL(inferenceRequest)))
+	go func() {
+		logging.Debug("Starting inference", types.Inferences, "id", inferenceRequest.InferenceId)
+		if s.configManager.GetApiConfig().TestMode && request.OpenAiRequest.Seed == 8675309 {
+			time.Sleep(10 * time.Second)
+		}
+		err := s.recorder.StartInference(inferenceRequest)
+		tracing.End(submitSpan, err)
+		if err != nil {
+			logging.Error("Failed to submit MsgStartInference", types.Inferences, "id", inferenceRequest.InferenceId, "error", err)
+		} else {
+			logging.Debug("Submitted MsgStartInference", types.Inferences, "id", inferenceRequest.InferenceId)
+		}
+	}()
+}
+
+// sendToExecutor sends the ORIGINAL request body to the executor with what it needs to verify the transfer
+func (s *Server) sendToExecutor(ctx context.Context, request *ChatRequest, executor *ExecutorDestination, inferenceRequest *inference.MsgStartInference, seed int32, hedged bool) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, executor.Url+string(request.Endpoint), bytes.NewReader(request.Body))
+	if err != nil {
+		logging.Error("handleTransferRequest. Failed to create request to the executor node", types.Inferences, "error", err)
+		return nil, err
+	}
 
 	// TODO use echo.Redirect?
-	req.Header.Set(utils.XInferenceIdHeader, inferenceUUID)
+	req.Header.Set(utils.XInferenceIdHeader, inferenceRequest.InferenceId)
 	req.Header.Set(utils.XSeedHeader, strconv.Itoa(int(seed)))
 	req.Header.Set(utils.AuthorizationHeader, request.AuthKey)
 	req.Header.Set(utils.XTimestampHeader, strconv.FormatInt(request.Timestamp, 10))
@@ -400,20 +498,33 @@ func (s *Server) handleTransferRequest(ctx echo.Context, request *ChatRequest) e
 	req.Header.Set(utils.XRequesterAddressHeader, request.RequesterAddress)
 	req.Header.Set(utils.XTASignatureHeader, inferenceRequest.TransferSignature)
 	req.Header.Set(utils.XPromptHashHeader, inferenceRequest.PromptHash)
+	if hedged {
+		req.Header.Set(utils.XHedgedRequestHeader, "true")
+	}
 	req.Header.Set("Content-Type", request.Request.Header.Get("Content-Type"))
+	tracing.Inject(req.Context(), req.Header)
 
-	resp, err := s.httpClient.Do(req)
-	if err != nil {
-		logging.Error("Failed to make http request to executor", types.Inferences, "error", err, "url", executor.Url)
-		return err
-	}
-	defer resp.Body.Close()
+	return s.httpClient.Do(req)
+}
 
-	logging.Info("Proxying response from executor", types.Inferences,
-		"inferenceId", inferenceUUID,
-		"executor", executor.Address)
-	proxyResponse(resp, ctx.Response().Writer, false, nil, inferenceUUID)
-	return nil
+// reportNonDelivery records evidence that the executor accepted the routed inference and did not deliver it.
+// The chain penalizes the executor separately once the started inference expires unfinished.
+func (s *Server) reportNonDelivery(request *ChatRequest, inferenceRequest *inference.MsgStartInference, executor *ExecutorDestination, sentAt time.Time, statusCode int, errMsg string) {
+	logging.Warn("Executor did not deliver inference", types.Inferences,
+		"inferenceId", inferenceRequest.InferenceId, "executor", executor.Address, "statusCode", statusCode, "error", errMsg)
+	s.peerHealth.RecordNonDelivery(peerhealth.NonDeliveryReport{
+		InferenceId:       inferenceRequest.InferenceId,
+		Executor:          executor.Address,
+		Url:               executor.Url,
+		Model:             inferenceRequest.Model,
+		RequestTimestamp:  request.Timestamp,
+		TransferSignature: inferenceRequest.TransferSignature,
+		PromptHash:        inferenceRequest.PromptHash,
+		SentAt:            sentAt,
+		FailedAt:          time.Now(),
+		StatusCode:        statusCode,
+		Error:             errMsg,
+	})
 }
 
 func (s *Server) getPromptTokenEstimation(text string, model string) (int, error) {
@@ -511,12 +622,28 @@ func (s *Server) extractPromptTextFromRequest(requestBytes []byte) (string, erro
 
 	promptText := ""
 	for _, message := range openAiRequest.Messages {
-		promptText += message.Content + "\n"
+		promptText += message.Content.Text + "\n"
+	}
+	if len(openAiRequest.Input) > 0 {
+		// Embeddings requests carry their prompt in input
+		input, err := parseEmbeddingInput(openAiRequest.Input)
+		if err != nil {
+			return "", err
+		}
+		promptText += strings.Join(input, "\n")
 	}
 	return promptText, nil
 }
 
 func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w http.ResponseWriter) error {
+	entry := s.newAuditEntry(audit.RoleExecutor, request)
+	entry.ExecutorAddress = s.recorder.GetAccountAddress()
+	err := s.executeRequest(ctx, request, w, entry)
+	s.recordAudit(ctx, entry, err)
+	return err
+}
+
+func (s *Server) executeRequest(ctx echo.Context, request *ChatRequest, w http.ResponseWriter, entry *audit.Entry) error {
 	inferenceId := request.InferenceId
 	err := s.validateFullRequest(ctx, request)
 	if err != nil {
@@ -529,13 +656,13 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 		return echo.ErrBadRequest
 	}
 
-	modifiedRequestBody, err := completionapi.ModifyRequestBody(request.Body, int32(seed))
+	modifiedRequestBody, err := request.modifiedBody(int32(seed))
 	if err != nil {
 		logging.Warn("Unable to modify request body", types.Inferences, "error", err)
 		return err
 	}
 
-	computedPromptHash, promptPayload, err := getModifiedPromptHash(modifiedRequestBody.NewBody)
+	computedPromptHash, promptPayload, err := getModifiedPromptHash(modifiedRequestBody)
 	if err != nil {
 		logging.Error("Failed to compute prompt hash", types.Inferences, "error", err)
 		return echo.NewHTTPError(http.StatusBadRequest, "Failed to compute prompt hash")
@@ -545,30 +672,81 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 			"expected", request.PromptHash, "computed", computedPromptHash)
 		return echo.NewHTTPError(http.StatusBadRequest, "Prompt hash mismatch")
 	}
+	entry.PromptHash = computedPromptHash
 
+	var skipNodeIDs []string
+	if request.Endpoint == EmbeddingsEndpoint {
+		var found bool
+		skipNodeIDs, found, err = broker.EmbeddingSkipList(s.nodeBroker, request.OpenAiRequest.Model)
+		if err != nil {
+			return err
+		}
+		if !found {
+			logging.Warn("No node serves embeddings for model", types.Inferences, "inferenceId", inferenceId, "model", request.OpenAiRequest.Model)
+			return echo.NewHTTPError(http.StatusBadRequest, "model is not served for embeddings: "+request.OpenAiRequest.Model)
+		}
+	} else if len(request.OpenAiRequest.images()) > 0 {
+		var found bool
+		skipNodeIDs, found, err = broker.MultimodalSkipList(s.nodeBroker, request.OpenAiRequest.Model)
+		if err != nil {
+			return err
+		}
+		if !found {
+			logging.Warn("No node serves model with image inputs", types.Inferences, "inferenceId", inferenceId, "model", request.OpenAiRequest.Model)
+			return echo.NewHTTPError(http.StatusBadRequest, "model is not served with image inputs: "+request.OpenAiRequest.Model)
+		}
+	}
+
+	// Once the chain expires the inference, finishing it is rejected, so the node is released right away
+	expiryCtx, stopTracking := s.inflightInferences.Track(ctx.Request().Context(), inferenceId)
+	defer stopTracking()
+	ctx.SetRequest(ctx.Request().WithContext(expiryCtx))
+
+	tracing.Annotate(ctx.Request().Context(),
+		attribute.String(tracing.AttrInferenceId, inferenceId),
+		attribute.String(tracing.AttrModel, request.OpenAiRequest.Model))
+	priorityClass := request.OpenAiRequest.priorityClass()
 	logging.Info("Attempting to lock node for inference", types.Inferences,
-		"inferenceId", inferenceId, "nodeVersion", s.configManager.GetCurrentNodeVersion())
-	resp, err := broker.DoWithLockedNodeHTTPRetry(s.nodeBroker, request.OpenAiRequest.Model, nil, 3, func(node *broker.Node) (*http.Response, *broker.ActionError) {
+		"inferenceId", inferenceId, "nodeVersion", s.configManager.GetCurrentNodeVersion(), "priorityClass", priorityClass)
+	selectCtx, selectSpan := tracing.Start(ctx.Request().Context(), "broker.select_node")
+	resp, err := broker.DoWithPriorityLockedNodeHTTPRetry(ctx.Request().Context(), s.nodeBroker, request.OpenAiRequest.Model, priorityClass, skipNodeIDs, 3, func(attemptCtx context.Context, node *broker.Node) (*http.Response, *broker.ActionError) {
 		logging.Info("Successfully acquired node lock for inference", types.Inferences,
 			"inferenceId", inferenceId, "node", node.Id, "url", node.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()))
+		entry.ExecutorNode = node.Id
+		nodeCtx, nodeSpan := tracing.Start(selectCtx, "mlnode.inference", attribute.String(tracing.AttrNodeId, node.Id))
 
-		completionsUrl, err := url.JoinPath(node.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "/v1/chat/completions")
+		completionsUrl, err := url.JoinPath(node.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), string(request.Endpoint))
 		if err != nil {
+			tracing.End(nodeSpan, err)
 			return nil, broker.NewApplicationActionError(err)
 		}
-		resp, postErr := s.httpClient.Post(
-			completionsUrl,
-			request.Request.Header.Get("Content-Type"),
-			bytes.NewReader(modifiedRequestBody.NewBody),
-		)
+		// Bound to the incoming request, so a cancelled (or preempted) completion stops the inference node too
+		nodeReq, err := http.NewRequestWithContext(attemptCtx, http.MethodPost, completionsUrl, bytes.NewReader(modifiedRequestBody))
+		if err != nil {
+			tracing.End(nodeSpan, err)
+			return nil, broker.NewApplicationActionError(err)
+		}
+		nodeReq.Header.Set("Content-Type", request.Request.Header.Get("Content-Type"))
+		tracing.Inject(nodeCtx, nodeReq.Header)
+		proxyStart := time.Now()
+		resp, postErr := s.httpClient.Do(nodeReq)
+		metrics.InferenceProxyDuration.WithLabelValues(node.Id).Observe(time.Since(proxyStart).Seconds())
+		tracing.End(nodeSpan, postErr)
 		if postErr != nil {
+			if ctx.Request().Context().Err() != nil {
+				return nil, broker.NewCancelledActionError(postErr)
+			}
 			return nil, broker.NewTransportActionError(postErr)
 		}
 		return resp, nil
 	})
+	tracing.End(selectSpan, err)
 	if err != nil {
 		logging.Error("Failed to get response from inference node", types.Inferences,
 			"inferenceId", inferenceId, "error", err)
+		if inflight.Expired(ctx.Request().Context()) {
+			return ErrInferenceExpired
+		}
 		return err
 	}
 	defer resp.Body.Close()
@@ -585,7 +763,12 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 				"inferenceId", inferenceId, "code", resp.StatusCode)
 			// Provide a parseable synthetic response payload so older validators can still unmarshal it.
 			promptTokens := uint64(1)
-			synthetic := emptyButParseableResponsePayload(inferenceId, request.OpenAiRequest.Model, promptTokens)
+			var synthetic completionapi.CompletionResponse
+			if request.Endpoint == EmbeddingsEndpoint {
+				synthetic = emptyEmbeddingResponsePayload(inferenceId, request.OpenAiRequest.Model, promptTokens)
+			} else if chatSynthetic := emptyButParseableResponsePayload(inferenceId, request.OpenAiRequest.Model, promptTokens); chatSynthetic != nil {
+				synthetic = chatSynthetic
+			}
 			if synthetic == nil {
 				logging.Error("Failed to create synthetic response payload", types.Inferences, "inferenceId", inferenceId)
 				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create synthetic response payload")
@@ -594,22 +777,56 @@ func (s *Server) handleExecutorRequest(ctx echo.Context, request *ChatRequest, w
 				logging.Error("Failed to record FinishInference after inference node payload error", types.Inferences,
 					"inferenceId", inferenceId, "error", txErr)
 			}
-			return echo.NewHTTPError(resp.StatusCode, msg)
 		}
-		return echo.NewHTTPError(http.StatusInternalServerError, msg)
+		return apierrors.Upstream(resp.StatusCode, msg)
 	}
 
 	responseProcessor := completionapi.NewExecutorResponseProcessor(request.InferenceId)
+	if maxTokens, err := request.completionTokenLimit(); err == nil {
+		responseProcessor.SetMaxTokens(maxTokens)
+	}
 	logging.Debug("Proxying response from inference node", types.Inferences, "inferenceId", request.InferenceId)
 	proxyResponse(resp, w, true, responseProcessor, inferenceId)
 
+	if inflight.Expired(ctx.Request().Context()) {
+		logging.Warn("Inference expired on chain while streaming, not recording it", types.Inferences, "inferenceId", inferenceId)
+		entry.Error = inflight.ErrInferenceExpired.Error()
+		return nil
+	}
+	// Closing the stream on cancellation makes the inference node abort the generation.
+	// What was generated so far is still recorded, so the chain settles the tokens actually produced.
+	cancelled := ctx.Request().Context().Err() != nil
+	if cancelled && request.Hedged && !s.hedgedInferenceAssigned(inferenceId) {
+		logging.Info("Hedged inference cancelled before it was assigned here, not recording it", types.Inferences, "inferenceId", inferenceId)
+		entry.Error = "hedged request not served"
+		return nil
+	}
+	if cancelled {
+		logging.Info("Inference cancelled by client, recording partial completion", types.Inferences, "inferenceId", inferenceId)
+		entry.Error = "cancelled by client"
+	}
+
 	logging.Debug("Processing response from inference node", types.Inferences, "inferenceId", request.InferenceId)
-	completionResponse, err := responseProcessor.GetResponse()
+	var completionResponse completionapi.CompletionResponse
+	if request.Endpoint == EmbeddingsEndpoint {
+		completionResponse, err = embeddingResponse(responseProcessor)
+	} else {
+		completionResponse, err = responseProcessor.GetResponse()
+	}
 
 	if err != nil || completionResponse == nil {
 		logging.Error("Failed to parse response data into CompletionResponse", types.Inferences, "error", err)
 		return err
 	}
+	entry.ResponseHash, _ = completionResponse.GetHash()
+	if usage, err := completionResponse.GetUsage(); err == nil {
+		entry.PromptTokens = usage.PromptTokens
+		entry.CompletionTokens = usage.CompletionTokens
+		if cancelled {
+			logging.Info("Partial completion of cancelled inference", types.Inferences,
+				"inferenceId", inferenceId, "completionTokens", usage.CompletionTokens)
+		}
+	}
 
 	err = s.sendInferenceTransaction(request.InferenceId, completionResponse, request.Body, s.recorder.GetAccountAddress(), request, promptPayload)
 	if err != nil {
@@ -707,15 +924,26 @@ func (s *Server) validateTimestampNonce(request *ChatRequest) error {
 	return nil
 }
 
+const maxUnhealthyExecutorRedraws = 3
+
 func (s *Server) getExecutorForRequest(ctx context.Context, model string) (*ExecutorDestination, error) {
 	queryClient := s.recorder.NewInferenceQueryClient()
-	response, err := queryClient.GetRandomExecutor(ctx, &types.QueryGetRandomExecutorRequest{
-		Model: model,
-	})
-	if err != nil {
-		return nil, err
+	var executor types.Participant
+	// Redraw when the executor failed recent health probes; the last draw is used
+	// regardless so that probing can never block routing entirely.
+	for attempt := 0; attempt < maxUnhealthyExecutorRedraws; attempt++ {
+		response, err := queryClient.GetRandomExecutor(ctx, &types.QueryGetRandomExecutorRequest{
+			Model: model,
+		})
+		if err != nil {
+			return nil, err
+		}
+		executor = response.Executor
+		if !s.peerHealth.IsUnhealthy(executor.Address) {
+			break
+		}
+		logging.Info("Skipping unhealthy executor", types.Inferences, "address", executor.Address, "attempt", attempt)
 	}
-	executor := response.Executor
 	logging.Info("Executor selected", types.Inferences, "address", executor.Address, "url", executor.InferenceUrl)
 	return &ExecutorDestination{
 		Url:     executor.InferenceUrl,
@@ -786,6 +1014,12 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 			if err != nil {
 				logging.Warn("Failed to get actual prompt token count", types.Inferences, "error", err)
 			} else {
+				// Tokenization only sees the text, images are charged at the governance-set rates
+				imageTokens, err := s.getImageTokenEstimation(request)
+				if err != nil {
+					logging.Warn("Failed to get image token estimation", types.Inferences, "error", err)
+				}
+				actualPromptTokens += imageTokens
 				logging.Info("Updated prompt tokens via tokenization", types.Inferences, "inferenceId", inferenceId, "tokens", actualPromptTokens)
 				usage.PromptTokens = uint64(actualPromptTokens)
 			}
@@ -827,10 +1061,14 @@ func (s *Server) sendInferenceTransaction(inferenceId string, response completio
 
 		// Store payloads before broadcasting transaction
 		// If storage fails, we still proceed with broadcast (but log error)
-		s.storePayloadsToStorage(request.Request.Context(), inferenceId, promptPayload, bodyBytes)
+		// Not bound to the client request: a cancelled inference still has its payloads stored
+		s.storePayloadsToStorage(context.WithoutCancel(request.Request.Context()), inferenceId, promptPayload, bodyBytes)
 
 		logging.Info("Submitting MsgFinishInference", types.Inferences, "inferenceId", inferenceId)
+		_, submitSpan := tracing.Start(request.Request.Context(), "chain.submit",
+			attribute.String(tracing.AttrMsgType, sdk.MsgTypeURL(message)))
 		err = s.recorder.FinishInference(message)
+		tracing.End(submitSpan, err)
 		if err != nil {
 			logging.Error("Failed to submit MsgFinishInference", types.Inferences, "inferenceId", inferenceId, "error", err)
 		} else {
@@ -877,19 +1115,17 @@ func getModifiedPromptHash(requestBytes []byte) (string, []byte, error) {
 }
 
 func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, inferenceId string, executor *ExecutorDestination, nodeVersion string, promptTokenCount int) (*inference.MsgStartInference, error) {
-	modifiedRequest, err := completionapi.ModifyRequestBody(request.Body, seed)
+	modifiedRequest, err := request.modifiedBody(seed)
 	if err != nil {
 		return nil, err
 	}
-	modifiedPromptHash, _, err := getModifiedPromptHash(modifiedRequest.NewBody)
+	modifiedPromptHash, _, err := getModifiedPromptHash(modifiedRequest)
 	if err != nil {
 		return nil, err
 	}
-	maxTokens := 0
-	if request.OpenAiRequest.MaxCompletionTokens > 0 {
-		maxTokens = int(request.OpenAiRequest.MaxCompletionTokens)
-	} else if request.OpenAiRequest.MaxTokens > 0 {
-		maxTokens = int(request.OpenAiRequest.MaxTokens)
+	maxTokens, err := request.completionTokenLimit()
+	if err != nil {
+		return nil, err
 	}
 
 	originalPromptHash := utils.GenerateSHA256HashBytes(request.Body)
@@ -898,7 +1134,7 @@ func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, in
 		InferenceId:        inferenceId,
 		PromptHash:         modifiedPromptHash,
 		RequestedBy:        request.RequesterAddress,
-		Model:              request.OpenAiRequest.Model,
+		Model:              types.PriorityClassModel(request.OpenAiRequest.Model, request.OpenAiRequest.priorityClass()),
 		AssignedTo:         executor.Address,
 		NodeVersion:        nodeVersion,
 		MaxTokens:          uint64(maxTokens),
@@ -917,6 +1153,40 @@ func createInferenceStartRequest(s *Server, request *ChatRequest, seed int32, in
 	return transaction, nil
 }
 
+// promptText is the text the prompt tokens are estimated from
+func (r *ChatRequest) promptText() string {
+	if r.Endpoint == EmbeddingsEndpoint {
+		return strings.Join(r.EmbeddingInput, "\n")
+	}
+	promptText := ""
+	for _, message := range r.OpenAiRequest.Messages {
+		promptText += message.Content.Text + "\n"
+	}
+	return promptText
+}
+
+// completionTokenLimit is the max tokens of the inference: the limit modifiedBody gives the ML node,
+// and the one the chain holds the finished completion to
+func (r *ChatRequest) completionTokenLimit() (int, error) {
+	if r.Endpoint == EmbeddingsEndpoint {
+		return int(r.OpenAiRequest.MaxTokens), nil
+	}
+	return completionapi.MaxTokens(r.Body)
+}
+
+// modifiedBody returns the body sent to the ML node. Chat requests get the seed and logprobs needed for
+// validation; embeddings are deterministic and sent as is.
+func (r *ChatRequest) modifiedBody(seed int32) ([]byte, error) {
+	if r.Endpoint == EmbeddingsEndpoint {
+		return r.Body, nil
+	}
+	modifiedRequest, err := completionapi.ModifyRequestBody(r.Body, seed)
+	if err != nil {
+		return nil, err
+	}
+	return modifiedRequest.NewBody, nil
+}
+
 func getInferenceErrorMessage(resp *http.Response) string {
 	msg := fmt.Sprintf("Inference node response with an error. code = %d.", resp.StatusCode)
 	bodyBytes, err := io.ReadAll(resp.Body)
@@ -927,7 +1197,7 @@ func getInferenceErrorMessage(resp *http.Response) string {
 	}
 }
 
-func readRequest(request *http.Request, writer http.ResponseWriter, transferAddress string) (*ChatRequest, error) {
+func readRequest(request *http.Request, writer http.ResponseWriter, transferAddress string, endpoint InferenceEndpoint) (*ChatRequest, error) {
 	body, err := readRequestBody(request, writer)
 	if err != nil {
 		logging.Error("Unable to read request body", types.Server, "error", err)
@@ -940,6 +1210,22 @@ func readRequest(request *http.Request, writer http.ResponseWriter, transferAddr
 		return nil, err
 	}
 
+	var embeddingInput []string
+	if endpoint == EmbeddingsEndpoint {
+		embeddingInput, err = parseEmbeddingInput(openAiRequest.Input)
+		if err != nil {
+			return nil, echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		// Embeddings generate no tokens: escrow and settlement are priced by input tokens only
+		openAiRequest.MaxTokens = 1
+		openAiRequest.MaxCompletionTokens = 0
+	}
+
+	if !types.IsPriorityClass(openAiRequest.priorityClass()) {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("unknown priority_class %q, expected one of %s",
+			openAiRequest.PriorityClass, strings.Join(types.PriorityClasses, ", ")))
+	}
+
 	timestamp, err := strconv.ParseInt(request.Header.Get(utils.XTimestampHeader), 10, 64)
 	if err != nil {
 		timestamp = 0
@@ -951,7 +1237,9 @@ func readRequest(request *http.Request, writer http.ResponseWriter, transferAddr
 	return &ChatRequest{
 		Body:              body,
 		Request:           request,
+		Endpoint:          endpoint,
 		OpenAiRequest:     openAiRequest,
+		EmbeddingInput:    embeddingInput,
 		AuthKey:           request.Header.Get(utils.AuthorizationHeader),
 		Seed:              request.Header.Get(utils.XSeedHeader),
 		InferenceId:       request.Header.Get(utils.XInferenceIdHeader),
@@ -960,6 +1248,7 @@ func readRequest(request *http.Request, writer http.ResponseWriter, transferAddr
 		TransferAddress:   transferAddress,
 		TransferSignature: request.Header.Get(utils.XTASignatureHeader),
 		PromptHash:        request.Header.Get(utils.XPromptHashHeader),
+		Hedged:            request.Header.Get(utils.XHedgedRequestHeader) == "true",
 	}, nil
 }
 
@@ -1017,6 +1306,13 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 			"perTokenPrice", perTokenPrice)
 	}
 
+	// The chain scales the model price by the multiplier of the priority class when the inference starts
+	if pricing, err := s.getPriorityClassPricing(); err == nil {
+		perTokenPrice = pricing.PerTokenPrice(perTokenPrice, request.OpenAiRequest.priorityClass())
+	} else {
+		logging.Warn("Failed to get priority class pricing, estimating escrow at the model price", types.Inferences, "error", err)
+	}
+
 	// Calculate escrow using consistent formula: (PromptTokens + MaxTokens) × PerTokenPrice
 	totalTokens := uint64(promptTokenCount) + uint64(request.OpenAiRequest.MaxTokens)
 	escrowNeeded = totalTokens * perTokenPrice
@@ -1028,9 +1324,36 @@ func (s *Server) validateRequester(ctx context.Context, request *ChatRequest, re
 		"maxTokens", request.OpenAiRequest.MaxTokens,
 		"totalTokens", totalTokens)
 
+	if request.OpenAiRequest.MaxCost > 0 {
+		if err := checkMaxCost(request, promptTokenCount, perTokenPrice); err != nil {
+			return err
+		}
+	}
+
 	logging.Debug("Client balance", types.Inferences, "balance", requester.Balance)
 	if requester.Balance < int64(escrowNeeded) {
 		return ErrInsufficientBalance
 	}
 	return nil
 }
+
+// checkMaxCost rejects requests whose escrow, the most the inference can be billed, exceeds the requester's max_cost
+func checkMaxCost(request *ChatRequest, promptTokenCount int, perTokenPrice uint64) error {
+	maxTokens, err := request.completionTokenLimit()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	maxCost := request.OpenAiRequest.MaxCost
+	escrow := (uint64(promptTokenCount) + uint64(maxTokens)) * perTokenPrice
+	if escrow <= maxCost {
+		return nil
+	}
+	affordableTokens := int64(maxCost/perTokenPrice) - int64(promptTokenCount)
+	logging.Info("Request exceeds max_cost", types.Inferences,
+		"maxCost", maxCost, "escrow", escrow, "maxTokens", maxTokens, "affordableTokens", affordableTokens)
+	if affordableTokens <= 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("max_cost %d does not cover the prompt of %d tokens at %d per token", maxCost, promptTokenCount, perTokenPrice))
+	}
+	return echo.NewHTTPError(http.StatusBadRequest,
+		fmt.Sprintf("max_cost %d is below the cost of %d tokens at %d per token; set max_tokens to at most %d", maxCost, uint64(promptTokenCount)+uint64(maxTokens), perTokenPrice, affordableTokens))
+}
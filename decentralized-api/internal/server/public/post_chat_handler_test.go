@@ -227,3 +227,22 @@ func TestMaxRequestBodySizeConstant(t *testing.T) {
 	expectedSize := 10 * 1024 * 1024
 	require.Equal(t, expectedSize, MaxRequestBodySize, "MaxRequestBodySize should be 10 MB")
 }
+
+func TestCheckMaxCost(t *testing.T) {
+	request := &ChatRequest{
+		Endpoint: ChatCompletionsEndpoint,
+		Body:     []byte(`{"model":"m","max_tokens":100,"max_cost":5000}`),
+	}
+	require.NoError(t, json.Unmarshal(request.Body, &request.OpenAiRequest))
+	require.Equal(t, uint64(5000), request.OpenAiRequest.MaxCost)
+
+	// 20 prompt + 100 max tokens at 40 per token = 4800
+	require.NoError(t, checkMaxCost(request, 20, 40))
+
+	// 6000 > 5000: at most 5000/50 - 20 = 80 completion tokens fit
+	err := checkMaxCost(request, 20, 50)
+	require.ErrorContains(t, err, "set max_tokens to at most 80")
+
+	err = checkMaxCost(request, 200, 50)
+	require.ErrorContains(t, err, "does not cover the prompt")
+}
@@ -0,0 +1,70 @@
+package public
+
+import (
+	"decentralized-api/completionapi"
+	"decentralized-api/logging"
+	"encoding/json"
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// postEmbeddings proxies OpenAI embeddings requests. They follow the chat completions flow (transfer agent,
+// executor, MsgStartInference/MsgFinishInference) but are only routed to ML nodes that declare the model
+// with embedding support.
+func (s *Server) postEmbeddings(ctx echo.Context) error {
+	return s.postInference(ctx, EmbeddingsEndpoint)
+}
+
+// parseEmbeddingInput accepts the string and list of strings forms of the OpenAI input field.
+// Token array inputs are not supported: the prompt would not be tokenizer independent.
+func parseEmbeddingInput(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 {
+		return nil, errors.New("input is required")
+	}
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		if single == "" {
+			return nil, errors.New("input must not be empty")
+		}
+		return []string{single}, nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, errors.New("input must be a string or an array of strings")
+	}
+	if len(list) == 0 {
+		return nil, errors.New("input must not be empty")
+	}
+	return list, nil
+}
+
+// emptyEmbeddingResponsePayload is the embeddings counterpart of emptyButParseableResponsePayload,
+// recorded when the ML node rejects the payload
+func emptyEmbeddingResponsePayload(inferenceId, model string, promptTokens uint64) completionapi.CompletionResponse {
+	resp := completionapi.EmbeddingsResponse{
+		ID:     inferenceId,
+		Object: "list",
+		Model:  model,
+		Data:   []completionapi.EmbeddingData{},
+		Usage:  completionapi.Usage{PromptTokens: promptTokens},
+	}
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return nil
+	}
+	return &completionapi.EmbeddingResponse{Bytes: b, Resp: resp}
+}
+
+func embeddingResponse(processor *completionapi.ExecutorResponseProcessor) (completionapi.CompletionResponse, error) {
+	bytes, err := processor.GetResponseBytes()
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes) == 0 {
+		logging.Error("Empty embeddings response from inference node", types.Inferences)
+		return nil, errors.New("empty embeddings response")
+	}
+	return completionapi.NewEmbeddingResponseFromBytes(bytes)
+}
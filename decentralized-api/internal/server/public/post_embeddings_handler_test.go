@@ -0,0 +1,46 @@
+package public
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRequest_Embeddings(t *testing.T) {
+	req := createTestRequest([]byte(`{"model": "test", "input": ["first", "second"], "max_tokens": 100}`))
+	request, err := readRequest(req, nil, "transfer", EmbeddingsEndpoint)
+	require.NoError(t, err)
+	require.Equal(t, EmbeddingsEndpoint, request.Endpoint)
+	require.Equal(t, []string{"first", "second"}, request.EmbeddingInput)
+	require.Equal(t, "first\nsecond", request.promptText())
+	// only one token is escrowed on top of the input
+	require.Equal(t, int32(1), request.OpenAiRequest.MaxTokens)
+
+	body, err := request.modifiedBody(42)
+	require.NoError(t, err)
+	require.Equal(t, request.Body, body)
+
+	req = createTestRequest([]byte(`{"model": "test", "input": "single"}`))
+	request, err = readRequest(req, nil, "transfer", EmbeddingsEndpoint)
+	require.NoError(t, err)
+	require.Equal(t, []string{"single"}, request.EmbeddingInput)
+
+	for _, body := range []string{`{"model": "test"}`, `{"model": "test", "input": []}`, `{"model": "test", "input": [1, 2]}`} {
+		_, err = readRequest(createTestRequest([]byte(body)), nil, "transfer", EmbeddingsEndpoint)
+		require.Error(t, err, body)
+	}
+}
+
+func TestEmptyEmbeddingResponsePayload(t *testing.T) {
+	resp := emptyEmbeddingResponsePayload("inf-empty", "test-model", 1)
+	require.NotNil(t, resp)
+
+	usage, err := resp.GetUsage()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), usage.PromptTokens)
+	require.Equal(t, uint64(0), usage.CompletionTokens)
+
+	hash, err := resp.GetHash()
+	require.NoError(t, err)
+	require.NotEmpty(t, hash)
+}
@@ -0,0 +1,67 @@
+package public
+
+import (
+	cosmos_client "decentralized-api/cosmosclient"
+	"decentralized-api/logging"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const priorityClassPricingCacheTTL = time.Minute
+
+// priorityClassPricingCache keeps the governance-set priority class multipliers, which rarely change
+type priorityClassPricingCache struct {
+	mu        sync.RWMutex
+	pricing   *types.PriorityClassPricing
+	expiresAt time.Time
+}
+
+func newPriorityClassPricingCache() *priorityClassPricingCache {
+	return &priorityClassPricingCache{}
+}
+
+func (c *priorityClassPricingCache) get() (types.PriorityClassPricing, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.pricing == nil || time.Now().After(c.expiresAt) {
+		return types.PriorityClassPricing{}, false
+	}
+	return *c.pricing, true
+}
+
+func (c *priorityClassPricingCache) set(pricing types.PriorityClassPricing) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pricing = &pricing
+	c.expiresAt = time.Now().Add(priorityClassPricingCacheTTL)
+}
+
+func (s *Server) getPriorityClassPricing() (types.PriorityClassPricing, error) {
+	if pricing, ok := s.priorityClassPricing.get(); ok {
+		return pricing, nil
+	}
+	rpcClient, err := cosmos_client.NewRpcClient(s.configManager.GetChainEndpoints().Current())
+	if err != nil {
+		logging.Error("Failed to create rpc client", types.Inferences, "error", err)
+		return types.PriorityClassPricing{}, err
+	}
+	result, err := cosmos_client.QueryByKey(rpcClient, "inference", types.PriorityClassPricingFullKey())
+	if err != nil {
+		logging.Error("Failed to query priority class pricing", types.Inferences, "error", err)
+		return types.PriorityClassPricing{}, err
+	}
+	var pricing types.PriorityClassPricing
+	if len(result.Response.Value) > 0 {
+		if err := json.Unmarshal(result.Response.Value, &pricing); err != nil {
+			logging.Error("Failed to decode priority class pricing", types.Inferences, "error", err)
+			return types.PriorityClassPricing{}, err
+		}
+	}
+	s.priorityClassPricing.set(pricing)
+	return pricing, nil
+}
@@ -2,8 +2,10 @@ package public
 
 import (
 	"bufio"
+	"context"
 	"decentralized-api/completionapi"
 	"decentralized-api/logging"
+	"errors"
 	"fmt"
 	"github.com/productscience/inference/x/inference/types"
 	"io"
@@ -56,6 +58,13 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 		if responseProcessor != nil {
 			var err error
 			lineToProxy, err = responseProcessor.ProcessStreamedResponse(line)
+			if errors.Is(err, completionapi.ErrMaxTokensExceeded) {
+				// Stopping here aborts the generation; what was streamed so far is the whole completion
+				logging.Warn("Streamed completion exceeded max tokens, cutting it off", types.Inferences, "inferenceId", inferenceId)
+				resp.Body.Close()
+				fmt.Fprint(w, completionapi.DataPrefix+"[DONE]\n\n")
+				return
+			}
 			if err != nil {
 				logging.Error("Failed to process streamed response line", types.Inferences,
 					"inferenceId", inferenceId, "error", err, "line", line,
@@ -83,6 +92,10 @@ func proxyTextStreamResponse(resp *http.Response, w http.ResponseWriter, respons
 	}
 
 	if err := scanner.Err(); err != nil {
+		if errors.Is(err, context.Canceled) {
+			logging.Warn("Stream cancelled by client", types.Inferences, "inferenceId", inferenceId)
+			return
+		}
 		logging.Error("Error after streaming response", types.Inferences, "inferenceId", inferenceId, "error", err)
 	}
 }
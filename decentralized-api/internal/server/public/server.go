@@ -1,13 +1,24 @@
 package public
 
 import (
+	"database/sql"
 	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
 	"decentralized-api/internal"
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/internal/audit"
 	"decentralized-api/internal/authzcache"
+	"decentralized-api/internal/batches"
+	"decentralized-api/internal/contentfilter"
+	"decentralized-api/internal/eventstream"
+	"decentralized-api/internal/inflight"
+	"decentralized-api/internal/onboarding"
+	"decentralized-api/internal/peerhealth"
+	"decentralized-api/internal/server/apierrors"
 	"decentralized-api/internal/server/middleware"
+	"decentralized-api/internal/tracing"
 	"decentralized-api/payloadstorage"
 	"decentralized-api/poc/artifacts"
 	"decentralized-api/training"
@@ -21,20 +32,36 @@ import (
 const httpClientTimeout = 20 * time.Minute
 
 type Server struct {
-	e                   *echo.Echo
-	nodeBroker          *broker.Broker
-	configManager       *apiconfig.ConfigManager
-	recorder            cosmosclient.CosmosMessageClient
-	trainingExecutor    *training.Executor
-	blockQueue          *BridgeQueue
-	bandwidthLimiter    *internal.BandwidthLimiter
-	identityCache       *identityCache
-	payloadStorage      payloadstorage.PayloadStorage
-	phaseTracker        *chainphase.ChainPhaseTracker
-	epochGroupDataCache *internal.EpochGroupDataCache
-	artifactStore       *artifacts.ManagedArtifactStore
-	authzCache          *authzcache.AuthzCache
-	httpClient          *http.Client
+	e                    *echo.Echo
+	nodeBroker           *broker.Broker
+	configManager        *apiconfig.ConfigManager
+	recorder             cosmosclient.CosmosMessageClient
+	trainingExecutor     *training.Executor
+	blockQueue           *BridgeQueue
+	bandwidthLimiter     *internal.BandwidthLimiter
+	identityCache        *identityCache
+	payloadStorage       payloadstorage.PayloadStorage
+	phaseTracker         *chainphase.ChainPhaseTracker
+	epochGroupDataCache  *internal.EpochGroupDataCache
+	artifactStore        *artifacts.ManagedArtifactStore
+	authzCache           *authzcache.AuthzCache
+	httpClient           *http.Client
+	peerHealth           *peerhealth.Prober
+	attestations         HardwareAttestationSource
+	activeModels         *activeModelsCache
+	apiKeys              *apikeys.Manager
+	auditLog             *audit.Log
+	db                   *sql.DB
+	wsLiveness           WebsocketLiveness
+	blockCache           *cosmosclient.BlockCache
+	idempotency          *idempotencyCache
+	imageInputPolicy     *imageInputPolicyCache
+	priorityClassPricing *priorityClassPricingCache
+	contentFilter        *contentfilter.Pipeline
+	onboarding           *onboarding.Manager
+	inflightInferences   *inflight.Tracker
+	batches              *batches.Manager
+	eventStream          *eventstream.Stream
 }
 
 // ServerOption configures optional Server dependencies.
@@ -47,6 +74,84 @@ func WithArtifactStore(store *artifacts.ManagedArtifactStore) ServerOption {
 	}
 }
 
+// WithPeerHealth skips executors that failed recent health probes when routing requests.
+func WithPeerHealth(prober *peerhealth.Prober) ServerOption {
+	return func(s *Server) {
+		s.peerHealth = prober
+	}
+}
+
+// WithHardwareAttestations publishes the signed capability reports of this participant's ML nodes.
+func WithHardwareAttestations(source HardwareAttestationSource) ServerOption {
+	return func(s *Server) {
+		s.attestations = source
+	}
+}
+
+// WithApiKeys authenticates inference requests by API key and enforces the per-key limits.
+func WithApiKeys(manager *apikeys.Manager) ServerOption {
+	return func(s *Server) {
+		s.apiKeys = manager
+	}
+}
+
+// WithAuditLog records the metadata of every inference request handled by this API.
+func WithAuditLog(log *audit.Log) ServerOption {
+	return func(s *Server) {
+		s.auditLog = log
+	}
+}
+
+// WithHealthChecks adds the SQLite database and the chain websocket subscription to the health and readiness probes.
+func WithHealthChecks(db *sql.DB, wsLiveness WebsocketLiveness) ServerOption {
+	return func(s *Server) {
+		s.db = db
+		s.wsLiveness = wsLiveness
+	}
+}
+
+// WithBlockCache serves the historical blocks fetched to verify participant lists from the local block cache.
+func WithBlockCache(cache *cosmosclient.BlockCache) ServerOption {
+	return func(s *Server) {
+		s.blockCache = cache
+	}
+}
+
+// WithContentFilter passes inference requests through the configured content filters before they are routed.
+func WithContentFilter(pipeline *contentfilter.Pipeline) ServerOption {
+	return func(s *Server) {
+		s.contentFilter = pipeline
+	}
+}
+
+// WithOnboarding enables the registration of grantee accounts through authz grants signed by their granter.
+func WithOnboarding(manager *onboarding.Manager) ServerOption {
+	return func(s *Server) {
+		s.onboarding = manager
+	}
+}
+
+// WithBatches serves the OpenAI-compatible files and batches endpoints, the manager runs the batches through RunBatchItem.
+func WithBatches(manager *batches.Manager) ServerOption {
+	return func(s *Server) {
+		s.batches = manager
+	}
+}
+
+// WithEventStream serves the chain events processed by the event listener at /ws/events.
+func WithEventStream(stream *eventstream.Stream) ServerOption {
+	return func(s *Server) {
+		s.eventStream = stream
+	}
+}
+
+// WithInflightInferences answers requests with a timeout once the chain expires their inference unfinished.
+func WithInflightInferences(tracker *inflight.Tracker) ServerOption {
+	return func(s *Server) {
+		s.inflightInferences = tracker
+	}
+}
+
 func NewServer(
 	nodeBroker *broker.Broker,
 	configManager *apiconfig.ConfigManager,
@@ -57,24 +162,28 @@ func NewServer(
 	payloadStorage payloadstorage.PayloadStorage,
 	opts ...ServerOption) *Server {
 	e := echo.New()
-	e.HTTPErrorHandler = middleware.TransparentErrorHandler
+	e.HTTPErrorHandler = apierrors.Handler(isOpenAiCompatible)
 
 	// Set the package-level configManagerRef
 	configManagerRef = configManager
 
 	s := &Server{
-		e:                   e,
-		nodeBroker:          nodeBroker,
-		configManager:       configManager,
-		recorder:            recorder,
-		trainingExecutor:    trainingExecutor,
-		blockQueue:          blockQueue,
-		identityCache:       newIdentityCache(),
-		payloadStorage:      payloadStorage,
-		phaseTracker:        phaseTracker,
-		epochGroupDataCache: internal.NewEpochGroupDataCache(recorder),
-		authzCache:          authzcache.NewAuthzCache(recorder),
-		httpClient:          NewNoRedirectClient(httpClientTimeout),
+		e:                    e,
+		nodeBroker:           nodeBroker,
+		configManager:        configManager,
+		recorder:             recorder,
+		trainingExecutor:     trainingExecutor,
+		blockQueue:           blockQueue,
+		identityCache:        newIdentityCache(),
+		activeModels:         newActiveModelsCache(),
+		idempotency:          newIdempotencyCache(),
+		imageInputPolicy:     newImageInputPolicyCache(),
+		priorityClassPricing: newPriorityClassPricingCache(),
+		payloadStorage:       payloadStorage,
+		phaseTracker:         phaseTracker,
+		epochGroupDataCache:  internal.NewEpochGroupDataCache(recorder),
+		authzCache:           authzcache.NewAuthzCache(recorder),
+		httpClient:           NewNoRedirectClient(httpClientTimeout),
 	}
 
 	for _, opt := range opts {
@@ -84,6 +193,11 @@ func NewServer(
 	s.bandwidthLimiter = internal.NewBandwidthLimiterFromConfig(configManager, recorder, phaseTracker)
 
 	e.Use(middleware.LoggingMiddleware)
+	e.Use(tracing.Middleware)
+	e.GET("/healthz", s.getHealthz)
+	e.GET("/readyz", s.getReadyz)
+	e.GET("/ws/events", s.eventsWebSocket)
+
 	g := e.Group("/v1/")
 
 	g.GET("status", s.getStatus)
@@ -91,15 +205,32 @@ func NewServer(
 
 	g.POST("chat/completions", s.postChat)
 	g.GET("chat/completions", s.getChatById)
+	g.GET("chat/completions/ws", s.chatCompletionsWebSocket)
+	g.POST("embeddings", s.postEmbeddings)
+	g.POST("files", s.postFile)
+	g.GET("files/:id", s.getFile)
+	g.GET("files/:id/content", s.getFileContent)
+	g.POST("batches", s.postBatch)
+	g.GET("batches", s.getBatches)
+	g.GET("batches/:id", s.getBatch)
+	g.POST("batches/:id/cancel", s.postBatchCancel)
 	g.GET("inference/payloads", s.getInferencePayloads)
+	g.GET("inference/escrow", s.getInferenceEscrow)
+	g.GET("bandwidth/:address", s.getRequesterBandwidth)
 
 	g.GET("participants/:address", s.getInferenceParticipantByAddress)
+	g.GET("participants/:address/earnings", s.getParticipantEarnings)
+	g.GET("participants/:address/claimable", s.getParticipantClaimable)
+	g.GET("participants/:address/top-rewards", s.getParticipantTopRewards)
+	g.GET("participants/:address/delegations", s.getParticipantDelegations)
 	g.GET("participants", s.getAllParticipants)
 	g.POST("participants", s.submitNewParticipantHandler)
 
 	g.POST("training/tasks", s.postTrainingTask)
 	g.GET("training/tasks", s.getTrainingTasks)
 	g.GET("training/tasks/:id", s.getTrainingTask)
+	g.GET("training/tasks/:id/datasets", s.getTrainingTaskDatasets)
+	g.GET("training/datasets", s.getTrainingDataset)
 	g.POST("training/lock-nodes", s.lockTrainingNodes)
 
 	g.POST("verify-proof", s.postVerifyProof)
@@ -107,6 +238,7 @@ func NewServer(
 
 	g.GET("pricing", s.getPricing)
 	g.GET("models", s.getModels)
+	g.GET("network/capacity", s.getNetworkCapacity)
 	g.GET("governance/pricing", s.getGovernancePricing)
 	g.GET("governance/models", s.getGovernanceModels)
 	g.GET("poc-batches/:epoch", s.getPoCBatches)
@@ -119,14 +251,22 @@ func NewServer(
 	g.GET("bridge/status", s.getBridgeStatus)
 	g.GET("bridge/addresses", s.getBridgeAddresses)
 
+	g.GET("epochs/latest/timeline", s.getPhaseTimeline)
 	g.GET("epochs/:epoch", s.getEpochById)
 	g.GET("epochs/:epoch/participants", s.getParticipantsByEpoch)
+	g.GET("epochs/:epoch/participants/bundle", s.getParticipantsBundle)
+	g.GET("epochs/:epoch/poc-allocation-audit", s.getPocAllocationAudit)
+	g.GET("epochs/:epoch/diff/:other", s.getEpochGroupDiff)
+
+	g.GET("nodes/attestations", s.getHardwareAttestations)
 
 	// BLS Query Endpoints
 	blsGroup := g.Group("bls/")
 	blsGroup.GET("epoch/:id", s.getBLSEpochByID)
 	blsGroup.GET("epochs/:id", s.getBLSEpochByID)
 	blsGroup.GET("signatures/:request_id", s.getBLSSignatureByRequestID)
+	blsGroup.GET("group-keys", s.getBLSGroupKeyHistory)
+	blsGroup.GET("external-signing-policy", s.getExternalSigningPolicy)
 
 	// Restrictions public API (query-only)
 	g.GET("restrictions/status", s.getRestrictionsStatus)
@@ -143,6 +283,19 @@ func NewServer(
 	))
 	g.POST("poc/proofs", s.postPocProofs, pocProofsRateLimiter)
 
+	// Grantee account onboarding, registrations are rate limited per IP (10 per minute)
+	onboardingRateLimiter := echomw.RateLimiter(echomw.NewRateLimiterMemoryStoreWithConfig(
+		echomw.RateLimiterMemoryStoreConfig{
+			Rate:      10.0 / 60.0,
+			Burst:     3,
+			ExpiresIn: 3 * time.Minute,
+		},
+	))
+	g.POST("onboarding/registrations", s.postOnboardingRegistration, onboardingRateLimiter)
+	g.GET("onboarding/registrations/:id", s.getOnboardingRegistration)
+	g.GET("onboarding/registrations/:id/grant-tx", s.getOnboardingGrantTx)
+	g.POST("onboarding/registrations/:id/grant-tx", s.postOnboardingGrantTx)
+
 	// PoC artifact state endpoint (for testermint/validators to get real count and root_hash)
 	g.GET("poc/artifacts/state", s.getPocArtifactsState)
 
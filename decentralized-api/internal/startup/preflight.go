@@ -0,0 +1,111 @@
+package startup
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type PreflightStatus string
+
+const (
+	PreflightPass PreflightStatus = "PASS"
+	PreflightWarn PreflightStatus = "WARN" // a non-critical check failed
+	PreflightFail PreflightStatus = "FAIL"
+)
+
+// PreflightCheck verifies one dependency before event processing starts.
+// Critical checks abort startup when they fail; the others are reported as warnings.
+type PreflightCheck struct {
+	Name     string
+	Critical bool
+	// Hint tells the operator what to look at when the check fails
+	Hint string
+	Run  func(ctx context.Context) (string, error)
+}
+
+type PreflightResult struct {
+	Name     string          `json:"name"`
+	Status   PreflightStatus `json:"status"`
+	Message  string          `json:"message"`
+	Hint     string          `json:"hint,omitempty"`
+	Duration time.Duration   `json:"duration"`
+}
+
+type PreflightReport struct {
+	Results []PreflightResult `json:"results"`
+}
+
+// RunPreflight runs every check in order, each bounded by timeout, and collects the results
+func RunPreflight(ctx context.Context, checks []PreflightCheck, timeout time.Duration) PreflightReport {
+	report := PreflightReport{Results: make([]PreflightResult, 0, len(checks))}
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		start := time.Now()
+		message, err := check.Run(checkCtx)
+		cancel()
+
+		result := PreflightResult{Name: check.Name, Status: PreflightPass, Message: message, Duration: time.Since(start)}
+		if err != nil {
+			result.Status = PreflightWarn
+			if check.Critical {
+				result.Status = PreflightFail
+			}
+			result.Message = err.Error()
+			result.Hint = check.Hint
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// Failed reports whether any critical check failed
+func (r PreflightReport) Failed() bool {
+	for _, result := range r.Results {
+		if result.Status == PreflightFail {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the report as a table, with a hint under every failed check
+func (r PreflightReport) String() string {
+	var sb strings.Builder
+	sb.WriteString("Preflight checks:\n")
+	for _, result := range r.Results {
+		fmt.Fprintf(&sb, "  [%s] %-28s %s (%s)\n", result.Status, result.Name, result.Message, result.Duration.Round(time.Millisecond))
+		if result.Hint != "" {
+			fmt.Fprintf(&sb, "         hint: %s\n", result.Hint)
+		}
+	}
+	passed := 0
+	for _, result := range r.Results {
+		if result.Status == PreflightPass {
+			passed++
+		}
+	}
+	fmt.Fprintf(&sb, "  %d/%d checks passed", passed, len(r.Results))
+	if r.Failed() {
+		sb.WriteString(", critical checks failed")
+	}
+	return sb.String()
+}
+
+// Log writes one log entry per check
+func (r PreflightReport) Log() {
+	for _, result := range r.Results {
+		switch result.Status {
+		case PreflightPass:
+			logging.Info("Preflight check passed", types.System, "check", result.Name, "message", result.Message)
+		case PreflightWarn:
+			logging.Warn("Preflight check failed", types.System, "check", result.Name, "error", result.Message, "hint", result.Hint)
+		default:
+			logging.Error("Critical preflight check failed", types.System, "check", result.Name, "error", result.Message, "hint", result.Hint)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package startup
+
+import (
+	"context"
+	"database/sql"
+	"decentralized-api/apiconfig"
+	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/nodeaddr"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// PreflightChecks returns the checks run before event processing starts
+func PreflightChecks(config *apiconfig.ConfigManager, signer interface{ SignBytes([]byte) ([]byte, error) }) []PreflightCheck {
+	chainNodeUrl := config.GetChainEndpoints().Current()
+	apiConfig := config.GetApiConfig()
+
+	checks := []PreflightCheck{
+		ChainNodeCheck(chainNodeUrl),
+		WebsocketSubscriptionCheck(chainNodeUrl),
+		KeyringSigningCheck(signer),
+	}
+	var db *sql.DB
+	if sqlDb := config.SqlDb(); sqlDb != nil {
+		db = sqlDb.GetDb()
+	}
+	checks = append(checks, SqliteWritableCheck(db))
+
+	version := config.GetCurrentNodeVersion()
+	for _, node := range config.GetNodes() {
+		pocUrl := nodeaddr.Default.URL(node.Host, nodeaddr.ServicePoC, node.PoCPort, version, node.PoCSegment)
+		checks = append(checks, MLNodeCheck(node.Id, pocUrl))
+	}
+
+	mlGrpcServerPort := apiConfig.MlGrpcServerPort
+	if mlGrpcServerPort == 0 {
+		mlGrpcServerPort = 9300
+	}
+	checks = append(checks,
+		PortBindingCheck("public_server_port", apiConfig.PublicServerPort),
+		PortBindingCheck("ml_server_port", apiConfig.MLServerPort),
+		PortBindingCheck("admin_server_port", apiConfig.AdminServerPort),
+		PortBindingCheck("ml_grpc_server_port", mlGrpcServerPort),
+	)
+	if apiConfig.AdminGrpcServerPort != 0 {
+		checks = append(checks, PortBindingCheck("admin_grpc_server_port", apiConfig.AdminGrpcServerPort))
+	}
+	return checks
+}
+
+func ChainNodeCheck(chainNodeUrl string) PreflightCheck {
+	return PreflightCheck{
+		Name:     "chain_node",
+		Critical: true,
+		Hint:     "check chain_node.url in the config and that the chain node RPC port (26657) is reachable from this container",
+		Run: func(ctx context.Context) (string, error) {
+			client, err := cosmosclient.NewRpcClient(chainNodeUrl)
+			if err != nil {
+				return "", err
+			}
+			status, err := client.Status(ctx)
+			if err != nil {
+				return "", fmt.Errorf("%s unreachable: %w", chainNodeUrl, err)
+			}
+			message := fmt.Sprintf("%s at height %d", status.NodeInfo.Network, status.SyncInfo.LatestBlockHeight)
+			if status.SyncInfo.CatchingUp {
+				message += ", catching up"
+			}
+			return message, nil
+		},
+	}
+}
+
+func WebsocketSubscriptionCheck(chainNodeUrl string) PreflightCheck {
+	return PreflightCheck{
+		Name:     "chain_websocket",
+		Critical: true,
+		Hint:     "the chain node must expose /websocket on its RPC port; check rpc.laddr and max_subscription_clients in config.toml",
+		Run: func(ctx context.Context) (string, error) {
+			u, err := url.Parse(chainNodeUrl)
+			if err != nil {
+				return "", err
+			}
+			u.Scheme = "ws"
+			u.Path = "/websocket"
+
+			ws, _, err := websocket.DefaultDialer.DialContext(ctx, u.String(), nil)
+			if err != nil {
+				return "", fmt.Errorf("dial %s: %w", u, err)
+			}
+			defer ws.Close()
+			if deadline, ok := ctx.Deadline(); ok {
+				_ = ws.SetReadDeadline(deadline)
+			}
+
+			subscribeMsg := `{"jsonrpc": "2.0", "method": "subscribe", "id": "preflight", "params": ["tm.event='NewBlock'"]}`
+			if err := ws.WriteMessage(websocket.TextMessage, []byte(subscribeMsg)); err != nil {
+				return "", fmt.Errorf("subscribe: %w", err)
+			}
+			var response struct {
+				Error *struct {
+					Message string `json:"message"`
+					Data    string `json:"data"`
+				} `json:"error"`
+			}
+			if err := ws.ReadJSON(&response); err != nil {
+				return "", fmt.Errorf("read subscription response: %w", err)
+			}
+			if response.Error != nil {
+				return "", fmt.Errorf("subscription rejected: %s %s", response.Error.Message, response.Error.Data)
+			}
+			_ = ws.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc": "2.0", "method": "unsubscribe_all", "id": "preflight-done", "params": []}`))
+			return "subscribed to NewBlock at " + u.String(), nil
+		},
+	}
+}
+
+func KeyringSigningCheck(signer interface{ SignBytes([]byte) ([]byte, error) }) PreflightCheck {
+	return PreflightCheck{
+		Name:     "keyring_signing",
+		Critical: true,
+		Hint:     "check KEYRING_BACKEND, KEYRING_PASSWORD and that the warm key named in the config exists in the keyring",
+		Run: func(ctx context.Context) (string, error) {
+			signature, err := signer.SignBytes([]byte("preflight"))
+			if err != nil {
+				return "", err
+			}
+			if len(signature) == 0 {
+				return "", errors.New("empty signature")
+			}
+			return "warm key signs", nil
+		},
+	}
+}
+
+// SqliteWritableCheck writes to a scratch table inside a transaction that is rolled back
+func SqliteWritableCheck(db *sql.DB) PreflightCheck {
+	return PreflightCheck{
+		Name:     "sqlite_writable",
+		Critical: true,
+		Hint:     "the dynamic state database must be on a writable volume with free space; check the mount of /root/.dapi",
+		Run: func(ctx context.Context) (string, error) {
+			if db == nil {
+				return "", errors.New("database is not initialized")
+			}
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return "", err
+			}
+			defer tx.Rollback()
+			if _, err := tx.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS preflight_check (checked_at INTEGER)"); err != nil {
+				return "", err
+			}
+			if _, err := tx.ExecContext(ctx, "INSERT INTO preflight_check (checked_at) VALUES (?)", time.Now().Unix()); err != nil {
+				return "", err
+			}
+			return "writable", nil
+		},
+	}
+}
+
+// MLNodeCheck is not critical: ML nodes may come up after the API, and the broker keeps retrying them
+func MLNodeCheck(nodeId string, pocUrl string) PreflightCheck {
+	return PreflightCheck{
+		Name: "mlnode_" + nodeId,
+		Hint: "check host and poc_port of the node in the config and that the ML node container is running",
+		Run: func(ctx context.Context) (string, error) {
+			healthUrl, err := url.JoinPath(pocUrl, "/health")
+			if err != nil {
+				return "", err
+			}
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthUrl, nil)
+			if err != nil {
+				return "", err
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				return "", err
+			}
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK {
+				return "", fmt.Errorf("%s returned HTTP %d", healthUrl, resp.StatusCode)
+			}
+			return "healthy at " + pocUrl, nil
+		},
+	}
+}
+
+func PortBindingCheck(name string, port int) PreflightCheck {
+	return PreflightCheck{
+		Name:     "port_" + name,
+		Critical: true,
+		Hint:     "another process already listens on this port; stop it or change " + name + " in the config",
+		Run: func(ctx context.Context) (string, error) {
+			addr := ":" + strconv.Itoa(port)
+			lis, err := net.Listen("tcp", addr)
+			if err != nil {
+				return "", err
+			}
+			if err := lis.Close(); err != nil {
+				return "", err
+			}
+			return addr + " available", nil
+		},
+	}
+}
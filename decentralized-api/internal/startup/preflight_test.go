@@ -0,0 +1,66 @@
+package startup
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+)
+
+func TestRunPreflight_Statuses(t *testing.T) {
+	checks := []PreflightCheck{
+		{Name: "ok", Critical: true, Run: func(ctx context.Context) (string, error) { return "fine", nil }},
+		{Name: "optional", Hint: "start it", Run: func(ctx context.Context) (string, error) { return "", errors.New("down") }},
+	}
+	report := RunPreflight(context.Background(), checks, time.Second)
+	require.Len(t, report.Results, 2)
+	require.Equal(t, PreflightPass, report.Results[0].Status)
+	require.Equal(t, PreflightWarn, report.Results[1].Status)
+	require.Equal(t, "start it", report.Results[1].Hint)
+	require.False(t, report.Failed())
+
+	checks = append(checks, PreflightCheck{Name: "critical", Critical: true, Run: func(ctx context.Context) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}})
+	report = RunPreflight(context.Background(), checks, 10*time.Millisecond)
+	require.Equal(t, PreflightFail, report.Results[2].Status)
+	require.True(t, report.Failed())
+	require.Contains(t, report.String(), "1/3 checks passed, critical checks failed")
+}
+
+func TestPortBindingCheck(t *testing.T) {
+	lis, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+
+	_, err = PortBindingCheck("test", port).Run(context.Background())
+	require.Error(t, err)
+
+	require.NoError(t, lis.Close())
+	_, err = PortBindingCheck("test", port).Run(context.Background())
+	require.NoError(t, err)
+}
+
+func TestSqliteWritableCheck(t *testing.T) {
+	_, err := SqliteWritableCheck(nil).Run(context.Background())
+	require.Error(t, err)
+
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "preflight.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = SqliteWritableCheck(db).Run(context.Background())
+	require.NoError(t, err)
+
+	// the scratch table is rolled back
+	var count int
+	require.NoError(t, db.QueryRow("SELECT count(*) FROM sqlite_master WHERE name = 'preflight_check'").Scan(&count))
+	require.Equal(t, 0, count)
+}
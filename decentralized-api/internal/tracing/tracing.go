@@ -0,0 +1,124 @@
+// Package tracing traces the lifecycle of inference requests with OpenTelemetry. Trace IDs are always
+// generated and returned to clients in the X-Trace-Id header; spans are exported only when an OTLP
+// endpoint is configured. The trace context is propagated to the executor and ML node with the W3C
+// traceparent header. Validations run on other participants' nodes later on, so their spans start
+// new traces and carry the inference id to be found by.
+package tracing
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	serviceName   = "decentralized-api"
+	TraceIdHeader = "X-Trace-Id"
+
+	AttrInferenceId = "inference.id"
+	AttrModel       = "inference.model"
+	AttrNodeId      = "mlnode.id"
+	AttrMsgType     = "chain.msg_type"
+	AttrOutcome     = "validation.outcome"
+)
+
+var propagator = propagation.TraceContext{}
+
+func init() {
+	otel.SetTextMapPropagator(propagator)
+}
+
+// Setup installs the tracer provider. Without an endpoint spans are still created, for their trace IDs, but not exported.
+// The returned function flushes and stops the exporter.
+func Setup(ctx context.Context, cfg apiconfig.TracingConfig) (func(context.Context) error, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	}
+	if cfg.Endpoint != "" {
+		exporterOpts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(cfg.Endpoint)}
+		if cfg.Insecure {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as a child of the span in ctx, if any
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(serviceName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// Annotate adds attributes to the span in ctx
+func Annotate(ctx context.Context, attrs ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attrs...)
+}
+
+// End ends a span, marking it failed if err is set
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Inject adds the trace context of ctx to the headers of an outgoing request
+func Inject(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// TraceId returns the trace ID of the span in ctx, or "" if there is none
+func TraceId(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+	return spanContext.TraceID().String()
+}
+
+// Middleware starts a span for each request, continuing the trace of an incoming traceparent header,
+// and returns the trace ID in the X-Trace-Id response header
+func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := otel.Tracer(serviceName).Start(ctx, req.Method+" "+c.Path(),
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.request.method", req.Method),
+				attribute.String("url.path", req.URL.Path),
+			))
+		defer span.End()
+
+		c.SetRequest(req.WithContext(ctx))
+		c.Response().Header().Set(TraceIdHeader, span.SpanContext().TraceID().String())
+
+		err := next(c)
+		status := c.Response().Status
+		if he, ok := err.(*echo.HTTPError); ok {
+			status = he.Code
+		}
+		span.SetAttributes(attribute.Int("http.response.status_code", status))
+		if err != nil || status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+		return err
+	}
+}
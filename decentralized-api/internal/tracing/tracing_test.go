@@ -0,0 +1,41 @@
+package tracing
+
+import (
+	"decentralized-api/apiconfig"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddlewareSetsTraceIdAndPropagates(t *testing.T) {
+	shutdown, err := Setup(t.Context(), apiconfig.TracingConfig{})
+	require.NoError(t, err)
+	defer shutdown(t.Context())
+
+	e := echo.New()
+	e.Use(Middleware)
+	var outgoing http.Header
+	e.GET("/v1/chat/completions", func(c echo.Context) error {
+		outgoing = http.Header{}
+		Inject(c.Request().Context(), outgoing)
+		require.Equal(t, c.Response().Header().Get(TraceIdHeader), TraceId(c.Request().Context()))
+		return c.NoContent(http.StatusOK)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/chat/completions", nil))
+	traceId := rec.Header().Get(TraceIdHeader)
+	require.Len(t, traceId, 32)
+	require.Contains(t, outgoing.Get("traceparent"), traceId)
+
+	const incomingTraceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/v1/chat/completions", nil)
+	req.Header.Set("traceparent", "00-"+incomingTraceId+"-00f067aa0ba902b7-01")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, incomingTraceId, rec.Header().Get(TraceIdHeader))
+	require.Contains(t, outgoing.Get("traceparent"), incomingTraceId)
+}
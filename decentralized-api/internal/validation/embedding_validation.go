@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"bytes"
+	"decentralized-api/broker"
+	"decentralized-api/completionapi"
+	"decentralized-api/logging"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// isEmbeddingsRequest tells embeddings prompts (OpenAI input field) apart from chat completions
+func isEmbeddingsRequest(requestMap map[string]interface{}) bool {
+	_, hasInput := requestMap["input"]
+	_, hasMessages := requestMap["messages"]
+	return hasInput && !hasMessages
+}
+
+// validateEmbeddings re-computes the embeddings on the validator's node and compares them to the original
+// vectors. Embeddings have no logits; the result is the lowest cosine similarity across the inputs.
+func (s *InferenceValidator) validateEmbeddings(inference types.Inference, inferenceNode *broker.Node, promptPayload, responsePayload []byte) (ValidationResult, error) {
+	if !inferenceNode.Models[inference.Model].Embedding {
+		// Retried by the validation queue, hopefully on a node that serves embeddings
+		return nil, fmt.Errorf("node %s does not serve embeddings for model %s", inferenceNode.Id, inference.Model)
+	}
+
+	originalResponse, err := completionapi.NewEmbeddingResponseFromBytes(responsePayload)
+	if err != nil {
+		return &InvalidInferenceResult{inference.InferenceId, "Failed to unmarshal embeddings responsePayload.", err}, nil
+	}
+
+	embeddingsUrl, err := url.JoinPath(inferenceNode.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "v1/embeddings")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.Post(embeddingsUrl, "application/json", bytes.NewReader(promptPayload))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity {
+		return rejectedPayloadResult(inference.InferenceId, resp.StatusCode, respBodyBytes), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embeddings validation request failed with status %d: %s", resp.StatusCode, string(respBodyBytes))
+	}
+
+	validationResponse, err := completionapi.NewEmbeddingResponseFromBytes(respBodyBytes)
+	if err != nil {
+		logging.Error("Failed to unmarshal embeddings validation response", types.Validation, "id", inference.InferenceId, "error", err)
+		return nil, err
+	}
+
+	baseResult := BaseValidationResult{
+		InferenceId:   inference.InferenceId,
+		ResponseBytes: respBodyBytes,
+	}
+	return compareEmbeddings(originalResponse.Vectors(), validationResponse.Vectors(), baseResult), nil
+}
+
+func compareEmbeddings(original, validation [][]float64, baseResult BaseValidationResult) ValidationResult {
+	if len(original) == 0 || len(original) != len(validation) {
+		logging.Warn("Different number of embeddings", types.Validation,
+			"id", baseResult.InferenceId, "original", len(original), "validation", len(validation))
+		return &DifferentLengthValidationResult{baseResult}
+	}
+
+	minSimilarity := 1.0
+	for i := range original {
+		if len(original[i]) != len(validation[i]) {
+			return &DifferentLengthValidationResult{baseResult}
+		}
+		minSimilarity = math.Min(minSimilarity, cosineSimilarity(original[i], validation[i]))
+	}
+	return &SimilarityValidationResult{BaseValidationResult: baseResult, Value: minSimilarity}
+}
+
+func cosineSimilarity(a, b []float64) float64 {
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
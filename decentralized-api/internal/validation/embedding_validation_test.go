@@ -0,0 +1,42 @@
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsEmbeddingsRequest(t *testing.T) {
+	require.True(t, isEmbeddingsRequest(map[string]interface{}{"model": "m", "input": "text"}))
+	require.False(t, isEmbeddingsRequest(map[string]interface{}{"model": "m", "messages": []interface{}{}}))
+}
+
+func TestHasImageInputs(t *testing.T) {
+	var textOnly, multimodal map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(`{"messages":[{"role":"user","content":"hi"},{"role":"user","content":[{"type":"text","text":"hi"}]}]}`), &textOnly))
+	require.NoError(t, json.Unmarshal([]byte(`{"messages":[{"role":"user","content":[{"type":"text","text":"what is this?"},{"type":"image_url","image_url":{"url":"https://example.com/cat.png"}}]}]}`), &multimodal))
+	require.False(t, hasImageInputs(textOnly))
+	require.True(t, hasImageInputs(multimodal))
+	require.False(t, hasImageInputs(map[string]interface{}{"model": "m", "input": "text"}))
+}
+
+func TestCompareEmbeddings(t *testing.T) {
+	base := BaseValidationResult{InferenceId: "inf"}
+	original := [][]float64{{1, 0, 0}, {0, 1, 0}}
+
+	result := compareEmbeddings(original, [][]float64{{1, 0.001, 0}, {0, 1, 0.001}}, base)
+	require.IsType(t, &SimilarityValidationResult{}, result)
+	require.True(t, result.IsSuccessful())
+
+	result = compareEmbeddings(original, [][]float64{{1, 0, 0}, {1, 0, 0}}, base)
+	require.IsType(t, &SimilarityValidationResult{}, result)
+	require.InDelta(t, 0, result.(*SimilarityValidationResult).Value, 1e-9)
+	require.False(t, result.IsSuccessful())
+
+	result = compareEmbeddings(original, [][]float64{{1, 0, 0}}, base)
+	require.IsType(t, &DifferentLengthValidationResult{}, result)
+
+	result = compareEmbeddings(original, [][]float64{{1, 0}, {0, 1}}, base)
+	require.IsType(t, &DifferentLengthValidationResult{}, result)
+}
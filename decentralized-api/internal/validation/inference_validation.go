@@ -8,6 +8,8 @@ import (
 	"decentralized-api/chainphase"
 	"decentralized-api/completionapi"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/metrics"
+	"decentralized-api/internal/tracing"
 	"decentralized-api/internal/utils"
 	"decentralized-api/logging"
 	"encoding/json"
@@ -19,15 +21,17 @@ import (
 	"net/http"
 	"net/url"
 	"sort"
-	"sync"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/google/uuid"
 	"github.com/productscience/inference/api/inference/inference"
 	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -41,6 +45,8 @@ type InferenceValidator struct {
 	nodeBroker    *broker.Broker
 	configManager *apiconfig.ConfigManager
 	phaseTracker  *chainphase.ChainPhaseTracker
+	queue         *ValidationQueue
+	nodeLimiter   *nodeLimiter
 }
 
 func NewInferenceValidator(
@@ -48,12 +54,45 @@ func NewInferenceValidator(
 	configManager *apiconfig.ConfigManager,
 	recorder cosmosclient.CosmosMessageClient,
 	phaseTracker *chainphase.ChainPhaseTracker) *InferenceValidator {
-	return &InferenceValidator{
+	queueConfig := configManager.GetValidationQueueConfig()
+	s := &InferenceValidator{
 		nodeBroker:    nodeBroker,
 		configManager: configManager,
 		recorder:      recorder,
 		phaseTracker:  phaseTracker,
+		nodeLimiter:   newNodeLimiter(queueConfig.MaxPerNode),
 	}
+
+	var store queueStore
+	if sqlDb := configManager.SqlDb(); sqlDb != nil {
+		if db := sqlDb.GetDb(); db != nil {
+			store = newSqlQueueStore(db)
+		}
+	}
+	s.queue = NewValidationQueue(queueConfig, store, s.processTask)
+	return s
+}
+
+// Start launches the validation worker pool
+func (s *InferenceValidator) Start(ctx context.Context) {
+	s.queue.Start(ctx)
+}
+
+// Queue exposes the validation queue for inspection (dead letters, pending count)
+func (s *InferenceValidator) Queue() *ValidationQueue {
+	return s.queue
+}
+
+// processTask runs a single queued validation. Returned errors are retried by the queue.
+func (s *InferenceValidator) processTask(ctx context.Context, task ValidationTask) error {
+	// Cast the interface back to concrete type (safe since it's always *InferenceCosmosClient)
+	recorder := s.recorder.(*cosmosclient.InferenceCosmosClient)
+
+	response, err := recorder.NewInferenceQueryClient().Inference(ctx, &types.QueryGetInferenceRequest{Index: task.InferenceId})
+	if err != nil {
+		return fmt.Errorf("failed to get inference: %w", err)
+	}
+	return s.validateInferenceAndSendValMessage(response.Inference, *recorder, task.Revalidation)
 }
 
 func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, recorder cosmosclient.InferenceCosmosClient) {
@@ -66,20 +105,8 @@ func (s *InferenceValidator) VerifyInvalidation(events map[string][]string, reco
 
 	logging.Debug("Verifying invalidation", types.Validation, "inference_id", inferenceId)
 
-	queryClient := recorder.NewInferenceQueryClient()
-
-	r, err := queryClient.Inference(recorder.GetContext(), &types.QueryGetInferenceRequest{Index: inferenceId})
-	if err != nil {
-		// FIXME: what should we do with validating the transaction?
-		logging.Warn("Failed to query Inference for revalidation.", types.Validation, "error", err)
-		return
-	}
-
 	logInferencesToValidate([]string{inferenceId})
-	go func() {
-		s.validateInferenceAndSendValMessage(r.Inference, recorder, true)
-	}()
-
+	s.queue.Enqueue(inferenceId, true)
 }
 
 // shouldValidateInference determines if the current participant should validate a specific inference
@@ -427,29 +454,15 @@ func (s *InferenceValidator) ExecuteRecoveryValidations(missedInferences []types
 
 	logging.Info("Starting recovery validation execution", types.ValidationRecovery, "missedValidations", len(missedInferencesToValidate))
 
-	var wg sync.WaitGroup
-
-	// Execute recovery validations in parallel goroutines with WaitGroup synchronization
+	inferenceIds := make([]string, 0, len(missedInferencesToValidate))
 	for _, inf := range missedInferencesToValidate {
-		wg.Add(1)
-		go func(inference types.Inference) {
-			defer wg.Done()
-
-			logging.Info("Executing recovery validation", types.ValidationRecovery, "inferenceId", inference.InferenceId)
-
-			// Use existing validation infrastructure
-			// The validateInferenceAndSendValMessage function handles all validation logic, node locking, and message sending
-			// Cast the interface back to concrete type (safe since it's always *InferenceCosmosClient)
-			concreteRecorder := s.recorder.(*cosmosclient.InferenceCosmosClient)
-			s.validateInferenceAndSendValMessage(inference, *concreteRecorder, false)
-
-			logging.Info("Recovery validation completed", types.ValidationRecovery, "inferenceId", inference.InferenceId)
-		}(inf)
+		inferenceIds = append(inferenceIds, inf.InferenceId)
 	}
 
-	// Wait for all recovery validations to complete
+	// Recovery validations go through the same worker pool as regular ones,
+	// wait until each of them is done or dead-lettered
 	logging.Info("Waiting for all recovery validations to complete", types.ValidationRecovery, "count", len(missedInferences))
-	wg.Wait()
+	s.queue.EnqueueAndWait(s.recorder.GetContext(), inferenceIds, false)
 
 	logging.Info("All recovery validations completed", types.ValidationRecovery, "count", len(missedInferences))
 	return len(missedInferencesToValidate), nil
@@ -532,14 +545,7 @@ func (s *InferenceValidator) SampleInferenceToValidate(ids []string, transaction
 
 	logInferencesToValidate(toValidateIds)
 	for _, inf := range toValidateIds {
-		go func() {
-			response, err := queryClient.Inference(transactionRecorder.GetContext(), &types.QueryGetInferenceRequest{Index: inf})
-			if err != nil {
-				logging.Error("Failed to get inference by id", types.Validation, "id", response, "error", err)
-				return
-			}
-			s.validateInferenceAndSendValMessage(response.Inference, transactionRecorder, false)
-		}()
+		s.queue.Enqueue(inf, false)
 	}
 }
 
@@ -570,28 +576,32 @@ func logInferencesToValidate(toValidate []string) {
 	logging.Info("Inferences to validate", types.Validation, "inferences", ids)
 }
 
-func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Inference, transactionRecorder cosmosclient.InferenceCosmosClient, revalidation bool) {
+// validateInferenceAndSendValMessage validates the inference and reports the result on chain.
+// Errors are returned only for failures worth retrying; terminal outcomes are handled here and return nil.
+func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Inference, transactionRecorder cosmosclient.InferenceCosmosClient, revalidation bool) error {
 	promptPayload, responsePayload, err := s.retrievePayloadsWithRetry(inf)
 	if err != nil {
 		if errors.Is(err, ErrPayloadUnavailable) {
 			// Post-upgrade inference: executor unavailable after 20 min of retries
+			metrics.Validations.WithLabelValues(metrics.ValidationUnavailable).Inc()
 			s.checkAndInvalidateUnavailable(inf, transactionRecorder, revalidation)
-			return
+			return nil
 		}
 		if errors.Is(err, ErrHashMismatch) {
 			// Executor served wrong payload with valid signature - immediate invalidation
+			metrics.Validations.WithLabelValues(metrics.ValidationHashMismatch).Inc()
 			s.submitHashMismatchInvalidation(inf, transactionRecorder, revalidation)
-			return
+			return nil
 		}
 		if errors.Is(err, ErrEpochStale) {
 			// Epoch too old - validation no longer useful, just return
 			logging.Info("Validation aborted: epoch stale", types.Validation,
 				"inferenceId", inf.InferenceId, "inferenceEpoch", inf.EpochId)
-			return
+			return nil
 		}
 		logging.Error("Failed to retrieve payloads", types.Validation,
 			"inferenceId", inf.InferenceId, "error", err)
-		return
+		return nil
 	}
 
 	// Check for duplicate AFTER payload retrieval - catches race conditions
@@ -599,62 +609,61 @@ func (s *InferenceValidator) validateInferenceAndSendValMessage(inf types.Infere
 	if !revalidation && s.isAlreadyValidated(inf.InferenceId, inf.EpochId, transactionRecorder) {
 		logging.Info("Inference already validated by us, skipping", types.Validation,
 			"inferenceId", inf.InferenceId)
-		return
+		return nil
 	}
 
-	const maxRetries = 5
-	const retryInterval = 4 * time.Minute
-
-	var valResult ValidationResult
-
-	// Retry logic for LockNode operation
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		valResult, err = broker.LockNode(s.nodeBroker, inf.Model, func(node *broker.Node) (ValidationResult, error) {
-			return s.validateWithPayloads(inf, node, promptPayload, responsePayload)
-		})
+	validationCtx, validationSpan := tracing.Start(context.Background(), "inference.validate",
+		attribute.String(tracing.AttrInferenceId, inf.InferenceId),
+		attribute.String(tracing.AttrModel, inf.Model),
+		attribute.Bool("validation.revalidation", revalidation))
+	defer validationSpan.End()
 
-		if err == nil {
-			// Success, break out of retry loop
-			break
+	validationStart := time.Now()
+	valResult, err := broker.LockNode(s.nodeBroker, inf.Model, func(node *broker.Node) (ValidationResult, error) {
+		if !s.nodeLimiter.tryAcquire(node.Id) {
+			return nil, errNodeBusy
 		}
-
-		// For all errors, check if we should retry
-		if attempt < maxRetries {
-			logging.Warn("Failed to validate inference, retrying", types.Validation,
-				"id", inf.InferenceId,
-				"attempt", attempt,
-				"maxRetries", maxRetries,
-				"error", err,
-				"nextRetryIn", retryInterval)
-			time.Sleep(retryInterval)
-		} else {
-			// Final attempt failed - check if it's ErrNoNodesAvailable for special handling
-			if errors.Is(err, broker.ErrNoNodesAvailable) {
-				logging.Warn("Failed to validate inference after all retry attempts. No nodes available, probably unsupported model.", types.Validation, "id", inf.InferenceId, "attempts", maxRetries, "error", err)
-				return
-			} else {
-				logging.Error("Failed to validate inference after all retry attempts", types.Validation,
-					"id", inf.InferenceId,
-					"attempts", maxRetries,
-					"error", err)
-				return
-			}
+		defer s.nodeLimiter.release(node.Id)
+		validationSpan.SetAttributes(attribute.String(tracing.AttrNodeId, node.Id))
+		return s.validateWithPayloads(inf, node, promptPayload, responsePayload)
+	})
+	if errors.Is(err, errNodeBusy) {
+		// Requeued by the validation queue without counting an attempt
+		return err
+	}
+	if err != nil {
+		metrics.ObserveValidation(metrics.ValidationError, validationStart)
+		validationSpan.SetAttributes(attribute.String(tracing.AttrOutcome, metrics.ValidationError))
+		validationSpan.SetStatus(otelcodes.Error, err.Error())
+		if errors.Is(err, broker.ErrNoNodesAvailable) {
+			logging.Warn("Failed to validate inference. No nodes available, probably unsupported model.", types.Validation, "id", inf.InferenceId, "error", err)
 		}
+		// Retried by the validation queue with backoff
+		return fmt.Errorf("failed to validate inference: %w", err)
+	}
+	outcome := metrics.ValidationFailed
+	if valResult.IsSuccessful() {
+		outcome = metrics.ValidationPassed
 	}
+	metrics.ObserveValidation(outcome, validationStart)
+	validationSpan.SetAttributes(attribute.String(tracing.AttrOutcome, outcome))
 
 	msgValidation, err := ToMsgValidation(valResult)
 	if err != nil {
 		logging.Error("Failed to convert to MsgValidation.", types.Validation, "id", inf.InferenceId, "error", err)
-		return
+		return nil
 	}
 	msgValidation.Revalidation = revalidation
 
-	if err = transactionRecorder.ReportValidation(msgValidation); err != nil {
-		logging.Error("Failed to report validation.", types.Validation, "id", inf.InferenceId, "error", err)
-		return
+	_, submitSpan := tracing.Start(validationCtx, "chain.submit", attribute.String(tracing.AttrMsgType, sdk.MsgTypeURL(msgValidation)))
+	err = transactionRecorder.ReportValidation(msgValidation)
+	tracing.End(submitSpan, err)
+	if err != nil {
+		return fmt.Errorf("failed to report validation: %w", err)
 	}
 
 	logging.Info("Successfully validated inference", types.Validation, "id", inf.InferenceId)
+	return nil
 }
 
 // isEpochStale returns true if inference epoch is too old for validation to be useful.
@@ -868,6 +877,14 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 		return &InvalidInferenceResult{inference.InferenceId, "Failed to unmarshal promptPayload.", err}, nil
 	}
 
+	if isEmbeddingsRequest(requestMap) {
+		return s.validateEmbeddings(inference, inferenceNode, promptPayload, responsePayload)
+	}
+	if hasImageInputs(requestMap) && !inferenceNode.Models[inference.Model].Multimodal {
+		// Retried by the validation queue, hopefully on a node that accepts image inputs
+		return nil, fmt.Errorf("node %s does not serve model %s with image inputs", inferenceNode.Id, inference.Model)
+	}
+
 	originalResponse, err := unmarshalResponsePayload(responsePayload)
 	if err != nil {
 		return &InvalidInferenceResult{inference.InferenceId, "Failed to unmarshal responsePayload.", err}, nil
@@ -884,14 +901,20 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 	requestMap["skip_special_tokens"] = false
 	delete(requestMap, "stream_options")
 
-	requestBody, err := json.Marshal(requestMap)
+	completionsUrl, err := url.JoinPath(inferenceNode.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "v1/chat/completions")
 	if err != nil {
+		logging.Error("Failed to join url", types.Validation, "url", inferenceNode.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "error", err)
 		return nil, err
 	}
 
-	completionsUrl, err := url.JoinPath(inferenceNode.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "v1/chat/completions")
+	originalLogits := originalResponse.ExtractLogits()
+	if len(originalLogits) >= streamingValidationMinTokens {
+		logging.Debug("Validating long completion as a stream", types.Validation, "id", inference.InferenceId, "tokens", len(originalLogits))
+		return validateStreaming(inference.InferenceId, completionsUrl, requestMap, originalLogits)
+	}
+
+	requestBody, err := json.Marshal(requestMap)
 	if err != nil {
-		logging.Error("Failed to join url", types.Validation, "url", inferenceNode.InferenceUrlWithVersion(s.configManager.GetCurrentNodeVersion()), "error", err)
 		return nil, err
 	}
 
@@ -914,17 +937,7 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 	// This can happen when the original inference could not be executed due to upstream payload rejection,
 	// and validators on older versions may still attempt re-execution.
 	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity {
-		logging.Warn("Validator inference node rejected payload; treating validation as passed", types.Validation,
-			"inferenceId", inference.InferenceId,
-			"status", resp.StatusCode,
-			"body", string(respBodyBytes))
-		return &SimilarityValidationResult{
-			BaseValidationResult: BaseValidationResult{
-				InferenceId:   inference.InferenceId,
-				ResponseBytes: []byte{},
-			},
-			Value: 1.0,
-		}, nil
+		return rejectedPayloadResult(inference.InferenceId, resp.StatusCode, respBodyBytes), nil
 	}
 
 	logging.Debug("responseValidation", types.Validation, "validation", string(respBodyBytes))
@@ -934,7 +947,6 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 		return nil, err
 	}
 
-	originalLogits := originalResponse.ExtractLogits()
 	validationLogits := responseValidation.ExtractLogits()
 	baseResult := BaseValidationResult{
 		InferenceId:   inference.InferenceId,
@@ -948,6 +960,36 @@ func (s *InferenceValidator) validateWithPayloads(inference types.Inference, inf
 	return compareLogits(originalLogits, validationLogits, baseResult), nil
 }
 
+// hasImageInputs tells multimodal chat prompts, with image_url message content parts, apart from text-only ones
+func hasImageInputs(requestMap map[string]interface{}) bool {
+	messages, _ := requestMap["messages"].([]interface{})
+	for _, message := range messages {
+		fields, _ := message.(map[string]interface{})
+		parts, _ := fields["content"].([]interface{})
+		for _, part := range parts {
+			if fields, ok := part.(map[string]interface{}); ok && fields["type"] == "image_url" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// rejectedPayloadResult treats validation as passed when the validator's node rejects the payload
+func rejectedPayloadResult(inferenceId string, statusCode int, body []byte) ValidationResult {
+	logging.Warn("Validator inference node rejected payload; treating validation as passed", types.Validation,
+		"inferenceId", inferenceId,
+		"status", statusCode,
+		"body", string(body))
+	return &SimilarityValidationResult{
+		BaseValidationResult: BaseValidationResult{
+			InferenceId:   inferenceId,
+			ResponseBytes: []byte{},
+		},
+		Value: 1.0,
+	}
+}
+
 func unmarshalResponse(inference *types.Inference) (completionapi.CompletionResponse, error) {
 	return unmarshalResponsePayload([]byte(inference.ResponsePayload))
 }
@@ -1014,8 +1056,11 @@ type SimilarityValidationResult struct {
 	Value float64
 }
 
+// similarityThreshold is the similarity a validation must exceed to pass
+const similarityThreshold = 0.99
+
 func (r SimilarityValidationResult) IsSuccessful() bool {
-	return r.Value > 0.99
+	return r.Value > similarityThreshold
 }
 
 type InvalidInferenceResult struct {
@@ -0,0 +1,372 @@
+package validation
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"decentralized-api/logging"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// errNodeBusy is returned when the node picked by the broker already runs MaxPerNode validations.
+// The task is retried later instead of piling more work onto the same node, without counting an attempt.
+var errNodeBusy = errors.New("node validation limit reached")
+
+// ValidationTask is a unit of work in the validation queue
+type ValidationTask struct {
+	InferenceId   string    `json:"inference_id"`
+	Revalidation  bool      `json:"revalidation"`
+	Attempts      int       `json:"attempts"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// ValidationQueue runs validations on a bounded pool of workers.
+// Failed tasks are retried with exponential backoff and moved to a dead-letter list
+// once MaxAttempts is exhausted. Tasks are persisted when a store is configured.
+type ValidationQueue struct {
+	workers        int
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	store          queueStore
+	process        func(ctx context.Context, task ValidationTask) error
+
+	mu          sync.Mutex
+	pending     map[string]*ValidationTask
+	inFlight    map[string]bool
+	deadLetters map[string]*ValidationTask
+	waiters     map[string][]chan struct{}
+	wake        chan struct{}
+}
+
+func NewValidationQueue(
+	cfg apiconfig.ValidationQueueConfig,
+	store queueStore,
+	process func(ctx context.Context, task ValidationTask) error,
+) *ValidationQueue {
+	return &ValidationQueue{
+		workers:        cfg.Workers,
+		maxAttempts:    cfg.MaxAttempts,
+		initialBackoff: time.Duration(cfg.InitialBackoffSeconds) * time.Second,
+		maxBackoff:     time.Duration(cfg.MaxBackoffSeconds) * time.Second,
+		store:          store,
+		process:        process,
+		pending:        make(map[string]*ValidationTask),
+		inFlight:       make(map[string]bool),
+		deadLetters:    make(map[string]*ValidationTask),
+		waiters:        make(map[string][]chan struct{}),
+		wake:           make(chan struct{}, 1),
+	}
+}
+
+// Start restores persisted tasks and launches the dispatcher and worker pool
+func (q *ValidationQueue) Start(ctx context.Context) {
+	if q.store != nil {
+		pending, deadLetters, err := q.store.LoadAll(ctx)
+		if err != nil {
+			logging.Error("Failed to load persisted validation queue", types.Validation, "error", err)
+		}
+		q.mu.Lock()
+		for i := range pending {
+			task := pending[i]
+			q.pending[task.InferenceId] = &task
+		}
+		for i := range deadLetters {
+			task := deadLetters[i]
+			q.deadLetters[task.InferenceId] = &task
+		}
+		q.mu.Unlock()
+		logging.Info("Validation queue restored", types.Validation, "pending", len(pending), "deadLetters", len(deadLetters))
+	}
+
+	work := make(chan *ValidationTask)
+	for i := 0; i < q.workers; i++ {
+		go q.worker(ctx, work)
+	}
+	go q.dispatch(ctx, work)
+}
+
+// Enqueue adds an inference to the queue. Duplicates are merged.
+func (q *ValidationQueue) Enqueue(inferenceId string, revalidation bool) {
+	q.enqueue(inferenceId, revalidation, nil)
+}
+
+// EnqueueAndWait enqueues the inferences and blocks until each of them either
+// completed or was moved to the dead-letter list
+func (q *ValidationQueue) EnqueueAndWait(ctx context.Context, inferenceIds []string, revalidation bool) {
+	done := make([]chan struct{}, 0, len(inferenceIds))
+	for _, id := range inferenceIds {
+		ch := make(chan struct{})
+		q.enqueue(id, revalidation, ch)
+		done = append(done, ch)
+	}
+	for _, ch := range done {
+		select {
+		case <-ch:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (q *ValidationQueue) enqueue(inferenceId string, revalidation bool, done chan struct{}) {
+	q.mu.Lock()
+	task, exists := q.pending[inferenceId]
+	if !exists {
+		task = &ValidationTask{InferenceId: inferenceId, NextAttemptAt: time.Now()}
+		q.pending[inferenceId] = task
+		delete(q.deadLetters, inferenceId)
+	}
+	task.Revalidation = task.Revalidation || revalidation
+	if done != nil {
+		q.waiters[inferenceId] = append(q.waiters[inferenceId], done)
+	}
+	snapshot := *task
+	q.mu.Unlock()
+
+	q.persist(snapshot, false)
+	q.signal()
+}
+
+// DeadLetters returns the tasks that exhausted their retries
+func (q *ValidationQueue) DeadLetters() []ValidationTask {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	out := make([]ValidationTask, 0, len(q.deadLetters))
+	for _, task := range q.deadLetters {
+		out = append(out, *task)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].InferenceId < out[j].InferenceId })
+	return out
+}
+
+// RetryDeadLetter moves a dead-lettered task back to the queue with a fresh attempt budget
+func (q *ValidationQueue) RetryDeadLetter(inferenceId string) bool {
+	q.mu.Lock()
+	task, ok := q.deadLetters[inferenceId]
+	if !ok {
+		q.mu.Unlock()
+		return false
+	}
+	delete(q.deadLetters, inferenceId)
+	task.Attempts = 0
+	task.NextAttemptAt = time.Now()
+	q.pending[inferenceId] = task
+	snapshot := *task
+	q.mu.Unlock()
+
+	q.persist(snapshot, false)
+	q.signal()
+	return true
+}
+
+// PendingCount returns the number of queued and running tasks
+func (q *ValidationQueue) PendingCount() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.pending)
+}
+
+func (q *ValidationQueue) dispatch(ctx context.Context, work chan<- *ValidationTask) {
+	for {
+		task, wait := q.nextDue(time.Now())
+		if task != nil {
+			select {
+			case work <- task:
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-q.wake:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// nextDue returns the earliest due task and marks it in flight,
+// or how long to wait until the next one becomes due
+func (q *ValidationQueue) nextDue(now time.Time) (*ValidationTask, time.Duration) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var next *ValidationTask
+	for id, task := range q.pending {
+		if q.inFlight[id] {
+			continue
+		}
+		if next == nil || task.NextAttemptAt.Before(next.NextAttemptAt) {
+			next = task
+		}
+	}
+
+	if next == nil {
+		return nil, time.Minute
+	}
+	if wait := next.NextAttemptAt.Sub(now); wait > 0 {
+		return nil, wait
+	}
+	q.inFlight[next.InferenceId] = true
+	copied := *next
+	return &copied, 0
+}
+
+func (q *ValidationQueue) worker(ctx context.Context, work <-chan *ValidationTask) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case task := <-work:
+			err := q.process(ctx, *task)
+			q.complete(task.InferenceId, err)
+		}
+	}
+}
+
+func (q *ValidationQueue) complete(inferenceId string, err error) {
+	q.mu.Lock()
+	delete(q.inFlight, inferenceId)
+	task, ok := q.pending[inferenceId]
+	if !ok {
+		q.mu.Unlock()
+		return
+	}
+
+	if err == nil {
+		delete(q.pending, inferenceId)
+		waiters := q.takeWaiters(inferenceId)
+		q.mu.Unlock()
+
+		q.remove(inferenceId)
+		closeAll(waiters)
+		return
+	}
+
+	if errors.Is(err, errNodeBusy) {
+		// The validation never ran, so it does not use up an attempt
+		task.NextAttemptAt = time.Now().Add(q.initialBackoff)
+		snapshot := *task
+		q.mu.Unlock()
+
+		logging.Debug("Validation node busy, requeued", types.Validation, "inferenceId", inferenceId)
+		q.persist(snapshot, false)
+		q.signal()
+		return
+	}
+
+	task.Attempts++
+	task.LastError = err.Error()
+	if task.Attempts >= q.maxAttempts {
+		delete(q.pending, inferenceId)
+		q.deadLetters[inferenceId] = task
+		waiters := q.takeWaiters(inferenceId)
+		snapshot := *task
+		q.mu.Unlock()
+
+		logging.Error("Validation moved to dead-letter list", types.Validation,
+			"inferenceId", inferenceId, "attempts", snapshot.Attempts, "error", err)
+		q.persist(snapshot, true)
+		closeAll(waiters)
+		return
+	}
+
+	backoff := q.backoff(task.Attempts)
+	task.NextAttemptAt = time.Now().Add(backoff)
+	snapshot := *task
+	q.mu.Unlock()
+
+	logging.Warn("Validation failed, will retry", types.Validation,
+		"inferenceId", inferenceId, "attempt", snapshot.Attempts, "maxAttempts", q.maxAttempts, "nextRetryIn", backoff, "error", err)
+	q.persist(snapshot, false)
+	q.signal()
+}
+
+// backoff doubles the delay for every failed attempt, capped at maxBackoff
+func (q *ValidationQueue) backoff(attempts int) time.Duration {
+	delay := q.initialBackoff
+	for i := 1; i < attempts && delay < q.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, q.maxBackoff)
+}
+
+func (q *ValidationQueue) takeWaiters(inferenceId string) []chan struct{} {
+	waiters := q.waiters[inferenceId]
+	delete(q.waiters, inferenceId)
+	return waiters
+}
+
+func (q *ValidationQueue) persist(task ValidationTask, deadLetter bool) {
+	if q.store == nil {
+		return
+	}
+	if err := q.store.Save(context.Background(), task, deadLetter); err != nil {
+		logging.Error("Failed to persist validation task", types.Validation, "inferenceId", task.InferenceId, "error", err)
+	}
+}
+
+func (q *ValidationQueue) remove(inferenceId string) {
+	if q.store == nil {
+		return
+	}
+	if err := q.store.Delete(context.Background(), inferenceId); err != nil {
+		logging.Error("Failed to delete validation task", types.Validation, "inferenceId", inferenceId, "error", err)
+	}
+}
+
+func (q *ValidationQueue) signal() {
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+func closeAll(chans []chan struct{}) {
+	for _, ch := range chans {
+		close(ch)
+	}
+}
+
+// nodeLimiter caps concurrent validations per ML node
+type nodeLimiter struct {
+	mu      sync.Mutex
+	max     int
+	running map[string]int
+}
+
+func newNodeLimiter(max int) *nodeLimiter {
+	return &nodeLimiter{max: max, running: make(map[string]int)}
+}
+
+func (l *nodeLimiter) tryAcquire(nodeId string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[nodeId] >= l.max {
+		return false
+	}
+	l.running[nodeId]++
+	return true
+}
+
+func (l *nodeLimiter) release(nodeId string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.running[nodeId] <= 1 {
+		delete(l.running, nodeId)
+		return
+	}
+	l.running[nodeId]--
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// queueStore persists validation tasks so queued work survives API restarts
+type queueStore interface {
+	Save(ctx context.Context, task ValidationTask, deadLetter bool) error
+	Delete(ctx context.Context, inferenceId string) error
+	LoadAll(ctx context.Context) (pending []ValidationTask, deadLetters []ValidationTask, err error)
+}
+
+// sqlQueueStore stores tasks in the validation_queue table created by apiconfig.EnsureSchema
+type sqlQueueStore struct {
+	db *sql.DB
+}
+
+func newSqlQueueStore(db *sql.DB) *sqlQueueStore {
+	return &sqlQueueStore{db: db}
+}
+
+func (s *sqlQueueStore) Save(ctx context.Context, task ValidationTask, deadLetter bool) error {
+	_, err := s.db.ExecContext(ctx, `
+INSERT INTO validation_queue (inference_id, revalidation, attempts, next_attempt_at, last_error, dead_letter)
+VALUES (?, ?, ?, ?, ?, ?)
+ON CONFLICT(inference_id) DO UPDATE SET
+  revalidation = excluded.revalidation,
+  attempts = excluded.attempts,
+  next_attempt_at = excluded.next_attempt_at,
+  last_error = excluded.last_error,
+  dead_letter = excluded.dead_letter`,
+		task.InferenceId, task.Revalidation, task.Attempts, task.NextAttemptAt.Unix(), task.LastError, deadLetter)
+	return err
+}
+
+func (s *sqlQueueStore) Delete(ctx context.Context, inferenceId string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM validation_queue WHERE inference_id = ?`, inferenceId)
+	return err
+}
+
+func (s *sqlQueueStore) LoadAll(ctx context.Context) ([]ValidationTask, []ValidationTask, error) {
+	rows, err := s.db.QueryContext(ctx, `
+SELECT inference_id, revalidation, attempts, next_attempt_at, last_error, dead_letter
+FROM validation_queue ORDER BY next_attempt_at`)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var pending, deadLetters []ValidationTask
+	for rows.Next() {
+		var (
+			task          ValidationTask
+			nextAttemptAt int64
+			deadLetter    bool
+		)
+		if err := rows.Scan(&task.InferenceId, &task.Revalidation, &task.Attempts, &nextAttemptAt, &task.LastError, &deadLetter); err != nil {
+			return nil, nil, err
+		}
+		task.NextAttemptAt = time.Unix(nextAttemptAt, 0)
+		if deadLetter {
+			deadLetters = append(deadLetters, task)
+		} else {
+			pending = append(pending, task)
+		}
+	}
+	return pending, deadLetters, rows.Err()
+}
@@ -0,0 +1,174 @@
+package validation
+
+import (
+	"context"
+	"decentralized-api/apiconfig"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestQueue(process func(ctx context.Context, task ValidationTask) error, store queueStore) *ValidationQueue {
+	q := NewValidationQueue(apiconfig.ValidationQueueConfig{
+		Workers:     2,
+		MaxPerNode:  1,
+		MaxAttempts: 3,
+	}, store, process)
+	q.initialBackoff = 5 * time.Millisecond
+	q.maxBackoff = 20 * time.Millisecond
+	return q
+}
+
+func TestValidationQueue_ProcessesTasks(t *testing.T) {
+	var mu sync.Mutex
+	processed := map[string]bool{}
+	q := newTestQueue(func(ctx context.Context, task ValidationTask) error {
+		mu.Lock()
+		defer mu.Unlock()
+		processed[task.InferenceId] = task.Revalidation
+		return nil
+	}, nil)
+	q.Start(t.Context())
+
+	q.Enqueue("inf-1", true)
+	q.EnqueueAndWait(t.Context(), []string{"inf-2", "inf-3"}, false)
+
+	require.Eventually(t, func() bool { return q.PendingCount() == 0 }, time.Second, 5*time.Millisecond)
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, processed, 3)
+	require.True(t, processed["inf-1"])
+	require.False(t, processed["inf-2"])
+}
+
+func TestValidationQueue_RetriesThenSucceeds(t *testing.T) {
+	var attempts atomic.Int32
+	q := newTestQueue(func(ctx context.Context, task ValidationTask) error {
+		if attempts.Add(1) < 3 {
+			return errors.New("node unavailable")
+		}
+		return nil
+	}, nil)
+	q.Start(t.Context())
+
+	q.EnqueueAndWait(t.Context(), []string{"inf-1"}, false)
+
+	require.Equal(t, int32(3), attempts.Load())
+	require.Empty(t, q.DeadLetters())
+}
+
+func TestValidationQueue_DeadLetterAndRetry(t *testing.T) {
+	var fail atomic.Bool
+	fail.Store(true)
+	q := newTestQueue(func(ctx context.Context, task ValidationTask) error {
+		if fail.Load() {
+			return errors.New("validation failed")
+		}
+		return nil
+	}, nil)
+	q.Start(t.Context())
+
+	q.EnqueueAndWait(t.Context(), []string{"inf-1"}, false)
+
+	deadLetters := q.DeadLetters()
+	require.Len(t, deadLetters, 1)
+	require.Equal(t, "inf-1", deadLetters[0].InferenceId)
+	require.Equal(t, 3, deadLetters[0].Attempts)
+	require.Equal(t, "validation failed", deadLetters[0].LastError)
+
+	fail.Store(false)
+	require.True(t, q.RetryDeadLetter("inf-1"))
+	require.False(t, q.RetryDeadLetter("unknown"))
+	require.Eventually(t, func() bool { return q.PendingCount() == 0 }, time.Second, 5*time.Millisecond)
+	require.Empty(t, q.DeadLetters())
+}
+
+func TestValidationQueue_NodeBusyDoesNotCountAttempts(t *testing.T) {
+	var runs atomic.Int32
+	var attempts sync.Map
+	q := newTestQueue(func(ctx context.Context, task ValidationTask) error {
+		attempts.Store(runs.Add(1), task.Attempts)
+		if runs.Load() <= 5 {
+			return fmt.Errorf("failed to validate inference: %w", errNodeBusy)
+		}
+		return nil
+	}, nil)
+	q.Start(t.Context())
+
+	q.EnqueueAndWait(t.Context(), []string{"inf-1"}, false)
+
+	// More busy rejections than MaxAttempts, yet the task ran and was never dead-lettered
+	require.Equal(t, int32(6), runs.Load())
+	require.Empty(t, q.DeadLetters())
+	attempts.Range(func(_, value any) bool {
+		require.Equal(t, 0, value.(int))
+		return true
+	})
+}
+
+func TestNewInferenceValidator_WithoutSqlDb(t *testing.T) {
+	validator := NewInferenceValidator(nil, &apiconfig.ConfigManager{}, nil, nil)
+	require.Nil(t, validator.queue.store)
+}
+
+func TestValidationQueue_Backoff(t *testing.T) {
+	q := NewValidationQueue(apiconfig.ValidationQueueConfig{
+		InitialBackoffSeconds: 60,
+		MaxBackoffSeconds:     600,
+	}, nil, nil)
+
+	require.Equal(t, 60*time.Second, q.backoff(1))
+	require.Equal(t, 120*time.Second, q.backoff(2))
+	require.Equal(t, 480*time.Second, q.backoff(4))
+	require.Equal(t, 600*time.Second, q.backoff(5))
+	require.Equal(t, 600*time.Second, q.backoff(50))
+}
+
+func TestValidationQueue_PersistsAcrossRestarts(t *testing.T) {
+	db, err := apiconfig.OpenSQLite(apiconfig.SqliteConfig{Path: filepath.Join(t.TempDir(), "test.db")})
+	require.NoError(t, err)
+	defer db.Close()
+	require.NoError(t, apiconfig.EnsureSchema(t.Context(), db))
+	store := newSqlQueueStore(db)
+
+	// Queue that is never started keeps the task persisted
+	first := newTestQueue(nil, store)
+	first.Enqueue("inf-1", true)
+	require.NoError(t, store.Save(t.Context(), ValidationTask{InferenceId: "inf-dead", Attempts: 3, NextAttemptAt: time.Now()}, true))
+
+	var processed atomic.Value
+	second := newTestQueue(func(ctx context.Context, task ValidationTask) error {
+		processed.Store(task)
+		return nil
+	}, store)
+	second.Start(t.Context())
+
+	require.Eventually(t, func() bool { return processed.Load() != nil }, time.Second, 5*time.Millisecond)
+	task := processed.Load().(ValidationTask)
+	require.Equal(t, "inf-1", task.InferenceId)
+	require.True(t, task.Revalidation)
+	require.Len(t, second.DeadLetters(), 1)
+
+	require.Eventually(t, func() bool {
+		pending, _, err := store.LoadAll(t.Context())
+		return err == nil && len(pending) == 0
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestNodeLimiter(t *testing.T) {
+	limiter := newNodeLimiter(2)
+
+	require.True(t, limiter.tryAcquire("node-1"))
+	require.True(t, limiter.tryAcquire("node-1"))
+	require.False(t, limiter.tryAcquire("node-1"))
+	require.True(t, limiter.tryAcquire("node-2"))
+
+	limiter.release("node-1")
+	require.True(t, limiter.tryAcquire("node-1"))
+}
@@ -0,0 +1,184 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	"decentralized-api/completionapi"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// streamingValidationMinTokens is the completion length from which validation re-runs the
+// inference as a stream, so long generations are compared chunk by chunk instead of being
+// buffered as a single response
+const streamingValidationMinTokens = 1024
+
+// maxStreamLineSize bounds a single SSE event; events carrying top logprobs for a chunk
+// can be considerably larger than bufio's default 64KB
+const maxStreamLineSize = 4 * 1024 * 1024
+
+// streamingValidationTimeout bounds a whole streaming validation, so an ML node that stalls
+// mid-stream does not hold a validation worker forever
+const streamingValidationTimeout = 10 * time.Minute
+
+var streamingValidationClient = utils.NewHttpClient(streamingValidationTimeout)
+
+// validateStreaming sends the validation request with stream=true and compares logits as they arrive.
+// Reading stops as soon as the outcome is known, which also aborts generation on the node.
+func validateStreaming(
+	inferenceId string,
+	completionsUrl string,
+	requestMap map[string]interface{},
+	originalLogits []completionapi.Logprob,
+) (ValidationResult, error) {
+	requestMap["stream"] = true
+
+	requestBody, err := json.Marshal(requestMap)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := streamingValidationClient.Post(completionsUrl, "application/json", bytes.NewReader(requestBody))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusUnprocessableEntity {
+		body, _ := io.ReadAll(resp.Body)
+		return rejectedPayloadResult(inferenceId, resp.StatusCode, body), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("streaming validation request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return compareStream(inferenceId, resp.Body, originalLogits)
+}
+
+// compareStream reads SSE events from the validation stream and feeds their logits to a streamingComparator
+func compareStream(inferenceId string, stream io.Reader, originalLogits []completionapi.Logprob) (ValidationResult, error) {
+	comparator := newStreamingComparator(inferenceId, originalLogits)
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	for scanner.Scan() {
+		event := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "data:"))
+		if event == "" {
+			continue
+		}
+		if event == "[DONE]" {
+			break
+		}
+
+		var chunk completionapi.Response
+		if err := json.Unmarshal([]byte(event), &chunk); err != nil {
+			logging.Error("Failed to unmarshal validation stream event", types.Validation, "inferenceId", inferenceId, "event", event, "error", err)
+			return nil, err
+		}
+
+		for _, c := range chunk.Choices {
+			if result := comparator.add(c.Logprobs.Content); result != nil {
+				return result, nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if comparator.received == 0 {
+		logging.Error("No logits found in validation stream", types.Validation, "id", inferenceId)
+		return nil, fmt.Errorf("no logits found in validation stream")
+	}
+	return comparator.finish(), nil
+}
+
+// streamingComparator is the incremental counterpart of compareLogits.
+// Distances are normalized by the original length, which is known up front, so the
+// similarity can only decrease as more tokens arrive and the comparison can stop once
+// it falls below similarityThreshold.
+type streamingComparator struct {
+	baseResult  BaseValidationResult
+	original    []completionapi.Logprob
+	received    int
+	distance    float64
+	normalizer  float64
+	maxDistance float64
+}
+
+func newStreamingComparator(inferenceId string, original []completionapi.Logprob) *streamingComparator {
+	normalizer := max(100, len(original))
+	if len(original) > 0 && len(original[0].TopLogprobs) > 0 {
+		normalizer *= len(original[0].TopLogprobs)
+	}
+	return &streamingComparator{
+		// Streamed validation responses are never held as a whole, so no response hash is reported
+		baseResult:  BaseValidationResult{InferenceId: inferenceId, ResponseBytes: []byte{}},
+		original:    original,
+		normalizer:  float64(normalizer),
+		maxDistance: (1 - similarityThreshold) * float64(normalizer),
+	}
+}
+
+// add consumes the next validation logits and returns a result once the outcome is decided
+func (c *streamingComparator) add(logits []completionapi.Logprob) ValidationResult {
+	for _, v := range logits {
+		if c.received >= len(c.original) {
+			// Tokens past the original completion are not compared
+			return c.finish()
+		}
+
+		o := c.original[c.received]
+		c.received++
+		if o.Token != v.Token {
+			logging.Error("Different tokens in streamed logits", types.Validation, "inferenceId", c.baseResult.InferenceId, "position", c.received-1, "originalToken", o.Token, "validationToken", v.Token)
+			return &DifferentTokensValidationResult{c.baseResult}
+		}
+
+		posDistance, err := positionDistance(o.TopLogprobs, v.TopLogprobs)
+		if err != nil {
+			logging.Error("Error calculating position distance", types.Validation, "error", err)
+			return &SimilarityValidationResult{BaseValidationResult: c.baseResult, Value: 0}
+		}
+		c.distance += posDistance
+
+		if c.distance > c.maxDistance {
+			logging.Warn("Streamed validation diverged, stopping early", types.Validation, "inferenceId", c.baseResult.InferenceId, "position", c.received, "lengthOriginal", len(c.original))
+			return &SimilarityValidationResult{BaseValidationResult: c.baseResult, Value: c.similarity()}
+		}
+	}
+	if c.received == len(c.original) {
+		return c.finish()
+	}
+	return nil
+}
+
+// finish produces the result once the stream ended or every original token was compared
+func (c *streamingComparator) finish() ValidationResult {
+	if c.received < len(c.original) {
+		logging.Warn("Validation stream is shorter than original logits", types.Validation, "inferenceId", c.baseResult.InferenceId, "lengthOriginal", len(c.original), "lengthValidation", c.received)
+		return &DifferentLengthValidationResult{c.baseResult}
+	}
+	return &SimilarityValidationResult{BaseValidationResult: c.baseResult, Value: c.similarity()}
+}
+
+func (c *streamingComparator) similarity() float64 {
+	if len(c.original) == 0 {
+		return 1
+	}
+	similarity := 1 - c.distance/c.normalizer
+	if math.IsNaN(similarity) || math.IsInf(similarity, 0) || similarity < 0 {
+		return 0
+	}
+	return similarity
+}
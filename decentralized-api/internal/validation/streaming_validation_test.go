@@ -0,0 +1,126 @@
+package validation
+
+import (
+	"decentralized-api/completionapi"
+	"decentralized-api/utils"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func makeLogits(n int, shift float64) []completionapi.Logprob {
+	logits := make([]completionapi.Logprob, n)
+	for i := range logits {
+		token := fmt.Sprintf("tok%d", i)
+		logits[i] = completionapi.Logprob{
+			Token: token,
+			TopLogprobs: []completionapi.TopLogprobs{
+				{Token: token, Logprob: -0.1 - shift},
+				{Token: "alt", Logprob: -2.5 + shift},
+			},
+		}
+	}
+	return logits
+}
+
+func makeStream(t *testing.T, logits []completionapi.Logprob, chunkSize int) string {
+	var sb strings.Builder
+	for start := 0; start < len(logits); start += chunkSize {
+		end := min(start+chunkSize, len(logits))
+		var chunk completionapi.Response
+		chunk.Choices = make([]completionapi.Choice, 1)
+		chunk.Choices[0].Logprobs.Content = logits[start:end]
+		data, err := json.Marshal(chunk)
+		require.NoError(t, err)
+		sb.WriteString("data: " + string(data) + "\n\n")
+	}
+	sb.WriteString("data: [DONE]\n\n")
+	return sb.String()
+}
+
+func TestCompareStream_MatchesCompareLogits(t *testing.T) {
+	original := makeLogits(300, 0)
+	validation := makeLogits(300, 0.001)
+
+	expected := compareLogits(original, validation, BaseValidationResult{InferenceId: "inf"})
+	result, err := compareStream("inf", strings.NewReader(makeStream(t, validation, 7)), original)
+	require.NoError(t, err)
+
+	require.IsType(t, &SimilarityValidationResult{}, result)
+	require.True(t, result.IsSuccessful())
+	require.InDelta(t, expected.(*SimilarityValidationResult).Value, result.(*SimilarityValidationResult).Value, 1e-9)
+}
+
+func TestCompareStream_DifferentTokens(t *testing.T) {
+	original := makeLogits(50, 0)
+	validation := makeLogits(50, 0)
+	validation[20].Token = "other"
+
+	result, err := compareStream("inf", strings.NewReader(makeStream(t, validation, 5)), original)
+	require.NoError(t, err)
+	require.IsType(t, &DifferentTokensValidationResult{}, result)
+}
+
+func TestCompareStream_ShorterThanOriginal(t *testing.T) {
+	original := makeLogits(50, 0)
+
+	result, err := compareStream("inf", strings.NewReader(makeStream(t, original[:30], 5)), original)
+	require.NoError(t, err)
+	require.IsType(t, &DifferentLengthValidationResult{}, result)
+}
+
+func TestCompareStream_StopsEarlyOnDivergence(t *testing.T) {
+	original := makeLogits(2000, 0)
+	validation := makeLogits(2000, 1.0)
+
+	comparator := newStreamingComparator("inf", original)
+	var result ValidationResult
+	chunks := 0
+	for start := 0; start < len(validation) && result == nil; start += 10 {
+		result = comparator.add(validation[start : start+10])
+		chunks++
+	}
+
+	require.IsType(t, &SimilarityValidationResult{}, result)
+	require.False(t, result.IsSuccessful())
+	require.Less(t, comparator.received, len(original))
+	require.Less(t, chunks, len(validation)/10)
+}
+
+func TestCompareStream_IgnoresTokensPastOriginal(t *testing.T) {
+	original := makeLogits(40, 0)
+
+	result, err := compareStream("inf", strings.NewReader(makeStream(t, makeLogits(60, 0), 25)), original)
+	require.NoError(t, err)
+	require.IsType(t, &SimilarityValidationResult{}, result)
+	require.InDelta(t, 1.0, result.(*SimilarityValidationResult).Value, 1e-9)
+}
+
+func TestCompareStream_NoLogits(t *testing.T) {
+	_, err := compareStream("inf", strings.NewReader("data: [DONE]\n\n"), makeLogits(10, 0))
+	require.Error(t, err)
+}
+
+func TestValidateStreaming_TimesOutOnStalledNode(t *testing.T) {
+	stalled := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.(http.Flusher).Flush()
+		<-stalled
+	}))
+	defer server.Close()
+	defer close(stalled)
+
+	client := streamingValidationClient
+	streamingValidationClient = utils.NewHttpClient(100 * time.Millisecond)
+	defer func() { streamingValidationClient = client }()
+
+	_, err := validateStreaming("inf-1", server.URL, map[string]interface{}{}, makeLogits(4, 0))
+	require.Error(t, err)
+}
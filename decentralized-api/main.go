@@ -6,13 +6,25 @@ import (
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
+	"decentralized-api/internal/apikeys"
+	"decentralized-api/internal/audit"
+	"decentralized-api/internal/backup"
+	"decentralized-api/internal/batches"
 	"decentralized-api/internal/bls"
+	"decentralized-api/internal/certs"
+	"decentralized-api/internal/contentfilter"
 	"decentralized-api/internal/event_listener"
+	"decentralized-api/internal/evmrelay"
 	"decentralized-api/internal/modelmanager"
 	"decentralized-api/internal/nats/server"
+	"decentralized-api/internal/onboarding"
+	"decentralized-api/internal/peerhealth"
+	"decentralized-api/internal/replay"
 	adminserver "decentralized-api/internal/server/admin"
 	mlserver "decentralized-api/internal/server/mlnode"
 	pserver "decentralized-api/internal/server/public"
+	"decentralized-api/internal/startup"
+	"decentralized-api/internal/tracing"
 	"decentralized-api/mlnodeclient"
 	"decentralized-api/payloadstorage"
 	"decentralized-api/poc"
@@ -28,10 +40,13 @@ import (
 	"decentralized-api/participant"
 	"decentralized-api/training"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
+	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -52,10 +67,15 @@ func main() {
 
 		return
 	}
+	if len(os.Args) >= 2 && os.Args[1] == "simulate-phases" {
+		os.Exit(chainphase.RunSimulateCommand(os.Args[2:], os.Stdout, os.Stderr))
+	}
 	if len(os.Args) >= 2 && os.Args[1] == "pre-upgrade" {
 		os.Exit(1)
 	}
 
+	restoreDatabaseFromBackup()
+
 	config, err := apiconfig.LoadDefaultConfigManager()
 	if err != nil {
 		log.Fatalf("Error loading config: %v", err)
@@ -65,6 +85,14 @@ func main() {
 		slog.SetLogLoggerLevel(slog.LevelDebug)
 	}
 
+	if replayConfig := config.GetReplayConfig(); replayConfig.Enabled {
+		startReplayServers(replayConfig)
+	}
+
+	chainEndpoints := cosmosclient.NewEndpointPool(config.GetChainNodeConfig().Endpoints())
+	chainEndpoints.Start(context.Background())
+	config.SetChainEndpoints(chainEndpoints)
+
 	natssrv := server.NewServer(config.GetNatsConfig())
 	if err := natssrv.Start(); err != nil {
 		panic(err)
@@ -98,8 +126,13 @@ func main() {
 		logging.Error("Failed to get participant info", types.Participants, "error", err)
 		return
 	}
-	chainBridge := broker.NewBrokerChainBridgeImpl(recorder, config.GetChainNodeConfig().Url)
+	chainBridge := broker.NewBrokerChainBridgeImpl(recorder, config.GetChainEndpoints())
 	nodeBroker := broker.NewBroker(chainBridge, chainPhaseTracker, participantInfo, config.GetApiConfig().PoCCallbackUrl, &mlnodeclient.HttpClientFactory{}, config)
+	if db := config.SqlDb().GetDb(); db != nil {
+		if err := nodeBroker.EnableStatePersistence(context.Background(), db); err != nil {
+			logging.Error("Failed to load persisted node state", types.Nodes, "error", err)
+		}
+	}
 
 	nodes := config.GetNodes()
 	for _, node := range nodes {
@@ -132,14 +165,23 @@ func main() {
 		participantInfo.GetAddress(),
 		nodeBroker,
 		config.GetApiConfig().PoCCallbackUrl,
-		config.GetChainNodeConfig().Url,
+		config.GetChainEndpoints(),
 		recorder,
 		chainPhaseTracker,
 	)
 	logging.Info("PoC orchestrator initialized", types.PoC)
 
+	if preflightConfig := config.GetPreflightConfig(); !preflightConfig.Disabled {
+		report := startup.RunPreflight(context.Background(), startup.PreflightChecks(config, recorder), time.Duration(preflightConfig.TimeoutSeconds)*time.Second)
+		report.Log()
+		if report.Failed() && !preflightConfig.ContinueOnFailure {
+			logging.Error("Preflight checks failed, not starting", types.System)
+			os.Exit(1)
+		}
+	}
+
 	tendermintClient := cosmosclient.TendermintClient{
-		ChainNodeUrl: config.GetChainNodeConfig().Url,
+		Endpoints: config.GetChainEndpoints(),
 	}
 	// Create a cancellable context for the entire system
 	ctx, cancel := context.WithCancel(context.Background())
@@ -148,12 +190,42 @@ func main() {
 	// Start periodic config auto-flush of dynamic data to DB
 	config.StartAutoFlush(ctx, 60*time.Second)
 
+	shutdownTracing, err := tracing.Setup(ctx, config.GetTracingConfig())
+	if err != nil {
+		logging.Error("Failed to set up trace export, traces will not be exported", types.Server, "error", err)
+		shutdownTracing, _ = tracing.Setup(ctx, apiconfig.TracingConfig{})
+	}
+
 	training.NewAssigner(recorder, &tendermintClient, ctx)
-	trainingExecutor := training.NewExecutor(ctx, nodeBroker, recorder)
+	trainingExecutor := training.NewExecutor(ctx, nodeBroker, recorder, training.NewFileCheckpointStore(training.DefaultCheckpointDir))
 
 	validator := validation.NewInferenceValidator(nodeBroker, config, recorder, chainPhaseTracker)
+	validator.Start(ctx)
 	blsManager := bls.NewBlsManager(*recorder)
+	if db := config.SqlDb().GetDb(); db != nil {
+		if err := blsManager.EnableStatePersistence(ctx, db); err != nil {
+			logging.Error("Failed to load persisted DKG state", types.BLS, "error", err)
+		}
+	}
 	listener := event_listener.NewEventListener(config, pocOrchestrator, nodeBroker, validator, *recorder, trainingExecutor, chainPhaseTracker, cancel, blsManager)
+	if relayConfig := config.GetEvmRelayConfig(); relayConfig.Enabled {
+		relayer, err := evmrelay.NewRelayer(evmrelay.Config{
+			RpcUrl:          relayConfig.RpcUrl,
+			ChainId:         relayConfig.ChainId,
+			ContractAddress: relayConfig.ContractAddress,
+			PrivateKey:      relayConfig.PrivateKey,
+			MaxFeePerGas:    new(big.Int).Mul(big.NewInt(relayConfig.MaxFeePerGasGwei), big.NewInt(1_000_000_000)),
+			ReplaceAfter:    time.Duration(relayConfig.ReplaceAfterSeconds) * time.Second,
+			MaxReplacements: relayConfig.MaxReplacements,
+			PollInterval:    time.Duration(relayConfig.PollIntervalSeconds) * time.Second,
+		}, recorder.NewBLSQueryClient())
+		if err != nil {
+			logging.Error("Failed to create the EVM relay, not relaying to the bridge", types.BLS, "error", err)
+		} else {
+			listener.SetEvmRelayer(relayer)
+			go relayer.Start(ctx)
+		}
+	}
 	// TODO: propagate trainingExecutor
 	go listener.Start(ctx)
 
@@ -164,8 +236,19 @@ func main() {
 		&mlnodeclient.HttpClientFactory{},
 		30*time.Minute,
 	)
+	if signer, err := modelmanager.NewAttestationSigner(participantInfo.GetAddress(), config.GetMLNodeKeyConfig()); err != nil {
+		logging.Warn("Hardware attestations disabled", types.Nodes, "error", err)
+	} else {
+		mlnodeBackgroundManager.SetAttestationSigner(signer)
+	}
+	mlnodeBackgroundManager.SetDownloadConfig(config.GetModelDownloadConfig())
 	go mlnodeBackgroundManager.Start(ctx)
 
+	if certIssuerUrl := config.GetApiConfig().CertIssuerUrl; certIssuerUrl != "" {
+		certManager := certs.NewAutoCertManager(certIssuerUrl, config.GetApiConfig().PublicUrl, config.GetApiConfig().CertDir, recorder)
+		go certManager.Start(ctx)
+	}
+
 	addr := fmt.Sprintf(":%v", config.GetApiConfig().PublicServerPort)
 	logging.Info("start public server on addr", types.Server, "addr", addr)
 
@@ -193,8 +276,102 @@ func main() {
 	commitWorker := poc.NewCommitWorker(artifactStore, recorder, chainPhaseTracker, participantInfo.GetAddress(), commitInterval)
 	defer commitWorker.Close()
 
-	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, payloadStore, pserver.WithArtifactStore(artifactStore))
+	var peerProber *peerhealth.Prober
+	if peerHealthCfg := config.GetPeerHealthConfig(); !peerHealthCfg.Disabled {
+		peerProber = peerhealth.NewProber(peerhealth.ChainPeerSource(recorder), peerhealth.Config{
+			Interval:       time.Duration(peerHealthCfg.IntervalSeconds) * time.Second,
+			Timeout:        time.Duration(peerHealthCfg.TimeoutSeconds) * time.Second,
+			UnhealthyAfter: peerHealthCfg.UnhealthyAfterFailures,
+			MaxConcurrency: peerHealthCfg.MaxConcurrency,
+		})
+		go peerProber.Start(ctx)
+	}
+
+	var apiKeys *apikeys.Manager
+	if db := config.SqlDb().GetDb(); db != nil {
+		if apiKeys, err = apikeys.NewManager(ctx, db); err != nil {
+			logging.Error("Failed to load API keys", types.Server, "error", err)
+		}
+	}
+	if apiKeys == nil && config.GetApiKeysConfig().Required {
+		logging.Warn("API keys are required but unavailable, inference requests will be rejected", types.Server)
+	}
+
+	var auditLog *audit.Log
+	if auditCfg := config.GetAuditLogConfig(); auditCfg.Enabled {
+		if db := config.SqlDb().GetDb(); db != nil {
+			auditLog = audit.NewLog(db, time.Duration(auditCfg.RetentionDays)*24*time.Hour)
+			go auditLog.Start(ctx)
+		} else {
+			logging.Warn("Audit log is enabled but no SQL database is available", types.Server)
+		}
+	}
+
+	if backupCfg := config.GetBackupConfig(); backupCfg.Backend != "" {
+		store, err := newBackupStore(backupCfg)
+		if err != nil {
+			logging.Error("Invalid backup configuration, backups are disabled", types.Config, "error", err)
+		} else if db := config.SqlDb().GetDb(); db != nil {
+			backupManager := backup.NewManager(db, store, backup.Config{
+				Interval: time.Duration(backupCfg.IntervalMinutes) * time.Minute,
+				Retain:   backupCfg.Retain,
+			})
+			go backupManager.Start(ctx)
+		} else {
+			logging.Warn("Backups are enabled but no SQL database is available", types.Config)
+		}
+	}
+
+	var onboardingManager *onboarding.Manager
+	if onboardingCfg := config.GetOnboardingConfig(); onboardingCfg.Enabled {
+		if db := config.SqlDb().GetDb(); db != nil {
+			clientCtx := recorder.GetClientContext()
+			onboardingManager = onboarding.NewManager(db, onboarding.NewChain(clientCtx), apiKeys, onboarding.Config{
+				AddressPrefix:   recorder.GetApiAccount().AddressPrefix,
+				ChainId:         clientCtx.ChainID,
+				AutoApprove:     onboardingCfg.AutoApprove,
+				GrantExpiration: time.Duration(onboardingCfg.GrantExpirationDays) * 24 * time.Hour,
+			})
+		} else {
+			logging.Warn("Account onboarding is enabled but no SQL database is available", types.Server)
+		}
+	}
+
+	var batchManager *batches.Manager
+	if batchesCfg := config.GetBatchesConfig(); batchesCfg.Enabled {
+		if db := config.SqlDb().GetDb(); db != nil {
+			batchManager, err = batches.NewManager(db, batches.Config{
+				Dir:          batchesCfg.Dir,
+				Concurrency:  batchesCfg.Concurrency,
+				MaxItems:     batchesCfg.MaxItems,
+				MaxFileBytes: int64(batchesCfg.MaxFileSizeMB) << 20,
+				MaxAttempts:  batchesCfg.MaxAttempts,
+			})
+			if err != nil {
+				logging.Error("Failed to create the batch inference manager, not starting", types.Server, "error", err)
+				os.Exit(1)
+			}
+		} else {
+			logging.Warn("Batch inference is enabled but no SQL database is available", types.Server)
+		}
+	}
+
+	contentFilter, err := contentfilter.NewPipeline(config.GetContentFilterConfig())
+	if err != nil {
+		logging.Error("Invalid content filter configuration, not starting", types.Server, "error", err)
+		os.Exit(1)
+	}
+
+	publicServer := pserver.NewServer(nodeBroker, config, recorder, trainingExecutor, blockQueue, chainPhaseTracker, payloadStore,
+		pserver.WithArtifactStore(artifactStore), pserver.WithPeerHealth(peerProber), pserver.WithHardwareAttestations(mlnodeBackgroundManager),
+		pserver.WithApiKeys(apiKeys), pserver.WithAuditLog(auditLog), pserver.WithHealthChecks(config.SqlDb().GetDb(), listener),
+		pserver.WithBlockCache(recorder.GetBlockCache()), pserver.WithContentFilter(contentFilter),
+		pserver.WithOnboarding(onboardingManager), pserver.WithInflightInferences(listener.InflightInferences()),
+		pserver.WithBatches(batchManager), pserver.WithEventStream(listener.EventStream()))
 	publicServer.Start(addr)
+	if batchManager != nil {
+		go batchManager.Start(ctx, publicServer)
+	}
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().MLServerPort)
 	logging.Info("start ml server on addr", types.Server, "addr", addr)
@@ -203,9 +380,20 @@ func main() {
 
 	addr = fmt.Sprintf(":%v", config.GetApiConfig().AdminServerPort)
 	logging.Info("start admin server on addr", types.Server, "addr", addr)
-	adminServer := adminserver.NewServer(recorder, nodeBroker, config, validator, blockQueue, payloadStore)
+	adminServer := adminserver.NewServer(recorder, nodeBroker, config, validator, blockQueue, payloadStore, adminserver.WithPeerHealth(peerProber),
+		adminserver.WithModelDownloads(mlnodeBackgroundManager), adminserver.WithApiKeys(apiKeys),
+		adminserver.WithAuditLog(auditLog), adminserver.WithEventReplay(listener),
+		adminserver.WithUpgradeOrchestrator(listener.UpgradeOrchestrator()), adminserver.WithOnboarding(onboardingManager))
 	adminServer.Start(addr)
 
+	if adminGrpcServerPort := config.GetApiConfig().AdminGrpcServerPort; adminGrpcServerPort != 0 {
+		addr = fmt.Sprintf(":%v", adminGrpcServerPort)
+		logging.Info("start admin grpc server on addr", types.Server, "addr", addr)
+		if err := adminServer.StartGrpc(addr); err != nil {
+			log.Fatalf("failed to start admin grpc server: %v", err)
+		}
+	}
+
 	mlGrpcServerPort := config.GetApiConfig().MlGrpcServerPort
 	if mlGrpcServerPort == 0 {
 		mlGrpcServerPort = 9300
@@ -235,6 +423,7 @@ func main() {
 	defer cancelFlush()
 	logging.Info("Flushing config to the DB on app exit", types.Config)
 	_ = config.FlushNow(ctxFlush)
+	_ = shutdownTracing(ctxFlush)
 
 	// Close DB gracefully
 	if db := config.SqlDb().GetDb(); db != nil {
@@ -280,3 +469,73 @@ func getParams(ctx context.Context, transactionRecorder cosmosclient.InferenceCo
 	logging.Error("Exhausted all retries to get chain params", types.System, "error", err)
 	return nil, err
 }
+
+// restoreDatabaseFromBackup restores the latest backup when restore_on_startup is set and the SQLite
+// database is missing, e.g. after the disk was lost. It runs before the database is opened.
+func restoreDatabaseFromBackup() {
+	static, err := apiconfig.LoadStaticConfigManager()
+	if err != nil {
+		log.Fatalf("Error loading config: %v", err)
+	}
+	backupCfg := static.GetBackupConfig()
+	if backupCfg.Backend == "" || !backupCfg.RestoreOnStartup {
+		return
+	}
+	store, err := newBackupStore(backupCfg)
+	if err != nil {
+		log.Fatalf("Invalid backup configuration: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+	key, err := backup.RestoreLatestIfMissing(ctx, store, static.GetSqlitePath())
+	if errors.Is(err, backup.ErrNoBackup) {
+		logging.Warn("No database backup to restore, starting with an empty database", types.Config)
+		return
+	}
+	if err != nil {
+		log.Fatalf("Error restoring database from backup: %v", err)
+	}
+	if key != "" {
+		logging.Info("Database restored from backup", types.Config, "key", key)
+	}
+}
+
+func newBackupStore(cfg apiconfig.BackupConfig) (backup.Store, error) {
+	switch cfg.Backend {
+	case "s3":
+		return backup.NewS3Store(backup.S3Config{
+			Bucket:   cfg.S3.Bucket,
+			Prefix:   cfg.S3.Prefix,
+			Region:   cfg.S3.Region,
+			Endpoint: cfg.S3.Endpoint,
+		})
+	case "dir":
+		return backup.NewDirStore(cfg.Dir)
+	default:
+		return nil, fmt.Errorf("unknown backup backend %q", cfg.Backend)
+	}
+}
+
+// startReplayServers serves the recorded chain node and ML node responses the API is pointed at, in
+// replay mode. They live as long as the process.
+func startReplayServers(cfg apiconfig.ReplayConfig) {
+	if len(cfg.Servers) == 0 {
+		log.Fatalf("Replay mode is enabled but no replay servers are configured")
+	}
+	for _, serverCfg := range cfg.Servers {
+		replayServer, err := replay.NewServer(replay.Config{
+			Name:              serverCfg.Name,
+			ListenAddress:     serverCfg.ListenAddress,
+			Dir:               filepath.Join(cfg.FixturesDir, serverCfg.Name),
+			Upstream:          serverCfg.Upstream,
+			Record:            cfg.Record && serverCfg.Upstream != "",
+			WebsocketInterval: time.Duration(cfg.WebsocketIntervalSeconds) * time.Second,
+		})
+		if err != nil {
+			log.Fatalf("Error creating replay server %s: %v", serverCfg.Name, err)
+		}
+		if err := replayServer.Start(); err != nil {
+			log.Fatalf("Error starting replay server %s: %v", serverCfg.Name, err)
+		}
+	}
+}
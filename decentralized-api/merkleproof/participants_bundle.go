@@ -0,0 +1,89 @@
+package merkleproof
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	cryptotypes "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const ParticipantsBundleVersion = 1
+
+// ParticipantsBundle is everything needed to verify the active participants of an epoch offline:
+// the store value with its merkle proof, the header whose AppHash commits to it with the commit
+// signing that header, and the validator set behind the commit.
+// Verifying a bundle proves the participants were committed by that validator set; whether the
+// validator set itself is trusted is up to the verifier.
+type ParticipantsBundle struct {
+	Version int    `json:"version"`
+	ChainID string `json:"chain_id"`
+	Epoch   uint64 `json:"epoch"`
+	// StoreKey is the module store holding Key
+	StoreKey string `json:"store_key"`
+	Key      []byte `json:"key"`
+	// Value is the proto-encoded types.ActiveParticipants
+	Value    []byte                `json:"value"`
+	ProofOps *cryptotypes.ProofOps `json:"proof_ops"`
+	// SignedHeader is the header of the block after the one Value was read at, as the app hash
+	// of a block is only recorded in the next header
+	SignedHeader *comettypes.SignedHeader `json:"signed_header"`
+	Validators   []*comettypes.Validator  `json:"validators"`
+}
+
+// MarshalParticipantsBundle serializes a bundle in its canonical form (CometBFT JSON)
+func MarshalParticipantsBundle(bundle *ParticipantsBundle) ([]byte, error) {
+	return cmtjson.Marshal(bundle)
+}
+
+func UnmarshalParticipantsBundle(data []byte) (*ParticipantsBundle, error) {
+	var bundle ParticipantsBundle
+	if err := cmtjson.Unmarshal(data, &bundle); err != nil {
+		return nil, err
+	}
+	return &bundle, nil
+}
+
+// VerifyParticipantsBundle checks the commit signatures of the bundle's header against its validator
+// set and the merkle proof of the participants against the header's app hash, and returns the
+// verified participants.
+func VerifyParticipantsBundle(bundle *ParticipantsBundle) (*types.ActiveParticipants, error) {
+	if bundle.Version != ParticipantsBundleVersion {
+		return nil, fmt.Errorf("unsupported bundle version %d", bundle.Version)
+	}
+	if !bytes.Equal(bundle.Key, types.ActiveParticipantsFullKey(bundle.Epoch)) {
+		return nil, fmt.Errorf("bundle key is not the active participants key of epoch %d", bundle.Epoch)
+	}
+	header := bundle.SignedHeader
+	if header == nil || header.Header == nil || header.Commit == nil {
+		return nil, fmt.Errorf("bundle has no signed header")
+	}
+	if err := header.ValidateBasic(bundle.ChainID); err != nil {
+		return nil, fmt.Errorf("invalid signed header: %w", err)
+	}
+
+	valSet, err := comettypes.ValidatorSetFromExistingValidators(bundle.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+	if !bytes.Equal(valSet.Hash(), header.ValidatorsHash) {
+		return nil, fmt.Errorf("validator set does not match the header")
+	}
+	if err := valSet.VerifyCommitLight(bundle.ChainID, header.Commit.BlockID, header.Height, header.Commit); err != nil {
+		return nil, fmt.Errorf("invalid commit signatures: %w", err)
+	}
+
+	keyPath := "/" + bundle.StoreKey + "/" + url.PathEscape(string(bundle.Key))
+	if err := VerifyUsingProofRt(bundle.ProofOps, header.AppHash, keyPath, bundle.Value); err != nil {
+		return nil, fmt.Errorf("invalid participants proof: %w", err)
+	}
+
+	var participants types.ActiveParticipants
+	if err := participants.Unmarshal(bundle.Value); err != nil {
+		return nil, err
+	}
+	return &participants, nil
+}
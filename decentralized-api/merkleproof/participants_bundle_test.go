@@ -0,0 +1,110 @@
+package merkleproof
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	comettypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testChainID = "gonka-test"
+
+// newTestParticipantsBundle commits the participants of an epoch to an in-memory store and signs
+// a header carrying the resulting app hash with a random validator set
+func newTestParticipantsBundle(t *testing.T, epoch uint64, participants types.ActiveParticipants) *ParticipantsBundle {
+	t.Helper()
+	value, err := participants.Marshal()
+	require.NoError(t, err)
+	key := types.ActiveParticipantsFullKey(epoch)
+
+	store := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	storeKey := storetypes.NewKVStoreKey("inference")
+	store.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+	store.GetKVStore(storeKey).Set(key, value)
+	commitID := store.Commit()
+	res, err := store.Query(&storetypes.RequestQuery{Path: "/inference/key", Data: key, Prove: true, Height: commitID.Version})
+	require.NoError(t, err)
+
+	valSet, privVals := comettypes.RandValidatorSet(4, 10)
+	header := &comettypes.Header{
+		Version:         cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:         testChainID,
+		Height:          commitID.Version + 1,
+		Time:            time.Now(),
+		AppHash:         commitID.Hash,
+		ValidatorsHash:  valSet.Hash(),
+		ProposerAddress: valSet.Proposer.Address,
+	}
+	blockID := comettypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: comettypes.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	voteSet := comettypes.NewVoteSet(testChainID, header.Height, 0, cmtproto.PrecommitType, valSet)
+	extCommit, err := comettypes.MakeExtCommit(blockID, header.Height, 0, voteSet, privVals, time.Now(), false)
+	require.NoError(t, err)
+
+	return &ParticipantsBundle{
+		Version:      ParticipantsBundleVersion,
+		ChainID:      testChainID,
+		Epoch:        epoch,
+		StoreKey:     "inference",
+		Key:          key,
+		Value:        res.Value,
+		ProofOps:     res.ProofOps,
+		SignedHeader: &comettypes.SignedHeader{Header: header, Commit: extCommit.ToCommit()},
+		Validators:   valSet.Validators,
+	}
+}
+
+func TestVerifyParticipantsBundle(t *testing.T) {
+	participants := types.ActiveParticipants{
+		EpochId:              7,
+		CreatedAtBlockHeight: 1,
+		Participants:         []*types.ActiveParticipant{{Index: "gonka1participant", Weight: 100}},
+	}
+	bundle := newTestParticipantsBundle(t, 7, participants)
+
+	data, err := MarshalParticipantsBundle(bundle)
+	require.NoError(t, err)
+	decoded, err := UnmarshalParticipantsBundle(data)
+	require.NoError(t, err)
+	verified, err := VerifyParticipantsBundle(decoded)
+	require.NoError(t, err)
+	require.Equal(t, participants.Participants[0].Index, verified.Participants[0].Index)
+	require.Equal(t, int64(100), verified.Participants[0].Weight)
+
+	t.Run("tampered participants", func(t *testing.T) {
+		tampered := *decoded
+		tampered.Value = append([]byte{}, decoded.Value...)
+		tampered.Value[len(tampered.Value)-1]++
+		_, err := VerifyParticipantsBundle(&tampered)
+		require.ErrorContains(t, err, "invalid participants proof")
+	})
+
+	t.Run("key of another epoch", func(t *testing.T) {
+		otherEpoch := *decoded
+		otherEpoch.Epoch = 8
+		_, err := VerifyParticipantsBundle(&otherEpoch)
+		require.ErrorContains(t, err, "not the active participants key")
+	})
+
+	t.Run("foreign validator set", func(t *testing.T) {
+		foreign := *decoded
+		otherValSet, _ := comettypes.RandValidatorSet(4, 10)
+		foreign.Validators = otherValSet.Validators
+		_, err := VerifyParticipantsBundle(&foreign)
+		require.ErrorContains(t, err, "validator set does not match")
+	})
+}
@@ -36,7 +36,8 @@ func NewNodeClient(pocUrl string, inferenceUrl string) *Client {
 		pocUrl:       pocUrl,
 		inferenceUrl: inferenceUrl,
 		client: http.Client{
-			Timeout: 15 * time.Minute,
+			Timeout:   15 * time.Minute,
+			Transport: nodeTransport,
 		},
 		mlGrpcCallbackAddress: "api-private:9300", // TODO: PRTODO: make this configurable
 	}
@@ -100,6 +101,8 @@ type TrainEnv struct {
 	GlobalUniqueID  string `json:"GLOBAL_UNIQUE_ID"`
 	GlobalWorldSize string `json:"GLOBAL_WORLD_SIZE"`
 	BasePort        string `json:"BASE_PORT"`
+	// ResumeFrom is the checkpoint artifact to continue training from, empty for a fresh start
+	ResumeFrom string `json:"RESUME_FROM,omitempty"`
 }
 
 var devTrainConfig = TrainConfig{
@@ -140,12 +143,7 @@ const (
 	defaultTrainingBasePort   = "10001"
 )
 
-func (api *Client) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int) error {
-	requestUrl, err := url.JoinPath(api.pocUrl, trainStartPath)
-	if err != nil {
-		return err
-	}
-
+func newStartTraining(taskId uint64, participant string, nodeId string, masterNodeAddr string, storeApiUrl string, rank int, worldSize int, resumeFrom string) StartTraining {
 	globalNodeId := training.GlobalNodeId{
 		Participant: participant,
 		LocalNodeId: nodeId,
@@ -153,20 +151,29 @@ func (api *Client) StartTraining(ctx context.Context, taskId uint64, participant
 	trainEnv := TrainEnv{
 		TaskId:          strconv.FormatUint(taskId, 10),
 		NodeId:          globalNodeId.ToString(),
-		StoreApiUrl:     api.mlGrpcCallbackAddress,
+		StoreApiUrl:     storeApiUrl,
 		GlobalAddr:      masterNodeAddr,
 		GlobalPort:      defaultGlobalTrainingPort,
 		GlobalRank:      strconv.Itoa(rank),
 		GlobalUniqueID:  strconv.Itoa(rank),
 		GlobalWorldSize: strconv.Itoa(worldSize),
 		BasePort:        defaultTrainingBasePort,
+		ResumeFrom:      resumeFrom,
 	}
-	body := StartTraining{
+	return StartTraining{
 		TrainConfig: devTrainConfig,
 		TrainEnv:    trainEnv,
 	}
+}
+
+func (api *Client) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error {
+	requestUrl, err := url.JoinPath(api.pocUrl, trainStartPath)
+	if err != nil {
+		return err
+	}
 
-	logging.Info("Starting training with", types.Training, "trainEnv", trainEnv)
+	body := newStartTraining(taskId, participant, nodeId, masterNodeAddr, api.mlGrpcCallbackAddress, rank, worldSize, resumeFrom)
+	logging.Info("Starting training with", types.Training, "trainEnv", body.TrainEnv)
 	_, err = utils.SendPostJsonRequest(ctx, &api.client, requestUrl, body)
 	if err != nil {
 		return err
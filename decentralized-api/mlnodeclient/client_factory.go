@@ -4,6 +4,8 @@ import "sync"
 
 type ClientFactory interface {
 	CreateClient(pocUrl string, inferenceUrl string) MLNodeClient
+	// CreateGrpcClient creates a client for nodes whose control API is served over gRPC at grpcAddress (host:port)
+	CreateGrpcClient(grpcAddress string, version string, inferenceUrl string) MLNodeClient
 }
 
 type HttpClientFactory struct{}
@@ -12,6 +14,10 @@ func (f *HttpClientFactory) CreateClient(pocUrl string, inferenceUrl string) MLN
 	return NewNodeClient(pocUrl, inferenceUrl)
 }
 
+func (f *HttpClientFactory) CreateGrpcClient(grpcAddress string, version string, inferenceUrl string) MLNodeClient {
+	return NewGrpcClient(grpcAddress, version, inferenceUrl)
+}
+
 type MockClientFactory struct {
 	mu      sync.RWMutex
 	clients map[string]*MockClient
@@ -39,6 +45,11 @@ func (f *MockClientFactory) CreateClient(pocUrl string, inferenceUrl string) MLN
 	return client
 }
 
+// CreateGrpcClient returns the mock client keyed by the gRPC address, so tests can look it up the same way
+func (f *MockClientFactory) CreateGrpcClient(grpcAddress string, version string, inferenceUrl string) MLNodeClient {
+	return f.CreateClient(grpcAddress, inferenceUrl)
+}
+
 func (f *MockClientFactory) GetClientForNode(pocUrl string) *MockClient {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
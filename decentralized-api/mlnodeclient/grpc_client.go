@@ -0,0 +1,361 @@
+package mlnodeclient
+
+import (
+	"context"
+	"decentralized-api/internal/nodeaddr"
+	"decentralized-api/logging"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// gRPC methods of the ML node control service. Messages are the JSON DTOs of the HTTP API,
+// sent with the "json" codec (content-type application/grpc+json), so no generated stubs are needed.
+const (
+	grpcServicePrefix = "/mlnode.v1.MLNode/"
+
+	grpcStartTraining     = grpcServicePrefix + "StartTraining"
+	grpcTrainingStatus    = grpcServicePrefix + "TrainingStatus"
+	grpcStop              = grpcServicePrefix + "Stop"
+	grpcNodeState         = grpcServicePrefix + "NodeState"
+	grpcInitGenerateV1    = grpcServicePrefix + "InitGenerateV1"
+	grpcInitValidateV1    = grpcServicePrefix + "InitValidateV1"
+	grpcValidateBatchesV1 = grpcServicePrefix + "ValidateBatchesV1"
+	grpcPowStatusV1       = grpcServicePrefix + "PowStatusV1"
+	grpcInitGenerateV2    = grpcServicePrefix + "InitGenerateV2"
+	grpcGenerateV2        = grpcServicePrefix + "GenerateV2"
+	grpcPowStatusV2       = grpcServicePrefix + "PowStatusV2"
+	grpcStopPowV2         = grpcServicePrefix + "StopPowV2"
+	grpcInferenceUp       = grpcServicePrefix + "InferenceUp"
+	grpcGPUDevices        = grpcServicePrefix + "GPUDevices"
+	grpcGPUDriver         = grpcServicePrefix + "GPUDriver"
+	grpcModelStatus       = grpcServicePrefix + "ModelStatus"
+	grpcDownloadModel     = grpcServicePrefix + "DownloadModel"
+	grpcVerifyModel       = grpcServicePrefix + "VerifyModel"
+	grpcDeleteModel       = grpcServicePrefix + "DeleteModel"
+	grpcListModels        = grpcServicePrefix + "ListModels"
+	grpcDiskSpace         = grpcServicePrefix + "DiskSpace"
+
+	// grpcVersionHeader carries the ML node version that HTTP clients put in the URL path
+	grpcVersionHeader = "mlnode-version"
+
+	// validateBatchChunkSize is the number of nonces per message when streaming a V1 batch
+	validateBatchChunkSize = 1000
+	grpcMaxMessageSize     = 64 * 1024 * 1024
+)
+
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v any) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                       { return "json" }
+
+// grpcConns shares one connection per ML node address, like nodeTransport does for HTTP
+var grpcConns = struct {
+	sync.Mutex
+	byTarget map[string]*grpc.ClientConn
+}{byTarget: make(map[string]*grpc.ClientConn)}
+
+func grpcConn(target string) (*grpc.ClientConn, error) {
+	grpcConns.Lock()
+	defer grpcConns.Unlock()
+	if conn, ok := grpcConns.byTarget[target]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.NewClient(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(
+			grpc.ForceCodec(jsonCodec{}),
+			grpc.MaxCallRecvMsgSize(grpcMaxMessageSize),
+			grpc.MaxCallSendMsgSize(grpcMaxMessageSize),
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	grpcConns.byTarget[target] = conn
+	return conn, nil
+}
+
+// dropGrpcConn closes the shared connection after the node became unreachable, so the next client
+// dials the address again (after a fresh SRV lookup)
+func dropGrpcConn(target string) {
+	grpcConns.Lock()
+	defer grpcConns.Unlock()
+	if conn, ok := grpcConns.byTarget[target]; ok {
+		_ = conn.Close()
+		delete(grpcConns.byTarget, target)
+	}
+	nodeaddr.Default.InvalidateAddress(target)
+}
+
+// GrpcClient talks to the ML node control API over gRPC. The inference server (vLLM) only speaks
+// HTTP, so inference health and loaded models still go through the HTTP client.
+type GrpcClient struct {
+	target                string
+	version               string
+	inference             *Client
+	mlGrpcCallbackAddress string
+}
+
+// Ensure GrpcClient implements MLNodeClient
+var _ MLNodeClient = (*GrpcClient)(nil)
+
+// NewGrpcClient creates a client for the ML node gRPC server at target (host:port).
+// version is sent as the mlnode-version header for the version proxy.
+func NewGrpcClient(target string, version string, inferenceUrl string) *GrpcClient {
+	inference := NewNodeClient("", inferenceUrl)
+	return &GrpcClient{
+		target:                target,
+		version:               version,
+		inference:             inference,
+		mlGrpcCallbackAddress: inference.mlGrpcCallbackAddress,
+	}
+}
+
+func (c *GrpcClient) outgoingContext(ctx context.Context) context.Context {
+	if c.version == "" {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, grpcVersionHeader, c.version)
+}
+
+func (c *GrpcClient) invoke(ctx context.Context, method string, req any, resp any) error {
+	conn, err := grpcConn(c.target)
+	if err != nil {
+		return err
+	}
+	if req == nil {
+		req = struct{}{}
+	}
+	if resp == nil {
+		resp = &struct{}{}
+	}
+	err = conn.Invoke(c.outgoingContext(ctx), method, req, resp)
+	return c.convertError(method, err)
+}
+
+// convertError maps gRPC status codes to the errors the HTTP client returns for the same conditions
+func (c *GrpcClient) convertError(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.Unimplemented:
+		return NewAPINotImplementedError(method, http.StatusNotImplemented)
+	case codes.Unavailable:
+		logging.Warn("ML node gRPC server unavailable", types.Nodes, "target", c.target, "method", method, "error", st.Message())
+		dropGrpcConn(c.target)
+	}
+	return fmt.Errorf("%s failed: %w", method, err)
+}
+
+func (c *GrpcClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error {
+	body := newStartTraining(taskId, participant, nodeId, masterNodeAddr, c.mlGrpcCallbackAddress, rank, worldSize, resumeFrom)
+	logging.Info("Starting training with", types.Training, "trainEnv", body.TrainEnv)
+	return c.invoke(ctx, grpcStartTraining, body, nil)
+}
+
+func (c *GrpcClient) GetTrainingStatus(ctx context.Context) error {
+	return c.invoke(ctx, grpcTrainingStatus, nil, nil)
+}
+
+func (c *GrpcClient) Stop(ctx context.Context) error {
+	return c.invoke(ctx, grpcStop, nil, nil)
+}
+
+func (c *GrpcClient) NodeState(ctx context.Context) (*StateResponse, error) {
+	var resp StateResponse
+	if err := c.invoke(ctx, grpcNodeState, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) InitGenerateV1(ctx context.Context, dto InitDtoV1) error {
+	return c.invoke(ctx, grpcInitGenerateV1, dto, nil)
+}
+
+func (c *GrpcClient) InitValidateV1(ctx context.Context, dto InitDtoV1) error {
+	return c.invoke(ctx, grpcInitValidateV1, dto, nil)
+}
+
+// ValidateBatchV1 streams the batch in chunks of validateBatchChunkSize nonces instead of one large message.
+// The ML node validates the chunks as one batch once the stream is closed.
+func (c *GrpcClient) ValidateBatchV1(ctx context.Context, batch ProofBatchV1) error {
+	if len(batch.Nonces) != len(batch.Dist) {
+		return errors.New("ValidateBatchV1: nonces and dist have different lengths")
+	}
+	conn, err := grpcConn(c.target)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(c.outgoingContext(ctx))
+	defer cancel()
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{StreamName: "ValidateBatchesV1", ClientStreams: true}, grpcValidateBatchesV1)
+	if err != nil {
+		return c.convertError(grpcValidateBatchesV1, err)
+	}
+
+	for start := 0; start == 0 || start < len(batch.Nonces); start += validateBatchChunkSize {
+		end := min(start+validateBatchChunkSize, len(batch.Nonces))
+		chunk := batch
+		chunk.Nonces = batch.Nonces[start:end]
+		chunk.Dist = batch.Dist[start:end]
+		if err := stream.SendMsg(chunk); err != nil {
+			// the actual error is returned by RecvMsg
+			break
+		}
+	}
+	if err := stream.CloseSend(); err != nil {
+		return c.convertError(grpcValidateBatchesV1, err)
+	}
+	return c.convertError(grpcValidateBatchesV1, stream.RecvMsg(&struct{}{}))
+}
+
+func (c *GrpcClient) GetPowStatusV1(ctx context.Context) (*PowStatusResponseV1, error) {
+	var resp PowStatusResponseV1
+	if err := c.invoke(ctx, grpcPowStatusV1, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) InitGenerateV2(ctx context.Context, req PoCInitGenerateRequestV2) (*PoCInitGenerateResponseV2, error) {
+	var resp PoCInitGenerateResponseV2
+	if err := c.invoke(ctx, grpcInitGenerateV2, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) GenerateV2(ctx context.Context, req PoCGenerateRequestV2) (*PoCGenerateResponseV2, error) {
+	var resp PoCGenerateResponseV2
+	if err := c.invoke(ctx, grpcGenerateV2, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) GetPowStatusV2(ctx context.Context) (*PoCStatusResponseV2, error) {
+	var resp PoCStatusResponseV2
+	if err := c.invoke(ctx, grpcPowStatusV2, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) StopPowV2(ctx context.Context) (*PoCStopResponseV2, error) {
+	var resp PoCStopResponseV2
+	if err := c.invoke(ctx, grpcStopPowV2, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) InferenceHealth(ctx context.Context) (bool, error) {
+	return c.inference.InferenceHealth(ctx)
+}
+
+func (c *GrpcClient) InferenceUp(ctx context.Context, model string, args []string) error {
+	dto := inferenceUpDto{
+		Model: model,
+		Dtype: "float16",
+		Args:  args,
+	}
+	logging.Info("Sending InferenceUp request to node", types.PoC, "target", c.target, "body", dto)
+	err := c.invoke(ctx, grpcInferenceUp, dto, nil)
+	if err != nil {
+		logging.Error("Failed to send InferenceUp request", types.PoC, "error", err, "target", c.target, "inferenceUpDto", dto)
+	}
+	return err
+}
+
+func (c *GrpcClient) GetLoadedModels(ctx context.Context) ([]string, error) {
+	return c.inference.GetLoadedModels(ctx)
+}
+
+func (c *GrpcClient) GetGPUDevices(ctx context.Context) (*GPUDevicesResponse, error) {
+	var resp GPUDevicesResponse
+	if err := c.invoke(ctx, grpcGPUDevices, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) GetGPUDriver(ctx context.Context) (*DriverInfo, error) {
+	var resp DriverInfo
+	if err := c.invoke(ctx, grpcGPUDriver, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) CheckModelStatus(ctx context.Context, model Model) (*ModelStatusResponse, error) {
+	var resp ModelStatusResponse
+	if err := c.invoke(ctx, grpcModelStatus, model, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) DownloadModel(ctx context.Context, model Model, opts DownloadOptions) (*DownloadStartResponse, error) {
+	var resp DownloadStartResponse
+	body := downloadModelRequest{Model: model, MaxBandwidthMbps: opts.MaxBandwidthMbps}
+	if err := c.invoke(ctx, grpcDownloadModel, body, &resp); err != nil {
+		switch status.Code(err) {
+		case codes.AlreadyExists:
+			return nil, fmt.Errorf("model is already downloading")
+		case codes.ResourceExhausted:
+			return nil, fmt.Errorf("maximum concurrent downloads reached")
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) VerifyModel(ctx context.Context, model Model) (*ModelVerifyResponse, error) {
+	var resp ModelVerifyResponse
+	if err := c.invoke(ctx, grpcVerifyModel, model, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) DeleteModel(ctx context.Context, model Model) (*DeleteResponse, error) {
+	var resp DeleteResponse
+	if err := c.invoke(ctx, grpcDeleteModel, model, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) ListModels(ctx context.Context) (*ModelListResponse, error) {
+	var resp ModelListResponse
+	if err := c.invoke(ctx, grpcListModels, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *GrpcClient) GetDiskSpace(ctx context.Context) (*DiskSpaceInfo, error) {
+	var resp DiskSpaceInfo
+	if err := c.invoke(ctx, grpcDiskSpace, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,109 @@
+package mlnodeclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeMLNodeServer answers the JSON-coded ML node gRPC methods without generated stubs
+type fakeMLNodeServer struct {
+	versions []string
+	chunks   []ProofBatchV1
+}
+
+func (s *fakeMLNodeServer) handle(_ any, stream grpc.ServerStream) error {
+	method, _ := grpc.MethodFromServerStream(stream)
+	if md, ok := metadata.FromIncomingContext(stream.Context()); ok {
+		s.versions = append(s.versions, md.Get(grpcVersionHeader)...)
+	}
+
+	switch method {
+	case grpcNodeState:
+		var req struct{}
+		if err := stream.RecvMsg(&req); err != nil {
+			return err
+		}
+		return stream.SendMsg(StateResponse{State: MlNodeState_INFERENCE})
+	case grpcValidateBatchesV1:
+		for {
+			var chunk ProofBatchV1
+			err := stream.RecvMsg(&chunk)
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			s.chunks = append(s.chunks, chunk)
+		}
+		return stream.SendMsg(struct{}{})
+	default:
+		return status.Error(codes.Unimplemented, "unknown method")
+	}
+}
+
+func startFakeMLNodeServer(t *testing.T) (*fakeMLNodeServer, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	fake := &fakeMLNodeServer{}
+	server := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}), grpc.UnknownServiceHandler(fake.handle))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(func() {
+		server.Stop()
+		dropGrpcConn(listener.Addr().String())
+	})
+	return fake, listener.Addr().String()
+}
+
+func TestGrpcClient_NodeState(t *testing.T) {
+	fake, target := startFakeMLNodeServer(t)
+	client := NewGrpcClient(target, "v3.0.8", "http://127.0.0.1:1")
+
+	state, err := client.NodeState(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, MlNodeState_INFERENCE, state.State)
+	require.Equal(t, []string{"v3.0.8"}, fake.versions)
+}
+
+func TestGrpcClient_ValidateBatchV1StreamsChunks(t *testing.T) {
+	fake, target := startFakeMLNodeServer(t)
+	client := NewGrpcClient(target, "", "http://127.0.0.1:1")
+
+	batch := ProofBatchV1{PublicKey: "pk", BlockHash: "hash", BlockHeight: 10, NodeNum: 2}
+	for i := 0; i < 2*validateBatchChunkSize+5; i++ {
+		batch.Nonces = append(batch.Nonces, int64(i))
+		batch.Dist = append(batch.Dist, float64(i)/10)
+	}
+	require.NoError(t, client.ValidateBatchV1(context.Background(), batch))
+
+	require.Len(t, fake.chunks, 3)
+	var nonces []int64
+	var dist []float64
+	for _, chunk := range fake.chunks {
+		require.Equal(t, "pk", chunk.PublicKey)
+		require.Equal(t, uint64(2), chunk.NodeNum)
+		nonces = append(nonces, chunk.Nonces...)
+		dist = append(dist, chunk.Dist...)
+	}
+	require.Equal(t, batch.Nonces, nonces)
+	require.Equal(t, batch.Dist, dist)
+	require.Empty(t, fake.versions)
+}
+
+func TestGrpcClient_UnimplementedIsAPINotImplemented(t *testing.T) {
+	_, target := startFakeMLNodeServer(t)
+	client := NewGrpcClient(target, "", "http://127.0.0.1:1")
+
+	_, err := client.GetGPUDevices(context.Background())
+	require.ErrorIs(t, err, &ErrAPINotImplemented{})
+}
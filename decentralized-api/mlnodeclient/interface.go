@@ -5,7 +5,7 @@ import "context"
 // MLNodeClient defines the interface for interacting with ML nodes
 type MLNodeClient interface {
 	// Training operations
-	StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int) error
+	StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error
 	GetTrainingStatus(ctx context.Context) error
 
 	// Node state operations
@@ -35,7 +35,8 @@ type MLNodeClient interface {
 
 	// Model management operations
 	CheckModelStatus(ctx context.Context, model Model) (*ModelStatusResponse, error)
-	DownloadModel(ctx context.Context, model Model) (*DownloadStartResponse, error)
+	DownloadModel(ctx context.Context, model Model, opts DownloadOptions) (*DownloadStartResponse, error)
+	VerifyModel(ctx context.Context, model Model) (*ModelVerifyResponse, error)
 	DeleteModel(ctx context.Context, model Model) (*DeleteResponse, error)
 	ListModels(ctx context.Context) (*ModelListResponse, error)
 	GetDiskSpace(ctx context.Context) (*DiskSpaceInfo, error)
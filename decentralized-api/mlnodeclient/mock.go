@@ -24,6 +24,7 @@ type MockClient struct {
 	// Model management state
 	CachedModels      map[string]ModelListItem // key: hf_repo:hf_commit
 	DownloadingModels map[string]*DownloadProgress
+	CorruptedModels   map[string][]string // key: hf_repo:hf_commit, value: mismatched files
 	DiskSpace         *DiskSpaceInfo
 
 	// Error injection
@@ -36,6 +37,7 @@ type MockClient struct {
 	GetGPUDriverError     error
 	CheckModelStatusError error
 	DownloadModelError    error
+	VerifyModelError      error
 	DeleteModelError      error
 	ListModelsError       error
 	GetDiskSpaceError     error
@@ -54,6 +56,7 @@ type MockClient struct {
 	GetGPUDriverCalled     int
 	CheckModelStatusCalled int
 	DownloadModelCalled    int
+	VerifyModelCalled      int
 	DeleteModelCalled      int
 	ListModelsCalled       int
 	GetDiskSpaceCalled     int
@@ -89,9 +92,12 @@ type MockClient struct {
 		MasterNodeAddr string
 		Rank           int
 		WorldSize      int
+		ResumeFrom     string
 	}
 	LastModelStatusCheck *Model
 	LastModelDownload    *Model
+	LastDownloadOptions  DownloadOptions
+	LastModelVerify      *Model
 	LastModelDelete      *Model
 }
 
@@ -104,6 +110,7 @@ func NewMockClient() *MockClient {
 		GPUDevices:         []GPUDevice{},
 		CachedModels:       make(map[string]ModelListItem),
 		DownloadingModels:  make(map[string]*DownloadProgress),
+		CorruptedModels:    make(map[string][]string),
 	}
 }
 
@@ -153,6 +160,7 @@ func (m *MockClient) Reset() {
 	m.DriverInfo = nil
 	m.CachedModels = make(map[string]ModelListItem)
 	m.DownloadingModels = make(map[string]*DownloadProgress)
+	m.CorruptedModels = make(map[string][]string)
 	m.DiskSpace = nil
 
 	m.StopError = nil
@@ -164,6 +172,7 @@ func (m *MockClient) Reset() {
 	m.GetGPUDriverError = nil
 	m.CheckModelStatusError = nil
 	m.DownloadModelError = nil
+	m.VerifyModelError = nil
 	m.DeleteModelError = nil
 	m.ListModelsError = nil
 	m.GetDiskSpaceError = nil
@@ -182,6 +191,7 @@ func (m *MockClient) Reset() {
 	m.GetGPUDriverCalled = 0
 	m.CheckModelStatusCalled = 0
 	m.DownloadModelCalled = 0
+	m.VerifyModelCalled = 0
 	m.DeleteModelCalled = 0
 	m.ListModelsCalled = 0
 	m.GetDiskSpaceCalled = 0
@@ -203,9 +213,12 @@ func (m *MockClient) Reset() {
 		MasterNodeAddr string
 		Rank           int
 		WorldSize      int
+		ResumeFrom     string
 	}{}
 	m.LastModelStatusCheck = nil
 	m.LastModelDownload = nil
+	m.LastDownloadOptions = DownloadOptions{}
+	m.LastModelVerify = nil
 	m.LastModelDelete = nil
 	m.PowStatusV1 = ""
 	m.PowStatusV2 = ""
@@ -269,7 +282,7 @@ func (m *MockClient) GetLoadedModels(ctx context.Context) ([]string, error) {
 	return nil, nil
 }
 
-func (m *MockClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int) error {
+func (m *MockClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error {
 	m.Mu.Lock()
 	defer m.Mu.Unlock()
 	m.StartTrainingCalled++
@@ -279,6 +292,7 @@ func (m *MockClient) StartTraining(ctx context.Context, taskId uint64, participa
 	m.LastTrainingParams.MasterNodeAddr = masterNodeAddr
 	m.LastTrainingParams.Rank = rank
 	m.LastTrainingParams.WorldSize = worldSize
+	m.LastTrainingParams.ResumeFrom = resumeFrom
 	if m.StartTrainingError != nil {
 		return m.StartTrainingError
 	}
@@ -424,11 +438,12 @@ func (m *MockClient) CheckModelStatus(ctx context.Context, model Model) (*ModelS
 	}, nil
 }
 
-func (m *MockClient) DownloadModel(ctx context.Context, model Model) (*DownloadStartResponse, error) {
+func (m *MockClient) DownloadModel(ctx context.Context, model Model, opts DownloadOptions) (*DownloadStartResponse, error) {
 	m.Mu.Lock()
 	defer m.Mu.Unlock()
 	m.DownloadModelCalled++
 	m.LastModelDownload = &model
+	m.LastDownloadOptions = opts
 	if m.DownloadModelError != nil {
 		return nil, m.DownloadModelError
 	}
@@ -448,6 +463,37 @@ func (m *MockClient) DownloadModel(ctx context.Context, model Model) (*DownloadS
 	}, nil
 }
 
+func (m *MockClient) VerifyModel(ctx context.Context, model Model) (*ModelVerifyResponse, error) {
+	m.Mu.Lock()
+	defer m.Mu.Unlock()
+	m.VerifyModelCalled++
+	m.LastModelVerify = &model
+	if m.VerifyModelError != nil {
+		return nil, m.VerifyModelError
+	}
+
+	key := getModelKey(model)
+	commit := ""
+	if model.HfCommit != nil {
+		commit = *model.HfCommit
+	}
+
+	if files, ok := m.CorruptedModels[key]; ok {
+		return &ModelVerifyResponse{
+			Model:           model,
+			Valid:           false,
+			Commit:          commit,
+			MismatchedFiles: files,
+		}, nil
+	}
+
+	return &ModelVerifyResponse{
+		Model:  model,
+		Valid:  true,
+		Commit: commit,
+	}, nil
+}
+
 func (m *MockClient) DeleteModel(ctx context.Context, model Model) (*DeleteResponse, error) {
 	m.Mu.Lock()
 	defer m.Mu.Unlock()
@@ -468,6 +514,7 @@ func (m *MockClient) DeleteModel(ctx context.Context, model Model) (*DeleteRespo
 
 	// Remove from cache
 	delete(m.CachedModels, key)
+	delete(m.CorruptedModels, key)
 
 	return &DeleteResponse{
 		Status: status,
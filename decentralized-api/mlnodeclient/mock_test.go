@@ -184,7 +184,7 @@ func TestMockClient_DownloadModel(t *testing.T) {
 		mock := NewMockClient()
 		model := Model{HfRepo: "test/model", HfCommit: nil}
 
-		resp, err := mock.DownloadModel(ctx, model)
+		resp, err := mock.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -208,7 +208,7 @@ func TestMockClient_DownloadModel(t *testing.T) {
 		commit := "abc123"
 		model := Model{HfRepo: "test/model", HfCommit: &commit}
 
-		resp, err := mock.DownloadModel(ctx, model)
+		resp, err := mock.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -223,7 +223,7 @@ func TestMockClient_DownloadModel(t *testing.T) {
 		mock.DownloadModelError = errors.New("download error")
 		model := Model{HfRepo: "test/model"}
 
-		_, err := mock.DownloadModel(ctx, model)
+		_, err := mock.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
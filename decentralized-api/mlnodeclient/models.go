@@ -13,6 +13,7 @@ import (
 const (
 	modelStatusPath   = "/api/v1/models/status"
 	modelDownloadPath = "/api/v1/models/download"
+	modelVerifyPath   = "/api/v1/models/verify"
 	modelDeletePath   = "/api/v1/models"
 	modelListPath     = "/api/v1/models/list"
 	modelSpacePath    = "/api/v1/models/space"
@@ -54,13 +55,14 @@ func (api *Client) CheckModelStatus(ctx context.Context, model Model) (*ModelSta
 // Returns 409 Conflict if model is already downloading.
 // Returns 429 Too Many Requests if concurrent download limit (3) is reached.
 // Returns ErrAPINotImplemented if the ML node doesn't support this endpoint.
-func (api *Client) DownloadModel(ctx context.Context, model Model) (*DownloadStartResponse, error) {
+func (api *Client) DownloadModel(ctx context.Context, model Model, opts DownloadOptions) (*DownloadStartResponse, error) {
 	requestURL, err := url.JoinPath(api.pocUrl, modelDownloadPath)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := utils.SendPostJsonRequest(ctx, &api.client, requestURL, model)
+	body := downloadModelRequest{Model: model, MaxBandwidthMbps: opts.MaxBandwidthMbps}
+	resp, err := utils.SendPostJsonRequest(ctx, &api.client, requestURL, body)
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +92,37 @@ func (api *Client) DownloadModel(ctx context.Context, model Model) (*DownloadSta
 	return &downloadResp, nil
 }
 
+// VerifyModel checks the cached files of a downloaded model against its revision.
+// The ML node compares the cached commit with hf_commit and the file checksums with the revision's.
+// Returns ErrAPINotImplemented if the ML node doesn't support this endpoint.
+func (api *Client) VerifyModel(ctx context.Context, model Model) (*ModelVerifyResponse, error) {
+	requestURL, err := url.JoinPath(api.pocUrl, modelVerifyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := utils.SendPostJsonRequest(ctx, &api.client, requestURL, model)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusMethodNotAllowed {
+		return nil, NewAPINotImplementedError(modelVerifyPath, resp.StatusCode)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var verifyResp ModelVerifyResponse
+	if err := json.NewDecoder(resp.Body).Decode(&verifyResp); err != nil {
+		return nil, err
+	}
+
+	return &verifyResp, nil
+}
+
 // DeleteModel deletes a model from cache or cancels an ongoing download.
 // If hf_commit is provided, only that specific revision is deleted.
 // If hf_commit is nil, all versions of the model are deleted.
@@ -169,7 +169,7 @@ func TestClient_DownloadModel(t *testing.T) {
 		defer server.Close()
 
 		client := NewNodeClient(server.URL, "")
-		resp, err := client.DownloadModel(ctx, model)
+		resp, err := client.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err != nil {
 			t.Fatalf("unexpected error: %v", err)
@@ -182,6 +182,29 @@ func TestClient_DownloadModel(t *testing.T) {
 		}
 	})
 
+	t.Run("bandwidth limit is sent with the model", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("failed to decode body: %v", err)
+			}
+			if body["hf_repo"] != "test/model" {
+				t.Errorf("expected hf_repo test/model, got %v", body["hf_repo"])
+			}
+			if body["max_bandwidth_mbps"] != float64(200) {
+				t.Errorf("expected max_bandwidth_mbps 200, got %v", body["max_bandwidth_mbps"])
+			}
+			w.WriteHeader(http.StatusAccepted)
+			json.NewEncoder(w).Encode(DownloadStartResponse{Status: ModelStatusDownloading})
+		}))
+		defer server.Close()
+
+		client := NewNodeClient(server.URL, "")
+		if _, err := client.DownloadModel(ctx, Model{HfRepo: "test/model"}, DownloadOptions{MaxBandwidthMbps: 200}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
 	t.Run("already downloading - conflict", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusConflict)
@@ -190,7 +213,7 @@ func TestClient_DownloadModel(t *testing.T) {
 
 		client := NewNodeClient(server.URL, "")
 		model := Model{HfRepo: "test/model"}
-		_, err := client.DownloadModel(ctx, model)
+		_, err := client.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -208,7 +231,7 @@ func TestClient_DownloadModel(t *testing.T) {
 
 		client := NewNodeClient(server.URL, "")
 		model := Model{HfRepo: "test/model"}
-		_, err := client.DownloadModel(ctx, model)
+		_, err := client.DownloadModel(ctx, model, DownloadOptions{})
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
@@ -226,7 +249,70 @@ func TestClient_DownloadModel(t *testing.T) {
 
 		client := NewNodeClient(server.URL, "")
 		model := Model{HfRepo: "test/model"}
-		_, err := client.DownloadModel(ctx, model)
+		_, err := client.DownloadModel(ctx, model, DownloadOptions{})
+
+		if err == nil {
+			t.Fatal("expected error, got nil")
+		}
+		if !isErrAPINotImplemented(err) {
+			t.Errorf("expected ErrAPINotImplemented, got %T", err)
+		}
+	})
+}
+
+func TestClient_VerifyModel(t *testing.T) {
+	ctx := context.Background()
+	commit := "abc123"
+
+	t.Run("mismatched files are reported", func(t *testing.T) {
+		model := Model{HfRepo: "meta-llama/Llama-2-7b-hf", HfCommit: &commit}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1/models/verify" {
+				t.Errorf("expected path /api/v1/models/verify, got %s", r.URL.Path)
+			}
+			if r.Method != http.MethodPost {
+				t.Errorf("expected POST method, got %s", r.Method)
+			}
+
+			var reqModel Model
+			json.NewDecoder(r.Body).Decode(&reqModel)
+			if reqModel.HfCommit == nil || *reqModel.HfCommit != commit {
+				t.Errorf("expected commit %s in request", commit)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ModelVerifyResponse{
+				Model:           model,
+				Valid:           false,
+				Commit:          commit,
+				MismatchedFiles: []string{"config.json"},
+			})
+		}))
+		defer server.Close()
+
+		client := NewNodeClient(server.URL, "")
+		resp, err := client.VerifyModel(ctx, model)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp.Valid {
+			t.Error("expected model to be invalid")
+		}
+		if len(resp.MismatchedFiles) != 1 || resp.MismatchedFiles[0] != "config.json" {
+			t.Errorf("expected config.json to be mismatched, got %v", resp.MismatchedFiles)
+		}
+	})
+
+	t.Run("API not implemented", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewNodeClient(server.URL, "")
+		_, err := client.VerifyModel(ctx, Model{HfRepo: "test/model", HfCommit: &commit})
 
 		if err == nil {
 			t.Fatal("expected error, got nil")
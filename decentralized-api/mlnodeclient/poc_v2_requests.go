@@ -27,7 +27,8 @@ type PoCInitGenerateRequestV2 struct {
 	NodeCount   int         `json:"node_count"`
 	Params      PoCParamsV2 `json:"params"`
 	URL         string      `json:"url,omitempty"`
-	// batch_size is intentionally omitted - MLNode will use its default
+	// BatchSize is the number of nonces per generated batch, MLNode uses its default when 0
+	BatchSize int `json:"batch_size,omitempty"`
 }
 
 // PoCGenerateRequestV2 represents the request body for /api/v1/inference/pow/generate.
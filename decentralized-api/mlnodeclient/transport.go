@@ -0,0 +1,38 @@
+package mlnodeclient
+
+import (
+	"decentralized-api/internal/nodeaddr"
+	"errors"
+	"net"
+	"net/http"
+)
+
+// reresolvingTransport drops the cached SRV answer for a node when a connection to it fails,
+// so the next client built for the node looks its address up again
+type reresolvingTransport struct {
+	base     http.RoundTripper
+	resolver *nodeaddr.Resolver
+}
+
+// nodeTransport is shared by all ML node clients to keep connection pooling across client instances
+var nodeTransport = &reresolvingTransport{
+	base:     http.DefaultTransport,
+	resolver: nodeaddr.Default,
+}
+
+func (t *reresolvingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil && isConnectError(err) {
+		t.resolver.InvalidateAddress(req.URL.Host)
+	}
+	return resp, err
+}
+
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
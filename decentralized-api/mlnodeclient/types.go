@@ -53,6 +53,18 @@ type ModelStatusResponse struct {
 	ErrorMessage *string           `json:"error_message"`
 }
 
+// DownloadOptions tune a model download on the ML node
+type DownloadOptions struct {
+	// MaxBandwidthMbps limits the download rate, 0 means unlimited
+	MaxBandwidthMbps int
+}
+
+// downloadModelRequest is the download request body: the model fields plus the download options
+type downloadModelRequest struct {
+	Model
+	MaxBandwidthMbps int `json:"max_bandwidth_mbps,omitempty"`
+}
+
 type DownloadStartResponse struct {
 	TaskId string      `json:"task_id"`
 	Status ModelStatus `json:"status"`
@@ -73,6 +85,17 @@ type ModelListResponse struct {
 	Models []ModelListItem `json:"models"`
 }
 
+// ModelVerifyResponse is the result of checking the cached files of a model against the requested revision
+type ModelVerifyResponse struct {
+	Model Model `json:"model"`
+	Valid bool  `json:"valid"`
+	// Commit is the revision found in the cache
+	Commit string `json:"commit"`
+	// MismatchedFiles lists the files whose checksum doesn't match the revision
+	MismatchedFiles []string `json:"mismatched_files"`
+	ErrorMessage    *string  `json:"error_message"`
+}
+
 type DiskSpaceInfo struct {
 	CacheSizeGB float64 `json:"cache_size_gb"`
 	AvailableGB float64 `json:"available_gb"`
@@ -101,14 +101,14 @@ func RegisterParticipantIfNeeded(recorder cosmosclient.CosmosMessageClient, conf
 }
 
 func registerJoiningParticipant(recorder cosmosclient.CosmosMessageClient, configManager *apiconfig.ConfigManager) error {
-	if exists, err := participantExistsWithWait(recorder, configManager.GetChainNodeConfig().Url); exists {
+	if exists, err := participantExistsWithWait(recorder, configManager.GetChainEndpoints().Current()); exists {
 		logging.Info("Participant already exists, skipping registration", types.Participants)
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("Failed to check if participant exists: %w", err)
 	}
 
-	validatorKey, err := getValidatorKey(configManager.GetChainNodeConfig().Url)
+	validatorKey, err := getValidatorKey(configManager.GetChainEndpoints().Current())
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,85 @@
+package payloadstorage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DiskBlobBackend stores blobs as files under baseDir, keys map to relative paths
+type DiskBlobBackend struct {
+	baseDir string
+}
+
+func NewDiskBlobBackend(baseDir string) *DiskBlobBackend {
+	return &DiskBlobBackend{baseDir: baseDir}
+}
+
+func (d *DiskBlobBackend) path(key string) string {
+	return filepath.Join(d.baseDir, filepath.FromSlash(key))
+}
+
+// Atomic write: temp file + rename
+func (d *DiskBlobBackend) Put(ctx context.Context, key string, data []byte) error {
+	target := d.path(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("create blob dir: %w", err)
+	}
+	// Blobs are content-addressed, so concurrent Puts of the same payload write the same key:
+	// each gets its own temp file and the last rename wins with identical content
+	temp, err := os.CreateTemp(filepath.Dir(target), filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	tempPath := temp.Name()
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		os.Remove(tempPath)
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("close temp file: %w", err)
+	}
+	if err := os.Chmod(tempPath, 0644); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("chmod temp file: %w", err)
+	}
+	if err := os.Rename(tempPath, target); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("rename to target: %w", err)
+	}
+	return nil
+}
+
+func (d *DiskBlobBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("read blob: %w", err)
+	}
+	return data, nil
+}
+
+func (d *DiskBlobBackend) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := os.Stat(d.path(key))
+	if err == nil {
+		return true, nil
+	}
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+func (d *DiskBlobBackend) DeletePrefix(ctx context.Context, prefix string) error {
+	if err := os.RemoveAll(d.path(prefix)); err != nil {
+		return fmt.Errorf("remove blobs: %w", err)
+	}
+	return nil
+}
+
+var _ BlobBackend = (*DiskBlobBackend)(nil)
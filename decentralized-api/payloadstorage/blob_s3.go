@@ -0,0 +1,140 @@
+package payloadstorage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+// S3Config configures an S3-compatible bucket. Credentials are taken from the standard
+// AWS environment (AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, shared config or instance role).
+type S3Config struct {
+	Bucket   string
+	Prefix   string
+	Region   string
+	Endpoint string // optional, for S3-compatible stores such as MinIO
+}
+
+// S3BlobBackend stores blobs as objects in an S3 bucket under an optional key prefix
+type S3BlobBackend struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func NewS3BlobBackend(cfg S3Config) (*S3BlobBackend, error) {
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+	awsCfg := aws.NewConfig()
+	if cfg.Region != "" {
+		awsCfg = awsCfg.WithRegion(cfg.Region)
+	}
+	if cfg.Endpoint != "" {
+		awsCfg = awsCfg.WithEndpoint(cfg.Endpoint).WithS3ForcePathStyle(true)
+	}
+	sess, err := session.NewSession(awsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("create aws session: %w", err)
+	}
+	return &S3BlobBackend{
+		client: s3.New(sess),
+		bucket: cfg.Bucket,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+func (s *S3BlobBackend) key(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return path.Join(s.prefix, key)
+}
+
+func (s *S3BlobBackend) Put(ctx context.Context, key string, data []byte) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+		Body:   bytes.NewReader(data),
+	})
+	return err
+}
+
+func (s *S3BlobBackend) Get(ctx context.Context, key string) ([]byte, error) {
+	out, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	defer out.Body.Close()
+	return io.ReadAll(out.Body)
+}
+
+func (s *S3BlobBackend) Exists(ctx context.Context, key string) (bool, error) {
+	_, err := s.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(key)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *S3BlobBackend) DeletePrefix(ctx context.Context, prefix string) error {
+	var deleteErr error
+	err := s.client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(s.key(prefix) + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		if len(page.Contents) == 0 {
+			return true
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, len(page.Contents))
+		for _, obj := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: obj.Key})
+		}
+		// A list page holds at most 1000 keys, which is also the DeleteObjects limit
+		_, deleteErr = s.client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(s.bucket),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		return deleteErr == nil
+	})
+	if err != nil {
+		return fmt.Errorf("list blobs: %w", err)
+	}
+	if deleteErr != nil {
+		return fmt.Errorf("delete blobs: %w", deleteErr)
+	}
+	return nil
+}
+
+func isS3NotFound(err error) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		switch awsErr.Code() {
+		case s3.ErrCodeNoSuchKey, "NotFound":
+			return true
+		}
+	}
+	return false
+}
+
+var _ BlobBackend = (*S3BlobBackend)(nil)
@@ -0,0 +1,128 @@
+package payloadstorage
+
+import (
+	"context"
+	"decentralized-api/logging"
+	"decentralized-api/utils"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// BlobBackend is a flat key-value object store, e.g. a local directory or an S3 bucket
+type BlobBackend interface {
+	Put(ctx context.Context, key string, data []byte) error
+	// Get returns ErrNotFound when the key does not exist
+	Get(ctx context.Context, key string) ([]byte, error)
+	Exists(ctx context.Context, key string) (bool, error)
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+type blobIndex struct {
+	PromptHash   string `json:"prompt_hash"`
+	ResponseHash string `json:"response_hash"`
+}
+
+// BlobStorage stores payloads content-addressed by their SHA-256 hash.
+// Identical payloads within an epoch are stored once; an index object maps each inference to its blobs.
+//
+// Layout: {epochId}/blobs/{sha256} and {epochId}/index/{hex(inferenceId)}.json
+// Blobs are scoped per epoch so PruneEpoch stays a single prefix delete without reference counting.
+type BlobStorage struct {
+	backend BlobBackend
+}
+
+func NewBlobStorage(backend BlobBackend) *BlobStorage {
+	return &BlobStorage{backend: backend}
+}
+
+func epochPrefix(epochId uint64) string {
+	return strconv.FormatUint(epochId, 10) + "/"
+}
+
+func blobKey(epochId uint64, hash string) string {
+	return path.Join(epochPrefix(epochId), "blobs", hash)
+}
+
+func indexKey(epochId uint64, inferenceId string) string {
+	return path.Join(epochPrefix(epochId), "index", inferenceIdToFilename(inferenceId)+".json")
+}
+
+func (b *BlobStorage) Store(ctx context.Context, inferenceId string, epochId uint64, promptPayload, responsePayload []byte) error {
+	promptHash, err := b.putBlob(ctx, epochId, promptPayload)
+	if err != nil {
+		return fmt.Errorf("store prompt blob: %w", err)
+	}
+	responseHash, err := b.putBlob(ctx, epochId, responsePayload)
+	if err != nil {
+		return fmt.Errorf("store response blob: %w", err)
+	}
+
+	index, err := json.Marshal(blobIndex{PromptHash: promptHash, ResponseHash: responseHash})
+	if err != nil {
+		return fmt.Errorf("marshal index: %w", err)
+	}
+	// Index is written last so a visible index always points to complete blobs
+	if err := b.backend.Put(ctx, indexKey(epochId, inferenceId), index); err != nil {
+		return fmt.Errorf("store index: %w", err)
+	}
+	logging.Debug("Stored payload blobs", types.PayloadStorage, "inferenceId", inferenceId, "epochId", epochId,
+		"promptHash", promptHash, "responseHash", responseHash)
+	return nil
+}
+
+func (b *BlobStorage) putBlob(ctx context.Context, epochId uint64, data []byte) (string, error) {
+	hash := utils.GenerateSHA256HashBytes(data)
+	key := blobKey(epochId, hash)
+	exists, err := b.backend.Exists(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return hash, nil
+	}
+	return hash, b.backend.Put(ctx, key, data)
+}
+
+func (b *BlobStorage) Retrieve(ctx context.Context, inferenceId string, epochId uint64) ([]byte, []byte, error) {
+	data, err := b.backend.Get(ctx, indexKey(epochId, inferenceId))
+	if err != nil {
+		return nil, nil, err
+	}
+	var index blobIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, nil, fmt.Errorf("unmarshal index: %w", err)
+	}
+
+	prompt, err := b.getBlob(ctx, epochId, index.PromptHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieve prompt blob: %w", err)
+	}
+	response, err := b.getBlob(ctx, epochId, index.ResponseHash)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieve response blob: %w", err)
+	}
+	return prompt, response, nil
+}
+
+func (b *BlobStorage) getBlob(ctx context.Context, epochId uint64, hash string) ([]byte, error) {
+	data, err := b.backend.Get(ctx, blobKey(epochId, hash))
+	if err != nil {
+		return nil, err
+	}
+	// Content addressing lets us detect corruption or tampering in the backend
+	if actual := utils.GenerateSHA256HashBytes(data); actual != hash {
+		return nil, errors.Join(ErrNotFound, fmt.Errorf("blob hash mismatch: expected %s, got %s", hash, actual))
+	}
+	return data, nil
+}
+
+func (b *BlobStorage) PruneEpoch(ctx context.Context, epochId uint64) error {
+	return b.backend.DeletePrefix(ctx, epochPrefix(epochId))
+}
+
+var _ PayloadStorage = (*BlobStorage)(nil)
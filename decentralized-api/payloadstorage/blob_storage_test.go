@@ -0,0 +1,111 @@
+package payloadstorage
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStorage_StoreRetrieve(t *testing.T) {
+	ctx := context.Background()
+	storage := NewBlobStorage(NewDiskBlobBackend(t.TempDir()))
+
+	prompt := []byte(`{"model":"test","messages":[]}`)
+	response := []byte(`{"choices":[{"text":"hello"}]}`)
+	require.NoError(t, storage.Store(ctx, "inf-1", 10, prompt, response))
+
+	gotPrompt, gotResponse, err := storage.Retrieve(ctx, "inf-1", 10)
+	require.NoError(t, err)
+	assert.Equal(t, prompt, gotPrompt)
+	assert.Equal(t, response, gotResponse)
+
+	_, _, err = storage.Retrieve(ctx, "inf-1", 11)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = storage.Retrieve(ctx, "missing", 10)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestBlobStorage_DeduplicatesIdenticalPayloads(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage := NewBlobStorage(NewDiskBlobBackend(dir))
+
+	prompt := []byte("same prompt")
+	require.NoError(t, storage.Store(ctx, "inf-1", 5, prompt, []byte("response 1")))
+	require.NoError(t, storage.Store(ctx, "inf-2", 5, prompt, []byte("response 2")))
+
+	blobs, err := os.ReadDir(filepath.Join(dir, "5", "blobs"))
+	require.NoError(t, err)
+	assert.Len(t, blobs, 3)
+
+	gotPrompt, gotResponse, err := storage.Retrieve(ctx, "inf-2", 5)
+	require.NoError(t, err)
+	assert.Equal(t, prompt, gotPrompt)
+	assert.Equal(t, []byte("response 2"), gotResponse)
+}
+
+func TestDiskBlobBackend_ConcurrentPutsOfSameKey(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	backend := NewDiskBlobBackend(dir)
+
+	data := bytes.Repeat([]byte("shared payload "), 64*1024)
+	var wg sync.WaitGroup
+	errs := make([]error, 32)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = backend.Put(ctx, "5/blobs/shared", data)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, "5", "blobs"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	got, err := backend.Get(ctx, "5/blobs/shared")
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+}
+
+func TestBlobStorage_PruneEpoch(t *testing.T) {
+	ctx := context.Background()
+	storage := NewBlobStorage(NewDiskBlobBackend(t.TempDir()))
+
+	require.NoError(t, storage.Store(ctx, "inf-1", 1, []byte("p1"), []byte("r1")))
+	require.NoError(t, storage.Store(ctx, "inf-2", 2, []byte("p2"), []byte("r2")))
+
+	require.NoError(t, storage.PruneEpoch(ctx, 1))
+
+	_, _, err := storage.Retrieve(ctx, "inf-1", 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = storage.Retrieve(ctx, "inf-2", 2)
+	assert.NoError(t, err)
+}
+
+func TestBlobStorage_DetectsTamperedBlob(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storage := NewBlobStorage(NewDiskBlobBackend(dir))
+
+	require.NoError(t, storage.Store(ctx, "inf-1", 3, []byte("prompt"), []byte("response")))
+
+	blobs, err := os.ReadDir(filepath.Join(dir, "3", "blobs"))
+	require.NoError(t, err)
+	for _, blob := range blobs {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "3", "blobs", blob.Name()), []byte("tampered"), 0644))
+	}
+
+	_, _, err = storage.Retrieve(ctx, "inf-1", 3)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -3,6 +3,7 @@ package payloadstorage
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"time"
 
 	"decentralized-api/logging"
@@ -14,7 +15,12 @@ import (
 // If PGHOST is set, uses HybridStorage (PG primary + file fallback).
 // If PostgreSQL is not accessible at startup, HybridStorage will retry lazily on Store operations.
 // If PGHOST is not set, uses FileStorage only.
+// PAYLOAD_BLOB_STORE=disk|s3 selects content-addressed BlobStorage instead and takes precedence over PGHOST.
 func NewPayloadStorage(ctx context.Context, fileBasePath string) PayloadStorage {
+	if blobStorage := newBlobStorageFromEnv(fileBasePath); blobStorage != nil {
+		return blobStorage
+	}
+
 	fileStorage := NewFileStorage(fileBasePath)
 
 	pgHost := os.Getenv("PGHOST")
@@ -39,3 +45,36 @@ func NewPayloadStorage(ctx context.Context, fileBasePath string) PayloadStorage
 	logging.Info("Using PostgreSQL with file fallback", types.PayloadStorage, "host", pgHost)
 	return NewHybridStorage(pgStorage, fileStorage, retryInterval)
 }
+
+// newBlobStorageFromEnv returns nil when PAYLOAD_BLOB_STORE is unset or the backend cannot be created,
+// in which case the default storage selection applies
+func newBlobStorageFromEnv(fileBasePath string) PayloadStorage {
+	switch os.Getenv("PAYLOAD_BLOB_STORE") {
+	case "":
+		return nil
+	case "disk":
+		dir := os.Getenv("PAYLOAD_BLOB_DIR")
+		if dir == "" {
+			dir = filepath.Join(fileBasePath, "blobs")
+		}
+		logging.Info("Using content-addressed disk blob storage", types.PayloadStorage, "dir", dir)
+		return NewBlobStorage(NewDiskBlobBackend(dir))
+	case "s3":
+		cfg := S3Config{
+			Bucket:   os.Getenv("PAYLOAD_S3_BUCKET"),
+			Prefix:   os.Getenv("PAYLOAD_S3_PREFIX"),
+			Region:   os.Getenv("PAYLOAD_S3_REGION"),
+			Endpoint: os.Getenv("PAYLOAD_S3_ENDPOINT"),
+		}
+		backend, err := NewS3BlobBackend(cfg)
+		if err != nil {
+			logging.Error("Failed to create S3 blob storage, falling back to default storage", types.PayloadStorage, "error", err)
+			return nil
+		}
+		logging.Info("Using content-addressed S3 blob storage", types.PayloadStorage, "bucket", cfg.Bucket, "prefix", cfg.Prefix)
+		return NewBlobStorage(backend)
+	default:
+		logging.Warn("Unknown PAYLOAD_BLOB_STORE, using default storage", types.PayloadStorage, "value", os.Getenv("PAYLOAD_BLOB_STORE"))
+		return nil
+	}
+}
@@ -1,6 +1,7 @@
 package poc
 
 import (
+	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	cosmosclient "decentralized-api/cosmosclient"
@@ -24,7 +25,7 @@ func NewOrchestrator(
 	validatorAddress string,
 	nodeBroker *broker.Broker,
 	callbackUrl string,
-	chainNodeUrl string,
+	chainEndpoints apiconfig.ChainEndpoints,
 	cosmosClient cosmosclient.CosmosMessageClient,
 	phaseTracker *chainphase.ChainPhaseTracker,
 ) Orchestrator {
@@ -37,7 +38,7 @@ func NewOrchestrator(
 		callbackUrl,
 		pubKey,
 		validatorAddress,
-		chainNodeUrl,
+		chainEndpoints,
 		config,
 	)
 
@@ -48,7 +49,7 @@ func NewOrchestrator(
 		callbackUrl,
 		pubKey,
 		validatorAddress,
-		chainNodeUrl,
+		chainEndpoints,
 		config,
 	)
 
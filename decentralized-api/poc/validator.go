@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
@@ -30,7 +31,7 @@ type OffChainValidator struct {
 	callbackUrl      string
 	pubKey           string
 	validatorAddress string
-	chainNodeUrl     string
+	chainEndpoints   apiconfig.ChainEndpoints
 
 	config ValidationConfig
 }
@@ -81,7 +82,7 @@ func NewOffChainValidator(
 	callbackUrl string,
 	pubKey string,
 	validatorAddress string,
-	chainNodeUrl string,
+	chainEndpoints apiconfig.ChainEndpoints,
 	config ValidationConfig,
 ) *OffChainValidator {
 	return &OffChainValidator{
@@ -91,7 +92,7 @@ func NewOffChainValidator(
 		callbackUrl:      callbackUrl,
 		pubKey:           pubKey,
 		validatorAddress: validatorAddress,
-		chainNodeUrl:     chainNodeUrl,
+		chainEndpoints:   chainEndpoints,
 		config:           config,
 	}
 }
@@ -589,12 +590,12 @@ func (v *OffChainValidator) getSamplingBlockHash(epochState *chainphase.EpochSta
 		return epochState.ActiveConfirmationPoCEvent.PocSeedBlockHash
 	}
 
-	if v.chainNodeUrl == "" {
+	if v.chainEndpoints == nil || v.chainEndpoints.Current() == "" {
 		logging.Warn("OffChainValidator: no chain node URL", types.PoC)
 		return ""
 	}
 
-	client, err := cosmosclient.NewRpcClient(v.chainNodeUrl)
+	client, err := cosmosclient.NewRpcClient(v.chainEndpoints.Current())
 	if err != nil {
 		logging.Error("OffChainValidator: failed to create RPC client", types.PoC, "error", err)
 		return ""
@@ -674,7 +675,7 @@ func (v *OffChainValidator) getNodesWithRetryConfig(
 			"numNodes", len(nodes),
 			"attempt", attempt)
 
-		nodes = filterNodesForValidation(nodes)
+		nodes = filterNodesForValidation(nodes, pocStageStartBlockHeight)
 		logging.Info("OffChainValidator: filtered nodes for validation", types.PoC,
 			"numNodes", len(nodes),
 			"attempt", attempt)
@@ -701,8 +702,8 @@ func (v *OffChainValidator) getNodesWithRetryConfig(
 // filterNodesForValidation returns nodes available for PoC validation.
 // - Accept nodes in POC status with any sub-status
 // - Accept nodes in INFERENCE status (unless preserved for inference via POC_SLOT)
-// - Exclude FAILED, administratively disabled, or POC_SLOT-preserved nodes
-func filterNodesForValidation(nodes []broker.NodeResponse) []broker.NodeResponse {
+// - Exclude FAILED, administratively disabled, in-maintenance, PoC-aborted or POC_SLOT-preserved nodes
+func filterNodesForValidation(nodes []broker.NodeResponse, pocStageStartBlockHeight int64) []broker.NodeResponse {
 	filtered := make([]broker.NodeResponse, 0, len(nodes))
 	for _, node := range nodes {
 		// Exclude failed nodes
@@ -723,6 +724,18 @@ func filterNodesForValidation(nodes []broker.NodeResponse) []broker.NodeResponse
 			continue
 		}
 
+		// Exclude nodes in a maintenance window
+		if node.State.InMaintenance() {
+			logging.Debug("filterNodesForValidation: Skipping node in maintenance window", types.PoC, "node_id", node.Node.Id)
+			continue
+		}
+
+		// Exclude nodes PoC was aborted on by an operator
+		if node.State.PocAbortedFor(pocStageStartBlockHeight) {
+			logging.Debug("filterNodesForValidation: Skipping node with aborted PoC", types.PoC, "node_id", node.Node.Id)
+			continue
+		}
+
 		// Exclude nodes preserved for inference (POC_SLOT allocation)
 		if node.State.ShouldContinueInference() {
 			logging.Debug("filterNodesForValidation: Skipping node preserved for inference", types.PoC, "node_id", node.Node.Id)
@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"decentralized-api/apiconfig"
 	"decentralized-api/broker"
 	"decentralized-api/chainphase"
 	"decentralized-api/cosmosclient"
@@ -32,7 +33,7 @@ type OnChainValidator struct {
 	callbackUrl      string
 	pubKey           string
 	validatorAddress string
-	chainNodeUrl     string
+	chainEndpoints   apiconfig.ChainEndpoints
 
 	config ValidationConfig
 }
@@ -44,7 +45,7 @@ func NewOnChainValidator(
 	callbackUrl string,
 	pubKey string,
 	validatorAddress string,
-	chainNodeUrl string,
+	chainEndpoints apiconfig.ChainEndpoints,
 	config ValidationConfig,
 ) *OnChainValidator {
 	return &OnChainValidator{
@@ -54,7 +55,7 @@ func NewOnChainValidator(
 		callbackUrl:      callbackUrl,
 		pubKey:           pubKey,
 		validatorAddress: validatorAddress,
-		chainNodeUrl:     chainNodeUrl,
+		chainEndpoints:   chainEndpoints,
 		config:           config,
 	}
 }
@@ -381,12 +382,12 @@ func (v *OnChainValidator) getSamplingBlockHash(epochState *chainphase.EpochStat
 		return epochState.ActiveConfirmationPoCEvent.PocSeedBlockHash
 	}
 
-	if v.chainNodeUrl == "" {
+	if v.chainEndpoints == nil || v.chainEndpoints.Current() == "" {
 		logging.Warn("OnChainValidator: no chain node URL", types.PoC)
 		return ""
 	}
 
-	client, err := cosmosclient.NewRpcClient(v.chainNodeUrl)
+	client, err := cosmosclient.NewRpcClient(v.chainEndpoints.Current())
 	if err != nil {
 		logging.Error("OnChainValidator: failed to create RPC client", types.PoC, "error", err)
 		return ""
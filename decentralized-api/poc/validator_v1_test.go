@@ -147,7 +147,7 @@ func (f *failingNodeClient) getCallCount() int {
 }
 
 // Implement MLNodeClient interface for failingNodeClient
-func (f *failingNodeClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int) error {
+func (f *failingNodeClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error {
 	return nil
 }
 func (f *failingNodeClient) GetTrainingStatus(ctx context.Context) error { return nil }
@@ -199,9 +199,12 @@ func (f *failingNodeClient) GetGPUDriver(ctx context.Context) (*mlnodeclient.Dri
 func (f *failingNodeClient) CheckModelStatus(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.ModelStatusResponse, error) {
 	return &mlnodeclient.ModelStatusResponse{}, nil
 }
-func (f *failingNodeClient) DownloadModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.DownloadStartResponse, error) {
+func (f *failingNodeClient) DownloadModel(ctx context.Context, model mlnodeclient.Model, opts mlnodeclient.DownloadOptions) (*mlnodeclient.DownloadStartResponse, error) {
 	return &mlnodeclient.DownloadStartResponse{}, nil
 }
+func (f *failingNodeClient) VerifyModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.ModelVerifyResponse, error) {
+	return &mlnodeclient.ModelVerifyResponse{}, nil
+}
 func (f *failingNodeClient) DeleteModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.DeleteResponse, error) {
 	return &mlnodeclient.DeleteResponse{}, nil
 }
@@ -212,7 +215,7 @@ func (f *failingNodeClient) GetDiskSpace(ctx context.Context) (*mlnodeclient.Dis
 	return &mlnodeclient.DiskSpaceInfo{}, nil
 }
 
-func (f fakeNodeClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int) error {
+func (f fakeNodeClient) StartTraining(ctx context.Context, taskId uint64, participant string, nodeId string, masterNodeAddr string, rank int, worldSize int, resumeFrom string) error {
 	return nil
 }
 func (f fakeNodeClient) GetTrainingStatus(ctx context.Context) error { return nil }
@@ -268,9 +271,12 @@ func (f fakeNodeClient) GetGPUDriver(ctx context.Context) (*mlnodeclient.DriverI
 func (f fakeNodeClient) CheckModelStatus(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.ModelStatusResponse, error) {
 	return &mlnodeclient.ModelStatusResponse{}, nil
 }
-func (f fakeNodeClient) DownloadModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.DownloadStartResponse, error) {
+func (f fakeNodeClient) DownloadModel(ctx context.Context, model mlnodeclient.Model, opts mlnodeclient.DownloadOptions) (*mlnodeclient.DownloadStartResponse, error) {
 	return &mlnodeclient.DownloadStartResponse{}, nil
 }
+func (f fakeNodeClient) VerifyModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.ModelVerifyResponse, error) {
+	return &mlnodeclient.ModelVerifyResponse{}, nil
+}
 func (f fakeNodeClient) DeleteModel(ctx context.Context, model mlnodeclient.Model) (*mlnodeclient.DeleteResponse, error) {
 	return &mlnodeclient.DeleteResponse{}, nil
 }
@@ -0,0 +1,147 @@
+package training
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CheckpointRecordKey is the training store key ML nodes report their progress under.
+// The value is a JSON encoded CheckpointProgress.
+const CheckpointRecordKey = "checkpoint"
+
+const DefaultCheckpointDir = "/root/.dapi/data/training/checkpoints"
+
+var ErrCheckpointNotFound = errors.New("checkpoint not found")
+
+// CheckpointProgress is what an ML node reports about a running training task
+type CheckpointProgress struct {
+	Step        uint64  `json:"step"`
+	Loss        float64 `json:"loss"`
+	ArtifactUri string  `json:"artifact_uri"`
+}
+
+// Checkpoint is the latest known state of a training task assigned to this participant:
+// how it was started and how far it got. It is enough to restart the task after a restart of the API.
+type Checkpoint struct {
+	TaskId         uint64         `json:"task_id"`
+	MasterNodeAddr string         `json:"master_node_addr"`
+	NodeRanks      map[string]int `json:"node_ranks"`
+	WorldSize      int            `json:"world_size"`
+
+	Step        uint64    `json:"step"`
+	Loss        float64   `json:"loss"`
+	ArtifactUri string    `json:"artifact_uri,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type CheckpointStore interface {
+	Save(checkpoint Checkpoint) error
+	Get(taskId uint64) (Checkpoint, error)
+	List() ([]Checkpoint, error)
+	Delete(taskId uint64) error
+}
+
+// FileCheckpointStore keeps one JSON file per task.
+// Directory structure: {baseDir}/{taskId}.json
+type FileCheckpointStore struct {
+	baseDir string
+	mu      sync.Mutex
+}
+
+func NewFileCheckpointStore(baseDir string) *FileCheckpointStore {
+	return &FileCheckpointStore{baseDir: baseDir}
+}
+
+func (s *FileCheckpointStore) path(taskId uint64) string {
+	return filepath.Join(s.baseDir, strconv.FormatUint(taskId, 10)+".json")
+}
+
+// Atomic write: temp file + rename
+func (s *FileCheckpointStore) Save(checkpoint Checkpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.MkdirAll(s.baseDir, 0755); err != nil {
+		return fmt.Errorf("create checkpoint dir: %w", err)
+	}
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return fmt.Errorf("marshal checkpoint: %w", err)
+	}
+
+	targetPath := s.path(checkpoint.TaskId)
+	tempPath := targetPath + ".tmp"
+	if err := os.WriteFile(tempPath, data, 0644); err != nil {
+		return fmt.Errorf("write temp file: %w", err)
+	}
+	if err := os.Rename(tempPath, targetPath); err != nil {
+		os.Remove(tempPath)
+		return fmt.Errorf("rename to target: %w", err)
+	}
+	return nil
+}
+
+func (s *FileCheckpointStore) Get(taskId uint64) (Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.read(s.path(taskId))
+}
+
+func (s *FileCheckpointStore) read(path string) (Checkpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return Checkpoint{}, ErrCheckpointNotFound
+		}
+		return Checkpoint{}, fmt.Errorf("read checkpoint: %w", err)
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return Checkpoint{}, fmt.Errorf("unmarshal checkpoint: %w", err)
+	}
+	return checkpoint, nil
+}
+
+// List returns all checkpoints ordered by task id
+func (s *FileCheckpointStore) List() ([]Checkpoint, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.baseDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read checkpoint dir: %w", err)
+	}
+	checkpoints := make([]Checkpoint, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		checkpoint, err := s.read(filepath.Join(s.baseDir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		checkpoints = append(checkpoints, checkpoint)
+	}
+	sort.Slice(checkpoints, func(i, j int) bool { return checkpoints[i].TaskId < checkpoints[j].TaskId })
+	return checkpoints, nil
+}
+
+func (s *FileCheckpointStore) Delete(taskId uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.path(taskId)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("delete checkpoint: %w", err)
+	}
+	return nil
+}
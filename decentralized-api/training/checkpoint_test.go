@@ -0,0 +1,49 @@
+package training_test
+
+import (
+	"decentralized-api/training"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	store := training.NewFileCheckpointStore(t.TempDir())
+
+	_, err := store.Get(1)
+	require.ErrorIs(t, err, training.ErrCheckpointNotFound)
+	checkpoints, err := store.List()
+	require.NoError(t, err)
+	require.Empty(t, checkpoints)
+
+	second := training.Checkpoint{TaskId: 2, MasterNodeAddr: "10.0.0.1", NodeRanks: map[string]int{"node-1": 0}, WorldSize: 1}
+	first := training.Checkpoint{TaskId: 1, MasterNodeAddr: "10.0.0.2", NodeRanks: map[string]int{"node-2": 1}, WorldSize: 2, Step: 1000, Loss: 1.5, ArtifactUri: "outputs/step_1000"}
+	require.NoError(t, store.Save(second))
+	require.NoError(t, store.Save(first))
+
+	got, err := store.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, first.Step, got.Step)
+	require.Equal(t, first.ArtifactUri, got.ArtifactUri)
+	require.Equal(t, first.NodeRanks, got.NodeRanks)
+
+	// A later checkpoint replaces the previous one
+	first.Step = 2000
+	first.ArtifactUri = "outputs/step_2000"
+	require.NoError(t, store.Save(first))
+	got, err = store.Get(1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2000), got.Step)
+	require.Equal(t, "outputs/step_2000", got.ArtifactUri)
+
+	checkpoints, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, checkpoints, 2)
+	require.Equal(t, uint64(1), checkpoints[0].TaskId)
+	require.Equal(t, uint64(2), checkpoints[1].TaskId)
+
+	require.NoError(t, store.Delete(1))
+	require.NoError(t, store.Delete(1))
+	_, err = store.Get(1)
+	require.ErrorIs(t, err, training.ErrCheckpointNotFound)
+}
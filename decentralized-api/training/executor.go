@@ -9,6 +9,7 @@ import (
 	"github.com/productscience/inference/x/inference/types"
 	"log/slog"
 	"sort"
+	"sync"
 	"time"
 )
 
@@ -17,14 +18,17 @@ const logTagExecutor = "[training-task-executor] "
 type Executor struct {
 	broker       *broker.Broker
 	cosmosClient cosmosclient.CosmosMessageClient
+	checkpoints  CheckpointStore
 	tasks        map[uint64]struct{}
+	tasksMu      sync.Mutex
 	ctx          context.Context
 }
 
-func NewExecutor(ctx context.Context, nodeBroker *broker.Broker, cosmosClient cosmosclient.CosmosMessageClient) *Executor {
+func NewExecutor(ctx context.Context, nodeBroker *broker.Broker, cosmosClient cosmosclient.CosmosMessageClient, checkpoints CheckpointStore) *Executor {
 	e := &Executor{
 		broker:       nodeBroker,
 		cosmosClient: cosmosClient,
+		checkpoints:  checkpoints,
 		tasks:        make(map[uint64]struct{}),
 		ctx:          ctx,
 	}
@@ -34,7 +38,7 @@ func NewExecutor(ctx context.Context, nodeBroker *broker.Broker, cosmosClient co
 	return e
 }
 
-func (e Executor) PreassignTask(taskId uint64, nodeIds []string) error {
+func (e *Executor) PreassignTask(taskId uint64, nodeIds []string) error {
 	command := broker.NewLockNodesForTrainingCommand(nodeIds)
 	err := e.broker.QueueMessage(command)
 	if err != nil {
@@ -44,7 +48,7 @@ func (e Executor) PreassignTask(taskId uint64, nodeIds []string) error {
 	success := <-command.Response
 
 	if success {
-		e.tasks[taskId] = struct{}{}
+		e.addTask(taskId)
 		return nil
 	} else {
 		return errors.New("failed to lock nodes")
@@ -112,30 +116,96 @@ func (e *Executor) ProcessTaskAssignedEvent(taskId uint64) {
 		}
 	}
 
-	logging.Info(logTagExecutor+"Starting training", types.Training, "taskId", taskId, "masterNode", masterNode.Host, "nodeRanks", nodeRanks, "worldSize", len(rankedNodes))
-	slog.Info(logTagExecutor+"Starting training", "taskId", taskId, "masterNode", masterNode.Host, "nodeRanks", nodeRanks, "worldSize", len(rankedNodes))
+	checkpoint := Checkpoint{
+		TaskId:         taskId,
+		MasterNodeAddr: masterNode.Host,
+		NodeRanks:      nodeRanks,
+		WorldSize:      len(rankedNodes),
+	}
+	// The event may be processed again after a restart: continue from where the task got to
+	if previous, err := e.checkpoints.Get(taskId); err == nil {
+		checkpoint.Step = previous.Step
+		checkpoint.Loss = previous.Loss
+		checkpoint.ArtifactUri = previous.ArtifactUri
+	}
+	e.startTraining(checkpoint)
+}
+
+// startTraining starts the task on the local nodes, from checkpoint.ArtifactUri if set,
+// and records how it was started so it can be resumed later
+func (e *Executor) startTraining(checkpoint Checkpoint) bool {
+	taskId := checkpoint.TaskId
+	logging.Info(logTagExecutor+"Starting training", types.Training, "taskId", taskId, "masterNode", checkpoint.MasterNodeAddr,
+		"nodeRanks", checkpoint.NodeRanks, "worldSize", checkpoint.WorldSize, "resumeFrom", checkpoint.ArtifactUri, "step", checkpoint.Step)
+	slog.Info(logTagExecutor+"Starting training", "taskId", taskId, "masterNode", checkpoint.MasterNodeAddr,
+		"nodeRanks", checkpoint.NodeRanks, "worldSize", checkpoint.WorldSize, "resumeFrom", checkpoint.ArtifactUri, "step", checkpoint.Step)
 	command := broker.NewStartTrainingCommand(
 		taskId,
-		masterNode.Host,
-		len(rankedNodes),
-		nodeRanks,
+		checkpoint.MasterNodeAddr,
+		checkpoint.WorldSize,
+		checkpoint.NodeRanks,
+		checkpoint.ArtifactUri,
 	)
-	err = e.broker.QueueMessage(command)
+	err := e.broker.QueueMessage(command)
 	if err != nil {
 		logging.Error(logTagExecutor+"Error starting training", types.Training, "taskId", taskId, "error", err)
 		slog.Error(logTagExecutor+"Error starting training", "taskId", taskId, "error", err)
-		return
+		return false
 	}
 
 	success := <-command.Response
-	if success {
-		e.tasks[taskId] = struct{}{}
-		logging.Info(logTagExecutor+"Training started", types.Training, "taskId", taskId)
-		slog.Info(logTagExecutor+"Training started", "taskId", taskId)
-	} else {
+	if !success {
 		logging.Error(logTagExecutor+"Error starting training", types.Training, "taskId", taskId)
 		slog.Error(logTagExecutor+"Error starting training", "taskId", taskId)
+		return false
+	}
+
+	e.addTask(taskId)
+	checkpoint.UpdatedAt = time.Now()
+	if err := e.checkpoints.Save(checkpoint); err != nil {
+		logging.Error(logTagExecutor+"Failed to save training checkpoint", types.Training, "taskId", taskId, "error", err)
+	}
+	logging.Info(logTagExecutor+"Training started", types.Training, "taskId", taskId)
+	slog.Info(logTagExecutor+"Training started", "taskId", taskId)
+	return true
+}
+
+// RecordCheckpoint stores the progress an ML node reported for a task running on this participant's nodes.
+// Progress older than the recorded one is ignored, since every node of the task reports it.
+func (e *Executor) RecordCheckpoint(taskId uint64, progress CheckpointProgress) error {
+	checkpoint, err := e.checkpoints.Get(taskId)
+	if errors.Is(err, ErrCheckpointNotFound) {
+		logging.Warn(logTagExecutor+"Checkpoint reported for a task that was not started here", types.Training, "taskId", taskId)
+		checkpoint = Checkpoint{TaskId: taskId}
+	} else if err != nil {
+		return err
+	}
+	if progress.Step < checkpoint.Step {
+		return nil
+	}
+
+	checkpoint.Step = progress.Step
+	checkpoint.Loss = progress.Loss
+	if progress.ArtifactUri != "" {
+		checkpoint.ArtifactUri = progress.ArtifactUri
 	}
+	checkpoint.UpdatedAt = time.Now()
+	logging.Info(logTagExecutor+"Recording training checkpoint", types.Training,
+		"taskId", taskId, "step", checkpoint.Step, "loss", checkpoint.Loss, "artifactUri", checkpoint.ArtifactUri)
+	return e.checkpoints.Save(checkpoint)
+}
+
+func (e *Executor) addTask(taskId uint64) {
+	e.tasksMu.Lock()
+	defer e.tasksMu.Unlock()
+	e.tasks[taskId] = struct{}{}
+}
+
+func (e *Executor) hasTask(taskId uint64) bool {
+	e.tasksMu.Lock()
+	defer e.tasksMu.Unlock()
+	_, ok := e.tasks[taskId]
+	return ok
 }
 
 type nodeWithParticipant struct {
@@ -233,23 +303,43 @@ func (e *Executor) checkInProgressTasksOnChain() {
 		}
 	}
 
-	// 3. For each task, check if it's already in the map
+	// 3. For each task, check if it's already in the map.
+	//  Tasks missing from it were lost by a restart: resume them from the latest checkpoint.
+	inProgress := make(map[uint64]struct{}, len(tasks))
 	for _, t := range tasks {
-		if _, ok := e.tasks[t.Id]; !ok {
-			logging.Info(logTagExecutor+"Task not found in the set", types.Training, "taskId", t.Id)
-			// TODO: add a task to the map
-			// e.tasks[t.Id] = struct{}{}
-		} else {
+		inProgress[t.Id] = struct{}{}
+		if e.hasTask(t.Id) {
 			logging.Info(logTagExecutor+"Task found in the set", types.Training, "taskId", t.Id)
+			continue
+		}
+		checkpoint, err := e.checkpoints.Get(t.Id)
+		if err != nil {
+			logging.Info(logTagExecutor+"Task not found in the set and has no checkpoint", types.Training, "taskId", t.Id, "error", err)
+			continue
 		}
+		if checkpoint.MasterNodeAddr == "" || len(checkpoint.NodeRanks) == 0 {
+			logging.Warn(logTagExecutor+"Checkpoint has no start parameters, can't resume task", types.Training, "taskId", t.Id)
+			continue
+		}
+		logging.Info(logTagExecutor+"Resuming task from checkpoint", types.Training, "taskId", t.Id, "step", checkpoint.Step, "artifactUri", checkpoint.ArtifactUri)
+		e.startTraining(checkpoint)
 	}
 
-	// TODO: So here's the question:
-	//  If task is absent in the map do we trigger it
-	// 		or
-	//	 do we have a separate routine that reads through the map each X seconds
-	//	 and just sends some check nodes are doing training command to the server
-	// I kind of like the option#2 more, because it's more resilient to failures
+	// 4. Checkpoints of tasks that are no longer in progress are not needed anymore
+	checkpoints, err := e.checkpoints.List()
+	if err != nil {
+		logging.Error(logTagExecutor+"Error listing training checkpoints", types.Training, "error", err)
+		return
+	}
+	for _, checkpoint := range checkpoints {
+		if _, ok := inProgress[checkpoint.TaskId]; ok {
+			continue
+		}
+		logging.Info(logTagExecutor+"Removing checkpoint of finished task", types.Training, "taskId", checkpoint.TaskId)
+		if err := e.checkpoints.Delete(checkpoint.TaskId); err != nil {
+			logging.Error(logTagExecutor+"Error removing training checkpoint", types.Training, "taskId", checkpoint.TaskId, "error", err)
+		}
+	}
 }
 
 func (e *Executor) checkStatus() {
@@ -4,6 +4,7 @@ import (
 	"context"
 	cosmosclient "decentralized-api/cosmosclient"
 	"decentralized-api/logging"
+	"encoding/json"
 
 	"github.com/productscience/inference/api/inference/inference"
 	"github.com/productscience/inference/x/inference/types"
@@ -53,6 +54,15 @@ func (s *Server) SetStoreRecord(ctx context.Context, req *inference.SetStoreReco
 
 	logging.Info("SetStoreRecord called", types.Training, "key", req.Record.Key, "value", req.Record.Value)
 
+	if req.Record.Key == CheckpointRecordKey {
+		var progress CheckpointProgress
+		if err := json.Unmarshal([]byte(req.Record.Value), &progress); err != nil {
+			logging.Warn("Malformed training checkpoint record", types.Training, "taskId", req.RunId, "error", err)
+		} else if err := s.executor.RecordCheckpoint(req.RunId, progress); err != nil {
+			logging.Error("Failed to record training checkpoint", types.Training, "taskId", req.RunId, "error", err)
+		}
+	}
+
 	msg := &inference.MsgSubmitTrainingKvRecord{
 		Creator:     s.cosmosClient.GetAccountAddress(),
 		Participant: s.cosmosClient.GetAccountAddress(),
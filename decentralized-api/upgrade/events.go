@@ -16,6 +16,7 @@ func ProcessNewBlockEvent(
 	event *chainevents.JSONRPCResponse,
 	transactionRecorder cosmosclient.InferenceCosmosClient,
 	configManager *apiconfig.ConfigManager,
+	orchestrator *Orchestrator,
 ) {
 	if event.Result.Data.Type != "tendermint/event/NewBlock" {
 		logging.Error("Expected tendermint/event/NewBlock event", types.Upgrades, "event", event.Result.Data.Type)
@@ -25,6 +26,10 @@ func ProcessNewBlockEvent(
 	checkForPartialUpgradesScheduled(transactionRecorder, configManager)
 	checkForFullUpgradesScheduled(transactionRecorder, configManager)
 
+	if orchestrator != nil {
+		orchestrator.OnNewBlock(configManager.GetHeight())
+		return
+	}
 	checkForVersionSwitch(configManager)
 }
 
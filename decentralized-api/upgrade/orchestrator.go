@@ -0,0 +1,426 @@
+package upgrade
+
+import (
+	"context"
+	"crypto/sha256"
+	"decentralized-api/apiconfig"
+	"decentralized-api/broker"
+	"decentralized-api/logging"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+type Stage string
+
+const (
+	StageIdle            Stage = "idle"
+	StagePreparing       Stage = "preparing"
+	StageReady           Stage = "ready"
+	StagePreflightFailed Stage = "preflight_failed"
+	StageSwitched        Stage = "switched"
+	StageCompleted       Stage = "completed"
+	StageUnhealthy       Stage = "unhealthy"
+	StageRolledBack      Stage = "rolled_back"
+)
+
+const (
+	CheckBinary      = "binary"
+	CheckDiskSpace   = "disk_space"
+	CheckDownload    = "download"
+	CheckNodeVersion = "node_version"
+)
+
+var ErrNothingToRollBack = errors.New("no ML node version switch to roll back")
+
+// NodeVersionChecker reports whether the ML nodes serve a version, see broker.Broker.CheckVersionHealth
+type NodeVersionChecker interface {
+	CheckVersionHealth(version string) map[string]broker.VersionHealthReport
+}
+
+type CheckResult struct {
+	Name    string `json:"name"`
+	Passed  bool   `json:"passed"`
+	Message string `json:"message,omitempty"`
+}
+
+// Status is the progress of the latest upgrade plan seen by the orchestrator
+type Status struct {
+	PlanName            string        `json:"plan_name,omitempty"`
+	PlanHeight          int64         `json:"plan_height,omitempty"`
+	NodeVersion         string        `json:"node_version,omitempty"`
+	Stage               Stage         `json:"stage"`
+	Checks              []CheckResult `json:"checks,omitempty"`
+	BinaryPath          string        `json:"binary_path,omitempty"`
+	PreviousNodeVersion string        `json:"previous_node_version,omitempty"`
+	SwitchedAtHeight    int64         `json:"switched_at_height,omitempty"`
+	Error               string        `json:"error,omitempty"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+}
+
+// Orchestrator drives an upgrade plan from the moment it is seen on chain: it pre-downloads the API binary
+// and runs pre-flight checks, switches the ML node version at the upgrade height, and rolls the switch back
+// when no ML node is healthy on the new version. The broker picks up version changes and refreshes its
+// ML node clients. The API binary itself is still switched by cosmovisor, see CheckForUpgrade.
+type Orchestrator struct {
+	configManager *apiconfig.ConfigManager
+	nodes         NodeVersionChecker
+	httpClient    *http.Client
+
+	mu     sync.Mutex
+	status Status
+	// busy is set while the health check of a switched version runs in the background
+	busy atomic.Bool
+}
+
+func NewOrchestrator(configManager *apiconfig.ConfigManager, nodes NodeVersionChecker) *Orchestrator {
+	return &Orchestrator{
+		configManager: configManager,
+		nodes:         nodes,
+		httpClient:    &http.Client{Timeout: 30 * time.Minute},
+		status:        Status{Stage: StageIdle, UpdatedAt: time.Now()},
+	}
+}
+
+func (o *Orchestrator) Status() Status {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	status := o.status
+	status.Checks = append([]CheckResult(nil), o.status.Checks...)
+	return status
+}
+
+// OnNewBlock advances the orchestration, it is called for every new block after the upgrade plans are synced
+func (o *Orchestrator) OnNewBlock(height int64) {
+	plan := o.configManager.GetUpgradePlan()
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if plan.Name != "" && plan.Name != o.status.PlanName {
+		logging.Info("Upgrade plan scheduled, preparing", types.Upgrades, "name", plan.Name, "height", plan.Height, "nodeVersion", plan.NodeVersion)
+		o.setStatusLocked(Status{PlanName: plan.Name, PlanHeight: plan.Height, NodeVersion: plan.NodeVersion, Stage: StagePreparing})
+		go o.prepare(plan)
+	}
+
+	switch o.status.Stage {
+	case StagePreparing, StageReady, StagePreflightFailed:
+		if plan.Name == o.status.PlanName && plan.NodeVersion != "" && height >= plan.Height-1 {
+			o.switchNodeVersionLocked(plan, height)
+		}
+	case StageSwitched:
+		if height >= o.status.SwitchedAtHeight+o.configManager.GetUpgradeConfig().HealthCheckBlocks && o.busy.CompareAndSwap(false, true) {
+			go o.verifySwitch(o.status.PlanName, o.status.NodeVersion)
+		}
+	}
+}
+
+// Rollback switches the ML nodes back to the version they ran before the latest switch
+func (o *Orchestrator) Rollback() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	switch o.status.Stage {
+	case StageSwitched, StageCompleted, StageUnhealthy:
+	default:
+		return ErrNothingToRollBack
+	}
+	if o.status.PreviousNodeVersion == "" {
+		return ErrNothingToRollBack
+	}
+	return o.rollbackLocked("rollback requested")
+}
+
+func (o *Orchestrator) switchNodeVersionLocked(plan apiconfig.UpgradePlan, height int64) {
+	if o.status.Stage == StagePreflightFailed {
+		logging.Warn("Switching MLNode version although the upgrade pre-flight checks failed", types.Upgrades,
+			"name", plan.Name, "checks", o.status.Checks)
+	}
+	previous := o.configManager.GetCurrentNodeVersion()
+	status := o.status
+	status.PreviousNodeVersion = previous
+	status.SwitchedAtHeight = height
+	if plan.NodeVersion == previous {
+		status.Stage = StageCompleted
+		o.setStatusLocked(status)
+		return
+	}
+	if err := o.configManager.SetCurrentNodeVersion(plan.NodeVersion); err != nil {
+		logging.Error("Failed to switch MLNode version", types.Upgrades, "error", err)
+		return
+	}
+	logging.Info("MLNode version switched for upgrade", types.Upgrades, "name", plan.Name, "oldVersion", previous, "newVersion", plan.NodeVersion, "height", height)
+	if len(plan.Binaries) == 0 {
+		o.configManager.ClearUpgradePlan()
+	}
+	status.Stage = StageSwitched
+	o.setStatusLocked(status)
+}
+
+// verifySwitch keeps the new version when at least one ML node is healthy on it. A version no node
+// serves is a broken release rather than a node problem, so the switch is rolled back.
+func (o *Orchestrator) verifySwitch(planName, version string) {
+	defer o.busy.Store(false)
+	reports := o.nodes.CheckVersionHealth(version)
+	check := nodeVersionCheck(version, reports)
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.status.PlanName != planName || o.status.Stage != StageSwitched {
+		return
+	}
+	o.status.Checks = append(o.status.Checks, check)
+	status := o.status
+	if check.Passed {
+		status.Stage = StageCompleted
+		o.setStatusLocked(status)
+		return
+	}
+	logging.Error("No MLNode is healthy on the upgraded version", types.Upgrades, "version", version, "reports", reports)
+	if o.configManager.GetUpgradeConfig().DisableRollback || o.status.PreviousNodeVersion == "" {
+		status.Stage = StageUnhealthy
+		status.Error = check.Message
+		o.setStatusLocked(status)
+		return
+	}
+	if err := o.rollbackLocked(check.Message); err != nil {
+		logging.Error("Failed to roll back MLNode version", types.Upgrades, "error", err)
+	}
+}
+
+func (o *Orchestrator) rollbackLocked(reason string) error {
+	previous := o.status.PreviousNodeVersion
+	if err := o.configManager.SetCurrentNodeVersion(previous); err != nil {
+		return err
+	}
+	logging.Warn("MLNode version rolled back", types.Upgrades, "name", o.status.PlanName,
+		"from", o.status.NodeVersion, "to", previous, "reason", reason)
+	status := o.status
+	status.Stage = StageRolledBack
+	status.Error = reason
+	o.setStatusLocked(status)
+	return nil
+}
+
+func (o *Orchestrator) setStatusLocked(status Status) {
+	status.UpdatedAt = time.Now()
+	o.status = status
+}
+
+// prepare pre-downloads the API binary of the plan and runs the pre-flight checks
+func (o *Orchestrator) prepare(plan apiconfig.UpgradePlan) {
+	var checks []CheckResult
+	var binaryPath string
+	if len(plan.Binaries) > 0 {
+		binaryUrl, check := platformBinary(plan.Binaries)
+		checks = append(checks, check)
+		if check.Passed {
+			target := o.binaryTarget(plan.Name, binaryUrl)
+			diskCheck := diskSpaceCheck(filepath.Dir(target), o.configManager.GetUpgradeConfig().MinFreeDiskMB)
+			checks = append(checks, diskCheck)
+			if diskCheck.Passed {
+				downloadCheck := CheckResult{Name: CheckDownload, Passed: true, Message: target}
+				if err := o.download(context.Background(), binaryUrl, target); err != nil {
+					downloadCheck = CheckResult{Name: CheckDownload, Message: err.Error()}
+				} else {
+					binaryPath = target
+				}
+				checks = append(checks, downloadCheck)
+			}
+		}
+	}
+	if plan.NodeVersion != "" {
+		checks = append(checks, nodeVersionCheck(plan.NodeVersion, o.nodes.CheckVersionHealth(plan.NodeVersion)))
+	}
+
+	stage := StageReady
+	for _, check := range checks {
+		if !check.Passed {
+			stage = StagePreflightFailed
+		}
+	}
+	if stage == StageReady {
+		logging.Info("Upgrade pre-flight checks passed", types.Upgrades, "name", plan.Name, "checks", checks)
+	} else {
+		logging.Error("Upgrade pre-flight checks failed", types.Upgrades, "name", plan.Name, "checks", checks)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.status.PlanName != plan.Name {
+		return
+	}
+	status := o.status
+	status.Checks = append(checks, status.Checks...)
+	status.BinaryPath = binaryPath
+	if status.Stage == StagePreparing {
+		status.Stage = stage
+	}
+	o.setStatusLocked(status)
+}
+
+// platformBinary returns the URL of the binary for this platform, keyed as cosmovisor does
+func platformBinary(binaries map[string]string) (string, CheckResult) {
+	platform := runtime.GOOS + "/" + runtime.GOARCH
+	for _, key := range []string{platform, "any"} {
+		if binaryUrl, ok := binaries[key]; ok {
+			return binaryUrl, CheckResult{Name: CheckBinary, Passed: true, Message: key}
+		}
+	}
+	return "", CheckResult{Name: CheckBinary, Message: "no binary for " + platform}
+}
+
+// binaryTarget is where cosmovisor looks for the binary of the upgrade when running under it, so it
+// does not download it again. Archives are unpacked by cosmovisor and only kept in the download dir.
+func (o *Orchestrator) binaryTarget(planName, binaryUrl string) string {
+	home, name := os.Getenv("DAEMON_HOME"), os.Getenv("DAEMON_NAME")
+	if home != "" && name != "" && !isArchive(binaryUrl) {
+		return filepath.Join(home, "cosmovisor", "upgrades", url.PathEscape(planName), "bin", name)
+	}
+	return filepath.Join(o.configManager.GetUpgradeConfig().DownloadDir, url.PathEscape(planName), path.Base(stripChecksum(binaryUrl)))
+}
+
+func isArchive(binaryUrl string) bool {
+	p := strings.ToLower(stripChecksum(binaryUrl))
+	for _, ext := range []string{".zip", ".tar", ".tar.gz", ".tgz", ".tar.bz2", ".tbz2", ".tar.xz", ".txz"} {
+		if strings.HasSuffix(p, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripChecksum removes the cosmovisor checksum parameter, which the download server doesn't expect
+func stripChecksum(binaryUrl string) string {
+	u, err := url.Parse(binaryUrl)
+	if err != nil {
+		return binaryUrl
+	}
+	q := u.Query()
+	q.Del("checksum")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// expectedChecksum returns the sha256 of a binary URL with a checksum=sha256:<hex> parameter
+func expectedChecksum(binaryUrl string) (string, error) {
+	u, err := url.Parse(binaryUrl)
+	if err != nil {
+		return "", err
+	}
+	checksum := u.Query().Get("checksum")
+	if checksum == "" {
+		return "", nil
+	}
+	algo, sum, ok := strings.Cut(checksum, ":")
+	if !ok || algo != "sha256" {
+		return "", fmt.Errorf("unsupported checksum %q", checksum)
+	}
+	return strings.ToLower(sum), nil
+}
+
+// download fetches the binary to target unless a file with the expected checksum is already there
+func (o *Orchestrator) download(ctx context.Context, binaryUrl, target string) error {
+	expected, err := expectedChecksum(binaryUrl)
+	if err != nil {
+		return err
+	}
+	if expected != "" {
+		if sum, err := fileChecksum(target); err == nil && sum == expected {
+			return nil
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stripChecksum(binaryUrl), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), resp.Body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if sum := hex.EncodeToString(hash.Sum(nil)); expected != "" && sum != expected {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expected, sum)
+	}
+	if err := os.Chmod(tmp.Name(), 0o755); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), target)
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func diskSpaceCheck(dir string, minFreeMB int64) CheckResult {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return CheckResult{Name: CheckDiskSpace, Message: err.Error()}
+	}
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(dir, &stat); err != nil {
+		return CheckResult{Name: CheckDiskSpace, Message: err.Error()}
+	}
+	freeMB := int64(uint64(stat.Bavail) * uint64(stat.Bsize) / (1 << 20))
+	return CheckResult{
+		Name:    CheckDiskSpace,
+		Passed:  freeMB >= minFreeMB,
+		Message: fmt.Sprintf("%d MB free in %s, %d MB required", freeMB, dir, minFreeMB),
+	}
+}
+
+// nodeVersionCheck passes when at least one ML node serves the version, or there are no nodes
+func nodeVersionCheck(version string, reports map[string]broker.VersionHealthReport) CheckResult {
+	alive := 0
+	for _, report := range reports {
+		if report.IsAlive {
+			alive++
+		}
+	}
+	return CheckResult{
+		Name:    CheckNodeVersion,
+		Passed:  alive > 0 || len(reports) == 0,
+		Message: fmt.Sprintf("%d of %d ML nodes healthy on version %s", alive, len(reports), version),
+	}
+}
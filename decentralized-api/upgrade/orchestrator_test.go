@@ -0,0 +1,143 @@
+package upgrade
+
+import (
+	"crypto/sha256"
+	"decentralized-api/apiconfig"
+	"decentralized-api/broker"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/knadh/koanf/providers/rawbytes"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeNodes struct {
+	mu    sync.Mutex
+	alive map[string]bool
+}
+
+func (f *fakeNodes) setAlive(version string, alive bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.alive[version] = alive
+}
+
+func (f *fakeNodes) CheckVersionHealth(version string) map[string]broker.VersionHealthReport {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return map[string]broker.VersionHealthReport{"node1": {IsAlive: f.alive[version]}}
+}
+
+func newTestOrchestrator(t *testing.T, nodes *fakeNodes) (*Orchestrator, *apiconfig.ConfigManager) {
+	t.Setenv("DAEMON_HOME", "")
+	yaml := fmt.Sprintf("upgrade:\n  download_dir: %s\n  min_free_disk_mb: 1\n  health_check_blocks: 5\n", t.TempDir())
+	configManager := &apiconfig.ConfigManager{KoanProvider: rawbytes.Provider([]byte(yaml))}
+	require.NoError(t, configManager.Load())
+	require.NoError(t, configManager.SetCurrentNodeVersion("v1"))
+	return NewOrchestrator(configManager, nodes), configManager
+}
+
+func waitForStage(t *testing.T, o *Orchestrator, stage Stage) Status {
+	require.Eventually(t, func() bool { return o.Status().Stage == stage }, 5*time.Second, 10*time.Millisecond)
+	return o.Status()
+}
+
+func TestOrchestratorPreparesAndSwitches(t *testing.T) {
+	binary := []byte("new api binary")
+	sum := sha256.Sum256(binary)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.Query().Get("checksum"))
+		_, _ = w.Write(binary)
+	}))
+	defer server.Close()
+
+	nodes := &fakeNodes{alive: map[string]bool{"v2": true}}
+	o, configManager := newTestOrchestrator(t, nodes)
+	require.NoError(t, configManager.SetUpgradePlan(apiconfig.UpgradePlan{
+		Name:        "v0.2.0",
+		Height:      100,
+		NodeVersion: "v2",
+		Binaries: map[string]string{
+			runtime.GOOS + "/" + runtime.GOARCH: server.URL + "/inferenced?checksum=sha256:" + hex.EncodeToString(sum[:]),
+		},
+	}))
+
+	o.OnNewBlock(50)
+	status := waitForStage(t, o, StageReady)
+	require.Len(t, status.Checks, 4)
+	bz, err := os.ReadFile(status.BinaryPath)
+	require.NoError(t, err)
+	require.Equal(t, binary, bz)
+	require.Equal(t, "v0.2.0", filepath.Base(filepath.Dir(status.BinaryPath)))
+
+	o.OnNewBlock(99)
+	require.Equal(t, "v2", configManager.GetCurrentNodeVersion())
+	require.Equal(t, StageSwitched, o.Status().Stage)
+	// The plan is kept for cosmovisor to switch the API binary
+	require.Equal(t, "v0.2.0", configManager.GetUpgradePlan().Name)
+
+	o.OnNewBlock(104)
+	require.Equal(t, StageSwitched, o.Status().Stage)
+	o.OnNewBlock(105)
+	waitForStage(t, o, StageCompleted)
+	require.Equal(t, "v2", configManager.GetCurrentNodeVersion())
+
+	require.NoError(t, o.Rollback())
+	require.Equal(t, "v1", configManager.GetCurrentNodeVersion())
+	require.ErrorIs(t, o.Rollback(), ErrNothingToRollBack)
+}
+
+func TestOrchestratorRollsBackUnhealthyVersion(t *testing.T) {
+	nodes := &fakeNodes{alive: map[string]bool{}}
+	o, configManager := newTestOrchestrator(t, nodes)
+	require.ErrorIs(t, o.Rollback(), ErrNothingToRollBack)
+	require.NoError(t, configManager.SetUpgradePlan(apiconfig.UpgradePlan{Name: "node-v2", Height: 20, NodeVersion: "v2"}))
+
+	o.OnNewBlock(10)
+	status := waitForStage(t, o, StagePreflightFailed)
+	require.Equal(t, CheckNodeVersion, status.Checks[0].Name)
+	require.False(t, status.Checks[0].Passed)
+
+	// The on-chain version is switched to even when the pre-flight checks failed
+	o.OnNewBlock(19)
+	require.Equal(t, "v2", configManager.GetCurrentNodeVersion())
+	require.Empty(t, configManager.GetUpgradePlan().Name)
+
+	o.OnNewBlock(24)
+	status = waitForStage(t, o, StageRolledBack)
+	require.Equal(t, "v1", configManager.GetCurrentNodeVersion())
+	require.Equal(t, "v1", status.PreviousNodeVersion)
+	require.NotEmpty(t, status.Error)
+}
+
+func TestOrchestratorPreflightFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("tampered"))
+	}))
+	defer server.Close()
+
+	o, configManager := newTestOrchestrator(t, &fakeNodes{alive: map[string]bool{}})
+	require.NoError(t, configManager.SetUpgradePlan(apiconfig.UpgradePlan{
+		Name:     "v0.3.0",
+		Height:   100,
+		Binaries: map[string]string{"any": server.URL + "/inferenced?checksum=sha256:00"},
+	}))
+	o.OnNewBlock(50)
+	status := waitForStage(t, o, StagePreflightFailed)
+	require.Equal(t, CheckDownload, status.Checks[2].Name)
+	require.Contains(t, status.Checks[2].Message, "checksum mismatch")
+	require.Empty(t, status.BinaryPath)
+
+	_, check := platformBinary(map[string]string{"plan9/mips": "https://example.com/bin"})
+	require.False(t, check.Passed)
+	require.True(t, isArchive("https://example.com/inferenced.tar.gz?checksum=sha256:00"))
+	require.False(t, isArchive("https://example.com/inferenced?checksum=sha256:00"))
+}
@@ -11,4 +11,9 @@ const (
 	XPromptHashHeader       = "X-Prompt-Hash"
 	XValidatorAddressHeader = "X-Validator-Address"
 	XEpochIdHeader          = "X-Epoch-Id"
+	XApiKeyHeader           = "X-Api-Key"
+	IdempotencyKeyHeader    = "Idempotency-Key"
+	IdempotentReplayHeader  = "Idempotent-Replayed"
+	XContentFilterHeader    = "X-Content-Filter"
+	XHedgedRequestHeader    = "X-Hedged-Request"
 )
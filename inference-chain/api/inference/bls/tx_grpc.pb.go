@@ -25,6 +25,7 @@ const (
 	Msg_SubmitGroupKeyValidationSignature_FullMethodName = "/inference.bls.Msg/SubmitGroupKeyValidationSignature"
 	Msg_SubmitPartialSignature_FullMethodName            = "/inference.bls.Msg/SubmitPartialSignature"
 	Msg_RequestThresholdSignature_FullMethodName         = "/inference.bls.Msg/RequestThresholdSignature"
+	Msg_UpdateExternalSigningPolicy_FullMethodName       = "/inference.bls.Msg/UpdateExternalSigningPolicy"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +45,8 @@ type MsgClient interface {
 	SubmitPartialSignature(ctx context.Context, in *MsgSubmitPartialSignature, opts ...grpc.CallOption) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(ctx context.Context, in *MsgRequestThresholdSignature, opts ...grpc.CallOption) (*MsgRequestThresholdSignatureResponse, error)
+	// UpdateExternalSigningPolicy is a governance operation selecting who may request threshold signatures
+	UpdateExternalSigningPolicy(ctx context.Context, in *MsgUpdateExternalSigningPolicy, opts ...grpc.CallOption) (*MsgUpdateExternalSigningPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -108,6 +111,15 @@ func (c *msgClient) RequestThresholdSignature(ctx context.Context, in *MsgReques
 	return out, nil
 }
 
+func (c *msgClient) UpdateExternalSigningPolicy(ctx context.Context, in *MsgUpdateExternalSigningPolicy, opts ...grpc.CallOption) (*MsgUpdateExternalSigningPolicyResponse, error) {
+	out := new(MsgUpdateExternalSigningPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateExternalSigningPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -125,6 +137,8 @@ type MsgServer interface {
 	SubmitPartialSignature(context.Context, *MsgSubmitPartialSignature) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error)
+	// UpdateExternalSigningPolicy is a governance operation selecting who may request threshold signatures
+	UpdateExternalSigningPolicy(context.Context, *MsgUpdateExternalSigningPolicy) (*MsgUpdateExternalSigningPolicyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -150,6 +164,9 @@ func (UnimplementedMsgServer) SubmitPartialSignature(context.Context, *MsgSubmit
 func (UnimplementedMsgServer) RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestThresholdSignature not implemented")
 }
+func (UnimplementedMsgServer) UpdateExternalSigningPolicy(context.Context, *MsgUpdateExternalSigningPolicy) (*MsgUpdateExternalSigningPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExternalSigningPolicy not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -271,6 +288,24 @@ func _Msg_RequestThresholdSignature_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateExternalSigningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateExternalSigningPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateExternalSigningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateExternalSigningPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateExternalSigningPolicy(ctx, req.(*MsgUpdateExternalSigningPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -302,6 +337,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RequestThresholdSignature",
 			Handler:    _Msg_RequestThresholdSignature_Handler,
 		},
+		{
+			MethodName: "UpdateExternalSigningPolicy",
+			Handler:    _Msg_UpdateExternalSigningPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/tx.proto",
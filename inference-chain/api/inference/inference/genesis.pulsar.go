@@ -586,475 +586,1531 @@ func (x *_GenesisState_5_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                     protoreflect.MessageDescriptor
-	fd_GenesisState_params              protoreflect.FieldDescriptor
-	fd_GenesisState_genesis_only_params protoreflect.FieldDescriptor
-	fd_GenesisState_model_list          protoreflect.FieldDescriptor
-	fd_GenesisState_cosm_wasm_params    protoreflect.FieldDescriptor
-	fd_GenesisState_participant_list    protoreflect.FieldDescriptor
-	fd_GenesisState_mlnode_version      protoreflect.FieldDescriptor
-	fd_GenesisState_bridge              protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
 
-func init() {
-	file_inference_inference_genesis_proto_init()
-	md_GenesisState = File_inference_inference_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_genesis_only_params = md_GenesisState.Fields().ByName("genesis_only_params")
-	fd_GenesisState_model_list = md_GenesisState.Fields().ByName("model_list")
-	fd_GenesisState_cosm_wasm_params = md_GenesisState.Fields().ByName("cosm_wasm_params")
-	fd_GenesisState_participant_list = md_GenesisState.Fields().ByName("participant_list")
-	fd_GenesisState_mlnode_version = md_GenesisState.Fields().ByName("mlnode_version")
-	fd_GenesisState_bridge = md_GenesisState.Fields().ByName("bridge")
+type _GenesisState_8_list struct {
+	list *[]*ParticipantExit
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantExit)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_inference_inference_genesis_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantExit)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantExit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(ParticipantExit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*ParticipantDelegations
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDelegations)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDelegations)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if x.GenesisOnlyParams != nil {
-		value := protoreflect.ValueOfMessage(x.GenesisOnlyParams.ProtoReflect())
-		if !f(fd_GenesisState_genesis_only_params, value) {
-			return
-		}
-	}
-	if len(x.ModelList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ModelList})
-		if !f(fd_GenesisState_model_list, value) {
-			return
-		}
-	}
-	if x.CosmWasmParams != nil {
-		value := protoreflect.ValueOfMessage(x.CosmWasmParams.ProtoReflect())
-		if !f(fd_GenesisState_cosm_wasm_params, value) {
-			return
-		}
-	}
-	if len(x.ParticipantList) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ParticipantList})
-		if !f(fd_GenesisState_participant_list, value) {
-			return
-		}
-	}
-	if x.MlnodeVersion != nil {
-		value := protoreflect.ValueOfMessage(x.MlnodeVersion.ProtoReflect())
-		if !f(fd_GenesisState_mlnode_version, value) {
-			return
-		}
-	}
-	if x.Bridge != nil {
-		value := protoreflect.ValueOfMessage(x.Bridge.ProtoReflect())
-		if !f(fd_GenesisState_bridge, value) {
-			return
-		}
-	}
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantDelegations)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "inference.inference.GenesisState.params":
-		return x.Params != nil
-	case "inference.inference.GenesisState.genesis_only_params":
-		return x.GenesisOnlyParams != nil
-	case "inference.inference.GenesisState.model_list":
-		return len(x.ModelList) != 0
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		return x.CosmWasmParams != nil
-	case "inference.inference.GenesisState.participant_list":
-		return len(x.ParticipantList) != 0
-	case "inference.inference.GenesisState.mlnode_version":
-		return x.MlnodeVersion != nil
-	case "inference.inference.GenesisState.bridge":
-		return x.Bridge != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "inference.inference.GenesisState.params":
-		x.Params = nil
-	case "inference.inference.GenesisState.genesis_only_params":
-		x.GenesisOnlyParams = nil
-	case "inference.inference.GenesisState.model_list":
-		x.ModelList = nil
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		x.CosmWasmParams = nil
-	case "inference.inference.GenesisState.participant_list":
-		x.ParticipantList = nil
-	case "inference.inference.GenesisState.mlnode_version":
-		x.MlnodeVersion = nil
-	case "inference.inference.GenesisState.bridge":
-		x.Bridge = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ParticipantDelegations)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "inference.inference.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "inference.inference.GenesisState.genesis_only_params":
-		value := x.GenesisOnlyParams
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "inference.inference.GenesisState.model_list":
-		if len(x.ModelList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.ModelList}
-		return protoreflect.ValueOfList(listValue)
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		value := x.CosmWasmParams
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "inference.inference.GenesisState.participant_list":
-		if len(x.ParticipantList) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.ParticipantList}
-		return protoreflect.ValueOfList(listValue)
-	case "inference.inference.GenesisState.mlnode_version":
-		value := x.MlnodeVersion
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "inference.inference.GenesisState.bridge":
-		value := x.Bridge
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", descriptor.FullName()))
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*EpochDelegation
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "inference.inference.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "inference.inference.GenesisState.genesis_only_params":
-		x.GenesisOnlyParams = value.Message().Interface().(*GenesisOnlyParams)
-	case "inference.inference.GenesisState.model_list":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.ModelList = *clv.list
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		x.CosmWasmParams = value.Message().Interface().(*CosmWasmParams)
-	case "inference.inference.GenesisState.participant_list":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.ParticipantList = *clv.list
-	case "inference.inference.GenesisState.mlnode_version":
-		x.MlnodeVersion = value.Message().Interface().(*MLNodeVersion)
-	case "inference.inference.GenesisState.bridge":
-		x.Bridge = value.Message().Interface().(*Bridge)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "inference.inference.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "inference.inference.GenesisState.genesis_only_params":
-		if x.GenesisOnlyParams == nil {
-			x.GenesisOnlyParams = new(GenesisOnlyParams)
-		}
-		return protoreflect.ValueOfMessage(x.GenesisOnlyParams.ProtoReflect())
-	case "inference.inference.GenesisState.model_list":
-		if x.ModelList == nil {
-			x.ModelList = []*Model{}
-		}
-		value := &_GenesisState_3_list{list: &x.ModelList}
-		return protoreflect.ValueOfList(value)
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		if x.CosmWasmParams == nil {
-			x.CosmWasmParams = new(CosmWasmParams)
-		}
-		return protoreflect.ValueOfMessage(x.CosmWasmParams.ProtoReflect())
-	case "inference.inference.GenesisState.participant_list":
-		if x.ParticipantList == nil {
-			x.ParticipantList = []*Participant{}
-		}
-		value := &_GenesisState_5_list{list: &x.ParticipantList}
-		return protoreflect.ValueOfList(value)
-	case "inference.inference.GenesisState.mlnode_version":
-		if x.MlnodeVersion == nil {
-			x.MlnodeVersion = new(MLNodeVersion)
-		}
-		return protoreflect.ValueOfMessage(x.MlnodeVersion.ProtoReflect())
-	case "inference.inference.GenesisState.bridge":
-		if x.Bridge == nil {
-			x.Bridge = new(Bridge)
-		}
-		return protoreflect.ValueOfMessage(x.Bridge.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EpochDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(EpochDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "inference.inference.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "inference.inference.GenesisState.genesis_only_params":
-		m := new(GenesisOnlyParams)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "inference.inference.GenesisState.model_list":
-		list := []*Model{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "inference.inference.GenesisState.cosm_wasm_params":
-		m := new(CosmWasmParams)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "inference.inference.GenesisState.participant_list":
-		list := []*Participant{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "inference.inference.GenesisState.mlnode_version":
-		m := new(MLNodeVersion)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "inference.inference.GenesisState.bridge":
-		m := new(Bridge)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
-		}
-		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(EpochDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*DelegationUnbonding
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in inference.inference.GenesisState", d.FullName()))
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationUnbonding)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelegationUnbonding)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(DelegationUnbonding)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(DelegationUnbonding)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*InferencePayment
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GenesisOnlyParams != nil {
-			l = options.Size(x.GenesisOnlyParams)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.ModelList) > 0 {
-			for _, e := range x.ModelList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.CosmWasmParams != nil {
-			l = options.Size(x.CosmWasmParams)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.ParticipantList) > 0 {
-			for _, e := range x.ParticipantList {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.MlnodeVersion != nil {
-			l = options.Size(x.MlnodeVersion)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Bridge != nil {
-			l = options.Size(x.Bridge)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InferencePayment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InferencePayment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(InferencePayment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(InferencePayment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*DenomTreasury
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomTreasury)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomTreasury)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(DenomTreasury)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(DenomTreasury)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*ParticipantDenomBalance
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDenomBalance)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ParticipantDenomBalance)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(ParticipantDenomBalance)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(ParticipantDenomBalance)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*InferenceEscrow
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InferenceEscrow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*InferenceEscrow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(InferenceEscrow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(InferenceEscrow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*ReleasableEscrow
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReleasableEscrow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReleasableEscrow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(ReleasableEscrow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(ReleasableEscrow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*RewardVestingAccount
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardVestingAccount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*RewardVestingAccount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(RewardVestingAccount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(RewardVestingAccount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*SettleAmount
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SettleAmount)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*SettleAmount)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(SettleAmount)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(SettleAmount)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*PolicyEntry
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PolicyEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PolicyEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(PolicyEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(PolicyEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                                protoreflect.MessageDescriptor
+	fd_GenesisState_params                         protoreflect.FieldDescriptor
+	fd_GenesisState_genesis_only_params            protoreflect.FieldDescriptor
+	fd_GenesisState_model_list                     protoreflect.FieldDescriptor
+	fd_GenesisState_cosm_wasm_params               protoreflect.FieldDescriptor
+	fd_GenesisState_participant_list               protoreflect.FieldDescriptor
+	fd_GenesisState_mlnode_version                 protoreflect.FieldDescriptor
+	fd_GenesisState_bridge                         protoreflect.FieldDescriptor
+	fd_GenesisState_participant_exit_list          protoreflect.FieldDescriptor
+	fd_GenesisState_participant_delegations_list   protoreflect.FieldDescriptor
+	fd_GenesisState_epoch_delegation_list          protoreflect.FieldDescriptor
+	fd_GenesisState_delegation_unbonding_list      protoreflect.FieldDescriptor
+	fd_GenesisState_inference_payment_list         protoreflect.FieldDescriptor
+	fd_GenesisState_denom_treasury_list            protoreflect.FieldDescriptor
+	fd_GenesisState_participant_denom_balance_list protoreflect.FieldDescriptor
+	fd_GenesisState_inference_escrow_list          protoreflect.FieldDescriptor
+	fd_GenesisState_releasable_escrow_list         protoreflect.FieldDescriptor
+	fd_GenesisState_reward_vesting_account_list    protoreflect.FieldDescriptor
+	fd_GenesisState_settle_carryover_list          protoreflect.FieldDescriptor
+	fd_GenesisState_policy_list                    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_genesis_proto_init()
+	md_GenesisState = File_inference_inference_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_genesis_only_params = md_GenesisState.Fields().ByName("genesis_only_params")
+	fd_GenesisState_model_list = md_GenesisState.Fields().ByName("model_list")
+	fd_GenesisState_cosm_wasm_params = md_GenesisState.Fields().ByName("cosm_wasm_params")
+	fd_GenesisState_participant_list = md_GenesisState.Fields().ByName("participant_list")
+	fd_GenesisState_mlnode_version = md_GenesisState.Fields().ByName("mlnode_version")
+	fd_GenesisState_bridge = md_GenesisState.Fields().ByName("bridge")
+	fd_GenesisState_participant_exit_list = md_GenesisState.Fields().ByName("participant_exit_list")
+	fd_GenesisState_participant_delegations_list = md_GenesisState.Fields().ByName("participant_delegations_list")
+	fd_GenesisState_epoch_delegation_list = md_GenesisState.Fields().ByName("epoch_delegation_list")
+	fd_GenesisState_delegation_unbonding_list = md_GenesisState.Fields().ByName("delegation_unbonding_list")
+	fd_GenesisState_inference_payment_list = md_GenesisState.Fields().ByName("inference_payment_list")
+	fd_GenesisState_denom_treasury_list = md_GenesisState.Fields().ByName("denom_treasury_list")
+	fd_GenesisState_participant_denom_balance_list = md_GenesisState.Fields().ByName("participant_denom_balance_list")
+	fd_GenesisState_inference_escrow_list = md_GenesisState.Fields().ByName("inference_escrow_list")
+	fd_GenesisState_releasable_escrow_list = md_GenesisState.Fields().ByName("releasable_escrow_list")
+	fd_GenesisState_reward_vesting_account_list = md_GenesisState.Fields().ByName("reward_vesting_account_list")
+	fd_GenesisState_settle_carryover_list = md_GenesisState.Fields().ByName("settle_carryover_list")
+	fd_GenesisState_policy_list = md_GenesisState.Fields().ByName("policy_list")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if x.GenesisOnlyParams != nil {
+		value := protoreflect.ValueOfMessage(x.GenesisOnlyParams.ProtoReflect())
+		if !f(fd_GenesisState_genesis_only_params, value) {
+			return
+		}
+	}
+	if len(x.ModelList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ModelList})
+		if !f(fd_GenesisState_model_list, value) {
+			return
+		}
+	}
+	if x.CosmWasmParams != nil {
+		value := protoreflect.ValueOfMessage(x.CosmWasmParams.ProtoReflect())
+		if !f(fd_GenesisState_cosm_wasm_params, value) {
+			return
+		}
+	}
+	if len(x.ParticipantList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.ParticipantList})
+		if !f(fd_GenesisState_participant_list, value) {
+			return
+		}
+	}
+	if x.MlnodeVersion != nil {
+		value := protoreflect.ValueOfMessage(x.MlnodeVersion.ProtoReflect())
+		if !f(fd_GenesisState_mlnode_version, value) {
+			return
+		}
+	}
+	if x.Bridge != nil {
+		value := protoreflect.ValueOfMessage(x.Bridge.ProtoReflect())
+		if !f(fd_GenesisState_bridge, value) {
+			return
+		}
+	}
+	if len(x.ParticipantExitList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.ParticipantExitList})
+		if !f(fd_GenesisState_participant_exit_list, value) {
+			return
+		}
+	}
+	if len(x.ParticipantDelegationsList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.ParticipantDelegationsList})
+		if !f(fd_GenesisState_participant_delegations_list, value) {
+			return
+		}
+	}
+	if len(x.EpochDelegationList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.EpochDelegationList})
+		if !f(fd_GenesisState_epoch_delegation_list, value) {
+			return
+		}
+	}
+	if len(x.DelegationUnbondingList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.DelegationUnbondingList})
+		if !f(fd_GenesisState_delegation_unbonding_list, value) {
+			return
+		}
+	}
+	if len(x.InferencePaymentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.InferencePaymentList})
+		if !f(fd_GenesisState_inference_payment_list, value) {
+			return
+		}
+	}
+	if len(x.DenomTreasuryList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.DenomTreasuryList})
+		if !f(fd_GenesisState_denom_treasury_list, value) {
+			return
+		}
+	}
+	if len(x.ParticipantDenomBalanceList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.ParticipantDenomBalanceList})
+		if !f(fd_GenesisState_participant_denom_balance_list, value) {
+			return
+		}
+	}
+	if len(x.InferenceEscrowList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.InferenceEscrowList})
+		if !f(fd_GenesisState_inference_escrow_list, value) {
+			return
+		}
+	}
+	if len(x.ReleasableEscrowList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.ReleasableEscrowList})
+		if !f(fd_GenesisState_releasable_escrow_list, value) {
+			return
+		}
+	}
+	if len(x.RewardVestingAccountList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.RewardVestingAccountList})
+		if !f(fd_GenesisState_reward_vesting_account_list, value) {
+			return
+		}
+	}
+	if len(x.SettleCarryoverList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.SettleCarryoverList})
+		if !f(fd_GenesisState_settle_carryover_list, value) {
+			return
+		}
+	}
+	if len(x.PolicyList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.PolicyList})
+		if !f(fd_GenesisState_policy_list, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.GenesisState.params":
+		return x.Params != nil
+	case "inference.inference.GenesisState.genesis_only_params":
+		return x.GenesisOnlyParams != nil
+	case "inference.inference.GenesisState.model_list":
+		return len(x.ModelList) != 0
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		return x.CosmWasmParams != nil
+	case "inference.inference.GenesisState.participant_list":
+		return len(x.ParticipantList) != 0
+	case "inference.inference.GenesisState.mlnode_version":
+		return x.MlnodeVersion != nil
+	case "inference.inference.GenesisState.bridge":
+		return x.Bridge != nil
+	case "inference.inference.GenesisState.participant_exit_list":
+		return len(x.ParticipantExitList) != 0
+	case "inference.inference.GenesisState.participant_delegations_list":
+		return len(x.ParticipantDelegationsList) != 0
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		return len(x.EpochDelegationList) != 0
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		return len(x.DelegationUnbondingList) != 0
+	case "inference.inference.GenesisState.inference_payment_list":
+		return len(x.InferencePaymentList) != 0
+	case "inference.inference.GenesisState.denom_treasury_list":
+		return len(x.DenomTreasuryList) != 0
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		return len(x.ParticipantDenomBalanceList) != 0
+	case "inference.inference.GenesisState.inference_escrow_list":
+		return len(x.InferenceEscrowList) != 0
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		return len(x.ReleasableEscrowList) != 0
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		return len(x.RewardVestingAccountList) != 0
+	case "inference.inference.GenesisState.settle_carryover_list":
+		return len(x.SettleCarryoverList) != 0
+	case "inference.inference.GenesisState.policy_list":
+		return len(x.PolicyList) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.GenesisState.params":
+		x.Params = nil
+	case "inference.inference.GenesisState.genesis_only_params":
+		x.GenesisOnlyParams = nil
+	case "inference.inference.GenesisState.model_list":
+		x.ModelList = nil
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		x.CosmWasmParams = nil
+	case "inference.inference.GenesisState.participant_list":
+		x.ParticipantList = nil
+	case "inference.inference.GenesisState.mlnode_version":
+		x.MlnodeVersion = nil
+	case "inference.inference.GenesisState.bridge":
+		x.Bridge = nil
+	case "inference.inference.GenesisState.participant_exit_list":
+		x.ParticipantExitList = nil
+	case "inference.inference.GenesisState.participant_delegations_list":
+		x.ParticipantDelegationsList = nil
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		x.EpochDelegationList = nil
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		x.DelegationUnbondingList = nil
+	case "inference.inference.GenesisState.inference_payment_list":
+		x.InferencePaymentList = nil
+	case "inference.inference.GenesisState.denom_treasury_list":
+		x.DenomTreasuryList = nil
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		x.ParticipantDenomBalanceList = nil
+	case "inference.inference.GenesisState.inference_escrow_list":
+		x.InferenceEscrowList = nil
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		x.ReleasableEscrowList = nil
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		x.RewardVestingAccountList = nil
+	case "inference.inference.GenesisState.settle_carryover_list":
+		x.SettleCarryoverList = nil
+	case "inference.inference.GenesisState.policy_list":
+		x.PolicyList = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.GenesisState.genesis_only_params":
+		value := x.GenesisOnlyParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.GenesisState.model_list":
+		if len(x.ModelList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.ModelList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		value := x.CosmWasmParams
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.GenesisState.participant_list":
+		if len(x.ParticipantList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.ParticipantList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.mlnode_version":
+		value := x.MlnodeVersion
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.GenesisState.bridge":
+		value := x.Bridge
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "inference.inference.GenesisState.participant_exit_list":
+		if len(x.ParticipantExitList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.ParticipantExitList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.participant_delegations_list":
+		if len(x.ParticipantDelegationsList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.ParticipantDelegationsList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		if len(x.EpochDelegationList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.EpochDelegationList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		if len(x.DelegationUnbondingList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.DelegationUnbondingList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.inference_payment_list":
+		if len(x.InferencePaymentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.InferencePaymentList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.denom_treasury_list":
+		if len(x.DenomTreasuryList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.DenomTreasuryList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		if len(x.ParticipantDenomBalanceList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.ParticipantDenomBalanceList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.inference_escrow_list":
+		if len(x.InferenceEscrowList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.InferenceEscrowList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		if len(x.ReleasableEscrowList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.ReleasableEscrowList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		if len(x.RewardVestingAccountList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.RewardVestingAccountList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.settle_carryover_list":
+		if len(x.SettleCarryoverList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.SettleCarryoverList}
+		return protoreflect.ValueOfList(listValue)
+	case "inference.inference.GenesisState.policy_list":
+		if len(x.PolicyList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.PolicyList}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "inference.inference.GenesisState.genesis_only_params":
+		x.GenesisOnlyParams = value.Message().Interface().(*GenesisOnlyParams)
+	case "inference.inference.GenesisState.model_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.ModelList = *clv.list
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		x.CosmWasmParams = value.Message().Interface().(*CosmWasmParams)
+	case "inference.inference.GenesisState.participant_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.ParticipantList = *clv.list
+	case "inference.inference.GenesisState.mlnode_version":
+		x.MlnodeVersion = value.Message().Interface().(*MLNodeVersion)
+	case "inference.inference.GenesisState.bridge":
+		x.Bridge = value.Message().Interface().(*Bridge)
+	case "inference.inference.GenesisState.participant_exit_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.ParticipantExitList = *clv.list
+	case "inference.inference.GenesisState.participant_delegations_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.ParticipantDelegationsList = *clv.list
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.EpochDelegationList = *clv.list
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.DelegationUnbondingList = *clv.list
+	case "inference.inference.GenesisState.inference_payment_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.InferencePaymentList = *clv.list
+	case "inference.inference.GenesisState.denom_treasury_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.DenomTreasuryList = *clv.list
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.ParticipantDenomBalanceList = *clv.list
+	case "inference.inference.GenesisState.inference_escrow_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.InferenceEscrowList = *clv.list
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.ReleasableEscrowList = *clv.list
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.RewardVestingAccountList = *clv.list
+	case "inference.inference.GenesisState.settle_carryover_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.SettleCarryoverList = *clv.list
+	case "inference.inference.GenesisState.policy_list":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.PolicyList = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "inference.inference.GenesisState.genesis_only_params":
+		if x.GenesisOnlyParams == nil {
+			x.GenesisOnlyParams = new(GenesisOnlyParams)
+		}
+		return protoreflect.ValueOfMessage(x.GenesisOnlyParams.ProtoReflect())
+	case "inference.inference.GenesisState.model_list":
+		if x.ModelList == nil {
+			x.ModelList = []*Model{}
+		}
+		value := &_GenesisState_3_list{list: &x.ModelList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		if x.CosmWasmParams == nil {
+			x.CosmWasmParams = new(CosmWasmParams)
+		}
+		return protoreflect.ValueOfMessage(x.CosmWasmParams.ProtoReflect())
+	case "inference.inference.GenesisState.participant_list":
+		if x.ParticipantList == nil {
+			x.ParticipantList = []*Participant{}
+		}
+		value := &_GenesisState_5_list{list: &x.ParticipantList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.mlnode_version":
+		if x.MlnodeVersion == nil {
+			x.MlnodeVersion = new(MLNodeVersion)
+		}
+		return protoreflect.ValueOfMessage(x.MlnodeVersion.ProtoReflect())
+	case "inference.inference.GenesisState.bridge":
+		if x.Bridge == nil {
+			x.Bridge = new(Bridge)
+		}
+		return protoreflect.ValueOfMessage(x.Bridge.ProtoReflect())
+	case "inference.inference.GenesisState.participant_exit_list":
+		if x.ParticipantExitList == nil {
+			x.ParticipantExitList = []*ParticipantExit{}
+		}
+		value := &_GenesisState_8_list{list: &x.ParticipantExitList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.participant_delegations_list":
+		if x.ParticipantDelegationsList == nil {
+			x.ParticipantDelegationsList = []*ParticipantDelegations{}
+		}
+		value := &_GenesisState_9_list{list: &x.ParticipantDelegationsList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		if x.EpochDelegationList == nil {
+			x.EpochDelegationList = []*EpochDelegation{}
+		}
+		value := &_GenesisState_10_list{list: &x.EpochDelegationList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		if x.DelegationUnbondingList == nil {
+			x.DelegationUnbondingList = []*DelegationUnbonding{}
+		}
+		value := &_GenesisState_11_list{list: &x.DelegationUnbondingList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.inference_payment_list":
+		if x.InferencePaymentList == nil {
+			x.InferencePaymentList = []*InferencePayment{}
+		}
+		value := &_GenesisState_12_list{list: &x.InferencePaymentList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.denom_treasury_list":
+		if x.DenomTreasuryList == nil {
+			x.DenomTreasuryList = []*DenomTreasury{}
+		}
+		value := &_GenesisState_13_list{list: &x.DenomTreasuryList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		if x.ParticipantDenomBalanceList == nil {
+			x.ParticipantDenomBalanceList = []*ParticipantDenomBalance{}
+		}
+		value := &_GenesisState_14_list{list: &x.ParticipantDenomBalanceList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.inference_escrow_list":
+		if x.InferenceEscrowList == nil {
+			x.InferenceEscrowList = []*InferenceEscrow{}
+		}
+		value := &_GenesisState_15_list{list: &x.InferenceEscrowList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		if x.ReleasableEscrowList == nil {
+			x.ReleasableEscrowList = []*ReleasableEscrow{}
+		}
+		value := &_GenesisState_16_list{list: &x.ReleasableEscrowList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		if x.RewardVestingAccountList == nil {
+			x.RewardVestingAccountList = []*RewardVestingAccount{}
+		}
+		value := &_GenesisState_17_list{list: &x.RewardVestingAccountList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.settle_carryover_list":
+		if x.SettleCarryoverList == nil {
+			x.SettleCarryoverList = []*SettleAmount{}
+		}
+		value := &_GenesisState_18_list{list: &x.SettleCarryoverList}
+		return protoreflect.ValueOfList(value)
+	case "inference.inference.GenesisState.policy_list":
+		if x.PolicyList == nil {
+			x.PolicyList = []*PolicyEntry{}
+		}
+		value := &_GenesisState_19_list{list: &x.PolicyList}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.GenesisState.genesis_only_params":
+		m := new(GenesisOnlyParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.GenesisState.model_list":
+		list := []*Model{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "inference.inference.GenesisState.cosm_wasm_params":
+		m := new(CosmWasmParams)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.GenesisState.participant_list":
+		list := []*Participant{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "inference.inference.GenesisState.mlnode_version":
+		m := new(MLNodeVersion)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.GenesisState.bridge":
+		m := new(Bridge)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "inference.inference.GenesisState.participant_exit_list":
+		list := []*ParticipantExit{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "inference.inference.GenesisState.participant_delegations_list":
+		list := []*ParticipantDelegations{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "inference.inference.GenesisState.epoch_delegation_list":
+		list := []*EpochDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "inference.inference.GenesisState.delegation_unbonding_list":
+		list := []*DelegationUnbonding{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "inference.inference.GenesisState.inference_payment_list":
+		list := []*InferencePayment{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "inference.inference.GenesisState.denom_treasury_list":
+		list := []*DenomTreasury{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "inference.inference.GenesisState.participant_denom_balance_list":
+		list := []*ParticipantDenomBalance{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "inference.inference.GenesisState.inference_escrow_list":
+		list := []*InferenceEscrow{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "inference.inference.GenesisState.releasable_escrow_list":
+		list := []*ReleasableEscrow{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "inference.inference.GenesisState.reward_vesting_account_list":
+		list := []*RewardVestingAccount{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "inference.inference.GenesisState.settle_carryover_list":
+		list := []*SettleAmount{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "inference.inference.GenesisState.policy_list":
+		list := []*PolicyEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.GenesisState"))
+		}
+		panic(fmt.Errorf("message inference.inference.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GenesisOnlyParams != nil {
+			l = options.Size(x.GenesisOnlyParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ModelList) > 0 {
+			for _, e := range x.ModelList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CosmWasmParams != nil {
+			l = options.Size(x.CosmWasmParams)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ParticipantList) > 0 {
+			for _, e := range x.ParticipantList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MlnodeVersion != nil {
+			l = options.Size(x.MlnodeVersion)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Bridge != nil {
+			l = options.Size(x.Bridge)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.ParticipantExitList) > 0 {
+			for _, e := range x.ParticipantExitList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ParticipantDelegationsList) > 0 {
+			for _, e := range x.ParticipantDelegationsList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EpochDelegationList) > 0 {
+			for _, e := range x.EpochDelegationList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DelegationUnbondingList) > 0 {
+			for _, e := range x.DelegationUnbondingList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InferencePaymentList) > 0 {
+			for _, e := range x.InferencePaymentList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomTreasuryList) > 0 {
+			for _, e := range x.DenomTreasuryList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ParticipantDenomBalanceList) > 0 {
+			for _, e := range x.ParticipantDenomBalanceList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.InferenceEscrowList) > 0 {
+			for _, e := range x.InferenceEscrowList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReleasableEscrowList) > 0 {
+			for _, e := range x.ReleasableEscrowList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RewardVestingAccountList) > 0 {
+			for _, e := range x.RewardVestingAccountList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.SettleCarryoverList) > 0 {
+			for _, e := range x.SettleCarryoverList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PolicyList) > 0 {
+			for _, e := range x.PolicyList {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
@@ -1065,17 +2121,217 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PolicyList) > 0 {
+			for iNdEx := len(x.PolicyList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PolicyList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
+		}
+		if len(x.SettleCarryoverList) > 0 {
+			for iNdEx := len(x.SettleCarryoverList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SettleCarryoverList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if len(x.RewardVestingAccountList) > 0 {
+			for iNdEx := len(x.RewardVestingAccountList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RewardVestingAccountList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
+			}
+		}
+		if len(x.ReleasableEscrowList) > 0 {
+			for iNdEx := len(x.ReleasableEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReleasableEscrowList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.InferenceEscrowList) > 0 {
+			for iNdEx := len(x.InferenceEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InferenceEscrowList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.ParticipantDenomBalanceList) > 0 {
+			for iNdEx := len(x.ParticipantDenomBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantDenomBalanceList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.DenomTreasuryList) > 0 {
+			for iNdEx := len(x.DenomTreasuryList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomTreasuryList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.InferencePaymentList) > 0 {
+			for iNdEx := len(x.InferencePaymentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.InferencePaymentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.DelegationUnbondingList) > 0 {
+			for iNdEx := len(x.DelegationUnbondingList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelegationUnbondingList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.EpochDelegationList) > 0 {
+			for iNdEx := len(x.EpochDelegationList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EpochDelegationList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.ParticipantDelegationsList) > 0 {
+			for iNdEx := len(x.ParticipantDelegationsList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantDelegationsList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ParticipantExitList) > 0 {
+			for iNdEx := len(x.ParticipantExitList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ParticipantExitList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
 		}
 		if x.Bridge != nil {
 			encoded, err := options.Marshal(x.Bridge)
@@ -1114,123 +2370,405 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 						Buf:               input.Buf,
 					}, err
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if x.CosmWasmParams != nil {
-			encoded, err := options.Marshal(x.CosmWasmParams)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.ModelList) > 0 {
-			for iNdEx := len(x.ModelList) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.ModelList[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.CosmWasmParams != nil {
+			encoded, err := options.Marshal(x.CosmWasmParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ModelList) > 0 {
+			for iNdEx := len(x.ModelList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModelList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.GenesisOnlyParams != nil {
+			encoded, err := options.Marshal(x.GenesisOnlyParams)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisOnlyParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GenesisOnlyParams == nil {
+					x.GenesisOnlyParams = &GenesisOnlyParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GenesisOnlyParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModelList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.GenesisOnlyParams != nil {
-			encoded, err := options.Marshal(x.GenesisOnlyParams)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModelList = append(x.ModelList, &Model{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModelList[len(x.ModelList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmWasmParams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CosmWasmParams == nil {
+					x.CosmWasmParams = &CosmWasmParams{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CosmWasmParams); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantList = append(x.ParticipantList, &Participant{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantList[len(x.ParticipantList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MlnodeVersion", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MlnodeVersion == nil {
+					x.MlnodeVersion = &MLNodeVersion{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MlnodeVersion); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Bridge == nil {
+					x.Bridge = &Bridge{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bridge); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantExitList", wireType)
 				}
-				if iNdEx >= l {
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.ParticipantExitList = append(x.ParticipantExitList, &ParticipantExit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantExitList[len(x.ParticipantExitList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 9:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantDelegationsList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1257,16 +2795,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.ParticipantDelegationsList = append(x.ParticipantDelegationsList, &ParticipantDelegations{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantDelegationsList[len(x.ParticipantDelegationsList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 10:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GenesisOnlyParams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochDelegationList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1293,16 +2829,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.GenesisOnlyParams == nil {
-					x.GenesisOnlyParams = &GenesisOnlyParams{}
+				x.EpochDelegationList = append(x.EpochDelegationList, &EpochDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EpochDelegationList[len(x.EpochDelegationList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GenesisOnlyParams); err != nil {
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelegationUnbondingList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelegationUnbondingList = append(x.DelegationUnbondingList, &DelegationUnbonding{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelegationUnbondingList[len(x.DelegationUnbondingList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModelList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferencePaymentList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1329,14 +2897,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ModelList = append(x.ModelList, &Model{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModelList[len(x.ModelList)-1]); err != nil {
+				x.InferencePaymentList = append(x.InferencePaymentList, &InferencePayment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InferencePaymentList[len(x.InferencePaymentList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CosmWasmParams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomTreasuryList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1363,16 +2931,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.CosmWasmParams == nil {
-					x.CosmWasmParams = &CosmWasmParams{}
+				x.DenomTreasuryList = append(x.DenomTreasuryList, &DenomTreasury{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomTreasuryList[len(x.DenomTreasuryList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CosmWasmParams); err != nil {
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantDenomBalanceList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ParticipantDenomBalanceList = append(x.ParticipantDenomBalanceList, &ParticipantDenomBalance{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantDenomBalanceList[len(x.ParticipantDenomBalanceList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ParticipantList", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceEscrowList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1399,14 +2999,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ParticipantList = append(x.ParticipantList, &Participant{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ParticipantList[len(x.ParticipantList)-1]); err != nil {
+				x.InferenceEscrowList = append(x.InferenceEscrowList, &InferenceEscrow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.InferenceEscrowList[len(x.InferenceEscrowList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MlnodeVersion", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasableEscrowList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1433,16 +3033,48 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.MlnodeVersion == nil {
-					x.MlnodeVersion = &MLNodeVersion{}
+				x.ReleasableEscrowList = append(x.ReleasableEscrowList, &ReleasableEscrow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReleasableEscrowList[len(x.ReleasableEscrowList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MlnodeVersion); err != nil {
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RewardVestingAccountList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RewardVestingAccountList = append(x.RewardVestingAccountList, &RewardVestingAccount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RewardVestingAccountList[len(x.RewardVestingAccountList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bridge", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettleCarryoverList", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1469,10 +3101,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Bridge == nil {
-					x.Bridge = &Bridge{}
+				x.SettleCarryoverList = append(x.SettleCarryoverList, &SettleAmount{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SettleCarryoverList[len(x.SettleCarryoverList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Bridge); err != nil {
+				iNdEx = postIndex
+			case 19:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PolicyList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PolicyList = append(x.PolicyList, &PolicyEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PolicyList[len(x.PolicyList)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1585,6 +3249,22 @@ type GenesisState struct {
 	ParticipantList []*Participant  `protobuf:"bytes,5,rep,name=participant_list,json=participantList,proto3" json:"participant_list,omitempty"`
 	MlnodeVersion   *MLNodeVersion  `protobuf:"bytes,6,opt,name=mlnode_version,json=mlnodeVersion,proto3" json:"mlnode_version,omitempty"`
 	Bridge          *Bridge         `protobuf:"bytes,7,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	// participant_exit_list holds the exits in progress, until the collateral of each is released
+	ParticipantExitList        []*ParticipantExit        `protobuf:"bytes,8,rep,name=participant_exit_list,json=participantExitList,proto3" json:"participant_exit_list,omitempty"`
+	ParticipantDelegationsList []*ParticipantDelegations `protobuf:"bytes,9,rep,name=participant_delegations_list,json=participantDelegationsList,proto3" json:"participant_delegations_list,omitempty"`
+	// epoch_delegation_list holds the delegations of the epochs not settled yet
+	EpochDelegationList         []*EpochDelegation         `protobuf:"bytes,10,rep,name=epoch_delegation_list,json=epochDelegationList,proto3" json:"epoch_delegation_list,omitempty"`
+	DelegationUnbondingList     []*DelegationUnbonding     `protobuf:"bytes,11,rep,name=delegation_unbonding_list,json=delegationUnbondingList,proto3" json:"delegation_unbonding_list,omitempty"`
+	InferencePaymentList        []*InferencePayment        `protobuf:"bytes,12,rep,name=inference_payment_list,json=inferencePaymentList,proto3" json:"inference_payment_list,omitempty"`
+	DenomTreasuryList           []*DenomTreasury           `protobuf:"bytes,13,rep,name=denom_treasury_list,json=denomTreasuryList,proto3" json:"denom_treasury_list,omitempty"`
+	ParticipantDenomBalanceList []*ParticipantDenomBalance `protobuf:"bytes,14,rep,name=participant_denom_balance_list,json=participantDenomBalanceList,proto3" json:"participant_denom_balance_list,omitempty"`
+	InferenceEscrowList         []*InferenceEscrow         `protobuf:"bytes,15,rep,name=inference_escrow_list,json=inferenceEscrowList,proto3" json:"inference_escrow_list,omitempty"`
+	ReleasableEscrowList        []*ReleasableEscrow        `protobuf:"bytes,16,rep,name=releasable_escrow_list,json=releasableEscrowList,proto3" json:"releasable_escrow_list,omitempty"`
+	RewardVestingAccountList    []*RewardVestingAccount    `protobuf:"bytes,17,rep,name=reward_vesting_account_list,json=rewardVestingAccountList,proto3" json:"reward_vesting_account_list,omitempty"`
+	// settle_carryover_list holds the unclaimed settle amounts still claimable after their epoch
+	SettleCarryoverList []*SettleAmount `protobuf:"bytes,18,rep,name=settle_carryover_list,json=settleCarryoverList,proto3" json:"settle_carryover_list,omitempty"`
+	// policy_list holds the governance policies that were set, training datasets as one entry each
+	PolicyList []*PolicyEntry `protobuf:"bytes,19,rep,name=policy_list,json=policyList,proto3" json:"policy_list,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1657,6 +3337,90 @@ func (x *GenesisState) GetBridge() *Bridge {
 	return nil
 }
 
+func (x *GenesisState) GetParticipantExitList() []*ParticipantExit {
+	if x != nil {
+		return x.ParticipantExitList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParticipantDelegationsList() []*ParticipantDelegations {
+	if x != nil {
+		return x.ParticipantDelegationsList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEpochDelegationList() []*EpochDelegation {
+	if x != nil {
+		return x.EpochDelegationList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDelegationUnbondingList() []*DelegationUnbonding {
+	if x != nil {
+		return x.DelegationUnbondingList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetInferencePaymentList() []*InferencePayment {
+	if x != nil {
+		return x.InferencePaymentList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDenomTreasuryList() []*DenomTreasury {
+	if x != nil {
+		return x.DenomTreasuryList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetParticipantDenomBalanceList() []*ParticipantDenomBalance {
+	if x != nil {
+		return x.ParticipantDenomBalanceList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetInferenceEscrowList() []*InferenceEscrow {
+	if x != nil {
+		return x.InferenceEscrowList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReleasableEscrowList() []*ReleasableEscrow {
+	if x != nil {
+		return x.ReleasableEscrowList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRewardVestingAccountList() []*RewardVestingAccount {
+	if x != nil {
+		return x.RewardVestingAccountList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSettleCarryoverList() []*SettleAmount {
+	if x != nil {
+		return x.SettleCarryoverList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPolicyList() []*PolicyEntry {
+	if x != nil {
+		return x.PolicyList
+	}
+	return nil
+}
+
 var File_inference_inference_genesis_proto protoreflect.FileDescriptor
 
 var file_inference_inference_genesis_proto_rawDesc = []byte{
@@ -1705,59 +3469,149 @@ var file_inference_inference_genesis_proto_rawDesc = []byte{
 	0x63, 0x65, 0x2f, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x28, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
 	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x6d, 0x6c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x0e, 0x43, 0x6f, 0x73,
-	0x6d, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63,
-	0x77, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
-	0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x63, 0x77, 0x32, 0x30,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
-	0x22, 0x9d, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x61, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x6f, 0x6e, 0x6c,
-	0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f,
+	0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x28, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x73,
+	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x55, 0x0a, 0x0e,
+	0x43, 0x6f, 0x73, 0x6d, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x77, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x63,
+	0x77, 0x32, 0x30, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x63, 0x77, 0x32, 0x30, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x3a, 0x04, 0xe8,
+	0xa0, 0x1f, 0x01, 0x22, 0xcd, 0x0d, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x61, 0x0a, 0x13, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4f,
+	0x6e, 0x6c, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4f, 0x6e, 0x6c,
+	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x73, 0x6d,
+	0x5f, 0x77, 0x61, 0x73, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x57, 0x61, 0x73,
+	0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x73,
+	0x6d, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x10, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x0e, 0x6d, 0x6c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x4d, 0x4c, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x0d, 0x6d, 0x6c, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x79,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
-	0x01, 0x52, 0x11, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d,
-	0x6f, 0x64, 0x65, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x51, 0x0a, 0x10, 0x63, 0x6f, 0x73, 0x6d, 0x5f, 0x77, 0x61,
-	0x73, 0x6d, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x43, 0x6f, 0x73, 0x6d, 0x57, 0x61, 0x73, 0x6d, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x73, 0x6d, 0x57, 0x61,
-	0x73, 0x6d, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x56, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x06, 0x62, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x12, 0x5e, 0x0a, 0x15, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
 	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69,
-	0x70, 0x61, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x49, 0x0a, 0x0e, 0x6d, 0x6c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x4d,
-	0x4c, 0x4e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6d, 0x6c,
-	0x6e, 0x6f, 0x64, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x62,
-	0x72, 0x69, 0x64, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e,
+	0x70, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x69, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x73, 0x0a, 0x1c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61,
+	0x6e, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x1a, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x15, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x13, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6a, 0x0a, 0x19, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x6e,
 	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x2e, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x06, 0x62, 0x72, 0x69, 0x64, 0x67, 0x65,
-	0x42, 0xba, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69,
-	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02,
-	0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x62, 0x6f,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x17, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x13, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x74, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x54, 0x72, 0x65, 0x61, 0x73, 0x75, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x77, 0x0a, 0x1e, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x1b, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a, 0x15, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x61, 0x0a, 0x16, 0x72, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f,
+	0x77, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x14, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x6e, 0x0a,
+	0x1b, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x76, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x56,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x18, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x56, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a,
+	0x15, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x43, 0x61, 0x72,
+	0x72, 0x79, 0x6f, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0b, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0xba, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42,
+	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1774,28 +3628,52 @@ func file_inference_inference_genesis_proto_rawDescGZIP() []byte {
 
 var file_inference_inference_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_inference_inference_genesis_proto_goTypes = []interface{}{
-	(*CosmWasmParams)(nil),    // 0: inference.inference.CosmWasmParams
-	(*GenesisState)(nil),      // 1: inference.inference.GenesisState
-	(*Params)(nil),            // 2: inference.inference.Params
-	(*GenesisOnlyParams)(nil), // 3: inference.inference.GenesisOnlyParams
-	(*Model)(nil),             // 4: inference.inference.Model
-	(*Participant)(nil),       // 5: inference.inference.Participant
-	(*MLNodeVersion)(nil),     // 6: inference.inference.MLNodeVersion
-	(*Bridge)(nil),            // 7: inference.inference.Bridge
+	(*CosmWasmParams)(nil),          // 0: inference.inference.CosmWasmParams
+	(*GenesisState)(nil),            // 1: inference.inference.GenesisState
+	(*Params)(nil),                  // 2: inference.inference.Params
+	(*GenesisOnlyParams)(nil),       // 3: inference.inference.GenesisOnlyParams
+	(*Model)(nil),                   // 4: inference.inference.Model
+	(*Participant)(nil),             // 5: inference.inference.Participant
+	(*MLNodeVersion)(nil),           // 6: inference.inference.MLNodeVersion
+	(*Bridge)(nil),                  // 7: inference.inference.Bridge
+	(*ParticipantExit)(nil),         // 8: inference.inference.ParticipantExit
+	(*ParticipantDelegations)(nil),  // 9: inference.inference.ParticipantDelegations
+	(*EpochDelegation)(nil),         // 10: inference.inference.EpochDelegation
+	(*DelegationUnbonding)(nil),     // 11: inference.inference.DelegationUnbonding
+	(*InferencePayment)(nil),        // 12: inference.inference.InferencePayment
+	(*DenomTreasury)(nil),           // 13: inference.inference.DenomTreasury
+	(*ParticipantDenomBalance)(nil), // 14: inference.inference.ParticipantDenomBalance
+	(*InferenceEscrow)(nil),         // 15: inference.inference.InferenceEscrow
+	(*ReleasableEscrow)(nil),        // 16: inference.inference.ReleasableEscrow
+	(*RewardVestingAccount)(nil),    // 17: inference.inference.RewardVestingAccount
+	(*SettleAmount)(nil),            // 18: inference.inference.SettleAmount
+	(*PolicyEntry)(nil),             // 19: inference.inference.PolicyEntry
 }
 var file_inference_inference_genesis_proto_depIdxs = []int32{
-	2, // 0: inference.inference.GenesisState.params:type_name -> inference.inference.Params
-	3, // 1: inference.inference.GenesisState.genesis_only_params:type_name -> inference.inference.GenesisOnlyParams
-	4, // 2: inference.inference.GenesisState.model_list:type_name -> inference.inference.Model
-	0, // 3: inference.inference.GenesisState.cosm_wasm_params:type_name -> inference.inference.CosmWasmParams
-	5, // 4: inference.inference.GenesisState.participant_list:type_name -> inference.inference.Participant
-	6, // 5: inference.inference.GenesisState.mlnode_version:type_name -> inference.inference.MLNodeVersion
-	7, // 6: inference.inference.GenesisState.bridge:type_name -> inference.inference.Bridge
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: inference.inference.GenesisState.params:type_name -> inference.inference.Params
+	3,  // 1: inference.inference.GenesisState.genesis_only_params:type_name -> inference.inference.GenesisOnlyParams
+	4,  // 2: inference.inference.GenesisState.model_list:type_name -> inference.inference.Model
+	0,  // 3: inference.inference.GenesisState.cosm_wasm_params:type_name -> inference.inference.CosmWasmParams
+	5,  // 4: inference.inference.GenesisState.participant_list:type_name -> inference.inference.Participant
+	6,  // 5: inference.inference.GenesisState.mlnode_version:type_name -> inference.inference.MLNodeVersion
+	7,  // 6: inference.inference.GenesisState.bridge:type_name -> inference.inference.Bridge
+	8,  // 7: inference.inference.GenesisState.participant_exit_list:type_name -> inference.inference.ParticipantExit
+	9,  // 8: inference.inference.GenesisState.participant_delegations_list:type_name -> inference.inference.ParticipantDelegations
+	10, // 9: inference.inference.GenesisState.epoch_delegation_list:type_name -> inference.inference.EpochDelegation
+	11, // 10: inference.inference.GenesisState.delegation_unbonding_list:type_name -> inference.inference.DelegationUnbonding
+	12, // 11: inference.inference.GenesisState.inference_payment_list:type_name -> inference.inference.InferencePayment
+	13, // 12: inference.inference.GenesisState.denom_treasury_list:type_name -> inference.inference.DenomTreasury
+	14, // 13: inference.inference.GenesisState.participant_denom_balance_list:type_name -> inference.inference.ParticipantDenomBalance
+	15, // 14: inference.inference.GenesisState.inference_escrow_list:type_name -> inference.inference.InferenceEscrow
+	16, // 15: inference.inference.GenesisState.releasable_escrow_list:type_name -> inference.inference.ReleasableEscrow
+	17, // 16: inference.inference.GenesisState.reward_vesting_account_list:type_name -> inference.inference.RewardVestingAccount
+	18, // 17: inference.inference.GenesisState.settle_carryover_list:type_name -> inference.inference.SettleAmount
+	19, // 18: inference.inference.GenesisState.policy_list:type_name -> inference.inference.PolicyEntry
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_inference_inference_genesis_proto_init() }
@@ -1818,6 +3696,12 @@ func file_inference_inference_genesis_proto_init() {
 	file_inference_inference_partial_upgrade_proto_init()
 	file_inference_inference_bridge_proto_init()
 	file_inference_inference_mlnode_version_proto_init()
+	file_inference_inference_participant_exit_proto_init()
+	file_inference_inference_delegation_proto_init()
+	file_inference_inference_payment_denom_proto_init()
+	file_inference_inference_inference_escrow_proto_init()
+	file_inference_inference_reward_vesting_proto_init()
+	file_inference_inference_policy_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_inference_inference_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CosmWasmParams); i {
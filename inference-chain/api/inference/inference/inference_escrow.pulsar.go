@@ -0,0 +1,1681 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_InferenceEscrow                   protoreflect.MessageDescriptor
+	fd_InferenceEscrow_inference_id      protoreflect.FieldDescriptor
+	fd_InferenceEscrow_requester         protoreflect.FieldDescriptor
+	fd_InferenceEscrow_executor          protoreflect.FieldDescriptor
+	fd_InferenceEscrow_status            protoreflect.FieldDescriptor
+	fd_InferenceEscrow_locked            protoreflect.FieldDescriptor
+	fd_InferenceEscrow_refunded          protoreflect.FieldDescriptor
+	fd_InferenceEscrow_executor_payment  protoreflect.FieldDescriptor
+	fd_InferenceEscrow_epoch_id          protoreflect.FieldDescriptor
+	fd_InferenceEscrow_locked_at_height  protoreflect.FieldDescriptor
+	fd_InferenceEscrow_settled_at_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_inference_escrow_proto_init()
+	md_InferenceEscrow = File_inference_inference_inference_escrow_proto.Messages().ByName("InferenceEscrow")
+	fd_InferenceEscrow_inference_id = md_InferenceEscrow.Fields().ByName("inference_id")
+	fd_InferenceEscrow_requester = md_InferenceEscrow.Fields().ByName("requester")
+	fd_InferenceEscrow_executor = md_InferenceEscrow.Fields().ByName("executor")
+	fd_InferenceEscrow_status = md_InferenceEscrow.Fields().ByName("status")
+	fd_InferenceEscrow_locked = md_InferenceEscrow.Fields().ByName("locked")
+	fd_InferenceEscrow_refunded = md_InferenceEscrow.Fields().ByName("refunded")
+	fd_InferenceEscrow_executor_payment = md_InferenceEscrow.Fields().ByName("executor_payment")
+	fd_InferenceEscrow_epoch_id = md_InferenceEscrow.Fields().ByName("epoch_id")
+	fd_InferenceEscrow_locked_at_height = md_InferenceEscrow.Fields().ByName("locked_at_height")
+	fd_InferenceEscrow_settled_at_height = md_InferenceEscrow.Fields().ByName("settled_at_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_InferenceEscrow)(nil)
+
+type fastReflection_InferenceEscrow InferenceEscrow
+
+func (x *InferenceEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_InferenceEscrow)(x)
+}
+
+func (x *InferenceEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_escrow_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_InferenceEscrow_messageType fastReflection_InferenceEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_InferenceEscrow_messageType{}
+
+type fastReflection_InferenceEscrow_messageType struct{}
+
+func (x fastReflection_InferenceEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_InferenceEscrow)(nil)
+}
+func (x fastReflection_InferenceEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_InferenceEscrow)
+}
+func (x fastReflection_InferenceEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_InferenceEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_InferenceEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_InferenceEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_InferenceEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_InferenceEscrow) New() protoreflect.Message {
+	return new(fastReflection_InferenceEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_InferenceEscrow) Interface() protoreflect.ProtoMessage {
+	return (*InferenceEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_InferenceEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.InferenceId != "" {
+		value := protoreflect.ValueOfString(x.InferenceId)
+		if !f(fd_InferenceEscrow_inference_id, value) {
+			return
+		}
+	}
+	if x.Requester != "" {
+		value := protoreflect.ValueOfString(x.Requester)
+		if !f(fd_InferenceEscrow_requester, value) {
+			return
+		}
+	}
+	if x.Executor != "" {
+		value := protoreflect.ValueOfString(x.Executor)
+		if !f(fd_InferenceEscrow_executor, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_InferenceEscrow_status, value) {
+			return
+		}
+	}
+	if x.Locked != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Locked)
+		if !f(fd_InferenceEscrow_locked, value) {
+			return
+		}
+	}
+	if x.Refunded != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Refunded)
+		if !f(fd_InferenceEscrow_refunded, value) {
+			return
+		}
+	}
+	if x.ExecutorPayment != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutorPayment)
+		if !f(fd_InferenceEscrow_executor_payment, value) {
+			return
+		}
+	}
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_InferenceEscrow_epoch_id, value) {
+			return
+		}
+	}
+	if x.LockedAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LockedAtHeight)
+		if !f(fd_InferenceEscrow_locked_at_height, value) {
+			return
+		}
+	}
+	if x.SettledAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettledAtHeight)
+		if !f(fd_InferenceEscrow_settled_at_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_InferenceEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		return x.InferenceId != ""
+	case "inference.inference.InferenceEscrow.requester":
+		return x.Requester != ""
+	case "inference.inference.InferenceEscrow.executor":
+		return x.Executor != ""
+	case "inference.inference.InferenceEscrow.status":
+		return x.Status != ""
+	case "inference.inference.InferenceEscrow.locked":
+		return x.Locked != int64(0)
+	case "inference.inference.InferenceEscrow.refunded":
+		return x.Refunded != int64(0)
+	case "inference.inference.InferenceEscrow.executor_payment":
+		return x.ExecutorPayment != int64(0)
+	case "inference.inference.InferenceEscrow.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		return x.LockedAtHeight != int64(0)
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		return x.SettledAtHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		x.InferenceId = ""
+	case "inference.inference.InferenceEscrow.requester":
+		x.Requester = ""
+	case "inference.inference.InferenceEscrow.executor":
+		x.Executor = ""
+	case "inference.inference.InferenceEscrow.status":
+		x.Status = ""
+	case "inference.inference.InferenceEscrow.locked":
+		x.Locked = int64(0)
+	case "inference.inference.InferenceEscrow.refunded":
+		x.Refunded = int64(0)
+	case "inference.inference.InferenceEscrow.executor_payment":
+		x.ExecutorPayment = int64(0)
+	case "inference.inference.InferenceEscrow.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		x.LockedAtHeight = int64(0)
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		x.SettledAtHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_InferenceEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		value := x.InferenceId
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceEscrow.requester":
+		value := x.Requester
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceEscrow.executor":
+		value := x.Executor
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceEscrow.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.InferenceEscrow.locked":
+		value := x.Locked
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceEscrow.refunded":
+		value := x.Refunded
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceEscrow.executor_payment":
+		value := x.ExecutorPayment
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceEscrow.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		value := x.LockedAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		value := x.SettledAtHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		x.InferenceId = value.Interface().(string)
+	case "inference.inference.InferenceEscrow.requester":
+		x.Requester = value.Interface().(string)
+	case "inference.inference.InferenceEscrow.executor":
+		x.Executor = value.Interface().(string)
+	case "inference.inference.InferenceEscrow.status":
+		x.Status = value.Interface().(string)
+	case "inference.inference.InferenceEscrow.locked":
+		x.Locked = value.Int()
+	case "inference.inference.InferenceEscrow.refunded":
+		x.Refunded = value.Int()
+	case "inference.inference.InferenceEscrow.executor_payment":
+		x.ExecutorPayment = value.Int()
+	case "inference.inference.InferenceEscrow.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		x.LockedAtHeight = value.Int()
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		x.SettledAtHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		panic(fmt.Errorf("field inference_id of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.requester":
+		panic(fmt.Errorf("field requester of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.executor":
+		panic(fmt.Errorf("field executor of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.status":
+		panic(fmt.Errorf("field status of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.locked":
+		panic(fmt.Errorf("field locked of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.refunded":
+		panic(fmt.Errorf("field refunded of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.executor_payment":
+		panic(fmt.Errorf("field executor_payment of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		panic(fmt.Errorf("field locked_at_height of message inference.inference.InferenceEscrow is not mutable"))
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		panic(fmt.Errorf("field settled_at_height of message inference.inference.InferenceEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_InferenceEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.InferenceEscrow.inference_id":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceEscrow.requester":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceEscrow.executor":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceEscrow.status":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.InferenceEscrow.locked":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceEscrow.refunded":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceEscrow.executor_payment":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceEscrow.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.InferenceEscrow.locked_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "inference.inference.InferenceEscrow.settled_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.InferenceEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.InferenceEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_InferenceEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.InferenceEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_InferenceEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_InferenceEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_InferenceEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_InferenceEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*InferenceEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.InferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Requester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Executor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Locked != 0 {
+			n += 1 + runtime.Sov(uint64(x.Locked))
+		}
+		if x.Refunded != 0 {
+			n += 1 + runtime.Sov(uint64(x.Refunded))
+		}
+		if x.ExecutorPayment != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutorPayment))
+		}
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		if x.LockedAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockedAtHeight))
+		}
+		if x.SettledAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettledAtHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SettledAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettledAtHeight))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.LockedAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockedAtHeight))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ExecutorPayment != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutorPayment))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Refunded != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Refunded))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Locked != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Locked))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Executor) > 0 {
+			i -= len(x.Executor)
+			copy(dAtA[i:], x.Executor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Executor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Requester) > 0 {
+			i -= len(x.Requester)
+			copy(dAtA[i:], x.Requester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Requester)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.InferenceId) > 0 {
+			i -= len(x.InferenceId)
+			copy(dAtA[i:], x.InferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferenceId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*InferenceEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: InferenceEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Requester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Executor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				}
+				x.Locked = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Locked |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+				}
+				x.Refunded = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Refunded |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutorPayment", wireType)
+				}
+				x.ExecutorPayment = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutorPayment |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAtHeight", wireType)
+				}
+				x.LockedAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockedAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettledAtHeight", wireType)
+				}
+				x.SettledAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SettledAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ReleasableEscrow              protoreflect.MessageDescriptor
+	fd_ReleasableEscrow_epoch_id     protoreflect.FieldDescriptor
+	fd_ReleasableEscrow_inference_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_inference_escrow_proto_init()
+	md_ReleasableEscrow = File_inference_inference_inference_escrow_proto.Messages().ByName("ReleasableEscrow")
+	fd_ReleasableEscrow_epoch_id = md_ReleasableEscrow.Fields().ByName("epoch_id")
+	fd_ReleasableEscrow_inference_id = md_ReleasableEscrow.Fields().ByName("inference_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_ReleasableEscrow)(nil)
+
+type fastReflection_ReleasableEscrow ReleasableEscrow
+
+func (x *ReleasableEscrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReleasableEscrow)(x)
+}
+
+func (x *ReleasableEscrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_inference_escrow_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ReleasableEscrow_messageType fastReflection_ReleasableEscrow_messageType
+var _ protoreflect.MessageType = fastReflection_ReleasableEscrow_messageType{}
+
+type fastReflection_ReleasableEscrow_messageType struct{}
+
+func (x fastReflection_ReleasableEscrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReleasableEscrow)(nil)
+}
+func (x fastReflection_ReleasableEscrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReleasableEscrow)
+}
+func (x fastReflection_ReleasableEscrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReleasableEscrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ReleasableEscrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReleasableEscrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ReleasableEscrow) Type() protoreflect.MessageType {
+	return _fastReflection_ReleasableEscrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ReleasableEscrow) New() protoreflect.Message {
+	return new(fastReflection_ReleasableEscrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ReleasableEscrow) Interface() protoreflect.ProtoMessage {
+	return (*ReleasableEscrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ReleasableEscrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.EpochId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochId)
+		if !f(fd_ReleasableEscrow_epoch_id, value) {
+			return
+		}
+	}
+	if x.InferenceId != "" {
+		value := protoreflect.ValueOfString(x.InferenceId)
+		if !f(fd_ReleasableEscrow_inference_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ReleasableEscrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		return x.EpochId != uint64(0)
+	case "inference.inference.ReleasableEscrow.inference_id":
+		return x.InferenceId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleasableEscrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		x.EpochId = uint64(0)
+	case "inference.inference.ReleasableEscrow.inference_id":
+		x.InferenceId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ReleasableEscrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		value := x.EpochId
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ReleasableEscrow.inference_id":
+		value := x.InferenceId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleasableEscrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		x.EpochId = value.Uint()
+	case "inference.inference.ReleasableEscrow.inference_id":
+		x.InferenceId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleasableEscrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		panic(fmt.Errorf("field epoch_id of message inference.inference.ReleasableEscrow is not mutable"))
+	case "inference.inference.ReleasableEscrow.inference_id":
+		panic(fmt.Errorf("field inference_id of message inference.inference.ReleasableEscrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ReleasableEscrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ReleasableEscrow.epoch_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ReleasableEscrow.inference_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ReleasableEscrow"))
+		}
+		panic(fmt.Errorf("message inference.inference.ReleasableEscrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ReleasableEscrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ReleasableEscrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ReleasableEscrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ReleasableEscrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ReleasableEscrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ReleasableEscrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ReleasableEscrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.EpochId != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochId))
+		}
+		l = len(x.InferenceId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ReleasableEscrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.InferenceId) > 0 {
+			i -= len(x.InferenceId)
+			copy(dAtA[i:], x.InferenceId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.InferenceId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.EpochId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ReleasableEscrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReleasableEscrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReleasableEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				}
+				x.EpochId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.InferenceId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/inference_escrow.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// InferenceEscrow tracks the funds locked for an inference from MsgStartInference until they are
+// released to the executor or refunded to the requester. Amounts are in BaseCoin.
+//
+// The executor's share is credited to its owed balance when the inference completes and is paid out
+// when the claims of the inference's epoch are set, at the end of PoC validation. That ends the
+// validation window: invalidations after it no longer refund, so it is also when the escrow is released.
+type InferenceEscrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Requester   string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Executor    string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	// status is one of "locked", "released" or "refunded"
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// locked is the total put in escrow, refunded what went back to the requester, including the
+	// unused part of the escrow refunded on completion
+	Locked   int64 `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`
+	Refunded int64 `protobuf:"varint,6,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// executor_payment is what was credited to the executor for the inference
+	ExecutorPayment int64 `protobuf:"varint,7,opt,name=executor_payment,json=executorPayment,proto3" json:"executor_payment,omitempty"`
+	// epoch_id is the epoch whose settlement releases the escrow, known once the inference completes
+	EpochId         uint64 `protobuf:"varint,8,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	LockedAtHeight  int64  `protobuf:"varint,9,opt,name=locked_at_height,json=lockedAtHeight,proto3" json:"locked_at_height,omitempty"`
+	SettledAtHeight int64  `protobuf:"varint,10,opt,name=settled_at_height,json=settledAtHeight,proto3" json:"settled_at_height,omitempty"`
+}
+
+func (x *InferenceEscrow) Reset() {
+	*x = InferenceEscrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_escrow_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InferenceEscrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InferenceEscrow) ProtoMessage() {}
+
+// Deprecated: Use InferenceEscrow.ProtoReflect.Descriptor instead.
+func (*InferenceEscrow) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_escrow_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InferenceEscrow) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+func (x *InferenceEscrow) GetRequester() string {
+	if x != nil {
+		return x.Requester
+	}
+	return ""
+}
+
+func (x *InferenceEscrow) GetExecutor() string {
+	if x != nil {
+		return x.Executor
+	}
+	return ""
+}
+
+func (x *InferenceEscrow) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InferenceEscrow) GetLocked() int64 {
+	if x != nil {
+		return x.Locked
+	}
+	return 0
+}
+
+func (x *InferenceEscrow) GetRefunded() int64 {
+	if x != nil {
+		return x.Refunded
+	}
+	return 0
+}
+
+func (x *InferenceEscrow) GetExecutorPayment() int64 {
+	if x != nil {
+		return x.ExecutorPayment
+	}
+	return 0
+}
+
+func (x *InferenceEscrow) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *InferenceEscrow) GetLockedAtHeight() int64 {
+	if x != nil {
+		return x.LockedAtHeight
+	}
+	return 0
+}
+
+func (x *InferenceEscrow) GetSettledAtHeight() int64 {
+	if x != nil {
+		return x.SettledAtHeight
+	}
+	return 0
+}
+
+// ReleasableEscrow schedules the escrow of a completed inference for release when its epoch settles
+type ReleasableEscrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EpochId     uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	InferenceId string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+}
+
+func (x *ReleasableEscrow) Reset() {
+	*x = ReleasableEscrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_inference_escrow_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReleasableEscrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleasableEscrow) ProtoMessage() {}
+
+// Deprecated: Use ReleasableEscrow.ProtoReflect.Descriptor instead.
+func (*ReleasableEscrow) Descriptor() ([]byte, []int) {
+	return file_inference_inference_inference_escrow_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReleasableEscrow) GetEpochId() uint64 {
+	if x != nil {
+		return x.EpochId
+	}
+	return 0
+}
+
+func (x *ReleasableEscrow) GetInferenceId() string {
+	if x != nil {
+		return x.InferenceId
+	}
+	return ""
+}
+
+var File_inference_inference_inference_escrow_proto protoreflect.FileDescriptor
+
+var file_inference_inference_inference_escrow_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f,
+	0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0xd6, 0x02, 0x0a, 0x0f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x45,
+	0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x6f, 0x72, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x6f, 0x72, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x50, 0x0a, 0x10, 0x52, 0x65,
+	0x6c, 0x65, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x42, 0xc2, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x45, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
+	0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02, 0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49,
+	0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_inference_inference_inference_escrow_proto_rawDescOnce sync.Once
+	file_inference_inference_inference_escrow_proto_rawDescData = file_inference_inference_inference_escrow_proto_rawDesc
+)
+
+func file_inference_inference_inference_escrow_proto_rawDescGZIP() []byte {
+	file_inference_inference_inference_escrow_proto_rawDescOnce.Do(func() {
+		file_inference_inference_inference_escrow_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_inference_escrow_proto_rawDescData)
+	})
+	return file_inference_inference_inference_escrow_proto_rawDescData
+}
+
+var file_inference_inference_inference_escrow_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_inference_inference_inference_escrow_proto_goTypes = []interface{}{
+	(*InferenceEscrow)(nil),  // 0: inference.inference.InferenceEscrow
+	(*ReleasableEscrow)(nil), // 1: inference.inference.ReleasableEscrow
+}
+var file_inference_inference_inference_escrow_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_inference_escrow_proto_init() }
+func file_inference_inference_inference_escrow_proto_init() {
+	if File_inference_inference_inference_escrow_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_inference_escrow_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InferenceEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_inference_inference_inference_escrow_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReleasableEscrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_inference_escrow_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_inference_escrow_proto_goTypes,
+		DependencyIndexes: file_inference_inference_inference_escrow_proto_depIdxs,
+		MessageInfos:      file_inference_inference_inference_escrow_proto_msgTypes,
+	}.Build()
+	File_inference_inference_inference_escrow_proto = out.File
+	file_inference_inference_inference_escrow_proto_rawDesc = nil
+	file_inference_inference_inference_escrow_proto_goTypes = nil
+	file_inference_inference_inference_escrow_proto_depIdxs = nil
+}
@@ -0,0 +1,889 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package inference
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ParticipantExit                          protoreflect.MessageDescriptor
+	fd_ParticipantExit_address                  protoreflect.FieldDescriptor
+	fd_ParticipantExit_requested_at_epoch       protoreflect.FieldDescriptor
+	fd_ParticipantExit_last_epoch               protoreflect.FieldDescriptor
+	fd_ParticipantExit_unbonding                protoreflect.FieldDescriptor
+	fd_ParticipantExit_collateral               protoreflect.FieldDescriptor
+	fd_ParticipantExit_collateral_release_epoch protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_inference_inference_participant_exit_proto_init()
+	md_ParticipantExit = File_inference_inference_participant_exit_proto.Messages().ByName("ParticipantExit")
+	fd_ParticipantExit_address = md_ParticipantExit.Fields().ByName("address")
+	fd_ParticipantExit_requested_at_epoch = md_ParticipantExit.Fields().ByName("requested_at_epoch")
+	fd_ParticipantExit_last_epoch = md_ParticipantExit.Fields().ByName("last_epoch")
+	fd_ParticipantExit_unbonding = md_ParticipantExit.Fields().ByName("unbonding")
+	fd_ParticipantExit_collateral = md_ParticipantExit.Fields().ByName("collateral")
+	fd_ParticipantExit_collateral_release_epoch = md_ParticipantExit.Fields().ByName("collateral_release_epoch")
+}
+
+var _ protoreflect.Message = (*fastReflection_ParticipantExit)(nil)
+
+type fastReflection_ParticipantExit ParticipantExit
+
+func (x *ParticipantExit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ParticipantExit)(x)
+}
+
+func (x *ParticipantExit) slowProtoReflect() protoreflect.Message {
+	mi := &file_inference_inference_participant_exit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ParticipantExit_messageType fastReflection_ParticipantExit_messageType
+var _ protoreflect.MessageType = fastReflection_ParticipantExit_messageType{}
+
+type fastReflection_ParticipantExit_messageType struct{}
+
+func (x fastReflection_ParticipantExit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ParticipantExit)(nil)
+}
+func (x fastReflection_ParticipantExit_messageType) New() protoreflect.Message {
+	return new(fastReflection_ParticipantExit)
+}
+func (x fastReflection_ParticipantExit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantExit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ParticipantExit) Descriptor() protoreflect.MessageDescriptor {
+	return md_ParticipantExit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ParticipantExit) Type() protoreflect.MessageType {
+	return _fastReflection_ParticipantExit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ParticipantExit) New() protoreflect.Message {
+	return new(fastReflection_ParticipantExit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ParticipantExit) Interface() protoreflect.ProtoMessage {
+	return (*ParticipantExit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ParticipantExit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ParticipantExit_address, value) {
+			return
+		}
+	}
+	if x.RequestedAtEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RequestedAtEpoch)
+		if !f(fd_ParticipantExit_requested_at_epoch, value) {
+			return
+		}
+	}
+	if x.LastEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LastEpoch)
+		if !f(fd_ParticipantExit_last_epoch, value) {
+			return
+		}
+	}
+	if x.Unbonding != false {
+		value := protoreflect.ValueOfBool(x.Unbonding)
+		if !f(fd_ParticipantExit_unbonding, value) {
+			return
+		}
+	}
+	if x.Collateral != "" {
+		value := protoreflect.ValueOfString(x.Collateral)
+		if !f(fd_ParticipantExit_collateral, value) {
+			return
+		}
+	}
+	if x.CollateralReleaseEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CollateralReleaseEpoch)
+		if !f(fd_ParticipantExit_collateral_release_epoch, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ParticipantExit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		return x.Address != ""
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		return x.RequestedAtEpoch != uint64(0)
+	case "inference.inference.ParticipantExit.last_epoch":
+		return x.LastEpoch != uint64(0)
+	case "inference.inference.ParticipantExit.unbonding":
+		return x.Unbonding != false
+	case "inference.inference.ParticipantExit.collateral":
+		return x.Collateral != ""
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		return x.CollateralReleaseEpoch != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantExit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		x.Address = ""
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		x.RequestedAtEpoch = uint64(0)
+	case "inference.inference.ParticipantExit.last_epoch":
+		x.LastEpoch = uint64(0)
+	case "inference.inference.ParticipantExit.unbonding":
+		x.Unbonding = false
+	case "inference.inference.ParticipantExit.collateral":
+		x.Collateral = ""
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		x.CollateralReleaseEpoch = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ParticipantExit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		value := x.RequestedAtEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantExit.last_epoch":
+		value := x.LastEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "inference.inference.ParticipantExit.unbonding":
+		value := x.Unbonding
+		return protoreflect.ValueOfBool(value)
+	case "inference.inference.ParticipantExit.collateral":
+		value := x.Collateral
+		return protoreflect.ValueOfString(value)
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		value := x.CollateralReleaseEpoch
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantExit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		x.Address = value.Interface().(string)
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		x.RequestedAtEpoch = value.Uint()
+	case "inference.inference.ParticipantExit.last_epoch":
+		x.LastEpoch = value.Uint()
+	case "inference.inference.ParticipantExit.unbonding":
+		x.Unbonding = value.Bool()
+	case "inference.inference.ParticipantExit.collateral":
+		x.Collateral = value.Interface().(string)
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		x.CollateralReleaseEpoch = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantExit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		panic(fmt.Errorf("field address of message inference.inference.ParticipantExit is not mutable"))
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		panic(fmt.Errorf("field requested_at_epoch of message inference.inference.ParticipantExit is not mutable"))
+	case "inference.inference.ParticipantExit.last_epoch":
+		panic(fmt.Errorf("field last_epoch of message inference.inference.ParticipantExit is not mutable"))
+	case "inference.inference.ParticipantExit.unbonding":
+		panic(fmt.Errorf("field unbonding of message inference.inference.ParticipantExit is not mutable"))
+	case "inference.inference.ParticipantExit.collateral":
+		panic(fmt.Errorf("field collateral of message inference.inference.ParticipantExit is not mutable"))
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		panic(fmt.Errorf("field collateral_release_epoch of message inference.inference.ParticipantExit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ParticipantExit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "inference.inference.ParticipantExit.address":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantExit.requested_at_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantExit.last_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "inference.inference.ParticipantExit.unbonding":
+		return protoreflect.ValueOfBool(false)
+	case "inference.inference.ParticipantExit.collateral":
+		return protoreflect.ValueOfString("")
+	case "inference.inference.ParticipantExit.collateral_release_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: inference.inference.ParticipantExit"))
+		}
+		panic(fmt.Errorf("message inference.inference.ParticipantExit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ParticipantExit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in inference.inference.ParticipantExit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ParticipantExit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ParticipantExit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ParticipantExit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ParticipantExit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ParticipantExit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RequestedAtEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.RequestedAtEpoch))
+		}
+		if x.LastEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastEpoch))
+		}
+		if x.Unbonding {
+			n += 2
+		}
+		l = len(x.Collateral)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CollateralReleaseEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.CollateralReleaseEpoch))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantExit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CollateralReleaseEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CollateralReleaseEpoch))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Collateral) > 0 {
+			i -= len(x.Collateral)
+			copy(dAtA[i:], x.Collateral)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Collateral)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Unbonding {
+			i--
+			if x.Unbonding {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LastEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastEpoch))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RequestedAtEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RequestedAtEpoch))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ParticipantExit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantExit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ParticipantExit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RequestedAtEpoch", wireType)
+				}
+				x.RequestedAtEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RequestedAtEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+				}
+				x.LastEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Unbonding = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collateral = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralReleaseEpoch", wireType)
+				}
+				x.CollateralReleaseEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CollateralReleaseEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: inference/inference/participant_exit.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ParticipantExit tracks a participant leaving the network, from its exit request until its collateral
+// is released. The participant serves up to last_epoch and is left out of every epoch group after it.
+type ParticipantExit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RequestedAtEpoch uint64 `protobuf:"varint,2,opt,name=requested_at_epoch,json=requestedAtEpoch,proto3" json:"requested_at_epoch,omitempty"`
+	// last_epoch is the last epoch the participant is part of the epoch group
+	LastEpoch uint64 `protobuf:"varint,3,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	// unbonding is set once last_epoch is settled and the collateral entered the unbonding queue
+	Unbonding bool `protobuf:"varint,4,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+	// collateral is the unbonding collateral, released at the end of collateral_release_epoch
+	Collateral             string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	CollateralReleaseEpoch uint64 `protobuf:"varint,6,opt,name=collateral_release_epoch,json=collateralReleaseEpoch,proto3" json:"collateral_release_epoch,omitempty"`
+}
+
+func (x *ParticipantExit) Reset() {
+	*x = ParticipantExit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_inference_inference_participant_exit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParticipantExit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParticipantExit) ProtoMessage() {}
+
+// Deprecated: Use ParticipantExit.ProtoReflect.Descriptor instead.
+func (*ParticipantExit) Descriptor() ([]byte, []int) {
+	return file_inference_inference_participant_exit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ParticipantExit) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ParticipantExit) GetRequestedAtEpoch() uint64 {
+	if x != nil {
+		return x.RequestedAtEpoch
+	}
+	return 0
+}
+
+func (x *ParticipantExit) GetLastEpoch() uint64 {
+	if x != nil {
+		return x.LastEpoch
+	}
+	return 0
+}
+
+func (x *ParticipantExit) GetUnbonding() bool {
+	if x != nil {
+		return x.Unbonding
+	}
+	return false
+}
+
+func (x *ParticipantExit) GetCollateral() string {
+	if x != nil {
+		return x.Collateral
+	}
+	return ""
+}
+
+func (x *ParticipantExit) GetCollateralReleaseEpoch() uint64 {
+	if x != nil {
+		return x.CollateralReleaseEpoch
+	}
+	return 0
+}
+
+var File_inference_inference_participant_exit_proto protoreflect.FileDescriptor
+
+var file_inference_inference_participant_exit_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x2f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x5f, 0x65, 0x78, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x69, 0x6e,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x22, 0xf0, 0x01, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
+	0x74, 0x45, 0x78, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x41, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x75, 0x6e, 0x62, 0x6f, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0xc2, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x14, 0x50, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x45, 0x78, 0x69,
+	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x24, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xa2, 0x02,
+	0x03, 0x49, 0x49, 0x58, 0xaa, 0x02, 0x13, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x2e, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0xca, 0x02, 0x13, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0xe2, 0x02, 0x1f, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x49, 0x6e, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x14, 0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x3a, 0x3a,
+	0x49, 0x6e, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_inference_inference_participant_exit_proto_rawDescOnce sync.Once
+	file_inference_inference_participant_exit_proto_rawDescData = file_inference_inference_participant_exit_proto_rawDesc
+)
+
+func file_inference_inference_participant_exit_proto_rawDescGZIP() []byte {
+	file_inference_inference_participant_exit_proto_rawDescOnce.Do(func() {
+		file_inference_inference_participant_exit_proto_rawDescData = protoimpl.X.CompressGZIP(file_inference_inference_participant_exit_proto_rawDescData)
+	})
+	return file_inference_inference_participant_exit_proto_rawDescData
+}
+
+var file_inference_inference_participant_exit_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_inference_inference_participant_exit_proto_goTypes = []interface{}{
+	(*ParticipantExit)(nil), // 0: inference.inference.ParticipantExit
+}
+var file_inference_inference_participant_exit_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_inference_inference_participant_exit_proto_init() }
+func file_inference_inference_participant_exit_proto_init() {
+	if File_inference_inference_participant_exit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_inference_inference_participant_exit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParticipantExit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_inference_inference_participant_exit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_inference_inference_participant_exit_proto_goTypes,
+		DependencyIndexes: file_inference_inference_participant_exit_proto_depIdxs,
+		MessageInfos:      file_inference_inference_participant_exit_proto_msgTypes,
+	}.Build()
+	File_inference_inference_participant_exit_proto = out.File
+	file_inference_inference_participant_exit_proto_rawDesc = nil
+	file_inference_inference_participant_exit_proto_goTypes = nil
+	file_inference_inference_participant_exit_proto_depIdxs = nil
+}
@@ -101,6 +101,10 @@ const (
 	Query_ListRandomSeeds_FullMethodName                           = "/inference.inference.Query/ListRandomSeeds"
 	Query_ParticipantsWithBalances_FullMethodName                  = "/inference.inference.Query/ParticipantsWithBalances"
 	Query_PoCValidationSnapshot_FullMethodName                     = "/inference.inference.Query/PoCValidationSnapshot"
+	Query_ListParticipantDelegations_FullMethodName                = "/inference.inference.Query/ListParticipantDelegations"
+	Query_ListDelegationUnbondings_FullMethodName                  = "/inference.inference.Query/ListDelegationUnbondings"
+	Query_ListClaimableSettleAmounts_FullMethodName                = "/inference.inference.Query/ListClaimableSettleAmounts"
+	Query_SimulateModelAssignment_FullMethodName                   = "/inference.inference.Query/SimulateModelAssignment"
 )
 
 // QueryClient is the client API for Query service.
@@ -248,6 +252,14 @@ type QueryClient interface {
 	ParticipantsWithBalances(ctx context.Context, in *QueryParticipantsWithBalancesRequest, opts ...grpc.CallOption) (*QueryParticipantsWithBalancesResponse, error)
 	// Queries PoC validation snapshot for deterministic sampling synchronization.
 	PoCValidationSnapshot(ctx context.Context, in *QueryPoCValidationSnapshotRequest, opts ...grpc.CallOption) (*QueryPoCValidationSnapshotResponse, error)
+	// Queries the stake delegated to a participant.
+	ListParticipantDelegations(ctx context.Context, in *QueryParticipantDelegationsRequest, opts ...grpc.CallOption) (*QueryParticipantDelegationsResponse, error)
+	// Queries the undelegated stake of a delegator waiting for the settlement of the epochs it weighted.
+	ListDelegationUnbondings(ctx context.Context, in *QueryDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryDelegationUnbondingsResponse, error)
+	// Queries the settle amounts a participant can still claim, including those carried over.
+	ListClaimableSettleAmounts(ctx context.Context, in *QueryClaimableSettleAmountsRequest, opts ...grpc.CallOption) (*QueryClaimableSettleAmountsResponse, error)
+	// Runs model assignment and PoC slot allocation read-only against the current hardware nodes and governance models.
+	SimulateModelAssignment(ctx context.Context, in *QuerySimulateModelAssignmentRequest, opts ...grpc.CallOption) (*QuerySimulateModelAssignmentResponse, error)
 }
 
 type queryClient struct {
@@ -996,6 +1008,42 @@ func (c *queryClient) PoCValidationSnapshot(ctx context.Context, in *QueryPoCVal
 	return out, nil
 }
 
+func (c *queryClient) ListParticipantDelegations(ctx context.Context, in *QueryParticipantDelegationsRequest, opts ...grpc.CallOption) (*QueryParticipantDelegationsResponse, error) {
+	out := new(QueryParticipantDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_ListParticipantDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDelegationUnbondings(ctx context.Context, in *QueryDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryDelegationUnbondingsResponse, error) {
+	out := new(QueryDelegationUnbondingsResponse)
+	err := c.cc.Invoke(ctx, Query_ListDelegationUnbondings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimableSettleAmounts(ctx context.Context, in *QueryClaimableSettleAmountsRequest, opts ...grpc.CallOption) (*QueryClaimableSettleAmountsResponse, error) {
+	out := new(QueryClaimableSettleAmountsResponse)
+	err := c.cc.Invoke(ctx, Query_ListClaimableSettleAmounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateModelAssignment(ctx context.Context, in *QuerySimulateModelAssignmentRequest, opts ...grpc.CallOption) (*QuerySimulateModelAssignmentResponse, error) {
+	out := new(QuerySimulateModelAssignmentResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateModelAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -1141,6 +1189,14 @@ type QueryServer interface {
 	ParticipantsWithBalances(context.Context, *QueryParticipantsWithBalancesRequest) (*QueryParticipantsWithBalancesResponse, error)
 	// Queries PoC validation snapshot for deterministic sampling synchronization.
 	PoCValidationSnapshot(context.Context, *QueryPoCValidationSnapshotRequest) (*QueryPoCValidationSnapshotResponse, error)
+	// Queries the stake delegated to a participant.
+	ListParticipantDelegations(context.Context, *QueryParticipantDelegationsRequest) (*QueryParticipantDelegationsResponse, error)
+	// Queries the undelegated stake of a delegator waiting for the settlement of the epochs it weighted.
+	ListDelegationUnbondings(context.Context, *QueryDelegationUnbondingsRequest) (*QueryDelegationUnbondingsResponse, error)
+	// Queries the settle amounts a participant can still claim, including those carried over.
+	ListClaimableSettleAmounts(context.Context, *QueryClaimableSettleAmountsRequest) (*QueryClaimableSettleAmountsResponse, error)
+	// Runs model assignment and PoC slot allocation read-only against the current hardware nodes and governance models.
+	SimulateModelAssignment(context.Context, *QuerySimulateModelAssignmentRequest) (*QuerySimulateModelAssignmentResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -1394,6 +1450,18 @@ func (UnimplementedQueryServer) ParticipantsWithBalances(context.Context, *Query
 func (UnimplementedQueryServer) PoCValidationSnapshot(context.Context, *QueryPoCValidationSnapshotRequest) (*QueryPoCValidationSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoCValidationSnapshot not implemented")
 }
+func (UnimplementedQueryServer) ListParticipantDelegations(context.Context, *QueryParticipantDelegationsRequest) (*QueryParticipantDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipantDelegations not implemented")
+}
+func (UnimplementedQueryServer) ListDelegationUnbondings(context.Context, *QueryDelegationUnbondingsRequest) (*QueryDelegationUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegationUnbondings not implemented")
+}
+func (UnimplementedQueryServer) ListClaimableSettleAmounts(context.Context, *QueryClaimableSettleAmountsRequest) (*QueryClaimableSettleAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimableSettleAmounts not implemented")
+}
+func (UnimplementedQueryServer) SimulateModelAssignment(context.Context, *QuerySimulateModelAssignmentRequest) (*QuerySimulateModelAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateModelAssignment not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -2883,6 +2951,78 @@ func _Query_PoCValidationSnapshot_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListParticipantDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListParticipantDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListParticipantDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListParticipantDelegations(ctx, req.(*QueryParticipantDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDelegationUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDelegationUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListDelegationUnbondings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDelegationUnbondings(ctx, req.(*QueryDelegationUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimableSettleAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableSettleAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimableSettleAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListClaimableSettleAmounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimableSettleAmounts(ctx, req.(*QueryClaimableSettleAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateModelAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateModelAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateModelAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateModelAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateModelAssignment(ctx, req.(*QuerySimulateModelAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3218,6 +3358,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoCValidationSnapshot",
 			Handler:    _Query_PoCValidationSnapshot_Handler,
 		},
+		{
+			MethodName: "ListParticipantDelegations",
+			Handler:    _Query_ListParticipantDelegations_Handler,
+		},
+		{
+			MethodName: "ListDelegationUnbondings",
+			Handler:    _Query_ListDelegationUnbondings_Handler,
+		},
+		{
+			MethodName: "ListClaimableSettleAmounts",
+			Handler:    _Query_ListClaimableSettleAmounts_Handler,
+		},
+		{
+			MethodName: "SimulateModelAssignment",
+			Handler:    _Query_SimulateModelAssignment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
@@ -61,6 +61,11 @@ const (
 	Msg_SetTrainingAllowList_FullMethodName             = "/inference.inference.Msg/SetTrainingAllowList"
 	Msg_AddParticipantsToAllowList_FullMethodName       = "/inference.inference.Msg/AddParticipantsToAllowList"
 	Msg_RemoveParticipantsFromAllowList_FullMethodName  = "/inference.inference.Msg/RemoveParticipantsFromAllowList"
+	Msg_InitiateExit_FullMethodName                     = "/inference.inference.Msg/InitiateExit"
+	Msg_DelegateToParticipant_FullMethodName            = "/inference.inference.Msg/DelegateToParticipant"
+	Msg_Undelegate_FullMethodName                       = "/inference.inference.Msg/Undelegate"
+	Msg_ValidationBatch_FullMethodName                  = "/inference.inference.Msg/ValidationBatch"
+	Msg_UpdatePolicy_FullMethodName                     = "/inference.inference.Msg/UpdatePolicy"
 )
 
 // MsgClient is the client API for Msg service.
@@ -113,6 +118,11 @@ type MsgClient interface {
 	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
 	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
 	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	InitiateExit(ctx context.Context, in *MsgInitiateExit, opts ...grpc.CallOption) (*MsgInitiateExitResponse, error)
+	DelegateToParticipant(ctx context.Context, in *MsgDelegateToParticipant, opts ...grpc.CallOption) (*MsgDelegateToParticipantResponse, error)
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	ValidationBatch(ctx context.Context, in *MsgValidationBatch, opts ...grpc.CallOption) (*MsgValidationBatchResponse, error)
+	UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error)
 }
 
 type msgClient struct {
@@ -501,6 +511,51 @@ func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *Msg
 	return out, nil
 }
 
+func (c *msgClient) InitiateExit(ctx context.Context, in *MsgInitiateExit, opts ...grpc.CallOption) (*MsgInitiateExitResponse, error) {
+	out := new(MsgInitiateExitResponse)
+	err := c.cc.Invoke(ctx, Msg_InitiateExit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelegateToParticipant(ctx context.Context, in *MsgDelegateToParticipant, opts ...grpc.CallOption) (*MsgDelegateToParticipantResponse, error) {
+	out := new(MsgDelegateToParticipantResponse)
+	err := c.cc.Invoke(ctx, Msg_DelegateToParticipant_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, Msg_Undelegate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ValidationBatch(ctx context.Context, in *MsgValidationBatch, opts ...grpc.CallOption) (*MsgValidationBatchResponse, error) {
+	out := new(MsgValidationBatchResponse)
+	err := c.cc.Invoke(ctx, Msg_ValidationBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error) {
+	out := new(MsgUpdatePolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -551,6 +606,11 @@ type MsgServer interface {
 	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
 	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
 	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	InitiateExit(context.Context, *MsgInitiateExit) (*MsgInitiateExitResponse, error)
+	DelegateToParticipant(context.Context, *MsgDelegateToParticipant) (*MsgDelegateToParticipantResponse, error)
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	ValidationBatch(context.Context, *MsgValidationBatch) (*MsgValidationBatchResponse, error)
+	UpdatePolicy(context.Context, *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -684,6 +744,21 @@ func (UnimplementedMsgServer) AddParticipantsToAllowList(context.Context, *MsgAd
 func (UnimplementedMsgServer) RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
 }
+func (UnimplementedMsgServer) InitiateExit(context.Context, *MsgInitiateExit) (*MsgInitiateExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateExit not implemented")
+}
+func (UnimplementedMsgServer) DelegateToParticipant(context.Context, *MsgDelegateToParticipant) (*MsgDelegateToParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateToParticipant not implemented")
+}
+func (UnimplementedMsgServer) Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+func (UnimplementedMsgServer) ValidationBatch(context.Context, *MsgValidationBatch) (*MsgValidationBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidationBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdatePolicy(context.Context, *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -1453,6 +1528,96 @@ func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_InitiateExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateExit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateExit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_InitiateExit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateExit(ctx, req.(*MsgInitiateExit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelegateToParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateToParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateToParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelegateToParticipant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateToParticipant(ctx, req.(*MsgDelegateToParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_Undelegate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ValidationBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidationBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ValidationBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ValidationBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidationBatch(ctx, req.(*MsgValidationBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePolicy(ctx, req.(*MsgUpdatePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1628,6 +1793,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveParticipantsFromAllowList",
 			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
 		},
+		{
+			MethodName: "InitiateExit",
+			Handler:    _Msg_InitiateExit_Handler,
+		},
+		{
+			MethodName: "DelegateToParticipant",
+			Handler:    _Msg_DelegateToParticipant_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+		{
+			MethodName: "ValidationBatch",
+			Handler:    _Msg_ValidationBatch_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _Msg_UpdatePolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/tx.proto",
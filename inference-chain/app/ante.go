@@ -211,6 +211,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		// Run mempool filters AFTER fee deduction (so invalid txs pay fees), but BEFORE signature verification (to avoid crypto work).
 		NewPocPeriodValidationDecorator(options.InferenceKeeper),   // Reject PoC submissions outside allowed windows
 		NewValidationEarlyRejectDecorator(options.InferenceKeeper), // Reject invalid MsgValidation txs early (duplicate / not-in-epoch)
+		NewValidationBatchLimitDecorator(options.InferenceKeeper),  // Bound the size of batched MsgValidation txs
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),          // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
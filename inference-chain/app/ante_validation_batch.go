@@ -0,0 +1,97 @@
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	inferencemodulekeeper "github.com/productscience/inference/x/inference/keeper"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+)
+
+// MaxValidationsPerTx bounds how many validations a single transaction may carry, as MsgValidation
+// or as MsgValidationBatch items. Each validation is still executed and charged gas independently,
+// this only caps the size of a batch.
+const MaxValidationsPerTx = inferencetypes.MaxValidationBatchSize
+
+// ValidationBatchLimitDecorator enforces limits on transactions carrying several validations:
+//   - no more than MaxValidationsPerTx validations (including those wrapped in authz MsgExec)
+//   - the same creator may not validate the same inference twice within one transaction
+//
+// Unlike ValidationEarlyRejectDecorator this is stateless and runs in both CheckTx and DeliverTx.
+type ValidationBatchLimitDecorator struct {
+	inferenceKeeper *inferencemodulekeeper.Keeper
+}
+
+func NewValidationBatchLimitDecorator(ik *inferencemodulekeeper.Keeper) ValidationBatchLimitDecorator {
+	return ValidationBatchLimitDecorator{inferenceKeeper: ik}
+}
+
+type validationBatchKey struct {
+	creator     string
+	inferenceId string
+}
+
+func collectValidation(creator string, inferenceId string, seen map[validationBatchKey]struct{}, count *int) error {
+	*count++
+	if *count > MaxValidationsPerTx {
+		return errorsmod.Wrapf(inferencetypes.ErrTooManyValidationsInTx, "limit is %d", MaxValidationsPerTx)
+	}
+	key := validationBatchKey{creator: creator, inferenceId: inferenceId}
+	if _, ok := seen[key]; ok {
+		return errorsmod.Wrapf(inferencetypes.ErrDuplicateValidationInTx, "inferenceId %s", inferenceId)
+	}
+	seen[key] = struct{}{}
+	return nil
+}
+
+func (d ValidationBatchLimitDecorator) collectValidations(msgs []sdk.Msg, seen map[validationBatchKey]struct{}, count *int) error {
+	for _, msg := range msgs {
+		switch m := msg.(type) {
+		case *inferencetypes.MsgValidation:
+			if err := collectValidation(m.Creator, m.InferenceId, seen, count); err != nil {
+				return err
+			}
+
+		case *inferencetypes.MsgValidationBatch:
+			for _, item := range m.Validations {
+				if err := collectValidation(m.Creator, item.InferenceId, seen, count); err != nil {
+					return err
+				}
+			}
+
+		case *authztypes.MsgExec:
+			if d.inferenceKeeper == nil {
+				continue
+			}
+			inner := make([]sdk.Msg, 0, len(m.Msgs))
+			for _, innerMsg := range m.Msgs {
+				var unwrapped sdk.Msg
+				if err := d.inferenceKeeper.Codec().UnpackAny(innerMsg, &unwrapped); err != nil {
+					// Malformed inner messages are rejected by the authz handler itself
+					continue
+				}
+				inner = append(inner, unwrapped)
+			}
+			if err := d.collectValidations(inner, seen, count); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d ValidationBatchLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	count := 0
+	if err := d.collectValidations(tx.GetMsgs(), make(map[validationBatchKey]struct{}), &count); err != nil {
+		if d.inferenceKeeper != nil {
+			d.inferenceKeeper.LogDebug(
+				"AnteHandle: ValidationBatchLimit - rejecting tx",
+				inferencetypes.Validation,
+				"validations", count,
+				"error", err,
+			)
+		}
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
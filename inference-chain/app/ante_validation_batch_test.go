@@ -0,0 +1,97 @@
+package app
+
+import (
+	"fmt"
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/productscience/inference/testutil"
+	testkeeper "github.com/productscience/inference/testutil/keeper"
+	inferencetypes "github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func passThrough(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+func validationMsgs(n int) []sdk.Msg {
+	msgs := make([]sdk.Msg, n)
+	for i := range msgs {
+		msgs[i] = &inferencetypes.MsgValidation{Creator: testutil.Validator, InferenceId: fmt.Sprintf("inf-%d", i), Value: 0.99}
+	}
+	return msgs
+}
+
+func TestValidationBatchLimitDecorator_AllowsBatchWithinLimit(t *testing.T) {
+	k, ctx := testkeeper.InferenceKeeper(t)
+	decorator := NewValidationBatchLimitDecorator(&k)
+
+	_, err := decorator.AnteHandle(ctx, testTx{msgs: validationMsgs(MaxValidationsPerTx)}, false, passThrough)
+	require.NoError(t, err)
+}
+
+func TestValidationBatchLimitDecorator_RejectsOversizedBatch(t *testing.T) {
+	k, ctx := testkeeper.InferenceKeeper(t)
+	decorator := NewValidationBatchLimitDecorator(&k)
+
+	_, err := decorator.AnteHandle(ctx, testTx{msgs: validationMsgs(MaxValidationsPerTx + 1)}, false, passThrough)
+	require.ErrorIs(t, err, inferencetypes.ErrTooManyValidationsInTx)
+}
+
+func TestValidationBatchLimitDecorator_RejectsDuplicateItem(t *testing.T) {
+	k, ctx := testkeeper.InferenceKeeper(t)
+	decorator := NewValidationBatchLimitDecorator(&k)
+
+	msgs := validationMsgs(3)
+	msgs = append(msgs, &inferencetypes.MsgValidation{Creator: testutil.Validator, InferenceId: "inf-1", Value: 0.5})
+
+	_, err := decorator.AnteHandle(ctx, testTx{msgs: msgs}, false, passThrough)
+	require.ErrorIs(t, err, inferencetypes.ErrDuplicateValidationInTx)
+}
+
+func TestValidationBatchLimitDecorator_CountsAuthzWrappedValidations(t *testing.T) {
+	k, ctx := testkeeper.InferenceKeeper(t)
+	decorator := NewValidationBatchLimitDecorator(&k)
+
+	inner := make([]*codectypes.Any, 0, MaxValidationsPerTx)
+	for _, msg := range validationMsgs(MaxValidationsPerTx) {
+		packed, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		inner = append(inner, packed)
+	}
+	msgs := []sdk.Msg{
+		&authztypes.MsgExec{Grantee: testutil.Executor, Msgs: inner},
+		&inferencetypes.MsgValidation{Creator: testutil.Executor, InferenceId: "extra", Value: 0.99},
+	}
+
+	_, err := decorator.AnteHandle(ctx, testTx{msgs: msgs}, false, passThrough)
+	require.ErrorIs(t, err, inferencetypes.ErrTooManyValidationsInTx)
+}
+
+func TestValidationBatchLimitDecorator_CountsBatchItems(t *testing.T) {
+	k, ctx := testkeeper.InferenceKeeper(t)
+	decorator := NewValidationBatchLimitDecorator(&k)
+
+	items := make([]inferencetypes.ValidationBatchItem, MaxValidationsPerTx)
+	for i := range items {
+		items[i] = inferencetypes.ValidationBatchItem{InferenceId: fmt.Sprintf("batch-%d", i)}
+	}
+	batch := &inferencetypes.MsgValidationBatch{Creator: testutil.Validator, Validations: items}
+
+	_, err := decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{batch}}, false, passThrough)
+	require.NoError(t, err)
+
+	msgs := append([]sdk.Msg{batch}, validationMsgs(1)...)
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: msgs}, false, passThrough)
+	require.ErrorIs(t, err, inferencetypes.ErrTooManyValidationsInTx)
+
+	duplicate := &inferencetypes.MsgValidationBatch{
+		Creator:     testutil.Validator,
+		Validations: []inferencetypes.ValidationBatchItem{items[0], items[1], items[0]},
+	}
+	_, err = decorator.AnteHandle(ctx, testTx{msgs: []sdk.Msg{duplicate}}, false, passThrough)
+	require.ErrorIs(t, err, inferencetypes.ErrDuplicateValidationInTx)
+}
@@ -99,11 +99,15 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	"github.com/productscience/inference/docs"
+	"github.com/spf13/cast"
 )
 
 const (
 	AccountAddressPrefix = "gonka"
 	Name                 = "inference"
+
+	// FlagPrunedRecordsExportDir is the app.toml option of the directory pruned records are exported to
+	FlagPrunedRecordsExportDir = "inference.pruned_records_export_dir"
 )
 
 var (
@@ -301,6 +305,15 @@ func New(
 		return nil, err
 	}
 
+	// export pruned inferences and epoch group data, for archive nodes
+	if exportDir := cast.ToString(appOpts.Get(FlagPrunedRecordsExportDir)); exportDir != "" {
+		exporter, err := inferencemodulekeeper.NewFilePrunedRecordExporter(exportDir, app.InferenceKeeper)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create pruned records exporter: %w", err)
+		}
+		app.InferenceKeeper.SetPrunedRecordExporter(exporter)
+	}
+
 	/****  Module Options ****/
 	// must be before Loading version
 	// requires the snapshot store to be created and registered as a BaseAppOption
@@ -72,6 +72,7 @@ import (
 	collateralmoduletypes "github.com/productscience/inference/x/collateral/types"
 	_ "github.com/productscience/inference/x/genesistransfer/module" // import for side-effects
 	genesistransfermoduletypes "github.com/productscience/inference/x/genesistransfer/types"
+	"github.com/productscience/inference/x/inference/interchainquery"
 	_ "github.com/productscience/inference/x/inference/module" // import for side-effects
 	inferencemoduletypes "github.com/productscience/inference/x/inference/types"
 	_ "github.com/productscience/inference/x/restrictions/module" // import for side-effects
@@ -105,6 +106,7 @@ var (
 		authz.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		interchainquery.ModuleName,
 		ibcfeetypes.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
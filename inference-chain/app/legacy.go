@@ -45,6 +45,7 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/productscience/inference/x/inference/interchainquery"
 	inferencetypes "github.com/productscience/inference/x/inference/types"
 	"github.com/spf13/cast"
 	// this line is used by starport scaffolding # ibc/app/import
@@ -92,6 +93,7 @@ func (app *App) registerLegacyModules(appOpts servertypes.AppOptions, wasmOpts [
 	scopedIBCTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedInterchainQueryKeeper := app.CapabilityKeeper.ScopeToModule(interchainquery.ModuleName)
 
 	// Create IBC keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
@@ -232,6 +234,10 @@ func (app *App) registerLegacyModules(appOpts servertypes.AppOptions, wasmOpts [
 
 	icaHostIBCModule := ibcfee.NewIBCMiddleware(icahost.NewIBCModule(app.ICAHostKeeper), app.IBCFeeKeeper)
 
+	// Other chains query participant sets, epoch groups and models of x/inference
+	interchainQueryHost := interchainquery.NewHost(app.appCodec, app.InferenceKeeper, app.IBCKeeper.PortKeeper, scopedInterchainQueryKeeper)
+	interchainQueryIBCModule := ibcfee.NewIBCMiddleware(interchainquery.NewIBCModule(interchainQueryHost), app.IBCFeeKeeper)
+
 	// Create fee enabled wasm ibc Stack
 	var wasmStack porttypes.IBCModule
 	wasmStack = wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper)
@@ -242,7 +248,8 @@ func (app *App) registerLegacyModules(appOpts servertypes.AppOptions, wasmOpts [
 		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
 		AddRoute(wasmtypes.ModuleName, wasmStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
-		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule)
+		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(interchainquery.ModuleName, interchainQueryIBCModule)
 
 	// this line is used by starport scaffolding # ibc/app/module
 
@@ -259,6 +266,7 @@ func (app *App) registerLegacyModules(appOpts servertypes.AppOptions, wasmOpts [
 		ibctransfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		icamodule.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		interchainquery.NewAppModule(interchainQueryHost),
 		capability.NewAppModule(app.appCodec, *app.CapabilityKeeper, false),
 		ibctm.AppModule{},
 		solomachine.AppModule{},
@@ -282,6 +290,7 @@ func RegisterLegacyModules(registry cdctypes.InterfaceRegistry) map[string]appmo
 		ibctransfertypes.ModuleName: ibctransfer.AppModule{},
 		ibcfeetypes.ModuleName:      ibcfee.AppModule{},
 		icatypes.ModuleName:         icamodule.AppModule{},
+		interchainquery.ModuleName:  interchainquery.AppModule{},
 		capabilitytypes.ModuleName:  capability.AppModule{},
 		ibctm.ModuleName:            ibctm.AppModule{},
 		solomachine.ModuleName:      solomachine.AppModule{},
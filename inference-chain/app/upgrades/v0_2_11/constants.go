@@ -0,0 +1,3 @@
+package v0_2_11
+
+const UpgradeName = "v0.2.11"
@@ -183,6 +183,7 @@ func setupTestKeeper(t *testing.T, ctrl *gomock.Controller) (keeper.Keeper, sdk.
 		runtime.NewKVStoreService(blsStoreKey),
 		log.NewNopLogger(),
 		authority.String(),
+		nil,
 	)
 
 	k := keeper.NewKeeper(
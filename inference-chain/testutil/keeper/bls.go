@@ -22,6 +22,10 @@ import (
 )
 
 func BlsKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	return BlsKeeperWithBank(t, nil)
+}
+
+func BlsKeeperWithBank(t testing.TB, bankKeeper types.BankKeeper) (keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -38,6 +42,7 @@ func BlsKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bankKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -734,6 +734,22 @@ func (mr *MockCollateralKeeperMockRecorder) Slash(ctx, participant, slashFractio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Slash", reflect.TypeOf((*MockCollateralKeeper)(nil).Slash), ctx, participant, slashFraction, reason)
 }
 
+// UnbondAllCollateral mocks base method.
+func (m *MockCollateralKeeper) UnbondAllCollateral(ctx context.Context, participant types0.AccAddress) (types0.Coin, uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbondAllCollateral", ctx, participant)
+	ret0, _ := ret[0].(types0.Coin)
+	ret1, _ := ret[1].(uint64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UnbondAllCollateral indicates an expected call of UnbondAllCollateral.
+func (mr *MockCollateralKeeperMockRecorder) UnbondAllCollateral(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbondAllCollateral", reflect.TypeOf((*MockCollateralKeeper)(nil).UnbondAllCollateral), ctx, participant)
+}
+
 // MockStreamVestingKeeper is a mock of StreamVestingKeeper interface.
 type MockStreamVestingKeeper struct {
 	ctrl     *gomock.Controller
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// MaxDKGRoundRetries is how many times a stalled DKG round is restarted without its non-responders
+// before the DKG of the epoch is marked as FAILED
+const MaxDKGRoundRetries = 2
+
+// SetDKGRoundRetries stores how many times the DKG of an epoch was restarted
+func (k Keeper) SetDKGRoundRetries(ctx sdk.Context, epochID uint64, retries uint32) {
+	store := k.storeService.OpenKVStore(ctx)
+	value := make([]byte, 4)
+	binary.BigEndian.PutUint32(value, retries)
+	if err := store.Set(types.DKGRoundRetriesKey(epochID), value); err != nil {
+		k.Logger().Error("Failed to set DKG round retries", "epochId", epochID, "error", err)
+	}
+}
+
+// GetDKGRoundRetries returns how many times the DKG of an epoch was restarted
+func (k Keeper) GetDKGRoundRetries(ctx sdk.Context, epochID uint64) uint32 {
+	store := k.storeService.OpenKVStore(ctx)
+	value, err := store.Get(types.DKGRoundRetriesKey(epochID))
+	if err != nil || len(value) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(value)
+}
+
+// handleStalledDKG resolves a DKG round that reached its phase deadline without enough participation.
+// responded marks, by participant index, who submitted in the stalled phase. While retries remain,
+// the round is restarted for the same epoch with only the responders, who get all slots reassigned.
+// Otherwise the DKG is marked as FAILED.
+func (k Keeper) handleStalledDKG(ctx sdk.Context, epochBLSData *types.EpochBLSData, responded []bool, stallReason string) error {
+	var responders []types.ParticipantWithWeightAndKey
+	var excluded []string
+	for i, participant := range epochBLSData.Participants {
+		if i < len(responded) && responded[i] {
+			responders = append(responders, types.ParticipantWithWeightAndKey{
+				Address:            participant.Address,
+				PercentageWeight:   participant.PercentageWeight,
+				Secp256k1PublicKey: participant.Secp256K1PublicKey,
+			})
+		} else {
+			excluded = append(excluded, participant.Address)
+		}
+	}
+
+	retries := k.GetDKGRoundRetries(ctx, epochBLSData.EpochId)
+	if len(responders) > 0 && retries < MaxDKGRoundRetries {
+		stalledPhase := epochBLSData.DkgPhase
+		err := k.InitiateKeyGenerationForEpoch(ctx, epochBLSData.EpochId, responders)
+		if err == nil {
+			k.SetDKGRoundRetries(ctx, epochBLSData.EpochId, retries+1)
+			restarted, err := k.GetEpochBLSData(ctx, epochBLSData.EpochId)
+			if err != nil {
+				return fmt.Errorf("failed to get restarted EpochBLSData for epoch %d: %w", epochBLSData.EpochId, err)
+			}
+			*epochBLSData = restarted
+
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeDKGRoundRestarted,
+				sdk.NewAttribute(types.AttributeKeyEpochId, strconv.FormatUint(epochBLSData.EpochId, 10)),
+				sdk.NewAttribute(types.AttributeKeyPhase, stalledPhase.String()),
+				sdk.NewAttribute(types.AttributeKeyAttempt, strconv.FormatUint(uint64(retries+1), 10)),
+				sdk.NewAttribute(types.AttributeKeyExcluded, strings.Join(excluded, ",")),
+			))
+
+			k.Logger().Info("Restarted stalled DKG round without non-responders",
+				"epochId", epochBLSData.EpochId,
+				"stalledPhase", stalledPhase.String(),
+				"attempt", retries+1,
+				"participants", len(responders),
+				"excluded", len(excluded),
+				"reason", stallReason)
+			return nil
+		}
+		k.Logger().Error("Failed to restart stalled DKG round, marking DKG as FAILED",
+			"epochId", epochBLSData.EpochId, "error", err)
+	}
+
+	epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
+
+	// Store updated epoch data
+	if err := k.SetEpochBLSData(ctx, *epochBLSData); err != nil {
+		return fmt.Errorf("failed to set EpochBLSData for epoch %d: %w", epochBLSData.EpochId, err)
+	}
+
+	// Clear active epoch since DKG process is complete (failed)
+	k.ClearActiveEpochID(ctx)
+
+	failureReason := fmt.Sprintf("%s after %d retries", stallReason, retries)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
+		EpochId:   epochBLSData.EpochId,
+		Reason:    failureReason,
+		EpochData: *epochBLSData,
+	}); err != nil {
+		return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+	}
+
+	k.Logger().Info("DKG marked as FAILED due to insufficient participation",
+		"epochId", epochBLSData.EpochId,
+		"reason", failureReason)
+	return nil
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// SetExternalSigningPolicy selects who may request threshold signatures through MsgRequestThresholdSignature
+// and at what rate and fee. Governance selects it with MsgUpdateExternalSigningPolicy.
+func (k Keeper) SetExternalSigningPolicy(ctx sdk.Context, policy types.ExternalSigningPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.ExternalSigningPolicyKey, bz)
+}
+
+// GetStoredExternalSigningPolicy returns the JSON of the selected policy for genesis export,
+// empty while the default applies
+func (k Keeper) GetStoredExternalSigningPolicy(ctx sdk.Context) string {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.ExternalSigningPolicyKey)
+	if err != nil {
+		return ""
+	}
+	return string(bz)
+}
+
+// GetExternalSigningPolicy returns the active external signing policy,
+// or types.DefaultExternalSigningPolicy if none was selected
+func (k Keeper) GetExternalSigningPolicy(ctx sdk.Context) types.ExternalSigningPolicy {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.ExternalSigningPolicyKey)
+	if err != nil || bz == nil {
+		return types.DefaultExternalSigningPolicy()
+	}
+	var policy types.ExternalSigningPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.Logger().Error("Failed to decode external signing policy, using default", "error", err)
+		return types.DefaultExternalSigningPolicy()
+	}
+	return policy
+}
+
+// GetExternalSigningUsage returns the requests a requester made in its current rate limit window
+func (k Keeper) GetExternalSigningUsage(ctx sdk.Context, requester string) types.ExternalSigningUsage {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.ExternalSigningUsageKey(requester))
+	if err != nil || bz == nil {
+		return types.ExternalSigningUsage{}
+	}
+	var usage types.ExternalSigningUsage
+	if err := json.Unmarshal(bz, &usage); err != nil {
+		k.Logger().Error("Failed to decode external signing usage", "requester", requester, "error", err)
+		return types.ExternalSigningUsage{}
+	}
+	return usage
+}
+
+func (k Keeper) setExternalSigningUsage(ctx sdk.Context, requester string, usage types.ExternalSigningUsage) error {
+	bz, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Set(types.ExternalSigningUsageKey(requester), bz)
+}
+
+// AdmitExternalSigningRequest checks an external threshold signing request against the external signing
+// policy: the requester must be approved, every data item must be a 32-byte digest and the requester must be
+// within its rate limit. The request is then counted and its fee is charged to the requester.
+// Every request is admitted while the policy is disabled.
+func (k Keeper) AdmitExternalSigningRequest(ctx sdk.Context, requester string, data [][]byte) error {
+	policy := k.GetExternalSigningPolicy(ctx)
+	if !policy.Enabled {
+		return nil
+	}
+	if !policy.IsApproved(requester) {
+		return errorsmod.Wrapf(types.ErrRequesterNotApproved, "requester %s", requester)
+	}
+	for i, digest := range data {
+		if len(digest) != types.SigningDigestSize {
+			return errorsmod.Wrapf(types.ErrInvalidSigningDigest, "data[%d] has %d bytes", i, len(digest))
+		}
+	}
+
+	usage := k.GetExternalSigningUsage(ctx, requester)
+	if policy.MaxRequestsPerWindow > 0 {
+		if ctx.BlockHeight() >= usage.WindowStartHeight+policy.WindowBlocks {
+			usage = types.ExternalSigningUsage{WindowStartHeight: ctx.BlockHeight()}
+		}
+		if usage.Requests >= policy.MaxRequestsPerWindow {
+			return errorsmod.Wrapf(types.ErrSigningRateLimited, "%d requests since block %d, next window at block %d",
+				usage.Requests, usage.WindowStartHeight, usage.WindowStartHeight+policy.WindowBlocks)
+		}
+	}
+	usage.Requests++
+	if err := k.setExternalSigningUsage(ctx, requester, usage); err != nil {
+		return fmt.Errorf("failed to store external signing usage: %w", err)
+	}
+
+	if !policy.Fee.IsZero() {
+		requesterAddr, err := sdk.AccAddressFromBech32(requester)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, requesterAddr, authtypes.FeeCollectorName, policy.Fee); err != nil {
+			return fmt.Errorf("failed to charge threshold signing fee %s: %w", policy.Fee, err)
+		}
+	}
+	return nil
+}
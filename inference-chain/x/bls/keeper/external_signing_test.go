@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/keeper"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+type recordingBankKeeper struct {
+	charged map[string]sdk.Coins
+}
+
+func (b *recordingBankKeeper) SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+func (b *recordingBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	b.charged[senderAddr.String()+"->"+recipientModule] = b.charged[senderAddr.String()+"->"+recipientModule].Add(amt...)
+	return nil
+}
+
+func TestExternalSigningPolicy(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+	requester := sdk.AccAddress([]byte("approved_requester__")).String()
+
+	require.Equal(t, types.DefaultExternalSigningPolicy(), k.GetExternalSigningPolicy(ctx))
+
+	policy := types.ExternalSigningPolicy{
+		Enabled:              true,
+		ApprovedRequesters:   []string{requester},
+		MaxRequestsPerWindow: 2,
+		WindowBlocks:         10,
+		Fee:                  sdk.NewCoins(sdk.NewInt64Coin("ngonka", 1000)),
+	}
+	require.NoError(t, k.SetExternalSigningPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetExternalSigningPolicy(ctx))
+
+	require.Error(t, k.SetExternalSigningPolicy(ctx, types.ExternalSigningPolicy{ApprovedRequesters: []string{"bad"}}))
+	require.Error(t, k.SetExternalSigningPolicy(ctx, types.ExternalSigningPolicy{MaxRequestsPerWindow: 1}))
+	require.Equal(t, policy, k.GetExternalSigningPolicy(ctx))
+}
+
+func TestRequestThresholdSignature_ExternalSigningPolicy(t *testing.T) {
+	bank := &recordingBankKeeper{charged: map[string]sdk.Coins{}}
+	k, ctx := keepertest.BlsKeeperWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(k)
+
+	requester := sdk.AccAddress([]byte("approved_requester__")).String()
+	stranger := sdk.AccAddress([]byte("unapproved_requester")).String()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("ngonka", 1000))
+	require.NoError(t, k.SetExternalSigningPolicy(ctx, types.ExternalSigningPolicy{
+		Enabled:              true,
+		ApprovedRequesters:   []string{requester},
+		MaxRequestsPerWindow: 2,
+		WindowBlocks:         10,
+		Fee:                  fee,
+	}))
+	require.NoError(t, k.SetEpochBLSData(ctx, types.EpochBLSData{
+		EpochId:        1,
+		DkgPhase:       types.DKGPhase_DKG_PHASE_COMPLETED,
+		ITotalSlots:    4,
+		GroupPublicKey: make([]byte, 96),
+	}))
+	ctx = ctx.WithBlockHeight(100)
+
+	digest := make([]byte, types.SigningDigestSize)
+	request := func(creator string, requestID string, data ...[]byte) error {
+		_, err := ms.RequestThresholdSignature(ctx, &types.MsgRequestThresholdSignature{
+			Creator:        creator,
+			CurrentEpochId: 1,
+			ChainId:        []byte("chain"),
+			RequestId:      []byte(requestID),
+			Data:           data,
+		})
+		return err
+	}
+
+	require.ErrorIs(t, request(stranger, "r0", digest), types.ErrRequesterNotApproved)
+	require.ErrorIs(t, request(requester, "r0", []byte("not a digest")), types.ErrInvalidSigningDigest)
+
+	require.NoError(t, request(requester, "r1", digest))
+	require.NoError(t, request(requester, "r2", digest, digest))
+	require.ErrorIs(t, request(requester, "r3", digest), types.ErrSigningRateLimited)
+
+	_, err := k.GetSigningStatus(ctx, []byte("r2"))
+	require.NoError(t, err)
+	require.Equal(t, types.ExternalSigningUsage{WindowStartHeight: 100, Requests: 2}, k.GetExternalSigningUsage(ctx, requester))
+
+	// A new window opens after WindowBlocks
+	ctx = ctx.WithBlockHeight(110)
+	require.NoError(t, request(requester, "r3", digest))
+	require.Equal(t, types.ExternalSigningUsage{WindowStartHeight: 110, Requests: 1}, k.GetExternalSigningUsage(ctx, requester))
+
+	require.Equal(t, fee.MulInt(math.NewInt(3)), bank.charged[requester+"->"+authtypes.FeeCollectorName])
+}
+
+func TestRequestThresholdSignature_DefaultPolicyAdmitsAll(t *testing.T) {
+	bank := &recordingBankKeeper{charged: map[string]sdk.Coins{}}
+	k, ctx := keepertest.BlsKeeperWithBank(t, bank)
+	ms := keeper.NewMsgServerImpl(k)
+	require.NoError(t, k.SetEpochBLSData(ctx, types.EpochBLSData{
+		EpochId:        1,
+		DkgPhase:       types.DKGPhase_DKG_PHASE_COMPLETED,
+		ITotalSlots:    4,
+		GroupPublicKey: make([]byte, 96),
+	}))
+
+	// Until governance selects a policy, any account may request signatures over any data, free of charge
+	requester := sdk.AccAddress([]byte("unapproved_requester")).String()
+	for i := 0; i < 20; i++ {
+		_, err := ms.RequestThresholdSignature(ctx, &types.MsgRequestThresholdSignature{
+			Creator:        requester,
+			CurrentEpochId: 1,
+			ChainId:        []byte("chain"),
+			RequestId:      []byte{byte(i)},
+			Data:           [][]byte{[]byte("not a digest")},
+		})
+		require.NoError(t, err)
+	}
+	require.Empty(t, bank.charged)
+}
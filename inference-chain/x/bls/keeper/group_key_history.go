@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+// GroupKeyHistory returns the group public keys of completed DKGs from fromEpochId onwards, in epoch order,
+// together with their validation signatures. Epochs whose DKG did not complete are skipped, which breaks
+// the chain of custody at that point. A limit of 0 returns all remaining records.
+func (k Keeper) GroupKeyHistory(ctx sdk.Context, fromEpochId uint64, limit uint32) ([]types.GroupKeyRecord, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	epochStore := prefix.NewStore(store, types.EpochBLSDataPrefix)
+
+	start := make([]byte, 8)
+	binary.BigEndian.PutUint64(start, fromEpochId)
+	iterator := epochStore.Iterator(start, nil)
+	defer iterator.Close()
+
+	records := []types.GroupKeyRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var epochBLSData types.EpochBLSData
+		if err := k.cdc.Unmarshal(iterator.Value(), &epochBLSData); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal EpochBLSData: %w", err)
+		}
+		if epochBLSData.DkgPhase != types.DKGPhase_DKG_PHASE_COMPLETED && epochBLSData.DkgPhase != types.DKGPhase_DKG_PHASE_SIGNED {
+			continue
+		}
+
+		record := types.GroupKeyRecord{
+			EpochId:             epochBLSData.EpochId,
+			DkgPhase:            epochBLSData.DkgPhase,
+			GroupPublicKey:      epochBLSData.GroupPublicKey,
+			ValidationSignature: epochBLSData.ValidationSignature,
+		}
+		if len(epochBLSData.ValidationSignature) > 0 {
+			record.ValidatedByEpochId = epochBLSData.EpochId - 1
+		}
+		records = append(records, record)
+
+		if limit > 0 && uint32(len(records)) >= limit {
+			break
+		}
+	}
+	return records, nil
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/types"
+)
+
+func TestGroupKeyHistory(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+
+	for _, data := range []types.EpochBLSData{
+		{EpochId: 1, DkgPhase: types.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key1")},
+		{EpochId: 2, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: []byte("key2"), ValidationSignature: []byte("sig2")},
+		{EpochId: 3, DkgPhase: types.DKGPhase_DKG_PHASE_FAILED},
+		{EpochId: 256, DkgPhase: types.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key256")},
+		{EpochId: 257, DkgPhase: types.DKGPhase_DKG_PHASE_DEALING},
+	} {
+		require.NoError(t, k.SetEpochBLSData(ctx, data))
+	}
+
+	history, err := k.GroupKeyHistory(ctx, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, []types.GroupKeyRecord{
+		{EpochId: 1, DkgPhase: types.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key1")},
+		{EpochId: 2, DkgPhase: types.DKGPhase_DKG_PHASE_SIGNED, GroupPublicKey: []byte("key2"), ValidationSignature: []byte("sig2"), ValidatedByEpochId: 1},
+		{EpochId: 256, DkgPhase: types.DKGPhase_DKG_PHASE_COMPLETED, GroupPublicKey: []byte("key256")},
+	}, history)
+
+	history, err = k.GroupKeyHistory(ctx, 2, 1)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, uint64(2), history[0].EpochId)
+
+	history, err = k.GroupKeyHistory(ctx, 258, 0)
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
@@ -37,11 +37,16 @@ func (ms msgServer) SubmitPartialSignature(ctx context.Context, msg *types.MsgSu
 	return &types.MsgSubmitPartialSignatureResponse{}, nil
 }
 
-// RequestThresholdSignature handles requests for threshold signatures from external users
+// RequestThresholdSignature handles requests for threshold signatures from external users, such as bridges
 func (ms msgServer) RequestThresholdSignature(ctx context.Context, msg *types.MsgRequestThresholdSignature) (*types.MsgRequestThresholdSignatureResponse, error) {
 	// Convert to SDK context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
+	// Only governance-approved requesters may request signatures, within their rate limit and for a fee
+	if err := ms.AdmitExternalSigningRequest(sdkCtx, msg.Creator, msg.Data); err != nil {
+		return nil, err
+	}
+
 	// Add domain separation for external requests by prepending CUSTOM_SIGNATURE_DOMAIN
 	// This prevents unauthorized signatures from being created for unintended operations
 	customData := make([][]byte, 0, len(msg.Data)+1)
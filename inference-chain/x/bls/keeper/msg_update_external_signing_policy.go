@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+func (k msgServer) UpdateExternalSigningPolicy(goCtx context.Context, req *types.MsgUpdateExternalSigningPolicy) (*types.MsgUpdateExternalSigningPolicyResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	policy, err := types.ParseExternalSigningPolicy(req.Policy)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid policy")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetExternalSigningPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateExternalSigningPolicyResponse{}, nil
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/bls/types"
+)
+
+func TestMsgUpdateExternalSigningPolicy(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	wctx := sdk.UnwrapSDKContext(ctx)
+	requester := sdk.AccAddress([]byte("approved_requester__")).String()
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgUpdateExternalSigningPolicy
+		expErrMsg string
+	}{
+		{
+			name: "invalid authority",
+			input: &types.MsgUpdateExternalSigningPolicy{
+				Authority: "invalid",
+				Policy:    `{"enabled":false}`,
+			},
+			expErrMsg: "invalid authority",
+		},
+		{
+			name: "unknown field",
+			input: &types.MsgUpdateExternalSigningPolicy{
+				Authority: k.GetAuthority(),
+				Policy:    `{"enabled":true,"approved_requester":["x"]}`,
+			},
+			expErrMsg: "unknown field",
+		},
+		{
+			name: "all good",
+			input: &types.MsgUpdateExternalSigningPolicy{
+				Authority: k.GetAuthority(),
+				Policy:    `{"enabled":true,"approved_requesters":["` + requester + `"],"max_requests_per_window":2,"window_blocks":10}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.UpdateExternalSigningPolicy(wctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	policy := k.GetExternalSigningPolicy(wctx)
+	require.True(t, policy.Enabled)
+	require.Equal(t, []string{requester}, policy.ApprovedRequesters)
+	require.NotEmpty(t, k.GetStoredExternalSigningPolicy(wctx))
+}
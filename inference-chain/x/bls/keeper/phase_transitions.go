@@ -55,7 +55,7 @@ func (k Keeper) ProcessDKGPhaseTransitionForEpoch(ctx sdk.Context, epochID uint6
 	return nil
 }
 
-// TransitionToVerifyingPhase transitions a DKG from DEALING phase to either VERIFYING or FAILED based on participation
+// TransitionToVerifyingPhase transitions a DKG from DEALING phase to VERIFYING, or restarts or fails it, based on participation
 func (k Keeper) TransitionToVerifyingPhase(ctx sdk.Context, epochBLSData *types.EpochBLSData) error {
 	if epochBLSData.DkgPhase != types.DKGPhase_DKG_PHASE_DEALING {
 		return fmt.Errorf("DKG for epoch %d is not in DEALING phase, current phase: %s", epochBLSData.EpochId, epochBLSData.DkgPhase.String())
@@ -101,32 +101,20 @@ func (k Keeper) TransitionToVerifyingPhase(ctx sdk.Context, epochBLSData *types.
 			"verifyingDeadline", epochBLSData.VerifyingPhaseDeadlineBlock)
 
 	} else {
-		// Insufficient participation - mark as FAILED
-		epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
-
-		// Store updated epoch data
-		if err := k.SetEpochBLSData(ctx, *epochBLSData); err != nil {
-			return fmt.Errorf("failed to set EpochBLSData for epoch %d: %w", epochBLSData.EpochId, err)
-		}
-
-		// Clear active epoch since DKG process is complete (failed)
-		k.ClearActiveEpochID(ctx)
-
-		// Emit event for DKG failure
-		failureReason := fmt.Sprintf("Insufficient participation in dealing phase: %d slots with dealer parts out of %d total slots (required: >%d)",
+		// Insufficient participation - restart without the non-responders, or mark as FAILED
+		stallReason := fmt.Sprintf("Insufficient participation in dealing phase: %d slots with dealer parts out of %d total slots (required: >%d)",
 			slotsWithDealerParts, epochBLSData.ITotalSlots, epochBLSData.ITotalSlots/2)
 
-		if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
-			EpochId:   epochBLSData.EpochId,
-			Reason:    failureReason,
-			EpochData: *epochBLSData,
-		}); err != nil {
-			return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+		responded := make([]bool, len(epochBLSData.Participants))
+		for i := range epochBLSData.Participants {
+			responded[i] = i < len(epochBLSData.DealerParts) &&
+				epochBLSData.DealerParts[i] != nil &&
+				epochBLSData.DealerParts[i].DealerAddress != ""
 		}
 
-		k.Logger().Info("DKG marked as FAILED due to insufficient participation",
-			"epochId", epochBLSData.EpochId,
-			"reason", failureReason)
+		if err := k.handleStalledDKG(ctx, epochBLSData, responded, stallReason); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -232,32 +220,20 @@ func (k Keeper) CompleteDKG(ctx sdk.Context, epochBLSData *types.EpochBLSData) e
 			"groupPublicKeySize", len(groupPublicKey))
 
 	} else {
-		// Insufficient verification participation - mark as FAILED
-		epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_FAILED
-
-		// Store updated epoch data
-		if err := k.SetEpochBLSData(ctx, *epochBLSData); err != nil {
-			return fmt.Errorf("failed to set EpochBLSData for epoch %d: %w", epochBLSData.EpochId, err)
-		}
-
-		// Clear active epoch since DKG process is complete (failed)
-		k.ClearActiveEpochID(ctx)
-
-		// Emit event for DKG failure
-		failureReason := fmt.Sprintf("Insufficient participation in verification phase: %d slots with verification vectors out of %d total slots (required: >%d)",
+		// Insufficient verification participation - restart without the non-responders, or mark as FAILED
+		stallReason := fmt.Sprintf("Insufficient participation in verification phase: %d slots with verification vectors out of %d total slots (required: >%d)",
 			slotsWithVerification, epochBLSData.ITotalSlots, epochBLSData.ITotalSlots/2)
 
-		if err := ctx.EventManager().EmitTypedEvent(&types.EventDKGFailed{
-			EpochId:   epochBLSData.EpochId,
-			Reason:    failureReason,
-			EpochData: *epochBLSData,
-		}); err != nil {
-			return fmt.Errorf("failed to emit EventDKGFailed for epoch %d: %w", epochBLSData.EpochId, err)
+		responded := make([]bool, len(epochBLSData.Participants))
+		for i := range epochBLSData.Participants {
+			responded[i] = i < len(epochBLSData.VerificationSubmissions) &&
+				epochBLSData.VerificationSubmissions[i] != nil &&
+				len(epochBLSData.VerificationSubmissions[i].DealerValidity) > 0
 		}
 
-		k.Logger().Info("DKG marked as FAILED due to insufficient verification participation",
-			"epochId", epochBLSData.EpochId,
-			"reason", failureReason)
+		if err := k.handleStalledDKG(ctx, epochBLSData, responded, stallReason); err != nil {
+			return err
+		}
 	}
 
 	return nil
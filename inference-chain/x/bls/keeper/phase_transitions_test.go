@@ -10,6 +10,7 @@ import (
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/bls/keeper"
 	"github.com/productscience/inference/x/bls/types"
 )
 
@@ -57,6 +58,7 @@ func TestTransitionToVerifyingPhase_InsufficientParticipation(t *testing.T) {
 	epochBLSData.DealerParts[0].DealerAddress = "participant1"
 
 	// Store the epoch data
+	k.SetDKGRoundRetries(ctx, epochID, keeper.MaxDKGRoundRetries) // no restarts left
 	k.SetEpochBLSData(ctx, epochBLSData)
 
 	// Set current block height to trigger transition
@@ -216,6 +218,7 @@ func TestActiveEpochClearedOnFailure(t *testing.T) {
 	// Only mark first participant as having submitted (insufficient)
 	epochBLSData.DealerParts[0].DealerAddress = "participant1"
 
+	k.SetDKGRoundRetries(ctx, epochID, keeper.MaxDKGRoundRetries) // no restarts left
 	k.SetEpochBLSData(ctx, epochBLSData)
 	k.SetActiveEpochID(ctx, epochID)
 
@@ -365,6 +368,7 @@ func TestCompleteDKG_InsufficientVerification(t *testing.T) {
 	// Only set up verification submission for first participant (insufficient <50%)
 	epochBLSData.VerificationSubmissions[0].DealerValidity = []bool{true, true, false}
 
+	k.SetDKGRoundRetries(ctx, epochID, keeper.MaxDKGRoundRetries) // no restarts left
 	k.SetEpochBLSData(ctx, epochBLSData)
 	k.SetActiveEpochID(ctx, epochID)
 
@@ -530,6 +534,7 @@ func TestProcessDKGPhaseTransitionForEpoch_VerifyingToFailed(t *testing.T) {
 	// Set up verification submission for only one participant (insufficient)
 	epochBLSData.VerificationSubmissions[0].DealerValidity = []bool{true, false, false}
 
+	k.SetDKGRoundRetries(ctx, epochID, keeper.MaxDKGRoundRetries) // no restarts left
 	k.SetEpochBLSData(ctx, epochBLSData)
 	k.SetActiveEpochID(ctx, epochID)
 
@@ -551,3 +556,82 @@ func TestProcessDKGPhaseTransitionForEpoch_VerifyingToFailed(t *testing.T) {
 	require.False(t, found)
 	require.Equal(t, uint64(0), activeEpoch)
 }
+
+func TestTransitionToVerifyingPhase_RestartsStalledRound(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+
+	epochID := uint64(28)
+	epochBLSData := createTestEpochBLSData(epochID, 3)
+	// Only participant1 dealt (insufficient)
+	epochBLSData.DealerParts[0].DealerAddress = "participant1"
+
+	k.SetEpochBLSData(ctx, epochBLSData)
+	k.SetActiveEpochID(ctx, epochID)
+
+	ctx = ctx.WithBlockHeight(epochBLSData.DealingPhaseDeadlineBlock)
+	err := k.TransitionToVerifyingPhase(ctx, &epochBLSData)
+	require.NoError(t, err)
+
+	// The round restarted with only the responder holding every slot
+	storedData, err := k.GetEpochBLSData(ctx, epochID)
+	require.NoError(t, err)
+	require.Equal(t, storedData, epochBLSData)
+	require.Equal(t, types.DKGPhase_DKG_PHASE_DEALING, storedData.DkgPhase)
+	require.Len(t, storedData.Participants, 1)
+	require.Equal(t, "participant1", storedData.Participants[0].Address)
+	require.Equal(t, uint32(0), storedData.Participants[0].SlotStartIndex)
+	require.Equal(t, storedData.ITotalSlots-1, storedData.Participants[0].SlotEndIndex)
+	require.Greater(t, storedData.DealingPhaseDeadlineBlock, ctx.BlockHeight())
+	require.Equal(t, "", storedData.DealerParts[0].DealerAddress)
+	require.Equal(t, uint32(1), k.GetDKGRoundRetries(ctx, epochID))
+
+	activeEpoch, found := k.GetActiveEpochID(ctx)
+	require.True(t, found)
+	require.Equal(t, epochID, activeEpoch)
+
+	restarted := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDKGRoundRestarted {
+			restarted = true
+			excluded, ok := event.GetAttribute(types.AttributeKeyExcluded)
+			require.True(t, ok)
+			require.Equal(t, "participant2,participant3", excluded.Value)
+		}
+	}
+	require.True(t, restarted)
+}
+
+func TestCompleteDKG_RestartsStalledRoundUntilRetriesExhausted(t *testing.T) {
+	k, ctx := keepertest.BlsKeeper(t)
+
+	epochID := uint64(29)
+	epochBLSData := createTestEpochBLSData(epochID, 3)
+	epochBLSData.DkgPhase = types.DKGPhase_DKG_PHASE_VERIFYING
+	// Only participant2 verified (insufficient)
+	epochBLSData.VerificationSubmissions[1].DealerValidity = []bool{true, true, true}
+	k.SetDKGRoundRetries(ctx, epochID, keeper.MaxDKGRoundRetries-1)
+
+	k.SetEpochBLSData(ctx, epochBLSData)
+	k.SetActiveEpochID(ctx, epochID)
+
+	ctx = ctx.WithBlockHeight(epochBLSData.VerifyingPhaseDeadlineBlock)
+	err := k.CompleteDKG(ctx, &epochBLSData)
+	require.NoError(t, err)
+
+	// Last retry: restarted with participant2 only
+	require.Equal(t, types.DKGPhase_DKG_PHASE_DEALING, epochBLSData.DkgPhase)
+	require.Len(t, epochBLSData.Participants, 1)
+	require.Equal(t, "participant2", epochBLSData.Participants[0].Address)
+	require.Equal(t, uint32(keeper.MaxDKGRoundRetries), k.GetDKGRoundRetries(ctx, epochID))
+
+	// Nobody deals in the restarted round: no responders and no retries left, so the DKG fails
+	ctx = ctx.WithBlockHeight(epochBLSData.DealingPhaseDeadlineBlock)
+	err = k.ProcessDKGPhaseTransitionForEpoch(ctx, epochID)
+	require.NoError(t, err)
+
+	storedData, err := k.GetEpochBLSData(ctx, epochID)
+	require.NoError(t, err)
+	require.Equal(t, types.DKGPhase_DKG_PHASE_FAILED, storedData.DkgPhase)
+	_, found := k.GetActiveEpochID(ctx)
+	require.False(t, found)
+}
@@ -46,6 +46,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateExternalSigningPolicy",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "RequestThresholdSignature",
 					Use:       "request-threshold-signature [current_epoch_id] [chain_id] [request_id] [data...]",
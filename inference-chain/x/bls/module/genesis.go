@@ -18,6 +18,17 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 	// Set the active epoch ID from genesis
 	k.SetActiveEpochID(ctx, genState.ActiveEpochId)
+
+	if genState.ExternalSigningPolicy != "" {
+		policy, err := types.ParseExternalSigningPolicy(genState.ExternalSigningPolicy)
+		if err == nil {
+			err = k.SetExternalSigningPolicy(ctx, policy)
+		}
+		if err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -36,6 +47,8 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.ActiveEpochId = activeEpochID
 	}
 
+	genesis.ExternalSigningPolicy = k.GetStoredExternalSigningPolicy(ctx)
+
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
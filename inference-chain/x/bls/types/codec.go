@@ -17,6 +17,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSubmitVerificationVector{},
 		&MsgUpdateParams{},
 		&MsgSubmitGroupKeyValidationSignature{},
+		&MsgUpdateExternalSigningPolicy{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
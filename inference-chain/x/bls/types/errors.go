@@ -11,4 +11,7 @@ var (
 	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrSample               = sdkerrors.Register(ModuleName, 1101, "sample error")
 	ErrEpochBLSDataNotFound = sdkerrors.Register(ModuleName, 1102, "epoch BLS data not found")
+	ErrRequesterNotApproved = sdkerrors.Register(ModuleName, 1103, "requester is not approved for threshold signing")
+	ErrSigningRateLimited   = sdkerrors.Register(ModuleName, 1104, "threshold signing request rate limit exceeded")
+	ErrInvalidSigningDigest = sdkerrors.Register(ModuleName, 1105, "threshold signing data must be 32-byte digests")
 )
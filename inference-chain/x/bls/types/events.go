@@ -0,0 +1,11 @@
+package types
+
+// Untyped events, emitted alongside the typed events of events.pb.go
+const (
+	EventTypeDKGRoundRestarted = "dkg_round_restarted"
+
+	AttributeKeyEpochId  = "epoch_id"
+	AttributeKeyPhase    = "phase"
+	AttributeKeyAttempt  = "attempt"
+	AttributeKeyExcluded = "excluded"
+)
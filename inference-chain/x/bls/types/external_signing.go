@@ -0,0 +1,79 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SigningDigestSize is the size of each digest an external requester may submit for group signing
+const SigningDigestSize = 32
+
+// ExternalSigningPolicy gates MsgRequestThresholdSignature, the threshold signing entry point for
+// accounts outside the chain's own flows, such as bridges.
+type ExternalSigningPolicy struct {
+	// Enabled turns the gate on. While disabled, every request is admitted as before the policy existed.
+	Enabled bool `json:"enabled"`
+	// ApprovedRequesters are the accounts allowed to request threshold signatures
+	ApprovedRequesters []string `json:"approved_requesters"`
+	// MaxRequestsPerWindow limits the requests of each requester within WindowBlocks; 0 means no limit
+	MaxRequestsPerWindow uint32 `json:"max_requests_per_window"`
+	WindowBlocks         int64  `json:"window_blocks"`
+	// Fee is charged for each request and sent to the fee collector
+	Fee sdk.Coins `json:"fee"`
+}
+
+// DefaultExternalSigningPolicy is disabled, so existing callers keep working until governance selects a policy
+func DefaultExternalSigningPolicy() ExternalSigningPolicy {
+	return ExternalSigningPolicy{
+		Enabled:              false,
+		ApprovedRequesters:   []string{},
+		MaxRequestsPerWindow: 10,
+		WindowBlocks:         100,
+		Fee:                  sdk.NewCoins(),
+	}
+}
+
+func (p ExternalSigningPolicy) Validate() error {
+	for _, requester := range p.ApprovedRequesters {
+		if _, err := sdk.AccAddressFromBech32(requester); err != nil {
+			return fmt.Errorf("invalid approved requester %q: %w", requester, err)
+		}
+	}
+	if p.MaxRequestsPerWindow > 0 && p.WindowBlocks <= 0 {
+		return fmt.Errorf("window_blocks must be positive when max_requests_per_window is set")
+	}
+	if err := p.Fee.Validate(); err != nil {
+		return fmt.Errorf("invalid fee: %w", err)
+	}
+	return nil
+}
+
+// ParseExternalSigningPolicy decodes and validates the JSON encoding of a policy. Unknown fields are
+// rejected so that a misspelled field fails the proposal instead of silently keeping its default.
+func ParseExternalSigningPolicy(value string) (ExternalSigningPolicy, error) {
+	var policy ExternalSigningPolicy
+	decoder := json.NewDecoder(bytes.NewReader([]byte(value)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&policy); err != nil {
+		return policy, fmt.Errorf("invalid external signing policy: %w", err)
+	}
+	return policy, policy.Validate()
+}
+
+func (p ExternalSigningPolicy) IsApproved(requester string) bool {
+	for _, approved := range p.ApprovedRequesters {
+		if approved == requester {
+			return true
+		}
+	}
+	return false
+}
+
+// ExternalSigningUsage counts the requests of one requester in its current rate limit window
+type ExternalSigningUsage struct {
+	WindowStartHeight int64  `json:"window_start_height"`
+	Requests          uint32 `json:"requests"`
+}
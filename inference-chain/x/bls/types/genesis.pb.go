@@ -32,6 +32,9 @@ type GenesisState struct {
 	// Only one epoch can have active DKG at a time
 	// 0 means no active DKG
 	ActiveEpochId uint64 `protobuf:"varint,2,opt,name=active_epoch_id,json=activeEpochId,proto3" json:"active_epoch_id,omitempty"`
+	// external_signing_policy is the JSON encoding of the selected types.ExternalSigningPolicy,
+	// empty while the default applies
+	ExternalSigningPolicy string `protobuf:"bytes,3,opt,name=external_signing_policy,json=externalSigningPolicy,proto3" json:"external_signing_policy,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -81,6 +84,13 @@ func (m *GenesisState) GetActiveEpochId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetExternalSigningPolicy() string {
+	if m != nil {
+		return m.ExternalSigningPolicy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "inference.bls.GenesisState")
 }
@@ -88,23 +98,26 @@ func init() {
 func init() { proto.RegisterFile("inference/bls/genesis.proto", fileDescriptor_ad7128ff5b7c4871) }
 
 var fileDescriptor_ad7128ff5b7c4871 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
+	// 289 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xcc, 0x4b, 0x4b,
 	0x2d, 0x4a, 0xcd, 0x4b, 0x4e, 0xd5, 0x4f, 0xca, 0x29, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
 	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4b, 0xea, 0x25, 0xe5, 0x14, 0x4b,
 	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x0a, 0x29, 0x91, 0xf4, 0xfc, 0xf4,
 	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x8a, 0x4a, 0xa1, 0x1a, 0x5a, 0x90, 0x58, 0x94, 0x98, 0x0b,
-	0x35, 0x53, 0xa9, 0x80, 0x8b, 0xc7, 0x1d, 0x62, 0x49, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x05,
-	0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x54, 0x0f, 0xc5, 0x52, 0xbd,
-	0x00, 0xb0, 0xa4, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82,
-	0xaa, 0x17, 0x52, 0xe3, 0xe2, 0x4f, 0x4c, 0x2e, 0xc9, 0x2c, 0x4b, 0x8d, 0x4f, 0x2d, 0xc8, 0x4f,
-	0xce, 0x88, 0xcf, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0x85, 0x08, 0xbb, 0x82,
-	0x44, 0x3d, 0x53, 0x9c, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0xa0, 0x28, 0x3f, 0xa5,
-	0x34, 0xb9, 0xa4, 0x38, 0x39, 0x13, 0xec, 0x6e, 0x84, 0x0f, 0x2a, 0xc0, 0x7e, 0x28, 0xa9, 0x2c,
-	0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xc1, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x65, 0x09, 0xb9,
-	0x53, 0x36, 0x01, 0x00, 0x00,
+	0x35, 0x53, 0x69, 0x05, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0x96, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21,
+	0x0b, 0x2e, 0x36, 0x88, 0x02, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0x5b,
+	0xf5, 0x02, 0xc0, 0x92, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31,
+	0x08, 0xaa, 0x5e, 0x48, 0x8d, 0x8b, 0x3f, 0x31, 0xb9, 0x24, 0xb3, 0x2c, 0x35, 0x3e, 0xb5, 0x20,
+	0x3f, 0x39, 0x23, 0x3e, 0x33, 0x45, 0x82, 0x49, 0x81, 0x51, 0x83, 0x25, 0x88, 0x17, 0x22, 0xec,
+	0x0a, 0x12, 0xf5, 0x4c, 0x11, 0x32, 0xe3, 0x12, 0x4f, 0xad, 0x28, 0x49, 0x2d, 0xca, 0x4b, 0xcc,
+	0x89, 0x2f, 0xce, 0x4c, 0xcf, 0xcb, 0xcc, 0x4b, 0x8f, 0x2f, 0xc8, 0xcf, 0xc9, 0x4c, 0xae, 0x94,
+	0x60, 0x56, 0x60, 0xd4, 0xe0, 0x0c, 0x12, 0x85, 0x49, 0x07, 0x43, 0x64, 0x03, 0xc0, 0x92, 0x4e,
+	0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
+	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x9f, 0x9e, 0x59, 0x92,
+	0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x5f, 0x50, 0x94, 0x9f, 0x52, 0x9a, 0x5c, 0x52, 0x9c,
+	0x9c, 0x09, 0xf6, 0x30, 0xc2, 0xeb, 0x15, 0x60, 0xcf, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1,
+	0x81, 0x3d, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcf, 0xbe, 0xe9, 0xfa, 0x6f, 0x01, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +140,13 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExternalSigningPolicy) > 0 {
+		i -= len(m.ExternalSigningPolicy)
+		copy(dAtA[i:], m.ExternalSigningPolicy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExternalSigningPolicy)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.ActiveEpochId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.ActiveEpochId))
 		i--
@@ -167,6 +187,10 @@ func (m *GenesisState) Size() (n int) {
 	if m.ActiveEpochId != 0 {
 		n += 1 + sovGenesis(uint64(m.ActiveEpochId))
 	}
+	l = len(m.ExternalSigningPolicy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -257,6 +281,38 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalSigningPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalSigningPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
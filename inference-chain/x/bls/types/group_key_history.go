@@ -0,0 +1,14 @@
+package types
+
+// GroupKeyRecord is one entry of the group public key history. The key of epoch N is validated by
+// ValidationSignature, made with the group key of epoch N-1, so consecutive records form a chain of
+// custody from genesis to the current epoch.
+type GroupKeyRecord struct {
+	EpochId        uint64   `json:"epoch_id"`
+	DkgPhase       DKGPhase `json:"dkg_phase"`
+	GroupPublicKey []byte   `json:"group_public_key"`
+	// ValidationSignature is empty for the genesis epoch and until the previous epoch signs the key
+	ValidationSignature []byte `json:"validation_signature"`
+	// ValidatedByEpochId is the epoch whose group key made ValidationSignature, 0 if there is none
+	ValidatedByEpochId uint64 `json:"validated_by_epoch_id"`
+}
@@ -20,6 +20,9 @@ var (
 	EpochBLSDataPrefix            = []byte("epoch_bls_data")
 	ThresholdSigningRequestPrefix = []byte("threshold_signing_request")
 	ExpirationIndexPrefix         = []byte("expiration_index")
+	DKGRoundRetriesPrefix         = []byte("dkg_round_retries")
+	ExternalSigningPolicyKey      = []byte("external_signing_policy")
+	ExternalSigningUsagePrefix    = []byte("external_signing_usage")
 )
 
 func KeyPrefix(p string) []byte {
@@ -34,6 +37,22 @@ func EpochBLSDataKey(epochID uint64) []byte {
 	return key
 }
 
+// DKGRoundRetriesKey generates a key for storing how many times the DKG of an epoch was restarted
+func DKGRoundRetriesKey(epochID uint64) []byte {
+	key := make([]byte, len(DKGRoundRetriesPrefix)+8)
+	copy(key, DKGRoundRetriesPrefix)
+	binary.BigEndian.PutUint64(key[len(DKGRoundRetriesPrefix):], epochID)
+	return key
+}
+
+// ExternalSigningUsageKey generates a key for storing the signing request usage of an external requester
+func ExternalSigningUsageKey(requester string) []byte {
+	key := make([]byte, len(ExternalSigningUsagePrefix)+len(requester))
+	copy(key, ExternalSigningUsagePrefix)
+	copy(key[len(ExternalSigningUsagePrefix):], requester)
+	return key
+}
+
 // ThresholdSigningRequestKey generates a key for storing ThresholdSigningRequest by request ID
 // This results in a variable length key, as we put no constraints on the request_id
 func ThresholdSigningRequestKey(requestID []byte) []byte {
@@ -689,6 +689,101 @@ func (m *MsgRequestThresholdSignatureResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestThresholdSignatureResponse proto.InternalMessageInfo
 
+// MsgUpdateExternalSigningPolicy sets the policy gating MsgRequestThresholdSignature
+type MsgUpdateExternalSigningPolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// policy is the JSON encoding of types.ExternalSigningPolicy
+	Policy string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *MsgUpdateExternalSigningPolicy) Reset()         { *m = MsgUpdateExternalSigningPolicy{} }
+func (m *MsgUpdateExternalSigningPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateExternalSigningPolicy) ProtoMessage()    {}
+func (*MsgUpdateExternalSigningPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{12}
+}
+func (m *MsgUpdateExternalSigningPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateExternalSigningPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateExternalSigningPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateExternalSigningPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateExternalSigningPolicy.Merge(m, src)
+}
+func (m *MsgUpdateExternalSigningPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateExternalSigningPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateExternalSigningPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateExternalSigningPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateExternalSigningPolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateExternalSigningPolicy) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+// MsgUpdateExternalSigningPolicyResponse defines the response structure for executing a
+// MsgUpdateExternalSigningPolicy message.
+type MsgUpdateExternalSigningPolicyResponse struct {
+}
+
+func (m *MsgUpdateExternalSigningPolicyResponse) Reset() {
+	*m = MsgUpdateExternalSigningPolicyResponse{}
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateExternalSigningPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateExternalSigningPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06b0e6f51d329716, []int{13}
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateExternalSigningPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateExternalSigningPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateExternalSigningPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateExternalSigningPolicyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "inference.bls.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.bls.MsgUpdateParamsResponse")
@@ -702,69 +797,75 @@ func init() {
 	proto.RegisterType((*MsgSubmitPartialSignatureResponse)(nil), "inference.bls.MsgSubmitPartialSignatureResponse")
 	proto.RegisterType((*MsgRequestThresholdSignature)(nil), "inference.bls.MsgRequestThresholdSignature")
 	proto.RegisterType((*MsgRequestThresholdSignatureResponse)(nil), "inference.bls.MsgRequestThresholdSignatureResponse")
+	proto.RegisterType((*MsgUpdateExternalSigningPolicy)(nil), "inference.bls.MsgUpdateExternalSigningPolicy")
+	proto.RegisterType((*MsgUpdateExternalSigningPolicyResponse)(nil), "inference.bls.MsgUpdateExternalSigningPolicyResponse")
 }
 
 func init() { proto.RegisterFile("inference/bls/tx.proto", fileDescriptor_06b0e6f51d329716) }
 
 var fileDescriptor_06b0e6f51d329716 = []byte{
-	// 902 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0xc7, 0xe3, 0xec, 0xa6, 0x49, 0x5e, 0xb6, 0x6d, 0x6a, 0x4a, 0xbb, 0x6b, 0xe8, 0x76, 0xe3,
-	0x40, 0x59, 0x92, 0xb0, 0x86, 0x4d, 0x85, 0xd0, 0x02, 0x07, 0x22, 0x02, 0x8a, 0x50, 0xa4, 0xc8,
-	0x81, 0x1c, 0xb8, 0x58, 0xb3, 0xf6, 0xc4, 0x3b, 0xd2, 0xda, 0x63, 0x66, 0xc6, 0x6d, 0x17, 0xa9,
-	0x12, 0xe2, 0x06, 0x27, 0x24, 0xc4, 0xff, 0xc0, 0x31, 0x07, 0x24, 0x0e, 0xfc, 0x03, 0x3d, 0x16,
-	0xb8, 0x70, 0x42, 0x28, 0x39, 0xe4, 0x7f, 0xe0, 0x84, 0x3c, 0xfe, 0x91, 0x66, 0x36, 0xde, 0x84,
-	0x40, 0x2f, 0xbb, 0x9e, 0xf7, 0xbe, 0x6f, 0xe6, 0xfb, 0x3e, 0x9a, 0x19, 0x1b, 0x6e, 0x91, 0x70,
-	0x1f, 0x33, 0x1c, 0xba, 0xd8, 0xea, 0x0f, 0xb9, 0x25, 0x1e, 0x75, 0x22, 0x46, 0x05, 0xd5, 0xaf,
-	0x16, 0xf1, 0x4e, 0x7f, 0xc8, 0x8d, 0x1b, 0x28, 0x20, 0x21, 0xb5, 0xe4, 0x6f, 0xaa, 0x30, 0x6e,
-	0xbb, 0x94, 0x07, 0x94, 0x5b, 0x01, 0xf7, 0xad, 0x07, 0x6f, 0x25, 0x7f, 0x59, 0xa2, 0x91, 0x26,
-	0x1c, 0x39, 0xb2, 0xd2, 0x41, 0x96, 0xba, 0xe9, 0x53, 0x9f, 0xa6, 0xf1, 0xe4, 0x29, 0x8b, 0x1a,
-	0xa7, 0x3d, 0x44, 0x88, 0xa1, 0x20, 0xaf, 0x68, 0x28, 0xfe, 0x46, 0x11, 0xce, 0x52, 0xe6, 0xcf,
-	0x1a, 0x5c, 0xdf, 0xe6, 0xfe, 0x67, 0x91, 0x87, 0x04, 0xde, 0x91, 0x45, 0xfa, 0xdb, 0x30, 0x8f,
-	0x62, 0x31, 0xa0, 0x8c, 0x88, 0x51, 0x5d, 0x6b, 0x69, 0xed, 0xf9, 0x8d, 0xfa, 0x6f, 0x3f, 0xbd,
-	0x71, 0x33, 0x73, 0xf1, 0x81, 0xe7, 0x31, 0xcc, 0xf9, 0xae, 0x60, 0x24, 0xf4, 0xed, 0x13, 0xa9,
-	0xfe, 0x0e, 0x5c, 0x49, 0x97, 0xad, 0x4f, 0xb7, 0xb4, 0xf6, 0x42, 0xf7, 0xc5, 0xce, 0xa9, 0xfe,
-	0x3b, 0xe9, 0xf4, 0x1b, 0xf3, 0x4f, 0xfe, 0xbc, 0x3b, 0xf5, 0xe3, 0xf1, 0xc1, 0x8a, 0x66, 0x67,
-	0xfa, 0x5e, 0xf7, 0xeb, 0xe3, 0x83, 0x95, 0x93, 0x99, 0xbe, 0x3d, 0x3e, 0x58, 0xb9, 0x7b, 0xe2,
-	0xf9, 0x91, 0x74, 0xad, 0xb8, 0x34, 0x1b, 0x70, 0x5b, 0x09, 0xd9, 0x98, 0x47, 0x34, 0xe4, 0xd8,
-	0xfc, 0x65, 0x1a, 0x5e, 0xd8, 0xe6, 0xfe, 0x6e, 0xdc, 0x0f, 0x88, 0xf8, 0x10, 0xa3, 0x21, 0x66,
-	0x3b, 0x88, 0x09, 0xbd, 0x0b, 0xb3, 0x2e, 0xc3, 0x48, 0x50, 0x76, 0x6e, 0x5b, 0xb9, 0x50, 0x6f,
-	0xc0, 0x1c, 0x8e, 0xa8, 0x3b, 0x70, 0x88, 0x27, 0xdb, 0xaa, 0xda, 0xb3, 0x72, 0xbc, 0xe5, 0xe9,
-	0x2d, 0x58, 0x70, 0x69, 0x10, 0x10, 0x11, 0xe0, 0x50, 0xf0, 0x7a, 0xa5, 0x55, 0x69, 0xd7, 0xec,
-	0x67, 0x43, 0xfa, 0x63, 0x58, 0xc2, 0xa1, 0xcb, 0x46, 0x91, 0xc0, 0x9e, 0xc3, 0x07, 0x88, 0x61,
-	0xee, 0xec, 0x53, 0xe6, 0x44, 0x88, 0x09, 0xe2, 0x92, 0x08, 0x25, 0x75, 0xd5, 0x56, 0xa5, 0xbd,
-	0xd0, 0x5d, 0x53, 0x60, 0x6d, 0xe6, 0x75, 0xbb, 0xb2, 0xec, 0x23, 0x2a, 0x5b, 0xc8, 0x8a, 0x36,
-	0xaa, 0x09, 0x43, 0xbb, 0x89, 0x27, 0x89, 0x78, 0xef, 0x7e, 0x82, 0x35, 0xef, 0x24, 0x81, 0xba,
-	0x7c, 0x06, 0x54, 0x95, 0x92, 0x79, 0x07, 0x5e, 0x3a, 0x23, 0x5c, 0xc0, 0xfd, 0x5d, 0x7b, 0x26,
-	0xbf, 0x87, 0x19, 0xd9, 0x27, 0x2e, 0x12, 0x84, 0x86, 0x7b, 0xd8, 0x4d, 0x80, 0xfd, 0xcf, 0x90,
-	0x5f, 0x83, 0xeb, 0x9e, 0x34, 0xe1, 0x3c, 0x40, 0x43, 0xe2, 0x25, 0x5b, 0x32, 0x01, 0x3d, 0x67,
-	0x5f, 0x4b, 0xc3, 0x7b, 0x59, 0xb4, 0xf7, 0x9e, 0xda, 0xec, 0x6a, 0x69, 0xb3, 0xe3, 0xae, 0xcd,
-	0x57, 0x61, 0x79, 0x42, 0xba, 0x68, 0xfe, 0xfb, 0x69, 0x78, 0xa5, 0xd0, 0x7d, 0xcc, 0x68, 0x1c,
-	0x7d, 0x82, 0x47, 0xd2, 0x82, 0x54, 0xef, 0x12, 0x3f, 0x44, 0x22, 0x66, 0xf8, 0x52, 0x14, 0x5a,
-	0x50, 0x0b, 0xf1, 0x43, 0x47, 0x21, 0x01, 0x21, 0x7e, 0xb8, 0x99, 0xc1, 0x58, 0x82, 0x1a, 0x1f,
-	0x52, 0xe1, 0x90, 0xd0, 0x23, 0x2e, 0x4e, 0xb7, 0xdc, 0x55, 0x7b, 0x21, 0x89, 0x6d, 0xa5, 0x21,
-	0x7d, 0x15, 0x6e, 0xc8, 0xdd, 0x85, 0x86, 0x0e, 0xcf, 0xdd, 0xd4, 0xab, 0x2d, 0xad, 0x5d, 0xb3,
-	0x17, 0xb3, 0x44, 0xe1, 0xb2, 0xb7, 0xa9, 0x32, 0xbb, 0x5f, 0xca, 0x6c, 0x42, 0xb3, 0x66, 0x07,
-	0xd6, 0x2e, 0xa2, 0x2b, 0x28, 0xfe, 0xad, 0x41, 0xa3, 0x28, 0xd8, 0x51, 0x4c, 0x5d, 0x0a, 0xdd,
-	0x1d, 0x00, 0x86, 0xbf, 0x88, 0x31, 0x17, 0x39, 0xb8, 0x9a, 0x3d, 0x9f, 0x45, 0x9e, 0x03, 0xb7,
-	0x9e, 0xca, 0xed, 0xf5, 0x52, 0x6e, 0x6a, 0x7b, 0xe6, 0x32, 0x2c, 0x95, 0x26, 0x0b, 0x42, 0xdf,
-	0x4c, 0xc3, 0xcb, 0xdb, 0xdc, 0xb7, 0xd3, 0x0e, 0x3e, 0x1d, 0x30, 0xcc, 0x07, 0x74, 0xe8, 0xfd,
-	0x37, 0x48, 0x6d, 0x58, 0x74, 0x63, 0xc6, 0x70, 0x28, 0xd4, 0x3d, 0x76, 0x2d, 0x8b, 0xe7, 0xfb,
-	0xac, 0x01, 0x73, 0xee, 0x00, 0x91, 0x30, 0x51, 0x54, 0x24, 0x83, 0x59, 0x39, 0xde, 0xf2, 0x14,
-	0xd2, 0x55, 0x95, 0xb4, 0x0e, 0x55, 0x0f, 0x09, 0x54, 0x9f, 0x91, 0x97, 0xa1, 0x7c, 0xee, 0xbd,
-	0xaf, 0xd2, 0x5a, 0x3b, 0x83, 0x56, 0x69, 0xab, 0xe6, 0x3d, 0x79, 0xe4, 0x4a, 0xf3, 0x39, 0xb3,
-	0xee, 0xaf, 0x33, 0x50, 0xd9, 0xe6, 0xbe, 0xbe, 0x07, 0xb5, 0x53, 0xaf, 0xb3, 0xa6, 0x72, 0xb3,
-	0x2a, 0x6f, 0x0d, 0xe3, 0xde, 0xe4, 0x7c, 0x3e, 0xbf, 0xde, 0x87, 0xc5, 0xb1, 0x37, 0x8a, 0x39,
-	0x5e, 0xab, 0x6a, 0x8c, 0x95, 0xf3, 0x35, 0xc5, 0x1a, 0x5f, 0x42, 0xbd, 0xf4, 0x62, 0x2d, 0x9d,
-	0x67, 0x5c, 0x6b, 0x74, 0x2f, 0xae, 0x2d, 0xd6, 0xfe, 0x41, 0x83, 0xa5, 0xf3, 0x2f, 0xb6, 0xf5,
-	0xb2, 0x99, 0x27, 0x14, 0x19, 0xef, 0x5e, 0xa2, 0xa8, 0xf0, 0x25, 0xe0, 0x56, 0xc9, 0x4d, 0xd1,
-	0x2e, 0x9b, 0x56, 0x55, 0x1a, 0x6f, 0x5e, 0x54, 0x59, 0xac, 0xfa, 0x18, 0x1a, 0xe5, 0xa7, 0x6f,
-	0x75, 0x7c, 0xba, 0x52, 0xb1, 0xb1, 0xfe, 0x2f, 0xc4, 0xf9, 0xf2, 0xc6, 0xcc, 0x57, 0xc9, 0x07,
-	0xd2, 0xc6, 0xd6, 0x93, 0xc3, 0xa6, 0xf6, 0xf4, 0xb0, 0xa9, 0xfd, 0x75, 0xd8, 0xd4, 0xbe, 0x3b,
-	0x6a, 0x4e, 0x3d, 0x3d, 0x6a, 0x4e, 0xfd, 0x71, 0xd4, 0x9c, 0xfa, 0xdc, 0xf2, 0x89, 0x18, 0xc4,
-	0xfd, 0x8e, 0x4b, 0x03, 0x2b, 0x62, 0xd4, 0x8b, 0x5d, 0xc1, 0x5d, 0x22, 0xcf, 0x94, 0x7a, 0xba,
-	0xe4, 0xf7, 0x5e, 0xff, 0x8a, 0xfc, 0xe0, 0x5b, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x31,
-	0x77, 0x84, 0xad, 0x0a, 0x00, 0x00,
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb3, 0x89, 0x9b, 0xc4, 0x2f, 0x6e, 0x9b, 0x2e, 0x25, 0xb5, 0xb7, 0xd4, 0x75, 0x36,
+	0x10, 0x4c, 0x92, 0xda, 0xe0, 0x14, 0x84, 0x0c, 0x1c, 0x1a, 0x11, 0x50, 0x84, 0x22, 0x45, 0x1b,
+	0xc8, 0x81, 0xcb, 0x6a, 0xbc, 0x3b, 0x59, 0x8f, 0xe4, 0xdd, 0x59, 0x66, 0xc6, 0x6d, 0x8c, 0x54,
+	0x09, 0xc1, 0x09, 0x4e, 0x48, 0x88, 0xff, 0x81, 0x63, 0x24, 0x90, 0x38, 0xf0, 0x0f, 0xf4, 0x58,
+	0xc1, 0x01, 0x4e, 0x08, 0x25, 0x87, 0xfc, 0x0f, 0x9c, 0xd0, 0xce, 0xfe, 0x48, 0x33, 0xf6, 0x3a,
+	0x69, 0x80, 0x4b, 0xe2, 0x79, 0xef, 0xfb, 0xde, 0xbc, 0xf7, 0xd1, 0xcc, 0xbc, 0x85, 0x05, 0x12,
+	0xec, 0x63, 0x86, 0x03, 0x07, 0x37, 0x3b, 0x3d, 0xde, 0x14, 0x07, 0x8d, 0x90, 0x51, 0x41, 0xf5,
+	0xab, 0x99, 0xbd, 0xd1, 0xe9, 0x71, 0xe3, 0x06, 0xf2, 0x49, 0x40, 0x9b, 0xf2, 0x6f, 0xac, 0x30,
+	0x6e, 0x39, 0x94, 0xfb, 0x94, 0x37, 0x7d, 0xee, 0x35, 0x1f, 0xbe, 0x11, 0xfd, 0x4b, 0x1c, 0x95,
+	0xd8, 0x61, 0xcb, 0x55, 0x33, 0x5e, 0x24, 0xae, 0x9b, 0x1e, 0xf5, 0x68, 0x6c, 0x8f, 0x7e, 0x25,
+	0x56, 0xe3, 0x6c, 0x0d, 0x21, 0x62, 0xc8, 0x4f, 0x23, 0x2a, 0x4a, 0x7d, 0x83, 0x10, 0x27, 0x2e,
+	0xf3, 0x67, 0x0d, 0xae, 0x6f, 0x73, 0xef, 0x93, 0xd0, 0x45, 0x02, 0xef, 0xc8, 0x20, 0xfd, 0x2d,
+	0x28, 0xa2, 0xbe, 0xe8, 0x52, 0x46, 0xc4, 0xa0, 0xac, 0xd5, 0xb4, 0x7a, 0x71, 0xa3, 0xfc, 0xeb,
+	0x4f, 0xf7, 0x6e, 0x26, 0x55, 0x3c, 0x70, 0x5d, 0x86, 0x39, 0xdf, 0x15, 0x8c, 0x04, 0x9e, 0x75,
+	0x2a, 0xd5, 0xdf, 0x86, 0xe9, 0x78, 0xdb, 0xf2, 0x64, 0x4d, 0xab, 0xcf, 0xb5, 0x5e, 0x6c, 0x9c,
+	0xe9, 0xbf, 0x11, 0xa7, 0xdf, 0x28, 0x3e, 0xf9, 0xf3, 0xee, 0xc4, 0x0f, 0x27, 0x87, 0x2b, 0x9a,
+	0x95, 0xe8, 0xdb, 0xad, 0x2f, 0x4f, 0x0e, 0x57, 0x4e, 0x33, 0x7d, 0x73, 0x72, 0xb8, 0x72, 0xf7,
+	0xb4, 0xe6, 0x03, 0x59, 0xb5, 0x52, 0xa5, 0x59, 0x81, 0x5b, 0x8a, 0xc9, 0xc2, 0x3c, 0xa4, 0x01,
+	0xc7, 0xe6, 0x2f, 0x93, 0xf0, 0xc2, 0x36, 0xf7, 0x76, 0xfb, 0x1d, 0x9f, 0x88, 0xf7, 0x31, 0xea,
+	0x61, 0xb6, 0x83, 0x98, 0xd0, 0x5b, 0x30, 0xe3, 0x30, 0x8c, 0x04, 0x65, 0xe7, 0xb6, 0x95, 0x0a,
+	0xf5, 0x0a, 0xcc, 0xe2, 0x90, 0x3a, 0x5d, 0x9b, 0xb8, 0xb2, 0xad, 0x82, 0x35, 0x23, 0xd7, 0x5b,
+	0xae, 0x5e, 0x83, 0x39, 0x87, 0xfa, 0x3e, 0x11, 0x3e, 0x0e, 0x04, 0x2f, 0x4f, 0xd5, 0xa6, 0xea,
+	0x25, 0xeb, 0x59, 0x93, 0xfe, 0x18, 0x16, 0x71, 0xe0, 0xb0, 0x41, 0x28, 0xb0, 0x6b, 0xf3, 0x2e,
+	0x62, 0x98, 0xdb, 0xfb, 0x94, 0xd9, 0x21, 0x62, 0x82, 0x38, 0x24, 0x44, 0x51, 0x5c, 0xa1, 0x36,
+	0x55, 0x9f, 0x6b, 0xad, 0x29, 0xb0, 0x36, 0xd3, 0xb8, 0x5d, 0x19, 0xf6, 0x01, 0x95, 0x2d, 0x24,
+	0x41, 0x1b, 0x85, 0x88, 0xa1, 0x55, 0xc5, 0xe3, 0x44, 0xbc, 0x7d, 0x3f, 0xc2, 0x9a, 0x76, 0x12,
+	0x41, 0x5d, 0x1a, 0x01, 0x55, 0xa5, 0x64, 0xde, 0x81, 0xdb, 0x23, 0xcc, 0x19, 0xdc, 0xdf, 0xb4,
+	0x67, 0xfc, 0x7b, 0x98, 0x91, 0x7d, 0xe2, 0x20, 0x41, 0x68, 0xb0, 0x87, 0x9d, 0x08, 0xd8, 0x7f,
+	0x0c, 0xf9, 0x55, 0xb8, 0xee, 0xca, 0x22, 0xec, 0x87, 0xa8, 0x47, 0xdc, 0xe8, 0x48, 0x46, 0xa0,
+	0x67, 0xad, 0x6b, 0xb1, 0x79, 0x2f, 0xb1, 0xb6, 0xdf, 0x55, 0x9b, 0x5d, 0xcd, 0x6d, 0x76, 0xb8,
+	0x6a, 0xf3, 0x15, 0x58, 0x1a, 0xe3, 0xce, 0x9a, 0xff, 0x6e, 0x12, 0x5e, 0xce, 0x74, 0x1f, 0x32,
+	0xda, 0x0f, 0x3f, 0xc2, 0x03, 0x59, 0x82, 0x54, 0xef, 0x12, 0x2f, 0x40, 0xa2, 0xcf, 0xf0, 0xa5,
+	0x28, 0xd4, 0xa0, 0x14, 0xe0, 0x47, 0xb6, 0x42, 0x02, 0x02, 0xfc, 0x68, 0x33, 0x81, 0xb1, 0x08,
+	0x25, 0xde, 0xa3, 0xc2, 0x26, 0x81, 0x4b, 0x1c, 0x1c, 0x1f, 0xb9, 0xab, 0xd6, 0x5c, 0x64, 0xdb,
+	0x8a, 0x4d, 0xfa, 0x2a, 0xdc, 0x90, 0xa7, 0x0b, 0xf5, 0x6c, 0x9e, 0x56, 0x53, 0x2e, 0xd4, 0xb4,
+	0x7a, 0xc9, 0x9a, 0x4f, 0x1c, 0x59, 0x95, 0xed, 0x4d, 0x95, 0xd9, 0xfd, 0x5c, 0x66, 0x63, 0x9a,
+	0x35, 0x1b, 0xb0, 0x76, 0x11, 0x5d, 0x46, 0xf1, 0x6f, 0x0d, 0x2a, 0x59, 0xc0, 0x8e, 0x52, 0xd4,
+	0xa5, 0xd0, 0xdd, 0x01, 0x60, 0xf8, 0xb3, 0x3e, 0xe6, 0x22, 0x05, 0x57, 0xb2, 0x8a, 0x89, 0xe5,
+	0x7f, 0xe0, 0xd6, 0x56, 0xb9, 0xbd, 0x96, 0xcb, 0x4d, 0x6d, 0xcf, 0x5c, 0x82, 0xc5, 0x5c, 0x67,
+	0x46, 0xe8, 0xeb, 0x49, 0x78, 0x69, 0x9b, 0x7b, 0x56, 0xdc, 0xc1, 0xc7, 0x5d, 0x86, 0x79, 0x97,
+	0xf6, 0xdc, 0x7f, 0x07, 0xa9, 0x0e, 0xf3, 0x4e, 0x9f, 0x31, 0x1c, 0x08, 0xf5, 0x8c, 0x5d, 0x4b,
+	0xec, 0xe9, 0x39, 0xab, 0xc0, 0xac, 0xd3, 0x45, 0x24, 0x88, 0x14, 0x53, 0x92, 0xc1, 0x8c, 0x5c,
+	0x6f, 0xb9, 0x0a, 0xe9, 0x82, 0x4a, 0x5a, 0x87, 0x82, 0x8b, 0x04, 0x2a, 0x5f, 0x91, 0x8f, 0xa1,
+	0xfc, 0xdd, 0x7e, 0x4f, 0xa5, 0xb5, 0x36, 0x82, 0x56, 0x6e, 0xab, 0xe6, 0xb2, 0xbc, 0x72, 0xb9,
+	0xfe, 0x8c, 0xd9, 0x8f, 0x1a, 0x54, 0xb3, 0x89, 0xb0, 0x79, 0x20, 0x30, 0x0b, 0x62, 0xb4, 0x24,
+	0xf0, 0x76, 0x68, 0x8f, 0x38, 0x83, 0x4b, 0x4f, 0xb6, 0x05, 0x98, 0x0e, 0x65, 0x06, 0xc9, 0xab,
+	0x68, 0x25, 0xab, 0xf6, 0x83, 0xe1, 0xb9, 0xd5, 0xc8, 0x9d, 0x5b, 0x23, 0x4b, 0x32, 0xeb, 0xb0,
+	0x3c, 0x5e, 0x91, 0xf6, 0xd7, 0xfa, 0x7d, 0x1a, 0xa6, 0xb6, 0xb9, 0xa7, 0xef, 0x41, 0xe9, 0xcc,
+	0xb8, 0xae, 0x2a, 0x93, 0x43, 0x99, 0x8a, 0xc6, 0xf2, 0x78, 0x7f, 0x9a, 0x5f, 0xef, 0xc0, 0xfc,
+	0xd0, 0xc4, 0x34, 0x87, 0x63, 0x55, 0x8d, 0xb1, 0x72, 0xbe, 0x26, 0xdb, 0xe3, 0x73, 0x28, 0xe7,
+	0x0e, 0x8e, 0xdc, 0x3c, 0xc3, 0x5a, 0xa3, 0x75, 0x71, 0x6d, 0xb6, 0xf7, 0xf7, 0x1a, 0x2c, 0x9e,
+	0xff, 0x70, 0xaf, 0xe7, 0x65, 0x1e, 0x13, 0x64, 0xbc, 0x73, 0x89, 0xa0, 0xac, 0x2e, 0x01, 0x0b,
+	0x39, 0x2f, 0x61, 0x3d, 0x2f, 0xad, 0xaa, 0x34, 0x5e, 0xbf, 0xa8, 0x32, 0xdb, 0xf5, 0x31, 0x54,
+	0xf2, 0x5f, 0x97, 0xd5, 0xe1, 0x74, 0xb9, 0x62, 0x63, 0xfd, 0x39, 0xc4, 0xd9, 0xf6, 0x5f, 0x69,
+	0x70, 0x7b, 0xdc, 0x4d, 0xbd, 0x97, 0x77, 0x68, 0x47, 0xca, 0x8d, 0x37, 0x9f, 0x4b, 0x9e, 0x56,
+	0x61, 0x5c, 0xf9, 0x22, 0xfa, 0x0c, 0xdd, 0xd8, 0x7a, 0x72, 0x54, 0xd5, 0x9e, 0x1e, 0x55, 0xb5,
+	0xbf, 0x8e, 0xaa, 0xda, 0xb7, 0xc7, 0xd5, 0x89, 0xa7, 0xc7, 0xd5, 0x89, 0x3f, 0x8e, 0xab, 0x13,
+	0x9f, 0x36, 0x3d, 0x22, 0xba, 0xfd, 0x4e, 0xc3, 0xa1, 0x7e, 0x33, 0x64, 0xd4, 0xed, 0x3b, 0x82,
+	0x3b, 0x44, 0xde, 0x6e, 0xf5, 0x9e, 0xcb, 0xaf, 0xea, 0xce, 0xb4, 0xfc, 0xac, 0x5e, 0xff, 0x27,
+	0x00, 0x00, 0xff, 0xff, 0x83, 0xc2, 0x4c, 0x56, 0x13, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -792,6 +893,8 @@ type MsgClient interface {
 	SubmitPartialSignature(ctx context.Context, in *MsgSubmitPartialSignature, opts ...grpc.CallOption) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(ctx context.Context, in *MsgRequestThresholdSignature, opts ...grpc.CallOption) (*MsgRequestThresholdSignatureResponse, error)
+	// UpdateExternalSigningPolicy is a governance operation selecting who may request threshold signatures
+	UpdateExternalSigningPolicy(ctx context.Context, in *MsgUpdateExternalSigningPolicy, opts ...grpc.CallOption) (*MsgUpdateExternalSigningPolicyResponse, error)
 }
 
 type msgClient struct {
@@ -856,6 +959,15 @@ func (c *msgClient) RequestThresholdSignature(ctx context.Context, in *MsgReques
 	return out, nil
 }
 
+func (c *msgClient) UpdateExternalSigningPolicy(ctx context.Context, in *MsgUpdateExternalSigningPolicy, opts ...grpc.CallOption) (*MsgUpdateExternalSigningPolicyResponse, error) {
+	out := new(MsgUpdateExternalSigningPolicyResponse)
+	err := c.cc.Invoke(ctx, "/inference.bls.Msg/UpdateExternalSigningPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
@@ -871,6 +983,8 @@ type MsgServer interface {
 	SubmitPartialSignature(context.Context, *MsgSubmitPartialSignature) (*MsgSubmitPartialSignatureResponse, error)
 	// RequestThresholdSignature allows external users to request a threshold signature
 	RequestThresholdSignature(context.Context, *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error)
+	// UpdateExternalSigningPolicy is a governance operation selecting who may request threshold signatures
+	UpdateExternalSigningPolicy(context.Context, *MsgUpdateExternalSigningPolicy) (*MsgUpdateExternalSigningPolicyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -895,6 +1009,9 @@ func (*UnimplementedMsgServer) SubmitPartialSignature(ctx context.Context, req *
 func (*UnimplementedMsgServer) RequestThresholdSignature(ctx context.Context, req *MsgRequestThresholdSignature) (*MsgRequestThresholdSignatureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestThresholdSignature not implemented")
 }
+func (*UnimplementedMsgServer) UpdateExternalSigningPolicy(ctx context.Context, req *MsgUpdateExternalSigningPolicy) (*MsgUpdateExternalSigningPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExternalSigningPolicy not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1008,6 +1125,24 @@ func _Msg_RequestThresholdSignature_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateExternalSigningPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateExternalSigningPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateExternalSigningPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.bls.Msg/UpdateExternalSigningPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateExternalSigningPolicy(ctx, req.(*MsgUpdateExternalSigningPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.bls.Msg",
@@ -1037,6 +1172,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestThresholdSignature",
 			Handler:    _Msg_RequestThresholdSignature_Handler,
 		},
+		{
+			MethodName: "UpdateExternalSigningPolicy",
+			Handler:    _Msg_UpdateExternalSigningPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/bls/tx.proto",
@@ -1506,6 +1645,66 @@ func (m *MsgRequestThresholdSignatureResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateExternalSigningPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateExternalSigningPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateExternalSigningPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateExternalSigningPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateExternalSigningPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateExternalSigningPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1718,6 +1917,32 @@ func (m *MsgRequestThresholdSignatureResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateExternalSigningPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateExternalSigningPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3115,6 +3340,170 @@ func (m *MsgRequestThresholdSignatureResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateExternalSigningPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateExternalSigningPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateExternalSigningPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateExternalSigningPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateExternalSigningPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateExternalSigningPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
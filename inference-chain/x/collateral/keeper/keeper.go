@@ -243,6 +243,85 @@ func (k Keeper) GetUnbondingByParticipant(ctx sdk.Context, participantAddress sd
 	return list, nil
 }
 
+// UnbondAllCollateral moves all the active collateral of a participant into the unbonding queue, e.g. when the
+// participant exits the network. It returns the amount and the epoch it is released at, a zero coin when the
+// participant has no collateral.
+func (k Keeper) UnbondAllCollateral(ctx context.Context, participantAddress sdk.AccAddress) (sdk.Coin, uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	collateral, found := k.GetCollateral(sdkCtx, participantAddress)
+	if !found || collateral.IsZero() {
+		return sdk.NewInt64Coin(inferencetypes.BaseCoin, 0), 0, nil
+	}
+	completionEpoch, err := k.startUnbonding(sdkCtx, participantAddress, collateral, collateral)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+	return collateral, completionEpoch, nil
+}
+
+// startUnbonding moves amount of the participant's current collateral into the unbonding queue,
+// released after the unbonding period, and returns the completion epoch
+func (k Keeper) startUnbonding(ctx sdk.Context, participantAddr sdk.AccAddress, currentCollateral sdk.Coin, amount sdk.Coin) (uint64, error) {
+	participant := participantAddr.String()
+
+	// Get the current epoch from the collateral module's own state
+	currentEpoch, err := k.GetCurrentEpoch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Get the unbonding period from params
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Calculate the completion epoch
+	completionEpoch := currentEpoch + params.UnbondingPeriodEpochs
+
+	k.Logger().Info("adding unbonding entry for collateral withdrawal",
+		"participant", participant,
+		"amount", amount.String(),
+		"completion_epoch", completionEpoch,
+		"current_epoch", currentEpoch,
+		"unbonding_period_epochs", params.UnbondingPeriodEpochs,
+	)
+	// Create the unbonding entry
+	if err := k.AddUnbondingCollateral(ctx, participantAddr, completionEpoch, amount); err != nil {
+		return 0, err
+	}
+
+	// Reduce the active collateral
+	newCollateral := currentCollateral.Sub(amount)
+	if newCollateral.IsZero() {
+		k.RemoveCollateral(ctx, participantAddr)
+	} else {
+		if err := k.SetCollateral(ctx, participantAddr, newCollateral); err != nil {
+			return 0, err
+		}
+	}
+
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, participant, types.ModuleName, types.SubAccountCollateral, amount, "collateral to unbonding")
+	k.bookkeepingBankKeeper.LogSubAccountTransaction(ctx, types.ModuleName, participant, types.SubAccountUnbonding, amount, "collateral to unbonding")
+
+	// Emit withdrawal event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawCollateral,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
+		),
+	})
+
+	k.Logger().Info("collateral withdrawal initiated",
+		"participant", participant,
+		"amount", amount.String(),
+		"completion_epoch", completionEpoch,
+	)
+	return completionEpoch, nil
+}
+
 // GetCurrentEpoch retrieves the current epoch from the store.
 func (k Keeper) GetCurrentEpoch(ctx sdk.Context) (uint64, error) {
 	return k.CurrentEpoch.Get(ctx)
@@ -366,8 +445,9 @@ func (k Keeper) GetAllJailed(ctx sdk.Context) ([]sdk.AccAddress, error) {
 }
 
 // Slash penalizes a participant by burning a fraction of their total collateral.
-// This includes both their active collateral and any collateral in the unbonding queue.
-// The slash is applied proportionally to all holdings.
+// The fraction is taken of both their active collateral and any collateral in the unbonding queue,
+// and the slashed amount is drawn from the active collateral, the bond backing their registered capacity,
+// first. Unbonding entries only cover what the active collateral cannot, earliest completion first.
 func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, slashFraction math.LegacyDec, reason string) (sdk.Coin, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	if slashFraction.IsNegative() || slashFraction.GT(math.LegacyOneDec()) {
@@ -378,55 +458,62 @@ func (k Keeper) Slash(ctx context.Context, participantAddress sdk.AccAddress, sl
 		return sdk.Coin{}, err
 	}
 
-	totalSlashedAmount := sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
-
-	// 1. Slash active collateral
 	activeCollateral, found := k.GetCollateral(ctx, participantAddress)
-	if found {
-		slashAmountDec := math.LegacyNewDecFromInt(activeCollateral.Amount).Mul(slashFraction)
-		slashAmount := sdk.NewCoin(activeCollateral.Denom, slashAmountDec.TruncateInt())
-
-		if !slashAmount.IsZero() {
-			newCollateral := activeCollateral.Sub(slashAmount)
-			if err := k.SetCollateral(ctx, participantAddress, newCollateral); err != nil {
-				return sdk.Coin{}, err
-			}
-			totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
-		}
+	if !found {
+		activeCollateral = sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
 	}
-
-	// 2. Slash unbonding collateral
 	unbondingEntries, err := k.GetUnbondingByParticipant(sdkCtx, participantAddress)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
+	holdings := activeCollateral.Amount
+	for _, entry := range unbondingEntries {
+		holdings = holdings.Add(entry.Amount.Amount)
+	}
+	remaining := math.LegacyNewDecFromInt(holdings).Mul(slashFraction).TruncateInt()
+	totalSlashedAmount := sdk.NewCoin(inferencetypes.BaseCoin, math.ZeroInt())
+
+	// 1. Slash active collateral
+	if remaining.IsPositive() && activeCollateral.IsPositive() {
+		slashAmount := sdk.NewCoin(activeCollateral.Denom, math.MinInt(remaining, activeCollateral.Amount))
+		if err := k.SetCollateral(ctx, participantAddress, activeCollateral.Sub(slashAmount)); err != nil {
+			return sdk.Coin{}, err
+		}
+		totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
+		remaining = remaining.Sub(slashAmount.Amount)
+	}
+
+	// 2. Slash unbonding collateral for the rest, the entries come in completion epoch order
 	for _, entry := range unbondingEntries {
-		slashAmountDec := math.LegacyNewDecFromInt(entry.Amount.Amount).Mul(slashFraction)
-		slashAmount := sdk.NewCoin(entry.Amount.Denom, slashAmountDec.TruncateInt())
-
-		if !slashAmount.IsZero() {
-			newUnbondingAmount := entry.Amount.Sub(slashAmount)
-			entry.Amount = newUnbondingAmount
-
-			// If the unbonding entry is now zero, remove it. Otherwise, update it.
-			if newUnbondingAmount.IsZero() {
-				pAddr, err := sdk.AccAddressFromBech32(entry.Participant)
-				if err != nil {
-					// This should not happen if addresses are valid
-					// even so, no panics
-					k.Logger().Error("failed to parse participant address during slash processing")
-					return sdk.Coin{}, err
-				}
-				if err := k.RemoveUnbondingCollateral(sdkCtx, pAddr, entry.CompletionEpoch); err != nil {
-					return sdk.Coin{}, err
-				}
-			} else {
-				if err := k.setUnbondingCollateralEntry(sdkCtx, entry); err != nil {
-					return sdk.Coin{}, err
-				}
+		if !remaining.IsPositive() {
+			break
+		}
+		slashAmount := sdk.NewCoin(entry.Amount.Denom, math.MinInt(remaining, entry.Amount.Amount))
+		if slashAmount.IsZero() {
+			continue
+		}
+		newUnbondingAmount := entry.Amount.Sub(slashAmount)
+		entry.Amount = newUnbondingAmount
+
+		// If the unbonding entry is now zero, remove it. Otherwise, update it.
+		if newUnbondingAmount.IsZero() {
+			pAddr, err := sdk.AccAddressFromBech32(entry.Participant)
+			if err != nil {
+				// This should not happen if addresses are valid
+				// even so, no panics
+				k.Logger().Error("failed to parse participant address during slash processing")
+				return sdk.Coin{}, err
+			}
+			if err := k.RemoveUnbondingCollateral(sdkCtx, pAddr, entry.CompletionEpoch); err != nil {
+				return sdk.Coin{}, err
+			}
+		} else {
+			if err := k.setUnbondingCollateralEntry(sdkCtx, entry); err != nil {
+				return sdk.Coin{}, err
 			}
-			totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
 		}
+		totalSlashedAmount = totalSlashedAmount.Add(slashAmount)
+		remaining = remaining.Sub(slashAmount.Amount)
 	}
 
 	// 3. Burn the total slashed amount from the module account
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/collateral/types"
@@ -36,62 +35,11 @@ func (k msgServer) WithdrawCollateral(goCtx context.Context, msg *types.MsgWithd
 			currentCollateral.String(), msg.Amount.String())
 	}
 
-	// Get the current epoch from the collateral module's own state
-	currentEpoch, err := k.GetCurrentEpoch(ctx)
+	completionEpoch, err := k.startUnbonding(ctx, participantAddr, currentCollateral, msg.Amount)
 	if err != nil {
 		return nil, err
 	}
 
-	// Get the unbonding period from params
-	params, err := k.GetParams(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	// Calculate the completion epoch
-	completionEpoch := currentEpoch + params.UnbondingPeriodEpochs
-
-	k.Logger().Info("adding unbonding entry for collateral withdrawal",
-		"participant", msg.Participant,
-		"amount", msg.Amount.String(),
-		"completion_epoch", completionEpoch,
-		"current_epoch", currentEpoch,
-		"unbonding_period_epochs", params.UnbondingPeriodEpochs,
-	)
-	// Create the unbonding entry
-	if err := k.AddUnbondingCollateral(ctx, participantAddr, completionEpoch, msg.Amount); err != nil {
-		return nil, err
-	}
-
-	// Reduce the active collateral
-	newCollateral := currentCollateral.Sub(msg.Amount)
-	if newCollateral.IsZero() {
-		k.RemoveCollateral(ctx, participantAddr)
-	} else {
-		if err := k.SetCollateral(ctx, participantAddr, newCollateral); err != nil {
-			return nil, err
-		}
-	}
-
-	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, msg.Participant, types.ModuleName, types.SubAccountCollateral, msg.Amount, "collateral to unbonding")
-	k.bookkeepingBankKeeper.LogSubAccountTransaction(goCtx, types.ModuleName, msg.Participant, types.SubAccountUnbonding, msg.Amount, "collateral to unbonding")
-
-	// Emit withdrawal event
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeWithdrawCollateral,
-			sdk.NewAttribute(types.AttributeKeyParticipant, msg.Participant),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyCompletionEpoch, strconv.FormatUint(completionEpoch, 10)),
-		),
-	})
-
-	k.Logger().Info("collateral withdrawal initiated",
-		"participant", msg.Participant,
-		"amount", msg.Amount.String(),
-		"completion_epoch", completionEpoch,
-	)
-
 	return &types.MsgWithdrawCollateralResponse{
 		CompletionEpoch: completionEpoch,
 	}, nil
@@ -10,7 +10,7 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
-func (s *KeeperTestSuite) TestSlashing_Proportional() {
+func (s *KeeperTestSuite) TestSlashing_ActiveFirst() {
 	participantStr := sample.AccAddress()
 	participant, err := sdk.AccAddressFromBech32(participantStr)
 	s.Require().NoError(err)
@@ -45,17 +45,45 @@ func (s *KeeperTestSuite) TestSlashing_Proportional() {
 	s.Require().NoError(err)
 	s.Require().Equal(expectedSlashedAmount, slashedAmount.Amount)
 
-	// Verify active collateral was slashed
-	expectedActive := activeCollateral.Amount.ToLegacyDec().Mul(math.LegacyNewDec(1).Sub(slashFraction)).TruncateInt()
+	// Verify the whole slash was drawn from active collateral
 	newActive, found := s.k.GetCollateral(s.ctx, participant)
 	s.Require().True(found)
-	s.Require().Equal(expectedActive, newActive.Amount)
+	s.Require().Equal(activeCollateral.Amount.Sub(expectedSlashedAmount), newActive.Amount)
 
-	// Verify unbonding collateral was slashed
-	expectedUnbonding := unbondingCollateral.Amount.ToLegacyDec().Mul(math.LegacyNewDec(1).Sub(slashFraction)).TruncateInt()
+	// Verify unbonding collateral was left untouched
 	newUnbonding, found := s.k.GetUnbondingCollateral(s.ctx, participant, completionEpoch)
 	s.Require().True(found)
-	s.Require().Equal(expectedUnbonding, newUnbonding.Amount.Amount)
+	s.Require().Equal(unbondingCollateral.Amount, newUnbonding.Amount.Amount)
+}
+
+func (s *KeeperTestSuite) TestSlashing_UnbondingCoversRest() {
+	participantStr := sample.AccAddress()
+	participant, err := sdk.AccAddressFromBech32(participantStr)
+	s.Require().NoError(err)
+
+	// Setup collateral state: most collateral is unbonding, in two entries
+	s.Require().NoError(s.k.SetCollateral(s.ctx, participant, sdk.NewInt64Coin(inftypes.BaseCoin, 100)))
+	s.Require().NoError(s.k.AddUnbondingCollateral(s.ctx, participant, 100, sdk.NewInt64Coin(inftypes.BaseCoin, 200)))
+	s.Require().NoError(s.k.AddUnbondingCollateral(s.ctx, participant, 101, sdk.NewInt64Coin(inftypes.BaseCoin, 700)))
+
+	s.bankKeeper.EXPECT().
+		BurnCoins(s.ctx, types.ModuleName, gomock.Any(), gomock.Any()).
+		Return(nil).
+		Times(1)
+
+	// 50% of 1000: 100 from active collateral, then 200 and 200 from the entries in completion order
+	slashedAmount, err := s.k.Slash(s.ctx, participant, math.LegacyNewDecWithPrec(50, 2), inftypes.SlashReasonInvalidation)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(500), slashedAmount.Amount)
+
+	newActive, found := s.k.GetCollateral(s.ctx, participant)
+	s.Require().True(found)
+	s.Require().True(newActive.Amount.IsZero())
+	_, found = s.k.GetUnbondingCollateral(s.ctx, participant, 100)
+	s.Require().False(found)
+	newUnbonding, found := s.k.GetUnbondingCollateral(s.ctx, participant, 101)
+	s.Require().True(found)
+	s.Require().Equal(math.NewInt(500), newUnbonding.Amount.Amount)
 }
 
 func (s *KeeperTestSuite) TestSlashing_ActiveOnly() {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -43,3 +44,8 @@ var (
 	// Key: (epoch:uint64, participant:AccAddress, reason:string)
 	SlashedInEpochKey = collections.NewPrefix(7)
 )
+
+// CollateralFullKey returns the store key of a participant's collateral, for raw store queries
+func CollateralFullKey(participant sdk.AccAddress) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(CollateralKey, sdk.AccAddressKey, participant)
+}
@@ -0,0 +1,74 @@
+package calculations
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+type AttestationOutcome int
+
+const (
+	AttestationPending AttestationOutcome = iota
+	AttestationAccepted
+	AttestationRejected
+)
+
+func (o AttestationOutcome) String() string {
+	switch o {
+	case AttestationAccepted:
+		return "accepted"
+	case AttestationRejected:
+		return "rejected"
+	default:
+		return "pending"
+	}
+}
+
+// SampleAttesters picks up to n distinct participants, each draw weighted by participant weight
+// among those not drawn yet. The draw only depends on the seed and the weights, so every node
+// samples the same attesters. Participants with non-positive weight are never drawn.
+func SampleAttesters(seed string, weights map[string]int64, n int) []WeightEntry {
+	entries, totalWeight := PrepareSortedEntries(weights)
+	if n <= 0 || len(entries) == 0 {
+		return nil
+	}
+
+	sampled := make([]WeightEntry, 0, min(n, len(entries)))
+	for draw := 0; draw < n && len(entries) > 0; draw++ {
+		target := attesterRandomVal(seed, draw, totalWeight)
+		cumulative := int64(0)
+		for i, entry := range entries {
+			cumulative += entry.Weight
+			if target < cumulative {
+				sampled = append(sampled, entry)
+				totalWeight -= entry.Weight
+				entries = append(entries[:i:i], entries[i+1:]...)
+				break
+			}
+		}
+	}
+	return sampled
+}
+
+func attesterRandomVal(seed string, draw int, totalWeight int64) int64 {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s|attester|%d", seed, draw)))
+	return int64(binary.BigEndian.Uint64(hash[:8]) % uint64(totalWeight))
+}
+
+// ResolveAttestations settles a verification by majority weight: it is accepted (or rejected)
+// once attesters holding more than half of the sampled weight passed (or failed) it.
+// Until then, or when it is split evenly, it stays pending.
+func ResolveAttestations(sampledWeight int64, passedWeight int64, failedWeight int64) AttestationOutcome {
+	if sampledWeight <= 0 {
+		return AttestationPending
+	}
+	switch {
+	case 2*passedWeight > sampledWeight:
+		return AttestationAccepted
+	case 2*failedWeight > sampledWeight:
+		return AttestationRejected
+	default:
+		return AttestationPending
+	}
+}
@@ -0,0 +1,64 @@
+package calculations
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSampleAttesters(t *testing.T) {
+	weights := map[string]int64{"a": 100, "b": 50, "c": 10, "d": 0, "e": 40}
+
+	sampled := SampleAttesters("seed", weights, 3)
+	require.Len(t, sampled, 3)
+	seen := make(map[string]bool)
+	for _, entry := range sampled {
+		require.False(t, seen[entry.Address], "attesters must be distinct")
+		require.NotEqual(t, "d", entry.Address, "zero weight participants are never sampled")
+		require.Equal(t, weights[entry.Address], entry.Weight)
+		seen[entry.Address] = true
+	}
+
+	// Deterministic for the same seed
+	require.Equal(t, sampled, SampleAttesters("seed", weights, 3))
+
+	// Asking for more attesters than candidates returns every candidate once
+	require.Len(t, SampleAttesters("seed", weights, 10), 4)
+	require.Empty(t, SampleAttesters("seed", weights, 0))
+	require.Empty(t, SampleAttesters("seed", map[string]int64{}, 3))
+}
+
+func TestSampleAttestersFavoursWeight(t *testing.T) {
+	weights := map[string]int64{"heavy": 900, "light": 100}
+	heavyFirst := 0
+	for i := 0; i < 1000; i++ {
+		sampled := SampleAttesters(fmt.Sprintf("seed-%d", i), weights, 1)
+		if sampled[0].Address == "heavy" {
+			heavyFirst++
+		}
+	}
+	require.InDelta(t, 900, heavyFirst, 60)
+}
+
+func TestResolveAttestations(t *testing.T) {
+	tests := []struct {
+		name     string
+		sampled  int64
+		passed   int64
+		failed   int64
+		expected AttestationOutcome
+	}{
+		{"no attestations", 100, 0, 0, AttestationPending},
+		{"majority passed", 100, 51, 0, AttestationAccepted},
+		{"majority failed", 100, 10, 60, AttestationRejected},
+		{"exactly half passed", 100, 50, 0, AttestationPending},
+		{"even split", 100, 50, 50, AttestationPending},
+		{"no sampled weight", 0, 0, 0, AttestationPending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, ResolveAttestations(tt.sampled, tt.passed, tt.failed))
+		})
+	}
+}
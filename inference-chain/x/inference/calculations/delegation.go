@@ -0,0 +1,95 @@
+package calculations
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// DelegationWeightParams controls how much delegated stake adds to a participant's PoC weight
+type DelegationWeightParams struct {
+	// StakePerWeight is the amount of delegated stake that adds one unit of weight
+	StakePerWeight decimal.Decimal
+	// MaxStakeBoost caps the weight added by stake as a fraction of the PoC weight,
+	// so stake can amplify compute but never replace it
+	MaxStakeBoost decimal.Decimal
+}
+
+// WeightWithDelegation combines a participant's PoC weight with the stake delegated to it.
+// A participant without PoC weight gets no weight, however much stake it holds.
+func WeightWithDelegation(pocWeight int64, delegatedStake int64, params DelegationWeightParams) int64 {
+	if pocWeight <= 0 {
+		return 0
+	}
+	if delegatedStake <= 0 || !params.StakePerWeight.IsPositive() || !params.MaxStakeBoost.IsPositive() {
+		return pocWeight
+	}
+
+	pocWeightDec := decimal.NewFromInt(pocWeight)
+	stakeWeight := decimal.NewFromInt(delegatedStake).Div(params.StakePerWeight)
+	maxStakeWeight := pocWeightDec.Mul(params.MaxStakeBoost)
+	if stakeWeight.GreaterThan(maxStakeWeight) {
+		stakeWeight = maxStakeWeight
+	}
+	return pocWeightDec.Add(stakeWeight).IntPart()
+}
+
+type Delegation struct {
+	Delegator string
+	Amount    int64
+}
+
+type DelegatorReward struct {
+	Delegator string
+	Amount    int64
+}
+
+// SplitDelegatorRewards splits a participant's reward between the participant and its delegators.
+// The participant keeps its commission plus the share earned by its PoC weight; the rest goes to
+// delegators pro rata to their stake. Rounding remainders stay with the participant.
+func SplitDelegatorRewards(reward int64, pocWeight int64, weight int64, commission decimal.Decimal, delegations []Delegation) (int64, []DelegatorReward) {
+	rewards := make([]DelegatorReward, 0, len(delegations))
+	if reward <= 0 || weight <= pocWeight || len(delegations) == 0 {
+		return reward, rewards
+	}
+
+	var totalStake int64
+	for _, d := range delegations {
+		if d.Amount > 0 {
+			totalStake += d.Amount
+		}
+	}
+	if totalStake == 0 {
+		return reward, rewards
+	}
+
+	if commission.IsNegative() {
+		commission = decimal.Zero
+	}
+	if commission.GreaterThan(one) {
+		commission = one
+	}
+
+	// The reward earned by the stake boost, less the participant's commission
+	delegatorPool := decimal.NewFromInt(reward).Mul(decimal.NewFromInt(weight - pocWeight)).
+		Mul(one.Sub(commission)).Div(decimal.NewFromInt(weight)).Floor()
+
+	// Deterministic order regardless of how delegations were collected
+	sorted := make([]Delegation, len(delegations))
+	copy(sorted, delegations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Delegator < sorted[j].Delegator })
+
+	var distributed int64
+	for _, d := range sorted {
+		if d.Amount <= 0 {
+			continue
+		}
+		amount := delegatorPool.Mul(decimal.NewFromInt(d.Amount)).Div(decimal.NewFromInt(totalStake)).IntPart()
+		if amount == 0 {
+			continue
+		}
+		rewards = append(rewards, DelegatorReward{Delegator: d.Delegator, Amount: amount})
+		distributed += amount
+	}
+	return reward - distributed, rewards
+}
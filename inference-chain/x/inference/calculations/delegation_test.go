@@ -0,0 +1,61 @@
+package calculations
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightWithDelegation(t *testing.T) {
+	params := DelegationWeightParams{
+		StakePerWeight: decimal.NewFromInt(100),
+		MaxStakeBoost:  decimal.NewFromFloat(0.5),
+	}
+	tests := []struct {
+		name           string
+		pocWeight      int64
+		delegatedStake int64
+		params         DelegationWeightParams
+		expected       int64
+	}{
+		{"No stake", 1000, 0, params, 1000},
+		{"Stake below cap", 1000, 20_000, params, 1200},
+		{"Stake capped", 1000, 1_000_000, params, 1500},
+		{"No PoC weight", 0, 1_000_000, params, 0},
+		{"Delegation disabled", 1000, 20_000, DelegationWeightParams{}, 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, WeightWithDelegation(tt.pocWeight, tt.delegatedStake, tt.params))
+		})
+	}
+}
+
+func TestSplitDelegatorRewards(t *testing.T) {
+	delegations := []Delegation{
+		{Delegator: "delegator2", Amount: 300},
+		{Delegator: "delegator1", Amount: 100},
+	}
+
+	// Stake added half again to the weight, so a third of the reward is earned by stake
+	participantShare, rewards := SplitDelegatorRewards(3000, 1000, 1500, decimal.NewFromFloat(0.1), delegations)
+	require.Equal(t, []DelegatorReward{
+		{Delegator: "delegator1", Amount: 225},
+		{Delegator: "delegator2", Amount: 675},
+	}, rewards)
+	require.Equal(t, int64(2100), participantShare)
+
+	// Remainders stay with the participant
+	participantShare, rewards = SplitDelegatorRewards(1000, 1000, 1500, decimal.Zero, delegations)
+	require.Equal(t, []DelegatorReward{
+		{Delegator: "delegator1", Amount: 83},
+		{Delegator: "delegator2", Amount: 249},
+	}, rewards)
+	require.Equal(t, int64(668), participantShare)
+
+	// Without a stake boost the participant keeps everything
+	participantShare, rewards = SplitDelegatorRewards(1000, 1000, 1000, decimal.Zero, delegations)
+	require.Empty(t, rewards)
+	require.Equal(t, int64(1000), participantShare)
+}
@@ -129,6 +129,13 @@ func ProcessFinishInference(
 ) (*types.Inference, *Payments, error) {
 	payments := Payments{}
 	logger.LogInfo("FinishInference being processed", types.Inferences)
+	// The escrow only covers MaxTokens completion tokens: a longer completion is a runaway generation the
+	// requester did not agree to pay for. Without a start the limit is unknown, then the payment is capped
+	// to the escrow once the start arrives (setEscrowForFinished).
+	if startProcessed(currentInference) && currentInference.MaxTokens > 0 && finishMessage.CompletionTokenCount > currentInference.MaxTokens {
+		return nil, nil, sdkerrors.Wrapf(types.ErrMaxTokensExceeded, "%d completion tokens, max tokens %d",
+			finishMessage.CompletionTokenCount, currentInference.MaxTokens)
+	}
 	if currentInference.InferenceId == "" {
 		logger.LogInfo(
 			"FinishInference received before StartInference",
@@ -394,6 +394,40 @@ func TestProcessStartInference(t *testing.T) {
 	}
 }
 
+func TestProcessFinishInference_MaxTokensExceeded(t *testing.T) {
+	mockLogger := &MockInferenceLogger{}
+	started := func() *types.Inference {
+		return &types.Inference{
+			InferenceId:   "test-id",
+			PromptHash:    "hash",
+			MaxTokens:     20,
+			EscrowAmount:  30 * PerTokenCost,
+			PerTokenPrice: PerTokenCost,
+		}
+	}
+	finish := func(completionTokens uint64) *types.MsgFinishInference {
+		return &types.MsgFinishInference{
+			InferenceId:          "test-id",
+			ResponseHash:         "hash",
+			PromptTokenCount:     10,
+			CompletionTokenCount: completionTokens,
+			ExecutedBy:           "executor",
+		}
+	}
+
+	_, _, err := ProcessFinishInference(started(), finish(21), BlockContext{BlockHeight: 100}, mockLogger)
+	assert.ErrorIs(t, err, types.ErrMaxTokensExceeded)
+
+	inference, payments, err := ProcessFinishInference(started(), finish(20), BlockContext{BlockHeight: 100}, mockLogger)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(30*PerTokenCost), payments.ExecutorPayment)
+	assert.Equal(t, types.InferenceStatus_FINISHED, inference.Status)
+
+	// Before the start the limit is unknown
+	_, _, err = ProcessFinishInference(&types.Inference{PerTokenPrice: PerTokenCost}, finish(21), BlockContext{BlockHeight: 100}, mockLogger)
+	assert.NoError(t, err)
+}
+
 func TestProcessFinishInference(t *testing.T) {
 	mockLogger := &MockInferenceLogger{}
 
@@ -0,0 +1,145 @@
+package epochgroup
+
+import (
+	"sort"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// EpochGroupDiff describes how the membership of an epoch group changed from one epoch to another
+type EpochGroupDiff struct {
+	FromEpoch uint64 `json:"from_epoch"`
+	ToEpoch   uint64 `json:"to_epoch"`
+	ModelId   string `json:"model_id"`
+
+	Added   []MemberWeight `json:"added"`
+	Removed []MemberWeight `json:"removed"`
+	Changed []MemberChange `json:"changed"`
+
+	FromTotalWeight int64 `json:"from_total_weight"`
+	ToTotalWeight   int64 `json:"to_total_weight"`
+}
+
+type MemberWeight struct {
+	Address string `json:"address"`
+	Weight  int64  `json:"weight"`
+}
+
+// MemberChange is a member present in both epochs whose weight or ML nodes changed
+type MemberChange struct {
+	Address     string `json:"address"`
+	FromWeight  int64  `json:"from_weight"`
+	ToWeight    int64  `json:"to_weight"`
+	WeightDelta int64  `json:"weight_delta"`
+
+	AddedNodes   []string     `json:"added_nodes,omitempty"`
+	RemovedNodes []string     `json:"removed_nodes,omitempty"`
+	ChangedNodes []NodeChange `json:"changed_nodes,omitempty"`
+}
+
+type NodeChange struct {
+	NodeId         string `json:"node_id"`
+	FromPocWeight  int64  `json:"from_poc_weight"`
+	ToPocWeight    int64  `json:"to_poc_weight"`
+	FromThroughput int64  `json:"from_throughput"`
+	ToThroughput   int64  `json:"to_throughput"`
+}
+
+// Diff compares the validation weights of two epoch groups. Members and nodes are sorted by
+// address and node id, so the result does not depend on the order in the group data.
+func Diff(from *types.EpochGroupData, to *types.EpochGroupData) EpochGroupDiff {
+	diff := EpochGroupDiff{
+		FromEpoch:       from.EpochIndex,
+		ToEpoch:         to.EpochIndex,
+		ModelId:         to.ModelId,
+		Added:           make([]MemberWeight, 0),
+		Removed:         make([]MemberWeight, 0),
+		Changed:         make([]MemberChange, 0),
+		FromTotalWeight: from.TotalWeight,
+		ToTotalWeight:   to.TotalWeight,
+	}
+
+	fromMembers := validationWeightsByAddress(from)
+	toMembers := validationWeightsByAddress(to)
+
+	for _, address := range sortedKeys(toMembers) {
+		toMember := toMembers[address]
+		fromMember, found := fromMembers[address]
+		if !found {
+			diff.Added = append(diff.Added, MemberWeight{Address: address, Weight: toMember.Weight})
+			continue
+		}
+		change := MemberChange{
+			Address:     address,
+			FromWeight:  fromMember.Weight,
+			ToWeight:    toMember.Weight,
+			WeightDelta: toMember.Weight - fromMember.Weight,
+		}
+		diffNodes(&change, fromMember.MlNodes, toMember.MlNodes)
+		if change.WeightDelta != 0 || len(change.AddedNodes) > 0 || len(change.RemovedNodes) > 0 || len(change.ChangedNodes) > 0 {
+			diff.Changed = append(diff.Changed, change)
+		}
+	}
+	for _, address := range sortedKeys(fromMembers) {
+		if _, found := toMembers[address]; !found {
+			diff.Removed = append(diff.Removed, MemberWeight{Address: address, Weight: fromMembers[address].Weight})
+		}
+	}
+	return diff
+}
+
+func diffNodes(change *MemberChange, fromNodes []*types.MLNodeInfo, toNodes []*types.MLNodeInfo) {
+	from := nodesById(fromNodes)
+	to := nodesById(toNodes)
+	for _, nodeId := range sortedKeys(to) {
+		toNode := to[nodeId]
+		fromNode, found := from[nodeId]
+		if !found {
+			change.AddedNodes = append(change.AddedNodes, nodeId)
+			continue
+		}
+		if fromNode.PocWeight != toNode.PocWeight || fromNode.Throughput != toNode.Throughput {
+			change.ChangedNodes = append(change.ChangedNodes, NodeChange{
+				NodeId:         nodeId,
+				FromPocWeight:  fromNode.PocWeight,
+				ToPocWeight:    toNode.PocWeight,
+				FromThroughput: fromNode.Throughput,
+				ToThroughput:   toNode.Throughput,
+			})
+		}
+	}
+	for _, nodeId := range sortedKeys(from) {
+		if _, found := to[nodeId]; !found {
+			change.RemovedNodes = append(change.RemovedNodes, nodeId)
+		}
+	}
+}
+
+func validationWeightsByAddress(data *types.EpochGroupData) map[string]*types.ValidationWeight {
+	result := make(map[string]*types.ValidationWeight, len(data.ValidationWeights))
+	for _, vw := range data.ValidationWeights {
+		if vw != nil {
+			result[vw.MemberAddress] = vw
+		}
+	}
+	return result
+}
+
+func nodesById(nodes []*types.MLNodeInfo) map[string]*types.MLNodeInfo {
+	result := make(map[string]*types.MLNodeInfo, len(nodes))
+	for _, node := range nodes {
+		if node != nil {
+			result[node.NodeId] = node
+		}
+	}
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,61 @@
+package epochgroup
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiff(t *testing.T) {
+	from := &types.EpochGroupData{
+		EpochIndex:  4,
+		ModelId:     "model-a",
+		TotalWeight: 300,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "stays", Weight: 100, MlNodes: []*types.MLNodeInfo{{NodeId: "node-1", PocWeight: 100}}},
+			{MemberAddress: "leaves", Weight: 100},
+			{MemberAddress: "grows", Weight: 100, MlNodes: []*types.MLNodeInfo{
+				{NodeId: "node-1", PocWeight: 60, Throughput: 10},
+				{NodeId: "node-2", PocWeight: 40},
+			}},
+		},
+	}
+	to := &types.EpochGroupData{
+		EpochIndex:  5,
+		ModelId:     "model-a",
+		TotalWeight: 400,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "joins", Weight: 50},
+			{MemberAddress: "grows", Weight: 250, MlNodes: []*types.MLNodeInfo{
+				{NodeId: "node-3", PocWeight: 150},
+				{NodeId: "node-1", PocWeight: 100, Throughput: 10},
+			}},
+			{MemberAddress: "stays", Weight: 100, MlNodes: []*types.MLNodeInfo{{NodeId: "node-1", PocWeight: 100}}},
+		},
+	}
+
+	diff := Diff(from, to)
+	require.Equal(t, uint64(4), diff.FromEpoch)
+	require.Equal(t, uint64(5), diff.ToEpoch)
+	require.Equal(t, "model-a", diff.ModelId)
+	require.Equal(t, int64(300), diff.FromTotalWeight)
+	require.Equal(t, int64(400), diff.ToTotalWeight)
+	require.Equal(t, []MemberWeight{{Address: "joins", Weight: 50}}, diff.Added)
+	require.Equal(t, []MemberWeight{{Address: "leaves", Weight: 100}}, diff.Removed)
+	require.Equal(t, []MemberChange{{
+		Address:      "grows",
+		FromWeight:   100,
+		ToWeight:     250,
+		WeightDelta:  150,
+		AddedNodes:   []string{"node-3"},
+		RemovedNodes: []string{"node-2"},
+		ChangedNodes: []NodeChange{{NodeId: "node-1", FromPocWeight: 60, ToPocWeight: 100, FromThroughput: 10, ToThroughput: 10}},
+	}}, diff.Changed)
+
+	// No changes between identical groups
+	diff = Diff(to, to)
+	require.Empty(t, diff.Added)
+	require.Empty(t, diff.Removed)
+	require.Empty(t, diff.Changed)
+}
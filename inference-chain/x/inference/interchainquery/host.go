@@ -0,0 +1,110 @@
+package interchainquery
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// InferenceKeeper is the part of the x/inference keeper the queries read
+type InferenceKeeper interface {
+	GetEffectiveEpochIndex(ctx context.Context) (uint64, bool)
+	GetActiveParticipants(ctx context.Context, epochId uint64) (types.ActiveParticipants, bool)
+	GetEpochGroupData(ctx context.Context, epochIndex uint64, modelId string) (types.EpochGroupData, bool)
+	GetGovernanceModelsSorted(ctx context.Context) ([]*types.Model, error)
+}
+
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// Host answers the queries of the packets received on the interchain query port
+type Host struct {
+	cdc          codec.JSONCodec
+	keeper       InferenceKeeper
+	portKeeper   PortKeeper
+	scopedKeeper capabilitykeeper.ScopedKeeper
+}
+
+func NewHost(cdc codec.JSONCodec, keeper InferenceKeeper, portKeeper PortKeeper, scopedKeeper capabilitykeeper.ScopedKeeper) Host {
+	return Host{cdc: cdc, keeper: keeper, portKeeper: portKeeper, scopedKeeper: scopedKeeper}
+}
+
+// BindPort binds the interchain query port unless this module already owns it, e.g. from the
+// capability genesis
+func (h Host) BindPort(ctx sdk.Context) error {
+	if _, ok := h.scopedKeeper.GetCapability(ctx, host.PortPath(PortID)); ok {
+		return nil
+	}
+	capability := h.portKeeper.BindPort(ctx, PortID)
+	return h.scopedKeeper.ClaimCapability(ctx, capability, host.PortPath(PortID))
+}
+
+// Answer runs the queries of a packet. A failing query gets an error result, the others are still answered.
+func (h Host) Answer(ctx sdk.Context, data QueryPacketData) QueryPacketAck {
+	ack := QueryPacketAck{Height: ctx.BlockHeight(), Results: make([]QueryResult, 0, len(data.Queries))}
+	for _, query := range data.Queries {
+		var result QueryResult
+		message, err := h.answer(ctx, query)
+		if err == nil {
+			result.Data, err = h.cdc.MarshalJSON(message)
+		}
+		if err != nil {
+			result = QueryResult{Error: err.Error()}
+		}
+		ack.Results = append(ack.Results, result)
+	}
+	return ack
+}
+
+func (h Host) answer(ctx sdk.Context, query Query) (proto.Message, error) {
+	if query.Kind == QueryModels {
+		models, err := h.keeper.GetGovernanceModelsSorted(ctx)
+		if err != nil {
+			return nil, err
+		}
+		response := &types.QueryModelsAllResponse{Model: make([]types.Model, 0, len(models))}
+		for _, model := range models {
+			response.Model = append(response.Model, *model)
+		}
+		return response, nil
+	}
+
+	epoch, err := h.epoch(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	switch query.Kind {
+	case QueryActiveParticipants:
+		participants, found := h.keeper.GetActiveParticipants(ctx, epoch)
+		if !found {
+			return nil, errorsmod.Wrapf(ErrNotFound, "active participants of epoch %d", epoch)
+		}
+		return &participants, nil
+	case QueryEpochGroupData:
+		data, found := h.keeper.GetEpochGroupData(ctx, epoch, query.ModelId)
+		if !found {
+			return nil, errorsmod.Wrapf(ErrNotFound, "epoch group data of epoch %d, model %q", epoch, query.ModelId)
+		}
+		return &types.QueryGetEpochGroupDataResponse{EpochGroupData: data}, nil
+	}
+	return nil, errorsmod.Wrapf(ErrInvalidPacket, "unknown kind %q", query.Kind)
+}
+
+func (h Host) epoch(ctx sdk.Context, query Query) (uint64, error) {
+	if query.Epoch != nil {
+		return *query.Epoch, nil
+	}
+	epoch, found := h.keeper.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return 0, errorsmod.Wrap(ErrNotFound, "effective epoch")
+	}
+	return epoch, nil
+}
@@ -0,0 +1,97 @@
+package interchainquery_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/interchainquery"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestHost_Answer(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	host := interchainquery.NewHost(cdc, k, nil, capabilitykeeper.ScopedKeeper{})
+
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 3))
+	require.NoError(t, k.SetActiveParticipants(ctx, types.ActiveParticipants{
+		EpochId:      3,
+		Participants: []*types.ActiveParticipant{{Index: "gonka1participant", Weight: 10}},
+	}))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 3, ModelId: "model-a", TotalWeight: 10})
+	k.SetModel(ctx, &types.Model{Id: "model-a"})
+
+	epoch := uint64(2)
+	ack := host.Answer(ctx, interchainquery.QueryPacketData{Queries: []interchainquery.Query{
+		{Kind: interchainquery.QueryActiveParticipants},
+		{Kind: interchainquery.QueryEpochGroupData, ModelId: "model-a"},
+		{Kind: interchainquery.QueryModels},
+		{Kind: interchainquery.QueryActiveParticipants, Epoch: &epoch},
+	}})
+	require.Equal(t, ctx.BlockHeight(), ack.Height)
+	require.Len(t, ack.Results, 4)
+
+	var participants types.ActiveParticipants
+	require.NoError(t, cdc.UnmarshalJSON(ack.Results[0].Data, &participants))
+	require.Equal(t, uint64(3), participants.EpochId)
+	require.Equal(t, "gonka1participant", participants.Participants[0].Index)
+
+	var groupData types.QueryGetEpochGroupDataResponse
+	require.NoError(t, cdc.UnmarshalJSON(ack.Results[1].Data, &groupData))
+	require.Equal(t, "model-a", groupData.EpochGroupData.ModelId)
+	require.Equal(t, int64(10), groupData.EpochGroupData.TotalWeight)
+
+	var models types.QueryModelsAllResponse
+	require.NoError(t, cdc.UnmarshalJSON(ack.Results[2].Data, &models))
+	require.Len(t, models.Model, 1)
+	require.Equal(t, "model-a", models.Model[0].Id)
+
+	// A missing record fails its query only
+	require.Empty(t, ack.Results[3].Data)
+	require.Contains(t, ack.Results[3].Error, "active participants of epoch 2")
+}
+
+func TestQueryPacketData_Validate(t *testing.T) {
+	epoch := uint64(1)
+	require.NoError(t, interchainquery.QueryPacketData{Queries: []interchainquery.Query{
+		{Kind: interchainquery.QueryEpochGroupData, Epoch: &epoch},
+	}}.Validate())
+
+	for name, data := range map[string]interchainquery.QueryPacketData{
+		"no queries":            {},
+		"unknown kind":          {Queries: []interchainquery.Query{{Kind: "balances"}}},
+		"models with epoch":     {Queries: []interchainquery.Query{{Kind: interchainquery.QueryModels, Epoch: &epoch}}},
+		"participants of model": {Queries: []interchainquery.Query{{Kind: interchainquery.QueryActiveParticipants, ModelId: "model-a"}}},
+		"too many queries":      {Queries: make([]interchainquery.Query, interchainquery.MaxQueriesPerPacket+1)},
+	} {
+		require.ErrorIs(t, data.Validate(), interchainquery.ErrInvalidPacket, name)
+	}
+}
+
+func TestIBCModule_OnRecvPacket(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	cdc := codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+	module := interchainquery.NewIBCModule(interchainquery.NewHost(cdc, k, nil, capabilitykeeper.ScopedKeeper{}))
+
+	ack := module.OnRecvPacket(ctx, channeltypes.Packet{Data: []byte("not json")}, nil)
+	require.False(t, ack.Success())
+
+	data, err := json.Marshal(interchainquery.QueryPacketData{Queries: []interchainquery.Query{{Kind: interchainquery.QueryModels}}})
+	require.NoError(t, err)
+	ack = module.OnRecvPacket(ctx, channeltypes.Packet{Data: data}, nil)
+	require.True(t, ack.Success())
+
+	result, ok := ack.(channeltypes.Acknowledgement)
+	require.True(t, ok)
+	var queryAck interchainquery.QueryPacketAck
+	require.NoError(t, json.Unmarshal(result.GetResult(), &queryAck))
+	require.Len(t, queryAck.Results, 1)
+	require.Empty(t, queryAck.Results[0].Error)
+}
@@ -0,0 +1,104 @@
+package interchainquery
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the channel callbacks of the interchain query port. This chain only hosts:
+// counterparties open unordered channels to it and send queries, it never sends packets.
+type IBCModule struct {
+	host Host
+}
+
+func NewIBCModule(host Host) IBCModule {
+	return IBCModule{host: host}
+}
+
+func (IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return "", errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "interchain query channels are opened by the querying chain")
+}
+
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+	if portID != PortID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, PortID)
+	}
+	if counterpartyVersion != Version {
+		return "", errorsmod.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, counterpartyVersion)
+	}
+	// OpenTry must claim the channel capability that IBC passes into the callback
+	if err := im.host.scopedKeeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+	return Version, nil
+}
+
+func (IBCModule) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "interchain query channels are opened by the querying chain")
+}
+
+func (IBCModule) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+func (IBCModule) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	// Disallow user-initiated channel closing, like the transfer module
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+func (IBCModule) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return nil
+}
+
+// OnRecvPacket answers the queries of the packet in the acknowledgement. Failing queries get an error
+// result in a successful acknowledgement; a packet that can't be decoded gets an error acknowledgement.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	data, err := decodePacketData(packet.GetData())
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	bz, err := json.Marshal(im.host.Answer(ctx, data))
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement(bz)
+}
+
+func (IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "the interchain query host sends no packets")
+}
+
+func (IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "the interchain query host sends no packets")
+}
@@ -0,0 +1,68 @@
+package interchainquery
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/core/appmodule"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+var (
+	_ module.AppModuleBasic      = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.HasConsensusVersion = AppModule{}
+
+	_ appmodule.AppModule = AppModule{}
+)
+
+// AppModule binds the interchain query port. It has no state: its genesis only binds the port, which
+// also happens when the v0.2.11 upgrade adds the module to a running chain.
+type AppModule struct {
+	host Host
+}
+
+func NewAppModule(host Host) AppModule {
+	return AppModule{host: host}
+}
+
+func (AppModule) Name() string { return ModuleName }
+
+func (AppModule) IsOnePerModuleType() {}
+
+func (AppModule) IsAppModule() {}
+
+func (AppModule) RegisterLegacyAminoCodec(*codec.LegacyAmino) {}
+
+func (AppModule) RegisterInterfaces(cdctypes.InterfaceRegistry) {}
+
+func (AppModule) RegisterGRPCGatewayRoutes(client.Context, *runtime.ServeMux) {}
+
+func (AppModule) DefaultGenesis(codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+func (AppModule) ValidateGenesis(_ codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &state); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return nil
+}
+
+func (am AppModule) InitGenesis(ctx sdk.Context, _ codec.JSONCodec, _ json.RawMessage) {
+	if err := am.host.BindPort(ctx); err != nil {
+		panic(fmt.Errorf("could not claim port capability: %w", err))
+	}
+}
+
+func (AppModule) ExportGenesis(sdk.Context, codec.JSONCodec) json.RawMessage {
+	return json.RawMessage("{}")
+}
+
+func (AppModule) ConsensusVersion() uint64 { return 1 }
@@ -0,0 +1,93 @@
+// Package interchainquery is a host-only IBC application answering other chains' queries about the
+// compute membership of the network: active participant sets, epoch group data and the model registry.
+// Answers are written to the packet acknowledgement, which the counterparty verifies against this chain's
+// light client like any other IBC proof, so no trusted bridge is involved.
+package interchainquery
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// ModuleName is also the name of the capability scope of the port
+	ModuleName = "inferenceicq"
+	PortID     = "inference-icq"
+	Version    = "inference-icq-1"
+
+	// MaxQueriesPerPacket bounds the work one packet makes the chain do
+	MaxQueriesPerPacket = 16
+)
+
+// Query kinds. Answers are the proto JSON of the messages the gRPC queries of x/inference return.
+const (
+	// QueryActiveParticipants answers an ActiveParticipants of the epoch
+	QueryActiveParticipants = "active_participants"
+	// QueryEpochGroupData answers a QueryGetEpochGroupDataResponse of the epoch and model, the parent
+	// group when the model is empty
+	QueryEpochGroupData = "epoch_group_data"
+	// QueryModels answers a QueryModelsAllResponse with the governance models
+	QueryModels = "models"
+)
+
+var (
+	ErrInvalidPacket  = errorsmod.Register(ModuleName, 2, "invalid interchain query packet")
+	ErrInvalidVersion = errorsmod.Register(ModuleName, 3, "invalid interchain query version")
+	ErrNotFound       = errorsmod.Register(ModuleName, 4, "queried data not found")
+)
+
+// Query is one query of a packet. Epoch defaults to the effective epoch.
+type Query struct {
+	Kind    string  `json:"kind"`
+	Epoch   *uint64 `json:"epoch,omitempty"`
+	ModelId string  `json:"model_id,omitempty"`
+}
+
+// QueryPacketData is the JSON data of the packets counterparties send
+type QueryPacketData struct {
+	Queries []Query `json:"queries"`
+}
+
+func (p QueryPacketData) Validate() error {
+	if len(p.Queries) == 0 || len(p.Queries) > MaxQueriesPerPacket {
+		return errorsmod.Wrapf(ErrInvalidPacket, "expected between 1 and %d queries, got %d", MaxQueriesPerPacket, len(p.Queries))
+	}
+	for i, query := range p.Queries {
+		switch query.Kind {
+		case QueryActiveParticipants, QueryEpochGroupData:
+		case QueryModels:
+			if query.Epoch != nil || query.ModelId != "" {
+				return errorsmod.Wrapf(ErrInvalidPacket, "query %d: models takes no epoch or model", i)
+			}
+		default:
+			return errorsmod.Wrapf(ErrInvalidPacket, "query %d: unknown kind %q", i, query.Kind)
+		}
+		if query.Kind == QueryActiveParticipants && query.ModelId != "" {
+			return errorsmod.Wrapf(ErrInvalidPacket, "query %d: active_participants takes no model", i)
+		}
+	}
+	return nil
+}
+
+// QueryResult answers one query: Data is set on success, Error otherwise
+type QueryResult struct {
+	Data  json.RawMessage `json:"data,omitempty"`
+	Error string          `json:"error,omitempty"`
+}
+
+// QueryPacketAck is the JSON result of the acknowledgement, one result per query in order.
+// Height is the block height the queries were answered at.
+type QueryPacketAck struct {
+	Height  int64         `json:"height"`
+	Results []QueryResult `json:"results"`
+}
+
+func decodePacketData(bz []byte) (QueryPacketData, error) {
+	var data QueryPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return QueryPacketData{}, errorsmod.Wrap(ErrInvalidPacket, fmt.Sprintf("cannot decode packet data: %s", err))
+	}
+	return data, data.Validate()
+}
@@ -161,8 +161,23 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 		k.LogInfo("using grace BinomTestP0", types.Settle, "epoch", currentEpochIndex)
 	}
 
+	// Weight reward distribution by the models participants served, if governance selected multipliers
+	settleData := &data
+	if rewardWeights := k.GetModelRewardWeights(ctx); rewardWeights.Enabled() {
+		modelWeights := k.AggregateModelWeightsFromModelSubgroups(ctx, &data)
+		settleData, participantMLNodes = ApplyModelRewardWeights(&data, participantMLNodes, modelWeights, rewardWeights)
+		k.LogInfo("Applied model reward weights", types.Settle, "multipliers", rewardWeights.MultipliersPermille)
+	}
+
+	// Delegated stake weighted the epoch group, so it weights the split of the reward too
+	epochDelegations := k.GetEpochDelegations(ctx, currentEpochIndex)
+	if len(epochDelegations) > 0 {
+		settleData, participantMLNodes = ApplyDelegationRewardWeights(settleData, participantMLNodes, epochDelegations)
+		k.LogInfo("Applied delegation reward weights", types.Settle, "participants", len(epochDelegations))
+	}
+
 	var bitcoinResult BitcoinResult
-	amounts, bitcoinResult, err = GetBitcoinSettleAmounts(allParticipants, &data, params.BitcoinRewardParams, validationParams, settleParameters, participantMLNodes, k.Logger())
+	amounts, bitcoinResult, err = GetBitcoinSettleAmounts(allParticipants, settleData, params.BitcoinRewardParams, validationParams, settleParameters, participantMLNodes, k.Logger())
 	if err != nil {
 		k.LogError("Error getting Bitcoin settle amounts", types.Settle, "error", err)
 	}
@@ -205,6 +220,9 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 		if participant.Status == types.ParticipantStatus_ACTIVE {
 			participant.EpochsCompleted += 1
 		}
+		if amount.Error == nil {
+			amount.Settle.DenomWorkCoins = k.settleDenomBalances(ctx, participant)
+		}
 		k.SafeLogSubAccountTransaction(ctx, types.ModuleName, participant.Address, "balance", participant.CoinBalance, "settling")
 		participant.CoinBalance = 0
 		participant.CurrentEpochStats.EarnedCoins = 0
@@ -231,14 +249,19 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 		}
 	}
 
+	var epochSubsidy uint64
 	for _, amount := range amounts {
 		// TODO: Check if we have to store 0 or error settle amount as well, as it store seed signature, which we may use somewhere
 		if amount.Error != nil {
 			k.LogError("Error calculating settle amounts", types.Settle, "error", amount.Error, "participant", amount.Settle.Participant)
 			continue
 		}
+		if delegation, found := epochDelegations[amount.Settle.Participant]; found && amount.Settle.RewardCoins > 0 {
+			amount.Settle.RewardCoins = k.payDelegatorRewards(ctx, currentEpochIndex, amount.Settle.Participant, delegation, amount.Settle.RewardCoins)
+		}
+		k.recordSettledEarnings(ctx, amount.Settle.Participant, currentEpochIndex, amount.Settle.WorkCoins, amount.Settle.RewardCoins)
 		totalPayment := amount.Settle.WorkCoins + amount.Settle.RewardCoins
-		if totalPayment == 0 {
+		if totalPayment == 0 && len(amount.Settle.DenomWorkCoins) == 0 {
 			k.LogDebug("No payment needed for participant", types.Settle, "address", amount.Settle.Participant)
 			continue
 		}
@@ -249,18 +272,34 @@ func (k *Keeper) SettleAccounts(ctx context.Context, currentEpochIndex uint64, p
 		}
 
 		amount.Settle.EpochIndex = currentEpochIndex
+		epochSubsidy += amount.Settle.RewardCoins
 		k.LogInfo("Settle for participant", types.Settle, "rewardCoins", amount.Settle.RewardCoins, "workCoins", amount.Settle.WorkCoins, "address", amount.Settle.Participant)
-		k.SetSettleAmountWithGovernanceTransfer(ctx, *amount.Settle)
+		k.SetSettleAmountWithCarryover(ctx, *amount.Settle)
+	}
+	if err := k.SetEpochSubsidyTotal(ctx, currentEpochIndex, epochSubsidy); err != nil {
+		k.LogError("Error storing epoch subsidy total", types.Settle, "error", err, "epoch", currentEpochIndex)
+	}
+	if err := k.RemoveEpochDelegations(ctx, currentEpochIndex); err != nil {
+		k.LogError("Error removing epoch delegations", types.Settle, "error", err, "epoch", currentEpochIndex)
+	}
+	if err := k.ReleaseDelegationUnbondings(ctx, currentEpochIndex); err != nil {
+		k.LogError("Error releasing undelegated stake", types.Settle, "error", err, "epoch", currentEpochIndex)
 	}
 
 	if previousEpochIndex == 0 {
 		return nil
 	}
 
-	k.LogInfo("Transferring old settle amounts", types.Settle, "previousEpochIndex", previousEpochIndex)
-	err = k.TransferOldSettleAmountsToGovernance(ctx, previousEpochIndex)
+	k.LogInfo("Carrying over old settle amounts", types.Settle, "previousEpochIndex", previousEpochIndex)
+	err = k.CarryOverOldSettleAmounts(ctx, previousEpochIndex)
 	if err != nil {
-		k.LogError("Error burning old settle amounts", types.Settle, "error", err)
+		k.LogError("Error carrying over old settle amounts", types.Settle, "error", err)
+	}
+	if err := k.ExpireSettleCarryovers(ctx, currentEpochIndex); err != nil {
+		k.LogError("Error expiring settle carryovers", types.Settle, "error", err)
+	}
+	if err := k.PruneEpochSubsidyTotals(ctx, previousEpochIndex); err != nil {
+		k.LogError("Error pruning epoch subsidy totals", types.Settle, "error", err)
 	}
 	return nil
 }
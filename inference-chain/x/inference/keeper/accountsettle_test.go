@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/testutil"
 	"go.uber.org/mock/gomock"
 
@@ -58,6 +59,8 @@ func TestActualSettle(t *testing.T) {
 
 	keeper.SetParticipant(ctx, participant1)
 	keeper.SetParticipant(ctx, participant2)
+	// Work paid for in a registered denom is settled in that denom
+	require.NoError(t, keeper.SetParticipantDenomBalance(ctx, types.ParticipantDenomBalance{Participant: participant1.Address, Denom: testIbcDenom, Amount: 400}))
 	keeper.SetEpochGroupData(ctx, types.EpochGroupData{
 		EpochIndex: 10,
 		ValidationWeights: []*types.ValidationWeight{
@@ -148,6 +151,10 @@ func TestActualSettle(t *testing.T) {
 	// remainder goes to `gov`, not to a participant
 	require.Equal(t, expectedRewardHalf, settleAmount1.RewardCoins, "Participant 1 reward coins should be half of total")
 	require.Equal(t, uint64(10), settleAmount1.EpochIndex, "Epoch index should be 10")
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testIbcDenom, 400)), sdk.NewCoins(settleAmount1.DenomWorkCoins...))
+	denomBalances, err := keeper.GetParticipantDenomBalances(ctx, sdk.MustAccAddressFromBech32(participant1.Address))
+	require.NoError(t, err)
+	require.Empty(t, denomBalances, "Participant 1 denom balance should be settled")
 	logger.Info("Verified participant 1 settle amount", "workCoins", settleAmount1.WorkCoins, "rewardCoins", settleAmount1.RewardCoins)
 
 	settleAmount2, found := keeper.GetSettleAmount(ctx, participant2.Address)
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetCapacityCollateralPolicy selects the collateral required for the capacity of ML nodes from the next
+// model assignment on. Governance updates it with MsgUpdatePolicy.
+func (k Keeper) SetCapacityCollateralPolicy(ctx context.Context, policy types.CapacityCollateralPolicy) error {
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.CapacityCollateralPolicy.Set(ctx, bz)
+}
+
+// GetCapacityCollateralPolicy returns the active capacity collateral policy,
+// or types.DefaultCapacityCollateralPolicy if none was selected.
+func (k Keeper) GetCapacityCollateralPolicy(ctx context.Context) types.CapacityCollateralPolicy {
+	bz, err := k.CapacityCollateralPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultCapacityCollateralPolicy()
+	}
+	var policy types.CapacityCollateralPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode capacity collateral policy, using default", types.Tokenomics, "error", err)
+		return types.DefaultCapacityCollateralPolicy()
+	}
+	return policy
+}
+
+// GetBondedCollateral returns the active collateral of a participant. Collateral in the unbonding queue
+// does not count.
+func (k Keeper) GetBondedCollateral(ctx context.Context, participant string) math.Int {
+	address, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return math.ZeroInt()
+	}
+	collateral, found := k.collateralKeeper.GetCollateral(ctx, address)
+	if !found {
+		return math.ZeroInt()
+	}
+	return collateral.Amount
+}
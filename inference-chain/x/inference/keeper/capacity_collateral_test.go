@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityCollateralPolicy(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+
+	require.Equal(t, types.DefaultCapacityCollateralPolicy(), k.GetCapacityCollateralPolicy(ctx))
+	require.False(t, k.GetCapacityCollateralPolicy(ctx).Enabled())
+
+	policy := types.CapacityCollateralPolicy{CollateralPerVRamGB: 10, CollateralPerThroughput: 2, MinCollateralPerNode: 500}
+	require.NoError(t, k.SetCapacityCollateralPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetCapacityCollateralPolicy(ctx))
+
+	// 2 * 80GB * 10 + 100 * 2, or the minimum for a node without a VRAM declaration
+	node := &types.HardwareNode{LocalId: "mlnode1", Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 2}}}
+	require.Equal(t, math.NewInt(1800), policy.RequiredCollateral(node, 100))
+	require.Equal(t, math.NewInt(500), policy.RequiredCollateral(&types.HardwareNode{LocalId: "mlnode2"}, 0))
+
+	bonded := sample.AccAddress()
+	mocks.CollateralKeeper.EXPECT().GetCollateral(ctx, sdk.MustAccAddressFromBech32(bonded)).Return(sdk.NewInt64Coin(types.BaseCoin, 1000), true)
+	require.Equal(t, math.NewInt(1000), k.GetBondedCollateral(ctx, bonded))
+	unbonded := sample.AccAddress()
+	mocks.CollateralKeeper.EXPECT().GetCollateral(ctx, sdk.MustAccAddressFromBech32(unbonded)).Return(sdk.Coin{}, false)
+	require.True(t, k.GetBondedCollateral(ctx, unbonded).IsZero())
+}
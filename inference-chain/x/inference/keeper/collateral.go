@@ -120,10 +120,12 @@ func (k Keeper) SlashForInvalidStatus(ctx context.Context, participant *types.Pa
 			"participant", participant.Address,
 			"slash_fraction", slashFraction.String(),
 		)
-		_, err := k.collateralKeeper.Slash(ctx, participantAddress, slashFraction, types.SlashReasonInvalidation)
+		slashed, err := k.collateralKeeper.Slash(ctx, participantAddress, slashFraction, types.SlashReasonInvalidation)
 		if err != nil {
 			k.LogError("Failed to slash participant", types.Tokenomics, "participant", participant.Address, "error", err)
 			// Non-fatal error, we log and continue. The participant is already marked INVALID.
+		} else {
+			k.recordSlashedEarnings(ctx, participant.Address, slashed)
 		}
 	}
 }
@@ -142,8 +144,10 @@ func (k Keeper) SlashForDowntime(ctx context.Context, participant *types.Partici
 		k.LogError("Could not parse participant address for downtime slashing", types.Tokenomics, "address", participant.Address, "error", err)
 		return
 	}
-	_, err = k.collateralKeeper.Slash(ctx, participantAddress, slashFractionDown, types.SlashReasonDowntime)
+	slashed, err := k.collateralKeeper.Slash(ctx, participantAddress, slashFractionDown, types.SlashReasonDowntime)
 	if err != nil {
 		k.LogError("Failed to slash participant for downtime", types.Tokenomics, "participant", participant.Address, "error", err)
+		return
 	}
+	k.recordSlashedEarnings(ctx, participant.Address, slashed)
 }
@@ -91,6 +91,7 @@ func setupRealKeepers(t testing.TB) (sdk.Context, keeper.Keeper, collateralKeepe
 		runtime.NewKVStoreService(blsStoreKey),
 		keepertest.PrintlnLogger{},
 		authority.String(),
+		nil,
 	)
 
 	upgradeMock := keepertest.NewMockUpgradeKeeper(ctrl)
@@ -0,0 +1,422 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// SetDelegationPolicy selects how delegated stake weights participants from the next epoch on.
+// Governance updates it with MsgUpdatePolicy.
+func (k Keeper) SetDelegationPolicy(ctx context.Context, policy types.DelegationPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.DelegationPolicy.Set(ctx, bz)
+}
+
+// GetDelegationPolicy returns the active delegation policy, or types.DefaultDelegationPolicy if none was selected.
+func (k Keeper) GetDelegationPolicy(ctx context.Context) types.DelegationPolicy {
+	bz, err := k.DelegationPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultDelegationPolicy()
+	}
+	var policy types.DelegationPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode delegation policy, using default", types.Tokenomics, "error", err)
+		return types.DefaultDelegationPolicy()
+	}
+	return policy
+}
+
+func delegationWeightParams(policy types.DelegationPolicy) calculations.DelegationWeightParams {
+	return calculations.DelegationWeightParams{
+		StakePerWeight: decimal.NewFromUint64(policy.StakePerWeight),
+		MaxStakeBoost:  decimal.New(int64(policy.MaxStakeBoostPermille), -3),
+	}
+}
+
+// GetParticipantDelegations returns the stake delegated to a participant
+func (k Keeper) GetParticipantDelegations(ctx context.Context, participant sdk.AccAddress) types.ParticipantDelegations {
+	delegations, err := k.ParticipantDelegations.Get(ctx, participant)
+	if err != nil {
+		return types.ParticipantDelegations{Participant: participant.String()}
+	}
+	return delegations
+}
+
+// GetAllParticipantDelegations returns the stake delegated to every participant with delegations
+func (k Keeper) GetAllParticipantDelegations(ctx context.Context) ([]types.ParticipantDelegations, error) {
+	iter, err := k.ParticipantDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetParticipantDelegations stores the stake delegated to a participant, removing the entry once it has none
+func (k Keeper) SetParticipantDelegations(ctx context.Context, delegations types.ParticipantDelegations) error {
+	participant, err := sdk.AccAddressFromBech32(delegations.Participant)
+	if err != nil {
+		return err
+	}
+	if len(delegations.Delegations) == 0 {
+		return k.ParticipantDelegations.Remove(ctx, participant)
+	}
+	return k.ParticipantDelegations.Set(ctx, participant, delegations)
+}
+
+// Delegate moves amount of the delegator's stake into the module and delegates it to the participant.
+// The stake weights the participant from the next epoch on.
+func (k Keeper) Delegate(ctx context.Context, delegator string, participant string, amount uint64) error {
+	if !k.GetDelegationPolicy(ctx).Enabled() {
+		return types.ErrDelegationDisabled
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return types.ErrInvalidAddress.Wrap(err.Error())
+	}
+	participantAddress, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if _, found := k.GetParticipant(ctx, participant); !found {
+		return types.ErrParticipantNotFound.Wrapf("participant %s", participant)
+	}
+
+	coins, err := types.GetCoins(int64(amount))
+	if err != nil {
+		return err
+	}
+	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, coins, "delegate to "+participant); err != nil {
+		return err
+	}
+
+	delegations := k.GetParticipantDelegations(ctx, participantAddress)
+	delegations.Add(delegator, int64(amount))
+	if err := k.SetParticipantDelegations(ctx, delegations); err != nil {
+		return err
+	}
+	k.LogInfo("Stake delegated", types.Tokenomics, "delegator", delegator, "participant", participant, "amount", amount)
+	k.emitDelegationEvent(ctx, types.EventTypeStakeDelegated, delegator, participant, amount)
+	return nil
+}
+
+// Undelegate takes back amount of the stake the delegator delegated to the participant. The participant loses
+// the weight from the next epoch on. Stake that weighted an epoch not settled yet is held until the last such
+// epoch is settled, as it still earns that epoch's rewards, and is returned right away otherwise. It returns
+// the epoch whose settlement releases the stake, or zero.
+// Undelegating is allowed whether or not delegation is enabled, so stake is never locked in.
+func (k Keeper) Undelegate(ctx context.Context, delegator string, participant string, amount uint64) (uint64, error) {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return 0, types.ErrInvalidAddress.Wrap(err.Error())
+	}
+	participantAddress, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return 0, types.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	delegations := k.GetParticipantDelegations(ctx, participantAddress)
+	if delegated := delegations.Amount(delegator); delegated < amount {
+		return 0, types.ErrInsufficientDelegation.Wrapf("delegated %d to %s, requested %d", delegated, participant, amount)
+	}
+	delegations.Add(delegator, -int64(amount))
+	if err := k.SetParticipantDelegations(ctx, delegations); err != nil {
+		return 0, err
+	}
+
+	releaseEpoch, err := k.lastUnsettledDelegationEpoch(ctx, delegator, participant)
+	if err != nil {
+		return 0, err
+	}
+	unbonding := types.DelegationUnbonding{Delegator: delegator, Participant: participant, Amount: amount, ReleaseEpoch: releaseEpoch}
+	if releaseEpoch == 0 {
+		if err := k.releaseDelegationUnbonding(ctx, unbonding); err != nil {
+			return 0, err
+		}
+	} else if err := k.AddDelegationUnbonding(ctx, unbonding); err != nil {
+		return 0, err
+	}
+	k.LogInfo("Stake undelegated", types.Tokenomics, "delegator", delegator, "participant", participant, "amount", amount, "releaseEpoch", releaseEpoch)
+	k.emitDelegationEvent(ctx, types.EventTypeStakeUndelegated, delegator, participant, amount)
+	return releaseEpoch, nil
+}
+
+// lastUnsettledDelegationEpoch returns the last epoch not settled yet that the delegator's stake in the
+// participant weighted, or zero if there is none
+func (k Keeper) lastUnsettledDelegationEpoch(ctx context.Context, delegator string, participant string) (uint64, error) {
+	iter, err := k.EpochDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+	var last uint64
+	for ; iter.Valid(); iter.Next() {
+		delegation, err := iter.Value()
+		if err != nil {
+			return 0, err
+		}
+		if delegation.Participant != participant || delegation.EpochIndex <= last {
+			continue
+		}
+		for _, d := range delegation.Delegations {
+			if d.Delegator == delegator {
+				last = delegation.EpochIndex
+				break
+			}
+		}
+	}
+	return last, nil
+}
+
+// AddDelegationUnbonding holds undelegated stake until the settlement of its release epoch
+func (k Keeper) AddDelegationUnbonding(ctx context.Context, unbonding types.DelegationUnbonding) error {
+	delegator, err := sdk.AccAddressFromBech32(unbonding.Delegator)
+	if err != nil {
+		return err
+	}
+	participant, err := sdk.AccAddressFromBech32(unbonding.Participant)
+	if err != nil {
+		return err
+	}
+	key := collections.Join3(unbonding.ReleaseEpoch, delegator, participant)
+	existing, err := k.DelegationUnbondings.Get(ctx, key)
+	if err == nil {
+		unbonding.Amount += existing.Amount
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.DelegationUnbondings.Set(ctx, key, unbonding)
+}
+
+// GetAllDelegationUnbondings returns the undelegated stake held until its release epoch is settled
+func (k Keeper) GetAllDelegationUnbondings(ctx context.Context) ([]types.DelegationUnbonding, error) {
+	iter, err := k.DelegationUnbondings.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// ReleaseDelegationUnbondings returns to the delegators the stake held until an epoch up to settledEpoch was settled
+func (k Keeper) ReleaseDelegationUnbondings(ctx context.Context, settledEpoch uint64) error {
+	rng := new(collections.Range[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress]]).
+		EndExclusive(collections.TriplePrefix[uint64, sdk.AccAddress, sdk.AccAddress](settledEpoch + 1))
+	iter, err := k.DelegationUnbondings.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range kvs {
+		if err := k.DelegationUnbondings.Remove(ctx, kv.Key); err != nil {
+			return err
+		}
+		if err := k.releaseDelegationUnbonding(ctx, kv.Value); err != nil {
+			k.LogError("Failed to release undelegated stake", types.Settle, "delegator", kv.Value.Delegator,
+				"participant", kv.Value.Participant, "amount", kv.Value.Amount, "error", err)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) releaseDelegationUnbonding(ctx context.Context, unbonding types.DelegationUnbonding) error {
+	delegatorAddress, err := sdk.AccAddressFromBech32(unbonding.Delegator)
+	if err != nil {
+		return err
+	}
+	coins, err := types.GetCoins(int64(unbonding.Amount))
+	if err != nil {
+		return err
+	}
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, coins, "undelegate from "+unbonding.Participant)
+}
+
+func (k Keeper) emitDelegationEvent(ctx context.Context, eventType string, delegator string, participant string, amount uint64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDelegator, delegator),
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatUint(amount, 10)),
+		))
+}
+
+// ApplyDelegations adds the weight of the stake delegated to each participant on top of its PoC weight,
+// see calculations.WeightWithDelegation, and records the delegations of the epoch for its settlement.
+// Weights are modified in place.
+func (k Keeper) ApplyDelegations(ctx context.Context, epochIndex uint64, participants []*types.ActiveParticipant) []*types.ActiveParticipant {
+	policy := k.GetDelegationPolicy(ctx)
+	if !policy.Enabled() {
+		return participants
+	}
+	params := delegationWeightParams(policy)
+
+	for _, participant := range participants {
+		address, err := sdk.AccAddressFromBech32(participant.Index)
+		if err != nil {
+			k.LogError("Could not parse participant address, skipping delegations", types.Tokenomics, "address", participant.Index, "error", err)
+			continue
+		}
+		delegations := k.GetParticipantDelegations(ctx, address)
+		stake := delegations.Total()
+		if stake == 0 {
+			continue
+		}
+
+		pocWeight := participant.Weight
+		weight := calculations.WeightWithDelegation(pocWeight, int64(stake), params)
+		if weight <= pocWeight {
+			continue
+		}
+		record := types.EpochDelegation{
+			EpochIndex:         epochIndex,
+			Participant:        participant.Index,
+			PocWeight:          pocWeight,
+			Weight:             weight,
+			CommissionPermille: policy.CommissionPermille,
+			Delegations:        delegations.Delegations,
+		}
+		if err := k.SetEpochDelegation(ctx, record); err != nil {
+			k.LogError("Failed to record epoch delegation, skipping delegations", types.Tokenomics, "participant", participant.Index, "error", err)
+			continue
+		}
+		participant.Weight = weight
+		k.LogInfo("Applied delegated stake to weight", types.Tokenomics,
+			"participant", participant.Index, "epochIndex", epochIndex, "stake", stake, "pocWeight", pocWeight, "weight", weight)
+	}
+	return participants
+}
+
+// SetEpochDelegation records the delegations of a participant for an epoch
+func (k Keeper) SetEpochDelegation(ctx context.Context, delegation types.EpochDelegation) error {
+	participant, err := sdk.AccAddressFromBech32(delegation.Participant)
+	if err != nil {
+		return err
+	}
+	return k.EpochDelegations.Set(ctx, collections.Join(delegation.EpochIndex, participant), delegation)
+}
+
+// GetEpochDelegations returns the delegations recorded for the participants of an epoch, keyed by participant address
+func (k Keeper) GetEpochDelegations(ctx context.Context, epochIndex uint64) map[string]types.EpochDelegation {
+	delegations := make(map[string]types.EpochDelegation)
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](epochIndex)
+	iter, err := k.EpochDelegations.Iterate(ctx, rng)
+	if err != nil {
+		k.LogError("Failed to iterate epoch delegations", types.Settle, "epochIndex", epochIndex, "error", err)
+		return delegations
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delegation, err := iter.Value()
+		if err != nil {
+			k.LogError("Failed to read epoch delegation", types.Settle, "epochIndex", epochIndex, "error", err)
+			continue
+		}
+		delegations[delegation.Participant] = delegation
+	}
+	return delegations
+}
+
+// GetAllEpochDelegations returns the delegations recorded for the epochs not settled yet
+func (k Keeper) GetAllEpochDelegations(ctx context.Context) ([]types.EpochDelegation, error) {
+	iter, err := k.EpochDelegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// RemoveEpochDelegations removes the delegations recorded for an epoch once it is settled
+func (k Keeper) RemoveEpochDelegations(ctx context.Context, epochIndex uint64) error {
+	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](epochIndex)
+	return k.EpochDelegations.Clear(ctx, rng)
+}
+
+// ApplyDelegationRewardWeights returns copies of the settlement inputs with the confirmation and ML node weights
+// of every participant with delegated stake scaled by the weight the stake added, so the reward of the epoch is
+// split by the same weights as the epoch group. Full weights already include the stake.
+func ApplyDelegationRewardWeights(
+	epochGroupData *types.EpochGroupData,
+	participantMLNodes map[string][]*types.MLNodeInfo,
+	delegations map[string]types.EpochDelegation,
+) (*types.EpochGroupData, map[string][]*types.MLNodeInfo) {
+	scale := func(participant string, weight int64) int64 {
+		d, ok := delegations[participant]
+		if !ok || weight <= 0 || d.PocWeight <= 0 || d.Weight <= d.PocWeight {
+			return weight
+		}
+		return decimal.NewFromInt(weight).Mul(decimal.NewFromInt(d.Weight)).Div(decimal.NewFromInt(d.PocWeight)).IntPart()
+	}
+
+	weighted := *epochGroupData
+	weighted.ValidationWeights = make([]*types.ValidationWeight, 0, len(epochGroupData.ValidationWeights))
+	for _, vw := range epochGroupData.ValidationWeights {
+		scaled := *vw
+		scaled.ConfirmationWeight = scale(vw.MemberAddress, vw.ConfirmationWeight)
+		scaled.MlNodes = scaleMLNodes(vw.MlNodes, func(w int64) int64 { return scale(vw.MemberAddress, w) })
+		weighted.ValidationWeights = append(weighted.ValidationWeights, &scaled)
+	}
+
+	weightedMLNodes := make(map[string][]*types.MLNodeInfo, len(participantMLNodes))
+	for participant, nodes := range participantMLNodes {
+		weightedMLNodes[participant] = scaleMLNodes(nodes, func(w int64) int64 { return scale(participant, w) })
+	}
+	return &weighted, weightedMLNodes
+}
+
+// payDelegatorRewards pays the delegators of a participant their share of its reward for the epoch, see
+// calculations.SplitDelegatorRewards, and returns the reward left to the participant
+func (k Keeper) payDelegatorRewards(ctx context.Context, epochIndex uint64, participant string, delegation types.EpochDelegation, reward uint64) uint64 {
+	delegations := make([]calculations.Delegation, 0, len(delegation.Delegations))
+	for _, d := range delegation.Delegations {
+		delegations = append(delegations, calculations.Delegation{Delegator: d.Delegator, Amount: int64(d.Amount)})
+	}
+	commission := decimal.New(int64(delegation.CommissionPermille), -3)
+	remaining, rewards := calculations.SplitDelegatorRewards(int64(reward), delegation.PocWeight, delegation.Weight, commission, delegations)
+
+	var paid uint64
+	for _, r := range rewards {
+		address, err := sdk.AccAddressFromBech32(r.Delegator)
+		if err != nil {
+			k.LogError("Invalid delegator address, reward stays with participant", types.Settle, "delegator", r.Delegator, "error", err)
+			continue
+		}
+		coins, err := types.GetCoins(r.Amount)
+		if err != nil {
+			k.LogError("Invalid delegator reward, reward stays with participant", types.Settle, "delegator", r.Delegator, "error", err)
+			continue
+		}
+		memo := fmt.Sprintf("delegator_reward:epoch=%d:participant=%s", epochIndex, participant)
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins, memo); err != nil {
+			k.LogError("Failed to pay delegator reward, reward stays with participant", types.Settle, "delegator", r.Delegator, "error", err)
+			continue
+		}
+		paid += uint64(r.Amount)
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDelegatorRewardPaid,
+				sdk.NewAttribute(types.AttributeKeyDelegator, r.Delegator),
+				sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+				sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatInt(r.Amount, 10)),
+			))
+	}
+	k.LogInfo("Paid delegator rewards", types.Settle, "participant", participant, "epochIndex", epochIndex,
+		"reward", reward, "delegatorRewards", paid, "participantShare", remaining)
+	return reward - paid
+}
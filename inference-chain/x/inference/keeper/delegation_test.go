@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDelegationPolicy(t *testing.T) {
+	k, _, ctx := setupMsgServer(t)
+
+	require.Equal(t, types.DefaultDelegationPolicy(), k.GetDelegationPolicy(ctx))
+	require.False(t, k.GetDelegationPolicy(ctx).Enabled())
+
+	policy := types.DelegationPolicy{StakePerWeight: 1000, MaxStakeBoostPermille: 500, CommissionPermille: 100}
+	require.NoError(t, k.SetDelegationPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetDelegationPolicy(ctx))
+	require.True(t, policy.Enabled())
+
+	require.Error(t, k.SetDelegationPolicy(ctx, types.DelegationPolicy{StakePerWeight: 1000, MaxStakeBoostPermille: 500, CommissionPermille: 1001}))
+}
+
+func TestMsgServer_DelegateToParticipant(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+
+	delegator := sample.AccAddress()
+	participant := sample.AccAddress()
+	participantAddress := sdk.MustAccAddressFromBech32(participant)
+	delegate := func(amount uint64) error {
+		_, err := ms.DelegateToParticipant(ctx, &types.MsgDelegateToParticipant{Delegator: delegator, Participant: participant, Amount: amount})
+		return err
+	}
+	undelegate := func(amount uint64) (uint64, error) {
+		resp, err := ms.Undelegate(ctx, &types.MsgUndelegate{Delegator: delegator, Participant: participant, Amount: amount})
+		if err != nil {
+			return 0, err
+		}
+		return resp.ReleaseEpoch, nil
+	}
+
+	require.ErrorIs(t, delegate(1000), types.ErrDelegationDisabled)
+
+	require.NoError(t, k.SetDelegationPolicy(ctx, types.DelegationPolicy{StakePerWeight: 1000, MaxStakeBoostPermille: 500}))
+	require.ErrorIs(t, delegate(1000), types.ErrParticipantNotFound)
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: participant, Address: participant}))
+
+	delegatorAddress := sdk.MustAccAddressFromBech32(delegator)
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(ctx, delegatorAddress, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1000)), gomock.Any()).Return(nil)
+	require.NoError(t, delegate(1000))
+	require.Equal(t, []*types.Delegation{{Delegator: delegator, Amount: 1000}}, k.GetParticipantDelegations(ctx, participantAddress).Delegations)
+	requireEvent(t, ctx, types.EventTypeStakeDelegated, types.AttributeKeyDelegator, delegator)
+	// The delegation did not register the delegator as a participant
+	_, found := k.GetParticipant(ctx, delegator)
+	require.False(t, found)
+
+	resp, err := k.ListParticipantDelegations(ctx, &types.QueryParticipantDelegationsRequest{Participant: participant})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000), resp.Total)
+
+	_, err = undelegate(1001)
+	require.ErrorIs(t, err, types.ErrInsufficientDelegation)
+
+	// Stake that weighted no unsettled epoch is returned right away
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 400)), gomock.Any()).Return(nil)
+	releaseEpoch, err := undelegate(400)
+	require.NoError(t, err)
+	require.Zero(t, releaseEpoch)
+	require.Equal(t, uint64(600), k.GetParticipantDelegations(ctx, participantAddress).Total())
+
+	// Stake that weighted epochs 4 and 5 is held until epoch 5 is settled
+	for _, epoch := range []uint64{4, 5} {
+		require.NoError(t, k.SetEpochDelegation(ctx, types.EpochDelegation{
+			EpochIndex: epoch, Participant: participant, PocWeight: 100, Weight: 100,
+			Delegations: []*types.Delegation{{Delegator: delegator, Amount: 600}},
+		}))
+	}
+	releaseEpoch, err = undelegate(600)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), releaseEpoch)
+	require.Empty(t, k.GetParticipantDelegations(ctx, participantAddress).Delegations)
+	requireEvent(t, ctx, types.EventTypeStakeUndelegated, types.AttributeKeyDelegator, delegator)
+
+	unbondings, err := k.ListDelegationUnbondings(ctx, &types.QueryDelegationUnbondingsRequest{Delegator: delegator})
+	require.NoError(t, err)
+	require.Equal(t, []types.DelegationUnbonding{{Delegator: delegator, Participant: participant, Amount: 600, ReleaseEpoch: 5}}, unbondings.Unbondings)
+
+	require.NoError(t, k.ReleaseDelegationUnbondings(ctx, 4))
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegatorAddress, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 600)), gomock.Any()).Return(nil)
+	require.NoError(t, k.ReleaseDelegationUnbondings(ctx, 5))
+	unbondings, err = k.ListDelegationUnbondings(ctx, &types.QueryDelegationUnbondingsRequest{Delegator: delegator})
+	require.NoError(t, err)
+	require.Empty(t, unbondings.Unbondings)
+}
+
+func TestParticipantDelegations_Add(t *testing.T) {
+	a, b, c := "a", "b", "c"
+	var d types.ParticipantDelegations
+	d.Add(c, 3)
+	d.Add(a, 1)
+	d.Add(b, 2)
+	d.Add(a, 4)
+	require.Equal(t, []*types.Delegation{{Delegator: a, Amount: 5}, {Delegator: b, Amount: 2}, {Delegator: c, Amount: 3}}, d.Delegations)
+	require.Equal(t, uint64(10), d.Total())
+
+	d.Add(b, -2)
+	d.Add(c, -1)
+	require.Equal(t, []*types.Delegation{{Delegator: a, Amount: 5}, {Delegator: c, Amount: 2}}, d.Delegations)
+	require.Zero(t, d.Amount(b))
+}
+
+func TestApplyDelegations(t *testing.T) {
+	k, _, ctx := setupMsgServer(t)
+
+	backed := sample.AccAddress()
+	unbacked := sample.AccAddress()
+	delegator := sample.AccAddress()
+	participants := func() []*types.ActiveParticipant {
+		return []*types.ActiveParticipant{{Index: backed, Weight: 100}, {Index: unbacked, Weight: 100}}
+	}
+	delegations := types.ParticipantDelegations{Participant: backed, Delegations: []*types.Delegation{{Delegator: delegator, Amount: 30_000}}}
+	require.NoError(t, k.SetParticipantDelegations(ctx, delegations))
+	require.Equal(t, delegations, k.GetParticipantDelegations(ctx, sdk.MustAccAddressFromBech32(backed)))
+
+	// Without a policy stake adds no weight
+	result := k.ApplyDelegations(ctx, 5, participants())
+	require.Equal(t, int64(100), result[0].Weight)
+	require.Empty(t, k.GetEpochDelegations(ctx, 5))
+
+	// 30000 stake adds 30 weight, under the 50% cap
+	require.NoError(t, k.SetDelegationPolicy(ctx, types.DelegationPolicy{StakePerWeight: 1000, MaxStakeBoostPermille: 500, CommissionPermille: 100}))
+	result = k.ApplyDelegations(ctx, 5, participants())
+	require.Equal(t, int64(130), result[0].Weight)
+	require.Equal(t, int64(100), result[1].Weight)
+	require.Equal(t, map[string]types.EpochDelegation{
+		backed: {EpochIndex: 5, Participant: backed, PocWeight: 100, Weight: 130, CommissionPermille: 100, Delegations: delegations.Delegations},
+	}, k.GetEpochDelegations(ctx, 5))
+
+	require.NoError(t, k.RemoveEpochDelegations(ctx, 5))
+	require.Empty(t, k.GetEpochDelegations(ctx, 5))
+}
+
+func TestApplyDelegationRewardWeights(t *testing.T) {
+	backed := sample.AccAddress()
+	unbacked := sample.AccAddress()
+	data := &types.EpochGroupData{ValidationWeights: []*types.ValidationWeight{
+		{MemberAddress: backed, Weight: 130, ConfirmationWeight: 40, MlNodes: []*types.MLNodeInfo{{NodeId: "n1", PocWeight: 60}}},
+		{MemberAddress: unbacked, Weight: 100, ConfirmationWeight: 40, MlNodes: []*types.MLNodeInfo{{NodeId: "n2", PocWeight: 60}}},
+	}}
+	mlNodes := map[string][]*types.MLNodeInfo{backed: {{NodeId: "n1", PocWeight: 60}}}
+	delegations := map[string]types.EpochDelegation{backed: {PocWeight: 100, Weight: 130}}
+
+	weighted, weightedMLNodes := keeper.ApplyDelegationRewardWeights(data, mlNodes, delegations)
+	require.Equal(t, int64(130), weighted.ValidationWeights[0].Weight)
+	require.Equal(t, int64(52), weighted.ValidationWeights[0].ConfirmationWeight)
+	require.Equal(t, int64(78), weighted.ValidationWeights[0].MlNodes[0].PocWeight)
+	require.Equal(t, int64(40), weighted.ValidationWeights[1].ConfirmationWeight)
+	require.Equal(t, int64(78), weightedMLNodes[backed][0].PocWeight)
+	// The inputs are left untouched
+	require.Equal(t, int64(40), data.ValidationWeights[0].ConfirmationWeight)
+	require.Equal(t, int64(60), mlNodes[backed][0].PocWeight)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/calculations"
@@ -119,6 +120,7 @@ func (k *Keeper) UpdateDynamicPricing(ctx context.Context) error {
 		totalModelsProcessed++
 		if newPrice != oldPrice {
 			totalPriceChanges++
+			k.EmitModelPriceUpdatedEvent(ctx, modelId, oldPrice, newPrice, utilization.String(), types.PriceSourceDynamic)
 		}
 
 		k.LogInfo("Updated model price", types.Pricing,
@@ -242,12 +244,12 @@ func (k *Keeper) handleGracePeriod(ctx context.Context, currentEpoch *types.Epoc
 	if currentEpoch.Index < dpParams.GracePeriodEndEpoch {
 		// Grace period is still active - use configurable grace period price
 		targetPrice = dpParams.GracePeriodPerTokenPrice
-		priceType = "grace"
+		priceType = types.PriceSourceGrace
 		actionDesc = "Grace period active - setting all model prices to grace period price"
 	} else {
 		// Grace period is ending - use base price
 		targetPrice = dpParams.BasePerTokenPrice
-		priceType = "base"
+		priceType = types.PriceSourceBase
 		actionDesc = "Grace period ending - initializing base pricing for all models"
 	}
 
@@ -257,17 +259,36 @@ func (k *Keeper) handleGracePeriod(ctx context.Context, currentEpoch *types.Epoc
 
 	// Set target price for all models
 	for _, modelId := range subGroupModels {
+		oldPrice, priceErr := k.GetModelCurrentPrice(ctx, modelId)
 		err := k.SetModelCurrentPrice(ctx, modelId, targetPrice)
 		if err != nil {
 			k.LogError("Failed to set price for model during grace period", types.Pricing,
 				"modelId", modelId, "priceType", priceType, "targetPrice", targetPrice, "error", err)
 			continue
 		}
+		if priceErr != nil || oldPrice != targetPrice {
+			k.EmitModelPriceUpdatedEvent(ctx, modelId, oldPrice, targetPrice, "", priceType)
+		}
 		k.LogInfo("Set grace period price", types.Pricing,
 			"modelId", modelId, "priceType", priceType, "price", targetPrice)
 	}
 }
 
+// EmitModelPriceUpdatedEvent reports a change of a model's per-token price, so clients can follow
+// prices without polling the price queries every block.
+// utilization is empty for prices set by the grace period rather than by utilization.
+func (k *Keeper) EmitModelPriceUpdatedEvent(ctx context.Context, modelId string, oldPrice uint64, newPrice uint64, utilization string, source string) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeModelPriceUpdated,
+			sdk.NewAttribute(types.AttributeKeyModelId, modelId),
+			sdk.NewAttribute(types.AttributeKeyOldPrice, strconv.FormatUint(oldPrice, 10)),
+			sdk.NewAttribute(types.AttributeKeyNewPrice, strconv.FormatUint(newPrice, 10)),
+			sdk.NewAttribute(types.AttributeKeyUtilization, utilization),
+			sdk.NewAttribute(types.AttributeKeyPriceSource, source),
+		))
+}
+
 // RecordInferencePrice locks in the current price for an inference
 // Called only on the first message (Start or Finish) to ensure consistent pricing
 // BeginBlocker must have set prices before this is called
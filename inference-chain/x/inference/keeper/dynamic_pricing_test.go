@@ -500,6 +500,27 @@ func TestDynamicPricingCoreWorkflow(t *testing.T) {
 	})
 }
 
+func TestEmitModelPriceUpdatedEvent(t *testing.T) {
+	k, ctx := setupTestKeeperWithDynamicPricing(t)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	k.EmitModelPriceUpdatedEvent(ctx, "Qwen2.5-7B-Instruct", 1000, 1010, "0.8", types.PriceSourceDynamic)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeModelPriceUpdated, events[0].Type)
+
+	attributes := make(map[string]string)
+	for _, attr := range events[0].Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	assert.Equal(t, "Qwen2.5-7B-Instruct", attributes[types.AttributeKeyModelId])
+	assert.Equal(t, "1000", attributes[types.AttributeKeyOldPrice])
+	assert.Equal(t, "1010", attributes[types.AttributeKeyNewPrice])
+	assert.Equal(t, "0.8", attributes[types.AttributeKeyUtilization])
+	assert.Equal(t, types.PriceSourceDynamic, attributes[types.AttributeKeyPriceSource])
+}
+
 // TestDynamicPricingWithRealStats tests the complete pipeline:
 // SetInference -> Stats Recording -> GetSummaryByModelAndTime -> Utilization -> Price Adjustment
 func TestDynamicPricingWithRealStats(t *testing.T) {
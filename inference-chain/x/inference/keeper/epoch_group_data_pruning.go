@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const (
+	// MinEpochGroupDataRetentionEpochs keeps epoch group data long enough for validations and claims
+	MinEpochGroupDataRetentionEpochs = uint64(5)
+	// EpochGroupDataPruningMax bounds the epoch group data removed per block; an epoch has one entry per model plus the parent
+	EpochGroupDataPruningMax = int64(100)
+)
+
+// SetEpochGroupDataRetentionEpochs selects how many epochs epoch group data is kept before pruning, which turns
+// the pruning on. Set by governance through MsgUpdatePolicy.
+func (k Keeper) SetEpochGroupDataRetentionEpochs(ctx context.Context, epochs uint64) error {
+	if epochs < MinEpochGroupDataRetentionEpochs {
+		return fmt.Errorf("epoch group data retention must be at least %d epochs, got %d", MinEpochGroupDataRetentionEpochs, epochs)
+	}
+	return k.EpochGroupDataRetentionEpochs.Set(ctx, epochs)
+}
+
+// GetEpochGroupDataRetentionEpochs returns the selected retention, never less than the inference
+// pruning threshold plus the claim window, since claims and validations read epoch group data.
+// It returns false until governance selects a retention: epoch group data is kept by default.
+func (k Keeper) GetEpochGroupDataRetentionEpochs(ctx context.Context, params types.Params) (uint64, bool) {
+	retention, err := k.EpochGroupDataRetentionEpochs.Get(ctx)
+	if err != nil {
+		return 0, false
+	}
+	if params.EpochParams != nil {
+		retention = max(retention, params.EpochParams.InferencePruningEpochThreshold+SettleCarryoverEpochs+1)
+	}
+	return max(retention, MinEpochGroupDataRetentionEpochs), true
+}
+
+// PrunedRecordExporter receives records right before pruning removes them from state, so archive
+// nodes can keep them. It runs in EndBlocker: it must not affect state, errors are the exporter's
+// own business, and a record may be exported again when blocks are replayed.
+type PrunedRecordExporter interface {
+	ExportInference(ctx context.Context, inference types.Inference)
+	ExportEpochGroupData(ctx context.Context, data types.EpochGroupData)
+}
+
+type prunedRecordExport struct {
+	exporter PrunedRecordExporter
+}
+
+// SetPrunedRecordExporter sets the exporter for pruned records; nil disables exporting
+func (k Keeper) SetPrunedRecordExporter(exporter PrunedRecordExporter) {
+	k.prunedRecordExport.exporter = exporter
+}
+
+func (k Keeper) exportPrunedInference(ctx context.Context, inference types.Inference) {
+	if k.prunedRecordExport != nil && k.prunedRecordExport.exporter != nil {
+		k.prunedRecordExport.exporter.ExportInference(ctx, inference)
+	}
+}
+
+func (k Keeper) exportPrunedEpochGroupData(ctx context.Context, data types.EpochGroupData) {
+	if k.prunedRecordExport != nil && k.prunedRecordExport.exporter != nil {
+		k.prunedRecordExport.exporter.ExportEpochGroupData(ctx, data)
+	}
+}
+
+// FilePrunedRecordExporter appends pruned records as JSON lines to inferences.jsonl and
+// epoch_group_data.jsonl in a directory
+type FilePrunedRecordExporter struct {
+	dir    string
+	logger types.InferenceLogger
+	mu     sync.Mutex
+}
+
+func NewFilePrunedRecordExporter(dir string, logger types.InferenceLogger) (*FilePrunedRecordExporter, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FilePrunedRecordExporter{dir: dir, logger: logger}, nil
+}
+
+type prunedRecord struct {
+	BlockHeight int64 `json:"block_height"`
+	Record      any   `json:"record"`
+}
+
+func (e *FilePrunedRecordExporter) ExportInference(ctx context.Context, inference types.Inference) {
+	e.append(ctx, "inferences.jsonl", inference)
+}
+
+func (e *FilePrunedRecordExporter) ExportEpochGroupData(ctx context.Context, data types.EpochGroupData) {
+	e.append(ctx, "epoch_group_data.jsonl", data)
+}
+
+func (e *FilePrunedRecordExporter) append(ctx context.Context, fileName string, record any) {
+	line, err := json.Marshal(prunedRecord{BlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(), Record: record})
+	if err != nil {
+		e.logger.LogError("Failed to encode pruned record", types.Pruning, "file", fileName, "error", err)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	f, err := os.OpenFile(filepath.Join(e.dir, fileName), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		e.logger.LogError("Failed to open pruned record export", types.Pruning, "file", fileName, "error", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		e.logger.LogError("Failed to export pruned record", types.Pruning, "file", fileName, "error", err)
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RecordEpochParams keeps the current epoch params as the ones the epoch runs on. It is called when the
+// epoch starts (its PoC stage begins): epoch params changed later through governance take effect only at
+// the next epoch, so phase math of an epoch in flight never changes. Records older than the epoch group
+// data retention are pruned; while epoch group data is kept, so are the params its epochs started with.
+func (k Keeper) RecordEpochParams(ctx context.Context, epochIndex uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.EpochParams == nil {
+		return types.ErrEpochParamsNotFound
+	}
+	if err := k.EpochParamsHistory.Set(ctx, epochIndex, *params.EpochParams); err != nil {
+		return err
+	}
+
+	retention, enabled := k.GetEpochGroupDataRetentionEpochs(ctx, params)
+	if !enabled || epochIndex <= retention {
+		return nil
+	}
+	return k.EpochParamsHistory.Clear(ctx, new(collections.Range[uint64]).EndExclusive(epochIndex-retention))
+}
+
+// GetEpochParamsForEpoch returns the epoch params the epoch started with. Epochs that have not started yet,
+// or started before epoch params were recorded, use the current params.
+func (k Keeper) GetEpochParamsForEpoch(ctx context.Context, epochIndex uint64) (*types.EpochParams, error) {
+	epochParams, err := k.EpochParamsHistory.Get(ctx, epochIndex)
+	if err == nil {
+		return &epochParams, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.EpochParams == nil {
+		return nil, types.ErrEpochParamsNotFound
+	}
+	return params.EpochParams, nil
+}
+
+// GetEpochContext returns the context of the epoch, computed from the epoch params it started with
+func (k Keeper) GetEpochContext(ctx context.Context, epoch types.Epoch) (types.EpochContext, error) {
+	epochParams, err := k.GetEpochParamsForEpoch(ctx, epoch.Index)
+	if err != nil {
+		return types.EpochContext{}, err
+	}
+	return types.NewEpochContext(epoch, *epochParams), nil
+}
+
+// GetEffectiveEpochContext is types.NewEpochContextFromEffectiveEpoch with the epoch params each epoch started with
+func (k Keeper) GetEffectiveEpochContext(ctx context.Context, effectiveEpoch types.Epoch, blockHeight int64) (*types.EpochContext, error) {
+	epochParams, err := k.GetEpochParamsForEpoch(ctx, effectiveEpoch.Index)
+	if err != nil {
+		return nil, err
+	}
+	nextEpochParams, err := k.GetEpochParamsForEpoch(ctx, effectiveEpoch.Index+1)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewEpochContextFromEffectiveEpochParams(effectiveEpoch, *epochParams, *nextEpochParams, blockHeight)
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestEpochParamsHistory(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	original := *params.EpochParams
+	require.NoError(t, k.RecordEpochParams(ctx, 5))
+
+	// Governance changes the epoch length while epoch 5 is in flight
+	params.EpochParams.EpochLength = original.EpochLength * 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	epochParams, err := k.GetEpochParamsForEpoch(ctx, 5)
+	require.NoError(t, err)
+	require.Equal(t, original, *epochParams)
+
+	epoch := types.Epoch{Index: 5, PocStartBlockHeight: 1000}
+	ec, err := k.GetEpochContext(ctx, epoch)
+	require.NoError(t, err)
+	require.Equal(t, original.EpochLength, ec.EpochParams.EpochLength)
+
+	// Epochs that have not started yet use the current params
+	epochParams, err = k.GetEpochParamsForEpoch(ctx, 6)
+	require.NoError(t, err)
+	require.Equal(t, original.EpochLength*2, epochParams.EpochLength)
+
+	// Without epoch group data pruning every record is kept
+	_, enabled := k.GetEpochGroupDataRetentionEpochs(ctx, params)
+	require.False(t, enabled)
+	require.NoError(t, k.RecordEpochParams(ctx, 1000))
+	has, err := k.EpochParamsHistory.Has(ctx, 5)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	// Records older than the epoch group data retention are pruned
+	require.NoError(t, k.SetEpochGroupDataRetentionEpochs(ctx, 10))
+	retention, enabled := k.GetEpochGroupDataRetentionEpochs(ctx, params)
+	require.True(t, enabled)
+	require.NoError(t, k.RecordEpochParams(ctx, 5+retention+1))
+	has, err = k.EpochParamsHistory.Has(ctx, 5)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestEpochParamsHistoryWithGenesisOnlyParams(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	genesisOnlyParams := types.DefaultGenesisOnlyParams()
+	require.NoError(t, k.SetGenesisOnlyParams(ctx, &genesisOnlyParams))
+	require.NoError(t, k.RecordEpochParams(ctx, 5))
+
+	// Legacy string keys in the same store are neither walked nor cleared with the history
+	iter, err := k.EpochParamsHistory.Iterate(ctx, nil)
+	require.NoError(t, err)
+	epochs, err := iter.Keys()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5}, epochs)
+
+	require.NoError(t, k.EpochParamsHistory.Clear(ctx, nil))
+	_, found := k.GetGenesisOnlyParams(ctx)
+	require.True(t, found)
+}
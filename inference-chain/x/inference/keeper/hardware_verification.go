@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetHardwareVerificationRequired toggles the check that ML nodes have a signed attestation of enough VRAM
+// for the models they serve before they are counted in model assignment. Governance toggles it with MsgUpdatePolicy.
+func (k Keeper) SetHardwareVerificationRequired(ctx context.Context, required bool) error {
+	return k.HardwareVerificationRequired.Set(ctx, required)
+}
+
+// IsHardwareVerificationRequired returns false unless governance enabled the check
+func (k Keeper) IsHardwareVerificationRequired(ctx context.Context) bool {
+	required, err := k.HardwareVerificationRequired.Get(ctx)
+	if err != nil {
+		return false
+	}
+	return required
+}
+
+// VerifyHardwareAttestation checks the attestation relayed with a hardware node of the participant against
+// the participant's worker key. The second return value is false when the node relays no attestation.
+func (k Keeper) VerifyHardwareAttestation(ctx context.Context, participant string, node *types.HardwareNode) (types.HardwareAttestation, bool, error) {
+	p, found := k.GetParticipant(ctx, participant)
+	if !found {
+		return types.HardwareAttestation{}, false, types.ErrParticipantNotFound
+	}
+	return types.VerifyNodeAttestation(node, participant, p.WorkerPublicKey)
+}
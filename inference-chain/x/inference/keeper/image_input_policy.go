@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetImageInputPolicy sets the accounting of image inputs of multimodal requests.
+// Governance changes it with MsgUpdatePolicy.
+func (k Keeper) SetImageInputPolicy(ctx context.Context, policy types.ImageInputPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.ImageInputPolicy.Set(ctx, bz)
+}
+
+// GetImageInputPolicy returns the active image input policy,
+// or types.DefaultImageInputPolicy if none was set.
+func (k Keeper) GetImageInputPolicy(ctx context.Context) types.ImageInputPolicy {
+	bz, err := k.ImageInputPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultImageInputPolicy()
+	}
+	var policy types.ImageInputPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode image input policy, using default", types.Inferences, "error", err)
+		return types.DefaultImageInputPolicy()
+	}
+	return policy
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestImageInputPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultImageInputPolicy(), k.GetImageInputPolicy(ctx))
+
+	policy := types.ImageInputPolicy{MaxImagesPerRequest: 4, TokensPerImage: 1000, LowDetailTokensPerImage: 80}
+	require.NoError(t, k.SetImageInputPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetImageInputPolicy(ctx))
+
+	require.Error(t, k.SetImageInputPolicy(ctx, types.ImageInputPolicy{MaxImagesPerRequest: 4, TokensPerImage: 0}))
+	require.Error(t, k.SetImageInputPolicy(ctx, types.ImageInputPolicy{MaxImagesPerRequest: 4, TokensPerImage: 100, LowDetailTokensPerImage: 200}))
+	require.Error(t, k.SetImageInputPolicy(ctx, types.ImageInputPolicy{MaxImagesPerRequest: 1000, TokensPerImage: 10_000}))
+	require.Equal(t, policy, k.GetImageInputPolicy(ctx))
+
+	// Governance can turn image inputs off entirely
+	require.NoError(t, k.SetImageInputPolicy(ctx, types.ImageInputPolicy{}))
+	require.Zero(t, k.GetImageInputPolicy(ctx).MaxImagesPerRequest)
+
+	require.Equal(t, uint64(80), policy.ImageTokens(types.ImageDetailLow))
+	require.Equal(t, uint64(1000), policy.ImageTokens("high"))
+	require.Equal(t, uint64(1000), policy.ImageTokens(""))
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// GetInferenceEscrow returns the escrow of an inference; not found for inferences started before escrows were tracked
+func (k Keeper) GetInferenceEscrow(ctx context.Context, inferenceId string) (types.InferenceEscrow, bool) {
+	escrow, err := k.InferenceEscrows.Get(ctx, inferenceId)
+	if err != nil {
+		return types.InferenceEscrow{}, false
+	}
+	return escrow, true
+}
+
+// SetInferenceEscrow stores the escrow of an inference
+func (k Keeper) SetInferenceEscrow(ctx context.Context, escrow types.InferenceEscrow) error {
+	return k.InferenceEscrows.Set(ctx, escrow.InferenceId, escrow)
+}
+
+// GetAllInferenceEscrows returns the escrows of all tracked inferences
+func (k Keeper) GetAllInferenceEscrows(ctx context.Context) ([]types.InferenceEscrow, error) {
+	it, err := k.InferenceEscrows.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+// SetReleasableEscrow schedules the escrow of an inference for release when epochId settles
+func (k Keeper) SetReleasableEscrow(ctx context.Context, releasable types.ReleasableEscrow) error {
+	return k.ReleasableEscrows.Set(ctx, collections.Join(releasable.EpochId, releasable.InferenceId))
+}
+
+// GetAllReleasableEscrows returns the escrows scheduled for release, by epoch
+func (k Keeper) GetAllReleasableEscrows(ctx context.Context) ([]types.ReleasableEscrow, error) {
+	it, err := k.ReleasableEscrows.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := it.Keys()
+	if err != nil {
+		return nil, err
+	}
+	releasable := make([]types.ReleasableEscrow, 0, len(keys))
+	for _, key := range keys {
+		releasable = append(releasable, types.ReleasableEscrow{EpochId: key.K1(), InferenceId: key.K2()})
+	}
+	return releasable, nil
+}
+
+// lockInferenceEscrow records funds put in escrow for an inference, opening its escrow on the first lock
+func (k Keeper) lockInferenceEscrow(ctx context.Context, inference *types.Inference, amount int64) error {
+	escrow, found := k.GetInferenceEscrow(ctx, inference.InferenceId)
+	if !found {
+		escrow = types.InferenceEscrow{
+			InferenceId:    inference.InferenceId,
+			Requester:      inference.RequestedBy,
+			Status:         types.EscrowLocked,
+			LockedAtHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		}
+	}
+	escrow.Locked += amount
+	return k.SetInferenceEscrow(ctx, escrow)
+}
+
+// updateLockedEscrow applies update to the escrow of an inference while it is locked. Escrows that are
+// not tracked or already settled are left alone.
+func (k Keeper) updateLockedEscrow(ctx context.Context, inferenceId string, update func(*types.InferenceEscrow)) error {
+	escrow, found := k.GetInferenceEscrow(ctx, inferenceId)
+	if !found || escrow.Status != types.EscrowLocked {
+		return nil
+	}
+	update(&escrow)
+	return k.SetInferenceEscrow(ctx, escrow)
+}
+
+// markEscrowReleasable schedules the escrow of a completed inference for release when its epoch settles
+func (k Keeper) markEscrowReleasable(ctx context.Context, inference *types.Inference) error {
+	escrow, found := k.GetInferenceEscrow(ctx, inference.InferenceId)
+	if !found || escrow.Status != types.EscrowLocked {
+		return nil
+	}
+	escrow.EpochId = inference.EpochId
+	if err := k.SetInferenceEscrow(ctx, escrow); err != nil {
+		return err
+	}
+	return k.ReleasableEscrows.Set(ctx, collections.Join(inference.EpochId, inference.InferenceId))
+}
+
+// RefundInferenceEscrow settles the escrow of an invalidated or expired inference after refundAmount
+// was refunded to the requester
+func (k Keeper) RefundInferenceEscrow(ctx context.Context, inferenceId string, refundAmount int64) error {
+	escrow, found := k.GetInferenceEscrow(ctx, inferenceId)
+	if !found || escrow.Status != types.EscrowLocked {
+		return nil
+	}
+	if err := k.ReleasableEscrows.Remove(ctx, collections.Join(escrow.EpochId, inferenceId)); err != nil {
+		return err
+	}
+	escrow.Refunded += refundAmount
+	escrow.Status = types.EscrowRefunded
+	escrow.SettledAtHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.SetInferenceEscrow(ctx, escrow); err != nil {
+		return err
+	}
+	k.LogInfo("Inference escrow refunded", types.Payments, "inferenceId", inferenceId, "requester", escrow.Requester, "amount", refundAmount)
+	k.emitInferenceEscrowEvent(ctx, types.EventTypeInferenceEscrowRefunded, inferenceId, escrow.Requester, refundAmount)
+	return nil
+}
+
+// ReleaseInferenceEscrows releases the escrows of the inferences of epochs up to epochIndex, whose claims
+// were just set. Their validation window is over, so the executors keep what they were credited.
+func (k Keeper) ReleaseInferenceEscrows(ctx context.Context, epochIndex uint64) error {
+	it, err := k.ReleasableEscrows.Iterate(ctx, collections.NewPrefixUntilPairRange[uint64, string](epochIndex))
+	if err != nil {
+		return err
+	}
+	keys, err := it.Keys()
+	if err != nil {
+		return err
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	released := 0
+	for _, key := range keys {
+		if err := k.ReleasableEscrows.Remove(ctx, key); err != nil {
+			return err
+		}
+		escrow, found := k.GetInferenceEscrow(ctx, key.K2())
+		if !found || escrow.Status != types.EscrowLocked {
+			continue
+		}
+		escrow.Status = types.EscrowReleased
+		escrow.SettledAtHeight = blockHeight
+		if err := k.SetInferenceEscrow(ctx, escrow); err != nil {
+			return err
+		}
+		k.emitInferenceEscrowEvent(ctx, types.EventTypeInferenceEscrowReleased, escrow.InferenceId, escrow.Executor, escrow.ExecutorPayment)
+		released++
+	}
+	k.LogInfo("Released inference escrows", types.Payments, "epochIndex", epochIndex, "released", released)
+	return nil
+}
+
+func (k Keeper) emitInferenceEscrowEvent(ctx context.Context, eventType string, inferenceId string, participant string, amount int64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyInferenceId, inferenceId),
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatInt(amount, 10)),
+		))
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestInferenceEscrowLifecycle(t *testing.T) {
+	const epochId = 1
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	ctx, err := advanceEpoch(ctx, &k, inferenceHelper.Mocks, 10, epochId)
+	require.NoError(t, err)
+	model := types.Model{Id: "model1"}
+	k.SetModel(ctx, &model)
+
+	started, err := inferenceHelper.StartInference("promptPayload", model.Id, ctx.BlockTime().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+
+	escrow, found := k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.EscrowLocked, escrow.Status)
+	require.Equal(t, testutil.Requester, escrow.Requester)
+	require.Equal(t, started.EscrowAmount, escrow.Locked)
+
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, &model)
+	finished, err := inferenceHelper.FinishInference()
+	require.NoError(t, err)
+
+	escrow, found = k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.EscrowLocked, escrow.Status)
+	require.Equal(t, testutil.Executor, escrow.Executor)
+	require.Equal(t, finished.ActualCost, escrow.ExecutorPayment)
+	require.Equal(t, finished.ActualCost, escrow.Held())
+	require.Equal(t, uint64(epochId), escrow.EpochId)
+
+	// Releasing an earlier epoch leaves the escrow locked
+	require.NoError(t, k.ReleaseInferenceEscrows(ctx, epochId-1))
+	escrow, _ = k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.Equal(t, types.EscrowLocked, escrow.Status)
+
+	ctx = ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ReleaseInferenceEscrows(ctx, epochId))
+	escrow, _ = k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.Equal(t, types.EscrowReleased, escrow.Status)
+	require.Equal(t, int64(100), escrow.SettledAtHeight)
+	require.Zero(t, escrow.Held())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeInferenceEscrowReleased, ctx.EventManager().Events()[0].Type)
+
+	// A released escrow is not refunded anymore
+	require.NoError(t, k.RefundInferenceEscrow(ctx, started.InferenceId, finished.ActualCost))
+	escrow, _ = k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.Equal(t, types.EscrowReleased, escrow.Status)
+}
+
+func TestInferenceEscrowRefund(t *testing.T) {
+	const epochId = 1
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	ctx, err := advanceEpoch(ctx, &k, inferenceHelper.Mocks, 10, epochId)
+	require.NoError(t, err)
+	model := types.Model{Id: "model1"}
+	k.SetModel(ctx, &model)
+
+	started, err := inferenceHelper.StartInference("promptPayload", model.Id, ctx.BlockTime().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.RefundInferenceEscrow(ctx, started.InferenceId, started.EscrowAmount))
+	escrow, found := k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.EscrowRefunded, escrow.Status)
+	require.Equal(t, started.EscrowAmount, escrow.Refunded)
+	require.Equal(t, int64(50), escrow.SettledAtHeight)
+	require.Zero(t, escrow.Held())
+	require.Equal(t, types.EventTypeInferenceEscrowRefunded, ctx.EventManager().Events()[0].Type)
+
+	// Refunded escrows are never released
+	require.NoError(t, k.ReleaseInferenceEscrows(ctx, epochId))
+	escrow, _ = k.GetInferenceEscrow(ctx, started.InferenceId)
+	require.Equal(t, types.EscrowRefunded, escrow.Status)
+}
@@ -65,3 +65,23 @@ func (k Keeper) GetAllInferenceTimeoutForHeight(ctx context.Context, expirationH
 	}
 	return out
 }
+
+// GetInferenceTimeoutsUpToHeight returns the inferenceTimeouts expiring at or before expirationHeight, oldest first
+func (k Keeper) GetInferenceTimeoutsUpToHeight(ctx context.Context, expirationHeight uint64) (list []types.InferenceTimeout) {
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(expirationHeight+1, ""))
+	it, err := k.InferenceTimeouts.Iterate(ctx, rng)
+	if err != nil {
+		return nil
+	}
+	defer it.Close()
+	var out []types.InferenceTimeout
+	for ; it.Valid(); it.Next() {
+		v, err := it.Value()
+		if err != nil {
+			return nil
+		}
+		out = append(out, v)
+	}
+	return out
+}
@@ -65,3 +65,11 @@ func TestInferenceTimeoutGetAll(t *testing.T) {
 		nullify.Fill(keeper.GetAllInferenceTimeout(ctx)),
 	)
 }
+
+func TestInferenceTimeoutsUpToHeight(t *testing.T) {
+	keeper, ctx := keepertest.InferenceKeeper(t)
+	items := createNInferenceTimeout(keeper, ctx, 10)
+
+	require.Equal(t, nullify.Fill(items[:5]), nullify.Fill(keeper.GetInferenceTimeoutsUpToHeight(ctx, 4)))
+	require.Len(t, keeper.GetInferenceTimeoutsUpToHeight(ctx, 100), 10)
+}
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RegisterInvariants registers the settlement accounting invariants with the crisis module
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "settlement-rewards", SettlementRewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "inference-escrow", InferenceEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the module, stopping at the first broken one
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SettlementRewardsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return InferenceEscrowInvariant(k)(ctx)
+	}
+}
+
+// SettlementRewardsInvariant checks that the reward coins recorded per epoch add up: the subsidy
+// total of each epoch still stored equals the reward coins settled to its participants, and all
+// reward coins ever settled were minted.
+func SettlementRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		it, err := k.ParticipantEarnings.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "settlement-rewards", fmt.Sprintf("iterate participant earnings: %v", err)), true
+		}
+		values, err := it.Values()
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "settlement-rewards", fmt.Sprintf("read participant earnings: %v", err)), true
+		}
+
+		settledPerEpoch := make(map[uint64]uint64)
+		var settledTotal uint64
+		for _, bz := range values {
+			var earnings types.ParticipantEpochEarnings
+			if err := json.Unmarshal(bz, &earnings); err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "settlement-rewards", fmt.Sprintf("decode participant earnings: %v", err)), true
+			}
+			if !earnings.Settled {
+				continue
+			}
+			settledPerEpoch[earnings.EpochIndex] += earnings.RewardCoins
+			settledTotal += earnings.RewardCoins
+		}
+
+		var msg string
+		broken := false
+		subsidies, err := k.EpochSubsidyTotals.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "settlement-rewards", fmt.Sprintf("iterate epoch subsidy totals: %v", err)), true
+		}
+		totals, err := subsidies.KeyValues()
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "settlement-rewards", fmt.Sprintf("read epoch subsidy totals: %v", err)), true
+		}
+		for _, total := range totals {
+			if settled := settledPerEpoch[total.Key]; settled != total.Value {
+				broken = true
+				msg += fmt.Sprintf("\tepoch %d: subsidy total %d, participant reward coins %d\n", total.Key, total.Value, settled)
+			}
+		}
+
+		tokenomics, _ := k.GetTokenomicsData(ctx)
+		if settledTotal > tokenomics.TotalSubsidies {
+			broken = true
+			msg += fmt.Sprintf("\tparticipant reward coins %d exceed minted subsidies %d\n", settledTotal, tokenomics.TotalSubsidies)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "settlement-rewards",
+			fmt.Sprintf("reward coins of participants do not match minted rewards:\n%s", msg)), broken
+	}
+}
+
+// InferenceEscrowInvariant checks that locked escrows belong to open inferences and that the module
+// account holds what it owes: the funds still in escrow plus the unclaimed settle amounts
+func InferenceEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		it, err := k.InferenceEscrows.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("iterate escrows: %v", err)), true
+		}
+		values, err := it.Values()
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("read escrows: %v", err)), true
+		}
+
+		var msg string
+		broken := false
+		held := math.ZeroInt()
+		escrows := make(map[string]types.InferenceEscrow, len(values))
+		for _, escrow := range values {
+			escrows[escrow.InferenceId] = escrow
+			if escrow.Refunded < 0 || escrow.Refunded > escrow.Locked {
+				broken = true
+				msg += fmt.Sprintf("\tinference %s: refunded %d of %d locked\n", escrow.InferenceId, escrow.Refunded, escrow.Locked)
+			}
+			if escrow.Status != types.EscrowLocked {
+				continue
+			}
+			held = held.AddRaw(escrow.Held())
+			// Escrows of invalidated or expired inferences are refunded right away
+			if inference, found := k.GetInference(ctx, escrow.InferenceId); found &&
+				(inference.Status == types.InferenceStatus_INVALIDATED || inference.Status == types.InferenceStatus_EXPIRED) {
+				broken = true
+				msg += fmt.Sprintf("\tinference %s is %s but its escrow is still locked\n", escrow.InferenceId, inference.Status)
+			}
+		}
+
+		releasable, err := k.ReleasableEscrows.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("iterate releasable escrows: %v", err)), true
+		}
+		keys, err := releasable.Keys()
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("read releasable escrows: %v", err)), true
+		}
+		for _, key := range keys {
+			escrow, found := escrows[key.K2()]
+			if !found || escrow.Status != types.EscrowLocked || escrow.EpochId != key.K1() {
+				broken = true
+				msg += fmt.Sprintf("\tinference %s is releasable in epoch %d without a locked escrow of that epoch\n", key.K2(), key.K1())
+			}
+		}
+
+		owed := held
+		for _, settleAmount := range k.GetAllSettleAmount(ctx) {
+			owed = owed.AddRaw(settleAmount.GetTotalCoins())
+		}
+		carryovers, err := k.SettleCarryovers.Iterate(ctx, nil)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("iterate settle carryovers: %v", err)), true
+		}
+		carried, err := carryovers.Values()
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "inference-escrow", fmt.Sprintf("read settle carryovers: %v", err)), true
+		}
+		for _, settleAmount := range carried {
+			owed = owed.AddRaw(settleAmount.GetTotalCoins())
+		}
+
+		// The module account also holds funds it does not owe to anyone yet, e.g. payments of
+		// inferences started before escrows were tracked, so it only has to cover what it owes
+		balance := k.BankView.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(types.ModuleName)).AmountOf(types.BaseCoin)
+		if balance.LT(owed) {
+			broken = true
+			msg += fmt.Sprintf("\tmodule balance %s%s is below %s%s held in escrow and owed to participants\n", balance, types.BaseCoin, owed, types.BaseCoin)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "inference-escrow",
+			fmt.Sprintf("inference escrows are inconsistent:\n%s", msg)), broken
+	}
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func setEarnings(t *testing.T, k keeper.Keeper, ctx sdk.Context, earnings types.ParticipantEpochEarnings) {
+	bz, err := json.Marshal(earnings)
+	require.NoError(t, err)
+	addr := sdk.MustAccAddressFromBech32(earnings.Participant)
+	require.NoError(t, k.ParticipantEarnings.Set(ctx, collections.Join(addr, earnings.EpochIndex), bz))
+}
+
+func setEscrow(t *testing.T, k keeper.Keeper, ctx sdk.Context, escrow types.InferenceEscrow) {
+	require.NoError(t, k.SetInferenceEscrow(ctx, escrow))
+}
+
+func TestSettlementRewardsInvariant(t *testing.T) {
+	k, _, ctx, _ := setupKeeperWithMocks(t)
+	invariant := keeper.SettlementRewardsInvariant(k)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.AddTokenomicsData(ctx, &types.TokenomicsData{TotalSubsidies: 1000}))
+	require.NoError(t, k.SetEpochSubsidyTotal(ctx, 3, 700))
+	setEarnings(t, k, ctx, types.ParticipantEpochEarnings{Participant: testutil.Executor, EpochIndex: 3, RewardCoins: 400, Settled: true})
+	setEarnings(t, k, ctx, types.ParticipantEpochEarnings{Participant: testutil.Executor2, EpochIndex: 3, RewardCoins: 300, Settled: true})
+	// Earnings of an epoch not settled yet do not count
+	setEarnings(t, k, ctx, types.ParticipantEpochEarnings{Participant: testutil.Executor, EpochIndex: 4, RefundedCoins: 10})
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	require.NoError(t, k.SetEpochSubsidyTotal(ctx, 3, 600))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "epoch 3: subsidy total 600, participant reward coins 700")
+
+	// Once its subsidy total is pruned, an epoch only counts towards the minted total
+	require.NoError(t, k.PruneEpochSubsidyTotals(ctx, 4))
+	setEarnings(t, k, ctx, types.ParticipantEpochEarnings{Participant: testutil.Executor, EpochIndex: 2, RewardCoins: 301, Settled: true})
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "participant reward coins 1001 exceed minted subsidies 1000")
+}
+
+func TestSettlementRewardsInvariantWithActiveParticipants(t *testing.T) {
+	k, _, ctx, _ := setupKeeperWithMocks(t)
+	invariant := keeper.SettlementRewardsInvariant(k)
+
+	// Legacy string keys in the same store are not read as participant earnings
+	require.NoError(t, k.SetActiveParticipants(ctx, types.ActiveParticipants{EpochId: 3, Participants: []*types.ActiveParticipant{{Index: testutil.Executor}}}))
+	require.NoError(t, k.AddTokenomicsData(ctx, &types.TokenomicsData{TotalSubsidies: 1000}))
+	require.NoError(t, k.SetEpochSubsidyTotal(ctx, 3, 400))
+	setEarnings(t, k, ctx, types.ParticipantEpochEarnings{Participant: testutil.Executor, EpochIndex: 3, RewardCoins: 400, Settled: true})
+	msg, broken := invariant(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestInferenceEscrowInvariant(t *testing.T) {
+	k, _, ctx, mocks := setupKeeperWithMocks(t)
+	moduleAddress := authtypes.NewModuleAddress(types.ModuleName)
+	mocks.AccountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(moduleAddress).AnyTimes()
+	balance := sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 150))
+	mocks.BankViewKeeper.EXPECT().GetAllBalances(gomock.Any(), moduleAddress).DoAndReturn(
+		func(_ any, _ sdk.AccAddress) sdk.Coins { return balance }).AnyTimes()
+	invariant := keeper.InferenceEscrowInvariant(k)
+
+	setEscrow(t, k, ctx, types.InferenceEscrow{InferenceId: "open", Status: types.EscrowLocked, Locked: 100, Refunded: 20, EpochId: 2})
+	require.NoError(t, k.ReleasableEscrows.Set(ctx, collections.Join(uint64(2), "open")))
+	setEscrow(t, k, ctx, types.InferenceEscrow{InferenceId: "released", Status: types.EscrowReleased, Locked: 500})
+	require.NoError(t, k.SetSettleAmount(ctx, types.SettleAmount{Participant: testutil.Executor, WorkCoins: 50, RewardCoins: 20}))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	balance = sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 149))
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "module balance 149ngonka is below 150ngonka")
+	balance = sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 150))
+
+	require.NoError(t, k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "open", InferenceId: "open", Status: types.InferenceStatus_EXPIRED}))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "inference open is EXPIRED but its escrow is still locked")
+	require.NoError(t, k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "open", InferenceId: "open", Status: types.InferenceStatus_FINISHED}))
+
+	require.NoError(t, k.ReleasableEscrows.Set(ctx, collections.Join(uint64(1), "released")))
+	msg, broken = invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "inference released is releasable in epoch 1")
+}
@@ -33,6 +33,10 @@ type (
 
 		collateralKeeper    types.CollateralKeeper
 		streamvestingKeeper types.StreamVestingKeeper
+		// Shared by all copies of the keeper, so an exporter set after depinject reaches the module
+		prunedRecordExport *prunedRecordExport
+		// Shared the same way, the module sets the simulator after the keeper is copied into it
+		modelAssignmentSim *modelAssignmentSimulation
 		// Collections schema and stores
 		Schema         collections.Schema
 		Participants   collections.Map[sdk.AccAddress, types.Participant]
@@ -86,6 +90,89 @@ type (
 		PoCValidationSnapshots collections.Map[int64, types.PoCValidationSnapshot]
 		// Punishment grace epochs for upgrade protection
 		PunishmentGraceEpochs collections.Map[uint64, types.GraceEpochParams]
+		// Reward coins distributed per epoch, used for subsidy shares in settlement events
+		EpochSubsidyTotals collections.Map[uint64, uint64]
+		// Algorithm used by the ModelAssigner, selected through governance
+		ModelAssignmentStrategy collections.Item[string]
+		// Unclaimed settle amounts kept claimable for SettleCarryoverEpochs after their claim epoch
+		SettleCarryovers collections.Map[collections.Pair[sdk.AccAddress, uint64], types.SettleAmount]
+		// JSON-encoded types.PocAllocationAudit keyed by (epoch index, model id)
+		PocAllocationAudits collections.Map[collections.Pair[uint64, string], []byte]
+		// JSON-encoded types.TimeslotSchedule, selected through governance (MsgUpdatePolicy)
+		TimeslotSchedule collections.Item[[]byte]
+		// Whether ML nodes must declare enough VRAM for their models, selected through governance
+		HardwareVerificationRequired collections.Item[bool]
+		// JSON-encoded types.SlashingPolicy, selected through governance (MsgUpdatePolicy)
+		SlashingPolicy collections.Item[[]byte]
+		// JSON-encoded types.JailRecord keyed by participant address
+		JailRecords collections.Map[sdk.AccAddress, []byte]
+		// Epoch group data is kept for this many epochs, selected through governance (MsgUpdatePolicy)
+		EpochGroupDataRetentionEpochs collections.Item[uint64]
+		EpochGroupDataPrunedEpoch     collections.Item[int64]
+		// JSON-encoded types.TrainingAttestationPolicy, selected through governance (MsgUpdatePolicy)
+		TrainingAttestationPolicy collections.Item[[]byte]
+		// JSON-encoded types.TrainingVerification keyed by training task id
+		TrainingVerifications collections.Map[uint64, []byte]
+		// JSON-encoded types.ValidationCircuitBreakerPolicy, selected through governance (MsgUpdatePolicy)
+		ValidationCircuitBreakerPolicy collections.Item[[]byte]
+		// JSON-encoded types.ValidationFailureStats keyed by epoch index
+		ValidationFailureStats collections.Map[uint64, []byte]
+		// JSON-encoded types.LateJoinPolicy, selected through governance (MsgUpdatePolicy)
+		LateJoinPolicy collections.Item[[]byte]
+		// Late joiners waiting to be added to the epoch group, keyed by epoch index
+		PendingLateJoiners collections.Map[uint64, types.ActiveParticipants]
+		// JSON-encoded types.PaymentDenomRegistry, selected through governance (MsgUpdatePolicy)
+		PaymentDenomRegistry collections.Item[[]byte]
+		// Escrows of the inferences not paid in the native denom, keyed by inference id
+		InferencePayments collections.Map[string, types.InferencePayment]
+		// Coins held in each registered payment denom, keyed by denom
+		DenomTreasuries collections.Map[string, types.DenomTreasury]
+		// Earnings of the current epoch in registered payment denoms, keyed by (participant, denom)
+		ParticipantDenomBalances collections.Map[collections.Pair[sdk.AccAddress, string], types.ParticipantDenomBalance]
+		// Escrows of the inferences started since escrows were tracked, keyed by inference id
+		InferenceEscrows collections.Map[string, types.InferenceEscrow]
+		// Locked escrows of completed inferences, keyed by the epoch whose settlement releases them
+		ReleasableEscrows collections.KeySet[collections.Pair[uint64, string]]
+		// JSON-encoded types.ParticipantEpochEarnings keyed by (participant, epoch index)
+		ParticipantEarnings collections.Map[collections.Pair[sdk.AccAddress, uint64], []byte]
+		// JSON-encoded types.RewardVestingSchedule, selected through governance (MsgUpdatePolicy)
+		RewardVestingSchedule collections.Item[[]byte]
+		// Rewards still vesting or not released yet, keyed by participant
+		RewardVestingAccounts collections.Map[sdk.AccAddress, types.RewardVestingAccount]
+		// JSON-encoded types.TopRewardProgress keyed by top miner
+		TopRewardProgress collections.Map[sdk.AccAddress, []byte]
+		// JSON-encoded types.TopRewardProgramState
+		TopRewardProgramState collections.Item[[]byte]
+		// JSON-encoded types.ImageInputPolicy, set through governance (MsgUpdatePolicy)
+		ImageInputPolicy collections.Item[[]byte]
+		// Epoch params each epoch started with, so parameter changes take effect at the next epoch
+		EpochParamsHistory collections.Map[uint64, types.EpochParams]
+		// JSON-encoded types.MLNodeVersionRollout, set through governance (partial upgrades)
+		MLNodeVersionRollout collections.Item[[]byte]
+		// JSON-encoded types.MLNodeVersionAssignment keyed by epoch
+		MLNodeVersionAssignments collections.Map[uint64, []byte]
+		// JSON-encoded types.ModelRewardWeights, selected through governance (MsgUpdatePolicy)
+		ModelRewardWeights collections.Item[[]byte]
+		// JSON-encoded types.ModelEpochUsage keyed by (epoch index, model id)
+		ModelEpochUsage collections.Map[collections.Pair[uint64, string], []byte]
+		// JSON-encoded types.PriorityClassPricing, set through governance (MsgUpdatePolicy)
+		PriorityClassPricing collections.Item[[]byte]
+		// JSON-encoded types.TrainingDataset keyed by name, registered through governance (MsgUpdatePolicy)
+		TrainingDatasets collections.Map[string, []byte]
+		// JSON-encoded types.TrainingTaskDatasets keyed by training task id
+		TrainingTaskDatasets collections.Map[uint64, []byte]
+		// Exits of the participants leaving the network
+		ParticipantExits collections.Map[sdk.AccAddress, types.ParticipantExit]
+		// JSON-encoded types.CapacityCollateralPolicy, selected through governance (MsgUpdatePolicy)
+		CapacityCollateralPolicy collections.Item[[]byte]
+		// JSON-encoded types.DelegationPolicy, selected through governance (MsgUpdatePolicy)
+		DelegationPolicy collections.Item[[]byte]
+		// Stake delegated to each participant
+		ParticipantDelegations collections.Map[sdk.AccAddress, types.ParticipantDelegations]
+		// Delegations of the epochs not settled yet, keyed by (epoch index, participant)
+		EpochDelegations collections.Map[collections.Pair[uint64, sdk.AccAddress], types.EpochDelegation]
+		// Undelegated stake keyed by (release epoch, delegator, participant)
+		DelegationUnbondings collections.Map[collections.Triple[uint64, sdk.AccAddress, sdk.AccAddress], types.DelegationUnbonding]
 	}
 )
 
@@ -131,6 +218,8 @@ func NewKeeper(
 		streamvestingKeeper: streamvestingKeeper,
 		getWasmKeeper:       getWasmKeeper,
 		UpgradeKeeper:       upgradeKeeper,
+		prunedRecordExport:  &prunedRecordExport{},
+		modelAssignmentSim:  &modelAssignmentSimulation{},
 		// collection init
 		Participants: collections.NewMap(
 			sb,
@@ -428,6 +517,281 @@ func NewKeeper(
 			collections.Uint64Key,
 			codec.CollValue[types.GraceEpochParams](cdc),
 		),
+		EpochSubsidyTotals: collections.NewMap(
+			sb,
+			types.EpochSubsidyTotalsPrefix,
+			"epoch_subsidy_totals",
+			collections.Uint64Key,
+			collections.Uint64Value,
+		),
+		ModelAssignmentStrategy: collections.NewItem(
+			sb,
+			types.ModelAssignmentStrategyPrefix,
+			"model_assignment_strategy",
+			collections.StringValue,
+		),
+		SettleCarryovers: collections.NewMap(
+			sb,
+			types.SettleCarryoversPrefix,
+			"settle_carryovers",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.SettleAmount](cdc),
+		),
+		PocAllocationAudits: collections.NewMap(
+			sb,
+			types.PocAllocationAuditsPrefix,
+			"poc_allocation_audits",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collections.BytesValue,
+		),
+		TimeslotSchedule: collections.NewItem(
+			sb,
+			types.TimeslotSchedulePrefix,
+			"timeslot_schedule",
+			collections.BytesValue,
+		),
+		HardwareVerificationRequired: collections.NewItem(
+			sb,
+			types.HardwareVerificationPrefix,
+			"hardware_verification_required",
+			collections.BoolValue,
+		),
+		SlashingPolicy: collections.NewItem(
+			sb,
+			types.SlashingPolicyPrefix,
+			"slashing_policy",
+			collections.BytesValue,
+		),
+		JailRecords: collections.NewMap(
+			sb,
+			types.JailRecordsPrefix,
+			"jail_records",
+			sdk.AccAddressKey,
+			collections.BytesValue,
+		),
+		EpochGroupDataRetentionEpochs: collections.NewItem(
+			sb,
+			types.EpochGroupDataRetentionPrefix,
+			"epoch_group_data_retention_epochs",
+			collections.Uint64Value,
+		),
+		EpochGroupDataPrunedEpoch: collections.NewItem(
+			sb,
+			types.EpochGroupDataPrunedEpochPrefix,
+			"epoch_group_data_pruned_epoch",
+			collections.Int64Value,
+		),
+		TrainingAttestationPolicy: collections.NewItem(
+			sb,
+			types.TrainingAttestationPolicyPrefix,
+			"training_attestation_policy",
+			collections.BytesValue,
+		),
+		TrainingVerifications: collections.NewMap(
+			sb,
+			types.TrainingVerificationsPrefix,
+			"training_verifications",
+			collections.Uint64Key,
+			collections.BytesValue,
+		),
+		ValidationCircuitBreakerPolicy: collections.NewItem(
+			sb,
+			types.ValidationCircuitBreakerPrefix,
+			"validation_circuit_breaker_policy",
+			collections.BytesValue,
+		),
+		ValidationFailureStats: collections.NewMap(
+			sb,
+			types.ValidationFailureStatsPrefix,
+			"validation_failure_stats",
+			collections.Uint64Key,
+			collections.BytesValue,
+		),
+		LateJoinPolicy: collections.NewItem(
+			sb,
+			types.LateJoinPolicyPrefix,
+			"late_join_policy",
+			collections.BytesValue,
+		),
+		PendingLateJoiners: collections.NewMap(
+			sb,
+			types.PendingLateJoinersPrefix,
+			"pending_late_joiners",
+			collections.Uint64Key,
+			codec.CollValue[types.ActiveParticipants](cdc),
+		),
+		PaymentDenomRegistry: collections.NewItem(
+			sb,
+			types.PaymentDenomRegistryPrefix,
+			"payment_denom_registry",
+			collections.BytesValue,
+		),
+		InferencePayments: collections.NewMap(
+			sb,
+			types.InferencePaymentsPrefix,
+			"inference_payments",
+			collections.StringKey,
+			codec.CollValue[types.InferencePayment](cdc),
+		),
+		DenomTreasuries: collections.NewMap(
+			sb,
+			types.DenomTreasuriesPrefix,
+			"denom_treasuries",
+			collections.StringKey,
+			codec.CollValue[types.DenomTreasury](cdc),
+		),
+		ParticipantDenomBalances: collections.NewMap(
+			sb,
+			types.ParticipantDenomBalancesPrefix,
+			"participant_denom_balances",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.StringKey),
+			codec.CollValue[types.ParticipantDenomBalance](cdc),
+		),
+		InferenceEscrows: collections.NewMap(
+			sb,
+			types.InferenceEscrowsPrefix,
+			"inference_escrows",
+			collections.StringKey,
+			codec.CollValue[types.InferenceEscrow](cdc),
+		),
+		ReleasableEscrows: collections.NewKeySet(
+			sb,
+			types.ReleasableEscrowsPrefix,
+			"releasable_escrows",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		ParticipantEarnings: collections.NewMap(
+			sb,
+			types.ParticipantEarningsPrefix,
+			"participant_earnings",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			collections.BytesValue,
+		),
+		RewardVestingSchedule: collections.NewItem(
+			sb,
+			types.RewardVestingSchedulePrefix,
+			"reward_vesting_schedule",
+			collections.BytesValue,
+		),
+		RewardVestingAccounts: collections.NewMap(
+			sb,
+			types.RewardVestingAccountsPrefix,
+			"reward_vesting_accounts",
+			sdk.AccAddressKey,
+			codec.CollValue[types.RewardVestingAccount](cdc),
+		),
+		TopRewardProgress: collections.NewMap(
+			sb,
+			types.TopRewardProgressPrefix,
+			"top_reward_progress",
+			sdk.AccAddressKey,
+			collections.BytesValue,
+		),
+		TopRewardProgramState: collections.NewItem(
+			sb,
+			types.TopRewardProgramStatePrefix,
+			"top_reward_program_state",
+			collections.BytesValue,
+		),
+		ImageInputPolicy: collections.NewItem(
+			sb,
+			types.ImageInputPolicyPrefix,
+			"image_input_policy",
+			collections.BytesValue,
+		),
+		EpochParamsHistory: collections.NewMap(
+			sb,
+			types.EpochParamsHistoryPrefix,
+			"epoch_params_history",
+			collections.Uint64Key,
+			codec.CollValue[types.EpochParams](cdc),
+		),
+		MLNodeVersionRollout: collections.NewItem(
+			sb,
+			types.MLNodeVersionRolloutPrefix,
+			"mlnode_version_rollout",
+			collections.BytesValue,
+		),
+		MLNodeVersionAssignments: collections.NewMap(
+			sb,
+			types.MLNodeVersionAssignmentsPrefix,
+			"mlnode_version_assignments",
+			collections.Uint64Key,
+			collections.BytesValue,
+		),
+		ModelRewardWeights: collections.NewItem(
+			sb,
+			types.ModelRewardWeightsPrefix,
+			"model_reward_weights",
+			collections.BytesValue,
+		),
+		ModelEpochUsage: collections.NewMap(
+			sb,
+			types.ModelEpochUsagePrefix,
+			"model_epoch_usage",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+			collections.BytesValue,
+		),
+		PriorityClassPricing: collections.NewItem(
+			sb,
+			types.PriorityClassPricingPrefix,
+			"priority_class_pricing",
+			collections.BytesValue,
+		),
+		TrainingDatasets: collections.NewMap(
+			sb,
+			types.TrainingDatasetsPrefix,
+			"training_datasets",
+			collections.StringKey,
+			collections.BytesValue,
+		),
+		TrainingTaskDatasets: collections.NewMap(
+			sb,
+			types.TrainingTaskDatasetsPrefix,
+			"training_task_datasets",
+			collections.Uint64Key,
+			collections.BytesValue,
+		),
+		ParticipantExits: collections.NewMap(
+			sb,
+			types.ParticipantExitsPrefix,
+			"participant_exits",
+			sdk.AccAddressKey,
+			codec.CollValue[types.ParticipantExit](cdc),
+		),
+		CapacityCollateralPolicy: collections.NewItem(
+			sb,
+			types.CapacityCollateralPolicyPrefix,
+			"capacity_collateral_policy",
+			collections.BytesValue,
+		),
+		DelegationPolicy: collections.NewItem(
+			sb,
+			types.DelegationPolicyPrefix,
+			"delegation_policy",
+			collections.BytesValue,
+		),
+		ParticipantDelegations: collections.NewMap(
+			sb,
+			types.ParticipantDelegationsPrefix,
+			"participant_delegations",
+			sdk.AccAddressKey,
+			codec.CollValue[types.ParticipantDelegations](cdc),
+		),
+		EpochDelegations: collections.NewMap(
+			sb,
+			types.EpochDelegationsPrefix,
+			"epoch_delegations",
+			collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey),
+			codec.CollValue[types.EpochDelegation](cdc),
+		),
+		DelegationUnbondings: collections.NewMap(
+			sb,
+			types.DelegationUnbondingsPrefix,
+			"delegation_unbondings",
+			collections.TripleKeyCodec(collections.Uint64Key, sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.DelegationUnbonding](cdc),
+		),
 	}
 	// Build the collections schema
 	schema, err := sb.Build()
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetLateJoinPolicy selects the late-join policy applied from the next PoC stage on.
+// Governance updates it with MsgUpdatePolicy (types.PolicyLateJoin).
+func (k Keeper) SetLateJoinPolicy(ctx context.Context, policy types.LateJoinPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.LateJoinPolicy.Set(ctx, bz)
+}
+
+// GetLateJoinPolicy returns the active late-join policy,
+// or types.DefaultLateJoinPolicy if none was selected.
+func (k Keeper) GetLateJoinPolicy(ctx context.Context) types.LateJoinPolicy {
+	bz, err := k.LateJoinPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultLateJoinPolicy()
+	}
+	var policy types.LateJoinPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode late join policy, using default", types.PoC, "error", err)
+		return types.DefaultLateJoinPolicy()
+	}
+	return policy
+}
+
+// hasOnTimePocBatch reports whether the participant submitted a PoC batch for the stage by the exchange deadline
+func (k Keeper) hasOnTimePocBatch(ctx context.Context, pocStageStartBlockHeight int64, participant sdk.AccAddress, deadline int64) (bool, error) {
+	it, err := k.PoCBatches.Iterate(ctx, collections.NewSuperPrefixedTripleRange[int64, sdk.AccAddress, string](pocStageStartBlockHeight, participant))
+	if err != nil {
+		return false, err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return false, err
+		}
+		if batch.ReceivedAtBlockHeight <= deadline {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// LateJoinersForStage returns the participants whose PoC for the epoch only arrived after the exchange deadline
+func (k Keeper) LateJoinersForStage(ctx context.Context, ec *types.EpochContext, pocV2Enabled bool) (map[string]bool, error) {
+	deadline := ec.PoCExchangeDeadline()
+	lateJoiners := make(map[string]bool)
+	if pocV2Enabled {
+		commits, err := k.GetAllPoCV2StoreCommitsForStage(ctx, ec.PocStartBlockHeight)
+		if err != nil {
+			return nil, err
+		}
+		for address, commit := range commits {
+			if commit.CommitBlockHeight > deadline {
+				lateJoiners[address] = true
+			}
+		}
+		return lateJoiners, nil
+	}
+
+	batches, err := k.GetPoCBatchesByStage(ctx, ec.PocStartBlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	for address, participantBatches := range batches {
+		late := true
+		for _, batch := range participantBatches {
+			if batch.ReceivedAtBlockHeight <= deadline {
+				late = false
+				break
+			}
+		}
+		if late {
+			lateJoiners[address] = true
+		}
+	}
+	return lateJoiners, nil
+}
+
+// DeferLateJoiners takes the late joiners out of the participants of the epoch being formed. They lose
+// the policy's weight penalty and are kept until the amendment height, when they join the epoch group.
+// Returns the participants that submitted their PoC on time.
+func (k Keeper) DeferLateJoiners(ctx context.Context, ec *types.EpochContext, participants []*types.ActiveParticipant, pocV2Enabled bool) []*types.ActiveParticipant {
+	// Late joiners of earlier epochs are only left over if their epoch ended before the amendment height
+	if err := k.PendingLateJoiners.Clear(ctx, new(collections.Range[uint64]).EndExclusive(ec.EpochIndex)); err != nil {
+		k.LogError("Failed to clear late joiners of earlier epochs", types.PoC, "error", err)
+	}
+
+	lateJoiners, err := k.LateJoinersForStage(ctx, ec, pocV2Enabled)
+	if err != nil {
+		k.LogError("Failed to find late joiners, forming the epoch group with all participants", types.PoC, "error", err)
+		return participants
+	}
+	if len(lateJoiners) == 0 {
+		return participants
+	}
+
+	policy := k.GetLateJoinPolicy(ctx)
+	amendmentHeight := policy.AmendmentHeight(ec)
+	onTime := make([]*types.ActiveParticipant, 0, len(participants))
+	var deferred []*types.ActiveParticipant
+	for _, participant := range participants {
+		if !lateJoiners[participant.Index] {
+			onTime = append(onTime, participant)
+			continue
+		}
+		originalWeight := participant.Weight
+		participant.Weight = policy.PenalizedWeight(originalWeight)
+		deferred = append(deferred, participant)
+		k.LogInfo("Deferring late joiner to the epoch group amendment", types.PoC,
+			"participant", participant.Index, "epochIndex", ec.EpochIndex,
+			"originalWeight", originalWeight, "weight", participant.Weight, "amendmentHeight", amendmentHeight)
+		k.emitParticipantLateJoinedEvent(ctx, participant.Index, ec.EpochIndex, originalWeight, participant.Weight, amendmentHeight)
+	}
+	if len(deferred) == 0 {
+		return participants
+	}
+
+	err = k.PendingLateJoiners.Set(ctx, ec.EpochIndex, types.ActiveParticipants{
+		Participants:         deferred,
+		EpochGroupId:         ec.EpochIndex,
+		EpochId:              ec.EpochIndex,
+		PocStartBlockHeight:  ec.PocStartBlockHeight,
+		EffectiveBlockHeight: amendmentHeight,
+		CreatedAtBlockHeight: sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	})
+	if err != nil {
+		k.LogError("Failed to store late joiners, forming the epoch group with all participants", types.PoC, "error", err)
+		return participants
+	}
+	return onTime
+}
+
+// GetPendingLateJoiners returns the late joiners waiting to be added to the epoch group of the epoch.
+// Their EffectiveBlockHeight is the amendment height.
+func (k Keeper) GetPendingLateJoiners(ctx context.Context, epochIndex uint64) (types.ActiveParticipants, bool) {
+	lateJoiners, err := k.PendingLateJoiners.Get(ctx, epochIndex)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			k.LogError("Failed to read late joiners", types.PoC, "epochIndex", epochIndex, "error", err)
+		}
+		return types.ActiveParticipants{}, false
+	}
+	return lateJoiners, true
+}
+
+func (k Keeper) RemovePendingLateJoiners(ctx context.Context, epochIndex uint64) error {
+	return k.PendingLateJoiners.Remove(ctx, epochIndex)
+}
+
+func (k Keeper) emitParticipantLateJoinedEvent(ctx context.Context, participant string, epochIndex uint64, originalWeight int64, weight int64, amendmentHeight int64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantLateJoined,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginalWeight, strconv.FormatInt(originalWeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyPenalizedWeight, strconv.FormatInt(weight, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmendmentHeight, strconv.FormatInt(amendmentHeight, 10)),
+		))
+}
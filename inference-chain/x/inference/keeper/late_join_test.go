@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestLateJoinPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultLateJoinPolicy(), k.GetLateJoinPolicy(ctx))
+
+	policy := types.LateJoinPolicy{GraceBlocks: 10, WeightPenalty: "0.25", AmendmentDelay: 5}
+	require.NoError(t, k.SetLateJoinPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetLateJoinPolicy(ctx))
+
+	require.Error(t, k.SetLateJoinPolicy(ctx, types.LateJoinPolicy{GraceBlocks: -1, WeightPenalty: "0.25", AmendmentDelay: 5}))
+	require.Error(t, k.SetLateJoinPolicy(ctx, types.LateJoinPolicy{GraceBlocks: 10, WeightPenalty: "1", AmendmentDelay: 5}))
+	require.Error(t, k.SetLateJoinPolicy(ctx, types.LateJoinPolicy{GraceBlocks: 10, WeightPenalty: "0.25", AmendmentDelay: 0}))
+	require.Equal(t, policy, k.GetLateJoinPolicy(ctx))
+
+	require.Equal(t, int64(75), policy.PenalizedWeight(100))
+
+	// Deadline 155, validation starts at 170: the grace window is capped to end before it
+	ec := types.NewEpochContext(types.Epoch{Index: 1, PocStartBlockHeight: 100}, lateJoinEpochParams())
+	require.False(t, policy.IsLateJoinWindow(&ec, 155))
+	require.True(t, policy.IsLateJoinWindow(&ec, 156))
+	require.True(t, policy.IsLateJoinWindow(&ec, 165))
+	require.False(t, policy.IsLateJoinWindow(&ec, 166))
+	policy.GraceBlocks = 100
+	require.True(t, policy.IsLateJoinWindow(&ec, 169))
+	require.False(t, policy.IsLateJoinWindow(&ec, 170))
+	require.False(t, types.DefaultLateJoinPolicy().IsLateJoinWindow(&ec, 156))
+}
+
+func lateJoinEpochParams() types.EpochParams {
+	return types.EpochParams{
+		EpochLength:           400,
+		PocStageDuration:      50,
+		PocExchangeDuration:   5,
+		PocValidationDelay:    20,
+		PocValidationDuration: 100,
+		SetNewValidatorsDelay: 1,
+	}
+}
+
+func TestPoCV2StoreCommit_LateJoinWindow(t *testing.T) {
+	k, ctx, _ := keepertest.InferenceKeeperReturningMocks(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.PocParams = &types.PocParams{PocV2Enabled: true}
+	epochParams := lateJoinEpochParams()
+	params.EpochParams = &epochParams
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	require.NoError(t, k.SetEffectiveEpochIndex(sdkCtx, 0))
+	require.NoError(t, k.SetEpoch(sdkCtx, &types.Epoch{Index: 1, PocStartBlockHeight: 100}))
+	require.NoError(t, k.SetLateJoinPolicy(sdkCtx, types.LateJoinPolicy{GraceBlocks: 10, WeightPenalty: "0.2", AmendmentDelay: 5}))
+
+	msgServer := keeper.NewMsgServerImpl(k)
+	commit := func(height int64, creator string, count uint32) error {
+		_, err := msgServer.PoCV2StoreCommit(sdkCtx.WithBlockHeight(height), &types.MsgPoCV2StoreCommit{
+			Creator:                  creator,
+			PocStageStartBlockHeight: 100,
+			Count:                    count,
+			RootHash:                 make([]byte, 32),
+		})
+		return err
+	}
+
+	require.NoError(t, commit(150, testutil.Executor, 10))
+	require.NoError(t, commit(160, testutil.Executor2, 10))
+	require.NoError(t, commit(161, testutil.Executor2, 20))
+	// On-time participants cannot extend their PoC in the late-join window
+	require.ErrorIs(t, commit(162, testutil.Executor, 20), types.ErrPocSubmittedOnTime)
+	require.ErrorIs(t, commit(166, testutil.Validator, 10), types.ErrPocTooLate)
+
+	ec := types.NewEpochContext(types.Epoch{Index: 1, PocStartBlockHeight: 100}, epochParams)
+	lateJoiners, err := k.LateJoinersForStage(sdkCtx, &ec, true)
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{testutil.Executor2: true}, lateJoiners)
+
+	sdkCtx = sdkCtx.WithBlockHeight(ec.EndOfPoCValidation()).WithEventManager(sdk.NewEventManager())
+	onTime := k.DeferLateJoiners(sdkCtx, &ec, []*types.ActiveParticipant{
+		{Index: testutil.Executor, Weight: 100},
+		{Index: testutil.Executor2, Weight: 100},
+	}, true)
+	require.Len(t, onTime, 1)
+	require.Equal(t, testutil.Executor, onTime[0].Index)
+
+	pending, found := k.GetPendingLateJoiners(sdkCtx, 1)
+	require.True(t, found)
+	require.Equal(t, ec.SetNewValidators()+5, pending.EffectiveBlockHeight)
+	require.Len(t, pending.Participants, 1)
+	require.Equal(t, testutil.Executor2, pending.Participants[0].Index)
+	require.Equal(t, int64(80), pending.Participants[0].Weight)
+	require.Len(t, sdkCtx.EventManager().Events(), 1)
+	require.Equal(t, types.EventTypeParticipantLateJoined, sdkCtx.EventManager().Events()[0].Type)
+
+	require.NoError(t, k.RemovePendingLateJoiners(sdkCtx, 1))
+	_, found = k.GetPendingLateJoiners(sdkCtx, 1)
+	require.False(t, found)
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// MLNodeVersionAssignmentRetentionEpochs is how many epochs of ML node version assignments are kept in state
+const MLNodeVersionAssignmentRetentionEpochs = 5
+
+// SetMLNodeVersionRollout starts or advances a staged rollout of an ML node version from the next model
+// assignment on. At 100 percent the rollout completes: the target becomes the current version of all
+// nodes. At 0 percent it is cancelled and all nodes stay on the current version.
+func (k Keeper) SetMLNodeVersionRollout(ctx context.Context, rollout types.MLNodeVersionRollout) error {
+	if err := rollout.Validate(); err != nil {
+		return err
+	}
+	switch rollout.CanaryPercent {
+	case 0:
+		k.LogInfo("MLNode version rollout cancelled", types.Upgrades, "targetVersion", rollout.TargetVersion)
+		return k.MLNodeVersionRollout.Remove(ctx)
+	case 100:
+		k.LogInfo("MLNode version rollout completed", types.Upgrades, "targetVersion", rollout.TargetVersion)
+		if err := k.SetMLNodeVersion(ctx, types.MLNodeVersion{CurrentVersion: rollout.TargetVersion}); err != nil {
+			return err
+		}
+		return k.MLNodeVersionRollout.Remove(ctx)
+	}
+	k.LogInfo("MLNode version rollout set", types.Upgrades, "targetVersion", rollout.TargetVersion, "canaryPercent", rollout.CanaryPercent)
+	bz, err := json.Marshal(rollout)
+	if err != nil {
+		return err
+	}
+	return k.MLNodeVersionRollout.Set(ctx, bz)
+}
+
+// GetMLNodeVersionRollout returns the rollout in progress, if any
+func (k Keeper) GetMLNodeVersionRollout(ctx context.Context) (types.MLNodeVersionRollout, bool) {
+	var rollout types.MLNodeVersionRollout
+	bz, err := k.MLNodeVersionRollout.Get(ctx)
+	if err != nil {
+		return rollout, false
+	}
+	if err := json.Unmarshal(bz, &rollout); err != nil {
+		k.LogError("Failed to decode MLNode version rollout", types.Upgrades, "error", err)
+		return rollout, false
+	}
+	return rollout, true
+}
+
+// SetMLNodeVersionAssignment stores the assignment of an epoch and prunes those older than the retention window
+func (k Keeper) SetMLNodeVersionAssignment(ctx context.Context, assignment types.MLNodeVersionAssignment) error {
+	bz, err := json.Marshal(assignment)
+	if err != nil {
+		return err
+	}
+	if err := k.MLNodeVersionAssignments.Set(ctx, assignment.EpochIndex, bz); err != nil {
+		return err
+	}
+	if assignment.EpochIndex < MLNodeVersionAssignmentRetentionEpochs {
+		return nil
+	}
+	rng := new(collections.Range[uint64]).EndExclusive(assignment.EpochIndex - MLNodeVersionAssignmentRetentionEpochs + 1)
+	return k.MLNodeVersionAssignments.Clear(ctx, rng)
+}
+
+// GetMLNodeVersionAssignment returns the canary nodes of an epoch, not found when no rollout was in progress
+func (k Keeper) GetMLNodeVersionAssignment(ctx context.Context, epochIndex uint64) (types.MLNodeVersionAssignment, bool) {
+	var assignment types.MLNodeVersionAssignment
+	bz, err := k.MLNodeVersionAssignments.Get(ctx, epochIndex)
+	if err != nil {
+		return assignment, false
+	}
+	if err := json.Unmarshal(bz, &assignment); err != nil {
+		k.LogError("Failed to decode MLNode version assignment", types.Upgrades, "epochIndex", epochIndex, "error", err)
+		return assignment, false
+	}
+	return assignment, true
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMLNodeVersionRollout(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetMLNodeVersion(ctx, types.MLNodeVersion{CurrentVersion: "v3.0.8"}))
+
+	_, found := k.GetMLNodeVersionRollout(ctx)
+	require.False(t, found)
+
+	rollout := types.MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 20}
+	require.NoError(t, k.SetMLNodeVersionRollout(ctx, rollout))
+	stored, found := k.GetMLNodeVersionRollout(ctx)
+	require.True(t, found)
+	require.Equal(t, rollout, stored)
+	require.Error(t, k.SetMLNodeVersionRollout(ctx, types.MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 120}))
+
+	// Cancelling keeps the current version
+	require.NoError(t, k.SetMLNodeVersionRollout(ctx, types.MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 0}))
+	_, found = k.GetMLNodeVersionRollout(ctx)
+	require.False(t, found)
+	version, _ := k.GetMLNodeVersion(ctx)
+	require.Equal(t, "v3.0.8", version.CurrentVersion)
+
+	// Completing switches all nodes to the target
+	require.NoError(t, k.SetMLNodeVersionRollout(ctx, rollout))
+	require.NoError(t, k.SetMLNodeVersionRollout(ctx, types.MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 100}))
+	_, found = k.GetMLNodeVersionRollout(ctx)
+	require.False(t, found)
+	version, _ = k.GetMLNodeVersion(ctx)
+	require.Equal(t, "v3.1.0", version.CurrentVersion)
+}
+
+func TestMLNodeVersionAssignmentRetention(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	for epoch := uint64(1); epoch <= 8; epoch++ {
+		require.NoError(t, k.SetMLNodeVersionAssignment(ctx, types.MLNodeVersionAssignment{
+			EpochIndex:    epoch,
+			TargetVersion: "v3.1.0",
+			Participants:  []types.ParticipantCanaryNodes{{Participant: "p", NodeIds: []string{"node-1"}}},
+		}))
+	}
+	_, found := k.GetMLNodeVersionAssignment(ctx, 3)
+	require.False(t, found)
+	assignment, found := k.GetMLNodeVersionAssignment(ctx, 4)
+	require.True(t, found)
+	require.Equal(t, "v3.1.0", assignment.VersionFor("p", "node-1"))
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetModelAssignmentStrategy selects the algorithm used to assign ML nodes to models.
+// Governance selects it with MsgUpdatePolicy; see types.ModelAssignmentStrategy*.
+func (k Keeper) SetModelAssignmentStrategy(ctx context.Context, strategy string) error {
+	if err := types.ValidateModelAssignmentStrategy(strategy); err != nil {
+		return err
+	}
+	return k.ModelAssignmentStrategy.Set(ctx, strategy)
+}
+
+// GetModelAssignmentStrategy returns the active model assignment strategy,
+// or types.DefaultModelAssignmentStrategy if none was selected.
+func (k Keeper) GetModelAssignmentStrategy(ctx context.Context) string {
+	strategy, err := k.ModelAssignmentStrategy.Get(ctx)
+	if err != nil || strategy == "" {
+		return types.DefaultModelAssignmentStrategy
+	}
+	return strategy
+}
@@ -0,0 +1,21 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestModelAssignmentStrategy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultModelAssignmentStrategy, k.GetModelAssignmentStrategy(ctx))
+
+	require.NoError(t, k.SetModelAssignmentStrategy(ctx, types.ModelAssignmentStrategyBalanced))
+	require.Equal(t, types.ModelAssignmentStrategyBalanced, k.GetModelAssignmentStrategy(ctx))
+
+	require.Error(t, k.SetModelAssignmentStrategy(ctx, "unknown"))
+	require.Equal(t, types.ModelAssignmentStrategyBalanced, k.GetModelAssignmentStrategy(ctx))
+}
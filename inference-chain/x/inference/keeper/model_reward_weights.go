@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetModelRewardWeights selects the per-model multipliers applied to reward distribution from the next settlement on.
+// Governance adjusts the weights with MsgUpdatePolicy.
+func (k Keeper) SetModelRewardWeights(ctx context.Context, weights types.ModelRewardWeights) error {
+	if err := weights.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(weights)
+	if err != nil {
+		return err
+	}
+	return k.ModelRewardWeights.Set(ctx, bz)
+}
+
+// GetModelRewardWeights returns the active per-model reward multipliers, empty (disabled) if none were selected.
+func (k Keeper) GetModelRewardWeights(ctx context.Context) types.ModelRewardWeights {
+	bz, err := k.ModelRewardWeights.Get(ctx)
+	if err != nil {
+		return types.ModelRewardWeights{}
+	}
+	var weights types.ModelRewardWeights
+	if err := json.Unmarshal(bz, &weights); err != nil {
+		k.LogError("Failed to decode model reward weights, using none", types.Settle, "error", err)
+		return types.ModelRewardWeights{}
+	}
+	return weights
+}
+
+// AggregateModelWeightsFromModelSubgroups returns the weight of each participant in each model group of the epoch,
+// keyed by participant address and model id.
+func (k Keeper) AggregateModelWeightsFromModelSubgroups(ctx context.Context, parent *types.EpochGroupData) map[string]map[string]int64 {
+	modelWeights := make(map[string]map[string]int64)
+	for _, modelId := range parent.SubGroupModels {
+		subgroup, found := k.GetEpochGroupData(ctx, parent.EpochIndex, modelId)
+		if !found {
+			continue
+		}
+		for _, vw := range subgroup.ValidationWeights {
+			if vw.Weight <= 0 {
+				continue
+			}
+			if modelWeights[vw.MemberAddress] == nil {
+				modelWeights[vw.MemberAddress] = make(map[string]int64)
+			}
+			modelWeights[vw.MemberAddress][modelId] += vw.Weight
+		}
+	}
+	return modelWeights
+}
+
+// ApplyModelRewardWeights returns copies of the settlement inputs with the weights of every participant scaled by
+// the average multiplier of the models it served, weighted by its weight per model. Full, confirmation and ML node
+// weights are scaled alike, so each participant's share of the fixed epoch reward changes while the reward does not.
+// Participants without model weights keep their weights.
+func ApplyModelRewardWeights(
+	epochGroupData *types.EpochGroupData,
+	participantMLNodes map[string][]*types.MLNodeInfo,
+	modelWeights map[string]map[string]int64,
+	rewardWeights types.ModelRewardWeights,
+) (*types.EpochGroupData, map[string][]*types.MLNodeInfo) {
+	type multiplier struct {
+		numerator   *big.Int
+		denominator *big.Int
+	}
+	multipliers := make(map[string]multiplier, len(modelWeights))
+	for participant, weights := range modelWeights {
+		numerator, denominator := new(big.Int), new(big.Int)
+		for modelId, weight := range weights {
+			w := big.NewInt(weight)
+			numerator.Add(numerator, new(big.Int).Mul(w, big.NewInt(int64(rewardWeights.MultiplierPermille(modelId)))))
+			denominator.Add(denominator, new(big.Int).Mul(w, big.NewInt(int64(types.ModelRewardNeutralPermille))))
+		}
+		if denominator.Sign() > 0 {
+			multipliers[participant] = multiplier{numerator: numerator, denominator: denominator}
+		}
+	}
+	scale := func(participant string, weight int64) int64 {
+		m, ok := multipliers[participant]
+		if !ok || weight <= 0 {
+			return weight
+		}
+		scaled := new(big.Int).Mul(big.NewInt(weight), m.numerator)
+		scaled.Quo(scaled, m.denominator)
+		if !scaled.IsInt64() {
+			return weight
+		}
+		return scaled.Int64()
+	}
+
+	weighted := *epochGroupData
+	weighted.ValidationWeights = make([]*types.ValidationWeight, 0, len(epochGroupData.ValidationWeights))
+	for _, vw := range epochGroupData.ValidationWeights {
+		scaled := *vw
+		scaled.Weight = scale(vw.MemberAddress, vw.Weight)
+		scaled.ConfirmationWeight = scale(vw.MemberAddress, vw.ConfirmationWeight)
+		scaled.MlNodes = scaleMLNodes(vw.MlNodes, func(w int64) int64 { return scale(vw.MemberAddress, w) })
+		weighted.ValidationWeights = append(weighted.ValidationWeights, &scaled)
+	}
+
+	weightedMLNodes := make(map[string][]*types.MLNodeInfo, len(participantMLNodes))
+	for participant, nodes := range participantMLNodes {
+		weightedMLNodes[participant] = scaleMLNodes(nodes, func(w int64) int64 { return scale(participant, w) })
+	}
+	return &weighted, weightedMLNodes
+}
+
+func scaleMLNodes(nodes []*types.MLNodeInfo, scale func(int64) int64) []*types.MLNodeInfo {
+	if nodes == nil {
+		return nil
+	}
+	scaled := make([]*types.MLNodeInfo, 0, len(nodes))
+	for _, node := range nodes {
+		if node == nil {
+			scaled = append(scaled, nil)
+			continue
+		}
+		n := *node
+		n.PocWeight = scale(node.PocWeight)
+		scaled = append(scaled, &n)
+	}
+	return scaled
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestModelRewardWeights(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.False(t, k.GetModelRewardWeights(ctx).Enabled())
+
+	weights := types.ModelRewardWeights{MultipliersPermille: map[string]uint32{"large": 1400}}
+	require.NoError(t, k.SetModelRewardWeights(ctx, weights))
+	require.Equal(t, weights, k.GetModelRewardWeights(ctx))
+	require.Equal(t, uint32(1400), weights.MultiplierPermille("large"))
+	require.Equal(t, types.ModelRewardNeutralPermille, weights.MultiplierPermille("small"))
+
+	require.Error(t, k.SetModelRewardWeights(ctx, types.ModelRewardWeights{MultipliersPermille: map[string]uint32{"large": 0}}))
+	require.Error(t, k.SetModelRewardWeights(ctx, types.ModelRewardWeights{MultipliersPermille: map[string]uint32{"large": 10001}}))
+	require.Error(t, k.SetModelRewardWeights(ctx, types.ModelRewardWeights{MultipliersPermille: map[string]uint32{"": 1000}}))
+}
+
+func TestAggregateModelWeightsFromModelSubgroups(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	parent := types.EpochGroupData{EpochIndex: 5, SubGroupModels: []string{"large", "small"}}
+	k.SetEpochGroupData(ctx, parent)
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 5, ModelId: "large", ValidationWeights: []*types.ValidationWeight{
+		{MemberAddress: "p1", Weight: 300},
+	}})
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 5, ModelId: "small", ValidationWeights: []*types.ValidationWeight{
+		{MemberAddress: "p1", Weight: 100},
+		{MemberAddress: "p2", Weight: 200},
+		{MemberAddress: "p3", Weight: 0},
+	}})
+
+	require.Equal(t, map[string]map[string]int64{
+		"p1": {"large": 300, "small": 100},
+		"p2": {"small": 200},
+	}, k.AggregateModelWeightsFromModelSubgroups(ctx, &parent))
+}
+
+func TestApplyModelRewardWeights(t *testing.T) {
+	var (
+		participants     []types.Participant
+		validationWeight []*types.ValidationWeight
+		mlNodes          = make(map[string][]*types.MLNodeInfo)
+		modelWeights     = make(map[string]map[string]int64)
+	)
+	for i, modelId := range []string{"large", "large", "small", "small"} {
+		address := fmt.Sprintf("participant%d", i)
+		participants = append(participants, types.Participant{
+			Address:           address,
+			Status:            types.ParticipantStatus_ACTIVE,
+			CurrentEpochStats: &types.CurrentEpochStats{InferenceCount: 100},
+		})
+		nodes := []*types.MLNodeInfo{{NodeId: "node", PocWeight: 1000, TimeslotAllocation: []bool{true, false}}}
+		validationWeight = append(validationWeight, &types.ValidationWeight{MemberAddress: address, Weight: 1000, ConfirmationWeight: 1000, MlNodes: nodes})
+		mlNodes[address] = nodes
+		modelWeights[address] = map[string]int64{modelId: 1000}
+	}
+	epochGroupData := &types.EpochGroupData{EpochIndex: 1, ValidationWeights: validationWeight}
+	bitcoinParams := &types.BitcoinRewardParams{InitialEpochReward: 4_800_000, GenesisEpoch: 1}
+	rewardWeights := types.ModelRewardWeights{MultipliersPermille: map[string]uint32{"large": 1400}}
+
+	weighted, weightedMLNodes := keeper.ApplyModelRewardWeights(epochGroupData, mlNodes, modelWeights, rewardWeights)
+	require.Equal(t, int64(1400), weighted.ValidationWeights[0].Weight)
+	require.Equal(t, int64(1400), weighted.ValidationWeights[0].ConfirmationWeight)
+	require.Equal(t, int64(1400), weightedMLNodes["participant0"][0].PocWeight)
+	require.Equal(t, int64(1000), weighted.ValidationWeights[2].Weight)
+	// The inputs are left untouched
+	require.Equal(t, int64(1000), epochGroupData.ValidationWeights[0].Weight)
+	require.Equal(t, int64(1000), mlNodes["participant0"][0].PocWeight)
+
+	results, bitcoinResult, err := keeper.CalculateParticipantBitcoinRewards(participants, weighted, bitcoinParams, nil, weightedMLNodes, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, int64(4_800_000), bitcoinResult.Amount)
+	require.Equal(t, uint64(1_400_000), results[0].Settle.RewardCoins)
+	require.Equal(t, uint64(1_400_000), results[1].Settle.RewardCoins)
+	require.Equal(t, uint64(1_000_000), results[2].Settle.RewardCoins)
+	require.Equal(t, uint64(1_000_000), results[3].Settle.RewardCoins)
+	require.Zero(t, bitcoinResult.GovernanceAmount)
+
+	// A participant serving both models gets the weighted average multiplier
+	mixed := map[string]map[string]int64{"participant0": {"large": 500, "small": 1500}}
+	weighted, _ = keeper.ApplyModelRewardWeights(epochGroupData, mlNodes, mixed, rewardWeights)
+	require.Equal(t, int64(1100), weighted.ValidationWeights[0].Weight)
+	require.Equal(t, int64(1000), weighted.ValidationWeights[1].Weight)
+}
@@ -70,16 +70,28 @@ func (ms msgServer) payoutClaim(ctx sdk.Context, msg *types.MsgClaimRewards, set
 			Result: "Error paying participant from escrow",
 		}, err
 	}
+	denomWorkCoins := sdk.NewCoins(settleAmount.DenomWorkCoins...)
+	if err := ms.payDenomWorkCoins(ctx, msg.Creator, denomWorkCoins, "denom_work_coins:"+settleAmount.Participant, workVestingPeriod); err != nil {
+		// The coins stay in the denom treasury reserve; the BaseCoin work is paid and the claim goes on
+		ms.LogError("Error paying participant denom work coins", types.Claims, "error", err, "coins", denomWorkCoins.String())
+	}
 	ms.AddTokenomicsData(ctx, &types.TokenomicsData{TotalFees: settleAmount.GetWorkCoins()})
 
-	// Pay rewards from module
+	// Pay rewards from module. Under a reward vesting schedule only the immediate share is paid now,
+	// the remainder stays in the module and is released with later claims.
 	rewardVestingPeriod := &params.TokenomicsParams.RewardVestingPeriod
-	if err := ms.PayParticipantFromModule(ctx, msg.Creator, int64(settleAmount.GetRewardCoins()), types.ModuleName, "reward_coins:"+settleAmount.Participant, rewardVestingPeriod); err != nil {
+	vestingSchedule := ms.GetRewardVestingSchedule(ctx)
+	immediateRewards, vestedRewards := vestingSchedule.Split(settleAmount.GetRewardCoins())
+	if vestingSchedule.Enabled() {
+		rewardVestingPeriod = nil
+	}
+	if err := ms.PayParticipantFromModule(ctx, msg.Creator, int64(immediateRewards), types.ModuleName, "reward_coins:"+settleAmount.Participant, rewardVestingPeriod); err != nil {
 		if sdkerrors.ErrInsufficientFunds.Is(err) {
 			ms.LogError("Insufficient funds for paying rewards. Work paid, rewards declined", types.Claims, "error", err, "settleAmount", settleAmount)
 		} else {
 			ms.LogError("Error paying participant for rewards", types.Claims, "error", err)
 		}
+		ms.EmitSettlementClaimedEvent(ctx, settleAmount, settleAmount.GetWorkCoins(), 0, types.SettlementStatusRewardsFailed)
 		ms.finishSettle(ctx, settleAmount)
 		return &types.MsgClaimRewardsResponse{
 			Amount: settleAmount.GetWorkCoins(),
@@ -87,7 +99,13 @@ func (ms msgServer) payoutClaim(ctx sdk.Context, msg *types.MsgClaimRewards, set
 		}, err
 	}
 
+	if err := ms.vestRewards(ctx, msg.Creator, settleAmount.EpochIndex, vestedRewards, vestingSchedule); err != nil {
+		ms.LogError("Failed to record vesting rewards", types.Claims, "error", err, "settleAmount", settleAmount, "vested", vestedRewards)
+	}
+
+	ms.EmitSettlementClaimedEvent(ctx, settleAmount, settleAmount.GetWorkCoins(), settleAmount.GetRewardCoins(), types.SettlementStatusPaid)
 	ms.finishSettle(ctx, settleAmount)
+	ms.releaseVestedRewards(ctx, msg.Creator)
 	// impossible, but check anyhow
 	if settleAmount.GetTotalCoins() < 0 {
 		return nil, types.ErrNegativeRewardAmount
@@ -116,7 +134,7 @@ func (ms msgServer) parseBalanceError(errMsg string) (spendable int64, required
 }
 
 func (ms msgServer) finishSettle(ctx sdk.Context, settleAmount *types.SettleAmount) {
-	ms.RemoveSettleAmount(ctx, settleAmount.Participant)
+	ms.removeClaimableSettleAmount(ctx, *settleAmount)
 	perfSummary, found := ms.GetEpochPerformanceSummary(ctx, settleAmount.EpochIndex, settleAmount.Participant)
 	if found {
 		perfSummary.Claimed = true
@@ -137,28 +155,30 @@ func (k msgServer) validateRequest(ctx sdk.Context, msg *types.MsgClaimRewards)
 		}
 	}
 
-	if (currentEpoch.GroupData.EpochIndex - 1) != msg.EpochIndex {
+	// Claims are for the previous epoch, or for an earlier one whose settle amount was carried over
+	claimEpoch := currentEpoch.GroupData.EpochIndex - 1
+	if msg.EpochIndex > claimEpoch || claimEpoch-msg.EpochIndex > SettleCarryoverEpochs {
 		k.LogError("Current epoch group does not match previous epoch", types.Claims, "epoch", msg.EpochIndex, "currentEpoch", currentEpoch.GroupData.EpochIndex)
 		return nil, &types.MsgClaimRewardsResponse{
 			Amount: 0,
 			Result: "Can't validate claim, current epoch group does not match previous epoch",
 		}
 	}
-	settleAmount, found := k.GetSettleAmount(ctx, msg.Creator)
+	settleAmount, found := k.getClaimableSettleAmount(ctx, msg.Creator, msg.EpochIndex)
 	if !found {
+		if current, exists := k.GetSettleAmount(ctx, msg.Creator); exists {
+			k.LogWarn("SettleAmount does not match epoch index", types.Claims, "epoch", msg.EpochIndex, "settleEpoch", current.EpochIndex)
+			return nil, &types.MsgClaimRewardsResponse{
+				Amount: 0,
+				Result: "No rewards for this block height",
+			}
+		}
 		k.LogInfo("SettleAmount not found for address", types.Claims, "address", msg.Creator)
 		return nil, &types.MsgClaimRewardsResponse{
 			Amount: 0,
 			Result: "No rewards for this address",
 		}
 	}
-	if settleAmount.EpochIndex != msg.EpochIndex {
-		k.LogWarn("SettleAmount does not match epoch index", types.Claims, "epoch", msg.EpochIndex, "settleEpoch", settleAmount.EpochIndex)
-		return nil, &types.MsgClaimRewardsResponse{
-			Amount: 0,
-			Result: "No rewards for this block height",
-		}
-	}
 	if ctx.BlockHeight()-settleAmount.LastClaimAttempt < 30 {
 		k.LogInfo("Claim rate limited", types.Claims, "address", msg.Creator, "lastAttempt", settleAmount.LastClaimAttempt)
 		return nil, &types.MsgClaimRewardsResponse{
@@ -167,7 +187,7 @@ func (k msgServer) validateRequest(ctx sdk.Context, msg *types.MsgClaimRewards)
 		}
 	}
 	settleAmount.LastClaimAttempt = ctx.BlockHeight()
-	if err := k.SetSettleAmount(ctx, settleAmount); err != nil {
+	if err := k.setClaimableSettleAmount(ctx, settleAmount); err != nil {
 		return nil, &types.MsgClaimRewardsResponse{
 			Amount: 0,
 			Result: "Internal error updating settle amount",
@@ -366,7 +386,10 @@ func (k msgServer) getMustBeValidatedInferences(ctx sdk.Context, msg *types.MsgC
 	if err != nil {
 		return nil, fmt.Errorf("failed to get params: %w", err)
 	}
-	epochContext := types.NewEpochContext(*epoch, *params.EpochParams)
+	epochContext, err := k.Keeper.GetEpochContext(ctx, *epoch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get epoch context: %w", err)
+	}
 
 	// Create a map to store weight maps for each model
 	modelWeightMaps := make(map[string]map[string]types.ValidationWeight)
@@ -67,6 +67,7 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 		SeedSignature: signatureHex,
 	}
 	_ = k.SetSettleAmount(sdk.UnwrapSDKContext(ctx), settleAmount)
+	require.NoError(t, k.SetEpochSubsidyTotal(ctx, epochIndex, 2000))
 
 	// Setup epoch group data
 	epochData := types.EpochGroupData{
@@ -185,6 +186,26 @@ func TestMsgServer_ClaimRewards(t *testing.T) {
 	updatedPerfSummary, found := k.GetEpochPerformanceSummary(sdk.UnwrapSDKContext(ctx), epochIndex, testutil.Creator)
 	require.True(t, found)
 	require.True(t, updatedPerfSummary.Claimed)
+
+	// Verify the settlement event carries the per-participant payout
+	var settlementEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSettlementClaimed {
+			settlementEvent = &event
+		}
+	}
+	require.NotNil(t, settlementEvent)
+	attributes := make(map[string]string)
+	for _, attr := range settlementEvent.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+	require.Equal(t, testutil.Creator, attributes[types.AttributeKeyParticipant])
+	require.Equal(t, "100", attributes[types.AttributeKeyEpochIndex])
+	require.Equal(t, "1000", attributes[types.AttributeKeyWorkCoins])
+	require.Equal(t, "500", attributes[types.AttributeKeyRewardCoins])
+	require.Equal(t, "0.25", attributes[types.AttributeKeySubsidyShare])
+	require.Equal(t, "2000", attributes[types.AttributeKeyEpochSubsidy])
+	require.Equal(t, types.SettlementStatusPaid, attributes[types.AttributeKeyStatus])
 }
 
 func TestMsgServer_ClaimRewards_NoRewards(t *testing.T) {
@@ -1039,3 +1060,45 @@ func pocAvailabilityTest(t *testing.T, validatorIsAvailableDuringPoC bool) {
 		require.Equal(t, "Rewards claimed successfully", resp.Result)
 	}
 }
+
+func TestMsgServer_ClaimRewards_CarriedOverEpoch(t *testing.T) {
+	k, ms, ctx, mocks := setupKeeperWithMocks(t)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	currentEpoch := types.Epoch{Index: 102, PocStartBlockHeight: 3000}
+	k.SetEpoch(ctx, &currentEpoch)
+	_ = k.SetEffectiveEpochIndex(ctx, currentEpoch.Index)
+	k.SetEpochGroupData(sdk.UnwrapSDKContext(ctx), types.EpochGroupData{
+		EpochIndex:          currentEpoch.Index,
+		EpochGroupId:        102,
+		PocStartBlockHeight: currentEpoch.Index,
+	})
+
+	// Epoch 100 went unclaimed and was carried over when epoch 101 settled
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: testutil.Creator, EpochIndex: 100, WorkCoins: 1000, RewardCoins: 500, SeedSignature: "00"}))
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: testutil.Creator, EpochIndex: 101, WorkCoins: 10, RewardCoins: 5}))
+
+	addr, err := sdk.AccAddressFromBech32(testutil.Creator)
+	require.NoError(t, err)
+	mocks.AccountKeeper.EXPECT().GetAccount(gomock.Any(), addr).Return(NewMockAccount(testutil.Creator)).AnyTimes()
+	mocks.AuthzKeeper.EXPECT().GranterGrants(gomock.Any(), gomock.Any()).Return(&authztypes.QueryGranterGrantsResponse{Grants: []*authztypes.GrantAuthorization{}}, nil).AnyTimes()
+
+	// Outside the carryover window
+	resp, err := ms.ClaimRewards(ctx.WithBlockHeight(claimDebounceBlocks+1), &types.MsgClaimRewards{Creator: testutil.Creator, EpochIndex: 99, Seed: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Can't validate claim, current epoch group does not match previous epoch", resp.Result)
+
+	// The carried over amount is found and goes through regular claim validation
+	resp, err = ms.ClaimRewards(ctx.WithBlockHeight(claimDebounceBlocks+1), &types.MsgClaimRewards{Creator: testutil.Creator, EpochIndex: 100, Seed: 1})
+	require.NoError(t, err)
+	require.Equal(t, "Seed signature validation failed", resp.Result)
+
+	carried, found := k.GetSettleCarryover(ctx, testutil.Creator, 100)
+	require.True(t, found)
+	require.Equal(t, int64(claimDebounceBlocks+1), carried.LastClaimAttempt)
+
+	current, found := k.GetSettleAmount(ctx, testutil.Creator)
+	require.True(t, found)
+	require.Equal(t, uint64(101), current.EpochIndex)
+	require.Zero(t, current.LastClaimAttempt)
+}
@@ -14,6 +14,9 @@ func (k msgServer) CreatePartialUpgrade(goCtx context.Context, msg *types.MsgCre
 	if k.GetAuthority() != msg.Authority {
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
 	}
+	if _, isRollout, err := types.ParseMLNodeVersionRollout(msg.NodeVersion, msg.ApiBinariesJson); isRollout && err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMLNodeVersionRollout, err.Error())
+	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	k.LogInfo("CreatePartialUpgrade", types.Upgrades, "height", msg.Height, "node_version", msg.NodeVersion, "api_binaries_json", msg.ApiBinariesJson)
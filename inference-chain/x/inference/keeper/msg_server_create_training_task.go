@@ -17,6 +17,11 @@ func (k msgServer) CreateTrainingTask(goCtx context.Context, msg *types.MsgCreat
 		return nil, err
 	}
 
+	datasets, err := k.resolveTrainingTaskDatasets(ctx, msg.Config)
+	if err != nil {
+		return nil, err
+	}
+
 	taskId := k.GetNextTaskID(ctx)
 
 	task := &types.TrainingTask{
@@ -30,10 +35,14 @@ func (k msgServer) CreateTrainingTask(goCtx context.Context, msg *types.MsgCreat
 		Epoch:                 training.NewEmptyEpochInfo(),
 	}
 
-	err := k.CreateTask(ctx, task)
+	err = k.CreateTask(ctx, task)
 	if err != nil {
 		return nil, err
 	}
+	datasets.TaskId = taskId
+	if err := k.setTrainingTaskDatasets(ctx, datasets); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgCreateTrainingTaskResponse{
 		Task: task,
@@ -30,12 +30,13 @@ func TestCreateTrainingTask_AllowListEnforced(t *testing.T) {
 	acc, e := sdk.AccAddressFromBech32("gonka1hgt9lxxxwpsnc3yn2nheqqy9a8vlcjwvgzpve2")
 	require.NoError(t, e)
 	require.NoError(t, k.TrainingStartAllowListSet.Set(wctx, acc))
+	require.NoError(t, k.RegisterTrainingDataset(wctx, testTrainingDataset("train")))
 
 	// now allowed -> should succeed
 	resp, err := ms.CreateTrainingTask(wctx, &types.MsgCreateTrainingTask{
 		Creator:           "gonka1hgt9lxxxwpsnc3yn2nheqqy9a8vlcjwvgzpve2",
 		HardwareResources: []*types.TrainingHardwareResources{},
-		Config:            &types.TrainingConfig{},
+		Config:            &types.TrainingConfig{Datasets: &types.TrainingDatasets{Train: "train"}},
 	})
 	require.NoError(t, err)
 	require.NotNil(t, resp)
@@ -0,0 +1,17 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) DelegateToParticipant(goCtx context.Context, msg *types.MsgDelegateToParticipant) (*types.MsgDelegateToParticipantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Delegate(ctx, msg.Delegator, msg.Participant, msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgDelegateToParticipantResponse{}, nil
+}
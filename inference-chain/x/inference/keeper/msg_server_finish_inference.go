@@ -252,6 +252,10 @@ func (k msgServer) handleInferenceCompleted(ctx sdk.Context, existingInference *
 	existingInference.EpochPocStartBlockHeight = uint64(effectiveEpoch.PocStartBlockHeight)
 	existingInference.EpochId = effectiveEpoch.Index
 	currentEpochGroup.GroupData.NumberOfRequests++
+	if err := k.RecordModelEpochUsage(ctx, effectiveEpoch.Index, existingInference); err != nil {
+		k.LogError("Unable to record model epoch usage", types.Inferences, "err", err)
+		return err
+	}
 
 	executorPower := uint64(0)
 	executorReputation := int32(0)
@@ -306,6 +310,9 @@ func (k msgServer) handleInferenceCompleted(ctx sdk.Context, existingInference *
 	if err != nil {
 		return err
 	}
+	if err := k.markEscrowReleasable(ctx, existingInference); err != nil {
+		return err
+	}
 	k.SetEpochGroupData(ctx, *currentEpochGroup.GroupData)
 	return nil
 }
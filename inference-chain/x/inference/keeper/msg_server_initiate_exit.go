@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) InitiateExit(goCtx context.Context, msg *types.MsgInitiateExit) (*types.MsgInitiateExitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	exit, err := k.Keeper.InitiateExit(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgInitiateExitResponse{LastEpoch: exit.LastEpoch}, nil
+}
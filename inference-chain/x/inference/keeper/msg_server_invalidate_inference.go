@@ -19,6 +19,11 @@ func (k msgServer) InvalidateInference(ctx context.Context, msg *types.MsgInvali
 		k.LogDebug("Inference already invalidated", types.Validation, "inferenceId", msg.InferenceId)
 		return nil, nil
 	}
+	if k.InvalidationsPaused(ctx) {
+		k.LogWarn("Validation circuit breaker tripped, not invalidating inference", types.Validation, "inferenceId", inference.InferenceId, "executor", executor.Address)
+		inference.Status = types.InferenceStatus_FINISHED
+		return &types.MsgInvalidateInferenceResponse{}, k.SetInference(ctx, *inference)
+	}
 	inference.Status = types.InferenceStatus_INVALIDATED
 	executor.CurrentEpochStats.InvalidatedInferences++
 	executor.ConsecutiveInvalidInferences++
@@ -60,16 +65,29 @@ func (k msgServer) refundInvalidatedInference(executor *types.Participant, infer
 		return types.ErrParticipantNotFound
 	}
 
+	// An executor paid in a registered denom gives its payment back to the escrow the refund is paid from
+	paidInDenom, err := k.reclaimExecutorPaymentInDenom(ctx, inference.InferenceId, executor.Address, inference.ActualCost)
+	if err != nil {
+		return err
+	}
+
 	// Attempt refund BEFORE modifying executor balance
 	// If refund fails (e.g. underfunded escrow), don't corrupt state
-	err := k.IssueRefund(ctx, inference.ActualCost, payer.Address, "invalidated_inference:"+inference.InferenceId)
+	err = k.IssueInferenceRefund(ctx, inference.InferenceId, inference.ActualCost, payer.Address, "invalidated_inference:"+inference.InferenceId)
 	if err != nil {
 		k.LogError("Refund failed", types.Validation, "error", err)
 		return err
 	}
 
+	if err := k.RefundInferenceEscrow(ctx, inference.InferenceId, inference.ActualCost); err != nil {
+		return err
+	}
+
 	// Only deduct from executor after successful refund
-	executor.CoinBalance -= inference.ActualCost
+	if !paidInDenom {
+		executor.CoinBalance -= inference.ActualCost
+	}
+	k.recordRefundedEarnings(ctx, executor.Address, inference.EpochId, inference.ActualCost)
 	k.SafeLogSubAccountTransaction(ctx, types.ModuleName, executor.Address, types.OwedSubAccount, inference.ActualCost, "invalidated_inference:"+inference.InferenceId)
 	k.LogInfo("Invalid Inference subtracted from Executor CoinBalance ", types.Balances, "inferenceId", inference.InferenceId, "executor", executor.Address, "actualCost", inference.ActualCost, "coinBalance", executor.CoinBalance)
 
@@ -52,11 +52,10 @@ func (k msgServer) submitPocBatchV1(goCtx context.Context, msg *types.MsgSubmitP
 		}
 
 		// Verify we're in the batch submission window (generation + exchange period)
-		params, err := k.GetParams(ctx)
+		epochParams, err := k.GetEpochParamsForEpoch(ctx, activeEvent.EpochIndex)
 		if err != nil {
 			return nil, err
 		}
-		epochParams := params.EpochParams
 		if !activeEvent.IsInBatchSubmissionWindow(currentBlockHeight, epochParams) {
 			k.LogError(PocFailureTag+"[SubmitPocBatch] Confirmation PoC: outside batch submission window", types.PoC,
 				"participant", msg.Creator,
@@ -87,11 +86,6 @@ func (k msgServer) submitPocBatchV1(goCtx context.Context, msg *types.MsgSubmitP
 	}
 
 	// Regular PoC logic
-	regularParams, err := k.Keeper.GetParams(goCtx)
-	if err != nil {
-		return nil, err
-	}
-	epochParams := regularParams.EpochParams
 	upcomingEpoch, found := k.Keeper.GetUpcomingEpoch(ctx)
 	if !found {
 		k.LogError(PocFailureTag+"[SubmitPocBatch] Failed to get upcoming epoch", types.PoC,
@@ -99,7 +93,10 @@ func (k msgServer) submitPocBatchV1(goCtx context.Context, msg *types.MsgSubmitP
 			"currentBlockHeight", currentBlockHeight)
 		return nil, sdkerrors.Wrap(types.ErrUpcomingEpochNotFound, "Failed to get upcoming epoch")
 	}
-	epochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+	epochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+	if err != nil {
+		return nil, err
+	}
 
 	if !epochContext.IsStartOfPocStage(startBlockHeight) {
 		k.LogError(PocFailureTag+"[SubmitPocBatch] message start block height doesn't match the upcoming epoch group", types.PoC,
@@ -113,7 +110,23 @@ func (k msgServer) submitPocBatchV1(goCtx context.Context, msg *types.MsgSubmitP
 		return nil, sdkerrors.Wrap(types.ErrPocWrongStartBlockHeight, errMsg)
 	}
 
-	if !epochContext.IsPoCExchangeWindow(currentBlockHeight) {
+	if !epochContext.IsPoCExchangeWindow(currentBlockHeight) && k.GetLateJoinPolicy(ctx).IsLateJoinWindow(&epochContext, currentBlockHeight) {
+		creator, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidAddress, err.Error())
+		}
+		onTime, err := k.hasOnTimePocBatch(ctx, startBlockHeight, creator, epochContext.PoCExchangeDeadline())
+		if err != nil {
+			return nil, err
+		}
+		if onTime {
+			return nil, sdkerrors.Wrap(types.ErrPocSubmittedOnTime, "participant submitted PoC batches before the PoC exchange deadline")
+		}
+		k.LogInfo("[SubmitPocBatch] Accepting late-join batch", types.PoC,
+			"participant", msg.Creator,
+			"currentBlockHeight", currentBlockHeight,
+			"exchangeDeadline", epochContext.PoCExchangeDeadline())
+	} else if !epochContext.IsPoCExchangeWindow(currentBlockHeight) {
 		k.LogError(PocFailureTag+"PoC exchange window is closed.", types.PoC,
 			"participant", msg.Creator,
 			"msg.PocStageStartBlockHeight", startBlockHeight,
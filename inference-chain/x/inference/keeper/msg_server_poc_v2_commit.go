@@ -42,26 +42,35 @@ func (k msgServer) PoCV2StoreCommit(goCtx context.Context, msg *types.MsgPoCV2St
 
 	// Validate PoC window
 	// For confirmation PoC: accept during batch submission window (generation + exchange)
-	// For regular PoC: accept during exchange window
+	// For regular PoC: accept during exchange window, and from late joiners during the late-join window
+	var lateJoinDeadline int64
 	if isActive && activeEvent != nil && startBlockHeight == activeEvent.TriggerHeight {
-		epochParams := params.EpochParams
+		epochParams, err := k.GetEpochParamsForEpoch(goCtx, activeEvent.EpochIndex)
+		if err != nil {
+			return nil, err
+		}
 		if !activeEvent.IsInBatchSubmissionWindow(currentBlockHeight, epochParams) {
 			return nil, sdkerrors.Wrap(types.ErrPocTooLate, "confirmation PoC batch submission window closed")
 		}
 	} else {
-		epochParams := params.EpochParams
 		upcomingEpoch, found := k.Keeper.GetUpcomingEpoch(ctx)
 		if !found {
 			return nil, sdkerrors.Wrap(types.ErrUpcomingEpochNotFound, "failed to get upcoming epoch")
 		}
-		epochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+		epochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+		if err != nil {
+			return nil, err
+		}
 
 		if !epochContext.IsStartOfPocStage(startBlockHeight) {
 			return nil, sdkerrors.Wrap(types.ErrPocWrongStartBlockHeight,
 				fmt.Sprintf("start block height %d doesn't match PoC stage start %d", startBlockHeight, epochContext.PocStartBlockHeight))
 		}
 		if !epochContext.IsPoCExchangeWindow(currentBlockHeight) {
-			return nil, sdkerrors.Wrap(types.ErrPocTooLate, "PoC exchange window closed")
+			if !k.GetLateJoinPolicy(ctx).IsLateJoinWindow(&epochContext, currentBlockHeight) {
+				return nil, sdkerrors.Wrap(types.ErrPocTooLate, "PoC exchange window closed")
+			}
+			lateJoinDeadline = epochContext.PoCExchangeDeadline()
 		}
 	}
 
@@ -73,6 +82,9 @@ func (k msgServer) PoCV2StoreCommit(goCtx context.Context, msg *types.MsgPoCV2St
 	pk := collections.Join(startBlockHeight, addr)
 	existing, err := k.PoCV2StoreCommits.Get(ctx, pk)
 	if err == nil {
+		if lateJoinDeadline > 0 && existing.CommitBlockHeight <= lateJoinDeadline {
+			return nil, sdkerrors.Wrap(types.ErrPocSubmittedOnTime, "commit was stored before the PoC exchange deadline")
+		}
 		// Same-block rate limit: only one commit per block allowed
 		if existing.CommitBlockHeight == currentBlockHeight {
 			return nil, sdkerrors.Wrap(types.ErrIllegalState, "only one commit per block allowed")
@@ -137,26 +149,23 @@ func (k msgServer) MLNodeWeightDistribution(goCtx context.Context, msg *types.Ms
 			return nil, sdkerrors.Wrap(types.ErrPocWrongStartBlockHeight,
 				fmt.Sprintf("confirmation PoC: start block height %d doesn't match event trigger %d", startBlockHeight, activeEvent.TriggerHeight))
 		}
-		confirmParams, err := k.GetParams(ctx)
+		epochParams, err := k.GetEpochParamsForEpoch(ctx, activeEvent.EpochIndex)
 		if err != nil {
 			return nil, err
 		}
-		epochParams := confirmParams.EpochParams
 		validationEnd := activeEvent.GetValidationEnd(epochParams)
 		if currentBlockHeight > validationEnd {
 			return nil, sdkerrors.Wrap(types.ErrPocTooLate, "confirmation PoC validation window closed")
 		}
 	} else {
-		regularParams, err := k.Keeper.GetParams(goCtx)
-		if err != nil {
-			return nil, err
-		}
-		epochParams := regularParams.EpochParams
 		upcomingEpoch, found := k.Keeper.GetUpcomingEpoch(ctx)
 		if !found {
 			return nil, sdkerrors.Wrap(types.ErrUpcomingEpochNotFound, "failed to get upcoming epoch")
 		}
-		epochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+		epochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+		if err != nil {
+			return nil, err
+		}
 
 		if !epochContext.IsStartOfPocStage(startBlockHeight) {
 			return nil, sdkerrors.Wrap(types.ErrPocWrongStartBlockHeight,
@@ -61,11 +61,10 @@ func (k msgServer) submitPocValidationV1(goCtx context.Context, msg *types.MsgSu
 		}
 
 		// Verify we're in the validation window
-		confirmParams, err := k.GetParams(ctx)
+		epochParams, err := k.GetEpochParamsForEpoch(ctx, activeEvent.EpochIndex)
 		if err != nil {
 			return nil, err
 		}
-		epochParams := confirmParams.EpochParams
 		if !activeEvent.IsInValidationWindow(currentBlockHeight, epochParams) {
 			k.LogError(PocFailureTag+"[SubmitPocValidation] Confirmation PoC: outside validation window", types.PoC,
 				"participant", msg.ParticipantAddress,
@@ -91,11 +90,6 @@ func (k msgServer) submitPocValidationV1(goCtx context.Context, msg *types.MsgSu
 	}
 
 	// Regular PoC logic
-	regularParams, err := k.Keeper.GetParams(ctx)
-	if err != nil {
-		return nil, err
-	}
-	epochParams := regularParams.EpochParams
 	upcomingEpoch, found := k.Keeper.GetUpcomingEpoch(ctx)
 	if !found {
 		k.LogError(PocFailureTag+"[SubmitPocValidation] Failed to get upcoming epoch", types.PoC,
@@ -104,7 +98,10 @@ func (k msgServer) submitPocValidationV1(goCtx context.Context, msg *types.MsgSu
 			"currentBlockHeight", currentBlockHeight)
 		return nil, sdkerrors.Wrap(types.ErrUpcomingEpochNotFound, "[SubmitPocBatch] Failed to get upcoming epoch")
 	}
-	epochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+	epochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+	if err != nil {
+		return nil, err
+	}
 
 	if !epochContext.IsStartOfPocStage(startBlockHeight) {
 		k.LogError(PocFailureTag+"[SubmitPocValidation] message start block height doesn't match the upcoming epoch", types.PoC,
@@ -53,11 +53,10 @@ func (k msgServer) SubmitPocValidationsV2(goCtx context.Context, msg *types.MsgS
 		}
 
 		// Verify we're in the validation window
-		confirmParams, err := k.GetParams(ctx)
+		epochParams, err := k.GetEpochParamsForEpoch(ctx, activeEvent.EpochIndex)
 		if err != nil {
 			return nil, err
 		}
-		epochParams := confirmParams.EpochParams
 		if !activeEvent.IsInValidationWindow(currentBlockHeight, epochParams) {
 			k.LogError(PocFailureTag+"[SubmitPocValidationsV2] Confirmation PoC: outside validation window", types.PoC,
 				"validatorParticipant", msg.Creator,
@@ -68,11 +67,6 @@ func (k msgServer) SubmitPocValidationsV2(goCtx context.Context, msg *types.MsgS
 		}
 	} else {
 		// Regular PoC logic
-		regularParams, err := k.Keeper.GetParams(ctx)
-		if err != nil {
-			return nil, err
-		}
-		epochParams := regularParams.EpochParams
 		upcomingEpoch, found := k.Keeper.GetUpcomingEpoch(ctx)
 		if !found {
 			k.LogError(PocFailureTag+"[SubmitPocValidationsV2] Failed to get upcoming epoch", types.PoC,
@@ -80,7 +74,10 @@ func (k msgServer) SubmitPocValidationsV2(goCtx context.Context, msg *types.MsgS
 				"currentBlockHeight", currentBlockHeight)
 			return nil, sdkerrors.Wrap(types.ErrUpcomingEpochNotFound, "[SubmitPocValidationsV2] Failed to get upcoming epoch")
 		}
-		epochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+		epochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+		if err != nil {
+			return nil, err
+		}
 
 		if !epochContext.IsStartOfPocStage(startBlockHeight) {
 			k.LogError(PocFailureTag+"[SubmitPocValidationsV2] message start block height doesn't match the upcoming epoch", types.PoC,
@@ -14,6 +14,10 @@ func (k msgServer) RegisterModel(goCtx context.Context, msg *types.MsgRegisterMo
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "MsgRegisterModel. invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
 	}
 
+	if err := types.ValidateModelId(msg.Id); err != nil {
+		return nil, err
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	k.SetModel(ctx, &types.Model{
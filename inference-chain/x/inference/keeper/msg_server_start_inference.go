@@ -62,12 +62,18 @@ func (k msgServer) StartInference(goCtx context.Context, msg *types.MsgStartInfe
 		return failedStart(ctx, sdkerrors.Wrap(types.ErrInferenceStartProcessed, "inference has already start processed"), msg), nil
 	}
 
+	// The model of the message carries the priority class of the inference, the inference keeps the bare model id
+	modelId, priorityClass := types.SplitPriorityClassModel(msg.Model)
+	msg.Model = modelId
+
 	// Record the current price only if this is the first message (FinishInference not processed yet)
 	// This ensures consistent pricing regardless of message arrival order
 	if !existingInference.FinishedProcessed() {
 		existingInference.Model = msg.Model
 		k.RecordInferencePrice(goCtx, &existingInference, msg.InferenceId)
 	}
+	// The class is only known from the start: a price locked by FinishInference is the model price and is scaled here too
+	existingInference.PerTokenPrice = k.GetPriorityClassPricing(ctx).PerTokenPrice(existingInference.PerTokenPrice, priorityClass)
 
 	blockContext := calculations.BlockContext{
 		BlockHeight:    ctx.BlockHeight(),
@@ -206,14 +212,19 @@ func (k msgServer) processInferencePayments(
 			return nil, err
 		}
 		inference.EscrowAmount = escrowAmount
+		if err := k.lockInferenceEscrow(ctx, inference, escrowAmount); err != nil {
+			return nil, err
+		}
 	}
 	if payments.EscrowAmount < 0 {
 		if !allowRefund {
 			return nil, sdkerrors.Wrapf(types.ErrInvalidEscrowAmount, "escrow amount cannot be negative here: %d", payments.EscrowAmount)
 		}
-		err := k.IssueRefund(ctx, -payments.EscrowAmount, inference.RequestedBy, "inference_refund:"+inference.InferenceId)
+		err := k.IssueInferenceRefund(ctx, inference.InferenceId, -payments.EscrowAmount, inference.RequestedBy, "inference_refund:"+inference.InferenceId)
 		if err != nil {
 			k.LogError("Unable to Issue Refund for started inference", types.Payments, err)
+		} else if err := k.updateLockedEscrow(ctx, inference.InferenceId, func(e *types.InferenceEscrow) { e.Refunded -= payments.EscrowAmount }); err != nil {
+			k.LogError("Unable to record refund in inference escrow", types.Payments, "inferenceId", inference.InferenceId, "error", err)
 		}
 	}
 	if payments.ExecutorPayment > 0 {
@@ -222,10 +233,23 @@ func (k msgServer) processInferencePayments(
 		if !found {
 			return nil, sdkerrors.Wrap(types.ErrParticipantNotFound, executedBy)
 		}
-		executor.CoinBalance += payments.ExecutorPayment
+		paidInDenom, err := k.payExecutorInDenom(ctx, inference.InferenceId, executedBy, payments.ExecutorPayment)
+		if err != nil {
+			return nil, err
+		}
+		if !paidInDenom {
+			executor.CoinBalance += payments.ExecutorPayment
+		}
 		executor.CurrentEpochStats.EarnedCoins += uint64(payments.ExecutorPayment)
 		k.SafeLogSubAccountTransaction(ctx, executor.Address, types.ModuleName, types.OwedSubAccount, executor.CoinBalance, "inference_started:"+inference.InferenceId)
-		err := k.SetParticipant(ctx, executor)
+		err = k.SetParticipant(ctx, executor)
+		if err != nil {
+			return nil, err
+		}
+		err = k.updateLockedEscrow(ctx, inference.InferenceId, func(e *types.InferenceEscrow) {
+			e.Executor = executedBy
+			e.ExecutorPayment += payments.ExecutorPayment
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/productscience/inference/x/inference/types"
 	"golang.org/x/exp/slices"
@@ -42,6 +43,14 @@ func (k msgServer) SubmitHardwareDiff(goCtx context.Context, msg *types.MsgSubmi
 		}
 	}
 
+	// A relayed hardware attestation must be signed with the participant's worker key
+	for _, node := range msg.NewOrModified {
+		if _, _, err := k.VerifyHardwareAttestation(ctx, msg.Creator, node); err != nil {
+			k.LogWarn("Rejecting invalid hardware attestation", types.Nodes, "participant", msg.Creator, "node_id", node.LocalId, "error", err)
+			return nil, errorsmod.Wrapf(types.ErrInvalidHardwareAttestation, "node %s: %s", node.LocalId, err)
+		}
+	}
+
 	existingNodes, found := k.GetHardwareNodes(ctx, msg.Creator)
 	if !found {
 		existingNodes = &types.HardwareNodes{
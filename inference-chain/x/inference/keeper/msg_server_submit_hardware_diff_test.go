@@ -2,8 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/base64"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+
 	"github.com/productscience/inference/testutil"
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
@@ -217,3 +220,48 @@ func TestMsgServer_SubmitHardwareDiff_RemoveAll(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, 0, len(hardwareNodes.HardwareNodes))
 }
+
+func TestMsgServer_SubmitHardwareDiff_HardwareAttestation(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	workerKey := ed25519.GenPrivKey()
+	mockCreator := NewMockAccount(testutil.Creator)
+	_, err := ms.SubmitNewParticipant(ctx, &types.MsgSubmitNewParticipant{
+		Creator:      testutil.Creator,
+		Url:          "url",
+		ValidatorKey: mockCreator.GetPubKey().String(),
+		WorkerKey:    base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes()),
+	})
+	require.NoError(t, err)
+	registerTestModels(t, k, ms, ctx, "model1")
+
+	attestedNode := func(key *ed25519.PrivKey, vramGB uint64) *types.HardwareNode {
+		attestation := types.HardwareAttestation{Participant: testutil.Creator, LocalId: "node1", VRamGB: vramGB}
+		signature, err := key.Sign(attestation.SignBytes())
+		require.NoError(t, err)
+		attestation.Signature = base64.StdEncoding.EncodeToString(signature)
+		hw, err := types.AttestationHardware(attestation)
+		require.NoError(t, err)
+		return &types.HardwareNode{LocalId: "node1", Models: []string{"model1"}, Hardware: []*types.Hardware{hw}}
+	}
+
+	_, err = ms.SubmitHardwareDiff(ctx, &types.MsgSubmitHardwareDiff{
+		Creator:       testutil.Creator,
+		NewOrModified: []*types.HardwareNode{attestedNode(ed25519.GenPrivKey(), 80)},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidHardwareAttestation)
+	_, found := k.GetHardwareNodes(ctx, testutil.Creator)
+	require.False(t, found)
+
+	_, err = ms.SubmitHardwareDiff(ctx, &types.MsgSubmitHardwareDiff{
+		Creator:       testutil.Creator,
+		NewOrModified: []*types.HardwareNode{attestedNode(workerKey, 80)},
+	})
+	require.NoError(t, err)
+	hardwareNodes, found := k.GetHardwareNodes(ctx, testutil.Creator)
+	require.True(t, found)
+	attestation, relayed, err := k.VerifyHardwareAttestation(ctx, testutil.Creator, hardwareNodes.HardwareNodes[0])
+	require.NoError(t, err)
+	require.True(t, relayed)
+	require.Equal(t, uint64(80), attestation.VRamGB)
+}
@@ -2,14 +2,25 @@ package keeper
 
 import (
 	"context"
+	"encoding/json"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/productscience/inference/x/inference/types"
 )
 
 func (k msgServer) SubmitTrainingKvRecord(goCtx context.Context, msg *types.MsgSubmitTrainingKvRecord) (*types.MsgSubmitTrainingKvRecordResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Attesters are sampled from the epoch group, outside the task's executors and the training allow list
+	if msg.Key == types.TrainingAttestationRecordKey {
+		if _, err := k.SubmitTrainingAttestation(ctx, msg.TaskId, msg.Creator, msg.Value); err != nil {
+			return nil, err
+		}
+		return &types.MsgSubmitTrainingKvRecordResponse{}, nil
+	}
+
 	if err := k.CheckTrainingAllowList(ctx, msg); err != nil {
 		return nil, err
 	}
@@ -35,6 +46,16 @@ func (k msgServer) SubmitTrainingKvRecord(goCtx context.Context, msg *types.MsgS
 		return nil, types.ErrTrainingTaskNotAssigned
 	}
 
+	if msg.Key == types.TrainingCheckpointRecordKey {
+		var report types.TrainingCheckpointReport
+		if err := json.Unmarshal([]byte(msg.Value), &report); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid training checkpoint report: %v", err)
+		}
+		if _, err := k.StartTrainingVerification(ctx, msg.TaskId, report.CheckpointUri, report.ClaimedScore); err != nil {
+			return nil, err
+		}
+	}
+
 	record := types.TrainingTaskKVRecord{
 		TaskId:      msg.TaskId,
 		Participant: msg.Creator,
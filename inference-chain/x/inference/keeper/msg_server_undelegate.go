@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	releaseEpoch, err := k.Keeper.Undelegate(ctx, msg.Delegator, msg.Participant, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUndelegateResponse{ReleaseEpoch: releaseEpoch}, nil
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// UpdatePolicy sets a governance policy. Only the module authority (x/gov) may send it.
+func (k msgServer) UpdatePolicy(goCtx context.Context, msg *types.MsgUpdatePolicy) (*types.MsgUpdatePolicyResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetPolicy(ctx, msg.Policy, []byte(msg.Value)); err != nil {
+		return nil, errorsmod.Wrapf(err, "invalid %s", msg.Policy)
+	}
+
+	k.LogInfo("Governance policy updated", types.System, "policy", msg.Policy, "value", msg.Value)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePolicyUpdated,
+			sdk.NewAttribute(types.AttributeKeyPolicy, msg.Policy),
+		))
+	return &types.MsgUpdatePolicyResponse{}, nil
+}
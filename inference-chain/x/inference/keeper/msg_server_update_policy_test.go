@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestMsgUpdatePolicy(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+
+	_, err := ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: "invalid",
+		Policy:    types.PolicyModelAssignmentStrategy,
+		Value:     `"balanced"`,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: k.GetAuthority(),
+		Policy:    types.PolicyModelAssignmentStrategy,
+		Value:     `"balanced"`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.ModelAssignmentStrategyBalanced, k.GetModelAssignmentStrategy(ctx))
+
+	_, err = ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: k.GetAuthority(),
+		Policy:    types.PolicyLateJoin,
+		Value:     `{"grace_blocks": 5, "weight_penalty": "0.2", "amendment_delay": 3}`,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.LateJoinPolicy{GraceBlocks: 5, WeightPenalty: "0.2", AmendmentDelay: 3}, k.GetLateJoinPolicy(ctx))
+
+	// The setter's validation applies
+	_, err = ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: k.GetAuthority(),
+		Policy:    types.PolicyModelAssignmentStrategy,
+		Value:     `"unknown"`,
+	})
+	require.Error(t, err)
+	require.Equal(t, types.ModelAssignmentStrategyBalanced, k.GetModelAssignmentStrategy(ctx))
+
+	// A misspelled field is rejected rather than left at its default
+	_, err = ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: k.GetAuthority(),
+		Policy:    types.PolicyLateJoin,
+		Value:     `{"grace_block": 10}`,
+	})
+	require.Error(t, err)
+	require.Equal(t, int64(5), k.GetLateJoinPolicy(ctx).GraceBlocks)
+
+	_, err = ms.UpdatePolicy(ctx, &types.MsgUpdatePolicy{
+		Authority: k.GetAuthority(),
+		Policy:    "no_such_policy",
+		Value:     "{}",
+	})
+	require.ErrorIs(t, err, types.ErrUnknownPolicy)
+}
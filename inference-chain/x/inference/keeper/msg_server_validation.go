@@ -116,7 +116,9 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 	k.LogInfo("Validating inner loop", types.Validation, "inferenceId", inference.InferenceId, "validator", msg.Creator, "passed", passed, "revalidation", msg.Revalidation)
 	if msg.Revalidation {
 		return epochGroup.Revalidate(passed, inference, msg, ctx)
-	} else if passed {
+	}
+	k.RecordValidationOutcome(ctx, currentEpochIndex, passed)
+	if passed {
 		inference.Status = types.InferenceStatus_VALIDATED
 		shouldShare, information := k.inferenceIsBeforeClaimsSet(ctx, inference, currentEpochIndex)
 		k.LogInfo("Validation sharing decision", types.Validation, "inferenceId", inference.InferenceId, "validator", msg.Creator, "shouldShare", shouldShare, "information", information)
@@ -132,6 +134,14 @@ func (k msgServer) Validation(goCtx context.Context, msg *types.MsgValidation) (
 		if err != nil {
 			return nil, err
 		}
+		if k.InvalidationsPaused(ctx) {
+			k.LogWarn("Validation circuit breaker tripped, not starting invalidation vote", types.Validation,
+				"inferenceId", inference.InferenceId,
+				"creator", msg.Creator,
+				"epochIndex", currentEpochIndex,
+			)
+			return &types.MsgValidationResponse{}, nil
+		}
 		if k.MaximumInvalidationsReached(ctx, creatorAddr, groupData) {
 			k.LogWarn("Maximum invalidations reached.", types.Validation,
 				"creator", msg.Creator,
@@ -276,6 +286,9 @@ func (k msgServer) inferenceIsBeforeClaimsSet(ctx context.Context, inference typ
 }
 
 func (k msgServer) shareWorkWithValidators(ctx sdk.Context, inference types.Inference, msg *types.MsgValidation, executor *types.Participant) {
+	if k.shareWorkInDenom(ctx, inference, msg.Creator) {
+		return
+	}
 	originalWorkers := append([]string{inference.ExecutedBy}, inference.ValidatedBy...)
 	adjustments := calculations.ShareWork(originalWorkers, []string{msg.Creator}, inference.ActualCost)
 	k.validateAdjustments(adjustments, msg)
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ValidationBatch handles each item of the batch as a MsgValidation from the batch creator. Items run in
+// their own cache context, so a rejected item leaves no state behind and does not fail the others. They
+// share the transaction's gas meter: every item is charged the gas of its validation, accepted or not.
+func (k msgServer) ValidationBatch(goCtx context.Context, msg *types.MsgValidationBatch) (*types.MsgValidationBatchResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	results := make([]types.ValidationBatchResult, 0, len(msg.Validations))
+	accepted := 0
+	for _, item := range msg.Validations {
+		result := types.ValidationBatchResult{InferenceId: item.InferenceId}
+		if err := k.validateBatchItem(ctx, item.ToMsgValidation(msg.Creator)); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Accepted = true
+			accepted++
+		}
+		results = append(results, result)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValidationBatchItem,
+				sdk.NewAttribute(types.AttributeKeyParticipant, msg.Creator),
+				sdk.NewAttribute(types.AttributeKeyInferenceId, result.InferenceId),
+				sdk.NewAttribute(types.AttributeKeyAccepted, strconv.FormatBool(result.Accepted)),
+				sdk.NewAttribute(types.AttributeKeyError, result.Error),
+			))
+	}
+
+	k.LogInfo("Processed validation batch", types.Validation,
+		"creator", msg.Creator,
+		"validations", len(msg.Validations),
+		"accepted", accepted)
+	return &types.MsgValidationBatchResponse{Results: results}, nil
+}
+
+func (k msgServer) validateBatchItem(ctx sdk.Context, validation *types.MsgValidation) error {
+	if err := validation.ValidateBasic(); err != nil {
+		return err
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if _, err := k.Validation(cacheCtx, validation); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
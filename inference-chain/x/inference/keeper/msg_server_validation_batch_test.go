@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/testutil"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgServer_ValidationBatch(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	createParticipants(t, inferenceHelper.MessageServer, ctx)
+
+	model := &types.Model{Id: MODEL_ID, ValidationThreshold: &types.Decimal{Value: 85, Exponent: -2}}
+	k.SetModel(ctx, model)
+	StubModelSubgroup(t, ctx, k, inferenceHelper.Mocks, model)
+	addMembersToGroupData(k, ctx)
+
+	expected, err := inferenceHelper.StartInference("promptPayload", model.Id, time.Now().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+	_, err = inferenceHelper.FinishInference()
+	require.NoError(t, err)
+
+	resp, err := inferenceHelper.MessageServer.ValidationBatch(ctx, &types.MsgValidationBatch{
+		Creator: testutil.Validator,
+		Validations: []types.ValidationBatchItem{
+			{InferenceId: expected.InferenceId, ValueDecimal: types.DecimalFromFloat(0.9999)},
+			{InferenceId: INFERENCE_ID, ValueDecimal: types.DecimalFromFloat(0.9999)},
+			{InferenceId: expected.InferenceId, ValueDecimal: types.DecimalFromFloat(2)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+	require.True(t, resp.Results[0].Accepted)
+	require.Empty(t, resp.Results[0].Error)
+	require.False(t, resp.Results[1].Accepted)
+	require.Contains(t, resp.Results[1].Error, types.ErrInferenceNotFound.Error())
+	require.False(t, resp.Results[2].Accepted)
+	require.NotEmpty(t, resp.Results[2].Error)
+
+	inference, found := k.GetInference(ctx, expected.InferenceId)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_VALIDATED, inference.Status)
+
+	items := 0
+	for _, event := range sdk.UnwrapSDKContext(ctx).EventManager().Events() {
+		if event.Type == types.EventTypeValidationBatchItem {
+			items++
+		}
+	}
+	require.Equal(t, 3, items)
+}
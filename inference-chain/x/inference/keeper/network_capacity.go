@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ModelEpochUsageRetentionEpochs is how many epochs of per-model usage are kept in state
+const ModelEpochUsageRetentionEpochs = 5
+
+// RecordModelEpochUsage adds a completed inference to the usage of its model in the epoch.
+// The first record of an epoch prunes the usage older than the retention window.
+func (k Keeper) RecordModelEpochUsage(ctx context.Context, epochIndex uint64, inference *types.Inference) error {
+	usage, found := k.GetModelEpochUsage(ctx, epochIndex, inference.Model)
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	if !found {
+		usage = types.ModelEpochUsage{EpochIndex: epochIndex, ModelId: inference.Model, FirstCompletedAt: now}
+		if err := k.pruneModelEpochUsage(ctx, epochIndex); err != nil {
+			return err
+		}
+	}
+	usage.Inferences++
+	usage.PromptTokens += inference.PromptTokenCount
+	usage.CompletionTokens += inference.CompletionTokenCount
+	usage.LastCompletedAt = now
+
+	bz, err := json.Marshal(usage)
+	if err != nil {
+		return err
+	}
+	return k.ModelEpochUsage.Set(ctx, collections.Join(epochIndex, inference.Model), bz)
+}
+
+func (k Keeper) GetModelEpochUsage(ctx context.Context, epochIndex uint64, modelId string) (types.ModelEpochUsage, bool) {
+	var usage types.ModelEpochUsage
+	bz, err := k.ModelEpochUsage.Get(ctx, collections.Join(epochIndex, modelId))
+	if err != nil {
+		return usage, false
+	}
+	if err := json.Unmarshal(bz, &usage); err != nil {
+		k.LogError("Failed to decode model epoch usage", types.Inferences, "epochIndex", epochIndex, "modelId", modelId, "error", err)
+		return usage, false
+	}
+	return usage, true
+}
+
+func (k Keeper) pruneModelEpochUsage(ctx context.Context, epochIndex uint64) error {
+	if epochIndex < ModelEpochUsageRetentionEpochs {
+		return nil
+	}
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(epochIndex-ModelEpochUsageRetentionEpochs+1, ""))
+	return k.ModelEpochUsage.Clear(ctx, rng)
+}
+
+// GetNetworkCapacity returns the capacity of every model group of the effective epoch next to its usage so far
+func (k Keeper) GetNetworkCapacity(ctx context.Context) (types.NetworkCapacity, error) {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return types.NetworkCapacity{}, types.ErrEffectiveEpochNotFound
+	}
+	parent, found := k.GetEpochGroupData(ctx, epochIndex, "")
+	if !found {
+		return types.NetworkCapacity{}, types.ErrEpochGroupDataNotFound.Wrapf("epoch %d", epochIndex)
+	}
+
+	subgroups := make([]types.EpochGroupData, 0, len(parent.SubGroupModels))
+	usage := make(map[string]types.ModelEpochUsage, len(parent.SubGroupModels))
+	for _, modelId := range parent.SubGroupModels {
+		subgroup, found := k.GetEpochGroupData(ctx, epochIndex, modelId)
+		if !found {
+			continue
+		}
+		subgroups = append(subgroups, subgroup)
+		if u, found := k.GetModelEpochUsage(ctx, epochIndex, modelId); found {
+			usage[modelId] = u
+		}
+	}
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	return types.NewNetworkCapacity(epochIndex, subgroups, usage, now), nil
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestRecordModelEpochUsage(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	inference := &types.Inference{Model: "large", PromptTokenCount: 10, CompletionTokenCount: 90}
+	require.NoError(t, k.RecordModelEpochUsage(ctx, 1, inference))
+	ctx = ctx.WithBlockTime(time.Unix(1010, 0))
+	require.NoError(t, k.RecordModelEpochUsage(ctx, 1, inference))
+
+	usage, found := k.GetModelEpochUsage(ctx, 1, "large")
+	require.True(t, found)
+	require.Equal(t, types.ModelEpochUsage{
+		EpochIndex:       1,
+		ModelId:          "large",
+		Inferences:       2,
+		PromptTokens:     20,
+		CompletionTokens: 180,
+		FirstCompletedAt: 1000,
+		LastCompletedAt:  1010,
+	}, usage)
+
+	// Usage older than the retention window is pruned once a new epoch records
+	require.NoError(t, k.RecordModelEpochUsage(ctx, 1+keeper.ModelEpochUsageRetentionEpochs, inference))
+	_, found = k.GetModelEpochUsage(ctx, 1, "large")
+	require.False(t, found)
+	_, found = k.GetModelEpochUsage(ctx, 1+keeper.ModelEpochUsageRetentionEpochs, "large")
+	require.True(t, found)
+}
+
+func TestGetNetworkCapacity(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+
+	_, err := k.GetNetworkCapacity(ctx)
+	require.ErrorIs(t, err, types.ErrEffectiveEpochNotFound)
+
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 3))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 3, SubGroupModels: []string{"small", "large"}})
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 3, ModelId: "large", TotalWeight: 300, TotalThroughput: 100,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "p1", Weight: 300, MlNodes: []*types.MLNodeInfo{{NodeId: "n1", Throughput: 60}, {NodeId: "n2", Throughput: 40}}},
+		}})
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 3, ModelId: "small", TotalWeight: 200, TotalThroughput: 300,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "p1", Weight: 100, MlNodes: []*types.MLNodeInfo{{NodeId: "n3", Throughput: 100}}},
+			{MemberAddress: "p2", Weight: 100, MlNodes: []*types.MLNodeInfo{{NodeId: "n4", Throughput: 200}}},
+		}})
+	require.NoError(t, k.RecordModelEpochUsage(ctx, 3, &types.Inference{Model: "large", PromptTokenCount: 500, CompletionTokenCount: 500}))
+
+	capacity, err := k.GetNetworkCapacity(ctx.WithBlockTime(time.Unix(1020, 0)))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), capacity.EpochIndex)
+	require.Equal(t, uint64(2), capacity.Participants)
+	require.Equal(t, uint64(4), capacity.MLNodes)
+	require.Equal(t, int64(400), capacity.TotalThroughput)
+	require.Equal(t, uint64(1), capacity.Inferences)
+	require.InDelta(t, 50.0, capacity.TokensPerSecond, 1e-9)
+	require.InDelta(t, 0.125, capacity.Utilization, 1e-9)
+
+	require.Len(t, capacity.Models, 2)
+	large, small := capacity.Models[0], capacity.Models[1]
+	require.Equal(t, "large", large.ModelId)
+	require.Equal(t, uint64(1), large.Participants)
+	require.Equal(t, uint64(2), large.MLNodes)
+	require.InDelta(t, 50.0, large.TokensPerSecond, 1e-9)
+	require.InDelta(t, 0.5, large.Utilization, 1e-9)
+	require.Equal(t, "small", small.ModelId)
+	require.Equal(t, uint64(2), small.Participants)
+	require.Zero(t, small.Inferences)
+	require.Zero(t, small.Utilization)
+}
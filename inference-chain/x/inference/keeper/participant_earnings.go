@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// GetParticipantEpochEarnings returns the earnings of a participant in an epoch
+func (k Keeper) GetParticipantEpochEarnings(ctx context.Context, participant string, epochIndex uint64) (types.ParticipantEpochEarnings, bool) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.ParticipantEpochEarnings{}, false
+	}
+	bz, err := k.ParticipantEarnings.Get(ctx, collections.Join(addr, epochIndex))
+	if err != nil {
+		return types.ParticipantEpochEarnings{}, false
+	}
+	var earnings types.ParticipantEpochEarnings
+	if err := json.Unmarshal(bz, &earnings); err != nil {
+		k.LogError("Failed to decode participant earnings", types.Settle, "participant", participant, "epoch", epochIndex, "error", err)
+		return types.ParticipantEpochEarnings{}, false
+	}
+	return earnings, true
+}
+
+// updateParticipantEarnings applies update to the earnings of a participant in an epoch, creating them on first use
+func (k Keeper) updateParticipantEarnings(ctx context.Context, participant string, epochIndex uint64, update func(*types.ParticipantEpochEarnings)) error {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return err
+	}
+	earnings, found := k.GetParticipantEpochEarnings(ctx, participant, epochIndex)
+	if !found {
+		earnings = types.ParticipantEpochEarnings{Participant: participant, EpochIndex: epochIndex}
+	}
+	update(&earnings)
+	bz, err := json.Marshal(earnings)
+	if err != nil {
+		return err
+	}
+	return k.ParticipantEarnings.Set(ctx, collections.Join(addr, epochIndex), bz)
+}
+
+// recordSettledEarnings writes the work and reward coins settled for a participant in an epoch
+func (k Keeper) recordSettledEarnings(ctx context.Context, participant string, epochIndex uint64, workCoins, rewardCoins uint64) {
+	err := k.updateParticipantEarnings(ctx, participant, epochIndex, func(earnings *types.ParticipantEpochEarnings) {
+		earnings.WorkCoins = workCoins
+		earnings.RewardCoins = rewardCoins
+		earnings.Settled = true
+	})
+	if err != nil {
+		k.LogError("Failed to record settled earnings", types.Settle, "participant", participant, "epoch", epochIndex, "error", err)
+	}
+}
+
+// recordRefundedEarnings adds an executor payment taken back for an invalidated inference of the epoch
+func (k Keeper) recordRefundedEarnings(ctx context.Context, participant string, epochIndex uint64, amount int64) {
+	if amount <= 0 {
+		return
+	}
+	err := k.updateParticipantEarnings(ctx, participant, epochIndex, func(earnings *types.ParticipantEpochEarnings) {
+		earnings.RefundedCoins += uint64(amount)
+	})
+	if err != nil {
+		k.LogError("Failed to record refunded earnings", types.Settle, "participant", participant, "epoch", epochIndex, "error", err)
+	}
+}
+
+// recordSlashedEarnings adds collateral slashed from a participant to its earnings of the effective epoch
+func (k Keeper) recordSlashedEarnings(ctx context.Context, participant string, slashed sdk.Coin) {
+	if !slashed.IsValid() || !slashed.IsPositive() {
+		return
+	}
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return
+	}
+	err := k.updateParticipantEarnings(ctx, participant, epochIndex, func(earnings *types.ParticipantEpochEarnings) {
+		earnings.SlashedCoins += slashed.Amount.Uint64()
+	})
+	if err != nil {
+		k.LogError("Failed to record slashed earnings", types.Settle, "participant", participant, "epoch", epochIndex, "error", err)
+	}
+}
+
+// ParticipantEarningsHistory returns a page of the per-epoch earnings of a participant, oldest epoch first
+func (k Keeper) ParticipantEarningsHistory(ctx context.Context, participant string, pagination *query.PageRequest) ([]types.ParticipantEpochEarnings, *query.PageResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return nil, nil, err
+	}
+	return query.CollectionPaginate(
+		ctx,
+		k.ParticipantEarnings,
+		pagination,
+		func(_ collections.Pair[sdk.AccAddress, uint64], bz []byte) (types.ParticipantEpochEarnings, error) {
+			var earnings types.ParticipantEpochEarnings
+			err := json.Unmarshal(bz, &earnings)
+			return earnings, err
+		},
+		query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](addr),
+	)
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParticipantEarningsHistory_RecordsSlashesPerEpoch(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	participant := &types.Participant{Address: testutil.Executor}
+	params := types.DefaultParams()
+
+	mocks.CollateralKeeper.EXPECT().
+		Slash(gomock.Any(), gomock.Any(), gomock.Any(), types.SlashReasonDowntime).
+		Return(sdk.NewCoin(types.BaseCoin, math.NewInt(40)), nil).Times(3)
+
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 3))
+	k.SlashForDowntime(ctx, participant, params)
+	k.SlashForDowntime(ctx, participant, params)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+	k.SlashForDowntime(ctx, participant, params)
+
+	earnings, found := k.GetParticipantEpochEarnings(ctx, testutil.Executor, 3)
+	require.True(t, found)
+	require.Equal(t, uint64(80), earnings.SlashedCoins)
+	require.False(t, earnings.Settled)
+
+	page, pageRes, err := k.ParticipantEarningsHistory(ctx, testutil.Executor, &query.PageRequest{Limit: 1, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, uint64(3), page[0].EpochIndex)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	page, _, err = k.ParticipantEarningsHistory(ctx, testutil.Executor, &query.PageRequest{Key: pageRes.NextKey, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, uint64(4), page[0].EpochIndex)
+	require.Equal(t, uint64(40), page[0].SlashedCoins)
+
+	other, _, err := k.ParticipantEarningsHistory(ctx, testutil.Requester, nil)
+	require.NoError(t, err)
+	require.Empty(t, other)
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func (k Keeper) GetParticipantExit(ctx context.Context, address sdk.AccAddress) (types.ParticipantExit, bool) {
+	exit, err := k.ParticipantExits.Get(ctx, address)
+	if err != nil {
+		return types.ParticipantExit{}, false
+	}
+	return exit, true
+}
+
+// SetParticipantExit stores the exit of the participant at exit.Address
+func (k Keeper) SetParticipantExit(ctx context.Context, exit types.ParticipantExit) error {
+	address, err := sdk.AccAddressFromBech32(exit.Address)
+	if err != nil {
+		return err
+	}
+	return k.ParticipantExits.Set(ctx, address, exit)
+}
+
+func (k Keeper) GetAllParticipantExits(ctx context.Context) ([]types.ParticipantExit, error) {
+	iter, err := k.ParticipantExits.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// InitiateExit starts the exit of a participant from the network. The participant keeps serving the
+// effective epoch, or the upcoming one when its epoch group already includes it, and is left out of the
+// epoch groups after it. Once that last epoch is settled its collateral unbonds, see ApplyParticipantExits.
+func (k Keeper) InitiateExit(ctx context.Context, participant string) (types.ParticipantExit, error) {
+	address, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.ParticipantExit{}, types.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if _, found := k.GetParticipant(ctx, participant); !found {
+		return types.ParticipantExit{}, types.ErrParticipantNotFound.Wrapf("participant %s", participant)
+	}
+	if _, exiting := k.GetParticipantExit(ctx, address); exiting {
+		return types.ParticipantExit{}, types.ErrParticipantAlreadyExiting.Wrapf("participant %s", participant)
+	}
+	effectiveEpoch, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return types.ParticipantExit{}, types.ErrEpochNotFound
+	}
+
+	lastEpoch := effectiveEpoch
+	if upcomingEpoch, found := k.GetUpcomingEpochIndex(ctx); found && k.isEpochGroupMember(ctx, upcomingEpoch, participant) {
+		lastEpoch = upcomingEpoch
+	}
+	exit := types.ParticipantExit{
+		Address:          participant,
+		RequestedAtEpoch: effectiveEpoch,
+		LastEpoch:        lastEpoch,
+	}
+	if err := k.ParticipantExits.Set(ctx, address, exit); err != nil {
+		return types.ParticipantExit{}, err
+	}
+	k.LogInfo("Participant exit initiated", types.Participants, "participant", participant,
+		"effectiveEpoch", effectiveEpoch, "lastEpoch", lastEpoch)
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantExitInitiated,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(effectiveEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyLastEpoch, strconv.FormatUint(lastEpoch, 10)),
+		))
+	return exit, nil
+}
+
+func (k Keeper) isEpochGroupMember(ctx context.Context, epochIndex uint64, participant string) bool {
+	groupData, found := k.GetEpochGroupData(ctx, epochIndex, "")
+	if !found {
+		return false
+	}
+	for _, weight := range groupData.ValidationWeights {
+		if weight != nil && weight.MemberAddress == participant {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyParticipantExits advances the exits at the formation of the epoch group of epochIndex, after the
+// previous epoch is settled. Participants past their last epoch are left out of the result. An exit whose
+// last epoch is settled moves the participant's collateral to the unbonding queue, and an exit whose
+// collateral was released is completed and forgotten, so the address may join again later.
+func (k Keeper) ApplyParticipantExits(ctx context.Context, epochIndex uint64, participants []*types.ActiveParticipant) []*types.ActiveParticipant {
+	exits, err := k.GetAllParticipantExits(ctx)
+	if err != nil {
+		k.LogError("Failed to read participant exits", types.Participants, "error", err)
+		return participants
+	}
+
+	leaving := make(map[string]bool)
+	for _, exit := range exits {
+		if exit.LastEpoch >= epochIndex {
+			continue
+		}
+		leaving[exit.Address] = true
+		address, err := sdk.AccAddressFromBech32(exit.Address)
+		if err != nil {
+			k.LogError("Could not parse exiting participant address", types.Participants, "address", exit.Address, "error", err)
+			continue
+		}
+
+		switch {
+		case !exit.Unbonding:
+			k.startExitUnbonding(ctx, address, exit, epochIndex)
+		case epochIndex > exit.CollateralReleaseEpoch:
+			if err := k.ParticipantExits.Remove(ctx, address); err != nil {
+				k.LogError("Failed to remove completed participant exit", types.Participants, "participant", exit.Address, "error", err)
+				continue
+			}
+			k.LogInfo("Participant exit completed", types.Participants, "participant", exit.Address, "epochIndex", epochIndex)
+			sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeParticipantExitCompleted,
+					sdk.NewAttribute(types.AttributeKeyParticipant, exit.Address),
+					sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+				))
+		}
+	}
+	if len(leaving) == 0 {
+		return participants
+	}
+
+	result := make([]*types.ActiveParticipant, 0, len(participants))
+	for _, participant := range participants {
+		if leaving[participant.Index] {
+			k.LogInfo("Participant is exiting, leaving it out of the epoch group", types.Participants,
+				"participant", participant.Index, "epochIndex", epochIndex)
+			continue
+		}
+		result = append(result, participant)
+	}
+	return result
+}
+
+// startExitUnbonding unbonds the collateral of a participant whose last epoch is settled. Its rewards stay
+// claimable; the event reports the unclaimed amount.
+func (k Keeper) startExitUnbonding(ctx context.Context, address sdk.AccAddress, exit types.ParticipantExit, epochIndex uint64) {
+	collateral, releaseEpoch, err := k.GetCollateralKeeper().UnbondAllCollateral(ctx, address)
+	if err != nil {
+		k.LogError("Failed to unbond collateral of exiting participant, retrying next epoch", types.Participants,
+			"participant", exit.Address, "error", err)
+		return
+	}
+	exit.Unbonding = true
+	exit.Collateral = collateral.String()
+	exit.CollateralReleaseEpoch = releaseEpoch
+	if err := k.ParticipantExits.Set(ctx, address, exit); err != nil {
+		k.LogError("Failed to store participant exit", types.Participants, "participant", exit.Address, "error", err)
+		return
+	}
+
+	unclaimed := math.ZeroInt()
+	for _, settleAmount := range k.GetClaimableSettleAmounts(ctx, exit.Address) {
+		unclaimed = unclaimed.Add(math.NewIntFromUint64(settleAmount.RewardCoins)).Add(math.NewIntFromUint64(settleAmount.WorkCoins))
+	}
+	k.LogInfo("Participant exited, collateral unbonding", types.Participants, "participant", exit.Address,
+		"epochIndex", epochIndex, "collateral", exit.Collateral, "releaseEpoch", releaseEpoch, "unclaimedRewards", unclaimed.String())
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantExited,
+			sdk.NewAttribute(types.AttributeKeyParticipant, exit.Address),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyLastEpoch, strconv.FormatUint(exit.LastEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyCollateral, exit.Collateral),
+			sdk.NewAttribute(types.AttributeKeyReleaseEpoch, strconv.FormatUint(releaseEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnclaimedRewards, sdk.NewCoin(types.BaseCoin, unclaimed).String()),
+		))
+}
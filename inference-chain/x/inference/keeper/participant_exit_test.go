@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitiateExit(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+
+	serving := sample.AccAddress()
+	formed := sample.AccAddress()
+	for _, address := range []string{serving, formed} {
+		require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: address, Address: address}))
+	}
+	// The epoch group of the upcoming epoch 5 is already formed with one of them
+	require.NoError(t, k.SetEpoch(ctx, &types.Epoch{Index: 5}))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: 5, ValidationWeights: []*types.ValidationWeight{{MemberAddress: formed}}})
+
+	exit, err := k.InitiateExit(ctx, serving)
+	require.NoError(t, err)
+	require.Equal(t, types.ParticipantExit{Address: serving, RequestedAtEpoch: 4, LastEpoch: 4}, exit)
+	stored, found := k.GetParticipantExit(ctx, sdk.MustAccAddressFromBech32(serving))
+	require.True(t, found)
+	require.Equal(t, exit, stored)
+
+	exit, err = k.InitiateExit(ctx, formed)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), exit.LastEpoch)
+
+	_, err = k.InitiateExit(ctx, serving)
+	require.ErrorIs(t, err, types.ErrParticipantAlreadyExiting)
+	_, err = k.InitiateExit(ctx, sample.AccAddress())
+	require.ErrorIs(t, err, types.ErrParticipantNotFound)
+}
+
+func TestMsgServer_InitiateExit(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+
+	participant := sample.AccAddress()
+	_, err := ms.InitiateExit(ctx, &types.MsgInitiateExit{Creator: participant})
+	require.ErrorIs(t, err, types.ErrParticipantNotFound)
+
+	require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: participant, Address: participant, InferenceUrl: "https://example.com"}))
+	resp, err := ms.InitiateExit(ctx, &types.MsgInitiateExit{Creator: participant})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), resp.LastEpoch)
+	exit, found := k.GetParticipantExit(ctx, sdk.MustAccAddressFromBech32(participant))
+	require.True(t, found)
+	require.Equal(t, uint64(4), exit.LastEpoch)
+	requireEvent(t, ctx, types.EventTypeParticipantExitInitiated, types.AttributeKeyParticipant, participant)
+
+	_, err = ms.InitiateExit(ctx, &types.MsgInitiateExit{Creator: participant})
+	require.ErrorIs(t, err, types.ErrParticipantAlreadyExiting)
+}
+
+func TestApplyParticipantExits(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+
+	exiting := sample.AccAddress()
+	staying := sample.AccAddress()
+	for _, address := range []string{exiting, staying} {
+		require.NoError(t, k.SetParticipant(ctx, types.Participant{Index: address, Address: address}))
+	}
+	_, err := k.InitiateExit(ctx, exiting)
+	require.NoError(t, err)
+	require.NoError(t, k.SetSettleAmount(ctx, types.SettleAmount{Participant: exiting, EpochIndex: 4, RewardCoins: 300, WorkCoins: 200}))
+	participants := func() []*types.ActiveParticipant {
+		return []*types.ActiveParticipant{{Index: exiting, Weight: 100}, {Index: staying, Weight: 100}}
+	}
+	exitingAddress := sdk.MustAccAddressFromBech32(exiting)
+
+	// The last epoch is settled when epoch 5 is formed: the participant is left out and its collateral unbonds
+	mocks.CollateralKeeper.EXPECT().UnbondAllCollateral(ctx, exitingAddress).Return(sdk.NewInt64Coin(types.BaseCoin, 1000), uint64(6), nil)
+	result := k.ApplyParticipantExits(ctx, 5, participants())
+	require.Equal(t, []*types.ActiveParticipant{{Index: staying, Weight: 100}}, result)
+	exit, found := k.GetParticipantExit(ctx, exitingAddress)
+	require.True(t, found)
+	require.True(t, exit.Unbonding)
+	require.Equal(t, "1000"+types.BaseCoin, exit.Collateral)
+	require.Equal(t, uint64(6), exit.CollateralReleaseEpoch)
+	requireEvent(t, ctx, types.EventTypeParticipantExited, types.AttributeKeyUnclaimedRewards, "500"+types.BaseCoin)
+
+	// Still unbonding at epoch 6: left out, collateral not unbonded again
+	result = k.ApplyParticipantExits(ctx, 6, participants())
+	require.Len(t, result, 1)
+	_, found = k.GetParticipantExit(ctx, exitingAddress)
+	require.True(t, found)
+
+	// The collateral is released at the end of epoch 6: the exit completes
+	result = k.ApplyParticipantExits(ctx, 7, participants())
+	require.Len(t, result, 1)
+	_, found = k.GetParticipantExit(ctx, exitingAddress)
+	require.False(t, found)
+	requireEvent(t, ctx, types.EventTypeParticipantExitCompleted, types.AttributeKeyParticipant, exiting)
+
+	// Forgotten after completion, the address may join again
+	require.Len(t, k.ApplyParticipantExits(ctx, 8, participants()), 2)
+}
+
+func requireEvent(t *testing.T, ctx sdk.Context, eventType string, key string, value string) {
+	t.Helper()
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == key && attribute.Value == value {
+				return
+			}
+		}
+	}
+	t.Fatalf("no %s event with %s=%s", eventType, key, value)
+}
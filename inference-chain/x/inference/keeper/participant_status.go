@@ -47,6 +47,11 @@ func (k Keeper) UpdateParticipantStatus(ctx context.Context, participant *types.
 		return nil
 	}
 
+	if newStatus == types.ParticipantStatus_INVALID && k.InvalidationsPaused(ctx) {
+		k.LogWarn("Validation circuit breaker tripped, keeping participant status", types.Validation, "address", participant.Address, "status", originalStatus, "reason", reason)
+		return nil
+	}
+
 	// This should be the ONLY place status is set
 	participant.Status = newStatus
 
@@ -0,0 +1,366 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetPaymentDenomRegistry selects the denoms and conversion rates inference payments are accepted in.
+// Governance updates it with MsgUpdatePolicy, which also keeps the rates current.
+func (k Keeper) SetPaymentDenomRegistry(ctx context.Context, registry types.PaymentDenomRegistry) error {
+	if err := registry.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(registry)
+	if err != nil {
+		return err
+	}
+	return k.PaymentDenomRegistry.Set(ctx, bz)
+}
+
+// GetPaymentDenomRegistry returns the registered payment denoms, empty if none were selected
+func (k Keeper) GetPaymentDenomRegistry(ctx context.Context) types.PaymentDenomRegistry {
+	bz, err := k.PaymentDenomRegistry.Get(ctx)
+	if err != nil {
+		return types.PaymentDenomRegistry{}
+	}
+	var registry types.PaymentDenomRegistry
+	if err := json.Unmarshal(bz, &registry); err != nil {
+		k.LogError("Failed to decode payment denom registry, accepting native payments only", types.Payments, "error", err)
+		return types.PaymentDenomRegistry{}
+	}
+	return registry
+}
+
+// GetInferencePayment returns the escrow of an inference paid in a registered denom;
+// not found means the inference was paid in the native denom
+func (k Keeper) GetInferencePayment(ctx context.Context, inferenceId string) (types.InferencePayment, bool) {
+	payment, err := k.InferencePayments.Get(ctx, inferenceId)
+	if err != nil {
+		return types.InferencePayment{}, false
+	}
+	return payment, true
+}
+
+func (k Keeper) SetInferencePayment(ctx context.Context, payment types.InferencePayment) error {
+	return k.InferencePayments.Set(ctx, payment.InferenceId, payment)
+}
+
+func (k Keeper) GetAllInferencePayments(ctx context.Context) ([]types.InferencePayment, error) {
+	iter, err := k.InferencePayments.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetDenomTreasury(ctx context.Context, denom string) types.DenomTreasury {
+	treasury, err := k.DenomTreasuries.Get(ctx, denom)
+	if err != nil {
+		return types.DenomTreasury{Denom: denom}
+	}
+	return treasury
+}
+
+func (k Keeper) SetDenomTreasury(ctx context.Context, treasury types.DenomTreasury) error {
+	return k.DenomTreasuries.Set(ctx, treasury.Denom, treasury)
+}
+
+func (k Keeper) GetAllDenomTreasuries(ctx context.Context) ([]types.DenomTreasury, error) {
+	iter, err := k.DenomTreasuries.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) updateDenomTreasury(ctx context.Context, denom string, update func(*types.DenomTreasury)) {
+	treasury := k.GetDenomTreasury(ctx, denom)
+	update(&treasury)
+	if err := k.SetDenomTreasury(ctx, treasury); err != nil {
+		k.LogError("Failed to store denom treasury", types.Payments, "denom", denom, "error", err)
+	}
+}
+
+// GetParticipantDenomBalances returns what a participant earned in registered denoms during the current epoch
+func (k Keeper) GetParticipantDenomBalances(ctx context.Context, participant sdk.AccAddress) ([]types.ParticipantDenomBalance, error) {
+	iter, err := k.ParticipantDenomBalances.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, string](participant))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetAllParticipantDenomBalances(ctx context.Context) ([]types.ParticipantDenomBalance, error) {
+	iter, err := k.ParticipantDenomBalances.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// SetParticipantDenomBalance stores a participant's balance in a denom, removing it once it is zero
+func (k Keeper) SetParticipantDenomBalance(ctx context.Context, balance types.ParticipantDenomBalance) error {
+	participant, err := sdk.AccAddressFromBech32(balance.Participant)
+	if err != nil {
+		return err
+	}
+	key := collections.Join(participant, balance.Denom)
+	if balance.Amount == 0 {
+		return k.ParticipantDenomBalances.Remove(ctx, key)
+	}
+	return k.ParticipantDenomBalances.Set(ctx, key, balance)
+}
+
+func (k Keeper) addParticipantDenomBalance(ctx context.Context, participant string, denom string, amount int64) error {
+	address, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return err
+	}
+	balance, err := k.ParticipantDenomBalances.Get(ctx, collections.Join(address, denom))
+	if err != nil {
+		balance = types.ParticipantDenomBalance{Participant: participant, Denom: denom}
+	}
+	balance.Amount += amount
+	return k.SetParticipantDenomBalance(ctx, balance)
+}
+
+// selectPaymentDenom picks the registered denom a requester that cannot pay cost in the native denom pays in.
+// Returns false when the requester pays natively, or cannot pay in any denom.
+func (k *Keeper) selectPaymentDenom(ctx context.Context, payer sdk.AccAddress, cost int64) (types.PaymentDenom, int64, bool) {
+	registry := k.GetPaymentDenomRegistry(ctx)
+	if len(registry.Denoms) == 0 {
+		return types.PaymentDenom{}, 0, false
+	}
+	if k.BankView.SpendableCoin(ctx, payer, types.BaseCoin).Amount.GTE(math.NewInt(cost)) {
+		return types.PaymentDenom{}, 0, false
+	}
+	for _, denom := range registry.Denoms {
+		amount, err := types.DenomAmount(cost, denom.Rate, true)
+		if err != nil {
+			k.LogError("Invalid payment denom rate", types.Payments, "denom", denom.Denom, "error", err)
+			continue
+		}
+		if k.BankView.SpendableCoin(ctx, payer, denom.Denom).Amount.GTE(math.NewInt(amount)) {
+			return denom, amount, true
+		}
+	}
+	return types.PaymentDenom{}, 0, false
+}
+
+// putPaymentInEscrowInDenom escrows the cost of an inference in a registered denom. The inference accounts
+// in BaseCoin like any other, but its executor is paid and its requester refunded from this escrow, in the denom.
+func (k *Keeper) putPaymentInEscrowInDenom(ctx context.Context, inference *types.Inference, payer sdk.AccAddress, cost int64, denom types.PaymentDenom, amount int64) (int64, error) {
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom.Denom, amount))
+	err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, coins, "escrow for inferenceId:"+inference.InferenceId)
+	if err != nil {
+		k.LogError("Error sending coins to escrow", types.Payments, "error", err, "denom", denom.Denom)
+		return 0, sdkerrors.Wrap(err, types.ErrRequesterCannotPay.Error())
+	}
+	payment := types.InferencePayment{InferenceId: inference.InferenceId, Denom: denom.Denom, Rate: denom.Rate, Amount: amount}
+	if err := k.SetInferencePayment(ctx, payment); err != nil {
+		return 0, err
+	}
+	k.updateDenomTreasury(ctx, denom.Denom, func(t *types.DenomTreasury) {
+		t.Escrowed += amount
+	})
+
+	k.LogInfo("Sent coins to escrow", types.Payments, "inference", inference.InferenceId, "coins", cost, "denom", denom.Denom, "denomAmount", amount, "payee", payer)
+	k.emitInferencePaymentEvent(ctx, types.EventTypeInferencePaymentEscrowed, inference.InferenceId, denom.Denom, amount, cost)
+	return cost, nil
+}
+
+// IssueInferenceRefund refunds part of an inference escrow to the requester, in the denom the inference was paid in
+func (k *Keeper) IssueInferenceRefund(ctx context.Context, inferenceId string, refundAmount int64, address string, memo string) error {
+	payment, found := k.GetInferencePayment(ctx, inferenceId)
+	if !found {
+		return k.IssueRefund(ctx, refundAmount, address, memo)
+	}
+	if refundAmount <= 0 {
+		return nil
+	}
+	recipient, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	amount, err := types.DenomAmount(refundAmount, payment.Rate, false)
+	if err != nil {
+		return err
+	}
+	amount = min(amount, payment.Amount)
+
+	k.LogInfo("Issuing refund", types.Payments, "address", address, "amount", refundAmount, "denom", payment.Denom, "denomAmount", amount)
+	if amount > 0 {
+		coins := sdk.NewCoins(sdk.NewInt64Coin(payment.Denom, amount))
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins, memo); err != nil {
+			k.LogError("Error issuing refund", types.Payments, "error", err)
+			return err
+		}
+	}
+
+	payment.Amount -= amount
+	if err := k.SetInferencePayment(ctx, payment); err != nil {
+		return err
+	}
+	k.updateDenomTreasury(ctx, payment.Denom, func(t *types.DenomTreasury) {
+		t.Refunded += amount
+	})
+	k.emitInferencePaymentEvent(ctx, types.EventTypeInferencePaymentRefunded, inferenceId, payment.Denom, amount, refundAmount)
+	return nil
+}
+
+// payExecutorInDenom credits the executor of an inference paid in a registered denom with its payment, taken
+// from the inference escrow, in place of its BaseCoin balance. It returns false when the inference was paid
+// in the native denom.
+func (k *Keeper) payExecutorInDenom(ctx context.Context, inferenceId string, executor string, executorPayment int64) (bool, error) {
+	payment, found := k.GetInferencePayment(ctx, inferenceId)
+	if !found {
+		return false, nil
+	}
+	amount, err := types.DenomAmount(executorPayment, payment.Rate, false)
+	if err != nil {
+		return true, err
+	}
+	amount = min(amount, payment.Amount)
+	if err := k.addParticipantDenomBalance(ctx, executor, payment.Denom, amount); err != nil {
+		return true, err
+	}
+	payment.Amount -= amount
+	return true, k.SetInferencePayment(ctx, payment)
+}
+
+// reclaimExecutorPaymentInDenom takes the payment for an invalidated inference paid in a registered denom back
+// from its executor into the inference escrow, so it can be refunded. It returns false when the inference was
+// paid in the native denom.
+func (k *Keeper) reclaimExecutorPaymentInDenom(ctx context.Context, inferenceId string, executor string, actualCost int64) (bool, error) {
+	payment, found := k.GetInferencePayment(ctx, inferenceId)
+	if !found {
+		return false, nil
+	}
+	amount, err := types.DenomAmount(actualCost, payment.Rate, false)
+	if err != nil {
+		return true, err
+	}
+	if err := k.addParticipantDenomBalance(ctx, executor, payment.Denom, -amount); err != nil {
+		return true, err
+	}
+	payment.Amount += amount
+	return true, k.SetInferencePayment(ctx, payment)
+}
+
+// shareWorkInDenom shares the work of an inference paid in a registered denom with a new validator, the way
+// shareWorkWithValidators shares BaseCoin balances. It returns false when the inference was paid in the native denom.
+func (k *Keeper) shareWorkInDenom(ctx context.Context, inference types.Inference, validator string) bool {
+	payment, found := k.GetInferencePayment(ctx, inference.InferenceId)
+	if !found {
+		return false
+	}
+	actualCost, err := types.DenomAmount(inference.ActualCost, payment.Rate, false)
+	if err != nil {
+		k.LogError("Invalid inference payment rate, work not shared", types.Validation, "inferenceId", inference.InferenceId, "error", err)
+		return true
+	}
+	originalWorkers := append([]string{inference.ExecutedBy}, inference.ValidatedBy...)
+	for _, adjustment := range calculations.ShareWork(originalWorkers, []string{validator}, actualCost) {
+		if err := k.addParticipantDenomBalance(ctx, adjustment.ParticipantId, payment.Denom, adjustment.WorkAdjustment); err != nil {
+			k.LogError("Unable to share work in denom", types.Validation, "participant", adjustment.ParticipantId, "denom", payment.Denom, "error", err)
+		}
+	}
+	return true
+}
+
+// settleDenomBalances takes what a participant earned in registered denoms during the settled epoch. An active
+// participant gets it as the denom work coins of its settle amount; an inactive one forfeits it to governance.
+// Negative balances, left by invalidations, are carried over to be offset by later earnings.
+func (k *Keeper) settleDenomBalances(ctx context.Context, participant types.Participant) sdk.Coins {
+	address, err := sdk.AccAddressFromBech32(participant.Address)
+	if err != nil {
+		return nil
+	}
+	balances, err := k.GetParticipantDenomBalances(ctx, address)
+	if err != nil {
+		k.LogError("Failed to read participant denom balances", types.Settle, "participant", participant.Address, "error", err)
+		return nil
+	}
+	var earned sdk.Coins
+	for _, balance := range balances {
+		if balance.Amount <= 0 {
+			continue
+		}
+		coin := sdk.NewInt64Coin(balance.Denom, balance.Amount)
+		if participant.Status != types.ParticipantStatus_ACTIVE {
+			if err := k.sendDenomCoinsToGovernance(ctx, sdk.NewCoins(coin), "forfeited_denom_work:"+participant.Address); err != nil {
+				continue
+			}
+		} else {
+			earned = earned.Add(coin)
+		}
+		balance.Amount = 0
+		if err := k.SetParticipantDenomBalance(ctx, balance); err != nil {
+			k.LogError("Failed to reset participant denom balance", types.Settle, "participant", participant.Address, "denom", balance.Denom, "error", err)
+		}
+	}
+	return earned
+}
+
+// payDenomWorkCoins pays the denom work coins of a claimed settle amount, vested like its BaseCoin work coins
+func (k *Keeper) payDenomWorkCoins(ctx context.Context, address string, coins sdk.Coins, memo string, vestingPeriods *uint64) error {
+	if coins.IsZero() {
+		return nil
+	}
+	if vestingPeriods != nil && *vestingPeriods > 0 {
+		if err := k.GetStreamVestingKeeper().AddVestedRewards(ctx, address, types.ModuleName, coins, vestingPeriods, memo+"_vested"); err != nil {
+			return err
+		}
+	} else {
+		recipient, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins, memo); err != nil {
+			return err
+		}
+	}
+	k.recordDenomPaidOut(ctx, coins)
+	return nil
+}
+
+func (k *Keeper) sendDenomCoinsToGovernance(ctx context.Context, coins sdk.Coins, memo string) error {
+	if coins.IsZero() {
+		return nil
+	}
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, govtypes.ModuleName, coins, memo); err != nil {
+		k.LogError("Error transferring denom coins to governance", types.Settle, "error", err, "coins", coins.String())
+		return err
+	}
+	k.recordDenomPaidOut(ctx, coins)
+	return nil
+}
+
+func (k *Keeper) recordDenomPaidOut(ctx context.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		k.updateDenomTreasury(ctx, coin.Denom, func(t *types.DenomTreasury) {
+			t.PaidOut += coin.Amount.Int64()
+		})
+	}
+}
+
+func (k *Keeper) emitInferencePaymentEvent(ctx context.Context, eventType string, inferenceId string, denom string, amount int64, nativeAmount int64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyInferenceId, inferenceId),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatInt(amount, 10)),
+			sdk.NewAttribute(types.AttributeKeyNativeAmount, strconv.FormatInt(nativeAmount, 10)),
+		))
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+const testIbcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+func TestPaymentDenomRegistry(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Empty(t, k.GetPaymentDenomRegistry(ctx).Denoms)
+
+	registry := types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{{Denom: testIbcDenom, Rate: "2.5"}}}
+	require.NoError(t, k.SetPaymentDenomRegistry(ctx, registry))
+	require.Equal(t, registry, k.GetPaymentDenomRegistry(ctx))
+
+	require.Error(t, k.SetPaymentDenomRegistry(ctx, types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{{Denom: types.BaseCoin, Rate: "1"}}}))
+	require.Error(t, k.SetPaymentDenomRegistry(ctx, types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{{Denom: testIbcDenom, Rate: "0"}}}))
+	require.Error(t, k.SetPaymentDenomRegistry(ctx, types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{
+		{Denom: testIbcDenom, Rate: "1"},
+		{Denom: testIbcDenom, Rate: "2"},
+	}}))
+	require.Equal(t, registry, k.GetPaymentDenomRegistry(ctx))
+}
+
+func TestEscrowAndRefundInRegisteredDenom(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.NoError(t, k.SetPaymentDenomRegistry(sdkCtx, types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{{Denom: testIbcDenom, Rate: "2.5"}}}))
+
+	requester, err := sdk.AccAddressFromBech32(testutil.Requester)
+	require.NoError(t, err)
+	inference := &types.Inference{InferenceId: "inference-1", RequestedBy: testutil.Requester}
+
+	// 1001 ngonka at 2.5 ngonka per unit is 400.4 units, charged as 401
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(gomock.Any(), requester, types.BaseCoin).Return(sdk.NewInt64Coin(types.BaseCoin, 10))
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(gomock.Any(), requester, testIbcDenom).Return(sdk.NewInt64Coin(testIbcDenom, 1000))
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(testIbcDenom, 401)), gomock.Any()).Return(nil)
+
+	escrowed, err := k.PutPaymentInEscrow(sdkCtx, inference, 1001)
+	require.NoError(t, err)
+	require.Equal(t, int64(1001), escrowed)
+
+	payment, found := k.GetInferencePayment(sdkCtx, "inference-1")
+	require.True(t, found)
+	require.Equal(t, types.InferencePayment{InferenceId: "inference-1", Denom: testIbcDenom, Rate: "2.5", Amount: 401}, payment)
+
+	// Refunding 501 ngonka returns 200 units
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, sdk.NewCoins(sdk.NewInt64Coin(testIbcDenom, 200)), gomock.Any()).Return(nil)
+	require.NoError(t, k.IssueInferenceRefund(sdkCtx, "inference-1", 501, testutil.Requester, "inference_refund:inference-1"))
+
+	payment, _ = k.GetInferencePayment(sdkCtx, "inference-1")
+	require.Equal(t, int64(201), payment.Amount)
+	require.Equal(t, types.DenomTreasury{Denom: testIbcDenom, Escrowed: 401, Refunded: 200}, k.GetDenomTreasury(sdkCtx, testIbcDenom))
+	require.Equal(t, int64(201), k.GetDenomTreasury(sdkCtx, testIbcDenom).Reserve())
+}
+
+func TestEscrowPrefersNativeDenom(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	require.NoError(t, k.SetPaymentDenomRegistry(sdkCtx, types.PaymentDenomRegistry{Denoms: []types.PaymentDenom{{Denom: testIbcDenom, Rate: "2.5"}}}))
+
+	requester, err := sdk.AccAddressFromBech32(testutil.Requester)
+	require.NoError(t, err)
+	inference := &types.Inference{InferenceId: "inference-2", RequestedBy: testutil.Requester}
+
+	mocks.BankViewKeeper.EXPECT().SpendableCoin(gomock.Any(), requester, types.BaseCoin).Return(sdk.NewInt64Coin(types.BaseCoin, 5000))
+	mocks.BankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), requester, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 1000)), gomock.Any()).Return(nil)
+
+	_, err = k.PutPaymentInEscrow(sdkCtx, inference, 1000)
+	require.NoError(t, err)
+	_, found := k.GetInferencePayment(sdkCtx, "inference-2")
+	require.False(t, found)
+
+	// Native refunds go through the regular refund path
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, requester, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 300)), gomock.Any()).Return(nil)
+	require.NoError(t, k.IssueInferenceRefund(sdkCtx, "inference-2", 300, testutil.Requester, "inference_refund:inference-2"))
+}
@@ -14,6 +14,9 @@ func (k *Keeper) PutPaymentInEscrow(ctx context.Context, inference *types.Infere
 	if err != nil {
 		return 0, err
 	}
+	if denom, amount, found := k.selectPaymentDenom(ctx, payeeAddress, cost); found {
+		return k.putPaymentInEscrowInDenom(ctx, inference, payeeAddress, cost, denom, amount)
+	}
 	k.LogDebug("Sending coins to escrow", types.Payments, "inference", inference.InferenceId, "coins", cost, "payee", payeeAddress)
 	coins, err := types.GetCoins(cost)
 	if err != nil {
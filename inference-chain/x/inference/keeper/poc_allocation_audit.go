@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"cosmossdk.io/collections"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// PocAllocationAuditRetentionEpochs is how many epochs of PoC allocation audits are kept in state
+const PocAllocationAuditRetentionEpochs = 5
+
+func (k Keeper) SetPocAllocationAudit(ctx context.Context, audit types.PocAllocationAudit) error {
+	bz, err := json.Marshal(audit)
+	if err != nil {
+		return err
+	}
+	return k.PocAllocationAudits.Set(ctx, collections.Join(audit.EpochIndex, audit.ModelId), bz)
+}
+
+func (k Keeper) GetPocAllocationAudit(ctx context.Context, epochIndex uint64, modelId string) (types.PocAllocationAudit, bool) {
+	var audit types.PocAllocationAudit
+	bz, err := k.PocAllocationAudits.Get(ctx, collections.Join(epochIndex, modelId))
+	if err != nil {
+		return audit, false
+	}
+	if err := json.Unmarshal(bz, &audit); err != nil {
+		k.LogError("Failed to decode PoC allocation audit", types.Allocation, "epochIndex", epochIndex, "modelId", modelId, "error", err)
+		return audit, false
+	}
+	return audit, true
+}
+
+// GetPocAllocationAudits returns the audits of all models for an epoch, ordered by model id
+func (k Keeper) GetPocAllocationAudits(ctx context.Context, epochIndex uint64) ([]types.PocAllocationAudit, error) {
+	iter, err := k.PocAllocationAudits.Iterate(ctx, collections.NewPrefixedPairRange[uint64, string](epochIndex))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var audits []types.PocAllocationAudit
+	for ; iter.Valid(); iter.Next() {
+		bz, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		var audit types.PocAllocationAudit
+		if err := json.Unmarshal(bz, &audit); err != nil {
+			return nil, err
+		}
+		audits = append(audits, audit)
+	}
+	return audits, nil
+}
+
+// SetPocAllocationAudits stores the audits of an epoch and prunes those older than the retention window
+func (k Keeper) SetPocAllocationAudits(ctx context.Context, epochIndex uint64, audits []types.PocAllocationAudit) error {
+	for _, audit := range audits {
+		if err := k.SetPocAllocationAudit(ctx, audit); err != nil {
+			return err
+		}
+	}
+	if epochIndex < PocAllocationAuditRetentionEpochs {
+		return nil
+	}
+	rng := new(collections.Range[collections.Pair[uint64, string]]).
+		EndExclusive(collections.Join(epochIndex-PocAllocationAuditRetentionEpochs+1, ""))
+	return k.PocAllocationAudits.Clear(ctx, rng)
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPocAllocationAudits(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	audit := types.PocAllocationAudit{
+		EpochIndex:          3,
+		ModelId:             "model-b",
+		Seed:                "filter_3_hash_model-b",
+		SampledParticipants: []string{"p1"},
+		Nodes: []types.PocAllocationNodeDecision{
+			{Participant: "p1", NodeId: "n1", PocWeight: 10, Decision: types.PocAllocationAllocated},
+			{Participant: "p2", NodeId: "n2", PocWeight: 20, Decision: types.PocAllocationNotSampled},
+		},
+	}
+	require.NoError(t, k.SetPocAllocationAudits(ctx, 3, []types.PocAllocationAudit{
+		audit,
+		{EpochIndex: 3, ModelId: "model-a"},
+	}))
+
+	got, found := k.GetPocAllocationAudit(ctx, 3, "model-b")
+	require.True(t, found)
+	require.Equal(t, audit, got)
+
+	_, found = k.GetPocAllocationAudit(ctx, 4, "model-b")
+	require.False(t, found)
+
+	audits, err := k.GetPocAllocationAudits(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, audits, 2)
+	require.Equal(t, "model-a", audits[0].ModelId)
+	require.Equal(t, "model-b", audits[1].ModelId)
+}
+
+func TestPocAllocationAudits_Retention(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	lastEpoch := uint64(keeper.PocAllocationAuditRetentionEpochs + 2)
+	for epoch := uint64(1); epoch <= lastEpoch; epoch++ {
+		require.NoError(t, k.SetPocAllocationAudits(ctx, epoch, []types.PocAllocationAudit{{EpochIndex: epoch, ModelId: "model"}}))
+	}
+
+	for epoch := uint64(1); epoch <= lastEpoch; epoch++ {
+		_, found := k.GetPocAllocationAudit(ctx, epoch, "model")
+		require.Equal(t, epoch > lastEpoch-keeper.PocAllocationAuditRetentionEpochs, found, "epoch %d", epoch)
+	}
+}
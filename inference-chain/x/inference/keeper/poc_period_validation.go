@@ -61,12 +61,11 @@ func (k Keeper) checkConfirmationPoCMessageTooLate(ctx sdk.Context, event *types
 		)
 	}
 
-	params, err := k.GetParams(ctx)
+	epochParams, err := k.GetEpochParamsForEpoch(ctx, event.EpochIndex)
 	if err != nil {
-		k.Logger().Debug("[ValidatePocPeriod] Error getting params", "error", err)
+		k.Logger().Debug("[ValidatePocPeriod] Error getting epoch params", "error", err)
 		return err
 	}
-	epochParams := params.EpochParams
 
 	switch windowType {
 	case PoCWindowBatch:
@@ -96,12 +95,6 @@ func (k Keeper) checkConfirmationPoCMessageTooLate(ctx sdk.Context, event *types
 }
 
 func (k Keeper) checkRegularPoCMessageTooLate(ctx sdk.Context, startBlockHeight, currentBlockHeight int64, windowType PoCWindowType) error {
-	params, err := k.GetParams(ctx)
-	if err != nil {
-		k.Logger().Debug("[ValidatePocPeriod] Error getting params", "error", err)
-		return err
-	}
-	epochParams := params.EpochParams
 	currentEpoch, found := k.GetEffectiveEpoch(ctx)
 	if !found {
 		k.Logger().Debug(
@@ -110,7 +103,11 @@ func (k Keeper) checkRegularPoCMessageTooLate(ctx sdk.Context, startBlockHeight,
 		)
 		return nil
 	}
-	currentEpochContext := types.NewEpochContext(*currentEpoch, *epochParams)
+	currentEpochContext, err := k.GetEpochContext(ctx, *currentEpoch)
+	if err != nil {
+		k.Logger().Debug("[ValidatePocPeriod] Error getting epoch context", "error", err)
+		return err
+	}
 	if startBlockHeight <= currentEpochContext.StartOfPoC() {
 		k.Logger().Debug(
 			"[ValidatePocPeriod] Start block height is for PoC stage that already finished",
@@ -139,7 +136,11 @@ func (k Keeper) checkRegularPoCMessageTooLate(ctx sdk.Context, startBlockHeight,
 		)
 	}
 
-	upcomingEpochContext := types.NewEpochContext(*upcomingEpoch, *epochParams)
+	upcomingEpochContext, err := k.GetEpochContext(ctx, *upcomingEpoch)
+	if err != nil {
+		k.Logger().Debug("[ValidatePocPeriod] Error getting epoch context", "error", err)
+		return err
+	}
 
 	if !upcomingEpochContext.IsStartOfPocStage(startBlockHeight) {
 		k.Logger().Debug(
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// applyPolicy decodes a policy value into the type its setter takes and applies it
+func applyPolicy[T any](value []byte, set func(T) error) error {
+	var decoded T
+	if err := types.DecodePolicyValue(value, &decoded); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid policy value: %v", err)
+	}
+	return set(decoded)
+}
+
+// SetPolicy applies a governance policy by name through the policy's setter, which validates it.
+// value is the JSON encoding of the type the setter takes, see types.Policy*.
+func (k Keeper) SetPolicy(ctx context.Context, policy string, value []byte) error {
+	switch policy {
+	case types.PolicyModelAssignmentStrategy:
+		return applyPolicy(value, func(strategy string) error { return k.SetModelAssignmentStrategy(ctx, strategy) })
+	case types.PolicyTimeslotSchedule:
+		return applyPolicy(value, func(schedule types.TimeslotSchedule) error { return k.SetTimeslotSchedule(ctx, schedule) })
+	case types.PolicyHardwareVerificationRequired:
+		return applyPolicy(value, func(required bool) error { return k.SetHardwareVerificationRequired(ctx, required) })
+	case types.PolicySlashing:
+		return applyPolicy(value, func(p types.SlashingPolicy) error { return k.SetSlashingPolicy(ctx, p) })
+	case types.PolicyEpochGroupDataRetentionEpochs:
+		return applyPolicy(value, func(epochs uint64) error { return k.SetEpochGroupDataRetentionEpochs(ctx, epochs) })
+	case types.PolicyTrainingAttestation:
+		return applyPolicy(value, func(p types.TrainingAttestationPolicy) error { return k.SetTrainingAttestationPolicy(ctx, p) })
+	case types.PolicyValidationCircuitBreaker:
+		return applyPolicy(value, func(p types.ValidationCircuitBreakerPolicy) error {
+			return k.SetValidationCircuitBreakerPolicy(ctx, p)
+		})
+	case types.PolicyLateJoin:
+		return applyPolicy(value, func(p types.LateJoinPolicy) error { return k.SetLateJoinPolicy(ctx, p) })
+	case types.PolicyPaymentDenomRegistry:
+		return applyPolicy(value, func(registry types.PaymentDenomRegistry) error { return k.SetPaymentDenomRegistry(ctx, registry) })
+	case types.PolicyRewardVestingSchedule:
+		return applyPolicy(value, func(schedule types.RewardVestingSchedule) error { return k.SetRewardVestingSchedule(ctx, schedule) })
+	case types.PolicyImageInput:
+		return applyPolicy(value, func(p types.ImageInputPolicy) error { return k.SetImageInputPolicy(ctx, p) })
+	case types.PolicyMLNodeVersionRollout:
+		return applyPolicy(value, func(rollout types.MLNodeVersionRollout) error { return k.SetMLNodeVersionRollout(ctx, rollout) })
+	case types.PolicyModelRewardWeights:
+		return applyPolicy(value, func(weights types.ModelRewardWeights) error { return k.SetModelRewardWeights(ctx, weights) })
+	case types.PolicyPriorityClassPricing:
+		return applyPolicy(value, func(pricing types.PriorityClassPricing) error { return k.SetPriorityClassPricing(ctx, pricing) })
+	case types.PolicyCapacityCollateral:
+		return applyPolicy(value, func(p types.CapacityCollateralPolicy) error { return k.SetCapacityCollateralPolicy(ctx, p) })
+	case types.PolicyDelegation:
+		return applyPolicy(value, func(p types.DelegationPolicy) error { return k.SetDelegationPolicy(ctx, p) })
+	case types.PolicyTrainingDataset:
+		return applyPolicy(value, func(dataset types.TrainingDataset) error { return k.RegisterTrainingDataset(ctx, dataset) })
+	}
+	return errorsmod.Wrapf(types.ErrUnknownPolicy, "%q", policy)
+}
+
+// GetAllPolicies returns the governance policies that were set, for genesis export. Policies left at
+// their defaults are omitted.
+func (k Keeper) GetAllPolicies(ctx context.Context) ([]types.PolicyEntry, error) {
+	var entries []types.PolicyEntry
+	addValue := func(policy string, value any) error {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, types.PolicyEntry{Policy: policy, Value: string(bz)})
+		return nil
+	}
+
+	if strategy, err := k.ModelAssignmentStrategy.Get(ctx); err == nil {
+		if err := addValue(types.PolicyModelAssignmentStrategy, strategy); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if required, err := k.HardwareVerificationRequired.Get(ctx); err == nil {
+		if err := addValue(types.PolicyHardwareVerificationRequired, required); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if epochs, err := k.EpochGroupDataRetentionEpochs.Get(ctx); err == nil {
+		if err := addValue(types.PolicyEpochGroupDataRetentionEpochs, epochs); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	// The other policies are stored as the JSON their setters take
+	jsonItems := []struct {
+		policy string
+		item   collections.Item[[]byte]
+	}{
+		{types.PolicyTimeslotSchedule, k.TimeslotSchedule},
+		{types.PolicySlashing, k.SlashingPolicy},
+		{types.PolicyTrainingAttestation, k.TrainingAttestationPolicy},
+		{types.PolicyValidationCircuitBreaker, k.ValidationCircuitBreakerPolicy},
+		{types.PolicyLateJoin, k.LateJoinPolicy},
+		{types.PolicyPaymentDenomRegistry, k.PaymentDenomRegistry},
+		{types.PolicyRewardVestingSchedule, k.RewardVestingSchedule},
+		{types.PolicyImageInput, k.ImageInputPolicy},
+		{types.PolicyMLNodeVersionRollout, k.MLNodeVersionRollout},
+		{types.PolicyModelRewardWeights, k.ModelRewardWeights},
+		{types.PolicyPriorityClassPricing, k.PriorityClassPricing},
+		{types.PolicyCapacityCollateral, k.CapacityCollateralPolicy},
+		{types.PolicyDelegation, k.DelegationPolicy},
+	}
+	for _, item := range jsonItems {
+		bz, err := item.item.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, types.PolicyEntry{Policy: item.policy, Value: string(bz)})
+	}
+
+	it, err := k.TrainingDatasets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	datasets, err := it.Values()
+	if err != nil {
+		return nil, err
+	}
+	for _, bz := range datasets {
+		entries = append(entries, types.PolicyEntry{Policy: types.PolicyTrainingDataset, Value: string(bz)})
+	}
+	return entries, nil
+}
+
+// InitPolicy restores an exported policy. Training datasets keep their registration height,
+// everything else goes through SetPolicy.
+func (k Keeper) InitPolicy(ctx context.Context, entry types.PolicyEntry) error {
+	if entry.Policy == types.PolicyTrainingDataset {
+		return applyPolicy([]byte(entry.Value), func(dataset types.TrainingDataset) error { return k.restoreTrainingDataset(ctx, dataset) })
+	}
+	return k.SetPolicy(ctx, entry.Policy, []byte(entry.Value))
+}
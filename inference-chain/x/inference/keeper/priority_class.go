@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetPriorityClassPricing sets the price multipliers of the inference priority classes.
+// Governance prices the classes with MsgUpdatePolicy.
+func (k Keeper) SetPriorityClassPricing(ctx context.Context, pricing types.PriorityClassPricing) error {
+	if err := pricing.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(pricing)
+	if err != nil {
+		return err
+	}
+	return k.PriorityClassPricing.Set(ctx, bz)
+}
+
+// GetPriorityClassPricing returns the active priority class multipliers, empty (every class priced like
+// the model) if none were set.
+func (k Keeper) GetPriorityClassPricing(ctx context.Context) types.PriorityClassPricing {
+	bz, err := k.PriorityClassPricing.Get(ctx)
+	if err != nil {
+		return types.PriorityClassPricing{}
+	}
+	var pricing types.PriorityClassPricing
+	if err := json.Unmarshal(bz, &pricing); err != nil {
+		k.LogError("Failed to decode priority class pricing, using none", types.Pricing, "error", err)
+		return types.PriorityClassPricing{}
+	}
+	return pricing
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestPriorityClassPricing(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	pricing := k.GetPriorityClassPricing(ctx)
+	require.Equal(t, uint64(1000), pricing.PerTokenPrice(1000, types.PriorityClassBatch))
+
+	require.Error(t, k.SetPriorityClassPricing(ctx, types.PriorityClassPricing{MultipliersPermille: map[string]uint32{"bulk": 500}}))
+	require.Error(t, k.SetPriorityClassPricing(ctx, types.PriorityClassPricing{MultipliersPermille: map[string]uint32{types.PriorityClassBatch: 0}}))
+
+	require.NoError(t, k.SetPriorityClassPricing(ctx, types.PriorityClassPricing{MultipliersPermille: map[string]uint32{
+		types.PriorityClassRealtime: 1500,
+		types.PriorityClassBatch:    500,
+	}}))
+	pricing = k.GetPriorityClassPricing(ctx)
+	require.Equal(t, uint64(1500), pricing.PerTokenPrice(1000, types.PriorityClassRealtime))
+	require.Equal(t, uint64(500), pricing.PerTokenPrice(1000, types.PriorityClassBatch))
+}
+
+func TestSplitPriorityClassModel(t *testing.T) {
+	modelId, class := types.SplitPriorityClassModel("Qwen/Qwen2.5-7B-Instruct")
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct", modelId)
+	require.Equal(t, types.PriorityClassRealtime, class)
+
+	model := types.PriorityClassModel("Qwen/Qwen2.5-7B-Instruct", types.PriorityClassBatch)
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct@batch", model)
+	modelId, class = types.SplitPriorityClassModel(model)
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct", modelId)
+	require.Equal(t, types.PriorityClassBatch, class)
+
+	require.Equal(t, "Qwen/Qwen2.5-7B-Instruct", types.PriorityClassModel("Qwen/Qwen2.5-7B-Instruct", types.PriorityClassRealtime))
+}
+
+func TestStartInference_PriorityClassPrice(t *testing.T) {
+	inferenceHelper, k, ctx := NewMockInferenceHelper(t)
+	ctx, err := advanceEpoch(ctx, &k, inferenceHelper.Mocks, 10, 1)
+	require.NoError(t, err)
+	model := types.Model{Id: "model1"}
+	k.SetModel(ctx, &model)
+	require.NoError(t, k.SetPriorityClassPricing(ctx, types.PriorityClassPricing{MultipliersPermille: map[string]uint32{
+		types.PriorityClassBatch: 500,
+	}}))
+
+	started, err := inferenceHelper.StartInference("promptPayload", types.PriorityClassModel(model.Id, types.PriorityClassBatch), ctx.BlockTime().UnixNano(), calculations.DefaultMaxTokens)
+	require.NoError(t, err)
+
+	inference, found := k.GetInference(ctx, started.InferenceId)
+	require.True(t, found)
+	require.Equal(t, model.Id, inference.Model)
+	require.Equal(t, uint64(calculations.PerTokenCost/2), inference.PerTokenPrice)
+	require.Equal(t, int64(calculations.DefaultMaxTokens*calculations.PerTokenCost/2), inference.EscrowAmount)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -29,6 +30,12 @@ func (k Keeper) Prune(ctx context.Context, currentEpochIndex int64) error {
 	if err != nil {
 		return err
 	}
+	if retention, enabled := k.GetEpochGroupDataRetentionEpochs(ctx, params); enabled {
+		err = k.GetEpochGroupDataPruner(retention).Prune(ctx, k, currentEpochIndex)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -47,10 +54,21 @@ func (k Keeper) GetInferencePruner(params types.Params) Pruner[collections.Pair[
 			state.InferencePrunedEpoch = epoch
 		},
 		Remover: func(ctx context.Context, key collections.Pair[int64, string]) error {
+			if inference, found := k.GetInference(ctx, key.K2()); found {
+				k.exportPrunedInference(ctx, inference)
+			}
 			err := k.Inferences.Remove(ctx, key.K2())
 			if err != nil {
 				return err
 			}
+			err = k.InferencePayments.Remove(ctx, key.K2())
+			if err != nil {
+				return err
+			}
+			err = k.InferenceEscrows.Remove(ctx, key.K2())
+			if err != nil {
+				return err
+			}
 			return k.InferencesToPrune.Remove(ctx, key)
 		},
 		Logger: k,
@@ -111,6 +129,25 @@ func (k Keeper) GetPoCValidationsPruner(params types.Params) Pruner[collections.
 	}
 }
 
+func (k Keeper) GetEpochGroupDataPruner(retention uint64) Pruner[collections.Pair[uint64, string], types.EpochGroupData] {
+	return Pruner[collections.Pair[uint64, string], types.EpochGroupData]{
+		Threshold:      retention,
+		PruningMax:     EpochGroupDataPruningMax,
+		List:           k.EpochGroupDataMap,
+		LastPrunedItem: &k.EpochGroupDataPrunedEpoch,
+		Ranger: func(ctx context.Context, epoch int64) collections.Ranger[collections.Pair[uint64, string]] {
+			return collections.NewPrefixedPairRange[uint64, string](uint64(epoch))
+		},
+		Remover: func(ctx context.Context, key collections.Pair[uint64, string]) error {
+			if data, err := k.EpochGroupDataMap.Get(ctx, key); err == nil {
+				k.exportPrunedEpochGroupData(ctx, data)
+			}
+			return k.EpochGroupDataMap.Remove(ctx, key)
+		},
+		Logger: k,
+	}
+}
+
 type Pruner[K any, V any] struct {
 	Threshold     uint64
 	PruningMax    int64
@@ -119,7 +156,9 @@ type Pruner[K any, V any] struct {
 	Logger        types.InferenceLogger
 	GetLastPruned func(pruningState types.PruningState) int64
 	SetLastPruned func(pruningState *types.PruningState, epoch int64)
-	Remover       func(ctx context.Context, key K) error
+	// LastPrunedItem keeps the last pruned epoch instead of PruningState, for lists added after it
+	LastPrunedItem *collections.Item[int64]
+	Remover        func(ctx context.Context, key K) error
 }
 
 func (p Pruner[K, V]) PruneEpoch(ctx context.Context, currentEpochIndex int64, prunesLeft int64) (int64, error) {
@@ -149,7 +188,7 @@ func (p Pruner[K, V]) PruneEpoch(ctx context.Context, currentEpochIndex int64, p
 }
 
 func (p Pruner[K, V]) Prune(ctx context.Context, k Keeper, currentEpochIndex int64) error {
-	pruningState, err := k.PruningState.Get(ctx)
+	lastPruned, err := p.lastPruned(ctx, k)
 	if err != nil {
 		p.Logger.LogError("Failed to get pruning state", types.Pruning,
 			"error", err,
@@ -157,7 +196,7 @@ func (p Pruner[K, V]) Prune(ctx context.Context, k Keeper, currentEpochIndex int
 		)
 		return err
 	}
-	startEpoch, endEpoch := getEpochsToPrune(p.Threshold, currentEpochIndex, p.GetLastPruned(pruningState))
+	startEpoch, endEpoch := getEpochsToPrune(p.Threshold, currentEpochIndex, lastPruned)
 	if startEpoch > endEpoch {
 		p.Logger.LogDebug("No epochs to prune", types.Pruning)
 		return nil
@@ -180,25 +219,12 @@ func (p Pruner[K, V]) Prune(ctx context.Context, k Keeper, currentEpochIndex int
 		}
 		if prunedForEpoch == 0 {
 			p.Logger.LogInfo("Pruning epoch complete", types.Pruning, "epoch", epoch, "list", p.List.GetName())
-			currentPruningState, err := k.PruningState.Get(ctx)
-			if err != nil {
-				p.Logger.LogError("Failed to get pruning state", types.Pruning,
+			if err := p.markPruned(ctx, k, epoch); err != nil {
+				p.Logger.LogError("Failed to mark epoch complete", types.Pruning,
 					"epoch", epoch,
 					"error", err,
 					"list", p.List.GetName(),
 				)
-				return err
-			}
-			if p.GetLastPruned(currentPruningState) < epoch {
-				p.SetLastPruned(&currentPruningState, epoch)
-				err = k.PruningState.Set(ctx, currentPruningState)
-				if err != nil {
-					p.Logger.LogError("Failed to mark epoch complete", types.Pruning,
-						"epoch", epoch,
-						"error", err,
-						"list", p.List.GetName(),
-					)
-				}
 			}
 		} else {
 			p.Logger.LogInfo("Items pruned for epoch", types.Pruning, "epoch", epoch, "pruned", prunedForEpoch, "list", p.List.GetName())
@@ -207,6 +233,41 @@ func (p Pruner[K, V]) Prune(ctx context.Context, k Keeper, currentEpochIndex int
 	return nil
 }
 
+// lastPruned returns the last fully pruned epoch, from LastPrunedItem if set, otherwise from PruningState
+func (p Pruner[K, V]) lastPruned(ctx context.Context, k Keeper) (int64, error) {
+	if p.LastPrunedItem != nil {
+		epoch, err := p.LastPrunedItem.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			return 0, nil
+		}
+		return epoch, err
+	}
+	pruningState, err := k.PruningState.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return p.GetLastPruned(pruningState), nil
+}
+
+func (p Pruner[K, V]) markPruned(ctx context.Context, k Keeper, epoch int64) error {
+	if p.LastPrunedItem != nil {
+		lastPruned, err := p.lastPruned(ctx, k)
+		if err != nil || lastPruned >= epoch {
+			return err
+		}
+		return p.LastPrunedItem.Set(ctx, epoch)
+	}
+	currentPruningState, err := k.PruningState.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if p.GetLastPruned(currentPruningState) < epoch {
+		p.SetLastPruned(&currentPruningState, epoch)
+		return k.PruningState.Set(ctx, currentPruningState)
+	}
+	return nil
+}
+
 func getEpochsToPrune(pruningThreshold uint64, currentEpochIndex int64, lastPrunedEpoch int64) (int64, int64) {
 	startEpoch := lastPrunedEpoch + 1
 	//if lastPrunedEpoch+1 > startEpoch {
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"testing"
 
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/x/inference/keeper"
@@ -389,3 +391,60 @@ func TestPoCBatchesPruningMaxLimit_MultiCall_EpochAdvanceAfterEmpty(t *testing.T
 	st, _ = k.PruningState.Get(ctx)
 	require.Equal(t, int64(2), st.PocBatchesPrunedEpoch)
 }
+
+type recordingExporter struct {
+	inferences     []string
+	epochGroupData []collections.Pair[uint64, string]
+}
+
+func (e *recordingExporter) ExportInference(_ context.Context, inference types.Inference) {
+	e.inferences = append(e.inferences, inference.Index)
+}
+
+func (e *recordingExporter) ExportEpochGroupData(_ context.Context, data types.EpochGroupData) {
+	e.epochGroupData = append(e.epochGroupData, collections.Join(data.EpochIndex, data.ModelId))
+}
+
+func TestPruningEpochGroupDataWithExport(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.PruningState.Set(ctx, types.PruningState{}))
+
+	exporter := &recordingExporter{}
+	k.SetPrunedRecordExporter(exporter)
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: epoch})
+		k.SetEpochGroupData(ctx, types.EpochGroupData{EpochIndex: epoch, ModelId: "model-a"})
+	}
+
+	k.SetInferenceWithoutDevStatComputation(ctx, types.Inference{Index: "pruned-inference", EpochId: 1})
+
+	// Epoch group data is kept until governance selects a retention
+	require.NoError(t, k.Prune(ctx, 7))
+	_, found := k.GetEpochGroupData(ctx, 1, "model-a")
+	require.True(t, found)
+	require.Empty(t, exporter.epochGroupData)
+	require.Equal(t, []string{"pruned-inference"}, exporter.inferences)
+
+	require.Error(t, k.SetEpochGroupDataRetentionEpochs(ctx, keeper.MinEpochGroupDataRetentionEpochs-1))
+	require.NoError(t, k.SetEpochGroupDataRetentionEpochs(ctx, 5))
+
+	// Epoch 7 with a retention of 5 prunes epoch group data of epochs 1 and 2
+	require.NoError(t, k.Prune(ctx, 7))
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		_, found := k.GetEpochGroupData(ctx, epoch, "model-a")
+		require.Equal(t, epoch > 2, found, "epoch %d", epoch)
+	}
+	require.Equal(t, []collections.Pair[uint64, string]{
+		collections.Join(uint64(1), ""), collections.Join(uint64(1), "model-a"),
+		collections.Join(uint64(2), ""), collections.Join(uint64(2), "model-a"),
+	}, exporter.epochGroupData)
+	require.Equal(t, []string{"pruned-inference"}, exporter.inferences)
+
+	// Epochs are marked pruned once nothing is left in them
+	require.NoError(t, k.Prune(ctx, 7))
+	prunedEpoch, err := k.EpochGroupDataPrunedEpoch.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), prunedEpoch)
+}
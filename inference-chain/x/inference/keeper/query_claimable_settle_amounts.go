@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListClaimableSettleAmounts returns the settle amounts a participant can still claim, the current one
+// and those carried over from earlier epochs.
+func (k Keeper) ListClaimableSettleAmounts(ctx context.Context, req *types.QueryClaimableSettleAmountsRequest) (*types.QueryClaimableSettleAmountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Participant); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid participant address")
+	}
+
+	return &types.QueryClaimableSettleAmountsResponse{SettleAmounts: k.GetClaimableSettleAmounts(ctx, req.Participant)}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListParticipantDelegations returns the stake delegated to a participant.
+func (k Keeper) ListParticipantDelegations(ctx context.Context, req *types.QueryParticipantDelegationsRequest) (*types.QueryParticipantDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	participant, err := sdk.AccAddressFromBech32(req.Participant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid participant address")
+	}
+
+	delegations := k.GetParticipantDelegations(ctx, participant)
+	return &types.QueryParticipantDelegationsResponse{Delegations: delegations, Total: delegations.Total()}, nil
+}
+
+// ListDelegationUnbondings returns the undelegated stake of a delegator waiting for its release epoch to be settled.
+func (k Keeper) ListDelegationUnbondings(ctx context.Context, req *types.QueryDelegationUnbondingsRequest) (*types.QueryDelegationUnbondingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Delegator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid delegator address")
+	}
+
+	unbondings, err := k.GetAllDelegationUnbondings(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to read delegation unbondings")
+	}
+	var delegatorUnbondings []types.DelegationUnbonding
+	for _, unbonding := range unbondings {
+		if unbonding.Delegator == req.Delegator {
+			delegatorUnbondings = append(delegatorUnbondings, unbonding)
+		}
+	}
+	return &types.QueryDelegationUnbondingsResponse{Unbondings: delegatorUnbondings}, nil
+}
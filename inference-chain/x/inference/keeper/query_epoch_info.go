@@ -30,6 +30,13 @@ func (k Keeper) EpochInfo(goCtx context.Context, req *types.QueryEpochInfoReques
 		return nil, types.ErrLatestEpochNotFound
 	}
 
+	// Phases of the latest epoch follow the epoch params it started with, not ones changed since
+	epochParams, err := k.GetEpochParamsForEpoch(ctx, latestEpoch.Index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	params.EpochParams = epochParams
+
 	// Check for active confirmation PoC event
 	activeEvent, isActive, err := k.GetActiveConfirmationPoCEvent(ctx)
 	if err != nil {
@@ -65,17 +65,7 @@ func (k Keeper) createFilterFn(goCtx context.Context, modelId string) (func(memb
 		return nil, status.Error(codes.NotFound, "GetRandomExecutor: no effective epoch found")
 	}
 
-	epochParams, err := k.GetParams(goCtx)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-	if epochParams.EpochParams == nil {
-		k.Logger().Error("GetRandomExecutor: createFilterFn: epoch params are nil",
-			"model_id", modelId, "epoch_index", effectiveEpoch.Index)
-		return nil, status.Error(codes.NotFound, "GetRandomExecutor: epoch params are nill")
-	}
-
-	epochContext, err := types.NewEpochContextFromEffectiveEpoch(*effectiveEpoch, *epochParams.EpochParams, sdkCtx.BlockHeight())
+	epochContext, err := k.GetEffectiveEpochContext(goCtx, *effectiveEpoch, sdkCtx.BlockHeight())
 	if err != nil {
 		k.Logger().Error("GetRandomExecutor: createFilterFn: failed to create epoch context",
 			"model_id", modelId, "epoch_index", effectiveEpoch.Index, "error", err.Error())
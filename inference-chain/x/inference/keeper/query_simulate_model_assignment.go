@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ModelAssignmentSimulator runs model assignment and PoC slot allocation on copies of the participants.
+// The assignment logic lives in the module, which sets the simulator when it is created.
+type ModelAssignmentSimulator func(ctx context.Context, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) []*types.ActiveParticipant
+
+type modelAssignmentSimulation struct {
+	simulator ModelAssignmentSimulator
+}
+
+// SetModelAssignmentSimulator sets the simulator behind the SimulateModelAssignment query
+func (k Keeper) SetModelAssignmentSimulator(simulator ModelAssignmentSimulator) {
+	k.modelAssignmentSim.simulator = simulator
+}
+
+// SimulateModelAssignment runs the next model assignment read-only against the current hardware nodes and
+// governance models. Participants and node weights are taken from the current epoch, since the upcoming
+// epoch's PoC results are not known before the flip.
+func (k Keeper) SimulateModelAssignment(goCtx context.Context, req *types.QuerySimulateModelAssignmentRequest) (*types.QuerySimulateModelAssignmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.modelAssignmentSim == nil || k.modelAssignmentSim.simulator == nil {
+		return nil, status.Error(codes.Unimplemented, "model assignment simulation is not available")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochGroup, err := k.GetCurrentEpochGroup(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	currentEpoch := epochGroup.GroupData
+
+	participants := make([]*types.ActiveParticipant, 0, len(currentEpoch.ValidationWeights))
+	for _, vw := range currentEpoch.ValidationWeights {
+		participants = append(participants, &types.ActiveParticipant{
+			Index:   vw.MemberAddress,
+			Weight:  vw.Weight,
+			MlNodes: []*types.ModelMLNodes{{MlNodes: vw.MlNodes}},
+		})
+	}
+
+	// Assignment runs for every participant, since PoC slots are allocated across them, and is filtered afterwards.
+	// Anything it writes goes to a discarded cache.
+	cacheCtx, _ := ctx.CacheContext()
+	upcomingEpoch := types.Epoch{Index: currentEpoch.EpochIndex + 1}
+	simulated := k.modelAssignmentSim.simulator(cacheCtx, participants, upcomingEpoch)
+
+	response := &types.QuerySimulateModelAssignmentResponse{
+		UpcomingEpochIndex: upcomingEpoch.Index,
+		Strategy:           k.GetModelAssignmentStrategy(ctx),
+		Participants:       make([]*types.ActiveParticipant, 0, len(simulated)),
+	}
+	for _, p := range simulated {
+		if req.Participant != "" && p.Index != req.Participant {
+			continue
+		}
+		response.Participants = append(response.Participants, p)
+	}
+	return response, nil
+}
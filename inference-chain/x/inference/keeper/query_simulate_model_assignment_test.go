@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestSimulateModelAssignmentQuery(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	_, err := k.SimulateModelAssignment(ctx, &types.QuerySimulateModelAssignmentRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 3))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{
+		EpochIndex: 3,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "participant-a", Weight: 10, MlNodes: []*types.MLNodeInfo{{NodeId: "n1", PocWeight: 10}}},
+			{MemberAddress: "participant-b", Weight: 20, MlNodes: []*types.MLNodeInfo{{NodeId: "n2", PocWeight: 20}}},
+		},
+	})
+
+	var simulatedEpoch types.Epoch
+	k.SetModelAssignmentSimulator(func(_ context.Context, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) []*types.ActiveParticipant {
+		simulatedEpoch = upcomingEpoch
+		for _, p := range participants {
+			p.Models = []string{"model-a"}
+		}
+		return participants
+	})
+
+	resp, err := k.SimulateModelAssignment(ctx, &types.QuerySimulateModelAssignmentRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), simulatedEpoch.Index)
+	require.Equal(t, uint64(4), resp.UpcomingEpochIndex)
+	require.Equal(t, types.DefaultModelAssignmentStrategy, resp.Strategy)
+	require.Len(t, resp.Participants, 2)
+	require.Equal(t, []string{"model-a"}, resp.Participants[0].Models)
+	require.Equal(t, "n1", resp.Participants[0].MlNodes[0].MlNodes[0].NodeId)
+
+	resp, err = k.SimulateModelAssignment(ctx, &types.QuerySimulateModelAssignmentRequest{Participant: "participant-b"})
+	require.NoError(t, err)
+	require.Len(t, resp.Participants, 1)
+	require.Equal(t, "participant-b", resp.Participants[0].Index)
+	require.Equal(t, int64(20), resp.Participants[0].Weight)
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetRewardVestingSchedule selects the vesting schedule applied to rewards claimed from now on.
+// MsgUpdatePolicy (types.PolicyRewardVestingSchedule) is the governance path that sets it.
+func (k Keeper) SetRewardVestingSchedule(ctx context.Context, schedule types.RewardVestingSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(schedule)
+	if err != nil {
+		return err
+	}
+	return k.RewardVestingSchedule.Set(ctx, bz)
+}
+
+// GetRewardVestingSchedule returns the active reward vesting schedule,
+// or types.DefaultRewardVestingSchedule if none was selected.
+func (k Keeper) GetRewardVestingSchedule(ctx context.Context) types.RewardVestingSchedule {
+	bz, err := k.RewardVestingSchedule.Get(ctx)
+	if err != nil {
+		return types.DefaultRewardVestingSchedule()
+	}
+	var schedule types.RewardVestingSchedule
+	if err := json.Unmarshal(bz, &schedule); err != nil {
+		k.LogError("Failed to decode reward vesting schedule, using default", types.Settle, "error", err)
+		return types.DefaultRewardVestingSchedule()
+	}
+	return schedule
+}
+
+// GetRewardVestingAccount returns the rewards of a participant that are still vesting or not released yet
+func (k Keeper) GetRewardVestingAccount(ctx context.Context, participant string) (types.RewardVestingAccount, bool) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.RewardVestingAccount{}, false
+	}
+	account, err := k.RewardVestingAccounts.Get(ctx, addr)
+	if err != nil {
+		return types.RewardVestingAccount{}, false
+	}
+	return account, true
+}
+
+// GetAllRewardVestingAccounts returns the vesting accounts of all participants
+func (k Keeper) GetAllRewardVestingAccounts(ctx context.Context) ([]types.RewardVestingAccount, error) {
+	it, err := k.RewardVestingAccounts.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return it.Values()
+}
+
+// SetRewardVestingAccount stores the account, removing it once all of its tranches were released
+func (k Keeper) SetRewardVestingAccount(ctx context.Context, account types.RewardVestingAccount) error {
+	addr, err := sdk.AccAddressFromBech32(account.Participant)
+	if err != nil {
+		return err
+	}
+	if len(account.Tranches) == 0 {
+		return k.RewardVestingAccounts.Remove(ctx, addr)
+	}
+	return k.RewardVestingAccounts.Set(ctx, addr, account)
+}
+
+// vestRewards adds the vesting part of a claimed reward to the participant's vesting account. The coins stay
+// in the module account and vest linearly over the schedule's epochs, starting with the next epoch.
+func (k Keeper) vestRewards(ctx context.Context, participant string, settleEpoch uint64, amount uint64, schedule types.RewardVestingSchedule) error {
+	if amount == 0 {
+		return nil
+	}
+	currentEpoch, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return types.ErrEffectiveEpochNotFound
+	}
+	account, found := k.GetRewardVestingAccount(ctx, participant)
+	if !found {
+		account = types.RewardVestingAccount{Participant: participant}
+	}
+	account.Tranches = append(account.Tranches, types.RewardVestingTranche{
+		SettleEpoch: settleEpoch,
+		StartEpoch:  currentEpoch + 1,
+		Epochs:      schedule.VestingEpochs,
+		Total:       amount,
+	})
+	return k.SetRewardVestingAccount(ctx, account)
+}
+
+// ClaimVestedRewards pays out the vested rewards of a participant that were not released yet
+// and returns the amount paid.
+func (k Keeper) ClaimVestedRewards(ctx context.Context, participant string) (uint64, error) {
+	account, found := k.GetRewardVestingAccount(ctx, participant)
+	if !found {
+		return 0, nil
+	}
+	currentEpoch, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return 0, types.ErrEffectiveEpochNotFound
+	}
+	releasable := account.Releasable(currentEpoch)
+	if releasable == 0 {
+		return 0, nil
+	}
+	if err := k.PayParticipantFromModule(ctx, participant, int64(releasable), types.ModuleName, "vested_reward_coins:"+participant, nil); err != nil {
+		return 0, err
+	}
+
+	remaining := account.Tranches[:0]
+	for _, tranche := range account.Tranches {
+		tranche.Released = tranche.VestedAt(currentEpoch)
+		if tranche.Released < tranche.Total {
+			remaining = append(remaining, tranche)
+		}
+	}
+	account.Tranches = remaining
+	if err := k.SetRewardVestingAccount(ctx, account); err != nil {
+		return 0, err
+	}
+	k.LogInfo("Released vested rewards", types.Settle, "participant", participant, "amount", releasable, "epoch", currentEpoch, "stillVesting", account.Locked())
+	return releasable, nil
+}
+
+// releaseVestedRewards releases what vested with a participant's claim; failures leave the coins vesting
+func (k Keeper) releaseVestedRewards(ctx context.Context, participant string) {
+	if _, err := k.ClaimVestedRewards(ctx, participant); err != nil {
+		k.LogError("Failed to release vested rewards", types.Settle, "participant", participant, "error", err)
+	}
+}
@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestRewardVestingSchedule(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultRewardVestingSchedule(), k.GetRewardVestingSchedule(ctx))
+	require.False(t, k.GetRewardVestingSchedule(ctx).Enabled())
+
+	schedule := types.RewardVestingSchedule{ImmediatePercent: 25, VestingEpochs: 4}
+	require.NoError(t, k.SetRewardVestingSchedule(ctx, schedule))
+	require.Equal(t, schedule, k.GetRewardVestingSchedule(ctx))
+	require.Error(t, k.SetRewardVestingSchedule(ctx, types.RewardVestingSchedule{ImmediatePercent: 101, VestingEpochs: 4}))
+
+	immediate, vested := schedule.Split(1001)
+	require.Equal(t, uint64(250), immediate)
+	require.Equal(t, uint64(751), vested)
+	immediate, vested = types.DefaultRewardVestingSchedule().Split(1001)
+	require.Equal(t, uint64(1001), immediate)
+	require.Zero(t, vested)
+
+	tranche := types.RewardVestingTranche{StartEpoch: 10, Epochs: 4, Total: 751}
+	require.Zero(t, tranche.VestedAt(9))
+	require.Equal(t, uint64(187), tranche.VestedAt(10))
+	require.Equal(t, uint64(375), tranche.VestedAt(11))
+	require.Equal(t, uint64(751), tranche.VestedAt(13))
+	require.Equal(t, uint64(751), tranche.VestedAt(100))
+}
+
+func TestClaimVestedRewards(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	addr, err := sdk.AccAddressFromBech32(testutil.Executor)
+	require.NoError(t, err)
+
+	account := types.RewardVestingAccount{
+		Participant: testutil.Executor,
+		Tranches: []types.RewardVestingTranche{
+			{SettleEpoch: 8, StartEpoch: 10, Epochs: 2, Total: 100},
+			{SettleEpoch: 9, StartEpoch: 11, Epochs: 4, Total: 400},
+		},
+	}
+	require.NoError(t, k.SetRewardVestingAccount(ctx, account))
+
+	// Nothing has vested before the first tranche starts
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 9))
+	paid, err := k.ClaimVestedRewards(ctx, testutil.Executor)
+	require.NoError(t, err)
+	require.Zero(t, paid)
+
+	// Epoch 11: all of the first tranche and a quarter of the second
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 200)), gomock.Any()).
+		Return(nil)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 11))
+	paid, err = k.ClaimVestedRewards(ctx, testutil.Executor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(200), paid)
+
+	stored, found := k.GetRewardVestingAccount(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Len(t, stored.Tranches, 1)
+	require.Equal(t, uint64(300), stored.Locked())
+
+	// Claiming again in the same epoch releases nothing
+	paid, err = k.ClaimVestedRewards(ctx, testutil.Executor)
+	require.NoError(t, err)
+	require.Zero(t, paid)
+
+	// Once the last tranche is released the account is removed
+	mocks.BankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, addr, sdk.NewCoins(sdk.NewInt64Coin(types.BaseCoin, 300)), gomock.Any()).
+		Return(nil)
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 20))
+	paid, err = k.ClaimVestedRewards(ctx, testutil.Executor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), paid)
+	_, found = k.GetRewardVestingAccount(ctx, testutil.Executor)
+	require.False(t, found)
+}
@@ -79,16 +79,14 @@ func (k Keeper) transferUnclaimedSettleAmountToGovernance(ctx context.Context, s
 		k.SafeLogSubAccountTransaction(ctx, types.ModuleName, settleAmount.Participant, types.SettleSubAccount, totalCoins, reason)
 		k.LogInfo("Transferred unclaimed settle amount to governance", types.Settle, "participant", settleAmount.Participant, "amount", totalCoins, "reason", reason)
 	}
-	return nil
+	return k.sendDenomCoinsToGovernance(ctx, sdk.NewCoins(settleAmount.DenomWorkCoins...), reason+":"+settleAmount.Participant)
 }
 
-// SetSettleAmountWithGovernanceTransfer sets a settle amount, transferring any existing unclaimed amount to governance first.
-func (k Keeper) SetSettleAmountWithGovernanceTransfer(ctx context.Context, settleAmount types.SettleAmount) error {
-	// Transfer existing settle amount if it exists
+// SetSettleAmountWithCarryover sets a settle amount, carrying over any existing unclaimed amount first.
+func (k Keeper) SetSettleAmountWithCarryover(ctx context.Context, settleAmount types.SettleAmount) error {
 	existingSettle, found := k.GetSettleAmount(ctx, settleAmount.Participant)
 	if found {
-		err := k.transferUnclaimedSettleAmountToGovernance(ctx, existingSettle, "expired claim")
-		if err != nil {
+		if err := k.carryOverSettleAmount(ctx, existingSettle); err != nil {
 			return err
 		}
 	}
@@ -102,13 +100,12 @@ func (k Keeper) SetSettleAmountWithGovernanceTransfer(ctx context.Context, settl
 	return nil
 }
 
-// TransferOldSettleAmountsToGovernance transfers and removes all settle amounts older than the specified epoch.
-func (k Keeper) TransferOldSettleAmountsToGovernance(ctx context.Context, beforeEpochIndex uint64) error {
+// CarryOverOldSettleAmounts moves all settle amounts older than the specified epoch into the carryover store.
+func (k Keeper) CarryOverOldSettleAmounts(ctx context.Context, beforeEpochIndex uint64) error {
 	allSettleAmounts := k.GetAllSettleAmount(ctx)
 	for _, settleAmount := range allSettleAmounts {
 		if settleAmount.EpochIndex < beforeEpochIndex {
-			err := k.transferUnclaimedSettleAmountToGovernance(ctx, settleAmount, "expired")
-			if err != nil {
+			if err := k.carryOverSettleAmount(ctx, settleAmount); err != nil {
 				return err
 			}
 			k.RemoveSettleAmount(ctx, settleAmount.Participant)
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SettleCarryoverEpochs is how many epochs an unclaimed settle amount stays claimable after its
+// regular claim epoch, so operator downtime during the claim stage does not forfeit rewards.
+// Carried over claims are validated against the settled epoch's data, so the window must stay
+// below InferencePruningEpochThreshold.
+const SettleCarryoverEpochs = 1
+
+// carryOverSettleAmount moves an unclaimed settle amount into the carryover store.
+// Coins stay in the module account until the amount is claimed or expires.
+func (k Keeper) carryOverSettleAmount(ctx context.Context, settleAmount types.SettleAmount) error {
+	if settleAmount.GetTotalCoins() == 0 {
+		return nil
+	}
+	// A fresh claim window starts for the carried over amount
+	settleAmount.LastClaimAttempt = 0
+	if err := k.SetSettleCarryover(ctx, settleAmount); err != nil {
+		return err
+	}
+	k.LogInfo("Carried over unclaimed settle amount", types.Settle, "participant", settleAmount.Participant, "epoch", settleAmount.EpochIndex, "amount", settleAmount.GetTotalCoins())
+	return nil
+}
+
+// GetSettleCarryover returns the carried over settle amount of a participant for an epoch
+func (k Keeper) GetSettleCarryover(ctx context.Context, participant string, epochIndex uint64) (types.SettleAmount, bool) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return types.SettleAmount{}, false
+	}
+	v, err := k.SettleCarryovers.Get(ctx, collections.Join(addr, epochIndex))
+	if err != nil {
+		return types.SettleAmount{}, false
+	}
+	return v, true
+}
+
+// SetSettleCarryover stores a carried over settle amount
+func (k Keeper) SetSettleCarryover(ctx context.Context, settleAmount types.SettleAmount) error {
+	addr, err := sdk.AccAddressFromBech32(settleAmount.Participant)
+	if err != nil {
+		return err
+	}
+	return k.SettleCarryovers.Set(ctx, collections.Join(addr, settleAmount.EpochIndex), settleAmount)
+}
+
+// GetAllSettleCarryovers returns the carried over settle amounts of all participants
+func (k Keeper) GetAllSettleCarryovers(ctx context.Context) ([]types.SettleAmount, error) {
+	iter, err := k.SettleCarryovers.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// RemoveSettleCarryover removes a carried over settle amount
+func (k Keeper) RemoveSettleCarryover(ctx context.Context, participant string, epochIndex uint64) {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return
+	}
+	_ = k.SettleCarryovers.Remove(ctx, collections.Join(addr, epochIndex))
+}
+
+// ExpireSettleCarryovers transfers carried over amounts whose window has passed to governance.
+// Called when settling settledEpochIndex: claims for epoch E remain valid up to epoch E+1+SettleCarryoverEpochs.
+func (k Keeper) ExpireSettleCarryovers(ctx context.Context, settledEpochIndex uint64) error {
+	iter, err := k.SettleCarryovers.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	carryovers, err := iter.Values()
+	if err != nil {
+		return err
+	}
+	for _, settleAmount := range carryovers {
+		if settleAmount.EpochIndex+SettleCarryoverEpochs >= settledEpochIndex {
+			continue
+		}
+		if err := k.transferUnclaimedSettleAmountToGovernance(ctx, settleAmount, "expired"); err != nil {
+			return err
+		}
+		k.RemoveSettleCarryover(ctx, settleAmount.Participant, settleAmount.EpochIndex)
+	}
+	return nil
+}
+
+// GetClaimableSettleAmounts returns every unclaimed settle amount of a participant, the current
+// one and those carried over from earlier epochs, ordered by epoch
+func (k Keeper) GetClaimableSettleAmounts(ctx context.Context, participant string) []types.SettleAmount {
+	addr, err := sdk.AccAddressFromBech32(participant)
+	if err != nil {
+		return nil
+	}
+	var claimable []types.SettleAmount
+	iter, err := k.SettleCarryovers.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err == nil {
+		if carryovers, err := iter.Values(); err == nil {
+			claimable = append(claimable, carryovers...)
+		}
+	}
+	if current, found := k.GetSettleAmount(ctx, participant); found {
+		claimable = append(claimable, current)
+	}
+	sort.Slice(claimable, func(i, j int) bool { return claimable[i].EpochIndex < claimable[j].EpochIndex })
+	return claimable
+}
+
+// getClaimableSettleAmount returns the settle amount a claim for epochIndex pays out,
+// either the participant's current settle amount or a carried over one
+func (k Keeper) getClaimableSettleAmount(ctx context.Context, participant string, epochIndex uint64) (types.SettleAmount, bool) {
+	if current, found := k.GetSettleAmount(ctx, participant); found && current.EpochIndex == epochIndex {
+		return current, true
+	}
+	return k.GetSettleCarryover(ctx, participant, epochIndex)
+}
+
+// setClaimableSettleAmount stores an updated claimable settle amount back where it came from
+func (k Keeper) setClaimableSettleAmount(ctx context.Context, settleAmount types.SettleAmount) error {
+	if _, carried := k.GetSettleCarryover(ctx, settleAmount.Participant, settleAmount.EpochIndex); carried {
+		addr, err := sdk.AccAddressFromBech32(settleAmount.Participant)
+		if err != nil {
+			return err
+		}
+		return k.SettleCarryovers.Set(ctx, collections.Join(addr, settleAmount.EpochIndex), settleAmount)
+	}
+	return k.SetSettleAmount(ctx, settleAmount)
+}
+
+// removeClaimableSettleAmount removes a settle amount once its claim is settled
+func (k Keeper) removeClaimableSettleAmount(ctx context.Context, settleAmount types.SettleAmount) {
+	if _, carried := k.GetSettleCarryover(ctx, settleAmount.Participant, settleAmount.EpochIndex); carried {
+		k.RemoveSettleCarryover(ctx, settleAmount.Participant, settleAmount.EpochIndex)
+		return
+	}
+	k.RemoveSettleAmount(ctx, settleAmount.Participant)
+}
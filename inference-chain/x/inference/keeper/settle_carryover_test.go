@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetSettleAmountWithCarryover(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	participant := testutil.Executor
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: participant, EpochIndex: 4, WorkCoins: 10, RewardCoins: 90, LastClaimAttempt: 7}))
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: participant, EpochIndex: 5, WorkCoins: 20, RewardCoins: 80}))
+
+	current, found := k.GetSettleAmount(ctx, participant)
+	require.True(t, found)
+	require.Equal(t, uint64(5), current.EpochIndex)
+
+	carried, found := k.GetSettleCarryover(ctx, participant, 4)
+	require.True(t, found)
+	require.Equal(t, int64(100), carried.GetTotalCoins())
+	require.Zero(t, carried.LastClaimAttempt)
+
+	claimable := k.GetClaimableSettleAmounts(ctx, participant)
+	require.Len(t, claimable, 2)
+	require.Equal(t, uint64(4), claimable[0].EpochIndex)
+	require.Equal(t, uint64(5), claimable[1].EpochIndex)
+
+	resp, err := k.ListClaimableSettleAmounts(ctx, &types.QueryClaimableSettleAmountsRequest{Participant: participant})
+	require.NoError(t, err)
+	require.Equal(t, claimable, resp.SettleAmounts)
+	_, err = k.ListClaimableSettleAmounts(ctx, &types.QueryClaimableSettleAmountsRequest{Participant: "invalid"})
+	require.Error(t, err)
+}
+
+func TestExpireSettleCarryovers(t *testing.T) {
+	k, ctx, mocks := keepertest.InferenceKeeperReturningMocks(t)
+	mocks.BankKeeper.EXPECT().LogSubAccountTransaction(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	participant := testutil.Executor
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: participant, EpochIndex: 4, RewardCoins: 100}))
+	require.NoError(t, k.CarryOverOldSettleAmounts(ctx, 5))
+	_, found := k.GetSettleAmount(ctx, participant)
+	require.False(t, found)
+
+	// Epoch 4 is still claimable while settling epoch 5
+	require.NoError(t, k.ExpireSettleCarryovers(ctx, 4+keeper.SettleCarryoverEpochs))
+	_, found = k.GetSettleCarryover(ctx, participant, 4)
+	require.True(t, found)
+
+	coins, err := types.GetCoins(100)
+	require.NoError(t, err)
+	mocks.BankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), types.ModuleName, "gov", coins, gomock.Any()).Return(nil)
+
+	require.NoError(t, k.ExpireSettleCarryovers(ctx, 5+keeper.SettleCarryoverEpochs))
+	_, found = k.GetSettleCarryover(ctx, participant, 4)
+	require.False(t, found)
+	require.Empty(t, k.GetClaimableSettleAmounts(ctx, participant))
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+func (k Keeper) SetEpochSubsidyTotal(ctx context.Context, epochIndex uint64, total uint64) error {
+	return k.EpochSubsidyTotals.Set(ctx, epochIndex, total)
+}
+
+func (k Keeper) GetEpochSubsidyTotal(ctx context.Context, epochIndex uint64) (uint64, bool) {
+	total, err := k.EpochSubsidyTotals.Get(ctx, epochIndex)
+	if err != nil {
+		return 0, false
+	}
+	return total, true
+}
+
+// PruneEpochSubsidyTotals removes totals for epochs before beforeEpochIndex that can no longer be claimed.
+// Totals of epochs with carried over settle amounts are kept, as claiming those still reports the subsidy share.
+func (k Keeper) PruneEpochSubsidyTotals(ctx context.Context, beforeEpochIndex uint64) error {
+	if oldest, found := k.oldestSettleCarryoverEpoch(ctx); found && oldest < beforeEpochIndex {
+		beforeEpochIndex = oldest
+	}
+	rng := new(collections.Range[uint64]).EndExclusive(beforeEpochIndex)
+	return k.EpochSubsidyTotals.Clear(ctx, rng)
+}
+
+// oldestSettleCarryoverEpoch returns the earliest epoch with a carried over settle amount
+func (k Keeper) oldestSettleCarryoverEpoch(ctx context.Context) (uint64, bool) {
+	iter, err := k.SettleCarryovers.Iterate(ctx, nil)
+	if err != nil {
+		return 0, false
+	}
+	defer iter.Close()
+	var oldest uint64
+	found := false
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			continue
+		}
+		if epoch := key.K2(); !found || epoch < oldest {
+			oldest = epoch
+			found = true
+		}
+	}
+	return oldest, found
+}
+
+// EmitSettlementClaimedEvent emits the per-participant payout of a claim, so accounting systems
+// can ingest payouts directly instead of diffing balances across blocks.
+// workCoins and rewardCoins are the amounts actually paid out.
+func (k Keeper) EmitSettlementClaimedEvent(ctx context.Context, settleAmount *types.SettleAmount, workCoins uint64, rewardCoins uint64, status string) {
+	subsidyShare := decimal.Zero
+	epochSubsidy, found := k.GetEpochSubsidyTotal(ctx, settleAmount.EpochIndex)
+	if found && epochSubsidy > 0 {
+		subsidyShare = decimal.NewFromUint64(rewardCoins).Div(decimal.NewFromUint64(epochSubsidy))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettlementClaimed,
+			sdk.NewAttribute(types.AttributeKeyParticipant, settleAmount.Participant),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(settleAmount.EpochIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyWorkCoins, strconv.FormatUint(workCoins, 10)),
+			sdk.NewAttribute(types.AttributeKeyRewardCoins, strconv.FormatUint(rewardCoins, 10)),
+			sdk.NewAttribute(types.AttributeKeySubsidyShare, subsidyShare.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochSubsidy, strconv.FormatUint(epochSubsidy, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, status),
+		))
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPruneEpochSubsidyTotals(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		require.NoError(t, k.SetEpochSubsidyTotal(ctx, epoch, epoch*100))
+	}
+
+	require.NoError(t, k.PruneEpochSubsidyTotals(ctx, 3))
+
+	_, found := k.GetEpochSubsidyTotal(ctx, 1)
+	require.False(t, found)
+	_, found = k.GetEpochSubsidyTotal(ctx, 2)
+	require.False(t, found)
+	total, found := k.GetEpochSubsidyTotal(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, uint64(300), total)
+	_, found = k.GetEpochSubsidyTotal(ctx, 4)
+	require.True(t, found)
+}
+
+func TestPruneEpochSubsidyTotalsKeepsCarriedOverEpochs(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	for epoch := uint64(1); epoch <= 4; epoch++ {
+		require.NoError(t, k.SetEpochSubsidyTotal(ctx, epoch, epoch*100))
+	}
+	// An unclaimed amount of epoch 2 is carried over when epoch 3 is settled
+	participant := sample.AccAddress()
+	require.NoError(t, k.SetSettleAmount(ctx, types.SettleAmount{Participant: participant, EpochIndex: 2, RewardCoins: 50}))
+	require.NoError(t, k.SetSettleAmountWithCarryover(ctx, types.SettleAmount{Participant: participant, EpochIndex: 3, RewardCoins: 60}))
+
+	require.NoError(t, k.PruneEpochSubsidyTotals(ctx, 4))
+
+	_, found := k.GetEpochSubsidyTotal(ctx, 1)
+	require.False(t, found)
+	total, found := k.GetEpochSubsidyTotal(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, uint64(200), total)
+
+	// Claiming the carried over amount still reports its share of the epoch subsidy
+	carried, found := k.GetSettleCarryover(ctx, participant, 2)
+	require.True(t, found)
+	k.EmitSettlementClaimedEvent(ctx, &carried, 0, 50, "claimed")
+	requireEvent(t, ctx, types.EventTypeSettlementClaimed, types.AttributeKeySubsidyShare, "0.25")
+
+	k.RemoveSettleCarryover(ctx, participant, 2)
+	require.NoError(t, k.PruneEpochSubsidyTotals(ctx, 4))
+	_, found = k.GetEpochSubsidyTotal(ctx, 2)
+	require.False(t, found)
+}
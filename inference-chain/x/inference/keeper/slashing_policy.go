@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// SetSlashingPolicy selects the slashing policy applied from the next epoch group formation on.
+// Governance selects it with MsgUpdatePolicy (types.PolicySlashing).
+func (k Keeper) SetSlashingPolicy(ctx context.Context, policy types.SlashingPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.SlashingPolicy.Set(ctx, bz)
+}
+
+// GetSlashingPolicy returns the active slashing policy,
+// or types.DefaultSlashingPolicy if none was selected.
+func (k Keeper) GetSlashingPolicy(ctx context.Context) types.SlashingPolicy {
+	bz, err := k.SlashingPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultSlashingPolicy()
+	}
+	var policy types.SlashingPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode slashing policy, using default", types.Tokenomics, "error", err)
+		return types.DefaultSlashingPolicy()
+	}
+	return policy
+}
+
+func (k Keeper) GetJailRecord(ctx context.Context, address sdk.AccAddress) (types.JailRecord, bool) {
+	bz, err := k.JailRecords.Get(ctx, address)
+	if err != nil {
+		return types.JailRecord{}, false
+	}
+	var record types.JailRecord
+	if err := json.Unmarshal(bz, &record); err != nil {
+		k.LogError("Failed to decode jail record", types.Tokenomics, "address", address.String(), "error", err)
+		return types.JailRecord{}, false
+	}
+	return record, true
+}
+
+func (k Keeper) setJailRecord(ctx context.Context, address sdk.AccAddress, record types.JailRecord) error {
+	bz, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return k.JailRecords.Set(ctx, address, bz)
+}
+
+// IsJailed reports whether the participant is left out of the epoch group of the given epoch
+func (k Keeper) IsJailed(ctx context.Context, address sdk.AccAddress, epochIndex uint64) bool {
+	record, found := k.GetJailRecord(ctx, address)
+	return found && epochIndex < record.ReleaseEpoch
+}
+
+// CountOffenses counts the epochs in [fromEpoch, toEpoch) the participant was excluded in
+func (k Keeper) CountOffenses(ctx context.Context, address sdk.AccAddress, fromEpoch uint64, toEpoch uint64) uint64 {
+	var offenses uint64
+	for epochIndex := fromEpoch; epochIndex < toEpoch; epochIndex++ {
+		excluded, err := k.ExcludedParticipantsMap.Has(ctx, collections.Join(epochIndex, address))
+		if err == nil && excluded {
+			offenses++
+		}
+	}
+	return offenses
+}
+
+// ApplySlashingPolicy applies the slashing policy to the participants of the epoch being formed.
+// Participants with offenses in the evidence window lose a share of their weight, participants
+// reaching the jail threshold are jailed, and jailed participants are left out of the result.
+// A jail term ends by itself at its release epoch; offenses from before the release do not count again.
+func (k Keeper) ApplySlashingPolicy(ctx context.Context, epochIndex uint64, participants []*types.ActiveParticipant) []*types.ActiveParticipant {
+	policy := k.GetSlashingPolicy(ctx)
+	if policy.EvidenceWindowEpochs == 0 {
+		return participants
+	}
+
+	windowStart := uint64(0)
+	if epochIndex > policy.EvidenceWindowEpochs {
+		windowStart = epochIndex - policy.EvidenceWindowEpochs
+	}
+
+	result := make([]*types.ActiveParticipant, 0, len(participants))
+	for _, participant := range participants {
+		address, err := sdk.AccAddressFromBech32(participant.Index)
+		if err != nil {
+			k.LogError("Could not parse participant address, skipping slashing policy", types.Tokenomics, "address", participant.Index, "error", err)
+			result = append(result, participant)
+			continue
+		}
+
+		from := windowStart
+		record, jailedBefore := k.GetJailRecord(ctx, address)
+		if jailedBefore {
+			if epochIndex < record.ReleaseEpoch {
+				k.LogInfo("Participant is jailed, leaving it out of the epoch group", types.Tokenomics,
+					"participant", participant.Index, "epochIndex", epochIndex, "releaseEpoch", record.ReleaseEpoch)
+				continue
+			}
+			if epochIndex == record.ReleaseEpoch {
+				k.emitParticipantUnjailedEvent(ctx, participant.Index, epochIndex)
+			}
+			from = max(from, record.ReleaseEpoch)
+		}
+
+		offenses := k.CountOffenses(ctx, address, from, epochIndex)
+		if offenses == 0 {
+			result = append(result, participant)
+			continue
+		}
+
+		if policy.JailThreshold > 0 && offenses >= policy.JailThreshold {
+			jail := types.JailRecord{
+				Address:       participant.Index,
+				JailedAtEpoch: epochIndex,
+				ReleaseEpoch:  epochIndex + policy.JailEpochs,
+				Offenses:      offenses,
+			}
+			if err := k.setJailRecord(ctx, address, jail); err != nil {
+				k.LogError("Failed to store jail record", types.Tokenomics, "participant", participant.Index, "error", err)
+				result = append(result, participant)
+				continue
+			}
+			k.LogWarn("Participant jailed for repeated offenses", types.Tokenomics,
+				"participant", participant.Index, "offenses", offenses, "epochIndex", epochIndex, "releaseEpoch", jail.ReleaseEpoch)
+			k.emitParticipantJailedEvent(ctx, jail)
+			continue
+		}
+
+		fraction := policy.WeightSlashFraction(offenses)
+		originalWeight := participant.Weight
+		participant.Weight = decimal.NewFromInt(originalWeight).Mul(decimal.NewFromInt(1).Sub(fraction)).IntPart()
+		k.LogInfo("Slashed participant weight for offenses in evidence window", types.Tokenomics,
+			"participant", participant.Index, "offenses", offenses, "slashFraction", fraction.String(),
+			"originalWeight", originalWeight, "weight", participant.Weight)
+		k.emitParticipantWeightSlashedEvent(ctx, participant.Index, epochIndex, offenses, fraction, originalWeight, participant.Weight)
+		result = append(result, participant)
+	}
+	return result
+}
+
+func (k Keeper) emitParticipantWeightSlashedEvent(ctx context.Context, participant string, epochIndex uint64, offenses uint64, fraction decimal.Decimal, originalWeight int64, weight int64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantWeightSlashed,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+			sdk.NewAttribute(types.AttributeKeyOffenses, strconv.FormatUint(offenses, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, fraction.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalWeight, strconv.FormatInt(originalWeight, 10)),
+			sdk.NewAttribute(types.AttributeKeySlashedWeight, strconv.FormatInt(weight, 10)),
+		))
+}
+
+func (k Keeper) emitParticipantJailedEvent(ctx context.Context, record types.JailRecord) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantJailed,
+			sdk.NewAttribute(types.AttributeKeyParticipant, record.Address),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(record.JailedAtEpoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyOffenses, strconv.FormatUint(record.Offenses, 10)),
+			sdk.NewAttribute(types.AttributeKeyReleaseEpoch, strconv.FormatUint(record.ReleaseEpoch, 10)),
+		))
+}
+
+func (k Keeper) emitParticipantUnjailedEvent(ctx context.Context, participant string, epochIndex uint64) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeParticipantUnjailed,
+			sdk.NewAttribute(types.AttributeKeyParticipant, participant),
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+		))
+}
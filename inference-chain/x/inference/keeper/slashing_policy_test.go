@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashingPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultSlashingPolicy(), k.GetSlashingPolicy(ctx))
+
+	policy := types.SlashingPolicy{EvidenceWindowEpochs: 5, WeightSlashPerOffense: "0.25", JailThreshold: 2, JailEpochs: 3}
+	require.NoError(t, k.SetSlashingPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetSlashingPolicy(ctx))
+
+	require.Error(t, k.SetSlashingPolicy(ctx, types.SlashingPolicy{EvidenceWindowEpochs: 5, WeightSlashPerOffense: "1.5"}))
+	require.Error(t, k.SetSlashingPolicy(ctx, types.SlashingPolicy{EvidenceWindowEpochs: 5, WeightSlashPerOffense: "0.1", JailThreshold: 6, JailEpochs: 1}))
+	require.Equal(t, policy, k.GetSlashingPolicy(ctx))
+}
+
+func TestApplySlashingPolicy_DisabledByDefault(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	// Offenses recorded before governance selects a policy neither slash nor jail
+	offender := sample.AccAddress()
+	for epochIndex := uint64(5); epochIndex < 10; epochIndex++ {
+		require.NoError(t, k.ExcludedParticipantsMap.Set(ctx, collections.Join(epochIndex, sdk.MustAccAddressFromBech32(offender)), types.ExcludedParticipant{
+			Address:    offender,
+			EpochIndex: epochIndex,
+			Reason:     "downtime",
+		}))
+	}
+	participants := []*types.ActiveParticipant{{Index: offender, Weight: 1000}}
+	require.Equal(t, participants, k.ApplySlashingPolicy(ctx, 10, participants))
+	require.False(t, k.IsJailed(ctx, sdk.MustAccAddressFromBech32(offender), 10))
+}
+
+func TestApplySlashingPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetSlashingPolicy(ctx, types.SlashingPolicy{
+		EvidenceWindowEpochs:  5,
+		WeightSlashPerOffense: "0.25",
+		JailThreshold:         2,
+		JailEpochs:            3,
+	}))
+
+	clean := sample.AccAddress()
+	offender := sample.AccAddress()
+	repeatOffender := sample.AccAddress()
+	exclude := func(epochIndex uint64, address string) {
+		addr := sdk.MustAccAddressFromBech32(address)
+		require.NoError(t, k.ExcludedParticipantsMap.Set(ctx, collections.Join(epochIndex, addr), types.ExcludedParticipant{
+			Address:    address,
+			EpochIndex: epochIndex,
+			Reason:     "downtime",
+		}))
+	}
+	participants := func() []*types.ActiveParticipant {
+		return []*types.ActiveParticipant{
+			{Index: clean, Weight: 1000},
+			{Index: offender, Weight: 1000},
+			{Index: repeatOffender, Weight: 1000},
+		}
+	}
+
+	exclude(2, offender)
+	exclude(8, offender) // outside the window of epoch 10 until it moves
+	exclude(7, repeatOffender)
+	exclude(9, repeatOffender)
+
+	// Epoch 10 counts offenses of epochs 5-9
+	result := k.ApplySlashingPolicy(ctx, 10, participants())
+	require.Len(t, result, 2)
+	require.Equal(t, clean, result[0].Index)
+	require.Equal(t, int64(1000), result[0].Weight)
+	require.Equal(t, offender, result[1].Index)
+	require.Equal(t, int64(750), result[1].Weight)
+
+	record, found := k.GetJailRecord(ctx, sdk.MustAccAddressFromBech32(repeatOffender))
+	require.True(t, found)
+	require.Equal(t, uint64(13), record.ReleaseEpoch)
+	require.Equal(t, uint64(2), record.Offenses)
+
+	// Still jailed
+	require.Len(t, k.ApplySlashingPolicy(ctx, 12, participants()), 2)
+
+	// Released, and offenses from before the release are not counted again
+	result = k.ApplySlashingPolicy(ctx, 13, participants())
+	require.Len(t, result, 3)
+	require.Equal(t, int64(1000), result[2].Weight)
+	events := sdk.UnwrapSDKContext(ctx).EventManager().Events()
+	require.Equal(t, types.EventTypeParticipantUnjailed, events[len(events)-1].Type)
+
+	// Disabled policy leaves participants untouched
+	require.NoError(t, k.SetSlashingPolicy(ctx, types.SlashingPolicy{WeightSlashPerOffense: "0"}))
+	result = k.ApplySlashingPolicy(ctx, 10, participants())
+	require.Len(t, result, 3)
+	require.Equal(t, int64(1000), result[1].Weight)
+}
@@ -80,6 +80,7 @@ func setupRealStreamVestingKeepers(t testing.TB) (sdk.Context, keeper.Keeper, st
 		runtime.NewKVStoreService(blsStoreKey),
 		keepertest.PrintlnLogger{},
 		authority.String(),
+		nil,
 	)
 
 	upgradeMock := keepertest.NewMockUpgradeKeeper(ctrl)
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetTimeslotSchedule selects the timeslots ML nodes are allocated for from the next model assignment on.
+// Governance sets it with MsgUpdatePolicy (types.PolicyTimeslotSchedule).
+func (k Keeper) SetTimeslotSchedule(ctx context.Context, schedule types.TimeslotSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(schedule)
+	if err != nil {
+		return err
+	}
+	return k.TimeslotSchedule.Set(ctx, bz)
+}
+
+// GetTimeslotSchedule returns the active timeslot schedule,
+// or types.DefaultTimeslotSchedule if none was selected.
+func (k Keeper) GetTimeslotSchedule(ctx context.Context) types.TimeslotSchedule {
+	bz, err := k.TimeslotSchedule.Get(ctx)
+	if err != nil {
+		return types.DefaultTimeslotSchedule()
+	}
+	var schedule types.TimeslotSchedule
+	if err := json.Unmarshal(bz, &schedule); err != nil {
+		k.LogError("Failed to decode timeslot schedule, using default", types.Allocation, "error", err)
+		return types.DefaultTimeslotSchedule()
+	}
+	return schedule
+}
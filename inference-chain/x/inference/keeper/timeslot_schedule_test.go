@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeslotSchedule(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultTimeslotSchedule(), k.GetTimeslotSchedule(ctx))
+
+	schedule := types.DefaultTimeslotSchedule()
+	schedule.Timeslots = append(schedule.Timeslots, types.TimeslotSpec{Name: "TRAINING_SLOT", AllocationFraction: "0.25"})
+	require.NoError(t, k.SetTimeslotSchedule(ctx, schedule))
+	require.Equal(t, schedule, k.GetTimeslotSchedule(ctx))
+	require.Equal(t, []bool{true, false, false}, k.GetTimeslotSchedule(ctx).InitialAllocation())
+
+	invalid := types.DefaultTimeslotSchedule()
+	invalid.Timeslots = append(invalid.Timeslots, types.TimeslotSpec{Name: "COOLDOWN_SLOT", AllocationFraction: "1.5"})
+	require.Error(t, k.SetTimeslotSchedule(ctx, invalid))
+	require.Error(t, k.SetTimeslotSchedule(ctx, types.TimeslotSchedule{Timeslots: invalid.Timeslots[1:]}))
+	require.Equal(t, schedule, k.GetTimeslotSchedule(ctx))
+}
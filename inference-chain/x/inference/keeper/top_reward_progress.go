@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// GetTopRewardProgress returns a miner's progress through the top-reward program
+func (k Keeper) GetTopRewardProgress(ctx context.Context, miner string) (types.TopRewardProgress, bool) {
+	addr, err := sdk.AccAddressFromBech32(miner)
+	if err != nil {
+		return types.TopRewardProgress{}, false
+	}
+	bz, err := k.TopRewardProgress.Get(ctx, addr)
+	if err != nil {
+		return types.TopRewardProgress{}, false
+	}
+	var progress types.TopRewardProgress
+	if err := json.Unmarshal(bz, &progress); err != nil {
+		k.LogError("Failed to decode top reward progress", types.Tokenomics, "miner", miner, "error", err)
+		return types.TopRewardProgress{}, false
+	}
+	return progress, true
+}
+
+func (k Keeper) setTopRewardProgress(ctx context.Context, progress types.TopRewardProgress) error {
+	addr, err := sdk.AccAddressFromBech32(progress.Miner)
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return k.TopRewardProgress.Set(ctx, addr, bz)
+}
+
+func (k Keeper) getTopRewardProgramState(ctx context.Context) types.TopRewardProgramState {
+	var state types.TopRewardProgramState
+	bz, err := k.TopRewardProgramState.Get(ctx)
+	if err != nil {
+		return state
+	}
+	if err := json.Unmarshal(bz, &state); err != nil {
+		k.LogError("Failed to decode top reward program state", types.Tokenomics, "error", err)
+	}
+	return state
+}
+
+// RecordTopRewardDisbursement adds a payout made to a top miner to its disbursement history
+func (k Keeper) RecordTopRewardDisbursement(ctx context.Context, miner types.TopMiner, amount int64, time int64) error {
+	progress, found := k.GetTopRewardProgress(ctx, miner.Address)
+	if !found {
+		progress = types.TopRewardProgress{Miner: miner.Address}
+	}
+	progress.Disbursements = append(progress.Disbursements, types.TopRewardDisbursement{
+		Height:       sdk.UnwrapSDKContext(ctx).BlockHeight(),
+		Time:         time,
+		Amount:       amount,
+		PayoutNumber: miner.RewardsPaidCount,
+	})
+	progress.PaidAmount += amount
+	return k.setTopRewardProgress(ctx, progress)
+}
+
+// UpdateTopRewardProgress refreshes the progress of all top miners after payouts were evaluated at time
+func (k Keeper) UpdateTopRewardProgress(ctx context.Context, settings PayoutSettings, time int64) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	previous := k.getTopRewardProgramState(ctx)
+	miners := k.GetAllTopMiner(ctx)
+	var totalPaid int32
+	for _, miner := range miners {
+		totalPaid += miner.RewardsPaidCount
+	}
+	for _, miner := range miners {
+		progress, found := k.GetTopRewardProgress(ctx, miner.Address)
+		if !found {
+			progress = types.TopRewardProgress{Miner: miner.Address}
+		}
+		updateTopRewardProgress(&progress, miner, settings, totalPaid, previous, height, time)
+		if err := k.setTopRewardProgress(ctx, progress); err != nil {
+			return err
+		}
+	}
+
+	bz, err := json.Marshal(types.TopRewardProgramState{LastEvaluationHeight: height, LastEvaluationTime: time})
+	if err != nil {
+		return err
+	}
+	return k.TopRewardProgramState.Set(ctx, bz)
+}
+
+// updateTopRewardProgress mirrors the payout rules of GetTopMinerAction: a miner is paid at the first
+// evaluation more than PayoutPeriod after its qualification (re)started, while payouts remain.
+func updateTopRewardProgress(progress *types.TopRewardProgress, miner types.TopMiner, settings PayoutSettings, totalPaid int32, previous types.TopRewardProgramState, height int64, time int64) {
+	progress.PayoutsReceived = miner.RewardsPaidCount
+	progress.UpdatedHeight = height
+	progress.RemainingPayouts = 0
+	progress.NextPayoutTime = 0
+	progress.NextPayoutHeight = 0
+
+	disqualified := miner.FirstQualifiedStarted == 0
+	programEnded := miner.LastQualifiedStarted > settings.FirstQualifiedTime+settings.MaximumTime
+	if !disqualified && !programEnded {
+		progress.RemainingPayouts = max(0, min(settings.MaxPayoutsPerMiner-miner.RewardsPaidCount, settings.MaxPayoutsTotal-totalPaid))
+	}
+	progress.RemainingAmount = int64(progress.RemainingPayouts) * settings.GetPayoutAmount()
+	if progress.RemainingPayouts == 0 {
+		return
+	}
+
+	progress.NextPayoutTime = miner.LastQualifiedStarted + settings.PayoutPeriod
+	secondsPerEvaluation := time - previous.LastEvaluationTime
+	blocksPerEvaluation := height - previous.LastEvaluationHeight
+	if previous.LastEvaluationHeight == 0 || secondsPerEvaluation <= 0 || blocksPerEvaluation <= 0 {
+		return
+	}
+	evaluations := int64(1)
+	if progress.NextPayoutTime >= time {
+		evaluations += (progress.NextPayoutTime - time) / secondsPerEvaluation
+	}
+	progress.NextPayoutHeight = height + evaluations*blocksPerEvaluation
+}
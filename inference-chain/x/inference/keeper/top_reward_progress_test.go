@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/testutil"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestTopRewardProgress(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	settings := keeper.PayoutSettings{
+		PayoutPeriod:       1000,
+		TotalRewards:       12000,
+		TopNumberOfMiners:  3,
+		MaxPayoutsTotal:    12,
+		MaxPayoutsPerMiner: 4,
+		AllowedFailureRate: *types.DecimalFromFloat(0.1),
+		MaximumTime:        10000,
+		FirstQualifiedTime: 100,
+	}
+	miner := types.TopMiner{Address: testutil.Executor, FirstQualifiedStarted: 100, LastQualifiedStarted: 100, LastUpdatedTime: 100}
+	require.NoError(t, k.SetTopMiner(ctx, miner))
+
+	// First evaluation: no pace to estimate the payout height from yet
+	ctx = ctx.WithBlockHeight(50)
+	require.NoError(t, k.UpdateTopRewardProgress(ctx, settings, 100))
+	progress, found := k.GetTopRewardProgress(ctx, testutil.Executor)
+	require.True(t, found)
+	require.Equal(t, int32(4), progress.RemainingPayouts)
+	require.Equal(t, int64(4000), progress.RemainingAmount)
+	require.Equal(t, int64(1100), progress.NextPayoutTime)
+	require.Zero(t, progress.NextPayoutHeight)
+
+	// 300 seconds and 100 blocks per evaluation: the payout is due after 1100, at the third evaluation from 400
+	ctx = ctx.WithBlockHeight(150)
+	require.NoError(t, k.UpdateTopRewardProgress(ctx, settings, 400))
+	progress, _ = k.GetTopRewardProgress(ctx, testutil.Executor)
+	require.Equal(t, int64(450), progress.NextPayoutHeight)
+
+	miner.RewardsPaidCount = 1
+	miner.LastQualifiedStarted = 1300
+	require.NoError(t, k.SetTopMiner(ctx, miner))
+	ctx = ctx.WithBlockHeight(550)
+	require.NoError(t, k.RecordTopRewardDisbursement(ctx, miner, 1000, 1300))
+	require.NoError(t, k.UpdateTopRewardProgress(ctx, settings, 1300))
+	progress, _ = k.GetTopRewardProgress(ctx, testutil.Executor)
+	require.Equal(t, int32(1), progress.PayoutsReceived)
+	require.Equal(t, int64(1000), progress.PaidAmount)
+	require.Equal(t, int32(3), progress.RemainingPayouts)
+	require.Equal(t, int64(2300), progress.NextPayoutTime)
+	require.Equal(t, []types.TopRewardDisbursement{{Height: 550, Time: 1300, Amount: 1000, PayoutNumber: 1}}, progress.Disbursements)
+
+	// A disqualified miner keeps its history but has nothing left to receive
+	miner.FirstQualifiedStarted = 0
+	require.NoError(t, k.SetTopMiner(ctx, miner))
+	require.NoError(t, k.UpdateTopRewardProgress(ctx.WithBlockHeight(650), settings, 1600))
+	progress, _ = k.GetTopRewardProgress(ctx, testutil.Executor)
+	require.Zero(t, progress.RemainingPayouts)
+	require.Zero(t, progress.NextPayoutTime)
+	require.Len(t, progress.Disbursements, 1)
+}
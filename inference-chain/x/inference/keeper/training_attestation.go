@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/calculations"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/shopspring/decimal"
+)
+
+// SetTrainingAttestationPolicy selects how finished training tasks are verified.
+// Governance selects it with MsgUpdatePolicy.
+func (k Keeper) SetTrainingAttestationPolicy(ctx context.Context, policy types.TrainingAttestationPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.TrainingAttestationPolicy.Set(ctx, bz)
+}
+
+// GetTrainingAttestationPolicy returns the active training attestation policy,
+// or types.DefaultTrainingAttestationPolicy if none was selected.
+func (k Keeper) GetTrainingAttestationPolicy(ctx context.Context) types.TrainingAttestationPolicy {
+	bz, err := k.TrainingAttestationPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultTrainingAttestationPolicy()
+	}
+	var policy types.TrainingAttestationPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode training attestation policy, using default", types.Training, "error", err)
+		return types.DefaultTrainingAttestationPolicy()
+	}
+	return policy
+}
+
+func (k Keeper) GetTrainingVerification(ctx context.Context, taskId uint64) (types.TrainingVerification, bool) {
+	bz, err := k.TrainingVerifications.Get(ctx, taskId)
+	if err != nil {
+		return types.TrainingVerification{}, false
+	}
+	var verification types.TrainingVerification
+	if err := json.Unmarshal(bz, &verification); err != nil {
+		k.LogError("Failed to decode training verification", types.Training, "taskId", taskId, "error", err)
+		return types.TrainingVerification{}, false
+	}
+	return verification, true
+}
+
+func (k Keeper) setTrainingVerification(ctx context.Context, verification types.TrainingVerification) error {
+	bz, err := json.Marshal(verification)
+	if err != nil {
+		return err
+	}
+	return k.TrainingVerifications.Set(ctx, verification.TaskId, bz)
+}
+
+// StartTrainingVerification samples the attesters of a finished training task. Attesters are drawn
+// by weight from the current epoch group, leaving out the participants that trained the task.
+// With verification disabled by the policy, the task is accepted right away.
+func (k Keeper) StartTrainingVerification(ctx context.Context, taskId uint64, checkpointUri string, claimedScore string) (types.TrainingVerification, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	task, found := k.GetTrainingTask(sdkCtx, taskId)
+	if !found {
+		return types.TrainingVerification{}, types.ErrTrainingTaskNotFound
+	}
+	if _, found := k.GetTrainingVerification(ctx, taskId); found {
+		return types.TrainingVerification{}, types.ErrTrainingVerificationExists
+	}
+	if _, err := decimal.NewFromString(claimedScore); err != nil {
+		return types.TrainingVerification{}, fmt.Errorf("invalid claimed score %q: %w", claimedScore, err)
+	}
+
+	policy := k.GetTrainingAttestationPolicy(ctx)
+	verification := types.TrainingVerification{
+		TaskId:          taskId,
+		CheckpointUri:   checkpointUri,
+		ClaimedScore:    claimedScore,
+		EvalSamples:     policy.EvalSamples,
+		Seed:            hex.EncodeToString(sdkCtx.HeaderHash()) + "|" + strconv.FormatUint(taskId, 10),
+		StartedAtHeight: sdkCtx.BlockHeight(),
+		Attesters:       []types.TrainingAttester{},
+		Attestations:    []types.TrainingAttestation{},
+		Status:          types.TrainingVerificationPending,
+		Datasets:        k.trainingTaskDatasets(ctx, taskId),
+	}
+
+	if policy.Attesters == 0 {
+		verification.Status = types.TrainingVerificationAccepted
+		verification.ResolvedAtHeight = sdkCtx.BlockHeight()
+		if err := k.setTrainingVerification(ctx, verification); err != nil {
+			return types.TrainingVerification{}, err
+		}
+		k.emitTrainingVerificationResolvedEvent(ctx, verification)
+		return verification, nil
+	}
+
+	candidates, err := k.trainingAttesterCandidates(ctx, task)
+	if err != nil {
+		return types.TrainingVerification{}, err
+	}
+	for _, entry := range calculations.SampleAttesters(verification.Seed, candidates, int(policy.Attesters)) {
+		verification.Attesters = append(verification.Attesters, types.TrainingAttester{Address: entry.Address, Weight: entry.Weight})
+	}
+	if len(verification.Attesters) == 0 {
+		return types.TrainingVerification{}, fmt.Errorf("no participants available to attest training task %d", taskId)
+	}
+
+	if err := k.setTrainingVerification(ctx, verification); err != nil {
+		return types.TrainingVerification{}, err
+	}
+	k.LogInfo("Started training verification", types.Training, "taskId", taskId, "attesters", len(verification.Attesters))
+	k.emitTrainingVerificationStartedEvent(ctx, verification)
+	return verification, nil
+}
+
+func (k Keeper) trainingAttesterCandidates(ctx context.Context, task *types.TrainingTask) (map[string]int64, error) {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return nil, types.ErrEffectiveEpochNotFound
+	}
+	epochGroupData, found := k.GetEpochGroupData(ctx, epochIndex, "")
+	if !found {
+		return nil, types.ErrEpochGroupDataNotFound
+	}
+
+	trainers := make(map[string]struct{}, len(task.Assignees))
+	for _, assignee := range task.Assignees {
+		trainers[assignee.Participant] = struct{}{}
+	}
+	candidates := make(map[string]int64, len(epochGroupData.ValidationWeights))
+	for _, vw := range epochGroupData.ValidationWeights {
+		if _, trained := trainers[vw.MemberAddress]; trained {
+			continue
+		}
+		candidates[vw.MemberAddress] = vw.Weight
+	}
+	return candidates, nil
+}
+
+// SubmitTrainingAttestation records the evaluation score a sampled attester measured on the task's
+// checkpoint. The attestation passes when the score is within the policy's deviation of the claimed
+// score, and the verification resolves once either side holds the majority of the sampled weight.
+func (k Keeper) SubmitTrainingAttestation(ctx context.Context, taskId uint64, attester string, score string) (types.TrainingVerification, error) {
+	verification, found := k.GetTrainingVerification(ctx, taskId)
+	if !found {
+		return types.TrainingVerification{}, types.ErrTrainingVerificationNotFound
+	}
+	if verification.Status != types.TrainingVerificationPending {
+		return types.TrainingVerification{}, types.ErrTrainingVerificationResolved
+	}
+	if _, sampled := verification.Attester(attester); !sampled {
+		return types.TrainingVerification{}, types.ErrNotTrainingAttester
+	}
+	if verification.HasAttested(attester) {
+		return types.TrainingVerification{}, types.ErrDuplicateTrainingAttestation
+	}
+	measured, err := decimal.NewFromString(score)
+	if err != nil {
+		return types.TrainingVerification{}, fmt.Errorf("invalid score %q: %w", score, err)
+	}
+	claimed, err := decimal.NewFromString(verification.ClaimedScore)
+	if err != nil {
+		return types.TrainingVerification{}, fmt.Errorf("invalid claimed score %q: %w", verification.ClaimedScore, err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attestation := types.TrainingAttestation{
+		Attester: attester,
+		Passed:   k.GetTrainingAttestationPolicy(ctx).ScoreMatches(claimed, measured),
+		Score:    score,
+		Height:   sdkCtx.BlockHeight(),
+	}
+	verification.Attestations = append(verification.Attestations, attestation)
+	k.emitTrainingAttestationSubmittedEvent(ctx, taskId, attestation)
+
+	var sampledWeight, passedWeight, failedWeight int64
+	for _, a := range verification.Attesters {
+		sampledWeight += a.Weight
+	}
+	for _, a := range verification.Attestations {
+		weighted, _ := verification.Attester(a.Attester)
+		if a.Passed {
+			passedWeight += weighted.Weight
+		} else {
+			failedWeight += weighted.Weight
+		}
+	}
+
+	switch calculations.ResolveAttestations(sampledWeight, passedWeight, failedWeight) {
+	case calculations.AttestationAccepted:
+		verification.Status = types.TrainingVerificationAccepted
+	case calculations.AttestationRejected:
+		verification.Status = types.TrainingVerificationRejected
+	}
+	if verification.Status != types.TrainingVerificationPending {
+		verification.ResolvedAtHeight = sdkCtx.BlockHeight()
+		k.LogInfo("Resolved training verification", types.Training, "taskId", taskId, "status", verification.Status,
+			"passedWeight", passedWeight, "failedWeight", failedWeight, "sampledWeight", sampledWeight)
+	}
+
+	if err := k.setTrainingVerification(ctx, verification); err != nil {
+		return types.TrainingVerification{}, err
+	}
+	if verification.Status != types.TrainingVerificationPending {
+		k.emitTrainingVerificationResolvedEvent(ctx, verification)
+	}
+	return verification, nil
+}
+
+func (k Keeper) emitTrainingVerificationStartedEvent(ctx context.Context, verification types.TrainingVerification) {
+	attesters := make([]string, 0, len(verification.Attesters))
+	for _, attester := range verification.Attesters {
+		attesters = append(attesters, attester.Address)
+	}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTrainingVerificationStarted,
+			sdk.NewAttribute(types.AttributeKeyTaskId, strconv.FormatUint(verification.TaskId, 10)),
+			sdk.NewAttribute(types.AttributeKeyCheckpointUri, verification.CheckpointUri),
+			sdk.NewAttribute(types.AttributeKeyAttesters, strings.Join(attesters, ",")),
+		))
+}
+
+func (k Keeper) emitTrainingAttestationSubmittedEvent(ctx context.Context, taskId uint64, attestation types.TrainingAttestation) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTrainingAttestationSubmitted,
+			sdk.NewAttribute(types.AttributeKeyTaskId, strconv.FormatUint(taskId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAttester, attestation.Attester),
+			sdk.NewAttribute(types.AttributeKeyPassed, strconv.FormatBool(attestation.Passed)),
+			sdk.NewAttribute(types.AttributeKeyScore, attestation.Score),
+		))
+}
+
+func (k Keeper) emitTrainingVerificationResolvedEvent(ctx context.Context, verification types.TrainingVerification) {
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTrainingVerificationResolved,
+			sdk.NewAttribute(types.AttributeKeyTaskId, strconv.FormatUint(verification.TaskId, 10)),
+			sdk.NewAttribute(types.AttributeKeyStatus, verification.Status),
+		))
+}
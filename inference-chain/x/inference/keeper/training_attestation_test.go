@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrainingAttestationPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultTrainingAttestationPolicy(), k.GetTrainingAttestationPolicy(ctx))
+
+	policy := types.TrainingAttestationPolicy{Attesters: 3, EvalSamples: 16, MaxScoreDeviation: "0.1"}
+	require.NoError(t, k.SetTrainingAttestationPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetTrainingAttestationPolicy(ctx))
+
+	require.Error(t, k.SetTrainingAttestationPolicy(ctx, types.TrainingAttestationPolicy{Attesters: 3, MaxScoreDeviation: "0.1"}))
+	require.Error(t, k.SetTrainingAttestationPolicy(ctx, types.TrainingAttestationPolicy{Attesters: 3, EvalSamples: 16, MaxScoreDeviation: "-1"}))
+	require.Equal(t, policy, k.GetTrainingAttestationPolicy(ctx))
+}
+
+func TestTrainingVerification(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	require.NoError(t, k.SetTrainingAttestationPolicy(ctx, types.TrainingAttestationPolicy{Attesters: 3, EvalSamples: 16, MaxScoreDeviation: "0.1"}))
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{
+		EpochIndex: 4,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: "trainer", Weight: 1000},
+			{MemberAddress: "attester-a", Weight: 400},
+			{MemberAddress: "attester-b", Weight: 300},
+			{MemberAddress: "attester-c", Weight: 300},
+		},
+	})
+	k.SetTrainingTask(sdkCtx, &types.TrainingTask{
+		Id:        1,
+		Assignees: []*types.TrainingTaskAssignee{{Participant: "trainer"}},
+	})
+
+	_, err := k.StartTrainingVerification(ctx, 2, "s3://checkpoints/2", "1.5")
+	require.ErrorIs(t, err, types.ErrTrainingTaskNotFound)
+
+	verification, err := k.StartTrainingVerification(ctx, 1, "s3://checkpoints/1", "1.5")
+	require.NoError(t, err)
+	require.Equal(t, types.TrainingVerificationPending, verification.Status)
+	require.Len(t, verification.Attesters, 3)
+	_, trainerSampled := verification.Attester("trainer")
+	require.False(t, trainerSampled, "participants who trained the task must not attest it")
+
+	_, err = k.StartTrainingVerification(ctx, 1, "s3://checkpoints/1", "1.5")
+	require.ErrorIs(t, err, types.ErrTrainingVerificationExists)
+
+	_, err = k.SubmitTrainingAttestation(ctx, 1, "trainer", "1.5")
+	require.ErrorIs(t, err, types.ErrNotTrainingAttester)
+
+	// attester-b fails it: 300 of 1000 sampled weight, still pending
+	verification, err = k.SubmitTrainingAttestation(ctx, 1, "attester-b", "2.0")
+	require.NoError(t, err)
+	require.False(t, verification.Attestations[0].Passed)
+	require.Equal(t, types.TrainingVerificationPending, verification.Status)
+
+	_, err = k.SubmitTrainingAttestation(ctx, 1, "attester-b", "1.5")
+	require.ErrorIs(t, err, types.ErrDuplicateTrainingAttestation)
+
+	// attester-a passes it: 400, still no majority
+	verification, err = k.SubmitTrainingAttestation(ctx, 1, "attester-a", "1.55")
+	require.NoError(t, err)
+	require.True(t, verification.Attestations[1].Passed)
+	require.Equal(t, types.TrainingVerificationPending, verification.Status)
+
+	// attester-c passes it: 700 of 1000, accepted
+	verification, err = k.SubmitTrainingAttestation(ctx, 1, "attester-c", "1.45")
+	require.NoError(t, err)
+	require.Equal(t, types.TrainingVerificationAccepted, verification.Status)
+
+	stored, found := k.GetTrainingVerification(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, verification, stored)
+
+	_, err = k.SubmitTrainingAttestation(ctx, 1, "attester-c", "1.45")
+	require.ErrorIs(t, err, types.ErrTrainingVerificationResolved)
+
+	found = false
+	for _, event := range sdkCtx.EventManager().Events() {
+		if event.Type == types.EventTypeTrainingVerificationResolved {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestTrainingVerificationDisabled(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	require.NoError(t, k.SetTrainingAttestationPolicy(ctx, types.TrainingAttestationPolicy{MaxScoreDeviation: "0"}))
+	k.SetTrainingTask(sdkCtx, &types.TrainingTask{Id: 1})
+
+	verification, err := k.StartTrainingVerification(ctx, 1, "s3://checkpoints/1", "1.5")
+	require.NoError(t, err)
+	require.Equal(t, types.TrainingVerificationAccepted, verification.Status)
+	require.Empty(t, verification.Attesters)
+}
+
+func TestTrainingVerificationThroughKvRecords(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	trainer, attester := sample.AccAddress(), sample.AccAddress()
+	require.NoError(t, k.Participants.Set(ctx, sdk.MustAccAddressFromBech32(trainer), types.Participant{Index: trainer, Address: trainer}))
+	require.NoError(t, k.TrainingExecAllowListSet.Set(ctx, sdk.MustAccAddressFromBech32(trainer)))
+	require.NoError(t, k.SetTrainingAttestationPolicy(ctx, types.TrainingAttestationPolicy{Attesters: 1, EvalSamples: 16, MaxScoreDeviation: "0.1"}))
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 4))
+	k.SetEpochGroupData(ctx, types.EpochGroupData{
+		EpochIndex: 4,
+		ValidationWeights: []*types.ValidationWeight{
+			{MemberAddress: trainer, Weight: 1000},
+			{MemberAddress: attester, Weight: 400},
+		},
+	})
+	k.SetTrainingTask(sdkCtx, &types.TrainingTask{Id: 1, Assignees: []*types.TrainingTaskAssignee{{Participant: trainer}}})
+
+	_, err := ms.SubmitTrainingKvRecord(ctx, &types.MsgSubmitTrainingKvRecord{
+		Creator: trainer, TaskId: 1, Key: types.TrainingCheckpointRecordKey, Value: "not json",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.SubmitTrainingKvRecord(ctx, &types.MsgSubmitTrainingKvRecord{
+		Creator: trainer, TaskId: 1, Key: types.TrainingCheckpointRecordKey,
+		Value: `{"checkpoint_uri":"s3://checkpoints/1","claimed_score":"1.5"}`,
+	})
+	require.NoError(t, err)
+	verification, found := k.GetTrainingVerification(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, "s3://checkpoints/1", verification.CheckpointUri)
+	_, sampled := verification.Attester(attester)
+	require.True(t, sampled)
+
+	// The attester is neither assigned to the task nor on the training allow list
+	_, err = ms.SubmitTrainingKvRecord(ctx, &types.MsgSubmitTrainingKvRecord{
+		Creator: trainer, TaskId: 1, Key: types.TrainingAttestationRecordKey, Value: "1.5",
+	})
+	require.ErrorIs(t, err, types.ErrNotTrainingAttester)
+	_, err = ms.SubmitTrainingKvRecord(ctx, &types.MsgSubmitTrainingKvRecord{
+		Creator: attester, TaskId: 1, Key: types.TrainingAttestationRecordKey, Value: "1.45",
+	})
+	require.NoError(t, err)
+	verification, _ = k.GetTrainingVerification(ctx, 1)
+	require.Equal(t, types.TrainingVerificationAccepted, verification.Status)
+}
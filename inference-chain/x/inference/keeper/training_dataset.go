@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// RegisterTrainingDataset adds a dataset to the training dataset registry. Governance registers datasets
+// with MsgUpdatePolicy (types.PolicyTrainingDataset). A registered dataset can't change: new content is a new dataset.
+func (k Keeper) RegisterTrainingDataset(ctx context.Context, dataset types.TrainingDataset) error {
+	if err := dataset.Validate(); err != nil {
+		return err
+	}
+	has, err := k.TrainingDatasets.Has(ctx, dataset.Name)
+	if err != nil {
+		return err
+	}
+	if has {
+		return fmt.Errorf("%w: %s", types.ErrTrainingDatasetExists, dataset.Name)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dataset.Hash = strings.ToLower(dataset.Hash)
+	dataset.RegisteredAtHeight = sdkCtx.BlockHeight()
+	bz, err := json.Marshal(dataset)
+	if err != nil {
+		return err
+	}
+	if err := k.TrainingDatasets.Set(ctx, dataset.Name, bz); err != nil {
+		return err
+	}
+	k.LogInfo("Registered training dataset", types.Training, "name", dataset.Name, "hash", dataset.Hash)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTrainingDatasetRegistered,
+			sdk.NewAttribute(types.AttributeKeyDatasetName, dataset.Name),
+			sdk.NewAttribute(types.AttributeKeyDatasetHash, dataset.Hash),
+		))
+	return nil
+}
+
+// restoreTrainingDataset stores an exported dataset as it was registered
+func (k Keeper) restoreTrainingDataset(ctx context.Context, dataset types.TrainingDataset) error {
+	if err := dataset.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(dataset)
+	if err != nil {
+		return err
+	}
+	return k.TrainingDatasets.Set(ctx, dataset.Name, bz)
+}
+
+func (k Keeper) GetTrainingDataset(ctx context.Context, name string) (types.TrainingDataset, bool) {
+	bz, err := k.TrainingDatasets.Get(ctx, name)
+	if err != nil {
+		return types.TrainingDataset{}, false
+	}
+	var dataset types.TrainingDataset
+	if err := json.Unmarshal(bz, &dataset); err != nil {
+		k.LogError("Failed to decode training dataset", types.Training, "name", name, "error", err)
+		return types.TrainingDataset{}, false
+	}
+	return dataset, true
+}
+
+// GetAllTrainingDatasets returns the registered datasets, ordered by name
+func (k Keeper) GetAllTrainingDatasets(ctx context.Context) ([]types.TrainingDataset, error) {
+	iter, err := k.TrainingDatasets.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var datasets []types.TrainingDataset
+	for ; iter.Valid(); iter.Next() {
+		bz, err := iter.Value()
+		if err != nil {
+			return nil, err
+		}
+		var dataset types.TrainingDataset
+		if err := json.Unmarshal(bz, &dataset); err != nil {
+			return nil, err
+		}
+		datasets = append(datasets, dataset)
+	}
+	return datasets, nil
+}
+
+func (k Keeper) GetTrainingTaskDatasets(ctx context.Context, taskId uint64) (types.TrainingTaskDatasets, bool) {
+	bz, err := k.TrainingTaskDatasets.Get(ctx, taskId)
+	if err != nil {
+		return types.TrainingTaskDatasets{}, false
+	}
+	var datasets types.TrainingTaskDatasets
+	if err := json.Unmarshal(bz, &datasets); err != nil {
+		k.LogError("Failed to decode training task datasets", types.Training, "taskId", taskId, "error", err)
+		return types.TrainingTaskDatasets{}, false
+	}
+	return datasets, true
+}
+
+// resolveTrainingTaskDatasets looks up the registered datasets a training task config names. The train
+// dataset is required, the test dataset only checked when set.
+func (k Keeper) resolveTrainingTaskDatasets(ctx context.Context, config *types.TrainingConfig) (types.TrainingTaskDatasets, error) {
+	if config == nil || config.Datasets == nil || config.Datasets.Train == "" {
+		return types.TrainingTaskDatasets{}, fmt.Errorf("%w: a train dataset is required", types.ErrTrainingDatasetNotFound)
+	}
+	train, found := k.GetTrainingDataset(ctx, config.Datasets.Train)
+	if !found {
+		return types.TrainingTaskDatasets{}, fmt.Errorf("%w: %s", types.ErrTrainingDatasetNotFound, config.Datasets.Train)
+	}
+	datasets := types.TrainingTaskDatasets{Train: train.Ref()}
+	if config.Datasets.Test != "" {
+		test, found := k.GetTrainingDataset(ctx, config.Datasets.Test)
+		if !found {
+			return types.TrainingTaskDatasets{}, fmt.Errorf("%w: %s", types.ErrTrainingDatasetNotFound, config.Datasets.Test)
+		}
+		ref := test.Ref()
+		datasets.Test = &ref
+	}
+	return datasets, nil
+}
+
+func (k Keeper) setTrainingTaskDatasets(ctx context.Context, datasets types.TrainingTaskDatasets) error {
+	bz, err := json.Marshal(datasets)
+	if err != nil {
+		return err
+	}
+	return k.TrainingTaskDatasets.Set(ctx, datasets.TaskId, bz)
+}
+
+// trainingTaskDatasets returns the datasets of a task, nil for the tasks created before the registry
+func (k Keeper) trainingTaskDatasets(ctx context.Context, taskId uint64) *types.TrainingTaskDatasets {
+	datasets, found := k.GetTrainingTaskDatasets(ctx, taskId)
+	if !found {
+		return nil
+	}
+	return &datasets
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func testTrainingDataset(name string) types.TrainingDataset {
+	return types.TrainingDataset{
+		Name:      name,
+		Hash:      "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08",
+		SizeBytes: 1 << 30,
+		License:   "CC-BY-4.0",
+		Uris:      []string{"https://datasets.example.com/" + name + ".parquet"},
+	}
+}
+
+func TestRegisterTrainingDataset(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.NoError(t, k.RegisterTrainingDataset(ctx, testTrainingDataset("fineweb/sample-10bt")))
+	require.ErrorIs(t, k.RegisterTrainingDataset(ctx, testTrainingDataset("fineweb/sample-10bt")), types.ErrTrainingDatasetExists)
+
+	dataset, found := k.GetTrainingDataset(ctx, "fineweb/sample-10bt")
+	require.True(t, found)
+	require.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", dataset.Hash)
+	require.Equal(t, sdk.UnwrapSDKContext(ctx).BlockHeight(), dataset.RegisteredAtHeight)
+
+	invalid := map[string]func(*types.TrainingDataset){
+		"name":    func(d *types.TrainingDataset) { d.Name = "../etc" },
+		"hash":    func(d *types.TrainingDataset) { d.Hash = "abcd" },
+		"size":    func(d *types.TrainingDataset) { d.SizeBytes = 0 },
+		"license": func(d *types.TrainingDataset) { d.License = "" },
+		"uris":    func(d *types.TrainingDataset) { d.Uris = nil },
+		"uri":     func(d *types.TrainingDataset) { d.Uris = []string{"no-scheme"} },
+	}
+	for field, mutate := range invalid {
+		dataset := testTrainingDataset("other")
+		mutate(&dataset)
+		require.Error(t, k.RegisterTrainingDataset(ctx, dataset), field)
+	}
+
+	datasets, err := k.GetAllTrainingDatasets(ctx)
+	require.NoError(t, err)
+	require.Len(t, datasets, 1)
+}
+
+func TestCreateTrainingTask_PinsDatasets(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := "gonka1hgt9lxxxwpsnc3yn2nheqqy9a8vlcjwvgzpve2"
+	acc, err := sdk.AccAddressFromBech32(creator)
+	require.NoError(t, err)
+	require.NoError(t, k.TrainingStartAllowListSet.Set(ctx, acc))
+	require.NoError(t, k.RegisterTrainingDataset(ctx, testTrainingDataset("train")))
+	require.NoError(t, k.RegisterTrainingDataset(ctx, testTrainingDataset("test")))
+
+	for _, datasets := range []*types.TrainingDatasets{nil, {Train: "missing"}, {Train: "train", Test: "missing"}} {
+		_, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{
+			Creator: creator,
+			Config:  &types.TrainingConfig{Datasets: datasets},
+		})
+		require.ErrorIs(t, err, types.ErrTrainingDatasetNotFound)
+	}
+
+	resp, err := ms.CreateTrainingTask(ctx, &types.MsgCreateTrainingTask{
+		Creator: creator,
+		Config:  &types.TrainingConfig{Datasets: &types.TrainingDatasets{Train: "train", Test: "test"}},
+	})
+	require.NoError(t, err)
+
+	pinned, found := k.GetTrainingTaskDatasets(ctx, resp.Task.Id)
+	require.True(t, found)
+	require.Equal(t, resp.Task.Id, pinned.TaskId)
+	require.Equal(t, "train", pinned.Train.Name)
+	require.Equal(t, "test", pinned.Test.Name)
+	require.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", pinned.Test.Hash)
+}
+
+func TestGetAllTrainingDatasetsWithMLNodeVersion(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	// Legacy string keys in the same store are not read as datasets
+	require.NoError(t, k.SetMLNodeVersion(ctx, types.MLNodeVersion{CurrentVersion: "v3.0.8"}))
+	require.NoError(t, k.RegisterTrainingDataset(ctx, testTrainingDataset("fineweb/sample-10bt")))
+
+	datasets, err := k.GetAllTrainingDatasets(ctx)
+	require.NoError(t, err)
+	require.Len(t, datasets, 1)
+	require.Equal(t, "fineweb/sample-10bt", datasets[0].Name)
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SetValidationCircuitBreakerPolicy selects when invalidation effects are paused for an epoch.
+// Governance tunes the thresholds with MsgUpdatePolicy.
+func (k Keeper) SetValidationCircuitBreakerPolicy(ctx context.Context, policy types.ValidationCircuitBreakerPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+	bz, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	return k.ValidationCircuitBreakerPolicy.Set(ctx, bz)
+}
+
+// GetValidationCircuitBreakerPolicy returns the active validation circuit breaker policy,
+// or types.DefaultValidationCircuitBreakerPolicy if none was selected.
+func (k Keeper) GetValidationCircuitBreakerPolicy(ctx context.Context) types.ValidationCircuitBreakerPolicy {
+	bz, err := k.ValidationCircuitBreakerPolicy.Get(ctx)
+	if err != nil {
+		return types.DefaultValidationCircuitBreakerPolicy()
+	}
+	var policy types.ValidationCircuitBreakerPolicy
+	if err := json.Unmarshal(bz, &policy); err != nil {
+		k.LogError("Failed to decode validation circuit breaker policy, using default", types.Validation, "error", err)
+		return types.DefaultValidationCircuitBreakerPolicy()
+	}
+	return policy
+}
+
+func (k Keeper) GetValidationFailureStats(ctx context.Context, epochIndex uint64) types.ValidationFailureStats {
+	bz, err := k.ValidationFailureStats.Get(ctx, epochIndex)
+	if err != nil {
+		return types.ValidationFailureStats{}
+	}
+	var stats types.ValidationFailureStats
+	if err := json.Unmarshal(bz, &stats); err != nil {
+		k.LogError("Failed to decode validation failure stats", types.Validation, "epochIndex", epochIndex, "error", err)
+		return types.ValidationFailureStats{}
+	}
+	return stats
+}
+
+func (k Keeper) setValidationFailureStats(ctx context.Context, epochIndex uint64, stats types.ValidationFailureStats) error {
+	bz, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	return k.ValidationFailureStats.Set(ctx, epochIndex, bz)
+}
+
+// RecordValidationOutcome counts a first-round validation of an inference of the given epoch
+func (k Keeper) RecordValidationOutcome(ctx context.Context, epochIndex uint64, passed bool) {
+	stats := k.GetValidationFailureStats(ctx, epochIndex)
+	if passed {
+		stats.Passed++
+	} else {
+		stats.Failed++
+	}
+	if err := k.setValidationFailureStats(ctx, epochIndex, stats); err != nil {
+		k.LogError("Failed to store validation failure stats", types.Validation, "epochIndex", epochIndex, "error", err)
+	}
+}
+
+// InvalidationsPaused reports whether the circuit breaker has tripped for the current epoch.
+// While it has, failed validations start no invalidation votes, votes already running do not invalidate,
+// and participants are not moved to INVALID, so nobody is penalized for what is likely a systemic bug.
+func (k Keeper) InvalidationsPaused(ctx context.Context) bool {
+	epochIndex, found := k.GetEffectiveEpochIndex(ctx)
+	if !found {
+		return false
+	}
+	return k.GetValidationFailureStats(ctx, epochIndex).Tripped()
+}
+
+// CheckValidationCircuitBreaker trips the circuit breaker of the current epoch when its validation failure
+// rate exceeds the policy, emitting an alert event for governance. It runs in the EndBlocker, which also
+// drops the stats of epochs before the previous one.
+func (k Keeper) CheckValidationCircuitBreaker(ctx context.Context, epochIndex uint64) error {
+	if epochIndex >= 2 {
+		if err := k.ValidationFailureStats.Clear(ctx, new(collections.Range[uint64]).EndExclusive(epochIndex-1)); err != nil {
+			return err
+		}
+	}
+
+	stats := k.GetValidationFailureStats(ctx, epochIndex)
+	policy := k.GetValidationCircuitBreakerPolicy(ctx)
+	if stats.Tripped() || !policy.ShouldTrip(stats) {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stats.TrippedAtHeight = sdkCtx.BlockHeight()
+	if err := k.setValidationFailureStats(ctx, epochIndex, stats); err != nil {
+		return err
+	}
+
+	k.LogWarn("Validation circuit breaker tripped, pausing invalidations for the epoch", types.Validation,
+		"epochIndex", epochIndex,
+		"passed", stats.Passed,
+		"failed", stats.Failed,
+		"failureRate", stats.FailureRate().String(),
+		"maxFailureRate", policy.MaxFailureRate)
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeValidationCircuitBreakerTripped,
+		sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(epochIndex, 10)),
+		sdk.NewAttribute(types.AttributeKeyFailureRate, stats.FailureRate().String()),
+		sdk.NewAttribute(types.AttributeKeyMaxFailureRate, policy.MaxFailureRate),
+		sdk.NewAttribute(types.AttributeKeyPassedValidations, strconv.FormatUint(stats.Passed, 10)),
+		sdk.NewAttribute(types.AttributeKeyFailedValidations, strconv.FormatUint(stats.Failed, 10)),
+	))
+	return nil
+}
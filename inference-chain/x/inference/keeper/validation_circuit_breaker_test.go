@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/productscience/inference/testutil/keeper"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+func TestValidationCircuitBreakerPolicy(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+
+	require.Equal(t, types.DefaultValidationCircuitBreakerPolicy(), k.GetValidationCircuitBreakerPolicy(ctx))
+
+	policy := types.ValidationCircuitBreakerPolicy{MinValidations: 10, MaxFailureRate: "0.3"}
+	require.NoError(t, k.SetValidationCircuitBreakerPolicy(ctx, policy))
+	require.Equal(t, policy, k.GetValidationCircuitBreakerPolicy(ctx))
+
+	require.Error(t, k.SetValidationCircuitBreakerPolicy(ctx, types.ValidationCircuitBreakerPolicy{MinValidations: 0, MaxFailureRate: "0.3"}))
+	require.Error(t, k.SetValidationCircuitBreakerPolicy(ctx, types.ValidationCircuitBreakerPolicy{MinValidations: 10, MaxFailureRate: "1.5"}))
+	require.Error(t, k.SetValidationCircuitBreakerPolicy(ctx, types.ValidationCircuitBreakerPolicy{MinValidations: 10, MaxFailureRate: "0"}))
+	require.Equal(t, policy, k.GetValidationCircuitBreakerPolicy(ctx))
+}
+
+func TestCheckValidationCircuitBreaker(t *testing.T) {
+	k, ctx := keepertest.InferenceKeeper(t)
+	require.NoError(t, k.SetValidationCircuitBreakerPolicy(ctx, types.ValidationCircuitBreakerPolicy{MinValidations: 10, MaxFailureRate: "0.5"}))
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 5))
+	ctx = ctx.WithBlockHeight(42).WithEventManager(sdk.NewEventManager())
+
+	k.RecordValidationOutcome(ctx, 3, false)
+	for i := 0; i < 6; i++ {
+		k.RecordValidationOutcome(ctx, 5, false)
+	}
+
+	// Failing most validations, but too few of them to judge
+	require.NoError(t, k.CheckValidationCircuitBreaker(ctx, 5))
+	require.False(t, k.InvalidationsPaused(ctx))
+
+	for i := 0; i < 3; i++ {
+		k.RecordValidationOutcome(ctx, 5, true)
+	}
+	require.NoError(t, k.CheckValidationCircuitBreaker(ctx, 5))
+	require.False(t, k.InvalidationsPaused(ctx))
+
+	k.RecordValidationOutcome(ctx, 5, false)
+	require.NoError(t, k.CheckValidationCircuitBreaker(ctx, 5))
+	require.True(t, k.InvalidationsPaused(ctx))
+	require.Equal(t, types.ValidationFailureStats{Passed: 3, Failed: 7, TrippedAtHeight: 42}, k.GetValidationFailureStats(ctx, 5))
+
+	var tripped []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeValidationCircuitBreakerTripped {
+			tripped = append(tripped, event)
+		}
+	}
+	require.Len(t, tripped, 1)
+	failureRate, found := tripped[0].GetAttribute(types.AttributeKeyFailureRate)
+	require.True(t, found)
+	require.Equal(t, "0.7", failureRate.Value)
+
+	// The breaker trips once per epoch
+	require.NoError(t, k.CheckValidationCircuitBreaker(ctx.WithBlockHeight(43), 5))
+	require.Equal(t, int64(42), k.GetValidationFailureStats(ctx, 5).TrippedAtHeight)
+
+	// Stats before the previous epoch are dropped
+	require.Equal(t, types.ValidationFailureStats{}, k.GetValidationFailureStats(ctx, 3))
+
+	// A new epoch starts with the breaker reset
+	require.NoError(t, k.SetEffectiveEpochIndex(ctx, 6))
+	require.False(t, k.InvalidationsPaused(ctx))
+}
+
+func TestInvalidateInference_PausedByCircuitBreaker(t *testing.T) {
+	k, ms, ctx, mocks := setupInvalidateHarness(t)
+	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, setEffectiveEpoch(ctx, k, 1, mocks))
+
+	executorAddr := sample.AccAddress()
+	payerAddr := sample.AccAddress()
+	k.SetParticipant(ctx, types.Participant{
+		Index:             executorAddr,
+		Address:           executorAddr,
+		Status:            types.ParticipantStatus_ACTIVE,
+		CurrentEpochStats: &types.CurrentEpochStats{},
+		CoinBalance:       1_000,
+	})
+	k.SetParticipant(ctx, types.Participant{Index: payerAddr, Address: payerAddr, CurrentEpochStats: &types.CurrentEpochStats{}})
+
+	inferenceID := "paused-invalidation"
+	k.SetInference(ctx, types.Inference{
+		Index:           inferenceID,
+		InferenceId:     inferenceID,
+		ExecutedBy:      executorAddr,
+		RequestedBy:     payerAddr,
+		Status:          types.InferenceStatus_VOTING,
+		ActualCost:      123,
+		ProposalDetails: &types.ProposalDetails{PolicyAddress: payerAddr},
+		EpochId:         1,
+	})
+
+	policy := types.DefaultValidationCircuitBreakerPolicy()
+	for i := uint64(0); i < policy.MinValidations; i++ {
+		k.RecordValidationOutcome(ctx, 1, false)
+	}
+	require.NoError(t, k.CheckValidationCircuitBreaker(ctx.WithBlockHeight(10), 1))
+
+	// No refund, no charge and no slash while paused
+	_, err := ms.InvalidateInference(ctx, &types.MsgInvalidateInference{Creator: payerAddr, InferenceId: inferenceID})
+	require.NoError(t, err)
+
+	executor, found := k.GetParticipant(ctx, executorAddr)
+	require.True(t, found)
+	require.Equal(t, int64(1_000), executor.CoinBalance)
+	require.Zero(t, executor.ConsecutiveInvalidInferences)
+
+	inference, found := k.GetInference(ctx, inferenceID)
+	require.True(t, found)
+	require.Equal(t, types.InferenceStatus_FINISHED, inference.Status)
+}
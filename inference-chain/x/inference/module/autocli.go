@@ -345,6 +345,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query all confirmation PoC events for an epoch",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "epoch_index"}},
 				},
+				{
+					RpcMethod:      "ListParticipantDelegations",
+					Use:            "participant-delegations [participant]",
+					Short:          "Query the stake delegated to a participant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
+				{
+					RpcMethod:      "ListDelegationUnbondings",
+					Use:            "delegation-unbondings [delegator]",
+					Short:          "Query the undelegated stake of a delegator waiting for its release epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "delegator"}},
+				},
+				{
+					RpcMethod:      "ListClaimableSettleAmounts",
+					Use:            "claimable-settle-amounts [participant]",
+					Short:          "Query the settle amounts a participant can still claim, including carried over ones",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}},
+				},
+				{
+					RpcMethod: "SimulateModelAssignment",
+					Use:       "simulate-model-assignment",
+					Short:     "Simulate the next model assignment against the current hardware nodes and governance models",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -368,6 +391,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "RegisterLiquidityPool",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdatePolicy",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "StartInference",
 					Use:            "start-inference [inference-id] [prompt-hash] [prompt-payload] [received-by]",
@@ -500,6 +527,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Request minting of WGNK tokens on Ethereum by bridging native Gonka",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "destination_address"}, {ProtoField: "chain_id"}},
 				},
+				{
+					RpcMethod:      "InitiateExit",
+					Use:            "initiate-exit",
+					Short:          "Leave the network: serve the last epoch, then unbond all collateral",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
+				},
+				{
+					RpcMethod:      "DelegateToParticipant",
+					Use:            "delegate-to-participant [participant] [amount]",
+					Short:          "Delegate stake to a compute participant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "Undelegate",
+					Use:            "undelegate [participant] [amount]",
+					Short:          "Take back stake delegated to a participant, held until the epochs it weighted are settled",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "participant"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "ValidationBatch",
+					Use:       "validation-batch",
+					Short:     "Send the validations of several inferences in one tx, given as JSON with --validations",
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
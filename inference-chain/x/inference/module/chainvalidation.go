@@ -619,6 +619,7 @@ func (am AppModule) GetPreservedNodesByParticipant(ctx context.Context, epochId
 	}
 
 	result := make(map[string][]*types.MLNodeInfo)
+	timeslotSchedule := am.keeper.GetTimeslotSchedule(ctx)
 
 	for _, p := range participants.Participants {
 		am.LogInfo("GetPreviousEpochMLNodesWithInferenceAllocation. GetPreservedNodesByParticipant: Processing participant", types.PoC,
@@ -631,8 +632,8 @@ func (am AppModule) GetPreservedNodesByParticipant(ctx context.Context, epochId
 					preservedMLNode := &types.MLNodeInfo{
 						NodeId:             mlNode.NodeId,
 						Throughput:         mlNode.Throughput,
-						PocWeight:          mlNode.PocWeight,    // Preserve the weight from current epoch
-						TimeslotAllocation: []bool{true, false}, // Reset to default for new epoch
+						PocWeight:          mlNode.PocWeight,                     // Preserve the weight from current epoch
+						TimeslotAllocation: timeslotSchedule.InitialAllocation(), // Reset to default for new epoch
 					}
 					nodes = append(nodes, preservedMLNode)
 				}
@@ -930,6 +931,13 @@ func (am AppModule) ComputeNewWeights(ctx context.Context, upcomingEpoch types.E
 	var validationSlots int
 	timeNormalizationFactor := mathsdk.LegacyOneDec()
 
+	epochParams, err := am.keeper.GetEpochParamsForEpoch(ctx, upcomingEpoch.Index)
+	if err != nil {
+		am.LogError("ComputeNewWeights: Error getting epoch params", types.PoC,
+			"upcomingEpoch.Index", upcomingEpoch.Index, "error", err)
+		return nil
+	}
+
 	snapshot, snapshotFound, _ := am.keeper.GetPoCValidationSnapshot(ctx, epochStartBlockHeight)
 	if snapshotFound {
 		if params.PocParams.ValidationSlots > 0 {
@@ -940,8 +948,8 @@ func (am AppModule) ComputeNewWeights(ctx context.Context, upcomingEpoch types.E
 			timeNormalizationFactor = CalculateTimeNormalizationFactor(
 				snapshot.GenerationStartTimestamp,
 				snapshot.ExchangeEndTimestamp,
-				params.EpochParams.PocStageDuration,
-				params.EpochParams.PocExchangeDuration,
+				epochParams.PocStageDuration,
+				epochParams.PocExchangeDuration,
 			)
 		}
 		am.LogInfo("ComputeNewWeights: Using validation snapshot", types.PoC,
@@ -41,11 +41,6 @@ func (am AppModule) handleConfirmationPoC(ctx context.Context, blockHeight int64
 		return nil
 	}
 
-	epochParams := params.EpochParams
-	if epochParams == nil {
-		return fmt.Errorf("epoch params not found")
-	}
-
 	// Get current epoch context
 	currentEpoch, found := am.keeper.GetEffectiveEpoch(ctx)
 	if !found || currentEpoch == nil {
@@ -53,10 +48,12 @@ func (am AppModule) handleConfirmationPoC(ctx context.Context, blockHeight int64
 		return nil
 	}
 
-	epochContext, err := types.NewEpochContextFromEffectiveEpoch(*currentEpoch, *epochParams, blockHeight)
+	epochContext, err := am.keeper.GetEffectiveEpochContext(ctx, *currentEpoch, blockHeight)
 	if err != nil {
 		return fmt.Errorf("failed to create epoch context: %w", err)
 	}
+	// Confirmation PoC runs on the epoch params of the epoch it confirms
+	epochParams := &epochContext.EpochParams
 
 	// Handle phase transitions for active event
 	err = am.handleConfirmationPoCPhaseTransitions(ctx, blockHeight, epochContext, epochParams)
@@ -548,6 +545,12 @@ func (am AppModule) updateConfirmationWeightsV2(
 	var validationSlots int
 	timeNormalizationFactor := mathsdk.LegacyOneDec()
 
+	epochParams, err := am.keeper.GetEpochParamsForEpoch(ctx, event.EpochIndex)
+	if err != nil {
+		am.LogError("updateConfirmationWeightsV2: failed to get epoch params", types.PoC, "error", err)
+		return nil
+	}
+
 	snapshot, snapshotFound, _ := am.keeper.GetPoCValidationSnapshot(ctx, event.TriggerHeight)
 	if snapshotFound {
 		if params.PocParams.ValidationSlots > 0 {
@@ -558,8 +561,8 @@ func (am AppModule) updateConfirmationWeightsV2(
 			timeNormalizationFactor = CalculateTimeNormalizationFactor(
 				snapshot.GenerationStartTimestamp,
 				snapshot.ExchangeEndTimestamp,
-				params.EpochParams.PocStageDuration,
-				params.EpochParams.PocExchangeDuration,
+				epochParams.PocStageDuration,
+				epochParams.PocExchangeDuration,
 			)
 		}
 		am.LogInfo("updateConfirmationWeightsV2: Using validation snapshot", types.PoC,
@@ -68,6 +68,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		//nolint:forbidigo // genesis code
 		panic(err)
 	}
+	if err := k.RecordEpochParams(ctx, 0); err != nil {
+		k.LogWarn("Unable to record the epoch params of the genesis epoch", types.EpochGroup, "error", err)
+	}
 	for _, elem := range genState.ModelList {
 		if elem.ProposedBy != "genesis" {
 			//nolint:forbidigo // genesis code
@@ -102,6 +105,79 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, exit := range genState.ParticipantExitList {
+		if err := k.SetParticipantExit(ctx, exit); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, delegations := range genState.ParticipantDelegationsList {
+		if err := k.SetParticipantDelegations(ctx, delegations); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, delegation := range genState.EpochDelegationList {
+		if err := k.SetEpochDelegation(ctx, delegation); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, unbonding := range genState.DelegationUnbondingList {
+		if err := k.AddDelegationUnbonding(ctx, unbonding); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, payment := range genState.InferencePaymentList {
+		if err := k.SetInferencePayment(ctx, payment); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, treasury := range genState.DenomTreasuryList {
+		if err := k.SetDenomTreasury(ctx, treasury); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, balance := range genState.ParticipantDenomBalanceList {
+		if err := k.SetParticipantDenomBalance(ctx, balance); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, escrow := range genState.InferenceEscrowList {
+		if err := k.SetInferenceEscrow(ctx, escrow); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, releasable := range genState.ReleasableEscrowList {
+		if err := k.SetReleasableEscrow(ctx, releasable); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, account := range genState.RewardVestingAccountList {
+		if err := k.SetRewardVestingAccount(ctx, account); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, settleAmount := range genState.SettleCarryoverList {
+		if err := k.SetSettleCarryover(ctx, settleAmount); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+	for _, entry := range genState.PolicyList {
+		if err := k.InitPolicy(ctx, entry); err != nil {
+			//nolint:forbidigo // genesis code
+			panic(err)
+		}
+	}
+
 	// Observability: end of InitGenesis
 	k.LogInfo("InitGenesis: completed", types.System)
 
@@ -297,6 +373,81 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		TokenMetadata:       tokenMetadataPtrs,
 		TradeApprovedTokens: tradeApprovedTokenPtrs,
 	}
+
+	participantExits, err := k.GetAllParticipantExits(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.ParticipantExitList = participantExits
+
+	participantDelegations, err := k.GetAllParticipantDelegations(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.ParticipantDelegationsList = participantDelegations
+	epochDelegations, err := k.GetAllEpochDelegations(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.EpochDelegationList = epochDelegations
+	delegationUnbondings, err := k.GetAllDelegationUnbondings(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.DelegationUnbondingList = delegationUnbondings
+
+	inferencePayments, err := k.GetAllInferencePayments(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.InferencePaymentList = inferencePayments
+	denomTreasuries, err := k.GetAllDenomTreasuries(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.DenomTreasuryList = denomTreasuries
+	participantDenomBalances, err := k.GetAllParticipantDenomBalances(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.ParticipantDenomBalanceList = participantDenomBalances
+	inferenceEscrows, err := k.GetAllInferenceEscrows(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.InferenceEscrowList = inferenceEscrows
+	releasableEscrows, err := k.GetAllReleasableEscrows(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.ReleasableEscrowList = releasableEscrows
+	rewardVestingAccounts, err := k.GetAllRewardVestingAccounts(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.RewardVestingAccountList = rewardVestingAccounts
+	settleCarryovers, err := k.GetAllSettleCarryovers(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.SettleCarryoverList = settleCarryovers
+	policies, err := k.GetAllPolicies(ctx)
+	if err != nil {
+		//nolint:forbidigo // genesis code
+		panic(err)
+	}
+	genesis.PolicyList = policies
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
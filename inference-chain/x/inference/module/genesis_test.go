@@ -1,16 +1,31 @@
 package inference_test
 
 import (
+	"encoding/json"
 	"testing"
 
 	keepertest "github.com/productscience/inference/testutil/keeper"
 	"github.com/productscience/inference/testutil/nullify"
+	"github.com/productscience/inference/testutil/sample"
 	inference "github.com/productscience/inference/x/inference/module"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	delegator := sample.AccAddress()
+	participant := sample.AccAddress()
+	lateJoinPolicy, err := json.Marshal(types.DefaultLateJoinPolicy())
+	require.NoError(t, err)
+	dataset, err := json.Marshal(types.TrainingDataset{
+		Name:               "dataset-1",
+		Hash:               "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+		SizeBytes:          1024,
+		License:            "MIT",
+		Uris:               []string{"https://example.com/dataset-1"},
+		RegisteredAtHeight: 7,
+	})
+	require.NoError(t, err)
 	genesisState := types.GenesisState{
 		Params:            types.DefaultParams(),
 		GenesisOnlyParams: types.DefaultGenesisOnlyParams(),
@@ -27,6 +42,62 @@ func TestGenesis(t *testing.T) {
 				ValidationThreshold:    &types.Decimal{Value: 99, Exponent: -2},
 			},
 		},
+		ParticipantExitList: []types.ParticipantExit{
+			{
+				Address:                sample.AccAddress(),
+				RequestedAtEpoch:       3,
+				LastEpoch:              4,
+				Unbonding:              true,
+				Collateral:             "1000ngonka",
+				CollateralReleaseEpoch: 6,
+			},
+		},
+		ParticipantDelegationsList: []types.ParticipantDelegations{
+			{Participant: participant, Delegations: []*types.Delegation{{Delegator: delegator, Amount: 3000}}},
+		},
+		EpochDelegationList: []types.EpochDelegation{
+			{
+				EpochIndex:         4,
+				Participant:        participant,
+				PocWeight:          100,
+				Weight:             103,
+				CommissionPermille: 100,
+				Delegations:        []*types.Delegation{{Delegator: delegator, Amount: 5000}},
+			},
+		},
+		DelegationUnbondingList: []types.DelegationUnbonding{
+			{Delegator: delegator, Participant: participant, Amount: 2000, ReleaseEpoch: 4},
+		},
+		InferencePaymentList: []types.InferencePayment{
+			{InferenceId: "inference-1", Denom: "ibc/denom", Rate: "2.5", Amount: 401},
+		},
+		DenomTreasuryList: []types.DenomTreasury{
+			{Denom: "ibc/denom", Escrowed: 401, Refunded: 200, PaidOut: 100},
+		},
+		ParticipantDenomBalanceList: []types.ParticipantDenomBalance{
+			{Participant: participant, Denom: "ibc/denom", Amount: 100},
+		},
+		InferenceEscrowList: []types.InferenceEscrow{
+			{InferenceId: "locked", Requester: participant, Executor: participant, Status: types.EscrowLocked, Locked: 500, Refunded: 100, ExecutorPayment: 400, EpochId: 3, LockedAtHeight: 10},
+			{InferenceId: "refunded", Requester: participant, Status: types.EscrowRefunded, Locked: 500, Refunded: 500, LockedAtHeight: 10, SettledAtHeight: 20},
+		},
+		ReleasableEscrowList: []types.ReleasableEscrow{
+			{EpochId: 3, InferenceId: "locked"},
+		},
+		RewardVestingAccountList: []types.RewardVestingAccount{
+			{Participant: participant, Tranches: []types.RewardVestingTranche{
+				{SettleEpoch: 2, StartEpoch: 3, Epochs: 4, Total: 400, Released: 100},
+			}},
+		},
+		SettleCarryoverList: []types.SettleAmount{
+			{Participant: participant, EpochIndex: 4, WorkCoins: 100, RewardCoins: 200},
+		},
+		PolicyList: []types.PolicyEntry{
+			{Policy: types.PolicyModelAssignmentStrategy, Value: `"balanced"`},
+			{Policy: types.PolicyEpochGroupDataRetentionEpochs, Value: "20"},
+			{Policy: types.PolicyLateJoin, Value: string(lateJoinPolicy)},
+			{Policy: types.PolicyTrainingDataset, Value: string(dataset)},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -42,5 +113,17 @@ func TestGenesis(t *testing.T) {
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.ModelList, got.ModelList)
+	require.ElementsMatch(t, genesisState.ParticipantExitList, got.ParticipantExitList)
+	require.ElementsMatch(t, genesisState.ParticipantDelegationsList, got.ParticipantDelegationsList)
+	require.ElementsMatch(t, genesisState.EpochDelegationList, got.EpochDelegationList)
+	require.ElementsMatch(t, genesisState.DelegationUnbondingList, got.DelegationUnbondingList)
+	require.ElementsMatch(t, genesisState.InferencePaymentList, got.InferencePaymentList)
+	require.ElementsMatch(t, genesisState.DenomTreasuryList, got.DenomTreasuryList)
+	require.ElementsMatch(t, genesisState.ParticipantDenomBalanceList, got.ParticipantDenomBalanceList)
+	require.ElementsMatch(t, genesisState.InferenceEscrowList, got.InferenceEscrowList)
+	require.ElementsMatch(t, genesisState.ReleasableEscrowList, got.ReleasableEscrowList)
+	require.ElementsMatch(t, genesisState.RewardVestingAccountList, got.RewardVestingAccountList)
+	require.ElementsMatch(t, genesisState.SettleCarryoverList, got.SettleCarryoverList)
+	require.ElementsMatch(t, genesisState.PolicyList, got.PolicyList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -31,8 +31,10 @@ func (am AppModule) GetLatestPoCOrCPoCRangeWithData(
 		return &PoCTimeRange{IsActive: false}, nil
 	}
 
-	epochParams := params.EpochParams
-	epochContext := types.NewEpochContext(*currentEpoch, *epochParams)
+	epochContext, err := am.keeper.GetEpochContext(ctx, *currentEpoch)
+	if err != nil {
+		return nil, err
+	}
 
 	// 1. Check if next PoC started
 	if epochContext.IsNextPoCStart(blockHeight) {
@@ -64,7 +66,7 @@ func (am AppModule) GetLatestPoCOrCPoCRangeWithData(
 
 	// If we have a latest CPoC, check if it's relevant (active or recently completed)
 	if latestCPoC != nil {
-		cpocEndBlock := latestCPoC.GetValidationEnd(epochParams)
+		cpocEndBlock := latestCPoC.GetValidationEnd(&epochContext.EpochParams)
 
 		isActive := blockHeight >= latestCPoC.GenerationStartHeight && blockHeight <= cpocEndBlock
 		return &PoCTimeRange{
@@ -110,6 +112,10 @@ func (am AppModule) NewInferenceExpiryContextWithEpoch(
 	}
 
 	expirationBlocks := params.ValidationParams.ExpirationBlocks
+	epochParams, err := am.keeper.GetEpochParamsForEpoch(ctx, currentEpoch.Index)
+	if err != nil {
+		return nil, err
+	}
 
 	return &InferenceExpiryContext{
 		CurrentBlockHeight:         blockHeight,
@@ -117,7 +123,7 @@ func (am AppModule) NewInferenceExpiryContextWithEpoch(
 		PoCRange:                   pocRange,
 		CurrentEpoch:               currentEpoch,
 		PreviousEpoch:              nil, // Lazy loaded in GetEpochForInference if needed
-		EpochParams:                params.EpochParams,
+		EpochParams:                epochParams,
 		CurrentActiveParticipants:  nil, // Lazy loaded in GetEpochForInference if needed
 		PreviousActiveParticipants: nil, // Lazy loaded in GetEpochForInference if needed
 	}, nil
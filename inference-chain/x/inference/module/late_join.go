@@ -0,0 +1,54 @@
+package inference
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// amendEpochGroupWithLateJoiners adds the late joiners of the effective epoch to its epoch group once the
+// amendment height is reached. They take part in inference and validation from then on, but not in the
+// BLS key generation, which started with the on-time participants at epoch group formation.
+func (am AppModule) amendEpochGroupWithLateJoiners(ctx context.Context, blockHeight int64) {
+	effectiveEpoch, found := am.keeper.GetEffectiveEpoch(ctx)
+	if !found || effectiveEpoch == nil {
+		return
+	}
+	lateJoiners, found := am.keeper.GetPendingLateJoiners(ctx, effectiveEpoch.Index)
+	if !found || blockHeight < lateJoiners.EffectiveBlockHeight {
+		return
+	}
+
+	am.LogInfo("Amending epoch group with late joiners", types.EpochGroup,
+		"epochIndex", effectiveEpoch.Index, "blockHeight", blockHeight, "lateJoiners", len(lateJoiners.Participants))
+	effectiveEg, err := am.keeper.GetEpochGroupForEpoch(ctx, *effectiveEpoch)
+	if err != nil {
+		am.LogError("amendEpochGroupWithLateJoiners: Unable to get epoch group for effective epoch", types.EpochGroup,
+			"epochIndex", effectiveEpoch.Index, "error", err.Error())
+		return
+	}
+	am.addEpochMembers(ctx, effectiveEg, lateJoiners.Participants)
+
+	activeParticipants, found := am.keeper.GetActiveParticipants(ctx, effectiveEpoch.Index)
+	if found {
+		activeParticipants.Participants = append(activeParticipants.Participants, lateJoiners.Participants...)
+		if err := am.keeper.SetActiveParticipants(ctx, activeParticipants); err != nil {
+			am.LogError("amendEpochGroupWithLateJoiners: Unable to set active participants", types.EpochGroup, "error", err.Error())
+		}
+	} else {
+		am.LogError("amendEpochGroupWithLateJoiners: Active participants not found", types.EpochGroup, "epochIndex", effectiveEpoch.Index)
+	}
+
+	if err := am.keeper.RemovePendingLateJoiners(ctx, effectiveEpoch.Index); err != nil {
+		am.LogError("amendEpochGroupWithLateJoiners: Unable to remove pending late joiners", types.EpochGroup, "error", err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEpochGroupAmended,
+			sdk.NewAttribute(types.AttributeKeyEpochIndex, strconv.FormatUint(effectiveEpoch.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyLateJoiners, strconv.Itoa(len(lateJoiners.Participants))),
+		))
+}
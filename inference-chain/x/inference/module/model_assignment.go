@@ -1,13 +1,16 @@
 package inference
 
 import (
+	"cmp"
 	"context"
 	"crypto/sha256"
 	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"slices"
+	"strings"
 
+	mathsdk "cosmossdk.io/math"
 	"github.com/productscience/inference/x/inference/types"
 	"github.com/shopspring/decimal"
 )
@@ -261,6 +264,10 @@ func (e *EpochMLNodeData) GetParticipantWeight(participantAddr string) int64 {
 type ModelAssigner struct {
 	types.InferenceLogger
 	keeper KeeperForModelAssigner
+	// pocAudits collects the PoC allocation audit per model during AllocateMLNodesForPoC
+	pocAudits map[string]*types.PocAllocationAudit
+	// versionAssignment is set by setModelsForParticipants while an ML node version rollout is in progress
+	versionAssignment *types.MLNodeVersionAssignment
 }
 
 func NewModelAssigner(keeper KeeperForModelAssigner, logger types.InferenceLogger) *ModelAssigner {
@@ -277,6 +284,14 @@ type KeeperForModelAssigner interface {
 	GetEpochGroupData(ctx context.Context, epochIndex uint64, modelId string) (val types.EpochGroupData, found bool)
 	GetSettleAmount(ctx context.Context, participant string) (val types.SettleAmount, found bool)
 	GetParams(ctx context.Context) (types.Params, error)
+	GetModelAssignmentStrategy(ctx context.Context) string
+	GetTimeslotSchedule(ctx context.Context) types.TimeslotSchedule
+	IsHardwareVerificationRequired(ctx context.Context) bool
+	GetMLNodeVersion(ctx context.Context) (val types.MLNodeVersion, found bool)
+	GetMLNodeVersionRollout(ctx context.Context) (types.MLNodeVersionRollout, bool)
+	GetCapacityCollateralPolicy(ctx context.Context) types.CapacityCollateralPolicy
+	GetBondedCollateral(ctx context.Context, participant string) mathsdk.Int
+	VerifyHardwareAttestation(ctx context.Context, participant string, node *types.HardwareNode) (types.HardwareAttestation, bool, error)
 }
 
 func (ma *ModelAssigner) setModelsForParticipants(ctx context.Context, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) {
@@ -292,6 +307,14 @@ func (ma *ModelAssigner) setModelsForParticipants(ctx context.Context, participa
 	}
 	ma.LogInfo("Retrieved governance models", types.Allocation, "flow_context", FlowContext, "step", "get_governance_models", "num_models", len(governanceModels))
 
+	strategyName := ma.keeper.GetModelAssignmentStrategy(ctx)
+	strategy := newModelAssignmentStrategy(strategyName)
+	ma.LogInfo("Using model assignment strategy", types.Allocation, "flow_context", FlowContext, "step", "select_strategy", "strategy", strategyName)
+
+	timeslotSchedule := ma.keeper.GetTimeslotSchedule(ctx)
+	verifyHardware := ma.keeper.IsHardwareVerificationRequired(ctx)
+	collateralPolicy := ma.keeper.GetCapacityCollateralPolicy(ctx)
+
 	for _, p := range participants {
 		ma.LogInfo("Processing participant", types.Allocation, "flow_context", FlowContext, "step", "participant_loop_start", "participant_index", p.Index)
 		hardwareNodes, found := ma.keeper.GetHardwareNodes(ctx, p.Index)
@@ -324,38 +347,38 @@ func (ma *ModelAssigner) setModelsForParticipants(ctx context.Context, participa
 		}
 
 		for _, mlNode := range originalMLNodes {
-			mlNode.TimeslotAllocation = []bool{true, false} // [PRE_POC_SLOT, POC_SLOT]
+			mlNode.TimeslotAllocation = timeslotSchedule.InitialAllocation() // [PRE_POC_SLOT, POC_SLOT, ...]
 		}
-		ma.LogInfo("Initialized all ML nodes to PRE_POC_SLOT=true, POC_SLOT=false", types.Allocation, "flow_context", FlowContext, "step", "init_slots", "participant_index", p.Index)
+		ma.LogInfo("Initialized all ML nodes to default timeslot allocation", types.Allocation, "flow_context", FlowContext, "step", "init_slots", "participant_index", p.Index, "timeslot_allocation", timeslotSchedule.InitialAllocation())
 
 		assignedMLNodes := make(map[string]bool)
 		var supportedModels []string
 		var newMLNodeArrays []*types.ModelMLNodes
 
 		supportedModelsByNode := supportedModelsByNode(hardwareNodes, governanceModels)
+		if verifyHardware {
+			attestations := ma.verifiedHardwareAttestations(ctx, p.Index, hardwareNodes)
+			for nodeId, rejectedModels := range removeModelsExceedingAttestedVRam(supportedModelsByNode, hardwareNodes, attestations, governanceModels) {
+				ma.LogInfo("Node has no verified attestation of enough VRAM for models", types.Allocation, "flow_context", FlowContext, "step", "verify_hardware", "participant_index", p.Index, "node_id", nodeId, "rejected_models", rejectedModels)
+			}
+		}
+		if collateralPolicy.Enabled() {
+			collateral := ma.keeper.GetBondedCollateral(ctx, p.Index)
+			for _, nodeId := range removeNodesNotCoveredByCollateral(supportedModelsByNode, hardwareNodes, originalMLNodes, collateralPolicy, collateral) {
+				ma.LogInfo("Node capacity is not covered by collateral, ignoring node", types.Allocation, "flow_context", FlowContext, "step", "verify_collateral", "participant_index", p.Index, "node_id", nodeId, "collateral", collateral.String())
+			}
+		}
 		for nodeId, supportedModels := range supportedModelsByNode {
 			ma.LogInfo("Supported models by node", types.Allocation, "flow_context", FlowContext, "step", "supported_models_by_node", "node_id", nodeId, "supported_models", supportedModels)
 		}
 
-		// For each governance model, pick the available MLNodes that have the model as first supported model
+		modelNodes := strategy.AssignNodes(originalMLNodes, supportedModelsByNode, governanceModels)
 		for _, model := range governanceModels {
-			ma.LogInfo("Attempting to assign ML node for model", types.Allocation, "flow_context", FlowContext, "step", "model_assignment_loop", "participant_index", p.Index, "model_id", model.Id)
-			var modelMLNodes []*types.MLNodeInfo
-
-			for _, mlNode := range originalMLNodes {
-				if assignedMLNodes[mlNode.NodeId] {
-					ma.LogInfo("Skipping already assigned ML node", types.Allocation, "flow_context", FlowContext, "step", "node_already_assigned", "participant_index", p.Index, "model_id", model.Id, "node_id", mlNode.NodeId)
-					continue
-				}
-
-				if slices.Contains(supportedModelsByNode[mlNode.NodeId], model.Id) {
-					ma.LogInfo("Found supporting and unassigned ML node for model", types.Allocation, "flow_context", FlowContext, "step", "assign_node_to_model", "participant_index", p.Index, "model_id", model.Id, "node_id", mlNode.NodeId)
-					modelMLNodes = append(modelMLNodes, mlNode)
+			modelMLNodes := modelNodes[model.Id]
+			if len(modelMLNodes) > 0 {
+				for _, mlNode := range modelMLNodes {
 					assignedMLNodes[mlNode.NodeId] = true
 				}
-			}
-
-			if len(modelMLNodes) > 0 {
 				supportedModels = append(supportedModels, model.Id)
 				newMLNodeArrays = append(newMLNodeArrays, &types.ModelMLNodes{MlNodes: modelMLNodes})
 				ma.LogInfo("Assigned ML nodes to model", types.Allocation, "flow_context", FlowContext, "step", "model_assignment_complete", "participant_index", p.Index, "model_id", model.Id, "assigned_nodes", modelMLNodes)
@@ -378,6 +401,56 @@ func (ma *ModelAssigner) setModelsForParticipants(ctx context.Context, participa
 		ma.LogInfo("Participant models and ML nodes updated", types.Allocation, "flow_context", FlowContext, "step", "participant_updated", "participant_index", p.Index, "supported_models", p.Models, "ml_nodes", p.MlNodes)
 	}
 	ma.LogInfo("Finished model assignment for all participants", types.Allocation, "flow_context", FlowContext, "step", "model_assignment_complete")
+
+	ma.assignMLNodeVersions(ctx, participants, upcomingEpoch)
+}
+
+// assignMLNodeVersions marks the canary nodes of each participant that run the target version of the
+// ML node version rollout in progress. Only nodes assigned to a model are considered.
+func (ma *ModelAssigner) assignMLNodeVersions(ctx context.Context, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) {
+	ma.versionAssignment = nil
+	rollout, found := ma.keeper.GetMLNodeVersionRollout(ctx)
+	if !found {
+		return
+	}
+	currentVersion, _ := ma.keeper.GetMLNodeVersion(ctx)
+	assignment := &types.MLNodeVersionAssignment{
+		EpochIndex:     upcomingEpoch.Index,
+		CurrentVersion: currentVersion.CurrentVersion,
+		TargetVersion:  rollout.TargetVersion,
+		CanaryPercent:  rollout.CanaryPercent,
+		Participants:   []types.ParticipantCanaryNodes{},
+	}
+	for _, p := range participants {
+		seen := make(map[string]bool)
+		var nodeIds []string
+		for _, modelNodes := range p.MlNodes {
+			if modelNodes == nil {
+				continue
+			}
+			for _, mlNode := range modelNodes.MlNodes {
+				if !seen[mlNode.NodeId] {
+					seen[mlNode.NodeId] = true
+					nodeIds = append(nodeIds, mlNode.NodeId)
+				}
+			}
+		}
+		if canaries := rollout.CanaryNodes(p.Index, nodeIds); len(canaries) > 0 {
+			assignment.Participants = append(assignment.Participants, types.ParticipantCanaryNodes{Participant: p.Index, NodeIds: canaries})
+		}
+	}
+	slices.SortFunc(assignment.Participants, func(a, b types.ParticipantCanaryNodes) int {
+		return strings.Compare(a.Participant, b.Participant)
+	})
+	ma.versionAssignment = assignment
+	ma.LogInfo("Assigned MLNode versions for rollout", types.Allocation, "flow_context", FlowContext, "step", "version_rollout",
+		"target_version", rollout.TargetVersion, "canary_percent", rollout.CanaryPercent, "participants_with_canaries", len(assignment.Participants))
+}
+
+// MLNodeVersionAssignment returns the rollout assignment of the last setModelsForParticipants call,
+// nil when no rollout is in progress
+func (ma *ModelAssigner) MLNodeVersionAssignment() *types.MLNodeVersionAssignment {
+	return ma.versionAssignment
 }
 
 func (ma *ModelAssigner) AllocateMLNodesForPoC(ctx context.Context, upcomingEpoch types.Epoch, participants []*types.ActiveParticipant) {
@@ -440,6 +513,8 @@ func (ma *ModelAssigner) AllocateMLNodesForPoC(ctx context.Context, upcomingEpoc
 		}
 	}
 
+	ma.pocAudits = make(map[string]*types.PocAllocationAudit)
+
 	totalCurrentEpochWeight := int64(0)
 	currentEpochData := NewEpochMLNodeData()
 	for _, participant := range participants {
@@ -476,14 +551,88 @@ func (ma *ModelAssigner) AllocateMLNodesForPoC(ctx context.Context, upcomingEpoc
 	for _, modelId := range sortedModelIds {
 		ma.LogInfo("Processing model for PoC allocation", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "model_loop_start", "model_id", modelId)
 		ma.allocateMLNodePerPoCForModel(modelId, currentEpochData, eligibleNodesData, allocationFraction)
+		ma.resolvePendingPocDecisions(modelId, eligibleNodesData)
+	}
+
+	ma.allocateAdditionalTimeslots(ctx, sortedModelIds, currentEpochData)
+}
+
+// allocateAdditionalTimeslots allocates the timeslots after POC_SLOT defined in the timeslot schedule.
+// Unlike POC_SLOT, every node of a model is a candidate: each timeslot gets its AllocationFraction of
+// the model's weight, balanced across participants.
+func (ma *ModelAssigner) allocateAdditionalTimeslots(ctx context.Context, sortedModelIds []string, currentEpochData *EpochMLNodeData) {
+	schedule := ma.keeper.GetTimeslotSchedule(ctx)
+	for slot := types.PocSlotIndex + 1; slot < len(schedule.Timeslots); slot++ {
+		spec := schedule.Timeslots[slot]
+		fraction, err := spec.Fraction()
+		if err != nil {
+			ma.LogError("Invalid timeslot allocation fraction, skipping timeslot", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "additional_timeslot", "timeslot", spec.Name, "error", err.Error())
+			continue
+		}
+		for _, modelId := range sortedModelIds {
+			totalWeight := currentEpochData.GetTotalWeightForModel(modelId)
+			targetWeight := fraction.Mul(decimal.NewFromInt(totalWeight)).IntPart()
+			allocatedWeight := ma.allocateTimeslotForModel(modelId, slot, currentEpochData, targetWeight)
+			ma.LogInfo("Finished timeslot allocation for model", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "additional_timeslot", "timeslot", spec.Name, "model_id", modelId, "achieved_weight", allocatedWeight, "target_weight", targetWeight, "total_weight", totalWeight)
+		}
+	}
+}
+
+// PocAllocationAudits returns the audits collected by the last AllocateMLNodesForPoC call, ordered by model id
+func (ma *ModelAssigner) PocAllocationAudits() []types.PocAllocationAudit {
+	audits := make([]types.PocAllocationAudit, 0, len(ma.pocAudits))
+	for _, modelId := range sortedKeys(ma.pocAudits) {
+		audits = append(audits, *ma.pocAudits[modelId])
+	}
+	return audits
+}
+
+func (ma *ModelAssigner) pocAudit(epochIndex uint64, modelId string) *types.PocAllocationAudit {
+	if ma.pocAudits == nil {
+		ma.pocAudits = make(map[string]*types.PocAllocationAudit)
+	}
+	audit, ok := ma.pocAudits[modelId]
+	if !ok {
+		audit = &types.PocAllocationAudit{
+			EpochIndex:           epochIndex,
+			ModelId:              modelId,
+			EligibleParticipants: []string{},
+			SampledParticipants:  []string{},
+			Nodes:                []types.PocAllocationNodeDecision{},
+		}
+		ma.pocAudits[modelId] = audit
+	}
+	return audit
+}
+
+// resolvePendingPocDecisions marks nodes that passed eligibility filtering as allocated or not,
+// depending on whether the round-robin allocation reached them before the target weight.
+func (ma *ModelAssigner) resolvePendingPocDecisions(modelId string, eligibleNodesData *EpochMLNodeData) {
+	audit, ok := ma.pocAudits[modelId]
+	if !ok {
+		return
+	}
+	for i := range audit.Nodes {
+		decision := &audit.Nodes[i]
+		if decision.Decision != "" {
+			continue
+		}
+		decision.Decision = types.PocAllocationTargetWeightFilled
+		for _, node := range eligibleNodesData.GetForParticipant(modelId, decision.Participant) {
+			if node.NodeId == decision.NodeId && len(node.TimeslotAllocation) > 1 && node.TimeslotAllocation[1] {
+				decision.Decision = types.PocAllocationAllocated
+				break
+			}
+		}
 	}
 }
 
 // thresholdSet holds the calculated thresholds for participant and node weight filtering
 type thresholdSet struct {
-	participantMinNodeWeights map[string]int64 // per-participant minimum node weight (25% rule)
-	participantNodeCounts     map[string]int   // per-participant target node count (for uniform weights)
-	globalMaxNodeWeight       int64            // global outlier threshold (IQR method)
+	participantWeightThreshold int64            // minimum participant weight to be in the top 75% (75% rule)
+	participantMinNodeWeights  map[string]int64 // per-participant minimum node weight (25% rule)
+	participantNodeCounts      map[string]int   // per-participant target node count (for uniform weights)
+	globalMaxNodeWeight        int64            // global outlier threshold (IQR method)
 }
 
 func (ma *ModelAssigner) calculateThresholds(currentEpochData *EpochMLNodeData) thresholdSet {
@@ -499,9 +648,10 @@ func (ma *ModelAssigner) calculateThresholds(currentEpochData *EpochMLNodeData)
 	ma.LogInfo("Calculated node weight threshold (IQR method)", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "calculate_node_threshold", "threshold", globalMaxNodeWeightThreshold, "total_nodes", len(allNodesWeights))
 
 	return thresholdSet{
-		participantMinNodeWeights: participantMinNodeWeightThresholds,
-		participantNodeCounts:     participantNodeCounts,
-		globalMaxNodeWeight:       globalMaxNodeWeightThreshold,
+		participantWeightThreshold: participantWeightThreshold,
+		participantMinNodeWeights:  participantMinNodeWeightThresholds,
+		participantNodeCounts:      participantNodeCounts,
+		globalMaxNodeWeight:        globalMaxNodeWeightThreshold,
 	}
 }
 
@@ -591,12 +741,18 @@ func (ma *ModelAssigner) filterEligibleMLNodes(
 		participantNodes := currentEpochData.GetForModel(modelId)
 		sortedParticipantAddrs := sortedKeys(participantNodes)
 
+		audit := ma.pocAudit(upcomingEpoch.Index, modelId)
+		audit.ParticipantWeightThreshold = thresholds.participantWeightThreshold
+		audit.GlobalMaxNodeWeight = thresholds.globalMaxNodeWeight
+		audit.MaxAllowedNonVotingWeight = maxAllowedNonVotingWeight
+
 		var filteredParticipantAddrs []string
 		for _, addr := range sortedParticipantAddrs {
 			if eligibleParticipantAddrs[addr] {
 				filteredParticipantAddrs = append(filteredParticipantAddrs, addr)
 			}
 		}
+		audit.EligibleParticipants = append(audit.EligibleParticipants, filteredParticipantAddrs...)
 
 		// Sample N/2+1 participants with history for rotation (deterministic per epoch+model)
 		eligibleParticipantsPerModel := ma.sampleEligibleParticipantsWithHistory(
@@ -606,14 +762,33 @@ func (ma *ModelAssigner) filterEligibleMLNodes(
 			upcomingEpoch,
 			allParticipantsHashStr,
 		)
+		audit.SampledParticipants = append(audit.SampledParticipants, eligibleParticipantsPerModel...)
+		// Nodes of sampled participants are recorded below, with a decision once allocation finishes
+		sampled := make(map[string]bool, len(eligibleParticipantsPerModel))
+		for _, addr := range eligibleParticipantsPerModel {
+			sampled[addr] = true
+		}
+		for _, addr := range sortedParticipantAddrs {
+			if sampled[addr] {
+				continue
+			}
+			decision := types.PocAllocationNotSampled
+			if !eligibleParticipantAddrs[addr] {
+				decision = types.PocAllocationBelowThresholds
+			} else if previousEpochData.GetForParticipant(modelId, addr) == nil {
+				decision = types.PocAllocationNoPreviousReward
+			}
+			recordPocNodeDecisions(audit, participantNodes[addr], addr, thresholds, decision)
+		}
 
 		for _, participantAddr := range eligibleParticipantsPerModel {
 			currentNodes := participantNodes[participantAddr]
 			filteredNodes := filterNodesByThresholds(currentNodes, participantAddr, thresholds)
+			recordPocNodeDecisions(audit, excludeNodes(currentNodes, filteredNodes), participantAddr, thresholds, types.PocAllocationNodeFiltered)
 
 			// Add nodes with Phase 3 voting constraint check
 			totalParticipantWeight := currentEpochData.GetParticipantWeight(participantAddr)
-			for _, node := range filteredNodes {
+			for i, node := range filteredNodes {
 				currentParticipantWeight := eligibleNodesData.GetParticipantWeight(participantAddr)
 				eligibleNodesWeightIfAdded := currentParticipantWeight + node.PocWeight
 
@@ -627,10 +802,13 @@ func (ma *ModelAssigner) filterEligibleMLNodes(
 				if !canAllocate {
 					// Stop adding nodes for this participant - would violate constraints
 					ma.LogInfo("Stopped adding nodes due to voting constraint", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "voting_constraint_limit", "participant", participantAddr, "model_id", modelId, "total_non_voting_weight", totalNonVotingWeight, "max_allowed", maxAllowedNonVotingWeight)
+					recordPocNodeDecisions(audit, filteredNodes[i:], participantAddr, thresholds, types.PocAllocationVotingConstraint)
 					break
 				}
 				totalNonVotingWeight = updatedWeight
 				eligibleNodesData.Append(modelId, participantAddr, node)
+				// Decided after allocation, see resolvePendingPocDecisions
+				recordPocNodeDecisions(audit, filteredNodes[i:i+1], participantAddr, thresholds, "")
 			}
 		}
 	}
@@ -638,6 +816,39 @@ func (ma *ModelAssigner) filterEligibleMLNodes(
 	return eligibleNodesData
 }
 
+// recordPocNodeDecisions appends a decision for each node to the audit. An empty decision is
+// resolved once the allocation for the model has finished.
+func recordPocNodeDecisions(audit *types.PocAllocationAudit, nodes []*types.MLNodeInfo, participantAddr string, thresholds thresholdSet, decision string) {
+	effectiveThreshold := calculateEffectiveNodeThreshold(
+		thresholds.participantMinNodeWeights[participantAddr],
+		thresholds.globalMaxNodeWeight,
+	)
+	for _, node := range nodes {
+		audit.Nodes = append(audit.Nodes, types.PocAllocationNodeDecision{
+			Participant:        participantAddr,
+			NodeId:             node.NodeId,
+			PocWeight:          node.PocWeight,
+			EffectiveThreshold: effectiveThreshold,
+			Decision:           decision,
+		})
+	}
+}
+
+// excludeNodes returns the nodes that are not in the subset, preserving order
+func excludeNodes(nodes []*types.MLNodeInfo, subset []*types.MLNodeInfo) []*types.MLNodeInfo {
+	inSubset := make(map[*types.MLNodeInfo]bool, len(subset))
+	for _, node := range subset {
+		inSubset[node] = true
+	}
+	var excluded []*types.MLNodeInfo
+	for _, node := range nodes {
+		if !inSubset[node] {
+			excluded = append(excluded, node)
+		}
+	}
+	return excluded
+}
+
 // canAllocateParticipantNode checks if a node can be allocated without violating voting constraints.
 //
 // VOTING CONSTRAINTS:
@@ -690,6 +901,13 @@ func (ma *ModelAssigner) allocateMLNodePerPoCForModel(
 
 	ma.LogInfo("Calculated target weight for model", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "calculate_target_weight", "model_id", modelId, "total_weight", totalWeight, "fraction", fractionDecimal.String(), "target_weight", targetPoCWeight)
 
+	audit := ma.pocAudits[modelId]
+	if audit != nil {
+		audit.AllocationFraction = fractionDecimal.String()
+		audit.TotalWeight = totalWeight
+		audit.TargetWeight = targetPoCWeight
+	}
+
 	eligibleModelNodes := eligibleNodesData.GetForModel(modelId)
 	eligibleParticipantAddrs := sortedKeys(eligibleModelNodes)
 
@@ -700,22 +918,55 @@ func (ma *ModelAssigner) allocateMLNodePerPoCForModel(
 		return
 	}
 
+	currentWeight := ma.allocateTimeslotForModel(modelId, types.PocSlotIndex, eligibleNodesData, targetPoCWeight)
+
+	for _, participantAddr := range eligibleParticipantAddrs {
+		nodes := eligibleNodesData.GetForParticipant(modelId, participantAddr)
+		var allocatedCount int
+		var allocatedWeight int64
+		var allocatedNodeIds []string
+
+		for _, node := range nodes {
+			if len(node.TimeslotAllocation) > 1 && node.TimeslotAllocation[1] {
+				allocatedCount++
+				allocatedWeight += node.PocWeight
+				allocatedNodeIds = append(allocatedNodeIds, node.NodeId)
+			}
+		}
+
+		ma.LogInfo("Participant allocation summary", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "participant_summary", "model_id", modelId, "participant", participantAddr, "total_nodes", len(nodes), "allocated_nodes", allocatedCount, "allocated_weight", allocatedWeight, "allocated_node_ids", allocatedNodeIds)
+	}
+
+	if audit != nil {
+		audit.AllocatedWeight = currentWeight
+	}
+	ma.LogInfo("Finished allocation for model", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "model_allocation_end", "model_id", modelId, "achieved_weight", currentWeight, "target_weight", targetPoCWeight, "total_weight", totalWeight)
+}
+
+// allocateTimeslotForModel sets the timeslot for the model's nodes round robin across participants,
+// smallest node first, until targetWeight is reached or no node is left. Returns the allocated weight.
+func (ma *ModelAssigner) allocateTimeslotForModel(modelId string, slot int, nodesData *EpochMLNodeData, targetWeight int64) int64 {
+	participantAddrs := sortedKeys(nodesData.GetForModel(modelId))
+	if len(participantAddrs) == 0 {
+		return 0
+	}
+
 	var currentWeight int64
 	currentParticipantIdx := 0
 	allocatedInRound := false
 
-	for currentWeight < targetPoCWeight {
-		participantAddr := eligibleParticipantAddrs[currentParticipantIdx]
-		nodes := eligibleNodesData.GetForParticipant(modelId, participantAddr)
+	for currentWeight < targetWeight {
+		participantAddr := participantAddrs[currentParticipantIdx]
+		nodes := nodesData.GetForParticipant(modelId, participantAddr)
 
-		nextMLNode := getSmallestMLNodeWithPOCSLotFalse(nodes)
+		nextMLNode := getSmallestUnallocatedMLNode(nodes, slot)
 
 		if nextMLNode == nil {
-			currentParticipantIdx = (currentParticipantIdx + 1) % len(eligibleParticipantAddrs)
+			currentParticipantIdx = (currentParticipantIdx + 1) % len(participantAddrs)
 
 			if currentParticipantIdx == 0 {
 				if !allocatedInRound {
-					ma.LogInfo("Completed full round without allocation, exiting", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "exit_no_nodes", "model_id", modelId, "current_weight", currentWeight, "target_weight", targetPoCWeight)
+					ma.LogInfo("Completed full round without allocation, exiting", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "exit_no_nodes", "model_id", modelId, "timeslot", slot, "current_weight", currentWeight, "target_weight", targetWeight)
 					break
 				}
 				allocatedInRound = false
@@ -723,43 +974,27 @@ func (ma *ModelAssigner) allocateMLNodePerPoCForModel(
 			continue
 		}
 
-		nextMLNode.TimeslotAllocation[1] = true
+		nextMLNode.TimeslotAllocation[slot] = true
 		currentWeight += nextMLNode.PocWeight
 		allocatedInRound = true
 
-		ma.LogInfo("Allocated node to PoC slot", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "allocate_node", "model_id", modelId, "participant", participantAddr, "node_id", nextMLNode.NodeId, "node_weight", nextMLNode.PocWeight, "current_weight", currentWeight, "target_weight", targetPoCWeight)
+		ma.LogInfo("Allocated node to timeslot", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "allocate_node", "model_id", modelId, "timeslot", slot, "participant", participantAddr, "node_id", nextMLNode.NodeId, "node_weight", nextMLNode.PocWeight, "current_weight", currentWeight, "target_weight", targetWeight)
 
-		currentParticipantIdx = (currentParticipantIdx + 1) % len(eligibleParticipantAddrs)
+		currentParticipantIdx = (currentParticipantIdx + 1) % len(participantAddrs)
 
 		if currentParticipantIdx == 0 {
 			allocatedInRound = false
 		}
 	}
-
-	for _, participantAddr := range eligibleParticipantAddrs {
-		nodes := eligibleNodesData.GetForParticipant(modelId, participantAddr)
-		var allocatedCount int
-		var allocatedWeight int64
-		var allocatedNodeIds []string
-
-		for _, node := range nodes {
-			if len(node.TimeslotAllocation) > 1 && node.TimeslotAllocation[1] {
-				allocatedCount++
-				allocatedWeight += node.PocWeight
-				allocatedNodeIds = append(allocatedNodeIds, node.NodeId)
-			}
-		}
-
-		ma.LogInfo("Participant allocation summary", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "participant_summary", "model_id", modelId, "participant", participantAddr, "total_nodes", len(nodes), "allocated_nodes", allocatedCount, "allocated_weight", allocatedWeight, "allocated_node_ids", allocatedNodeIds)
-	}
-
-	ma.LogInfo("Finished allocation for model", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "model_allocation_end", "model_id", modelId, "achieved_weight", currentWeight, "target_weight", targetPoCWeight, "total_weight", totalWeight)
+	return currentWeight
 }
 
-func getSmallestMLNodeWithPOCSLotFalse(nodes []*types.MLNodeInfo) *types.MLNodeInfo {
+// getSmallestUnallocatedMLNode returns the node with the smallest weight that is not yet allocated for the timeslot.
+// Nodes whose TimeslotAllocation does not cover the timeslot are skipped.
+func getSmallestUnallocatedMLNode(nodes []*types.MLNodeInfo, slot int) *types.MLNodeInfo {
 	var smallest *types.MLNodeInfo
 	for _, node := range nodes {
-		if len(node.TimeslotAllocation) > 1 && !node.TimeslotAllocation[1] {
+		if len(node.TimeslotAllocation) > slot && !node.TimeslotAllocation[slot] {
 			if smallest == nil || node.PocWeight < smallest.PocWeight {
 				smallest = node
 			}
@@ -1059,6 +1294,11 @@ func (ma *ModelAssigner) sampleEligibleParticipantsWithHistory(
 	seedInt := int64(binary.BigEndian.Uint64(hash[:8]))
 	rng := rand.New(rand.NewSource(seedInt))
 
+	if audit, ok := ma.pocAudits[modelId]; ok {
+		audit.Seed = seed
+		audit.SeedInt = seedInt
+	}
+
 	ma.LogInfo("Generated deterministic seed for participant selection", types.Allocation, "flow_context", FlowContext, "sub_flow_context", SubFlowContext, "step", "generate_filter_seed", "model_id", modelId, "seed_string", seed, "seed_int", seedInt)
 
 	shuffledParticipants := make([]string, len(participantsWithHistory))
@@ -1098,6 +1338,82 @@ func supportedModelsByNode(hardwareNodes *types.HardwareNodes, governanceModels
 	return supportedModelsByNode
 }
 
+// verifiedHardwareAttestations returns the attestations of the participant's nodes that carry a valid
+// signature from the participant's worker key, by node id
+func (ma *ModelAssigner) verifiedHardwareAttestations(ctx context.Context, participant string, hardwareNodes *types.HardwareNodes) map[string]*types.HardwareAttestation {
+	attestations := make(map[string]*types.HardwareAttestation)
+	for _, node := range hardwareNodes.HardwareNodes {
+		attestation, relayed, err := ma.keeper.VerifyHardwareAttestation(ctx, participant, node)
+		if err != nil {
+			ma.LogWarn("Ignoring invalid hardware attestation", types.Allocation, "flow_context", FlowContext, "step", "verify_hardware", "participant_index", participant, "node_id", node.LocalId, "error", err)
+			continue
+		}
+		if relayed {
+			attestations[node.LocalId] = &attestation
+		}
+	}
+	return attestations
+}
+
+// removeModelsExceedingAttestedVRam drops the models a node cannot fit according to its verified
+// attestation, and returns the dropped models by node id. The self-declared hardware does not count.
+func removeModelsExceedingAttestedVRam(supportedModelsByNode map[string][]string, hardwareNodes *types.HardwareNodes, attestations map[string]*types.HardwareAttestation, governanceModels []*types.Model) map[string][]string {
+	modelsById := make(map[string]*types.Model, len(governanceModels))
+	for _, model := range governanceModels {
+		modelsById[model.Id] = model
+	}
+
+	rejected := make(map[string][]string)
+	for _, node := range hardwareNodes.HardwareNodes {
+		fitting := make([]string, 0, len(supportedModelsByNode[node.LocalId]))
+		for _, modelId := range supportedModelsByNode[node.LocalId] {
+			if types.AttestationFitsModel(attestations[node.LocalId], modelsById[modelId]) {
+				fitting = append(fitting, modelId)
+			} else {
+				rejected[node.LocalId] = append(rejected[node.LocalId], modelId)
+			}
+		}
+		supportedModelsByNode[node.LocalId] = fitting
+	}
+	return rejected
+}
+
+// removeNodesNotCoveredByCollateral drops the models of the nodes the participant's collateral does not
+// cover under the policy, and returns their ids. Nodes are covered in order of PoC weight, then id; a node
+// that does not fit in the remaining collateral is skipped and the next ones are still considered.
+func removeNodesNotCoveredByCollateral(supportedModelsByNode map[string][]string, hardwareNodes *types.HardwareNodes, mlNodes []*types.MLNodeInfo, policy types.CapacityCollateralPolicy, collateral mathsdk.Int) []string {
+	hardwareById := make(map[string]*types.HardwareNode, len(hardwareNodes.HardwareNodes))
+	for _, node := range hardwareNodes.HardwareNodes {
+		hardwareById[node.LocalId] = node
+	}
+
+	ordered := make([]*types.MLNodeInfo, 0, len(mlNodes))
+	for _, mlNode := range mlNodes {
+		if mlNode != nil && hardwareById[mlNode.NodeId] != nil {
+			ordered = append(ordered, mlNode)
+		}
+	}
+	slices.SortFunc(ordered, func(a, b *types.MLNodeInfo) int {
+		if a.PocWeight != b.PocWeight {
+			return cmp.Compare(b.PocWeight, a.PocWeight)
+		}
+		return strings.Compare(a.NodeId, b.NodeId)
+	})
+
+	remaining := collateral
+	var uncovered []string
+	for _, mlNode := range ordered {
+		required := policy.RequiredCollateral(hardwareById[mlNode.NodeId], mlNode.Throughput)
+		if required.GT(remaining) {
+			supportedModelsByNode[mlNode.NodeId] = nil
+			uncovered = append(uncovered, mlNode.NodeId)
+			continue
+		}
+		remaining = remaining.Sub(required)
+	}
+	return uncovered
+}
+
 func (ma *ModelAssigner) logMLNodeDedupStats(message string, stats map[string]mlNodeDedupDecision, keyvals ...interface{}) {
 	if len(stats) == 0 {
 		return
@@ -0,0 +1,196 @@
+package inference
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// assignmentFuzzCase is a random participant/node/weight configuration for model assignment and
+// PoC allocation. It is generated from a seed only, so every run of a case sees the same input.
+type assignmentFuzzCase struct {
+	keeper       *mockKeeperForModelAssigner
+	participants []*types.ActiveParticipant
+	epoch        types.Epoch
+}
+
+func newAssignmentFuzzCase(seed int64) assignmentFuzzCase {
+	r := rand.New(rand.NewSource(seed))
+
+	numModels := 1 + r.Intn(3)
+	models := make([]types.Model, numModels)
+	for i := range models {
+		models[i] = types.Model{Id: fmt.Sprintf("model-%d", i), ThroughputPerNonce: uint64(1 + r.Intn(100))}
+	}
+
+	strategy := types.ModelAssignmentStrategyGreedy
+	if r.Intn(2) == 0 {
+		strategy = types.ModelAssignmentStrategyBalanced
+	}
+
+	epoch := types.Epoch{Index: uint64(1 + r.Intn(100))}
+	hardwareNodes := make(map[string]*types.HardwareNodes)
+	settleAmounts := make(map[string]types.SettleAmount)
+	previousWeights := make(map[string][]*types.ValidationWeight)
+	var participants []*types.ActiveParticipant
+
+	numParticipants := 1 + r.Intn(12)
+	for i := 0; i < numParticipants; i++ {
+		participantId := formatParticipantID(i)
+		hw := &types.HardwareNodes{Participant: participantId}
+		var mlNodes []*types.MLNodeInfo
+		var weight int64
+		numNodes := r.Intn(8)
+		for j := 0; j < numNodes; j++ {
+			nodeId := formatNodeID(i, j)
+			var supported []string
+			for _, model := range models {
+				if r.Intn(2) == 0 {
+					supported = append(supported, model.Id)
+				}
+			}
+			// Nodes may also declare models that are not governance models
+			if r.Intn(5) == 0 {
+				supported = append(supported, "unknown-model")
+			}
+			hw.HardwareNodes = append(hw.HardwareNodes, &types.HardwareNode{LocalId: nodeId, Models: supported})
+
+			node := &types.MLNodeInfo{NodeId: nodeId, PocWeight: int64(1 + r.Intn(1000)), Throughput: int64(r.Intn(50))}
+			mlNodes = append(mlNodes, node)
+			weight += node.PocWeight
+			// Nodes are occasionally reported twice
+			if r.Intn(10) == 0 {
+				mlNodes = append(mlNodes, proto.Clone(node).(*types.MLNodeInfo))
+			}
+		}
+		r.Shuffle(len(mlNodes), func(a, b int) { mlNodes[a], mlNodes[b] = mlNodes[b], mlNodes[a] })
+
+		// Some participants have no hardware nodes registered at all
+		if r.Intn(8) != 0 {
+			hardwareNodes[participantId] = hw
+		}
+		if r.Intn(4) != 0 {
+			settleAmounts[participantId] = types.SettleAmount{Participant: participantId, EpochIndex: epoch.Index - 1, RewardCoins: uint64(r.Intn(3))}
+		}
+		for _, model := range models {
+			if r.Intn(3) == 0 || len(mlNodes) == 0 {
+				continue
+			}
+			previous := make([]*types.MLNodeInfo, 0, len(mlNodes))
+			for _, node := range mlNodes {
+				previous = append(previous, &types.MLNodeInfo{NodeId: node.NodeId, PocWeight: node.PocWeight})
+			}
+			previousWeights[model.Id] = append(previousWeights[model.Id], &types.ValidationWeight{MemberAddress: participantId, MlNodes: previous})
+		}
+
+		participants = append(participants, &types.ActiveParticipant{
+			Index:   participantId,
+			Weight:  weight,
+			MlNodes: []*types.ModelMLNodes{{MlNodes: mlNodes}},
+		})
+	}
+
+	epochGroupData := make(map[string]map[uint64]types.EpochGroupData)
+	for modelId, weights := range previousWeights {
+		epochGroupData[modelId] = map[uint64]types.EpochGroupData{epoch.Index - 1: {ValidationWeights: weights}}
+	}
+
+	return assignmentFuzzCase{
+		keeper: &mockKeeperForModelAssigner{
+			hardwareNodes:    hardwareNodes,
+			governanceModels: models,
+			epochGroupData:   epochGroupData,
+			settleAmounts:    settleAmounts,
+			strategy:         strategy,
+			params: &types.Params{
+				EpochParams: &types.EpochParams{
+					PocSlotAllocation: &types.Decimal{Value: int64(r.Intn(11)), Exponent: -1},
+				},
+			},
+		},
+		participants: participants,
+		epoch:        epoch,
+	}
+}
+
+// run performs model assignment and PoC allocation the way the epoch transition does
+func (c assignmentFuzzCase) run() ([]*types.ActiveParticipant, []types.PocAllocationAudit) {
+	ctx := context.Background()
+	modelAssigner := NewModelAssigner(c.keeper, mockLogger{})
+	modelAssigner.setModelsForParticipants(ctx, c.participants, c.epoch)
+	modelAssigner.AllocateMLNodesForPoC(ctx, c.epoch, c.participants)
+	return c.participants, modelAssigner.PocAllocationAudits()
+}
+
+func requireAssignmentInvariants(t *testing.T, c assignmentFuzzCase, participants []*types.ActiveParticipant, audits []types.PocAllocationAudit) {
+	allocatedWeight := make(map[string]int64)
+	for _, p := range participants {
+		require.Len(t, p.MlNodes, len(p.Models), "participant %s", p.Index)
+		hw, hasHardware := c.keeper.hardwareNodes[p.Index]
+		if !hasHardware {
+			require.Empty(t, p.Models, "participant %s has no hardware nodes", p.Index)
+			continue
+		}
+		supported := make(map[string][]string)
+		for _, node := range hw.HardwareNodes {
+			supported[node.LocalId] = node.Models
+		}
+
+		// Every node serves at most one model, and only a model it supports
+		assigned := make(map[string]string)
+		for i, modelId := range p.Models {
+			for _, node := range p.MlNodes[i].MlNodes {
+				previous, seen := assigned[node.NodeId]
+				require.False(t, seen, "participant %s node %s assigned to %s and %s", p.Index, node.NodeId, previous, modelId)
+				assigned[node.NodeId] = modelId
+				require.Contains(t, supported[node.NodeId], modelId, "participant %s node %s", p.Index, node.NodeId)
+				if len(node.TimeslotAllocation) > types.PocSlotIndex && node.TimeslotAllocation[types.PocSlotIndex] {
+					allocatedWeight[modelId] += node.PocWeight
+				}
+			}
+		}
+		require.Equal(t, RecalculateWeight(p), p.Weight, "participant %s", p.Index)
+	}
+
+	// PoC allocation never exceeds the weight of the model, and the audit agrees with the nodes
+	for _, audit := range audits {
+		require.LessOrEqual(t, audit.AllocatedWeight, audit.TotalWeight, "model %s", audit.ModelId)
+		require.GreaterOrEqual(t, audit.AllocatedWeight, int64(0), "model %s", audit.ModelId)
+		require.Equal(t, allocatedWeight[audit.ModelId], audit.AllocatedWeight, "model %s", audit.ModelId)
+	}
+	for modelId, weight := range allocatedWeight {
+		require.True(t, weight == 0 || slices.ContainsFunc(audits, func(a types.PocAllocationAudit) bool { return a.ModelId == modelId }),
+			"model %s allocated %d without an audit", modelId, weight)
+	}
+}
+
+// FuzzModelAssignment checks model assignment and PoC allocation on random configurations.
+// The seed corpus runs with go test; explore further with
+//
+//	go test ./x/inference/module -run '^$' -fuzz FuzzModelAssignment -fuzztime 1m
+func FuzzModelAssignment(f *testing.F) {
+	for seed := int64(0); seed < 32; seed++ {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, seed int64) {
+		c := newAssignmentFuzzCase(seed)
+		participants, audits := c.run()
+		requireAssignmentInvariants(t, c, participants, audits)
+
+		// The same input always yields the same assignment, whatever the map iteration order
+		again := newAssignmentFuzzCase(seed)
+		participantsAgain, auditsAgain := again.run()
+		require.Len(t, participantsAgain, len(participants))
+		for i := range participants {
+			require.True(t, proto.Equal(participants[i], participantsAgain[i]), "participant %s differs between runs", participants[i].Index)
+		}
+		require.Equal(t, audits, auditsAgain)
+	})
+}
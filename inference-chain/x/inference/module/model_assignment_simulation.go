@@ -0,0 +1,35 @@
+package inference
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// SimulateModelAssignment runs model assignment and PoC slot allocation for upcomingEpoch on copies
+// of the given participants and returns the hypothetical result. The ModelAssigner only reads through
+// the keeper, so this is safe to run outside block execution, e.g. against state fetched with queries.
+//
+// Collateral adjustment and power capping are not applied, so weights in the result are uncapped
+// and PoC slot allocation may differ slightly from the real epoch transition.
+func SimulateModelAssignment(ctx context.Context, keeper KeeperForModelAssigner, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) []*types.ActiveParticipant {
+	simulated := make([]*types.ActiveParticipant, 0, len(participants))
+	for _, p := range participants {
+		simulated = append(simulated, proto.Clone(p).(*types.ActiveParticipant))
+	}
+
+	modelAssigner := NewModelAssigner(keeper, discardLogger{})
+	modelAssigner.setModelsForParticipants(ctx, simulated, upcomingEpoch)
+	modelAssigner.AllocateMLNodesForPoC(ctx, upcomingEpoch, simulated)
+	return simulated
+}
+
+// discardLogger keeps simulations from flooding the node log with allocation steps
+type discardLogger struct{}
+
+func (discardLogger) LogInfo(msg string, subSystem types.SubSystem, keyvals ...interface{})  {}
+func (discardLogger) LogError(msg string, subSystem types.SubSystem, keyvals ...interface{}) {}
+func (discardLogger) LogWarn(msg string, subSystem types.SubSystem, keyvals ...interface{})  {}
+func (discardLogger) LogDebug(msg string, subSystem types.SubSystem, keyvals ...interface{}) {}
@@ -0,0 +1,50 @@
+package inference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulateModelAssignment_DoesNotModifyInput(t *testing.T) {
+	participant := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{{Id: "model-a"}, {Id: "model-b"}},
+		hardwareNodes: map[string]*types.HardwareNodes{
+			participant: {
+				Participant: participant,
+				HardwareNodes: []*types.HardwareNode{
+					{LocalId: "n1", Models: []string{"model-a"}},
+					{LocalId: "n2", Models: []string{"model-b"}},
+				},
+			},
+		},
+	}
+	participants := []*types.ActiveParticipant{
+		{
+			Index: participant,
+			MlNodes: []*types.ModelMLNodes{
+				{MlNodes: []*types.MLNodeInfo{{NodeId: "n1", PocWeight: 10}, {NodeId: "n2", PocWeight: 20}}},
+			},
+		},
+	}
+
+	result := SimulateModelAssignment(context.Background(), mockKeeper, participants, types.Epoch{Index: 1})
+
+	require.Len(t, result, 1)
+	require.Equal(t, []string{"model-a", "model-b"}, result[0].Models)
+	require.Len(t, result[0].MlNodes, 2)
+	require.Equal(t, int64(30), result[0].Weight)
+	for _, group := range result[0].MlNodes {
+		for _, node := range group.MlNodes {
+			require.Len(t, node.TimeslotAllocation, 2)
+		}
+	}
+
+	// The input is left untouched
+	require.Empty(t, participants[0].Models)
+	require.Len(t, participants[0].MlNodes, 1)
+	require.Nil(t, participants[0].MlNodes[0].MlNodes[0].TimeslotAllocation)
+}
@@ -0,0 +1,122 @@
+package inference
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/productscience/inference/x/inference/types"
+)
+
+// ModelAssignmentStrategy decides which of a participant's ML nodes serve which governance model.
+// A strategy instance lives for a single assignment run and may carry state across participants,
+// which are always processed in the same deterministic order.
+type ModelAssignmentStrategy interface {
+	// AssignNodes returns the nodes assigned to each model id, in their original order.
+	// Nodes that appear under no model stay unassigned.
+	AssignNodes(nodes []*types.MLNodeInfo, supportedModelsByNode map[string][]string, governanceModels []*types.Model) map[string][]*types.MLNodeInfo
+}
+
+func newModelAssignmentStrategy(name string) ModelAssignmentStrategy {
+	switch name {
+	case types.ModelAssignmentStrategyBalanced:
+		return &balancedModelAssignment{assignedThroughput: make(map[string]int64)}
+	default:
+		return greedyModelAssignment{}
+	}
+}
+
+// greedyModelAssignment is a first fit in governance model order: each model takes every
+// still unassigned node that supports it. Nodes supporting several models therefore always
+// end up on the earliest of them, which can strand capacity for later models.
+type greedyModelAssignment struct{}
+
+func (greedyModelAssignment) AssignNodes(nodes []*types.MLNodeInfo, supportedModelsByNode map[string][]string, governanceModels []*types.Model) map[string][]*types.MLNodeInfo {
+	result := make(map[string][]*types.MLNodeInfo)
+	assigned := make(map[string]bool)
+	for _, model := range governanceModels {
+		for _, node := range nodes {
+			if assigned[node.NodeId] {
+				continue
+			}
+			if slices.Contains(supportedModelsByNode[node.NodeId], model.Id) {
+				result[model.Id] = append(result[model.Id], node)
+				assigned[node.NodeId] = true
+			}
+		}
+	}
+	return result
+}
+
+// balancedModelAssignment is a weighted bin-packing: nodes are placed on the supported model
+// with the least throughput assigned so far in this run, across all participants.
+// The most constrained nodes (fewest supported models) and then the largest nodes are placed first,
+// so flexible nodes fill whatever capacity gaps remain.
+type balancedModelAssignment struct {
+	assignedThroughput map[string]int64 // modelId -> throughput assigned so far
+}
+
+func (b *balancedModelAssignment) AssignNodes(nodes []*types.MLNodeInfo, supportedModelsByNode map[string][]string, governanceModels []*types.Model) map[string][]*types.MLNodeInfo {
+	type candidate struct {
+		index  int
+		node   *types.MLNodeInfo
+		models []string // supported governance models, in governance order
+	}
+
+	candidates := make([]candidate, 0, len(nodes))
+	for i, node := range nodes {
+		var models []string
+		for _, model := range governanceModels {
+			if slices.Contains(supportedModelsByNode[node.NodeId], model.Id) {
+				models = append(models, model.Id)
+			}
+		}
+		if len(models) > 0 {
+			candidates = append(candidates, candidate{index: i, node: node, models: models})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if len(a.models) != len(b.models) {
+			return len(a.models) < len(b.models)
+		}
+		if ca, cb := nodeAssignmentCapacity(a.node), nodeAssignmentCapacity(b.node); ca != cb {
+			return ca > cb
+		}
+		return a.node.NodeId < b.node.NodeId
+	})
+
+	assignedIndex := make(map[string][]int)
+	for _, c := range candidates {
+		// Ties go to the earlier governance model
+		target := c.models[0]
+		for _, modelId := range c.models[1:] {
+			if b.assignedThroughput[modelId] < b.assignedThroughput[target] {
+				target = modelId
+			}
+		}
+		b.assignedThroughput[target] += nodeAssignmentCapacity(c.node)
+		assignedIndex[target] = append(assignedIndex[target], c.index)
+	}
+
+	result := make(map[string][]*types.MLNodeInfo, len(assignedIndex))
+	for modelId, indexes := range assignedIndex {
+		slices.Sort(indexes)
+		for _, i := range indexes {
+			result[modelId] = append(result[modelId], nodes[i])
+		}
+	}
+	return result
+}
+
+// nodeAssignmentCapacity is the capacity a node contributes to a model. Throughput is not
+// always declared, in which case PoC weight is the best available proxy.
+func nodeAssignmentCapacity(node *types.MLNodeInfo) int64 {
+	if node.Throughput > 0 {
+		return node.Throughput
+	}
+	if node.PocWeight > 0 {
+		return node.PocWeight
+	}
+	return 1
+}
@@ -0,0 +1,98 @@
+package inference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/productscience/inference/x/inference/types"
+	"github.com/stretchr/testify/require"
+)
+
+func nodeIds(nodes []*types.MLNodeInfo) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		ids = append(ids, n.NodeId)
+	}
+	return ids
+}
+
+func TestGreedyModelAssignment_StrandsMultiModelNodes(t *testing.T) {
+	models := []*types.Model{{Id: "model-a"}, {Id: "model-b"}}
+	nodes := []*types.MLNodeInfo{
+		{NodeId: "n1", Throughput: 100},
+		{NodeId: "n2", Throughput: 100},
+	}
+	supported := map[string][]string{
+		"n1": {"model-a", "model-b"},
+		"n2": {"model-a", "model-b"},
+	}
+
+	result := newModelAssignmentStrategy(types.ModelAssignmentStrategyGreedy).AssignNodes(nodes, supported, models)
+	require.Equal(t, []string{"n1", "n2"}, nodeIds(result["model-a"]))
+	require.Empty(t, result["model-b"])
+}
+
+func TestBalancedModelAssignment_SpreadsThroughput(t *testing.T) {
+	models := []*types.Model{{Id: "model-a"}, {Id: "model-b"}}
+	nodes := []*types.MLNodeInfo{
+		{NodeId: "n1", Throughput: 100},
+		{NodeId: "n2", Throughput: 100},
+		{NodeId: "n3", Throughput: 300},
+	}
+	supported := map[string][]string{
+		"n1": {"model-a", "model-b"},
+		"n2": {"model-a", "model-b"},
+		"n3": {"model-a"},
+	}
+
+	result := newModelAssignmentStrategy(types.ModelAssignmentStrategyBalanced).AssignNodes(nodes, supported, models)
+	// n3 only supports model-a and is placed first, so both flexible nodes go to model-b
+	require.Equal(t, []string{"n3"}, nodeIds(result["model-a"]))
+	require.Equal(t, []string{"n1", "n2"}, nodeIds(result["model-b"]))
+}
+
+func TestBalancedModelAssignment_BalancesAcrossParticipants(t *testing.T) {
+	models := []*types.Model{{Id: "model-a"}, {Id: "model-b"}}
+	strategy := newModelAssignmentStrategy(types.ModelAssignmentStrategyBalanced)
+	supported := map[string][]string{"n1": {"model-a", "model-b"}}
+
+	first := strategy.AssignNodes([]*types.MLNodeInfo{{NodeId: "n1", PocWeight: 10}}, supported, models)
+	second := strategy.AssignNodes([]*types.MLNodeInfo{{NodeId: "n1", PocWeight: 10}}, supported, models)
+
+	require.Len(t, first["model-a"], 1)
+	require.Len(t, second["model-b"], 1)
+}
+
+func TestSetModelsForParticipants_BalancedStrategy(t *testing.T) {
+	ctx := context.Background()
+	participant := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{{Id: "model-a"}, {Id: "model-b"}},
+		hardwareNodes: map[string]*types.HardwareNodes{
+			participant: {
+				Participant: participant,
+				HardwareNodes: []*types.HardwareNode{
+					{LocalId: "n1", Models: []string{"model-a", "model-b"}},
+					{LocalId: "n2", Models: []string{"model-a", "model-b"}},
+				},
+			},
+		},
+		strategy: types.ModelAssignmentStrategyBalanced,
+	}
+	participants := []*types.ActiveParticipant{
+		{
+			Index: participant,
+			MlNodes: []*types.ModelMLNodes{
+				{MlNodes: []*types.MLNodeInfo{{NodeId: "n1", PocWeight: 10}, {NodeId: "n2", PocWeight: 10}}},
+			},
+		},
+	}
+
+	NewModelAssigner(mockKeeper, mockLogger{}).setModelsForParticipants(ctx, participants, types.Epoch{Index: 1})
+
+	require.Equal(t, []string{"model-a", "model-b"}, participants[0].Models)
+	require.Len(t, participants[0].MlNodes, 2)
+	require.Equal(t, []string{"n1"}, nodeIds(participants[0].MlNodes[0].MlNodes))
+	require.Equal(t, []string{"n2"}, nodeIds(participants[0].MlNodes[1].MlNodes))
+}
@@ -2,9 +2,13 @@ package inference
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"testing"
 
+	mathsdk "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+
 	"github.com/productscience/inference/x/inference/keeper"
 
 	"github.com/productscience/inference/x/inference/types"
@@ -18,6 +22,14 @@ type mockKeeperForModelAssigner struct {
 	epochGroupData   map[string]map[uint64]types.EpochGroupData // modelId -> epochIndex -> data
 	settleAmounts    map[string]types.SettleAmount              // participant -> settle (optional; when set, participants count as rewarded for previous epoch)
 	params           *types.Params
+	strategy         string
+	timeslotSchedule *types.TimeslotSchedule
+	verifyHardware   bool
+	mlNodeVersion    string
+	versionRollout   *types.MLNodeVersionRollout
+	collateralPolicy types.CapacityCollateralPolicy
+	collateral       map[string]int64
+	workerKeys       map[string]string // participant -> base64 worker public key
 }
 
 func (m *mockKeeperForModelAssigner) GetGovernanceModelsSorted(ctx context.Context) ([]*types.Model, error) {
@@ -62,6 +74,47 @@ func (m *mockKeeperForModelAssigner) GetParams(ctx context.Context) (types.Param
 	return types.DefaultParams(), nil
 }
 
+func (m *mockKeeperForModelAssigner) GetModelAssignmentStrategy(ctx context.Context) string {
+	if m.strategy != "" {
+		return m.strategy
+	}
+	return types.DefaultModelAssignmentStrategy
+}
+
+func (m *mockKeeperForModelAssigner) GetTimeslotSchedule(ctx context.Context) types.TimeslotSchedule {
+	if m.timeslotSchedule != nil {
+		return *m.timeslotSchedule
+	}
+	return types.DefaultTimeslotSchedule()
+}
+
+func (m *mockKeeperForModelAssigner) IsHardwareVerificationRequired(ctx context.Context) bool {
+	return m.verifyHardware
+}
+
+func (m *mockKeeperForModelAssigner) GetMLNodeVersion(ctx context.Context) (types.MLNodeVersion, bool) {
+	return types.MLNodeVersion{CurrentVersion: m.mlNodeVersion}, m.mlNodeVersion != ""
+}
+
+func (m *mockKeeperForModelAssigner) GetMLNodeVersionRollout(ctx context.Context) (types.MLNodeVersionRollout, bool) {
+	if m.versionRollout != nil {
+		return *m.versionRollout, true
+	}
+	return types.MLNodeVersionRollout{}, false
+}
+
+func (m *mockKeeperForModelAssigner) GetCapacityCollateralPolicy(ctx context.Context) types.CapacityCollateralPolicy {
+	return m.collateralPolicy
+}
+
+func (m *mockKeeperForModelAssigner) GetBondedCollateral(ctx context.Context, participant string) mathsdk.Int {
+	return mathsdk.NewInt(m.collateral[participant])
+}
+
+func (m *mockKeeperForModelAssigner) VerifyHardwareAttestation(ctx context.Context, participant string, node *types.HardwareNode) (types.HardwareAttestation, bool, error) {
+	return types.VerifyNodeAttestation(node, participant, m.workerKeys[participant])
+}
+
 // Mock Logger
 type mockLogger struct{}
 
@@ -300,6 +353,124 @@ func TestSetModelsForParticipants_OneNodeOneModel(t *testing.T) {
 	assertTimeslotAllocationCount(t, modelGroup.MlNodes, []bool{true, true}, 0)  // Not allocated for PoC
 }
 
+func TestSetModelsForParticipants_HardwareVerification(t *testing.T) {
+	ctx := context.Background()
+	participantAddress := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+	smallModel := "Qwen/Qwen2.5-7B-Instruct"
+	largeModel := "Qwen/QwQ-32B"
+
+	workerKey := ed25519.GenPrivKey()
+	otherKey := ed25519.GenPrivKey()
+	attest := func(key *ed25519.PrivKey, localId string, vramGB uint64) *types.Hardware {
+		attestation := types.HardwareAttestation{Participant: participantAddress, LocalId: localId, VRamGB: vramGB}
+		signature, err := key.Sign(attestation.SignBytes())
+		require.NoError(t, err)
+		attestation.Signature = base64.StdEncoding.EncodeToString(signature)
+		hw, err := types.AttestationHardware(attestation)
+		require.NoError(t, err)
+		return hw
+	}
+
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{
+			{ProposedBy: "genesis", Id: largeModel, VRam: 80},
+			{ProposedBy: "genesis", Id: smallModel, VRam: 16},
+		},
+		hardwareNodes: map[string]*types.HardwareNodes{
+			participantAddress: {
+				Participant: participantAddress,
+				HardwareNodes: []*types.HardwareNode{
+					// over-claims: declares 80GB, but its attestation reports 48GB
+					{LocalId: "mlnode1", Models: []string{largeModel}, Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 1}, attest(workerKey, "mlnode1", 48)}},
+					{LocalId: "mlnode2", Models: []string{largeModel}, Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 79GB", Count: 2}, attest(workerKey, "mlnode2", 158)}},
+					// declares VRAM without an attestation
+					{LocalId: "mlnode3", Models: []string{smallModel}, Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 1}}},
+					// attestation not signed with the participant's worker key
+					{LocalId: "mlnode4", Models: []string{smallModel}, Hardware: []*types.Hardware{attest(otherKey, "mlnode4", 80)}},
+				},
+			},
+		},
+		verifyHardware: true,
+		workerKeys:     map[string]string{participantAddress: base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes())},
+	}
+	modelAssigner := NewModelAssigner(mockKeeper, mockLogger{})
+
+	participants := []*types.ActiveParticipant{
+		{
+			Index: participantAddress,
+			MlNodes: []*types.ModelMLNodes{
+				{
+					MlNodes: []*types.MLNodeInfo{
+						{NodeId: "mlnode1", PocWeight: 10},
+						{NodeId: "mlnode2", PocWeight: 10},
+						{NodeId: "mlnode3", PocWeight: 10},
+						{NodeId: "mlnode4", PocWeight: 10},
+					},
+				},
+			},
+		},
+	}
+
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 1})
+
+	participant := participants[0]
+	require.Equal(t, []string{largeModel}, participant.Models)
+	require.Len(t, participant.MlNodes, 1)
+	require.Len(t, participant.MlNodes[0].MlNodes, 1)
+	assertNodeInGroup(t, participant.MlNodes[0].MlNodes, "mlnode2")
+}
+
+func TestSetModelsForParticipants_CapacityCollateral(t *testing.T) {
+	ctx := context.Background()
+	participantAddress := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+	model := "Qwen/Qwen2.5-7B-Instruct"
+
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{{ProposedBy: "genesis", Id: model}},
+		hardwareNodes: map[string]*types.HardwareNodes{
+			participantAddress: {
+				Participant: participantAddress,
+				HardwareNodes: []*types.HardwareNode{
+					// requires 80 * 10 = 800
+					{LocalId: "mlnode1", Models: []string{model}, Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 1}}},
+					// requires 2 * 80 * 10 = 1600
+					{LocalId: "mlnode2", Models: []string{model}, Hardware: []*types.Hardware{{Type: "NVIDIA H100 80GB HBM3 | 80GB", Count: 2}}},
+					// no VRAM declared, requires the minimum of 100
+					{LocalId: "mlnode3", Models: []string{model}},
+				},
+			},
+		},
+		collateralPolicy: types.CapacityCollateralPolicy{CollateralPerVRamGB: 10, MinCollateralPerNode: 100},
+		collateral:       map[string]int64{participantAddress: 1000},
+	}
+	modelAssigner := NewModelAssigner(mockKeeper, mockLogger{})
+
+	participants := []*types.ActiveParticipant{
+		{
+			Index: participantAddress,
+			MlNodes: []*types.ModelMLNodes{
+				{
+					MlNodes: []*types.MLNodeInfo{
+						{NodeId: "mlnode1", PocWeight: 10},
+						{NodeId: "mlnode2", PocWeight: 20},
+						{NodeId: "mlnode3", PocWeight: 5},
+					},
+				},
+			},
+		},
+	}
+
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 1})
+
+	// mlnode2 weighs the most but does not fit in the collateral, the two others do
+	participant := participants[0]
+	require.Equal(t, []string{model}, participant.Models)
+	require.Len(t, participant.MlNodes, 1)
+	require.Len(t, participant.MlNodes[0].MlNodes, 2)
+	assertNodeInGroup(t, participant.MlNodes[0].MlNodes, "mlnode1")
+	assertNodeInGroup(t, participant.MlNodes[0].MlNodes, "mlnode3")
+}
+
 func TestSetModelsForParticipants_ManyNodesManyModels(t *testing.T) {
 	// 1. Setup
 	ctx := context.Background()
@@ -1273,6 +1444,8 @@ func TestAllocateMLNodesForPoC_FairDistribution(t *testing.T) {
 				"Distribution among eligible participants should be relatively fair")
 		}
 	}
+
+	requirePocAuditMatchesAllocation(t, modelAssigner, participants, modelID, upcomingEpoch.Index)
 }
 
 // TestAllocateMLNodesForPoC_NoReward_NoEligibleParticipants verifies that when no participants
@@ -1415,6 +1588,46 @@ func TestAllocateMLNodesForPoC_NoReward_NoEligibleParticipants(t *testing.T) {
 	require.Equal(t, 0, globalAllocatedNodes, "No nodes should have POC_SLOT=true when no participants have reward")
 	require.Equal(t, int64(0), globalAllocatedWeight, "Allocated weight should be 0 when no participants have reward")
 	require.Equal(t, 0, participantsWithAllocation, "No participant should have any POC_SLOT allocation")
+
+	audit := requirePocAuditMatchesAllocation(t, modelAssigner, participants, modelID, upcomingEpoch.Index)
+	require.Empty(t, audit.SampledParticipants)
+	for _, decision := range audit.Nodes {
+		require.Equal(t, types.PocAllocationNoPreviousReward, decision.Decision)
+	}
+}
+
+// requirePocAuditMatchesAllocation checks that the audit has exactly one decision per node
+// and that it agrees with the POC_SLOT allocation applied to the participants
+func requirePocAuditMatchesAllocation(t *testing.T, modelAssigner *ModelAssigner, participants []*types.ActiveParticipant, modelID string, epochIndex uint64) types.PocAllocationAudit {
+	audits := modelAssigner.PocAllocationAudits()
+	require.Len(t, audits, 1)
+	audit := audits[0]
+	require.Equal(t, modelID, audit.ModelId)
+	require.Equal(t, epochIndex, audit.EpochIndex)
+
+	decisions := make(map[string]string, len(audit.Nodes))
+	for _, decision := range audit.Nodes {
+		key := decision.Participant + "/" + decision.NodeId
+		require.NotContains(t, decisions, key, "duplicate decision for %s", key)
+		require.NotEmpty(t, decision.Decision)
+		decisions[key] = decision.Decision
+	}
+
+	var allocatedWeight int64
+	for _, participant := range participants {
+		for _, node := range participant.MlNodes[0].MlNodes {
+			decision, ok := decisions[participant.Index+"/"+node.NodeId]
+			require.True(t, ok, "missing decision for %s/%s", participant.Index, node.NodeId)
+			allocated := len(node.TimeslotAllocation) > 1 && node.TimeslotAllocation[1]
+			require.Equal(t, allocated, decision == types.PocAllocationAllocated)
+			if allocated {
+				allocatedWeight += node.PocWeight
+			}
+		}
+	}
+	require.Len(t, decisions, len(audit.Nodes))
+	require.Equal(t, allocatedWeight, audit.AllocatedWeight)
+	return audit
 }
 
 // Helper functions for test
@@ -2009,6 +2222,56 @@ func TestAllocateMLNodesForPoC_MixedUniformAndHeterogeneous(t *testing.T) {
 	require.LessOrEqual(t, totalAllocatedWeight, totalWeight, "Allocated weight should not exceed total")
 }
 
+func TestAllocateMLNodesForPoC_AdditionalTimeslot(t *testing.T) {
+	ctx := context.Background()
+	modelID := "model-timeslots"
+
+	schedule := types.DefaultTimeslotSchedule()
+	schedule.Timeslots = append(schedule.Timeslots, types.TimeslotSpec{Name: "TRAINING_SLOT", AllocationFraction: "0.5"})
+
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{{ProposedBy: "genesis", Id: modelID}},
+		hardwareNodes:    map[string]*types.HardwareNodes{},
+		timeslotSchedule: &schedule,
+	}
+
+	var participants []*types.ActiveParticipant
+	for _, addr := range []string{"participant-1", "participant-2"} {
+		mockKeeper.hardwareNodes[addr] = &types.HardwareNodes{
+			Participant: addr,
+			HardwareNodes: []*types.HardwareNode{
+				{LocalId: "node-a", Models: []string{modelID}},
+				{LocalId: "node-b", Models: []string{modelID}},
+				{LocalId: "node-c", Models: []string{modelID}},
+			},
+		}
+		participants = append(participants, &types.ActiveParticipant{
+			Index: addr,
+			MlNodes: []*types.ModelMLNodes{{MlNodes: []*types.MLNodeInfo{
+				{NodeId: "node-a", PocWeight: 10},
+				{NodeId: "node-b", PocWeight: 20},
+				{NodeId: "node-c", PocWeight: 30},
+			}}},
+		})
+	}
+
+	modelAssigner := NewModelAssigner(mockKeeper, mockLogger{})
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 1})
+	modelAssigner.AllocateMLNodesForPoC(ctx, types.Epoch{Index: 1}, participants)
+
+	// Target is half of the model weight (60 of 120), filled round robin with the smallest nodes first
+	for _, participant := range participants {
+		require.Len(t, participant.MlNodes, 1)
+		allocated := make(map[string]bool)
+		for _, node := range participant.MlNodes[0].MlNodes {
+			require.Len(t, node.TimeslotAllocation, 3)
+			require.True(t, node.TimeslotAllocation[types.PrePocSlotIndex])
+			allocated[node.NodeId] = node.TimeslotAllocation[2]
+		}
+		require.Equal(t, map[string]bool{"node-a": true, "node-b": true, "node-c": false}, allocated, participant.Index)
+	}
+}
+
 func TestDedupMLNodesById(t *testing.T) {
 	nodes := []*types.MLNodeInfo{
 		{NodeId: "node-b", PocWeight: 10, Throughput: 100},
@@ -2128,3 +2391,49 @@ func TestAllocateMLNodesForPoC_DedupesBeforeAllocation(t *testing.T) {
 	})
 	require.Equal(t, int64(50), participants[0].MlNodes[0].MlNodes[0].PocWeight)
 }
+
+func TestSetModelsForParticipants_MLNodeVersionRollout(t *testing.T) {
+	ctx := context.Background()
+	modelID := "Qwen/Qwen2.5-7B-Instruct"
+	mockKeeper := &mockKeeperForModelAssigner{
+		governanceModels: []types.Model{{Id: modelID, VRam: 16, ThroughputPerNonce: 1000}},
+		hardwareNodes:    map[string]*types.HardwareNodes{},
+		mlNodeVersion:    "v3.0.8",
+	}
+	var participants []*types.ActiveParticipant
+	for _, address := range []string{"participant-b", "participant-a"} {
+		var hardwareNodes []*types.HardwareNode
+		var mlNodes []*types.MLNodeInfo
+		for i := 0; i < 4; i++ {
+			nodeId := fmt.Sprintf("%s-node-%d", address, i)
+			hardwareNodes = append(hardwareNodes, &types.HardwareNode{LocalId: nodeId, Models: []string{modelID}})
+			mlNodes = append(mlNodes, &types.MLNodeInfo{NodeId: nodeId, PocWeight: 10})
+		}
+		mockKeeper.hardwareNodes[address] = &types.HardwareNodes{Participant: address, HardwareNodes: hardwareNodes}
+		participants = append(participants, &types.ActiveParticipant{
+			Index:   address,
+			Models:  []string{modelID},
+			MlNodes: []*types.ModelMLNodes{{MlNodes: mlNodes}},
+		})
+	}
+
+	modelAssigner := NewModelAssigner(mockKeeper, mockLogger{})
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 7})
+	require.Nil(t, modelAssigner.MLNodeVersionAssignment())
+
+	rollout := types.MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 50}
+	mockKeeper.versionRollout = &rollout
+	modelAssigner.setModelsForParticipants(ctx, participants, types.Epoch{Index: 8})
+	assignment := modelAssigner.MLNodeVersionAssignment()
+	require.NotNil(t, assignment)
+	require.Equal(t, uint64(8), assignment.EpochIndex)
+	require.Equal(t, "v3.0.8", assignment.CurrentVersion)
+	require.Len(t, assignment.Participants, 2)
+	require.Equal(t, "participant-a", assignment.Participants[0].Participant)
+	for _, p := range assignment.Participants {
+		require.Len(t, p.NodeIds, 2)
+		for _, nodeId := range p.NodeIds {
+			require.Equal(t, "v3.1.0", assignment.VersionFor(p.Participant, nodeId))
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strconv"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -132,6 +133,9 @@ func NewAppModule(
 	groupMsgServer types.GroupMessageKeeper,
 	collateralKeeper types.CollateralKeeper,
 ) AppModule {
+	keeper.SetModelAssignmentSimulator(func(ctx context.Context, participants []*types.ActiveParticipant, upcomingEpoch types.Epoch) []*types.ActiveParticipant {
+		return SimulateModelAssignment(ctx, keeper, participants, upcomingEpoch)
+	})
 	return AppModule{
 		AppModuleBasic:   NewAppModuleBasic(cdc),
 		keeper:           keeper,
@@ -149,7 +153,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization. It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) {
@@ -221,9 +227,11 @@ func (am AppModule) expireInferenceAndIssueRefund(ctx context.Context, inference
 	inference.Status = types.InferenceStatus_EXPIRED
 	inference.ActualCost = 0
 
-	err := am.keeper.IssueRefund(ctx, inference.EscrowAmount, inference.RequestedBy, "expired_inference:"+inference.InferenceId)
+	err := am.keeper.IssueInferenceRefund(ctx, inference.InferenceId, inference.EscrowAmount, inference.RequestedBy, "expired_inference:"+inference.InferenceId)
 	if err != nil {
 		am.LogError("Error issuing refund", types.Inferences, "error", err)
+	} else if err := am.keeper.RefundInferenceEscrow(ctx, inference.InferenceId, inference.EscrowAmount); err != nil {
+		am.LogError("Error settling inference escrow", types.Inferences, "error", err)
 	}
 
 	err = am.keeper.SetInference(ctx, inference)
@@ -231,6 +239,16 @@ func (am AppModule) expireInferenceAndIssueRefund(ctx context.Context, inference
 		am.LogError("Error updating inference", types.Inferences, "error", err)
 	}
 
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInferenceExpired,
+			sdk.NewAttribute(types.AttributeKeyInferenceId, inference.InferenceId),
+			sdk.NewAttribute(types.AttributeKeyRequester, inference.RequestedBy),
+			sdk.NewAttribute(types.AttributeKeyExecutor, inference.AssignedTo),
+			sdk.NewAttribute(types.AttributeKeyModelId, inference.Model),
+			sdk.NewAttribute(types.AttributeKeyAmount, strconv.FormatInt(inference.EscrowAmount, 10)),
+		))
+
 	return inference
 }
 
@@ -325,13 +343,12 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		am.LogError("Unable to get parameters", types.Settle, "error", err.Error())
 		return err
 	}
-	epochParams := params.EpochParams
 	currentEpoch, found := am.keeper.GetEffectiveEpoch(ctx)
 	if !found || currentEpoch == nil {
 		am.LogError("Unable to get effective epoch", types.EpochGroup, "blockHeight", blockHeight)
 		return nil
 	}
-	epochContext, err := types.NewEpochContextFromEffectiveEpoch(*currentEpoch, *epochParams, blockHeight)
+	epochContext, err := am.keeper.GetEffectiveEpochContext(ctx, *currentEpoch, blockHeight)
 	if err != nil {
 		am.LogError("Unable to create epoch context", types.EpochGroup, "error", err.Error())
 		return nil
@@ -343,7 +360,8 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		return nil
 	}
 
-	timeouts := am.keeper.GetAllInferenceTimeoutForHeight(ctx, uint64(blockHeight))
+	// Timeouts of earlier heights are left over when a previous EndBlock returned before reaching this point
+	timeouts := am.keeper.GetInferenceTimeoutsUpToHeight(ctx, uint64(blockHeight))
 	err = am.expireInferences(ctx, timeouts, blockHeight, currentEpoch, &params)
 	if err != nil {
 		am.LogError("Error expiring inferences", types.Inferences)
@@ -352,6 +370,11 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		am.keeper.RemoveInferenceTimeout(ctx, t.ExpirationHeight, t.InferenceId)
 	}
 
+	err = am.keeper.CheckValidationCircuitBreaker(ctx, currentEpoch.Index)
+	if err != nil {
+		am.LogError("Error checking validation circuit breaker", types.Validation, "error", err.Error())
+	}
+
 	err = am.keeper.Prune(ctx, int64(currentEpoch.Index))
 	if err != nil {
 		am.LogError("Error during pruning", types.Pruning, "error", err.Error())
@@ -371,12 +394,25 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 	partialUpgrades := am.keeper.GetAllPartialUpgrade(ctx)
 	for _, pu := range partialUpgrades {
 		if pu.Height == uint64(blockHeight) {
-			if pu.NodeVersion != "" {
+			if rollout, isRollout, err := types.ParseMLNodeVersionRollout(pu.NodeVersion, pu.ApiBinariesJson); isRollout {
+				am.LogInfo("PartialUpgradeActive - staging MLNode version rollout", types.Upgrades,
+					"partialUpgradeHeight", pu.Height, "blockHeight", blockHeight, "nodeVersion", pu.NodeVersion, "canaryPercent", rollout.CanaryPercent)
+				if err == nil {
+					err = am.keeper.SetMLNodeVersionRollout(ctx, rollout)
+				}
+				if err != nil {
+					am.LogError("Failed to set MLNode version rollout", types.Upgrades, "error", err)
+				}
+			} else if pu.NodeVersion != "" {
 				am.LogInfo("PartialUpgradeActive - updating current MLNode version", types.Upgrades,
 					"partialUpgradeHeight", pu.Height, "blockHeight", blockHeight, "nodeVersion", pu.NodeVersion)
 				am.keeper.SetMLNodeVersion(ctx, types.MLNodeVersion{
 					CurrentVersion: pu.NodeVersion,
 				})
+				// Switching all nodes supersedes a staged rollout
+				if err := am.keeper.MLNodeVersionRollout.Remove(ctx); err != nil {
+					am.LogError("Failed to clear MLNode version rollout", types.Upgrades, "error", err)
+				}
 			}
 
 			// Track last upgrade height
@@ -409,6 +445,8 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 		}
 	}
 
+	am.amendEpochGroupWithLateJoiners(ctx, blockHeight)
+
 	if epochContext.IsStartOfPocStage(blockHeight) {
 		upcomingEpoch := createNewEpoch(*currentEpoch, blockHeight)
 		err = am.keeper.SetEpoch(ctx, upcomingEpoch)
@@ -416,6 +454,10 @@ func (am AppModule) EndBlock(ctx context.Context) error {
 			am.LogError("Unable to set upcoming epoch", types.EpochGroup, "error", err.Error())
 			return err
 		}
+		if err := am.keeper.RecordEpochParams(ctx, upcomingEpoch.Index); err != nil {
+			am.LogError("Unable to record epoch params of upcoming epoch", types.EpochGroup, "error", err.Error())
+			return err
+		}
 
 		am.LogInfo("StartStage:PocStart", types.Stages, "blockHeight", blockHeight)
 		newGroup, err := am.keeper.CreateEpochGroup(ctx, uint64(blockHeight), upcomingEpoch.Index)
@@ -521,6 +563,9 @@ func (am AppModule) onEndOfPoCValidationStage(ctx context.Context, blockHeight i
 	if err != nil {
 		am.LogError("onEndOfPoCValidationStage: Unable to settle accounts", types.Settle, "error", err.Error())
 	}
+	if err := am.keeper.ReleaseInferenceEscrows(ctx, effectiveEpoch.Index); err != nil {
+		am.LogError("onEndOfPoCValidationStage: Unable to release inference escrows", types.Payments, "error", err.Error())
+	}
 
 	upcomingEpoch, found := am.keeper.GetUpcomingEpoch(ctx)
 	if !found || upcomingEpoch == nil {
@@ -555,11 +600,36 @@ func (am AppModule) onEndOfPoCValidationStage(ctx context.Context, blockHeight i
 		// which means participants will proceed with their unadjusted PotentialWeight.
 	}
 
+	// Slash weight for recent offenses and leave jailed participants out of the epoch group
+	activeParticipants = am.keeper.ApplySlashingPolicy(ctx, upcomingEpoch.Index, activeParticipants)
+
+	// Leave exiting participants out once their last epoch is over and unbond their collateral
+	activeParticipants = am.keeper.ApplyParticipantExits(ctx, upcomingEpoch.Index, activeParticipants)
+
+	// Add the weight of delegated stake on top of the PoC weight
+	activeParticipants = am.keeper.ApplyDelegations(ctx, upcomingEpoch.Index, activeParticipants)
+
 	// Apply universal power capping to epoch powers
 	activeParticipants = am.applyEpochPowerCapping(ctx, activeParticipants)
 
 	modelAssigner.AllocateMLNodesForPoC(ctx, *upcomingEpoch, activeParticipants)
 	am.LogInfo("Finished PoC allocation for all participants", types.EpochGroup, "step", "poc_allocation_complete")
+	if err := am.keeper.SetPocAllocationAudits(ctx, upcomingEpoch.Index, modelAssigner.PocAllocationAudits()); err != nil {
+		am.LogError("onEndOfPoCValidationStage: Unable to store PoC allocation audits", types.Allocation, "error", err.Error())
+	}
+	if assignment := modelAssigner.MLNodeVersionAssignment(); assignment != nil {
+		if err := am.keeper.SetMLNodeVersionAssignment(ctx, *assignment); err != nil {
+			am.LogError("onEndOfPoCValidationStage: Unable to store MLNode version assignment", types.Allocation, "error", err.Error())
+		}
+	}
+
+	// Late joiners are validated and allocated with everyone else, but join the epoch group only at the amendment height
+	upcomingEpochContext, err := am.keeper.GetEpochContext(ctx, *upcomingEpoch)
+	if err != nil {
+		am.LogError("onEndOfPoCValidationStage: Unable to get upcoming epoch context", types.EpochGroup, "error", err.Error())
+		return
+	}
+	activeParticipants = am.keeper.DeferLateJoiners(ctx, &upcomingEpochContext, activeParticipants, params.PocParams.PocV2Enabled)
 
 	err = am.RegisterTopMiners(ctx, activeParticipants, blockTime)
 	if err != nil {
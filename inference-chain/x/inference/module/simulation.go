@@ -144,8 +144,26 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	// Every account is a participant and there is one model, so the inference operations can run
+	participants := make([]types.Participant, len(accs))
+	for i, acc := range accs {
+		participants[i] = types.Participant{
+			Index:             acc,
+			Address:           acc,
+			Status:            types.ParticipantStatus_ACTIVE,
+			CurrentEpochStats: types.NewCurrentEpochStats(),
+		}
+	}
 	inferenceGenesis := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:            types.DefaultParams(),
+		GenesisOnlyParams: types.DefaultGenesisOnlyParams(),
+		ParticipantList:   participants,
+		ModelList: []types.Model{{
+			ProposedBy:             "genesis",
+			Id:                     "sim/model",
+			UnitsOfComputePerToken: 1000,
+			ValidationThreshold:    &types.Decimal{Value: 85, Exponent: -2},
+		}},
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&inferenceGenesis) //nolint:forbidigo // Simulation code
@@ -166,7 +184,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgStartInference,
-		inferencesimulation.SimulateMsgStartInference(am.accountKeeper, am.bankKeeper, am.keeper),
+		inferencesimulation.SimulateMsgStartInference(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	var weightMsgFinishInference int
@@ -177,7 +195,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgFinishInference,
-		inferencesimulation.SimulateMsgFinishInference(am.accountKeeper, am.bankKeeper, am.keeper),
+		inferencesimulation.SimulateMsgFinishInference(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	var weightMsgSubmitNewParticipant int
@@ -199,7 +217,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgValidation,
-		inferencesimulation.SimulateMsgValidation(am.accountKeeper, am.bankKeeper, am.keeper),
+		inferencesimulation.SimulateMsgValidation(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	var weightMsgSubmitNewUnfundedParticipant int
@@ -243,7 +261,7 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
 		weightMsgClaimRewards,
-		inferencesimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper),
+		inferencesimulation.SimulateMsgClaimRewards(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
 	var weightMsgSubmitPocBatch int
@@ -467,7 +485,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgStartInference,
 			defaultWeightMsgStartInference,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				inferencesimulation.SimulateMsgStartInference(am.accountKeeper, am.bankKeeper, am.keeper)
+				inferencesimulation.SimulateMsgStartInference(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
@@ -475,7 +493,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgFinishInference,
 			defaultWeightMsgFinishInference,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				inferencesimulation.SimulateMsgFinishInference(am.accountKeeper, am.bankKeeper, am.keeper)
+				inferencesimulation.SimulateMsgFinishInference(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
@@ -491,7 +509,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgValidation,
 			defaultWeightMsgValidation,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				inferencesimulation.SimulateMsgValidation(am.accountKeeper, am.bankKeeper, am.keeper)
+				inferencesimulation.SimulateMsgValidation(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
@@ -523,7 +541,7 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 			opWeightMsgClaimRewards,
 			defaultWeightMsgClaimRewards,
 			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
-				inferencesimulation.SimulateMsgClaimRewards(am.accountKeeper, am.bankKeeper, am.keeper)
+				inferencesimulation.SimulateMsgClaimRewards(simState.TxConfig, am.accountKeeper, am.bankKeeper, am.keeper)
 				return nil
 			},
 		),
@@ -65,10 +65,17 @@ func (am AppModule) RegisterTopMiners(ctx context.Context, participants []*types
 			if err != nil {
 				return err
 			}
+			if err := am.keeper.RecordTopRewardDisbursement(ctx, typedAction.Miner, typedAction.Payout, time); err != nil {
+				am.LogError("Unable to record top reward disbursement", types.Tokenomics, "address", typedAction.Miner.Address, "error", err)
+			}
 		}
 	}
 	if payoutSettings.FirstQualifiedTime == 0 && minerFound {
 		am.updateTopMinerFirstQualified(ctx, time)
+		payoutSettings.FirstQualifiedTime = time
+	}
+	if err := am.keeper.UpdateTopRewardProgress(ctx, payoutSettings, time); err != nil {
+		am.LogError("Unable to update top reward progress", types.Tokenomics, "error", err)
 	}
 	return nil
 }
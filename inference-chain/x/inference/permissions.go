@@ -20,6 +20,7 @@ var InferenceOperationKeyPerms = []sdk.Msg{
 	&types.MsgFinishInference{},
 	&types.MsgClaimRewards{},
 	&types.MsgValidation{},
+	&types.MsgValidationBatch{},
 	&types.MsgSubmitPocBatch{},
 	&types.MsgSubmitPocValidation{},
 	&types.MsgSubmitPocValidationsV2{},   // PoC v2 validations
@@ -1,29 +1,45 @@
 package simulation
 
 import (
-	"github.com/productscience/inference/x/inference/keeper"
-	"github.com/productscience/inference/x/inference/types"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
 )
 
+// SimulateMsgClaimRewards claims the settle amount of a participant after its epoch settled
 func SimulateMsgClaimRewards(
+	txGen client.TxConfig,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgClaimRewards{
-			Creator: simAccount.Address.String(),
+		var claimable []types.SettleAmount
+		for _, settleAmount := range k.GetAllSettleAmount(ctx) {
+			if _, found := FindAccount(accs, settleAmount.Participant); found {
+				claimable = append(claimable, settleAmount)
+			}
 		}
+		if len(claimable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgClaimRewards{}), "no settle amounts"), nil, nil
+		}
+		settleAmount := claimable[r.Intn(len(claimable))]
+		claimant, _ := FindAccount(accs, settleAmount.Participant)
 
-		// TODO: Handling the ClaimRewards simulation
-
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "ClaimRewards simulation not implemented"), nil, nil
+		msg := &types.MsgClaimRewards{
+			Creator:    settleAmount.Participant,
+			Seed:       r.Int63(),
+			EpochIndex: settleAmount.EpochIndex,
+		}
+		return deliverIfAccepted(r, app, ctx, txGen, ak, bk, claimant, msg, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).ClaimRewards(ctx, msg)
+			return err
+		})
 	}
 }
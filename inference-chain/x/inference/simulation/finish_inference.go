@@ -4,26 +4,72 @@ import (
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// SimulateMsgFinishInference finishes a signed inference before it is started, as happens when the
+// executor's message lands first, and schedules the start a block later
 func SimulateMsgFinishInference(
+	txGen client.TxConfig,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgFinishInference{
-			Creator: simAccount.Address.String(),
+		inf, err := randomSimInference(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgFinishInference{}), err.Error()), nil, nil
 		}
+		opMsg, _, err := simulateFinish(r, app, ctx, txGen, ak, bk, k, inf)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+		return opMsg, []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1,
+			Op:          simulateStartOf(txGen, ak, bk, k, inf),
+		}}, nil
+	}
+}
 
-		// TODO: Handling the FinishInference simulation
+func simulateFinish(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	inf *simInference,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg := inf.finishMsg(r)
+	return deliverIfAccepted(r, app, ctx, txGen, ak, bk, inf.executor, msg, func(ctx sdk.Context) error {
+		_, err := keeper.NewMsgServerImpl(k).FinishInference(ctx, msg)
+		return err
+	})
+}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "FinishInference simulation not implemented"), nil, nil
+// simulateFinishOf finishes a started inference and schedules its validation
+func simulateFinishOf(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	inf *simInference,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		opMsg, _, err := simulateFinish(r, app, ctx, txGen, ak, bk, k, inf)
+		if err != nil || !opMsg.OK {
+			return opMsg, nil, err
+		}
+		return opMsg, []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(3),
+			Op:          simulateValidationOf(txGen, ak, bk, k, inf.inferenceId),
+		}}, nil
 	}
 }
@@ -1,8 +1,16 @@
 package simulation
 
 import (
+	"encoding/base64"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/productscience/inference/x/inference/keeper"
+	"github.com/productscience/inference/x/inference/types"
 )
 
 // FindAccount find a specific address from an account list
@@ -14,3 +22,58 @@ func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, boo
 	}
 	return simtypes.FindAccount(accs, creator)
 }
+
+// participantAccounts returns the simulation accounts that are registered participants
+func participantAccounts(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) []simtypes.Account {
+	var participants []simtypes.Account
+	for _, acc := range accs {
+		if _, found := k.GetParticipant(ctx, acc.Address.String()); found {
+			participants = append(participants, acc)
+		}
+	}
+	return participants
+}
+
+// accountSigner signs payloads with the key of a simulation account, as the API node does with its keyring
+type accountSigner struct {
+	account simtypes.Account
+}
+
+func (s accountSigner) SignBytes(data []byte) (string, error) {
+	signature, err := s.account.PrivKey.Sign(data)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// deliverIfAccepted delivers msg signed by sender, unless dryRun fails on a cached context. A
+// transaction the message server rejects would abort the whole simulation, so expected rejections
+// (an inference of a pruned epoch, a validator outside the epoch group, ...) become no-ops.
+func deliverIfAccepted(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	sender simtypes.Account,
+	msg sdk.Msg,
+	dryRun func(ctx sdk.Context) error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	if err := dryRun(cacheCtx); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+	}
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:             r,
+		App:           app,
+		TxGen:         txGen,
+		Msg:           msg,
+		Context:       ctx,
+		SimAccount:    sender,
+		AccountKeeper: ak,
+		Bankkeeper:    bk,
+		ModuleName:    types.ModuleName,
+	})
+}
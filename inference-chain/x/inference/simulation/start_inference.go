@@ -1,29 +1,181 @@
 package simulation
 
 import (
+	"encoding/hex"
+	"errors"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/productscience/inference/x/inference/calculations"
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// simInference is an inference request signed the way the API nodes sign it, so that its start and
+// finish messages pass signature verification
+type simInference struct {
+	dev               simtypes.Account
+	transferAgent     simtypes.Account
+	executor          simtypes.Account
+	model             string
+	inferenceId       string
+	promptHash        string
+	originalHash      string
+	timestamp         int64
+	transferSignature string
+	executorSignature string
+	promptTokens      uint64
+	completionTokens  uint64
+}
+
+// randomSimInference signs an inference between random participants for a random model
+func randomSimInference(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (*simInference, error) {
+	participants := participantAccounts(ctx, k, accs)
+	if len(participants) == 0 {
+		return nil, errors.New("no participants")
+	}
+	models, err := k.GetGovernanceModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, errors.New("no models")
+	}
+	return newSimInference(r, participants, models[r.Intn(len(models))].Id, ctx.BlockTime().UnixNano())
+}
+
+func newSimInference(r *rand.Rand, participants []simtypes.Account, model string, timestamp int64) (*simInference, error) {
+	inf := &simInference{
+		dev:              participants[r.Intn(len(participants))],
+		transferAgent:    participants[r.Intn(len(participants))],
+		executor:         participants[r.Intn(len(participants))],
+		model:            model,
+		promptHash:       randomHash(r),
+		originalHash:     randomHash(r),
+		timestamp:        timestamp,
+		promptTokens:     uint64(1 + r.Intn(2000)),
+		completionTokens: uint64(1 + r.Intn(2000)),
+	}
+	devComponents := calculations.SignatureComponents{
+		Payload:         inf.originalHash,
+		Timestamp:       inf.timestamp,
+		TransferAddress: inf.transferAgent.Address.String(),
+	}
+	taComponents := calculations.SignatureComponents{
+		Payload:         inf.promptHash,
+		Timestamp:       inf.timestamp,
+		TransferAddress: inf.transferAgent.Address.String(),
+		ExecutorAddress: inf.executor.Address.String(),
+	}
+	var err error
+	// The developer signature is the inference id
+	if inf.inferenceId, err = calculations.Sign(accountSigner{inf.dev}, devComponents, calculations.Developer); err != nil {
+		return nil, err
+	}
+	if inf.transferSignature, err = calculations.Sign(accountSigner{inf.transferAgent}, taComponents, calculations.TransferAgent); err != nil {
+		return nil, err
+	}
+	if inf.executorSignature, err = calculations.Sign(accountSigner{inf.executor}, taComponents, calculations.ExecutorAgent); err != nil {
+		return nil, err
+	}
+	return inf, nil
+}
+
+func randomHash(r *rand.Rand) string {
+	b := make([]byte, 32)
+	r.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (inf *simInference) startMsg() *types.MsgStartInference {
+	return &types.MsgStartInference{
+		Creator:            inf.transferAgent.Address.String(),
+		InferenceId:        inf.inferenceId,
+		PromptHash:         inf.promptHash,
+		Model:              inf.model,
+		RequestedBy:        inf.dev.Address.String(),
+		AssignedTo:         inf.executor.Address.String(),
+		MaxTokens:          calculations.DefaultMaxTokens,
+		PromptTokenCount:   inf.promptTokens,
+		RequestTimestamp:   inf.timestamp,
+		TransferSignature:  inf.transferSignature,
+		OriginalPromptHash: inf.originalHash,
+	}
+}
+
+func (inf *simInference) finishMsg(r *rand.Rand) *types.MsgFinishInference {
+	return &types.MsgFinishInference{
+		Creator:              inf.executor.Address.String(),
+		InferenceId:          inf.inferenceId,
+		ResponseHash:         randomHash(r),
+		PromptTokenCount:     inf.promptTokens,
+		CompletionTokenCount: inf.completionTokens,
+		ExecutedBy:           inf.executor.Address.String(),
+		TransferredBy:        inf.transferAgent.Address.String(),
+		RequestTimestamp:     inf.timestamp,
+		TransferSignature:    inf.transferSignature,
+		ExecutorSignature:    inf.executorSignature,
+		RequestedBy:          inf.dev.Address.String(),
+		Model:                inf.model,
+		PromptHash:           inf.promptHash,
+		OriginalPromptHash:   inf.originalHash,
+	}
+}
+
+// SimulateMsgStartInference starts a signed inference and schedules its finish a few blocks later.
+// Some inferences are never finished, so that they expire and their escrow is refunded.
 func SimulateMsgStartInference(
+	txGen client.TxConfig,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgStartInference{
-			Creator: simAccount.Address.String(),
+		inf, err := randomSimInference(r, ctx, k, accs)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgStartInference{}), err.Error()), nil, nil
 		}
+		opMsg, _, err := simulateStart(r, app, ctx, txGen, ak, bk, k, inf)
+		if err != nil || !opMsg.OK || r.Intn(10) == 0 {
+			return opMsg, nil, err
+		}
+		return opMsg, []simtypes.FutureOperation{{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(5),
+			Op:          simulateFinishOf(txGen, ak, bk, k, inf),
+		}}, nil
+	}
+}
 
-		// TODO: Handling the StartInference simulation
+func simulateStart(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	inf *simInference,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msg := inf.startMsg()
+	return deliverIfAccepted(r, app, ctx, txGen, ak, bk, inf.transferAgent, msg, func(ctx sdk.Context) error {
+		_, err := keeper.NewMsgServerImpl(k).StartInference(ctx, msg)
+		return err
+	})
+}
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "StartInference simulation not implemented"), nil, nil
+func simulateStartOf(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	inf *simInference,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		return simulateStart(r, app, ctx, txGen, ak, bk, k, inf)
 	}
 }
@@ -0,0 +1,45 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	"github.com/productscience/inference/x/inference/calculations"
+)
+
+func TestSimInferenceIsSigned(t *testing.T) {
+	sdk.GetConfig().SetBech32PrefixForAccount("gonka", "gonkapub")
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	inf, err := newSimInference(r, accs, "sim/model", 1_700_000_000_000_000_000)
+	require.NoError(t, err)
+	start := inf.startMsg()
+	require.NoError(t, start.ValidateBasic())
+	finish := inf.finishMsg(r)
+	require.NoError(t, finish.ValidateBasic())
+	require.Equal(t, start.InferenceId, finish.InferenceId)
+
+	pubKey := func(acc simtypes.Account) string {
+		return base64.StdEncoding.EncodeToString(acc.PubKey.Bytes())
+	}
+	devComponents := calculations.SignatureComponents{
+		Payload:         start.OriginalPromptHash,
+		Timestamp:       start.RequestTimestamp,
+		TransferAddress: start.Creator,
+	}
+	require.NoError(t, calculations.ValidateSignature(devComponents, calculations.Developer, pubKey(inf.dev), start.InferenceId))
+	taComponents := calculations.SignatureComponents{
+		Payload:         finish.PromptHash,
+		Timestamp:       finish.RequestTimestamp,
+		TransferAddress: finish.TransferredBy,
+		ExecutorAddress: finish.ExecutedBy,
+	}
+	require.NoError(t, calculations.ValidateSignature(taComponents, calculations.TransferAgent, pubKey(inf.transferAgent), start.TransferSignature))
+	require.NoError(t, calculations.ValidateSignature(taComponents, calculations.ExecutorAgent, pubKey(inf.executor), finish.ExecutorSignature))
+}
@@ -1,29 +1,91 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/productscience/inference/x/inference/keeper"
 	"github.com/productscience/inference/x/inference/types"
 )
 
+// maxValidationCandidates bounds how many stored inferences a validation operation looks at
+const maxValidationCandidates = 100
+
+// SimulateMsgValidation validates a finished inference
 func SimulateMsgValidation(
+	txGen client.TxConfig,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgValidation{
-			Creator: simAccount.Address.String(),
+		it, err := k.Inferences.Iterate(ctx, nil)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgValidation{}), err.Error()), nil, err
+		}
+		defer it.Close()
+		var candidates []string
+		for ; it.Valid() && len(candidates) < maxValidationCandidates; it.Next() {
+			inference, err := it.Value()
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgValidation{}), err.Error()), nil, err
+			}
+			if inference.Status == types.InferenceStatus_FINISHED {
+				candidates = append(candidates, inference.InferenceId)
+			}
 		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgValidation{}), "no finished inferences"), nil, nil
+		}
+		return simulateValidationOf(txGen, ak, bk, k, candidates[r.Intn(len(candidates))])(r, app, ctx, accs, chainID)
+	}
+}
 
-		// TODO: Handling the Validation simulation
+// simulateValidationOf validates an inference by a participant other than its executor. One in ten
+// validations fails, which starts an invalidation vote.
+func simulateValidationOf(
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	inferenceId string,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		inference, found := k.GetInference(ctx, inferenceId)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgValidation{}), "inference not found"), nil, nil
+		}
+		var validators []simtypes.Account
+		for _, acc := range participantAccounts(ctx, k, accs) {
+			if acc.Address.String() != inference.ExecutedBy {
+				validators = append(validators, acc)
+			}
+		}
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgValidation{}), "no validators"), nil, nil
+		}
+		validator := validators[r.Intn(len(validators))]
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Validation simulation not implemented"), nil, nil
+		value := 1.0
+		if r.Intn(10) == 0 {
+			value = 0
+		}
+		msg := &types.MsgValidation{
+			Creator:      validator.Address.String(),
+			Id:           fmt.Sprintf("%s-%d", inferenceId, ctx.BlockHeight()),
+			InferenceId:  inferenceId,
+			ResponseHash: inference.ResponseHash,
+			Value:        value,
+		}
+		return deliverIfAccepted(r, app, ctx, txGen, ak, bk, validator, msg, func(ctx sdk.Context) error {
+			_, err := keeper.NewMsgServerImpl(k).Validation(ctx, msg)
+			return err
+		})
 	}
 }
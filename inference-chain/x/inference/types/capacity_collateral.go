@@ -0,0 +1,38 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// CapacityCollateralPolicy requires participants to bond collateral in proportion to the capacity of the
+// ML nodes they register. At model assignment the nodes of a participant are covered by its collateral in
+// order of PoC weight; the nodes its collateral does not cover are left out of the epoch. Amounts are in
+// BaseCoin. A policy with all amounts zero, the default, requires no collateral.
+type CapacityCollateralPolicy struct {
+	// CollateralPerVRamGB is required per GB of VRAM declared for a node
+	CollateralPerVRamGB uint64 `json:"collateral_per_vram_gb"`
+	// CollateralPerThroughput is required per unit of throughput of a node
+	CollateralPerThroughput uint64 `json:"collateral_per_throughput"`
+	// MinCollateralPerNode is required at least for every node, including nodes without a VRAM declaration
+	MinCollateralPerNode uint64 `json:"min_collateral_per_node"`
+}
+
+func DefaultCapacityCollateralPolicy() CapacityCollateralPolicy {
+	return CapacityCollateralPolicy{}
+}
+
+func (p CapacityCollateralPolicy) Enabled() bool {
+	return p.CollateralPerVRamGB > 0 || p.CollateralPerThroughput > 0 || p.MinCollateralPerNode > 0
+}
+
+// RequiredCollateral is the collateral a node needs, from its declared VRAM and its throughput
+func (p CapacityCollateralPolicy) RequiredCollateral(node *HardwareNode, throughput int64) math.Int {
+	required := math.ZeroInt()
+	if vram, declared := DeclaredVRamGB(node); declared {
+		required = required.Add(math.NewIntFromUint64(vram).Mul(math.NewIntFromUint64(p.CollateralPerVRamGB)))
+	}
+	if throughput > 0 {
+		required = required.Add(math.NewInt(throughput).Mul(math.NewIntFromUint64(p.CollateralPerThroughput)))
+	}
+	return math.MaxInt(required, math.NewIntFromUint64(p.MinCollateralPerNode))
+}
@@ -113,6 +113,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRemoveParticipantsFromAllowList{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgInitiateExit{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDelegateToParticipant{},
+		&MsgUndelegate{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgValidationBatch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdatePolicy{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
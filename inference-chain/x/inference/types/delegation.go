@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+)
+
+const (
+	// MaxDelegationStakeBoostPermille caps the weight stake can add at 10x the PoC weight
+	MaxDelegationStakeBoostPermille uint32 = 10000
+	DelegationPermille              uint32 = 1000
+)
+
+// DelegationPolicy lets token holders delegate stake to compute participants. Delegated stake adds weight
+// on top of a participant's PoC weight, capped as a fraction of it, and delegators share the part of the
+// participant's reward earned by that boost, minus the participant's commission. The default policy,
+// without StakePerWeight, disables delegation.
+type DelegationPolicy struct {
+	// StakePerWeight is the amount of delegated stake, in BaseCoin, that adds one unit of weight
+	StakePerWeight uint64 `json:"stake_per_weight"`
+	// MaxStakeBoostPermille caps the weight added by stake, in permille of the PoC weight
+	MaxStakeBoostPermille uint32 `json:"max_stake_boost_permille"`
+	// CommissionPermille is the part of the delegators' reward share kept by the participant
+	CommissionPermille uint32 `json:"commission_permille"`
+}
+
+func DefaultDelegationPolicy() DelegationPolicy {
+	return DelegationPolicy{}
+}
+
+func (p DelegationPolicy) Enabled() bool {
+	return p.StakePerWeight > 0 && p.MaxStakeBoostPermille > 0
+}
+
+func (p DelegationPolicy) Validate() error {
+	if p.MaxStakeBoostPermille > MaxDelegationStakeBoostPermille {
+		return fmt.Errorf("max stake boost must be at most %d permille, got %d", MaxDelegationStakeBoostPermille, p.MaxStakeBoostPermille)
+	}
+	if p.CommissionPermille > DelegationPermille {
+		return fmt.Errorf("commission must be at most %d permille, got %d", DelegationPermille, p.CommissionPermille)
+	}
+	return nil
+}
+
+// Total is the stake delegated to the participant
+func (d ParticipantDelegations) Total() uint64 {
+	var total uint64
+	for _, delegation := range d.Delegations {
+		total += delegation.Amount
+	}
+	return total
+}
+
+// Amount is the stake the delegator delegated to the participant
+func (d ParticipantDelegations) Amount(delegator string) uint64 {
+	for _, delegation := range d.Delegations {
+		if delegation.Delegator == delegator {
+			return delegation.Amount
+		}
+	}
+	return 0
+}
+
+// Add changes the stake of the delegator by amount, which is negative to take stake back, keeping
+// the delegations ordered by delegator address and dropping those left without stake
+func (d *ParticipantDelegations) Add(delegator string, amount int64) {
+	i := sort.Search(len(d.Delegations), func(i int) bool { return d.Delegations[i].Delegator >= delegator })
+	if i == len(d.Delegations) || d.Delegations[i].Delegator != delegator {
+		if amount <= 0 {
+			return
+		}
+		d.Delegations = append(d.Delegations, nil)
+		copy(d.Delegations[i+1:], d.Delegations[i:])
+		d.Delegations[i] = &Delegation{Delegator: delegator}
+	}
+	updated := int64(d.Delegations[i].Amount) + amount
+	if updated <= 0 {
+		d.Delegations = append(d.Delegations[:i], d.Delegations[i+1:]...)
+		return
+	}
+	d.Delegations[i].Amount = uint64(updated)
+}
+
+// DelegationPolicyFullKey returns the store key of the delegation policy, for raw store queries
+func DelegationPolicyFullKey() []byte {
+	return DelegationPolicyPrefix.Bytes()
+}
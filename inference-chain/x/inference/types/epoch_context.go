@@ -24,27 +24,26 @@ func NewEpochContext(epoch Epoch, params EpochParams) EpochContext {
 
 // NewEpochContextFromEffectiveEpoch determines the most up-to-date Epoch context based on the current block height.
 func NewEpochContextFromEffectiveEpoch(epoch Epoch, epochParams EpochParams, currentBlockHeight int64) (*EpochContext, error) {
+	return NewEpochContextFromEffectiveEpochParams(epoch, epochParams, epochParams, currentBlockHeight)
+}
+
+// NewEpochContextFromEffectiveEpochParams is NewEpochContextFromEffectiveEpoch for an effective epoch and a next
+// epoch running on different params. The next epoch starts where the effective epoch's params end it, and its
+// phases follow its own params.
+func NewEpochContextFromEffectiveEpochParams(epoch Epoch, epochParams EpochParams, nextEpochParams EpochParams, currentBlockHeight int64) (*EpochContext, error) {
 	ec := NewEpochContext(epoch, epochParams)
 	nextEc := NewEpochContext(
 		Epoch{
 			Index:               epoch.Index + 1,
 			PocStartBlockHeight: ec.NextPoCStart(),
 		},
-		epochParams,
+		nextEpochParams,
 	)
 	if currentBlockHeight < ec.NextPoCStart() &&
 		currentBlockHeight > ec.SetNewValidators() {
-		return &EpochContext{
-			EpochIndex:          epoch.Index,
-			PocStartBlockHeight: epoch.PocStartBlockHeight,
-			EpochParams:         epochParams,
-		}, nil
+		return &ec, nil
 	} else if currentBlockHeight <= nextEc.SetNewValidators() {
-		return &EpochContext{
-			EpochIndex:          epoch.Index + 1,
-			PocStartBlockHeight: ec.NextPoCStart(),
-			EpochParams:         epochParams,
-		}, nil
+		return &nextEc, nil
 	} else {
 		// This is a special case where the current block height is beyond the expected range.
 		// It should not happen in normal operation, but we handle it gracefully.
@@ -52,7 +51,8 @@ func NewEpochContextFromEffectiveEpoch(epoch Epoch, epochParams EpochParams, cur
 	}
 }
 
-// TODO: rework when you implement setting new EpochParams at next epoch?
+// NextEpochContext predicts the context of the next epoch. Its start is exact, but its phases assume it
+// runs on the same params: epoch params changed through governance only apply once the next epoch starts.
 func (ec *EpochContext) NextEpochContext() EpochContext {
 	return EpochContext{
 		EpochIndex:          ec.EpochIndex + 1,
@@ -253,3 +253,36 @@ func TestProdBug(t *testing.T) {
 
 	require.True(t, ec.IsValidationExchangeWindow(57))
 }
+
+func TestEffectiveEpochWithChangedParams(t *testing.T) {
+	epochParams := types.EpochParams{
+		EpochLength:           100,
+		EpochMultiplier:       1,
+		PocStageDuration:      20,
+		PocExchangeDuration:   1,
+		PocValidationDelay:    2,
+		PocValidationDuration: 10,
+		SetNewValidatorsDelay: 1,
+	}
+	nextEpochParams := epochParams
+	nextEpochParams.EpochLength = 200
+	nextEpochParams.PocStageDuration = 40
+
+	epoch := types.Epoch{Index: 1, PocStartBlockHeight: 100}
+	current := types.NewEpochContext(epoch, epochParams)
+
+	// The effective epoch keeps its own params until the next one starts
+	ec, err := types.NewEpochContextFromEffectiveEpochParams(epoch, epochParams, nextEpochParams, current.SetNewValidators()+1)
+	require.NoError(t, err)
+	require.Equal(t, epoch.Index, ec.EpochIndex)
+	require.Equal(t, int64(200), ec.NextPoCStart())
+
+	// The next epoch starts where the effective epoch ends, and runs on its own params
+	ec, err = types.NewEpochContextFromEffectiveEpochParams(epoch, epochParams, nextEpochParams, current.NextPoCStart())
+	require.NoError(t, err)
+	require.Equal(t, epoch.Index+1, ec.EpochIndex)
+	require.Equal(t, current.NextPoCStart(), ec.PocStartBlockHeight)
+	require.Equal(t, nextEpochParams, ec.EpochParams)
+	require.Equal(t, types.PoCGeneratePhase, ec.GetCurrentPhase(ec.PocStartBlockHeight+30))
+	require.Equal(t, int64(400), ec.NextPoCStart())
+}
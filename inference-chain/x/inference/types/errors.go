@@ -69,4 +69,22 @@ var (
 	ErrNotSupported                          = sdkerrors.Register(ModuleName, 1163, "operation not supported in current mode")
 	ErrInvalidAddress                        = sdkerrors.Register(ModuleName, 1164, "invalid address")
 	ErrTransferAgentNotAllowlisted           = sdkerrors.Register(ModuleName, 1165, "transfer agent not in allowlist")
+	ErrTooManyValidationsInTx                = sdkerrors.Register(ModuleName, 1166, "too many validation messages in a single transaction")
+	ErrDuplicateValidationInTx               = sdkerrors.Register(ModuleName, 1167, "duplicate validation message in a single transaction")
+	ErrTrainingVerificationNotFound          = sdkerrors.Register(ModuleName, 1168, "training verification not found")
+	ErrTrainingVerificationExists            = sdkerrors.Register(ModuleName, 1169, "training task is already being verified")
+	ErrTrainingVerificationResolved          = sdkerrors.Register(ModuleName, 1170, "training verification already resolved")
+	ErrNotTrainingAttester                   = sdkerrors.Register(ModuleName, 1171, "not a sampled attester of this training task")
+	ErrDuplicateTrainingAttestation          = sdkerrors.Register(ModuleName, 1172, "training attestation already submitted")
+	ErrPocSubmittedOnTime                    = sdkerrors.Register(ModuleName, 1173, "PoC was submitted on time and cannot be extended in the late-join window")
+	ErrMaxTokensExceeded                     = sdkerrors.Register(ModuleName, 1174, "completion token count exceeds the max tokens of the inference")
+	ErrEpochParamsNotFound                   = sdkerrors.Register(ModuleName, 1175, "epoch params not found")
+	ErrInvalidMLNodeVersionRollout           = sdkerrors.Register(ModuleName, 1176, "invalid MLNode version rollout")
+	ErrTrainingDatasetNotFound               = sdkerrors.Register(ModuleName, 1177, "training dataset not registered")
+	ErrTrainingDatasetExists                 = sdkerrors.Register(ModuleName, 1178, "training dataset already registered")
+	ErrParticipantAlreadyExiting             = sdkerrors.Register(ModuleName, 1179, "participant is already exiting")
+	ErrInvalidHardwareAttestation            = sdkerrors.Register(ModuleName, 1180, "invalid hardware attestation")
+	ErrDelegationDisabled                    = sdkerrors.Register(ModuleName, 1181, "delegation is disabled")
+	ErrInsufficientDelegation                = sdkerrors.Register(ModuleName, 1182, "insufficient delegated stake")
+	ErrUnknownPolicy                         = sdkerrors.Register(ModuleName, 1183, "unknown governance policy")
 )
@@ -0,0 +1,151 @@
+package types
+
+// Settlement events are emitted once per participant at claim time
+const (
+	EventTypeSettlementClaimed = "settlement_claimed"
+
+	AttributeKeyParticipant  = "participant"
+	AttributeKeyEpochIndex   = "epoch_index"
+	AttributeKeyWorkCoins    = "work_coins"
+	AttributeKeyRewardCoins  = "reward_coins"
+	AttributeKeySubsidyShare = "subsidy_share"
+	AttributeKeyEpochSubsidy = "epoch_subsidy"
+	AttributeKeyStatus       = "status"
+
+	SettlementStatusPaid          = "paid"
+	SettlementStatusRewardsFailed = "rewards_failed"
+)
+
+// Pricing events are emitted whenever dynamic pricing changes a model's per-token price
+const (
+	EventTypeModelPriceUpdated = "model_price_updated"
+
+	AttributeKeyModelId     = "model_id"
+	AttributeKeyOldPrice    = "old_price"
+	AttributeKeyNewPrice    = "new_price"
+	AttributeKeyUtilization = "utilization"
+	AttributeKeyPriceSource = "price_source"
+
+	PriceSourceDynamic = "dynamic"
+	PriceSourceGrace   = "grace"
+	PriceSourceBase    = "base"
+)
+
+// Slashing events are emitted at epoch group formation by the SlashingPolicy
+const (
+	EventTypeParticipantWeightSlashed = "participant_weight_slashed"
+	EventTypeParticipantJailed        = "participant_jailed"
+	EventTypeParticipantUnjailed      = "participant_unjailed"
+
+	AttributeKeyOffenses       = "offenses"
+	AttributeKeySlashFraction  = "slash_fraction"
+	AttributeKeyOriginalWeight = "original_weight"
+	AttributeKeySlashedWeight  = "slashed_weight"
+	AttributeKeyReleaseEpoch   = "release_epoch"
+)
+
+// Participant exit events. Initiated is emitted on the exit request; exited when the participant's last
+// epoch is settled, it is left out of the epoch group being formed and its collateral starts unbonding;
+// completed once the collateral is released.
+const (
+	EventTypeParticipantExitInitiated = "participant_exit_initiated"
+	EventTypeParticipantExited        = "participant_exited"
+	EventTypeParticipantExitCompleted = "participant_exit_completed"
+
+	AttributeKeyLastEpoch        = "last_epoch"
+	AttributeKeyCollateral       = "collateral"
+	AttributeKeyUnclaimedRewards = "unclaimed_rewards"
+)
+
+// Training verification events, emitted when attesters are sampled and when attestations settle a verification
+const (
+	EventTypeTrainingVerificationStarted  = "training_verification_started"
+	EventTypeTrainingAttestationSubmitted = "training_attestation_submitted"
+	EventTypeTrainingVerificationResolved = "training_verification_resolved"
+
+	AttributeKeyTaskId        = "task_id"
+	AttributeKeyCheckpointUri = "checkpoint_uri"
+	AttributeKeyAttesters     = "attesters"
+	AttributeKeyAttester      = "attester"
+	AttributeKeyPassed        = "passed"
+	AttributeKeyScore         = "score"
+)
+
+// Training dataset registry events
+const (
+	EventTypeTrainingDatasetRegistered = "training_dataset_registered"
+
+	AttributeKeyDatasetName = "dataset_name"
+	AttributeKeyDatasetHash = "dataset_hash"
+)
+
+// Validation circuit breaker events. The tripped event is an alert for governance: invalidation effects
+// are paused for the rest of the epoch, which usually means a systemic validation bug needs a look.
+const (
+	EventTypeValidationCircuitBreakerTripped = "validation_circuit_breaker_tripped"
+
+	AttributeKeyFailureRate       = "failure_rate"
+	AttributeKeyMaxFailureRate    = "max_failure_rate"
+	AttributeKeyPassedValidations = "passed_validations"
+	AttributeKeyFailedValidations = "failed_validations"
+)
+
+// Late join events. A late joiner is announced at epoch group formation and joins at the amendment height.
+const (
+	EventTypeParticipantLateJoined = "participant_late_joined"
+	EventTypeEpochGroupAmended     = "epoch_group_amended"
+
+	AttributeKeyPenalizedWeight = "penalized_weight"
+	AttributeKeyAmendmentHeight = "amendment_height"
+	AttributeKeyLateJoiners     = "late_joiners"
+)
+
+// Payments in registered denoms. The native amount is the BaseCoin value the denom amount converts to at the escrow rate.
+const (
+	EventTypeInferencePaymentEscrowed = "inference_payment_escrowed"
+	EventTypeInferencePaymentRefunded = "inference_payment_refunded"
+
+	AttributeKeyInferenceId  = "inference_id"
+	AttributeKeyDenom        = "denom"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyNativeAmount = "native_amount"
+)
+
+// Inference escrow settlement. Released escrows carry the executor and its payment, refunded ones the amount refunded.
+const (
+	EventTypeInferenceEscrowReleased = "inference_escrow_released"
+	EventTypeInferenceEscrowRefunded = "inference_escrow_refunded"
+)
+
+// Inferences not finished within ExpirationBlocks of their start. The escrow is refunded to the requester,
+// the API uses the event to answer the clients still waiting with a timeout.
+const (
+	EventTypeInferenceExpired = "inference_expired"
+
+	AttributeKeyRequester = "requester"
+	AttributeKeyExecutor  = "executor"
+)
+
+// Stake delegated to a participant or taken back, and the rewards paid to delegators at settlement
+const (
+	EventTypeStakeDelegated      = "stake_delegated"
+	EventTypeStakeUndelegated    = "stake_undelegated"
+	EventTypeDelegatorRewardPaid = "delegator_reward_paid"
+
+	AttributeKeyDelegator = "delegator"
+)
+
+// Validation batches emit one event per item, so the API can tell which validations of a batch were rejected
+const (
+	EventTypeValidationBatchItem = "validation_batch_item"
+
+	AttributeKeyAccepted = "accepted"
+	AttributeKeyError    = "error"
+)
+
+// Governance policy updates, emitted by MsgUpdatePolicy
+const (
+	EventTypePolicyUpdated = "policy_updated"
+
+	AttributeKeyPolicy = "policy"
+)
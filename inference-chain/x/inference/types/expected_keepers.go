@@ -81,6 +81,7 @@ type CollateralKeeper interface {
 	AdvanceEpoch(ctx context.Context, completedEpoch uint64) error
 	GetCollateral(ctx context.Context, participant sdk.AccAddress) (collateral sdk.Coin, found bool)
 	Slash(ctx context.Context, participant sdk.AccAddress, slashFraction math.LegacyDec, reason string) (sdk.Coin, error)
+	UnbondAllCollateral(ctx context.Context, participant sdk.AccAddress) (sdk.Coin, uint64, error)
 }
 
 // StreamVestingKeeper defines the expected interface for the StreamVesting module.
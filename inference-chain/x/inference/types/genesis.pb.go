@@ -89,6 +89,22 @@ type GenesisState struct {
 	ParticipantList []Participant   `protobuf:"bytes,5,rep,name=participant_list,json=participantList,proto3" json:"participant_list"`
 	MlnodeVersion   *MLNodeVersion  `protobuf:"bytes,6,opt,name=mlnode_version,json=mlnodeVersion,proto3" json:"mlnode_version,omitempty"`
 	Bridge          *Bridge         `protobuf:"bytes,7,opt,name=bridge,proto3" json:"bridge,omitempty"`
+	// participant_exit_list holds the exits in progress, until the collateral of each is released
+	ParticipantExitList        []ParticipantExit        `protobuf:"bytes,8,rep,name=participant_exit_list,json=participantExitList,proto3" json:"participant_exit_list"`
+	ParticipantDelegationsList []ParticipantDelegations `protobuf:"bytes,9,rep,name=participant_delegations_list,json=participantDelegationsList,proto3" json:"participant_delegations_list"`
+	// epoch_delegation_list holds the delegations of the epochs not settled yet
+	EpochDelegationList         []EpochDelegation         `protobuf:"bytes,10,rep,name=epoch_delegation_list,json=epochDelegationList,proto3" json:"epoch_delegation_list"`
+	DelegationUnbondingList     []DelegationUnbonding     `protobuf:"bytes,11,rep,name=delegation_unbonding_list,json=delegationUnbondingList,proto3" json:"delegation_unbonding_list"`
+	InferencePaymentList        []InferencePayment        `protobuf:"bytes,12,rep,name=inference_payment_list,json=inferencePaymentList,proto3" json:"inference_payment_list"`
+	DenomTreasuryList           []DenomTreasury           `protobuf:"bytes,13,rep,name=denom_treasury_list,json=denomTreasuryList,proto3" json:"denom_treasury_list"`
+	ParticipantDenomBalanceList []ParticipantDenomBalance `protobuf:"bytes,14,rep,name=participant_denom_balance_list,json=participantDenomBalanceList,proto3" json:"participant_denom_balance_list"`
+	InferenceEscrowList         []InferenceEscrow         `protobuf:"bytes,15,rep,name=inference_escrow_list,json=inferenceEscrowList,proto3" json:"inference_escrow_list"`
+	ReleasableEscrowList        []ReleasableEscrow        `protobuf:"bytes,16,rep,name=releasable_escrow_list,json=releasableEscrowList,proto3" json:"releasable_escrow_list"`
+	RewardVestingAccountList    []RewardVestingAccount    `protobuf:"bytes,17,rep,name=reward_vesting_account_list,json=rewardVestingAccountList,proto3" json:"reward_vesting_account_list"`
+	// settle_carryover_list holds the unclaimed settle amounts still claimable after their epoch
+	SettleCarryoverList []SettleAmount `protobuf:"bytes,18,rep,name=settle_carryover_list,json=settleCarryoverList,proto3" json:"settle_carryover_list"`
+	// policy_list holds the governance policies that were set, training datasets as one entry each
+	PolicyList []PolicyEntry `protobuf:"bytes,19,rep,name=policy_list,json=policyList,proto3" json:"policy_list"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -174,6 +190,90 @@ func (m *GenesisState) GetBridge() *Bridge {
 	return nil
 }
 
+func (m *GenesisState) GetParticipantExitList() []ParticipantExit {
+	if m != nil {
+		return m.ParticipantExitList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantDelegationsList() []ParticipantDelegations {
+	if m != nil {
+		return m.ParticipantDelegationsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpochDelegationList() []EpochDelegation {
+	if m != nil {
+		return m.EpochDelegationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegationUnbondingList() []DelegationUnbonding {
+	if m != nil {
+		return m.DelegationUnbondingList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInferencePaymentList() []InferencePayment {
+	if m != nil {
+		return m.InferencePaymentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomTreasuryList() []DenomTreasury {
+	if m != nil {
+		return m.DenomTreasuryList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParticipantDenomBalanceList() []ParticipantDenomBalance {
+	if m != nil {
+		return m.ParticipantDenomBalanceList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInferenceEscrowList() []InferenceEscrow {
+	if m != nil {
+		return m.InferenceEscrowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReleasableEscrowList() []ReleasableEscrow {
+	if m != nil {
+		return m.ReleasableEscrowList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardVestingAccountList() []RewardVestingAccount {
+	if m != nil {
+		return m.RewardVestingAccountList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSettleCarryoverList() []SettleAmount {
+	if m != nil {
+		return m.SettleCarryoverList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPolicyList() []PolicyEntry {
+	if m != nil {
+		return m.PolicyList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CosmWasmParams)(nil), "inference.inference.CosmWasmParams")
 	proto.RegisterType((*GenesisState)(nil), "inference.inference.GenesisState")
@@ -182,46 +282,70 @@ func init() {
 func init() { proto.RegisterFile("inference/inference/genesis.proto", fileDescriptor_ba05d339ce8ae856) }
 
 var fileDescriptor_ba05d339ce8ae856 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x4f, 0x13, 0x4f,
-	0x14, 0xc7, 0xbb, 0xd0, 0x5f, 0x7f, 0x32, 0x20, 0xc2, 0xe2, 0xa1, 0x29, 0x49, 0x59, 0x21, 0x6a,
-	0xc5, 0xa4, 0x55, 0x88, 0x1e, 0x3c, 0x68, 0x52, 0x0e, 0x86, 0x04, 0x45, 0xd7, 0x88, 0x89, 0x97,
-	0xc9, 0xb0, 0x33, 0x2c, 0x13, 0x77, 0xe6, 0x6d, 0x66, 0x66, 0xc1, 0xfe, 0x17, 0xfe, 0x03, 0x26,
-	0x1e, 0x3d, 0xfa, 0x67, 0x70, 0xe4, 0xe8, 0xc9, 0x18, 0x38, 0xe8, 0x9f, 0x61, 0x3a, 0xbb, 0x5b,
-	0x0a, 0x4e, 0xd7, 0x4b, 0x33, 0xdd, 0xf7, 0x79, 0xdf, 0x79, 0xef, 0xcd, 0xf7, 0xa1, 0x5b, 0x5c,
-	0x1e, 0x30, 0xc5, 0x64, 0xc4, 0x7a, 0x17, 0xa7, 0x98, 0x49, 0xa6, 0xb9, 0xee, 0xa6, 0x0a, 0x0c,
-	0xf8, 0x4b, 0xa3, 0x40, 0x77, 0x74, 0x6a, 0x2d, 0x12, 0xc1, 0x25, 0xf4, 0xec, 0x6f, 0xce, 0xb5,
-	0x6e, 0xc6, 0x10, 0x83, 0x3d, 0xf6, 0x86, 0xa7, 0xe2, 0x6b, 0xe0, 0xba, 0x20, 0x25, 0x8a, 0x88,
-	0x42, 0xbf, 0xb5, 0xe6, 0x22, 0x2e, 0xee, 0xcc, 0xa1, 0xdb, 0x13, 0x64, 0x0c, 0x8f, 0x78, 0x4a,
-	0xa4, 0x29, 0xb0, 0x75, 0x17, 0xc6, 0x52, 0x88, 0x0e, 0x71, 0xac, 0x20, 0x4b, 0x31, 0x25, 0x86,
-	0x14, 0xec, 0x5d, 0x17, 0xab, 0x99, 0x31, 0x09, 0xc3, 0x44, 0x40, 0x36, 0x12, 0x7d, 0xf8, 0x2f,
-	0xd1, 0x23, 0x92, 0x70, 0x4a, 0x0c, 0x07, 0x59, 0xf6, 0x74, 0xcf, 0x95, 0x62, 0xe0, 0x03, 0x93,
-	0x20, 0x78, 0xa4, 0xc7, 0xcb, 0x58, 0x71, 0xa1, 0x02, 0x28, 0x4b, 0xaa, 0xe6, 0x63, 0x20, 0xc5,
-	0x82, 0x4b, 0xa6, 0x0a, 0xe8, 0x7e, 0xe5, 0x10, 0xb1, 0xe1, 0x82, 0x41, 0x56, 0x36, 0xf4, 0xb8,
-	0x1a, 0xbe, 0x68, 0x07, 0x53, 0x66, 0x08, 0x4f, 0xca, 0xae, 0x36, 0x27, 0x0f, 0x22, 0x65, 0xea,
-	0x00, 0x94, 0x20, 0xc3, 0x7c, 0x9d, 0x09, 0x41, 0xd4, 0xa0, 0x6a, 0x14, 0xf6, 0xe5, 0x48, 0x82,
-	0xb3, 0x34, 0x56, 0x84, 0xb2, 0x2a, 0xaf, 0xec, 0x2b, 0x4e, 0xe3, 0x92, 0xe8, 0x38, 0x87, 0x95,
-	0x48, 0xa0, 0x0c, 0x1f, 0x31, 0xa5, 0x39, 0xc8, 0x9c, 0x5c, 0x7d, 0x8b, 0xe6, 0xb7, 0x40, 0x8b,
-	0x77, 0x44, 0x8b, 0x57, 0xd6, 0x6d, 0xfe, 0x32, 0x9a, 0x89, 0x8e, 0x37, 0x1e, 0xe0, 0x08, 0x28,
-	0x6b, 0x7a, 0x81, 0xd7, 0x99, 0x0b, 0xaf, 0x0d, 0x3f, 0x6c, 0x01, 0x65, 0x7e, 0x80, 0xe6, 0x46,
-	0x41, 0xcc, 0x69, 0x73, 0x2a, 0xf0, 0x3a, 0xf5, 0x10, 0x95, 0xf1, 0x6d, 0xfa, 0xa4, 0xfe, 0xfb,
-	0xcb, 0x8a, 0xb7, 0xfa, 0xb9, 0x8e, 0xe6, 0x9e, 0xe7, 0xeb, 0xf1, 0xc6, 0x10, 0xc3, 0xfc, 0xa7,
-	0xa8, 0x91, 0xbb, 0xd9, 0x4a, 0xce, 0x6e, 0x2c, 0x77, 0x1d, 0xeb, 0xd2, 0xcd, 0x4b, 0xe8, 0xcf,
-	0x9c, 0xfc, 0x58, 0xa9, 0x7d, 0xfd, 0xf5, 0x6d, 0xdd, 0x0b, 0x8b, 0x2c, 0x9f, 0xa0, 0xa5, 0x62,
-	0xdd, 0x30, 0xc8, 0x64, 0x80, 0x0b, 0xb1, 0x29, 0x2b, 0x76, 0xc7, 0x29, 0x56, 0xdc, 0xbf, 0x2b,
-	0x93, 0xc1, 0xdf, 0xba, 0x8b, 0xf1, 0xd5, 0xa8, 0xff, 0x0c, 0x21, 0xeb, 0x27, 0x9c, 0x70, 0x6d,
-	0x9a, 0xd3, 0xc1, 0x74, 0x67, 0x76, 0xa3, 0xe5, 0x54, 0x7e, 0x31, 0xc4, 0xfa, 0xf5, 0xa1, 0x5a,
-	0x38, 0x63, 0x73, 0x76, 0xb8, 0x36, 0xfe, 0x6b, 0xb4, 0x10, 0x81, 0x16, 0xf8, 0x98, 0x68, 0x51,
-	0x16, 0x58, 0xb7, 0x05, 0xae, 0x39, 0x65, 0x2e, 0x0f, 0xbe, 0x3f, 0xd5, 0xf4, 0xc2, 0xf9, 0xe8,
-	0xf2, 0x63, 0xec, 0xa1, 0x85, 0xb1, 0xed, 0xcd, 0x2b, 0xfb, 0xcf, 0x56, 0x16, 0x4c, 0x1a, 0x60,
-	0x09, 0x8f, 0x77, 0x7b, 0x63, 0x4c, 0xc4, 0x96, 0xba, 0x8d, 0xe6, 0x2f, 0xdb, 0xa1, 0xd9, 0xb0,
-	0x85, 0xae, 0xba, 0xfb, 0xdd, 0x79, 0x09, 0x94, 0xed, 0xe5, 0x64, 0x78, 0x3d, 0xcf, 0x2c, 0xfe,
-	0xfa, 0x9b, 0xa8, 0x91, 0x7b, 0xaf, 0xf9, 0x7f, 0xc5, 0xcb, 0xf6, 0x2d, 0x12, 0x16, 0x68, 0x7f,
-	0xf7, 0xe4, 0xac, 0xed, 0x9d, 0x9e, 0xb5, 0xbd, 0x9f, 0x67, 0x6d, 0xef, 0xd3, 0x79, 0xbb, 0x76,
-	0x7a, 0xde, 0xae, 0x7d, 0x3f, 0x6f, 0xd7, 0xde, 0x3f, 0x8a, 0xb9, 0x39, 0xcc, 0xf6, 0xbb, 0x11,
-	0x88, 0x5e, 0xaa, 0x80, 0x66, 0x91, 0xd1, 0x11, 0xbf, 0x62, 0xe5, 0x8f, 0xe3, 0x2b, 0x3e, 0x48,
-	0x99, 0xde, 0x6f, 0x58, 0x3b, 0x6f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x87, 0x6c, 0x15,
-	0xa9, 0x05, 0x00, 0x00,
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0x4d, 0x6f, 0x1c, 0x35,
+	0x18, 0xc7, 0xb3, 0x69, 0x08, 0x8d, 0xf3, 0xd2, 0x64, 0x36, 0x81, 0x25, 0x41, 0x9b, 0x6d, 0xda,
+	0x42, 0xda, 0xa2, 0x04, 0x12, 0xc1, 0x81, 0x03, 0xa8, 0x1b, 0xa2, 0x2a, 0x52, 0xa1, 0x65, 0x4b,
+	0x03, 0x02, 0xa9, 0x23, 0xef, 0xd8, 0x9d, 0x1a, 0xc6, 0xf6, 0xc8, 0xf6, 0x66, 0xb3, 0xdf, 0x82,
+	0x8f, 0xc0, 0x91, 0x23, 0x1f, 0xa3, 0x17, 0xa4, 0x1e, 0x39, 0x21, 0x94, 0x1c, 0xe0, 0x63, 0xa0,
+	0x79, 0xec, 0xd9, 0x99, 0xd9, 0x7a, 0x27, 0x97, 0x95, 0xd7, 0xfe, 0x3f, 0xff, 0x9f, 0x9f, 0xc7,
+	0x6f, 0x83, 0x6e, 0x32, 0xf1, 0x82, 0x2a, 0x2a, 0x22, 0xba, 0x5f, 0xb4, 0x62, 0x2a, 0xa8, 0x66,
+	0x7a, 0x2f, 0x55, 0xd2, 0xc8, 0xa0, 0x39, 0x1e, 0xd8, 0x1b, 0xb7, 0x36, 0xd7, 0x30, 0x67, 0x42,
+	0xee, 0xc3, 0xaf, 0xd5, 0x6d, 0xae, 0xc7, 0x32, 0x96, 0xd0, 0xdc, 0xcf, 0x5a, 0xae, 0xb7, 0xe3,
+	0x03, 0xa4, 0x58, 0x61, 0xee, 0xfc, 0x37, 0x6f, 0xf9, 0x14, 0x05, 0xd3, 0x8a, 0xee, 0x4c, 0xb1,
+	0x31, 0x2c, 0x62, 0x29, 0x16, 0xc6, 0xc9, 0xee, 0xf9, 0x64, 0x34, 0x95, 0xd1, 0xcb, 0x30, 0x56,
+	0x72, 0x90, 0x86, 0x04, 0x1b, 0xec, 0xb4, 0x1f, 0xfa, 0xb4, 0x9a, 0x1a, 0x93, 0xd0, 0x10, 0x73,
+	0x39, 0x18, 0x9b, 0x7e, 0x72, 0x95, 0xe9, 0x19, 0x4e, 0x18, 0xc1, 0x86, 0x49, 0x91, 0xe7, 0x74,
+	0xd7, 0x17, 0x62, 0xe4, 0x2f, 0x54, 0x48, 0xce, 0x22, 0x5d, 0x9e, 0xc6, 0xb6, 0x4f, 0xca, 0x25,
+	0xa1, 0x49, 0x5d, 0x7d, 0x8c, 0x4c, 0x43, 0xce, 0x04, 0x55, 0x4e, 0x74, 0xbf, 0xb6, 0x88, 0xa1,
+	0x61, 0x9c, 0xca, 0x41, 0x9e, 0xd0, 0x67, 0xf5, 0xe2, 0x22, 0x9d, 0x90, 0x50, 0x83, 0x59, 0x92,
+	0x67, 0x75, 0x38, 0xbd, 0x10, 0x29, 0x55, 0x2f, 0xa4, 0xe2, 0x38, 0x8b, 0xd7, 0x03, 0xce, 0xb1,
+	0x1a, 0xd5, 0x95, 0x02, 0x56, 0x0e, 0x27, 0xe1, 0x20, 0x8d, 0x15, 0x26, 0xb4, 0x6e, 0xaf, 0xf4,
+	0x15, 0x23, 0x71, 0xae, 0xd8, 0xf5, 0x16, 0x2b, 0x11, 0x92, 0xd0, 0xf0, 0x8c, 0x2a, 0xcd, 0xa4,
+	0xa8, 0xdb, 0x09, 0xa5, 0x0d, 0x13, 0xd2, 0x73, 0x96, 0xd7, 0xe3, 0xb6, 0x4f, 0x4b, 0x68, 0x42,
+	0x63, 0xa8, 0x42, 0xdd, 0x7e, 0x49, 0xf1, 0x88, 0x53, 0x61, 0x42, 0x92, 0xad, 0x6c, 0x1d, 0xba,
+	0x28, 0x2f, 0xd5, 0x91, 0x92, 0xc3, 0xba, 0x84, 0x14, 0x1d, 0x62, 0x45, 0xc2, 0x33, 0xaa, 0x0d,
+	0x13, 0x71, 0xed, 0x41, 0x92, 0x09, 0x8b, 0x5c, 0xa5, 0x77, 0x9e, 0xa1, 0x95, 0x23, 0xa9, 0xf9,
+	0xf7, 0x58, 0xf3, 0x27, 0x70, 0xc0, 0x82, 0x2d, 0xb4, 0x10, 0x0d, 0x0f, 0x3e, 0x0e, 0x23, 0x49,
+	0x68, 0xab, 0xd1, 0x69, 0xec, 0x2e, 0xf5, 0xae, 0x67, 0x1d, 0x47, 0x92, 0xd0, 0xa0, 0x83, 0x96,
+	0xc6, 0x83, 0x21, 0x23, 0xad, 0xd9, 0x4e, 0x63, 0x77, 0xae, 0x87, 0xf2, 0xf1, 0x13, 0xf2, 0xf9,
+	0xdc, 0x7f, 0xbf, 0x6d, 0x37, 0x76, 0xfe, 0x5c, 0x46, 0x4b, 0x0f, 0xed, 0x8d, 0xf0, 0xd4, 0x60,
+	0x43, 0x83, 0x2f, 0xd0, 0xbc, 0x3d, 0xc0, 0x60, 0xb9, 0x78, 0xb0, 0xb5, 0xe7, 0xb9, 0x21, 0xf6,
+	0xec, 0x14, 0xba, 0x0b, 0xaf, 0xfe, 0xde, 0x9e, 0xf9, 0xfd, 0xdf, 0x3f, 0xee, 0x35, 0x7a, 0x2e,
+	0x2a, 0xc0, 0xa8, 0xe9, 0x6e, 0x98, 0x50, 0x8a, 0x64, 0x14, 0x3a, 0xb3, 0x59, 0x30, 0xfb, 0xc0,
+	0x6b, 0xe6, 0xf8, 0x8f, 0x45, 0x32, 0x7a, 0xd3, 0x77, 0x2d, 0x9e, 0x1c, 0x0d, 0xbe, 0x44, 0x08,
+	0x8e, 0x50, 0x98, 0x30, 0x6d, 0x5a, 0xd7, 0x3a, 0xd7, 0x76, 0x17, 0x0f, 0x36, 0xbd, 0xce, 0x5f,
+	0x67, 0xb2, 0xee, 0x5c, 0xe6, 0xd6, 0x5b, 0x80, 0x98, 0x47, 0x4c, 0x9b, 0xe0, 0x5b, 0xb4, 0x1a,
+	0x49, 0xcd, 0xc3, 0x21, 0xd6, 0x3c, 0x9f, 0xe0, 0x1c, 0x4c, 0xf0, 0x96, 0xd7, 0xa6, 0x5a, 0xf8,
+	0xee, 0x6c, 0xab, 0xd1, 0x5b, 0x89, 0xaa, 0x8b, 0x71, 0x8a, 0x56, 0xcb, 0xfb, 0x0f, 0x66, 0xf6,
+	0x16, 0xcc, 0xac, 0x33, 0xad, 0x80, 0xb9, 0xb8, 0x9c, 0xed, 0x8d, 0x92, 0x09, 0x4c, 0xf5, 0x04,
+	0xad, 0x54, 0x4f, 0x40, 0x6b, 0x1e, 0x26, 0xba, 0xe3, 0xcf, 0xf7, 0xd1, 0x37, 0x92, 0xd0, 0x53,
+	0xab, 0xec, 0x2d, 0xdb, 0x48, 0xf7, 0x37, 0x38, 0x44, 0xf3, 0xf6, 0xb8, 0xb5, 0xde, 0xae, 0x59,
+	0xd9, 0x2e, 0x48, 0x7a, 0x4e, 0x1a, 0x3c, 0x47, 0x1b, 0x93, 0xe7, 0xca, 0x26, 0x77, 0x1d, 0x92,
+	0xbb, 0x7d, 0x55, 0x72, 0xc7, 0xe7, 0xcc, 0xb8, 0x05, 0x68, 0xa6, 0xd5, 0x6e, 0xc8, 0x4f, 0xa3,
+	0xf7, 0xcb, 0xfe, 0xc5, 0xb9, 0xd4, 0x16, 0xb3, 0x00, 0x98, 0xfb, 0x57, 0x61, 0xbe, 0x2a, 0xe2,
+	0x1c, 0x6d, 0x33, 0xf5, 0x8e, 0x02, 0xf4, 0x39, 0xda, 0xb0, 0x17, 0x5b, 0x81, 0xb3, 0x34, 0x54,
+	0x93, 0xd4, 0x71, 0x16, 0x51, 0x38, 0xe5, 0x49, 0xd1, 0x6a, 0x37, 0xf8, 0xff, 0x8c, 0xde, 0x2b,
+	0x39, 0x0f, 0x44, 0x5f, 0x0a, 0xc2, 0x44, 0x6c, 0x19, 0x8b, 0xc0, 0xd8, 0xf5, 0x32, 0x0a, 0x9f,
+	0x67, 0x79, 0x90, 0xe3, 0xbc, 0x4b, 0xde, 0x1c, 0x02, 0x16, 0x46, 0xef, 0x14, 0xb7, 0x4f, 0x7e,
+	0x61, 0x01, 0x68, 0x09, 0x40, 0x77, 0xbc, 0xa0, 0x93, 0xbc, 0xf5, 0xc4, 0x46, 0x38, 0xca, 0x3a,
+	0x9b, 0xe8, 0x07, 0xc4, 0x0f, 0xa8, 0x09, 0x37, 0x60, 0x68, 0x14, 0xc5, 0x7a, 0xa0, 0x46, 0xd6,
+	0x7f, 0x19, 0xfc, 0x77, 0xa6, 0x24, 0x22, 0x24, 0xff, 0xce, 0xc9, 0x9d, 0xf9, 0x1a, 0x29, 0x77,
+	0x82, 0xf3, 0x10, 0xb5, 0xab, 0xab, 0x9f, 0x51, 0xfa, 0x38, 0x81, 0x97, 0x06, 0x20, 0x2b, 0x00,
+	0xf9, 0xe8, 0xea, 0xf5, 0x17, 0x92, 0x77, 0x6d, 0xa0, 0xc3, 0x6d, 0xa5, 0xfe, 0xe1, 0x7c, 0x07,
+	0x4c, 0xde, 0xd9, 0x96, 0x77, 0xa3, 0x66, 0x07, 0x8c, 0x8b, 0x76, 0x0c, 0x01, 0xf9, 0x0e, 0x60,
+	0xd5, 0xee, 0x7c, 0x55, 0x14, 0x4d, 0x28, 0xd6, 0xb8, 0x9f, 0x54, 0x01, 0xab, 0x35, 0xab, 0xd2,
+	0x1b, 0x87, 0x54, 0x08, 0xeb, 0x6a, 0xa2, 0x1f, 0x10, 0x02, 0x6d, 0x55, 0x9f, 0x92, 0x10, 0x47,
+	0x51, 0xf6, 0x61, 0x63, 0x39, 0x6b, 0xc0, 0xb9, 0x3b, 0x85, 0x93, 0xc5, 0x9d, 0xda, 0xb0, 0x07,
+	0x36, 0xca, 0xb1, 0x5a, 0xca, 0x33, 0x06, 0xbc, 0x9f, 0xd0, 0x86, 0xfb, 0x7e, 0x8a, 0xb0, 0x52,
+	0x23, 0x79, 0x46, 0x95, 0x25, 0x05, 0x40, 0xba, 0xe9, 0x25, 0x3d, 0x85, 0x88, 0x07, 0xbc, 0x44,
+	0x68, 0x5a, 0x97, 0xa3, 0xdc, 0x04, 0xcc, 0x1f, 0xa2, 0x45, 0xfb, 0xda, 0x59, 0xcb, 0x66, 0xdd,
+	0xcd, 0x09, 0xba, 0x63, 0x61, 0xc6, 0x1b, 0x0b, 0xd9, 0xd0, 0xcc, 0xa8, 0xfb, 0xf8, 0xd5, 0x45,
+	0xbb, 0xf1, 0xfa, 0xa2, 0xdd, 0xf8, 0xe7, 0xa2, 0xdd, 0xf8, 0xf5, 0xb2, 0x3d, 0xf3, 0xfa, 0xb2,
+	0x3d, 0xf3, 0xd7, 0x65, 0x7b, 0xe6, 0xc7, 0x4f, 0x63, 0x66, 0x5e, 0x0e, 0xfa, 0x7b, 0x91, 0xe4,
+	0xfb, 0xa9, 0x92, 0x64, 0x10, 0x19, 0x1d, 0xb1, 0x89, 0x27, 0xf7, 0xbc, 0xfc, 0x15, 0x36, 0x4a,
+	0xa9, 0xee, 0xcf, 0xc3, 0xf3, 0x7b, 0xf8, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00, 0x4a, 0x17,
+	0xbb, 0x4c, 0x0b, 0x00, 0x00,
 }
 
 func (this *CosmWasmParams) Equal(that interface{}) bool {
@@ -306,6 +430,182 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PolicyList) > 0 {
+		for iNdEx := len(m.PolicyList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.SettleCarryoverList) > 0 {
+		for iNdEx := len(m.SettleCarryoverList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettleCarryoverList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.RewardVestingAccountList) > 0 {
+		for iNdEx := len(m.RewardVestingAccountList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestingAccountList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ReleasableEscrowList) > 0 {
+		for iNdEx := len(m.ReleasableEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleasableEscrowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.InferenceEscrowList) > 0 {
+		for iNdEx := len(m.InferenceEscrowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InferenceEscrowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ParticipantDenomBalanceList) > 0 {
+		for iNdEx := len(m.ParticipantDenomBalanceList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantDenomBalanceList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DenomTreasuryList) > 0 {
+		for iNdEx := len(m.DenomTreasuryList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTreasuryList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.InferencePaymentList) > 0 {
+		for iNdEx := len(m.InferencePaymentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InferencePaymentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DelegationUnbondingList) > 0 {
+		for iNdEx := len(m.DelegationUnbondingList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationUnbondingList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.EpochDelegationList) > 0 {
+		for iNdEx := len(m.EpochDelegationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochDelegationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ParticipantDelegationsList) > 0 {
+		for iNdEx := len(m.ParticipantDelegationsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantDelegationsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ParticipantExitList) > 0 {
+		for iNdEx := len(m.ParticipantExitList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipantExitList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Bridge != nil {
 		{
 			size, err := m.Bridge.MarshalToSizedBuffer(dAtA[:i])
@@ -454,6 +754,78 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Bridge.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.ParticipantExitList) > 0 {
+		for _, e := range m.ParticipantExitList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantDelegationsList) > 0 {
+		for _, e := range m.ParticipantDelegationsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EpochDelegationList) > 0 {
+		for _, e := range m.EpochDelegationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelegationUnbondingList) > 0 {
+		for _, e := range m.DelegationUnbondingList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InferencePaymentList) > 0 {
+		for _, e := range m.InferencePaymentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomTreasuryList) > 0 {
+		for _, e := range m.DenomTreasuryList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ParticipantDenomBalanceList) > 0 {
+		for _, e := range m.ParticipantDenomBalanceList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InferenceEscrowList) > 0 {
+		for _, e := range m.InferenceEscrowList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReleasableEscrowList) > 0 {
+		for _, e := range m.ReleasableEscrowList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardVestingAccountList) > 0 {
+		for _, e := range m.RewardVestingAccountList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SettleCarryoverList) > 0 {
+		for _, e := range m.SettleCarryoverList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PolicyList) > 0 {
+		for _, e := range m.PolicyList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -837,6 +1209,414 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantExitList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantExitList = append(m.ParticipantExitList, ParticipantExit{})
+			if err := m.ParticipantExitList[len(m.ParticipantExitList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantDelegationsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantDelegationsList = append(m.ParticipantDelegationsList, ParticipantDelegations{})
+			if err := m.ParticipantDelegationsList[len(m.ParticipantDelegationsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDelegationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochDelegationList = append(m.EpochDelegationList, EpochDelegation{})
+			if err := m.EpochDelegationList[len(m.EpochDelegationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationUnbondingList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationUnbondingList = append(m.DelegationUnbondingList, DelegationUnbonding{})
+			if err := m.DelegationUnbondingList[len(m.DelegationUnbondingList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferencePaymentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferencePaymentList = append(m.InferencePaymentList, InferencePayment{})
+			if err := m.InferencePaymentList[len(m.InferencePaymentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTreasuryList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTreasuryList = append(m.DenomTreasuryList, DenomTreasury{})
+			if err := m.DenomTreasuryList[len(m.DenomTreasuryList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantDenomBalanceList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParticipantDenomBalanceList = append(m.ParticipantDenomBalanceList, ParticipantDenomBalance{})
+			if err := m.ParticipantDenomBalanceList[len(m.ParticipantDenomBalanceList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceEscrowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceEscrowList = append(m.InferenceEscrowList, InferenceEscrow{})
+			if err := m.InferenceEscrowList[len(m.InferenceEscrowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasableEscrowList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleasableEscrowList = append(m.ReleasableEscrowList, ReleasableEscrow{})
+			if err := m.ReleasableEscrowList[len(m.ReleasableEscrowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingAccountList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestingAccountList = append(m.RewardVestingAccountList, RewardVestingAccount{})
+			if err := m.RewardVestingAccountList[len(m.RewardVestingAccountList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleCarryoverList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettleCarryoverList = append(m.SettleCarryoverList, SettleAmount{})
+			if err := m.SettleCarryoverList[len(m.SettleCarryoverList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyList = append(m.PolicyList, PolicyEntry{})
+			if err := m.PolicyList[len(m.PolicyList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
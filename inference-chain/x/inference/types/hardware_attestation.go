@@ -0,0 +1,139 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/productscience/inference/x/inference/utils"
+)
+
+// HardwareAttestation is a capability report for one ML node, signed with the participant's worker key
+// (Participant.WorkerPublicKey). It lets anyone check that the hardware a node declares was reported by
+// the participant's node stack rather than edited into the hardware diff by hand.
+type HardwareAttestation struct {
+	Participant    string   `json:"participant"`
+	LocalId        string   `json:"local_id"`
+	GpuModel       string   `json:"gpu_model"`
+	GpuCount       uint32   `json:"gpu_count"`
+	VRamGB         uint64   `json:"vram_gb"` // total across all GPUs of the node
+	BenchmarkScore uint64   `json:"benchmark_score,omitempty"`
+	Models         []string `json:"models"`
+	Timestamp      int64    `json:"timestamp"` // unix seconds
+	Signature      string   `json:"signature,omitempty"`
+}
+
+// SignBytes returns the canonical bytes covered by the signature: the JSON encoding of the
+// attestation with Signature cleared and Models sorted.
+func (a HardwareAttestation) SignBytes() []byte {
+	a.Signature = ""
+	a.Models = slices.Clone(a.Models)
+	slices.Sort(a.Models)
+	bz, _ := json.Marshal(a)
+	return bz
+}
+
+// Verify checks the signature against a base64 ED25519 worker public key
+func (a HardwareAttestation) Verify(workerPublicKey string) error {
+	pubKey, err := utils.SafeCreateED25519ValidatorKey(workerPublicKey)
+	if err != nil {
+		return err
+	}
+	signature, err := base64.StdEncoding.DecodeString(a.Signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if !pubKey.VerifySignature(a.SignBytes(), signature) {
+		return fmt.Errorf("signature does not match worker key")
+	}
+	return nil
+}
+
+// Matches the Hardware.Type written by the API for detected GPUs, e.g. "NVIDIA H100 80GB HBM3 | 79GB"
+var hardwareVRamPattern = regexp.MustCompile(`\|\s*(\d+)\s*GB\s*$`)
+
+// DeclaredVRamGB sums the VRAM of the GPUs declared for the node. The second return value is false when
+// no Hardware entry carries a VRAM figure, e.g. for nodes registered before GPU detection.
+func DeclaredVRamGB(node *HardwareNode) (uint64, bool) {
+	var total uint64
+	declared := false
+	for _, hw := range node.Hardware {
+		if hw == nil {
+			continue
+		}
+		match := hardwareVRamPattern.FindStringSubmatch(hw.Type)
+		if match == nil {
+			continue
+		}
+		gb, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		total += gb * uint64(hw.Count)
+		declared = true
+	}
+	return total, declared
+}
+
+// HardwareAttestationTypePrefix marks the Hardware entry that relays a node's signed HardwareAttestation
+// in MsgSubmitHardwareDiff, which has no field of its own for it: the entry type is the prefix followed by
+// the JSON attestation, and its count is 0 so it adds no GPU.
+const HardwareAttestationTypePrefix = "attestation:"
+
+// AttestationHardware wraps a signed attestation as the Hardware entry relaying it
+func AttestationHardware(a HardwareAttestation) (*Hardware, error) {
+	bz, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return &Hardware{Type: HardwareAttestationTypePrefix + string(bz)}, nil
+}
+
+// NodeAttestation returns the attestation relayed with the node. The second return value is false when
+// the node relays none.
+func NodeAttestation(node *HardwareNode) (HardwareAttestation, bool, error) {
+	for _, hw := range node.Hardware {
+		if hw == nil || !strings.HasPrefix(hw.Type, HardwareAttestationTypePrefix) {
+			continue
+		}
+		var attestation HardwareAttestation
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(hw.Type, HardwareAttestationTypePrefix)), &attestation); err != nil {
+			return HardwareAttestation{}, true, fmt.Errorf("invalid attestation encoding: %w", err)
+		}
+		return attestation, true, nil
+	}
+	return HardwareAttestation{}, false, nil
+}
+
+// VerifyNodeAttestation checks the attestation relayed with a node of the participant: it must be signed
+// with the participant's worker key and describe that node. The second return value is false when the
+// node relays no attestation.
+func VerifyNodeAttestation(node *HardwareNode, participant string, workerPublicKey string) (HardwareAttestation, bool, error) {
+	attestation, relayed, err := NodeAttestation(node)
+	if !relayed || err != nil {
+		return HardwareAttestation{}, relayed, err
+	}
+	if attestation.Participant != participant {
+		return HardwareAttestation{}, true, fmt.Errorf("attestation of participant %s relayed by %s", attestation.Participant, participant)
+	}
+	if attestation.LocalId != node.LocalId {
+		return HardwareAttestation{}, true, fmt.Errorf("attestation of node %s relayed for node %s", attestation.LocalId, node.LocalId)
+	}
+	if err := attestation.Verify(workerPublicKey); err != nil {
+		return HardwareAttestation{}, true, err
+	}
+	return attestation, true, nil
+}
+
+// AttestationFitsModel reports whether the attested VRAM covers the model's requirement.
+// A node without a verified attestation (nil) only fits models that have no requirement.
+func AttestationFitsModel(attestation *HardwareAttestation, model *Model) bool {
+	if model.VRam == 0 {
+		return true
+	}
+	return attestation != nil && attestation.VRamGB >= model.VRam
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHardwareAttestation_Verify(t *testing.T) {
+	workerKey := ed25519.GenPrivKey()
+	workerPublicKey := base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes())
+
+	attestation := HardwareAttestation{
+		Participant: "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6",
+		LocalId:     "mlnode1",
+		GpuModel:    "NVIDIA H100 80GB HBM3",
+		GpuCount:    2,
+		VRamGB:      158,
+		Models:      []string{"b", "a"},
+		Timestamp:   1700000000,
+	}
+	signature, err := workerKey.Sign(attestation.SignBytes())
+	require.NoError(t, err)
+	attestation.Signature = base64.StdEncoding.EncodeToString(signature)
+	require.NoError(t, attestation.Verify(workerPublicKey))
+
+	// model order is not part of the signed content
+	attestation.Models = []string{"a", "b"}
+	require.NoError(t, attestation.Verify(workerPublicKey))
+
+	attestation.VRamGB = 320
+	require.Error(t, attestation.Verify(workerPublicKey))
+
+	otherKey := base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes())
+	attestation.VRamGB = 158
+	require.Error(t, attestation.Verify(otherKey))
+}
+
+func TestDeclaredVRamGB(t *testing.T) {
+	vram, declared := DeclaredVRamGB(&HardwareNode{Hardware: []*Hardware{
+		{Type: "NVIDIA H100 80GB HBM3 | 79GB", Count: 2},
+		{Type: "NVIDIA RTX A6000 | 48GB", Count: 1},
+		{Type: "custom"},
+	}})
+	require.True(t, declared)
+	require.Equal(t, uint64(206), vram)
+
+	_, declared = DeclaredVRamGB(&HardwareNode{Hardware: []*Hardware{{Type: "H100", Count: 8}}})
+	require.False(t, declared)
+}
+
+func TestVerifyNodeAttestation(t *testing.T) {
+	workerKey := ed25519.GenPrivKey()
+	workerPublicKey := base64.StdEncoding.EncodeToString(workerKey.PubKey().Bytes())
+	participant := "gonka1xmwh48ugfvd2ktmy0t90ueuzqxdk4g0anwe3v6"
+	sign := func(attestation HardwareAttestation) *Hardware {
+		signature, err := workerKey.Sign(attestation.SignBytes())
+		require.NoError(t, err)
+		attestation.Signature = base64.StdEncoding.EncodeToString(signature)
+		hw, err := AttestationHardware(attestation)
+		require.NoError(t, err)
+		return hw
+	}
+	declared := &Hardware{Type: "NVIDIA H100 80GB HBM3 | 79GB", Count: 2}
+
+	node := &HardwareNode{LocalId: "mlnode1", Hardware: []*Hardware{declared, sign(HardwareAttestation{Participant: participant, LocalId: "mlnode1", VRamGB: 158})}}
+	attestation, relayed, err := VerifyNodeAttestation(node, participant, workerPublicKey)
+	require.NoError(t, err)
+	require.True(t, relayed)
+	require.Equal(t, uint64(158), attestation.VRamGB)
+	require.True(t, AttestationFitsModel(&attestation, &Model{VRam: 80}))
+	require.False(t, AttestationFitsModel(&attestation, &Model{VRam: 160}))
+	// The relaying entry adds no VRAM to the declared hardware
+	vram, _ := DeclaredVRamGB(node)
+	require.Equal(t, uint64(158), vram)
+
+	_, relayed, err = VerifyNodeAttestation(&HardwareNode{LocalId: "mlnode1", Hardware: []*Hardware{declared}}, participant, workerPublicKey)
+	require.NoError(t, err)
+	require.False(t, relayed)
+	require.True(t, AttestationFitsModel(nil, &Model{}))
+	require.False(t, AttestationFitsModel(nil, &Model{VRam: 16}))
+
+	// Another node's attestation, an edited one and another participant's are rejected
+	_, _, err = VerifyNodeAttestation(&HardwareNode{LocalId: "mlnode2", Hardware: node.Hardware}, participant, workerPublicKey)
+	require.Error(t, err)
+	edited := &HardwareNode{LocalId: "mlnode1", Hardware: []*Hardware{{Type: strings.Replace(node.Hardware[1].Type, "158", "320", 1)}}}
+	_, _, err = VerifyNodeAttestation(edited, participant, workerPublicKey)
+	require.Error(t, err)
+	_, _, err = VerifyNodeAttestation(node, "gonka1other", workerPublicKey)
+	require.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package types
+
+import "fmt"
+
+// ImageDetailLow is the image_url detail level of images the ML node downscales before encoding
+const ImageDetailLow = "low"
+
+// ImageInputPolicy sets how image inputs of multimodal chat requests are accounted for. Images are charged
+// as a fixed number of prompt tokens each when the escrow of an inference is estimated; the final cost
+// still comes from the prompt token count reported by the executor.
+type ImageInputPolicy struct {
+	// MaxImagesPerRequest caps the images of a request; 0 rejects requests with images
+	MaxImagesPerRequest uint32 `json:"max_images_per_request"`
+	// TokensPerImage is the prompt token estimate of an image
+	TokensPerImage uint64 `json:"tokens_per_image"`
+	// LowDetailTokensPerImage is the prompt token estimate of an image sent with detail "low"
+	LowDetailTokensPerImage uint64 `json:"low_detail_tokens_per_image"`
+}
+
+func DefaultImageInputPolicy() ImageInputPolicy {
+	return ImageInputPolicy{
+		MaxImagesPerRequest:     8,
+		TokensPerImage:          1500,
+		LowDetailTokensPerImage: 100,
+	}
+}
+
+func (p ImageInputPolicy) Validate() error {
+	if p.MaxImagesPerRequest > 0 && p.TokensPerImage == 0 {
+		return fmt.Errorf("tokens_per_image must be positive when images are accepted")
+	}
+	if p.LowDetailTokensPerImage > p.TokensPerImage {
+		return fmt.Errorf("low_detail_tokens_per_image (%d) must not exceed tokens_per_image (%d)", p.LowDetailTokensPerImage, p.TokensPerImage)
+	}
+	if uint64(p.MaxImagesPerRequest)*p.TokensPerImage > MaxAllowedTokens {
+		return fmt.Errorf("max_images_per_request * tokens_per_image exceeds the token limit %d", MaxAllowedTokens)
+	}
+	return nil
+}
+
+// ImageTokens is the prompt token estimate of an image with the given image_url detail level
+func (p ImageInputPolicy) ImageTokens(detail string) uint64 {
+	if detail == ImageDetailLow && p.LowDetailTokensPerImage > 0 {
+		return p.LowDetailTokensPerImage
+	}
+	return p.TokensPerImage
+}
+
+// ImageInputPolicyFullKey returns the store key of the image input policy, for raw store queries
+func ImageInputPolicyFullKey() []byte {
+	return ImageInputPolicyPrefix.Bytes()
+}
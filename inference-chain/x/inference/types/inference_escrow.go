@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// Inference escrow statuses
+const (
+	// EscrowLocked holds the requester's funds while the inference runs and its validation window is open
+	EscrowLocked = "locked"
+	// EscrowReleased means the validation window closed without invalidation and the executor is paid
+	EscrowReleased = "released"
+	// EscrowRefunded means the inference was invalidated or timed out and the requester got its funds back
+	EscrowRefunded = "refunded"
+)
+
+// Held returns what is still in escrow
+func (e InferenceEscrow) Held() int64 {
+	if e.Status != EscrowLocked {
+		return 0
+	}
+	return e.Locked - e.Refunded
+}
+
+// InferenceEscrowFullKey returns the store key of the escrow of an inference, for raw store queries
+func InferenceEscrowFullKey(inferenceId string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(InferenceEscrowsPrefix, collections.StringKey, inferenceId)
+}
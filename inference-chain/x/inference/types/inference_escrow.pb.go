@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/inference_escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InferenceEscrow tracks the funds locked for an inference from MsgStartInference until they are
+// released to the executor or refunded to the requester. Amounts are in BaseCoin.
+//
+// The executor's share is credited to its owed balance when the inference completes and is paid out
+// when the claims of the inference's epoch are set, at the end of PoC validation. That ends the
+// validation window: invalidations after it no longer refund, so it is also when the escrow is released.
+type InferenceEscrow struct {
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Requester   string `protobuf:"bytes,2,opt,name=requester,proto3" json:"requester,omitempty"`
+	Executor    string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	// status is one of "locked", "released" or "refunded"
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// locked is the total put in escrow, refunded what went back to the requester, including the
+	// unused part of the escrow refunded on completion
+	Locked   int64 `protobuf:"varint,5,opt,name=locked,proto3" json:"locked,omitempty"`
+	Refunded int64 `protobuf:"varint,6,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// executor_payment is what was credited to the executor for the inference
+	ExecutorPayment int64 `protobuf:"varint,7,opt,name=executor_payment,json=executorPayment,proto3" json:"executor_payment,omitempty"`
+	// epoch_id is the epoch whose settlement releases the escrow, known once the inference completes
+	EpochId         uint64 `protobuf:"varint,8,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	LockedAtHeight  int64  `protobuf:"varint,9,opt,name=locked_at_height,json=lockedAtHeight,proto3" json:"locked_at_height,omitempty"`
+	SettledAtHeight int64  `protobuf:"varint,10,opt,name=settled_at_height,json=settledAtHeight,proto3" json:"settled_at_height,omitempty"`
+}
+
+func (m *InferenceEscrow) Reset()         { *m = InferenceEscrow{} }
+func (m *InferenceEscrow) String() string { return proto.CompactTextString(m) }
+func (*InferenceEscrow) ProtoMessage()    {}
+func (*InferenceEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185b926b01579e7f, []int{0}
+}
+func (m *InferenceEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferenceEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferenceEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferenceEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferenceEscrow.Merge(m, src)
+}
+func (m *InferenceEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferenceEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferenceEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferenceEscrow proto.InternalMessageInfo
+
+func (m *InferenceEscrow) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func (m *InferenceEscrow) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func (m *InferenceEscrow) GetExecutor() string {
+	if m != nil {
+		return m.Executor
+	}
+	return ""
+}
+
+func (m *InferenceEscrow) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *InferenceEscrow) GetLocked() int64 {
+	if m != nil {
+		return m.Locked
+	}
+	return 0
+}
+
+func (m *InferenceEscrow) GetRefunded() int64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *InferenceEscrow) GetExecutorPayment() int64 {
+	if m != nil {
+		return m.ExecutorPayment
+	}
+	return 0
+}
+
+func (m *InferenceEscrow) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *InferenceEscrow) GetLockedAtHeight() int64 {
+	if m != nil {
+		return m.LockedAtHeight
+	}
+	return 0
+}
+
+func (m *InferenceEscrow) GetSettledAtHeight() int64 {
+	if m != nil {
+		return m.SettledAtHeight
+	}
+	return 0
+}
+
+// ReleasableEscrow schedules the escrow of a completed inference for release when its epoch settles
+type ReleasableEscrow struct {
+	EpochId     uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	InferenceId string `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+}
+
+func (m *ReleasableEscrow) Reset()         { *m = ReleasableEscrow{} }
+func (m *ReleasableEscrow) String() string { return proto.CompactTextString(m) }
+func (*ReleasableEscrow) ProtoMessage()    {}
+func (*ReleasableEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_185b926b01579e7f, []int{1}
+}
+func (m *ReleasableEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleasableEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleasableEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleasableEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasableEscrow.Merge(m, src)
+}
+func (m *ReleasableEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleasableEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasableEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasableEscrow proto.InternalMessageInfo
+
+func (m *ReleasableEscrow) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *ReleasableEscrow) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*InferenceEscrow)(nil), "inference.inference.InferenceEscrow")
+	proto.RegisterType((*ReleasableEscrow)(nil), "inference.inference.ReleasableEscrow")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/inference_escrow.proto", fileDescriptor_185b926b01579e7f)
+}
+
+var fileDescriptor_185b926b01579e7f = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcd, 0x6e, 0xe2, 0x30,
+	0x14, 0x85, 0x31, 0x30, 0xfc, 0x78, 0x46, 0x03, 0xe3, 0x91, 0x2a, 0xb7, 0xaa, 0x22, 0xca, 0x2a,
+	0x65, 0x01, 0x8b, 0xaa, 0x0f, 0xd0, 0x4a, 0x95, 0xca, 0xaa, 0x28, 0xcb, 0x6e, 0xa2, 0xe0, 0x5c,
+	0x48, 0xd4, 0x10, 0xa7, 0xf6, 0x8d, 0x0a, 0x6f, 0xd1, 0xc7, 0xea, 0x92, 0x55, 0xd5, 0x65, 0x05,
+	0x2f, 0x52, 0xe1, 0x90, 0xf0, 0xd3, 0xee, 0xee, 0xf9, 0xce, 0xd1, 0xb1, 0x7d, 0x65, 0xda, 0x0b,
+	0xe3, 0x09, 0x28, 0x88, 0x05, 0x0c, 0x7e, 0x98, 0x5c, 0xd0, 0x42, 0xc9, 0x97, 0x7e, 0xa2, 0x24,
+	0x4a, 0xf6, 0xbf, 0xe0, 0xfd, 0x62, 0xea, 0xbe, 0x97, 0x69, 0x6b, 0x98, 0xab, 0x3b, 0x13, 0x67,
+	0x17, 0xf4, 0xcf, 0xae, 0x22, 0xf4, 0x39, 0xe9, 0x10, 0xbb, 0xe9, 0xfc, 0x2e, 0xd8, 0xd0, 0x67,
+	0xe7, 0xb4, 0xa9, 0xe0, 0x39, 0x05, 0x8d, 0xa0, 0x78, 0xd9, 0xf8, 0x3b, 0xc0, 0xce, 0x68, 0x03,
+	0xe6, 0x20, 0x52, 0x94, 0x8a, 0x57, 0x8c, 0x59, 0x68, 0x76, 0x42, 0x6b, 0x1a, 0x3d, 0x4c, 0x35,
+	0xaf, 0x1a, 0x67, 0xab, 0x36, 0x3c, 0x92, 0xe2, 0x09, 0x7c, 0xfe, 0xab, 0x43, 0xec, 0x8a, 0xb3,
+	0x55, 0x9b, 0x2e, 0x05, 0x93, 0x34, 0xf6, 0xc1, 0xe7, 0x35, 0xe3, 0x14, 0x9a, 0x5d, 0xd2, 0x76,
+	0xde, 0xeb, 0x26, 0xde, 0x62, 0x06, 0x31, 0xf2, 0xba, 0xc9, 0xb4, 0x72, 0x3e, 0xca, 0x30, 0x3b,
+	0xa5, 0x0d, 0x48, 0xa4, 0x08, 0x36, 0xef, 0x69, 0x74, 0x88, 0x5d, 0x75, 0xea, 0x46, 0x0f, 0x7d,
+	0x66, 0xd3, 0x76, 0x76, 0x96, 0xeb, 0xa1, 0x1b, 0x40, 0x38, 0x0d, 0x90, 0x37, 0x4d, 0xcb, 0xdf,
+	0x8c, 0xdf, 0xe0, 0xbd, 0xa1, 0xac, 0x47, 0xff, 0x69, 0x40, 0x8c, 0x0e, 0xa2, 0x34, 0x3b, 0x70,
+	0x6b, 0xe4, 0xd9, 0xee, 0x88, 0xb6, 0x1d, 0x88, 0xc0, 0xd3, 0xde, 0x38, 0xca, 0x17, 0xbb, 0x7f,
+	0x09, 0x72, 0x78, 0x89, 0xe3, 0x9d, 0x97, 0xbf, 0xed, 0xfc, 0xf6, 0xe1, 0x6d, 0x65, 0x91, 0xe5,
+	0xca, 0x22, 0x9f, 0x2b, 0x8b, 0xbc, 0xae, 0xad, 0xd2, 0x72, 0x6d, 0x95, 0x3e, 0xd6, 0x56, 0xe9,
+	0xf1, 0x7a, 0x1a, 0x62, 0x90, 0x8e, 0xfb, 0x42, 0xce, 0x06, 0x89, 0x92, 0x7e, 0x2a, 0x50, 0x8b,
+	0xf0, 0xe8, 0x57, 0xcc, 0xf7, 0x66, 0x5c, 0x24, 0xa0, 0xc7, 0x35, 0xf3, 0x2f, 0xae, 0xbe, 0x02,
+	0x00, 0x00, 0xff, 0xff, 0x13, 0x49, 0x29, 0xec, 0x45, 0x02, 0x00, 0x00,
+}
+
+func (m *InferenceEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferenceEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferenceEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettledAtHeight != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.SettledAtHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LockedAtHeight != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.LockedAtHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ExecutorPayment != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.ExecutorPayment))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Locked != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.Locked))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleasableEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleasableEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleasableEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintInferenceEscrow(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintInferenceEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovInferenceEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InferenceEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovInferenceEscrow(uint64(l))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovInferenceEscrow(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovInferenceEscrow(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovInferenceEscrow(uint64(l))
+	}
+	if m.Locked != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.Locked))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.Refunded))
+	}
+	if m.ExecutorPayment != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.ExecutorPayment))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.EpochId))
+	}
+	if m.LockedAtHeight != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.LockedAtHeight))
+	}
+	if m.SettledAtHeight != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.SettledAtHeight))
+	}
+	return n
+}
+
+func (m *ReleasableEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovInferenceEscrow(uint64(m.EpochId))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovInferenceEscrow(uint64(l))
+	}
+	return n
+}
+
+func sovInferenceEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozInferenceEscrow(x uint64) (n int) {
+	return sovInferenceEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InferenceEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferenceEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferenceEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			m.Locked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Locked |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorPayment", wireType)
+			}
+			m.ExecutorPayment = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorPayment |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAtHeight", wireType)
+			}
+			m.LockedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledAtHeight", wireType)
+			}
+			m.SettledAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleasableEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInferenceEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleasableEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleasableEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInferenceEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInferenceEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipInferenceEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowInferenceEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowInferenceEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthInferenceEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupInferenceEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthInferenceEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthInferenceEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowInferenceEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupInferenceEscrow = fmt.Errorf("proto: unexpected end of group")
+)
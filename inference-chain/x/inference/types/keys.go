@@ -20,6 +20,9 @@ const (
 	BridgeEscrowAccName      = "bridge_escrow"
 )
 
+// Collection prefixes share the store with legacy string keys, which start with 'A' (65), 'G' (71),
+// 'H' (72), 'L' (76), 'M' (77), 'T' (84), 'p' (112) and 's' (115). Maps and other ranged collections
+// must not use these bytes, or iterating them decodes the legacy entries.
 var (
 	ParticipantsPrefix                = collections.NewPrefix(0)
 	RandomSeedPrefix                  = collections.NewPrefix(1)
@@ -64,6 +67,48 @@ var (
 	PocV2EnabledEpochPrefix           = collections.NewPrefix(41)
 	PoCValidationSnapshotPrefix       = collections.NewPrefix(42)
 	PunishmentGraceEpochsPrefix       = collections.NewPrefix(43)
+	EpochSubsidyTotalsPrefix          = collections.NewPrefix(44)
+	ModelAssignmentStrategyPrefix     = collections.NewPrefix(45)
+	SettleCarryoversPrefix            = collections.NewPrefix(46)
+	PocAllocationAuditsPrefix         = collections.NewPrefix(47)
+	TimeslotSchedulePrefix            = collections.NewPrefix(48)
+	HardwareVerificationPrefix        = collections.NewPrefix(49)
+	SlashingPolicyPrefix              = collections.NewPrefix(50)
+	JailRecordsPrefix                 = collections.NewPrefix(51)
+	EpochGroupDataRetentionPrefix     = collections.NewPrefix(52)
+	EpochGroupDataPrunedEpochPrefix   = collections.NewPrefix(53)
+	TrainingAttestationPolicyPrefix   = collections.NewPrefix(54)
+	TrainingVerificationsPrefix       = collections.NewPrefix(55)
+	ValidationCircuitBreakerPrefix    = collections.NewPrefix(56)
+	ValidationFailureStatsPrefix      = collections.NewPrefix(57)
+	LateJoinPolicyPrefix              = collections.NewPrefix(58)
+	PendingLateJoinersPrefix          = collections.NewPrefix(59)
+	PaymentDenomRegistryPrefix        = collections.NewPrefix(60)
+	InferencePaymentsPrefix           = collections.NewPrefix(61)
+	DenomTreasuriesPrefix             = collections.NewPrefix(62)
+	InferenceEscrowsPrefix            = collections.NewPrefix(63)
+	ReleasableEscrowsPrefix           = collections.NewPrefix(64)
+	RewardVestingSchedulePrefix       = collections.NewPrefix(66)
+	RewardVestingAccountsPrefix       = collections.NewPrefix(67)
+	TopRewardProgressPrefix           = collections.NewPrefix(68)
+	TopRewardProgramStatePrefix       = collections.NewPrefix(69)
+	ImageInputPolicyPrefix            = collections.NewPrefix(70)
+	MLNodeVersionRolloutPrefix        = collections.NewPrefix(72)
+	MLNodeVersionAssignmentsPrefix    = collections.NewPrefix(73)
+	ModelRewardWeightsPrefix          = collections.NewPrefix(74)
+	ModelEpochUsagePrefix             = collections.NewPrefix(75)
+	PriorityClassPricingPrefix        = collections.NewPrefix(76)
+	TrainingTaskDatasetsPrefix        = collections.NewPrefix(78)
+	ParticipantExitsPrefix            = collections.NewPrefix(79)
+	CapacityCollateralPolicyPrefix    = collections.NewPrefix(80)
+	ParticipantEarningsPrefix         = collections.NewPrefix(81)
+	TrainingDatasetsPrefix            = collections.NewPrefix(82)
+	EpochParamsHistoryPrefix          = collections.NewPrefix(83)
+	DelegationPolicyPrefix            = collections.NewPrefix(85)
+	ParticipantDelegationsPrefix      = collections.NewPrefix(86)
+	EpochDelegationsPrefix            = collections.NewPrefix(87)
+	DelegationUnbondingsPrefix        = collections.NewPrefix(88)
+	ParticipantDenomBalancesPrefix    = collections.NewPrefix(89)
 	ParamsKey                         = []byte("p_inference")
 )
 
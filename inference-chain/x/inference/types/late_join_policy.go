@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// LateJoinPolicy lets participants that miss the PoC exchange deadline by a few blocks still join the epoch.
+// Their PoC is accepted in a grace window after the deadline and validated with everyone else's, but they
+// are left out of epoch group formation and added with a weight penalty at a fixed block after the switch
+// to the new validators.
+type LateJoinPolicy struct {
+	// GraceBlocks is how many blocks after the PoC exchange deadline late PoC is accepted; 0 disables late join.
+	// The window always closes before PoC validation starts, so it needs a PocValidationDelay longer than
+	// the PocExchangeDuration to open at all.
+	GraceBlocks int64 `json:"grace_blocks"`
+	// WeightPenalty is the fraction of weight late joiners lose for the epoch, as a decimal string
+	WeightPenalty string `json:"weight_penalty"`
+	// AmendmentDelay is how many blocks after set_new_validators the late joiners are added to the epoch group
+	AmendmentDelay int64 `json:"amendment_delay"`
+}
+
+func DefaultLateJoinPolicy() LateJoinPolicy {
+	return LateJoinPolicy{
+		GraceBlocks:    0,
+		WeightPenalty:  "0.2",
+		AmendmentDelay: 10,
+	}
+}
+
+func (p LateJoinPolicy) Validate() error {
+	if p.GraceBlocks < 0 {
+		return fmt.Errorf("grace_blocks must not be negative, got %d", p.GraceBlocks)
+	}
+	penalty, err := decimal.NewFromString(p.WeightPenalty)
+	if err != nil {
+		return fmt.Errorf("invalid weight_penalty %q: %w", p.WeightPenalty, err)
+	}
+	if penalty.IsNegative() || !penalty.LessThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("weight_penalty must be in [0, 1), got %s", penalty)
+	}
+	if p.AmendmentDelay <= 0 {
+		return fmt.Errorf("amendment_delay must be positive, got %d", p.AmendmentDelay)
+	}
+	return nil
+}
+
+// LateJoinDeadline is the last block late PoC is accepted at for the epoch. It is not after the
+// PoC exchange deadline when late join is disabled or the params leave no room before validation.
+func (p LateJoinPolicy) LateJoinDeadline(ec *EpochContext) int64 {
+	return min(ec.PoCExchangeDeadline()+p.GraceBlocks, ec.StartOfPoCValidation()-1)
+}
+
+// IsLateJoinWindow reports whether PoC submitted at blockHeight is late but still accepted
+func (p LateJoinPolicy) IsLateJoinWindow(ec *EpochContext, blockHeight int64) bool {
+	if ec.EpochIndex == 0 {
+		return false
+	}
+	return blockHeight > ec.PoCExchangeDeadline() && blockHeight <= p.LateJoinDeadline(ec)
+}
+
+// AmendmentHeight is the block the late joiners of the epoch are added to its epoch group at
+func (p LateJoinPolicy) AmendmentHeight(ec *EpochContext) int64 {
+	return ec.SetNewValidators() + p.AmendmentDelay
+}
+
+// PenalizedWeight is the weight a late joiner keeps
+func (p LateJoinPolicy) PenalizedWeight(weight int64) int64 {
+	penalty, err := decimal.NewFromString(p.WeightPenalty)
+	if err != nil {
+		return weight
+	}
+	return decimal.NewFromInt(weight).Mul(decimal.NewFromInt(1).Sub(penalty)).IntPart()
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDelegateToParticipant{}
+
+func NewMsgDelegateToParticipant(delegator string, participant string, amount uint64) *MsgDelegateToParticipant {
+	return &MsgDelegateToParticipant{Delegator: delegator, Participant: participant, Amount: amount}
+}
+
+func (msg *MsgDelegateToParticipant) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Participant, msg.Amount)
+}
+
+func validateDelegationMsg(delegator string, participant string, amount uint64) error {
+	if _, err := sdk.AccAddressFromBech32(delegator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(participant); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid participant address (%s)", err)
+	}
+	if amount == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDelegateToParticipant_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDelegateToParticipant
+		err  error
+	}{
+		{
+			name: "invalid delegator",
+			msg: MsgDelegateToParticipant{
+				Delegator:   "invalid_address",
+				Participant: sample.AccAddress(),
+				Amount:      1000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid participant",
+			msg: MsgDelegateToParticipant{
+				Delegator:   sample.AccAddress(),
+				Participant: "invalid_address",
+				Amount:      1000,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "zero amount",
+			msg: MsgDelegateToParticipant{
+				Delegator:   sample.AccAddress(),
+				Participant: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgDelegateToParticipant{
+				Delegator:   sample.AccAddress(),
+				Participant: sample.AccAddress(),
+				Amount:      1000,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
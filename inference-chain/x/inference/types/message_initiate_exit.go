@@ -0,0 +1,20 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgInitiateExit{}
+
+func NewMsgInitiateExit(creator string) *MsgInitiateExit {
+	return &MsgInitiateExit{Creator: creator}
+}
+
+func (msg *MsgInitiateExit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgInitiateExit_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgInitiateExit
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgInitiateExit{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgInitiateExit{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
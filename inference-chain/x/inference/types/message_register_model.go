@@ -26,5 +26,5 @@ func (msg *MsgRegisterModel) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid proposedBy address (%s)", err)
 	}
-	return nil
+	return ValidateModelId(msg.Id)
 }
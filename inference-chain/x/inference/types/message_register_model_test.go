@@ -23,6 +23,15 @@ func TestMsgRegisterModel_ValidateBasic(t *testing.T) {
 				ValidationThreshold: &Decimal{Value: 85, Exponent: -2},
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "priority class separator in model id",
+			msg: MsgRegisterModel{
+				Authority:           sample.AccAddress(),
+				ProposedBy:          sample.AccAddress(),
+				Id:                  "model-1@batch",
+				ValidationThreshold: &Decimal{Value: 85, Exponent: -2},
+			},
+			err: ErrInvalidModel,
 		}, {
 			name: "valid address",
 			msg: MsgRegisterModel{
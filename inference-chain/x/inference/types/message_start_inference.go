@@ -43,9 +43,13 @@ func (msg *MsgStartInference) ValidateBasic() error {
 	if err := utils.ValidateBase64RSig64("inference_id", strings.TrimSpace(msg.InferenceId)); err != nil {
 		return err
 	}
-	if strings.TrimSpace(msg.Model) == "" {
+	modelId, priorityClass := SplitPriorityClassModel(msg.Model)
+	if strings.TrimSpace(modelId) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "model is required")
 	}
+	if !IsPriorityClass(priorityClass) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unknown priority class %q", priorityClass)
+	}
 	if strings.TrimSpace(msg.PromptHash) == "" {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "prompt_hash is required")
 	}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUndelegate{}
+
+func NewMsgUndelegate(delegator string, participant string, amount uint64) *MsgUndelegate {
+	return &MsgUndelegate{Delegator: delegator, Participant: participant, Amount: amount}
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Participant, msg.Amount)
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdatePolicy{}
+
+func NewMsgUpdatePolicy(authority string, policy string, value string) *MsgUpdatePolicy {
+	return &MsgUpdatePolicy{
+		Authority: authority,
+		Policy:    policy,
+		Value:     value,
+	}
+}
+
+func (msg *MsgUpdatePolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+	if strings.TrimSpace(msg.Policy) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "policy is required")
+	}
+	if strings.TrimSpace(msg.Value) == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "value is required")
+	}
+	return nil
+}
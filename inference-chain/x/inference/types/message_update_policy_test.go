@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdatePolicy_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdatePolicy
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgUpdatePolicy{
+				Authority: "invalid_address",
+				Policy:    PolicyLateJoin,
+				Value:     "{}",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing policy",
+			msg: MsgUpdatePolicy{
+				Authority: sample.AccAddress(),
+				Value:     "{}",
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "missing value",
+			msg: MsgUpdatePolicy{
+				Authority: sample.AccAddress(),
+				Policy:    PolicyLateJoin,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg: MsgUpdatePolicy{
+				Authority: sample.AccAddress(),
+				Policy:    PolicyLateJoin,
+				Value:     "{}",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
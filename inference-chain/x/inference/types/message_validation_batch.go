@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxValidationBatchSize bounds how many validations a single MsgValidationBatch may carry
+const MaxValidationBatchSize = 100
+
+var _ sdk.Msg = &MsgValidationBatch{}
+
+func NewMsgValidationBatch(creator string, validations []ValidationBatchItem) *MsgValidationBatch {
+	return &MsgValidationBatch{
+		Creator:     creator,
+		Validations: validations,
+	}
+}
+
+// ValidateBasic only checks the batch itself. Items are checked one by one when the batch is handled,
+// so a malformed item is rejected on its own.
+func (msg *MsgValidationBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Validations) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "validations are required")
+	}
+	if len(msg.Validations) > MaxValidationBatchSize {
+		return errorsmod.Wrapf(ErrTooManyValidationsInTx, "%d validations, limit is %d", len(msg.Validations), MaxValidationBatchSize)
+	}
+	return nil
+}
+
+// ToMsgValidation returns the MsgValidation the item is handled as
+func (item ValidationBatchItem) ToMsgValidation(creator string) *MsgValidation {
+	return &MsgValidation{
+		Creator:      creator,
+		Id:           item.Id,
+		InferenceId:  item.InferenceId,
+		ResponseHash: item.ResponseHash,
+		Revalidation: item.Revalidation,
+		ValueDecimal: item.ValueDecimal,
+	}
+}
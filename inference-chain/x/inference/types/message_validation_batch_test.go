@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/productscience/inference/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgValidationBatch_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgValidationBatch
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg: MsgValidationBatch{
+				Creator:     "invalid_address",
+				Validations: []ValidationBatchItem{{InferenceId: "inference"}},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty batch",
+			msg: MsgValidationBatch{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "too many validations",
+			msg: MsgValidationBatch{
+				Creator:     sample.AccAddress(),
+				Validations: make([]ValidationBatchItem, MaxValidationBatchSize+1),
+			},
+			err: ErrTooManyValidationsInTx,
+		}, {
+			name: "malformed item is left to the handler",
+			msg: MsgValidationBatch{
+				Creator:     sample.AccAddress(),
+				Validations: []ValidationBatchItem{{InferenceId: "inference"}, {}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
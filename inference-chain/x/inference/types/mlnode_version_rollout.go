@@ -0,0 +1,111 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+)
+
+// MLNodeVersionRollout moves the ML nodes to TargetVersion in stages. At each model assignment, the
+// CanaryPercent of every participant's nodes is marked to run the target version while the others stay
+// on the current MLNodeVersion. Governance starts and advances a rollout with partial upgrades, see
+// ParseMLNodeVersionRollout; at 100 percent the target becomes the current version.
+type MLNodeVersionRollout struct {
+	TargetVersion string `json:"target_version"`
+	CanaryPercent uint32 `json:"canary_percent"`
+}
+
+func (r MLNodeVersionRollout) Validate() error {
+	if r.TargetVersion == "" {
+		return fmt.Errorf("rollout target version is empty")
+	}
+	if r.CanaryPercent > 100 {
+		return fmt.Errorf("rollout canary percent %d exceeds 100", r.CanaryPercent)
+	}
+	return nil
+}
+
+// ParseMLNodeVersionRollout returns the rollout a partial upgrade starts or advances. Such a partial
+// upgrade has the target as NodeVersion and {"mlnode_rollout": {"canary_percent": N}} as ApiBinariesJson,
+// instead of switching all nodes to NodeVersion at its height. The API does not read api_binaries from it.
+func ParseMLNodeVersionRollout(nodeVersion, apiBinariesJson string) (MLNodeVersionRollout, bool, error) {
+	if apiBinariesJson == "" {
+		return MLNodeVersionRollout{}, false, nil
+	}
+	var info struct {
+		MLNodeRollout *struct {
+			CanaryPercent uint32 `json:"canary_percent"`
+		} `json:"mlnode_rollout"`
+	}
+	if err := json.Unmarshal([]byte(apiBinariesJson), &info); err != nil || info.MLNodeRollout == nil {
+		// Not a rollout, the API binaries are validated by the API
+		return MLNodeVersionRollout{}, false, nil
+	}
+	rollout := MLNodeVersionRollout{TargetVersion: nodeVersion, CanaryPercent: info.MLNodeRollout.CanaryPercent}
+	if err := rollout.Validate(); err != nil {
+		return MLNodeVersionRollout{}, true, err
+	}
+	return rollout, true, nil
+}
+
+// CanaryNodes returns the ids of a participant's nodes that run the target version, sorted: the
+// CanaryPercent of the nodes, rounded down, that rank first by sha256(target version, participant, node id).
+// The ranking doesn't depend on the epoch or the percentage, so raising the percentage only adds nodes.
+func (r MLNodeVersionRollout) CanaryNodes(participant string, nodeIds []string) []string {
+	count := len(nodeIds) * int(r.CanaryPercent) / 100
+	if count == 0 {
+		return nil
+	}
+	ranked := make([]string, len(nodeIds))
+	copy(ranked, nodeIds)
+	rank := make(map[string][32]byte, len(ranked))
+	for _, nodeId := range ranked {
+		rank[nodeId] = sha256.Sum256([]byte(r.TargetVersion + "/" + participant + "/" + nodeId))
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := rank[ranked[i]], rank[ranked[j]]
+		return string(a[:]) < string(b[:])
+	})
+	canaries := ranked[:count]
+	sort.Strings(canaries)
+	return canaries
+}
+
+// MLNodeVersionAssignment records which nodes run the target version of a rollout in an epoch.
+// Nodes not listed run CurrentVersion. It is stored as JSON (see Keeper.SetMLNodeVersionAssignment),
+// so fields must stay deterministic: slices only, in a stable order.
+type MLNodeVersionAssignment struct {
+	EpochIndex     uint64                   `json:"epoch_index"`
+	CurrentVersion string                   `json:"current_version"`
+	TargetVersion  string                   `json:"target_version"`
+	CanaryPercent  uint32                   `json:"canary_percent"`
+	Participants   []ParticipantCanaryNodes `json:"participants"`
+}
+
+type ParticipantCanaryNodes struct {
+	Participant string   `json:"participant"`
+	NodeIds     []string `json:"node_ids"`
+}
+
+// VersionFor returns the version a participant's node runs in the epoch
+func (a MLNodeVersionAssignment) VersionFor(participant, nodeId string) string {
+	for _, p := range a.Participants {
+		if p.Participant != participant {
+			continue
+		}
+		for _, id := range p.NodeIds {
+			if id == nodeId {
+				return a.TargetVersion
+			}
+		}
+	}
+	return a.CurrentVersion
+}
+
+// MLNodeVersionAssignmentFullKey returns the store key of the assignment for an epoch, for raw store queries
+func MLNodeVersionAssignmentFullKey(epochIndex uint64) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(MLNodeVersionAssignmentsPrefix, collections.Uint64Key, epochIndex)
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMLNodeVersionRollout(t *testing.T) {
+	rollout, isRollout, err := ParseMLNodeVersionRollout("v3.1.0", `{"mlnode_rollout": {"canary_percent": 25}}`)
+	require.NoError(t, err)
+	require.True(t, isRollout)
+	require.Equal(t, MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 25}, rollout)
+
+	_, isRollout, _ = ParseMLNodeVersionRollout("v3.1.0", `{"api_binaries": {"linux/amd64": "https://example.com/api"}}`)
+	require.False(t, isRollout)
+	_, isRollout, _ = ParseMLNodeVersionRollout("v3.1.0", "")
+	require.False(t, isRollout)
+
+	_, isRollout, err = ParseMLNodeVersionRollout("v3.1.0", `{"mlnode_rollout": {"canary_percent": 101}}`)
+	require.True(t, isRollout)
+	require.Error(t, err)
+	_, _, err = ParseMLNodeVersionRollout("", `{"mlnode_rollout": {"canary_percent": 10}}`)
+	require.Error(t, err)
+}
+
+func TestCanaryNodes(t *testing.T) {
+	var nodeIds []string
+	for i := 0; i < 10; i++ {
+		nodeIds = append(nodeIds, fmt.Sprintf("node-%d", i))
+	}
+	rollout := MLNodeVersionRollout{TargetVersion: "v3.1.0", CanaryPercent: 10}
+	require.Empty(t, rollout.CanaryNodes("participant", nodeIds[:9]))
+
+	previous := rollout.CanaryNodes("participant", nodeIds)
+	require.Len(t, previous, 1)
+	for _, percent := range []uint32{30, 50, 90, 100} {
+		rollout.CanaryPercent = percent
+		canaries := rollout.CanaryNodes("participant", nodeIds)
+		require.Len(t, canaries, int(percent)/10)
+		// Raising the percentage keeps the canaries and adds more
+		require.Subset(t, canaries, previous)
+		previous = canaries
+	}
+
+	// The selection doesn't depend on the order the nodes are listed in
+	rollout.CanaryPercent = 50
+	reversed := make([]string, len(nodeIds))
+	for i, id := range nodeIds {
+		reversed[len(nodeIds)-1-i] = id
+	}
+	require.Equal(t, rollout.CanaryNodes("participant", nodeIds), rollout.CanaryNodes("participant", reversed))
+
+	assignment := MLNodeVersionAssignment{
+		CurrentVersion: "v3.0.8",
+		TargetVersion:  "v3.1.0",
+		Participants:   []ParticipantCanaryNodes{{Participant: "participant", NodeIds: []string{"node-1"}}},
+	}
+	require.Equal(t, "v3.1.0", assignment.VersionFor("participant", "node-1"))
+	require.Equal(t, "v3.0.8", assignment.VersionFor("participant", "node-2"))
+	require.Equal(t, "v3.0.8", assignment.VersionFor("other", "node-1"))
+}
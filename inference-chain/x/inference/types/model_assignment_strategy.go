@@ -0,0 +1,25 @@
+package types
+
+import "fmt"
+
+// Model assignment strategies used by the ModelAssigner when distributing a participant's
+// ML nodes across governance models at the start of an epoch.
+const (
+	// ModelAssignmentStrategyGreedy walks governance models in order and gives each model
+	// every still unassigned node that supports it (first fit).
+	ModelAssignmentStrategyGreedy = "greedy"
+	// ModelAssignmentStrategyBalanced assigns nodes to the supported model with the least
+	// throughput assigned so far, balancing total throughput per model across the network.
+	ModelAssignmentStrategyBalanced = "balanced"
+
+	DefaultModelAssignmentStrategy = ModelAssignmentStrategyGreedy
+)
+
+func ValidateModelAssignmentStrategy(strategy string) error {
+	switch strategy {
+	case ModelAssignmentStrategyGreedy, ModelAssignmentStrategyBalanced:
+		return nil
+	default:
+		return fmt.Errorf("unknown model assignment strategy: %q", strategy)
+	}
+}
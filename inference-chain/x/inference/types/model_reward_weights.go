@@ -0,0 +1,44 @@
+package types
+
+import "fmt"
+
+const (
+	// ModelRewardNeutralPermille is the multiplier of models without a configured premium
+	ModelRewardNeutralPermille uint32 = 1000
+	// MaxModelRewardPermille caps a model multiplier at 10x
+	MaxModelRewardPermille uint32 = 10000
+)
+
+// ModelRewardWeights weights the distribution of the epoch reward by the models participants serve,
+// so that scarce capacity (e.g. for large models) earns a premium. The PoC weight of each participant
+// is scaled by the average multiplier of its models, weighted by its weight in each model group.
+// The minted epoch reward does not change, only its split between participants.
+// Without multipliers the mode is disabled and rewards follow PoC weight alone.
+type ModelRewardWeights struct {
+	// MultipliersPermille by model id, 1000 is neutral; models not listed are neutral
+	MultipliersPermille map[string]uint32 `json:"multipliers_permille"`
+}
+
+func (w ModelRewardWeights) Validate() error {
+	for modelId, permille := range w.MultipliersPermille {
+		if modelId == "" {
+			return fmt.Errorf("model reward multiplier without model id")
+		}
+		if permille == 0 || permille > MaxModelRewardPermille {
+			return fmt.Errorf("model reward multiplier of %s must be between 1 and %d permille, got %d", modelId, MaxModelRewardPermille, permille)
+		}
+	}
+	return nil
+}
+
+func (w ModelRewardWeights) Enabled() bool {
+	return len(w.MultipliersPermille) > 0
+}
+
+// MultiplierPermille returns the multiplier of a model, neutral when none is configured
+func (w ModelRewardWeights) MultiplierPermille(modelId string) uint32 {
+	if permille, ok := w.MultipliersPermille[modelId]; ok {
+		return permille
+	}
+	return ModelRewardNeutralPermille
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"sort"
+
+	"cosmossdk.io/collections"
+)
+
+// ModelEpochUsage accumulates the completed inferences of a model within an epoch, it is the served side
+// of the network capacity. Timestamps are block times in unix seconds.
+type ModelEpochUsage struct {
+	EpochIndex       uint64 `json:"epoch_index"`
+	ModelId          string `json:"model_id"`
+	Inferences       uint64 `json:"inferences"`
+	PromptTokens     uint64 `json:"prompt_tokens"`
+	CompletionTokens uint64 `json:"completion_tokens"`
+	FirstCompletedAt int64  `json:"first_completed_at"`
+	LastCompletedAt  int64  `json:"last_completed_at"`
+}
+
+func (u ModelEpochUsage) Tokens() uint64 {
+	return u.PromptTokens + u.CompletionTokens
+}
+
+// ModelEpochUsageFullKey returns the store key of the usage of a model in an epoch, for raw store queries
+func ModelEpochUsageFullKey(epochIndex uint64, modelId string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		ModelEpochUsagePrefix,
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		collections.Join(epochIndex, modelId),
+	)
+}
+
+// ModelNetworkCapacity is the capacity of a model group in an epoch next to what it served so far.
+// TotalThroughput is the sum of the throughput declared for the ML nodes of the group, read as tokens per
+// second. TokensPerSecond averages the served tokens from the first completed inference of the epoch until
+// now and Utilization is its ratio to TotalThroughput; both are 0 while nothing was served.
+type ModelNetworkCapacity struct {
+	ModelId          string  `json:"model_id"`
+	Participants     uint64  `json:"participants"`
+	MLNodes          uint64  `json:"ml_nodes"`
+	TotalWeight      int64   `json:"total_weight"`
+	TotalThroughput  int64   `json:"total_throughput"`
+	Inferences       uint64  `json:"inferences"`
+	PromptTokens     uint64  `json:"prompt_tokens"`
+	CompletionTokens uint64  `json:"completion_tokens"`
+	TokensPerSecond  float64 `json:"tokens_per_second"`
+	Utilization      float64 `json:"utilization"`
+}
+
+// NetworkCapacity aggregates the capacity and utilization of every model served in an epoch, ordered by model id
+type NetworkCapacity struct {
+	EpochIndex      uint64                 `json:"epoch_index"`
+	Models          []ModelNetworkCapacity `json:"models"`
+	Participants    uint64                 `json:"participants"`
+	MLNodes         uint64                 `json:"ml_nodes"`
+	TotalThroughput int64                  `json:"total_throughput"`
+	Inferences      uint64                 `json:"inferences"`
+	TokensPerSecond float64                `json:"tokens_per_second"`
+	Utilization     float64                `json:"utilization"`
+}
+
+// NewNetworkCapacity builds the capacity of an epoch from its model subgroups and the usage recorded per model.
+// now is the current block time in unix seconds.
+func NewNetworkCapacity(epochIndex uint64, subgroups []EpochGroupData, usage map[string]ModelEpochUsage, now int64) NetworkCapacity {
+	capacity := NetworkCapacity{EpochIndex: epochIndex, Models: make([]ModelNetworkCapacity, 0, len(subgroups))}
+	participants := make(map[string]struct{})
+	for _, subgroup := range subgroups {
+		model := ModelNetworkCapacity{
+			ModelId:         subgroup.ModelId,
+			TotalWeight:     subgroup.TotalWeight,
+			TotalThroughput: subgroup.TotalThroughput,
+		}
+		for _, vw := range subgroup.ValidationWeights {
+			if vw == nil {
+				continue
+			}
+			model.Participants++
+			participants[vw.MemberAddress] = struct{}{}
+			for _, node := range vw.MlNodes {
+				if node != nil {
+					model.MLNodes++
+				}
+			}
+		}
+		if u, ok := usage[subgroup.ModelId]; ok {
+			model.Inferences = u.Inferences
+			model.PromptTokens = u.PromptTokens
+			model.CompletionTokens = u.CompletionTokens
+			model.TokensPerSecond = tokensPerSecond(u.Tokens(), u.FirstCompletedAt, now)
+			model.Utilization = utilization(model.TokensPerSecond, model.TotalThroughput)
+		}
+
+		capacity.MLNodes += model.MLNodes
+		capacity.TotalThroughput += model.TotalThroughput
+		capacity.Inferences += model.Inferences
+		capacity.TokensPerSecond += model.TokensPerSecond
+		capacity.Models = append(capacity.Models, model)
+	}
+	sort.Slice(capacity.Models, func(i, j int) bool {
+		return capacity.Models[i].ModelId < capacity.Models[j].ModelId
+	})
+	capacity.Participants = uint64(len(participants))
+	capacity.Utilization = utilization(capacity.TokensPerSecond, capacity.TotalThroughput)
+	return capacity
+}
+
+func tokensPerSecond(tokens uint64, since, now int64) float64 {
+	if tokens == 0 {
+		return 0
+	}
+	elapsed := now - since
+	if elapsed < 1 {
+		elapsed = 1
+	}
+	return float64(tokens) / float64(elapsed)
+}
+
+func utilization(tokensPerSecond float64, throughput int64) float64 {
+	if throughput <= 0 {
+		return 0
+	}
+	return tokensPerSecond / float64(throughput)
+}
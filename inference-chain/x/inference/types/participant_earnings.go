@@ -0,0 +1,30 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParticipantEpochEarnings is what a participant earned and lost in one epoch, in BaseCoin.
+// Work and reward coins are written when the epoch is settled; refunds and slashes as they happen.
+type ParticipantEpochEarnings struct {
+	Participant string `json:"participant"`
+	EpochIndex  uint64 `json:"epoch_index"`
+	WorkCoins   uint64 `json:"work_coins"`
+	RewardCoins uint64 `json:"reward_coins"`
+	// RefundedCoins is the executor payment for inferences of the epoch that were invalidated and
+	// refunded to their requesters
+	RefundedCoins uint64 `json:"refunded_coins"`
+	// SlashedCoins is the collateral slashed for downtime or invalid status during the epoch
+	SlashedCoins uint64 `json:"slashed_coins"`
+	Settled      bool   `json:"settled"`
+}
+
+// ParticipantEarningsFullKey returns the store key of a participant's earnings in an epoch, for raw store queries
+func ParticipantEarningsFullKey(participant sdk.AccAddress, epochIndex uint64) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		ParticipantEarningsPrefix,
+		collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+		collections.Join(participant, epochIndex),
+	)
+}
@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/participant_exit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParticipantExit tracks a participant leaving the network, from its exit request until its collateral
+// is released. The participant serves up to last_epoch and is left out of every epoch group after it.
+type ParticipantExit struct {
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RequestedAtEpoch uint64 `protobuf:"varint,2,opt,name=requested_at_epoch,json=requestedAtEpoch,proto3" json:"requested_at_epoch,omitempty"`
+	// last_epoch is the last epoch the participant is part of the epoch group
+	LastEpoch uint64 `protobuf:"varint,3,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
+	// unbonding is set once last_epoch is settled and the collateral entered the unbonding queue
+	Unbonding bool `protobuf:"varint,4,opt,name=unbonding,proto3" json:"unbonding,omitempty"`
+	// collateral is the unbonding collateral, released at the end of collateral_release_epoch
+	Collateral             string `protobuf:"bytes,5,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	CollateralReleaseEpoch uint64 `protobuf:"varint,6,opt,name=collateral_release_epoch,json=collateralReleaseEpoch,proto3" json:"collateral_release_epoch,omitempty"`
+}
+
+func (m *ParticipantExit) Reset()         { *m = ParticipantExit{} }
+func (m *ParticipantExit) String() string { return proto.CompactTextString(m) }
+func (*ParticipantExit) ProtoMessage()    {}
+func (*ParticipantExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e69ff957ddba876, []int{0}
+}
+func (m *ParticipantExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantExit.Merge(m, src)
+}
+func (m *ParticipantExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantExit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantExit proto.InternalMessageInfo
+
+func (m *ParticipantExit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ParticipantExit) GetRequestedAtEpoch() uint64 {
+	if m != nil {
+		return m.RequestedAtEpoch
+	}
+	return 0
+}
+
+func (m *ParticipantExit) GetLastEpoch() uint64 {
+	if m != nil {
+		return m.LastEpoch
+	}
+	return 0
+}
+
+func (m *ParticipantExit) GetUnbonding() bool {
+	if m != nil {
+		return m.Unbonding
+	}
+	return false
+}
+
+func (m *ParticipantExit) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
+	}
+	return ""
+}
+
+func (m *ParticipantExit) GetCollateralReleaseEpoch() uint64 {
+	if m != nil {
+		return m.CollateralReleaseEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ParticipantExit)(nil), "inference.inference.ParticipantExit")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/participant_exit.proto", fileDescriptor_6e69ff957ddba876)
+}
+
+var fileDescriptor_6e69ff957ddba876 = []byte{
+	// 278 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x18, 0x84, 0xbb, 0x5a, 0xab, 0xdd, 0x8b, 0xb2, 0x82, 0xec, 0x41, 0x97, 0xe0, 0x29, 0x88, 0xa4,
+	0x07, 0x11, 0xbc, 0x2a, 0xf4, 0xac, 0xe4, 0xe8, 0x25, 0x6c, 0x36, 0xbf, 0xed, 0x42, 0xdc, 0x5d,
+	0x77, 0xff, 0x40, 0x7c, 0x0b, 0x1f, 0xcb, 0x63, 0x8f, 0x1e, 0x25, 0x79, 0x01, 0x1f, 0x41, 0x9a,
+	0xda, 0xa4, 0x78, 0x9b, 0x7f, 0xe6, 0x83, 0xf9, 0x19, 0x7a, 0xa5, 0xcd, 0x0b, 0x78, 0x30, 0x0a,
+	0x66, 0x83, 0x72, 0xd2, 0xa3, 0x56, 0xda, 0x49, 0x83, 0x19, 0xd4, 0x1a, 0x13, 0xe7, 0x2d, 0x5a,
+	0x76, 0xda, 0x13, 0x49, 0xaf, 0x2e, 0x7f, 0x08, 0x3d, 0x7e, 0x1a, 0xf8, 0x79, 0xad, 0x91, 0x71,
+	0x7a, 0x28, 0x8b, 0xc2, 0x43, 0x08, 0x9c, 0x44, 0x24, 0x9e, 0xa6, 0xdb, 0x93, 0x5d, 0x53, 0xe6,
+	0xe1, 0xad, 0x82, 0x80, 0x50, 0x64, 0x12, 0x33, 0x70, 0x56, 0x2d, 0xf9, 0x5e, 0x44, 0xe2, 0x71,
+	0x7a, 0xd2, 0x27, 0xf7, 0x38, 0x5f, 0xfb, 0xec, 0x82, 0xd2, 0x52, 0x86, 0x2d, 0xb5, 0xdf, 0x51,
+	0xd3, 0xb5, 0xb3, 0x89, 0xcf, 0xe9, 0xb4, 0x32, 0xb9, 0x35, 0x85, 0x36, 0x0b, 0x3e, 0x8e, 0x48,
+	0x7c, 0x94, 0x0e, 0x06, 0x13, 0x94, 0x2a, 0x5b, 0x96, 0x12, 0xc1, 0xcb, 0x92, 0x1f, 0x74, 0x7f,
+	0xec, 0x38, 0xec, 0x8e, 0xf2, 0xe1, 0xca, 0x3c, 0x94, 0x20, 0x03, 0xfc, 0x55, 0x4d, 0xba, 0xaa,
+	0xb3, 0x21, 0x4f, 0x37, 0x71, 0xd7, 0xfb, 0xf0, 0xf8, 0xd9, 0x08, 0xb2, 0x6a, 0x04, 0xf9, 0x6e,
+	0x04, 0xf9, 0x68, 0xc5, 0x68, 0xd5, 0x8a, 0xd1, 0x57, 0x2b, 0x46, 0xcf, 0xb7, 0x0b, 0x8d, 0xcb,
+	0x2a, 0x4f, 0x94, 0x7d, 0x9d, 0x39, 0x6f, 0x8b, 0x4a, 0x61, 0x50, 0xfa, 0xdf, 0xba, 0xf5, 0x8e,
+	0xc6, 0x77, 0x07, 0x21, 0x9f, 0x74, 0xfb, 0xde, 0xfc, 0x06, 0x00, 0x00, 0xff, 0xff, 0x30, 0x63,
+	0xc9, 0xe0, 0x8d, 0x01, 0x00, 0x00,
+}
+
+func (m *ParticipantExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CollateralReleaseEpoch != 0 {
+		i = encodeVarintParticipantExit(dAtA, i, uint64(m.CollateralReleaseEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintParticipantExit(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Unbonding {
+		i--
+		if m.Unbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastEpoch != 0 {
+		i = encodeVarintParticipantExit(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequestedAtEpoch != 0 {
+		i = encodeVarintParticipantExit(dAtA, i, uint64(m.RequestedAtEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParticipantExit(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParticipantExit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParticipantExit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParticipantExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParticipantExit(uint64(l))
+	}
+	if m.RequestedAtEpoch != 0 {
+		n += 1 + sovParticipantExit(uint64(m.RequestedAtEpoch))
+	}
+	if m.LastEpoch != 0 {
+		n += 1 + sovParticipantExit(uint64(m.LastEpoch))
+	}
+	if m.Unbonding {
+		n += 2
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovParticipantExit(uint64(l))
+	}
+	if m.CollateralReleaseEpoch != 0 {
+		n += 1 + sovParticipantExit(uint64(m.CollateralReleaseEpoch))
+	}
+	return n
+}
+
+func sovParticipantExit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParticipantExit(x uint64) (n int) {
+	return sovParticipantExit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParticipantExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParticipantExit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedAtEpoch", wireType)
+			}
+			m.RequestedAtEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestedAtEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unbonding = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collateral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralReleaseEpoch", wireType)
+			}
+			m.CollateralReleaseEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CollateralReleaseEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParticipantExit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParticipantExit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParticipantExit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParticipantExit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParticipantExit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParticipantExit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParticipantExit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParticipantExit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParticipantExit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParticipantExit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParticipantExit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1004 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/payment_denom.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InferencePayment records the escrow of an inference paid in a registered denom. The inference itself
+// accounts in BaseCoin; this keeps the rate its BaseCoin amounts convert to the denom at, so the executor
+// is paid and the requester refunded in the denom it paid in.
+type InferencePayment struct {
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the conversion rate at escrow time, as a decimal string
+	Rate string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	// amount is what is left in escrow, in denom
+	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *InferencePayment) Reset()         { *m = InferencePayment{} }
+func (m *InferencePayment) String() string { return proto.CompactTextString(m) }
+func (*InferencePayment) ProtoMessage()    {}
+func (*InferencePayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22258496611e69b3, []int{0}
+}
+func (m *InferencePayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InferencePayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InferencePayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InferencePayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InferencePayment.Merge(m, src)
+}
+func (m *InferencePayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *InferencePayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_InferencePayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InferencePayment proto.InternalMessageInfo
+
+func (m *InferencePayment) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
+	}
+	return ""
+}
+
+func (m *InferencePayment) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *InferencePayment) GetRate() string {
+	if m != nil {
+		return m.Rate
+	}
+	return ""
+}
+
+func (m *InferencePayment) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// DenomTreasury tracks the coins the module holds in a registered denom. Escrowed coins leave the
+// module as refunds to requesters or as work coins paid to executors; the difference is the reserve.
+type DenomTreasury struct {
+	Denom    string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Escrowed int64  `protobuf:"varint,2,opt,name=escrowed,proto3" json:"escrowed,omitempty"`
+	Refunded int64  `protobuf:"varint,3,opt,name=refunded,proto3" json:"refunded,omitempty"`
+	// paid_out is what was paid to executors, or sent to governance when unclaimed
+	PaidOut int64 `protobuf:"varint,4,opt,name=paid_out,json=paidOut,proto3" json:"paid_out,omitempty"`
+}
+
+func (m *DenomTreasury) Reset()         { *m = DenomTreasury{} }
+func (m *DenomTreasury) String() string { return proto.CompactTextString(m) }
+func (*DenomTreasury) ProtoMessage()    {}
+func (*DenomTreasury) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22258496611e69b3, []int{1}
+}
+func (m *DenomTreasury) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTreasury) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTreasury.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomTreasury) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTreasury.Merge(m, src)
+}
+func (m *DenomTreasury) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTreasury) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTreasury.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTreasury proto.InternalMessageInfo
+
+func (m *DenomTreasury) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomTreasury) GetEscrowed() int64 {
+	if m != nil {
+		return m.Escrowed
+	}
+	return 0
+}
+
+func (m *DenomTreasury) GetRefunded() int64 {
+	if m != nil {
+		return m.Refunded
+	}
+	return 0
+}
+
+func (m *DenomTreasury) GetPaidOut() int64 {
+	if m != nil {
+		return m.PaidOut
+	}
+	return 0
+}
+
+// ParticipantDenomBalance is what a participant earned in a registered denom during the current epoch.
+// It is settled with the epoch into the participant's settle amount, like its BaseCoin balance.
+type ParticipantDenomBalance struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount      int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *ParticipantDenomBalance) Reset()         { *m = ParticipantDenomBalance{} }
+func (m *ParticipantDenomBalance) String() string { return proto.CompactTextString(m) }
+func (*ParticipantDenomBalance) ProtoMessage()    {}
+func (*ParticipantDenomBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22258496611e69b3, []int{2}
+}
+func (m *ParticipantDenomBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipantDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipantDenomBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipantDenomBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipantDenomBalance.Merge(m, src)
+}
+func (m *ParticipantDenomBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipantDenomBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipantDenomBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipantDenomBalance proto.InternalMessageInfo
+
+func (m *ParticipantDenomBalance) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *ParticipantDenomBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ParticipantDenomBalance) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InferencePayment)(nil), "inference.inference.InferencePayment")
+	proto.RegisterType((*DenomTreasury)(nil), "inference.inference.DenomTreasury")
+	proto.RegisterType((*ParticipantDenomBalance)(nil), "inference.inference.ParticipantDenomBalance")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/payment_denom.proto", fileDescriptor_22258496611e69b3)
+}
+
+var fileDescriptor_22258496611e69b3 = []byte{
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xc1, 0x4e, 0x3a, 0x31,
+	0x10, 0xc6, 0xe9, 0x7f, 0xf9, 0x23, 0x16, 0x4d, 0x4c, 0x35, 0xba, 0x7a, 0x68, 0x90, 0x8b, 0x9c,
+	0xe0, 0x60, 0x7c, 0x01, 0xe2, 0x85, 0x13, 0x64, 0xe3, 0xc9, 0x0b, 0x29, 0xed, 0xa0, 0x4d, 0xdc,
+	0xb6, 0xe9, 0xb6, 0x01, 0xde, 0xc2, 0xc7, 0xf2, 0xc8, 0xd1, 0xa3, 0x81, 0x17, 0x31, 0x14, 0xb7,
+	0xbb, 0x31, 0xf1, 0xf6, 0xfd, 0xe6, 0xeb, 0xcc, 0xf4, 0xcb, 0xe0, 0x3b, 0xa9, 0x16, 0x60, 0x41,
+	0x71, 0x18, 0x56, 0xca, 0xb0, 0x75, 0x0e, 0xca, 0xcd, 0x04, 0x28, 0x9d, 0x0f, 0x8c, 0xd5, 0x4e,
+	0x93, 0xf3, 0x68, 0x0f, 0xa2, 0xea, 0x2d, 0xf1, 0xd9, 0xb8, 0x84, 0xe9, 0xa1, 0x89, 0xdc, 0xe2,
+	0x93, 0xf8, 0x60, 0x26, 0x45, 0x8a, 0xba, 0xa8, 0x7f, 0x9c, 0x75, 0x62, 0x6d, 0x2c, 0xc8, 0x05,
+	0xfe, 0x1f, 0x46, 0xa7, 0xff, 0x82, 0x77, 0x00, 0x42, 0x70, 0xd3, 0x32, 0x07, 0x69, 0x12, 0x8a,
+	0x41, 0x93, 0x4b, 0xdc, 0x62, 0xb9, 0xf6, 0xca, 0xa5, 0xcd, 0x2e, 0xea, 0x27, 0xd9, 0x0f, 0xf5,
+	0x56, 0xf8, 0xf4, 0x71, 0xdf, 0xf4, 0x64, 0x81, 0x15, 0xde, 0xae, 0xab, 0x91, 0xa8, 0x3e, 0xf2,
+	0x06, 0xb7, 0xa1, 0xe0, 0x56, 0x2f, 0x41, 0x84, 0x5d, 0x49, 0x16, 0x79, 0xef, 0x59, 0x58, 0x78,
+	0x25, 0x40, 0x84, 0x95, 0x49, 0x16, 0x99, 0x5c, 0xe3, 0xb6, 0x61, 0x52, 0xcc, 0xb4, 0x2f, 0x17,
+	0x1f, 0xed, 0x79, 0xe2, 0x5d, 0x4f, 0xe2, 0xab, 0x29, 0xb3, 0x4e, 0x72, 0x69, 0x98, 0x72, 0xe1,
+	0x13, 0x23, 0xf6, 0xc6, 0x14, 0x07, 0xd2, 0xc5, 0x1d, 0x53, 0x59, 0x65, 0xf0, 0x5a, 0xe9, 0x8f,
+	0xe0, 0x55, 0xc8, 0xa4, 0x1e, 0x72, 0x34, 0xf9, 0xd8, 0x52, 0xb4, 0xd9, 0x52, 0xf4, 0xb5, 0xa5,
+	0xe8, 0x7d, 0x47, 0x1b, 0x9b, 0x1d, 0x6d, 0x7c, 0xee, 0x68, 0xe3, 0xf9, 0xe1, 0x45, 0xba, 0x57,
+	0x3f, 0x1f, 0x70, 0x9d, 0x0f, 0x8d, 0xd5, 0xc2, 0x73, 0x57, 0x70, 0xf9, 0xeb, 0x8a, 0xab, 0x9a,
+	0x76, 0x6b, 0x03, 0xc5, 0xbc, 0x15, 0x4e, 0x79, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0x02, 0xe7,
+	0xbf, 0x2f, 0xf5, 0x01, 0x00, 0x00,
+}
+
+func (m *InferencePayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InferencePayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InferencePayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTreasury) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTreasury) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTreasury) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaidOut != 0 {
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(m.PaidOut))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Refunded != 0 {
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(m.Refunded))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Escrowed != 0 {
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(m.Escrowed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipantDenomBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipantDenomBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipantDenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintPaymentDenom(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPaymentDenom(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPaymentDenom(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InferencePayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovPaymentDenom(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *DenomTreasury) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	if m.Escrowed != 0 {
+		n += 1 + sovPaymentDenom(uint64(m.Escrowed))
+	}
+	if m.Refunded != 0 {
+		n += 1 + sovPaymentDenom(uint64(m.Refunded))
+	}
+	if m.PaidOut != 0 {
+		n += 1 + sovPaymentDenom(uint64(m.PaidOut))
+	}
+	return n
+}
+
+func (m *ParticipantDenomBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPaymentDenom(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovPaymentDenom(uint64(m.Amount))
+	}
+	return n
+}
+
+func sovPaymentDenom(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPaymentDenom(x uint64) (n int) {
+	return sovPaymentDenom(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InferencePayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymentDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InferencePayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InferencePayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymentDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTreasury) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymentDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTreasury: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTreasury: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			m.Escrowed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Escrowed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			m.Refunded = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Refunded |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidOut", wireType)
+			}
+			m.PaidOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymentDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipantDenomBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPaymentDenom
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantDenomBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantDenomBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPaymentDenom(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPaymentDenom
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPaymentDenom(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPaymentDenom
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPaymentDenom
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPaymentDenom
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPaymentDenom
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPaymentDenom
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPaymentDenom        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPaymentDenom          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPaymentDenom = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/shopspring/decimal"
+)
+
+// PaymentDenom is a registered denom, usually an IBC denom, that inference requesters can pay in
+type PaymentDenom struct {
+	Denom string `json:"denom"`
+	// Rate is the value of one unit of Denom in BaseCoin, as a decimal string
+	Rate string `json:"rate"`
+}
+
+// PaymentDenomRegistry lists the denoms inference payments are accepted in besides BaseCoin, with
+// their conversion rates. A requester pays in BaseCoin when it can, otherwise in the first registered
+// denom it holds enough of.
+type PaymentDenomRegistry struct {
+	Denoms []PaymentDenom `json:"denoms"`
+}
+
+func (r PaymentDenomRegistry) Validate() error {
+	seen := make(map[string]bool, len(r.Denoms))
+	for _, d := range r.Denoms {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return fmt.Errorf("invalid payment denom %q: %w", d.Denom, err)
+		}
+		if d.Denom == BaseCoin {
+			return fmt.Errorf("%s is always accepted and cannot be registered", BaseCoin)
+		}
+		if seen[d.Denom] {
+			return fmt.Errorf("payment denom %s is registered twice", d.Denom)
+		}
+		seen[d.Denom] = true
+		rate, err := decimal.NewFromString(d.Rate)
+		if err != nil {
+			return fmt.Errorf("invalid rate %q for %s: %w", d.Rate, d.Denom, err)
+		}
+		if !rate.IsPositive() {
+			return fmt.Errorf("rate for %s must be positive, got %s", d.Denom, rate)
+		}
+	}
+	return nil
+}
+
+// DenomAmount converts an amount in BaseCoin to the denom, rounding up for charges and down for payouts and refunds
+func DenomAmount(nativeAmount int64, rate string, roundUp bool) (int64, error) {
+	r, err := decimal.NewFromString(rate)
+	if err != nil {
+		return 0, err
+	}
+	if !r.IsPositive() {
+		return 0, fmt.Errorf("rate must be positive, got %s", r)
+	}
+	amount := decimal.NewFromInt(nativeAmount).Div(r)
+	if roundUp {
+		return amount.Ceil().IntPart(), nil
+	}
+	return amount.Floor().IntPart(), nil
+}
+
+// Reserve is what the module holds in the denom: escrowed coins not refunded or paid out yet
+func (t DenomTreasury) Reserve() int64 {
+	return t.Escrowed - t.Refunded - t.PaidOut
+}
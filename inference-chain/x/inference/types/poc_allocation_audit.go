@@ -0,0 +1,59 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// PoC slot allocation decisions recorded for each node in a PocAllocationAudit
+const (
+	PocAllocationAllocated          = "allocated"
+	PocAllocationNoPreviousReward   = "no_previous_epoch_reward"
+	PocAllocationBelowThresholds    = "participant_below_thresholds"
+	PocAllocationNotSampled         = "participant_not_sampled"
+	PocAllocationNodeFiltered       = "node_filtered_by_weight_threshold"
+	PocAllocationVotingConstraint   = "voting_constraint"
+	PocAllocationTargetWeightFilled = "target_weight_reached"
+)
+
+// PocAllocationAudit records how POC_SLOT allocation was decided for one model in an epoch,
+// so participants can verify why their nodes were or were not allocated.
+// It is stored as JSON (see Keeper.SetPocAllocationAudit), so fields must stay deterministic:
+// slices only, in a stable order.
+type PocAllocationAudit struct {
+	EpochIndex uint64 `json:"epoch_index"`
+	ModelId    string `json:"model_id"`
+
+	// Thresholds shared by all models
+	ParticipantWeightThreshold int64 `json:"participant_weight_threshold"` // 75% rule
+	GlobalMaxNodeWeight        int64 `json:"global_max_node_weight"`       // IQR outlier threshold, 0 = no filtering
+	MaxAllowedNonVotingWeight  int64 `json:"max_allowed_non_voting_weight"`
+
+	// Deterministic sampling of participants with history
+	Seed                 string   `json:"seed"`
+	SeedInt              int64    `json:"seed_int"`
+	EligibleParticipants []string `json:"eligible_participants"`
+	SampledParticipants  []string `json:"sampled_participants"`
+
+	AllocationFraction string `json:"allocation_fraction"`
+	TotalWeight        int64  `json:"total_weight"`
+	TargetWeight       int64  `json:"target_weight"`
+	AllocatedWeight    int64  `json:"allocated_weight"`
+
+	Nodes []PocAllocationNodeDecision `json:"nodes"`
+}
+
+type PocAllocationNodeDecision struct {
+	Participant string `json:"participant"`
+	NodeId      string `json:"node_id"`
+	PocWeight   int64  `json:"poc_weight"`
+	// EffectiveThreshold is the per-participant node weight threshold (25% rule capped by IQR)
+	EffectiveThreshold int64  `json:"effective_threshold"`
+	Decision           string `json:"decision"`
+}
+
+// PocAllocationAuditFullKey returns the store key of the audit for an epoch and model, for raw store queries
+func PocAllocationAuditFullKey(epochIndex uint64, modelId string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(
+		PocAllocationAuditsPrefix,
+		collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		collections.Join(epochIndex, modelId),
+	)
+}
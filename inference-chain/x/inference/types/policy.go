@@ -0,0 +1,37 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Governance policies set with MsgUpdatePolicy. The value of each is the JSON encoding of the type
+// its keeper setter takes.
+const (
+	PolicyModelAssignmentStrategy       = "model_assignment_strategy"         // string, see ModelAssignmentStrategy*
+	PolicyTimeslotSchedule              = "timeslot_schedule"                 // TimeslotSchedule
+	PolicyHardwareVerificationRequired  = "hardware_verification_required"    // bool
+	PolicySlashing                      = "slashing_policy"                   // SlashingPolicy
+	PolicyEpochGroupDataRetentionEpochs = "epoch_group_data_retention_epochs" // uint64
+	PolicyTrainingAttestation           = "training_attestation_policy"       // TrainingAttestationPolicy
+	PolicyValidationCircuitBreaker      = "validation_circuit_breaker_policy" // ValidationCircuitBreakerPolicy
+	PolicyLateJoin                      = "late_join_policy"                  // LateJoinPolicy
+	PolicyPaymentDenomRegistry          = "payment_denom_registry"            // PaymentDenomRegistry
+	PolicyRewardVestingSchedule         = "reward_vesting_schedule"           // RewardVestingSchedule
+	PolicyImageInput                    = "image_input_policy"                // ImageInputPolicy
+	PolicyMLNodeVersionRollout          = "mlnode_version_rollout"            // MLNodeVersionRollout
+	PolicyModelRewardWeights            = "model_reward_weights"              // ModelRewardWeights
+	PolicyPriorityClassPricing          = "priority_class_pricing"            // PriorityClassPricing
+	PolicyCapacityCollateral            = "capacity_collateral_policy"        // CapacityCollateralPolicy
+	PolicyDelegation                    = "delegation_policy"                 // DelegationPolicy
+	// PolicyTrainingDataset registers one more training dataset rather than replacing a value
+	PolicyTrainingDataset = "training_dataset" // TrainingDataset
+)
+
+// DecodePolicyValue decodes the JSON value of a policy, rejecting fields its type does not have so
+// that a misspelled field fails the proposal instead of silently keeping its default
+func DecodePolicyValue(value []byte, target any) error {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(target)
+}
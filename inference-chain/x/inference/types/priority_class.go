@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Priority classes of inference requests. Realtime is the default class; batch requests accept to wait
+// and to be preempted by realtime traffic, usually for a lower price.
+const (
+	PriorityClassRealtime = "realtime"
+	PriorityClassBatch    = "batch"
+
+	// priorityClassSeparator joins a model id and the priority class of an inference in MsgStartInference.Model.
+	// Model ids never contain it, see ValidateModelId.
+	priorityClassSeparator = "@"
+
+	// PriorityClassNeutralPermille is the multiplier of classes without a configured price
+	PriorityClassNeutralPermille uint32 = 1000
+	// MaxPriorityClassPermille caps a class multiplier at 10x
+	MaxPriorityClassPermille uint32 = 10000
+)
+
+// PriorityClasses lists the known priority classes
+var PriorityClasses = []string{PriorityClassRealtime, PriorityClassBatch}
+
+// PriorityClassPricing prices inferences by priority class: the per-token price of the model is scaled by
+// the multiplier of the class when the inference starts. Classes not listed are priced like the model.
+type PriorityClassPricing struct {
+	// MultipliersPermille by priority class, 1000 is neutral
+	MultipliersPermille map[string]uint32 `json:"multipliers_permille"`
+}
+
+func (p PriorityClassPricing) Validate() error {
+	for class, permille := range p.MultipliersPermille {
+		if !IsPriorityClass(class) {
+			return fmt.Errorf("unknown priority class %q", class)
+		}
+		if permille == 0 || permille > MaxPriorityClassPermille {
+			return fmt.Errorf("multiplier of priority class %s must be between 1 and %d permille, got %d", class, MaxPriorityClassPermille, permille)
+		}
+	}
+	return nil
+}
+
+// MultiplierPermille returns the multiplier of a class, neutral when none is configured
+func (p PriorityClassPricing) MultiplierPermille(class string) uint32 {
+	if permille, ok := p.MultipliersPermille[class]; ok {
+		return permille
+	}
+	return PriorityClassNeutralPermille
+}
+
+// PerTokenPrice scales the per-token price of a model for the class
+func (p PriorityClassPricing) PerTokenPrice(price uint64, class string) uint64 {
+	permille := p.MultiplierPermille(class)
+	if permille == PriorityClassNeutralPermille {
+		return price
+	}
+	return price * uint64(permille) / uint64(PriorityClassNeutralPermille)
+}
+
+func IsPriorityClass(class string) bool {
+	for _, known := range PriorityClasses {
+		if class == known {
+			return true
+		}
+	}
+	return false
+}
+
+// PriorityClassModel returns the model of MsgStartInference for an inference of the class.
+// Realtime inferences keep the bare model id, so they start as they did before priority classes.
+func PriorityClassModel(modelId string, class string) string {
+	if class == "" || class == PriorityClassRealtime {
+		return modelId
+	}
+	return modelId + priorityClassSeparator + class
+}
+
+// ValidateModelId rejects model ids containing the priority class separator, which
+// SplitPriorityClassModel would otherwise read as a model id and a class.
+func ValidateModelId(modelId string) error {
+	if strings.Contains(modelId, priorityClassSeparator) {
+		return errorsmod.Wrapf(ErrInvalidModel, "model id %q must not contain %q, it separates the priority class", modelId, priorityClassSeparator)
+	}
+	return nil
+}
+
+// SplitPriorityClassModel splits the model of MsgStartInference into the model id and the priority class
+func SplitPriorityClassModel(model string) (modelId string, class string) {
+	modelId, class, found := strings.Cut(model, priorityClassSeparator)
+	if !found {
+		return model, PriorityClassRealtime
+	}
+	return modelId, class
+}
+
+// PriorityClassPricingFullKey returns the store key of the priority class pricing, for raw store queries
+func PriorityClassPricingFullKey() []byte {
+	return PriorityClassPricingPrefix.Bytes()
+}
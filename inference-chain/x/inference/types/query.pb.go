@@ -8670,6 +8670,386 @@ func (m *QueryPoCValidationSnapshotResponse) GetFound() bool {
 	return false
 }
 
+type QueryParticipantDelegationsRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryParticipantDelegationsRequest) Reset()         { *m = QueryParticipantDelegationsRequest{} }
+func (m *QueryParticipantDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantDelegationsRequest) ProtoMessage()    {}
+func (*QueryParticipantDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{178}
+}
+func (m *QueryParticipantDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantDelegationsRequest.Merge(m, src)
+}
+func (m *QueryParticipantDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryParticipantDelegationsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+type QueryParticipantDelegationsResponse struct {
+	Delegations ParticipantDelegations `protobuf:"bytes,1,opt,name=delegations,proto3" json:"delegations"`
+	// total is the stake delegated to the participant
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *QueryParticipantDelegationsResponse) Reset()         { *m = QueryParticipantDelegationsResponse{} }
+func (m *QueryParticipantDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipantDelegationsResponse) ProtoMessage()    {}
+func (*QueryParticipantDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{179}
+}
+func (m *QueryParticipantDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipantDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipantDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipantDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipantDelegationsResponse.Merge(m, src)
+}
+func (m *QueryParticipantDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipantDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipantDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipantDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryParticipantDelegationsResponse) GetDelegations() ParticipantDelegations {
+	if m != nil {
+		return m.Delegations
+	}
+	return ParticipantDelegations{}
+}
+
+func (m *QueryParticipantDelegationsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type QueryDelegationUnbondingsRequest struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+}
+
+func (m *QueryDelegationUnbondingsRequest) Reset()         { *m = QueryDelegationUnbondingsRequest{} }
+func (m *QueryDelegationUnbondingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationUnbondingsRequest) ProtoMessage()    {}
+func (*QueryDelegationUnbondingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{180}
+}
+func (m *QueryDelegationUnbondingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationUnbondingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationUnbondingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationUnbondingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationUnbondingsRequest.Merge(m, src)
+}
+func (m *QueryDelegationUnbondingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationUnbondingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationUnbondingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationUnbondingsRequest proto.InternalMessageInfo
+
+func (m *QueryDelegationUnbondingsRequest) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+type QueryDelegationUnbondingsResponse struct {
+	Unbondings []DelegationUnbonding `protobuf:"bytes,1,rep,name=unbondings,proto3" json:"unbondings"`
+}
+
+func (m *QueryDelegationUnbondingsResponse) Reset()         { *m = QueryDelegationUnbondingsResponse{} }
+func (m *QueryDelegationUnbondingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationUnbondingsResponse) ProtoMessage()    {}
+func (*QueryDelegationUnbondingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{181}
+}
+func (m *QueryDelegationUnbondingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDelegationUnbondingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDelegationUnbondingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDelegationUnbondingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationUnbondingsResponse.Merge(m, src)
+}
+func (m *QueryDelegationUnbondingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDelegationUnbondingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationUnbondingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDelegationUnbondingsResponse proto.InternalMessageInfo
+
+func (m *QueryDelegationUnbondingsResponse) GetUnbondings() []DelegationUnbonding {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+type QueryClaimableSettleAmountsRequest struct {
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QueryClaimableSettleAmountsRequest) Reset()         { *m = QueryClaimableSettleAmountsRequest{} }
+func (m *QueryClaimableSettleAmountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableSettleAmountsRequest) ProtoMessage()    {}
+func (*QueryClaimableSettleAmountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{182}
+}
+func (m *QueryClaimableSettleAmountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableSettleAmountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableSettleAmountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableSettleAmountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableSettleAmountsRequest.Merge(m, src)
+}
+func (m *QueryClaimableSettleAmountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableSettleAmountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableSettleAmountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableSettleAmountsRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableSettleAmountsRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+type QueryClaimableSettleAmountsResponse struct {
+	// settle_amounts are the current and carried over settle amounts, ordered by epoch
+	SettleAmounts []SettleAmount `protobuf:"bytes,1,rep,name=settle_amounts,json=settleAmounts,proto3" json:"settle_amounts"`
+}
+
+func (m *QueryClaimableSettleAmountsResponse) Reset()         { *m = QueryClaimableSettleAmountsResponse{} }
+func (m *QueryClaimableSettleAmountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableSettleAmountsResponse) ProtoMessage()    {}
+func (*QueryClaimableSettleAmountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{183}
+}
+func (m *QueryClaimableSettleAmountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableSettleAmountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableSettleAmountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableSettleAmountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableSettleAmountsResponse.Merge(m, src)
+}
+func (m *QueryClaimableSettleAmountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableSettleAmountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableSettleAmountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableSettleAmountsResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableSettleAmountsResponse) GetSettleAmounts() []SettleAmount {
+	if m != nil {
+		return m.SettleAmounts
+	}
+	return nil
+}
+
+type QuerySimulateModelAssignmentRequest struct {
+	// participant limits the result to one participant, all participants when empty
+	Participant string `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+}
+
+func (m *QuerySimulateModelAssignmentRequest) Reset()         { *m = QuerySimulateModelAssignmentRequest{} }
+func (m *QuerySimulateModelAssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateModelAssignmentRequest) ProtoMessage()    {}
+func (*QuerySimulateModelAssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{184}
+}
+func (m *QuerySimulateModelAssignmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateModelAssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateModelAssignmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateModelAssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateModelAssignmentRequest.Merge(m, src)
+}
+func (m *QuerySimulateModelAssignmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateModelAssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateModelAssignmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateModelAssignmentRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateModelAssignmentRequest) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+type QuerySimulateModelAssignmentResponse struct {
+	UpcomingEpochIndex uint64 `protobuf:"varint,1,opt,name=upcoming_epoch_index,json=upcomingEpochIndex,proto3" json:"upcoming_epoch_index,omitempty"`
+	Strategy           string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// participants with the models and ML nodes the next model assignment would give them
+	Participants []*ActiveParticipant `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+}
+
+func (m *QuerySimulateModelAssignmentResponse) Reset()         { *m = QuerySimulateModelAssignmentResponse{} }
+func (m *QuerySimulateModelAssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateModelAssignmentResponse) ProtoMessage()    {}
+func (*QuerySimulateModelAssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf0cfe3b0e1cc5bd, []int{185}
+}
+func (m *QuerySimulateModelAssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateModelAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateModelAssignmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateModelAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateModelAssignmentResponse.Merge(m, src)
+}
+func (m *QuerySimulateModelAssignmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateModelAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateModelAssignmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateModelAssignmentResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateModelAssignmentResponse) GetUpcomingEpochIndex() uint64 {
+	if m != nil {
+		return m.UpcomingEpochIndex
+	}
+	return 0
+}
+
+func (m *QuerySimulateModelAssignmentResponse) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *QuerySimulateModelAssignmentResponse) GetParticipants() []*ActiveParticipant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "inference.inference.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "inference.inference.QueryParamsResponse")
@@ -8851,477 +9231,507 @@ func init() {
 	proto.RegisterType((*QueryRandomSeedsResponse)(nil), "inference.inference.QueryRandomSeedsResponse")
 	proto.RegisterType((*QueryPoCValidationSnapshotRequest)(nil), "inference.inference.QueryPoCValidationSnapshotRequest")
 	proto.RegisterType((*QueryPoCValidationSnapshotResponse)(nil), "inference.inference.QueryPoCValidationSnapshotResponse")
+	proto.RegisterType((*QueryParticipantDelegationsRequest)(nil), "inference.inference.QueryParticipantDelegationsRequest")
+	proto.RegisterType((*QueryParticipantDelegationsResponse)(nil), "inference.inference.QueryParticipantDelegationsResponse")
+	proto.RegisterType((*QueryDelegationUnbondingsRequest)(nil), "inference.inference.QueryDelegationUnbondingsRequest")
+	proto.RegisterType((*QueryDelegationUnbondingsResponse)(nil), "inference.inference.QueryDelegationUnbondingsResponse")
+	proto.RegisterType((*QueryClaimableSettleAmountsRequest)(nil), "inference.inference.QueryClaimableSettleAmountsRequest")
+	proto.RegisterType((*QueryClaimableSettleAmountsResponse)(nil), "inference.inference.QueryClaimableSettleAmountsResponse")
+	proto.RegisterType((*QuerySimulateModelAssignmentRequest)(nil), "inference.inference.QuerySimulateModelAssignmentRequest")
+	proto.RegisterType((*QuerySimulateModelAssignmentResponse)(nil), "inference.inference.QuerySimulateModelAssignmentResponse")
 }
 
 func init() { proto.RegisterFile("inference/inference/query.proto", fileDescriptor_cf0cfe3b0e1cc5bd) }
 
 var fileDescriptor_cf0cfe3b0e1cc5bd = []byte{
-	// 7433 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x6b, 0x6c, 0xdd, 0xc8,
-	0x75, 0xff, 0xf2, 0xca, 0x0f, 0x69, 0x2c, 0xdb, 0xf2, 0xac, 0xd7, 0x96, 0xaf, 0x6d, 0xd9, 0x3b,
-	0xf6, 0xae, 0x9f, 0xeb, 0xbb, 0x96, 0x5f, 0xeb, 0xf5, 0x53, 0x92, 0x9f, 0xeb, 0xc7, 0xca, 0xd7,
-	0xaf, 0xdd, 0x6c, 0xb2, 0x0c, 0x75, 0x2f, 0x25, 0x31, 0xe6, 0xbd, 0xbc, 0x4b, 0xf2, 0xca, 0x56,
-	0xf4, 0x57, 0xfe, 0x48, 0x50, 0x20, 0x05, 0xfa, 0x48, 0x8b, 0x7c, 0x28, 0x9a, 0x16, 0x28, 0x82,
-	0xb6, 0x40, 0x0a, 0xf4, 0x43, 0x52, 0x34, 0x41, 0x13, 0xa4, 0x8f, 0x14, 0x48, 0xb1, 0x6d, 0x8a,
-	0x20, 0x4d, 0x82, 0x45, 0x5b, 0x04, 0x49, 0xba, 0x09, 0xd2, 0x22, 0x2d, 0x9a, 0xb4, 0x05, 0xfa,
-	0xb1, 0x2d, 0x38, 0x73, 0x86, 0x1c, 0x92, 0xc3, 0x21, 0x79, 0x75, 0x37, 0xf9, 0x22, 0x88, 0xc3,
-	0x99, 0x33, 0xe7, 0x31, 0x33, 0x3c, 0xe7, 0xcc, 0xcc, 0xef, 0xa2, 0x5d, 0x56, 0x7b, 0xd6, 0x74,
-	0xcd, 0x76, 0xc3, 0xac, 0x45, 0xff, 0xbd, 0xd5, 0x35, 0xdd, 0xc5, 0x23, 0x1d, 0xd7, 0xf1, 0x1d,
-	0xfc, 0x74, 0x58, 0x7c, 0x24, 0xfc, 0xaf, 0xba, 0xc9, 0x68, 0x59, 0x6d, 0xa7, 0x46, 0xff, 0xb2,
-	0x7a, 0xd5, 0xcd, 0x73, 0xce, 0x9c, 0x43, 0xff, 0xad, 0x05, 0xff, 0x41, 0xe9, 0x8e, 0x39, 0xc7,
-	0x99, 0xb3, 0xcd, 0x9a, 0xd1, 0xb1, 0x6a, 0x46, 0xbb, 0xed, 0xf8, 0x86, 0x6f, 0x39, 0x6d, 0x0f,
-	0xde, 0x1e, 0x6c, 0x38, 0x5e, 0xcb, 0xf1, 0x6a, 0x33, 0x86, 0x07, 0x9d, 0xd6, 0x16, 0x8e, 0xce,
-	0x98, 0xbe, 0x71, 0xb4, 0xd6, 0x31, 0xe6, 0xac, 0x36, 0xad, 0x0c, 0x75, 0x77, 0xcb, 0x18, 0xed,
-	0x18, 0xae, 0xd1, 0xe2, 0xd4, 0xf6, 0xc8, 0x6a, 0x44, 0xdc, 0xb3, 0x4a, 0xcf, 0x65, 0x90, 0xf1,
-	0xad, 0x86, 0xd5, 0x31, 0xda, 0x3e, 0xe7, 0x4c, 0x56, 0xcd, 0xec, 0x38, 0x8d, 0x79, 0x7d, 0xce,
-	0x75, 0xba, 0x1d, 0xbd, 0x69, 0xf8, 0x06, 0xd4, 0xdd, 0x27, 0xab, 0xeb, 0x99, 0xbe, 0x6f, 0x9b,
-	0xba, 0xd1, 0x72, 0xba, 0x21, 0xd1, 0xa3, 0x79, 0x44, 0x17, 0x0c, 0xdb, 0x6a, 0xc6, 0x34, 0x44,
-	0xa4, 0xec, 0x3a, 0x8d, 0x19, 0xc3, 0x6f, 0xcc, 0x43, 0x9d, 0x03, 0xb2, 0x3a, 0xbe, 0xf3, 0xc8,
-	0x6c, 0x3b, 0x2d, 0xab, 0xe1, 0x89, 0xac, 0x4a, 0xab, 0x76, 0xdb, 0x96, 0xaf, 0x3b, 0xb3, 0x7a,
-	0xc3, 0x69, 0x75, 0xba, 0x3e, 0x57, 0x94, 0x74, 0x60, 0xb4, 0x9c, 0xa6, 0x69, 0xab, 0xd4, 0xed,
-	0x3b, 0x1d, 0xbd, 0x65, 0xb5, 0x4d, 0x17, 0x2a, 0x8d, 0x89, 0x16, 0xe6, 0xb6, 0x6d, 0x38, 0x16,
-	0xb7, 0xea, 0x21, 0xa5, 0xcd, 0x74, 0xdf, 0x6a, 0x99, 0x4e, 0x97, 0xeb, 0xef, 0xa4, 0xba, 0x72,
-	0xa4, 0x3d, 0xbd, 0x69, 0xfa, 0x86, 0x65, 0x73, 0x25, 0x1e, 0xcb, 0xd6, 0x7b, 0xc7, 0x74, 0x67,
-	0x1d, 0xb7, 0x65, 0x04, 0xed, 0xbd, 0x6e, 0xab, 0x65, 0xf0, 0x71, 0x2f, 0xb7, 0xaa, 0xef, 0x1a,
-	0x56, 0xdb, 0x6a, 0xcf, 0xe9, 0xbe, 0xe1, 0x3d, 0x82, 0x8a, 0x87, 0x73, 0x2b, 0xea, 0xde, 0x62,
-	0xbb, 0xa1, 0x22, 0x3b, 0x6f, 0xb8, 0xcd, 0xc7, 0x86, 0x6b, 0xea, 0x6d, 0xa7, 0x69, 0xaa, 0x4c,
-	0x45, 0x07, 0xaa, 0x61, 0xeb, 0xdd, 0xce, 0x9c, 0x6b, 0x84, 0x55, 0x9f, 0x97, 0x55, 0x6d, 0x9b,
-	0xfe, 0x63, 0xc7, 0x7d, 0x24, 0x92, 0x94, 0x4e, 0xa1, 0x19, 0xd7, 0x6a, 0xce, 0x29, 0x3b, 0xf5,
-	0x7c, 0xc3, 0xf7, 0xf4, 0xa6, 0xb9, 0x60, 0xda, 0x4e, 0x27, 0xb4, 0xec, 0xae, 0x4c, 0xa5, 0x42,
-	0x85, 0xfd, 0xd2, 0x01, 0x64, 0x07, 0xfc, 0xe8, 0x0b, 0xa6, 0xeb, 0x45, 0x53, 0xfb, 0x88, 0x94,
-	0xd4, 0x93, 0x86, 0xdd, 0x6d, 0x9a, 0x4d, 0x3d, 0x3d, 0x39, 0x5f, 0x94, 0xd5, 0x6f, 0x38, 0xed,
-	0x59, 0xcb, 0x6d, 0x31, 0xfb, 0x77, 0x9c, 0x86, 0x6e, 0x2e, 0x98, 0x61, 0x0b, 0x29, 0x2f, 0xb6,
-	0xf5, 0x56, 0xd7, 0x6a, 0x5a, 0xfe, 0xa2, 0xde, 0x71, 0x1c, 0x5b, 0xb9, 0xcc, 0x38, 0x0d, 0x7d,
-	0x61, 0x5c, 0xb5, 0x82, 0xb8, 0x46, 0xbb, 0xe9, 0xb4, 0x74, 0xcf, 0x34, 0x9b, 0xaa, 0xc9, 0x4e,
-	0x09, 0x45, 0xc3, 0xd4, 0x6b, 0x1b, 0x1d, 0x6f, 0xde, 0x01, 0x2e, 0xc9, 0x66, 0x84, 0xef, 0x04,
-	0x8b, 0xe0, 0x34, 0x5d, 0xd5, 0xea, 0xe6, 0x5b, 0x5d, 0xd3, 0xf3, 0xc9, 0x7d, 0xf4, 0x74, 0xac,
-	0xd4, 0xeb, 0x38, 0x6d, 0xcf, 0xc4, 0xe7, 0xd1, 0x1a, 0xb6, 0xfa, 0x8d, 0x6a, 0xbb, 0xb5, 0xfd,
-	0xeb, 0xc6, 0xb7, 0x1f, 0x91, 0x2c, 0xd4, 0x47, 0x58, 0xa3, 0xc9, 0xa1, 0xb7, 0xbf, 0xbb, 0xeb,
-	0xa9, 0xcf, 0xfc, 0xf3, 0x67, 0x0f, 0x6a, 0x75, 0x68, 0x45, 0x5e, 0x44, 0xa3, 0x94, 0xec, 0x55,
-	0xd3, 0xbf, 0xce, 0xab, 0x43, 0x97, 0x78, 0x33, 0x5a, 0x6d, 0xb5, 0x9b, 0xe6, 0x13, 0x4a, 0x7a,
-	0xa8, 0xce, 0x1e, 0x88, 0x8e, 0xb6, 0x49, 0x5a, 0x00, 0x3b, 0x93, 0x68, 0x28, 0xec, 0x15, 0x38,
-	0x1a, 0x93, 0x72, 0x14, 0x36, 0x9d, 0x5c, 0x15, 0x30, 0x55, 0x8f, 0x9a, 0x91, 0x19, 0x60, 0x69,
-	0xc2, 0xb6, 0x53, 0x2c, 0x5d, 0x41, 0x28, 0xfa, 0x24, 0x40, 0x07, 0xcf, 0x1f, 0x61, 0xab, 0xcb,
-	0x91, 0x60, 0x75, 0x39, 0xc2, 0x3e, 0x5a, 0xb0, 0xc6, 0x1c, 0x99, 0x36, 0xe6, 0x78, 0xdb, 0xba,
-	0xd0, 0x92, 0x7c, 0x46, 0x03, 0x29, 0xe2, 0x9d, 0xc8, 0xa5, 0x18, 0xe8, 0x41, 0x0a, 0x7c, 0x35,
-	0xc6, 0x69, 0x85, 0x72, 0xba, 0x2f, 0x97, 0x53, 0xc6, 0x40, 0x8c, 0xd5, 0x71, 0x54, 0xe5, 0xfa,
-	0x9e, 0x8e, 0xe6, 0x80, 0xda, 0x46, 0x73, 0x68, 0xbb, 0xb4, 0x0d, 0xc8, 0x77, 0x0d, 0xad, 0x13,
-	0xa6, 0x13, 0xa8, 0x71, 0x77, 0xd6, 0xc8, 0xe1, 0xf5, 0x40, 0x46, 0xb1, 0x29, 0x69, 0x02, 0x73,
-	0x13, 0xb6, 0x2d, 0x61, 0xae, 0x5f, 0xd6, 0xfa, 0x96, 0x06, 0xf2, 0x24, 0xbb, 0xc9, 0x92, 0x67,
-	0xa0, 0x47, 0x79, 0xfa, 0x66, 0x35, 0xfc, 0x2c, 0x1a, 0x9e, 0xb1, 0x9d, 0xc6, 0x23, 0x7d, 0xde,
-	0xb4, 0xe6, 0xe6, 0xfd, 0xd1, 0x81, 0xdd, 0xda, 0xfe, 0x81, 0xfa, 0x3a, 0x5a, 0x76, 0x8d, 0x16,
-	0x91, 0xb3, 0x68, 0x37, 0x15, 0x2a, 0x1c, 0x44, 0x12, 0x0d, 0x8e, 0xa2, 0xb5, 0x46, 0xb3, 0xe9,
-	0x9a, 0x9e, 0x07, 0x06, 0xe6, 0x8f, 0xe4, 0x3e, 0x7a, 0x56, 0xd1, 0x1a, 0x14, 0xb3, 0x05, 0xad,
-	0xe9, 0x74, 0x67, 0x1e, 0x99, 0x8b, 0xd0, 0x1a, 0x9e, 0x02, 0xb2, 0x33, 0x86, 0x1d, 0x7c, 0xee,
-	0xa8, 0x8c, 0x03, 0x75, 0xfe, 0x48, 0x4e, 0xa0, 0x9d, 0x7c, 0xe4, 0xd4, 0xe9, 0x62, 0x76, 0xf9,
-	0x89, 0xd9, 0xe8, 0xfa, 0x8e, 0x2b, 0x0c, 0x38, 0xfa, 0xf9, 0xe7, 0x03, 0x8e, 0x3e, 0x90, 0x26,
-	0x1a, 0xcb, 0x6a, 0x16, 0xce, 0xa9, 0x41, 0x13, 0xca, 0x4a, 0x0e, 0xb8, 0xb0, 0x1d, 0x79, 0x23,
-	0x62, 0xee, 0x72, 0xf0, 0x89, 0xb9, 0x1a, 0xb8, 0x4b, 0x97, 0x0c, 0xdf, 0xe0, 0xcc, 0xed, 0x42,
-	0xeb, 0xd8, 0x07, 0x3d, 0x9a, 0x13, 0xab, 0xea, 0x88, 0x16, 0x5d, 0x0f, 0x4a, 0xf0, 0x36, 0x34,
-	0x48, 0x19, 0xd6, 0xad, 0x26, 0x95, 0x7c, 0xa8, 0xbe, 0x96, 0x3e, 0x5f, 0x6f, 0x92, 0x6e, 0x24,
-	0x42, 0x92, 0x38, 0x88, 0x70, 0x17, 0x8d, 0x24, 0x7d, 0x3f, 0x10, 0x65, 0x8f, 0x54, 0x94, 0x38,
-	0x19, 0x90, 0x66, 0x83, 0x19, 0x2b, 0x25, 0x73, 0x20, 0xd3, 0x84, 0x6d, 0xcb, 0x65, 0xea, 0xd7,
-	0x24, 0xfa, 0x33, 0x0d, 0x04, 0x94, 0xf4, 0xa4, 0x14, 0x70, 0x60, 0x45, 0x02, 0xf6, 0x6f, 0x21,
-	0xbc, 0x10, 0x2d, 0x6a, 0x77, 0xa9, 0x5b, 0x3d, 0x41, 0xbd, 0x6a, 0xae, 0xa7, 0xdd, 0xe9, 0x45,
-	0x6d, 0x28, 0xbe, 0x58, 0xd9, 0x68, 0x87, 0x9c, 0x00, 0x88, 0x7f, 0x13, 0xad, 0x8f, 0xf9, 0xeb,
-	0xa0, 0xec, 0x67, 0xa5, 0xb2, 0x8b, 0x14, 0x40, 0xf2, 0x61, 0x4f, 0x28, 0x23, 0x66, 0xb4, 0x66,
-	0xc9, 0xd8, 0xed, 0x97, 0x59, 0x3f, 0xaf, 0x81, 0x54, 0xa9, 0x7e, 0xb2, 0xa5, 0x1a, 0xe8, 0x59,
-	0xaa, 0xfe, 0x59, 0xd3, 0x42, 0x7b, 0xd3, 0xd3, 0xed, 0x41, 0x14, 0xf9, 0x14, 0x36, 0x6b, 0x72,
-	0xd2, 0x57, 0x92, 0x93, 0x9e, 0xfc, 0xba, 0x86, 0x9e, 0xcb, 0xe9, 0x0b, 0x74, 0x35, 0x8f, 0xb6,
-	0x66, 0x04, 0x62, 0x60, 0xa1, 0x83, 0x39, 0xf3, 0x40, 0x20, 0x0a, 0xea, 0x7b, 0xc6, 0x94, 0xbd,
-	0x24, 0x6d, 0x10, 0x3f, 0x36, 0x19, 0x25, 0xe2, 0xf7, 0x6b, 0x98, 0xfc, 0x23, 0xd7, 0x41, 0x76,
-	0x87, 0x45, 0x74, 0x30, 0xd0, 0x47, 0x1d, 0xf4, 0x6f, 0x2c, 0x4d, 0xc1, 0xca, 0x36, 0xed, 0x34,
-	0x26, 0x83, 0x88, 0xd8, 0xf4, 0xae, 0x38, 0xee, 0x5d, 0x3f, 0x52, 0x45, 0xea, 0x73, 0xac, 0xa5,
-	0x3f, 0xc7, 0x5d, 0xb4, 0x2b, 0x93, 0x08, 0xa8, 0xa6, 0x8e, 0x86, 0x02, 0xd7, 0x9d, 0x46, 0xdd,
-	0xa0, 0x8c, 0x9a, 0xfc, 0x23, 0xe6, 0x4c, 0x01, 0x8d, 0x87, 0x96, 0x3f, 0x2f, 0x7c, 0xd2, 0xb8,
-	0x46, 0x06, 0x3b, 0xd0, 0x0b, 0xf9, 0xa2, 0x86, 0xaa, 0xd9, 0xd5, 0x0b, 0x0c, 0xff, 0xad, 0x68,
-	0x6d, 0xa7, 0x3b, 0xa3, 0x07, 0x1f, 0xf9, 0x4a, 0xf8, 0x91, 0xbf, 0x61, 0x2e, 0xe2, 0x31, 0xb4,
-	0x6e, 0xde, 0x7c, 0xa2, 0xf3, 0x97, 0x03, 0xf4, 0xe5, 0xd0, 0xbc, 0xf9, 0x64, 0x9a, 0xbd, 0xbf,
-	0x28, 0x4a, 0xb3, 0x8a, 0x4a, 0xb3, 0x53, 0x29, 0x4d, 0x8a, 0xf7, 0xab, 0x88, 0x70, 0x95, 0x09,
-	0x76, 0xed, 0x41, 0xf7, 0x1f, 0xd7, 0xd0, 0x1e, 0x25, 0x25, 0x30, 0xc0, 0x07, 0xd1, 0x86, 0x78,
-	0xec, 0x04, 0x56, 0x38, 0x96, 0xc5, 0xb7, 0x40, 0x2c, 0xc3, 0x12, 0xeb, 0x3b, 0x62, 0x97, 0xe4,
-	0x6f, 0x35, 0x34, 0xa6, 0x6e, 0xf7, 0x5e, 0x9a, 0xe4, 0xd5, 0x94, 0x7c, 0xcc, 0x2e, 0x24, 0x5f,
-	0x3e, 0xb9, 0x38, 0xd7, 0x61, 0x99, 0x09, 0xf4, 0x3a, 0xbe, 0x32, 0x1b, 0xfd, 0x12, 0x5f, 0x41,
-	0xb2, 0x69, 0x81, 0x95, 0x66, 0x32, 0xac, 0x74, 0xa2, 0x07, 0x2b, 0x3d, 0x18, 0x97, 0x0b, 0xf6,
-	0x0d, 0x0d, 0xed, 0xce, 0x6b, 0xf9, 0x5e, 0x5a, 0xea, 0x4e, 0x86, 0xa5, 0xf6, 0xe6, 0xcb, 0x98,
-	0x25, 0xd2, 0x27, 0xf8, 0x97, 0x3c, 0xa8, 0x3d, 0x7e, 0xd7, 0x77, 0x5c, 0x73, 0xca, 0x69, 0xb5,
-	0xac, 0xd0, 0x65, 0x38, 0x8f, 0x76, 0x04, 0x7d, 0x7a, 0x81, 0xb2, 0x83, 0xbf, 0xae, 0xaf, 0x4b,
-	0x8c, 0x36, 0xda, 0x71, 0x1a, 0xd4, 0x1e, 0x77, 0x83, 0x1a, 0x93, 0x91, 0x05, 0x71, 0x0d, 0x3d,
-	0x2d, 0xc8, 0xae, 0xf3, 0xc0, 0x82, 0x09, 0x8e, 0x85, 0x57, 0x13, 0x10, 0x63, 0xcc, 0x83, 0x6f,
-	0x9a, 0x66, 0x08, 0x2c, 0xbd, 0x19, 0xad, 0x6e, 0x84, 0x9e, 0xd2, 0xfa, 0x3a, 0x7b, 0xc0, 0xdb,
-	0xd1, 0x90, 0xeb, 0x38, 0xbe, 0x3e, 0x6f, 0x78, 0xf3, 0x94, 0xfa, 0x70, 0x7d, 0x30, 0x28, 0xb8,
-	0x66, 0x78, 0xf3, 0x41, 0x93, 0x59, 0xa7, 0xdb, 0x6e, 0x52, 0x95, 0x0e, 0xd6, 0xd9, 0x03, 0xf9,
-	0x0d, 0x0d, 0x06, 0xea, 0xad, 0x9b, 0xb7, 0x9d, 0xa6, 0xf9, 0x90, 0x32, 0x7c, 0xc9, 0xf2, 0x7c,
-	0xd7, 0x9a, 0xe9, 0x06, 0xda, 0xf9, 0xb9, 0xe9, 0xe0, 0xc3, 0x30, 0xea, 0xb3, 0x19, 0x03, 0x5d,
-	0x9c, 0x41, 0x6b, 0x1f, 0xd3, 0xb7, 0x9e, 0xd2, 0xc3, 0x12, 0xe9, 0xd4, 0x79, 0x8b, 0x48, 0x2b,
-	0x15, 0x51, 0x2b, 0x1f, 0x42, 0xfb, 0xc3, 0xb0, 0x37, 0x61, 0x82, 0xd4, 0x0c, 0x5e, 0xa1, 0x62,
-	0xc8, 0x63, 0x74, 0xa0, 0x40, 0x5f, 0x20, 0xeb, 0x2b, 0x68, 0x6d, 0x83, 0xbd, 0x02, 0x59, 0x5f,
-	0xcc, 0x1c, 0xf6, 0x22, 0xa1, 0x60, 0x8a, 0x82, 0x5e, 0xeb, 0x9c, 0x00, 0xf9, 0xb4, 0x86, 0xb6,
-	0x2b, 0x2a, 0x66, 0x59, 0x4c, 0xcb, 0xb2, 0x58, 0x34, 0x28, 0x2b, 0x99, 0x83, 0x72, 0x20, 0x31,
-	0x28, 0x13, 0xb3, 0x7d, 0x55, 0x62, 0xb6, 0x13, 0x17, 0xbd, 0xc8, 0x95, 0x93, 0x35, 0x0e, 0xfa,
-	0x6e, 0x90, 0x4f, 0x6a, 0xe8, 0x68, 0x89, 0x4e, 0xc1, 0x32, 0x6f, 0xa2, 0xf5, 0x4d, 0xb1, 0x02,
-	0xd8, 0xe7, 0xa5, 0xdc, 0xb1, 0x28, 0x92, 0x15, 0xed, 0x14, 0x27, 0x17, 0x70, 0xb5, 0xa7, 0x40,
-	0xb3, 0xf2, 0x56, 0x13, 0xa6, 0x4f, 0xa5, 0xec, 0xf4, 0x21, 0x3b, 0xa3, 0xd0, 0x70, 0xaa, 0xeb,
-	0xba, 0x66, 0x9b, 0xf9, 0xf9, 0x3c, 0x77, 0x7a, 0x3c, 0x0a, 0xfc, 0xe2, 0xaf, 0xa3, 0x65, 0x8c,
-	0x7a, 0xa8, 0x90, 0x30, 0x60, 0x0f, 0x64, 0x57, 0x94, 0x6d, 0xb8, 0x17, 0x6e, 0xa3, 0x08, 0x91,
-	0x39, 0xf1, 0xa3, 0x8c, 0x41, 0xb2, 0x42, 0xe8, 0x30, 0x6e, 0x4c, 0xec, 0xc0, 0x28, 0x13, 0x06,
-	0x71, 0x2a, 0x3c, 0x9e, 0xf6, 0x63, 0xa5, 0xe4, 0x16, 0x4c, 0xd4, 0xab, 0xa6, 0x7f, 0xbf, 0x6d,
-	0xf9, 0xaf, 0xce, 0x4e, 0xb1, 0x0d, 0x9b, 0x69, 0xd7, 0x6a, 0x98, 0xd3, 0xae, 0xd3, 0x71, 0x3c,
-	0xc3, 0x2e, 0x1e, 0x14, 0x7f, 0x52, 0x43, 0x07, 0x8b, 0xd0, 0x03, 0x89, 0x6e, 0xa0, 0xc1, 0x0e,
-	0x94, 0x81, 0x28, 0x72, 0x0f, 0x58, 0x41, 0x2a, 0x24, 0x80, 0x47, 0xd1, 0xda, 0xa6, 0x39, 0x6b,
-	0x74, 0x6d, 0x1f, 0xa2, 0x36, 0xfe, 0x48, 0xf6, 0x40, 0x76, 0x4b, 0x34, 0x57, 0x32, 0x33, 0x42,
-	0x16, 0xc1, 0xfd, 0xcc, 0xa8, 0xf4, 0x5e, 0x66, 0x6d, 0xf6, 0x72, 0xcf, 0xd7, 0x35, 0x17, 0x2c,
-	0xa7, 0xeb, 0xc9, 0x19, 0xfc, 0x30, 0xf7, 0x6a, 0x33, 0x6a, 0xbd, 0x97, 0x1c, 0xea, 0xe8, 0x19,
-	0xf6, 0xdd, 0x72, 0x9a, 0xa6, 0xed, 0x4d, 0xd8, 0x76, 0xbf, 0x23, 0xca, 0xdf, 0xd4, 0xd0, 0x96,
-	0x64, 0x0f, 0x20, 0xd0, 0xc9, 0x28, 0x47, 0x18, 0xcc, 0xe4, 0xaa, 0x7c, 0x26, 0x07, 0x35, 0x80,
-	0x79, 0x56, 0xbd, 0x7f, 0x01, 0xe1, 0x31, 0xb4, 0x35, 0x9a, 0x99, 0x9d, 0x5b, 0x56, 0xdb, 0x74,
-	0xf3, 0x33, 0xaa, 0xef, 0x8f, 0xb6, 0x42, 0xa2, 0x46, 0x20, 0xd1, 0x45, 0x34, 0x14, 0xee, 0x69,
-	0x82, 0xce, 0x76, 0x66, 0x4c, 0x61, 0xd6, 0x92, 0xc7, 0x4a, 0x3e, 0x3c, 0x13, 0x03, 0x58, 0x9a,
-	0xb0, 0xed, 0x24, 0x4b, 0xfd, 0xb2, 0xc8, 0xef, 0x6b, 0xd1, 0xce, 0x49, 0x9e, 0x04, 0x03, 0xa5,
-	0x25, 0xe8, 0x9f, 0x75, 0x1c, 0x88, 0xb4, 0xc5, 0x1d, 0xa4, 0x7b, 0x6c, 0x8b, 0x97, 0xab, 0xe4,
-	0x20, 0x1a, 0x31, 0x9f, 0x74, 0x2c, 0x97, 0x36, 0xb8, 0x16, 0x7d, 0x30, 0x57, 0xd5, 0x53, 0xe5,
-	0xc1, 0x1a, 0x17, 0x72, 0x7f, 0x9d, 0xa7, 0x75, 0xc5, 0x22, 0xf2, 0xff, 0x20, 0xd3, 0x2e, 0xed,
-	0x10, 0xf4, 0xf3, 0x1a, 0xda, 0x94, 0xda, 0x70, 0x06, 0x5b, 0x3c, 0xa7, 0xde, 0xfb, 0x01, 0x4a,
-	0xa0, 0xaf, 0x11, 0x2b, 0x51, 0x4e, 0x2c, 0x10, 0x57, 0xdc, 0x6a, 0x4a, 0x88, 0xdb, 0xaf, 0x11,
-	0xf0, 0x55, 0x0d, 0x24, 0x95, 0xf6, 0xa5, 0x96, 0x74, 0x60, 0xc5, 0x92, 0xf6, 0x6f, 0x84, 0xcc,
-	0x45, 0xdf, 0xb8, 0xb0, 0xf3, 0x28, 0x52, 0xba, 0xc4, 0xb6, 0xf5, 0x85, 0x19, 0xcd, 0x92, 0x7d,
-	0x4d, 0x18, 0x22, 0xfc, 0xb1, 0xc0, 0xc8, 0xf8, 0x5d, 0xe1, 0xeb, 0xa7, 0xea, 0x09, 0x54, 0xd7,
-	0x45, 0x55, 0x2b, 0xb3, 0x96, 0xf2, 0x7b, 0x98, 0x4d, 0x1c, 0xb4, 0xa9, 0x20, 0x4c, 0xbc, 0xc8,
-	0x37, 0xcf, 0x57, 0x47, 0xbf, 0xc6, 0xd2, 0xbf, 0x71, 0xd5, 0xe4, 0xf4, 0x5a, 0x50, 0x35, 0x03,
-	0xef, 0x89, 0x6a, 0xfa, 0x37, 0xe4, 0xde, 0x44, 0x87, 0x15, 0x03, 0x81, 0x6e, 0xa0, 0x9b, 0xbe,
-	0xe9, 0x86, 0x6a, 0x1e, 0x41, 0x03, 0x56, 0x93, 0x09, 0x36, 0x54, 0x0f, 0xfe, 0xc5, 0x3b, 0xd0,
-	0x90, 0xcb, 0x5e, 0x9a, 0x2e, 0x8c, 0xb5, 0xa8, 0x80, 0xfc, 0x49, 0x05, 0xbd, 0x50, 0xb0, 0x03,
-	0xd0, 0xe8, 0x6d, 0x34, 0x02, 0xe9, 0x05, 0xc7, 0xd5, 0x3b, 0xce, 0x63, 0xd3, 0xf5, 0x94, 0xbb,
-	0x31, 0x0f, 0x78, 0xe5, 0xe9, 0xa0, 0x6e, 0x7d, 0xe3, 0x42, 0xec, 0xd9, 0xc3, 0xcf, 0xa1, 0x0d,
-	0x0d, 0xe6, 0x29, 0xf1, 0x10, 0x84, 0x39, 0x5d, 0xeb, 0xa1, 0x14, 0x96, 0xd3, 0xeb, 0x81, 0x53,
-	0xc6, 0xac, 0x36, 0xd0, 0x93, 0xd5, 0xea, 0xbc, 0x3d, 0xbe, 0x1c, 0x18, 0x87, 0xcb, 0x45, 0xa3,
-	0xaa, 0xac, 0x25, 0x26, 0xa1, 0x08, 0xaf, 0x2e, 0x34, 0x24, 0x57, 0xd1, 0x86, 0xb8, 0x6c, 0x81,
-	0xc3, 0x4e, 0x15, 0xc2, 0x1d, 0x76, 0xfa, 0x90, 0xbf, 0x11, 0x70, 0x0b, 0x46, 0x34, 0x75, 0xa0,
-	0xa6, 0xa3, 0x33, 0x3f, 0x77, 0xd9, 0x91, 0x9f, 0xc9, 0x45, 0x31, 0x6a, 0xc8, 0xdd, 0x4c, 0x24,
-	0xbf, 0xa5, 0xa1, 0x43, 0x85, 0xe8, 0x81, 0x41, 0x6d, 0xc8, 0xac, 0xa7, 0x6b, 0x82, 0x5d, 0x0f,
-	0x67, 0xbb, 0x7b, 0x12, 0xea, 0x6c, 0x72, 0x64, 0x91, 0x24, 0x8b, 0x10, 0xb3, 0x66, 0x32, 0x27,
-	0xd9, 0x6e, 0xce, 0xdd, 0x3f, 0xdd, 0x8b, 0xd6, 0x0b, 0xc1, 0x43, 0xb8, 0xa6, 0xc6, 0x0b, 0x83,
-	0x90, 0xfe, 0x68, 0x89, 0xbe, 0x8b, 0xa8, 0x47, 0xeb, 0xb7, 0x7a, 0x3a, 0xe8, 0xf9, 0xd8, 0x7e,
-	0x48, 0xba, 0x4a, 0xbf, 0x17, 0xd4, 0xef, 0x6b, 0x68, 0x5f, 0x6e, 0x97, 0x3f, 0x8f, 0xa1, 0xd2,
-	0xbf, 0x45, 0xf4, 0x20, 0x38, 0xa0, 0xf7, 0xe0, 0xdc, 0xdb, 0x3d, 0xc3, 0x7b, 0xc4, 0xd5, 0xb8,
-	0x01, 0x55, 0x2c, 0xfe, 0x85, 0xae, 0x58, 0x4d, 0x52, 0x87, 0x13, 0x38, 0xf1, 0xba, 0x20, 0xff,
-	0x09, 0xb4, 0xca, 0x37, 0xbc, 0x47, 0xca, 0x1d, 0xd8, 0x58, 0x43, 0x5a, 0x9d, 0x9c, 0x03, 0x9a,
-	0xd7, 0xe0, 0x24, 0xdd, 0x6d, 0xa7, 0x69, 0x16, 0xdf, 0x49, 0x24, 0x0f, 0xe0, 0x34, 0x4b, 0xa2,
-	0x39, 0xf0, 0xf4, 0x12, 0x5a, 0xdd, 0x0e, 0x0a, 0x80, 0x29, 0x79, 0x4e, 0x3e, 0xde, 0x94, 0x35,
-	0x20, 0x63, 0x90, 0x7f, 0x88, 0xbd, 0x8c, 0x42, 0x32, 0xf2, 0x3a, 0x64, 0x1a, 0xd2, 0xef, 0xd3,
-	0x5d, 0x0f, 0x94, 0xeb, 0xfa, 0x59, 0x70, 0x3e, 0xef, 0x74, 0xcd, 0xae, 0xd9, 0x14, 0x55, 0x16,
-	0x9e, 0x2c, 0x7b, 0x03, 0x7c, 0x46, 0x69, 0x15, 0x60, 0xe0, 0x14, 0x5a, 0x1d, 0x28, 0x58, 0x9d,
-	0xda, 0x8c, 0x19, 0x84, 0xd5, 0x0f, 0x33, 0x33, 0xe2, 0x3b, 0x41, 0xf2, 0x87, 0xa0, 0x99, 0xd4,
-	0xeb, 0x95, 0xf6, 0x7b, 0x1b, 0xa6, 0x77, 0xfc, 0x04, 0x14, 0x64, 0x0a, 0xee, 0xfa, 0x86, 0x1f,
-	0x0e, 0x8b, 0xd4, 0x92, 0xa6, 0xc9, 0x96, 0x34, 0x03, 0xe6, 0xae, 0x8a, 0x5e, 0x74, 0xe8, 0xe6,
-	0xb1, 0x18, 0xb1, 0xc0, 0x13, 0x1e, 0x43, 0xc8, 0x35, 0x3b, 0x5d, 0x3f, 0x9a, 0x65, 0xab, 0xeb,
-	0x42, 0x09, 0x39, 0x14, 0x39, 0xbd, 0xf1, 0x73, 0x4e, 0x12, 0xae, 0xc9, 0xb7, 0x05, 0xc7, 0x55,
-	0x55, 0x3b, 0xdc, 0xd4, 0xdd, 0x26, 0xa6, 0xe3, 0xb8, 0x1f, 0x40, 0xcf, 0x74, 0x2a, 0x57, 0x94,
-	0x2c, 0xc2, 0x5b, 0x3b, 0xf2, 0x17, 0xa9, 0x9d, 0xa4, 0x4a, 0x6a, 0x27, 0x09, 0x6f, 0x43, 0x83,
-	0xf0, 0x95, 0x69, 0xc2, 0xb9, 0x28, 0xee, 0xb1, 0x93, 0xb7, 0x35, 0xb4, 0x35, 0xa3, 0xcb, 0xc0,
-	0x7f, 0x11, 0x65, 0xb0, 0xe4, 0x96, 0x12, 0xd4, 0x5f, 0x89, 0xa9, 0xff, 0x00, 0x1a, 0x31, 0x67,
-	0x67, 0xcd, 0x86, 0x6f, 0x2d, 0x98, 0x3a, 0xd4, 0x58, 0x45, 0x6b, 0x6c, 0x0c, 0xcb, 0x59, 0x5e,
-	0x11, 0xef, 0x41, 0xeb, 0x1b, 0x46, 0xa7, 0x63, 0x36, 0x79, 0xbd, 0xd5, 0xb4, 0xde, 0x30, 0x2b,
-	0x7c, 0x28, 0x33, 0xe7, 0x40, 0xca, 0x9c, 0x9f, 0xab, 0xa0, 0xcd, 0x82, 0x28, 0x57, 0xba, 0xb6,
-	0xcd, 0xe4, 0xd8, 0x87, 0x36, 0x1a, 0x0d, 0x9a, 0x94, 0x4e, 0xa4, 0x45, 0x37, 0x40, 0x31, 0x4f,
-	0x89, 0x1e, 0x40, 0x23, 0x4e, 0xc7, 0x74, 0xa9, 0xff, 0x17, 0xdf, 0xa8, 0xd8, 0xc8, 0xcb, 0x79,
-	0xd5, 0x1c, 0x66, 0xf0, 0x19, 0x54, 0x35, 0x0d, 0xb7, 0x6d, 0x36, 0xf5, 0x86, 0x63, 0xb5, 0xbd,
-	0x70, 0x00, 0xb0, 0xb4, 0x27, 0x53, 0xc3, 0x56, 0x56, 0x63, 0x2a, 0xa8, 0x20, 0xa6, 0xd4, 0xf0,
-	0x39, 0xb4, 0xdd, 0x35, 0x1f, 0x1b, 0x6e, 0x33, 0x6c, 0x6e, 0x1b, 0xbe, 0xe9, 0xf1, 0xd6, 0x4c,
-	0x39, 0xa3, 0xbc, 0x0a, 0x6d, 0x7f, 0x93, 0x56, 0x60, 0xcd, 0x0f, 0x40, 0x7a, 0xcb, 0xa3, 0x47,
-	0xcb, 0x6d, 0xd3, 0x37, 0x9b, 0xa3, 0x6b, 0x68, 0x6a, 0x7e, 0x23, 0x2b, 0x9f, 0xe2, 0xc5, 0x61,
-	0xda, 0x4f, 0xdc, 0xc7, 0x0b, 0x15, 0xc7, 0x87, 0xfe, 0x47, 0x78, 0xee, 0x4d, 0x5e, 0x29, 0x8c,
-	0x72, 0xc5, 0x2c, 0xb3, 0x17, 0x1b, 0xea, 0x07, 0xf2, 0x86, 0x7a, 0x44, 0x6e, 0x93, 0x48, 0x84,
-	0x16, 0x91, 0x8f, 0xc0, 0xae, 0x0c, 0x7d, 0x9a, 0x5c, 0x0c, 0x82, 0xdf, 0x69, 0xd3, 0xb5, 0x9c,
-	0xe6, 0xe4, 0xe2, 0x25, 0x7e, 0x3a, 0x9a, 0x2f, 0x2e, 0x3b, 0xd0, 0x50, 0x78, 0x62, 0x1a, 0xac,
-	0x1c, 0x15, 0xe0, 0xed, 0x68, 0x28, 0x88, 0xbf, 0xf5, 0x59, 0xd7, 0x69, 0xc1, 0x44, 0x19, 0x0c,
-	0x0a, 0xae, 0xb8, 0x4e, 0x0b, 0x6f, 0x45, 0x6b, 0xe9, 0x4b, 0xdf, 0x81, 0x49, 0xb2, 0x26, 0x78,
-	0xbc, 0xe7, 0x10, 0x1b, 0xd6, 0x09, 0x75, 0xff, 0xa0, 0x86, 0x0b, 0x68, 0x75, 0xbe, 0xe4, 0x61,
-	0x33, 0x81, 0x64, 0x9d, 0xb5, 0x23, 0xf3, 0x10, 0xb6, 0xc0, 0xab, 0xb0, 0xea, 0x44, 0xbb, 0x49,
-	0xcd, 0x3b, 0x69, 0x34, 0x1e, 0x05, 0x86, 0xf7, 0x8a, 0x89, 0xcc, 0xe7, 0xbe, 0xa7, 0xf3, 0x25,
-	0x90, 0xcd, 0x7d, 0xef, 0x36, 0xa9, 0xa3, 0x13, 0xf1, 0x13, 0x8d, 0xde, 0x44, 0xbb, 0x49, 0x53,
-	0xe2, 0x1e, 0x74, 0x4e, 0x3b, 0xf4, 0x52, 0x3d, 0x8a, 0x34, 0xb5, 0x38, 0xcd, 0x79, 0x70, 0x82,
-	0xb3, 0x69, 0x46, 0xea, 0xe3, 0xe4, 0x7a, 0xb3, 0x4a, 0x13, 0xe2, 0xc7, 0xec, 0x9e, 0x58, 0x9e,
-	0x74, 0x65, 0xbd, 0xe8, 0x08, 0x51, 0x32, 0x6c, 0x25, 0x91, 0x9e, 0xc5, 0x0c, 0x28, 0x1b, 0x96,
-	0x4e, 0x77, 0x0d, 0x3c, 0x4e, 0xd9, 0xb0, 0x18, 0x2b, 0xc1, 0x42, 0x11, 0xda, 0xdd, 0xe3, 0x0b,
-	0x45, 0x54, 0x42, 0x3c, 0x30, 0x77, 0xbe, 0x18, 0xe1, 0xb9, 0xce, 0x61, 0x76, 0x33, 0x80, 0x76,
-	0xce, 0xc7, 0xd9, 0xae, 0xec, 0x94, 0x2f, 0x9b, 0x57, 0xeb, 0x68, 0x23, 0x46, 0x8b, 0x7c, 0x8a,
-	0x1f, 0x2d, 0xc8, 0xea, 0x35, 0xec, 0x2d, 0x26, 0x9b, 0xa6, 0x94, 0xad, 0x92, 0x94, 0x0d, 0x1f,
-	0x47, 0x5b, 0x8c, 0x86, 0xdf, 0x35, 0x6c, 0x3d, 0x2a, 0xd4, 0x1b, 0x8e, 0xc7, 0x4f, 0xe7, 0x6e,
-	0x66, 0x6f, 0x23, 0x26, 0xa6, 0x1c, 0xcf, 0x27, 0x04, 0xdc, 0xa3, 0x29, 0xba, 0x34, 0xc7, 0x3e,
-	0xb5, 0xe1, 0x92, 0x74, 0x9a, 0x6f, 0x57, 0x48, 0xeb, 0x44, 0xbb, 0x4c, 0xbe, 0xe3, 0xc3, 0xbe,
-	0xc9, 0x40, 0x9d, 0x3d, 0x90, 0x51, 0xc8, 0xa2, 0x5f, 0x32, 0x67, 0xba, 0x73, 0xb1, 0x75, 0xee,
-	0xeb, 0x03, 0x90, 0x32, 0x16, 0x5f, 0x85, 0x47, 0x2c, 0xd6, 0x33, 0xad, 0xcf, 0x2c, 0xd2, 0x14,
-	0x1e, 0xa8, 0xfd, 0xbc, 0x54, 0xed, 0x19, 0x44, 0x8e, 0xdc, 0x33, 0x5b, 0x1d, 0xc7, 0x35, 0x5c,
-	0x3a, 0x07, 0x82, 0x57, 0x60, 0x15, 0x36, 0x29, 0xb0, 0x89, 0x36, 0x84, 0x7d, 0xb0, 0x95, 0x9e,
-	0x6d, 0xcc, 0x5d, 0xe8, 0xad, 0x13, 0x3a, 0x79, 0x69, 0x2f, 0xc3, 0x9e, 0x30, 0x9d, 0xab, 0x2e,
-	0xda, 0x94, 0x62, 0x24, 0x67, 0x11, 0x09, 0x17, 0xb5, 0x4a, 0x6f, 0x8b, 0x5a, 0xd5, 0x47, 0x38,
-	0xcd, 0x57, 0x4e, 0xa7, 0x17, 0xe3, 0x9d, 0x1e, 0x2c, 0xd4, 0x29, 0x4b, 0x00, 0xc0, 0x52, 0x7a,
-	0x20, 0xf2, 0x21, 0x6f, 0x59, 0x6d, 0xab, 0xd5, 0x6d, 0x45, 0x69, 0x8f, 0x89, 0x05, 0xd3, 0x8d,
-	0xe2, 0x46, 0xf2, 0x59, 0x0d, 0x3e, 0x32, 0xca, 0xba, 0x30, 0x18, 0xf6, 0xa0, 0xf5, 0xbe, 0x6b,
-	0xcc, 0xce, 0x5a, 0x0d, 0x7d, 0xc6, 0xf0, 0x2c, 0x0f, 0xfc, 0xce, 0x61, 0x28, 0x9c, 0x0c, 0xca,
-	0xf0, 0x59, 0x54, 0x6d, 0x31, 0x42, 0xe2, 0xd5, 0x13, 0x83, 0x91, 0x02, 0xb7, 0x62, 0xb4, 0x95,
-	0xd1, 0x95, 0xf4, 0x38, 0xfb, 0xaa, 0xf8, 0xf9, 0xa0, 0xe7, 0x60, 0xb3, 0xeb, 0x7a, 0x7b, 0xda,
-	0x75, 0xe6, 0x02, 0xaf, 0x44, 0x1a, 0x6d, 0xe8, 0x70, 0xd0, 0x23, 0xb3, 0xda, 0x4a, 0x3d, 0xff,
-	0x53, 0xd1, 0xb6, 0xed, 0x34, 0xbb, 0x27, 0x75, 0x9f, 0x5d, 0x93, 0xe2, 0x2b, 0xef, 0x16, 0xb4,
-	0x66, 0x3e, 0xe6, 0x9f, 0xb3, 0x27, 0xe2, 0x45, 0xdb, 0xb9, 0xc9, 0x86, 0xc0, 0xd3, 0x1d, 0xf0,
-	0x40, 0xc3, 0x37, 0xca, 0x6d, 0xba, 0x38, 0x11, 0xbe, 0x4d, 0x17, 0x27, 0x20, 0x1e, 0xff, 0x96,
-	0x73, 0xdb, 0xaf, 0xec, 0xc3, 0x97, 0x85, 0xe3, 0xdf, 0x25, 0xc4, 0x1b, 0x58, 0x91, 0x78, 0xfd,
-	0xcb, 0x2c, 0x5c, 0x4b, 0x04, 0x8a, 0x37, 0x16, 0xea, 0x66, 0xc3, 0x71, 0x9b, 0x82, 0x51, 0x03,
-	0xf3, 0x87, 0x7b, 0x00, 0xf0, 0x84, 0x47, 0xd0, 0x40, 0x74, 0xb8, 0x2b, 0xf8, 0x97, 0xcc, 0x80,
-	0xc6, 0xd3, 0x94, 0x40, 0x0d, 0x13, 0x68, 0x8d, 0x4b, 0x4b, 0x40, 0xdb, 0x07, 0x72, 0x87, 0xde,
-	0x8d, 0x07, 0x40, 0x02, 0x1a, 0x92, 0xf3, 0x30, 0xc8, 0x6f, 0x5a, 0x9e, 0x9f, 0xec, 0xe7, 0x86,
-	0xb9, 0xe8, 0xe5, 0x70, 0x4d, 0xce, 0xc0, 0xf7, 0x30, 0xbb, 0x3d, 0xf0, 0x8a, 0xd1, 0xaa, 0x47,
-	0xe6, 0x22, 0x4f, 0x43, 0xd3, 0xff, 0xc9, 0x07, 0x12, 0x21, 0xf7, 0xa4, 0xe1, 0xba, 0x56, 0xe4,
-	0x92, 0x9e, 0x47, 0x03, 0xae, 0xf9, 0x96, 0x32, 0xa5, 0x76, 0xd5, 0xf4, 0xa1, 0x51, 0xb0, 0x8c,
-	0x75, 0x39, 0xbb, 0xf5, 0xa0, 0x21, 0x31, 0x12, 0x96, 0x08, 0xc9, 0x87, 0xea, 0x5b, 0xe5, 0x9a,
-	0x5e, 0x07, 0x8c, 0xfd, 0x42, 0xc1, 0x0e, 0xc0, 0xe4, 0xb4, 0x29, 0xf9, 0x00, 0x0c, 0x55, 0xde,
-	0xc5, 0x84, 0x6d, 0x2d, 0xc4, 0x73, 0x39, 0x67, 0x44, 0x21, 0x0e, 0x64, 0xf5, 0x91, 0x6a, 0xc7,
-	0x25, 0xd8, 0x95, 0x49, 0x3e, 0xbc, 0x56, 0x27, 0x0a, 0x71, 0xb0, 0x48, 0x07, 0x31, 0x09, 0x7e,
-	0x45, 0x8b, 0xb6, 0x1c, 0x27, 0xe9, 0xd5, 0xca, 0x7b, 0xae, 0xd1, 0xf6, 0x8c, 0x86, 0x78, 0x96,
-	0xed, 0x59, 0x34, 0xec, 0xb8, 0xd6, 0x9c, 0xd5, 0xd6, 0x1b, 0xf3, 0x86, 0xd5, 0xe6, 0x19, 0x29,
-	0x56, 0x36, 0x15, 0x14, 0x45, 0xeb, 0x6e, 0xbb, 0xdb, 0x9a, 0x09, 0xb7, 0x15, 0xd8, 0xba, 0x7b,
-	0x9b, 0x16, 0x05, 0xab, 0xbf, 0x6b, 0x36, 0x4c, 0xab, 0xe3, 0x43, 0xd6, 0x96, 0x9d, 0x55, 0x1c,
-	0x86, 0x42, 0x96, 0xaa, 0xfe, 0xa8, 0x06, 0x1e, 0x8a, 0x9c, 0x1f, 0x90, 0xfa, 0xfd, 0x08, 0xcf,
-	0x24, 0x5f, 0xf2, 0x05, 0xf8, 0x79, 0xa9, 0x0e, 0x52, 0xb4, 0x60, 0x1d, 0x90, 0xd0, 0x21, 0x8f,
-	0x80, 0x85, 0x09, 0xdb, 0x4e, 0x35, 0xeb, 0xfb, 0xee, 0xd5, 0xd7, 0x34, 0x88, 0x12, 0x33, 0x7a,
-	0xfb, 0x59, 0x48, 0xdc, 0xbf, 0xd5, 0xef, 0xfb, 0x1a, 0x7a, 0xfa, 0xa1, 0x4b, 0xb3, 0x0f, 0xd4,
-	0xd7, 0x9d, 0x64, 0xb7, 0xb5, 0xf0, 0x6d, 0x84, 0xa8, 0x2f, 0x1c, 0x38, 0xb4, 0x8e, 0x72, 0xff,
-	0x91, 0xb1, 0x2d, 0xd2, 0x98, 0x72, 0xda, 0xbe, 0x6b, 0x34, 0xfc, 0xfa, 0x10, 0x25, 0x71, 0xbd,
-	0x3d, 0xeb, 0x04, 0xeb, 0x91, 0xb7, 0xd8, 0x9a, 0x71, 0x6c, 0x7e, 0x1a, 0x96, 0x3d, 0x89, 0xf7,
-	0xc5, 0xd8, 0xe8, 0xe2, 0x8f, 0xb8, 0x8a, 0x06, 0x9b, 0x66, 0xc3, 0x6a, 0x19, 0xb6, 0x07, 0xc7,
-	0xe6, 0xc2, 0x67, 0x7c, 0x08, 0x6d, 0xa2, 0x99, 0x66, 0xdf, 0x37, 0x9b, 0x3a, 0x6f, 0xbf, 0x9a,
-	0x56, 0x1a, 0x09, 0x5f, 0x80, 0x28, 0xe1, 0x6d, 0x38, 0x89, 0x98, 0x5e, 0xfe, 0xd9, 0x0d, 0x0b,
-	0xc6, 0x96, 0xbc, 0x35, 0x18, 0xfb, 0x12, 0x1a, 0x04, 0x2e, 0xb8, 0x89, 0xf7, 0x4b, 0x75, 0x25,
-	0x21, 0x52, 0x0f, 0x5b, 0x92, 0x0b, 0x30, 0xb0, 0x98, 0x46, 0x21, 0x01, 0x63, 0x7a, 0x93, 0x8b,
-	0x74, 0xc6, 0x0a, 0x31, 0x29, 0x9d, 0xd4, 0x51, 0x9a, 0x6a, 0x2d, 0x7d, 0xa6, 0x17, 0xcd, 0xf6,
-	0x28, 0x09, 0x84, 0xdb, 0x7f, 0x43, 0x06, 0x7f, 0xa7, 0xbc, 0x79, 0xc1, 0xe8, 0x70, 0x73, 0x02,
-	0x3d, 0x7e, 0x21, 0x35, 0x24, 0x41, 0xee, 0x83, 0x47, 0x09, 0xce, 0x5d, 0x6c, 0x2c, 0x5c, 0x71,
-	0xdc, 0x7b, 0xa2, 0xd3, 0x71, 0x00, 0x8d, 0x34, 0x80, 0x5e, 0x22, 0x47, 0xb5, 0xb1, 0x11, 0xef,
-	0x87, 0x5c, 0x81, 0x6c, 0x84, 0x9a, 0x2c, 0xc8, 0xb4, 0x0d, 0x0d, 0x5a, 0x1e, 0xf3, 0x3f, 0x29,
-	0xbd, 0xc1, 0xfa, 0x5a, 0xcb, 0xa3, 0x2d, 0xc9, 0x76, 0x48, 0xdd, 0xdf, 0xe4, 0xd7, 0xb1, 0xa7,
-	0x1d, 0x27, 0x4c, 0x13, 0xbf, 0x05, 0x89, 0xf9, 0xc4, 0x4b, 0xa0, 0x9a, 0x39, 0x2c, 0x82, 0xf1,
-	0xdc, 0x70, 0x9a, 0x7c, 0xef, 0x7f, 0x55, 0x1d, 0x9e, 0x8a, 0xb8, 0xb3, 0x5b, 0xe1, 0xfc, 0xd4,
-	0x65, 0xb6, 0xf9, 0x35, 0xeb, 0x70, 0x5e, 0x7e, 0x54, 0x81, 0x88, 0x4d, 0x78, 0x03, 0x8c, 0xe4,
-	0x9f, 0xa2, 0xc7, 0xa7, 0xc3, 0xfb, 0xda, 0x95, 0xfc, 0xfb, 0xda, 0xcc, 0x86, 0xd0, 0x00, 0x4f,
-	0xa1, 0xe1, 0x58, 0xde, 0x6d, 0x80, 0x12, 0xa8, 0x66, 0x6f, 0x04, 0xf1, 0x0b, 0xae, 0xb6, 0x90,
-	0x8c, 0x3b, 0x83, 0xaa, 0x56, 0x10, 0xf4, 0x26, 0x6e, 0xc9, 0x1b, 0x34, 0xfd, 0x49, 0x67, 0xf0,
-	0x60, 0x7d, 0xab, 0xe5, 0x4d, 0x09, 0x15, 0xa6, 0x9d, 0xc6, 0x04, 0x7d, 0x8d, 0x6d, 0xb4, 0x93,
-	0x55, 0xd4, 0xe5, 0xd7, 0xec, 0xe9, 0xe4, 0xce, 0xfa, 0x1e, 0xc7, 0x49, 0x4e, 0x5d, 0x0e, 0x1a,
-	0xd4, 0xab, 0x8c, 0x5e, 0xa2, 0x3b, 0xfa, 0x8e, 0x5c, 0x85, 0x79, 0x42, 0x83, 0xea, 0x69, 0x67,
-	0x6a, 0x86, 0x5d, 0x91, 0x99, 0x80, 0x8d, 0x6a, 0x61, 0x5b, 0x47, 0x50, 0x30, 0x24, 0x80, 0x12,
-	0x17, 0x6d, 0xf7, 0xaa, 0x09, 0xc9, 0x4e, 0xb3, 0xaf, 0x82, 0x83, 0xc3, 0xe4, 0x06, 0x44, 0x6d,
-	0xbc, 0xb5, 0x70, 0x21, 0xaa, 0x3c, 0x2b, 0x17, 0x61, 0x12, 0x2a, 0x89, 0x29, 0xd9, 0xe1, 0x27,
-	0x0f, 0x27, 0x3a, 0x1d, 0xd7, 0x59, 0x80, 0x89, 0xe6, 0x25, 0x26, 0x30, 0xf9, 0xff, 0xa0, 0xbb,
-	0xac, 0x5a, 0x61, 0x92, 0x74, 0xa3, 0x01, 0x35, 0xa2, 0xa4, 0x4a, 0x76, 0x48, 0x0d, 0xdf, 0xbe,
-	0xa0, 0x62, 0xdd, 0x8c, 0xdf, 0x7c, 0xdf, 0x60, 0xc4, 0x7a, 0x22, 0xe7, 0x23, 0x7f, 0x83, 0x26,
-	0x79, 0xa6, 0x4d, 0x97, 0xbe, 0xa1, 0x47, 0x41, 0x85, 0x45, 0x32, 0xbc, 0x8d, 0xab, 0xc5, 0x6f,
-	0xe3, 0x4e, 0x83, 0x98, 0x19, 0xed, 0x23, 0x15, 0x75, 0x82, 0x82, 0xf0, 0x1c, 0x40, 0xf0, 0x90,
-	0x71, 0x98, 0x7e, 0x5f, 0x74, 0x09, 0x70, 0xc2, 0xb6, 0xd3, 0x44, 0xc3, 0x08, 0xf5, 0x1c, 0xe4,
-	0xd8, 0x68, 0xa9, 0x82, 0xc7, 0xa8, 0xf7, 0x8a, 0xd0, 0x3b, 0x71, 0xa3, 0x9d, 0xa7, 0xac, 0x7e,
-	0xc2, 0x6b, 0xeb, 0xc3, 0x8c, 0x34, 0x6d, 0x58, 0x20, 0x75, 0x46, 0xdb, 0xf3, 0x59, 0xdd, 0x0a,
-	0x4b, 0x3c, 0x72, 0x3a, 0x3a, 0xe0, 0x4c, 0x2b, 0x4e, 0x19, 0x1d, 0xa3, 0x61, 0xf9, 0x8b, 0x05,
-	0x14, 0x7d, 0x27, 0x0a, 0x97, 0x13, 0x4d, 0x81, 0xcb, 0x2a, 0x1a, 0x6c, 0x40, 0x19, 0xa8, 0x39,
-	0x7c, 0xce, 0xd0, 0xf4, 0x9e, 0xc8, 0xf6, 0x5c, 0x03, 0x40, 0xd5, 0x8a, 0xb4, 0xbc, 0x18, 0x19,
-	0x58, 0x56, 0x29, 0x3a, 0x1a, 0xcb, 0x18, 0x6f, 0x84, 0xef, 0x94, 0x7b, 0xa0, 0x31, 0x11, 0x40,
-	0x53, 0x1b, 0x5b, 0x71, 0xe2, 0xe4, 0x0a, 0x5a, 0x1f, 0xab, 0xa7, 0xb2, 0xb1, 0x28, 0x7d, 0x25,
-	0x2e, 0x3d, 0x59, 0xe0, 0x72, 0xba, 0x46, 0xdb, 0x37, 0x83, 0x2f, 0xf8, 0x2d, 0xd3, 0xf3, 0x8c,
-	0x39, 0xf3, 0xde, 0x62, 0x27, 0x1c, 0xe3, 0xfb, 0xd0, 0xc6, 0x39, 0xfa, 0xde, 0x4d, 0xee, 0xf6,
-	0x40, 0x31, 0xdf, 0xc2, 0xd9, 0x8f, 0x46, 0x5a, 0xac, 0xb9, 0xee, 0x2f, 0x76, 0x4c, 0xbd, 0xeb,
-	0x72, 0x2f, 0x6c, 0x43, 0x2b, 0x22, 0x7b, 0xdf, 0xb5, 0xc9, 0x59, 0xb4, 0x16, 0xba, 0x54, 0x7c,
-	0xfa, 0xb2, 0x6e, 0x36, 0x91, 0x37, 0xb9, 0xe2, 0xe5, 0x5c, 0x87, 0x3b, 0xce, 0x83, 0x73, 0x50,
-	0x01, 0x14, 0xbe, 0x43, 0x1e, 0x04, 0xb1, 0x4a, 0xf5, 0xb0, 0x36, 0x39, 0x96, 0x88, 0xaf, 0x27,
-	0x6c, 0xdb, 0x79, 0x1c, 0x04, 0xb2, 0x5c, 0x23, 0x18, 0xad, 0x72, 0x1d, 0xdb, 0x84, 0xe5, 0x91,
-	0xfe, 0x4f, 0xce, 0xa7, 0x22, 0xbe, 0xb0, 0x11, 0x30, 0xb4, 0x23, 0xe9, 0x0e, 0x0d, 0x89, 0xce,
-	0x0d, 0x4f, 0xd2, 0x0a, 0x89, 0xd7, 0x64, 0xbf, 0x64, 0x22, 0xbd, 0xb9, 0x54, 0xb6, 0x9b, 0x31,
-	0x61, 0x9e, 0xd1, 0x8b, 0x08, 0x0f, 0x18, 0x82, 0x0d, 0xef, 0xa2, 0x23, 0x4c, 0xa6, 0xf8, 0x7b,
-	0x20, 0xff, 0x2a, 0xda, 0x10, 0xc7, 0xbe, 0x51, 0x1e, 0x26, 0x88, 0xd1, 0xe0, 0x97, 0xc6, 0x58,
-	0x7b, 0x28, 0x24, 0x53, 0x20, 0xf8, 0x65, 0xc0, 0xc9, 0x91, 0x64, 0xa7, 0xf3, 0x0f, 0x32, 0x35,
-	0x40, 0x33, 0x72, 0x22, 0x61, 0x48, 0xbc, 0xda, 0xf2, 0xcd, 0x96, 0xda, 0x75, 0x96, 0x50, 0xa8,
-	0xb3, 0x66, 0x64, 0x3f, 0x3f, 0x70, 0x23, 0xf9, 0xe2, 0x83, 0x37, 0x00, 0x5a, 0xfc, 0x78, 0x78,
-	0x50, 0x46, 0x51, 0x35, 0xda, 0x10, 0xb0, 0x3c, 0xee, 0xbe, 0x30, 0x97, 0x72, 0xd0, 0xf2, 0xc0,
-	0x5f, 0xb9, 0x80, 0x56, 0x33, 0xbf, 0xa4, 0x52, 0xd6, 0x2f, 0x61, 0xed, 0xc8, 0xa5, 0x30, 0xaf,
-	0x9f, 0xae, 0x53, 0x5c, 0xbd, 0x73, 0xfc, 0x9e, 0x82, 0x9c, 0x4a, 0x94, 0x76, 0xa2, 0x9d, 0xaa,
-	0x3f, 0xc1, 0x52, 0x6e, 0xa1, 0x21, 0xf9, 0x1d, 0x0d, 0x6d, 0x11, 0x34, 0xff, 0xd0, 0xf2, 0xe7,
-	0x79, 0xa4, 0xd8, 0x37, 0xc8, 0x17, 0x7c, 0x46, 0x88, 0xa2, 0x58, 0x2a, 0x7c, 0x5b, 0x2c, 0xa4,
-	0xe5, 0xc1, 0xec, 0x94, 0x63, 0xf1, 0x31, 0x1b, 0x05, 0x4f, 0xfc, 0xda, 0xbb, 0x38, 0xc2, 0x04,
-	0x36, 0xfb, 0x9e, 0x06, 0xf8, 0x71, 0x78, 0x69, 0x35, 0xb3, 0x43, 0x50, 0xff, 0x7d, 0x34, 0x2c,
-	0x6e, 0xf5, 0x82, 0x11, 0x0e, 0xe5, 0x69, 0x48, 0xa0, 0xc5, 0xf1, 0x12, 0x44, 0x32, 0x3f, 0x53,
-	0x40, 0x99, 0x97, 0x61, 0xbf, 0x88, 0x21, 0xb0, 0xdc, 0x35, 0xcd, 0x66, 0xf1, 0x31, 0x7a, 0x07,
-	0x4e, 0x6e, 0xc5, 0xda, 0x86, 0x87, 0xb1, 0x56, 0x7b, 0x41, 0x81, 0xd2, 0x41, 0x89, 0x1a, 0xd6,
-	0x59, 0x6d, 0xf2, 0x1a, 0x5f, 0x6f, 0xc5, 0xcb, 0xaf, 0x77, 0x01, 0xeb, 0x8a, 0x33, 0x76, 0x0c,
-	0x6d, 0x49, 0xde, 0x92, 0x8b, 0x05, 0x4f, 0x4f, 0xc7, 0xee, 0xc7, 0x81, 0xa0, 0x1f, 0xd3, 0xc2,
-	0x8b, 0xe7, 0x52, 0xd2, 0xc0, 0xf7, 0x15, 0x34, 0xc8, 0xa1, 0xb5, 0x94, 0xf0, 0x0d, 0x72, 0x2a,
-	0x61, 0x5b, 0xb9, 0x97, 0x33, 0xfe, 0x9d, 0x5f, 0xd4, 0xd0, 0x6a, 0xca, 0x05, 0xfe, 0x84, 0x86,
-	0xd6, 0xb0, 0x90, 0x0d, 0xef, 0xcb, 0xde, 0x1c, 0x8b, 0xe1, 0x79, 0x55, 0xf7, 0xe7, 0x57, 0x64,
-	0x62, 0x90, 0xf1, 0x8f, 0x7d, 0xeb, 0x87, 0x9f, 0xac, 0x1c, 0xc6, 0x07, 0x6b, 0x1d, 0xd7, 0x69,
-	0x76, 0x1b, 0xbe, 0xd7, 0xb0, 0xb2, 0xd0, 0xef, 0x00, 0x0a, 0x11, 0xff, 0x81, 0x86, 0x86, 0xc2,
-	0x7d, 0x4c, 0xfc, 0x42, 0x76, 0x5f, 0x12, 0xdc, 0xaf, 0xea, 0x91, 0xa2, 0xd5, 0x81, 0xc1, 0x73,
-	0x94, 0xc1, 0x53, 0xf8, 0x44, 0x11, 0x06, 0xa3, 0xff, 0x96, 0xe8, 0x58, 0x5c, 0xc6, 0xbf, 0xa7,
-	0xa1, 0xe1, 0x90, 0xe8, 0x84, 0x6d, 0xab, 0xd8, 0x95, 0x60, 0x82, 0xa9, 0xd8, 0x95, 0xa1, 0x7b,
-	0x91, 0x13, 0x94, 0xdd, 0x1a, 0x7e, 0xa1, 0x14, 0xbb, 0xf8, 0x73, 0x1a, 0x5a, 0x27, 0x4c, 0x7c,
-	0x5c, 0x53, 0x6a, 0x29, 0x7d, 0xb8, 0xb6, 0xfa, 0x62, 0xf1, 0x06, 0xc0, 0xe9, 0x05, 0xca, 0xe9,
-	0x69, 0x7c, 0xaa, 0xa0, 0xe5, 0x39, 0x81, 0x50, 0xb5, 0x7f, 0xa8, 0xa1, 0x0d, 0x71, 0x77, 0x47,
-	0xc5, 0xb6, 0x14, 0xc4, 0x4b, 0xc5, 0xb6, 0x1c, 0x8e, 0x8b, 0x9c, 0xa2, 0x6c, 0x1f, 0xc5, 0xb5,
-	0x92, 0x6c, 0xe3, 0x6f, 0x6a, 0x68, 0xb3, 0x0c, 0xcf, 0x0a, 0x9f, 0xc8, 0xe6, 0x41, 0x81, 0x9e,
-	0x55, 0x3d, 0x59, 0xb6, 0x19, 0x08, 0x70, 0x83, 0x0a, 0x70, 0x19, 0x4f, 0x95, 0x1a, 0x21, 0x7a,
-	0xcc, 0x02, 0xe0, 0x34, 0x2e, 0xe3, 0x3f, 0xd5, 0xd0, 0xa6, 0x14, 0x2c, 0x16, 0x1e, 0x57, 0x0e,
-	0x06, 0x29, 0xf4, 0x56, 0xf5, 0x58, 0xa9, 0x36, 0xbd, 0x8c, 0xa1, 0x39, 0xd3, 0xd7, 0x01, 0xc3,
-	0x90, 0x83, 0x6e, 0xe1, 0xaf, 0x6a, 0x68, 0x43, 0xfc, 0xc6, 0x61, 0x0e, 0xf3, 0xd2, 0xbb, 0x90,
-	0x39, 0xcc, 0xcb, 0x6f, 0x46, 0x92, 0x57, 0x28, 0xf3, 0x97, 0xf0, 0x64, 0x11, 0xe6, 0x93, 0x77,
-	0x28, 0x6b, 0x4b, 0xc2, 0x27, 0x6f, 0x19, 0x7f, 0x49, 0x43, 0x9b, 0xe2, 0xdd, 0x04, 0xd3, 0x61,
-	0x5c, 0x39, 0xba, 0x4b, 0x8b, 0x92, 0x89, 0xad, 0x45, 0xce, 0x52, 0x51, 0x4e, 0xe2, 0xe3, 0xbd,
-	0x88, 0x82, 0xbf, 0xac, 0xa1, 0x61, 0x11, 0x9b, 0x09, 0xab, 0x17, 0x13, 0x09, 0xe0, 0x54, 0xf5,
-	0x68, 0x89, 0x16, 0xc0, 0xf3, 0x55, 0xca, 0xf3, 0x04, 0xbe, 0x50, 0x84, 0xe7, 0x18, 0xc8, 0x54,
-	0x6d, 0x49, 0x98, 0x0c, 0xcb, 0xc1, 0xda, 0xb9, 0x51, 0xec, 0x21, 0xd0, 0xbc, 0x7a, 0x5d, 0x29,
-	0x29, 0x41, 0x06, 0xf8, 0x15, 0x39, 0x4d, 0x25, 0x38, 0x86, 0x8f, 0x96, 0x96, 0x00, 0xff, 0x44,
-	0x43, 0xcf, 0x48, 0x41, 0x8d, 0xf0, 0xe9, 0x82, 0x43, 0x39, 0x0d, 0xe7, 0x54, 0x7d, 0xb9, 0x97,
-	0xa6, 0x20, 0x8b, 0x4e, 0x65, 0x79, 0x1d, 0x3f, 0x2c, 0x3b, 0x82, 0x84, 0x24, 0x63, 0xdc, 0x2e,
-	0x89, 0x19, 0xf2, 0x8e, 0x86, 0x46, 0xa5, 0x2c, 0x04, 0xe6, 0x3a, 0x5d, 0x70, 0xd0, 0x97, 0x13,
-	0x3a, 0x0f, 0x8d, 0x8a, 0x4c, 0x51, 0xa1, 0xcf, 0xe1, 0x33, 0x2b, 0x10, 0x1a, 0xff, 0x9d, 0x86,
-	0x70, 0x1a, 0xd6, 0x09, 0x2b, 0xe6, 0x71, 0x26, 0x92, 0x54, 0xf5, 0x78, 0xb9, 0x46, 0x20, 0xc6,
-	0x34, 0x15, 0xe3, 0x15, 0x7c, 0xad, 0xd0, 0x27, 0x91, 0xa3, 0x32, 0x99, 0x9e, 0x3e, 0xeb, 0xb8,
-	0xcc, 0x39, 0xae, 0x2d, 0x89, 0xee, 0xff, 0x32, 0xfe, 0xa7, 0x20, 0xd6, 0x93, 0xa2, 0x25, 0xe1,
-	0x53, 0x4a, 0x16, 0xb3, 0x51, 0x80, 0xaa, 0x2f, 0x95, 0x6f, 0x08, 0xf2, 0xdd, 0xa3, 0xf2, 0xdd,
-	0xc6, 0x37, 0x8b, 0xca, 0x27, 0x98, 0x27, 0x5b, 0xc6, 0x1f, 0x6b, 0x68, 0x34, 0x0b, 0x6d, 0x48,
-	0x35, 0x20, 0x73, 0xd0, 0x8e, 0x54, 0x03, 0x32, 0x0f, 0xdc, 0x88, 0x3c, 0xa4, 0x92, 0xde, 0xc1,
-	0xaf, 0x16, 0x96, 0x74, 0xbc, 0x98, 0xb0, 0x3f, 0xd1, 0xd0, 0x48, 0x12, 0x07, 0x05, 0x1f, 0x55,
-	0x71, 0x2a, 0x45, 0x09, 0xaa, 0x8e, 0x97, 0x69, 0x02, 0x42, 0xf9, 0x54, 0xa8, 0x36, 0xb6, 0x4b,
-	0x08, 0xe5, 0x05, 0x74, 0x74, 0x86, 0xe2, 0x52, 0x5b, 0x52, 0x41, 0x9d, 0x2c, 0xc7, 0x56, 0x1d,
-	0x3d, 0xf4, 0x8c, 0x7e, 0xb5, 0x82, 0x46, 0xb3, 0xb0, 0x44, 0x54, 0xe6, 0xcd, 0xc1, 0x08, 0x52,
-	0x99, 0x37, 0x0f, 0xc5, 0x87, 0x2c, 0x51, 0x4d, 0x74, 0xb1, 0x57, 0x44, 0x13, 0x90, 0xca, 0x63,
-	0x97, 0x04, 0x74, 0x11, 0x28, 0xa5, 0x37, 0x85, 0x7c, 0xac, 0x82, 0x76, 0xa8, 0xf0, 0x77, 0xf0,
-	0x39, 0xb5, 0x2f, 0x9e, 0x83, 0x11, 0x54, 0x3d, 0xdf, 0x6b, 0x73, 0x50, 0x4e, 0x83, 0x2a, 0xe7,
-	0x03, 0xf8, 0x8d, 0x22, 0xca, 0x31, 0x6c, 0x5b, 0x97, 0x0c, 0x15, 0x2f, 0x47, 0x35, 0xf8, 0xd3,
-	0x15, 0xb4, 0xb7, 0x08, 0xe4, 0x0d, 0xbe, 0xac, 0x94, 0xa6, 0x28, 0x4e, 0x4f, 0xf5, 0xca, 0x4a,
-	0xc9, 0x80, 0x72, 0x3e, 0x44, 0x95, 0xd3, 0xc4, 0x33, 0x45, 0x95, 0x93, 0x3d, 0x7a, 0x72, 0x75,
-	0xf4, 0x79, 0x0d, 0x6d, 0x4c, 0x80, 0xd9, 0xe4, 0x78, 0x84, 0x12, 0x58, 0x9c, 0x1c, 0x8f, 0x50,
-	0x86, 0x94, 0x53, 0x2e, 0xd4, 0x0f, 0xa2, 0x89, 0xd8, 0x45, 0x13, 0xfc, 0x05, 0x0d, 0x6d, 0x88,
-	0x83, 0xdc, 0xe4, 0xc4, 0x12, 0x52, 0xe0, 0x9d, 0x9c, 0x58, 0x42, 0x8e, 0xc5, 0x43, 0xce, 0x50,
-	0xd6, 0x4f, 0xe0, 0x63, 0x45, 0x58, 0x4f, 0xa0, 0xf6, 0xe0, 0x9f, 0x6a, 0x68, 0xa7, 0x12, 0x20,
-	0x07, 0x9f, 0x57, 0xf2, 0x94, 0x8b, 0xd4, 0x53, 0xbd, 0xd0, 0x73, 0x7b, 0x90, 0xef, 0x36, 0x95,
-	0xef, 0x1a, 0xbe, 0x52, 0xd4, 0x34, 0x89, 0x1f, 0x7c, 0x60, 0xbb, 0x90, 0x7a, 0x08, 0xce, 0xf3,
-	0x75, 0x0d, 0x3d, 0x23, 0x45, 0xd6, 0xc1, 0x8a, 0xb0, 0x5a, 0x85, 0xd7, 0x53, 0x3d, 0x55, 0xba,
-	0x1d, 0x88, 0x76, 0x89, 0x8a, 0x76, 0x1e, 0x9f, 0x2d, 0x22, 0x5a, 0x6c, 0xc4, 0x89, 0x31, 0xd4,
-	0x6f, 0x6b, 0x68, 0x28, 0xc4, 0xaa, 0xc1, 0x07, 0x15, 0x1f, 0x89, 0x04, 0x64, 0x4e, 0xf5, 0x50,
-	0xa1, 0xba, 0xc0, 0xec, 0x49, 0xca, 0xec, 0x8b, 0xf8, 0x48, 0xa1, 0x2f, 0x08, 0x6d, 0xae, 0x1b,
-	0xb6, 0x8d, 0x3f, 0xa3, 0xa1, 0x41, 0x8e, 0xbd, 0x82, 0x0f, 0xe7, 0x8c, 0xf0, 0x18, 0x80, 0x4c,
-	0xf5, 0x85, 0x82, 0xb5, 0x7b, 0x49, 0x09, 0x84, 0xa0, 0x31, 0x42, 0x4a, 0xe3, 0xd3, 0x1a, 0x5a,
-	0xc7, 0xa9, 0x06, 0xba, 0x3c, 0xac, 0x5c, 0x42, 0x4b, 0x70, 0x2b, 0x01, 0xae, 0x29, 0x97, 0xae,
-	0x0b, 0xb9, 0xc5, 0xdf, 0xd3, 0xd0, 0x48, 0x12, 0xb8, 0x04, 0x1f, 0x2f, 0x96, 0xd9, 0x8c, 0xa3,
-	0xb3, 0x54, 0x4f, 0x94, 0x6c, 0x05, 0x8c, 0xbf, 0x49, 0x19, 0x7f, 0x0d, 0x3f, 0x28, 0x97, 0x45,
-	0x02, 0x44, 0x96, 0xda, 0x52, 0x12, 0xe8, 0x66, 0xb9, 0xb6, 0x24, 0x40, 0x97, 0x2c, 0xe3, 0xaf,
-	0x68, 0xe8, 0xe9, 0x64, 0xe7, 0x81, 0x35, 0x8e, 0x17, 0xcb, 0x87, 0x16, 0x17, 0x52, 0x01, 0x26,
-	0xd3, 0x63, 0xee, 0x97, 0x0b, 0x89, 0xff, 0x57, 0x43, 0xd5, 0x6c, 0x24, 0x89, 0x9c, 0x45, 0x35,
-	0x17, 0x0b, 0x25, 0x67, 0x51, 0xcd, 0x47, 0x35, 0x21, 0x1f, 0xa4, 0xe2, 0xbd, 0x0f, 0xbf, 0x56,
-	0x4e, 0xbc, 0xf4, 0x6f, 0xd0, 0x40, 0xa8, 0x7d, 0xbd, 0x99, 0xb4, 0xe2, 0x8f, 0x35, 0xb4, 0x33,
-	0x9b, 0x91, 0xc0, 0x9e, 0xe7, 0x8b, 0x59, 0xa6, 0x17, 0x25, 0x14, 0x82, 0x76, 0x21, 0xd7, 0xa8,
-	0x12, 0x26, 0xf1, 0xc5, 0x95, 0x2a, 0x01, 0xff, 0x72, 0x05, 0xed, 0xce, 0xc3, 0x3f, 0xc1, 0x13,
-	0x65, 0x8d, 0x96, 0x02, 0x67, 0xa9, 0x4e, 0xae, 0x84, 0x04, 0x48, 0x6d, 0x50, 0xa9, 0xdf, 0xc0,
-	0xaf, 0x17, 0xfd, 0x9e, 0x4a, 0x25, 0x8f, 0x60, 0x4c, 0x6a, 0x4b, 0x56, 0xd3, 0x5b, 0xae, 0x2d,
-	0x85, 0x90, 0x30, 0xcb, 0xf8, 0x3f, 0x34, 0xb4, 0x35, 0x03, 0xb2, 0x01, 0x2b, 0xac, 0x56, 0x08,
-	0xbe, 0xa4, 0x7a, 0xb1, 0x77, 0x02, 0xa0, 0x81, 0xbb, 0x54, 0x03, 0xb7, 0xf0, 0x8d, 0xe2, 0xb9,
-	0x17, 0xc9, 0x0f, 0x29, 0x25, 0x92, 0x4c, 0x9f, 0xaa, 0xa0, 0xbd, 0x45, 0x60, 0x41, 0x54, 0xee,
-	0x7d, 0x09, 0x48, 0x13, 0x95, 0x7b, 0x5f, 0x06, 0x9d, 0xa4, 0x5c, 0xec, 0x53, 0x50, 0x19, 0xb1,
-	0x30, 0x30, 0x58, 0x0c, 0xbe, 0xab, 0xa1, 0x6a, 0x06, 0x57, 0xc1, 0x4a, 0x70, 0x26, 0x3f, 0x91,
-	0x96, 0x09, 0x63, 0x52, 0x3d, 0xdb, 0x5b, 0x63, 0x10, 0xff, 0x32, 0x15, 0xff, 0x02, 0x3e, 0xb7,
-	0x22, 0xf1, 0x03, 0x27, 0x67, 0x58, 0xbc, 0x39, 0xa3, 0xda, 0xeb, 0x93, 0x80, 0x88, 0xa8, 0xf6,
-	0xfa, 0x64, 0x38, 0x22, 0xe5, 0xf2, 0xbf, 0xb1, 0x5f, 0xeb, 0xc2, 0x5f, 0xd2, 0xd0, 0xfa, 0x18,
-	0xa4, 0x06, 0x56, 0x74, 0x2e, 0x03, 0x1c, 0xa9, 0xd6, 0x0a, 0xd7, 0xef, 0x65, 0xa1, 0x8d, 0xfd,
-	0x5a, 0x58, 0x22, 0xb1, 0x8b, 0xbf, 0xa8, 0xa1, 0x91, 0x24, 0x9a, 0x88, 0x2a, 0x99, 0x94, 0x81,
-	0x4c, 0xa2, 0x4a, 0x26, 0x65, 0x81, 0x95, 0x90, 0xf3, 0x54, 0x8a, 0x97, 0xf0, 0xc9, 0xf2, 0x52,
-	0x50, 0x47, 0xf8, 0xab, 0x1a, 0xfd, 0xa9, 0xab, 0x24, 0x16, 0x89, 0xca, 0xaf, 0xc9, 0x46, 0x37,
-	0x51, 0xf9, 0x35, 0x0a, 0xc0, 0x13, 0x32, 0x41, 0x85, 0x38, 0x83, 0x4f, 0x17, 0x11, 0xe2, 0x2d,
-	0x4a, 0x48, 0x8f, 0x8d, 0x1f, 0x8f, 0x06, 0xe9, 0x09, 0x5c, 0x13, 0x55, 0x90, 0x2e, 0x47, 0x48,
-	0x51, 0x05, 0xe9, 0x19, 0xa0, 0x29, 0xe5, 0x7c, 0xb2, 0xf8, 0x4f, 0xd4, 0x05, 0xfa, 0xff, 0x4f,
-	0x0d, 0x55, 0xb3, 0x61, 0x4e, 0x54, 0x8b, 0x50, 0x2e, 0xd8, 0x8a, 0x6a, 0x11, 0xca, 0x47, 0x56,
-	0x21, 0xaf, 0x53, 0xc1, 0xee, 0xe2, 0x3b, 0x45, 0x3f, 0xc9, 0x99, 0x98, 0x27, 0xa9, 0x95, 0xf7,
-	0x27, 0x2c, 0xc0, 0xcf, 0x86, 0x52, 0xc9, 0xf1, 0x45, 0x73, 0x11, 0x5b, 0x72, 0x7c, 0xd1, 0x7c,
-	0x0c, 0x17, 0x72, 0x8b, 0x4a, 0x7f, 0x15, 0x5f, 0x2e, 0x2a, 0x3d, 0xcd, 0xc0, 0x65, 0x69, 0x00,
-	0x7f, 0x4d, 0x43, 0xa3, 0x71, 0x9d, 0x47, 0x28, 0x1a, 0xaa, 0x10, 0x5f, 0x85, 0xcd, 0xa1, 0x0a,
-	0xf1, 0x95, 0x70, 0x1d, 0xe5, 0x16, 0x8d, 0x34, 0xb0, 0x07, 0xfe, 0x77, 0x0d, 0xed, 0x50, 0x01,
-	0x62, 0xa8, 0x52, 0xa7, 0x05, 0x80, 0x3c, 0x54, 0xa9, 0xd3, 0x22, 0x38, 0x1c, 0xe4, 0x55, 0x2a,
-	0xdf, 0x75, 0x7c, 0xb5, 0x88, 0x7c, 0xe1, 0xa5, 0xf3, 0xda, 0x52, 0xf8, 0xef, 0x72, 0x2d, 0x76,
-	0xe1, 0x1f, 0x7f, 0xb4, 0x82, 0x48, 0x16, 0x26, 0x47, 0x04, 0x91, 0x81, 0x27, 0x73, 0xf9, 0xce,
-	0x45, 0xf4, 0x50, 0xe5, 0xd3, 0xf3, 0x00, 0x1b, 0xc8, 0x1b, 0x54, 0xee, 0xfb, 0xf8, 0x6e, 0x1f,
-	0xe4, 0x06, 0x90, 0x8f, 0x99, 0x50, 0xb8, 0xbf, 0xd4, 0xd0, 0x26, 0x76, 0x93, 0x42, 0x3c, 0x72,
-	0xa7, 0x58, 0xf1, 0x15, 0x18, 0x0e, 0xaa, 0xc3, 0x22, 0x2a, 0x58, 0x87, 0xde, 0x47, 0x6e, 0x8d,
-	0x01, 0xd3, 0x7f, 0x59, 0x43, 0xa3, 0x11, 0x6e, 0x42, 0x1c, 0x40, 0x00, 0x1f, 0x2a, 0x86, 0xb5,
-	0xc0, 0x24, 0x38, 0x5c, 0x06, 0x98, 0xa1, 0x9c, 0x47, 0xd7, 0x0c, 0xda, 0x0b, 0xf6, 0x09, 0x96,
-	0x16, 0x36, 0xf1, 0x7e, 0xa1, 0x82, 0x0e, 0x14, 0x46, 0x6c, 0xc1, 0xaf, 0x94, 0x1f, 0x4a, 0x59,
-	0xb0, 0x2f, 0x2b, 0x1a, 0x96, 0x3d, 0x4e, 0xc7, 0xec, 0xa1, 0xf8, 0x2f, 0x1a, 0xda, 0x5b, 0x04,
-	0x64, 0x46, 0x15, 0xd6, 0x94, 0x00, 0xa9, 0x59, 0x91, 0xf0, 0xa5, 0xfc, 0x83, 0xd0, 0xd2, 0xe1,
-	0xca, 0xf3, 0xaf, 0x1a, 0xda, 0x9d, 0x07, 0x0f, 0xa3, 0x0a, 0xe2, 0x0b, 0x22, 0xe4, 0xa8, 0x82,
-	0xf8, 0xa2, 0xe8, 0x34, 0xe4, 0x22, 0x15, 0xf5, 0x65, 0xfc, 0x52, 0x89, 0x64, 0x6c, 0x5c, 0xda,
-	0x1f, 0x69, 0x68, 0xbb, 0x02, 0x84, 0x03, 0xab, 0x3d, 0x9a, 0x1c, 0x9c, 0x8f, 0xea, 0xb9, 0x1e,
-	0x5b, 0xf7, 0x72, 0x3e, 0x2a, 0x70, 0x09, 0xb2, 0x21, 0x40, 0xf0, 0x3b, 0x1a, 0xda, 0x9a, 0x01,
-	0xca, 0x81, 0x5f, 0x52, 0x99, 0x42, 0x05, 0xf7, 0x51, 0x3d, 0xdd, 0x43, 0x4b, 0x10, 0xee, 0x0a,
-	0x15, 0xee, 0x22, 0x3e, 0x5f, 0x2c, 0xed, 0xa4, 0x77, 0x80, 0x5a, 0xd2, 0x0f, 0xff, 0x73, 0x7e,
-	0x08, 0x32, 0x82, 0xa5, 0x18, 0xcf, 0x77, 0x43, 0x93, 0x40, 0x1c, 0x39, 0x9b, 0x4e, 0x72, 0x48,
-	0x8d, 0x72, 0x3b, 0x17, 0x89, 0x9f, 0x75, 0xae, 0x2d, 0xf1, 0xed, 0xbe, 0x2f, 0x6a, 0x68, 0x53,
-	0xbc, 0x83, 0xfc, 0xa3, 0x6b, 0xa5, 0x85, 0xc8, 0xc4, 0x05, 0x29, 0xb7, 0x73, 0x96, 0x10, 0x02,
-	0xff, 0x54, 0x43, 0x9b, 0x52, 0x77, 0xe6, 0xb1, 0x3a, 0xa5, 0x9e, 0x85, 0x98, 0xa0, 0xfa, 0x46,
-	0xab, 0x80, 0x0d, 0x88, 0x4d, 0x25, 0x98, 0xc5, 0xcd, 0x22, 0x12, 0xb0, 0x8b, 0xfc, 0xc1, 0x28,
-	0xe2, 0x74, 0x6a, 0x4b, 0x22, 0x4e, 0xc3, 0x32, 0x3f, 0xb9, 0xc1, 0x30, 0x19, 0x68, 0x4a, 0x4f,
-	0xc0, 0x5f, 0x58, 0xc6, 0x7f, 0xa5, 0x21, 0x9c, 0xc6, 0x1c, 0x50, 0xf9, 0xd4, 0x2a, 0x48, 0x04,
-	0x95, 0x4f, 0xad, 0x04, 0x37, 0x28, 0xb7, 0xcf, 0x93, 0x96, 0xda, 0xc3, 0xdf, 0xd6, 0xd0, 0x16,
-	0xf9, 0x2d, 0x75, 0xd5, 0x19, 0x23, 0xe5, 0xc5, 0x78, 0xd5, 0x19, 0x23, 0xf5, 0x85, 0xf8, 0x72,
-	0xa7, 0x11, 0x41, 0x9c, 0xf0, 0xea, 0x56, 0x6d, 0x89, 0x5f, 0xc7, 0xa7, 0xa7, 0xa2, 0xd7, 0xc7,
-	0x6e, 0x92, 0xab, 0x32, 0x3b, 0xb2, 0xfb, 0xe8, 0xaa, 0xcc, 0x8e, 0xf4, 0x8a, 0x3a, 0x79, 0x99,
-	0xf2, 0x7e, 0x1c, 0x8f, 0x17, 0xe1, 0x3d, 0xfe, 0x8b, 0xe4, 0xf8, 0x5b, 0x1a, 0xda, 0x2c, 0xc3,
-	0x35, 0x50, 0x4d, 0x22, 0x05, 0x8a, 0x82, 0x6a, 0x12, 0xa9, 0xe0, 0x13, 0xc8, 0x4d, 0x2a, 0xc3,
-	0x15, 0x7c, 0xa9, 0x88, 0x0c, 0x8f, 0x19, 0x25, 0x76, 0xab, 0x98, 0x83, 0x3f, 0x78, 0xc2, 0x1e,
-	0xe2, 0xff, 0x68, 0x68, 0x87, 0x0a, 0x33, 0x40, 0x15, 0xb0, 0x15, 0x80, 0x30, 0x50, 0x05, 0x6c,
-	0x45, 0xa0, 0x0a, 0xca, 0x9d, 0xb6, 0x84, 0xcf, 0xa9, 0xa9, 0xc7, 0xc5, 0x9e, 0x75, 0xdc, 0x60,
-	0x42, 0x05, 0x4b, 0x79, 0x12, 0x4e, 0x61, 0x19, 0x7f, 0x53, 0x43, 0x5b, 0xe4, 0xd7, 0xb3, 0x55,
-	0x93, 0x4b, 0x79, 0xed, 0x5b, 0x35, 0xb9, 0xd4, 0x37, 0xc1, 0xcb, 0x45, 0x03, 0x89, 0x3b, 0xe3,
-	0x91, 0x9c, 0xf8, 0x6d, 0x0d, 0x8d, 0x24, 0x01, 0x8b, 0x70, 0x81, 0x14, 0x56, 0x02, 0xce, 0x49,
-	0x95, 0x77, 0xcc, 0xc2, 0x6d, 0x2a, 0x97, 0x1f, 0x09, 0x7d, 0x84, 0x47, 0x0b, 0x3a, 0x03, 0x6d,
-	0xaa, 0x2d, 0x31, 0xf0, 0xa5, 0xe5, 0xda, 0xd2, 0x23, 0x73, 0x71, 0x19, 0xff, 0x50, 0x43, 0xa3,
-	0x59, 0xf8, 0x4b, 0xaa, 0xd3, 0x69, 0x39, 0x98, 0x4f, 0x2a, 0xcf, 0x3d, 0x0f, 0xee, 0x89, 0xdc,
-	0xa1, 0x22, 0xde, 0xc0, 0xd7, 0x8b, 0x2d, 0x23, 0x9e, 0xaf, 0xa7, 0xe5, 0xd4, 0x1f, 0x99, 0x8b,
-	0x5e, 0x28, 0x2c, 0xfe, 0x63, 0x21, 0x4b, 0x09, 0x98, 0x4c, 0x45, 0xb2, 0x94, 0x71, 0x50, 0xa9,
-	0x22, 0x59, 0xca, 0x04, 0x4e, 0x54, 0xb9, 0x03, 0xf1, 0xa1, 0x18, 0x33, 0xc0, 0xe2, 0x57, 0x34,
-	0x84, 0xd3, 0xf8, 0x4d, 0xaa, 0x23, 0xbd, 0x99, 0x60, 0x52, 0xaa, 0x23, 0xbd, 0xd9, 0x10, 0x51,
-	0xe5, 0x42, 0x8b, 0x90, 0x7f, 0x23, 0x20, 0xc4, 0x92, 0xdd, 0xf4, 0x40, 0x4a, 0x08, 0x22, 0xa2,
-	0x3a, 0x90, 0x92, 0xc4, 0x20, 0x51, 0x1d, 0x48, 0x49, 0xa1, 0x92, 0x94, 0x3b, 0x90, 0xc2, 0xb7,
-	0xa7, 0x66, 0x9d, 0x20, 0xf2, 0xd9, 0x9a, 0x01, 0x99, 0xa1, 0x0a, 0x08, 0xd4, 0x70, 0x1d, 0xaa,
-	0x80, 0x20, 0x07, 0x9f, 0x83, 0x3c, 0xa0, 0x82, 0x4c, 0xe3, 0xdb, 0x85, 0x8e, 0x01, 0x51, 0x80,
-	0xe5, 0x8e, 0xa3, 0x47, 0x67, 0xa9, 0x0d, 0x7e, 0xb7, 0x10, 0x1c, 0x38, 0x38, 0x56, 0x81, 0xff,
-	0x5b, 0x43, 0xdb, 0x15, 0x80, 0x1c, 0xaa, 0x10, 0x2f, 0x1f, 0x14, 0x44, 0x15, 0xe2, 0x15, 0x40,
-	0x01, 0x21, 0xef, 0xa3, 0x42, 0xdf, 0xc3, 0xf5, 0x92, 0x42, 0x8b, 0x67, 0x8e, 0xb3, 0x04, 0x7f,
-	0x47, 0x43, 0xcf, 0x48, 0x01, 0x36, 0xb0, 0xda, 0xd1, 0xce, 0x44, 0xf4, 0x50, 0xf9, 0xaa, 0x4a,
-	0x24, 0x8f, 0x72, 0xce, 0x05, 0xa0, 0x66, 0x98, 0x2e, 0x7c, 0x67, 0xe9, 0xc9, 0xb5, 0xda, 0x12,
-	0xc7, 0x70, 0x58, 0xc6, 0xdf, 0xd1, 0xd0, 0xb6, 0x4c, 0xfc, 0x0d, 0xfc, 0x72, 0x5e, 0x26, 0x3e,
-	0x1b, 0x1c, 0xa4, 0x7a, 0xa6, 0xa7, 0xb6, 0xbd, 0x44, 0xb4, 0xf4, 0x88, 0xa8, 0x4c, 0x50, 0x0f,
-	0xff, 0x85, 0x86, 0x46, 0x92, 0x78, 0x1d, 0xf8, 0x68, 0xbe, 0xea, 0x13, 0xb0, 0x20, 0xd5, 0xf1,
-	0x32, 0x4d, 0x7a, 0x71, 0x14, 0x62, 0xd8, 0x1d, 0x8b, 0xa2, 0x85, 0xfe, 0x86, 0x0d, 0xbd, 0x34,
-	0xf4, 0x47, 0xce, 0xd0, 0xcb, 0x04, 0x14, 0xc9, 0x19, 0x7a, 0xd9, 0x18, 0x23, 0xe5, 0x16, 0xf2,
-	0xc8, 0x2a, 0x11, 0x22, 0x09, 0xfe, 0xaf, 0x40, 0x18, 0x19, 0x9c, 0x86, 0x52, 0x18, 0x05, 0x6a,
-	0x88, 0x52, 0x18, 0x15, 0x6e, 0x07, 0xb1, 0xa8, 0x30, 0x0d, 0x6c, 0x14, 0xca, 0x08, 0x01, 0x29,
-	0x7d, 0x66, 0x51, 0x17, 0xb1, 0x47, 0x6a, 0x4b, 0x09, 0xc8, 0x92, 0xe5, 0xda, 0x52, 0x12, 0x9b,
-	0x64, 0x19, 0xff, 0x91, 0x86, 0xd6, 0xc7, 0x10, 0x2a, 0xf2, 0x86, 0xa0, 0x04, 0x31, 0x23, 0x6f,
-	0x08, 0xca, 0x40, 0x34, 0xca, 0x05, 0x53, 0x71, 0xb8, 0x0d, 0x7a, 0x1b, 0x33, 0x05, 0x32, 0x82,
-	0xc7, 0x8b, 0x78, 0x00, 0x71, 0x38, 0x91, 0xea, 0xb1, 0x52, 0x6d, 0x7a, 0x3a, 0x7a, 0x19, 0x39,
-	0x0d, 0xb6, 0xf3, 0x58, 0x0f, 0xfc, 0x39, 0xfc, 0xd7, 0x5a, 0xec, 0x57, 0x05, 0x22, 0x11, 0x4e,
-	0x14, 0xda, 0x79, 0x4b, 0x49, 0x71, 0xb2, 0x6c, 0x33, 0x10, 0x64, 0x92, 0x0a, 0x72, 0x16, 0xbf,
-	0x5c, 0x72, 0xd7, 0x43, 0x94, 0xe5, 0x1d, 0x0d, 0x6d, 0x96, 0x41, 0x8e, 0xa8, 0x64, 0x51, 0xe0,
-	0x9c, 0xa8, 0x64, 0x51, 0x21, 0x9b, 0x94, 0xdb, 0x0c, 0x30, 0x81, 0x92, 0x1e, 0xdb, 0xca, 0x89,
-	0x9f, 0x71, 0x7a, 0x57, 0x43, 0xd5, 0x6c, 0xec, 0x12, 0xe5, 0x31, 0x9e, 0x3c, 0x70, 0x14, 0xe5,
-	0x31, 0x9e, 0x5c, 0xb8, 0x14, 0x72, 0x9d, 0x8a, 0x3a, 0x85, 0x27, 0x0a, 0xad, 0x75, 0x2a, 0xac,
-	0x37, 0xfc, 0x3d, 0x0d, 0x6d, 0x0b, 0x86, 0x84, 0x14, 0xd5, 0x44, 0x79, 0x46, 0x5c, 0x01, 0xa6,
-	0xa2, 0x3c, 0x23, 0xae, 0x82, 0x4f, 0x29, 0x17, 0x1a, 0xc9, 0x45, 0x4a, 0x9a, 0xf1, 0x0b, 0x1a,
-	0xda, 0x48, 0x07, 0x7d, 0x84, 0x89, 0xa1, 0x3a, 0xea, 0x9c, 0x86, 0xdd, 0x50, 0x1d, 0x75, 0x96,
-	0x00, 0x6d, 0x94, 0xf3, 0x0f, 0xe0, 0x9e, 0x36, 0xc5, 0xda, 0x48, 0x30, 0xfe, 0x0f, 0x1a, 0x1a,
-	0xcd, 0x02, 0x3c, 0x51, 0xde, 0x9b, 0x53, 0xa3, 0xb2, 0x28, 0xef, 0xcd, 0xe5, 0xe0, 0xab, 0x94,
-	0x93, 0x2d, 0xb6, 0xc1, 0xff, 0xd8, 0xf2, 0xe7, 0xc3, 0x0c, 0x52, 0x10, 0x95, 0x3c, 0x23, 0x05,
-	0xec, 0x50, 0x9e, 0x59, 0x50, 0x40, 0x90, 0x28, 0xcf, 0x2c, 0xa8, 0xf0, 0x45, 0xc8, 0xfb, 0xa9,
-	0x48, 0x0f, 0xf0, 0xbd, 0xf2, 0x97, 0x1e, 0x75, 0x0e, 0x2e, 0x92, 0xbe, 0xe9, 0x03, 0x49, 0xff,
-	0xc9, 0x57, 0xdf, 0x7e, 0x77, 0x4c, 0xfb, 0xc6, 0xbb, 0x63, 0xda, 0xf7, 0xdf, 0x1d, 0xd3, 0x7e,
-	0xed, 0x07, 0x63, 0x4f, 0x7d, 0xe3, 0x07, 0x63, 0x4f, 0xfd, 0xfd, 0x0f, 0xc6, 0x9e, 0x7a, 0xdf,
-	0x89, 0x39, 0xcb, 0x9f, 0xef, 0xce, 0x1c, 0x69, 0x38, 0xad, 0xec, 0x9e, 0x9f, 0x88, 0x1f, 0x92,
-	0xc5, 0x8e, 0xe9, 0xcd, 0xac, 0xe9, 0xb8, 0x8e, 0xef, 0x1c, 0xfb, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xf5, 0xd7, 0x31, 0xe5, 0xec, 0x95, 0x00, 0x00,
+	// 7778 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x6d, 0x6c, 0x1c, 0xc7,
+	0x79, 0xbf, 0x97, 0xd4, 0x0b, 0x39, 0xa2, 0x24, 0x6a, 0x2c, 0x4b, 0xd4, 0x49, 0xa6, 0xe4, 0x91,
+	0x6c, 0x4b, 0xb2, 0xad, 0xb3, 0x28, 0xcb, 0xb6, 0x2c, 0xeb, 0x85, 0xa4, 0x5e, 0xad, 0x17, 0x53,
+	0xa7, 0x37, 0x3b, 0x4e, 0xbc, 0x59, 0xde, 0x0d, 0x8f, 0x1b, 0xed, 0xdd, 0x9e, 0x77, 0xf7, 0x28,
+	0x33, 0xfc, 0x33, 0x7f, 0x24, 0xf8, 0x03, 0xf9, 0xf0, 0x6f, 0x9b, 0x14, 0xf9, 0x50, 0x34, 0x2d,
+	0x50, 0x04, 0x6d, 0x81, 0x14, 0xe8, 0x87, 0xa4, 0x68, 0x82, 0x26, 0x48, 0xdb, 0xa4, 0x40, 0x0a,
+	0xb7, 0x29, 0x82, 0x34, 0x49, 0x8d, 0x34, 0x28, 0x92, 0xd4, 0x0e, 0xd2, 0x22, 0x2d, 0x9a, 0xb4,
+	0x05, 0xfa, 0xb1, 0x2d, 0x76, 0xe6, 0x99, 0xdd, 0xd9, 0xbd, 0xd9, 0xd9, 0xdd, 0xe3, 0x39, 0xf9,
+	0x42, 0x70, 0x67, 0x67, 0x9e, 0x79, 0x5e, 0x66, 0x66, 0x9f, 0xe7, 0x99, 0x99, 0xdf, 0xa1, 0xbd,
+	0x76, 0x7b, 0x81, 0x7a, 0xb4, 0x5d, 0xa7, 0xd5, 0xf8, 0xbf, 0x37, 0xba, 0xd4, 0x5b, 0x3e, 0xd2,
+	0xf1, 0xdc, 0xc0, 0xc5, 0x0f, 0x46, 0xc5, 0x47, 0xa2, 0xff, 0x2a, 0xdb, 0xac, 0x96, 0xdd, 0x76,
+	0xab, 0xec, 0x2f, 0xaf, 0x57, 0xd9, 0xde, 0x74, 0x9b, 0x2e, 0xfb, 0xb7, 0x1a, 0xfe, 0x07, 0xa5,
+	0x7b, 0x9a, 0xae, 0xdb, 0x74, 0x68, 0xd5, 0xea, 0xd8, 0x55, 0xab, 0xdd, 0x76, 0x03, 0x2b, 0xb0,
+	0xdd, 0xb6, 0x0f, 0x6f, 0x0f, 0xd7, 0x5d, 0xbf, 0xe5, 0xfa, 0xd5, 0x79, 0xcb, 0x87, 0x4e, 0xab,
+	0x4b, 0x47, 0xe7, 0x69, 0x60, 0x1d, 0xad, 0x76, 0xac, 0xa6, 0xdd, 0x66, 0x95, 0xa1, 0xee, 0x3e,
+	0x15, 0xa3, 0x1d, 0xcb, 0xb3, 0x5a, 0x82, 0xda, 0x7e, 0x55, 0x8d, 0x98, 0x7b, 0x5e, 0xe9, 0xd1,
+	0x0c, 0x32, 0x81, 0x5d, 0xb7, 0x3b, 0x56, 0x3b, 0x10, 0x9c, 0xa9, 0xaa, 0xd1, 0x8e, 0x5b, 0x5f,
+	0x34, 0x9b, 0x9e, 0xdb, 0xed, 0x98, 0x0d, 0x2b, 0xb0, 0xa0, 0xee, 0xe3, 0xaa, 0xba, 0x3e, 0x0d,
+	0x02, 0x87, 0x9a, 0x56, 0xcb, 0xed, 0x46, 0x44, 0x8f, 0xe6, 0x11, 0x5d, 0xb2, 0x1c, 0xbb, 0x91,
+	0xd0, 0x10, 0x51, 0xb2, 0xeb, 0xd6, 0xe7, 0xad, 0xa0, 0xbe, 0x08, 0x75, 0x0e, 0xa9, 0xea, 0x04,
+	0xee, 0x3d, 0xda, 0x76, 0x5b, 0x76, 0xdd, 0x97, 0x59, 0x55, 0x56, 0xed, 0xb6, 0xed, 0xc0, 0x74,
+	0x17, 0xcc, 0xba, 0xdb, 0xea, 0x74, 0x03, 0xa1, 0x28, 0xe5, 0xc0, 0x68, 0xb9, 0x0d, 0xea, 0xe8,
+	0xd4, 0x1d, 0xb8, 0x1d, 0xb3, 0x65, 0xb7, 0xa9, 0x07, 0x95, 0x26, 0x65, 0x0b, 0x0b, 0xdb, 0xd6,
+	0x5d, 0x5b, 0x58, 0xf5, 0x09, 0xad, 0xcd, 0xcc, 0xc0, 0x6e, 0x51, 0xb7, 0x2b, 0xf4, 0xf7, 0xac,
+	0xbe, 0x72, 0xac, 0x3d, 0xb3, 0x41, 0x03, 0xcb, 0x76, 0x84, 0x12, 0x8f, 0x65, 0xeb, 0xbd, 0x43,
+	0xbd, 0x05, 0xd7, 0x6b, 0x59, 0x61, 0x7b, 0xbf, 0xdb, 0x6a, 0x59, 0x62, 0xdc, 0xab, 0xad, 0x1a,
+	0x78, 0x96, 0xdd, 0xb6, 0xdb, 0x4d, 0x33, 0xb0, 0xfc, 0x7b, 0x50, 0xf1, 0xc9, 0xdc, 0x8a, 0xa6,
+	0xbf, 0xdc, 0xae, 0xeb, 0xc8, 0x2e, 0x5a, 0x5e, 0xe3, 0xbe, 0xe5, 0x51, 0xb3, 0xed, 0x36, 0xa8,
+	0xce, 0x54, 0x6c, 0xa0, 0x5a, 0x8e, 0xd9, 0xed, 0x34, 0x3d, 0x2b, 0xaa, 0xfa, 0x98, 0xaa, 0x6a,
+	0x9b, 0x06, 0xf7, 0x5d, 0xef, 0x9e, 0x4c, 0x52, 0x39, 0x85, 0xe6, 0x3d, 0xbb, 0xd1, 0xd4, 0x76,
+	0xea, 0x07, 0x56, 0xe0, 0x9b, 0x0d, 0xba, 0x44, 0x1d, 0xb7, 0x13, 0x59, 0x76, 0x6f, 0xa6, 0x52,
+	0xa1, 0xc2, 0x41, 0xe5, 0x00, 0x72, 0x42, 0x7e, 0xcc, 0x25, 0xea, 0xf9, 0xf1, 0xd4, 0x3e, 0xa2,
+	0x24, 0xf5, 0x66, 0xdd, 0xe9, 0x36, 0x68, 0xc3, 0xec, 0x9d, 0x9c, 0x4f, 0xab, 0xea, 0xd7, 0xdd,
+	0xf6, 0x82, 0xed, 0xb5, 0xb8, 0xfd, 0x3b, 0x6e, 0xdd, 0xa4, 0x4b, 0x34, 0x6a, 0xa1, 0xe4, 0xc5,
+	0xb1, 0xdf, 0xe8, 0xda, 0x0d, 0x3b, 0x58, 0x36, 0x3b, 0xae, 0xeb, 0x68, 0x97, 0x19, 0xb7, 0x6e,
+	0x2e, 0x4d, 0xe9, 0x56, 0x10, 0xcf, 0x6a, 0x37, 0xdc, 0x96, 0xe9, 0x53, 0xda, 0xd0, 0x4d, 0x76,
+	0x46, 0x28, 0x1e, 0xa6, 0x7e, 0xdb, 0xea, 0xf8, 0x8b, 0xae, 0xe0, 0xf2, 0x80, 0xaa, 0x49, 0x83,
+	0x3a, 0xb4, 0x29, 0x2f, 0x84, 0xca, 0xf1, 0x66, 0xd5, 0x03, 0x7b, 0x89, 0x4a, 0xaa, 0x82, 0xb1,
+	0x4f, 0xb6, 0x23, 0x7c, 0x23, 0x5c, 0x58, 0xe7, 0xd8, 0x4a, 0x59, 0xa3, 0x6f, 0x74, 0xa9, 0x1f,
+	0x90, 0xdb, 0xe8, 0xc1, 0x44, 0xa9, 0xdf, 0x71, 0xdb, 0x3e, 0xc5, 0xa7, 0xd1, 0x06, 0xbe, 0xa2,
+	0x4e, 0x18, 0xfb, 0x8c, 0x83, 0x9b, 0xa6, 0x76, 0x1f, 0x51, 0x2c, 0xfe, 0x47, 0x78, 0xa3, 0x99,
+	0xd1, 0xb7, 0x7e, 0xb0, 0xf7, 0x81, 0xcf, 0xfe, 0xd3, 0xe7, 0x0e, 0x1b, 0x35, 0x68, 0x45, 0x9e,
+	0x46, 0x13, 0x8c, 0xec, 0x45, 0x1a, 0x5c, 0x16, 0xd5, 0xa1, 0x4b, 0xbc, 0x1d, 0xad, 0xb7, 0xdb,
+	0x0d, 0xfa, 0x26, 0x23, 0x3d, 0x5a, 0xe3, 0x0f, 0xc4, 0x44, 0xbb, 0x14, 0x2d, 0x80, 0x9d, 0x19,
+	0x34, 0x1a, 0xf5, 0x0a, 0x1c, 0x4d, 0x2a, 0x39, 0x8a, 0x9a, 0xce, 0xac, 0x0b, 0x99, 0xaa, 0xc5,
+	0xcd, 0xc8, 0x3c, 0xb0, 0x34, 0xed, 0x38, 0x3d, 0x2c, 0x5d, 0x40, 0x28, 0xfe, 0xcc, 0x40, 0x07,
+	0x8f, 0x1d, 0xe1, 0x2b, 0xd6, 0x91, 0x70, 0xc5, 0x3a, 0xc2, 0x3f, 0x84, 0xb0, 0x6e, 0x1d, 0x99,
+	0xb3, 0x9a, 0xa2, 0x6d, 0x4d, 0x6a, 0x49, 0x3e, 0x6b, 0x80, 0x14, 0xc9, 0x4e, 0xd4, 0x52, 0x0c,
+	0xf7, 0x21, 0x05, 0xbe, 0x98, 0xe0, 0x74, 0x88, 0x71, 0xfa, 0x78, 0x2e, 0xa7, 0x9c, 0x81, 0x04,
+	0xab, 0x53, 0xa8, 0x22, 0xf4, 0x3d, 0x17, 0x0f, 0x16, 0xbd, 0x8d, 0x9a, 0x68, 0xb7, 0xb2, 0x0d,
+	0xc8, 0x77, 0x09, 0x6d, 0x92, 0xc6, 0x1d, 0xa8, 0x71, 0x5f, 0xd6, 0xc8, 0x11, 0xf5, 0x40, 0x46,
+	0xb9, 0x29, 0x69, 0x00, 0x73, 0xd3, 0x8e, 0xa3, 0x60, 0x6e, 0x50, 0xd6, 0xfa, 0x8e, 0x01, 0xf2,
+	0xa4, 0xbb, 0xc9, 0x92, 0x67, 0xb8, 0x4f, 0x79, 0x06, 0x66, 0x35, 0xfc, 0x08, 0x1a, 0x9b, 0x77,
+	0xdc, 0xfa, 0x3d, 0x73, 0x91, 0xda, 0xcd, 0xc5, 0x60, 0x62, 0x78, 0x9f, 0x71, 0x70, 0xb8, 0xb6,
+	0x89, 0x95, 0x5d, 0x62, 0x45, 0xe4, 0x45, 0xb4, 0x8f, 0x09, 0x15, 0x0d, 0x22, 0x85, 0x06, 0x27,
+	0xd0, 0x46, 0xab, 0xd1, 0xf0, 0xa8, 0xef, 0x83, 0x81, 0xc5, 0x23, 0xb9, 0x8d, 0x1e, 0xd1, 0xb4,
+	0x06, 0xc5, 0xec, 0x40, 0x1b, 0x3a, 0xdd, 0xf9, 0x7b, 0x74, 0x19, 0x5a, 0xc3, 0x53, 0x48, 0x76,
+	0xde, 0x72, 0xc2, 0x4f, 0x28, 0x93, 0x71, 0xb8, 0x26, 0x1e, 0xc9, 0x71, 0xf4, 0xb0, 0x18, 0x39,
+	0x35, 0xb6, 0x40, 0x9e, 0x7f, 0x93, 0xd6, 0xbb, 0x81, 0xeb, 0x49, 0x03, 0x8e, 0xb9, 0x14, 0x62,
+	0xc0, 0xb1, 0x07, 0xd2, 0x40, 0x93, 0x59, 0xcd, 0xa2, 0x39, 0x35, 0x42, 0xa1, 0xac, 0xe4, 0x80,
+	0x8b, 0xda, 0x91, 0xd7, 0x62, 0xe6, 0xce, 0x87, 0x9f, 0xad, 0x8b, 0xa1, 0x0b, 0x76, 0xce, 0x0a,
+	0x2c, 0xc1, 0xdc, 0x5e, 0xb4, 0x89, 0x3b, 0x09, 0xf1, 0x9c, 0x58, 0x57, 0x43, 0xac, 0xe8, 0x72,
+	0x58, 0x82, 0x77, 0xa1, 0x11, 0xc6, 0xb0, 0x69, 0x37, 0x98, 0xe4, 0xa3, 0xb5, 0x8d, 0xec, 0xf9,
+	0x72, 0x83, 0x74, 0x63, 0x11, 0xd2, 0xc4, 0x41, 0x84, 0x9b, 0x68, 0x3c, 0xed, 0x4f, 0x82, 0x28,
+	0xfb, 0x95, 0xa2, 0x24, 0xc9, 0x80, 0x34, 0x5b, 0x68, 0xa2, 0x94, 0x34, 0x41, 0xa6, 0x69, 0xc7,
+	0x51, 0xcb, 0x34, 0xa8, 0x49, 0xf4, 0x67, 0x06, 0x08, 0xa8, 0xe8, 0x49, 0x2b, 0xe0, 0xf0, 0x9a,
+	0x04, 0x1c, 0xdc, 0x42, 0x78, 0x26, 0x5e, 0xd4, 0x6e, 0x32, 0x57, 0x7d, 0x9a, 0x79, 0xea, 0x42,
+	0x4f, 0xfb, 0x7a, 0x17, 0xb5, 0xd1, 0xe4, 0x62, 0xe5, 0xa0, 0x3d, 0x6a, 0x02, 0x20, 0xfe, 0x55,
+	0xb4, 0x39, 0x11, 0x03, 0x80, 0xb2, 0x1f, 0x51, 0xca, 0x2e, 0x53, 0x00, 0xc9, 0xc7, 0x7c, 0xa9,
+	0x8c, 0xd0, 0x78, 0xcd, 0x52, 0xb1, 0x3b, 0x28, 0xb3, 0x7e, 0xc1, 0x00, 0xa9, 0x7a, 0xfa, 0xc9,
+	0x96, 0x6a, 0xb8, 0x6f, 0xa9, 0x06, 0x67, 0x4d, 0x1b, 0x1d, 0xe8, 0x9d, 0x6e, 0x77, 0xe2, 0x68,
+	0xaa, 0xb0, 0x59, 0xd3, 0x93, 0x7e, 0x28, 0x3d, 0xe9, 0xc9, 0xaf, 0x1b, 0xe8, 0xd1, 0x9c, 0xbe,
+	0x40, 0x57, 0x8b, 0x68, 0x67, 0x46, 0x70, 0x07, 0x16, 0x3a, 0x9c, 0x33, 0x0f, 0x24, 0xa2, 0xa0,
+	0xbe, 0x87, 0xa8, 0xea, 0x25, 0x69, 0x83, 0xf8, 0x89, 0xc9, 0xa8, 0x10, 0x7f, 0x50, 0xc3, 0xe4,
+	0xfb, 0x42, 0x07, 0xd9, 0x1d, 0x16, 0xd1, 0xc1, 0xf0, 0x00, 0x75, 0x30, 0xb8, 0xb1, 0x34, 0x0b,
+	0x2b, 0xdb, 0x9c, 0x5b, 0x9f, 0x09, 0xa3, 0x6c, 0xea, 0x5f, 0x70, 0xbd, 0x9b, 0x41, 0xac, 0x8a,
+	0x9e, 0xcf, 0xb1, 0xd1, 0xfb, 0x39, 0xee, 0xa2, 0xbd, 0x99, 0x44, 0x40, 0x35, 0x35, 0x34, 0x1a,
+	0x86, 0x03, 0x2c, 0x92, 0x07, 0x65, 0x54, 0xd5, 0x1f, 0x31, 0x77, 0x16, 0x68, 0xdc, 0xb5, 0x83,
+	0x45, 0xe9, 0x93, 0x26, 0x34, 0x32, 0xd2, 0x81, 0x5e, 0xc8, 0x97, 0x0c, 0x54, 0xc9, 0xae, 0x5e,
+	0x60, 0xf8, 0xef, 0x44, 0x1b, 0x3b, 0xdd, 0x79, 0x33, 0xfc, 0xc8, 0x0f, 0x45, 0x1f, 0xf9, 0x2b,
+	0x74, 0x19, 0x4f, 0xa2, 0x4d, 0x8b, 0xf4, 0x4d, 0x53, 0xbc, 0x1c, 0x66, 0x2f, 0x47, 0x17, 0xe9,
+	0x9b, 0x73, 0xfc, 0xfd, 0x59, 0x59, 0x9a, 0x75, 0x4c, 0x9a, 0x87, 0xb5, 0xd2, 0xf4, 0xf0, 0x7e,
+	0x11, 0x11, 0xa1, 0x32, 0xc9, 0xae, 0x7d, 0xe8, 0xfe, 0xe3, 0x06, 0xda, 0xaf, 0xa5, 0x04, 0x06,
+	0xf8, 0x20, 0xda, 0x92, 0x8c, 0xc7, 0xc0, 0x0a, 0xc7, 0xb2, 0xf8, 0x96, 0x88, 0x65, 0x58, 0x62,
+	0x73, 0x47, 0xee, 0x92, 0xfc, 0x8d, 0x81, 0x26, 0xf5, 0xed, 0xde, 0x4b, 0x93, 0xbc, 0xdc, 0x23,
+	0x1f, 0xb7, 0x0b, 0xc9, 0x97, 0x4f, 0x2d, 0xce, 0x65, 0x58, 0x66, 0x42, 0xbd, 0x4e, 0xad, 0xcd,
+	0x46, 0xff, 0x5f, 0xac, 0x20, 0xd9, 0xb4, 0xc0, 0x4a, 0xf3, 0x19, 0x56, 0x3a, 0xde, 0x87, 0x95,
+	0xee, 0x4c, 0xa9, 0x05, 0xfb, 0x96, 0x81, 0xf6, 0xe5, 0xb5, 0x7c, 0x2f, 0x2d, 0x75, 0x23, 0xc3,
+	0x52, 0x07, 0xf2, 0x65, 0xcc, 0x12, 0xe9, 0x13, 0xe2, 0x4b, 0x1e, 0xd6, 0x9e, 0xba, 0x19, 0xb8,
+	0x1e, 0x9d, 0x75, 0x5b, 0x2d, 0x3b, 0x72, 0x19, 0x4e, 0xa3, 0x3d, 0x61, 0x9f, 0x7e, 0xa8, 0xec,
+	0xf0, 0xaf, 0x17, 0x98, 0x0a, 0xa3, 0x4d, 0x74, 0xdc, 0x3a, 0xb3, 0xc7, 0xcd, 0xb0, 0xc6, 0x4c,
+	0x6c, 0x41, 0x5c, 0x45, 0x0f, 0x4a, 0xb2, 0x9b, 0x22, 0xb0, 0xe0, 0x82, 0x63, 0xe9, 0xd5, 0x34,
+	0xc4, 0x18, 0x8b, 0xe0, 0x9b, 0xf6, 0x32, 0x04, 0x96, 0xde, 0x8e, 0xd6, 0xd7, 0x23, 0x4f, 0x69,
+	0x73, 0x8d, 0x3f, 0xe0, 0xdd, 0x68, 0xd4, 0x73, 0xdd, 0xc0, 0x5c, 0xb4, 0xfc, 0x45, 0x46, 0x7d,
+	0xac, 0x36, 0x12, 0x16, 0x5c, 0xb2, 0xfc, 0xc5, 0xb0, 0xc9, 0x82, 0xdb, 0x6d, 0x37, 0x98, 0x4a,
+	0x47, 0x6a, 0xfc, 0x81, 0xfc, 0x86, 0x01, 0x03, 0xf5, 0xda, 0xd5, 0xeb, 0x6e, 0x83, 0xde, 0x65,
+	0x0c, 0x9f, 0xb3, 0xfd, 0xc0, 0xb3, 0xe7, 0xbb, 0xa1, 0x76, 0x7e, 0x69, 0x3a, 0xf8, 0x30, 0x8c,
+	0xfa, 0x6c, 0xc6, 0x40, 0x17, 0x27, 0xd1, 0xc6, 0xfb, 0xec, 0xad, 0xaf, 0xf5, 0xb0, 0x64, 0x3a,
+	0x35, 0xd1, 0x22, 0xd6, 0xca, 0x90, 0xac, 0x95, 0x0f, 0xa1, 0x83, 0x51, 0xd8, 0x9b, 0x32, 0x41,
+	0xcf, 0x0c, 0x5e, 0xa3, 0x62, 0xc8, 0x7d, 0x74, 0xa8, 0x40, 0x5f, 0x20, 0xeb, 0x4b, 0x68, 0x63,
+	0x9d, 0xbf, 0x02, 0x59, 0x9f, 0xce, 0x1c, 0xf6, 0x32, 0xa1, 0x70, 0x8a, 0x82, 0x5e, 0x6b, 0x82,
+	0x00, 0xf9, 0x8c, 0x81, 0x76, 0x6b, 0x2a, 0x66, 0x59, 0xcc, 0xc8, 0xb2, 0x58, 0x3c, 0x28, 0x87,
+	0x32, 0x07, 0xe5, 0x70, 0x6a, 0x50, 0xa6, 0x66, 0xfb, 0xba, 0xd4, 0x6c, 0x27, 0x1e, 0x7a, 0x5a,
+	0x28, 0x27, 0x6b, 0x1c, 0x0c, 0xdc, 0x20, 0x9f, 0x32, 0xd0, 0xd1, 0x12, 0x9d, 0x82, 0x65, 0x5e,
+	0x47, 0x9b, 0x1b, 0x72, 0x05, 0xb0, 0xcf, 0xf3, 0xb9, 0x63, 0x51, 0x26, 0x2b, 0xdb, 0x29, 0x49,
+	0x2e, 0xe4, 0x6a, 0x7f, 0x81, 0x66, 0xe5, 0xad, 0x26, 0x4d, 0x9f, 0xa1, 0xb2, 0xd3, 0x87, 0x3c,
+	0x1c, 0x87, 0x86, 0xb3, 0x5d, 0xcf, 0xa3, 0x6d, 0xee, 0xe7, 0x8b, 0xdc, 0xe9, 0x33, 0x71, 0xe0,
+	0x97, 0x7c, 0x1d, 0x2f, 0x63, 0xcc, 0x43, 0x85, 0x84, 0x01, 0x7f, 0x20, 0x7b, 0xe3, 0x6c, 0xc3,
+	0xad, 0x68, 0x6b, 0x46, 0x8a, 0xcc, 0x49, 0x10, 0x67, 0x0c, 0xd2, 0x15, 0x22, 0x87, 0x71, 0x6b,
+	0x6a, 0x57, 0x47, 0x9b, 0x30, 0x48, 0x52, 0x11, 0xf1, 0x74, 0x90, 0x28, 0x25, 0xd7, 0x60, 0xa2,
+	0x5e, 0xa4, 0xc1, 0xed, 0xb6, 0x1d, 0xbc, 0xbc, 0x30, 0xcb, 0x37, 0x81, 0xe6, 0x3c, 0xbb, 0x4e,
+	0xe7, 0x3c, 0xb7, 0xe3, 0xfa, 0x96, 0x53, 0x3c, 0x28, 0xfe, 0x94, 0x81, 0x0e, 0x17, 0xa1, 0x07,
+	0x12, 0x5d, 0x41, 0x23, 0x1d, 0x28, 0x03, 0x51, 0xd4, 0x1e, 0xb0, 0x86, 0x54, 0x44, 0x00, 0x4f,
+	0xa0, 0x8d, 0x0d, 0xba, 0x60, 0x75, 0x9d, 0x00, 0xa2, 0x36, 0xf1, 0x48, 0xf6, 0x43, 0x76, 0x4b,
+	0x36, 0x57, 0x3a, 0x33, 0x42, 0x96, 0xc1, 0xfd, 0xcc, 0xa8, 0xf4, 0x5e, 0x66, 0x6d, 0x0e, 0x08,
+	0xcf, 0xd7, 0xa3, 0x4b, 0xb6, 0xdb, 0xf5, 0xd5, 0x0c, 0x7e, 0x58, 0x78, 0xb5, 0x19, 0xb5, 0xde,
+	0x4b, 0x0e, 0x4d, 0xf4, 0x10, 0xff, 0x6e, 0xb9, 0x0d, 0xea, 0xf8, 0xd3, 0x8e, 0x33, 0xe8, 0x88,
+	0xf2, 0x37, 0x0d, 0xb4, 0x23, 0xdd, 0x03, 0x08, 0xf4, 0x6c, 0x9c, 0x23, 0x0c, 0x67, 0x72, 0x45,
+	0x3d, 0x93, 0xc3, 0x1a, 0xc0, 0x3c, 0xaf, 0x3e, 0xb8, 0x80, 0xf0, 0x18, 0xda, 0x19, 0xcf, 0xcc,
+	0xce, 0x35, 0xbb, 0x4d, 0xbd, 0xfc, 0x8c, 0xea, 0xfb, 0xe3, 0xad, 0x90, 0xb8, 0x11, 0x48, 0x74,
+	0x16, 0x8d, 0x46, 0xfb, 0xa4, 0xa0, 0xb3, 0x87, 0x33, 0xa6, 0x30, 0x6f, 0x29, 0x62, 0xa5, 0x00,
+	0x9e, 0x89, 0x05, 0x2c, 0x4d, 0x3b, 0x4e, 0x9a, 0xa5, 0x41, 0x59, 0xe4, 0xf7, 0x8d, 0x78, 0xe7,
+	0x24, 0x4f, 0x82, 0xe1, 0xd2, 0x12, 0x0c, 0xce, 0x3a, 0x2e, 0x44, 0xda, 0xf2, 0x0e, 0xd2, 0x2d,
+	0xbe, 0x6d, 0x2c, 0x54, 0x72, 0x18, 0x8d, 0xd3, 0x37, 0x3b, 0xb6, 0xc7, 0x1a, 0x5c, 0x8a, 0x3f,
+	0x98, 0xeb, 0x6a, 0x3d, 0xe5, 0xe1, 0x1a, 0x17, 0x71, 0x7f, 0x59, 0xa4, 0x75, 0xe5, 0x22, 0xf2,
+	0x7f, 0x20, 0xd3, 0xae, 0xec, 0x10, 0xf4, 0xf3, 0x0a, 0xda, 0xd6, 0xb3, 0x89, 0x0d, 0xb6, 0x78,
+	0x54, 0xbf, 0xf7, 0x03, 0x94, 0x40, 0x5f, 0xe3, 0x76, 0xaa, 0x9c, 0xd8, 0x20, 0xae, 0xbc, 0xd5,
+	0x94, 0x12, 0x77, 0x50, 0x23, 0xe0, 0xeb, 0x06, 0x48, 0xaa, 0xec, 0x4b, 0x2f, 0xe9, 0xf0, 0x9a,
+	0x25, 0x1d, 0xdc, 0x08, 0x69, 0xc6, 0xdf, 0xb8, 0xa8, 0xf3, 0x38, 0x52, 0x3a, 0xc7, 0x8f, 0x0a,
+	0x48, 0x33, 0x9a, 0x27, 0xfb, 0x1a, 0x30, 0x44, 0xc4, 0x63, 0x81, 0x91, 0xf1, 0xbb, 0xd2, 0xd7,
+	0x4f, 0xd7, 0x13, 0xa8, 0xae, 0x8b, 0x2a, 0x76, 0x66, 0x2d, 0xed, 0xf7, 0x30, 0x9b, 0x38, 0x68,
+	0x53, 0x43, 0x98, 0xf8, 0xb1, 0x6f, 0x9e, 0xaf, 0x8e, 0x41, 0x8d, 0xa5, 0x7f, 0x15, 0xaa, 0xc9,
+	0xe9, 0xb5, 0xa0, 0x6a, 0x86, 0xdf, 0x13, 0xd5, 0x0c, 0x6e, 0xc8, 0xbd, 0x8e, 0x9e, 0xd4, 0x0c,
+	0x04, 0xb6, 0x81, 0x4e, 0x03, 0xea, 0x45, 0x6a, 0x1e, 0x47, 0xc3, 0x76, 0x83, 0x0b, 0x36, 0x5a,
+	0x0b, 0xff, 0xc5, 0x7b, 0xd0, 0xa8, 0xc7, 0x5f, 0x52, 0x0f, 0xc6, 0x5a, 0x5c, 0x40, 0xfe, 0x64,
+	0x08, 0x3d, 0x55, 0xb0, 0x03, 0xd0, 0xe8, 0x75, 0x34, 0x0e, 0xe9, 0x05, 0xd7, 0x33, 0x3b, 0xee,
+	0x7d, 0xea, 0xf9, 0xda, 0xdd, 0x98, 0x3b, 0xa2, 0xf2, 0x5c, 0x58, 0xb7, 0xb6, 0x75, 0x29, 0xf1,
+	0xec, 0xe3, 0x47, 0xd1, 0x96, 0x3a, 0xf7, 0x94, 0x44, 0x08, 0xc2, 0x9d, 0xae, 0xcd, 0x50, 0x0a,
+	0xcb, 0xe9, 0xe5, 0xd0, 0x29, 0xe3, 0x56, 0x1b, 0xee, 0xcb, 0x6a, 0x35, 0xd1, 0x1e, 0x9f, 0x0f,
+	0x8d, 0x23, 0xe4, 0x62, 0x51, 0x55, 0xd6, 0x12, 0x93, 0x52, 0x84, 0x5f, 0x93, 0x1a, 0x92, 0x8b,
+	0x68, 0x4b, 0x52, 0xb6, 0xd0, 0x61, 0x67, 0x0a, 0x11, 0x0e, 0x3b, 0x7b, 0xc8, 0xdf, 0x08, 0xb8,
+	0x06, 0x23, 0x9a, 0x39, 0x50, 0x73, 0xf1, 0x39, 0xa2, 0x9b, 0xfc, 0x18, 0xd1, 0xcc, 0xb2, 0x1c,
+	0x35, 0xe4, 0x6e, 0x26, 0x92, 0xdf, 0x32, 0xd0, 0x13, 0x85, 0xe8, 0x81, 0x41, 0x1d, 0xc8, 0xac,
+	0xf7, 0xd6, 0x04, 0xbb, 0x3e, 0x99, 0xed, 0xee, 0x29, 0xa8, 0xf3, 0xc9, 0x91, 0x45, 0x92, 0x2c,
+	0x43, 0xcc, 0x9a, 0xc9, 0x9c, 0x62, 0xbb, 0x39, 0x77, 0xff, 0xf4, 0x00, 0xda, 0x2c, 0x05, 0x0f,
+	0xd1, 0x9a, 0x9a, 0x2c, 0x0c, 0x43, 0xfa, 0xa3, 0x25, 0xfa, 0x2e, 0xa2, 0x1e, 0x63, 0xd0, 0xea,
+	0xe9, 0xa0, 0xc7, 0x12, 0xfb, 0x21, 0xbd, 0x55, 0x06, 0xbd, 0xa0, 0xfe, 0xc8, 0x40, 0x8f, 0xe7,
+	0x76, 0xf9, 0xcb, 0x18, 0x2a, 0x83, 0x5b, 0x44, 0x0f, 0x83, 0x03, 0x7a, 0x0b, 0xce, 0xd2, 0xdd,
+	0xb2, 0xfc, 0x7b, 0x42, 0x8d, 0x5b, 0xd0, 0x90, 0x2d, 0xbe, 0xd0, 0x43, 0x76, 0x83, 0xd4, 0xe0,
+	0x04, 0x4e, 0xb2, 0x2e, 0xc8, 0x7f, 0x1c, 0xad, 0x0b, 0x2c, 0xff, 0x9e, 0x76, 0x07, 0x36, 0xd1,
+	0x90, 0x55, 0x27, 0xa7, 0x80, 0xe6, 0x25, 0x38, 0x9d, 0x77, 0xdd, 0x6d, 0xd0, 0xe2, 0x3b, 0x89,
+	0xe4, 0x0e, 0x9c, 0x66, 0x49, 0x35, 0x07, 0x9e, 0x9e, 0x47, 0xeb, 0xdb, 0x61, 0x01, 0x30, 0xa5,
+	0xce, 0xc9, 0x27, 0x9b, 0xf2, 0x06, 0x64, 0x12, 0xf2, 0x0f, 0x89, 0x97, 0x71, 0x48, 0x46, 0x5e,
+	0x85, 0x4c, 0x43, 0xef, 0xfb, 0xde, 0xae, 0x87, 0xcb, 0x75, 0xfd, 0x08, 0x38, 0x9f, 0x37, 0xba,
+	0xb4, 0x4b, 0x1b, 0xb2, 0xca, 0xa2, 0x93, 0x65, 0xaf, 0x81, 0xcf, 0xa8, 0xac, 0x02, 0x0c, 0x3c,
+	0x87, 0xd6, 0x87, 0x0a, 0xd6, 0xa7, 0x36, 0x13, 0x06, 0xe1, 0xf5, 0xa3, 0xcc, 0x8c, 0xfc, 0x4e,
+	0x92, 0xfc, 0x2e, 0x68, 0xa6, 0xe7, 0xf5, 0x5a, 0xfb, 0xbd, 0x0e, 0xd3, 0x3b, 0x79, 0x02, 0x0a,
+	0x32, 0x05, 0x37, 0x03, 0x2b, 0x88, 0x86, 0x45, 0xcf, 0x92, 0x66, 0xa8, 0x96, 0x34, 0x0b, 0xe6,
+	0xae, 0x8e, 0x5e, 0x7c, 0xe8, 0xe6, 0xbe, 0x1c, 0xb1, 0xc0, 0x13, 0x9e, 0x44, 0xc8, 0xa3, 0x9d,
+	0x6e, 0x10, 0xcf, 0xb2, 0xf5, 0x35, 0xa9, 0x84, 0x3c, 0x11, 0x3b, 0xbd, 0xc9, 0x73, 0x4e, 0x0a,
+	0xae, 0xc9, 0x77, 0x25, 0xc7, 0x55, 0x57, 0x3b, 0xda, 0xd4, 0xdd, 0x25, 0xa7, 0xe3, 0x84, 0x1f,
+	0xc0, 0xce, 0x89, 0x6a, 0x57, 0x94, 0x2c, 0xc2, 0x3b, 0x3b, 0xea, 0x17, 0x3d, 0x3b, 0x49, 0x43,
+	0x3d, 0x3b, 0x49, 0x78, 0x17, 0x1a, 0x81, 0xaf, 0x4c, 0x03, 0xce, 0x45, 0x09, 0x8f, 0x9d, 0xbc,
+	0x65, 0xa0, 0x9d, 0x19, 0x5d, 0x86, 0xfe, 0x8b, 0x2c, 0x83, 0xad, 0xb6, 0x94, 0xa4, 0xfe, 0xa1,
+	0x84, 0xfa, 0x0f, 0xa1, 0x71, 0xba, 0xb0, 0x40, 0xd9, 0xa9, 0x4b, 0x13, 0x6a, 0xac, 0x63, 0x35,
+	0xb6, 0x46, 0xe5, 0x3c, 0xaf, 0x88, 0xf7, 0xa3, 0xcd, 0x75, 0xab, 0xd3, 0xa1, 0x0d, 0x51, 0x6f,
+	0x3d, 0xab, 0x37, 0xc6, 0x0b, 0xef, 0xaa, 0xcc, 0x39, 0xdc, 0x63, 0xce, 0xcf, 0x0f, 0xa1, 0xed,
+	0x92, 0x28, 0x17, 0xba, 0x8e, 0xc3, 0xe5, 0x78, 0x1c, 0x6d, 0xb5, 0xea, 0x2c, 0x29, 0x9d, 0x4a,
+	0x8b, 0x6e, 0x81, 0x62, 0x91, 0x12, 0x3d, 0x84, 0xc6, 0xdd, 0x0e, 0xf5, 0x98, 0xff, 0x97, 0xdc,
+	0xa8, 0xd8, 0x2a, 0xca, 0x45, 0xd5, 0x1c, 0x66, 0xf0, 0x49, 0x54, 0xa1, 0x96, 0xd7, 0xa6, 0x0d,
+	0xb3, 0xee, 0xda, 0x6d, 0x3f, 0x1a, 0x00, 0x3c, 0xed, 0xc9, 0xd5, 0xb0, 0x93, 0xd7, 0x98, 0x0d,
+	0x2b, 0xc8, 0x29, 0x35, 0x7c, 0x0a, 0xed, 0xf6, 0xe8, 0x7d, 0xcb, 0x6b, 0x44, 0xcd, 0x1d, 0x2b,
+	0xa0, 0xbe, 0x68, 0xcd, 0x95, 0x33, 0x21, 0xaa, 0xb0, 0xf6, 0x57, 0x59, 0x05, 0xde, 0xfc, 0x10,
+	0xa4, 0xb7, 0x7c, 0x76, 0x5c, 0xdd, 0xa1, 0x01, 0x6d, 0x4c, 0x6c, 0x60, 0xa9, 0xf9, 0xad, 0xbc,
+	0x7c, 0x56, 0x14, 0x47, 0x69, 0x3f, 0x79, 0x1f, 0x2f, 0x52, 0x9c, 0x18, 0xfa, 0x1f, 0x11, 0xb9,
+	0x37, 0x75, 0xa5, 0x28, 0xca, 0x95, 0xb3, 0xcc, 0x7e, 0x62, 0xa8, 0x1f, 0xca, 0x1b, 0xea, 0x31,
+	0xb9, 0x6d, 0x32, 0x11, 0x56, 0x44, 0x3e, 0x02, 0xbb, 0x32, 0xec, 0x69, 0x66, 0x39, 0x0c, 0x7e,
+	0xe7, 0xa8, 0x67, 0xbb, 0x8d, 0x99, 0xe5, 0x73, 0xe2, 0xc4, 0xb5, 0x58, 0x5c, 0xf6, 0xa0, 0xd1,
+	0xe8, 0x14, 0x36, 0x58, 0x39, 0x2e, 0xc0, 0xbb, 0xd1, 0x68, 0x18, 0x7f, 0x9b, 0x0b, 0x9e, 0xdb,
+	0x82, 0x89, 0x32, 0x12, 0x16, 0x5c, 0xf0, 0xdc, 0x16, 0xde, 0x89, 0x36, 0xb2, 0x97, 0x81, 0x0b,
+	0x93, 0x64, 0x43, 0xf8, 0x78, 0xcb, 0x25, 0x0e, 0xac, 0x13, 0xfa, 0xfe, 0x41, 0x0d, 0x67, 0xd0,
+	0xfa, 0x7c, 0xc9, 0xa3, 0x66, 0x12, 0xc9, 0x1a, 0x6f, 0x47, 0x16, 0x21, 0x6c, 0x81, 0x57, 0x51,
+	0xd5, 0xe9, 0x76, 0x83, 0x99, 0x77, 0xc6, 0xaa, 0xdf, 0x0b, 0x0d, 0xef, 0x17, 0x13, 0x59, 0xcc,
+	0x7d, 0xdf, 0x14, 0x4b, 0x20, 0x9f, 0xfb, 0xfe, 0x75, 0x52, 0x43, 0xc7, 0x93, 0x27, 0x1a, 0xfd,
+	0xe9, 0x76, 0x83, 0xa5, 0xc4, 0x7d, 0xe8, 0x9c, 0x75, 0xe8, 0xf7, 0xf4, 0x28, 0xd3, 0x34, 0x92,
+	0x34, 0x17, 0xc1, 0x09, 0xce, 0xa6, 0x19, 0xab, 0x4f, 0x90, 0xeb, 0xcf, 0x2a, 0x0d, 0x88, 0x1f,
+	0xb3, 0x7b, 0xe2, 0x79, 0xd2, 0xb5, 0xf5, 0x62, 0x22, 0xc4, 0xc8, 0xf0, 0x95, 0x44, 0x79, 0x16,
+	0x33, 0xa4, 0x6c, 0xd9, 0x26, 0xdb, 0x35, 0xf0, 0x05, 0x65, 0xcb, 0xe6, 0xac, 0x84, 0x0b, 0x45,
+	0x64, 0x77, 0x5f, 0x2c, 0x14, 0x71, 0x09, 0xf1, 0xc1, 0xdc, 0xf9, 0x62, 0x44, 0xe7, 0x3a, 0xc7,
+	0xf8, 0x6d, 0x03, 0xd6, 0xb9, 0x18, 0x67, 0x7b, 0xb3, 0x53, 0xbe, 0x7c, 0x5e, 0x6d, 0x62, 0x8d,
+	0x38, 0x2d, 0xf2, 0x69, 0x71, 0xb4, 0x20, 0xab, 0xd7, 0xa8, 0xb7, 0x84, 0x6c, 0x86, 0x56, 0xb6,
+	0xa1, 0xb4, 0x6c, 0xf8, 0x19, 0xb4, 0xc3, 0xaa, 0x07, 0x5d, 0xcb, 0x31, 0xe3, 0x42, 0xb3, 0xee,
+	0xfa, 0xe2, 0x74, 0xee, 0x76, 0xfe, 0x36, 0x66, 0x62, 0xd6, 0xf5, 0x03, 0x42, 0xc0, 0x3d, 0x9a,
+	0x65, 0x4b, 0x73, 0xe2, 0x53, 0x1b, 0x2d, 0x49, 0x27, 0xc4, 0x76, 0x85, 0xb2, 0x4e, 0xbc, 0xcb,
+	0x14, 0xb8, 0x01, 0xec, 0x9b, 0x0c, 0xd7, 0xf8, 0x03, 0x99, 0x80, 0x2c, 0xfa, 0x39, 0x3a, 0xdf,
+	0x6d, 0x26, 0xd6, 0xb9, 0x6f, 0x0e, 0x43, 0xca, 0x58, 0x7e, 0x15, 0x1d, 0xb1, 0xd8, 0xcc, 0xb5,
+	0x3e, 0xbf, 0xcc, 0x52, 0x78, 0xa0, 0xf6, 0xd3, 0x4a, 0xb5, 0x67, 0x10, 0x39, 0x72, 0x8b, 0xb6,
+	0x3a, 0xae, 0x67, 0x79, 0x6c, 0x0e, 0x84, 0xaf, 0xc0, 0x2a, 0x7c, 0x52, 0x60, 0x8a, 0xb6, 0x44,
+	0x7d, 0xf0, 0x95, 0x9e, 0x6f, 0xcc, 0x9d, 0xe9, 0xaf, 0x13, 0x36, 0x79, 0x59, 0x2f, 0x63, 0xbe,
+	0x34, 0x9d, 0x2b, 0x1e, 0xda, 0xd6, 0xc3, 0x48, 0xce, 0x22, 0x12, 0x2d, 0x6a, 0x43, 0xfd, 0x2d,
+	0x6a, 0x95, 0x00, 0xe1, 0x5e, 0xbe, 0x72, 0x3a, 0x3d, 0x9b, 0xec, 0xf4, 0x70, 0xa1, 0x4e, 0x79,
+	0x02, 0x00, 0x96, 0xd2, 0x43, 0xb1, 0x0f, 0x79, 0xcd, 0x6e, 0xdb, 0xad, 0x6e, 0x2b, 0x4e, 0x7b,
+	0x4c, 0x2f, 0x51, 0x2f, 0x8e, 0x1b, 0xc9, 0xe7, 0x0c, 0xf8, 0xc8, 0x68, 0xeb, 0xc2, 0x60, 0xd8,
+	0x8f, 0x36, 0x07, 0x9e, 0xb5, 0xb0, 0x60, 0xd7, 0xcd, 0x79, 0xcb, 0xb7, 0x7d, 0xf0, 0x3b, 0xc7,
+	0xa0, 0x70, 0x26, 0x2c, 0xc3, 0x2f, 0xa2, 0x4a, 0x8b, 0x13, 0x92, 0xaf, 0xb3, 0x58, 0x9c, 0x14,
+	0xb8, 0x15, 0x13, 0xad, 0x8c, 0xae, 0x94, 0xc7, 0xd9, 0xd7, 0x25, 0xcf, 0x07, 0x3d, 0x0a, 0x9b,
+	0x5d, 0x97, 0xdb, 0x73, 0x9e, 0xdb, 0x0c, 0xbd, 0x12, 0x65, 0xb4, 0x61, 0xc2, 0x41, 0x8f, 0xcc,
+	0x6a, 0x6b, 0xf5, 0xfc, 0x9f, 0x8b, 0xb7, 0x6d, 0xe7, 0xf8, 0xdd, 0xab, 0xdb, 0xfc, 0xea, 0x95,
+	0x58, 0x79, 0x77, 0xa0, 0x0d, 0x8b, 0x09, 0xff, 0x9c, 0x3f, 0x11, 0x3f, 0xde, 0xce, 0x4d, 0x37,
+	0x04, 0x9e, 0x6e, 0x80, 0x07, 0x1a, 0xbd, 0xd1, 0x6e, 0xd3, 0x25, 0x89, 0x88, 0x6d, 0xba, 0x24,
+	0x01, 0xf9, 0xf8, 0xb7, 0x9a, 0xdb, 0x41, 0x65, 0x1f, 0xbe, 0x22, 0x1d, 0xff, 0x2e, 0x21, 0xde,
+	0xf0, 0x9a, 0xc4, 0x1b, 0x5c, 0x66, 0xe1, 0x52, 0x2a, 0x50, 0xbc, 0xb2, 0x54, 0xa3, 0x75, 0xd7,
+	0x6b, 0x48, 0x46, 0x0d, 0xcd, 0x1f, 0xed, 0x01, 0xc0, 0x13, 0x1e, 0x47, 0xc3, 0xf1, 0xe1, 0xae,
+	0xf0, 0x5f, 0x32, 0x0f, 0x1a, 0xef, 0xa5, 0x04, 0x6a, 0x98, 0x46, 0x1b, 0x3c, 0x56, 0x02, 0xda,
+	0x3e, 0x94, 0x3b, 0xf4, 0xae, 0xdc, 0x01, 0x12, 0xd0, 0x90, 0x9c, 0x86, 0x41, 0x7e, 0xd5, 0xf6,
+	0x83, 0x74, 0x3f, 0x57, 0xe8, 0xb2, 0x9f, 0xc3, 0x35, 0x39, 0x09, 0xdf, 0xc3, 0xec, 0xf6, 0xc0,
+	0x2b, 0x46, 0xeb, 0xee, 0xd1, 0x65, 0x91, 0x86, 0x66, 0xff, 0x93, 0x0f, 0xa4, 0x42, 0xee, 0x19,
+	0xcb, 0xf3, 0xec, 0xd8, 0x25, 0x3d, 0x8d, 0x86, 0x3d, 0xfa, 0x86, 0x36, 0xa5, 0x76, 0x91, 0x06,
+	0xd0, 0x28, 0x5c, 0xc6, 0xba, 0x82, 0xdd, 0x5a, 0xd8, 0x90, 0x58, 0x29, 0x4b, 0x44, 0xe4, 0x23,
+	0xf5, 0xad, 0xf3, 0xa8, 0xdf, 0x01, 0x63, 0x3f, 0x55, 0xb0, 0x03, 0x30, 0x39, 0x6b, 0x4a, 0x3e,
+	0x00, 0x43, 0x55, 0x74, 0x31, 0xed, 0xd8, 0x4b, 0xc9, 0x5c, 0xce, 0x49, 0x59, 0x88, 0x43, 0x59,
+	0x7d, 0xf4, 0xb4, 0x13, 0x12, 0xec, 0xcd, 0x24, 0x1f, 0x5d, 0xab, 0x93, 0x85, 0x38, 0x5c, 0xa4,
+	0x83, 0x84, 0x04, 0xbf, 0x6a, 0xc4, 0x5b, 0x8e, 0x33, 0xec, 0xba, 0xe6, 0x2d, 0xcf, 0x6a, 0xfb,
+	0x56, 0x5d, 0x3e, 0xcb, 0xf6, 0x08, 0x1a, 0x73, 0x3d, 0xbb, 0x69, 0xb7, 0xcd, 0xfa, 0xa2, 0x65,
+	0xb7, 0x45, 0x46, 0x8a, 0x97, 0xcd, 0x86, 0x45, 0xf1, 0xba, 0xdb, 0xee, 0xb6, 0xe6, 0xa3, 0x6d,
+	0x05, 0xbe, 0xee, 0x5e, 0x67, 0x45, 0xe1, 0xea, 0xef, 0xd1, 0x3a, 0xb5, 0x3b, 0x01, 0x64, 0x6d,
+	0xf9, 0x59, 0xc5, 0x31, 0x28, 0xe4, 0xa9, 0xea, 0x8f, 0x1a, 0xe0, 0xa1, 0xa8, 0xf9, 0x01, 0xa9,
+	0xdf, 0x8f, 0xf0, 0x7c, 0xfa, 0xa5, 0x58, 0x80, 0x1f, 0x53, 0xea, 0xa0, 0x87, 0x16, 0xac, 0x03,
+	0x0a, 0x3a, 0xe4, 0x1e, 0xb0, 0x30, 0xed, 0x38, 0x3d, 0xcd, 0x06, 0xbe, 0x7b, 0xf5, 0x0d, 0x03,
+	0xa2, 0xc4, 0x8c, 0xde, 0x7e, 0x11, 0x12, 0x0f, 0x6e, 0xf5, 0xfb, 0x91, 0x81, 0x1e, 0xbc, 0xeb,
+	0xb1, 0xec, 0x03, 0xf3, 0x75, 0x67, 0xf8, 0x6d, 0x2d, 0x7c, 0x1d, 0x21, 0xe6, 0x0b, 0x87, 0x0e,
+	0xad, 0xab, 0xdd, 0x7f, 0xe4, 0x6c, 0xcb, 0x34, 0x66, 0xdd, 0x76, 0xe0, 0x59, 0xf5, 0xa0, 0x36,
+	0xca, 0x48, 0x5c, 0x6e, 0x2f, 0xb8, 0xe1, 0x7a, 0xe4, 0x2f, 0xb7, 0xe6, 0x5d, 0x47, 0x9c, 0x86,
+	0xe5, 0x4f, 0xf2, 0x7d, 0x31, 0x3e, 0xba, 0xc4, 0x23, 0xae, 0xa0, 0x91, 0x06, 0xad, 0xdb, 0x2d,
+	0xcb, 0xf1, 0xe1, 0xd8, 0x5c, 0xf4, 0x8c, 0x9f, 0x40, 0xdb, 0x58, 0xa6, 0x39, 0x08, 0x68, 0xc3,
+	0x14, 0xed, 0xd7, 0xb3, 0x4a, 0xe3, 0xd1, 0x0b, 0x10, 0x25, 0xba, 0x0d, 0xa7, 0x10, 0xd3, 0xcf,
+	0x3f, 0xbb, 0x61, 0xc3, 0xd8, 0x52, 0xb7, 0x06, 0x63, 0x9f, 0x43, 0x23, 0xc0, 0x85, 0x30, 0xf1,
+	0x41, 0xa5, 0xae, 0x14, 0x44, 0x6a, 0x51, 0x4b, 0x72, 0x06, 0x06, 0x16, 0xd7, 0x28, 0x24, 0x60,
+	0xa8, 0x3f, 0xb3, 0xcc, 0x66, 0xac, 0x14, 0x93, 0xb2, 0x49, 0x1d, 0xa7, 0xa9, 0x36, 0xb2, 0x67,
+	0x76, 0xd1, 0x6c, 0xbf, 0x96, 0x40, 0xb4, 0xfd, 0x37, 0x6a, 0x89, 0x77, 0xda, 0x9b, 0x17, 0x9c,
+	0x8e, 0x30, 0x27, 0xd0, 0x13, 0x17, 0x52, 0x23, 0x12, 0xe4, 0x36, 0x78, 0x94, 0xe0, 0xdc, 0x25,
+	0xc6, 0xc2, 0x05, 0xd7, 0xbb, 0x25, 0x3b, 0x1d, 0x87, 0xd0, 0x78, 0x1d, 0xe8, 0xa5, 0x72, 0x54,
+	0x5b, 0xeb, 0xc9, 0x7e, 0xc8, 0x05, 0xc8, 0x46, 0xe8, 0xc9, 0x82, 0x4c, 0xbb, 0xd0, 0x88, 0xed,
+	0x73, 0xff, 0x93, 0xd1, 0x1b, 0xa9, 0x6d, 0xb4, 0x7d, 0xd6, 0x92, 0xec, 0x86, 0xd4, 0xfd, 0x55,
+	0x71, 0xc5, 0x7b, 0xce, 0x75, 0xa3, 0x34, 0xf1, 0x1b, 0x90, 0x98, 0x4f, 0xbd, 0x04, 0xaa, 0x99,
+	0xc3, 0x22, 0x1c, 0xcf, 0x75, 0xb7, 0x21, 0xf6, 0xfe, 0xd7, 0xd5, 0xe0, 0xa9, 0x88, 0x3b, 0xbb,
+	0x13, 0xce, 0x4f, 0x9d, 0xe7, 0x9b, 0x5f, 0x0b, 0xae, 0xe0, 0xe5, 0x27, 0x43, 0x10, 0xb1, 0x49,
+	0x6f, 0x80, 0x91, 0xfc, 0x53, 0xf4, 0xf8, 0x44, 0x74, 0x5f, 0x7b, 0x28, 0xff, 0xbe, 0x36, 0xb7,
+	0x21, 0x34, 0xc0, 0xb3, 0x68, 0x2c, 0x91, 0x77, 0x1b, 0x66, 0x04, 0x2a, 0xd9, 0x1b, 0x41, 0xe2,
+	0x82, 0xab, 0x23, 0x25, 0xe3, 0x4e, 0xa2, 0x8a, 0x1d, 0x06, 0xbd, 0xa9, 0x9b, 0xf7, 0xfc, 0x32,
+	0x3a, 0x9b, 0xc1, 0x23, 0xb5, 0x9d, 0xb6, 0x3f, 0x2b, 0x55, 0x98, 0x73, 0xeb, 0xd3, 0xec, 0x35,
+	0x76, 0xd0, 0xc3, 0xbc, 0xa2, 0xa9, 0xbe, 0xba, 0xcf, 0x26, 0x77, 0xd6, 0xf7, 0x38, 0x49, 0x72,
+	0xf6, 0x7c, 0xd8, 0xa0, 0x56, 0xe1, 0xf4, 0x52, 0xdd, 0xb1, 0x77, 0xe4, 0x22, 0xcc, 0x13, 0x16,
+	0x54, 0xcf, 0xb9, 0xb3, 0xf3, 0xfc, 0x8a, 0xcc, 0x34, 0x6c, 0x54, 0x4b, 0xdb, 0x3a, 0x92, 0x82,
+	0x21, 0x01, 0x94, 0xba, 0x68, 0x7b, 0x40, 0x4f, 0x48, 0x75, 0x9a, 0x7d, 0x1d, 0x1c, 0x1c, 0x26,
+	0x57, 0x20, 0x6a, 0x13, 0xad, 0xa5, 0x0b, 0x51, 0xe5, 0x59, 0x39, 0x0b, 0x93, 0x50, 0x4b, 0x4c,
+	0xcb, 0x8e, 0x38, 0x79, 0x38, 0xdd, 0xe9, 0x78, 0xee, 0x12, 0x4c, 0x34, 0x3f, 0x35, 0x81, 0xc9,
+	0xff, 0x05, 0xdd, 0x65, 0xd5, 0x8a, 0x92, 0xa4, 0x5b, 0x2d, 0xa8, 0x11, 0x27, 0x55, 0xb2, 0x43,
+	0x6a, 0xf8, 0xf6, 0x85, 0x15, 0x6b, 0x34, 0x79, 0xf3, 0x7d, 0x8b, 0x95, 0xe8, 0x89, 0x9c, 0x8e,
+	0xfd, 0x0d, 0x96, 0xe4, 0x99, 0xa3, 0x1e, 0x7b, 0xc3, 0x8e, 0x82, 0x4a, 0x8b, 0x64, 0x74, 0x1b,
+	0xd7, 0x48, 0xde, 0xc6, 0x9d, 0x03, 0x31, 0x33, 0xda, 0xc7, 0x2a, 0xea, 0x84, 0x05, 0xd1, 0x39,
+	0x80, 0xf0, 0x21, 0xe3, 0x30, 0xfd, 0xe3, 0xf1, 0x25, 0xc0, 0x69, 0xc7, 0xe9, 0x25, 0x1a, 0x45,
+	0xa8, 0xa7, 0x20, 0xc7, 0xc6, 0x4a, 0x35, 0x3c, 0xc6, 0xbd, 0x0f, 0x49, 0xbd, 0x13, 0x2f, 0xde,
+	0x79, 0xca, 0xea, 0x27, 0xba, 0xb6, 0x3e, 0xc6, 0x49, 0xb3, 0x86, 0x05, 0x52, 0x67, 0xac, 0xbd,
+	0x98, 0xd5, 0xad, 0xa8, 0xc4, 0x27, 0x27, 0xe2, 0x03, 0xce, 0xac, 0xe2, 0xac, 0xd5, 0xb1, 0xea,
+	0x76, 0xb0, 0x5c, 0x40, 0xd1, 0x37, 0xe2, 0x70, 0x39, 0xd5, 0x14, 0xb8, 0xac, 0xa0, 0x91, 0x3a,
+	0x94, 0x81, 0x9a, 0xa3, 0xe7, 0x0c, 0x4d, 0xef, 0x8f, 0x6d, 0x2f, 0x34, 0x00, 0x54, 0xed, 0x58,
+	0xcb, 0xcb, 0xb1, 0x81, 0x55, 0x95, 0xe2, 0xa3, 0xb1, 0x9c, 0xf1, 0x7a, 0xf4, 0x4e, 0xbb, 0x07,
+	0x9a, 0x10, 0x01, 0x34, 0xb5, 0xb5, 0x95, 0x24, 0x4e, 0x2e, 0xa0, 0xcd, 0x89, 0x7a, 0x3a, 0x1b,
+	0xcb, 0xd2, 0x0f, 0x25, 0xa5, 0x27, 0x4b, 0x42, 0x4e, 0xcf, 0x6a, 0x07, 0x34, 0xfc, 0x82, 0x5f,
+	0xa3, 0xbe, 0x6f, 0x35, 0xe9, 0xad, 0xe5, 0x4e, 0x34, 0xc6, 0x1f, 0x47, 0x5b, 0x9b, 0xec, 0xbd,
+	0x97, 0xde, 0xed, 0x81, 0x62, 0xb1, 0x85, 0x73, 0x10, 0x8d, 0xb7, 0x78, 0x73, 0x33, 0x58, 0xee,
+	0x50, 0xb3, 0xeb, 0x09, 0x2f, 0x6c, 0x4b, 0x2b, 0x26, 0x7b, 0xdb, 0x73, 0xc8, 0x8b, 0x68, 0x23,
+	0x74, 0xa9, 0xf9, 0xf4, 0x65, 0xdd, 0x6c, 0x22, 0xaf, 0x0b, 0xc5, 0xab, 0xb9, 0x8e, 0x76, 0x9c,
+	0x47, 0x9a, 0x50, 0x01, 0x14, 0xbe, 0x47, 0x1d, 0x04, 0xf1, 0x4a, 0xb5, 0xa8, 0x36, 0x39, 0x96,
+	0x8a, 0xaf, 0xa7, 0x1d, 0xc7, 0xbd, 0x1f, 0x06, 0xb2, 0x42, 0x23, 0x18, 0xad, 0xf3, 0x5c, 0x87,
+	0xc2, 0xf2, 0xc8, 0xfe, 0x27, 0xa7, 0x7b, 0x22, 0xbe, 0xa8, 0x11, 0x30, 0xb4, 0x27, 0xed, 0x0e,
+	0x8d, 0xca, 0xce, 0x8d, 0x48, 0xd2, 0x4a, 0x89, 0xd7, 0x74, 0xbf, 0x64, 0xba, 0x77, 0x73, 0xa9,
+	0x6c, 0x37, 0x93, 0xd2, 0x3c, 0x63, 0x17, 0x11, 0xee, 0x70, 0x54, 0x1c, 0xd1, 0x45, 0x47, 0x9a,
+	0x4c, 0xc9, 0xf7, 0x40, 0xfe, 0x65, 0xb4, 0x25, 0x89, 0xa7, 0xa3, 0x3d, 0x4c, 0x90, 0xa0, 0x21,
+	0x2e, 0x8d, 0xf1, 0xf6, 0x50, 0x48, 0x66, 0x41, 0xf0, 0xf3, 0x80, 0xbd, 0xa3, 0xc8, 0x4e, 0xe7,
+	0x1f, 0x64, 0xaa, 0x83, 0x66, 0xd4, 0x44, 0xa2, 0x90, 0x78, 0xbd, 0x1d, 0xd0, 0x96, 0xde, 0x75,
+	0x56, 0x50, 0xa8, 0xf1, 0x66, 0xe4, 0xa0, 0x38, 0x70, 0xa3, 0xf8, 0xe2, 0x83, 0x37, 0x00, 0x5a,
+	0xfc, 0x78, 0x74, 0x50, 0x46, 0x53, 0x35, 0xde, 0x10, 0xb0, 0x7d, 0xe1, 0xbe, 0x70, 0x97, 0x72,
+	0xc4, 0xf6, 0xc1, 0x5f, 0x39, 0x83, 0xd6, 0x73, 0xbf, 0x64, 0xa8, 0xac, 0x5f, 0xc2, 0xdb, 0x91,
+	0x73, 0x51, 0x5e, 0xbf, 0xb7, 0x4e, 0x71, 0xf5, 0x36, 0xc5, 0x3d, 0x05, 0x35, 0x95, 0x38, 0xed,
+	0xc4, 0x3a, 0xd5, 0x7f, 0x82, 0x95, 0xdc, 0x42, 0x43, 0xf2, 0x3b, 0x06, 0xda, 0x21, 0x69, 0xfe,
+	0xae, 0x1d, 0x2c, 0x8a, 0x48, 0x71, 0x60, 0x90, 0x2f, 0xf8, 0xa4, 0x14, 0x45, 0xf1, 0x54, 0xf8,
+	0xae, 0x44, 0x48, 0x2b, 0x82, 0xd9, 0x59, 0xd7, 0x16, 0x63, 0x36, 0x0e, 0x9e, 0xc4, 0xb5, 0x77,
+	0x79, 0x84, 0x49, 0x6c, 0x0e, 0x3c, 0x0d, 0xf0, 0xd3, 0xe8, 0xd2, 0x6a, 0x66, 0x87, 0xa0, 0xfe,
+	0xdb, 0x68, 0x4c, 0xde, 0xea, 0x05, 0x23, 0x3c, 0x91, 0xa7, 0x21, 0x89, 0x96, 0xc0, 0x4b, 0x90,
+	0xc9, 0xfc, 0x42, 0x01, 0x65, 0x5e, 0x80, 0xfd, 0x22, 0x8e, 0xc0, 0x72, 0x93, 0xd2, 0x46, 0xf1,
+	0x31, 0x7a, 0x03, 0x4e, 0x6e, 0x25, 0xda, 0x46, 0x87, 0xb1, 0xd6, 0xfb, 0x61, 0x81, 0xd6, 0x41,
+	0x89, 0x1b, 0xd6, 0x78, 0x6d, 0xf2, 0x8a, 0x58, 0x6f, 0xe5, 0xcb, 0xaf, 0x37, 0x01, 0x3f, 0x4b,
+	0x30, 0x76, 0x0c, 0xed, 0x48, 0xdf, 0x92, 0x4b, 0x04, 0x4f, 0x0f, 0x26, 0xee, 0xc7, 0x81, 0xa0,
+	0x1f, 0x33, 0xa2, 0x8b, 0xe7, 0x4a, 0xd2, 0xc0, 0xf7, 0x05, 0x34, 0x22, 0xe0, 0xba, 0xb4, 0xf0,
+	0x0d, 0x6a, 0x2a, 0x51, 0xdb, 0x0c, 0x2f, 0xe7, 0x42, 0xef, 0x31, 0x84, 0x73, 0x11, 0xf0, 0x57,
+	0x89, 0x43, 0x67, 0x9f, 0x8c, 0xee, 0xbe, 0x67, 0x10, 0x8a, 0x5c, 0xa1, 0x4d, 0x31, 0xb0, 0x98,
+	0x38, 0x84, 0x96, 0x3b, 0x3e, 0x25, 0x4a, 0x62, 0x32, 0x4b, 0x54, 0xe2, 0x3d, 0x49, 0x70, 0x61,
+	0xf9, 0x9e, 0xe4, 0x59, 0xf8, 0xa8, 0xc4, 0x8d, 0x6f, 0xb7, 0xe7, 0xdd, 0x76, 0xc3, 0x6e, 0x37,
+	0x93, 0xdb, 0xfc, 0xec, 0xb5, 0x2b, 0x6d, 0x96, 0x41, 0x01, 0xf1, 0xc1, 0xf6, 0x6a, 0x0a, 0x51,
+	0x86, 0x03, 0x75, 0xa3, 0x52, 0xed, 0x67, 0x45, 0x41, 0x06, 0xa4, 0x91, 0x28, 0x44, 0x16, 0x99,
+	0x75, 0x2c, 0xbb, 0x65, 0xcd, 0x3b, 0x54, 0x46, 0x33, 0x29, 0x61, 0x11, 0x91, 0xa0, 0xc9, 0xa2,
+	0x13, 0xb1, 0xbf, 0x25, 0x01, 0xac, 0xe2, 0x97, 0x45, 0x56, 0xd9, 0x2c, 0x23, 0xab, 0xf8, 0x51,
+	0xbc, 0x7b, 0xd3, 0x6e, 0x75, 0xc3, 0x90, 0x9d, 0xf9, 0xa8, 0xd3, 0xbe, 0x6f, 0x37, 0xdb, 0x2d,
+	0x5a, 0x06, 0xe7, 0xe6, 0xab, 0xe2, 0x32, 0x75, 0x26, 0x25, 0x90, 0xe0, 0x69, 0xb4, 0xbd, 0xdb,
+	0xa9, 0xbb, 0x2d, 0xbb, 0xdd, 0x34, 0x7b, 0x97, 0x07, 0x2c, 0xde, 0x9d, 0x8f, 0xcf, 0xff, 0x56,
+	0xd0, 0x88, 0x1f, 0x78, 0x56, 0x40, 0x9b, 0xc2, 0xad, 0x8c, 0x9e, 0xf1, 0x4b, 0xa9, 0x15, 0x74,
+	0x58, 0x93, 0x45, 0xe5, 0x1f, 0x68, 0xd9, 0x4b, 0x48, 0xb4, 0x9d, 0xfa, 0xfe, 0x27, 0x0c, 0xb4,
+	0x9e, 0xc9, 0x80, 0x3f, 0x61, 0xa0, 0x0d, 0x3c, 0x1f, 0x82, 0x1f, 0xcf, 0xde, 0x79, 0x4e, 0x80,
+	0xe5, 0x55, 0x0e, 0xe6, 0x57, 0xe4, 0x2a, 0x20, 0x53, 0x1f, 0xfb, 0xce, 0x8f, 0x3f, 0x35, 0xf4,
+	0x24, 0x3e, 0x5c, 0xed, 0x78, 0x6e, 0xa3, 0x5b, 0x0f, 0xfc, 0xba, 0x9d, 0x05, 0x57, 0x09, 0xd8,
+	0xa5, 0xf8, 0x0f, 0x0c, 0x34, 0x1a, 0x1d, 0x12, 0xc0, 0x4f, 0x65, 0xf7, 0xa5, 0x00, 0xd5, 0xab,
+	0x1c, 0x29, 0x5a, 0x1d, 0x18, 0x3c, 0xc5, 0x18, 0x7c, 0x0e, 0x1f, 0x2f, 0xc2, 0x60, 0xfc, 0xdf,
+	0x0a, 0xb3, 0xe4, 0x2a, 0xfe, 0x3d, 0x03, 0x8d, 0x45, 0x44, 0xa7, 0x1d, 0x47, 0xc7, 0xae, 0x02,
+	0x70, 0x4f, 0xc7, 0xae, 0x0a, 0x3a, 0x8f, 0x1c, 0x67, 0xec, 0x56, 0xf1, 0x53, 0xa5, 0xd8, 0xc5,
+	0x9f, 0x37, 0xd0, 0x26, 0x69, 0x34, 0xe0, 0xaa, 0x56, 0x4b, 0xbd, 0x27, 0xd7, 0x2b, 0x4f, 0x17,
+	0x6f, 0x00, 0x9c, 0x9e, 0x61, 0x9c, 0x9e, 0xc0, 0xcf, 0x15, 0xb4, 0xbc, 0x20, 0x10, 0xa9, 0xf6,
+	0x0f, 0x0d, 0xb4, 0x25, 0x19, 0x4b, 0xe8, 0xd8, 0x56, 0x22, 0xe4, 0xe9, 0xd8, 0x56, 0x63, 0xdd,
+	0x91, 0xe7, 0x18, 0xdb, 0x47, 0x71, 0xb5, 0x24, 0xdb, 0xf8, 0xdb, 0x06, 0xda, 0xae, 0x02, 0x8b,
+	0xc3, 0xc7, 0xb3, 0x79, 0xd0, 0x40, 0xd3, 0x55, 0x9e, 0x2d, 0xdb, 0x0c, 0x04, 0xb8, 0xc2, 0x04,
+	0x38, 0x8f, 0x67, 0x4b, 0x8d, 0x10, 0x33, 0x61, 0x01, 0x88, 0xc8, 0x56, 0xf1, 0x9f, 0x1a, 0x68,
+	0x5b, 0x0f, 0xe6, 0x1c, 0x9e, 0xd2, 0x0e, 0x06, 0x25, 0xae, 0x5d, 0xe5, 0x58, 0xa9, 0x36, 0xfd,
+	0x8c, 0xa1, 0x26, 0x0d, 0x4c, 0x00, 0x1d, 0x15, 0x88, 0x76, 0xf8, 0xeb, 0x06, 0xda, 0x92, 0xbc,
+	0xce, 0x9b, 0xc3, 0xbc, 0xf2, 0xa2, 0x71, 0x0e, 0xf3, 0xea, 0x6b, 0xc7, 0xe4, 0x25, 0xc6, 0xfc,
+	0x39, 0x3c, 0x53, 0x84, 0xf9, 0xf4, 0x05, 0xe5, 0xea, 0x8a, 0xf4, 0xc1, 0x58, 0xc5, 0x5f, 0x36,
+	0xd0, 0xb6, 0x64, 0x37, 0xe1, 0x74, 0x98, 0xd2, 0x8e, 0xee, 0xd2, 0xa2, 0x64, 0x02, 0xd7, 0x91,
+	0x17, 0x99, 0x28, 0xcf, 0xe2, 0x67, 0xfa, 0x11, 0x05, 0x7f, 0xc5, 0x40, 0x63, 0xf2, 0xe7, 0x19,
+	0xeb, 0x17, 0x13, 0x05, 0x9a, 0x5b, 0xe5, 0x68, 0x89, 0x16, 0xc0, 0xf3, 0x45, 0xc6, 0xf3, 0x34,
+	0x3e, 0x53, 0x84, 0xe7, 0x84, 0xa3, 0x51, 0x5d, 0x91, 0x26, 0xc3, 0x6a, 0xb8, 0x76, 0x6e, 0x95,
+	0x7b, 0x08, 0x35, 0xaf, 0x5f, 0x57, 0x4a, 0x4a, 0x90, 0x81, 0x2c, 0x47, 0x4e, 0x30, 0x09, 0x8e,
+	0xe1, 0xa3, 0xa5, 0x25, 0xc0, 0x3f, 0x33, 0xd0, 0x43, 0x4a, 0xc4, 0x30, 0x7c, 0xa2, 0xe0, 0x50,
+	0xee, 0xc5, 0x4a, 0xab, 0xbc, 0xd0, 0x4f, 0x53, 0x90, 0xc5, 0x64, 0xb2, 0xbc, 0x8a, 0xef, 0x96,
+	0x1d, 0x41, 0x52, 0x06, 0x3f, 0x69, 0x97, 0xd4, 0x0c, 0x79, 0xdb, 0x40, 0x13, 0x4a, 0x16, 0x42,
+	0x73, 0x9d, 0x28, 0x38, 0xe8, 0xcb, 0x09, 0x9d, 0x07, 0xf5, 0x46, 0x66, 0x99, 0xd0, 0xa7, 0xf0,
+	0xc9, 0x35, 0x08, 0x8d, 0xff, 0xd6, 0x40, 0xb8, 0x17, 0x33, 0x0d, 0x6b, 0xe6, 0x71, 0x26, 0x4c,
+	0x5b, 0xe5, 0x99, 0x72, 0x8d, 0x40, 0x8c, 0x39, 0x26, 0xc6, 0x4b, 0xf8, 0x52, 0xa1, 0x4f, 0xa2,
+	0x80, 0x3c, 0xa3, 0xbe, 0xb9, 0xe0, 0x7a, 0x3c, 0xf2, 0xac, 0xae, 0xc8, 0xb1, 0xf5, 0x2a, 0xfe,
+	0x47, 0x03, 0xed, 0x50, 0x43, 0x91, 0xe1, 0xe7, 0xb4, 0x2c, 0x66, 0x43, 0x6c, 0x55, 0x9e, 0x2f,
+	0xdf, 0x10, 0xe4, 0xbb, 0xc5, 0xe4, 0xbb, 0x8e, 0xaf, 0x16, 0x95, 0x4f, 0x32, 0x4f, 0xb6, 0x8c,
+	0x3f, 0x35, 0xd0, 0x44, 0x16, 0x94, 0x97, 0x6e, 0x40, 0xe6, 0x40, 0x89, 0xe9, 0x06, 0x64, 0x1e,
+	0x72, 0x18, 0xb9, 0xcb, 0x24, 0xbd, 0x81, 0x5f, 0x2e, 0x2c, 0xe9, 0x54, 0x31, 0x61, 0x7f, 0x66,
+	0xa0, 0xf1, 0x34, 0xc8, 0x10, 0x3e, 0xaa, 0xe3, 0x54, 0x09, 0xc1, 0x55, 0x99, 0x2a, 0xd3, 0x04,
+	0x84, 0x0a, 0x98, 0x50, 0x6d, 0xec, 0x94, 0x10, 0xca, 0x0f, 0xe9, 0x98, 0x1c, 0x22, 0xa9, 0xba,
+	0xa2, 0xc3, 0x11, 0x5a, 0x4d, 0xac, 0x3a, 0x66, 0xe4, 0x19, 0xfd, 0xda, 0x10, 0x9a, 0xc8, 0x02,
+	0xea, 0xd1, 0x99, 0x37, 0x07, 0x80, 0x4b, 0x67, 0xde, 0x3c, 0x88, 0x2c, 0xb2, 0xc2, 0x34, 0xd1,
+	0xc5, 0x7e, 0x11, 0x4d, 0x40, 0x9e, 0x9c, 0xdf, 0xc0, 0x31, 0x65, 0x14, 0xa2, 0xfe, 0x14, 0xf2,
+	0xb1, 0x21, 0xb4, 0x47, 0x07, 0x6e, 0x85, 0x4f, 0xe9, 0x7d, 0xf1, 0x1c, 0x00, 0xae, 0xca, 0xe9,
+	0x7e, 0x9b, 0x83, 0x72, 0xea, 0x4c, 0x39, 0x1f, 0xc0, 0xaf, 0x15, 0x51, 0x8e, 0xe5, 0x38, 0xa6,
+	0x62, 0xa8, 0xf8, 0x39, 0xaa, 0xc1, 0x9f, 0x19, 0x42, 0x07, 0x8a, 0xe0, 0x49, 0xe1, 0xf3, 0x5a,
+	0x69, 0x8a, 0x82, 0x60, 0x55, 0x2e, 0xac, 0x95, 0x0c, 0x28, 0xe7, 0x43, 0x4c, 0x39, 0x0d, 0x3c,
+	0x5f, 0x54, 0x39, 0xd9, 0xa3, 0x27, 0x57, 0x47, 0x5f, 0x30, 0xd0, 0xd6, 0x14, 0x52, 0x54, 0x8e,
+	0x47, 0xa8, 0xc0, 0x9c, 0xca, 0xf1, 0x08, 0x55, 0x30, 0x54, 0xe5, 0x42, 0xfd, 0x30, 0x9a, 0x48,
+	0xdc, 0xe2, 0xc2, 0x5f, 0x34, 0xd0, 0x96, 0x24, 0x82, 0x54, 0x4e, 0x2c, 0xa1, 0x44, 0xb5, 0xca,
+	0x89, 0x25, 0xd4, 0x40, 0x57, 0xe4, 0x24, 0x63, 0xfd, 0x38, 0x3e, 0x56, 0x84, 0xf5, 0x14, 0x24,
+	0x16, 0xfe, 0xb9, 0x81, 0x1e, 0xd6, 0xa2, 0x4f, 0xe1, 0xd3, 0x5a, 0x9e, 0x72, 0x61, 0xb0, 0x2a,
+	0x67, 0xfa, 0x6e, 0x0f, 0xf2, 0x5d, 0x67, 0xf2, 0x5d, 0xc2, 0x17, 0x8a, 0x9a, 0x26, 0xf5, 0x0b,
+	0x2d, 0x7c, 0x8b, 0xdf, 0x8c, 0x90, 0xaf, 0xbe, 0x69, 0xa0, 0x87, 0x94, 0xb0, 0x55, 0x58, 0x13,
+	0x56, 0xeb, 0xc0, 0xb0, 0x2a, 0xcf, 0x95, 0x6e, 0x07, 0xa2, 0x9d, 0x63, 0xa2, 0x9d, 0xc6, 0x2f,
+	0x16, 0x11, 0x2d, 0x31, 0xe2, 0xe4, 0x18, 0xea, 0xb7, 0x0d, 0x34, 0x1a, 0x01, 0x41, 0xe1, 0xc3,
+	0x9a, 0x8f, 0x44, 0x0a, 0x8f, 0xaa, 0xf2, 0x44, 0xa1, 0xba, 0xc0, 0xec, 0xb3, 0x8c, 0xd9, 0xa7,
+	0xf1, 0x91, 0x42, 0x5f, 0x10, 0xd6, 0xdc, 0xb4, 0x1c, 0x07, 0x7f, 0xd6, 0x40, 0x23, 0x02, 0xd8,
+	0x08, 0x3f, 0x99, 0x33, 0xc2, 0x13, 0xe8, 0x4c, 0x95, 0xa7, 0x0a, 0xd6, 0xee, 0x27, 0x25, 0x10,
+	0x21, 0x32, 0x49, 0x29, 0x8d, 0xcf, 0x18, 0x68, 0x93, 0xa0, 0x1a, 0xea, 0xf2, 0x49, 0xed, 0x12,
+	0x5a, 0x82, 0x5b, 0x05, 0x2a, 0x54, 0xb9, 0x74, 0x5d, 0xc4, 0x2d, 0xfe, 0xa1, 0x81, 0xc6, 0xd3,
+	0xa8, 0x40, 0xf8, 0x99, 0x62, 0x99, 0xcd, 0x24, 0xf4, 0x51, 0xe5, 0x78, 0xc9, 0x56, 0xc0, 0xf8,
+	0xeb, 0x8c, 0xf1, 0x57, 0xf0, 0x9d, 0x72, 0x59, 0x24, 0x80, 0x3b, 0xaa, 0xae, 0xa4, 0x51, 0xa4,
+	0x56, 0xab, 0x2b, 0x12, 0x2e, 0xd0, 0x2a, 0xfe, 0x9a, 0x81, 0x1e, 0x4c, 0x77, 0x1e, 0x5a, 0xe3,
+	0x99, 0x62, 0xf9, 0xd0, 0xe2, 0x42, 0x6a, 0x90, 0x9a, 0xfa, 0xcc, 0xfd, 0x0a, 0x21, 0xf1, 0xff,
+	0x18, 0xa8, 0x92, 0x0d, 0xd3, 0x92, 0xb3, 0xa8, 0xe6, 0x02, 0x0d, 0xe5, 0x2c, 0xaa, 0xf9, 0x90,
+	0x41, 0xe4, 0x83, 0x4c, 0xbc, 0xf7, 0xe1, 0x57, 0xca, 0x89, 0xd7, 0xfb, 0xa3, 0x51, 0x10, 0x6a,
+	0x5f, 0x6e, 0xa4, 0xad, 0xf8, 0x53, 0x03, 0x3d, 0x9c, 0xcd, 0x48, 0x68, 0xcf, 0xd3, 0xc5, 0x2c,
+	0xd3, 0x8f, 0x12, 0x0a, 0xe1, 0x26, 0x91, 0x4b, 0x4c, 0x09, 0x33, 0xf8, 0xec, 0x5a, 0x95, 0x80,
+	0x7f, 0x65, 0x08, 0xed, 0xcb, 0x03, 0x17, 0xc2, 0xd3, 0x65, 0x8d, 0xd6, 0x83, 0x7c, 0x54, 0x99,
+	0x59, 0x0b, 0x09, 0x90, 0xda, 0x62, 0x52, 0xbf, 0x86, 0x5f, 0x2d, 0xfa, 0x3d, 0x55, 0x4a, 0x1e,
+	0x63, 0x04, 0x55, 0x57, 0xec, 0x86, 0xbf, 0x5a, 0x5d, 0x89, 0xf0, 0x96, 0x56, 0xf1, 0xbf, 0x1b,
+	0x68, 0x67, 0x06, 0x1e, 0x0a, 0xd6, 0x58, 0xad, 0x10, 0x36, 0x50, 0xe5, 0x6c, 0xff, 0x04, 0x40,
+	0x03, 0x37, 0x99, 0x06, 0xae, 0xe1, 0x2b, 0xc5, 0x73, 0x2f, 0x8a, 0x5f, 0x3e, 0x4b, 0x25, 0x99,
+	0x3e, 0x3d, 0x84, 0x0e, 0x14, 0xc1, 0xdc, 0xd1, 0xb9, 0xf7, 0x25, 0xf0, 0x82, 0x74, 0xee, 0x7d,
+	0x19, 0xe8, 0x9f, 0x72, 0xb1, 0x4f, 0x41, 0x65, 0x24, 0xc2, 0xc0, 0x70, 0x31, 0xf8, 0x81, 0x81,
+	0x2a, 0x19, 0x5c, 0x85, 0x2b, 0xc1, 0xc9, 0xfc, 0x44, 0x5a, 0x26, 0x46, 0x50, 0xe5, 0xc5, 0xfe,
+	0x1a, 0x83, 0xf8, 0xe7, 0x99, 0xf8, 0x67, 0xf0, 0xa9, 0x35, 0x89, 0x1f, 0x3a, 0x39, 0x63, 0xf2,
+	0xb5, 0x34, 0xdd, 0x5e, 0x9f, 0x02, 0xa1, 0x47, 0xb7, 0xd7, 0xa7, 0x02, 0xe9, 0x29, 0x97, 0xff,
+	0x4d, 0xfc, 0xbc, 0x1e, 0xfe, 0xb2, 0x81, 0x36, 0x27, 0xf0, 0x6a, 0xb0, 0xa6, 0x73, 0x15, 0x9a,
+	0x4f, 0xa5, 0x5a, 0xb8, 0x7e, 0x3f, 0x0b, 0x6d, 0xe2, 0xe7, 0xfd, 0x52, 0x89, 0x5d, 0xfc, 0x25,
+	0x03, 0x8d, 0xa7, 0xa1, 0x7a, 0x74, 0xc9, 0xa4, 0x0c, 0xd8, 0x1f, 0x5d, 0x32, 0x29, 0x0b, 0x09,
+	0x88, 0x9c, 0x66, 0x52, 0x3c, 0x8f, 0x9f, 0x2d, 0x2f, 0x05, 0x73, 0x84, 0xbf, 0x6e, 0xb0, 0xdf,
+	0x91, 0x4b, 0x03, 0xfd, 0xe8, 0xfc, 0x9a, 0x6c, 0xe8, 0x20, 0x9d, 0x5f, 0xa3, 0x41, 0x13, 0x22,
+	0xd3, 0x4c, 0x88, 0x93, 0xf8, 0x44, 0x11, 0x21, 0xde, 0x60, 0x84, 0xcc, 0xc4, 0xf8, 0xf1, 0x59,
+	0x90, 0x9e, 0x02, 0x0d, 0xd2, 0x05, 0xe9, 0x6a, 0xf8, 0x21, 0x5d, 0x90, 0x9e, 0x81, 0x48, 0x54,
+	0xce, 0x27, 0x4b, 0xfe, 0xa6, 0x64, 0xa8, 0xff, 0xff, 0x30, 0x50, 0x25, 0x1b, 0x43, 0x48, 0xb7,
+	0x08, 0xe5, 0x22, 0x19, 0xe9, 0x16, 0xa1, 0x7c, 0xd8, 0x22, 0xf2, 0x2a, 0x13, 0xec, 0x26, 0xbe,
+	0x51, 0xf4, 0x93, 0x9c, 0x09, 0x28, 0xd4, 0xb3, 0xf2, 0xfe, 0x8c, 0x07, 0xf8, 0xd9, 0x38, 0x45,
+	0x39, 0xbe, 0x68, 0x2e, 0x1c, 0x52, 0x8e, 0x2f, 0x9a, 0x0f, 0x90, 0x44, 0xae, 0x31, 0xe9, 0x2f,
+	0xe2, 0xf3, 0x45, 0xa5, 0x67, 0x19, 0xb8, 0x2c, 0x0d, 0xe0, 0x6f, 0x18, 0x68, 0x22, 0xa9, 0xf3,
+	0x18, 0xa2, 0x46, 0x17, 0xe2, 0xeb, 0x80, 0x6f, 0x74, 0x21, 0xbe, 0x16, 0x0b, 0xa7, 0xdc, 0xa2,
+	0xd1, 0x8b, 0x9a, 0x83, 0xff, 0xcd, 0x40, 0x7b, 0x74, 0x68, 0x33, 0xba, 0xd4, 0x69, 0x01, 0x94,
+	0x1c, 0x5d, 0xea, 0xb4, 0x08, 0xc8, 0x0d, 0x79, 0x99, 0xc9, 0x77, 0x19, 0x5f, 0x2c, 0x22, 0x5f,
+	0x84, 0xe8, 0x50, 0x5d, 0x89, 0xfe, 0x5d, 0xad, 0x26, 0xd0, 0x34, 0xf0, 0x47, 0x87, 0x10, 0xc9,
+	0x02, 0xbc, 0x89, 0xf1, 0x67, 0xf0, 0x4c, 0x2e, 0xdf, 0xb9, 0x70, 0x39, 0xba, 0x7c, 0x7a, 0x1e,
+	0x1a, 0x0a, 0x79, 0x8d, 0xc9, 0x7d, 0x1b, 0xdf, 0x1c, 0x80, 0xdc, 0x80, 0xa0, 0x33, 0x1f, 0x09,
+	0xf7, 0x17, 0x06, 0xda, 0xc6, 0xaf, 0x29, 0xc9, 0xe7, 0x59, 0x35, 0x2b, 0xbe, 0x06, 0x20, 0x45,
+	0x77, 0x58, 0x44, 0x87, 0x99, 0xd2, 0xff, 0xc8, 0xad, 0xf2, 0x5f, 0x7d, 0xf8, 0x8a, 0x81, 0x26,
+	0x62, 0x50, 0x92, 0x24, 0x3a, 0x07, 0x7e, 0xa2, 0x18, 0x90, 0x09, 0x97, 0xe0, 0xc9, 0x32, 0xa8,
+	0x27, 0xe5, 0x3c, 0xba, 0x46, 0xd8, 0x5e, 0xb2, 0x4f, 0xb8, 0xb4, 0xf0, 0x89, 0xf7, 0xff, 0x86,
+	0xd0, 0xa1, 0xc2, 0x70, 0x48, 0xf8, 0xa5, 0xf2, 0x43, 0x29, 0x0b, 0x53, 0x69, 0x4d, 0xc3, 0xb2,
+	0xcf, 0xe9, 0x98, 0x3d, 0x14, 0xff, 0xd9, 0x40, 0x07, 0x8a, 0x20, 0x38, 0xe9, 0xc2, 0x9a, 0x12,
+	0x08, 0x50, 0x6b, 0x12, 0xbe, 0x94, 0x7f, 0x10, 0x59, 0x3a, 0x5a, 0x79, 0xfe, 0xc5, 0x40, 0xfb,
+	0xf2, 0xb0, 0x97, 0x74, 0x41, 0x7c, 0x41, 0xf8, 0x29, 0x5d, 0x10, 0x5f, 0x14, 0xfa, 0x89, 0x9c,
+	0x65, 0xa2, 0xbe, 0x80, 0x9f, 0x2f, 0x91, 0x8c, 0x4d, 0x4a, 0xfb, 0x13, 0x03, 0xed, 0xd6, 0x20,
+	0xdc, 0x60, 0xbd, 0x47, 0x93, 0x03, 0xa2, 0x53, 0x39, 0xd5, 0x67, 0xeb, 0x7e, 0xce, 0x47, 0x85,
+	0x2e, 0x41, 0x36, 0xbe, 0x0e, 0x7e, 0xdb, 0x40, 0x3b, 0x33, 0x10, 0x6f, 0xf0, 0xf3, 0x3a, 0x53,
+	0xe8, 0xb0, 0x74, 0x2a, 0x27, 0xfa, 0x68, 0x09, 0xc2, 0x5d, 0x60, 0xc2, 0x9d, 0xc5, 0xa7, 0x8b,
+	0xa5, 0x9d, 0xcc, 0x0e, 0x50, 0x4b, 0xfb, 0xe1, 0x7f, 0x2e, 0x0e, 0x41, 0xc6, 0x98, 0x2f, 0x53,
+	0xf9, 0x6e, 0x68, 0x1a, 0xe5, 0x26, 0x67, 0xd3, 0x49, 0x8d, 0x57, 0x53, 0x6e, 0xe7, 0x22, 0xf5,
+	0x3b, 0xec, 0xd5, 0x15, 0xb1, 0xdd, 0xf7, 0x25, 0x03, 0x6d, 0x4b, 0x76, 0x90, 0x7f, 0x74, 0xad,
+	0xb4, 0x10, 0x99, 0xa0, 0x3b, 0xe5, 0x76, 0xce, 0x52, 0x42, 0xe0, 0x9f, 0x1b, 0x68, 0x5b, 0x0f,
+	0x20, 0x05, 0xd6, 0xa7, 0xd4, 0xb3, 0xe0, 0x48, 0x74, 0xdf, 0x68, 0x1d, 0x6a, 0x08, 0x71, 0x98,
+	0x04, 0x0b, 0xb8, 0x51, 0x44, 0x02, 0x8e, 0x92, 0x11, 0x8e, 0x22, 0x41, 0xa7, 0xba, 0x22, 0x83,
+	0xa0, 0xac, 0x8a, 0x93, 0x1b, 0x1c, 0xf0, 0x84, 0xa5, 0xf4, 0x24, 0x70, 0x93, 0x55, 0xfc, 0x97,
+	0x06, 0xc2, 0xbd, 0x80, 0x1e, 0x3a, 0x9f, 0x5a, 0x87, 0x37, 0xa2, 0xf3, 0xa9, 0xb5, 0xc8, 0x21,
+	0xe5, 0xf6, 0x79, 0x7a, 0xa5, 0xf6, 0xf1, 0x77, 0x0d, 0xb4, 0x43, 0x0d, 0x01, 0xa1, 0x3b, 0x63,
+	0xa4, 0x45, 0x9d, 0xd0, 0x9d, 0x31, 0xd2, 0xa3, 0x4d, 0x94, 0x3b, 0x8d, 0x08, 0xe2, 0x44, 0xf7,
+	0x22, 0xab, 0x2b, 0x02, 0xeb, 0x82, 0x9d, 0x8a, 0xde, 0x9c, 0x80, 0x69, 0xd0, 0x65, 0x76, 0x54,
+	0x60, 0x0f, 0xba, 0xcc, 0x8e, 0x12, 0xff, 0x81, 0xbc, 0xc0, 0x78, 0x7f, 0x06, 0x4f, 0x15, 0xe1,
+	0xdd, 0x11, 0x24, 0xcc, 0x4e, 0xc8, 0xdc, 0x77, 0x0c, 0xb4, 0x5d, 0x05, 0x1a, 0xa2, 0x9b, 0x44,
+	0x1a, 0x88, 0x12, 0xdd, 0x24, 0xd2, 0x61, 0x93, 0x90, 0xab, 0x4c, 0x86, 0x0b, 0xf8, 0x5c, 0x11,
+	0x19, 0xee, 0x73, 0x4a, 0xfc, 0xca, 0xbe, 0x40, 0x56, 0xf1, 0xa5, 0x3d, 0xc4, 0xff, 0x36, 0xd0,
+	0x1e, 0x1d, 0x20, 0x87, 0x2e, 0x60, 0x2b, 0x80, 0x0f, 0xa2, 0x0b, 0xd8, 0x8a, 0xe0, 0x80, 0x94,
+	0x3b, 0x6d, 0x09, 0x9f, 0x53, 0x6a, 0x26, 0xc5, 0x5e, 0x70, 0xbd, 0x70, 0x42, 0x85, 0x4b, 0x79,
+	0x1a, 0xab, 0x64, 0x15, 0x7f, 0xdb, 0x40, 0x3b, 0xd4, 0xd8, 0x07, 0xba, 0xc9, 0xa5, 0xc5, 0x54,
+	0xd0, 0x4d, 0x2e, 0x3d, 0xcc, 0x42, 0xb9, 0x68, 0x20, 0x05, 0xc8, 0x10, 0xcb, 0x89, 0xdf, 0x32,
+	0xd0, 0x78, 0x1a, 0x0d, 0x0c, 0x17, 0x48, 0x61, 0xa5, 0xb0, 0xd2, 0x74, 0x79, 0xc7, 0x2c, 0x50,
+	0xb4, 0x72, 0xf9, 0x91, 0xc8, 0x47, 0xb8, 0xb7, 0x64, 0x72, 0x44, 0xb4, 0xea, 0x0a, 0x47, 0x36,
+	0x5b, 0xad, 0xae, 0xdc, 0xa3, 0xcb, 0xab, 0xf8, 0xc7, 0x06, 0x9a, 0xc8, 0x02, 0x37, 0xd3, 0x9d,
+	0x4e, 0xcb, 0x01, 0x54, 0xd3, 0x79, 0xee, 0x79, 0x58, 0x6a, 0xe4, 0x06, 0x13, 0xf1, 0x0a, 0xbe,
+	0x5c, 0x6c, 0x19, 0xf1, 0x03, 0xb3, 0x57, 0x4e, 0xf3, 0x1e, 0x5d, 0xf6, 0x23, 0x61, 0xf1, 0x1f,
+	0x4b, 0x59, 0x4a, 0x00, 0x3c, 0x2b, 0x92, 0xa5, 0x4c, 0x22, 0xb6, 0x15, 0xc9, 0x52, 0xa6, 0x40,
+	0xd8, 0xca, 0x1d, 0x88, 0x8f, 0xc4, 0x98, 0x07, 0x16, 0xbf, 0x66, 0x20, 0xdc, 0x0b, 0x8e, 0xa6,
+	0x3b, 0xd2, 0x9b, 0x89, 0xd4, 0xa6, 0x3b, 0xd2, 0x9b, 0x8d, 0xbf, 0x56, 0x2e, 0xb4, 0x88, 0xf8,
+	0xb7, 0x42, 0x42, 0x3c, 0xd9, 0xcd, 0x0e, 0xa4, 0x44, 0x08, 0x3d, 0xba, 0x03, 0x29, 0x69, 0x80,
+	0x1f, 0xdd, 0x81, 0x94, 0x1e, 0xc8, 0x9f, 0x72, 0x07, 0x52, 0xc4, 0xf6, 0xd4, 0x82, 0x1b, 0x46,
+	0x3e, 0x3b, 0x33, 0xf0, 0x68, 0x74, 0x01, 0x81, 0x1e, 0x0b, 0x47, 0x17, 0x10, 0xe4, 0x80, 0xdf,
+	0x90, 0x3b, 0x4c, 0x90, 0x39, 0x7c, 0xbd, 0xd0, 0x31, 0x20, 0x86, 0x5e, 0xde, 0x71, 0xcd, 0xf8,
+	0x2c, 0xb5, 0x25, 0x2e, 0xee, 0x82, 0x03, 0x07, 0xc7, 0x2a, 0xf0, 0x7f, 0x19, 0x68, 0xb7, 0x06,
+	0xed, 0x46, 0x17, 0xe2, 0xe5, 0x23, 0xee, 0xe8, 0x42, 0xbc, 0x02, 0x10, 0x3b, 0xe4, 0x7d, 0x4c,
+	0xe8, 0x5b, 0xb8, 0x56, 0x52, 0x68, 0xf9, 0xcc, 0x71, 0x96, 0xe0, 0x6f, 0x1b, 0xe8, 0x21, 0x25,
+	0x7a, 0x0d, 0xd6, 0x3b, 0xda, 0x99, 0x70, 0x39, 0x3a, 0x5f, 0x55, 0x0b, 0x93, 0x53, 0xce, 0xb9,
+	0x00, 0x48, 0x1a, 0xea, 0xc1, 0x77, 0x96, 0x9d, 0x5c, 0xab, 0xae, 0x08, 0x80, 0x94, 0x55, 0xfc,
+	0x0f, 0x06, 0xda, 0x95, 0x09, 0x6e, 0x83, 0x5f, 0xc8, 0xcb, 0xc4, 0x67, 0x23, 0xef, 0x54, 0x4e,
+	0xf6, 0xd5, 0xb6, 0x9f, 0x88, 0x96, 0x1d, 0x11, 0x55, 0x09, 0xea, 0xe3, 0xaf, 0x1a, 0x68, 0x3c,
+	0x0d, 0x86, 0x83, 0x8f, 0xe6, 0xab, 0x3e, 0x85, 0xb9, 0x53, 0x99, 0x2a, 0xd3, 0xa4, 0x1f, 0x47,
+	0x21, 0x01, 0x8c, 0xb3, 0x2c, 0x5b, 0xe8, 0xaf, 0xf9, 0xd0, 0xeb, 0xc5, 0xd5, 0xc9, 0x19, 0x7a,
+	0x99, 0x68, 0x3d, 0x39, 0x43, 0x2f, 0x1b, 0xc0, 0xa7, 0xdc, 0x42, 0x1e, 0x5b, 0x25, 0x86, 0xfb,
+	0xc1, 0xff, 0x19, 0x0a, 0xa3, 0xc2, 0xaa, 0xd1, 0x0a, 0xa3, 0x81, 0xe4, 0xd1, 0x0a, 0xa3, 0x03,
+	0xc5, 0x21, 0x36, 0x13, 0xa6, 0x8e, 0xad, 0x42, 0x19, 0x21, 0x20, 0x65, 0xce, 0x2f, 0x9b, 0x32,
+	0xb0, 0x4f, 0x75, 0x25, 0x85, 0x07, 0xb4, 0x5a, 0x5d, 0x49, 0x03, 0xff, 0xac, 0xe2, 0x3f, 0x32,
+	0xd0, 0xe6, 0x04, 0xfc, 0x4b, 0xde, 0x10, 0x54, 0xc0, 0xd1, 0xe4, 0x0d, 0x41, 0x15, 0x42, 0x4d,
+	0xb9, 0x60, 0x2a, 0x89, 0x65, 0xc3, 0x6e, 0x63, 0xf6, 0x20, 0xf8, 0xe0, 0xa9, 0x22, 0x1e, 0x40,
+	0x12, 0xab, 0xa7, 0x72, 0xac, 0x54, 0x9b, 0xbe, 0x8e, 0x5e, 0xc6, 0x4e, 0x83, 0xe3, 0xde, 0x37,
+	0x43, 0x7f, 0x0e, 0xff, 0x95, 0x91, 0xf8, 0xc9, 0x8e, 0x58, 0x84, 0xe3, 0x85, 0x76, 0xde, 0x7a,
+	0xa4, 0x78, 0xb6, 0x6c, 0x33, 0x10, 0x64, 0x86, 0x09, 0xf2, 0x22, 0x7e, 0xa1, 0xe4, 0xae, 0x87,
+	0x2c, 0xcb, 0xdb, 0x06, 0xda, 0xae, 0xc2, 0xf3, 0xd1, 0xc9, 0xa2, 0x01, 0x11, 0xd2, 0xc9, 0xa2,
+	0x83, 0x0d, 0x2a, 0xb7, 0x19, 0x40, 0x81, 0x92, 0x99, 0xd8, 0xca, 0x49, 0x9e, 0x71, 0x7a, 0xc7,
+	0x40, 0x95, 0x6c, 0x60, 0x20, 0xed, 0x31, 0x9e, 0x3c, 0xe4, 0x21, 0xed, 0x31, 0x9e, 0x5c, 0x2c,
+	0x22, 0x72, 0x99, 0x89, 0x3a, 0x8b, 0xa7, 0x0b, 0xad, 0x75, 0x3a, 0x20, 0x45, 0xfc, 0x43, 0x03,
+	0xed, 0x0a, 0x87, 0x84, 0x12, 0x32, 0x48, 0x7b, 0x46, 0x5c, 0x83, 0x54, 0xa4, 0x3d, 0x23, 0xae,
+	0xc3, 0x26, 0x2a, 0x17, 0x1a, 0xa9, 0x45, 0x4a, 0x9b, 0xf1, 0x8b, 0x06, 0xda, 0xca, 0x06, 0x7d,
+	0x0c, 0x38, 0xa3, 0x3b, 0xea, 0xdc, 0x8b, 0x69, 0xa3, 0x3b, 0xea, 0xac, 0x40, 0xb1, 0x29, 0xe7,
+	0x1f, 0xc0, 0x3d, 0x6d, 0x06, 0x64, 0x93, 0x62, 0xfc, 0xef, 0x0d, 0x34, 0x91, 0x85, 0x26, 0xa4,
+	0xbd, 0x37, 0xa7, 0x87, 0x3c, 0xd2, 0xde, 0x9b, 0xcb, 0x01, 0x2f, 0x2a, 0x27, 0x5b, 0x62, 0x83,
+	0xff, 0xbe, 0x1d, 0x2c, 0x46, 0x19, 0xa4, 0x30, 0x2a, 0x79, 0x48, 0x89, 0x86, 0xa3, 0x3d, 0xb3,
+	0xa0, 0xc1, 0xf7, 0xd1, 0x9e, 0x59, 0xd0, 0x81, 0xf7, 0x90, 0xf7, 0x33, 0x91, 0xee, 0xe0, 0x5b,
+	0xe5, 0x2f, 0x3d, 0x9a, 0x02, 0xb9, 0xa7, 0xf7, 0xa6, 0x8f, 0x48, 0xfa, 0x87, 0x8b, 0x48, 0x38,
+	0xfa, 0xd4, 0x48, 0x39, 0xb8, 0xd8, 0x49, 0x8b, 0x5e, 0xb8, 0x1f, 0xed, 0x25, 0x4f, 0x2d, 0xbc,
+	0x4f, 0xb9, 0x29, 0x26, 0xaf, 0xf9, 0x12, 0x9c, 0x4f, 0xea, 0x9c, 0xda, 0xf7, 0x20, 0xc9, 0xa2,
+	0x02, 0xe1, 0xd1, 0x7d, 0x06, 0x34, 0xb0, 0x3f, 0xba, 0xcf, 0x80, 0x0e, 0xeb, 0xa7, 0x5c, 0xfe,
+	0x28, 0x16, 0xc9, 0x8c, 0xe1, 0x7d, 0xaa, 0x2b, 0x11, 0xbc, 0xd0, 0x2a, 0x7e, 0x17, 0xec, 0xa7,
+	0x86, 0xe8, 0xd1, 0xd9, 0x4f, 0x0b, 0x0e, 0xa4, 0xb3, 0x9f, 0x1e, 0x0d, 0x88, 0xd4, 0x98, 0x80,
+	0x57, 0xf1, 0x4b, 0x85, 0x96, 0x48, 0x41, 0xcb, 0x4c, 0x22, 0x08, 0xa5, 0x0c, 0xf8, 0x77, 0x06,
+	0xda, 0x99, 0x81, 0xe1, 0xa3, 0x4b, 0x12, 0xe8, 0x01, 0x84, 0x74, 0x49, 0x82, 0x1c, 0xc0, 0xa0,
+	0x72, 0xf1, 0x89, 0x0f, 0xc4, 0xc0, 0xa5, 0xb7, 0x22, 0x72, 0x33, 0x2f, 0xbf, 0xf5, 0xce, 0xa4,
+	0xf1, 0xad, 0x77, 0x26, 0x8d, 0x1f, 0xbd, 0x33, 0x69, 0x7c, 0xf2, 0xdd, 0xc9, 0x07, 0xbe, 0xf5,
+	0xee, 0xe4, 0x03, 0xdf, 0x7b, 0x77, 0xf2, 0x81, 0xf7, 0x1d, 0x6f, 0xda, 0xc1, 0x62, 0x77, 0xfe,
+	0x48, 0xdd, 0x6d, 0x65, 0x77, 0xf1, 0xa6, 0xec, 0xc6, 0x2d, 0x77, 0xa8, 0x3f, 0xbf, 0xa1, 0xe3,
+	0xb9, 0x81, 0x7b, 0xec, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x44, 0xef, 0xa7, 0x67, 0x1b, 0x9d,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -9477,6 +9887,14 @@ type QueryClient interface {
 	ParticipantsWithBalances(ctx context.Context, in *QueryParticipantsWithBalancesRequest, opts ...grpc.CallOption) (*QueryParticipantsWithBalancesResponse, error)
 	// Queries PoC validation snapshot for deterministic sampling synchronization.
 	PoCValidationSnapshot(ctx context.Context, in *QueryPoCValidationSnapshotRequest, opts ...grpc.CallOption) (*QueryPoCValidationSnapshotResponse, error)
+	// Queries the stake delegated to a participant.
+	ListParticipantDelegations(ctx context.Context, in *QueryParticipantDelegationsRequest, opts ...grpc.CallOption) (*QueryParticipantDelegationsResponse, error)
+	// Queries the undelegated stake of a delegator waiting for the settlement of the epochs it weighted.
+	ListDelegationUnbondings(ctx context.Context, in *QueryDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryDelegationUnbondingsResponse, error)
+	// Queries the settle amounts a participant can still claim, including those carried over.
+	ListClaimableSettleAmounts(ctx context.Context, in *QueryClaimableSettleAmountsRequest, opts ...grpc.CallOption) (*QueryClaimableSettleAmountsResponse, error)
+	// Runs model assignment and PoC slot allocation read-only against the current hardware nodes and governance models.
+	SimulateModelAssignment(ctx context.Context, in *QuerySimulateModelAssignmentRequest, opts ...grpc.CallOption) (*QuerySimulateModelAssignmentResponse, error)
 }
 
 type queryClient struct {
@@ -10225,6 +10643,42 @@ func (c *queryClient) PoCValidationSnapshot(ctx context.Context, in *QueryPoCVal
 	return out, nil
 }
 
+func (c *queryClient) ListParticipantDelegations(ctx context.Context, in *QueryParticipantDelegationsRequest, opts ...grpc.CallOption) (*QueryParticipantDelegationsResponse, error) {
+	out := new(QueryParticipantDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/ListParticipantDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDelegationUnbondings(ctx context.Context, in *QueryDelegationUnbondingsRequest, opts ...grpc.CallOption) (*QueryDelegationUnbondingsResponse, error) {
+	out := new(QueryDelegationUnbondingsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/ListDelegationUnbondings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimableSettleAmounts(ctx context.Context, in *QueryClaimableSettleAmountsRequest, opts ...grpc.CallOption) (*QueryClaimableSettleAmountsResponse, error) {
+	out := new(QueryClaimableSettleAmountsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/ListClaimableSettleAmounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateModelAssignment(ctx context.Context, in *QuerySimulateModelAssignmentRequest, opts ...grpc.CallOption) (*QuerySimulateModelAssignmentResponse, error) {
+	out := new(QuerySimulateModelAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Query/SimulateModelAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -10368,6 +10822,14 @@ type QueryServer interface {
 	ParticipantsWithBalances(context.Context, *QueryParticipantsWithBalancesRequest) (*QueryParticipantsWithBalancesResponse, error)
 	// Queries PoC validation snapshot for deterministic sampling synchronization.
 	PoCValidationSnapshot(context.Context, *QueryPoCValidationSnapshotRequest) (*QueryPoCValidationSnapshotResponse, error)
+	// Queries the stake delegated to a participant.
+	ListParticipantDelegations(context.Context, *QueryParticipantDelegationsRequest) (*QueryParticipantDelegationsResponse, error)
+	// Queries the undelegated stake of a delegator waiting for the settlement of the epochs it weighted.
+	ListDelegationUnbondings(context.Context, *QueryDelegationUnbondingsRequest) (*QueryDelegationUnbondingsResponse, error)
+	// Queries the settle amounts a participant can still claim, including those carried over.
+	ListClaimableSettleAmounts(context.Context, *QueryClaimableSettleAmountsRequest) (*QueryClaimableSettleAmountsResponse, error)
+	// Runs model assignment and PoC slot allocation read-only against the current hardware nodes and governance models.
+	SimulateModelAssignment(context.Context, *QuerySimulateModelAssignmentRequest) (*QuerySimulateModelAssignmentResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -10620,8 +11082,20 @@ func (*UnimplementedQueryServer) ParticipantsWithBalances(ctx context.Context, r
 func (*UnimplementedQueryServer) PoCValidationSnapshot(ctx context.Context, req *QueryPoCValidationSnapshotRequest) (*QueryPoCValidationSnapshotResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoCValidationSnapshot not implemented")
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+func (*UnimplementedQueryServer) ListParticipantDelegations(ctx context.Context, req *QueryParticipantDelegationsRequest) (*QueryParticipantDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParticipantDelegations not implemented")
+}
+func (*UnimplementedQueryServer) ListDelegationUnbondings(ctx context.Context, req *QueryDelegationUnbondingsRequest) (*QueryDelegationUnbondingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegationUnbondings not implemented")
+}
+func (*UnimplementedQueryServer) ListClaimableSettleAmounts(ctx context.Context, req *QueryClaimableSettleAmountsRequest) (*QueryClaimableSettleAmountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimableSettleAmounts not implemented")
+}
+func (*UnimplementedQueryServer) SimulateModelAssignment(ctx context.Context, req *QuerySimulateModelAssignmentRequest) (*QuerySimulateModelAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateModelAssignment not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
@@ -12101,6 +12575,78 @@ func _Query_PoCValidationSnapshot_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListParticipantDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipantDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListParticipantDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/ListParticipantDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListParticipantDelegations(ctx, req.(*QueryParticipantDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDelegationUnbondings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationUnbondingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDelegationUnbondings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/ListDelegationUnbondings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDelegationUnbondings(ctx, req.(*QueryDelegationUnbondingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimableSettleAmounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableSettleAmountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimableSettleAmounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/ListClaimableSettleAmounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimableSettleAmounts(ctx, req.(*QueryClaimableSettleAmountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateModelAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateModelAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateModelAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Query/SimulateModelAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateModelAssignment(ctx, req.(*QuerySimulateModelAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inference.inference.Query",
@@ -12434,6 +12980,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PoCValidationSnapshot",
 			Handler:    _Query_PoCValidationSnapshot_Handler,
 		},
+		{
+			MethodName: "ListParticipantDelegations",
+			Handler:    _Query_ListParticipantDelegations_Handler,
+		},
+		{
+			MethodName: "ListDelegationUnbondings",
+			Handler:    _Query_ListDelegationUnbondings_Handler,
+		},
+		{
+			MethodName: "ListClaimableSettleAmounts",
+			Handler:    _Query_ListClaimableSettleAmounts_Handler,
+		},
+		{
+			MethodName: "SimulateModelAssignment",
+			Handler:    _Query_SimulateModelAssignment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inference/inference/query.proto",
@@ -18821,141 +19383,422 @@ func (m *QueryPoCValidationSnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParticipantDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryParticipantDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetInferenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParticipantDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParticipantDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Inference.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllInferenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryParticipantDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParticipantDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Inference) > 0 {
-		for _, e := range m.Inference {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Delegations.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetParticipantRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegationUnbondingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationUnbondingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationUnbondingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegationUnbondingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Participant.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllParticipantRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegationUnbondingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationUnbondingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimableSettleAmountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableSettleAmountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableSettleAmountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Participant) > 0 {
-		for _, e := range m.Participant {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableSettleAmountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableSettleAmountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableSettleAmountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettleAmounts) > 0 {
+		for iNdEx := len(m.SettleAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettleAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateModelAssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateModelAssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateModelAssignmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateModelAssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateModelAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateModelAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for iNdEx := len(m.Participants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Participants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.UpcomingEpochIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpcomingEpochIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetInferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inference.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllInferenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllInferenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inference) > 0 {
+		for _, e := range m.Inference {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Participant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllParticipantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participant) > 0 {
+		for _, e := range m.Participant {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
@@ -21445,11 +22288,129 @@ func (m *QueryPoCValidationSnapshotResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryParticipantDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryParticipantDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegations.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryDelegationUnbondingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationUnbondingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableSettleAmountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableSettleAmountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SettleAmounts) > 0 {
+		for _, e := range m.SettleAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateModelAssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateModelAssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpcomingEpochIndex != 0 {
+		n += 1 + sovQuery(uint64(m.UpcomingEpochIndex))
+	}
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -37741,6 +38702,739 @@ func (m *QueryPoCValidationSnapshotResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParticipantDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipantDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipantDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipantDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationUnbondingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationUnbondingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationUnbondingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationUnbondingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationUnbondingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationUnbondingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, DelegationUnbonding{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableSettleAmountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableSettleAmountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableSettleAmountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableSettleAmountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableSettleAmountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableSettleAmountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettleAmounts = append(m.SettleAmounts, SettleAmount{})
+			if err := m.SettleAmounts[len(m.SettleAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateModelAssignmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateModelAssignmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateModelAssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateModelAssignmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateModelAssignmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateModelAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingEpochIndex", wireType)
+			}
+			m.UpcomingEpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpcomingEpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &ActiveParticipant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,65 @@
+package types
+
+import "fmt"
+
+// RewardVestingSchedule vests the reward coins of claimed settlements: ImmediatePercent of the reward is
+// paid with the claim, the remainder is released linearly over VestingEpochs epochs, starting with the
+// epoch after the claim. Released coins are paid out with the participant's next claim.
+// While the schedule is disabled, rewards follow TokenomicsParams.RewardVestingPeriod.
+type RewardVestingSchedule struct {
+	ImmediatePercent uint32 `json:"immediate_percent"`
+	// VestingEpochs is the number of epochs the remainder vests over; 0 disables the schedule
+	VestingEpochs uint64 `json:"vesting_epochs"`
+}
+
+func DefaultRewardVestingSchedule() RewardVestingSchedule {
+	return RewardVestingSchedule{ImmediatePercent: 100, VestingEpochs: 0}
+}
+
+func (s RewardVestingSchedule) Validate() error {
+	if s.ImmediatePercent > 100 {
+		return fmt.Errorf("immediate_percent must be at most 100, got %d", s.ImmediatePercent)
+	}
+	return nil
+}
+
+func (s RewardVestingSchedule) Enabled() bool {
+	return s.VestingEpochs > 0 && s.ImmediatePercent < 100
+}
+
+// Split returns the part of a reward paid immediately and the part that vests
+func (s RewardVestingSchedule) Split(reward uint64) (immediate uint64, vested uint64) {
+	if !s.Enabled() {
+		return reward, 0
+	}
+	percent := uint64(s.ImmediatePercent)
+	immediate = reward/100*percent + reward%100*percent/100
+	return immediate, reward - immediate
+}
+
+// VestedAt returns how much of the tranche has vested by the given epoch, all of it after its last epoch
+func (t RewardVestingTranche) VestedAt(epochIndex uint64) uint64 {
+	if epochIndex < t.StartEpoch || t.Epochs == 0 {
+		return 0
+	}
+	n := min(epochIndex-t.StartEpoch+1, t.Epochs)
+	return t.Total/t.Epochs*n + t.Total%t.Epochs*n/t.Epochs
+}
+
+// Releasable returns what has vested by the given epoch and was not released yet
+func (a RewardVestingAccount) Releasable(epochIndex uint64) uint64 {
+	var total uint64
+	for _, t := range a.Tranches {
+		total += t.VestedAt(epochIndex) - t.Released
+	}
+	return total
+}
+
+// Locked returns what is still vesting
+func (a RewardVestingAccount) Locked() uint64 {
+	var total uint64
+	for _, t := range a.Tranches {
+		total += t.Total - t.Released
+	}
+	return total
+}
@@ -0,0 +1,686 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: inference/inference/reward_vesting.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RewardVestingTranche is the vesting part of the reward of one settled epoch
+type RewardVestingTranche struct {
+	SettleEpoch uint64 `protobuf:"varint,1,opt,name=settle_epoch,json=settleEpoch,proto3" json:"settle_epoch,omitempty"`
+	// start_epoch is the first epoch in which a part of the tranche is released
+	StartEpoch uint64 `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	Epochs     uint64 `protobuf:"varint,3,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	Total      uint64 `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Released   uint64 `protobuf:"varint,5,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *RewardVestingTranche) Reset()         { *m = RewardVestingTranche{} }
+func (m *RewardVestingTranche) String() string { return proto.CompactTextString(m) }
+func (*RewardVestingTranche) ProtoMessage()    {}
+func (*RewardVestingTranche) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fedd321deb83e3f, []int{0}
+}
+func (m *RewardVestingTranche) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVestingTranche) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVestingTranche.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVestingTranche) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVestingTranche.Merge(m, src)
+}
+func (m *RewardVestingTranche) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVestingTranche) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVestingTranche.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVestingTranche proto.InternalMessageInfo
+
+func (m *RewardVestingTranche) GetSettleEpoch() uint64 {
+	if m != nil {
+		return m.SettleEpoch
+	}
+	return 0
+}
+
+func (m *RewardVestingTranche) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *RewardVestingTranche) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *RewardVestingTranche) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *RewardVestingTranche) GetReleased() uint64 {
+	if m != nil {
+		return m.Released
+	}
+	return 0
+}
+
+// RewardVestingAccount holds the reward tranches of a participant that are not fully released yet
+type RewardVestingAccount struct {
+	Participant string                 `protobuf:"bytes,1,opt,name=participant,proto3" json:"participant,omitempty"`
+	Tranches    []RewardVestingTranche `protobuf:"bytes,2,rep,name=tranches,proto3" json:"tranches"`
+}
+
+func (m *RewardVestingAccount) Reset()         { *m = RewardVestingAccount{} }
+func (m *RewardVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*RewardVestingAccount) ProtoMessage()    {}
+func (*RewardVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9fedd321deb83e3f, []int{1}
+}
+func (m *RewardVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVestingAccount.Merge(m, src)
+}
+func (m *RewardVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardVestingAccount proto.InternalMessageInfo
+
+func (m *RewardVestingAccount) GetParticipant() string {
+	if m != nil {
+		return m.Participant
+	}
+	return ""
+}
+
+func (m *RewardVestingAccount) GetTranches() []RewardVestingTranche {
+	if m != nil {
+		return m.Tranches
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RewardVestingTranche)(nil), "inference.inference.RewardVestingTranche")
+	proto.RegisterType((*RewardVestingAccount)(nil), "inference.inference.RewardVestingAccount")
+}
+
+func init() {
+	proto.RegisterFile("inference/inference/reward_vesting.proto", fileDescriptor_9fedd321deb83e3f)
+}
+
+var fileDescriptor_9fedd321deb83e3f = []byte{
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xbb, 0x4e, 0x42, 0x31,
+	0x18, 0x3e, 0x87, 0x5b, 0xb0, 0xc7, 0xa9, 0x12, 0x73, 0xc2, 0x50, 0x90, 0x09, 0x97, 0x43, 0xa2,
+	0xf1, 0x01, 0x24, 0x71, 0x72, 0x30, 0x39, 0x31, 0x0e, 0x2e, 0xa4, 0x94, 0xdf, 0x43, 0x13, 0x6c,
+	0x9b, 0xf6, 0xc7, 0xcb, 0x03, 0xb8, 0xfb, 0x10, 0x3e, 0x0c, 0x23, 0xa3, 0x93, 0x31, 0xf0, 0x22,
+	0x86, 0x96, 0x1c, 0x09, 0x61, 0xfb, 0x6e, 0xf9, 0xf2, 0x5f, 0x48, 0x5f, 0xaa, 0x27, 0xb0, 0xa0,
+	0x04, 0x0c, 0xfe, 0x91, 0x85, 0x57, 0x6e, 0x27, 0xa3, 0x17, 0x70, 0x28, 0x55, 0x91, 0x19, 0xab,
+	0x51, 0xd3, 0x93, 0xd2, 0xcf, 0x4a, 0xd4, 0x6e, 0x15, 0xba, 0xd0, 0xde, 0x1f, 0x6c, 0x50, 0x88,
+	0xf6, 0xbe, 0x62, 0xd2, 0xca, 0x7d, 0xc7, 0x43, 0xa8, 0xb8, 0xb7, 0x5c, 0x89, 0x29, 0xd0, 0x33,
+	0x72, 0xec, 0x00, 0x71, 0x06, 0x23, 0x30, 0x5a, 0x4c, 0xd3, 0xb8, 0x1b, 0xf7, 0x6b, 0x79, 0x12,
+	0xb4, 0x9b, 0x8d, 0x44, 0x3b, 0x24, 0x71, 0xc8, 0x2d, 0x6e, 0x13, 0x15, 0x9f, 0x20, 0x5e, 0x0a,
+	0x81, 0x53, 0xd2, 0xf0, 0x96, 0x4b, 0xab, 0xde, 0xdb, 0x32, 0xda, 0x22, 0x75, 0xd4, 0xc8, 0x67,
+	0x69, 0xcd, 0xcb, 0x81, 0xd0, 0x36, 0x69, 0x5a, 0x98, 0x01, 0x77, 0x30, 0x49, 0xeb, 0xde, 0x28,
+	0x79, 0xef, 0x63, 0x7f, 0xcc, 0x6b, 0x21, 0xf4, 0x5c, 0x21, 0xed, 0x92, 0xc4, 0x70, 0x8b, 0x52,
+	0x48, 0xc3, 0x15, 0xfa, 0x29, 0x8f, 0xf2, 0x5d, 0x89, 0xde, 0x92, 0x26, 0x86, 0x9d, 0x5c, 0x5a,
+	0xe9, 0x56, 0xfb, 0xc9, 0xc5, 0x79, 0x76, 0xe0, 0x3e, 0xd9, 0xa1, 0x2b, 0x0c, 0x6b, 0x8b, 0x9f,
+	0x4e, 0x94, 0x97, 0x05, 0xc3, 0xbb, 0xc5, 0x8a, 0xc5, 0xcb, 0x15, 0x8b, 0x7f, 0x57, 0x2c, 0xfe,
+	0x5c, 0xb3, 0x68, 0xb9, 0x66, 0xd1, 0xf7, 0x9a, 0x45, 0x8f, 0x57, 0x85, 0xc4, 0xe9, 0x7c, 0x9c,
+	0x09, 0xfd, 0x3c, 0x30, 0x56, 0x4f, 0xe6, 0x02, 0x9d, 0x90, 0x7b, 0xdf, 0x7a, 0xdb, 0xc1, 0xf8,
+	0x6e, 0xc0, 0x8d, 0x1b, 0xfe, 0x0d, 0x97, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x49, 0x19, 0xa0,
+	0x6c, 0xdd, 0x01, 0x00, 0x00,
+}
+
+func (m *RewardVestingTranche) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardVestingTranche) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardVestingTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Released != 0 {
+		i = encodeVarintRewardVesting(dAtA, i, uint64(m.Released))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Total != 0 {
+		i = encodeVarintRewardVesting(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epochs != 0 {
+		i = encodeVarintRewardVesting(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartEpoch != 0 {
+		i = encodeVarintRewardVesting(dAtA, i, uint64(m.StartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SettleEpoch != 0 {
+		i = encodeVarintRewardVesting(dAtA, i, uint64(m.SettleEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tranches) > 0 {
+		for iNdEx := len(m.Tranches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tranches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewardVesting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintRewardVesting(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewardVesting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewardVesting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardVestingTranche) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SettleEpoch != 0 {
+		n += 1 + sovRewardVesting(uint64(m.SettleEpoch))
+	}
+	if m.StartEpoch != 0 {
+		n += 1 + sovRewardVesting(uint64(m.StartEpoch))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovRewardVesting(uint64(m.Epochs))
+	}
+	if m.Total != 0 {
+		n += 1 + sovRewardVesting(uint64(m.Total))
+	}
+	if m.Released != 0 {
+		n += 1 + sovRewardVesting(uint64(m.Released))
+	}
+	return n
+}
+
+func (m *RewardVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovRewardVesting(uint64(l))
+	}
+	if len(m.Tranches) > 0 {
+		for _, e := range m.Tranches {
+			l = e.Size()
+			n += 1 + l + sovRewardVesting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRewardVesting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewardVesting(x uint64) (n int) {
+	return sovRewardVesting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RewardVestingTranche) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardVestingTranche: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardVestingTranche: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleEpoch", wireType)
+			}
+			m.SettleEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettleEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartEpoch", wireType)
+			}
+			m.StartEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			m.Released = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Released |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewardVesting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tranches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tranches = append(m.Tranches, RewardVestingTranche{})
+			if err := m.Tranches[len(m.Tranches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewardVesting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewardVesting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRewardVesting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRewardVesting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRewardVesting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRewardVesting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRewardVesting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRewardVesting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRewardVesting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRewardVesting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRewardVesting = fmt.Errorf("proto: unexpected end of group")
+)
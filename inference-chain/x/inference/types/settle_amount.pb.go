@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -29,6 +31,8 @@ type SettleAmount struct {
 	EpochIndex       uint64 `protobuf:"varint,4,opt,name=epoch_index,json=epochIndex,proto3" json:"epoch_index,omitempty"`
 	SeedSignature    string `protobuf:"bytes,5,opt,name=seed_signature,json=seedSignature,proto3" json:"seed_signature,omitempty"`
 	LastClaimAttempt int64  `protobuf:"varint,6,opt,name=last_claim_attempt,json=lastClaimAttempt,proto3" json:"last_claim_attempt,omitempty"`
+	// denom_work_coins is the work paid for in registered denoms, paid out in those denoms
+	DenomWorkCoins []types.Coin `protobuf:"bytes,7,rep,name=denom_work_coins,json=denomWorkCoins,proto3" json:"denom_work_coins"`
 }
 
 func (m *SettleAmount) Reset()         { *m = SettleAmount{} }
@@ -106,6 +110,13 @@ func (m *SettleAmount) GetLastClaimAttempt() int64 {
 	return 0
 }
 
+func (m *SettleAmount) GetDenomWorkCoins() []types.Coin {
+	if m != nil {
+		return m.DenomWorkCoins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SettleAmount)(nil), "inference.inference.SettleAmount")
 }
@@ -115,25 +126,30 @@ func init() {
 }
 
 var fileDescriptor_b05d6309beaf448e = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0xd0, 0xb1, 0x4e, 0x42, 0x31,
-	0x14, 0x06, 0x60, 0x2a, 0x48, 0x42, 0x41, 0x63, 0xea, 0x72, 0x17, 0xeb, 0xd5, 0xc4, 0xc8, 0x60,
-	0x60, 0x30, 0x3e, 0x00, 0x32, 0x39, 0x99, 0xc0, 0xe6, 0xd2, 0x94, 0xde, 0x23, 0x34, 0x72, 0xdb,
-	0xa6, 0x3d, 0x37, 0xe0, 0x5b, 0xf8, 0x58, 0x8e, 0x8c, 0x8e, 0x06, 0x16, 0x1f, 0xc3, 0xb4, 0x28,
-	0x12, 0xb7, 0x3f, 0xdf, 0xff, 0x0f, 0x27, 0x87, 0x5e, 0x6b, 0xf3, 0x0c, 0x1e, 0x8c, 0x82, 0xfe,
-	0x5f, 0x0a, 0x80, 0x38, 0x07, 0x21, 0x4b, 0x5b, 0x19, 0xec, 0x39, 0x6f, 0xd1, 0xb2, 0xd3, 0x5d,
-	0xdd, 0xdb, 0xa5, 0xcb, 0x2f, 0x42, 0x3b, 0xe3, 0x34, 0x1e, 0xa4, 0x2d, 0xcb, 0x69, 0xdb, 0x49,
-	0x8f, 0x5a, 0x69, 0x27, 0x0d, 0x66, 0x24, 0x27, 0xdd, 0xd6, 0x68, 0x9f, 0xd8, 0x05, 0xed, 0x78,
-	0x58, 0x48, 0x5f, 0x08, 0x65, 0xb5, 0x09, 0xd9, 0x41, 0x4e, 0xba, 0x8d, 0x51, 0x7b, 0x6b, 0xc3,
-	0x48, 0xec, 0x8c, 0xd2, 0x85, 0xf5, 0x2f, 0x3f, 0x83, 0x7a, 0x1a, 0xb4, 0xa2, 0x6c, 0xeb, 0x73,
-	0xda, 0x06, 0x67, 0xd5, 0x4c, 0x68, 0x53, 0xc0, 0x32, 0x6b, 0xa4, 0x9e, 0x26, 0x7a, 0x88, 0xc2,
-	0xae, 0xe8, 0x71, 0x00, 0x28, 0x44, 0xd0, 0x53, 0x23, 0xb1, 0xf2, 0x90, 0x1d, 0xa6, 0x3b, 0x8e,
-	0xa2, 0x8e, 0x7f, 0x91, 0xdd, 0x50, 0x36, 0x97, 0x01, 0x85, 0x9a, 0x4b, 0x5d, 0x0a, 0x89, 0x08,
-	0xa5, 0xc3, 0xac, 0x99, 0x93, 0x6e, 0x7d, 0x74, 0x12, 0x9b, 0x61, 0x2c, 0x06, 0x5b, 0xbf, 0x7f,
-	0x7c, 0x5f, 0x73, 0xb2, 0x5a, 0x73, 0xf2, 0xb9, 0xe6, 0xe4, 0x6d, 0xc3, 0x6b, 0xab, 0x0d, 0xaf,
-	0x7d, 0x6c, 0x78, 0xed, 0xe9, 0x6e, 0xaa, 0x71, 0x56, 0x4d, 0x7a, 0xca, 0x96, 0x7d, 0xe7, 0x6d,
-	0x51, 0x29, 0x0c, 0x4a, 0xff, 0x7b, 0xe9, 0x72, 0x2f, 0xe3, 0xab, 0x83, 0x30, 0x69, 0xa6, 0xbf,
-	0xde, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0xc9, 0xe0, 0x6b, 0x7f, 0x82, 0x01, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xc1, 0xce, 0xd2, 0x40,
+	0x10, 0xc7, 0x5b, 0x40, 0x0c, 0x5b, 0x24, 0x64, 0xf5, 0x50, 0x49, 0x2c, 0xd5, 0xc4, 0xd8, 0x83,
+	0x69, 0x83, 0xc6, 0x07, 0x00, 0x4e, 0x9c, 0x4c, 0xca, 0xc1, 0xc4, 0x4b, 0xb3, 0xdd, 0x8e, 0x65,
+	0x23, 0xdd, 0x6d, 0x76, 0xb7, 0x82, 0x6f, 0xe1, 0xbb, 0xf8, 0x12, 0x1c, 0x39, 0x7a, 0x32, 0x06,
+	0x5e, 0xc4, 0xec, 0x16, 0xf8, 0xc8, 0x77, 0xfb, 0xe7, 0xf7, 0x9b, 0x76, 0x66, 0x67, 0xd0, 0x3b,
+	0xc6, 0xbf, 0x81, 0x04, 0x4e, 0x21, 0x79, 0x48, 0x0a, 0xb4, 0xde, 0x42, 0x46, 0x2a, 0xd1, 0x70,
+	0x1d, 0xd7, 0x52, 0x68, 0x81, 0x9f, 0xdf, 0x74, 0x7c, 0x4b, 0x93, 0x17, 0xa5, 0x28, 0x85, 0xf5,
+	0x89, 0x49, 0x6d, 0xe9, 0x24, 0xa0, 0x42, 0x55, 0x42, 0x25, 0x39, 0x51, 0x90, 0xfc, 0x98, 0xe5,
+	0xa0, 0xc9, 0x2c, 0xa1, 0x82, 0xf1, 0xd6, 0xbf, 0xf9, 0xdd, 0x41, 0xc3, 0xb5, 0x6d, 0x31, 0xb7,
+	0x1d, 0x70, 0x88, 0xbc, 0x9a, 0x48, 0xcd, 0x28, 0xab, 0x09, 0xd7, 0xbe, 0x1b, 0xba, 0xd1, 0x20,
+	0xbd, 0x47, 0xf8, 0x35, 0x1a, 0x4a, 0xd8, 0x11, 0x59, 0x64, 0xe6, 0x3f, 0xca, 0xef, 0x84, 0x6e,
+	0xd4, 0x4b, 0xbd, 0x96, 0x2d, 0x0d, 0xc2, 0xaf, 0x10, 0xda, 0x09, 0xf9, 0xfd, 0x52, 0xd0, 0xb5,
+	0x05, 0x03, 0x43, 0x5a, 0x3d, 0x45, 0x1e, 0xd4, 0x82, 0x6e, 0x32, 0xc6, 0x0b, 0xd8, 0xfb, 0x3d,
+	0xeb, 0x91, 0x45, 0x2b, 0x43, 0xf0, 0x5b, 0x34, 0x52, 0x00, 0x45, 0xa6, 0x58, 0xc9, 0x89, 0x6e,
+	0x24, 0xf8, 0x4f, 0xec, 0x1c, 0xcf, 0x0c, 0x5d, 0x5f, 0x21, 0x7e, 0x8f, 0xf0, 0x96, 0x28, 0x9d,
+	0xd1, 0x2d, 0x61, 0x55, 0x46, 0xb4, 0x86, 0xaa, 0xd6, 0x7e, 0x3f, 0x74, 0xa3, 0x6e, 0x3a, 0x36,
+	0x66, 0x69, 0xc4, 0xbc, 0xe5, 0x78, 0x85, 0xc6, 0x05, 0x70, 0x51, 0x65, 0x77, 0xa3, 0x3d, 0x0d,
+	0xbb, 0x91, 0xf7, 0xe1, 0x65, 0xdc, 0x6e, 0x29, 0x36, 0x5b, 0x8a, 0x2f, 0x5b, 0x8a, 0xcd, 0xac,
+	0x8b, 0xde, 0xe1, 0xef, 0xd4, 0x49, 0x47, 0xf6, 0xc3, 0x2f, 0xd7, 0x07, 0x2c, 0x3e, 0x1f, 0x4e,
+	0x81, 0x7b, 0x3c, 0x05, 0xee, 0xbf, 0x53, 0xe0, 0xfe, 0x3a, 0x07, 0xce, 0xf1, 0x1c, 0x38, 0x7f,
+	0xce, 0x81, 0xf3, 0xf5, 0x53, 0xc9, 0xf4, 0xa6, 0xc9, 0x63, 0x2a, 0xaa, 0xa4, 0x96, 0xa2, 0x68,
+	0xa8, 0x56, 0x94, 0x3d, 0xba, 0xe9, 0xfe, 0x2e, 0xeb, 0x9f, 0x35, 0xa8, 0xbc, 0x6f, 0xaf, 0xf1,
+	0xf1, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0xd2, 0x0c, 0x7a, 0x03, 0x02, 0x00, 0x00,
 }
 
 func (m *SettleAmount) Marshal() (dAtA []byte, err error) {
@@ -156,6 +172,20 @@ func (m *SettleAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomWorkCoins) > 0 {
+		for iNdEx := len(m.DenomWorkCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomWorkCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSettleAmount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.LastClaimAttempt != 0 {
 		i = encodeVarintSettleAmount(dAtA, i, uint64(m.LastClaimAttempt))
 		i--
@@ -230,6 +260,12 @@ func (m *SettleAmount) Size() (n int) {
 	if m.LastClaimAttempt != 0 {
 		n += 1 + sovSettleAmount(uint64(m.LastClaimAttempt))
 	}
+	if len(m.DenomWorkCoins) > 0 {
+		for _, e := range m.DenomWorkCoins {
+			l = e.Size()
+			n += 1 + l + sovSettleAmount(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -408,6 +444,40 @@ func (m *SettleAmount) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomWorkCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSettleAmount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSettleAmount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomWorkCoins = append(m.DenomWorkCoins, types.Coin{})
+			if err := m.DenomWorkCoins[len(m.DenomWorkCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSettleAmount(dAtA[iNdEx:])
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// SlashingPolicy escalates repeated offenses into weight slashing and jail at epoch group formation.
+// Offenses are the ExcludedParticipant entries recorded when a participant turns INVALID (failed
+// validations) or INACTIVE (downtime, failed confirmation PoC), at most one per epoch.
+type SlashingPolicy struct {
+	// EvidenceWindowEpochs is how many past epochs of offenses are counted; 0 disables the policy
+	EvidenceWindowEpochs uint64 `json:"evidence_window_epochs"`
+	// WeightSlashPerOffense is the fraction of weight removed per offense in the window, as a decimal string
+	WeightSlashPerOffense string `json:"weight_slash_per_offense"`
+	// JailThreshold is the number of offenses in the window that jails a participant; 0 never jails
+	JailThreshold uint64 `json:"jail_threshold"`
+	// JailEpochs is the number of epochs a jailed participant is left out of epoch groups
+	JailEpochs uint64 `json:"jail_epochs"`
+}
+
+// DefaultSlashingPolicy is disabled: slashing and jail apply only once governance selects a policy
+func DefaultSlashingPolicy() SlashingPolicy {
+	return SlashingPolicy{
+		EvidenceWindowEpochs:  0,
+		WeightSlashPerOffense: "0",
+	}
+}
+
+func (p SlashingPolicy) Validate() error {
+	fraction, err := decimal.NewFromString(p.WeightSlashPerOffense)
+	if err != nil {
+		return fmt.Errorf("invalid weight_slash_per_offense %q: %w", p.WeightSlashPerOffense, err)
+	}
+	if fraction.IsNegative() || fraction.GreaterThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("weight_slash_per_offense must be between 0 and 1, got %s", fraction)
+	}
+	if p.JailThreshold > 0 && p.JailEpochs == 0 {
+		return fmt.Errorf("jail_epochs must be set when jail_threshold is set")
+	}
+	if p.JailThreshold > p.EvidenceWindowEpochs {
+		return fmt.Errorf("jail_threshold %d can never be reached in an evidence window of %d epochs", p.JailThreshold, p.EvidenceWindowEpochs)
+	}
+	return nil
+}
+
+// WeightSlashFraction is the fraction of weight removed for the given number of offenses, at most 1
+func (p SlashingPolicy) WeightSlashFraction(offenses uint64) decimal.Decimal {
+	perOffense, err := decimal.NewFromString(p.WeightSlashPerOffense)
+	if err != nil {
+		return decimal.Zero
+	}
+	return decimal.Min(perOffense.Mul(decimal.NewFromInt(int64(offenses))), decimal.NewFromInt(1))
+}
+
+// JailRecord is the latest jail term of a participant. It is kept after release so offenses from
+// before the release are not counted again.
+type JailRecord struct {
+	Address       string `json:"address"`
+	JailedAtEpoch uint64 `json:"jailed_at_epoch"`
+	// ReleaseEpoch is the first epoch the participant can join epoch groups again
+	ReleaseEpoch uint64 `json:"release_epoch"`
+	Offenses     uint64 `json:"offenses"`
+}
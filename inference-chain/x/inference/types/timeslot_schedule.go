@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Positions of the built-in timeslots in MLNodeInfo.TimeslotAllocation
+const (
+	PrePocSlotIndex = int(TimeslotType_PRE_POC_SLOT)
+	PocSlotIndex    = int(TimeslotType_POC_SLOT)
+)
+
+// TimeslotSchedule defines the timeslots every ML node is allocated for in an epoch, and with it the
+// length and meaning of MLNodeInfo.TimeslotAllocation. The first two timeslots are always
+// PRE_POC_SLOT and POC_SLOT. Additional timeslots (e.g. training windows, cooldown) are allocated by
+// the ModelAssigner with the same weight-balancing round robin as POC_SLOT.
+type TimeslotSchedule struct {
+	Timeslots []TimeslotSpec `json:"timeslots"`
+}
+
+type TimeslotSpec struct {
+	Name string `json:"name"`
+	// Default is the allocation of every node before balancing
+	Default bool `json:"default"`
+	// AllocationFraction is the share of each model's weight to allocate to this timeslot, as a decimal
+	// string. Not used for PRE_POC_SLOT and POC_SLOT; POC_SLOT uses EpochParams.PocSlotAllocation.
+	AllocationFraction string `json:"allocation_fraction,omitempty"`
+}
+
+// DefaultTimeslotSchedule is the [PRE_POC_SLOT, POC_SLOT] schedule
+func DefaultTimeslotSchedule() TimeslotSchedule {
+	return TimeslotSchedule{
+		Timeslots: []TimeslotSpec{
+			{Name: TimeslotType_PRE_POC_SLOT.String(), Default: true},
+			{Name: TimeslotType_POC_SLOT.String(), Default: false},
+		},
+	}
+}
+
+func (s TimeslotSchedule) Validate() error {
+	if len(s.Timeslots) < 2 {
+		return fmt.Errorf("timeslot schedule must contain at least %s and %s", TimeslotType_PRE_POC_SLOT, TimeslotType_POC_SLOT)
+	}
+	if s.Timeslots[PrePocSlotIndex].Name != TimeslotType_PRE_POC_SLOT.String() {
+		return fmt.Errorf("timeslot %d must be %s, got %q", PrePocSlotIndex, TimeslotType_PRE_POC_SLOT, s.Timeslots[PrePocSlotIndex].Name)
+	}
+	if s.Timeslots[PocSlotIndex].Name != TimeslotType_POC_SLOT.String() {
+		return fmt.Errorf("timeslot %d must be %s, got %q", PocSlotIndex, TimeslotType_POC_SLOT, s.Timeslots[PocSlotIndex].Name)
+	}
+
+	names := make(map[string]bool, len(s.Timeslots))
+	for i, slot := range s.Timeslots {
+		if slot.Name == "" {
+			return fmt.Errorf("timeslot %d has no name", i)
+		}
+		if names[slot.Name] {
+			return fmt.Errorf("duplicate timeslot name %q", slot.Name)
+		}
+		names[slot.Name] = true
+
+		if i <= PocSlotIndex {
+			continue
+		}
+		if _, err := slot.Fraction(); err != nil {
+			return fmt.Errorf("timeslot %q: %w", slot.Name, err)
+		}
+	}
+	return nil
+}
+
+// Fraction parses AllocationFraction; an empty value means nothing is allocated
+func (s TimeslotSpec) Fraction() (decimal.Decimal, error) {
+	if s.AllocationFraction == "" {
+		return decimal.Zero, nil
+	}
+	fraction, err := decimal.NewFromString(s.AllocationFraction)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid allocation fraction %q: %w", s.AllocationFraction, err)
+	}
+	if fraction.IsNegative() || fraction.GreaterThan(decimal.NewFromInt(1)) {
+		return decimal.Zero, fmt.Errorf("allocation fraction %s must be between 0 and 1", fraction)
+	}
+	return fraction, nil
+}
+
+// InitialAllocation returns a new TimeslotAllocation with every timeslot set to its default
+func (s TimeslotSchedule) InitialAllocation() []bool {
+	allocation := make([]bool, len(s.Timeslots))
+	for i, slot := range s.Timeslots {
+		allocation[i] = slot.Default
+	}
+	return allocation
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TopRewardDisbursement is one top-reward payout made to a miner
+type TopRewardDisbursement struct {
+	Height int64 `json:"height"`
+	// Time is the block time (unix seconds) of the payout
+	Time         int64 `json:"time"`
+	Amount       int64 `json:"amount"`
+	PayoutNumber int32 `json:"payout_number"`
+}
+
+// TopRewardProgress is a top miner's progress through the top-reward program, refreshed every time
+// top-reward payouts are evaluated (at the end of PoC validation).
+type TopRewardProgress struct {
+	Miner           string `json:"miner"`
+	PayoutsReceived int32  `json:"payouts_received"`
+	PaidAmount      int64  `json:"paid_amount"`
+	// RemainingPayouts is how many payouts the miner can still receive, bounded by both the per-miner
+	// and the program-wide payout limits; 0 once the miner is disqualified or the program ended
+	RemainingPayouts int32 `json:"remaining_payouts"`
+	RemainingAmount  int64 `json:"remaining_amount"`
+	// NextPayoutTime is the block time after which the next payout is due, 0 if none is expected
+	NextPayoutTime int64 `json:"next_payout_time"`
+	// NextPayoutHeight estimates the height at which the next payout is evaluated from the pace of the
+	// previous evaluations, 0 if none is expected or there is no pace yet
+	NextPayoutHeight int64                   `json:"next_payout_height"`
+	UpdatedHeight    int64                   `json:"updated_height"`
+	Disbursements    []TopRewardDisbursement `json:"disbursements"`
+}
+
+// TopRewardProgramState remembers the last evaluation of top-reward payouts to estimate the next ones
+type TopRewardProgramState struct {
+	LastEvaluationHeight int64 `json:"last_evaluation_height"`
+	LastEvaluationTime   int64 `json:"last_evaluation_time"`
+}
+
+// TopRewardProgressFullKey returns the store key of a miner's top-reward progress, for raw store queries
+func TopRewardProgressFullKey(miner sdk.AccAddress) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(TopRewardProgressPrefix, sdk.AccAddressKey, miner)
+}
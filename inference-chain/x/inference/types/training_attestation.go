@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// TrainingAttestationPolicy configures verification of finished training tasks: a sample of
+// participants outside the task re-runs an evaluation slice of the trained checkpoint and attests the result.
+type TrainingAttestationPolicy struct {
+	// Attesters is how many participants are sampled, weighted by epoch group weight; 0 disables verification
+	Attesters uint32 `json:"attesters"`
+	// EvalSamples is the size of the evaluation slice each attester re-runs
+	EvalSamples uint32 `json:"eval_samples"`
+	// MaxScoreDeviation is how far, as a decimal string, an attester's score may be from the claimed one to pass
+	MaxScoreDeviation string `json:"max_score_deviation"`
+}
+
+func DefaultTrainingAttestationPolicy() TrainingAttestationPolicy {
+	return TrainingAttestationPolicy{
+		Attesters:         5,
+		EvalSamples:       64,
+		MaxScoreDeviation: "0.05",
+	}
+}
+
+func (p TrainingAttestationPolicy) Validate() error {
+	if p.Attesters > 0 && p.EvalSamples == 0 {
+		return fmt.Errorf("eval_samples must be set when attesters is set")
+	}
+	deviation, err := decimal.NewFromString(p.MaxScoreDeviation)
+	if err != nil {
+		return fmt.Errorf("invalid max_score_deviation %q: %w", p.MaxScoreDeviation, err)
+	}
+	if deviation.IsNegative() {
+		return fmt.Errorf("max_score_deviation must not be negative, got %s", deviation)
+	}
+	return nil
+}
+
+// ScoreMatches reports whether a measured evaluation score is close enough to the claimed one
+func (p TrainingAttestationPolicy) ScoreMatches(claimed decimal.Decimal, measured decimal.Decimal) bool {
+	deviation, err := decimal.NewFromString(p.MaxScoreDeviation)
+	if err != nil {
+		return false
+	}
+	return measured.Sub(claimed).Abs().LessThanOrEqual(deviation)
+}
+
+// Reserved keys of MsgSubmitTrainingKvRecord that drive the verification, which has no messages of its own
+const (
+	// TrainingCheckpointRecordKey is submitted by an executor of the task once training finished, with a
+	// JSON TrainingCheckpointReport as value, and starts the verification
+	TrainingCheckpointRecordKey = "verification/checkpoint"
+	// TrainingAttestationRecordKey is submitted by a sampled attester, with the measured score as value
+	TrainingAttestationRecordKey = "verification/attestation"
+)
+
+// TrainingCheckpointReport is the final checkpoint of a training task and the score its executors claim
+type TrainingCheckpointReport struct {
+	CheckpointUri string `json:"checkpoint_uri"`
+	ClaimedScore  string `json:"claimed_score"`
+}
+
+const (
+	TrainingVerificationPending  = "pending"
+	TrainingVerificationAccepted = "accepted"
+	TrainingVerificationRejected = "rejected"
+)
+
+// TrainingVerification is the verification of one finished training task
+type TrainingVerification struct {
+	TaskId        uint64 `json:"task_id"`
+	CheckpointUri string `json:"checkpoint_uri"`
+	// ClaimedScore is the evaluation score the executors reported for the checkpoint, as a decimal string
+	ClaimedScore     string                `json:"claimed_score"`
+	EvalSamples      uint32                `json:"eval_samples"`
+	Seed             string                `json:"seed"`
+	StartedAtHeight  int64                 `json:"started_at_height"`
+	Attesters        []TrainingAttester    `json:"attesters"`
+	Attestations     []TrainingAttestation `json:"attestations"`
+	Status           string                `json:"status"`
+	ResolvedAtHeight int64                 `json:"resolved_at_height,omitempty"`
+	// Datasets the task was created with, attesters evaluate the checkpoint on the pinned test dataset
+	Datasets *TrainingTaskDatasets `json:"datasets,omitempty"`
+}
+
+type TrainingAttester struct {
+	Address string `json:"address"`
+	Weight  int64  `json:"weight"`
+}
+
+type TrainingAttestation struct {
+	Attester string `json:"attester"`
+	// Passed is whether Score matched the claimed score, decided on chain
+	Passed bool `json:"passed"`
+	// Score is the evaluation score the attester measured, as a decimal string
+	Score  string `json:"score"`
+	Height int64  `json:"height"`
+}
+
+func (v TrainingVerification) Attester(address string) (TrainingAttester, bool) {
+	for _, attester := range v.Attesters {
+		if attester.Address == address {
+			return attester, true
+		}
+	}
+	return TrainingAttester{}, false
+}
+
+func (v TrainingVerification) HasAttested(address string) bool {
+	for _, attestation := range v.Attestations {
+		if attestation.Attester == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"cosmossdk.io/collections"
+)
+
+const (
+	MaxTrainingDatasetNameLength = 128
+	MaxTrainingDatasetUris       = 8
+	MaxTrainingDatasetUriLength  = 512
+	MaxTrainingDatasetLicenseLen = 128
+)
+
+var trainingDatasetNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._/-]*$`)
+
+// TrainingDataset is a dataset registered for training through governance (MsgUpdatePolicy).
+// Training tasks reference datasets by name, Hash pins their content so validators can check that a
+// task was trained and evaluated against the declared data.
+type TrainingDataset struct {
+	Name string `json:"name"`
+	// Hash is the hex SHA-256 of the dataset content
+	Hash      string `json:"hash"`
+	SizeBytes uint64 `json:"size_bytes"`
+	License   string `json:"license"`
+	// Uris the dataset can be downloaded from, all serving the same content
+	Uris               []string `json:"uris"`
+	RegisteredAtHeight int64    `json:"registered_at_height"`
+}
+
+func (d TrainingDataset) Validate() error {
+	if len(d.Name) > MaxTrainingDatasetNameLength || !trainingDatasetNamePattern.MatchString(d.Name) {
+		return fmt.Errorf("invalid dataset name %q", d.Name)
+	}
+	if hash, err := hex.DecodeString(d.Hash); err != nil || len(hash) != 32 {
+		return fmt.Errorf("hash of dataset %s must be a hex SHA-256, got %q", d.Name, d.Hash)
+	}
+	if d.SizeBytes == 0 {
+		return fmt.Errorf("size of dataset %s must be set", d.Name)
+	}
+	if d.License == "" || len(d.License) > MaxTrainingDatasetLicenseLen {
+		return fmt.Errorf("license of dataset %s must be set, up to %d characters", d.Name, MaxTrainingDatasetLicenseLen)
+	}
+	if len(d.Uris) == 0 || len(d.Uris) > MaxTrainingDatasetUris {
+		return fmt.Errorf("dataset %s must have between 1 and %d uris, got %d", d.Name, MaxTrainingDatasetUris, len(d.Uris))
+	}
+	for _, uri := range d.Uris {
+		parsed, err := url.Parse(uri)
+		if err != nil || parsed.Scheme == "" || len(uri) > MaxTrainingDatasetUriLength {
+			return fmt.Errorf("invalid uri %q of dataset %s", uri, d.Name)
+		}
+	}
+	return nil
+}
+
+// TrainingDatasetRef is a dataset as a training task referenced it, with the hash it had then
+type TrainingDatasetRef struct {
+	Name string `json:"name"`
+	Hash string `json:"hash"`
+}
+
+func (d TrainingDataset) Ref() TrainingDatasetRef {
+	return TrainingDatasetRef{Name: d.Name, Hash: d.Hash}
+}
+
+// TrainingTaskDatasets are the datasets a training task was created with. Test is optional.
+type TrainingTaskDatasets struct {
+	TaskId uint64              `json:"task_id"`
+	Train  TrainingDatasetRef  `json:"train"`
+	Test   *TrainingDatasetRef `json:"test,omitempty"`
+}
+
+// TrainingDatasetFullKey is the store key of a registered dataset, for ABCI queries
+func TrainingDatasetFullKey(name string) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(TrainingDatasetsPrefix, collections.StringKey, name)
+}
+
+// TrainingTaskDatasetsFullKey is the store key of the datasets of a training task, for ABCI queries
+func TrainingTaskDatasetsFullKey(taskId uint64) ([]byte, error) {
+	return collections.EncodeKeyWithPrefix(TrainingTaskDatasetsPrefix, collections.Uint64Key, taskId)
+}
@@ -4777,2896 +4777,2761 @@ func (m *MsgMigrateAllWrappedTokensResponse) GetAttempted() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
-	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
-	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
-	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
-	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
-	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
-	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
-	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
-	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
-	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
-	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
-	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
-	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
-	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
-	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
-	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
-	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
-	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
-	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
-	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
-	proto.RegisterType((*MsgSubmitPocValidationsV2)(nil), "inference.inference.MsgSubmitPocValidationsV2")
-	proto.RegisterType((*MsgSubmitPocValidationsV2Response)(nil), "inference.inference.MsgSubmitPocValidationsV2Response")
-	proto.RegisterType((*MsgPoCV2StoreCommit)(nil), "inference.inference.MsgPoCV2StoreCommit")
-	proto.RegisterType((*MsgPoCV2StoreCommitResponse)(nil), "inference.inference.MsgPoCV2StoreCommitResponse")
-	proto.RegisterType((*MsgMLNodeWeightDistribution)(nil), "inference.inference.MsgMLNodeWeightDistribution")
-	proto.RegisterType((*MsgMLNodeWeightDistributionResponse)(nil), "inference.inference.MsgMLNodeWeightDistributionResponse")
-	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
-	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
-	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
-	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
-	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
-	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
-	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
-	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
-	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
-	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
-	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
-	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
-	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
-	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
-	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
-	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
-	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
-	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
-	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
-	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
-	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
-	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
-	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
-	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
-	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
-	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
-	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
-	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
-	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
-	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
-	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
-	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
-	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
-	proto.RegisterType((*MsgAddParticipantsToAllowList)(nil), "inference.inference.MsgAddParticipantsToAllowList")
-	proto.RegisterType((*MsgAddParticipantsToAllowListResponse)(nil), "inference.inference.MsgAddParticipantsToAllowListResponse")
-	proto.RegisterType((*MsgRemoveParticipantsFromAllowList)(nil), "inference.inference.MsgRemoveParticipantsFromAllowList")
-	proto.RegisterType((*MsgRemoveParticipantsFromAllowListResponse)(nil), "inference.inference.MsgRemoveParticipantsFromAllowListResponse")
-	proto.RegisterType((*MsgRegisterBridgeAddresses)(nil), "inference.inference.MsgRegisterBridgeAddresses")
-	proto.RegisterType((*MsgRegisterBridgeAddressesResponse)(nil), "inference.inference.MsgRegisterBridgeAddressesResponse")
-	proto.RegisterType((*MsgRegisterTokenMetadata)(nil), "inference.inference.MsgRegisterTokenMetadata")
-	proto.RegisterType((*MsgRegisterTokenMetadataResponse)(nil), "inference.inference.MsgRegisterTokenMetadataResponse")
-	proto.RegisterType((*MsgApproveBridgeTokenForTrading)(nil), "inference.inference.MsgApproveBridgeTokenForTrading")
-	proto.RegisterType((*MsgApproveBridgeTokenForTradingResponse)(nil), "inference.inference.MsgApproveBridgeTokenForTradingResponse")
-	proto.RegisterType((*MsgRegisterLiquidityPool)(nil), "inference.inference.MsgRegisterLiquidityPool")
-	proto.RegisterType((*MsgRegisterLiquidityPoolResponse)(nil), "inference.inference.MsgRegisterLiquidityPoolResponse")
-	proto.RegisterType((*MsgRequestBridgeWithdrawal)(nil), "inference.inference.MsgRequestBridgeWithdrawal")
-	proto.RegisterType((*MsgRequestBridgeWithdrawalResponse)(nil), "inference.inference.MsgRequestBridgeWithdrawalResponse")
-	proto.RegisterType((*MsgRequestBridgeMint)(nil), "inference.inference.MsgRequestBridgeMint")
-	proto.RegisterType((*MsgRequestBridgeMintResponse)(nil), "inference.inference.MsgRequestBridgeMintResponse")
-	proto.RegisterType((*MsgRegisterWrappedTokenContract)(nil), "inference.inference.MsgRegisterWrappedTokenContract")
-	proto.RegisterType((*MsgRegisterWrappedTokenContractResponse)(nil), "inference.inference.MsgRegisterWrappedTokenContractResponse")
-	proto.RegisterType((*MsgMigrateAllWrappedTokens)(nil), "inference.inference.MsgMigrateAllWrappedTokens")
-	proto.RegisterType((*MsgMigrateAllWrappedTokensResponse)(nil), "inference.inference.MsgMigrateAllWrappedTokensResponse")
+// MsgInitiateExit starts the exit of the signing participant from the network. It serves its last epoch,
+// then its collateral unbonds.
+type MsgInitiateExit struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
-
-var fileDescriptor_09b36d0241b9acd5 = []byte{
-	// 3990 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x6f, 0x1c, 0xc9,
-	0x75, 0x6a, 0xce, 0xf0, 0xab, 0xf8, 0x21, 0xb2, 0x45, 0x4b, 0xa3, 0x59, 0x89, 0xa2, 0x46, 0xab,
-	0x15, 0x25, 0x4a, 0xa2, 0xcd, 0xfd, 0xf0, 0x42, 0x1b, 0x6c, 0x42, 0x52, 0xbb, 0x5e, 0x79, 0x97,
-	0x12, 0xd1, 0xa4, 0xb4, 0x89, 0x93, 0xa0, 0x51, 0x33, 0x5d, 0xec, 0x69, 0x73, 0xba, 0xab, 0x55,
-	0x55, 0x43, 0x8a, 0x31, 0x02, 0x18, 0x4e, 0xe2, 0x83, 0x91, 0x43, 0x90, 0x1c, 0x12, 0x24, 0x81,
-	0x91, 0xc4, 0x08, 0x10, 0x20, 0x87, 0xe8, 0x90, 0x4b, 0x4e, 0x01, 0xf2, 0x01, 0xf8, 0x68, 0x18,
-	0x08, 0x10, 0xc0, 0x40, 0x10, 0xec, 0x1e, 0xf6, 0x90, 0x5b, 0x7e, 0x81, 0x51, 0x1f, 0x5d, 0xd3,
-	0xdd, 0xd3, 0xd5, 0x3d, 0xd4, 0x4a, 0xc6, 0x5e, 0xa4, 0xa9, 0x57, 0xaf, 0xaa, 0xde, 0xab, 0xf7,
-	0x59, 0xef, 0x35, 0xc1, 0xa5, 0x20, 0x3a, 0x40, 0x04, 0x45, 0x1d, 0xb4, 0x3e, 0xf8, 0xc5, 0x9e,
-	0xdd, 0x8d, 0x09, 0x66, 0xd8, 0x3e, 0xa7, 0x61, 0x77, 0xf5, 0xaf, 0xe6, 0x22, 0x0c, 0x83, 0x08,
-	0xaf, 0x8b, 0x7f, 0x25, 0x5e, 0xf3, 0x42, 0x07, 0xd3, 0x10, 0xd3, 0xf5, 0x90, 0xfa, 0xeb, 0x47,
-	0xdf, 0xe0, 0xff, 0xa9, 0x89, 0x8b, 0x72, 0xc2, 0x15, 0xa3, 0x75, 0x39, 0x50, 0x53, 0x4b, 0x3e,
-	0xf6, 0xb1, 0x84, 0xf3, 0x5f, 0xc9, 0x02, 0x1f, 0x63, 0xbf, 0x87, 0xd6, 0xc5, 0xa8, 0xdd, 0x3f,
-	0x58, 0x87, 0xd1, 0x89, 0x9a, 0x5a, 0x29, 0x22, 0x35, 0x86, 0x04, 0x86, 0xc9, 0x96, 0x37, 0x0a,
-	0x99, 0x21, 0x30, 0x88, 0x82, 0xc8, 0x77, 0x19, 0xa4, 0x87, 0x65, 0x88, 0x5d, 0x48, 0xbc, 0x63,
-	0x48, 0x90, 0x1b, 0x61, 0x0f, 0x29, 0xc4, 0x37, 0x8a, 0x10, 0x23, 0xc4, 0x8e, 0x31, 0x39, 0x4c,
-	0xe3, 0x15, 0xd2, 0xd6, 0x26, 0x81, 0xe7, 0x97, 0x62, 0xc4, 0xb8, 0xe3, 0x1e, 0x6d, 0x48, 0x8c,
-	0xd6, 0xbf, 0x5b, 0xe0, 0xec, 0x0e, 0xf5, 0x1f, 0xc7, 0x1e, 0x64, 0x68, 0x57, 0xf0, 0x65, 0xbf,
-	0x03, 0xa6, 0x61, 0x9f, 0x75, 0x31, 0x09, 0xd8, 0x49, 0xc3, 0x5a, 0xb1, 0x56, 0xa7, 0xb7, 0x1a,
-	0x3f, 0xff, 0xe7, 0x3b, 0x4b, 0xea, 0x26, 0x37, 0x3d, 0x8f, 0x20, 0x4a, 0xf7, 0x18, 0x09, 0x22,
-	0xdf, 0x19, 0xa0, 0xda, 0xef, 0x83, 0x09, 0x79, 0x33, 0x8d, 0xb1, 0x15, 0x6b, 0x75, 0x66, 0xe3,
-	0xb5, 0xbb, 0x05, 0x92, 0xbc, 0x2b, 0x0f, 0xd9, 0x9a, 0xfe, 0xe9, 0xff, 0x5c, 0x39, 0xf3, 0x0f,
-	0x5f, 0x3c, 0xbf, 0x65, 0x39, 0x6a, 0xd5, 0xbd, 0x77, 0x7f, 0xf0, 0xc5, 0xf3, 0x5b, 0x83, 0xfd,
-	0x7e, 0xf4, 0xc5, 0xf3, 0x5b, 0xd7, 0x07, 0x64, 0x3f, 0x4b, 0xb1, 0x90, 0xa3, 0xb8, 0x75, 0x11,
-	0x5c, 0xc8, 0x81, 0x1c, 0x44, 0x63, 0x1c, 0x51, 0xd4, 0xfa, 0xa7, 0x3a, 0x58, 0xdc, 0xa1, 0xfe,
-	0x1e, 0x83, 0x84, 0x3d, 0x48, 0x36, 0xb0, 0x1b, 0x60, 0xb2, 0x43, 0x10, 0x64, 0x98, 0x48, 0x06,
-	0x9d, 0x64, 0x68, 0x5f, 0x05, 0xb3, 0xfa, 0x1c, 0x37, 0xf0, 0x04, 0x2b, 0xd3, 0xce, 0x8c, 0x86,
-	0x3d, 0xf0, 0xec, 0x2b, 0x60, 0x26, 0x26, 0x38, 0x8c, 0x99, 0xdb, 0x85, 0xb4, 0xdb, 0xa8, 0x09,
-	0x0c, 0x20, 0x41, 0x1f, 0x41, 0xda, 0xb5, 0x6f, 0x82, 0x79, 0x85, 0x10, 0xc3, 0x93, 0x1e, 0x86,
-	0x5e, 0xa3, 0x2e, 0x6e, 0x71, 0xac, 0x61, 0x39, 0x73, 0x72, 0x66, 0x57, 0x4e, 0xd8, 0x4b, 0x60,
-	0x3c, 0xc4, 0x1e, 0xea, 0x35, 0x26, 0xc4, 0x2e, 0x72, 0xc0, 0x89, 0x20, 0xe8, 0x69, 0x1f, 0x51,
-	0x86, 0x3c, 0xb7, 0x7d, 0xd2, 0x98, 0x94, 0x44, 0x68, 0xd8, 0xd6, 0x09, 0x27, 0x02, 0x52, 0x1a,
-	0xf8, 0x11, 0xf2, 0x5c, 0x86, 0x1b, 0x53, 0x92, 0x88, 0x04, 0xb4, 0x8f, 0xf9, 0x1e, 0x5c, 0x57,
-	0xdc, 0x23, 0x44, 0x68, 0x80, 0xa3, 0xc6, 0xb4, 0xdc, 0x83, 0xc3, 0x9e, 0x48, 0x90, 0x7d, 0x19,
-	0x80, 0x10, 0x3e, 0x73, 0x19, 0x3e, 0x44, 0x11, 0x6d, 0x80, 0x15, 0x6b, 0xb5, 0xee, 0x4c, 0x87,
-	0xf0, 0xd9, 0xbe, 0x00, 0xd8, 0xb7, 0x81, 0xad, 0xd8, 0x10, 0x18, 0x6e, 0x07, 0xf7, 0x23, 0xd6,
-	0x98, 0x11, 0x68, 0x0b, 0x72, 0x46, 0x60, 0x6e, 0x73, 0xb8, 0xbd, 0x06, 0x16, 0x15, 0x7d, 0x2e,
-	0x0b, 0x42, 0x44, 0x19, 0x0c, 0xe3, 0xc6, 0xec, 0x8a, 0xb5, 0x5a, 0x73, 0x16, 0xd4, 0xc4, 0x7e,
-	0x02, 0xb7, 0xef, 0x00, 0x9b, 0x11, 0x18, 0xd1, 0x03, 0x44, 0x5c, 0x4e, 0x31, 0x64, 0x7d, 0x82,
-	0x1a, 0xf3, 0x82, 0xc4, 0xc5, 0x64, 0x66, 0x2f, 0x99, 0xb0, 0xd7, 0xc0, 0x59, 0x4c, 0x02, 0x3f,
-	0x88, 0x60, 0xcf, 0x95, 0x07, 0x37, 0xce, 0xea, 0x1b, 0x9d, 0x4f, 0xa6, 0x76, 0xc5, 0x8c, 0xfd,
-	0x75, 0xb0, 0x94, 0x43, 0x96, 0x72, 0x5a, 0x10, 0xbb, 0xdb, 0x59, 0x6c, 0x2e, 0xaf, 0x7b, 0xb3,
-	0x5c, 0xf1, 0x12, 0x0d, 0x68, 0x05, 0xe0, 0xe2, 0x90, 0xc2, 0x24, 0xea, 0x64, 0xdf, 0x00, 0x67,
-	0x53, 0xea, 0x11, 0x79, 0xe8, 0x99, 0x52, 0xa0, 0xf9, 0x81, 0x86, 0x70, 0xa8, 0x7d, 0x0d, 0xcc,
-	0x21, 0x42, 0x30, 0x71, 0x43, 0x44, 0x29, 0xf4, 0x91, 0x52, 0xa4, 0x59, 0x01, 0xdc, 0x91, 0xb0,
-	0xd6, 0x4f, 0xc6, 0x81, 0xbd, 0x43, 0xfd, 0x0f, 0x83, 0x28, 0xa0, 0xdd, 0x97, 0xa4, 0x9d, 0xd7,
-	0xc0, 0x1c, 0x51, 0xd4, 0xa6, 0xf5, 0x73, 0x36, 0x01, 0x0a, 0x0d, 0xbd, 0x03, 0x16, 0x34, 0xd2,
-	0xb0, 0x8e, 0x9e, 0x4d, 0xe6, 0x12, 0x2d, 0x2d, 0xd6, 0x84, 0x71, 0x83, 0x26, 0xbc, 0x05, 0xce,
-	0x77, 0x70, 0x18, 0xf7, 0x10, 0x0b, 0x70, 0x94, 0x59, 0x31, 0x21, 0x56, 0x2c, 0x0d, 0x66, 0x53,
-	0xab, 0xae, 0x80, 0x19, 0xf4, 0x0c, 0x75, 0xfa, 0x19, 0x95, 0x07, 0x09, 0x68, 0xeb, 0xc4, 0xbe,
-	0x0e, 0xe6, 0x13, 0xcd, 0x20, 0x12, 0x47, 0x2a, 0xfd, 0x5c, 0x0a, 0xba, 0x75, 0x52, 0xac, 0x87,
-	0xd3, 0xa7, 0xd2, 0x43, 0x60, 0xd2, 0xc3, 0x3b, 0xc0, 0x96, 0x04, 0xe1, 0x34, 0xfa, 0x8c, 0x44,
-	0x4f, 0x66, 0x06, 0xe8, 0x79, 0x33, 0x9e, 0x1d, 0x36, 0xe3, 0x02, 0xcd, 0x9e, 0x33, 0x6a, 0xb6,
-	0x76, 0x16, 0xf3, 0x69, 0x67, 0x91, 0x73, 0x47, 0x67, 0x87, 0xdc, 0xd1, 0x97, 0x35, 0x88, 0xef,
-	0x82, 0xe6, 0xb0, 0x92, 0xbe, 0x22, 0x8b, 0xf8, 0x73, 0x4b, 0xb8, 0xf2, 0xbd, 0x7e, 0x3b, 0x0c,
-	0xd8, 0x43, 0x74, 0xbc, 0x0b, 0x09, 0x0b, 0x3a, 0x41, 0x0c, 0x23, 0x56, 0x62, 0x16, 0x0b, 0xa0,
-	0xd6, 0x27, 0x3d, 0xb5, 0x21, 0xff, 0xc9, 0x0f, 0x3b, 0x82, 0xbd, 0xc0, 0xe3, 0xd3, 0xee, 0x21,
-	0x3a, 0x49, 0xac, 0x40, 0x03, 0x3f, 0x46, 0x27, 0xdc, 0xff, 0xf1, 0x98, 0x8a, 0x24, 0x86, 0xd0,
-	0x7f, 0x67, 0x5a, 0x42, 0x3e, 0x46, 0x27, 0xb9, 0x5b, 0x38, 0x00, 0x57, 0x0c, 0x84, 0xe9, 0xab,
-	0x58, 0x03, 0x8b, 0xf1, 0x00, 0x9c, 0xb9, 0x8c, 0x85, 0xd4, 0x84, 0xbc, 0x8e, 0xf3, 0x60, 0x82,
-	0x32, 0xc8, 0xfa, 0x54, 0x91, 0xad, 0x46, 0xad, 0x7f, 0x1d, 0x03, 0x73, 0x3b, 0xd4, 0x7f, 0x22,
-	0x09, 0xe5, 0x6e, 0xda, 0xcc, 0xf7, 0x3c, 0x18, 0xd3, 0x4e, 0x60, 0x2c, 0xf0, 0x86, 0xdc, 0x43,
-	0x6d, 0xd8, 0x3d, 0x9c, 0xd2, 0xf2, 0x87, 0xbc, 0xc9, 0x78, 0x81, 0x37, 0x69, 0x80, 0xf1, 0x23,
-	0xd8, 0xeb, 0x23, 0x61, 0xdf, 0x96, 0xd8, 0x48, 0x02, 0xec, 0x16, 0xb7, 0x80, 0x23, 0xcd, 0x8a,
-	0xb0, 0xea, 0x29, 0x27, 0x03, 0xb3, 0x37, 0x85, 0xa8, 0xfa, 0xc8, 0xf5, 0x50, 0x27, 0x08, 0x61,
-	0x4f, 0x98, 0xf5, 0xcc, 0xc6, 0xa5, 0xc2, 0xec, 0xe1, 0xbe, 0xc4, 0x11, 0x82, 0xec, 0x23, 0x35,
-	0xca, 0x49, 0xea, 0x02, 0xf8, 0x5a, 0xe6, 0x02, 0x75, 0x2e, 0xf0, 0x73, 0x2b, 0x2b, 0xc3, 0xc7,
-	0xd1, 0x41, 0x3f, 0xf2, 0x90, 0x37, 0x9a, 0x92, 0x35, 0xc0, 0x24, 0x94, 0xa9, 0x8f, 0xba, 0xf1,
-	0x64, 0x98, 0xa8, 0x5f, 0x6d, 0xa0, 0x7e, 0x17, 0xc0, 0x64, 0xdc, 0x6f, 0xa7, 0xd4, 0x6a, 0x22,
-	0xee, 0xb7, 0xb9, 0xca, 0x0d, 0xe9, 0xe5, 0x78, 0xa5, 0x5e, 0x4e, 0x94, 0xeb, 0xe5, 0x4d, 0x70,
-	0xa3, 0x82, 0x27, 0xcd, 0xff, 0x1f, 0x59, 0xe0, 0xfc, 0x0e, 0xf5, 0x1f, 0x44, 0xea, 0x34, 0xf4,
-	0x92, 0x42, 0xce, 0x0a, 0x98, 0x09, 0x22, 0xcd, 0xc1, 0x40, 0xeb, 0x34, 0x28, 0x47, 0xf2, 0x0a,
-	0x58, 0x2e, 0x26, 0x23, 0x4f, 0xa9, 0x83, 0xbe, 0x12, 0x94, 0x16, 0x90, 0xa1, 0x29, 0x8d, 0x44,
-	0xfe, 0xbc, 0xdd, 0x83, 0x41, 0xe8, 0xa0, 0x63, 0x48, 0x3c, 0x5a, 0x42, 0xa1, 0x0d, 0xea, 0x14,
-	0x21, 0x49, 0x59, 0xcd, 0x11, 0xbf, 0x45, 0xdc, 0x8b, 0x71, 0xa7, 0xab, 0xdc, 0x45, 0x4d, 0x84,
-	0x48, 0x20, 0x40, 0xc2, 0x51, 0xe4, 0x28, 0x7a, 0x20, 0xfc, 0x63, 0xfa, 0x3c, 0xed, 0x7e, 0xce,
-	0x83, 0x09, 0x18, 0x8a, 0x38, 0x6b, 0x89, 0x4d, 0xd4, 0x88, 0xc3, 0x09, 0xa2, 0xfd, 0x1e, 0x4b,
-	0x3c, 0x8d, 0x1c, 0xb5, 0x7e, 0x61, 0xc9, 0xd4, 0x58, 0xa8, 0xce, 0x2e, 0xee, 0x6c, 0x41, 0xd6,
-	0xe9, 0x96, 0x50, 0xff, 0x3e, 0xb8, 0xc4, 0xdf, 0x0e, 0x94, 0x41, 0x1f, 0xf1, 0x7f, 0x09, 0x73,
-	0xdb, 0x3d, 0xdc, 0x39, 0x74, 0xbb, 0x28, 0xf0, 0xbb, 0x4c, 0x71, 0xd5, 0x88, 0x71, 0x67, 0x8f,
-	0xa3, 0x88, 0x0c, 0x6a, 0x8b, 0x23, 0x7c, 0x24, 0xe6, 0xed, 0x8b, 0x60, 0xaa, 0xcd, 0x8f, 0x18,
-	0x78, 0xa6, 0x49, 0x31, 0x7e, 0xe0, 0x71, 0x12, 0x23, 0x1c, 0x75, 0x10, 0x6d, 0xd4, 0x57, 0x6a,
-	0xab, 0x35, 0x47, 0x8d, 0xf8, 0x85, 0x79, 0x01, 0xe5, 0xa9, 0x46, 0x6d, 0xd5, 0x72, 0xc4, 0x6f,
-	0x6e, 0x5b, 0x22, 0xb1, 0x0d, 0x3c, 0x65, 0x1a, 0x13, 0x7c, 0xf8, 0xc0, 0xcb, 0x5d, 0xd4, 0x6b,
-	0x32, 0x8d, 0xcb, 0x30, 0xa7, 0xa5, 0xf6, 0x2f, 0x35, 0xa1, 0x5f, 0x7a, 0x76, 0x24, 0x6f, 0xbb,
-	0x0e, 0xce, 0xa5, 0xdd, 0x7b, 0xd6, 0x19, 0xd8, 0xa9, 0x29, 0xf5, 0x42, 0xaa, 0xbc, 0xb0, 0x5a,
-	0xc5, 0x85, 0x9d, 0xe6, 0x56, 0x84, 0xa3, 0xee, 0xa0, 0xe0, 0x08, 0x79, 0xae, 0x98, 0x9c, 0x10,
-	0x93, 0xb3, 0x09, 0xf0, 0x3e, 0x47, 0xba, 0x08, 0xa6, 0x88, 0xcb, 0x20, 0xf1, 0x11, 0x13, 0xae,
-	0xd8, 0x72, 0x26, 0xc9, 0xbe, 0x18, 0xf2, 0x30, 0x7e, 0x40, 0x60, 0xdf, 0x73, 0x59, 0x97, 0x20,
-	0xda, 0xc5, 0x3d, 0x4f, 0xf8, 0x61, 0xcb, 0x99, 0x17, 0xe0, 0xfd, 0x04, 0x6a, 0xbf, 0x06, 0xa6,
-	0x23, 0x57, 0x59, 0x8c, 0xca, 0xab, 0xa6, 0x22, 0x65, 0xcb, 0x3c, 0x41, 0x8a, 0x09, 0x6e, 0xc3,
-	0x76, 0xd0, 0x0b, 0xd8, 0x89, 0xdb, 0xc5, 0x11, 0xa2, 0x4c, 0xe4, 0x53, 0x96, 0xb3, 0x98, 0x9a,
-	0xf9, 0x48, 0x4c, 0xf0, 0x94, 0x4e, 0x1e, 0xea, 0x21, 0x86, 0x3a, 0x0c, 0x79, 0x22, 0x97, 0x9a,
-	0x72, 0xe6, 0x04, 0xf4, 0xbe, 0x02, 0x16, 0xda, 0x64, 0x81, 0xe8, 0xd2, 0x7e, 0xfe, 0x62, 0x31,
-	0x0a, 0x7d, 0xb2, 0xf1, 0x0a, 0x15, 0x7c, 0x07, 0xcc, 0x0c, 0xe2, 0x1a, 0x6d, 0xd4, 0x56, 0x6a,
-	0xab, 0x33, 0x1b, 0x6b, 0xc5, 0xaf, 0x60, 0xbc, 0x3d, 0xa0, 0x4a, 0x05, 0xdb, 0x27, 0x1b, 0x4e,
-	0x7a, 0x7d, 0x8e, 0xed, 0x6b, 0xe0, 0xaa, 0x91, 0x27, 0xcd, 0xf9, 0x73, 0x0b, 0x9c, 0xdb, 0xa1,
-	0x3e, 0xdf, 0x7d, 0x63, 0x8f, 0x61, 0x82, 0xb6, 0x71, 0x18, 0x06, 0xec, 0x15, 0xf2, 0xbc, 0x04,
-	0xc6, 0x65, 0x6e, 0xcf, 0x95, 0x79, 0xce, 0x91, 0x03, 0xae, 0x24, 0x04, 0x63, 0x95, 0x67, 0xf2,
-	0x08, 0x38, 0xeb, 0x4c, 0x71, 0x40, 0x41, 0x76, 0x79, 0x19, 0xbc, 0x56, 0x40, 0xb1, 0xe6, 0xe8,
-	0xdf, 0x2c, 0x31, 0xbf, 0xf3, 0xc9, 0x43, 0xec, 0xa1, 0x4f, 0xc5, 0x99, 0x5c, 0x95, 0x49, 0xd0,
-	0xee, 0x57, 0x98, 0xeb, 0x97, 0xe5, 0xec, 0x3d, 0x30, 0x79, 0x2c, 0x7e, 0x25, 0x92, 0xbc, 0x5a,
-	0x28, 0xc9, 0x34, 0x65, 0x4e, 0xb2, 0x22, 0xc7, 0xe3, 0x75, 0x70, 0xad, 0x84, 0x07, 0xcd, 0xeb,
-	0x91, 0xc8, 0xfc, 0xa4, 0x88, 0xf7, 0x78, 0x6c, 0x30, 0x33, 0x97, 0x8b, 0x1a, 0x63, 0xf9, 0xa8,
-	0x61, 0x5f, 0x02, 0xd3, 0x83, 0x17, 0x8a, 0xf4, 0xb6, 0x03, 0x40, 0x61, 0xc2, 0x34, 0x38, 0x57,
-	0x13, 0xd4, 0x11, 0x74, 0xcb, 0x89, 0xc7, 0x51, 0xc0, 0x1e, 0x1d, 0x6c, 0xe3, 0x30, 0xee, 0x33,
-	0xb4, 0x4b, 0x82, 0x0e, 0xda, 0x25, 0x38, 0xc6, 0x14, 0xf6, 0x4a, 0xc8, 0x5c, 0x02, 0xe3, 0x31,
-	0x47, 0x55, 0x04, 0xca, 0x41, 0xee, 0xf4, 0x3b, 0x60, 0x6d, 0x84, 0x43, 0x34, 0x4d, 0x7f, 0x59,
-	0x03, 0x0b, 0x22, 0x26, 0xfb, 0x01, 0x65, 0x88, 0xec, 0x88, 0x37, 0xd0, 0xa5, 0xa1, 0x92, 0x55,
-	0xba, 0x30, 0x25, 0x5f, 0x48, 0x31, 0xa6, 0xf2, 0x19, 0x36, 0xa6, 0x5f, 0x48, 0x02, 0xb4, 0x75,
-	0xa2, 0xf2, 0xe8, 0x9a, 0xce, 0xa3, 0xef, 0x81, 0x66, 0x3f, 0x0a, 0x18, 0x75, 0xf1, 0x81, 0xdb,
-	0x91, 0xc4, 0xb8, 0x31, 0x22, 0xf2, 0x2d, 0x2b, 0xf4, 0xb9, 0xee, 0x9c, 0x17, 0x18, 0x03, 0x62,
-	0x11, 0x11, 0x8f, 0x59, 0x1e, 0x9e, 0xba, 0x07, 0x2e, 0x41, 0x31, 0x56, 0xb9, 0xdd, 0x44, 0xf7,
-	0xc0, 0x41, 0x31, 0xe6, 0x36, 0xd1, 0x15, 0xdb, 0x85, 0x01, 0x53, 0x91, 0x6b, 0xaa, 0x7b, 0xa0,
-	0x0c, 0xf4, 0x32, 0x00, 0xe2, 0x35, 0xe7, 0x42, 0xe2, 0xd3, 0xc6, 0xe4, 0x4a, 0x8d, 0x73, 0x20,
-	0x20, 0x9b, 0xc4, 0xa7, 0xf6, 0x39, 0x30, 0x7e, 0xe4, 0x12, 0x18, 0x0a, 0x9f, 0x5c, 0x77, 0xea,
-	0x47, 0x0e, 0x0c, 0xf9, 0xbb, 0x8e, 0x75, 0x09, 0xee, 0xfb, 0xdd, 0xb8, 0xcf, 0x04, 0x7d, 0x22,
-	0x3e, 0x08, 0xa7, 0x5c, 0x77, 0xec, 0xc1, 0xdc, 0x2e, 0x22, 0x0f, 0xf9, 0x8c, 0xfd, 0x08, 0x2c,
-	0x0d, 0x1c, 0x4c, 0xca, 0xd3, 0x83, 0x11, 0x32, 0xee, 0x73, 0x83, 0x95, 0x3a, 0x18, 0xdc, 0x9b,
-	0xcf, 0x96, 0xec, 0x5a, 0x4d, 0xd0, 0xc8, 0xcb, 0x46, 0x0b, 0xee, 0x17, 0x96, 0x50, 0xb3, 0x6d,
-	0x2e, 0x76, 0xb4, 0xaf, 0x0a, 0xa4, 0xfb, 0x90, 0x1e, 0x96, 0xe8, 0xcf, 0xef, 0x02, 0x5b, 0x57,
-	0x48, 0x09, 0xa2, 0xb8, 0x4f, 0x78, 0x34, 0x1c, 0x13, 0xe6, 0x78, 0xb7, 0x90, 0xdc, 0x64, 0xe3,
-	0x8f, 0xd4, 0x32, 0x27, 0x59, 0xe5, 0x2c, 0x76, 0xf3, 0x20, 0xfb, 0x3d, 0x30, 0xd1, 0xc1, 0xd1,
-	0x41, 0xe0, 0x0b, 0xd9, 0xcf, 0x6c, 0x5c, 0x2b, 0xdd, 0x72, 0x5b, 0xa0, 0x3a, 0x6a, 0x49, 0x4e,
-	0x8b, 0x9f, 0x80, 0xcb, 0x85, 0xcc, 0xe9, 0xec, 0xec, 0x6d, 0x50, 0x67, 0x90, 0x1e, 0x0a, 0x0e,
-	0x4d, 0xbe, 0x24, 0xb3, 0x50, 0xa0, 0xb7, 0xfe, 0xd3, 0x4a, 0x19, 0x67, 0xc2, 0xd4, 0xfd, 0xe0,
-	0xe0, 0xa0, 0xe4, 0xd6, 0xbe, 0x05, 0xe6, 0x22, 0x74, 0xfc, 0x88, 0xdf, 0x7f, 0x70, 0x10, 0x88,
-	0x7c, 0xd3, 0xec, 0xbf, 0x92, 0x3d, 0xb9, 0x6f, 0x72, 0xb2, 0xeb, 0xb8, 0x0b, 0x24, 0x28, 0xc4,
-	0x47, 0xc8, 0x2b, 0x75, 0x81, 0x99, 0x2d, 0x92, 0x15, 0xb9, 0xfb, 0xb9, 0x22, 0xee, 0x67, 0x98,
-	0x0d, 0xad, 0x1e, 0x9e, 0x88, 0x6f, 0x22, 0xb1, 0x4d, 0x5f, 0xc3, 0x87, 0x98, 0x6c, 0x8a, 0xa2,
-	0x66, 0x88, 0x4a, 0x5f, 0x67, 0x17, 0xc0, 0x24, 0xbf, 0xaf, 0x24, 0xef, 0xaf, 0x3b, 0x13, 0x7c,
-	0x38, 0x94, 0x15, 0xae, 0x81, 0x9b, 0x95, 0xa7, 0x68, 0x92, 0xfe, 0x56, 0xde, 0xbd, 0x9c, 0x19,
-	0x51, 0x63, 0x4d, 0x74, 0xd8, 0xdf, 0x02, 0xd3, 0xaa, 0x3a, 0x8b, 0x92, 0x80, 0x72, 0xb3, 0x52,
-	0x09, 0x36, 0xd5, 0x0a, 0x67, 0xb0, 0xb6, 0xf0, 0x5e, 0x87, 0x49, 0xd4, 0x4c, 0xfc, 0xa3, 0xac,
-	0xa8, 0x48, 0xcd, 0x14, 0xaf, 0x42, 0xd8, 0x7b, 0x1c, 0xfb, 0x04, 0x7a, 0xa8, 0xc2, 0x6d, 0x9e,
-	0x07, 0x13, 0xa9, 0x50, 0x59, 0x77, 0xd4, 0x88, 0x3f, 0xa2, 0x52, 0x55, 0xe4, 0xe4, 0x11, 0x95,
-	0x2e, 0x2c, 0xaf, 0x82, 0xb3, 0x30, 0x0e, 0xb6, 0x82, 0x08, 0x92, 0x00, 0xd1, 0x6f, 0x53, 0x1c,
-	0xa9, 0x67, 0x70, 0x1e, 0x3c, 0xe4, 0x40, 0xae, 0x8a, 0x17, 0x7a, 0x11, 0xb1, 0x9a, 0xa1, 0xbf,
-	0x4f, 0x67, 0x77, 0x09, 0xcb, 0x1f, 0x1f, 0x39, 0xa8, 0x83, 0x49, 0x59, 0xc8, 0x3c, 0x0f, 0x94,
-	0x28, 0x72, 0x82, 0x59, 0x01, 0x33, 0xa9, 0xdc, 0x3d, 0x61, 0x27, 0x05, 0xe2, 0xef, 0xfb, 0xc1,
-	0x4b, 0x9e, 0xff, 0xe4, 0x71, 0x4d, 0x56, 0x3c, 0xa4, 0x8b, 0x97, 0x83, 0x92, 0x84, 0x2d, 0x4f,
-	0xa6, 0x66, 0xa6, 0x2f, 0x9e, 0x8f, 0xdf, 0xc6, 0x81, 0x16, 0x5e, 0x09, 0x07, 0xf7, 0x40, 0x8d,
-	0xa0, 0xa7, 0xaa, 0xbb, 0xb2, 0x5a, 0xa8, 0x3c, 0xe9, 0x9d, 0x1c, 0x59, 0x67, 0x74, 0xf8, 0xa2,
-	0x1c, 0x6d, 0xbf, 0x25, 0x74, 0x22, 0x8b, 0xac, 0xfc, 0xd4, 0xfb, 0xba, 0x2e, 0x25, 0x3d, 0xd5,
-	0x1b, 0x25, 0x59, 0x8f, 0x58, 0xbc, 0x27, 0xb0, 0x75, 0xfd, 0xea, 0x18, 0x2c, 0xed, 0x50, 0x5f,
-	0xbb, 0x61, 0x04, 0x09, 0x6b, 0x23, 0x58, 0x66, 0xba, 0xdf, 0x4c, 0xb3, 0x75, 0xbd, 0xd8, 0xc3,
-	0x24, 0xdb, 0x94, 0xf0, 0xf4, 0x1d, 0x70, 0xa9, 0xe8, 0x60, 0xcd, 0xd8, 0x3d, 0x50, 0x27, 0x88,
-	0xc6, 0xa5, 0x6c, 0x0d, 0xad, 0x72, 0xc4, 0x9a, 0xd6, 0x53, 0x99, 0x99, 0x21, 0xb6, 0x05, 0x09,
-	0x09, 0x10, 0x29, 0xe1, 0xe6, 0xdd, 0x34, 0x37, 0xc5, 0xa7, 0x0c, 0xf6, 0x29, 0x61, 0x67, 0x5f,
-	0xfa, 0xfd, 0x14, 0xaa, 0xe2, 0xe3, 0xbd, 0x0c, 0x1f, 0x37, 0x2a, 0x4f, 0xc8, 0x30, 0xf2, 0x3d,
-	0xb1, 0x6b, 0x5a, 0xf0, 0x52, 0x7c, 0xbf, 0x12, 0xad, 0x73, 0x85, 0xaf, 0x1a, 0x3e, 0xfc, 0xa5,
-	0xe9, 0xde, 0xf7, 0x44, 0xa5, 0x5a, 0x7a, 0x8f, 0xfb, 0xfd, 0x30, 0x3c, 0x19, 0xd1, 0x69, 0x27,
-	0xb1, 0x79, 0xec, 0x54, 0xb1, 0x39, 0xc7, 0xdd, 0x6f, 0x83, 0x96, 0xf9, 0xf0, 0x2f, 0x9b, 0x06,
-	0xfc, 0xd7, 0x98, 0xa8, 0xd5, 0x6c, 0x89, 0xee, 0xee, 0x07, 0xcf, 0x3a, 0x5d, 0x18, 0xf9, 0xc2,
-	0x7f, 0x0f, 0x8a, 0x59, 0xca, 0x7f, 0x6b, 0x00, 0x77, 0x6c, 0xb2, 0xb6, 0xbf, 0xdd, 0x85, 0x41,
-	0x94, 0x94, 0xc3, 0x52, 0x20, 0xee, 0xa7, 0x3b, 0x38, 0x62, 0x04, 0x76, 0x92, 0x9a, 0x85, 0x72,
-	0x7f, 0x79, 0xb0, 0xdd, 0x02, 0xb3, 0xf8, 0x38, 0x42, 0x24, 0x41, 0x93, 0xbe, 0x30, 0x03, 0x13,
-	0xe7, 0xf1, 0xf1, 0xae, 0x28, 0x75, 0x2a, 0xd7, 0x98, 0x06, 0xa5, 0x2a, 0x54, 0xaa, 0x72, 0xa3,
-	0x2a, 0x54, 0x2b, 0x60, 0x46, 0x3c, 0xcd, 0x1e, 0xf6, 0xc3, 0x36, 0x22, 0x49, 0xbb, 0x33, 0x05,
-	0x92, 0x85, 0xe4, 0x0e, 0x0a, 0x62, 0x59, 0x3d, 0x57, 0xad, 0x9f, 0x0c, 0x2c, 0x85, 0x43, 0x1d,
-	0x8c, 0x99, 0xea, 0x78, 0x66, 0x60, 0x2a, 0xde, 0xe8, 0x3b, 0x6a, 0xad, 0x89, 0x58, 0x92, 0xbd,
-	0x56, 0x2d, 0x2b, 0xf9, 0x2c, 0xb0, 0x92, 0x67, 0x41, 0xeb, 0xc7, 0x96, 0x0c, 0xb6, 0x9e, 0xf7,
-	0x98, 0xf2, 0x74, 0x3f, 0x91, 0xd3, 0x66, 0xaf, 0x87, 0x8f, 0x3f, 0x09, 0x28, 0xab, 0x08, 0xa8,
-	0xe6, 0x0a, 0xf2, 0xdb, 0xa0, 0x4e, 0x70, 0x4f, 0x3e, 0xd4, 0xe6, 0x2b, 0xb4, 0xc2, 0xc1, 0x3d,
-	0x6e, 0xcd, 0xb8, 0x87, 0x86, 0xa2, 0xe7, 0x0d, 0x70, 0xbd, 0x94, 0x3e, 0x1d, 0x76, 0x7e, 0x62,
-	0x09, 0x65, 0x75, 0x44, 0xaa, 0xc6, 0x91, 0x3f, 0x24, 0x38, 0xfc, 0xca, 0xb1, 0x73, 0x1b, 0xdc,
-	0xaa, 0x26, 0x52, 0xf3, 0xf4, 0xd7, 0xaa, 0x75, 0x84, 0xd8, 0x69, 0x19, 0xe1, 0xb3, 0x92, 0x72,
-	0xf5, 0xb8, 0xe0, 0xb3, 0x09, 0xe0, 0x65, 0x31, 0x23, 0x33, 0x9b, 0x22, 0xea, 0x34, 0x07, 0x87,
-	0x89, 0x7a, 0xa5, 0x8a, 0xf7, 0x74, 0x1f, 0xbf, 0x14, 0x36, 0xcc, 0xba, 0x62, 0x38, 0x4c, 0x53,
-	0x15, 0xa7, 0x54, 0x25, 0x8d, 0xcb, 0xa5, 0xf1, 0x6a, 0x48, 0x4b, 0xcb, 0xdd, 0x78, 0xa2, 0xa6,
-	0xef, 0x87, 0x96, 0xf0, 0xfa, 0xc9, 0xa3, 0x53, 0xda, 0xf2, 0xa6, 0x16, 0x5f, 0x25, 0x61, 0x1d,
-	0xee, 0x0a, 0x1f, 0xc2, 0x30, 0x69, 0x48, 0x0e, 0x00, 0x59, 0xb2, 0x6b, 0x55, 0x64, 0xbf, 0xae,
-	0x2e, 0xaa, 0x90, 0x0e, 0x4d, 0xee, 0xff, 0x5b, 0x99, 0x37, 0xb2, 0x28, 0x1a, 0xec, 0x20, 0x06,
-	0x3d, 0xc8, 0x60, 0xb5, 0xc1, 0x09, 0xda, 0x1e, 0x24, 0xbd, 0x8d, 0x64, 0x78, 0x0a, 0x47, 0x6e,
-	0x83, 0x7a, 0xc4, 0x79, 0x95, 0x0e, 0x5c, 0xfc, 0x16, 0xad, 0xc8, 0x93, 0xb0, 0x8d, 0x7b, 0x49,
-	0xc5, 0x42, 0x8e, 0xec, 0x26, 0x98, 0x52, 0x3d, 0x39, 0x2a, 0x1c, 0xf6, 0x9c, 0xa3, 0xc7, 0x9c,
-	0x52, 0x7c, 0x84, 0xc8, 0x31, 0x09, 0x18, 0x52, 0x6d, 0xbd, 0x01, 0x60, 0xe8, 0x6a, 0x5a, 0x60,
-	0xc5, 0xc4, 0xb3, 0xbe, 0x98, 0x3f, 0x93, 0xdd, 0xb9, 0xcd, 0x38, 0x26, 0xf8, 0x08, 0xc9, 0xeb,
-	0x13, 0x98, 0x1f, 0x62, 0xb2, 0x4f, 0xa0, 0xc7, 0x73, 0xe3, 0x57, 0x7e, 0x3f, 0x43, 0x94, 0xcb,
-	0xf6, 0x5a, 0x19, 0x51, 0x9a, 0x81, 0xbf, 0xc9, 0x4a, 0xf6, 0x93, 0xe0, 0x69, 0x3f, 0xf0, 0x02,
-	0x76, 0xb2, 0x8b, 0x71, 0x55, 0x85, 0xea, 0x02, 0x98, 0xec, 0xa8, 0x9e, 0x86, 0xea, 0xd1, 0x74,
-	0x44, 0x4f, 0x83, 0x3f, 0x34, 0x7a, 0xb0, 0x8d, 0x92, 0xe6, 0xa2, 0x1c, 0xc8, 0x9e, 0x3b, 0x65,
-	0x30, 0x62, 0x01, 0x64, 0xc8, 0x0d, 0xa9, 0xaf, 0xe4, 0x39, 0x9f, 0x02, 0xef, 0x50, 0xbf, 0x42,
-	0x0e, 0x19, 0x0a, 0xd3, 0x35, 0x64, 0x69, 0x4f, 0x22, 0x8f, 0x93, 0x2c, 0x7f, 0x1a, 0xb0, 0xae,
-	0x47, 0xe0, 0x71, 0x69, 0xb1, 0xef, 0x2a, 0x98, 0xed, 0x53, 0x44, 0x72, 0x8d, 0x91, 0x19, 0x0e,
-	0x4b, 0xb4, 0x6f, 0x90, 0x00, 0xd4, 0x32, 0x09, 0xc0, 0x3a, 0x38, 0xe7, 0x21, 0xca, 0x82, 0x48,
-	0x56, 0xa6, 0x60, 0x26, 0xcb, 0xb0, 0x53, 0x53, 0x89, 0x98, 0xb2, 0xa9, 0xd7, 0x8f, 0x92, 0x70,
-	0x56, 0x48, 0xb2, 0x8e, 0xe7, 0x97, 0x01, 0x48, 0x3e, 0x0d, 0xd1, 0x71, 0x7d, 0x5a, 0x41, 0x1e,
-	0x78, 0xd5, 0x35, 0xd5, 0xd7, 0xc1, 0x7c, 0xbb, 0x47, 0xdd, 0xd4, 0x1e, 0xea, 0xab, 0x82, 0x76,
-	0x8f, 0x3a, 0xc9, 0x36, 0xad, 0xbf, 0xb3, 0xc4, 0x0b, 0x28, 0x43, 0xcc, 0x4e, 0x50, 0x5a, 0xbc,
-	0x18, 0x5c, 0xcb, 0xd8, 0x28, 0xd7, 0x52, 0x33, 0x5d, 0x8b, 0x7d, 0x11, 0x4c, 0x09, 0x95, 0xe7,
-	0xb4, 0xd5, 0x33, 0x26, 0x90, 0xbb, 0xb1, 0x3f, 0xb4, 0xc4, 0x6b, 0x69, 0x88, 0xc8, 0x5f, 0xf1,
-	0x5d, 0xfd, 0x40, 0xda, 0x7c, 0xa2, 0x90, 0x9f, 0x12, 0x18, 0xc7, 0xc8, 0x53, 0x5f, 0x05, 0x49,
-	0xc3, 0x7c, 0xe1, 0xcf, 0x11, 0x73, 0x36, 0x55, 0x4f, 0x6c, 0xca, 0x60, 0xe2, 0x65, 0x34, 0x68,
-	0xdb, 0xf8, 0x0f, 0x69, 0x1b, 0x3b, 0x81, 0x4f, 0x20, 0x43, 0x9b, 0xbd, 0x5e, 0x1a, 0xfb, 0xc5,
-	0xbf, 0x9c, 0x5c, 0x06, 0x33, 0x11, 0x3a, 0x76, 0xb3, 0xe4, 0x4e, 0x47, 0xe8, 0x78, 0x5b, 0x7a,
-	0x81, 0x55, 0xb0, 0x10, 0xca, 0x23, 0xb9, 0xad, 0xbb, 0xdf, 0xa5, 0xba, 0xec, 0x32, 0xaf, 0xe0,
-	0xfc, 0x5d, 0x45, 0x71, 0x24, 0xfc, 0x45, 0x10, 0x06, 0x4c, 0x48, 0x7f, 0xce, 0x91, 0x83, 0x21,
-	0x8e, 0xb7, 0x84, 0xb9, 0x18, 0xb8, 0xd0, 0x2a, 0xc0, 0x5d, 0x16, 0x63, 0x28, 0x8c, 0x19, 0x92,
-	0x1a, 0x30, 0xe7, 0x0c, 0x00, 0xb7, 0xee, 0x80, 0xd9, 0x74, 0xd6, 0x63, 0xcf, 0x81, 0x69, 0xe7,
-	0xd1, 0x27, 0x1f, 0xb8, 0x1f, 0xfc, 0xe6, 0x07, 0xdb, 0x0b, 0x67, 0xec, 0x79, 0x00, 0xc4, 0x70,
-	0x6f, 0x7f, 0xd3, 0xd9, 0x5f, 0xb0, 0x36, 0xfe, 0x6f, 0x15, 0xd4, 0x76, 0xa8, 0x6f, 0xb7, 0xc1,
-	0x6c, 0xe6, 0x63, 0xd3, 0xd7, 0x8b, 0x9f, 0x78, 0xd9, 0xaf, 0x39, 0x9b, 0xb7, 0x47, 0xc1, 0xd2,
-	0x84, 0x77, 0xc1, 0x7c, 0xee, 0x7b, 0xcf, 0x37, 0x4c, 0xeb, 0xb3, 0x78, 0xcd, 0xbb, 0xa3, 0xe1,
-	0xe9, 0x93, 0x0e, 0xc1, 0xd9, 0xfc, 0xc7, 0x7b, 0x37, 0x4c, 0x5b, 0xe4, 0x10, 0x9b, 0xeb, 0x23,
-	0x22, 0xea, 0xc3, 0x7e, 0x0f, 0x2c, 0x15, 0x7e, 0x17, 0x65, 0xbc, 0x9c, 0x22, 0xec, 0xe6, 0x5b,
-	0xa7, 0xc1, 0xd6, 0x67, 0xff, 0x0e, 0x00, 0xa9, 0x1e, 0x79, 0xcb, 0xb4, 0xc7, 0x00, 0xa7, 0x79,
-	0xab, 0x1a, 0x47, 0xef, 0xfe, 0xa7, 0x16, 0xb8, 0x54, 0xfa, 0x55, 0x4e, 0x35, 0xd1, 0x05, 0xab,
-	0x9a, 0xbf, 0xf6, 0x22, 0xab, 0x34, 0x51, 0xc7, 0xe0, 0x5c, 0xd1, 0x97, 0x32, 0x6b, 0xa6, 0x4d,
-	0x0b, 0x90, 0x9b, 0x6f, 0x9e, 0x02, 0x39, 0x7d, 0x70, 0xd1, 0x87, 0x2f, 0xc6, 0x83, 0x0b, 0x90,
-	0xcd, 0x07, 0x97, 0x7c, 0xcb, 0xc2, 0x6d, 0x33, 0xf3, 0x21, 0x8b, 0xd1, 0x36, 0xd3, 0x58, 0x66,
-	0xdb, 0x2c, 0xfc, 0x48, 0x85, 0xdb, 0x66, 0xf6, 0x83, 0x93, 0x37, 0xca, 0xa5, 0x94, 0xe0, 0x95,
-	0xd8, 0x66, 0xe1, 0x37, 0x1e, 0xfc, 0x1a, 0x8b, 0xbe, 0xef, 0x58, 0xab, 0xdc, 0x26, 0xa5, 0xc4,
-	0x6f, 0x9e, 0x02, 0x59, 0x1f, 0xfc, 0x7d, 0x0b, 0x9c, 0x37, 0x7c, 0x7b, 0x70, 0xf7, 0x14, 0xfb,
-	0xd1, 0x27, 0x1b, 0xcd, 0x77, 0x4e, 0x87, 0xaf, 0x49, 0x88, 0xc0, 0xc2, 0xd0, 0x37, 0x00, 0xab,
-	0xa6, 0xbd, 0xf2, 0x98, 0xcd, 0xaf, 0x8f, 0x8a, 0xa9, 0xcf, 0xfb, 0x21, 0x4f, 0x7d, 0x4d, 0x2d,
-	0x7a, 0xe3, 0x76, 0xa6, 0x15, 0xcd, 0x77, 0x4f, 0xbb, 0x22, 0xed, 0xa7, 0x52, 0xfd, 0xf3, 0x56,
-	0xf9, 0xf5, 0x71, 0x1c, 0xb3, 0x9f, 0x1a, 0xee, 0x87, 0xdb, 0x3f, 0xb6, 0xc0, 0x4a, 0x65, 0x37,
-	0xfc, 0xdd, 0xf2, 0x0d, 0xcd, 0x2b, 0x9b, 0xbf, 0xf1, 0xa2, 0x2b, 0x35, 0x81, 0x08, 0xcc, 0x65,
-	0x1b, 0xe3, 0xd7, 0xcd, 0x7e, 0x20, 0x85, 0xd6, 0xbc, 0x33, 0x12, 0x9a, 0x3e, 0x86, 0x01, 0xbb,
-	0xa0, 0x8d, 0x6b, 0xbc, 0xc9, 0x61, 0xdc, 0xe6, 0xc6, 0xe8, 0xb8, 0xe9, 0x53, 0x0b, 0xda, 0xa0,
-	0x15, 0xf2, 0x4b, 0xe3, 0x9a, 0x4f, 0x35, 0xf7, 0x25, 0x79, 0xd4, 0x2d, 0xec, 0x9d, 0xdd, 0x2e,
-	0xe7, 0x20, 0x8b, 0x6d, 0x8e, 0xba, 0x65, 0xad, 0x2e, 0xfb, 0x2f, 0x2c, 0xb0, 0x5c, 0xd1, 0x11,
-	0x7d, 0xa7, 0xd4, 0xfb, 0x1a, 0xd7, 0x35, 0xdf, 0x7f, 0xb1, 0x75, 0x69, 0x61, 0x14, 0xf4, 0x45,
-	0x8d, 0xc2, 0x18, 0xc6, 0x35, 0x0b, 0xc3, 0xdc, 0xcc, 0x4c, 0xb9, 0xd6, 0xa1, 0xc6, 0x5f, 0x85,
-	0x6b, 0xcd, 0xe3, 0x57, 0xb9, 0x56, 0x53, 0xc7, 0x8e, 0x07, 0xc9, 0x4c, 0xbb, 0xce, 0x18, 0x24,
-	0xd3, 0x58, 0xe6, 0x20, 0x59, 0xd8, 0x83, 0x7b, 0x0a, 0x16, 0x87, 0x1b, 0x68, 0x37, 0x4d, 0x5b,
-	0x0c, 0xa1, 0x36, 0xbf, 0x31, 0x32, 0x6a, 0xc6, 0x71, 0x0e, 0xda, 0x5b, 0x66, 0xc7, 0xa9, 0x71,
-	0x4a, 0x1c, 0xe7, 0x70, 0xcf, 0x8a, 0x01, 0xbb, 0xa0, 0xe7, 0x74, 0x6b, 0x94, 0x4b, 0x91, 0xb8,
-	0x66, 0x6d, 0x29, 0x69, 0x27, 0xfd, 0x81, 0x05, 0x2e, 0x98, 0x9a, 0x41, 0xeb, 0xe5, 0x06, 0x39,
-	0xb4, 0xa0, 0xf9, 0xcd, 0x53, 0x2e, 0x48, 0x67, 0x3c, 0xb9, 0xb6, 0x8d, 0x31, 0xe3, 0xc9, 0xe2,
-	0x99, 0x33, 0x1e, 0x43, 0xbf, 0x82, 0xf3, 0x6b, 0x2a, 0x83, 0xae, 0x57, 0x79, 0xf8, 0xdc, 0x02,
-	0x33, 0xbf, 0x15, 0x05, 0x4e, 0xfb, 0xf7, 0xc1, 0xd7, 0x8a, 0x4b, 0x60, 0x95, 0x41, 0x26, 0x83,
-	0xde, 0x7c, 0xfb, 0x54, 0xe8, 0x45, 0xc7, 0x67, 0x6b, 0xab, 0x95, 0xc7, 0x67, 0xd0, 0xab, 0x8f,
-	0x2f, 0xac, 0x62, 0x8a, 0xa7, 0x4c, 0x69, 0x09, 0xd3, 0x18, 0x09, 0xca, 0x56, 0x99, 0x9f, 0x32,
-	0xa3, 0x54, 0x26, 0x95, 0x62, 0x14, 0xd7, 0xf3, 0x4a, 0x14, 0xa3, 0x70, 0x41, 0x99, 0x62, 0x94,
-	0x97, 0xdf, 0x9e, 0x82, 0xc5, 0xe1, 0xa2, 0xd8, 0xcd, 0x91, 0x76, 0xe3, 0xa8, 0x66, 0xaf, 0x66,
-	0xae, 0x62, 0x71, 0x69, 0x94, 0x16, 0x97, 0xde, 0xaa, 0x92, 0x72, 0xd1, 0x2a, 0xb3, 0x34, 0x46,
-	0x29, 0x22, 0x09, 0x69, 0x98, 0x2a, 0x48, 0x46, 0x69, 0x18, 0x16, 0x98, 0xa5, 0x51, 0x55, 0xdd,
-	0xf9, 0x63, 0x0b, 0x34, 0x4b, 0x3a, 0x99, 0xe6, 0xe8, 0x6c, 0x5c, 0xd3, 0xbc, 0x77, 0xfa, 0x35,
-	0x9a, 0x9c, 0xbf, 0xb2, 0xc0, 0x95, 0xaa, 0x76, 0x64, 0x89, 0xe6, 0x95, 0x2e, 0x6c, 0xfe, 0xfa,
-	0x0b, 0x2e, 0xcc, 0x94, 0x5e, 0x8a, 0xfa, 0x8a, 0xb7, 0x4b, 0x62, 0xe0, 0x30, 0x19, 0x6f, 0x9d,
-	0x06, 0x3b, 0x2f, 0x28, 0x53, 0x4f, 0xb0, 0x4c, 0x50, 0x86, 0x35, 0xa5, 0x82, 0xaa, 0x68, 0x07,
-	0xa6, 0x04, 0x65, 0x6e, 0x06, 0x56, 0x08, 0xca, 0xb8, 0xb0, 0x4a, 0x50, 0x95, 0xcd, 0xc0, 0xe6,
-	0xf8, 0xf7, 0xbf, 0x78, 0x7e, 0xcb, 0xda, 0x7a, 0xf4, 0xd3, 0xcf, 0x96, 0xad, 0x9f, 0x7d, 0xb6,
-	0x6c, 0xfd, 0xef, 0x67, 0xcb, 0xd6, 0x9f, 0x7c, 0xbe, 0x7c, 0xe6, 0x67, 0x9f, 0x2f, 0x9f, 0xf9,
-	0xef, 0xcf, 0x97, 0xcf, 0x7c, 0xe7, 0x6d, 0x3f, 0x60, 0xdd, 0x7e, 0xfb, 0x6e, 0x07, 0x87, 0xeb,
-	0x31, 0xc1, 0x5e, 0xbf, 0xc3, 0x68, 0x27, 0xc8, 0xfd, 0x89, 0x7c, 0xfa, 0x6f, 0xcd, 0xd9, 0x49,
-	0x8c, 0x68, 0x7b, 0x42, 0xfc, 0xb9, 0xfc, 0x9b, 0xbf, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x71, 0x90,
-	0x86, 0xa4, 0xbb, 0x40, 0x00, 0x00,
+func (m *MsgInitiateExit) Reset()         { *m = MsgInitiateExit{} }
+func (m *MsgInitiateExit) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateExit) ProtoMessage()    {}
+func (*MsgInitiateExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{84}
+}
+func (m *MsgInitiateExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInitiateExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateExit.Merge(m, src)
+}
+func (m *MsgInitiateExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateExit.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgInitiateExit proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
-	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
-	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
-	// PoC v2 validation messages
-	SubmitPocValidationsV2(ctx context.Context, in *MsgSubmitPocValidationsV2, opts ...grpc.CallOption) (*MsgSubmitPocValidationsV2Response, error)
-	// PoC v2 off-chain commit messages
-	PoCV2StoreCommit(ctx context.Context, in *MsgPoCV2StoreCommit, opts ...grpc.CallOption) (*MsgPoCV2StoreCommitResponse, error)
-	MLNodeWeightDistribution(ctx context.Context, in *MsgMLNodeWeightDistribution, opts ...grpc.CallOption) (*MsgMLNodeWeightDistributionResponse, error)
-	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
-	RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error)
-	RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error)
-	RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error)
-	ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error)
-	RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error)
-	RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error)
-	RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error)
-	MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error)
-	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
-	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
-	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+func (m *MsgInitiateExit) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgInitiateExitResponse struct {
+	// last_epoch is the last epoch the participant is part of the epoch group
+	LastEpoch uint64 `protobuf:"varint,1,opt,name=last_epoch,json=lastEpoch,proto3" json:"last_epoch,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgInitiateExitResponse) Reset()         { *m = MsgInitiateExitResponse{} }
+func (m *MsgInitiateExitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateExitResponse) ProtoMessage()    {}
+func (*MsgInitiateExitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{85}
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgInitiateExitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateExitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateExitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgInitiateExitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateExitResponse.Merge(m, src)
+}
+func (m *MsgInitiateExitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateExitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateExitResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
-	out := new(MsgStartInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgInitiateExitResponse proto.InternalMessageInfo
+
+func (m *MsgInitiateExitResponse) GetLastEpoch() uint64 {
+	if m != nil {
+		return m.LastEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
-	out := new(MsgFinishInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgDelegateToParticipant delegates stake of the signer to a compute participant. The stake weights the
+// participant from the next epoch on.
+type MsgDelegateToParticipant struct {
+	Delegator   string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	Amount      uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
-	out := new(MsgSubmitNewParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToParticipant) Reset()         { *m = MsgDelegateToParticipant{} }
+func (m *MsgDelegateToParticipant) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToParticipant) ProtoMessage()    {}
+func (*MsgDelegateToParticipant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{86}
+}
+func (m *MsgDelegateToParticipant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToParticipant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateToParticipant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateToParticipant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToParticipant.Merge(m, src)
+}
+func (m *MsgDelegateToParticipant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToParticipant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToParticipant.DiscardUnknown(m)
 }
 
-func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
-	out := new(MsgValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDelegateToParticipant proto.InternalMessageInfo
+
+func (m *MsgDelegateToParticipant) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	out := new(MsgSubmitNewUnfundedParticipantResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToParticipant) GetParticipant() string {
+	if m != nil {
+		return m.Participant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
-	out := new(MsgInvalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToParticipant) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
-	out := new(MsgRevalidateInferenceResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgDelegateToParticipantResponse struct {
 }
 
-func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
-	out := new(MsgClaimRewardsResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgDelegateToParticipantResponse) Reset()         { *m = MsgDelegateToParticipantResponse{} }
+func (m *MsgDelegateToParticipantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateToParticipantResponse) ProtoMessage()    {}
+func (*MsgDelegateToParticipantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{87}
 }
-
-func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
-	out := new(MsgSubmitPocBatchResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgDelegateToParticipantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateToParticipantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateToParticipantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDelegateToParticipantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateToParticipantResponse.Merge(m, src)
+}
+func (m *MsgDelegateToParticipantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateToParticipantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateToParticipantResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
-	out := new(MsgSubmitPocValidationResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgDelegateToParticipantResponse proto.InternalMessageInfo
+
+// MsgUndelegate takes back stake the signer delegated to a participant. Stake that weighted epochs not
+// settled yet is returned once they are.
+type MsgUndelegate struct {
+	Delegator   string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Participant string `protobuf:"bytes,2,opt,name=participant,proto3" json:"participant,omitempty"`
+	Amount      uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) SubmitPocValidationsV2(ctx context.Context, in *MsgSubmitPocValidationsV2, opts ...grpc.CallOption) (*MsgSubmitPocValidationsV2Response, error) {
-	out := new(MsgSubmitPocValidationsV2Response)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidationsV2", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegate) Reset()         { *m = MsgUndelegate{} }
+func (m *MsgUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegate) ProtoMessage()    {}
+func (*MsgUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{88}
+}
+func (m *MsgUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegate.Merge(m, src)
+}
+func (m *MsgUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegate.DiscardUnknown(m)
 }
 
-func (c *msgClient) PoCV2StoreCommit(ctx context.Context, in *MsgPoCV2StoreCommit, opts ...grpc.CallOption) (*MsgPoCV2StoreCommitResponse, error) {
-	out := new(MsgPoCV2StoreCommitResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/PoCV2StoreCommit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegate proto.InternalMessageInfo
+
+func (m *MsgUndelegate) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) MLNodeWeightDistribution(ctx context.Context, in *MsgMLNodeWeightDistribution, opts ...grpc.CallOption) (*MsgMLNodeWeightDistributionResponse, error) {
-	out := new(MsgMLNodeWeightDistributionResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MLNodeWeightDistribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegate) GetParticipant() string {
+	if m != nil {
+		return m.Participant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
-	out := new(MsgSubmitSeedResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegate) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUndelegateResponse struct {
+	// release_epoch is the epoch whose settlement returns the stake, zero if it was returned right away
+	ReleaseEpoch uint64 `protobuf:"varint,1,opt,name=release_epoch,json=releaseEpoch,proto3" json:"release_epoch,omitempty"`
 }
 
-func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
-	out := new(MsgRegisterModelResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUndelegateResponse) Reset()         { *m = MsgUndelegateResponse{} }
+func (m *MsgUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateResponse) ProtoMessage()    {}
+func (*MsgUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{89}
+}
+func (m *MsgUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateResponse.Merge(m, src)
+}
+func (m *MsgUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
-	out := new(MsgCreateTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgUndelegateResponse) GetReleaseEpoch() uint64 {
+	if m != nil {
+		return m.ReleaseEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
-	out := new(MsgSubmitHardwareDiffResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgValidationBatch carries the validations of several inferences under one signature. Each item is
+// handled as a MsgValidation from the creator; a failing item does not fail the batch.
+type MsgValidationBatch struct {
+	Creator     string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Validations []ValidationBatchItem `protobuf:"bytes,2,rep,name=validations,proto3" json:"validations"`
 }
 
-func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
-	out := new(MsgCreatePartialUpgradeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgValidationBatch) Reset()         { *m = MsgValidationBatch{} }
+func (m *MsgValidationBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgValidationBatch) ProtoMessage()    {}
+func (*MsgValidationBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{90}
+}
+func (m *MsgValidationBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgValidationBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidationBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgValidationBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidationBatch.Merge(m, src)
+}
+func (m *MsgValidationBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgValidationBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidationBatch.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	out := new(MsgClaimTrainingTaskForAssignmentResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgValidationBatch proto.InternalMessageInfo
+
+func (m *MsgValidationBatch) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
-	out := new(MsgAssignTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgValidationBatch) GetValidations() []ValidationBatchItem {
+	if m != nil {
+		return m.Validations
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
-	out := new(MsgSubmitTrainingKvRecordResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ValidationBatchItem struct {
+	Id           string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InferenceId  string   `protobuf:"bytes,2,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	ResponseHash string   `protobuf:"bytes,3,opt,name=response_hash,json=responseHash,proto3" json:"response_hash,omitempty"`
+	Revalidation bool     `protobuf:"varint,4,opt,name=revalidation,proto3" json:"revalidation,omitempty"`
+	ValueDecimal *Decimal `protobuf:"bytes,5,opt,name=value_decimal,json=valueDecimal,proto3" json:"value_decimal,omitempty"`
 }
 
-func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
-	out := new(MsgJoinTrainingResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchItem) Reset()         { *m = ValidationBatchItem{} }
+func (m *ValidationBatchItem) String() string { return proto.CompactTextString(m) }
+func (*ValidationBatchItem) ProtoMessage()    {}
+func (*ValidationBatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{91}
+}
+func (m *ValidationBatchItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidationBatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationBatchItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidationBatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationBatchItem.Merge(m, src)
+}
+func (m *ValidationBatchItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidationBatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationBatchItem.DiscardUnknown(m)
 }
 
-func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
-	out := new(MsgTrainingHeartbeatResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidationBatchItem proto.InternalMessageInfo
+
+func (m *ValidationBatchItem) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
-	out := new(MsgSetBarrierResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchItem) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
-	out := new(MsgJoinTrainingStatusResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchItem) GetResponseHash() string {
+	if m != nil {
+		return m.ResponseHash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
-	out := new(MsgCreateDummyTrainingTaskResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchItem) GetRevalidation() bool {
+	if m != nil {
+		return m.Revalidation
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
-	out := new(MsgBridgeExchangeResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchItem) GetValueDecimal() *Decimal {
+	if m != nil {
+		return m.ValueDecimal
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error) {
-	out := new(MsgRegisterBridgeAddressesResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterBridgeAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// ValidationBatchResult is the outcome of one item of a MsgValidationBatch, in item order
+type ValidationBatchResult struct {
+	InferenceId string `protobuf:"bytes,1,opt,name=inference_id,json=inferenceId,proto3" json:"inference_id,omitempty"`
+	Accepted    bool   `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// error is why the item was rejected, empty if it was accepted
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (c *msgClient) RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error) {
-	out := new(MsgRegisterLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterLiquidityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidationBatchResult) Reset()         { *m = ValidationBatchResult{} }
+func (m *ValidationBatchResult) String() string { return proto.CompactTextString(m) }
+func (*ValidationBatchResult) ProtoMessage()    {}
+func (*ValidationBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{92}
 }
-
-func (c *msgClient) RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error) {
-	out := new(MsgRegisterTokenMetadataResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterTokenMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidationBatchResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error) {
-	out := new(MsgApproveBridgeTokenForTradingResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ApproveBridgeTokenForTrading", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidationBatchResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error) {
-	out := new(MsgRequestBridgeWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeWithdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidationBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidationBatchResult.Merge(m, src)
 }
-
-func (c *msgClient) RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error) {
-	out := new(MsgRequestBridgeMintResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeMint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidationBatchResult) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error) {
-	out := new(MsgRegisterWrappedTokenContractResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterWrappedTokenContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidationBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidationBatchResult.DiscardUnknown(m)
 }
 
-func (c *msgClient) MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error) {
-	out := new(MsgMigrateAllWrappedTokensResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MigrateAllWrappedTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_ValidationBatchResult proto.InternalMessageInfo
 
-func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
-	out := new(MsgAddUserToTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchResult) GetInferenceId() string {
+	if m != nil {
+		return m.InferenceId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	out := new(MsgRemoveUserFromTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return out, nil
+	return false
 }
 
-func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
-	out := new(MsgSetTrainingAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidationBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error) {
-	out := new(MsgAddParticipantsToAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddParticipantsToAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgValidationBatchResponse struct {
+	Results []ValidationBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error) {
-	out := new(MsgRemoveParticipantsFromAllowListResponse)
-	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveParticipantsFromAllowList", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgValidationBatchResponse) Reset()         { *m = MsgValidationBatchResponse{} }
+func (m *MsgValidationBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgValidationBatchResponse) ProtoMessage()    {}
+func (*MsgValidationBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{93}
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
-	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
-	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
-	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
-	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
-	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
-	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
-	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
-	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
-	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
-	// PoC v2 validation messages
-	SubmitPocValidationsV2(context.Context, *MsgSubmitPocValidationsV2) (*MsgSubmitPocValidationsV2Response, error)
-	// PoC v2 off-chain commit messages
-	PoCV2StoreCommit(context.Context, *MsgPoCV2StoreCommit) (*MsgPoCV2StoreCommitResponse, error)
-	MLNodeWeightDistribution(context.Context, *MsgMLNodeWeightDistribution) (*MsgMLNodeWeightDistributionResponse, error)
-	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
-	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
-	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
-	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
-	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
-	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
-	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
-	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
-	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
-	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
-	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
-	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
-	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
-	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
-	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
-	RegisterBridgeAddresses(context.Context, *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error)
-	RegisterLiquidityPool(context.Context, *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error)
-	RegisterTokenMetadata(context.Context, *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error)
-	ApproveBridgeTokenForTrading(context.Context, *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error)
-	RequestBridgeWithdrawal(context.Context, *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error)
-	RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error)
-	RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error)
-	MigrateAllWrappedTokens(context.Context, *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error)
-	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
-	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
-	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
-	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
-	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+func (m *MsgValidationBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgValidationBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgValidationBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgValidationBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgValidationBatchResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
+func (m *MsgValidationBatchResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
+func (m *MsgValidationBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgValidationBatchResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
+
+var xxx_messageInfo_MsgValidationBatchResponse proto.InternalMessageInfo
+
+func (m *MsgValidationBatchResponse) GetResults() []ValidationBatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
-func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
+
+// MsgUpdatePolicy sets one of the module's governance policies. The policy is named by one of the
+// types.Policy* constants, value is the JSON encoding of the policy's type.
+type MsgUpdatePolicy struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Policy    string `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Value     string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
-func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
+
+func (m *MsgUpdatePolicy) Reset()         { *m = MsgUpdatePolicy{} }
+func (m *MsgUpdatePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicy) ProtoMessage()    {}
+func (*MsgUpdatePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{94}
 }
-func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
+func (m *MsgUpdatePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
+func (m *MsgUpdatePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+func (m *MsgUpdatePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicy.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
+func (m *MsgUpdatePolicy) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
+func (m *MsgUpdatePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicy.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitPocValidationsV2(ctx context.Context, req *MsgSubmitPocValidationsV2) (*MsgSubmitPocValidationsV2Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidationsV2 not implemented")
+
+var xxx_messageInfo_MsgUpdatePolicy proto.InternalMessageInfo
+
+func (m *MsgUpdatePolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) PoCV2StoreCommit(ctx context.Context, req *MsgPoCV2StoreCommit) (*MsgPoCV2StoreCommitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoCV2StoreCommit not implemented")
+
+func (m *MsgUpdatePolicy) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) MLNodeWeightDistribution(ctx context.Context, req *MsgMLNodeWeightDistribution) (*MsgMLNodeWeightDistributionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MLNodeWeightDistribution not implemented")
+
+func (m *MsgUpdatePolicy) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
+
+type MsgUpdatePolicyResponse struct {
 }
-func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
+
+func (m *MsgUpdatePolicyResponse) Reset()         { *m = MsgUpdatePolicyResponse{} }
+func (m *MsgUpdatePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePolicyResponse) ProtoMessage()    {}
+func (*MsgUpdatePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09b36d0241b9acd5, []int{95}
 }
-func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
+func (m *MsgUpdatePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+func (m *MsgUpdatePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+func (m *MsgUpdatePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePolicyResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+func (m *MsgUpdatePolicyResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
-}
-func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
-}
-func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
-}
-func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
-}
-func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
-}
-func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
-}
-func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
-}
-func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
-}
-func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
-}
-func (*UnimplementedMsgServer) RegisterBridgeAddresses(ctx context.Context, req *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterBridgeAddresses not implemented")
-}
-func (*UnimplementedMsgServer) RegisterLiquidityPool(ctx context.Context, req *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterLiquidityPool not implemented")
-}
-func (*UnimplementedMsgServer) RegisterTokenMetadata(ctx context.Context, req *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterTokenMetadata not implemented")
-}
-func (*UnimplementedMsgServer) ApproveBridgeTokenForTrading(ctx context.Context, req *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApproveBridgeTokenForTrading not implemented")
-}
-func (*UnimplementedMsgServer) RequestBridgeWithdrawal(ctx context.Context, req *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeWithdrawal not implemented")
-}
-func (*UnimplementedMsgServer) RequestBridgeMint(ctx context.Context, req *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeMint not implemented")
-}
-func (*UnimplementedMsgServer) RegisterWrappedTokenContract(ctx context.Context, req *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterWrappedTokenContract not implemented")
-}
-func (*UnimplementedMsgServer) MigrateAllWrappedTokens(ctx context.Context, req *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllWrappedTokens not implemented")
-}
-func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+func (m *MsgUpdatePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePolicyResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
+
+var xxx_messageInfo_MsgUpdatePolicyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("inference.inference.TrainingRole", TrainingRole_name, TrainingRole_value)
+	proto.RegisterType((*MsgUpdateParams)(nil), "inference.inference.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "inference.inference.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStartInference)(nil), "inference.inference.MsgStartInference")
+	proto.RegisterType((*MsgStartInferenceResponse)(nil), "inference.inference.MsgStartInferenceResponse")
+	proto.RegisterType((*MsgFinishInference)(nil), "inference.inference.MsgFinishInference")
+	proto.RegisterType((*MsgFinishInferenceResponse)(nil), "inference.inference.MsgFinishInferenceResponse")
+	proto.RegisterType((*MsgSubmitNewParticipant)(nil), "inference.inference.MsgSubmitNewParticipant")
+	proto.RegisterType((*MsgSubmitNewParticipantResponse)(nil), "inference.inference.MsgSubmitNewParticipantResponse")
+	proto.RegisterType((*MsgValidation)(nil), "inference.inference.MsgValidation")
+	proto.RegisterType((*MsgValidationResponse)(nil), "inference.inference.MsgValidationResponse")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipant)(nil), "inference.inference.MsgSubmitNewUnfundedParticipant")
+	proto.RegisterType((*MsgSubmitNewUnfundedParticipantResponse)(nil), "inference.inference.MsgSubmitNewUnfundedParticipantResponse")
+	proto.RegisterType((*MsgInvalidateInference)(nil), "inference.inference.MsgInvalidateInference")
+	proto.RegisterType((*MsgInvalidateInferenceResponse)(nil), "inference.inference.MsgInvalidateInferenceResponse")
+	proto.RegisterType((*MsgRevalidateInference)(nil), "inference.inference.MsgRevalidateInference")
+	proto.RegisterType((*MsgRevalidateInferenceResponse)(nil), "inference.inference.MsgRevalidateInferenceResponse")
+	proto.RegisterType((*MsgClaimRewards)(nil), "inference.inference.MsgClaimRewards")
+	proto.RegisterType((*MsgClaimRewardsResponse)(nil), "inference.inference.MsgClaimRewardsResponse")
+	proto.RegisterType((*MsgSubmitPocBatch)(nil), "inference.inference.MsgSubmitPocBatch")
+	proto.RegisterType((*MsgSubmitPocBatchResponse)(nil), "inference.inference.MsgSubmitPocBatchResponse")
+	proto.RegisterType((*MsgSubmitPocValidation)(nil), "inference.inference.MsgSubmitPocValidation")
+	proto.RegisterType((*MsgSubmitPocValidationResponse)(nil), "inference.inference.MsgSubmitPocValidationResponse")
+	proto.RegisterType((*MsgSubmitPocValidationsV2)(nil), "inference.inference.MsgSubmitPocValidationsV2")
+	proto.RegisterType((*MsgSubmitPocValidationsV2Response)(nil), "inference.inference.MsgSubmitPocValidationsV2Response")
+	proto.RegisterType((*MsgPoCV2StoreCommit)(nil), "inference.inference.MsgPoCV2StoreCommit")
+	proto.RegisterType((*MsgPoCV2StoreCommitResponse)(nil), "inference.inference.MsgPoCV2StoreCommitResponse")
+	proto.RegisterType((*MsgMLNodeWeightDistribution)(nil), "inference.inference.MsgMLNodeWeightDistribution")
+	proto.RegisterType((*MsgMLNodeWeightDistributionResponse)(nil), "inference.inference.MsgMLNodeWeightDistributionResponse")
+	proto.RegisterType((*MsgSubmitSeed)(nil), "inference.inference.MsgSubmitSeed")
+	proto.RegisterType((*MsgSubmitSeedResponse)(nil), "inference.inference.MsgSubmitSeedResponse")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposal)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposal")
+	proto.RegisterType((*MsgSubmitUnitOfComputePriceProposalResponse)(nil), "inference.inference.MsgSubmitUnitOfComputePriceProposalResponse")
+	proto.RegisterType((*MsgRegisterModel)(nil), "inference.inference.MsgRegisterModel")
+	proto.RegisterType((*MsgRegisterModelResponse)(nil), "inference.inference.MsgRegisterModelResponse")
+	proto.RegisterType((*MsgCreateTrainingTask)(nil), "inference.inference.MsgCreateTrainingTask")
+	proto.RegisterType((*MsgCreateTrainingTaskResponse)(nil), "inference.inference.MsgCreateTrainingTaskResponse")
+	proto.RegisterType((*MsgSubmitHardwareDiff)(nil), "inference.inference.MsgSubmitHardwareDiff")
+	proto.RegisterType((*MsgSubmitHardwareDiffResponse)(nil), "inference.inference.MsgSubmitHardwareDiffResponse")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignment)(nil), "inference.inference.MsgClaimTrainingTaskForAssignment")
+	proto.RegisterType((*MsgClaimTrainingTaskForAssignmentResponse)(nil), "inference.inference.MsgClaimTrainingTaskForAssignmentResponse")
+	proto.RegisterType((*MsgAssignTrainingTask)(nil), "inference.inference.MsgAssignTrainingTask")
+	proto.RegisterType((*MsgAssignTrainingTaskResponse)(nil), "inference.inference.MsgAssignTrainingTaskResponse")
+	proto.RegisterType((*MsgCreatePartialUpgrade)(nil), "inference.inference.MsgCreatePartialUpgrade")
+	proto.RegisterType((*MsgCreatePartialUpgradeResponse)(nil), "inference.inference.MsgCreatePartialUpgradeResponse")
+	proto.RegisterType((*MsgSubmitTrainingKvRecord)(nil), "inference.inference.MsgSubmitTrainingKvRecord")
+	proto.RegisterType((*MsgSubmitTrainingKvRecordResponse)(nil), "inference.inference.MsgSubmitTrainingKvRecordResponse")
+	proto.RegisterType((*MsgJoinTraining)(nil), "inference.inference.MsgJoinTraining")
+	proto.RegisterType((*MsgJoinTrainingResponse)(nil), "inference.inference.MsgJoinTrainingResponse")
+	proto.RegisterType((*MsgTrainingHeartbeat)(nil), "inference.inference.MsgTrainingHeartbeat")
+	proto.RegisterType((*MsgTrainingHeartbeatResponse)(nil), "inference.inference.MsgTrainingHeartbeatResponse")
+	proto.RegisterType((*MsgSetBarrier)(nil), "inference.inference.MsgSetBarrier")
+	proto.RegisterType((*MsgSetBarrierResponse)(nil), "inference.inference.MsgSetBarrierResponse")
+	proto.RegisterType((*MsgJoinTrainingStatus)(nil), "inference.inference.MsgJoinTrainingStatus")
+	proto.RegisterType((*MsgJoinTrainingStatusResponse)(nil), "inference.inference.MsgJoinTrainingStatusResponse")
+	proto.RegisterType((*MsgCreateDummyTrainingTask)(nil), "inference.inference.MsgCreateDummyTrainingTask")
+	proto.RegisterType((*MsgCreateDummyTrainingTaskResponse)(nil), "inference.inference.MsgCreateDummyTrainingTaskResponse")
+	proto.RegisterType((*MsgBridgeExchange)(nil), "inference.inference.MsgBridgeExchange")
+	proto.RegisterType((*MsgBridgeExchangeResponse)(nil), "inference.inference.MsgBridgeExchangeResponse")
+	proto.RegisterType((*MsgAddUserToTrainingAllowList)(nil), "inference.inference.MsgAddUserToTrainingAllowList")
+	proto.RegisterType((*MsgAddUserToTrainingAllowListResponse)(nil), "inference.inference.MsgAddUserToTrainingAllowListResponse")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowList)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowList")
+	proto.RegisterType((*MsgRemoveUserFromTrainingAllowListResponse)(nil), "inference.inference.MsgRemoveUserFromTrainingAllowListResponse")
+	proto.RegisterType((*MsgSetTrainingAllowList)(nil), "inference.inference.MsgSetTrainingAllowList")
+	proto.RegisterType((*MsgSetTrainingAllowListResponse)(nil), "inference.inference.MsgSetTrainingAllowListResponse")
+	proto.RegisterType((*MsgAddParticipantsToAllowList)(nil), "inference.inference.MsgAddParticipantsToAllowList")
+	proto.RegisterType((*MsgAddParticipantsToAllowListResponse)(nil), "inference.inference.MsgAddParticipantsToAllowListResponse")
+	proto.RegisterType((*MsgRemoveParticipantsFromAllowList)(nil), "inference.inference.MsgRemoveParticipantsFromAllowList")
+	proto.RegisterType((*MsgRemoveParticipantsFromAllowListResponse)(nil), "inference.inference.MsgRemoveParticipantsFromAllowListResponse")
+	proto.RegisterType((*MsgRegisterBridgeAddresses)(nil), "inference.inference.MsgRegisterBridgeAddresses")
+	proto.RegisterType((*MsgRegisterBridgeAddressesResponse)(nil), "inference.inference.MsgRegisterBridgeAddressesResponse")
+	proto.RegisterType((*MsgRegisterTokenMetadata)(nil), "inference.inference.MsgRegisterTokenMetadata")
+	proto.RegisterType((*MsgRegisterTokenMetadataResponse)(nil), "inference.inference.MsgRegisterTokenMetadataResponse")
+	proto.RegisterType((*MsgApproveBridgeTokenForTrading)(nil), "inference.inference.MsgApproveBridgeTokenForTrading")
+	proto.RegisterType((*MsgApproveBridgeTokenForTradingResponse)(nil), "inference.inference.MsgApproveBridgeTokenForTradingResponse")
+	proto.RegisterType((*MsgRegisterLiquidityPool)(nil), "inference.inference.MsgRegisterLiquidityPool")
+	proto.RegisterType((*MsgRegisterLiquidityPoolResponse)(nil), "inference.inference.MsgRegisterLiquidityPoolResponse")
+	proto.RegisterType((*MsgRequestBridgeWithdrawal)(nil), "inference.inference.MsgRequestBridgeWithdrawal")
+	proto.RegisterType((*MsgRequestBridgeWithdrawalResponse)(nil), "inference.inference.MsgRequestBridgeWithdrawalResponse")
+	proto.RegisterType((*MsgRequestBridgeMint)(nil), "inference.inference.MsgRequestBridgeMint")
+	proto.RegisterType((*MsgRequestBridgeMintResponse)(nil), "inference.inference.MsgRequestBridgeMintResponse")
+	proto.RegisterType((*MsgRegisterWrappedTokenContract)(nil), "inference.inference.MsgRegisterWrappedTokenContract")
+	proto.RegisterType((*MsgRegisterWrappedTokenContractResponse)(nil), "inference.inference.MsgRegisterWrappedTokenContractResponse")
+	proto.RegisterType((*MsgMigrateAllWrappedTokens)(nil), "inference.inference.MsgMigrateAllWrappedTokens")
+	proto.RegisterType((*MsgMigrateAllWrappedTokensResponse)(nil), "inference.inference.MsgMigrateAllWrappedTokensResponse")
+	proto.RegisterType((*MsgInitiateExit)(nil), "inference.inference.MsgInitiateExit")
+	proto.RegisterType((*MsgInitiateExitResponse)(nil), "inference.inference.MsgInitiateExitResponse")
+	proto.RegisterType((*MsgDelegateToParticipant)(nil), "inference.inference.MsgDelegateToParticipant")
+	proto.RegisterType((*MsgDelegateToParticipantResponse)(nil), "inference.inference.MsgDelegateToParticipantResponse")
+	proto.RegisterType((*MsgUndelegate)(nil), "inference.inference.MsgUndelegate")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "inference.inference.MsgUndelegateResponse")
+	proto.RegisterType((*MsgValidationBatch)(nil), "inference.inference.MsgValidationBatch")
+	proto.RegisterType((*ValidationBatchItem)(nil), "inference.inference.ValidationBatchItem")
+	proto.RegisterType((*ValidationBatchResult)(nil), "inference.inference.ValidationBatchResult")
+	proto.RegisterType((*MsgValidationBatchResponse)(nil), "inference.inference.MsgValidationBatchResponse")
+	proto.RegisterType((*MsgUpdatePolicy)(nil), "inference.inference.MsgUpdatePolicy")
+	proto.RegisterType((*MsgUpdatePolicyResponse)(nil), "inference.inference.MsgUpdatePolicyResponse")
 }
-func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
+
+func init() { proto.RegisterFile("inference/inference/tx.proto", fileDescriptor_09b36d0241b9acd5) }
+
+var fileDescriptor_09b36d0241b9acd5 = []byte{
+	// 4351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x5b, 0x6c, 0x1c, 0xc9,
+	0x71, 0x1a, 0xee, 0xf2, 0xd5, 0x7c, 0x88, 0x1a, 0xf1, 0xa8, 0xd5, 0x9e, 0x8e, 0xa2, 0x56, 0xa7,
+	0x13, 0x45, 0x4a, 0xa2, 0xcd, 0x7b, 0x58, 0xd1, 0x19, 0x97, 0x90, 0x94, 0xce, 0xc7, 0xbb, 0xa3,
+	0x44, 0x0c, 0x29, 0x5d, 0xe2, 0x24, 0x18, 0xcc, 0xee, 0x34, 0x77, 0xc7, 0xdc, 0x99, 0x1e, 0x75,
+	0xf7, 0x92, 0x62, 0x0c, 0x03, 0x86, 0x93, 0x38, 0x80, 0x11, 0x04, 0x41, 0xf2, 0x91, 0x20, 0x09,
+	0x8c, 0x24, 0x46, 0x80, 0x00, 0xf9, 0x88, 0x3e, 0xf2, 0x93, 0xaf, 0x00, 0x79, 0x00, 0xf7, 0x69,
+	0x18, 0x09, 0x10, 0xc0, 0x80, 0x11, 0xdc, 0x7d, 0xdc, 0x7f, 0xfe, 0x03, 0x04, 0xfd, 0x98, 0x9e,
+	0x67, 0xcf, 0x2c, 0x75, 0xd2, 0xc1, 0x3f, 0xd2, 0x4e, 0x75, 0x75, 0x77, 0x55, 0x57, 0x75, 0x55,
+	0x75, 0x55, 0x81, 0xe0, 0x92, 0x17, 0x1c, 0x40, 0x0c, 0x83, 0x0e, 0x5c, 0x8b, 0x7f, 0xd1, 0xa7,
+	0xb7, 0x43, 0x8c, 0x28, 0x32, 0xcf, 0x2b, 0xd8, 0x6d, 0xf5, 0xab, 0x79, 0xce, 0xf1, 0xbd, 0x00,
+	0xad, 0xf1, 0x7f, 0x05, 0x5e, 0xf3, 0x42, 0x07, 0x11, 0x1f, 0x91, 0x35, 0x9f, 0x74, 0xd7, 0x8e,
+	0xbe, 0xce, 0xfe, 0x93, 0x03, 0x17, 0xc5, 0x80, 0xcd, 0xbf, 0xd6, 0xc4, 0x87, 0x1c, 0x9a, 0xef,
+	0xa2, 0x2e, 0x12, 0x70, 0xf6, 0x2b, 0x9a, 0xd0, 0x45, 0xa8, 0xdb, 0x87, 0x6b, 0xfc, 0xab, 0x3d,
+	0x38, 0x58, 0x73, 0x82, 0x13, 0x39, 0xb4, 0x54, 0x44, 0x6a, 0xe8, 0x60, 0xc7, 0x8f, 0x96, 0xbc,
+	0x5e, 0xc8, 0x0c, 0x76, 0xbc, 0xc0, 0x0b, 0xba, 0x36, 0x75, 0xc8, 0x61, 0x19, 0x62, 0xcf, 0xc1,
+	0xee, 0xb1, 0x83, 0xa1, 0x1d, 0x20, 0x17, 0x4a, 0xc4, 0x37, 0x8a, 0x10, 0x03, 0x48, 0x8f, 0x11,
+	0x3e, 0x4c, 0xe2, 0x15, 0xd2, 0xd6, 0xc6, 0x9e, 0xdb, 0x2d, 0xc5, 0x08, 0x51, 0xc7, 0x3e, 0x5a,
+	0x17, 0x18, 0xad, 0x7f, 0x33, 0xc0, 0xd9, 0x1d, 0xd2, 0x7d, 0x14, 0xba, 0x0e, 0x85, 0xbb, 0x9c,
+	0x2f, 0xf3, 0x1d, 0x30, 0xe9, 0x0c, 0x68, 0x0f, 0x61, 0x8f, 0x9e, 0x34, 0x8c, 0x25, 0x63, 0x79,
+	0x72, 0xb3, 0xf1, 0xb3, 0x7f, 0xba, 0x35, 0x2f, 0x4f, 0x72, 0xc3, 0x75, 0x31, 0x24, 0x64, 0x8f,
+	0x62, 0x2f, 0xe8, 0x5a, 0x31, 0xaa, 0xf9, 0x1e, 0x18, 0x13, 0x27, 0xd3, 0x18, 0x59, 0x32, 0x96,
+	0xa7, 0xd6, 0x5f, 0xbd, 0x5d, 0x20, 0xc9, 0xdb, 0x62, 0x93, 0xcd, 0xc9, 0x4f, 0x7f, 0x71, 0xf9,
+	0xcc, 0xdf, 0x7f, 0xf1, 0x6c, 0xc5, 0xb0, 0xe4, 0xac, 0xbb, 0x77, 0x7e, 0xf0, 0xc5, 0xb3, 0x95,
+	0x78, 0xbd, 0x1f, 0x7d, 0xf1, 0x6c, 0xe5, 0x5a, 0x4c, 0xf6, 0xd3, 0x04, 0x0b, 0x19, 0x8a, 0x5b,
+	0x17, 0xc1, 0x85, 0x0c, 0xc8, 0x82, 0x24, 0x44, 0x01, 0x81, 0xad, 0x7f, 0xac, 0x83, 0x73, 0x3b,
+	0xa4, 0xbb, 0x47, 0x1d, 0x4c, 0xb7, 0xa3, 0x05, 0xcc, 0x06, 0x18, 0xef, 0x60, 0xe8, 0x50, 0x84,
+	0x05, 0x83, 0x56, 0xf4, 0x69, 0x5e, 0x01, 0xd3, 0x6a, 0x1f, 0xdb, 0x73, 0x39, 0x2b, 0x93, 0xd6,
+	0x94, 0x82, 0x6d, 0xbb, 0xe6, 0x65, 0x30, 0x15, 0x62, 0xe4, 0x87, 0xd4, 0xee, 0x39, 0xa4, 0xd7,
+	0xa8, 0x71, 0x0c, 0x20, 0x40, 0x1f, 0x38, 0xa4, 0x67, 0xde, 0x00, 0xb3, 0x12, 0x21, 0x74, 0x4e,
+	0xfa, 0xc8, 0x71, 0x1b, 0x75, 0x7e, 0x8a, 0x23, 0x0d, 0xc3, 0x9a, 0x11, 0x23, 0xbb, 0x62, 0xc0,
+	0x9c, 0x07, 0xa3, 0x3e, 0x72, 0x61, 0xbf, 0x31, 0xc6, 0x57, 0x11, 0x1f, 0x8c, 0x08, 0x0c, 0x9f,
+	0x0c, 0x20, 0xa1, 0xd0, 0xb5, 0xdb, 0x27, 0x8d, 0x71, 0x41, 0x84, 0x82, 0x6d, 0x9e, 0x30, 0x22,
+	0x1c, 0x42, 0xbc, 0x6e, 0x00, 0x5d, 0x9b, 0xa2, 0xc6, 0x84, 0x20, 0x22, 0x02, 0xed, 0x23, 0xb6,
+	0x06, 0xd3, 0x15, 0xfb, 0x08, 0x62, 0xe2, 0xa1, 0xa0, 0x31, 0x29, 0xd6, 0x60, 0xb0, 0xc7, 0x02,
+	0x64, 0xbe, 0x06, 0x80, 0xef, 0x3c, 0xb5, 0x29, 0x3a, 0x84, 0x01, 0x69, 0x80, 0x25, 0x63, 0xb9,
+	0x6e, 0x4d, 0xfa, 0xce, 0xd3, 0x7d, 0x0e, 0x30, 0x6f, 0x02, 0x53, 0xb2, 0xc1, 0x31, 0xec, 0x0e,
+	0x1a, 0x04, 0xb4, 0x31, 0xc5, 0xd1, 0xe6, 0xc4, 0x08, 0xc7, 0xdc, 0x62, 0x70, 0x73, 0x15, 0x9c,
+	0x93, 0xf4, 0xd9, 0xd4, 0xf3, 0x21, 0xa1, 0x8e, 0x1f, 0x36, 0xa6, 0x97, 0x8c, 0xe5, 0x9a, 0x35,
+	0x27, 0x07, 0xf6, 0x23, 0xb8, 0x79, 0x0b, 0x98, 0x14, 0x3b, 0x01, 0x39, 0x80, 0xd8, 0x66, 0x14,
+	0x3b, 0x74, 0x80, 0x61, 0x63, 0x96, 0x93, 0x78, 0x2e, 0x1a, 0xd9, 0x8b, 0x06, 0xcc, 0x55, 0x70,
+	0x16, 0x61, 0xaf, 0xeb, 0x05, 0x4e, 0xdf, 0x16, 0x1b, 0x37, 0xce, 0xaa, 0x13, 0x9d, 0x8d, 0x86,
+	0x76, 0xf9, 0x88, 0xf9, 0x35, 0x30, 0x9f, 0x41, 0x16, 0x72, 0x9a, 0xe3, 0xab, 0x9b, 0x69, 0x6c,
+	0x26, 0xaf, 0xbb, 0xd3, 0x4c, 0xf1, 0x22, 0x0d, 0x68, 0x79, 0xe0, 0x62, 0x4e, 0x61, 0x22, 0x75,
+	0x32, 0xaf, 0x83, 0xb3, 0x09, 0xf5, 0x08, 0x5c, 0xf8, 0x54, 0x2a, 0xd0, 0x6c, 0xac, 0x21, 0x0c,
+	0x6a, 0x5e, 0x05, 0x33, 0x10, 0x63, 0x84, 0x6d, 0x1f, 0x12, 0xe2, 0x74, 0xa1, 0x54, 0xa4, 0x69,
+	0x0e, 0xdc, 0x11, 0xb0, 0xd6, 0x4f, 0x46, 0x81, 0xb9, 0x43, 0xba, 0xef, 0x7b, 0x81, 0x47, 0x7a,
+	0x2f, 0x48, 0x3b, 0xaf, 0x82, 0x19, 0x2c, 0xa9, 0x4d, 0xea, 0xe7, 0x74, 0x04, 0xe4, 0x1a, 0x7a,
+	0x0b, 0xcc, 0x29, 0xa4, 0xbc, 0x8e, 0x9e, 0x8d, 0xc6, 0x22, 0x2d, 0x2d, 0xd6, 0x84, 0x51, 0x8d,
+	0x26, 0xbc, 0x05, 0x16, 0x3a, 0xc8, 0x0f, 0xfb, 0x90, 0x7a, 0x28, 0x48, 0xcd, 0x18, 0xe3, 0x33,
+	0xe6, 0xe3, 0xd1, 0xc4, 0xac, 0xcb, 0x60, 0x0a, 0x3e, 0x85, 0x9d, 0x41, 0x4a, 0xe5, 0x41, 0x04,
+	0xda, 0x3c, 0x31, 0xaf, 0x81, 0xd9, 0x48, 0x33, 0xb0, 0xc0, 0x11, 0x4a, 0x3f, 0x93, 0x80, 0x6e,
+	0x9e, 0x14, 0xeb, 0xe1, 0xe4, 0xa9, 0xf4, 0x10, 0xe8, 0xf4, 0xf0, 0x16, 0x30, 0x05, 0x41, 0x28,
+	0x89, 0x3e, 0x25, 0xd0, 0xa3, 0x91, 0x18, 0x3d, 0x7b, 0x8d, 0xa7, 0xf3, 0xd7, 0xb8, 0x40, 0xb3,
+	0x67, 0xb4, 0x9a, 0xad, 0x8c, 0xc5, 0x6c, 0xd2, 0x58, 0x64, 0xcc, 0xd1, 0xd9, 0x9c, 0x39, 0xfa,
+	0xb2, 0x17, 0xe2, 0x3b, 0xa0, 0x99, 0x57, 0xd2, 0x97, 0x74, 0x23, 0xfe, 0xcc, 0xe0, 0xa6, 0x7c,
+	0x6f, 0xd0, 0xf6, 0x3d, 0xfa, 0x00, 0x1e, 0xef, 0x3a, 0x98, 0x7a, 0x1d, 0x2f, 0x74, 0x02, 0x5a,
+	0x72, 0x2d, 0xe6, 0x40, 0x6d, 0x80, 0xfb, 0x72, 0x41, 0xf6, 0x93, 0x6d, 0x76, 0xe4, 0xf4, 0x3d,
+	0x97, 0x0d, 0xdb, 0x87, 0xf0, 0x24, 0xba, 0x05, 0x0a, 0xf8, 0x11, 0x3c, 0x61, 0xf6, 0x8f, 0xf9,
+	0x54, 0x28, 0x30, 0xb8, 0xfe, 0x5b, 0x93, 0x02, 0xf2, 0x11, 0x3c, 0xc9, 0x9c, 0xc2, 0x01, 0xb8,
+	0xac, 0x21, 0x4c, 0x1d, 0xc5, 0x2a, 0x38, 0x17, 0xc6, 0xe0, 0xd4, 0x61, 0xcc, 0x25, 0x06, 0xc4,
+	0x71, 0x2c, 0x80, 0x31, 0x42, 0x1d, 0x3a, 0x20, 0x92, 0x6c, 0xf9, 0xd5, 0xfa, 0x97, 0x11, 0x30,
+	0xb3, 0x43, 0xba, 0x8f, 0x05, 0xa1, 0xcc, 0x4c, 0xeb, 0xf9, 0x9e, 0x05, 0x23, 0xca, 0x08, 0x8c,
+	0x78, 0x6e, 0xce, 0x3c, 0xd4, 0xf2, 0xe6, 0xe1, 0x94, 0x37, 0x3f, 0x67, 0x4d, 0x46, 0x0b, 0xac,
+	0x49, 0x03, 0x8c, 0x1e, 0x39, 0xfd, 0x01, 0xe4, 0xf7, 0xdb, 0xe0, 0x0b, 0x09, 0x80, 0xd9, 0x62,
+	0x37, 0xe0, 0x48, 0xb1, 0xc2, 0x6f, 0xf5, 0x84, 0x95, 0x82, 0x99, 0x1b, 0x5c, 0x54, 0x03, 0x68,
+	0xbb, 0xb0, 0xe3, 0xf9, 0x4e, 0x9f, 0x5f, 0xeb, 0xa9, 0xf5, 0x4b, 0x85, 0xd1, 0xc3, 0x3d, 0x81,
+	0xc3, 0x05, 0x39, 0x80, 0xf2, 0x2b, 0x23, 0xa9, 0x0b, 0xe0, 0x95, 0xd4, 0x01, 0xaa, 0x58, 0xe0,
+	0x67, 0x46, 0x5a, 0x86, 0x8f, 0x82, 0x83, 0x41, 0xe0, 0x42, 0x77, 0x38, 0x25, 0x6b, 0x80, 0x71,
+	0x47, 0x84, 0x3e, 0xf2, 0xc4, 0xa3, 0xcf, 0x48, 0xfd, 0x6a, 0xb1, 0xfa, 0x5d, 0x00, 0xe3, 0xe1,
+	0xa0, 0x9d, 0x50, 0xab, 0xb1, 0x70, 0xd0, 0x66, 0x2a, 0x97, 0xd3, 0xcb, 0xd1, 0x4a, 0xbd, 0x1c,
+	0x2b, 0xd7, 0xcb, 0x1b, 0xe0, 0x7a, 0x05, 0x4f, 0x8a, 0xff, 0xdf, 0x37, 0xc0, 0xc2, 0x0e, 0xe9,
+	0x6e, 0x07, 0x72, 0x37, 0xf8, 0x82, 0x5c, 0xce, 0x12, 0x98, 0xf2, 0x02, 0xc5, 0x41, 0xac, 0x75,
+	0x0a, 0x94, 0x21, 0x79, 0x09, 0x2c, 0x16, 0x93, 0x91, 0xa5, 0xd4, 0x82, 0xbf, 0x14, 0x94, 0x16,
+	0x90, 0xa1, 0x28, 0x0d, 0x78, 0xfc, 0xbc, 0xd5, 0x77, 0x3c, 0xdf, 0x82, 0xc7, 0x0e, 0x76, 0x49,
+	0x09, 0x85, 0x26, 0xa8, 0x13, 0x08, 0x05, 0x65, 0x35, 0x8b, 0xff, 0xe6, 0x7e, 0x2f, 0x44, 0x9d,
+	0x9e, 0x34, 0x17, 0x35, 0xee, 0x22, 0x01, 0x07, 0x71, 0x43, 0x91, 0xa1, 0x68, 0x9b, 0xdb, 0xc7,
+	0xe4, 0x7e, 0xca, 0xfc, 0x2c, 0x80, 0x31, 0xc7, 0xe7, 0x7e, 0xd6, 0xe0, 0x8b, 0xc8, 0x2f, 0x06,
+	0xc7, 0x90, 0x0c, 0xfa, 0x34, 0xb2, 0x34, 0xe2, 0xab, 0xf5, 0x73, 0x43, 0x84, 0xc6, 0x5c, 0x75,
+	0x76, 0x51, 0x67, 0xd3, 0xa1, 0x9d, 0x5e, 0x09, 0xf5, 0xef, 0x81, 0x4b, 0xec, 0xed, 0x40, 0xa8,
+	0xd3, 0x85, 0xec, 0x5f, 0x4c, 0xed, 0x76, 0x1f, 0x75, 0x0e, 0xed, 0x1e, 0xf4, 0xba, 0x3d, 0x2a,
+	0xb9, 0x6a, 0x84, 0xa8, 0xb3, 0xc7, 0x50, 0x78, 0x04, 0xb5, 0xc9, 0x10, 0x3e, 0xe0, 0xe3, 0xe6,
+	0x45, 0x30, 0xd1, 0x66, 0x5b, 0xc4, 0x96, 0x69, 0x9c, 0x7f, 0x6f, 0xbb, 0x8c, 0xc4, 0x00, 0x05,
+	0x1d, 0x48, 0x1a, 0xf5, 0xa5, 0xda, 0x72, 0xcd, 0x92, 0x5f, 0xec, 0xc0, 0x5c, 0x8f, 0xb0, 0x50,
+	0xa3, 0xb6, 0x6c, 0x58, 0xfc, 0x37, 0xbb, 0x5b, 0x3c, 0xb0, 0xf5, 0x5c, 0x79, 0x35, 0xc6, 0xd8,
+	0xe7, 0xb6, 0x9b, 0x39, 0xa8, 0x57, 0x45, 0x18, 0x97, 0x62, 0x4e, 0x49, 0xed, 0x9f, 0x6b, 0x5c,
+	0xbf, 0xd4, 0xe8, 0x50, 0xd6, 0x76, 0x0d, 0x9c, 0x4f, 0x9a, 0xf7, 0xb4, 0x31, 0x30, 0x13, 0x43,
+	0xf2, 0x85, 0x54, 0x79, 0x60, 0xb5, 0x8a, 0x03, 0x3b, 0xcd, 0xa9, 0x70, 0x43, 0xdd, 0x81, 0xde,
+	0x11, 0x74, 0x6d, 0x3e, 0x38, 0xc6, 0x07, 0xa7, 0x23, 0xe0, 0x3d, 0x86, 0x74, 0x11, 0x4c, 0x60,
+	0x9b, 0x3a, 0xb8, 0x0b, 0x29, 0x37, 0xc5, 0x86, 0x35, 0x8e, 0xf7, 0xf9, 0x27, 0x73, 0xe3, 0x07,
+	0xd8, 0x19, 0xb8, 0x36, 0xed, 0x61, 0x48, 0x7a, 0xa8, 0xef, 0x72, 0x3b, 0x6c, 0x58, 0xb3, 0x1c,
+	0xbc, 0x1f, 0x41, 0xcd, 0x57, 0xc1, 0x64, 0x60, 0xcb, 0x1b, 0x23, 0xe3, 0xaa, 0x89, 0x40, 0xde,
+	0x65, 0x16, 0x20, 0x85, 0x18, 0xb5, 0x9d, 0xb6, 0xd7, 0xf7, 0xe8, 0x89, 0xdd, 0x43, 0x01, 0x24,
+	0x94, 0xc7, 0x53, 0x86, 0x75, 0x2e, 0x31, 0xf2, 0x01, 0x1f, 0x60, 0x21, 0x9d, 0xd8, 0xd4, 0x85,
+	0x14, 0x76, 0x28, 0x74, 0x79, 0x2c, 0x35, 0x61, 0xcd, 0x70, 0xe8, 0x3d, 0x09, 0x2c, 0xbc, 0x93,
+	0x05, 0xa2, 0x4b, 0xda, 0xf9, 0x8b, 0xc5, 0x28, 0xe4, 0xf1, 0xfa, 0x4b, 0x54, 0xf0, 0x1d, 0x30,
+	0x15, 0xfb, 0x35, 0xd2, 0xa8, 0x2d, 0xd5, 0x96, 0xa7, 0xd6, 0x57, 0x8b, 0x5f, 0xc1, 0x68, 0x2b,
+	0xa6, 0x4a, 0x3a, 0xdb, 0xc7, 0xeb, 0x56, 0x72, 0x7e, 0x86, 0xed, 0xab, 0xe0, 0x8a, 0x96, 0x27,
+	0xc5, 0xf9, 0x33, 0x03, 0x9c, 0xdf, 0x21, 0x5d, 0xb6, 0xfa, 0xfa, 0x1e, 0x45, 0x18, 0x6e, 0x21,
+	0xdf, 0xf7, 0xe8, 0x4b, 0xe4, 0x79, 0x1e, 0x8c, 0x8a, 0xd8, 0x9e, 0x29, 0xf3, 0x8c, 0x25, 0x3e,
+	0x98, 0x92, 0x60, 0x84, 0x64, 0x9c, 0xc9, 0x3c, 0xe0, 0xb4, 0x35, 0xc1, 0x00, 0x05, 0xd1, 0xe5,
+	0x6b, 0xe0, 0xd5, 0x02, 0x8a, 0x15, 0x47, 0xff, 0x6a, 0xf0, 0xf1, 0x9d, 0x8f, 0x1f, 0x20, 0x17,
+	0x7e, 0xc2, 0xf7, 0x64, 0xaa, 0x8c, 0xbd, 0xf6, 0xa0, 0xe2, 0xba, 0x7e, 0x59, 0xce, 0xde, 0x05,
+	0xe3, 0xc7, 0xfc, 0x57, 0x24, 0xc9, 0x2b, 0x85, 0x92, 0x4c, 0x52, 0x66, 0x45, 0x33, 0x32, 0x3c,
+	0x5e, 0x03, 0x57, 0x4b, 0x78, 0x50, 0xbc, 0x1e, 0xf1, 0xc8, 0x4f, 0x88, 0x78, 0x8f, 0xf9, 0x06,
+	0x3d, 0x73, 0x19, 0xaf, 0x31, 0x92, 0xf5, 0x1a, 0xe6, 0x25, 0x30, 0x19, 0xbf, 0x50, 0x84, 0xb5,
+	0x8d, 0x01, 0x85, 0x01, 0x53, 0xbc, 0xaf, 0x22, 0xa8, 0xc3, 0xe9, 0x16, 0x03, 0x8f, 0x02, 0x8f,
+	0x3e, 0x3c, 0xd8, 0x42, 0x7e, 0x38, 0xa0, 0x70, 0x17, 0x7b, 0x1d, 0xb8, 0x8b, 0x51, 0x88, 0x88,
+	0xd3, 0x2f, 0x21, 0x73, 0x1e, 0x8c, 0x86, 0x0c, 0x55, 0x12, 0x28, 0x3e, 0x32, 0xbb, 0xdf, 0x02,
+	0xab, 0x43, 0x6c, 0xa2, 0x68, 0xfa, 0x8b, 0x1a, 0x98, 0xe3, 0x3e, 0xb9, 0xeb, 0x11, 0x0a, 0xf1,
+	0x0e, 0x7f, 0x03, 0x5d, 0xca, 0xa5, 0xac, 0x92, 0x89, 0x29, 0xf1, 0x42, 0x0a, 0x11, 0x11, 0xcf,
+	0xb0, 0x11, 0xf5, 0x42, 0xe2, 0xa0, 0xcd, 0x13, 0x19, 0x47, 0xd7, 0x54, 0x1c, 0x7d, 0x17, 0x34,
+	0x07, 0x81, 0x47, 0x89, 0x8d, 0x0e, 0xec, 0x8e, 0x20, 0xc6, 0x0e, 0x21, 0x16, 0x6f, 0x59, 0xae,
+	0xcf, 0x75, 0x6b, 0x81, 0x63, 0xc4, 0xc4, 0x42, 0xcc, 0x1f, 0xb3, 0xcc, 0x3d, 0xf5, 0x0e, 0x6c,
+	0x0c, 0x43, 0x24, 0x63, 0xbb, 0xb1, 0xde, 0x81, 0x05, 0x43, 0xc4, 0xee, 0x44, 0x8f, 0x2f, 0xe7,
+	0x7b, 0x54, 0x7a, 0xae, 0x89, 0xde, 0x81, 0xbc, 0xa0, 0xaf, 0x01, 0xc0, 0x5f, 0x73, 0xb6, 0x83,
+	0xbb, 0xa4, 0x31, 0xbe, 0x54, 0x63, 0x1c, 0x70, 0xc8, 0x06, 0xee, 0x12, 0xf3, 0x3c, 0x18, 0x3d,
+	0xb2, 0xb1, 0xe3, 0x73, 0x9b, 0x5c, 0xb7, 0xea, 0x47, 0x96, 0xe3, 0xb3, 0x77, 0x1d, 0xed, 0x61,
+	0x34, 0xe8, 0xf6, 0xc2, 0x01, 0xe5, 0xf4, 0x71, 0xff, 0xc0, 0x8d, 0x72, 0xdd, 0x32, 0xe3, 0xb1,
+	0x5d, 0x88, 0x1f, 0xb0, 0x11, 0xf3, 0x21, 0x98, 0x8f, 0x0d, 0x4c, 0xc2, 0xd2, 0x83, 0x21, 0x22,
+	0xee, 0xf3, 0xf1, 0x4c, 0xe5, 0x0c, 0xee, 0xce, 0xa6, 0x53, 0x76, 0xad, 0x26, 0x68, 0x64, 0x65,
+	0xa3, 0x04, 0xf7, 0x73, 0x83, 0xab, 0xd9, 0x16, 0x13, 0x3b, 0xdc, 0x97, 0x09, 0xd2, 0x7d, 0x87,
+	0x1c, 0x96, 0xe8, 0xcf, 0x6f, 0x03, 0x53, 0x65, 0x48, 0x31, 0x24, 0x68, 0x80, 0x99, 0x37, 0x1c,
+	0xe1, 0xd7, 0xf1, 0x76, 0x21, 0xb9, 0xd1, 0xc2, 0x1f, 0xc8, 0x69, 0x56, 0x34, 0xcb, 0x3a, 0xd7,
+	0xcb, 0x82, 0xcc, 0x77, 0xc1, 0x58, 0x07, 0x05, 0x07, 0x5e, 0x97, 0xcb, 0x7e, 0x6a, 0xfd, 0x6a,
+	0xe9, 0x92, 0x5b, 0x1c, 0xd5, 0x92, 0x53, 0x32, 0x5a, 0xfc, 0x18, 0xbc, 0x56, 0xc8, 0x9c, 0x8a,
+	0xce, 0xde, 0x06, 0x75, 0xea, 0x90, 0x43, 0xce, 0xa1, 0xce, 0x96, 0xa4, 0x26, 0x72, 0xf4, 0xd6,
+	0x7f, 0x18, 0x89, 0xcb, 0x19, 0x31, 0x75, 0xcf, 0x3b, 0x38, 0x28, 0x39, 0xb5, 0x6f, 0x81, 0x99,
+	0x00, 0x1e, 0x3f, 0x64, 0xe7, 0xef, 0x1d, 0x78, 0x3c, 0xde, 0xd4, 0xdb, 0xaf, 0x68, 0x4d, 0x66,
+	0x9b, 0xac, 0xf4, 0x3c, 0x66, 0x02, 0x31, 0xf4, 0xd1, 0x11, 0x74, 0x4b, 0x4d, 0x60, 0x6a, 0x89,
+	0x68, 0x46, 0xe6, 0x7c, 0x2e, 0xf3, 0xf3, 0xc9, 0xb3, 0xa1, 0xd4, 0xc3, 0xe5, 0xfe, 0x8d, 0x07,
+	0xb6, 0xc9, 0x63, 0x78, 0x1f, 0xe1, 0x0d, 0x9e, 0xd4, 0xf4, 0x61, 0xe9, 0xeb, 0xec, 0x02, 0x18,
+	0x67, 0xe7, 0x15, 0xc5, 0xfd, 0x75, 0x6b, 0x8c, 0x7d, 0xe6, 0xa2, 0xc2, 0x55, 0x70, 0xa3, 0x72,
+	0x17, 0x45, 0xd2, 0xdf, 0x88, 0xb3, 0x17, 0x23, 0x43, 0x6a, 0xac, 0x8e, 0x0e, 0xf3, 0x5b, 0x60,
+	0x52, 0x66, 0x67, 0x61, 0xe4, 0x50, 0x6e, 0x54, 0x2a, 0xc1, 0x86, 0x9c, 0x61, 0xc5, 0x73, 0x0b,
+	0xcf, 0x35, 0x4f, 0xa2, 0x62, 0xe2, 0x1f, 0x44, 0x46, 0x45, 0x68, 0x26, 0x7f, 0x15, 0x3a, 0xfd,
+	0x47, 0x61, 0x17, 0x3b, 0x2e, 0xac, 0x30, 0x9b, 0x0b, 0x60, 0x2c, 0xe1, 0x2a, 0xeb, 0x96, 0xfc,
+	0x62, 0x8f, 0xa8, 0x44, 0x16, 0x39, 0x7a, 0x44, 0x25, 0x13, 0xcb, 0xcb, 0xe0, 0xac, 0x13, 0x7a,
+	0x9b, 0x5e, 0xe0, 0x60, 0x0f, 0x92, 0x0f, 0x09, 0x0a, 0xe4, 0x33, 0x38, 0x0b, 0xce, 0x19, 0x90,
+	0x2b, 0xfc, 0x85, 0x5e, 0x44, 0xac, 0x62, 0xe8, 0xef, 0x92, 0xd1, 0x5d, 0xc4, 0xf2, 0x47, 0x47,
+	0x16, 0xec, 0x20, 0x5c, 0xe6, 0x32, 0x17, 0x80, 0x14, 0x45, 0x46, 0x30, 0x4b, 0x60, 0x2a, 0x11,
+	0xbb, 0x47, 0xec, 0x24, 0x40, 0xec, 0x7d, 0x1f, 0xbf, 0xe4, 0xd9, 0x4f, 0xe6, 0xd7, 0x44, 0xc6,
+	0x43, 0x98, 0x78, 0xf1, 0x51, 0x12, 0xb0, 0x65, 0xc9, 0x54, 0xcc, 0x0c, 0xf8, 0xf3, 0xf1, 0x43,
+	0xe4, 0x29, 0xe1, 0x95, 0x70, 0x70, 0x17, 0xd4, 0x30, 0x7c, 0x22, 0xab, 0x2b, 0xcb, 0x85, 0xca,
+	0x93, 0x5c, 0xc9, 0x12, 0x79, 0x46, 0x8b, 0x4d, 0xca, 0xd0, 0xf6, 0x1b, 0x5c, 0x27, 0xd2, 0xc8,
+	0xd2, 0x4e, 0xbd, 0xa7, 0xf2, 0x52, 0xc2, 0x52, 0xbd, 0x51, 0x12, 0xf5, 0xf0, 0xc9, 0x7b, 0x1c,
+	0x5b, 0xe5, 0xaf, 0x8e, 0xc1, 0xfc, 0x0e, 0xe9, 0x2a, 0x33, 0x0c, 0x1d, 0x4c, 0xdb, 0xd0, 0x29,
+	0xbb, 0xba, 0xdf, 0x48, 0xb2, 0x75, 0xad, 0xd8, 0xc2, 0x44, 0xcb, 0x94, 0xf0, 0xf4, 0x6d, 0x70,
+	0xa9, 0x68, 0x63, 0xc5, 0xd8, 0x5d, 0x50, 0xc7, 0x90, 0x84, 0xa5, 0x6c, 0xe5, 0x66, 0x59, 0x7c,
+	0x4e, 0xeb, 0x89, 0x88, 0xcc, 0x20, 0xdd, 0x74, 0x30, 0xf6, 0x20, 0x2e, 0xe1, 0xe6, 0x4e, 0x92,
+	0x9b, 0xe2, 0x5d, 0xe2, 0x75, 0x4a, 0xd8, 0xd9, 0x17, 0x76, 0x3f, 0x81, 0x2a, 0xf9, 0x78, 0x37,
+	0xc5, 0xc7, 0xf5, 0xca, 0x1d, 0x52, 0x8c, 0x7c, 0x97, 0xaf, 0x9a, 0x14, 0xbc, 0x10, 0xdf, 0x57,
+	0xa2, 0x75, 0x36, 0xb7, 0x55, 0xf9, 0xcd, 0x5f, 0x98, 0xee, 0x7d, 0x97, 0x67, 0xaa, 0x85, 0xf5,
+	0xb8, 0x37, 0xf0, 0xfd, 0x93, 0x21, 0x8d, 0x76, 0xe4, 0x9b, 0x47, 0x4e, 0xe5, 0x9b, 0x33, 0xdc,
+	0xfd, 0x26, 0x68, 0xe9, 0x37, 0xff, 0xb2, 0x61, 0xc0, 0x7f, 0x8d, 0xf0, 0x5c, 0xcd, 0x26, 0xaf,
+	0xee, 0xde, 0x7f, 0xda, 0xe9, 0x39, 0x41, 0x97, 0xdb, 0xef, 0x38, 0x99, 0x25, 0xed, 0xb7, 0x02,
+	0x30, 0xc3, 0x26, 0x72, 0xfb, 0x5b, 0x3d, 0xc7, 0x0b, 0xa2, 0x74, 0x58, 0x02, 0xc4, 0xec, 0x74,
+	0x07, 0x05, 0x14, 0x3b, 0x9d, 0x28, 0x67, 0x21, 0xcd, 0x5f, 0x16, 0x6c, 0xb6, 0xc0, 0x34, 0x3a,
+	0x0e, 0x20, 0x8e, 0xd0, 0x84, 0x2d, 0x4c, 0xc1, 0xf8, 0x7e, 0xec, 0x7b, 0x97, 0xa7, 0x3a, 0xa5,
+	0x69, 0x4c, 0x82, 0x12, 0x19, 0x2a, 0x99, 0xb9, 0x91, 0x19, 0xaa, 0x25, 0x30, 0xc5, 0x9f, 0x66,
+	0x0f, 0x06, 0x7e, 0x1b, 0xe2, 0xa8, 0xdc, 0x99, 0x00, 0x89, 0x44, 0x72, 0x07, 0x7a, 0xa1, 0xc8,
+	0x9e, 0xcb, 0xd2, 0x4f, 0x0a, 0x96, 0xc0, 0x21, 0x16, 0x42, 0x54, 0x56, 0x3c, 0x53, 0x30, 0xe9,
+	0x6f, 0xd4, 0x19, 0xb5, 0x56, 0xb9, 0x2f, 0x49, 0x1f, 0xab, 0x92, 0x95, 0x78, 0x16, 0x18, 0xd1,
+	0xb3, 0xa0, 0xf5, 0x63, 0x43, 0x38, 0x5b, 0xd7, 0x7d, 0x44, 0x58, 0xb8, 0x1f, 0xc9, 0x69, 0xa3,
+	0xdf, 0x47, 0xc7, 0x1f, 0x7b, 0x84, 0x56, 0x38, 0x54, 0x7d, 0x06, 0xf9, 0x6d, 0x50, 0xc7, 0xa8,
+	0x2f, 0x1e, 0x6a, 0xb3, 0x15, 0x5a, 0x61, 0xa1, 0x3e, 0xbb, 0xcd, 0xa8, 0x0f, 0x73, 0xde, 0xf3,
+	0x3a, 0xb8, 0x56, 0x4a, 0x9f, 0x72, 0x3b, 0x3f, 0x31, 0xb8, 0xb2, 0x5a, 0x3c, 0x54, 0x63, 0xc8,
+	0xef, 0x63, 0xe4, 0xff, 0xd2, 0xb1, 0x73, 0x13, 0xac, 0x54, 0x13, 0xa9, 0x78, 0xfa, 0x2b, 0x59,
+	0x3a, 0x82, 0xf4, 0xb4, 0x8c, 0xb0, 0x51, 0x41, 0xb9, 0x7c, 0x5c, 0xb0, 0xd1, 0x08, 0xf0, 0xa2,
+	0x98, 0x11, 0x91, 0x4d, 0x11, 0x75, 0x8a, 0x83, 0xc3, 0x48, 0xbd, 0x12, 0xc9, 0x7b, 0xb2, 0x8f,
+	0x5e, 0x08, 0x1b, 0x7a, 0x5d, 0xd1, 0x6c, 0xa6, 0xa8, 0x0a, 0x13, 0xaa, 0x92, 0xc4, 0x65, 0xd2,
+	0x78, 0x39, 0xa4, 0x25, 0xe5, 0xae, 0xdd, 0x51, 0xd1, 0xf7, 0x43, 0x83, 0x5b, 0xfd, 0xe8, 0xd1,
+	0x29, 0xee, 0xf2, 0x86, 0x12, 0x5f, 0x25, 0x61, 0x1d, 0x66, 0x0a, 0x1f, 0x38, 0x7e, 0x54, 0x90,
+	0x8c, 0x01, 0x69, 0xb2, 0x6b, 0x55, 0x64, 0xbf, 0x2e, 0x0f, 0xaa, 0x90, 0x0e, 0x45, 0xee, 0xff,
+	0x1a, 0xa9, 0x37, 0x32, 0x4f, 0x1a, 0xec, 0x40, 0xea, 0xb8, 0x0e, 0x75, 0xaa, 0x2f, 0x1c, 0xa7,
+	0x6d, 0x3b, 0xaa, 0x6d, 0x44, 0x9f, 0xa7, 0x30, 0xe4, 0x26, 0xa8, 0x07, 0x8c, 0x57, 0x61, 0xc0,
+	0xf9, 0x6f, 0x5e, 0x8a, 0x3c, 0xf1, 0xdb, 0xa8, 0x1f, 0x65, 0x2c, 0xc4, 0x97, 0xd9, 0x04, 0x13,
+	0xb2, 0x26, 0x47, 0xb8, 0xc1, 0x9e, 0xb1, 0xd4, 0x37, 0xa3, 0x14, 0x1d, 0x41, 0x7c, 0x8c, 0x3d,
+	0x0a, 0x65, 0x59, 0x2f, 0x06, 0xe4, 0x8e, 0xa6, 0x05, 0x96, 0x74, 0x3c, 0xab, 0x83, 0xf9, 0x53,
+	0x51, 0x9d, 0xdb, 0x08, 0x43, 0x8c, 0x8e, 0xa0, 0x38, 0x3e, 0x8e, 0xf9, 0x3e, 0xc2, 0xfb, 0xd8,
+	0x71, 0x59, 0x6c, 0xfc, 0xd2, 0xcf, 0x27, 0x47, 0xb9, 0x28, 0xaf, 0x95, 0x11, 0xa5, 0x18, 0xf8,
+	0xeb, 0xb4, 0x64, 0x3f, 0xf6, 0x9e, 0x0c, 0x3c, 0xd7, 0xa3, 0x27, 0xbb, 0x08, 0x55, 0x65, 0xa8,
+	0x2e, 0x80, 0xf1, 0x8e, 0xac, 0x69, 0xc8, 0x1a, 0x4d, 0x87, 0xd7, 0x34, 0xd8, 0x43, 0xa3, 0xef,
+	0xb4, 0x61, 0x54, 0x5c, 0x14, 0x1f, 0xa2, 0xe6, 0x4e, 0xa8, 0x13, 0x50, 0xcf, 0xa1, 0xd0, 0xf6,
+	0x49, 0x57, 0xca, 0x73, 0x36, 0x01, 0xde, 0x21, 0xdd, 0x0a, 0x39, 0xa4, 0x28, 0x4c, 0xe6, 0x90,
+	0xc5, 0x7d, 0xe2, 0x71, 0x9c, 0x60, 0xf9, 0x13, 0x8f, 0xf6, 0x5c, 0xec, 0x1c, 0x97, 0x26, 0xfb,
+	0xae, 0x80, 0xe9, 0x01, 0x81, 0x38, 0x53, 0x18, 0x99, 0x62, 0xb0, 0x48, 0xfb, 0xe2, 0x00, 0xa0,
+	0x96, 0x0a, 0x00, 0xd6, 0xc0, 0x79, 0x17, 0x12, 0xea, 0x05, 0x22, 0x33, 0xe5, 0xa4, 0xa2, 0x0c,
+	0x33, 0x31, 0x14, 0x89, 0x29, 0x1d, 0x7a, 0xfd, 0x28, 0x72, 0x67, 0x85, 0x24, 0x2b, 0x7f, 0xfe,
+	0x1a, 0x00, 0x51, 0x6b, 0x88, 0xf2, 0xeb, 0x93, 0x12, 0xb2, 0xed, 0x56, 0xe7, 0x54, 0x5f, 0x07,
+	0xb3, 0xed, 0x3e, 0xb1, 0x13, 0x6b, 0xc8, 0xae, 0x82, 0x76, 0x9f, 0x58, 0xd1, 0x32, 0xad, 0xbf,
+	0x35, 0xf8, 0x0b, 0x28, 0x45, 0xcc, 0x8e, 0x57, 0x9a, 0xbc, 0x88, 0x8f, 0x65, 0x64, 0x98, 0x63,
+	0xa9, 0xe9, 0x8e, 0xc5, 0xbc, 0x08, 0x26, 0xb8, 0xca, 0x33, 0xda, 0xea, 0xa9, 0x2b, 0x90, 0x39,
+	0xb1, 0xdf, 0x33, 0xf8, 0x6b, 0x29, 0x47, 0xe4, 0x57, 0x7c, 0x56, 0x3f, 0x10, 0x77, 0x3e, 0x52,
+	0xc8, 0x4f, 0xb0, 0x13, 0x86, 0xd0, 0x95, 0x5d, 0x41, 0xe2, 0x62, 0x3e, 0x77, 0x3b, 0x62, 0xe6,
+	0x4e, 0xd5, 0xa3, 0x3b, 0xa5, 0xb9, 0xe2, 0x65, 0x34, 0xa8, 0xbb, 0xf1, 0xef, 0xe2, 0x6e, 0xec,
+	0x78, 0x5d, 0xec, 0x50, 0xb8, 0xd1, 0xef, 0x27, 0xb1, 0x9f, 0xbf, 0x73, 0x72, 0x11, 0x4c, 0x05,
+	0xf0, 0xd8, 0x4e, 0x93, 0x3b, 0x19, 0xc0, 0xe3, 0x2d, 0x61, 0x05, 0x96, 0xc1, 0x9c, 0x2f, 0xb6,
+	0x64, 0x77, 0xdd, 0xfe, 0x0e, 0x51, 0x69, 0x97, 0x59, 0x09, 0x67, 0xef, 0x2a, 0x82, 0x02, 0x6e,
+	0x2f, 0x3c, 0xdf, 0xa3, 0x5c, 0xfa, 0x33, 0x96, 0xf8, 0xc8, 0x71, 0xbc, 0xc9, 0xaf, 0x8b, 0x86,
+	0x0b, 0xa5, 0x02, 0xcc, 0x64, 0x51, 0x0a, 0xfd, 0x90, 0x42, 0xa1, 0x01, 0x33, 0x56, 0x0c, 0x68,
+	0xfd, 0x0a, 0xcf, 0x5c, 0x6c, 0x07, 0x1e, 0x37, 0x36, 0xf7, 0x9f, 0x96, 0x55, 0x99, 0x32, 0xca,
+	0x77, 0x87, 0x07, 0x6a, 0xc9, 0xa9, 0x49, 0xb5, 0xeb, 0x3b, 0x84, 0xda, 0x5c, 0x93, 0x64, 0x1d,
+	0x7b, 0x92, 0x41, 0xee, 0x33, 0x00, 0xd3, 0x17, 0x66, 0x62, 0xef, 0xc1, 0x3e, 0xec, 0x3a, 0x14,
+	0xee, 0xa3, 0x64, 0xeb, 0xc6, 0x25, 0x30, 0xe9, 0x8a, 0x81, 0xf8, 0x35, 0xa4, 0x00, 0xd9, 0x34,
+	0xcf, 0x48, 0x3e, 0xcd, 0x93, 0x36, 0x4e, 0xaa, 0x7e, 0x2e, 0x4f, 0x4f, 0xad, 0x24, 0x8d, 0x68,
+	0x21, 0x0d, 0x4a, 0x51, 0x8e, 0x79, 0xc2, 0xe0, 0x51, 0x20, 0x67, 0xc1, 0xaf, 0x8c, 0xb8, 0x6f,
+	0xf2, 0x07, 0x7e, 0xbc, 0xb1, 0x3a, 0x59, 0x5e, 0x20, 0xee, 0x43, 0x87, 0xc0, 0xd4, 0xe1, 0x4e,
+	0x4b, 0xa0, 0x38, 0xdf, 0x3f, 0x32, 0x78, 0x43, 0x62, 0x5c, 0x5c, 0xac, 0xea, 0x09, 0xd8, 0x4d,
+	0x97, 0x3c, 0x45, 0xa2, 0xb9, 0x38, 0x49, 0x90, 0x59, 0x74, 0x9b, 0x42, 0x7f, 0xb3, 0xfe, 0xe9,
+	0x2f, 0x2e, 0x9f, 0x29, 0xab, 0x7a, 0xfe, 0xa7, 0x01, 0xce, 0x17, 0x4c, 0xcc, 0x3e, 0xcd, 0x5e,
+	0x58, 0x63, 0x64, 0xb6, 0x61, 0xa9, 0x3e, 0x4c, 0xc3, 0xd2, 0xe8, 0x69, 0x1b, 0x96, 0x5a, 0x7d,
+	0xf0, 0x4a, 0x86, 0x2b, 0x8b, 0xf7, 0x64, 0xe4, 0xf8, 0x30, 0xf2, 0x7c, 0x34, 0xc1, 0x84, 0xd3,
+	0xe9, 0x40, 0x7e, 0x2b, 0x47, 0x38, 0x79, 0xea, 0x9b, 0x5d, 0x7f, 0xde, 0x4e, 0x17, 0x85, 0x0b,
+	0xfc, 0xa3, 0xd5, 0xe3, 0x46, 0x2b, 0xbf, 0xa1, 0x50, 0x8c, 0x0f, 0xc1, 0xb8, 0x68, 0x08, 0x21,
+	0x0d, 0x83, 0x8b, 0x6f, 0x65, 0x18, 0xf1, 0x09, 0x7a, 0xa5, 0x00, 0xa3, 0x05, 0x5a, 0x7f, 0x90,
+	0x6a, 0x27, 0x47, 0x7d, 0xaf, 0x73, 0xf2, 0xdc, 0x46, 0x71, 0x01, 0x8c, 0x85, 0x7c, 0x85, 0xc8,
+	0x29, 0x8a, 0xaf, 0x38, 0xf7, 0x5a, 0x4b, 0xe6, 0x5e, 0xb3, 0x26, 0x2e, 0xd5, 0x12, 0xce, 0x27,
+	0x46, 0x0c, 0xaf, 0xdc, 0x02, 0xd3, 0xc9, 0xf7, 0x9a, 0x39, 0x03, 0x26, 0xad, 0x87, 0x1f, 0xdf,
+	0xb7, 0xef, 0xff, 0xfa, 0xfd, 0xad, 0xb9, 0x33, 0xe6, 0x2c, 0x00, 0xfc, 0x73, 0x6f, 0x7f, 0xc3,
+	0xda, 0x9f, 0x33, 0xd6, 0xff, 0xef, 0x26, 0xa8, 0xed, 0x90, 0xae, 0xd9, 0x06, 0xd3, 0xa9, 0x36,
+	0xf9, 0xd7, 0x8b, 0x93, 0x53, 0xe9, 0x3e, 0xf4, 0xe6, 0xcd, 0x61, 0xb0, 0x94, 0x2c, 0x7a, 0x60,
+	0x36, 0xd3, 0xa9, 0xfe, 0x86, 0x6e, 0x7e, 0x1a, 0xaf, 0x79, 0x7b, 0x38, 0x3c, 0xb5, 0xd3, 0x21,
+	0x38, 0x9b, 0x6d, 0x3b, 0xbe, 0xae, 0x5b, 0x22, 0x83, 0xd8, 0x5c, 0x1b, 0x12, 0x51, 0x6d, 0xf6,
+	0x3b, 0x60, 0xbe, 0xb0, 0xa3, 0x53, 0x7b, 0x38, 0x45, 0xd8, 0xcd, 0xb7, 0x4e, 0x83, 0xad, 0xf6,
+	0xfe, 0x2d, 0x00, 0x12, 0xdd, 0x3d, 0x2d, 0xdd, 0x1a, 0x31, 0x4e, 0x73, 0xa5, 0x1a, 0x47, 0xad,
+	0xfe, 0x27, 0x06, 0xb8, 0x54, 0xda, 0x4f, 0x58, 0x4d, 0x74, 0xc1, 0xac, 0xe6, 0x37, 0x9f, 0x67,
+	0x96, 0x22, 0xea, 0x18, 0x9c, 0x2f, 0xea, 0xf1, 0x5b, 0xd5, 0x2d, 0x5a, 0x80, 0xdc, 0x7c, 0xf3,
+	0x14, 0xc8, 0xc9, 0x8d, 0x8b, 0x5a, 0xf6, 0xb4, 0x1b, 0x17, 0x20, 0xeb, 0x37, 0x2e, 0xe9, 0xc2,
+	0x63, 0x77, 0x33, 0xd5, 0x82, 0xa7, 0xbd, 0x9b, 0x49, 0x2c, 0xfd, 0xdd, 0x2c, 0x6c, 0xaf, 0x63,
+	0x77, 0x33, 0xdd, 0x2a, 0xf7, 0x46, 0xb9, 0x94, 0x22, 0xbc, 0x92, 0xbb, 0x59, 0xd8, 0x9d, 0xc6,
+	0x8e, 0xb1, 0xa8, 0x33, 0x6d, 0xb5, 0x72, 0x99, 0x84, 0x12, 0xbf, 0x79, 0x0a, 0x64, 0xb5, 0xf1,
+	0xf7, 0x0d, 0xb0, 0xa0, 0xe9, 0x9a, 0xba, 0x7d, 0x8a, 0xf5, 0xc8, 0xe3, 0xf5, 0xe6, 0x3b, 0xa7,
+	0xc3, 0x57, 0x24, 0x04, 0x60, 0x2e, 0xd7, 0xbd, 0xb4, 0xac, 0x5b, 0x2b, 0x8b, 0xd9, 0xfc, 0xda,
+	0xb0, 0x98, 0x6a, 0xbf, 0x1f, 0xb2, 0x88, 0x52, 0xd7, 0x5c, 0xa4, 0x5d, 0x4e, 0x37, 0xa3, 0x79,
+	0xe7, 0xb4, 0x33, 0x92, 0x76, 0x2a, 0xd1, 0xf9, 0xd3, 0x2a, 0x3f, 0x3e, 0x86, 0xa3, 0xb7, 0x53,
+	0xf9, 0x4e, 0x1e, 0xf3, 0xc7, 0x06, 0x58, 0xaa, 0xec, 0xe3, 0xb9, 0x53, 0xbe, 0xa0, 0x7e, 0x66,
+	0xf3, 0xd7, 0x9e, 0x77, 0xa6, 0x22, 0x10, 0x82, 0x99, 0x74, 0x4b, 0xcf, 0x35, 0xbd, 0x1d, 0x48,
+	0xa0, 0x35, 0x6f, 0x0d, 0x85, 0xa6, 0xb6, 0xa1, 0xc0, 0x2c, 0x68, 0x40, 0xd1, 0x9e, 0x64, 0x1e,
+	0xb7, 0xb9, 0x3e, 0x3c, 0x6e, 0x72, 0xd7, 0x82, 0x06, 0x8e, 0x0a, 0xf9, 0x25, 0x71, 0xf5, 0xbb,
+	0xea, 0x3b, 0x2a, 0x98, 0xd7, 0x2d, 0xac, 0xfa, 0xdf, 0x2c, 0xe7, 0x20, 0x8d, 0xad, 0xf7, 0xba,
+	0x65, 0x45, 0x7a, 0xf3, 0xcf, 0x0d, 0xb0, 0x58, 0xd1, 0xcb, 0xf1, 0x4e, 0xa9, 0xf5, 0xd5, 0xce,
+	0x6b, 0xbe, 0xf7, 0x7c, 0xf3, 0x92, 0xc2, 0x28, 0xe8, 0xe8, 0xd0, 0x0a, 0x23, 0x8f, 0xab, 0x17,
+	0x86, 0xbe, 0x0d, 0x23, 0x61, 0x5a, 0x73, 0x2d, 0x0b, 0x15, 0xa6, 0x35, 0x8b, 0x5f, 0x65, 0x5a,
+	0x75, 0xbd, 0x06, 0xcc, 0x49, 0xa6, 0x1a, 0x0d, 0xb4, 0x4e, 0x32, 0x89, 0xa5, 0x77, 0x92, 0x85,
+	0xdd, 0x03, 0x4f, 0xc0, 0xb9, 0x7c, 0xe9, 0xff, 0x86, 0x6e, 0x89, 0x1c, 0x6a, 0xf3, 0xeb, 0x43,
+	0xa3, 0xa6, 0x0c, 0x67, 0x5c, 0x98, 0xd7, 0x1b, 0x4e, 0x85, 0x53, 0x62, 0x38, 0xf3, 0xd5, 0x76,
+	0x0a, 0xcc, 0x82, 0x6a, 0xf9, 0xca, 0x30, 0x87, 0x22, 0x70, 0xf5, 0xda, 0x52, 0x52, 0x08, 0xff,
+	0x5d, 0x03, 0x5c, 0xd0, 0x95, 0xb1, 0xd7, 0xca, 0x2f, 0x64, 0x6e, 0x42, 0xf3, 0x1b, 0xa7, 0x9c,
+	0x90, 0x8c, 0x78, 0x32, 0x05, 0x67, 0x6d, 0xc4, 0x93, 0xc6, 0xd3, 0x47, 0x3c, 0x9a, 0x4a, 0x2b,
+	0xe3, 0x57, 0x57, 0xc0, 0x59, 0xab, 0xb2, 0xf0, 0x99, 0x09, 0x7a, 0x7e, 0x2b, 0x4a, 0x33, 0xe6,
+	0xf7, 0xc0, 0x2b, 0xc5, 0xc9, 0xfb, 0x4a, 0x27, 0x93, 0x42, 0x6f, 0xbe, 0x7d, 0x2a, 0xf4, 0xa2,
+	0xed, 0xd3, 0x55, 0xa1, 0xca, 0xed, 0x53, 0xe8, 0xd5, 0xdb, 0x17, 0xd6, 0x5f, 0xf8, 0x53, 0xa6,
+	0xb4, 0xf8, 0xa2, 0xf5, 0x04, 0x65, 0xb3, 0xf4, 0x4f, 0x99, 0x61, 0x6a, 0x2a, 0x52, 0x31, 0x8a,
+	0x2b, 0x11, 0x25, 0x8a, 0x51, 0x38, 0xa1, 0x4c, 0x31, 0xca, 0x0b, 0x07, 0x4f, 0xc0, 0xb9, 0x7c,
+	0x3a, 0xff, 0xc6, 0x50, 0xab, 0x31, 0x54, 0xbd, 0x55, 0xd3, 0xe7, 0xdf, 0x99, 0x34, 0x4a, 0xd3,
+	0xe2, 0x6f, 0x55, 0x49, 0xb9, 0x68, 0x96, 0x5e, 0x1a, 0xc3, 0xa4, 0xbf, 0xb9, 0x34, 0x74, 0xb9,
+	0x6f, 0xad, 0x34, 0x34, 0x13, 0xf4, 0xd2, 0xa8, 0xca, 0x4b, 0xff, 0xa1, 0x01, 0x9a, 0x25, 0x3d,
+	0x18, 0x7a, 0xef, 0xac, 0x9d, 0xd3, 0xbc, 0x7b, 0xfa, 0x39, 0x8a, 0x9c, 0xbf, 0x34, 0xc0, 0xe5,
+	0xaa, 0x46, 0x8a, 0x12, 0xcd, 0x2b, 0x9d, 0xd8, 0xfc, 0xd5, 0xe7, 0x9c, 0x98, 0x4a, 0xbd, 0x14,
+	0x75, 0x44, 0xdc, 0x2c, 0xf1, 0x81, 0x79, 0x32, 0xde, 0x3a, 0x0d, 0x76, 0x56, 0x50, 0xba, 0x6e,
+	0x86, 0x32, 0x41, 0x69, 0xe6, 0x94, 0x0a, 0xaa, 0xa2, 0x91, 0x21, 0x21, 0x28, 0x7d, 0x1b, 0x43,
+	0x85, 0xa0, 0xb4, 0x13, 0xab, 0x04, 0x55, 0xd9, 0xc6, 0xc0, 0xa2, 0xb3, 0x54, 0x31, 0xe5, 0x75,
+	0x7d, 0x02, 0x26, 0xc6, 0xd2, 0x47, 0x67, 0x85, 0xd5, 0x95, 0xef, 0x81, 0x57, 0x8a, 0x4b, 0x27,
+	0x5a, 0x0f, 0x53, 0x88, 0xae, 0xf7, 0x30, 0xa5, 0x45, 0x11, 0x16, 0xa9, 0x25, 0x2a, 0x22, 0xda,
+	0x48, 0x2d, 0xc6, 0xd1, 0x47, 0x6a, 0x05, 0x05, 0x8e, 0x43, 0x70, 0x36, 0x5b, 0xb7, 0xb8, 0x5e,
+	0x9d, 0xc9, 0x13, 0x19, 0x9a, 0xb5, 0x21, 0x11, 0x93, 0xd2, 0x4a, 0x25, 0xb9, 0xab, 0x92, 0xc1,
+	0x1c, 0xab, 0x32, 0x19, 0x9c, 0xca, 0x53, 0x37, 0x47, 0xbf, 0xff, 0xc5, 0xb3, 0x15, 0x63, 0xf3,
+	0xe1, 0xa7, 0x9f, 0x2d, 0x1a, 0x3f, 0xfd, 0x6c, 0xd1, 0xf8, 0x9f, 0xcf, 0x16, 0x8d, 0x3f, 0xfe,
+	0x7c, 0xf1, 0xcc, 0x4f, 0x3f, 0x5f, 0x3c, 0xf3, 0xdf, 0x9f, 0x2f, 0x9e, 0xf9, 0xf6, 0xdb, 0x5d,
+	0x8f, 0xf6, 0x06, 0xed, 0xdb, 0x1d, 0xe4, 0xaf, 0x85, 0x18, 0xb9, 0x83, 0x0e, 0x25, 0x1d, 0x2f,
+	0xf3, 0xe7, 0x5e, 0x92, 0x7f, 0x37, 0x85, 0x9e, 0x84, 0x90, 0xb4, 0xc7, 0xf8, 0x9f, 0x7e, 0x79,
+	0xf3, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x09, 0xac, 0xe6, 0x87, 0x47, 0x00, 0x00,
 }
-func (*UnimplementedMsgServer) AddParticipantsToAllowList(ctx context.Context, req *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddParticipantsToAllowList not implemented")
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error)
+	FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error)
+	Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error)
+	// PoC v2 validation messages
+	SubmitPocValidationsV2(ctx context.Context, in *MsgSubmitPocValidationsV2, opts ...grpc.CallOption) (*MsgSubmitPocValidationsV2Response, error)
+	// PoC v2 off-chain commit messages
+	PoCV2StoreCommit(ctx context.Context, in *MsgPoCV2StoreCommit, opts ...grpc.CallOption) (*MsgPoCV2StoreCommitResponse, error)
+	MLNodeWeightDistribution(ctx context.Context, in *MsgMLNodeWeightDistribution, opts ...grpc.CallOption) (*MsgMLNodeWeightDistributionResponse, error)
+	SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error)
+	RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error)
+	RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error)
+	RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error)
+	ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error)
+	RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error)
+	RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error)
+	RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error)
+	MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error)
+	AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error)
+	AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error)
+	RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	InitiateExit(ctx context.Context, in *MsgInitiateExit, opts ...grpc.CallOption) (*MsgInitiateExitResponse, error)
+	DelegateToParticipant(ctx context.Context, in *MsgDelegateToParticipant, opts ...grpc.CallOption) (*MsgDelegateToParticipantResponse, error)
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	ValidationBatch(ctx context.Context, in *MsgValidationBatch, opts ...grpc.CallOption) (*MsgValidationBatchResponse, error)
+	UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error)
 }
-func (*UnimplementedMsgServer) RemoveParticipantsFromAllowList(ctx context.Context, req *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
+
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStartInference)
-	if err := dec(in); err != nil {
+func (c *msgClient) StartInference(ctx context.Context, in *MsgStartInference, opts ...grpc.CallOption) (*MsgStartInferenceResponse, error) {
+	out := new(MsgStartInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/StartInference", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).StartInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/StartInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFinishInference)
-	if err := dec(in); err != nil {
+func (c *msgClient) FinishInference(ctx context.Context, in *MsgFinishInference, opts ...grpc.CallOption) (*MsgFinishInferenceResponse, error) {
+	out := new(MsgFinishInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/FinishInference", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).FinishInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/FinishInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewParticipant)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitNewParticipant(ctx context.Context, in *MsgSubmitNewParticipant, opts ...grpc.CallOption) (*MsgSubmitNewParticipantResponse, error) {
+	out := new(MsgSubmitNewParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewParticipant", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValidation)
-	if err := dec(in); err != nil {
+func (c *msgClient) Validation(ctx context.Context, in *MsgValidation, opts ...grpc.CallOption) (*MsgValidationResponse, error) {
+	out := new(MsgValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Validation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Validation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/Validation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitNewUnfundedParticipant)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitNewUnfundedParticipant(ctx context.Context, in *MsgSubmitNewUnfundedParticipant, opts ...grpc.CallOption) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	out := new(MsgSubmitNewUnfundedParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitNewUnfundedParticipant", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgInvalidateInference)
-	if err := dec(in); err != nil {
+func (c *msgClient) InvalidateInference(ctx context.Context, in *MsgInvalidateInference, opts ...grpc.CallOption) (*MsgInvalidateInferenceResponse, error) {
+	out := new(MsgInvalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InvalidateInference", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).InvalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/InvalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevalidateInference)
-	if err := dec(in); err != nil {
+func (c *msgClient) RevalidateInference(ctx context.Context, in *MsgRevalidateInference, opts ...grpc.CallOption) (*MsgRevalidateInferenceResponse, error) {
+	out := new(MsgRevalidateInferenceResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RevalidateInference", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevalidateInference(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RevalidateInference",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimRewards)
-	if err := dec(in); err != nil {
+func (c *msgClient) ClaimRewards(ctx context.Context, in *MsgClaimRewards, opts ...grpc.CallOption) (*MsgClaimRewardsResponse, error) {
+	out := new(MsgClaimRewardsResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimRewards", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocBatch)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitPocBatch(ctx context.Context, in *MsgSubmitPocBatch, opts ...grpc.CallOption) (*MsgSubmitPocBatchResponse, error) {
+	out := new(MsgSubmitPocBatchResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocBatch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocValidation)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitPocValidation(ctx context.Context, in *MsgSubmitPocValidation, opts ...grpc.CallOption) (*MsgSubmitPocValidationResponse, error) {
+	out := new(MsgSubmitPocValidationResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidation", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocValidation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitPocValidationsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitPocValidationsV2)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitPocValidationsV2(ctx context.Context, in *MsgSubmitPocValidationsV2, opts ...grpc.CallOption) (*MsgSubmitPocValidationsV2Response, error) {
+	out := new(MsgSubmitPocValidationsV2Response)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitPocValidationsV2", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitPocValidationsV2(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitPocValidationsV2",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitPocValidationsV2(ctx, req.(*MsgSubmitPocValidationsV2))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_PoCV2StoreCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPoCV2StoreCommit)
-	if err := dec(in); err != nil {
+func (c *msgClient) PoCV2StoreCommit(ctx context.Context, in *MsgPoCV2StoreCommit, opts ...grpc.CallOption) (*MsgPoCV2StoreCommitResponse, error) {
+	out := new(MsgPoCV2StoreCommitResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/PoCV2StoreCommit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PoCV2StoreCommit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/PoCV2StoreCommit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PoCV2StoreCommit(ctx, req.(*MsgPoCV2StoreCommit))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_MLNodeWeightDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMLNodeWeightDistribution)
-	if err := dec(in); err != nil {
+func (c *msgClient) MLNodeWeightDistribution(ctx context.Context, in *MsgMLNodeWeightDistribution, opts ...grpc.CallOption) (*MsgMLNodeWeightDistributionResponse, error) {
+	out := new(MsgMLNodeWeightDistributionResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MLNodeWeightDistribution", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MLNodeWeightDistribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/MLNodeWeightDistribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MLNodeWeightDistribution(ctx, req.(*MsgMLNodeWeightDistribution))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitSeed)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitSeed(ctx context.Context, in *MsgSubmitSeed, opts ...grpc.CallOption) (*MsgSubmitSeedResponse, error) {
+	out := new(MsgSubmitSeedResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitSeed", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitSeed",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitUnitOfComputePriceProposal)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitUnitOfComputePriceProposal(ctx context.Context, in *MsgSubmitUnitOfComputePriceProposal, opts ...grpc.CallOption) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	out := new(MsgSubmitUnitOfComputePriceProposalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitUnitOfComputePriceProposal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterModel)
-	if err := dec(in); err != nil {
+func (c *msgClient) RegisterModel(ctx context.Context, in *MsgRegisterModel, opts ...grpc.CallOption) (*MsgRegisterModelResponse, error) {
+	out := new(MsgRegisterModelResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterModel", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterModel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterModel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTrainingTask)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateTrainingTask(ctx context.Context, in *MsgCreateTrainingTask, opts ...grpc.CallOption) (*MsgCreateTrainingTaskResponse, error) {
+	out := new(MsgCreateTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateTrainingTask", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitHardwareDiff)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitHardwareDiff(ctx context.Context, in *MsgSubmitHardwareDiff, opts ...grpc.CallOption) (*MsgSubmitHardwareDiffResponse, error) {
+	out := new(MsgSubmitHardwareDiffResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitHardwareDiff", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePartialUpgrade)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreatePartialUpgrade(ctx context.Context, in *MsgCreatePartialUpgrade, opts ...grpc.CallOption) (*MsgCreatePartialUpgradeResponse, error) {
+	out := new(MsgCreatePartialUpgradeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreatePartialUpgrade", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimTrainingTaskForAssignment)
-	if err := dec(in); err != nil {
+func (c *msgClient) ClaimTrainingTaskForAssignment(ctx context.Context, in *MsgClaimTrainingTaskForAssignment, opts ...grpc.CallOption) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	out := new(MsgClaimTrainingTaskForAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ClaimTrainingTaskForAssignment", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAssignTrainingTask)
-	if err := dec(in); err != nil {
+func (c *msgClient) AssignTrainingTask(ctx context.Context, in *MsgAssignTrainingTask, opts ...grpc.CallOption) (*MsgAssignTrainingTaskResponse, error) {
+	out := new(MsgAssignTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AssignTrainingTask", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AssignTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitTrainingKvRecord)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitTrainingKvRecord(ctx context.Context, in *MsgSubmitTrainingKvRecord, opts ...grpc.CallOption) (*MsgSubmitTrainingKvRecordResponse, error) {
+	out := new(MsgSubmitTrainingKvRecordResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SubmitTrainingKvRecord", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTraining)
-	if err := dec(in); err != nil {
+func (c *msgClient) JoinTraining(ctx context.Context, in *MsgJoinTraining, opts ...grpc.CallOption) (*MsgJoinTrainingResponse, error) {
+	out := new(MsgJoinTrainingResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTraining", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTraining(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTraining",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTrainingHeartbeat)
-	if err := dec(in); err != nil {
+func (c *msgClient) TrainingHeartbeat(ctx context.Context, in *MsgTrainingHeartbeat, opts ...grpc.CallOption) (*MsgTrainingHeartbeatResponse, error) {
+	out := new(MsgTrainingHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/TrainingHeartbeat", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetBarrier)
-	if err := dec(in); err != nil {
+func (c *msgClient) SetBarrier(ctx context.Context, in *MsgSetBarrier, opts ...grpc.CallOption) (*MsgSetBarrierResponse, error) {
+	out := new(MsgSetBarrierResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetBarrier", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetBarrier(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetBarrier",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgJoinTrainingStatus)
-	if err := dec(in); err != nil {
+func (c *msgClient) JoinTrainingStatus(ctx context.Context, in *MsgJoinTrainingStatus, opts ...grpc.CallOption) (*MsgJoinTrainingStatusResponse, error) {
+	out := new(MsgJoinTrainingStatusResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/JoinTrainingStatus", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDummyTrainingTask)
-	if err := dec(in); err != nil {
+func (c *msgClient) CreateDummyTrainingTask(ctx context.Context, in *MsgCreateDummyTrainingTask, opts ...grpc.CallOption) (*MsgCreateDummyTrainingTaskResponse, error) {
+	out := new(MsgCreateDummyTrainingTaskResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/CreateDummyTrainingTask", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeExchange)
-	if err := dec(in); err != nil {
+func (c *msgClient) BridgeExchange(ctx context.Context, in *MsgBridgeExchange, opts ...grpc.CallOption) (*MsgBridgeExchangeResponse, error) {
+	out := new(MsgBridgeExchangeResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/BridgeExchange", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).BridgeExchange(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) RegisterBridgeAddresses(ctx context.Context, in *MsgRegisterBridgeAddresses, opts ...grpc.CallOption) (*MsgRegisterBridgeAddressesResponse, error) {
+	out := new(MsgRegisterBridgeAddressesResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterBridgeAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/BridgeExchange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterBridgeAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterBridgeAddresses)
-	if err := dec(in); err != nil {
+func (c *msgClient) RegisterLiquidityPool(ctx context.Context, in *MsgRegisterLiquidityPool, opts ...grpc.CallOption) (*MsgRegisterLiquidityPoolResponse, error) {
+	out := new(MsgRegisterLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterLiquidityPool", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterBridgeAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterBridgeAddresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterBridgeAddresses(ctx, req.(*MsgRegisterBridgeAddresses))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterLiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterLiquidityPool)
-	if err := dec(in); err != nil {
+func (c *msgClient) RegisterTokenMetadata(ctx context.Context, in *MsgRegisterTokenMetadata, opts ...grpc.CallOption) (*MsgRegisterTokenMetadataResponse, error) {
+	out := new(MsgRegisterTokenMetadataResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterTokenMetadata", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterLiquidityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterLiquidityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterLiquidityPool(ctx, req.(*MsgRegisterLiquidityPool))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterTokenMetadata)
-	if err := dec(in); err != nil {
+func (c *msgClient) ApproveBridgeTokenForTrading(ctx context.Context, in *MsgApproveBridgeTokenForTrading, opts ...grpc.CallOption) (*MsgApproveBridgeTokenForTradingResponse, error) {
+	out := new(MsgApproveBridgeTokenForTradingResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ApproveBridgeTokenForTrading", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterTokenMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterTokenMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterTokenMetadata(ctx, req.(*MsgRegisterTokenMetadata))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ApproveBridgeTokenForTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgApproveBridgeTokenForTrading)
-	if err := dec(in); err != nil {
+func (c *msgClient) RequestBridgeWithdrawal(ctx context.Context, in *MsgRequestBridgeWithdrawal, opts ...grpc.CallOption) (*MsgRequestBridgeWithdrawalResponse, error) {
+	out := new(MsgRequestBridgeWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeWithdrawal", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/ApproveBridgeTokenForTrading",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, req.(*MsgApproveBridgeTokenForTrading))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RequestBridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBridgeWithdrawal)
-	if err := dec(in); err != nil {
+func (c *msgClient) RequestBridgeMint(ctx context.Context, in *MsgRequestBridgeMint, opts ...grpc.CallOption) (*MsgRequestBridgeMintResponse, error) {
+	out := new(MsgRequestBridgeMintResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RequestBridgeMint", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RequestBridgeWithdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, req.(*MsgRequestBridgeWithdrawal))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RequestBridgeMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBridgeMint)
-	if err := dec(in); err != nil {
+func (c *msgClient) RegisterWrappedTokenContract(ctx context.Context, in *MsgRegisterWrappedTokenContract, opts ...grpc.CallOption) (*MsgRegisterWrappedTokenContractResponse, error) {
+	out := new(MsgRegisterWrappedTokenContractResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RegisterWrappedTokenContract", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBridgeMint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RequestBridgeMint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBridgeMint(ctx, req.(*MsgRequestBridgeMint))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RegisterWrappedTokenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterWrappedTokenContract)
-	if err := dec(in); err != nil {
+func (c *msgClient) MigrateAllWrappedTokens(ctx context.Context, in *MsgMigrateAllWrappedTokens, opts ...grpc.CallOption) (*MsgMigrateAllWrappedTokensResponse, error) {
+	out := new(MsgMigrateAllWrappedTokensResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/MigrateAllWrappedTokens", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RegisterWrappedTokenContract",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, req.(*MsgRegisterWrappedTokenContract))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_MigrateAllWrappedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMigrateAllWrappedTokens)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddUserToTrainingAllowList(ctx context.Context, in *MsgAddUserToTrainingAllowList, opts ...grpc.CallOption) (*MsgAddUserToTrainingAllowListResponse, error) {
+	out := new(MsgAddUserToTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddUserToTrainingAllowList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/MigrateAllWrappedTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, req.(*MsgMigrateAllWrappedTokens))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddUserToTrainingAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) RemoveUserFromTrainingAllowList(ctx context.Context, in *MsgRemoveUserFromTrainingAllowList, opts ...grpc.CallOption) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	out := new(MsgRemoveUserFromTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveUserFromTrainingAllowList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveUserFromTrainingAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) SetTrainingAllowList(ctx context.Context, in *MsgSetTrainingAllowList, opts ...grpc.CallOption) (*MsgSetTrainingAllowListResponse, error) {
+	out := new(MsgSetTrainingAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/SetTrainingAllowList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetTrainingAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddParticipantsToAllowList(ctx context.Context, in *MsgAddParticipantsToAllowList, opts ...grpc.CallOption) (*MsgAddParticipantsToAllowListResponse, error) {
+	out := new(MsgAddParticipantsToAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/AddParticipantsToAllowList", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+	return out, nil
+}
+
+func (c *msgClient) RemoveParticipantsFromAllowList(ctx context.Context, in *MsgRemoveParticipantsFromAllowList, opts ...grpc.CallOption) (*MsgRemoveParticipantsFromAllowListResponse, error) {
+	out := new(MsgRemoveParticipantsFromAllowListResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/RemoveParticipantsFromAllowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_AddParticipantsToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddParticipantsToAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) InitiateExit(ctx context.Context, in *MsgInitiateExit, opts ...grpc.CallOption) (*MsgInitiateExitResponse, error) {
+	out := new(MsgInitiateExitResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/InitiateExit", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddParticipantsToAllowList(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) DelegateToParticipant(ctx context.Context, in *MsgDelegateToParticipant, opts ...grpc.CallOption) (*MsgDelegateToParticipantResponse, error) {
+	out := new(MsgDelegateToParticipantResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/DelegateToParticipant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/inference.inference.Msg/AddParticipantsToAllowList",
+	return out, nil
+}
+
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/Undelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddParticipantsToAllowList(ctx, req.(*MsgAddParticipantsToAllowList))
+	return out, nil
+}
+
+func (c *msgClient) ValidationBatch(ctx context.Context, in *MsgValidationBatch, opts ...grpc.CallOption) (*MsgValidationBatchResponse, error) {
+	out := new(MsgValidationBatchResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/ValidationBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveParticipantsFromAllowList)
-	if err := dec(in); err != nil {
+func (c *msgClient) UpdatePolicy(ctx context.Context, in *MsgUpdatePolicy, opts ...grpc.CallOption) (*MsgUpdatePolicyResponse, error) {
+	out := new(MsgUpdatePolicyResponse)
+	err := c.cc.Invoke(ctx, "/inference.inference.Msg/UpdatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	StartInference(context.Context, *MsgStartInference) (*MsgStartInferenceResponse, error)
+	FinishInference(context.Context, *MsgFinishInference) (*MsgFinishInferenceResponse, error)
+	SubmitNewParticipant(context.Context, *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error)
+	Validation(context.Context, *MsgValidation) (*MsgValidationResponse, error)
+	SubmitNewUnfundedParticipant(context.Context, *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error)
+	InvalidateInference(context.Context, *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error)
+	RevalidateInference(context.Context, *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error)
+	ClaimRewards(context.Context, *MsgClaimRewards) (*MsgClaimRewardsResponse, error)
+	SubmitPocBatch(context.Context, *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error)
+	SubmitPocValidation(context.Context, *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error)
+	// PoC v2 validation messages
+	SubmitPocValidationsV2(context.Context, *MsgSubmitPocValidationsV2) (*MsgSubmitPocValidationsV2Response, error)
+	// PoC v2 off-chain commit messages
+	PoCV2StoreCommit(context.Context, *MsgPoCV2StoreCommit) (*MsgPoCV2StoreCommitResponse, error)
+	MLNodeWeightDistribution(context.Context, *MsgMLNodeWeightDistribution) (*MsgMLNodeWeightDistributionResponse, error)
+	SubmitSeed(context.Context, *MsgSubmitSeed) (*MsgSubmitSeedResponse, error)
+	SubmitUnitOfComputePriceProposal(context.Context, *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error)
+	RegisterModel(context.Context, *MsgRegisterModel) (*MsgRegisterModelResponse, error)
+	CreateTrainingTask(context.Context, *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error)
+	SubmitHardwareDiff(context.Context, *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error)
+	CreatePartialUpgrade(context.Context, *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error)
+	ClaimTrainingTaskForAssignment(context.Context, *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error)
+	AssignTrainingTask(context.Context, *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error)
+	SubmitTrainingKvRecord(context.Context, *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error)
+	JoinTraining(context.Context, *MsgJoinTraining) (*MsgJoinTrainingResponse, error)
+	TrainingHeartbeat(context.Context, *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error)
+	SetBarrier(context.Context, *MsgSetBarrier) (*MsgSetBarrierResponse, error)
+	JoinTrainingStatus(context.Context, *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error)
+	CreateDummyTrainingTask(context.Context, *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error)
+	BridgeExchange(context.Context, *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error)
+	RegisterBridgeAddresses(context.Context, *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error)
+	RegisterLiquidityPool(context.Context, *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error)
+	RegisterTokenMetadata(context.Context, *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error)
+	ApproveBridgeTokenForTrading(context.Context, *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error)
+	RequestBridgeWithdrawal(context.Context, *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error)
+	RequestBridgeMint(context.Context, *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error)
+	RegisterWrappedTokenContract(context.Context, *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error)
+	MigrateAllWrappedTokens(context.Context, *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error)
+	AddUserToTrainingAllowList(context.Context, *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error)
+	RemoveUserFromTrainingAllowList(context.Context, *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error)
+	SetTrainingAllowList(context.Context, *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error)
+	AddParticipantsToAllowList(context.Context, *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error)
+	RemoveParticipantsFromAllowList(context.Context, *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error)
+	InitiateExit(context.Context, *MsgInitiateExit) (*MsgInitiateExitResponse, error)
+	DelegateToParticipant(context.Context, *MsgDelegateToParticipant) (*MsgDelegateToParticipantResponse, error)
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	ValidationBatch(context.Context, *MsgValidationBatch) (*MsgValidationBatchResponse, error)
+	UpdatePolicy(context.Context, *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) StartInference(ctx context.Context, req *MsgStartInference) (*MsgStartInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartInference not implemented")
+}
+func (*UnimplementedMsgServer) FinishInference(ctx context.Context, req *MsgFinishInference) (*MsgFinishInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishInference not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNewParticipant(ctx context.Context, req *MsgSubmitNewParticipant) (*MsgSubmitNewParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewParticipant not implemented")
+}
+func (*UnimplementedMsgServer) Validation(ctx context.Context, req *MsgValidation) (*MsgValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitNewUnfundedParticipant(ctx context.Context, req *MsgSubmitNewUnfundedParticipant) (*MsgSubmitNewUnfundedParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNewUnfundedParticipant not implemented")
+}
+func (*UnimplementedMsgServer) InvalidateInference(ctx context.Context, req *MsgInvalidateInference) (*MsgInvalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateInference not implemented")
+}
+func (*UnimplementedMsgServer) RevalidateInference(ctx context.Context, req *MsgRevalidateInference) (*MsgRevalidateInferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevalidateInference not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRewards(ctx context.Context, req *MsgClaimRewards) (*MsgClaimRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRewards not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPocBatch(ctx context.Context, req *MsgSubmitPocBatch) (*MsgSubmitPocBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocBatch not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPocValidation(ctx context.Context, req *MsgSubmitPocValidation) (*MsgSubmitPocValidationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitPocValidationsV2(ctx context.Context, req *MsgSubmitPocValidationsV2) (*MsgSubmitPocValidationsV2Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPocValidationsV2 not implemented")
+}
+func (*UnimplementedMsgServer) PoCV2StoreCommit(ctx context.Context, req *MsgPoCV2StoreCommit) (*MsgPoCV2StoreCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoCV2StoreCommit not implemented")
+}
+func (*UnimplementedMsgServer) MLNodeWeightDistribution(ctx context.Context, req *MsgMLNodeWeightDistribution) (*MsgMLNodeWeightDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MLNodeWeightDistribution not implemented")
+}
+func (*UnimplementedMsgServer) SubmitSeed(ctx context.Context, req *MsgSubmitSeed) (*MsgSubmitSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSeed not implemented")
+}
+func (*UnimplementedMsgServer) SubmitUnitOfComputePriceProposal(ctx context.Context, req *MsgSubmitUnitOfComputePriceProposal) (*MsgSubmitUnitOfComputePriceProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitUnitOfComputePriceProposal not implemented")
+}
+func (*UnimplementedMsgServer) RegisterModel(ctx context.Context, req *MsgRegisterModel) (*MsgRegisterModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterModel not implemented")
+}
+func (*UnimplementedMsgServer) CreateTrainingTask(ctx context.Context, req *MsgCreateTrainingTask) (*MsgCreateTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitHardwareDiff(ctx context.Context, req *MsgSubmitHardwareDiff) (*MsgSubmitHardwareDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitHardwareDiff not implemented")
+}
+func (*UnimplementedMsgServer) CreatePartialUpgrade(ctx context.Context, req *MsgCreatePartialUpgrade) (*MsgCreatePartialUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePartialUpgrade not implemented")
+}
+func (*UnimplementedMsgServer) ClaimTrainingTaskForAssignment(ctx context.Context, req *MsgClaimTrainingTaskForAssignment) (*MsgClaimTrainingTaskForAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimTrainingTaskForAssignment not implemented")
+}
+func (*UnimplementedMsgServer) AssignTrainingTask(ctx context.Context, req *MsgAssignTrainingTask) (*MsgAssignTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) SubmitTrainingKvRecord(ctx context.Context, req *MsgSubmitTrainingKvRecord) (*MsgSubmitTrainingKvRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTrainingKvRecord not implemented")
+}
+func (*UnimplementedMsgServer) JoinTraining(ctx context.Context, req *MsgJoinTraining) (*MsgJoinTrainingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTraining not implemented")
+}
+func (*UnimplementedMsgServer) TrainingHeartbeat(ctx context.Context, req *MsgTrainingHeartbeat) (*MsgTrainingHeartbeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TrainingHeartbeat not implemented")
+}
+func (*UnimplementedMsgServer) SetBarrier(ctx context.Context, req *MsgSetBarrier) (*MsgSetBarrierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBarrier not implemented")
+}
+func (*UnimplementedMsgServer) JoinTrainingStatus(ctx context.Context, req *MsgJoinTrainingStatus) (*MsgJoinTrainingStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTrainingStatus not implemented")
+}
+func (*UnimplementedMsgServer) CreateDummyTrainingTask(ctx context.Context, req *MsgCreateDummyTrainingTask) (*MsgCreateDummyTrainingTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDummyTrainingTask not implemented")
+}
+func (*UnimplementedMsgServer) BridgeExchange(ctx context.Context, req *MsgBridgeExchange) (*MsgBridgeExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeExchange not implemented")
+}
+func (*UnimplementedMsgServer) RegisterBridgeAddresses(ctx context.Context, req *MsgRegisterBridgeAddresses) (*MsgRegisterBridgeAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterBridgeAddresses not implemented")
+}
+func (*UnimplementedMsgServer) RegisterLiquidityPool(ctx context.Context, req *MsgRegisterLiquidityPool) (*MsgRegisterLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterLiquidityPool not implemented")
+}
+func (*UnimplementedMsgServer) RegisterTokenMetadata(ctx context.Context, req *MsgRegisterTokenMetadata) (*MsgRegisterTokenMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTokenMetadata not implemented")
+}
+func (*UnimplementedMsgServer) ApproveBridgeTokenForTrading(ctx context.Context, req *MsgApproveBridgeTokenForTrading) (*MsgApproveBridgeTokenForTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBridgeTokenForTrading not implemented")
+}
+func (*UnimplementedMsgServer) RequestBridgeWithdrawal(ctx context.Context, req *MsgRequestBridgeWithdrawal) (*MsgRequestBridgeWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) RequestBridgeMint(ctx context.Context, req *MsgRequestBridgeMint) (*MsgRequestBridgeMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBridgeMint not implemented")
+}
+func (*UnimplementedMsgServer) RegisterWrappedTokenContract(ctx context.Context, req *MsgRegisterWrappedTokenContract) (*MsgRegisterWrappedTokenContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWrappedTokenContract not implemented")
+}
+func (*UnimplementedMsgServer) MigrateAllWrappedTokens(ctx context.Context, req *MsgMigrateAllWrappedTokens) (*MsgMigrateAllWrappedTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAllWrappedTokens not implemented")
+}
+func (*UnimplementedMsgServer) AddUserToTrainingAllowList(ctx context.Context, req *MsgAddUserToTrainingAllowList) (*MsgAddUserToTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUserToTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) RemoveUserFromTrainingAllowList(ctx context.Context, req *MsgRemoveUserFromTrainingAllowList) (*MsgRemoveUserFromTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserFromTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) SetTrainingAllowList(ctx context.Context, req *MsgSetTrainingAllowList) (*MsgSetTrainingAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrainingAllowList not implemented")
+}
+func (*UnimplementedMsgServer) AddParticipantsToAllowList(ctx context.Context, req *MsgAddParticipantsToAllowList) (*MsgAddParticipantsToAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddParticipantsToAllowList not implemented")
+}
+func (*UnimplementedMsgServer) RemoveParticipantsFromAllowList(ctx context.Context, req *MsgRemoveParticipantsFromAllowList) (*MsgRemoveParticipantsFromAllowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveParticipantsFromAllowList not implemented")
+}
+func (*UnimplementedMsgServer) InitiateExit(ctx context.Context, req *MsgInitiateExit) (*MsgInitiateExitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateExit not implemented")
+}
+func (*UnimplementedMsgServer) DelegateToParticipant(ctx context.Context, req *MsgDelegateToParticipant) (*MsgDelegateToParticipantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateToParticipant not implemented")
+}
+func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+func (*UnimplementedMsgServer) ValidationBatch(ctx context.Context, req *MsgValidationBatch) (*MsgValidationBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidationBatch not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePolicy(ctx context.Context, req *MsgUpdatePolicy) (*MsgUpdatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, in)
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/inference.inference.Msg/RemoveParticipantsFromAllowList",
+		FullMethod: "/inference.inference.Msg/UpdateParams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, req.(*MsgRemoveParticipantsFromAllowList))
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "inference.inference.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "StartInference",
-			Handler:    _Msg_StartInference_Handler,
-		},
-		{
-			MethodName: "FinishInference",
-			Handler:    _Msg_FinishInference_Handler,
-		},
-		{
-			MethodName: "SubmitNewParticipant",
-			Handler:    _Msg_SubmitNewParticipant_Handler,
-		},
-		{
-			MethodName: "Validation",
-			Handler:    _Msg_Validation_Handler,
-		},
-		{
-			MethodName: "SubmitNewUnfundedParticipant",
-			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
-		},
-		{
-			MethodName: "InvalidateInference",
-			Handler:    _Msg_InvalidateInference_Handler,
-		},
-		{
-			MethodName: "RevalidateInference",
-			Handler:    _Msg_RevalidateInference_Handler,
-		},
-		{
-			MethodName: "ClaimRewards",
-			Handler:    _Msg_ClaimRewards_Handler,
-		},
-		{
-			MethodName: "SubmitPocBatch",
-			Handler:    _Msg_SubmitPocBatch_Handler,
-		},
-		{
-			MethodName: "SubmitPocValidation",
-			Handler:    _Msg_SubmitPocValidation_Handler,
-		},
-		{
-			MethodName: "SubmitPocValidationsV2",
-			Handler:    _Msg_SubmitPocValidationsV2_Handler,
-		},
-		{
-			MethodName: "PoCV2StoreCommit",
-			Handler:    _Msg_PoCV2StoreCommit_Handler,
-		},
-		{
-			MethodName: "MLNodeWeightDistribution",
-			Handler:    _Msg_MLNodeWeightDistribution_Handler,
-		},
-		{
-			MethodName: "SubmitSeed",
-			Handler:    _Msg_SubmitSeed_Handler,
-		},
-		{
-			MethodName: "SubmitUnitOfComputePriceProposal",
-			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
-		},
-		{
-			MethodName: "RegisterModel",
-			Handler:    _Msg_RegisterModel_Handler,
-		},
-		{
-			MethodName: "CreateTrainingTask",
-			Handler:    _Msg_CreateTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitHardwareDiff",
-			Handler:    _Msg_SubmitHardwareDiff_Handler,
-		},
-		{
-			MethodName: "CreatePartialUpgrade",
-			Handler:    _Msg_CreatePartialUpgrade_Handler,
-		},
-		{
-			MethodName: "ClaimTrainingTaskForAssignment",
-			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
-		},
-		{
-			MethodName: "AssignTrainingTask",
-			Handler:    _Msg_AssignTrainingTask_Handler,
-		},
-		{
-			MethodName: "SubmitTrainingKvRecord",
-			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
-		},
-		{
-			MethodName: "JoinTraining",
-			Handler:    _Msg_JoinTraining_Handler,
-		},
-		{
-			MethodName: "TrainingHeartbeat",
-			Handler:    _Msg_TrainingHeartbeat_Handler,
-		},
-		{
-			MethodName: "SetBarrier",
-			Handler:    _Msg_SetBarrier_Handler,
-		},
-		{
-			MethodName: "JoinTrainingStatus",
-			Handler:    _Msg_JoinTrainingStatus_Handler,
-		},
-		{
-			MethodName: "CreateDummyTrainingTask",
-			Handler:    _Msg_CreateDummyTrainingTask_Handler,
-		},
-		{
-			MethodName: "BridgeExchange",
-			Handler:    _Msg_BridgeExchange_Handler,
-		},
-		{
-			MethodName: "RegisterBridgeAddresses",
-			Handler:    _Msg_RegisterBridgeAddresses_Handler,
-		},
-		{
-			MethodName: "RegisterLiquidityPool",
-			Handler:    _Msg_RegisterLiquidityPool_Handler,
-		},
-		{
-			MethodName: "RegisterTokenMetadata",
-			Handler:    _Msg_RegisterTokenMetadata_Handler,
-		},
-		{
-			MethodName: "ApproveBridgeTokenForTrading",
-			Handler:    _Msg_ApproveBridgeTokenForTrading_Handler,
-		},
-		{
-			MethodName: "RequestBridgeWithdrawal",
-			Handler:    _Msg_RequestBridgeWithdrawal_Handler,
-		},
-		{
-			MethodName: "RequestBridgeMint",
-			Handler:    _Msg_RequestBridgeMint_Handler,
-		},
-		{
-			MethodName: "RegisterWrappedTokenContract",
-			Handler:    _Msg_RegisterWrappedTokenContract_Handler,
-		},
-		{
-			MethodName: "MigrateAllWrappedTokens",
-			Handler:    _Msg_MigrateAllWrappedTokens_Handler,
-		},
-		{
-			MethodName: "AddUserToTrainingAllowList",
-			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveUserFromTrainingAllowList",
-			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "SetTrainingAllowList",
-			Handler:    _Msg_SetTrainingAllowList_Handler,
-		},
-		{
-			MethodName: "AddParticipantsToAllowList",
-			Handler:    _Msg_AddParticipantsToAllowList_Handler,
-		},
-		{
-			MethodName: "RemoveParticipantsFromAllowList",
-			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "inference/inference/tx.proto",
-}
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_StartInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).StartInference(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/StartInference",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartInference(ctx, req.(*MsgStartInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FinishInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinishInference)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).FinishInference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/FinishInference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinishInference(ctx, req.(*MsgFinishInference))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitNewParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewParticipant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewParticipant(ctx, req.(*MsgSubmitNewParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_Validation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Validation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/Validation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Validation(ctx, req.(*MsgValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitNewUnfundedParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNewUnfundedParticipant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitNewUnfundedParticipant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNewUnfundedParticipant(ctx, req.(*MsgSubmitNewUnfundedParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalPromptHash) > 0 {
-		i -= len(m.OriginalPromptHash)
-		copy(dAtA[i:], m.OriginalPromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+func _Msg_InvalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInvalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x7a
+	if interceptor == nil {
+		return srv.(MsgServer).InvalidateInference(ctx, in)
 	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x72
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/InvalidateInference",
 	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x60
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InvalidateInference(ctx, req.(*MsgInvalidateInference))
 	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x58
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevalidateInference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevalidateInference)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MaxTokens != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
-		i--
-		dAtA[i] = 0x50
+	if interceptor == nil {
+		return srv.(MsgServer).RevalidateInference(ctx, in)
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
-		i--
-		dAtA[i] = 0x4a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RevalidateInference",
 	}
-	if len(m.AssignedTo) > 0 {
-		i -= len(m.AssignedTo)
-		copy(dAtA[i:], m.AssignedTo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
-		i--
-		dAtA[i] = 0x42
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevalidateInference(ctx, req.(*MsgRevalidateInference))
 	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x3a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRewards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRewards(ctx, in)
 	}
-	if len(m.PromptPayload) > 0 {
-		i -= len(m.PromptPayload)
-		copy(dAtA[i:], m.PromptPayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimRewards",
 	}
-	if len(m.PromptHash) > 0 {
-		i -= len(m.PromptHash)
-		copy(dAtA[i:], m.PromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRewards(ctx, req.(*MsgClaimRewards))
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPocBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocBatch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocBatch(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocBatch(ctx, req.(*MsgSubmitPocBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitPocValidation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocValidation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocValidation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocValidation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocValidation(ctx, req.(*MsgSubmitPocValidation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitPocValidationsV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPocValidationsV2)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPocValidationsV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitPocValidationsV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPocValidationsV2(ctx, req.(*MsgSubmitPocValidationsV2))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_PoCV2StoreCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPoCV2StoreCommit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).PoCV2StoreCommit(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/PoCV2StoreCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PoCV2StoreCommit(ctx, req.(*MsgPoCV2StoreCommit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MLNodeWeightDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMLNodeWeightDistribution)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MLNodeWeightDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/MLNodeWeightDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MLNodeWeightDistribution(ctx, req.(*MsgMLNodeWeightDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitSeed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitSeed(ctx, req.(*MsgSubmitSeed))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalPromptHash) > 0 {
-		i -= len(m.OriginalPromptHash)
-		copy(dAtA[i:], m.OriginalPromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+func _Msg_SubmitUnitOfComputePriceProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitUnitOfComputePriceProposal)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PromptHash) > 0 {
-		i -= len(m.PromptHash)
-		copy(dAtA[i:], m.PromptHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
-		i--
-		dAtA[i] = 0x7a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, in)
 	}
-	if len(m.Model) > 0 {
-		i -= len(m.Model)
-		copy(dAtA[i:], m.Model)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
-		i--
-		dAtA[i] = 0x72
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitUnitOfComputePriceProposal",
 	}
-	if len(m.OriginalPrompt) > 0 {
-		i -= len(m.OriginalPrompt)
-		copy(dAtA[i:], m.OriginalPrompt)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
-		i--
-		dAtA[i] = 0x6a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitUnitOfComputePriceProposal(ctx, req.(*MsgSubmitUnitOfComputePriceProposal))
 	}
-	if len(m.RequestedBy) > 0 {
-		i -= len(m.RequestedBy)
-		copy(dAtA[i:], m.RequestedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
-		i--
-		dAtA[i] = 0x62
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterModel)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExecutorSignature) > 0 {
-		i -= len(m.ExecutorSignature)
-		copy(dAtA[i:], m.ExecutorSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterModel(ctx, in)
 	}
-	if len(m.TransferSignature) > 0 {
-		i -= len(m.TransferSignature)
-		copy(dAtA[i:], m.TransferSignature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterModel",
 	}
-	if m.RequestTimestamp != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterModel(ctx, req.(*MsgRegisterModel))
 	}
-	if len(m.TransferredBy) > 0 {
-		i -= len(m.TransferredBy)
-		copy(dAtA[i:], m.TransferredBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTrainingTask)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExecutedBy) > 0 {
-		i -= len(m.ExecutedBy)
-		copy(dAtA[i:], m.ExecutedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTrainingTask(ctx, in)
 	}
-	if m.CompletionTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateTrainingTask",
 	}
-	if m.PromptTokenCount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
-		i--
-		dAtA[i] = 0x28
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTrainingTask(ctx, req.(*MsgCreateTrainingTask))
 	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitHardwareDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitHardwareDiff)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, in)
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitHardwareDiff",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitHardwareDiff(ctx, req.(*MsgSubmitHardwareDiff))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreatePartialUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePartialUpgrade)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ErrorMessage) > 0 {
-		i -= len(m.ErrorMessage)
-		copy(dAtA[i:], m.ErrorMessage)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, in)
 	}
-	if len(m.InferenceIndex) > 0 {
-		i -= len(m.InferenceIndex)
-		copy(dAtA[i:], m.InferenceIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreatePartialUpgrade",
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePartialUpgrade(ctx, req.(*MsgCreatePartialUpgrade))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_ClaimTrainingTaskForAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimTrainingTaskForAssignment)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, in)
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ClaimTrainingTaskForAssignment",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimTrainingTaskForAssignment(ctx, req.(*MsgClaimTrainingTaskForAssignment))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AssignTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAssignTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).AssignTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AssignTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AssignTrainingTask(ctx, req.(*MsgAssignTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_SubmitTrainingKvRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitTrainingKvRecord)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ParticipantIndex) > 0 {
-		i -= len(m.ParticipantIndex)
-		copy(dAtA[i:], m.ParticipantIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SubmitTrainingKvRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitTrainingKvRecord(ctx, req.(*MsgSubmitTrainingKvRecord))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_JoinTraining_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTraining)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTraining(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTraining",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTraining(ctx, req.(*MsgJoinTraining))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_TrainingHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTrainingHeartbeat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/TrainingHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TrainingHeartbeat(ctx, req.(*MsgTrainingHeartbeat))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValueDecimal != nil {
-		{
-			size, err := m.ValueDecimal.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+func _Msg_SetBarrier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBarrier)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Revalidation {
-		i--
-		if m.Revalidation {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(MsgServer).SetBarrier(ctx, in)
 	}
-	if m.Value != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
-		i--
-		dAtA[i] = 0x31
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetBarrier",
 	}
-	if len(m.ResponseHash) > 0 {
-		i -= len(m.ResponseHash)
-		copy(dAtA[i:], m.ResponseHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBarrier(ctx, req.(*MsgSetBarrier))
 	}
-	if len(m.ResponsePayload) > 0 {
-		i -= len(m.ResponsePayload)
-		copy(dAtA[i:], m.ResponsePayload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_JoinTrainingStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinTrainingStatus)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, in)
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/JoinTrainingStatus",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinTrainingStatus(ctx, req.(*MsgJoinTrainingStatus))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateDummyTrainingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDummyTrainingTask)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/CreateDummyTrainingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDummyTrainingTask(ctx, req.(*MsgCreateDummyTrainingTask))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BridgeExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeExchange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/BridgeExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeExchange(ctx, req.(*MsgBridgeExchange))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_RegisterBridgeAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterBridgeAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterBridgeAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterBridgeAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterBridgeAddresses(ctx, req.(*MsgRegisterBridgeAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterLiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterLiquidityPool)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterLiquidityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterLiquidityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterLiquidityPool(ctx, req.(*MsgRegisterLiquidityPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerKey) > 0 {
-		i -= len(m.WorkerKey)
-		copy(dAtA[i:], m.WorkerKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ValidatorKey) > 0 {
-		i -= len(m.ValidatorKey)
-		copy(dAtA[i:], m.ValidatorKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_RegisterTokenMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterTokenMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterTokenMetadata(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterTokenMetadata",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterTokenMetadata(ctx, req.(*MsgRegisterTokenMetadata))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ApproveBridgeTokenForTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBridgeTokenForTrading)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ApproveBridgeTokenForTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveBridgeTokenForTrading(ctx, req.(*MsgApproveBridgeTokenForTrading))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestBridgeWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBridgeWithdrawal)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RequestBridgeWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBridgeWithdrawal(ctx, req.(*MsgRequestBridgeWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_RequestBridgeMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBridgeMint)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBridgeMint(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RequestBridgeMint",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBridgeMint(ctx, req.(*MsgRequestBridgeMint))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterWrappedTokenContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterWrappedTokenContract)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RegisterWrappedTokenContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterWrappedTokenContract(ctx, req.(*MsgRegisterWrappedTokenContract))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_MigrateAllWrappedTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAllWrappedTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/MigrateAllWrappedTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAllWrappedTokens(ctx, req.(*MsgMigrateAllWrappedTokens))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_AddUserToTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddUserToTrainingAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AddUserToTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddUserToTrainingAllowList(ctx, req.(*MsgAddUserToTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveUserFromTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveUserFromTrainingAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RemoveUserFromTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveUserFromTrainingAllowList(ctx, req.(*MsgRemoveUserFromTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SetTrainingAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTrainingAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/SetTrainingAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTrainingAllowList(ctx, req.(*MsgSetTrainingAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Invalidator) > 0 {
-		i -= len(m.Invalidator)
-		copy(dAtA[i:], m.Invalidator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_AddParticipantsToAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddParticipantsToAllowList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.InferenceId) > 0 {
-		i -= len(m.InferenceId)
-		copy(dAtA[i:], m.InferenceId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).AddParticipantsToAllowList(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/AddParticipantsToAllowList",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddParticipantsToAllowList(ctx, req.(*MsgAddParticipantsToAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveParticipantsFromAllowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveParticipantsFromAllowList)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/RemoveParticipantsFromAllowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveParticipantsFromAllowList(ctx, req.(*MsgRemoveParticipantsFromAllowList))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_InitiateExit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateExit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateExit(ctx, in)
 	}
-	if m.Seed != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/InitiateExit",
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateExit(ctx, req.(*MsgInitiateExit))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_DelegateToParticipant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateToParticipant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Result) > 0 {
-		i -= len(m.Result)
-		copy(dAtA[i:], m.Result)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateToParticipant(ctx, in)
 	}
-	if m.Amount != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/DelegateToParticipant",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateToParticipant(ctx, req.(*MsgDelegateToParticipant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
 	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f3 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f3))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/Undelegate",
 	}
-	if len(m.Nonces) > 0 {
-		dAtA5 := make([]byte, len(m.Nonces)*10)
-		var j4 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
-		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
 	}
-	if len(m.BatchId) > 0 {
-		i -= len(m.BatchId)
-		copy(dAtA[i:], m.BatchId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ValidationBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValidationBatch)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(MsgServer).ValidationBatch(ctx, in)
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/ValidationBatch",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValidationBatch(ctx, req.(*MsgValidationBatch))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePolicy)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inference.inference.Msg/UpdatePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePolicy(ctx, req.(*MsgUpdatePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "inference.inference.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "StartInference",
+			Handler:    _Msg_StartInference_Handler,
+		},
+		{
+			MethodName: "FinishInference",
+			Handler:    _Msg_FinishInference_Handler,
+		},
+		{
+			MethodName: "SubmitNewParticipant",
+			Handler:    _Msg_SubmitNewParticipant_Handler,
+		},
+		{
+			MethodName: "Validation",
+			Handler:    _Msg_Validation_Handler,
+		},
+		{
+			MethodName: "SubmitNewUnfundedParticipant",
+			Handler:    _Msg_SubmitNewUnfundedParticipant_Handler,
+		},
+		{
+			MethodName: "InvalidateInference",
+			Handler:    _Msg_InvalidateInference_Handler,
+		},
+		{
+			MethodName: "RevalidateInference",
+			Handler:    _Msg_RevalidateInference_Handler,
+		},
+		{
+			MethodName: "ClaimRewards",
+			Handler:    _Msg_ClaimRewards_Handler,
+		},
+		{
+			MethodName: "SubmitPocBatch",
+			Handler:    _Msg_SubmitPocBatch_Handler,
+		},
+		{
+			MethodName: "SubmitPocValidation",
+			Handler:    _Msg_SubmitPocValidation_Handler,
+		},
+		{
+			MethodName: "SubmitPocValidationsV2",
+			Handler:    _Msg_SubmitPocValidationsV2_Handler,
+		},
+		{
+			MethodName: "PoCV2StoreCommit",
+			Handler:    _Msg_PoCV2StoreCommit_Handler,
+		},
+		{
+			MethodName: "MLNodeWeightDistribution",
+			Handler:    _Msg_MLNodeWeightDistribution_Handler,
+		},
+		{
+			MethodName: "SubmitSeed",
+			Handler:    _Msg_SubmitSeed_Handler,
+		},
+		{
+			MethodName: "SubmitUnitOfComputePriceProposal",
+			Handler:    _Msg_SubmitUnitOfComputePriceProposal_Handler,
+		},
+		{
+			MethodName: "RegisterModel",
+			Handler:    _Msg_RegisterModel_Handler,
+		},
+		{
+			MethodName: "CreateTrainingTask",
+			Handler:    _Msg_CreateTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitHardwareDiff",
+			Handler:    _Msg_SubmitHardwareDiff_Handler,
+		},
+		{
+			MethodName: "CreatePartialUpgrade",
+			Handler:    _Msg_CreatePartialUpgrade_Handler,
+		},
+		{
+			MethodName: "ClaimTrainingTaskForAssignment",
+			Handler:    _Msg_ClaimTrainingTaskForAssignment_Handler,
+		},
+		{
+			MethodName: "AssignTrainingTask",
+			Handler:    _Msg_AssignTrainingTask_Handler,
+		},
+		{
+			MethodName: "SubmitTrainingKvRecord",
+			Handler:    _Msg_SubmitTrainingKvRecord_Handler,
+		},
+		{
+			MethodName: "JoinTraining",
+			Handler:    _Msg_JoinTraining_Handler,
+		},
+		{
+			MethodName: "TrainingHeartbeat",
+			Handler:    _Msg_TrainingHeartbeat_Handler,
+		},
+		{
+			MethodName: "SetBarrier",
+			Handler:    _Msg_SetBarrier_Handler,
+		},
+		{
+			MethodName: "JoinTrainingStatus",
+			Handler:    _Msg_JoinTrainingStatus_Handler,
+		},
+		{
+			MethodName: "CreateDummyTrainingTask",
+			Handler:    _Msg_CreateDummyTrainingTask_Handler,
+		},
+		{
+			MethodName: "BridgeExchange",
+			Handler:    _Msg_BridgeExchange_Handler,
+		},
+		{
+			MethodName: "RegisterBridgeAddresses",
+			Handler:    _Msg_RegisterBridgeAddresses_Handler,
+		},
+		{
+			MethodName: "RegisterLiquidityPool",
+			Handler:    _Msg_RegisterLiquidityPool_Handler,
+		},
+		{
+			MethodName: "RegisterTokenMetadata",
+			Handler:    _Msg_RegisterTokenMetadata_Handler,
+		},
+		{
+			MethodName: "ApproveBridgeTokenForTrading",
+			Handler:    _Msg_ApproveBridgeTokenForTrading_Handler,
+		},
+		{
+			MethodName: "RequestBridgeWithdrawal",
+			Handler:    _Msg_RequestBridgeWithdrawal_Handler,
+		},
+		{
+			MethodName: "RequestBridgeMint",
+			Handler:    _Msg_RequestBridgeMint_Handler,
+		},
+		{
+			MethodName: "RegisterWrappedTokenContract",
+			Handler:    _Msg_RegisterWrappedTokenContract_Handler,
+		},
+		{
+			MethodName: "MigrateAllWrappedTokens",
+			Handler:    _Msg_MigrateAllWrappedTokens_Handler,
+		},
+		{
+			MethodName: "AddUserToTrainingAllowList",
+			Handler:    _Msg_AddUserToTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveUserFromTrainingAllowList",
+			Handler:    _Msg_RemoveUserFromTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "SetTrainingAllowList",
+			Handler:    _Msg_SetTrainingAllowList_Handler,
+		},
+		{
+			MethodName: "AddParticipantsToAllowList",
+			Handler:    _Msg_AddParticipantsToAllowList_Handler,
+		},
+		{
+			MethodName: "RemoveParticipantsFromAllowList",
+			Handler:    _Msg_RemoveParticipantsFromAllowList_Handler,
+		},
+		{
+			MethodName: "InitiateExit",
+			Handler:    _Msg_InitiateExit_Handler,
+		},
+		{
+			MethodName: "DelegateToParticipant",
+			Handler:    _Msg_DelegateToParticipant_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+		{
+			MethodName: "ValidationBatch",
+			Handler:    _Msg_ValidationBatch_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _Msg_UpdatePolicy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "inference/inference/tx.proto",
+}
 
-func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7676,126 +7541,52 @@ func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FraudDetected {
-		i--
-		if m.FraudDetected {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.ProbabilityHonest != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
-		i--
-		dAtA[i] = 0x51
-	}
-	if m.NInvalid != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.FraudThreshold != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
-		i--
-		dAtA[i] = 0x41
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.RTarget != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
-		dAtA[i] = 0x39
+		dAtA[i] = 0xa
 	}
-	if len(m.ReceivedDist) > 0 {
-		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
-			f6 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Dist) > 0 {
-		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
-			f7 := math.Float64bits(float64(m.Dist[iNdEx]))
-			i -= 8
-			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
-		}
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Nonces) > 0 {
-		dAtA9 := make([]byte, len(m.Nonces)*10)
-		var j8 int
-		for _, num1 := range m.Nonces {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
-		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintTx(dAtA, i, uint64(j8))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ParticipantAddress) > 0 {
-		i -= len(m.ParticipantAddress)
-		copy(dAtA[i:], m.ParticipantAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7803,7 +7594,7 @@ func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidationsV2) Marshal() (dAtA []byte, err error) {
+func (m *MsgStartInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7813,104 +7604,102 @@ func (m *MsgSubmitPocValidationsV2) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocValidationsV2) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStartInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitPocValidationsV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStartInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Validations) > 0 {
-		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.OriginalPromptHash) > 0 {
+		i -= len(m.OriginalPromptHash)
+		copy(dAtA[i:], m.OriginalPromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x7a
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x72
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitPocValidationsV2Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitPocValidationsV2Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitPocValidationsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgPoCV2StoreCommit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x58
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgPoCV2StoreCommit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgPoCV2StoreCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RootHash) > 0 {
-		i -= len(m.RootHash)
-		copy(dAtA[i:], m.RootHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RootHash)))
+	if m.MaxTokens != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTokens))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.AssignedTo) > 0 {
+		i -= len(m.AssignedTo)
+		copy(dAtA[i:], m.AssignedTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AssignedTo)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PromptPayload) > 0 {
+		i -= len(m.PromptPayload)
+		copy(dAtA[i:], m.PromptPayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptPayload)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.Count != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+	if len(m.PromptHash) > 0 {
+		i -= len(m.PromptHash)
+		copy(dAtA[i:], m.PromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -7922,7 +7711,7 @@ func (m *MsgPoCV2StoreCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPoCV2StoreCommitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgStartInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7932,20 +7721,34 @@ func (m *MsgPoCV2StoreCommitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPoCV2StoreCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgStartInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPoCV2StoreCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgStartInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMLNodeWeightDistribution) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7955,34 +7758,116 @@ func (m *MsgMLNodeWeightDistribution) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMLNodeWeightDistribution) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMLNodeWeightDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Weights) > 0 {
-		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
-		i--
-		dAtA[i] = 0x10
+	if len(m.OriginalPromptHash) > 0 {
+		i -= len(m.OriginalPromptHash)
+		copy(dAtA[i:], m.OriginalPromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPromptHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.PromptHash) > 0 {
+		i -= len(m.PromptHash)
+		copy(dAtA[i:], m.PromptHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PromptHash)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OriginalPrompt) > 0 {
+		i -= len(m.OriginalPrompt)
+		copy(dAtA[i:], m.OriginalPrompt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginalPrompt)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RequestedBy) > 0 {
+		i -= len(m.RequestedBy)
+		copy(dAtA[i:], m.RequestedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestedBy)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ExecutorSignature) > 0 {
+		i -= len(m.ExecutorSignature)
+		copy(dAtA[i:], m.ExecutorSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutorSignature)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.TransferSignature) > 0 {
+		i -= len(m.TransferSignature)
+		copy(dAtA[i:], m.TransferSignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferSignature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RequestTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RequestTimestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TransferredBy) > 0 {
+		i -= len(m.TransferredBy)
+		copy(dAtA[i:], m.TransferredBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TransferredBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExecutedBy) > 0 {
+		i -= len(m.ExecutedBy)
+		copy(dAtA[i:], m.ExecutedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CompletionTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionTokenCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PromptTokenCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PromptTokenCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -7994,7 +7879,7 @@ func (m *MsgMLNodeWeightDistribution) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMLNodeWeightDistributionResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFinishInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8004,20 +7889,34 @@ func (m *MsgMLNodeWeightDistributionResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgMLNodeWeightDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMLNodeWeightDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFinishInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ErrorMessage) > 0 {
+		i -= len(m.ErrorMessage)
+		copy(dAtA[i:], m.ErrorMessage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ErrorMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InferenceIndex) > 0 {
+		i -= len(m.InferenceIndex)
+		copy(dAtA[i:], m.InferenceIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8027,27 +7926,36 @@ func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8059,7 +7967,7 @@ func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8069,20 +7977,34 @@ func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParticipantIndex) > 0 {
+		i -= len(m.ParticipantIndex)
+		copy(dAtA[i:], m.ParticipantIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantIndex)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8092,20 +8014,71 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Price != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Price))
+	if m.ValueDecimal != nil {
+		{
+			size, err := m.ValueDecimal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x42
+	}
+	if m.Revalidation {
+		i--
+		if m.Revalidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Value != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Value))))
+		i--
+		dAtA[i] = 0x31
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ResponsePayload) > 0 {
+		i -= len(m.ResponsePayload)
+		copy(dAtA[i:], m.ResponsePayload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponsePayload)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8117,7 +8090,7 @@ func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValidationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8127,12 +8100,12 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8140,7 +8113,7 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8150,91 +8123,62 @@ func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ValidationThreshold != nil {
-		{
-			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.ThroughputPerNonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.VRam != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.ModelArgs) > 0 {
-		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ModelArgs[iNdEx])
-			copy(dAtA[i:], m.ModelArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.HfCommit) > 0 {
-		i -= len(m.HfCommit)
-		copy(dAtA[i:], m.HfCommit)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
+	if len(m.WorkerKey) > 0 {
+		i -= len(m.WorkerKey)
+		copy(dAtA[i:], m.WorkerKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkerKey)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.HfRepo) > 0 {
-		i -= len(m.HfRepo)
-		copy(dAtA[i:], m.HfRepo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
+	if len(m.ValidatorKey) > 0 {
+		i -= len(m.ValidatorKey)
+		copy(dAtA[i:], m.ValidatorKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorKey)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.UnitsOfComputePerToken != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubKey)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.ProposedBy) > 0 {
-		i -= len(m.ProposedBy)
-		copy(dAtA[i:], m.ProposedBy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8244,12 +8188,12 @@ func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8257,7 +8201,7 @@ func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8267,41 +8211,29 @@ func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.HardwareResources) > 0 {
-		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8313,7 +8245,7 @@ func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgInvalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8323,32 +8255,20 @@ func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgInvalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8358,43 +8278,29 @@ func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Removed) > 0 {
-		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Invalidator) > 0 {
+		i -= len(m.Invalidator)
+		copy(dAtA[i:], m.Invalidator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Invalidator)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.NewOrModified) > 0 {
-		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8406,7 +8312,7 @@ func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevalidateInferenceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8416,12 +8322,12 @@ func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevalidateInferenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8429,7 +8335,7 @@ func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8439,18 +8345,23 @@ func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Seed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seed))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -8464,7 +8375,7 @@ func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimRewardsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8474,20 +8385,32 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8497,46 +8420,75 @@ func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Assignees) > 0 {
-		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x32
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f3 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f3))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
+	if len(m.Nonces) > 0 {
+		dAtA5 := make([]byte, len(m.Nonces)*10)
+		var j4 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
 
-func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8546,12 +8498,12 @@ func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8559,7 +8511,7 @@ func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8569,46 +8521,111 @@ func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ApiBinariesJson) > 0 {
-		i -= len(m.ApiBinariesJson)
-		copy(dAtA[i:], m.ApiBinariesJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
+	if m.FraudDetected {
+		i--
+		if m.FraudDetected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ProbabilityHonest != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ProbabilityHonest))))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.NInvalid != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NInvalid))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.FraudThreshold != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.FraudThreshold))))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.RTarget != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RTarget))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if len(m.ReceivedDist) > 0 {
+		for iNdEx := len(m.ReceivedDist) - 1; iNdEx >= 0; iNdEx-- {
+			f6 := math.Float64bits(float64(m.ReceivedDist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f6))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceivedDist)*8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Dist) > 0 {
+		for iNdEx := len(m.Dist) - 1; iNdEx >= 0; iNdEx-- {
+			f7 := math.Float64bits(float64(m.Dist[iNdEx]))
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f7))
+		}
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Dist)*8))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Nonces) > 0 {
+		dAtA9 := make([]byte, len(m.Nonces)*10)
+		var j8 int
+		for _, num1 := range m.Nonces {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.NodeVersion) > 0 {
-		i -= len(m.NodeVersion)
-		copy(dAtA[i:], m.NodeVersion)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+	if len(m.ParticipantAddress) > 0 {
+		i -= len(m.ParticipantAddress)
+		copy(dAtA[i:], m.ParticipantAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParticipantAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8618,12 +8635,12 @@ func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8631,7 +8648,7 @@ func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidationsV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8641,39 +8658,32 @@ func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationsV2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationsV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Participant) > 0 {
-		i -= len(m.Participant)
-		copy(dAtA[i:], m.Participant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
 		i--
 		dAtA[i] = 0x10
 	}
@@ -8687,7 +8697,7 @@ func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitPocValidationsV2Response) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8697,12 +8707,12 @@ func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationsV2Response) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitPocValidationsV2Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8710,7 +8720,7 @@ func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
+func (m *MsgPoCV2StoreCommit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8720,27 +8730,32 @@ func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPoCV2StoreCommit) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPoCV2StoreCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RootHash)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if m.Count != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8752,7 +8767,7 @@ func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPoCV2StoreCommitResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8762,32 +8777,20 @@ func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPoCV2StoreCommitResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPoCV2StoreCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
+func (m *MsgMLNodeWeightDistribution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8797,27 +8800,34 @@ func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMLNodeWeightDistribution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMLNodeWeightDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Weights) > 0 {
+		for iNdEx := len(m.Weights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Weights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PocStageStartBlockHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8829,7 +8839,7 @@ func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgMLNodeWeightDistributionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8839,32 +8849,20 @@ func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgMLNodeWeightDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgMLNodeWeightDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8874,27 +8872,27 @@ func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8906,7 +8904,7 @@ func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitSeedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8916,32 +8914,20 @@ func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		{
-			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8951,27 +8937,20 @@ func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Req != nil {
-		{
-			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if m.Price != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Price))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -8983,7 +8962,7 @@ func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8993,19 +8972,42 @@ func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterModel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterModel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterModel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidationThreshold != nil {
 		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ValidationThreshold.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9013,12 +9015,71 @@ func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x52
+	}
+	if m.ThroughputPerNonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ThroughputPerNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.VRam != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VRam))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ModelArgs) > 0 {
+		for iNdEx := len(m.ModelArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ModelArgs[iNdEx])
+			copy(dAtA[i:], m.ModelArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ModelArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HfCommit) > 0 {
+		i -= len(m.HfCommit)
+		copy(dAtA[i:], m.HfCommit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfCommit)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.HfRepo) > 0 {
+		i -= len(m.HfRepo)
+		copy(dAtA[i:], m.HfRepo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HfRepo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.UnitsOfComputePerToken != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UnitsOfComputePerToken))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProposedBy) > 0 {
+		i -= len(m.ProposedBy)
+		copy(dAtA[i:], m.ProposedBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProposedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterModelResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9028,19 +9089,42 @@ func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterModelResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterModelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTrainingTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Config != nil {
 		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9048,7 +9132,21 @@ func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
+	}
+	if len(m.HardwareResources) > 0 {
+		for iNdEx := len(m.HardwareResources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HardwareResources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
@@ -9060,7 +9158,7 @@ func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9070,12 +9168,12 @@ func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9095,7 +9193,7 @@ func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitHardwareDiff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9105,83 +9203,55 @@ func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiff) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ReceiptsRoot) > 0 {
-		i -= len(m.ReceiptsRoot)
-		copy(dAtA[i:], m.ReceiptsRoot)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.ReceiptIndex) > 0 {
-		i -= len(m.ReceiptIndex)
-		copy(dAtA[i:], m.ReceiptIndex)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BlockNumber) > 0 {
-		i -= len(m.BlockNumber)
-		copy(dAtA[i:], m.BlockNumber)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OwnerPubKey) > 0 {
-		i -= len(m.OwnerPubKey)
-		copy(dAtA[i:], m.OwnerPubKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.OriginChain) > 0 {
-		i -= len(m.OriginChain)
-		copy(dAtA[i:], m.OriginChain)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.NewOrModified) > 0 {
+		for iNdEx := len(m.NewOrModified) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewOrModified[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Validator) > 0 {
-		i -= len(m.Validator)
-		copy(dAtA[i:], m.Validator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitHardwareDiffResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9191,27 +9261,20 @@ func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiffResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitHardwareDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimTrainingTaskForAssignment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9221,39 +9284,32 @@ func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9263,12 +9319,12 @@ func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9276,7 +9332,7 @@ func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgAssignTrainingTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9286,39 +9342,46 @@ func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
-		i--
-		dAtA[i] = 0x18
+	if len(m.Assignees) > 0 {
+		for iNdEx := len(m.Assignees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assignees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAssignTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9328,12 +9391,12 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAssignTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9341,7 +9404,7 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA [
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePartialUpgrade) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9351,29 +9414,34 @@ func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgrade) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Role != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+	if len(m.ApiBinariesJson) > 0 {
+		i -= len(m.ApiBinariesJson)
+		copy(dAtA[i:], m.ApiBinariesJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ApiBinariesJson)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.NodeVersion) > 0 {
+		i -= len(m.NodeVersion)
+		copy(dAtA[i:], m.NodeVersion)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -9385,7 +9453,7 @@ func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreatePartialUpgradeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9395,12 +9463,12 @@ func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgradeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreatePartialUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9408,7 +9476,7 @@ func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitTrainingKvRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9418,36 +9486,53 @@ func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddParticipantsToAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitTrainingKvRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddParticipantsToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitTrainingKvRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Key)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9457,12 +9542,12 @@ func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitTrainingKvRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9470,7 +9555,7 @@ func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error) {
+func (m *MsgJoinTraining) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9480,36 +9565,39 @@ func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgJoinTraining) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveParticipantsFromAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgJoinTraining) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgJoinTrainingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9519,20 +9607,32 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
+func (m *MsgTrainingHeartbeat) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9542,43 +9642,39 @@ func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterBridgeAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTrainingHeartbeat) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterBridgeAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTrainingHeartbeat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.ChainName) > 0 {
-		i -= len(m.ChainName)
-		copy(dAtA[i:], m.ChainName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainName)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTrainingHeartbeatResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9588,20 +9684,32 @@ func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTrainingHeartbeatResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTrainingHeartbeatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetBarrier) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9611,70 +9719,39 @@ func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetBarrier) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetBarrier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Overwrite {
-		i--
-		if m.Overwrite {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x38
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetBarrierResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9684,20 +9761,32 @@ func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetBarrierResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetBarrierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Resp != nil {
+		{
+			size, err := m.Resp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
+func (m *MsgJoinTrainingStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9707,41 +9796,39 @@ func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgApproveBridgeTokenForTrading) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgApproveBridgeTokenForTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+	if m.Req != nil {
+		{
+			size, err := m.Req.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgJoinTrainingStatusResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9751,71 +9838,74 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgJoinTrainingStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgRegisterLiquidityPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDummyTrainingTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterLiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDummyTrainingTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterLiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDummyTrainingTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.InstantiateMsg) > 0 {
-		i -= len(m.InstantiateMsg)
-		copy(dAtA[i:], m.InstantiateMsg)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.InstantiateMsg)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.CodeId) > 0 {
-		i -= len(m.CodeId)
-		copy(dAtA[i:], m.CodeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeId)))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateDummyTrainingTaskResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9825,20 +9915,32 @@ func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateDummyTrainingTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
+func (m *MsgBridgeExchange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9848,48 +9950,83 @@ func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBridgeExchange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBridgeExchange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+	if len(m.ReceiptsRoot) > 0 {
+		i -= len(m.ReceiptsRoot)
+		copy(dAtA[i:], m.ReceiptsRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptsRoot)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x4a
+	}
+	if len(m.ReceiptIndex) > 0 {
+		i -= len(m.ReceiptIndex)
+		copy(dAtA[i:], m.ReceiptIndex)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptIndex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BlockNumber) > 0 {
+		i -= len(m.BlockNumber)
+		copy(dAtA[i:], m.BlockNumber)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockNumber)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if len(m.Amount) > 0 {
 		i -= len(m.Amount)
 		copy(dAtA[i:], m.Amount)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
 		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OwnerPubKey) > 0 {
+		i -= len(m.OwnerPubKey)
+		copy(dAtA[i:], m.OwnerPubKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerPubKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.UserAddress) > 0 {
-		i -= len(m.UserAddress)
-		copy(dAtA[i:], m.UserAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UserAddress)))
+	if len(m.OriginChain) > 0 {
+		i -= len(m.OriginChain)
+		copy(dAtA[i:], m.OriginChain)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OriginChain)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Validator)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBridgeExchangeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9899,39 +10036,27 @@ func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBridgeExchangeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBridgeExchangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlsRequestId) > 0 {
-		i -= len(m.BlsRequestId)
-		copy(dAtA[i:], m.BlsRequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddUserToTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9941,48 +10066,39 @@ func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeMint) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddUserToTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddUserToTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.DestinationAddress) > 0 {
-		i -= len(m.DestinationAddress)
-		copy(dAtA[i:], m.DestinationAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddUserToTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9992,39 +10108,20 @@ func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBridgeMintResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBridgeMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddUserToTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlsRequestId) > 0 {
-		i -= len(m.BlsRequestId)
-		copy(dAtA[i:], m.BlsRequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EpochIndex != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.RequestId) > 0 {
-		i -= len(m.RequestId)
-		copy(dAtA[i:], m.RequestId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveUserFromTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10034,20 +10131,27 @@ func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveUserFromTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -10059,7 +10163,7 @@ func (m *MsgRegisterWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10069,12 +10173,12 @@ func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10082,7 +10186,7 @@ func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetTrainingAllowList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10092,32 +10196,29 @@ func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateAllWrappedTokens) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetTrainingAllowList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetTrainingAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.MigrateMsgJson) > 0 {
-		i -= len(m.MigrateMsgJson)
-		copy(dAtA[i:], m.MigrateMsgJson)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsgJson)))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if m.NewCodeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.NewCodeId))
-		i--
-		dAtA[i] = 0x10
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
@@ -10129,7 +10230,7 @@ func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetTrainingAllowListResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10139,1330 +10240,1505 @@ func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetTrainingAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetTrainingAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Attempted != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Attempted))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddParticipantsToAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAddParticipantsToAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddParticipantsToAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAddParticipantsToAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddParticipantsToAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddParticipantsToAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStartInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveParticipantsFromAllowList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveParticipantsFromAllowList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveParticipantsFromAllowList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PromptHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PromptPayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AssignedTo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxTokens != 0 {
-		n += 1 + sovTx(uint64(m.MaxTokens))
-	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
-	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
-	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.OriginalPromptHash)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgStartInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveParticipantsFromAllowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveParticipantsFromAllowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ErrorMessage)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinishInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterBridgeAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterBridgeAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterBridgeAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PromptTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.PromptTokenCount))
-	}
-	if m.CompletionTokenCount != 0 {
-		n += 1 + sovTx(uint64(m.CompletionTokenCount))
-	}
-	l = len(m.ExecutedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TransferredBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequestTimestamp != 0 {
-		n += 1 + sovTx(uint64(m.RequestTimestamp))
-	}
-	l = len(m.TransferSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExecutorSignature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RequestedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalPrompt)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Model)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.PromptHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainName) > 0 {
+		i -= len(m.ChainName)
+		copy(dAtA[i:], m.ChainName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainName)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OriginalPromptHash)
-	if l > 0 {
-		n += 2 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinishInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InferenceIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ErrorMessage)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterBridgeAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitNewParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgRegisterBridgeAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterBridgeAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ParticipantIndex)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterTokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterTokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Overwrite {
+		i--
+		if m.Overwrite {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Decimals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.ResponsePayload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ResponseHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Value != 0 {
-		n += 9
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Revalidation {
-		n += 2
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ValueDecimal != nil {
-		l = m.ValueDecimal.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterTokenMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterTokenMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTokenMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WorkerKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgApproveBridgeTokenForTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgInvalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgApproveBridgeTokenForTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBridgeTokenForTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgInvalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgApproveBridgeTokenForTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevalidateInference) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterLiquidityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterLiquidityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterLiquidityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.InstantiateMsg) > 0 {
+		i -= len(m.InstantiateMsg)
+		copy(dAtA[i:], m.InstantiateMsg)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InstantiateMsg)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.InferenceId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Invalidator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CodeId) > 0 {
+		i -= len(m.CodeId)
+		copy(dAtA[i:], m.CodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevalidateInferenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBridgeWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.Seed != 0 {
-		n += 1 + sovTx(uint64(m.Seed))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgClaimRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovTx(uint64(m.Amount))
-	}
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRequestBridgeWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRequestBridgeWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	l = len(m.BatchId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	if len(m.BlsRequestId) > 0 {
+		i -= len(m.BlsRequestId)
+		copy(dAtA[i:], m.BlsRequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocValidation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRequestBridgeMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ParticipantAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	if len(m.Nonces) > 0 {
-		l = 0
-		for _, e := range m.Nonces {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Dist) > 0 {
-		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
-	}
-	if len(m.ReceivedDist) > 0 {
-		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
-	}
-	if m.RTarget != 0 {
-		n += 9
-	}
-	if m.FraudThreshold != 0 {
-		n += 9
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if m.NInvalid != 0 {
-		n += 1 + sovTx(uint64(m.NInvalid))
+	if len(m.DestinationAddress) > 0 {
+		i -= len(m.DestinationAddress)
+		copy(dAtA[i:], m.DestinationAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ProbabilityHonest != 0 {
-		n += 9
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.FraudDetected {
-		n += 2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestBridgeMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitPocValidationsV2) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRequestBridgeMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBridgeMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BlsRequestId) > 0 {
+		i -= len(m.BlsRequestId)
+		copy(dAtA[i:], m.BlsRequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlsRequestId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	if m.EpochIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EpochIndex))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Validations) > 0 {
-		for _, e := range m.Validations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.RequestId) > 0 {
+		i -= len(m.RequestId)
+		copy(dAtA[i:], m.RequestId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RequestId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitPocValidationsV2Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterWrappedTokenContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPoCV2StoreCommit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterWrappedTokenContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterWrappedTokenContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	if m.Count != 0 {
-		n += 1 + sovTx(uint64(m.Count))
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.RootHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPoCV2StoreCommitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterWrappedTokenContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgMLNodeWeightDistribution) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PocStageStartBlockHeight != 0 {
-		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
-	}
-	if len(m.Weights) > 0 {
-		for _, e := range m.Weights {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgRegisterWrappedTokenContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgMLNodeWeightDistributionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterWrappedTokenContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateAllWrappedTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateAllWrappedTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllWrappedTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if len(m.MigrateMsgJson) > 0 {
+		i -= len(m.MigrateMsgJson)
+		copy(dAtA[i:], m.MigrateMsgJson)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MigrateMsgJson)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.NewCodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewCodeId))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateAllWrappedTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgMigrateAllWrappedTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Price != 0 {
-		n += 1 + sovTx(uint64(m.Price))
+	if m.Attempted != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Attempted))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgInitiateExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRegisterModel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgInitiateExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProposedBy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.UnitsOfComputePerToken != 0 {
-		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
-	}
-	l = len(m.HfRepo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.HfCommit)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ModelArgs) > 0 {
-		for _, s := range m.ModelArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.VRam != 0 {
-		n += 1 + sovTx(uint64(m.VRam))
-	}
-	if m.ThroughputPerNonce != 0 {
-		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
-	}
-	if m.ValidationThreshold != nil {
-		l = m.ValidationThreshold.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterModelResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgInitiateExitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.HardwareResources) > 0 {
-		for _, e := range m.HardwareResources {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgInitiateExitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgInitiateExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.LastEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LastEpoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiff) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateToParticipant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateToParticipant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateToParticipant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.NewOrModified) > 0 {
-		for _, e := range m.NewOrModified {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Removed) > 0 {
-		for _, e := range m.Removed {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDelegateToParticipantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	return n
+func (m *MsgDelegateToParticipantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgDelegateToParticipantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssignTrainingTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	if len(m.Assignees) > 0 {
-		for _, e := range m.Assignees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreatePartialUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NodeVersion)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Participant) > 0 {
+		i -= len(m.Participant)
+		copy(dAtA[i:], m.Participant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Participant)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ApiBinariesJson)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
-	}
-	l = len(m.Participant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ReleaseEpoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseEpoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgValidationBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgJoinTraining) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgValidationBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidationBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Validations) > 0 {
+		for iNdEx := len(m.Validations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
+func (m *ValidationBatchItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgTrainingHeartbeat) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *ValidationBatchItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidationBatchItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	if m.ValueDecimal != nil {
+		{
+			size, err := m.ValueDecimal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Revalidation {
+		i--
+		if m.Revalidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ResponseHash) > 0 {
+		i -= len(m.ResponseHash)
+		copy(dAtA[i:], m.ResponseHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ResponseHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrier) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidationBatchResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidationBatchResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidationBatchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.InferenceId) > 0 {
+		i -= len(m.InferenceId)
+		copy(dAtA[i:], m.InferenceId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.InferenceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetBarrierResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgValidationBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgValidationBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgValidationBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Resp != nil {
-		l = m.Resp.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Req != nil {
-		l = m.Req.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateDummyTrainingTask) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Creator)
+	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
+func (m *MsgUpdateParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
 	return n
 }
 
-func (m *MsgBridgeExchange) Size() (n int) {
+func (m *MsgStartInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Validator)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.OriginChain)
+	l = len(m.InferenceId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ContractAddress)
+	l = len(m.PromptHash)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.OwnerAddress)
+	l = len(m.PromptPayload)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.OwnerPubKey)
+	l = len(m.Model)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Amount)
+	l = len(m.RequestedBy)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.BlockNumber)
+	l = len(m.AssignedTo)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ReceiptIndex)
+	l = len(m.NodeVersion)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ReceiptsRoot)
+	if m.MaxTokens != 0 {
+		n += 1 + sovTx(uint64(m.MaxTokens))
+	}
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
+	}
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
+	}
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPrompt)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.OriginalPromptHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgBridgeExchangeResponse) Size() (n int) {
+func (m *MsgStartInferenceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Id)
+	l = len(m.InferenceIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ErrorMessage)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
+func (m *MsgFinishInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.InferenceId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PromptTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.PromptTokenCount))
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+	if m.CompletionTokenCount != 0 {
+		n += 1 + sovTx(uint64(m.CompletionTokenCount))
+	}
+	l = len(m.ExecutedBy)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.TransferredBy)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	if m.RequestTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.RequestTimestamp))
 	}
-	return n
-}
-
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.TransferSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgSetTrainingAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.ExecutorSignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+	l = len(m.RequestedBy)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.OriginalPrompt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Role != 0 {
-		n += 1 + sovTx(uint64(m.Role))
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.PromptHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalPromptHash)
+	if l > 0 {
+		n += 2 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
 	return n
 }
 
-func (m *MsgAddParticipantsToAllowList) Size() (n int) {
+func (m *MsgFinishInferenceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.InferenceIndex)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.ErrorMessage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgAddParticipantsToAllowListResponse) Size() (n int) {
+func (m *MsgSubmitNewParticipant) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgRemoveParticipantsFromAllowList) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
+	l = len(m.Url)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.ValidatorKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRemoveParticipantsFromAllowListResponse) Size() (n int) {
+func (m *MsgSubmitNewParticipantResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.ParticipantIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
-func (m *MsgRegisterBridgeAddresses) Size() (n int) {
+func (m *MsgValidation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChainName)
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponsePayload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Value != 0 {
+		n += 9
+	}
+	if m.Revalidation {
+		n += 2
+	}
+	if m.ValueDecimal != nil {
+		l = m.ValueDecimal.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRegisterBridgeAddressesResponse) Size() (n int) {
+func (m *MsgValidationResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11471,42 +11747,40 @@ func (m *MsgRegisterBridgeAddressesResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgRegisterTokenMetadata) Size() (n int) {
+func (m *MsgSubmitNewUnfundedParticipant) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChainId)
+	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ContractAddress)
+	l = len(m.Url)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Name)
+	l = len(m.PubKey)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Symbol)
+	l = len(m.ValidatorKey)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovTx(uint64(m.Decimals))
-	}
-	if m.Overwrite {
-		n += 2
+	l = len(m.WorkerKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRegisterTokenMetadataResponse) Size() (n int) {
+func (m *MsgSubmitNewUnfundedParticipantResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11515,28 +11789,28 @@ func (m *MsgRegisterTokenMetadataResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgApproveBridgeTokenForTrading) Size() (n int) {
+func (m *MsgInvalidateInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChainId)
+	l = len(m.InferenceId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ContractAddress)
+	l = len(m.Invalidator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgApproveBridgeTokenForTradingResponse) Size() (n int) {
+func (m *MsgInvalidateInferenceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11545,32 +11819,28 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgRegisterLiquidityPool) Size() (n int) {
+func (m *MsgRevalidateInference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeId)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Label)
+	l = len(m.InferenceId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.InstantiateMsg)
+	l = len(m.Invalidator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRegisterLiquidityPoolResponse) Size() (n int) {
+func (m *MsgRevalidateInferenceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11579,7 +11849,7 @@ func (m *MsgRegisterLiquidityPoolResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgRequestBridgeWithdrawal) Size() (n int) {
+func (m *MsgClaimRewards) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11589,42 +11859,32 @@ func (m *MsgRequestBridgeWithdrawal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.UserAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Seed != 0 {
+		n += 1 + sovTx(uint64(m.Seed))
 	}
-	l = len(m.DestinationAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
 	}
 	return n
 }
 
-func (m *MsgRequestBridgeWithdrawalResponse) Size() (n int) {
+func (m *MsgClaimRewardsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RequestId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
 	}
-	l = len(m.BlsRequestId)
+	l = len(m.Result)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRequestBridgeMint) Size() (n int) {
+func (m *MsgSubmitPocBatch) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11634,58 +11894,182 @@ func (m *MsgRequestBridgeMint) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Amount)
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	l = len(m.BatchId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.DestinationAddress)
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	l = len(m.NodeId)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ChainId)
+	return n
+}
+
+func (m *MsgSubmitPocBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPocValidation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParticipantAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	if len(m.Nonces) > 0 {
+		l = 0
+		for _, e := range m.Nonces {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Dist) > 0 {
+		n += 1 + sovTx(uint64(len(m.Dist)*8)) + len(m.Dist)*8
+	}
+	if len(m.ReceivedDist) > 0 {
+		n += 1 + sovTx(uint64(len(m.ReceivedDist)*8)) + len(m.ReceivedDist)*8
+	}
+	if m.RTarget != 0 {
+		n += 9
+	}
+	if m.FraudThreshold != 0 {
+		n += 9
+	}
+	if m.NInvalid != 0 {
+		n += 1 + sovTx(uint64(m.NInvalid))
+	}
+	if m.ProbabilityHonest != 0 {
+		n += 9
+	}
+	if m.FraudDetected {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitPocValidationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitPocValidationsV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgRequestBridgeMintResponse) Size() (n int) {
+func (m *MsgSubmitPocValidationsV2Response) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RequestId)
+	return n
+}
+
+func (m *MsgPoCV2StoreCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.EpochIndex != 0 {
-		n += 1 + sovTx(uint64(m.EpochIndex))
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
 	}
-	l = len(m.BlsRequestId)
+	if m.Count != 0 {
+		n += 1 + sovTx(uint64(m.Count))
+	}
+	l = len(m.RootHash)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
 	return n
 }
 
-func (m *MsgRegisterWrappedTokenContract) Size() (n int) {
+func (m *MsgPoCV2StoreCommitResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	return n
+}
+
+func (m *MsgMLNodeWeightDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.CodeId != 0 {
-		n += 1 + sovTx(uint64(m.CodeId))
+	if m.PocStageStartBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.PocStageStartBlockHeight))
+	}
+	if len(m.Weights) > 0 {
+		for _, e := range m.Weights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *MsgRegisterWrappedTokenContractResponse) Size() (n int) {
+func (m *MsgMLNodeWeightDistributionResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11694,79 +12078,2307 @@ func (m *MsgRegisterWrappedTokenContractResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgMigrateAllWrappedTokens) Size() (n int) {
+func (m *MsgSubmitSeed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Creator)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.NewCodeId != 0 {
-		n += 1 + sovTx(uint64(m.NewCodeId))
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
 	}
-	l = len(m.MigrateMsgJson)
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
+	return n
+}
+
+func (m *MsgSubmitSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *MsgMigrateAllWrappedTokensResponse) Size() (n int) {
+func (m *MsgSubmitUnitOfComputePriceProposal) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Attempted != 0 {
-		n += 1 + sovTx(uint64(m.Attempted))
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Price != 0 {
+		n += 1 + sovTx(uint64(m.Price))
 	}
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgRegisterModel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProposedBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.UnitsOfComputePerToken != 0 {
+		n += 1 + sovTx(uint64(m.UnitsOfComputePerToken))
+	}
+	l = len(m.HfRepo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HfCommit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ModelArgs) > 0 {
+		for _, s := range m.ModelArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.VRam != 0 {
+		n += 1 + sovTx(uint64(m.VRam))
+	}
+	if m.ThroughputPerNonce != 0 {
+		n += 1 + sovTx(uint64(m.ThroughputPerNonce))
+	}
+	if m.ValidationThreshold != nil {
+		l = m.ValidationThreshold.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgRegisterModelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.HardwareResources) > 0 {
+		for _, e := range m.HardwareResources {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.NewOrModified) > 0 {
+		for _, e := range m.NewOrModified {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitHardwareDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAssignTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	if len(m.Assignees) > 0 {
+		for _, e := range m.Assignees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAssignTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePartialUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	l = len(m.NodeVersion)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ApiBinariesJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePartialUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitTrainingKvRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgJoinTraining) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTrainingHeartbeatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetBarrierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resp != nil {
+		l = m.Resp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Req != nil {
+		l = m.Req.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinTrainingStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDummyTrainingTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeExchange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginChain)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OwnerPubKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockNumber)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptIndex)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptsRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBridgeExchangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgAddUserToTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTrainingAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *MsgSetTrainingAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddParticipantsToAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddParticipantsToAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveParticipantsFromAllowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveParticipantsFromAllowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterBridgeAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterBridgeAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterTokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTx(uint64(m.Decimals))
+	}
+	if m.Overwrite {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterTokenMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgApproveBridgeTokenForTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgApproveBridgeTokenForTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterLiquidityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InstantiateMsg)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBridgeWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.BlsRequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBridgeMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EpochIndex != 0 {
+		n += 1 + sovTx(uint64(m.EpochIndex))
+	}
+	l = len(m.BlsRequestId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterWrappedTokenContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *MsgRegisterWrappedTokenContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateAllWrappedTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewCodeId != 0 {
+		n += 1 + sovTx(uint64(m.NewCodeId))
+	}
+	l = len(m.MigrateMsgJson)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgMigrateAllWrappedTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempted != 0 {
+		n += 1 + sovTx(uint64(m.Attempted))
+	}
+	return n
+}
+
+func (m *MsgInitiateExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateExitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastEpoch != 0 {
+		n += 1 + sovTx(uint64(m.LastEpoch))
+	}
+	return n
+}
+
+func (m *MsgDelegateToParticipant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgDelegateToParticipantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Participant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseEpoch != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseEpoch))
+	}
+	return n
+}
+
+func (m *MsgValidationBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Validations) > 0 {
+		for _, e := range m.Validations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidationBatchItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ResponseHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Revalidation {
+		n += 2
+	}
+	if m.ValueDecimal != nil {
+		l = m.ValueDecimal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidationBatchResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InferenceId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValidationBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PromptPayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Model = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssignedTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
+			}
+			m.MaxTokens = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTokens |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
+			}
+			m.PromptTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PromptTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
+			}
+			m.CompletionTokenCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTokenCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+			}
+			m.RequestTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11794,13 +14406,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Model = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -11810,24 +14422,55 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11850,7 +14493,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11873,12 +14516,76 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -11900,7 +14607,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11923,10 +14630,10 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11963,7 +14670,7 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11991,11 +14698,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12023,11 +14730,93 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptPayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12055,11 +14844,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptPayload = string(dAtA[iNdEx:postIndex])
+			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12087,43 +14876,61 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssignedTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12151,11 +14958,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssignedTo = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12183,68 +14990,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokens", wireType)
-			}
-			m.MaxTokens = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxTokens |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
-			}
-			m.PromptTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
-			}
-			m.RequestTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12272,11 +15022,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12304,11 +15054,11 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12336,63 +15086,24 @@ func (m *MsgStartInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			if iNdEx >= l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStartInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Value = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12402,29 +15113,17 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Revalidation = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDecimal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -12434,23 +15133,27 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
+			if m.ValueDecimal == nil {
+				m.ValueDecimal = &Decimal{}
+			}
+			if err := m.ValueDecimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12473,7 +15176,7 @@ func (m *MsgStartInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
+func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12496,10 +15199,60 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12536,7 +15289,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12564,11 +15317,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12596,11 +15349,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12628,49 +15381,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
+			m.PubKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptTokenCount", wireType)
-			}
-			m.PromptTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PromptTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTokenCount", wireType)
-			}
-			m.CompletionTokenCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CompletionTokenCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12698,11 +15413,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutedBy = string(dAtA[iNdEx:postIndex])
+			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferredBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12730,62 +15445,111 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferredBy = string(dAtA[iNdEx:postIndex])
+			m.WorkerKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestTimestamp", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RequestTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RequestTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferSignature", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransferSignature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12813,11 +15577,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorSignature = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedBy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12845,11 +15609,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedBy = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPrompt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12877,11 +15641,111 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPrompt = string(dAtA[iNdEx:postIndex])
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12909,11 +15773,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Model = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12941,11 +15805,11 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromptHash = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPromptHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12973,7 +15837,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalPromptHash = string(dAtA[iNdEx:postIndex])
+			m.Invalidator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12996,7 +15860,7 @@ func (m *MsgFinishInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13019,76 +15883,12 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFinishInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorMessage", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ErrorMessage = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13110,7 +15910,7 @@ func (m *MsgFinishInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13133,10 +15933,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13172,42 +15972,10 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
 			}
-			var stringLen uint64
+			m.Seed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13217,29 +15985,16 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Seed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13249,24 +16004,11 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13288,7 +16030,7 @@ func (m *MsgSubmitNewParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13311,17 +16053,17 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantIndex", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13331,27 +16073,14 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParticipantIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13379,7 +16108,7 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Result = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13402,7 +16131,7 @@ func (m *MsgSubmitNewParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13425,10 +16154,10 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13464,10 +16193,10 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13477,27 +16206,14 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13525,108 +16241,143 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
+			m.BatchId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponsePayload", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResponsePayload = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ResponseHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Value = float64(math.Float64frombits(v))
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
-			m.Revalidation = bool(v != 0)
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueDecimal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13636,27 +16387,23 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueDecimal == nil {
-				m.ValueDecimal = &Decimal{}
-			}
-			if err := m.ValueDecimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13679,7 +16426,7 @@ func (m *MsgValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13702,10 +16449,10 @@ func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -13729,7 +16476,7 @@ func (m *MsgValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13752,10 +16499,10 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13792,7 +16539,7 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13820,77 +16567,238 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Nonces = append(m.Nonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Nonces) == 0 {
+					m.Nonces = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Nonces = append(m.Nonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			}
+		case 5:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Dist = append(m.Dist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Dist) == 0 {
+					m.Dist = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Dist = append(m.Dist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			}
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.ReceivedDist = append(m.ReceivedDist, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.ReceivedDist) == 0 {
+					m.ReceivedDist = make([]float64, 0, elementCount)
 				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.ReceivedDist = append(m.ReceivedDist, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RTarget = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PubKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKey", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FraudThreshold = float64(math.Float64frombits(v))
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
 			}
-			var stringLen uint64
+			m.NInvalid = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13900,29 +16808,27 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NInvalid |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerKey", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ProbabilityHonest = float64(math.Float64frombits(v))
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -13932,24 +16838,12 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WorkerKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.FraudDetected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -13971,7 +16865,7 @@ func (m *MsgSubmitNewUnfundedParticipant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13994,10 +16888,10 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitNewUnfundedParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14021,7 +16915,7 @@ func (m *MsgSubmitNewUnfundedParticipantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14044,10 +16938,10 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidationsV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidationsV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14083,10 +16977,10 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14096,29 +16990,16 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14128,23 +17009,25 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			m.Validations = append(m.Validations, &PoCValidationPayloadV2{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14167,7 +17050,7 @@ func (m *MsgInvalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitPocValidationsV2Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14190,10 +17073,10 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitPocValidationsV2Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgInvalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitPocValidationsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14217,7 +17100,7 @@ func (m *MsgInvalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
+func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14240,10 +17123,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPoCV2StoreCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPoCV2StoreCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14279,10 +17162,10 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14292,29 +17175,35 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.InferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Invalidator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14324,23 +17213,25 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Invalidator = string(dAtA[iNdEx:postIndex])
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14363,7 +17254,7 @@ func (m *MsgRevalidateInference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPoCV2StoreCommitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14386,10 +17277,10 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPoCV2StoreCommitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevalidateInferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPoCV2StoreCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14413,7 +17304,7 @@ func (m *MsgRevalidateInferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14436,10 +17327,10 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMLNodeWeightDistribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMLNodeWeightDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14476,9 +17367,9 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
 			}
-			m.Seed = 0
+			m.PocStageStartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14488,16 +17379,16 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seed |= int64(b&0x7F) << shift
+				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
 			}
-			m.EpochIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14507,11 +17398,26 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weights = append(m.Weights, &MLNodeWeight{})
+			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14533,7 +17439,7 @@ func (m *MsgClaimRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMLNodeWeightDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14556,63 +17462,12 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMLNodeWeightDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMLNodeWeightDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			m.Amount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14634,7 +17489,7 @@ func (m *MsgClaimRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14657,10 +17512,10 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14697,9 +17552,9 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -14709,14 +17564,14 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14744,141 +17599,111 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchId = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14906,8 +17731,27 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			m.Price = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Price |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -14929,7 +17773,7 @@ func (m *MsgSubmitPocBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14952,10 +17796,10 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -14979,7 +17823,7 @@ func (m *MsgSubmitPocBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15002,15 +17846,15 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15038,11 +17882,11 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipantAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15070,13 +17914,13 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParticipantAddress = string(dAtA[iNdEx:postIndex])
+			m.ProposedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15086,222 +17930,163 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Nonces = append(m.Nonces, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Nonces) == 0 {
-					m.Nonces = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Nonces = append(m.Nonces, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonces", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
 			}
-		case 5:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
-				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.Dist = append(m.Dist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.UnitsOfComputePerToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.Dist) == 0 {
-					m.Dist = make([]float64, 0, elementCount)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.Dist = append(m.Dist, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dist", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HfRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
-			if wireType == 1 {
-				var v uint64
-				if (iNdEx + 8) > l {
-					return io.ErrUnexpectedEOF
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-				iNdEx += 8
-				v2 := float64(math.Float64frombits(v))
-				m.ReceivedDist = append(m.ReceivedDist, v2)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HfCommit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				elementCount = packedLen / 8
-				if elementCount != 0 && len(m.ReceivedDist) == 0 {
-					m.ReceivedDist = make([]float64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					if (iNdEx + 8) > l {
-						return io.ErrUnexpectedEOF
-					}
-					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-					iNdEx += 8
-					v2 := float64(math.Float64frombits(v))
-					m.ReceivedDist = append(m.ReceivedDist, v2)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedDist", wireType)
 			}
-		case 7:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RTarget", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.RTarget = float64(math.Float64frombits(v))
+			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 8:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudThreshold", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.VRam = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VRam |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FraudThreshold = float64(math.Float64frombits(v))
 		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NInvalid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
 			}
-			m.NInvalid = 0
+			m.ThroughputPerNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15311,27 +18096,16 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NInvalid |= int64(b&0x7F) << shift
+				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 10:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProbabilityHonest", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.ProbabilityHonest = float64(math.Float64frombits(v))
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FraudDetected", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15341,12 +18115,28 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FraudDetected = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValidationThreshold == nil {
+				m.ValidationThreshold = &Decimal{}
+			}
+			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15368,7 +18158,7 @@ func (m *MsgSubmitPocValidation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15391,10 +18181,10 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15418,7 +18208,7 @@ func (m *MsgSubmitPocValidationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15441,10 +18231,10 @@ func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationsV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationsV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15480,10 +18270,10 @@ func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15493,14 +18283,29 @@ func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
+			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15527,8 +18332,10 @@ func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validations = append(m.Validations, &PoCValidationPayloadV2{})
-			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &TrainingConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15553,7 +18360,7 @@ func (m *MsgSubmitPocValidationsV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitPocValidationsV2Response) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15576,12 +18383,48 @@ func (m *MsgSubmitPocValidationsV2Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationsV2Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitPocValidationsV2Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15603,7 +18446,7 @@ func (m *MsgSubmitPocValidationsV2Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15626,10 +18469,10 @@ func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPoCV2StoreCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPoCV2StoreCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15665,10 +18508,10 @@ func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
 			}
-			m.PocStageStartBlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15678,35 +18521,31 @@ func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.Count = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Count |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
+			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15716,24 +18555,24 @@ func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.RootHash == nil {
-				m.RootHash = []byte{}
+			m.Removed = append(m.Removed, &HardwareNode{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -15757,7 +18596,7 @@ func (m *MsgPoCV2StoreCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPoCV2StoreCommitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15780,10 +18619,10 @@ func (m *MsgPoCV2StoreCommitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPoCV2StoreCommitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPoCV2StoreCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15807,7 +18646,7 @@ func (m *MsgPoCV2StoreCommitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15830,10 +18669,10 @@ func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMLNodeWeightDistribution: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMLNodeWeightDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15870,28 +18709,9 @@ func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocStageStartBlockHeight", wireType)
-			}
-			m.PocStageStartBlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PocStageStartBlockHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -15901,26 +18721,11 @@ func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Weights = append(m.Weights, &MLNodeWeight{})
-			if err := m.Weights[len(m.Weights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -15942,7 +18747,7 @@ func (m *MsgMLNodeWeightDistribution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMLNodeWeightDistributionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15965,10 +18770,10 @@ func (m *MsgMLNodeWeightDistributionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMLNodeWeightDistributionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMLNodeWeightDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15992,7 +18797,7 @@ func (m *MsgMLNodeWeightDistributionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16015,10 +18820,10 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16055,9 +18860,9 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			m.EpochIndex = 0
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16067,16 +18872,16 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16086,23 +18891,25 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
+			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16125,7 +18932,7 @@ func (m *MsgSubmitSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16148,10 +18955,10 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16175,7 +18982,7 @@ func (m *MsgSubmitSeedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16198,15 +19005,66 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16234,13 +19092,13 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.NodeVersion = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
 			}
-			m.Price = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16250,11 +19108,24 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16276,7 +19147,7 @@ func (m *MsgSubmitUnitOfComputePriceProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16299,10 +19170,10 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitUnitOfComputePriceProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16326,7 +19197,7 @@ func (m *MsgSubmitUnitOfComputePriceProposalResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16349,15 +19220,15 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModel: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16385,13 +19256,13 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var stringLen uint64
+			m.TaskId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -16401,27 +19272,14 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TaskId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProposedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16449,30 +19307,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitsOfComputePerToken", wireType)
-			}
-			m.UnitsOfComputePerToken = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnitsOfComputePerToken |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16500,11 +19339,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfRepo = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HfCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16532,11 +19371,111 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HfCommit = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModelArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16564,49 +19503,11 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModelArgs = append(m.ModelArgs, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VRam", wireType)
-			}
-			m.VRam = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VRam |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ThroughputPerNonce", wireType)
-			}
-			m.ThroughputPerNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ThroughputPerNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16633,10 +19534,10 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValidationThreshold == nil {
-				m.ValidationThreshold = &Decimal{}
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
 			}
-			if err := m.ValidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16661,7 +19562,7 @@ func (m *MsgRegisterModel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16684,12 +19585,48 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterModelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -16711,7 +19648,7 @@ func (m *MsgRegisterModelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16734,10 +19671,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16774,41 +19711,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HardwareResources", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.HardwareResources = append(m.HardwareResources, &TrainingHardwareResources{})
-			if err := m.HardwareResources[len(m.HardwareResources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16835,10 +19738,10 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &TrainingConfig{}
+			if m.Req == nil {
+				m.Req = &HeartbeatRequest{}
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16863,7 +19766,7 @@ func (m *MsgCreateTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16886,15 +19789,15 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16921,10 +19824,10 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
+			if m.Resp == nil {
+				m.Resp = &HeartbeatResponse{}
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16949,7 +19852,7 @@ func (m *MsgCreateTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16972,10 +19875,10 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17012,7 +19915,7 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOrModified", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17039,42 +19942,10 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOrModified = append(m.NewOrModified, &HardwareNode{})
-			if err := m.NewOrModified[len(m.NewOrModified)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Req == nil {
+				m.Req = &SetBarrierRequest{}
 			}
-			m.Removed = append(m.Removed, &HardwareNode{})
-			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17099,7 +19970,7 @@ func (m *MsgSubmitHardwareDiff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17122,12 +19993,48 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitHardwareDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resp == nil {
+				m.Resp = &SetBarrierResponse{}
+			}
+			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17149,7 +20056,7 @@ func (m *MsgSubmitHardwareDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17172,10 +20079,10 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17211,10 +20118,10 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
 			}
-			m.TaskId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17224,11 +20131,28 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Req == nil {
+				m.Req = &JoinTrainingRequest{}
+			}
+			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17250,7 +20174,7 @@ func (m *MsgClaimTrainingTaskForAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17273,12 +20197,48 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimTrainingTaskForAssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &MLNodeTrainStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17300,7 +20260,7 @@ func (m *MsgClaimTrainingTaskForAssignmentResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17323,10 +20283,10 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17362,27 +20322,8 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17409,8 +20350,10 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Assignees = append(m.Assignees, &TrainingTaskAssignee{})
-			if err := m.Assignees[len(m.Assignees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17435,7 +20378,7 @@ func (m *MsgAssignTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17458,12 +20401,48 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAssignTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &TrainingTask{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17485,7 +20464,7 @@ func (m *MsgAssignTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17508,15 +20487,15 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17544,13 +20523,173 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17560,14 +20699,27 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17595,11 +20747,11 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeVersion = string(dAtA[iNdEx:postIndex])
+			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApiBinariesJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17627,7 +20779,7 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ApiBinariesJson = string(dAtA[iNdEx:postIndex])
+			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17650,7 +20802,7 @@ func (m *MsgCreatePartialUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17673,12 +20825,44 @@ func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePartialUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17700,7 +20884,7 @@ func (m *MsgCreatePartialUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17723,15 +20907,15 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17759,62 +20943,11 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			m.TaskId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Participant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17842,13 +20975,13 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -17858,24 +20991,11 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= TrainingRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -17897,7 +21017,7 @@ func (m *MsgSubmitTrainingKvRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17920,10 +21040,10 @@ func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitTrainingKvRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17947,7 +21067,7 @@ func (m *MsgSubmitTrainingKvRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17970,15 +21090,15 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTraining: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTraining: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18006,13 +21126,13 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18022,28 +21142,43 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18065,7 +21200,7 @@ func (m *MsgJoinTraining) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18080,56 +21215,20 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18151,7 +21250,7 @@ func (m *MsgJoinTrainingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18174,15 +21273,15 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18210,13 +21309,13 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18226,28 +21325,43 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &HeartbeatRequest{}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= TrainingRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18269,7 +21383,7 @@ func (m *MsgTrainingHeartbeat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18292,48 +21406,12 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTrainingHeartbeatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &HeartbeatResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18355,7 +21433,7 @@ func (m *MsgTrainingHeartbeatResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
+func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18378,15 +21456,15 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrier: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18414,13 +21492,13 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18430,27 +21508,23 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &SetBarrierRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18473,7 +21547,7 @@ func (m *MsgSetBarrier) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18496,48 +21570,12 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetBarrierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resp", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Resp == nil {
-				m.Resp = &SetBarrierResponse{}
-			}
-			if err := m.Resp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18559,7 +21597,7 @@ func (m *MsgSetBarrierResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18582,15 +21620,15 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18618,13 +21656,13 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Req", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18634,27 +21672,23 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Req == nil {
-				m.Req = &JoinTrainingRequest{}
-			}
-			if err := m.Req.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18674,74 +21708,38 @@ func (m *MsgJoinTrainingStatus) Unmarshal(dAtA []byte) error {
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinTrainingStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+	}
+	return nil
+}
+func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &MLNodeTrainStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18763,7 +21761,7 @@ func (m *MsgJoinTrainingStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18786,15 +21784,15 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18822,13 +21820,13 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -18838,27 +21836,55 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
+			m.ChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18881,7 +21907,7 @@ func (m *MsgCreateDummyTrainingTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18904,48 +21930,12 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDummyTrainingTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Task == nil {
-				m.Task = &TrainingTask{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -18967,7 +21957,7 @@ func (m *MsgCreateDummyTrainingTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18990,15 +21980,15 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchange: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterTokenMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19026,11 +22016,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19058,7 +22048,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginChain = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -19094,7 +22084,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19122,11 +22112,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerPubKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19154,13 +22144,13 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerPubKey = string(dAtA[iNdEx:postIndex])
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			var stringLen uint64
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19170,27 +22160,134 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Decimals |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19218,11 +22315,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockNumber = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19250,11 +22347,11 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptIndex = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptsRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19282,7 +22379,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiptsRoot = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19305,7 +22402,7 @@ func (m *MsgBridgeExchange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19328,44 +22425,12 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBridgeExchangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19387,7 +22452,7 @@ func (m *MsgBridgeExchangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19410,10 +22475,10 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterLiquidityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterLiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19450,7 +22515,7 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19478,13 +22543,13 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.CodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19494,11 +22559,56 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateMsg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantiateMsg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19520,7 +22630,7 @@ func (m *MsgAddUserToTrainingAllowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19543,10 +22653,10 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddUserToTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19570,7 +22680,7 @@ func (m *MsgAddUserToTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19593,15 +22703,15 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19629,11 +22739,11 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19661,13 +22771,13 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19677,61 +22787,56 @@ func (m *MsgRemoveUserFromTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveUserFromTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19753,7 +22858,7 @@ func (m *MsgRemoveUserFromTrainingAllowListResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19776,15 +22881,15 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19812,13 +22917,13 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
 			}
-			var stringLen uint64
+			m.EpochIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19828,29 +22933,16 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EpochIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
 			}
-			m.Role = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -19860,61 +22952,24 @@ func (m *MsgSetTrainingAllowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Role |= TrainingRole(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetTrainingAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -19936,7 +22991,7 @@ func (m *MsgSetTrainingAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19959,15 +23014,15 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19995,11 +23050,11 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20027,58 +23082,72 @@ func (m *MsgAddParticipantsToAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddParticipantsToAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20100,7 +23169,7 @@ func (m *MsgAddParticipantsToAllowListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20123,15 +23192,15 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20159,11 +23228,30 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.RequestId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+			}
+			m.EpochIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20181,68 +23269,18 @@ func (m *MsgRemoveParticipantsFromAllowList) Unmarshal(dAtA []byte) error {
 				}
 			}
 			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveParticipantsFromAllowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20264,7 +23302,7 @@ func (m *MsgRemoveParticipantsFromAllowListResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20287,10 +23325,10 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20326,42 +23364,10 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
 			}
-			var stringLen uint64
+			m.CodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20371,24 +23377,11 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20410,7 +23403,7 @@ func (m *MsgRegisterBridgeAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20433,10 +23426,10 @@ func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterBridgeAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -20460,7 +23453,7 @@ func (m *MsgRegisterBridgeAddressesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20483,10 +23476,10 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -20522,10 +23515,10 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
 			}
-			var stringLen uint64
+			m.NewCodeId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20535,27 +23528,14 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewCodeId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsgJson", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20583,96 +23563,13 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.MigrateMsgJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var v int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -20682,12 +23579,11 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20709,7 +23605,7 @@ func (m *MsgRegisterTokenMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20728,16 +23624,35 @@ func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTokenMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempted", wireType)
+			}
+			m.Attempted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempted |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20759,7 +23674,7 @@ func (m *MsgRegisterTokenMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateExit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20782,79 +23697,15 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateExit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTrading: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateExit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20882,7 +23733,7 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -20905,7 +23756,7 @@ func (m *MsgApproveBridgeTokenForTrading) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInitiateExitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20928,12 +23779,31 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInitiateExitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgApproveBridgeTokenForTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInitiateExitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpoch", wireType)
+			}
+			m.LastEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -20955,7 +23825,7 @@ func (m *MsgApproveBridgeTokenForTradingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToParticipant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -20978,15 +23848,15 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateToParticipant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateToParticipant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21014,11 +23884,11 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21046,45 +23916,13 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeId = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Label = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateMsg", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21094,24 +23932,11 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InstantiateMsg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -21133,7 +23958,7 @@ func (m *MsgRegisterLiquidityPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelegateToParticipantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21156,10 +23981,10 @@ func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelegateToParticipantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelegateToParticipantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21183,7 +24008,7 @@ func (m *MsgRegisterLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21206,15 +24031,15 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21242,11 +24067,11 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Participant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21274,13 +24099,13 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			m.Participant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21290,29 +24115,66 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			var stringLen uint64
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseEpoch", wireType)
+			}
+			m.ReleaseEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21322,24 +24184,11 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ReleaseEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -21361,7 +24210,7 @@ func (m *MsgRequestBridgeWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValidationBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21384,15 +24233,15 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidationBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidationBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21420,32 +24269,13 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
-			}
-			m.EpochIndex = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21455,23 +24285,25 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			m.Validations = append(m.Validations, ValidationBatchItem{})
+			if err := m.Validations[len(m.Validations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21494,7 +24326,7 @@ func (m *MsgRequestBridgeWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
+func (m *ValidationBatchItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21517,15 +24349,15 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationBatchItem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationBatchItem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21553,11 +24385,11 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21585,11 +24417,11 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21617,13 +24449,33 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationAddress = string(dAtA[iNdEx:postIndex])
+			m.ResponseHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revalidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revalidation = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDecimal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21633,23 +24485,27 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.ValueDecimal == nil {
+				m.ValueDecimal = &Decimal{}
+			}
+			if err := m.ValueDecimal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21672,7 +24528,7 @@ func (m *MsgRequestBridgeMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidationBatchResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21695,15 +24551,15 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidationBatchResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBridgeMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidationBatchResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21731,13 +24587,13 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestId = string(dAtA[iNdEx:postIndex])
+			m.InferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
 			}
-			m.EpochIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21747,14 +24603,15 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochIndex |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Accepted = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlsRequestId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21782,7 +24639,7 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlsRequestId = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21805,7 +24662,7 @@ func (m *MsgRequestBridgeMintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
+func (m *MsgValidationBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21828,17 +24685,17 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValidationBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValidationBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -21848,93 +24705,26 @@ func (m *MsgRegisterWrappedTokenContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
-			}
-			m.CodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			m.Results = append(m.Results, ValidationBatchResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrappedTokenContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -21956,7 +24746,7 @@ func (m *MsgRegisterWrappedTokenContractResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -21979,10 +24769,10 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -22018,10 +24808,10 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewCodeId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			m.NewCodeId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -22031,14 +24821,27 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewCodeId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateMsgJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22066,27 +24869,8 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MigrateMsgJson = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -22108,7 +24892,7 @@ func (m *MsgMigrateAllWrappedTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22131,31 +24915,12 @@ func (m *MsgMigrateAllWrappedTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMigrateAllWrappedTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attempted", wireType)
-			}
-			m.Attempted = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Attempted |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
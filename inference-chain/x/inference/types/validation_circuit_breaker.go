@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ValidationCircuitBreakerPolicy configures the circuit breaker that pauses invalidation effects when
+// the share of failed validations in an epoch is so high that a systemic bug is more likely than cheating
+type ValidationCircuitBreakerPolicy struct {
+	// MinValidations is how many validations an epoch needs before its failure rate is considered
+	MinValidations uint64 `json:"min_validations"`
+	// MaxFailureRate is the highest failure rate, as a decimal string, that does not trip the breaker; "1" disables it
+	MaxFailureRate string `json:"max_failure_rate"`
+}
+
+func DefaultValidationCircuitBreakerPolicy() ValidationCircuitBreakerPolicy {
+	return ValidationCircuitBreakerPolicy{
+		MinValidations: 100,
+		MaxFailureRate: "0.5",
+	}
+}
+
+func (p ValidationCircuitBreakerPolicy) Validate() error {
+	if p.MinValidations == 0 {
+		return fmt.Errorf("min_validations must be positive")
+	}
+	rate, err := decimal.NewFromString(p.MaxFailureRate)
+	if err != nil {
+		return fmt.Errorf("invalid max_failure_rate %q: %w", p.MaxFailureRate, err)
+	}
+	if !rate.IsPositive() || rate.GreaterThan(decimal.NewFromInt(1)) {
+		return fmt.Errorf("max_failure_rate must be in (0, 1], got %s", rate)
+	}
+	return nil
+}
+
+// ShouldTrip reports whether the validations of an epoch have failed often enough to trip the breaker
+func (p ValidationCircuitBreakerPolicy) ShouldTrip(stats ValidationFailureStats) bool {
+	if stats.Total() < p.MinValidations {
+		return false
+	}
+	maxRate, err := decimal.NewFromString(p.MaxFailureRate)
+	if err != nil {
+		return false
+	}
+	return stats.FailureRate().GreaterThan(maxRate)
+}
+
+// ValidationFailureStats counts the first-round validations of one epoch; revalidation votes are not counted
+type ValidationFailureStats struct {
+	Passed uint64 `json:"passed"`
+	Failed uint64 `json:"failed"`
+	// TrippedAtHeight is the block the circuit breaker tripped at, 0 while it has not
+	TrippedAtHeight int64 `json:"tripped_at_height,omitempty"`
+}
+
+func (s ValidationFailureStats) Total() uint64 {
+	return s.Passed + s.Failed
+}
+
+func (s ValidationFailureStats) FailureRate() decimal.Decimal {
+	if s.Total() == 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromInt(int64(s.Failed)).Div(decimal.NewFromInt(int64(s.Total())))
+}
+
+func (s ValidationFailureStats) Tripped() bool {
+	return s.TrippedAtHeight > 0
+}
@@ -37,6 +37,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Renew certificates before they expire
+	renewalCtx, stopRenewal := context.WithCancel(context.Background())
+	defer stopRenewal()
+	go certIssuer.StartRenewal(renewalCtx)
+
 	// Create API server
 	apiServer := api.NewServer(cfg, certIssuer, logger)
 
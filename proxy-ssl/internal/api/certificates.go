@@ -0,0 +1,24 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// listCertificates returns the issuance state and expiry of every current certificate
+func (s *Server) listCertificates(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"certificates": s.issuer.Certificates()})
+}
+
+// getCertificates returns the current certificates covering a domain
+func (s *Server) getCertificates(c *gin.Context) {
+	domain := c.Param("domain")
+	certificates := s.issuer.CertificatesForDomain(domain)
+	if len(certificates) == 0 {
+		c.JSON(http.StatusNotFound, gin.H{"error": "No certificate found for " + domain})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"domain": domain, "certificates": certificates})
+}
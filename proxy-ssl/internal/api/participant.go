@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gonka/proxy-ssl/internal/participant"
+)
+
+// createParticipantCertificate issues or returns the certificate for the domain of a participant's
+// registered InferenceUrl. The request is authenticated by the participant key instead of a JWT,
+// so a dAPI only needs the proxy-ssl URL to set up TLS.
+func (s *Server) createParticipantCertificate(c *gin.Context) {
+	var req participant.CertificateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		s.logger.Error("Invalid request body", "error", err)
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	if !s.isAllowedFQDN(req.FQDN) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "FQDN " + req.FQDN + " is not allowed"})
+		return
+	}
+
+	if err := s.verifier.Verify(c.Request.Context(), &req); err != nil {
+		s.logger.Warn("Participant certificate request rejected", "address", req.Address, "fqdn", req.FQDN, "error", err)
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	fqdns := []string{req.FQDN}
+
+	// Serve the existing certificate until it enters the renewal window
+	if order := s.issuer.FindLatestCompletedOrder(req.Address, fqdns); order != nil && time.Until(order.ExpiresAt) > s.config.RenewalThreshold {
+		response, err := s.existingCertificate(c, order.ID, req.Address)
+		if err == nil {
+			c.JSON(http.StatusOK, response)
+			return
+		}
+		s.logger.Warn("Failed to load existing participant certificate, issuing a new one", "order_id", order.ID, "error", err)
+	}
+
+	response, err := s.issueWithNewKey(c.Request.Context(), req.Address, fqdns)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// existingCertificate loads the bundle and persisted private key of a completed order
+func (s *Server) existingCertificate(c *gin.Context, orderID, nodeID string) (*CertificateResponse, error) {
+	order, err := s.issuer.GetOrder(c.Request.Context(), nodeID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := s.issuer.GetCertificateBundle(c.Request.Context(), nodeID, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := os.ReadFile(filepath.Join(s.config.CertStoragePath, orderID+".key"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateResponse{
+		OrderID:     order.ID,
+		NodeID:      nodeID,
+		PrivateKey:  string(privateKey),
+		Certificate: string(bundle),
+		Status:      order.Status,
+		ExpiresAt:   order.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
@@ -21,14 +21,18 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/gonka/proxy-ssl/internal/config"
 	"github.com/gonka/proxy-ssl/internal/issuer"
+	"github.com/gonka/proxy-ssl/internal/participant"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 // Server represents the HTTP API server
 type Server struct {
-	config *config.Config
-	issuer *issuer.Issuer
-	logger *slog.Logger
-	router *gin.Engine
+	config   *config.Config
+	issuer   *issuer.Issuer
+	verifier *participant.Verifier
+	logger   *slog.Logger
+	router   *gin.Engine
 }
 
 // NewServer creates a new API server
@@ -48,6 +52,15 @@ func NewServer(cfg *config.Config, certIssuer *issuer.Issuer, logger *slog.Logge
 	// Health check
 	router.GET("/health", server.healthHandler)
 
+	// Certificate status and Prometheus metrics for operators
+	router.GET("/certificates", server.listCertificates)
+	router.GET("/certificates/:domain", server.getCertificates)
+	registry := prometheus.NewRegistry()
+	if err := certIssuer.RegisterMetrics(registry); err != nil {
+		logger.Error("Failed to register certificate metrics", "error", err)
+	}
+	router.GET("/metrics", gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})))
+
 	// API routes
 	v1 := router.Group("/v1")
 	{
@@ -66,6 +79,12 @@ func NewServer(cfg *config.Config, certIssuer *issuer.Issuer, logger *slog.Logge
 			certs.GET("/orders/:id/bundle", server.getCertificateBundle)
 			certs.POST("/orders/:id/renew", server.renewCertificate)
 		}
+
+		// Participant endpoints, authenticated by the participant key registered on chain
+		if cfg.ChainAPIURL != "" {
+			server.verifier = participant.NewVerifier(cfg.ChainAPIURL, logger)
+			v1.POST("/participant/certs", server.createParticipantCertificate)
+		}
 	}
 
 	server.router = router
@@ -93,8 +112,7 @@ func (s *Server) healthHandler(c *gin.Context) {
 		}
 
 		if !latestOrder.ExpiresAt.IsZero() {
-			// 30 days before expiry
-			latest["next_renewal"] = latestOrder.ExpiresAt.AddDate(0, 0, -30)
+			latest["next_renewal"] = latestOrder.ExpiresAt.Add(-s.config.RenewalThreshold)
 		}
 
 		status["latest_order"] = latest
@@ -122,28 +140,37 @@ func (s *Server) createAutoCertificate(c *gin.Context) {
 		return
 	}
 
+	response, err := s.issueWithNewKey(c.Request.Context(), nodeID, req.FQDNs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// issueWithNewKey generates a fresh key pair, orders a certificate for it and waits for issuance.
+// Returned errors are safe to expose to the caller; details are logged.
+func (s *Server) issueWithNewKey(ctx context.Context, nodeID string, fqdns []string) (*CertificateResponse, error) {
 	// Generate private key
 	privateKey, err := s.generatePrivateKey()
 	if err != nil {
 		s.logger.Error("Failed to generate private key", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate private key"})
-		return
+		return nil, fmt.Errorf("Failed to generate private key")
 	}
 
 	// Generate CSR
-	csrBytes, err := s.generateCSR(privateKey, req.FQDNs)
+	csrBytes, err := s.generateCSR(privateKey, fqdns)
 	if err != nil {
 		s.logger.Error("Failed to generate CSR", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate CSR"})
-		return
+		return nil, fmt.Errorf("Failed to generate CSR")
 	}
 
 	// Submit to proxy-ssl
-	order, err := s.issuer.CreateOrder(c.Request.Context(), nodeID, base64.StdEncoding.EncodeToString(csrBytes), req.FQDNs)
+	order, err := s.issuer.CreateOrder(ctx, nodeID, base64.StdEncoding.EncodeToString(csrBytes), fqdns)
 	if err != nil {
 		s.logger.Error("Failed to create order", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to create order"})
-		return
+		return nil, fmt.Errorf("Failed to create order")
 	}
 
 	// Persist the private key alongside the certificate bundle for renewals
@@ -159,21 +186,18 @@ func (s *Server) createAutoCertificate(c *gin.Context) {
 	certBundle, err := s.waitForCertificate(nodeID, order.ID)
 	if err != nil {
 		s.logger.Error("Failed to get certificate", "error", err)
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to get certificate"})
-		return
+		return nil, fmt.Errorf("Failed to get certificate")
 	}
 
 	// Return complete certificate response
-	response := CertificateResponse{
+	return &CertificateResponse{
 		OrderID:     order.ID,
 		NodeID:      nodeID,
 		PrivateKey:  string(privateKey),
 		Certificate: string(certBundle),
 		Status:      "completed",
 		ExpiresAt:   order.ExpiresAt.Format(time.RFC3339),
-	}
-
-	c.JSON(http.StatusCreated, response)
+	}, nil
 }
 
 // createOrder handles certificate order creation (legacy)
@@ -372,8 +396,20 @@ func (s *Server) createJWTToken(nodeID string, expiresInDays int) (string, error
 	return tokenString, nil
 }
 
-// isAllowedFQDN checks if the FQDN is allowed based on configuration
+// isAllowedFQDN checks if the FQDN is allowed based on configuration.
+// A wildcard is allowed if the domain it covers is; a wildcard over the whole
+// configured domain only when subdomains aren't restricted.
 func (s *Server) isAllowedFQDN(fqdn string) bool {
+	if issuer.IsWildcard(fqdn) {
+		if issuer.ValidateFQDN(fqdn) != nil {
+			return false
+		}
+		fqdn = issuer.WildcardBase(fqdn)
+		if fqdn == s.config.Domain {
+			return len(s.config.AllowedSubdomains) == 0
+		}
+	}
+
 	// Check if FQDN ends with configured domain
 	if !strings.HasSuffix(fqdn, "."+s.config.Domain) && fqdn != s.config.Domain {
 		return false
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"log/slog"
 
@@ -24,6 +25,9 @@ type Config struct {
 	// DNS provider configuration
 	DNSProvider       string
 	DNSProviderConfig map[string]string
+	// DNSResolvers are the nameservers used to check DNS-01 record propagation.
+	// The system resolvers are used when empty.
+	DNSResolvers []string
 
 	// Domain configuration
 	Domain            string
@@ -32,9 +36,20 @@ type Config struct {
 	// Security configuration
 	JWTSecret string
 
+	// Chain REST API used to authenticate participant (dAPI) certificate requests.
+	// Participant endpoints are disabled when empty.
+	ChainAPIURL string
+
 	// Storage configuration
 	CertStoragePath string
 	DataPath        string
+
+	// Renewal configuration: certificates are renewed when they expire within
+	// RenewalThreshold, checked every RenewalCheckInterval
+	RenewalThreshold     time.Duration
+	RenewalCheckInterval time.Duration
+	// ReloadHooks run after a certificate is renewed, see issuer.ParseReloadHook
+	ReloadHooks []string
 }
 
 // Load loads configuration from environment variables and files
@@ -59,19 +74,26 @@ func Load() (*Config, error) {
 	viper.SetDefault("acme_directory_url", acmeDefault)
 	viper.SetDefault("cert_storage_path", "/app/certs")
 	viper.SetDefault("data_path", "/app/data")
+	viper.SetDefault("cert_renewal_threshold_days", 30)
+	viper.SetDefault("cert_renewal_check_interval", "12h")
 
 	// Load configuration
 	cfg := &Config{
-		Port:              viper.GetInt("port"),
-		LogLevel:          getLogLevel(viper.GetString("log_level")),
-		ACMEDirectoryURL:  viper.GetString("acme_directory_url"),
-		ACMEAccountEmail:  viper.GetString("acme_account_email"),
-		DNSProvider:       viper.GetString("acme_dns_provider"),
-		Domain:            viper.GetString("cert_issuer_domain"),
-		AllowedSubdomains: filterEmpty(strings.Split(strings.TrimSpace(viper.GetString("cert_issuer_allowed_subdomains")), ",")),
-		JWTSecret:         viper.GetString("cert_issuer_jwt_secret"),
-		CertStoragePath:   viper.GetString("cert_storage_path"),
-		DataPath:          viper.GetString("data_path"),
+		Port:                 viper.GetInt("port"),
+		LogLevel:             getLogLevel(viper.GetString("log_level")),
+		ACMEDirectoryURL:     viper.GetString("acme_directory_url"),
+		ACMEAccountEmail:     viper.GetString("acme_account_email"),
+		DNSProvider:          viper.GetString("acme_dns_provider"),
+		DNSResolvers:         filterEmpty(strings.Split(strings.TrimSpace(viper.GetString("acme_dns_resolvers")), ",")),
+		Domain:               viper.GetString("cert_issuer_domain"),
+		AllowedSubdomains:    filterEmpty(strings.Split(strings.TrimSpace(viper.GetString("cert_issuer_allowed_subdomains")), ",")),
+		JWTSecret:            viper.GetString("cert_issuer_jwt_secret"),
+		ChainAPIURL:          viper.GetString("cert_issuer_chain_api_url"),
+		CertStoragePath:      viper.GetString("cert_storage_path"),
+		DataPath:             viper.GetString("data_path"),
+		RenewalThreshold:     time.Duration(viper.GetInt("cert_renewal_threshold_days")) * 24 * time.Hour,
+		RenewalCheckInterval: viper.GetDuration("cert_renewal_check_interval"),
+		ReloadHooks:          filterEmpty(strings.Split(strings.TrimSpace(viper.GetString("cert_reload_hooks")), ",")),
 	}
 
 	// Load DNS provider configuration from environment
@@ -121,6 +143,14 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("JWT secret is required")
 	}
 
+	if c.RenewalThreshold <= 0 {
+		return fmt.Errorf("certificate renewal threshold must be positive")
+	}
+
+	if c.RenewalCheckInterval <= 0 {
+		return fmt.Errorf("certificate renewal check interval must be positive")
+	}
+
 	// Validate DNS provider configuration
 	if err := c.validateDNSProviderConfig(); err != nil {
 		return fmt.Errorf("DNS provider configuration: %w", err)
@@ -172,8 +202,17 @@ func (c *Config) validateDNSProviderConfig() error {
 		if c.DNSProviderConfig["HETZNER_API_KEY"] == "" {
 			return fmt.Errorf("HETZNER_API_KEY is required for Hetzner")
 		}
+	case "rfc2136":
+		if c.DNSProviderConfig["RFC2136_NAMESERVER"] == "" {
+			return fmt.Errorf("RFC2136_NAMESERVER is required for RFC2136")
+		}
+		// TSIG is optional, but the key name and secret only work together
+		if (c.DNSProviderConfig["RFC2136_TSIG_KEY"] == "") != (c.DNSProviderConfig["RFC2136_TSIG_SECRET"] == "") {
+			return fmt.Errorf("RFC2136_TSIG_KEY and RFC2136_TSIG_SECRET must be set together")
+		}
 	default:
-		return fmt.Errorf("unsupported DNS provider: %s", c.DNSProvider)
+		// Providers registered with issuer.RegisterDNSProvider carry their own
+		// configuration; the issuer rejects unknown names at startup.
 	}
 
 	return nil
@@ -207,6 +246,12 @@ func loadDNSProviderConfig() map[string]string {
 	// Hetzner
 	config["HETZNER_API_KEY"] = os.Getenv("HETZNER_API_KEY")
 
+	// RFC2136
+	config["RFC2136_NAMESERVER"] = os.Getenv("RFC2136_NAMESERVER")
+	config["RFC2136_TSIG_KEY"] = os.Getenv("RFC2136_TSIG_KEY")
+	config["RFC2136_TSIG_SECRET"] = os.Getenv("RFC2136_TSIG_SECRET")
+	config["RFC2136_TSIG_ALGORITHM"] = os.Getenv("RFC2136_TSIG_ALGORITHM")
+
 	return config
 }
 
@@ -15,9 +15,8 @@ import (
 
 	"github.com/go-acme/lego/v4/certcrypto"
 	"github.com/go-acme/lego/v4/certificate"
-	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/lego"
-	"github.com/go-acme/lego/v4/providers/dns"
 	"github.com/go-acme/lego/v4/registration"
 	"github.com/gonka/proxy-ssl/internal/config"
 )
@@ -211,36 +210,18 @@ func (r *RealACMEProvider) createLegoClient() (*lego.Client, error) {
 	}
 
 	// Setup DNS provider
-	provider, err := r.setupDNSProvider()
+	provider, err := newDNSProvider(r.config.DNSProvider)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup DNS provider: %w", err)
 	}
 
-	// Add DNS challenge
-	err = client.Challenge.SetDNS01Provider(provider)
+	// Add DNS challenge. Propagation is checked against the configured resolvers,
+	// e.g. when the zone is served by a private RFC2136 nameserver.
+	err = client.Challenge.SetDNS01Provider(provider,
+		dns01.CondOption(len(r.config.DNSResolvers) > 0, dns01.AddRecursiveNameservers(dns01.ParseNameservers(r.config.DNSResolvers))))
 	if err != nil {
 		return nil, fmt.Errorf("failed to set DNS challenge provider: %w", err)
 	}
 
 	return client, nil
 }
-
-// setupDNSProvider sets up the DNS provider based on configuration
-func (r *RealACMEProvider) setupDNSProvider() (challenge.Provider, error) {
-	switch r.config.DNSProvider {
-	case "route53":
-		return dns.NewDNSChallengeProviderByName("route53")
-	case "cloudflare":
-		return dns.NewDNSChallengeProviderByName("cloudflare")
-	case "gcloud":
-		return dns.NewDNSChallengeProviderByName("gcloud")
-	case "azure":
-		return dns.NewDNSChallengeProviderByName("azure")
-	case "digitalocean":
-		return dns.NewDNSChallengeProviderByName("digitalocean")
-	case "hetzner":
-		return dns.NewDNSChallengeProviderByName("hetzner")
-	default:
-		return nil, fmt.Errorf("unsupported DNS provider: %s", r.config.DNSProvider)
-	}
-}
@@ -0,0 +1,66 @@
+package issuer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/providers/dns"
+)
+
+// DNSProviderFactory creates the DNS-01 challenge provider that publishes the
+// _acme-challenge TXT records. The built-in lego providers read their
+// credentials from the environment.
+type DNSProviderFactory func() (challenge.Provider, error)
+
+var (
+	dnsProvidersMu sync.RWMutex
+	dnsProviders   = map[string]DNSProviderFactory{
+		"route53":      legoDNSProvider("route53"),
+		"cloudflare":   legoDNSProvider("cloudflare"),
+		"gcloud":       legoDNSProvider("gcloud"),
+		"azure":        legoDNSProvider("azure"),
+		"digitalocean": legoDNSProvider("digitalocean"),
+		"hetzner":      legoDNSProvider("hetzner"),
+		// RFC2136 dynamic updates, for self-hosted nameservers (BIND, Knot, PowerDNS)
+		"rfc2136": legoDNSProvider("rfc2136"),
+	}
+)
+
+func legoDNSProvider(name string) DNSProviderFactory {
+	return func() (challenge.Provider, error) {
+		return dns.NewDNSChallengeProviderByName(name)
+	}
+}
+
+// RegisterDNSProvider makes a DNS provider available under the given
+// acme_dns_provider name, replacing any provider registered under that name.
+func RegisterDNSProvider(name string, factory DNSProviderFactory) {
+	dnsProvidersMu.Lock()
+	defer dnsProvidersMu.Unlock()
+	dnsProviders[name] = factory
+}
+
+// DNSProviders returns the names of the registered DNS providers, sorted
+func DNSProviders() []string {
+	dnsProvidersMu.RLock()
+	defer dnsProvidersMu.RUnlock()
+	names := make([]string, 0, len(dnsProviders))
+	for name := range dnsProviders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// newDNSProvider creates the DNS provider registered under the given name
+func newDNSProvider(name string) (challenge.Provider, error) {
+	dnsProvidersMu.RLock()
+	factory, ok := dnsProviders[name]
+	dnsProvidersMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unsupported DNS provider: %s", name)
+	}
+	return factory()
+}
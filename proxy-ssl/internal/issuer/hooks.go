@@ -0,0 +1,122 @@
+package issuer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const reloadHookTimeout = 10 * time.Second
+
+// ReloadHook tells a consumer of a certificate, e.g. nginx, that the certificate was renewed
+type ReloadHook interface {
+	Run(ctx context.Context, order *Order) error
+	String() string
+}
+
+// ParseReloadHook parses an operator-defined hook:
+//   - signal:<SIGNAL>:<pidfile> sends the signal to the process in the pid file, e.g. signal:HUP:/run/nginx.pid
+//   - http://... or https://... POSTs the renewed order as JSON to the URL
+func ParseReloadHook(spec string) (ReloadHook, error) {
+	switch {
+	case strings.HasPrefix(spec, "signal:"):
+		parts := strings.SplitN(strings.TrimPrefix(spec, "signal:"), ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, fmt.Errorf("invalid signal hook %q: expected signal:<SIGNAL>:<pidfile>", spec)
+		}
+		sig, ok := reloadSignals[strings.TrimPrefix(strings.ToUpper(parts[0]), "SIG")]
+		if !ok {
+			return nil, fmt.Errorf("invalid signal hook %q: unsupported signal %s", spec, parts[0])
+		}
+		return &signalHook{signal: sig, pidFile: parts[1]}, nil
+	case strings.HasPrefix(spec, "http://"), strings.HasPrefix(spec, "https://"):
+		return &httpHook{url: spec, client: &http.Client{Timeout: reloadHookTimeout}}, nil
+	default:
+		return nil, fmt.Errorf("invalid reload hook %q: expected signal:<SIGNAL>:<pidfile> or an http(s) URL", spec)
+	}
+}
+
+var reloadSignals = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"USR1": syscall.SIGUSR1,
+	"USR2": syscall.SIGUSR2,
+}
+
+// signalHook signals the process whose pid is in a pid file
+type signalHook struct {
+	signal  syscall.Signal
+	pidFile string
+}
+
+func (h *signalHook) Run(ctx context.Context, order *Order) error {
+	data, err := os.ReadFile(h.pidFile)
+	if err != nil {
+		return fmt.Errorf("read pid file: %w", err)
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("parse pid file %s: %w", h.pidFile, err)
+	}
+	if err := syscall.Kill(pid, h.signal); err != nil {
+		return fmt.Errorf("send %s to pid %d: %w", h.signal, pid, err)
+	}
+	return nil
+}
+
+func (h *signalHook) String() string {
+	return fmt.Sprintf("signal %s to %s", h.signal, h.pidFile)
+}
+
+// httpHook POSTs the renewed order to a URL
+type httpHook struct {
+	url    string
+	client *http.Client
+}
+
+func (h *httpHook) Run(ctx context.Context, order *Order) error {
+	body, err := json.Marshal(order)
+	if err != nil {
+		return fmt.Errorf("marshal order: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (h *httpHook) String() string {
+	return "POST " + h.url
+}
+
+// runReloadHooks runs every hook for a renewed order; a failing hook doesn't stop the others
+func (i *Issuer) runReloadHooks(order Order) {
+	for _, hook := range i.reloadHooks {
+		ctx, cancel := context.WithTimeout(context.Background(), reloadHookTimeout)
+		err := hook.Run(ctx, &order)
+		cancel()
+		if err != nil {
+			i.logger.Error("Reload hook failed", "order_id", order.ID, "hook", hook.String(), "error", err)
+			continue
+		}
+		i.logger.Info("Reload hook completed", "order_id", order.ID, "hook", hook.String())
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"log/slog"
 
 	"github.com/gonka/proxy-ssl/internal/config"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // CertificateProvider defines the interface for certificate issuance
@@ -37,30 +39,53 @@ type Order struct {
 	ExpiresAt   time.Time `json:"expires_at"`
 	LastUpdated time.Time `json:"last_updated"`
 	LastError   string    `json:"last_error,omitempty"`
+	RenewedAt   time.Time `json:"renewed_at,omitempty"`
 }
 
-// Issuer handles certificate issuance using ACME DNS-01
+// Issuer handles certificate issuance using ACME DNS-01, including wildcard FQDNs
 type Issuer struct {
 	config   *config.Config
 	logger   *slog.Logger
 	provider CertificateProvider
 	orders   map[string]*Order
 	mu       sync.RWMutex
+
+	reloadHooks     []ReloadHook
+	renewalFailures *prometheus.CounterVec
 }
 
 // New creates a new certificate issuer
 func New(cfg *config.Config, logger *slog.Logger) (*Issuer, error) {
+	if !slices.Contains(DNSProviders(), cfg.DNSProvider) {
+		return nil, fmt.Errorf("unsupported DNS provider: %s (available: %s)", cfg.DNSProvider, strings.Join(DNSProviders(), ", "))
+	}
+
 	var provider CertificateProvider
 
 	// Always use real ACME provider
 	provider = &RealACMEProvider{logger: logger, config: cfg}
 	logger.Info("Using real ACME certificate provider")
 
+	reloadHooks := make([]ReloadHook, 0, len(cfg.ReloadHooks))
+	for _, spec := range cfg.ReloadHooks {
+		hook, err := ParseReloadHook(spec)
+		if err != nil {
+			return nil, err
+		}
+		reloadHooks = append(reloadHooks, hook)
+	}
+
 	issuer := &Issuer{
-		config:   cfg,
-		logger:   logger,
-		provider: provider,
-		orders:   make(map[string]*Order),
+		config:      cfg,
+		logger:      logger,
+		provider:    provider,
+		orders:      make(map[string]*Order),
+		reloadHooks: reloadHooks,
+		renewalFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "certificate_renewal_failures_total",
+			Help:      "Failed certificate renewals per domain.",
+		}, []string{"domain"}),
 	}
 
 	// Load existing orders from disk
@@ -80,6 +105,12 @@ func (i *Issuer) CreateOrder(ctx context.Context, nodeID, csrBase64 string, fqdn
 		return nil, fmt.Errorf("invalid CSR encoding: %w", err)
 	}
 
+	for _, fqdn := range fqdns {
+		if err := ValidateFQDN(fqdn); err != nil {
+			return nil, err
+		}
+	}
+
 	// Parse CSR
 	csr, err := x509.ParseCertificateRequest(csrBytes)
 	if err != nil {
@@ -133,6 +164,25 @@ func (i *Issuer) GetLatestOrder() *Order {
 	return latest
 }
 
+// FindLatestCompletedOrder returns the most recent completed order of the node
+// covering exactly the given FQDNs, or nil if there is none
+func (i *Issuer) FindLatestCompletedOrder(nodeID string, fqdns []string) *Order {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var latest *Order
+	for _, order := range i.orders {
+		if order.NodeID != nodeID || order.Status != "completed" || !sameFQDNs(order.FQDNs, fqdns) {
+			continue
+		}
+		if latest == nil || order.CreatedAt.After(latest.CreatedAt) {
+			latest = order
+		}
+	}
+
+	return latest
+}
+
 // GetOrder retrieves an order by ID
 func (i *Issuer) GetOrder(ctx context.Context, nodeID, orderID string) (*Order, error) {
 	i.mu.RLock()
@@ -184,13 +234,17 @@ func (i *Issuer) RenewCertificate(ctx context.Context, nodeID, orderID string) e
 		return fmt.Errorf("order not completed")
 	}
 
-	// Check if renewal is needed (cert expires in 30 days)
-	if time.Until(order.ExpiresAt) > 30*24*time.Hour {
+	// Check if renewal is needed (cert expires within the renewal threshold)
+	if time.Until(order.ExpiresAt) > i.config.RenewalThreshold {
 		return fmt.Errorf("certificate does not need renewal yet")
 	}
 
 	// Start renewal in background
-	go i.renewCertificate(orderID)
+	go func() {
+		if err := i.renewCertificate(orderID); err != nil {
+			i.logger.Error("Failed to renew certificate", "order_id", orderID, "error", err)
+		}
+	}()
 
 	return nil
 }
@@ -284,20 +338,20 @@ func (i *Issuer) issueCertificate(orderID string, csrBytes []byte, fqdns []strin
 	i.logger.Info("Certificate issued successfully", "order_id", orderID)
 }
 
-// renewCertificate renews an existing certificate
-func (i *Issuer) renewCertificate(orderID string) {
-	// Get order
-	i.mu.RLock()
+// renewCertificate renews an existing certificate with its stored key and runs the reload hooks.
+// A failed renewal keeps the current certificate and records the error on the order.
+func (i *Issuer) renewCertificate(orderID string) error {
+	// Claim the order so a manual and a scheduled renewal don't run together
+	i.mu.Lock()
 	order, exists := i.orders[orderID]
-	i.mu.RUnlock()
-
 	if !exists {
-		i.logger.Error("Order not found for renewal", "order_id", orderID)
-		return
+		i.mu.Unlock()
+		return fmt.Errorf("order not found")
+	}
+	if order.Status != "completed" {
+		i.mu.Unlock()
+		return fmt.Errorf("order is %s", order.Status)
 	}
-
-	// Update order status
-	i.mu.Lock()
 	order.Status = "renewing"
 	order.LastUpdated = time.Now()
 	order.LastError = ""
@@ -305,33 +359,18 @@ func (i *Issuer) renewCertificate(orderID string) {
 
 	_ = i.saveOrder(order)
 
-	// Load stored private key
-	keyPath := filepath.Join(i.config.CertStoragePath, orderID+".key")
-	keyPEM, err := os.ReadFile(keyPath)
-	if err != nil {
-		i.logger.Error("Failed to read stored private key for renewal", "order_id", orderID, "error", err)
-		return
-	}
+	i.logger.Info("Renewing certificate", "order_id", orderID, "expires_at", order.ExpiresAt)
 
-	// Generate CSR from stored key
-	csrBytes, err := generateCSRFromKeyPEM(keyPEM, order.FQDNs)
+	certBundle, err := i.obtainRenewal(order)
 	if err != nil {
-		i.logger.Error("Failed to generate CSR for renewal", "order_id", orderID, "error", err)
-		return
-	}
-
-	// Issue new certificate using the same key
-	certBundle, err := i.provider.IssueCertificate(csrBytes, order.FQDNs)
-	if err != nil {
-		i.logger.Error("Failed to renew certificate", "order_id", orderID, "error", err)
-		return
-	}
-
-	// Save renewed certificate
-	bundlePath := filepath.Join(i.config.CertStoragePath, orderID+".pem")
-	if err := os.WriteFile(bundlePath, certBundle, 0644); err != nil {
-		i.logger.Error("Failed to save renewed certificate", "order_id", orderID, "error", err)
-		return
+		i.mu.Lock()
+		order.Status = "completed"
+		order.LastUpdated = time.Now()
+		order.LastError = "renewal failed: " + err.Error()
+		i.mu.Unlock()
+		_ = i.saveOrder(order)
+		i.renewalFailures.WithLabelValues(order.FQDNs[0]).Inc()
+		return err
 	}
 
 	// Update expiry from renewed bundle
@@ -358,12 +397,47 @@ func (i *Issuer) renewCertificate(orderID string) {
 	}
 	order.Status = "completed"
 	order.LastUpdated = time.Now()
+	order.RenewedAt = order.LastUpdated
 	order.LastError = ""
+	renewed := *order
 	i.mu.Unlock()
 
 	_ = i.saveOrder(order)
 
-	i.logger.Info("Certificate renewal completed", "order_id", orderID)
+	i.logger.Info("Certificate renewal completed", "order_id", orderID, "expires_at", renewed.ExpiresAt)
+
+	i.runReloadHooks(renewed)
+	return nil
+}
+
+// obtainRenewal issues a new certificate for the order with its stored private key and saves the bundle
+func (i *Issuer) obtainRenewal(order *Order) ([]byte, error) {
+	// Load stored private key
+	keyPath := filepath.Join(i.config.CertStoragePath, order.ID+".key")
+	keyPEM, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stored private key: %w", err)
+	}
+
+	// Generate CSR from stored key
+	csrBytes, err := generateCSRFromKeyPEM(keyPEM, order.FQDNs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate CSR: %w", err)
+	}
+
+	// Issue new certificate using the same key
+	certBundle, err := i.provider.IssueCertificate(csrBytes, order.FQDNs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to issue certificate: %w", err)
+	}
+
+	// Save renewed certificate
+	bundlePath := filepath.Join(i.config.CertStoragePath, order.ID+".pem")
+	if err := os.WriteFile(bundlePath, certBundle, 0644); err != nil {
+		return nil, fmt.Errorf("failed to save renewed certificate: %w", err)
+	}
+
+	return certBundle, nil
 }
 
 // saveOrder persists the order to disk
@@ -418,6 +492,11 @@ func (i *Issuer) loadOrders() error {
 			continue
 		}
 
+		// A renewal interrupted by a restart left the previous certificate in place
+		if order.Status == "renewing" {
+			order.Status = "completed"
+		}
+
 		i.orders[order.ID] = &order
 		i.logger.Debug("Loaded order", "id", order.ID, "status", order.Status)
 	}
@@ -444,6 +523,25 @@ func (i *Issuer) validateCSR(csr *x509.CertificateRequest, fqdns []string) error
 	return nil
 }
 
+// sameFQDNs reports whether both lists contain the same FQDNs, ignoring order and case
+func sameFQDNs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]int, len(a))
+	for _, fqdn := range a {
+		seen[strings.ToLower(fqdn)]++
+	}
+	for _, fqdn := range b {
+		key := strings.ToLower(fqdn)
+		if seen[key] == 0 {
+			return false
+		}
+		seen[key]--
+	}
+	return true
+}
+
 // generateOrderID generates a unique order ID
 func generateOrderID() string {
 	return fmt.Sprintf("order_%d", time.Now().UnixNano())
@@ -0,0 +1,38 @@
+package issuer
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const metricsNamespace = "proxy_ssl"
+
+var daysToExpiryDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metricsNamespace, "", "certificate_days_to_expiry"),
+	"Days until the certificate expires, negative once expired.",
+	[]string{"domain", "order_id"}, nil,
+)
+
+// RegisterMetrics registers the certificate expiry and renewal failure metrics
+func (i *Issuer) RegisterMetrics(registerer prometheus.Registerer) error {
+	if err := registerer.Register(i.renewalFailures); err != nil {
+		return err
+	}
+	return registerer.Register(&expiryCollector{issuer: i})
+}
+
+// expiryCollector reports the days to expiry of the current certificates at scrape time
+type expiryCollector struct {
+	issuer *Issuer
+}
+
+func (c *expiryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- daysToExpiryDesc
+}
+
+func (c *expiryCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, certificate := range c.issuer.Certificates() {
+		if certificate.DaysToExpiry == nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(daysToExpiryDesc, prometheus.GaugeValue,
+			*certificate.DaysToExpiry, certificate.Domain, certificate.OrderID)
+	}
+}
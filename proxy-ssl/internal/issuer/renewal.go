@@ -0,0 +1,71 @@
+package issuer
+
+import (
+	"context"
+	"time"
+)
+
+// StartRenewal renews certificates that expire within the renewal threshold,
+// checking every RenewalCheckInterval until the context is cancelled
+func (i *Issuer) StartRenewal(ctx context.Context) {
+	i.logger.Info("Certificate renewal started",
+		"threshold", i.config.RenewalThreshold,
+		"check_interval", i.config.RenewalCheckInterval,
+		"reload_hooks", len(i.reloadHooks))
+
+	ticker := time.NewTicker(i.config.RenewalCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		i.renewDueCertificates()
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			i.logger.Info("Certificate renewal stopped")
+			return
+		}
+	}
+}
+
+// renewDueCertificates renews every certificate due for renewal, one at a time
+func (i *Issuer) renewDueCertificates() {
+	for _, orderID := range i.dueForRenewal(time.Now()) {
+		if err := i.renewCertificate(orderID); err != nil {
+			i.logger.Error("Scheduled certificate renewal failed", "order_id", orderID, "error", err)
+		}
+	}
+}
+
+// dueForRenewal returns the completed orders expiring within the renewal threshold.
+// Only the latest order of a node for a set of FQDNs is renewed; older ones were replaced.
+func (i *Issuer) dueForRenewal(now time.Time) []string {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var due []string
+	for _, order := range i.orders {
+		if order.Status != "completed" || order.ExpiresAt.IsZero() || order.ExpiresAt.Sub(now) > i.config.RenewalThreshold {
+			continue
+		}
+		if i.hasNewerOrder(order, true) {
+			continue
+		}
+		due = append(due, order.ID)
+	}
+	return due
+}
+
+// hasNewerOrder reports whether the node has a later order, or a later completed order if completedOnly,
+// for the same FQDNs. Must be called with i.mu held.
+func (i *Issuer) hasNewerOrder(order *Order, completedOnly bool) bool {
+	for _, other := range i.orders {
+		if completedOnly && other.Status != "completed" {
+			continue
+		}
+		if other.ID != order.ID && other.NodeID == order.NodeID &&
+			other.CreatedAt.After(order.CreatedAt) && sameFQDNs(other.FQDNs, order.FQDNs) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package issuer
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// CertificateStatus is the operator view of a certificate: the latest order of a node for a set of FQDNs
+type CertificateStatus struct {
+	Domain       string    `json:"domain"`
+	SANs         []string  `json:"sans"`
+	OrderID      string    `json:"order_id"`
+	NodeID       string    `json:"node_id"`
+	Status       string    `json:"status"`
+	IssuedAt     time.Time `json:"issued_at"`
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	DaysToExpiry *float64  `json:"days_to_expiry,omitempty"`
+	RenewedAt    time.Time `json:"renewed_at,omitempty"`
+	NextRenewal  time.Time `json:"next_renewal,omitempty"`
+	LastError    string    `json:"last_error,omitempty"`
+}
+
+// Certificates returns the status of every current certificate, sorted by domain.
+// Orders of a node replaced by a later completed order for the same FQDNs are left out,
+// and so are unfinished or failed orders followed by a later attempt.
+func (i *Issuer) Certificates() []CertificateStatus {
+	now := time.Now()
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	certificates := make([]CertificateStatus, 0, len(i.orders))
+	for _, order := range i.orders {
+		if len(order.FQDNs) == 0 || i.hasNewerOrder(order, order.Status == "completed") {
+			continue
+		}
+		certificates = append(certificates, i.certificateStatus(order, now))
+	}
+
+	sort.Slice(certificates, func(a, b int) bool {
+		if certificates[a].Domain != certificates[b].Domain {
+			return certificates[a].Domain < certificates[b].Domain
+		}
+		return certificates[a].IssuedAt.After(certificates[b].IssuedAt)
+	})
+	return certificates
+}
+
+// CertificatesForDomain returns the current certificates with the domain among their SANs
+func (i *Issuer) CertificatesForDomain(domain string) []CertificateStatus {
+	result := make([]CertificateStatus, 0)
+	for _, certificate := range i.Certificates() {
+		for _, san := range certificate.SANs {
+			if strings.EqualFold(san, domain) {
+				result = append(result, certificate)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// certificateStatus builds the status of an order. Must be called with i.mu held.
+func (i *Issuer) certificateStatus(order *Order, now time.Time) CertificateStatus {
+	status := CertificateStatus{
+		Domain:    order.FQDNs[0],
+		SANs:      append([]string(nil), order.FQDNs...),
+		OrderID:   order.ID,
+		NodeID:    order.NodeID,
+		Status:    order.Status,
+		IssuedAt:  order.CreatedAt,
+		ExpiresAt: order.ExpiresAt,
+		RenewedAt: order.RenewedAt,
+		LastError: order.LastError,
+	}
+	if !order.ExpiresAt.IsZero() {
+		days := order.ExpiresAt.Sub(now).Hours() / 24
+		status.DaysToExpiry = &days
+		status.NextRenewal = order.ExpiresAt.Add(-i.config.RenewalThreshold)
+	}
+	return status
+}
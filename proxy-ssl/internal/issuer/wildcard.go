@@ -0,0 +1,32 @@
+package issuer
+
+import (
+	"fmt"
+	"strings"
+)
+
+const wildcardPrefix = "*."
+
+// IsWildcard reports whether the FQDN is a wildcard name such as *.node1.example.com
+func IsWildcard(fqdn string) bool {
+	return strings.HasPrefix(fqdn, wildcardPrefix)
+}
+
+// WildcardBase returns the domain whose direct subdomains a wildcard FQDN covers,
+// or the FQDN itself if it isn't a wildcard
+func WildcardBase(fqdn string) string {
+	return strings.TrimPrefix(fqdn, wildcardPrefix)
+}
+
+// ValidateFQDN checks that a wildcard is only used as the whole left-most label.
+// ACME CAs only issue wildcards in that form, and only through DNS-01.
+func ValidateFQDN(fqdn string) error {
+	base := WildcardBase(fqdn)
+	if strings.Contains(base, "*") {
+		return fmt.Errorf("invalid wildcard FQDN %s: only a left-most *. label is allowed", fqdn)
+	}
+	if IsWildcard(fqdn) && !strings.Contains(base, ".") {
+		return fmt.Errorf("invalid wildcard FQDN %s: wildcard must be under a domain", fqdn)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+package participant
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"log/slog"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// startInferenceMsgType is the authz message type dAPI signer keys are granted for.
+// The dAPI signs outgoing requests with that key, not the cold account key.
+const startInferenceMsgType = "/inference.inference.MsgStartInference"
+
+// MaxSignatureAge bounds how far a request timestamp may drift from local time
+const MaxSignatureAge = 5 * time.Minute
+
+// CertificateRequest is the body a dAPI sends to obtain a certificate for its InferenceUrl domain
+type CertificateRequest struct {
+	Address   string `json:"address" binding:"required"`
+	FQDN      string `json:"fqdn" binding:"required"`
+	Timestamp int64  `json:"timestamp" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
+
+// SignBytes returns the bytes a participant signs for a certificate request.
+// The layout matches calculations.SignatureComponents with the Developer signature type
+// (payload + timestamp + address) so the dAPI can reuse its existing signer.
+func SignBytes(fqdn string, timestamp int64, address string) []byte {
+	payload := []byte(fqdn)
+	payload = append(payload, []byte(strconv.FormatInt(timestamp, 10))...)
+	payload = append(payload, []byte(address)...)
+	return payload
+}
+
+// Verifier authenticates certificate requests against participant data stored on chain
+type Verifier struct {
+	chainAPIURL string
+	httpClient  *http.Client
+	logger      *slog.Logger
+	now         func() time.Time
+}
+
+// NewVerifier creates a verifier that queries the chain REST API at chainAPIURL
+func NewVerifier(chainAPIURL string, logger *slog.Logger) *Verifier {
+	return &Verifier{
+		chainAPIURL: strings.TrimRight(chainAPIURL, "/"),
+		httpClient:  &http.Client{Timeout: 15 * time.Second},
+		logger:      logger,
+		now:         time.Now,
+	}
+}
+
+// Verify checks that the request is fresh, signed by a key of the participant and
+// that the requested FQDN is the host of the participant's registered InferenceUrl
+func (v *Verifier) Verify(ctx context.Context, req *CertificateRequest) error {
+	requestTime := time.Unix(0, req.Timestamp)
+	if drift := v.now().Sub(requestTime); drift > MaxSignatureAge || drift < -MaxSignatureAge {
+		return fmt.Errorf("request timestamp outside of allowed window")
+	}
+
+	inferenceURL, err := v.getInferenceURL(ctx, req.Address)
+	if err != nil {
+		return fmt.Errorf("failed to get participant: %w", err)
+	}
+
+	host, err := hostFromURL(inferenceURL)
+	if err != nil {
+		return fmt.Errorf("invalid participant inference url: %w", err)
+	}
+	if !strings.EqualFold(host, req.FQDN) {
+		return fmt.Errorf("fqdn %s does not match registered inference url host %s", req.FQDN, host)
+	}
+
+	pubKeys, err := v.getPubKeys(ctx, req.Address)
+	if err != nil {
+		return fmt.Errorf("failed to get participant keys: %w", err)
+	}
+
+	message := SignBytes(req.FQDN, req.Timestamp, req.Address)
+	for _, pubKey := range pubKeys {
+		err := verifySignature(message, pubKey, req.Signature)
+		if err == nil {
+			return nil
+		}
+		v.logger.Debug("Signature does not match key", "address", req.Address, "error", err)
+	}
+
+	return fmt.Errorf("invalid signature")
+}
+
+// getInferenceURL returns the InferenceUrl registered for the participant
+func (v *Verifier) getInferenceURL(ctx context.Context, address string) (string, error) {
+	var resp struct {
+		Participant struct {
+			InferenceURL string `json:"inference_url"`
+		} `json:"participant"`
+	}
+	if err := v.getJSON(ctx, "/productscience/inference/inference/participant/"+url.PathEscape(address), &resp); err != nil {
+		return "", err
+	}
+	if resp.Participant.InferenceURL == "" {
+		return "", fmt.Errorf("participant %s has no inference url", address)
+	}
+	return resp.Participant.InferenceURL, nil
+}
+
+// getPubKeys returns the participant account key followed by the keys of its authz grantees
+func (v *Verifier) getPubKeys(ctx context.Context, address string) ([]string, error) {
+	var participantResp struct {
+		Pubkey string `json:"pubkey"`
+	}
+	if err := v.getJSON(ctx, "/productscience/inference/inference/inference_participant/"+url.PathEscape(address), &participantResp); err != nil {
+		return nil, err
+	}
+
+	pubKeys := make([]string, 0, 4)
+	if participantResp.Pubkey != "" {
+		pubKeys = append(pubKeys, participantResp.Pubkey)
+	}
+
+	var granteesResp struct {
+		Grantees []struct {
+			PubKey string `json:"pub_key"`
+		} `json:"grantees"`
+	}
+	path := "/productscience/inference/inference/grantees_by_message_type/" + url.PathEscape(address) + "/" + url.PathEscape(startInferenceMsgType)
+	if err := v.getJSON(ctx, path, &granteesResp); err != nil {
+		// Grantees are optional: the account key alone is enough to authenticate
+		v.logger.Warn("Failed to get participant grantees", "address", address, "error", err)
+	}
+	for _, g := range granteesResp.Grantees {
+		if g.PubKey != "" {
+			pubKeys = append(pubKeys, g.PubKey)
+		}
+	}
+
+	if len(pubKeys) == 0 {
+		return nil, fmt.Errorf("no public keys found for participant %s", address)
+	}
+	return pubKeys, nil
+}
+
+func (v *Verifier) getJSON(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.chainAPIURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("chain api returned status %d: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// hostFromURL extracts the host name (without port) from an InferenceUrl
+func hostFromURL(rawURL string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	host := parsed.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "" {
+		return "", fmt.Errorf("url %s has no host", rawURL)
+	}
+	return host, nil
+}
+
+// verifySignature verifies a base64 cosmos secp256k1 signature (64 byte r||s over sha256)
+// against a base64 compressed public key
+func verifySignature(message []byte, pubKeyB64, signatureB64 string) error {
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(pubKeyB64)
+	if err != nil {
+		return fmt.Errorf("invalid public key encoding: %w", err)
+	}
+	pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	sigBytes, err := base64.StdEncoding.DecodeString(signatureB64)
+	if err != nil {
+		return fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sigBytes) != 64 {
+		return fmt.Errorf("invalid signature length %d", len(sigBytes))
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sigBytes[:32]); overflow {
+		return fmt.Errorf("invalid signature r value")
+	}
+	if overflow := s.SetByteSlice(sigBytes[32:]); overflow {
+		return fmt.Errorf("invalid signature s value")
+	}
+	// Reject malleable high-S signatures, same as the cosmos-sdk verifier
+	if s.IsOverHalfOrder() {
+		return fmt.Errorf("signature s value is not canonical")
+	}
+
+	hash := sha256.Sum256(message)
+	if !ecdsa.NewSignature(&r, &s).Verify(hash[:], pubKey) {
+		return fmt.Errorf("signature verification failed")
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package participant
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+const (
+	testAddress = "gonka1participant"
+	testFQDN    = "node.example.com"
+)
+
+func newTestKey(t *testing.T) *secp256k1.PrivateKey {
+	t.Helper()
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return key
+}
+
+func pubKeyBase64(key *secp256k1.PrivateKey) string {
+	return base64.StdEncoding.EncodeToString(key.PubKey().SerializeCompressed())
+}
+
+// sign produces a cosmos-style signature: base64 of the 64 byte r||s over sha256 of the message
+func sign(key *secp256k1.PrivateKey, message []byte) string {
+	hash := sha256.Sum256(message)
+	sig := ecdsa.Sign(key, hash[:])
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	return base64.StdEncoding.EncodeToString(append(rBytes[:], sBytes[:]...))
+}
+
+// newChainAPI serves the participant, account key and grantee queries the verifier makes
+func newChainAPI(t *testing.T, accountKey string, granteeKeys ...string) *httptest.Server {
+	t.Helper()
+	grantees := make([]map[string]string, 0, len(granteeKeys))
+	for _, key := range granteeKeys {
+		grantees = append(grantees, map[string]string{"pub_key": key})
+	}
+	routes := map[string]interface{}{
+		"/productscience/inference/inference/participant/" + testAddress: map[string]interface{}{
+			"participant": map[string]string{"inference_url": "https://" + testFQDN + ":8443"},
+		},
+		"/productscience/inference/inference/inference_participant/" + testAddress: map[string]string{
+			"pubkey": accountKey,
+		},
+		"/productscience/inference/inference/grantees_by_message_type/" + testAddress + "/" + startInferenceMsgType: map[string]interface{}{
+			"grantees": grantees,
+		},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := routes[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	accountKey := newTestKey(t)
+	granteeKey := newTestKey(t)
+	otherKey := newTestKey(t)
+	chainAPI := newChainAPI(t, pubKeyBase64(accountKey), pubKeyBase64(granteeKey))
+
+	now := time.Unix(1_700_000_000, 0)
+	timestamp := now.UnixNano()
+	message := SignBytes(testFQDN, timestamp, testAddress)
+
+	tests := []struct {
+		name    string
+		req     CertificateRequest
+		wantErr bool
+	}{
+		{
+			name: "signed by the participant account key",
+			req:  CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: timestamp, Signature: sign(accountKey, message)},
+		},
+		{
+			name: "signed by a grantee key",
+			req:  CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: timestamp, Signature: sign(granteeKey, message)},
+		},
+		{
+			name:    "signed by an unrelated key",
+			req:     CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: timestamp, Signature: sign(otherKey, message)},
+			wantErr: true,
+		},
+		{
+			name:    "signature over other bytes",
+			req:     CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: timestamp, Signature: sign(accountKey, []byte("other"))},
+			wantErr: true,
+		},
+		{
+			name:    "malformed signature",
+			req:     CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: timestamp, Signature: base64.StdEncoding.EncodeToString([]byte("short"))},
+			wantErr: true,
+		},
+		{
+			name: "stale timestamp",
+			req: CertificateRequest{Address: testAddress, FQDN: testFQDN, Timestamp: now.Add(-2 * MaxSignatureAge).UnixNano(),
+				Signature: sign(accountKey, SignBytes(testFQDN, now.Add(-2*MaxSignatureAge).UnixNano(), testAddress))},
+			wantErr: true,
+		},
+		{
+			name: "fqdn other than the registered inference url host",
+			req: CertificateRequest{Address: testAddress, FQDN: "other.example.com", Timestamp: timestamp,
+				Signature: sign(accountKey, SignBytes("other.example.com", timestamp, testAddress))},
+			wantErr: true,
+		},
+	}
+
+	verifier := NewVerifier(chainAPI.URL, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	verifier.now = func() time.Time { return now }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.Verify(context.Background(), &tt.req)
+			if tt.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifySignature_RejectsHighS(t *testing.T) {
+	key := newTestKey(t)
+	message := []byte("message")
+	hash := sha256.Sum256(message)
+	sig := ecdsa.Sign(key, hash[:])
+	r, s := sig.R(), sig.S()
+	s.Negate()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	highS := base64.StdEncoding.EncodeToString(append(rBytes[:], sBytes[:]...))
+
+	if err := verifySignature(message, pubKeyBase64(key), highS); err == nil {
+		t.Fatal("expected the high-S signature to be rejected")
+	}
+}